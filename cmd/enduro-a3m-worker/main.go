@@ -24,6 +24,7 @@ import (
 	"github.com/artefactual-sdps/temporal-activities/removepaths"
 	"github.com/artefactual-sdps/temporal-activities/xmlvalidate"
 	"github.com/oklog/run"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
 	"go.artefactual.dev/tools/bucket"
@@ -37,6 +38,7 @@ import (
 	"gocloud.dev/blob"
 
 	"github.com/artefactual-sdps/enduro/internal/a3m"
+	"github.com/artefactual-sdps/enduro/internal/antivirus"
 	"github.com/artefactual-sdps/enduro/internal/auth"
 	"github.com/artefactual-sdps/enduro/internal/config"
 	"github.com/artefactual-sdps/enduro/internal/db"
@@ -45,6 +47,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/persistence"
 	entclient "github.com/artefactual-sdps/enduro/internal/persistence/ent/client"
 	entdb "github.com/artefactual-sdps/enduro/internal/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/sipsource"
 	"github.com/artefactual-sdps/enduro/internal/telemetry"
 	"github.com/artefactual-sdps/enduro/internal/temporal"
@@ -106,6 +109,21 @@ func main() {
 	}
 	defer func() { _ = shutdown(ctx) }()
 
+	// Set up the meter provider.
+	mp, shutdownMeter, err := telemetry.MeterProvider(
+		ctx,
+		logger,
+		cfg.Telemetry,
+		prometheus.DefaultRegisterer,
+		appName,
+		version.Long,
+	)
+	if err != nil {
+		logger.Error(err, "Error creating meter provider.")
+		os.Exit(1)
+	}
+	defer func() { _ = shutdownMeter(ctx) }()
+
 	enduroDatabase, err := db.Connect(ctx, tp, cfg.Database.Driver, cfg.Database.DSN)
 	if err != nil {
 		logger.Error(err, "Enduro database configuration failed.")
@@ -179,6 +197,13 @@ func main() {
 	}
 	defer sipSource.Close()
 
+	// Set up the SIP search backend.
+	ingestSearch, err := search.New(cfg.Search, perSvc)
+	if err != nil {
+		logger.Error(err, "Error setting up SIP search backend.")
+		os.Exit(1)
+	}
+
 	// Set up the ingest service.
 	var ingestsvc ingest.Service
 	{
@@ -195,6 +220,8 @@ func main() {
 			UploadMaxSize:      0,
 			Rander:             rand.Reader,
 			SIPSource:          sipSource,
+			SearchBackend:      ingestSearch,
+			MeterProvider:      mp,
 		})
 	}
 
@@ -250,6 +277,16 @@ func main() {
 		}
 	}()
 
+	// Set up the antivirus scanner, if enabled.
+	var scanner antivirus.Scanner
+	if cfg.Antivirus.Enabled() {
+		scanner, err = antivirus.NewClamdScanner(cfg.Antivirus)
+		if err != nil {
+			logger.Error(err, "Error setting up antivirus scanner.")
+			os.Exit(1)
+		}
+	}
+
 	var g run.Group
 
 	// Activity worker.
@@ -309,6 +346,10 @@ func main() {
 			activities.NewCalcFileChecksumActivity().Execute,
 			temporalsdk_activity.RegisterOptions{Name: activities.CalcFileChecksumActivityName},
 		)
+		w.RegisterActivityWithOptions(
+			activities.NewVerifyManifestActivity().Execute,
+			temporalsdk_activity.RegisterOptions{Name: activities.VerifyManifestActivityName},
+		)
 		w.RegisterActivityWithOptions(
 			activities.NewCheckDuplicateSIPActivity(ingestsvc).Execute,
 			temporalsdk_activity.RegisterOptions{Name: activities.CheckDuplicateSIPActivityName},
@@ -325,6 +366,12 @@ func main() {
 			activities.NewClassifySIPActivity().Execute,
 			temporalsdk_activity.RegisterOptions{Name: activities.ClassifySIPActivityName},
 		)
+		if scanner != nil {
+			w.RegisterActivityWithOptions(
+				activities.NewScanSIPActivity(scanner).Execute,
+				temporalsdk_activity.RegisterOptions{Name: activities.ScanSIPActivityName},
+			)
+		}
 		w.RegisterActivityWithOptions(
 			bagvalidate.New(bagValidator).Execute,
 			temporalsdk_activity.RegisterOptions{Name: bagvalidate.Name},
@@ -0,0 +1,266 @@
+// Command enduro-admin provides administrative tasks for Enduro deployments.
+//
+// Export the ingest and storage databases to an archive, removing the secrets
+// from the storage location configs:
+//
+//	$ enduro-admin export --config=enduro.toml --scrub-secrets --output=enduro.jsonl
+//
+// Import the archive into empty databases, e.g. after migrating to another
+// database server or driver:
+//
+//	$ enduro-admin import --config=enduro.toml --input=enduro.jsonl
+//
+// The database drivers and DSNs are read from the Enduro configuration file and
+// can be overridden with the --ingest-driver, --ingest-dsn, --storage-driver and
+// --storage-dsn flags.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	"go.artefactual.dev/tools/log"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/artefactual-sdps/enduro/internal/config"
+	"github.com/artefactual-sdps/enduro/internal/dataexport"
+	"github.com/artefactual-sdps/enduro/internal/db"
+	entdb "github.com/artefactual-sdps/enduro/internal/persistence/ent/db"
+	storage_entdb "github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/version"
+)
+
+const appName = "enduro-admin"
+
+const usage = `Usage: enduro-admin <command> [flags]
+
+Commands:
+  export    Export the Enduro databases to an archive
+  import    Import an archive into empty Enduro databases
+
+Run "enduro-admin <command> --help" for the command flags.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	var err error
+	switch cmd := os.Args[1]; cmd {
+	case "export":
+		err = runExport(ctx, os.Args[2:])
+	case "import":
+		err = runImport(ctx, os.Args[2:])
+	case "--version", "version":
+		fmt.Println(version.Info(appName))
+	case "-h", "--help", "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %q\n\n%s", cmd, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// dbFlags are the database flags shared by the commands.
+type dbFlags struct {
+	config        string
+	ingestDriver  string
+	ingestDSN     string
+	storageDriver string
+	storageDSN    string
+}
+
+func (f *dbFlags) register(p *pflag.FlagSet) {
+	p.StringVar(&f.config, "config", "", "Configuration file")
+	p.StringVar(&f.ingestDriver, "ingest-driver", "", "Ingest database driver, overrides the configuration")
+	p.StringVar(&f.ingestDSN, "ingest-dsn", "", "Ingest database DSN, overrides the configuration")
+	p.StringVar(&f.storageDriver, "storage-driver", "", "Storage database driver, overrides the configuration")
+	p.StringVar(&f.storageDSN, "storage-dsn", "", "Storage database DSN, overrides the configuration")
+}
+
+// databases connects to the ingest and storage databases. The returned
+// function closes the connections.
+func (f *dbFlags) databases(ctx context.Context, logger logr.Logger) ([]dataexport.Database, func(), error) {
+	var cfg config.Configuration
+	found, used, err := config.Read(&cfg, f.config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read configuration: %v", err)
+	}
+	if found {
+		logger.V(1).Info("Configuration file loaded.", "path", used)
+	}
+
+	ingest := db.Config{Driver: cfg.Database.Driver, DSN: cfg.Database.DSN}
+	storage := db.Config{Driver: cfg.Storage.Database.Driver, DSN: cfg.Storage.Database.DSN}
+	if f.ingestDriver != "" {
+		ingest.Driver = f.ingestDriver
+	}
+	if f.ingestDSN != "" {
+		ingest.DSN = f.ingestDSN
+	}
+	if f.storageDriver != "" {
+		storage.Driver = f.storageDriver
+	}
+	if f.storageDSN != "" {
+		storage.DSN = f.storageDSN
+	}
+
+	tp := noop.NewTracerProvider()
+
+	ingestDB, err := db.Connect(ctx, tp, ingest.Driver, ingest.DSN)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to ingest database: %v", err)
+	}
+	storageDB, err := db.Connect(ctx, tp, storage.Driver, storage.DSN)
+	if err != nil {
+		ingestDB.Close()
+		return nil, nil, fmt.Errorf("connect to storage database: %v", err)
+	}
+
+	closer := func() {
+		ingestDB.Close()
+		storageDB.Close()
+	}
+
+	return []dataexport.Database{
+		dataexport.Ingest(ingest.Driver, ingestDB),
+		dataexport.Storage(storage.Driver, storageDB),
+	}, closer, nil
+}
+
+func newLogger() logr.Logger {
+	return log.New(os.Stderr, log.WithName(appName))
+}
+
+func runExport(ctx context.Context, args []string) error {
+	var f dbFlags
+	p := pflag.NewFlagSet("export", pflag.ExitOnError)
+	f.register(p)
+	p.String("output", "-", `Archive file, "-" writes to the standard output`)
+	p.Bool("scrub-secrets", false, "Remove the secrets from the storage location configs")
+	_ = p.Parse(args)
+
+	logger := newLogger()
+	defer log.Sync(logger)
+
+	dbs, closer, err := f.databases(ctx, logger)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	var (
+		w    io.Writer = os.Stdout
+		file *os.File
+	)
+	output, _ := p.GetString("output")
+	if output != "-" {
+		// The archive may hold secrets, don't make it readable by others.
+		file, err = os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			return fmt.Errorf("create archive: %v", err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	scrub, _ := p.GetBool("scrub-secrets")
+	rows, err := dataexport.Export(ctx, w, dataexport.ExportOptions{ScrubSecrets: scrub}, dbs...)
+	if err != nil {
+		return err
+	}
+	if file != nil {
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("close archive: %v", err)
+		}
+	}
+
+	logger.Info("Export completed.", "rows", rows, "scrubbed", scrub)
+
+	return nil
+}
+
+func runImport(ctx context.Context, args []string) error {
+	var f dbFlags
+	p := pflag.NewFlagSet("import", pflag.ExitOnError)
+	f.register(p)
+	p.String("input", "-", `Archive file, "-" reads from the standard input`)
+	_ = p.Parse(args)
+
+	logger := newLogger()
+	defer log.Sync(logger)
+
+	dbs, closer, err := f.databases(ctx, logger)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	var r io.Reader = os.Stdin
+	input, _ := p.GetString("input")
+	if input != "-" {
+		file, err := os.Open(input)
+		if err != nil {
+			return fmt.Errorf("open archive: %v", err)
+		}
+		defer file.Close()
+		r = file
+	}
+
+	for _, d := range dbs {
+		if err := prepareSchema(ctx, logger, d); err != nil {
+			return fmt.Errorf("prepare %s database schema: %v", d.Name, err)
+		}
+	}
+
+	rows, err := dataexport.Import(ctx, r, dbs...)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Import completed.", "rows", rows)
+
+	return nil
+}
+
+// prepareSchema brings the schema of d up to date. MySQL and PostgreSQL
+// databases are migrated, SQLite databases are created from the Ent schema.
+func prepareSchema(ctx context.Context, logger logr.Logger, d dataexport.Database) error {
+	if d.Driver == dialect.SQLite {
+		return createSchema(ctx, d.Name, d.DB)
+	}
+
+	l := logger.WithName("migrate").WithValues("database", d.Name)
+	if d.Name == dataexport.IngestDatabase {
+		return db.MigrateEnduroDatabase(l, d.Driver, d.DB)
+	}
+
+	return db.MigrateEnduroStorageDatabase(l, d.Driver, d.DB)
+}
+
+func createSchema(ctx context.Context, name string, sqlDB *sql.DB) error {
+	drv := entsql.OpenDB(dialect.SQLite, sqlDB)
+	if name == dataexport.IngestDatabase {
+		return entdb.NewClient(entdb.Driver(drv)).Schema.Create(ctx)
+	}
+
+	return storage_entdb.NewClient(storage_entdb.Driver(drv)).Schema.Create(ctx)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/jonboulle/clockwork"
 	"github.com/oklog/run"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
 	"go.artefactual.dev/tools/bucket"
@@ -34,6 +35,7 @@ import (
 	temporalsdk_interceptor "go.temporal.io/sdk/interceptor"
 	temporalsdk_worker "go.temporal.io/sdk/worker"
 	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+	"gocloud.dev/blob"
 
 	"github.com/artefactual-sdps/enduro/internal/about"
 	"github.com/artefactual-sdps/enduro/internal/api"
@@ -46,6 +48,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/persistence"
 	entclient "github.com/artefactual-sdps/enduro/internal/persistence/ent/client"
 	entdb "github.com/artefactual-sdps/enduro/internal/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/sipsource"
 	"github.com/artefactual-sdps/enduro/internal/storage"
 	storage_activities "github.com/artefactual-sdps/enduro/internal/storage/activities"
@@ -57,6 +60,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/telemetry"
 	"github.com/artefactual-sdps/enduro/internal/version"
 	"github.com/artefactual-sdps/enduro/internal/watcher"
+	"github.com/artefactual-sdps/enduro/internal/webhook"
 	"github.com/artefactual-sdps/enduro/internal/workflow"
 	"github.com/artefactual-sdps/enduro/internal/workflow/activities"
 )
@@ -122,6 +126,21 @@ func main() {
 	}
 	defer func() { _ = shutdown(ctx) }()
 
+	// Set up the meter provider.
+	mp, shutdownMeter, err := telemetry.MeterProvider(
+		ctx,
+		logger,
+		cfg.Telemetry,
+		prometheus.DefaultRegisterer,
+		appName,
+		version.Long,
+	)
+	if err != nil {
+		logger.Error(err, "Error creating meter provider.")
+		os.Exit(1)
+	}
+	defer func() { _ = shutdownMeter(ctx) }()
+
 	// Set up the Enduro database client handler.
 	enduroDatabase, err := db.Connect(ctx, tp, cfg.Database.Driver, cfg.Database.DSN)
 	if err != nil {
@@ -130,7 +149,7 @@ func main() {
 	}
 	if cfg.Database.Migrate {
 		l := logger.WithName("migrate")
-		if err := db.MigrateEnduroDatabase(l, enduroDatabase); err != nil {
+		if err := db.MigrateEnduroDatabase(l, cfg.Database.Driver, enduroDatabase); err != nil {
 			l.Error(err, "Enduro database migration failed.")
 			os.Exit(1)
 		}
@@ -144,7 +163,7 @@ func main() {
 	}
 	if cfg.Storage.Database.Migrate {
 		l := logger.WithName("storage-migrate")
-		if err := db.MigrateEnduroStorageDatabase(l, storageDatabase); err != nil {
+		if err := db.MigrateEnduroStorageDatabase(l, cfg.Storage.Database.Driver, storageDatabase); err != nil {
 			l.Error(err, "Storage database migration failed.")
 			os.Exit(1)
 		}
@@ -183,6 +202,7 @@ func main() {
 		logger.Error(err, "Error creating Ingest Event service.")
 		os.Exit(1)
 	}
+	ingestEventSvc = event.WithMetrics(ingestEventSvc, mp, "ingest")
 
 	// Set up the storage event service.
 	storageEventSvc, err := event.NewServiceRedis(
@@ -196,6 +216,7 @@ func main() {
 		logger.Error(err, "Error creating Storage Event service.")
 		os.Exit(1)
 	}
+	storageEventSvc = event.WithMetrics(storageEventSvc, mp, "storage")
 
 	// Set up the OIDC token verifier.
 	var tokenVerifier auth.TokenVerifier
@@ -248,6 +269,18 @@ func main() {
 		)
 	}
 
+	// Accept the Enduro API tokens alongside the OIDC tokens, the API token
+	// verifier goes first as it rejects other tokens without a lookup. The
+	// OIDC verifier revokes the API tokens their owners no longer have access
+	// to.
+	if cfg.API.Auth.Enabled {
+		apiTokenStore := ingest.NewAPITokenStore(perSvc)
+		tokenVerifier = auth.TokenVerifiers{
+			auth.NewAPITokenVerifier(apiTokenStore, auditLogger, nil),
+			auth.NewAPITokenOwnerVerifier(tokenVerifier, apiTokenStore, auditLogger, nil),
+		}
+	}
+
 	// Set up internal storage bucket.
 	internalStorage, err := bucket.NewWithConfig(ctx, &cfg.InternalStorage)
 	if err != nil {
@@ -264,6 +297,20 @@ func main() {
 	}
 	defer sipSource.Close()
 
+	// Set up the SIP search backend.
+	ingestSearch, err := search.New(cfg.Search, perSvc)
+	if err != nil {
+		logger.Error(err, "Error setting up SIP search backend.")
+		os.Exit(1)
+	}
+
+	// Set up the storage API client.
+	storageClient, err := ingest.NewStorageClient(ctx, tp, cfg.Ingest.Storage)
+	if err != nil {
+		logger.Error(err, "Error setting up storage API client.")
+		os.Exit(1)
+	}
+
 	// Set up the ingest service.
 	var ingestsvc ingest.Service
 	{
@@ -279,9 +326,16 @@ func main() {
 			InternalStorage:       internalStorage,
 			UploadMaxSize:         cfg.Upload.MaxSize,
 			UploadRetentionPeriod: cfg.Upload.RetentionPeriod,
+			UploadSessionExpiry:   cfg.Upload.SessionExpiry,
+			APITokenMaxLifetime:   cfg.Ingest.APITokenMaxLifetime,
 			Rander:                rand.Reader,
 			SIPSource:             sipSource,
 			AuditLogger:           auditLogger,
+			ProcessingProfiles:    cfg.Preservation.Profiles,
+			PresTaskQueue:         cfg.Preservation.TaskQueue,
+			SearchBackend:         ingestSearch,
+			StorageClient:         storageClient,
+			MeterProvider:         mp,
 		})
 	}
 
@@ -302,6 +356,13 @@ func main() {
 		)
 	}
 
+	// Set up the AIP search backend.
+	storageSearch, err := search.New(cfg.Search, storagePersistence)
+	if err != nil {
+		logger.Error(err, "Error setting up AIP search backend.")
+		os.Exit(1)
+	}
+
 	// Set up the shared outbound HTTP client for AMSS requests.
 	amssHTTPClient := cleanhttp.DefaultPooledClient()
 	amssHTTPClient.Transport = otelhttp.NewTransport(
@@ -327,11 +388,32 @@ func main() {
 			rand.Reader,
 			auditLogger,
 			amssHTTPClient,
+			storageSearch,
 		)
 		if err != nil {
 			logger.Error(err, "Error setting up storage service.")
 			os.Exit(1)
 		}
+
+		err = storage.InitStorageFixityAuditSchedule(
+			ctx,
+			temporalClient,
+			cfg.Storage.Fixity,
+			cfg.Storage.TaskQueue,
+		)
+		if err != nil {
+			logger.Error(err, "Error initializing storage fixity audit schedule.")
+		}
+
+		err = storage.InitStorageRetentionSchedule(
+			ctx,
+			temporalClient,
+			cfg.Storage.Retention,
+			cfg.Storage.TaskQueue,
+		)
+		if err != nil {
+			logger.Error(err, "Error initializing storage retention schedule.")
+		}
 	}
 
 	aboutsvc := about.NewService(
@@ -369,7 +451,7 @@ func main() {
 
 		g.Add(
 			func() error {
-				srv = api.HTTPServer(logger, apiLog.Logger, tp, &cfg.API, ingestsvc, storagesvc, aboutsvc)
+				srv = api.HTTPServer(logger, apiLog.Logger, tp, mp, &cfg.API, ingestsvc, storagesvc, aboutsvc)
 				return srv.ListenAndServe()
 			},
 			func(err error) {
@@ -396,9 +478,16 @@ func main() {
 			InternalStorage:       internalStorage,
 			UploadMaxSize:         cfg.Upload.MaxSize,
 			UploadRetentionPeriod: cfg.Upload.RetentionPeriod,
+			UploadSessionExpiry:   cfg.Upload.SessionExpiry,
+			APITokenMaxLifetime:   cfg.Ingest.APITokenMaxLifetime,
 			Rander:                rand.Reader,
 			SIPSource:             sipSource,
 			AuditLogger:           auditLogger,
+			ProcessingProfiles:    cfg.Preservation.Profiles,
+			PresTaskQueue:         cfg.Preservation.TaskQueue,
+			SearchBackend:         ingestSearch,
+			StorageClient:         storageClient,
+			MeterProvider:         mp,
 		})
 
 		iss, err := storage.NewService(
@@ -413,6 +502,7 @@ func main() {
 			rand.Reader,
 			auditLogger,
 			amssHTTPClient,
+			storageSearch,
 		)
 		if err != nil {
 			logger.Error(err, "Error setting up internal storage service.")
@@ -439,7 +529,7 @@ func main() {
 
 		g.Add(
 			func() error {
-				srv = api.HTTPServer(logger, iaLog.Logger, tp, &cfg.InternalAPI, ips, iss, ias)
+				srv = api.HTTPServer(logger, iaLog.Logger, tp, mp, &cfg.InternalAPI, ips, iss, ias)
 				return srv.ListenAndServe()
 			},
 			func(err error) {
@@ -450,6 +540,41 @@ func main() {
 		)
 	}
 
+	// Webhook dispatcher, if any webhook endpoints are configured.
+	if len(cfg.Webhooks.Endpoints) > 0 {
+		var deadLetter *blob.Bucket
+		if cfg.Webhooks.DeadLetter != nil {
+			deadLetter, err = bucket.NewWithConfig(ctx, cfg.Webhooks.DeadLetter)
+			if err != nil {
+				logger.Error(err, "Error setting up webhooks dead-letter bucket.")
+				os.Exit(1)
+			}
+			defer deadLetter.Close()
+		}
+
+		httpClient := cleanhttp.DefaultPooledClient()
+		httpClient.Transport = otelhttp.NewTransport(
+			httpClient.Transport,
+			otelhttp.WithTracerProvider(tp),
+		)
+
+		dispatcher := webhook.NewDispatcher(logger.WithName("webhooks"), cfg.Webhooks, httpClient, deadLetter)
+		ctx, cancel := context.WithCancel(ctx)
+
+		g.Add(
+			func() error {
+				return dispatcher.Run(
+					ctx,
+					webhook.EventSource(ingestEventSvc, &ingest.EventSerializer{}),
+					webhook.EventSource(storageEventSvc, &storage.EventSerializer{}),
+				)
+			},
+			func(err error) {
+				cancel()
+			},
+		)
+	}
+
 	// Watchers, where each watcher is a group actor.
 	{
 		for _, w := range wsvc.Watchers() {
@@ -477,14 +602,15 @@ func main() {
 							go func() {
 								defer span.End()
 								req := ingest.ProcessingWorkflowRequest{
-									WatcherName:     event.WatcherName,
-									RetentionPeriod: event.RetentionPeriod,
-									CompletedDir:    event.CompletedDir,
-									Key:             event.Key,
-									IsDir:           event.IsDir,
-									Type:            event.WorkflowType,
-									SIPUUID:         uuid.New(),
-									SIPName:         event.Key,
+									WatcherName:       event.WatcherName,
+									RetentionPeriod:   event.RetentionPeriod,
+									CompletedDir:      event.CompletedDir,
+									Key:               event.Key,
+									IsDir:             event.IsDir,
+									Type:              event.WorkflowType,
+									SIPUUID:           uuid.New(),
+									SIPName:           event.Key,
+									ProcessingProfile: event.ProcessingProfile,
 								}
 								if err := ingest.InitProcessingWorkflow(
 									ctx,
@@ -542,11 +668,6 @@ func main() {
 			activities.NewPollSIPStatusesActivity(ingestsvc, time.Second*60).Execute,
 			temporalsdk_activity.RegisterOptions{Name: activities.PollSIPStatusesActivityName},
 		)
-		storageClient, err := ingest.NewStorageClient(ctx, tp, cfg.Ingest.Storage)
-		if err != nil {
-			logger.Error(err, "Error setting up storage API client.")
-			os.Exit(1)
-		}
 		w.RegisterActivityWithOptions(
 			activities.NewClearIngestedSIPsActivity(ingestsvc, storageClient, time.Second*60).Execute,
 			temporalsdk_activity.RegisterOptions{Name: activities.ClearIngestedSIPsActivityName},
@@ -564,13 +685,50 @@ func main() {
 			storage_workflows.NewStorageMoveWorkflow(storagesvc).Execute,
 			temporalsdk_workflow.RegisterOptions{Name: storage.StorageMoveWorkflowName},
 		)
+		w.RegisterWorkflowWithOptions(
+			storage_workflows.NewStorageFixityWorkflow(storagesvc).Execute,
+			temporalsdk_workflow.RegisterOptions{Name: storage.StorageFixityWorkflowName},
+		)
+		w.RegisterWorkflowWithOptions(
+			storage_workflows.NewStorageFixityAuditWorkflow(storagesvc).Execute,
+			temporalsdk_workflow.RegisterOptions{Name: storage.StorageFixityAuditWorkflowName},
+		)
+		w.RegisterWorkflowWithOptions(
+			storage_workflows.NewStorageRetentionWorkflow(cfg.Storage.Retention, storagesvc).Execute,
+			temporalsdk_workflow.RegisterOptions{Name: storage.StorageRetentionWorkflowName},
+		)
+		w.RegisterWorkflowWithOptions(
+			storage_workflows.NewStorageReplicateWorkflow(storagesvc).Execute,
+			temporalsdk_workflow.RegisterOptions{Name: storage.StorageReplicateWorkflowName},
+		)
+		w.RegisterWorkflowWithOptions(
+			storage_workflows.NewStorageRestoreWorkflow(storagesvc).Execute,
+			temporalsdk_workflow.RegisterOptions{Name: storage.StorageRestoreWorkflowName},
+		)
 
 		w.RegisterActivityWithOptions(
-			storage_activities.NewCopyToPermanentLocationActivity(storagesvc).Execute,
+			storage_activities.NewCopyToPermanentLocationActivity(storagesvc, cfg.Storage.CountAIPFiles, mp).Execute,
 			temporalsdk_activity.RegisterOptions{Name: storage.CopyToPermanentLocationActivityName},
 		)
+		w.RegisterActivityWithOptions(
+			storage_activities.NewVerifyAIPFixityActivity(storagesvc).Execute,
+			temporalsdk_activity.RegisterOptions{Name: storage.VerifyAIPFixityActivityName},
+		)
+		w.RegisterActivityWithOptions(
+			storage_activities.NewReplicateAIPActivity(storagesvc).Execute,
+			temporalsdk_activity.RegisterOptions{Name: storage.ReplicateAIPActivityName},
+		)
+		w.RegisterActivityWithOptions(
+			storage_activities.NewRestoreAIPActivity(storagesvc, cfg.Storage.Restore.Bucket).Execute,
+			temporalsdk_activity.RegisterOptions{Name: storage.RestoreAIPActivityName},
+		)
+		w.RegisterActivityWithOptions(
+			storage_activities.NewDeleteRestoredAIPActivity(storagesvc, cfg.Storage.Restore.Bucket).Execute,
+			temporalsdk_activity.RegisterOptions{Name: storage.DeleteRestoredAIPActivityName},
+		)
 		w.RegisterActivityWithOptions(
 			storage_activities.NewDeleteFromAMSSLocationActivity(
+				storagesvc,
 				amssHTTPClient,
 				cfg.Storage.AIPDeletion.ApproveAMSS,
 				time.Second*60,
@@ -58,6 +58,12 @@ app.config.globalProperties.$filters = {
         return "Move AIP";
       case api.EnduroStorageAipWorkflowTypeEnum.DeleteAip:
         return "Delete AIP";
+      case api.EnduroStorageAipWorkflowTypeEnum.AuditAip:
+        return "Audit AIP";
+      case api.EnduroStorageAipWorkflowTypeEnum.ReplicateAip:
+        return "Replicate AIP";
+      case api.EnduroStorageAipWorkflowTypeEnum.RestoreAip:
+        return "Restore AIP";
       default:
         return value;
     }
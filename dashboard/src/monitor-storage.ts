@@ -39,6 +39,11 @@ const handlers: {
   [StorageEventValueTypeEnum.AipWorkflowUpdatedEvent]: handleAipWorkflowUpdated,
   [StorageEventValueTypeEnum.AipTaskCreatedEvent]: handleAipTaskCreated,
   [StorageEventValueTypeEnum.AipTaskUpdatedEvent]: handleAipTaskUpdated,
+  // Deletion request changes are also reflected in the AIP status events.
+  [StorageEventValueTypeEnum.AipDeletionRequestCreatedEvent]: () => {},
+  [StorageEventValueTypeEnum.AipDeletionRequestUpdatedEvent]: () => {},
+  // Fixity check results are not shown in the dashboard.
+  [StorageEventValueTypeEnum.AipFixityCheckedEvent]: () => {},
 };
 
 function handleLocationCreated() {
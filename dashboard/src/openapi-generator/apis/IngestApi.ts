@@ -15,11 +15,15 @@
 
 import * as runtime from '../runtime';
 import type {
+  APIToken,
+  APITokens,
   AddBatchRequestBody,
   AddSipRequestBody,
   AddSipResponseBody,
   BatchNotFound,
   ConfirmSipRequestBody,
+  CreateAPITokenRequestBody,
+  CreateSipUploadRequestBody,
   EnduroIngestBatch,
   EnduroIngestBatches,
   EnduroIngestSip,
@@ -31,9 +35,15 @@ import type {
   IngestEvent,
   ReviewBatchRequestBody,
   SIPNotFound,
+  SIPUpload,
+  SearchResults,
   SubmitSipDecisionRequestBody,
 } from '../models/index';
 import {
+    APITokenFromJSON,
+    APITokenToJSON,
+    APITokensFromJSON,
+    APITokensToJSON,
     AddBatchRequestBodyFromJSON,
     AddBatchRequestBodyToJSON,
     AddSipRequestBodyFromJSON,
@@ -44,6 +54,10 @@ import {
     BatchNotFoundToJSON,
     ConfirmSipRequestBodyFromJSON,
     ConfirmSipRequestBodyToJSON,
+    CreateAPITokenRequestBodyFromJSON,
+    CreateAPITokenRequestBodyToJSON,
+    CreateSipUploadRequestBodyFromJSON,
+    CreateSipUploadRequestBodyToJSON,
     EnduroIngestBatchFromJSON,
     EnduroIngestBatchToJSON,
     EnduroIngestBatchesFromJSON,
@@ -66,6 +80,10 @@ import {
     ReviewBatchRequestBodyToJSON,
     SIPNotFoundFromJSON,
     SIPNotFoundToJSON,
+    SIPUploadFromJSON,
+    SIPUploadToJSON,
+    SearchResultsFromJSON,
+    SearchResultsToJSON,
     SubmitSipDecisionRequestBodyFromJSON,
     SubmitSipDecisionRequestBodyToJSON,
 } from '../models/index';
@@ -78,11 +96,23 @@ export interface IngestAddSipRequest {
     addSipRequestBody: AddSipRequestBody;
 }
 
+export interface IngestCancelSipRequest {
+    uuid: string;
+}
+
 export interface IngestConfirmSipRequest {
     uuid: string;
     confirmSipRequestBody: ConfirmSipRequestBody;
 }
 
+export interface IngestCreateApiTokenRequest {
+    createAPITokenRequestBody: CreateAPITokenRequestBody;
+}
+
+export interface IngestCreateSipUploadRequest {
+    createSipUploadRequestBody: CreateSipUploadRequestBody;
+}
+
 export interface IngestDownloadSipRequest {
     uuid: string;
     enduroSipDownloadTicket?: string;
@@ -120,6 +150,7 @@ export interface IngestListSipsRequest {
     status?: IngestListSipsStatusEnum;
     uploaderUuid?: string;
     batchUuid?: string;
+    metadataKey?: string;
     limit?: number;
     offset?: number;
 }
@@ -135,11 +166,29 @@ export interface IngestRejectSipRequest {
     uuid: string;
 }
 
+export interface IngestRetryBatchRequest {
+    uuid: string;
+}
+
+export interface IngestRetrySipRequest {
+    uuid: string;
+}
+
 export interface IngestReviewBatchRequest {
     uuid: string;
     reviewBatchRequestBody: ReviewBatchRequestBody;
 }
 
+export interface IngestRevokeApiTokenRequest {
+    uuid: string;
+}
+
+export interface IngestSearchRequest {
+    query: string;
+    limit?: number;
+    offset?: number;
+}
+
 export interface IngestShowBatchRequest {
     uuid: string;
 }
@@ -152,6 +201,10 @@ export interface IngestShowSipDecisionRequest {
     uuid: string;
 }
 
+export interface IngestShowSipUploadRequest {
+    uuid: string;
+}
+
 export interface IngestSubmitSipDecisionRequest {
     uuid: string;
     submitSipDecisionRequestBody: SubmitSipDecisionRequestBody;
@@ -161,6 +214,12 @@ export interface IngestUploadSipRequest {
     contentType?: string;
 }
 
+export interface IngestUploadSipChunkRequest {
+    uuid: string;
+    uploadOffset: number;
+    uploadChecksum?: string;
+}
+
 /**
  * IngestApi - interface
  * 
@@ -216,6 +275,30 @@ export interface IngestApiInterface {
      */
     ingestAddSip(requestParameters: IngestAddSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<AddSipResponseBody>;
 
+    /**
+     * Creates request options for ingestCancelSip without sending the request
+     * @param {string} uuid Identifier of SIP to look up
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestCancelSipRequestOpts(requestParameters: IngestCancelSipRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Cancel the processing of a queued or processing SIP
+     * @summary cancel_sip ingest
+     * @param {string} uuid Identifier of SIP to look up
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestCancelSipRaw(requestParameters: IngestCancelSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Cancel the processing of a queued or processing SIP
+     * cancel_sip ingest
+     */
+    ingestCancelSip(requestParameters: IngestCancelSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
     /**
      * Creates request options for ingestConfirmSip without sending the request
      * @param {string} uuid Identifier of SIP to look up
@@ -242,6 +325,54 @@ export interface IngestApiInterface {
      */
     ingestConfirmSip(requestParameters: IngestConfirmSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Creates request options for ingestCreateApiToken without sending the request
+     * @param {CreateAPITokenRequestBody} createAPITokenRequestBody 
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestCreateApiTokenRequestOpts(requestParameters: IngestCreateApiTokenRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Create an API token for the current user
+     * @summary create_api_token ingest
+     * @param {CreateAPITokenRequestBody} createAPITokenRequestBody 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestCreateApiTokenRaw(requestParameters: IngestCreateApiTokenRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<APIToken>>;
+
+    /**
+     * Create an API token for the current user
+     * create_api_token ingest
+     */
+    ingestCreateApiToken(requestParameters: IngestCreateApiTokenRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<APIToken>;
+
+    /**
+     * Creates request options for ingestCreateSipUpload without sending the request
+     * @param {CreateSipUploadRequestBody} createSipUploadRequestBody 
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestCreateSipUploadRequestOpts(requestParameters: IngestCreateSipUploadRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Start a resumable SIP upload
+     * @summary create_sip_upload ingest
+     * @param {CreateSipUploadRequestBody} createSipUploadRequestBody 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestCreateSipUploadRaw(requestParameters: IngestCreateSipUploadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SIPUpload>>;
+
+    /**
+     * Start a resumable SIP upload
+     * create_sip_upload ingest
+     */
+    ingestCreateSipUpload(requestParameters: IngestCreateSipUploadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SIPUpload>;
+
     /**
      * Creates request options for ingestDownloadSip without sending the request
      * @param {string} uuid Identifier of the SIP to download
@@ -292,6 +423,28 @@ export interface IngestApiInterface {
      */
     ingestDownloadSipRequest(requestParameters: IngestDownloadSipRequestRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Creates request options for ingestListApiTokens without sending the request
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestListApiTokensRequestOpts(): Promise<runtime.RequestOpts>;
+
+    /**
+     * List the API tokens of the current user
+     * @summary list_api_tokens ingest
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestListApiTokensRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<APITokens>>;
+
+    /**
+     * List the API tokens of the current user
+     * list_api_tokens ingest
+     */
+    ingestListApiTokens(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<APITokens>;
+
     /**
      * Creates request options for ingestListBatches without sending the request
      * @param {string} [identifier] 
@@ -389,6 +542,7 @@ export interface IngestApiInterface {
      * @param {'error' | 'failed' | 'queued' | 'processing' | 'pending' | 'ingested' | 'validated' | 'canceled'} [status] 
      * @param {string} [uploaderUuid] UUID of the SIP uploader
      * @param {string} [batchUuid] UUID of the related Batch
+     * @param {string} [metadataKey] Filter by SIPs whose custom metadata has the given key
      * @param {number} [limit] Limit number of results to return
      * @param {number} [offset] Offset from the beginning of the found set
      * @throws {RequiredError}
@@ -406,6 +560,7 @@ export interface IngestApiInterface {
      * @param {'error' | 'failed' | 'queued' | 'processing' | 'pending' | 'ingested' | 'validated' | 'canceled'} [status] 
      * @param {string} [uploaderUuid] UUID of the SIP uploader
      * @param {string} [batchUuid] UUID of the related Batch
+     * @param {string} [metadataKey] Filter by SIPs whose custom metadata has the given key
      * @param {number} [limit] Limit number of results to return
      * @param {number} [offset] Offset from the beginning of the found set
      * @param {*} [options] Override http request option.
@@ -496,6 +651,54 @@ export interface IngestApiInterface {
      */
     ingestRejectSip(requestParameters: IngestRejectSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Creates request options for ingestRetryBatch without sending the request
+     * @param {string} uuid Identifier of Batch to retry
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestRetryBatchRequestOpts(requestParameters: IngestRetryBatchRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Retry the processing of the failed SIPs of a Batch
+     * @summary retry_batch ingest
+     * @param {string} uuid Identifier of Batch to retry
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestRetryBatchRaw(requestParameters: IngestRetryBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Retry the processing of the failed SIPs of a Batch
+     * retry_batch ingest
+     */
+    ingestRetryBatch(requestParameters: IngestRetryBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
+    /**
+     * Creates request options for ingestRetrySip without sending the request
+     * @param {string} uuid Identifier of SIP to look up
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestRetrySipRequestOpts(requestParameters: IngestRetrySipRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Retry the processing of a failed SIP from its failed package
+     * @summary retry_sip ingest
+     * @param {string} uuid Identifier of SIP to look up
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestRetrySipRaw(requestParameters: IngestRetrySipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Retry the processing of a failed SIP from its failed package
+     * retry_sip ingest
+     */
+    ingestRetrySip(requestParameters: IngestRetrySipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
     /**
      * Creates request options for ingestReviewBatch without sending the request
      * @param {string} uuid Identifier of Batch to review
@@ -522,6 +725,58 @@ export interface IngestApiInterface {
      */
     ingestReviewBatch(requestParameters: IngestReviewBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Creates request options for ingestRevokeApiToken without sending the request
+     * @param {string} uuid Identifier of the API token
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestRevokeApiTokenRequestOpts(requestParameters: IngestRevokeApiTokenRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Revoke an API token of the current user
+     * @summary revoke_api_token ingest
+     * @param {string} uuid Identifier of the API token
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestRevokeApiTokenRaw(requestParameters: IngestRevokeApiTokenRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Revoke an API token of the current user
+     * revoke_api_token ingest
+     */
+    ingestRevokeApiToken(requestParameters: IngestRevokeApiTokenRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
+    /**
+     * Creates request options for ingestSearch without sending the request
+     * @param {string} query Search terms, all of them must match
+     * @param {number} [limit] Limit number of results to return
+     * @param {number} [offset] Offset from the beginning of the found set
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestSearchRequestOpts(requestParameters: IngestSearchRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Search SIPs by name, batch identifier and custom metadata
+     * @summary search ingest
+     * @param {string} query Search terms, all of them must match
+     * @param {number} [limit] Limit number of results to return
+     * @param {number} [offset] Offset from the beginning of the found set
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestSearchRaw(requestParameters: IngestSearchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SearchResults>>;
+
+    /**
+     * Search SIPs by name, batch identifier and custom metadata
+     * search ingest
+     */
+    ingestSearch(requestParameters: IngestSearchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SearchResults>;
+
     /**
      * Creates request options for ingestShowBatch without sending the request
      * @param {string} uuid Identifier of Batch to show
@@ -594,6 +849,30 @@ export interface IngestApiInterface {
      */
     ingestShowSipDecision(requestParameters: IngestShowSipDecisionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<EnduroIngestSipDecision>;
 
+    /**
+     * Creates request options for ingestShowSipUpload without sending the request
+     * @param {string} uuid Identifier of the upload
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestShowSipUploadRequestOpts(requestParameters: IngestShowSipUploadRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Show the progress of a resumable SIP upload
+     * @summary show_sip_upload ingest
+     * @param {string} uuid Identifier of the upload
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestShowSipUploadRaw(requestParameters: IngestShowSipUploadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SIPUpload>>;
+
+    /**
+     * Show the progress of a resumable SIP upload
+     * show_sip_upload ingest
+     */
+    ingestShowSipUpload(requestParameters: IngestShowSipUploadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SIPUpload>;
+
     /**
      * Creates request options for ingestSubmitSipDecision without sending the request
      * @param {string} uuid Identifier of SIP to look up
@@ -644,6 +923,34 @@ export interface IngestApiInterface {
      */
     ingestUploadSip(requestParameters: IngestUploadSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<AddSipResponseBody>;
 
+    /**
+     * Creates request options for ingestUploadSipChunk without sending the request
+     * @param {string} uuid Identifier of the upload
+     * @param {number} uploadOffset Offset of the chunk in the SIP, must match the upload offset
+     * @param {string} [uploadChecksum] Optional checksum of the chunk, e.g. "sha256:9f86d0..."
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestUploadSipChunkRequestOpts(requestParameters: IngestUploadSipChunkRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Upload the next chunk of a resumable SIP upload
+     * @summary upload_sip_chunk ingest
+     * @param {string} uuid Identifier of the upload
+     * @param {number} uploadOffset Offset of the chunk in the SIP, must match the upload offset
+     * @param {string} [uploadChecksum] Optional checksum of the chunk, e.g. "sha256:9f86d0..."
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof IngestApiInterface
+     */
+    ingestUploadSipChunkRaw(requestParameters: IngestUploadSipChunkRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SIPUpload>>;
+
+    /**
+     * Upload the next chunk of a resumable SIP upload
+     * upload_sip_chunk ingest
+     */
+    ingestUploadSipChunk(requestParameters: IngestUploadSipChunkRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SIPUpload>;
+
 }
 
 /**
@@ -765,6 +1072,60 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
         return await response.value();
     }
 
+    /**
+     * Creates request options for ingestCancelSip without sending the request
+     */
+    async ingestCancelSipRequestOpts(requestParameters: IngestCancelSipRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling ingestCancelSip().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/sips/{uuid}/cancel`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Cancel the processing of a queued or processing SIP
+     * cancel_sip ingest
+     */
+    async ingestCancelSipRaw(requestParameters: IngestCancelSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const requestOptions = await this.ingestCancelSipRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Cancel the processing of a queued or processing SIP
+     * cancel_sip ingest
+     */
+    async ingestCancelSip(requestParameters: IngestCancelSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.ingestCancelSipRaw(requestParameters, initOverrides);
+    }
+
     /**
      * Creates request options for ingestConfirmSip without sending the request
      */
@@ -830,13 +1191,13 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
     }
 
     /**
-     * Creates request options for ingestDownloadSip without sending the request
+     * Creates request options for ingestCreateApiToken without sending the request
      */
-    async ingestDownloadSipRequestOpts(requestParameters: IngestDownloadSipRequest): Promise<runtime.RequestOpts> {
-        if (requestParameters['uuid'] == null) {
+    async ingestCreateApiTokenRequestOpts(requestParameters: IngestCreateApiTokenRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['createAPITokenRequestBody'] == null) {
             throw new runtime.RequiredError(
-                'uuid',
-                'Required parameter "uuid" was null or undefined when calling ingestDownloadSip().'
+                'createAPITokenRequestBody',
+                'Required parameter "createAPITokenRequestBody" was null or undefined when calling ingestCreateApiToken().'
             );
         }
 
@@ -844,46 +1205,56 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
 
-        let urlPath = `/ingest/sips/{uuid}/download`;
-        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/api-tokens`;
 
         return {
             path: urlPath,
-            method: 'GET',
+            method: 'POST',
             headers: headerParameters,
             query: queryParameters,
+            body: CreateAPITokenRequestBodyToJSON(requestParameters['createAPITokenRequestBody']),
         };
     }
 
     /**
-     * Download the failed package related to a SIP. It will be the original SIP or the transformed PIP, based on the SIP\'s `failed_as` value.
-     * download_sip ingest
+     * Create an API token for the current user
+     * create_api_token ingest
      */
-    async ingestDownloadSipRaw(requestParameters: IngestDownloadSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Blob>> {
-        const requestOptions = await this.ingestDownloadSipRequestOpts(requestParameters);
+    async ingestCreateApiTokenRaw(requestParameters: IngestCreateApiTokenRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<APIToken>> {
+        const requestOptions = await this.ingestCreateApiTokenRequestOpts(requestParameters);
         const response = await this.request(requestOptions, initOverrides);
 
-        return new runtime.BlobApiResponse(response);
+        return new runtime.JSONApiResponse(response, (jsonValue) => APITokenFromJSON(jsonValue));
     }
 
     /**
-     * Download the failed package related to a SIP. It will be the original SIP or the transformed PIP, based on the SIP\'s `failed_as` value.
-     * download_sip ingest
+     * Create an API token for the current user
+     * create_api_token ingest
      */
-    async ingestDownloadSip(requestParameters: IngestDownloadSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Blob> {
-        const response = await this.ingestDownloadSipRaw(requestParameters, initOverrides);
+    async ingestCreateApiToken(requestParameters: IngestCreateApiTokenRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<APIToken> {
+        const response = await this.ingestCreateApiTokenRaw(requestParameters, initOverrides);
         return await response.value();
     }
 
     /**
-     * Creates request options for ingestDownloadSipRequest without sending the request
+     * Creates request options for ingestCreateSipUpload without sending the request
      */
-    async ingestDownloadSipRequestRequestOpts(requestParameters: IngestDownloadSipRequestRequest): Promise<runtime.RequestOpts> {
-        if (requestParameters['uuid'] == null) {
+    async ingestCreateSipUploadRequestOpts(requestParameters: IngestCreateSipUploadRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['createSipUploadRequestBody'] == null) {
             throw new runtime.RequiredError(
-                'uuid',
-                'Required parameter "uuid" was null or undefined when calling ingestDownloadSipRequest().'
+                'createSipUploadRequestBody',
+                'Required parameter "createSipUploadRequestBody" was null or undefined when calling ingestCreateSipUpload().'
             );
         }
 
@@ -891,6 +1262,8 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
+
         if (this.configuration && this.configuration.accessToken) {
             const token = this.configuration.accessToken;
             const tokenString = await token("bearer_header_Authorization", []);
@@ -900,22 +1273,124 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
             }
         }
 
-        let urlPath = `/ingest/sips/{uuid}/download`;
-        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+        let urlPath = `/ingest/sips/uploads`;
 
         return {
             path: urlPath,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
+            body: CreateSipUploadRequestBodyToJSON(requestParameters['createSipUploadRequestBody']),
         };
     }
 
     /**
-     * Request access to SIP download
-     * download_sip_request ingest
+     * Start a resumable SIP upload
+     * create_sip_upload ingest
      */
-    async ingestDownloadSipRequestRaw(requestParameters: IngestDownloadSipRequestRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+    async ingestCreateSipUploadRaw(requestParameters: IngestCreateSipUploadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SIPUpload>> {
+        const requestOptions = await this.ingestCreateSipUploadRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SIPUploadFromJSON(jsonValue));
+    }
+
+    /**
+     * Start a resumable SIP upload
+     * create_sip_upload ingest
+     */
+    async ingestCreateSipUpload(requestParameters: IngestCreateSipUploadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SIPUpload> {
+        const response = await this.ingestCreateSipUploadRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Creates request options for ingestDownloadSip without sending the request
+     */
+    async ingestDownloadSipRequestOpts(requestParameters: IngestDownloadSipRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling ingestDownloadSip().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/ingest/sips/{uuid}/download`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Download the failed package related to a SIP. It will be the original SIP or the transformed PIP, based on the SIP\'s `failed_as` value.
+     * download_sip ingest
+     */
+    async ingestDownloadSipRaw(requestParameters: IngestDownloadSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Blob>> {
+        const requestOptions = await this.ingestDownloadSipRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.BlobApiResponse(response);
+    }
+
+    /**
+     * Download the failed package related to a SIP. It will be the original SIP or the transformed PIP, based on the SIP\'s `failed_as` value.
+     * download_sip ingest
+     */
+    async ingestDownloadSip(requestParameters: IngestDownloadSipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Blob> {
+        const response = await this.ingestDownloadSipRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Creates request options for ingestDownloadSipRequest without sending the request
+     */
+    async ingestDownloadSipRequestRequestOpts(requestParameters: IngestDownloadSipRequestRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling ingestDownloadSipRequest().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/sips/{uuid}/download`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Request access to SIP download
+     * download_sip_request ingest
+     */
+    async ingestDownloadSipRequestRaw(requestParameters: IngestDownloadSipRequestRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const requestOptions = await this.ingestDownloadSipRequestRequestOpts(requestParameters);
         const response = await this.request(requestOptions, initOverrides);
 
@@ -930,6 +1405,53 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
         await this.ingestDownloadSipRequestRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Creates request options for ingestListApiTokens without sending the request
+     */
+    async ingestListApiTokensRequestOpts(): Promise<runtime.RequestOpts> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/api-tokens`;
+
+        return {
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * List the API tokens of the current user
+     * list_api_tokens ingest
+     */
+    async ingestListApiTokensRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<APITokens>> {
+        const requestOptions = await this.ingestListApiTokensRequestOpts();
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => APITokensFromJSON(jsonValue));
+    }
+
+    /**
+     * List the API tokens of the current user
+     * list_api_tokens ingest
+     */
+    async ingestListApiTokens(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<APITokens> {
+        const response = await this.ingestListApiTokensRaw(initOverrides);
+        return await response.value();
+    }
+
     /**
      * Creates request options for ingestListBatches without sending the request
      */
@@ -1157,6 +1679,10 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
             queryParameters['batch_uuid'] = requestParameters['batchUuid'];
         }
 
+        if (requestParameters['metadataKey'] != null) {
+            queryParameters['metadata_key'] = requestParameters['metadataKey'];
+        }
+
         if (requestParameters['limit'] != null) {
             queryParameters['limit'] = requestParameters['limit'];
         }
@@ -1370,6 +1896,114 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
         await this.ingestRejectSipRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Creates request options for ingestRetryBatch without sending the request
+     */
+    async ingestRetryBatchRequestOpts(requestParameters: IngestRetryBatchRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling ingestRetryBatch().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/batches/{uuid}/retry`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Retry the processing of the failed SIPs of a Batch
+     * retry_batch ingest
+     */
+    async ingestRetryBatchRaw(requestParameters: IngestRetryBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const requestOptions = await this.ingestRetryBatchRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Retry the processing of the failed SIPs of a Batch
+     * retry_batch ingest
+     */
+    async ingestRetryBatch(requestParameters: IngestRetryBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.ingestRetryBatchRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Creates request options for ingestRetrySip without sending the request
+     */
+    async ingestRetrySipRequestOpts(requestParameters: IngestRetrySipRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling ingestRetrySip().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/sips/{uuid}/retry`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Retry the processing of a failed SIP from its failed package
+     * retry_sip ingest
+     */
+    async ingestRetrySipRaw(requestParameters: IngestRetrySipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const requestOptions = await this.ingestRetrySipRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Retry the processing of a failed SIP from its failed package
+     * retry_sip ingest
+     */
+    async ingestRetrySip(requestParameters: IngestRetrySipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.ingestRetrySipRaw(requestParameters, initOverrides);
+    }
+
     /**
      * Creates request options for ingestReviewBatch without sending the request
      */
@@ -1434,6 +2068,126 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
         await this.ingestReviewBatchRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Creates request options for ingestRevokeApiToken without sending the request
+     */
+    async ingestRevokeApiTokenRequestOpts(requestParameters: IngestRevokeApiTokenRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling ingestRevokeApiToken().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/api-tokens/{uuid}/revoke`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Revoke an API token of the current user
+     * revoke_api_token ingest
+     */
+    async ingestRevokeApiTokenRaw(requestParameters: IngestRevokeApiTokenRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const requestOptions = await this.ingestRevokeApiTokenRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Revoke an API token of the current user
+     * revoke_api_token ingest
+     */
+    async ingestRevokeApiToken(requestParameters: IngestRevokeApiTokenRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.ingestRevokeApiTokenRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Creates request options for ingestSearch without sending the request
+     */
+    async ingestSearchRequestOpts(requestParameters: IngestSearchRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['query'] == null) {
+            throw new runtime.RequiredError(
+                'query',
+                'Required parameter "query" was null or undefined when calling ingestSearch().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['query'] != null) {
+            queryParameters['query'] = requestParameters['query'];
+        }
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        if (requestParameters['offset'] != null) {
+            queryParameters['offset'] = requestParameters['offset'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/search`;
+
+        return {
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Search SIPs by name, batch identifier and custom metadata
+     * search ingest
+     */
+    async ingestSearchRaw(requestParameters: IngestSearchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SearchResults>> {
+        const requestOptions = await this.ingestSearchRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SearchResultsFromJSON(jsonValue));
+    }
+
+    /**
+     * Search SIPs by name, batch identifier and custom metadata
+     * search ingest
+     */
+    async ingestSearch(requestParameters: IngestSearchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SearchResults> {
+        const response = await this.ingestSearchRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Creates request options for ingestShowBatch without sending the request
      */
@@ -1599,6 +2353,61 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
         return await response.value();
     }
 
+    /**
+     * Creates request options for ingestShowSipUpload without sending the request
+     */
+    async ingestShowSipUploadRequestOpts(requestParameters: IngestShowSipUploadRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling ingestShowSipUpload().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/sips/uploads/{uuid}`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Show the progress of a resumable SIP upload
+     * show_sip_upload ingest
+     */
+    async ingestShowSipUploadRaw(requestParameters: IngestShowSipUploadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SIPUpload>> {
+        const requestOptions = await this.ingestShowSipUploadRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SIPUploadFromJSON(jsonValue));
+    }
+
+    /**
+     * Show the progress of a resumable SIP upload
+     * show_sip_upload ingest
+     */
+    async ingestShowSipUpload(requestParameters: IngestShowSipUploadRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SIPUpload> {
+        const response = await this.ingestShowSipUploadRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Creates request options for ingestSubmitSipDecision without sending the request
      */
@@ -1714,6 +2523,76 @@ export class IngestApi extends runtime.BaseAPI implements IngestApiInterface {
         return await response.value();
     }
 
+    /**
+     * Creates request options for ingestUploadSipChunk without sending the request
+     */
+    async ingestUploadSipChunkRequestOpts(requestParameters: IngestUploadSipChunkRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling ingestUploadSipChunk().'
+            );
+        }
+
+        if (requestParameters['uploadOffset'] == null) {
+            throw new runtime.RequiredError(
+                'uploadOffset',
+                'Required parameter "uploadOffset" was null or undefined when calling ingestUploadSipChunk().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (requestParameters['uploadOffset'] != null) {
+            headerParameters['Upload-Offset'] = String(requestParameters['uploadOffset']);
+        }
+
+        if (requestParameters['uploadChecksum'] != null) {
+            headerParameters['Upload-Checksum'] = String(requestParameters['uploadChecksum']);
+        }
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/ingest/sips/uploads/{uuid}`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'PATCH',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Upload the next chunk of a resumable SIP upload
+     * upload_sip_chunk ingest
+     */
+    async ingestUploadSipChunkRaw(requestParameters: IngestUploadSipChunkRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SIPUpload>> {
+        const requestOptions = await this.ingestUploadSipChunkRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SIPUploadFromJSON(jsonValue));
+    }
+
+    /**
+     * Upload the next chunk of a resumable SIP upload
+     * upload_sip_chunk ingest
+     */
+    async ingestUploadSipChunk(requestParameters: IngestUploadSipChunkRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SIPUpload> {
+        const response = await this.ingestUploadSipChunkRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
 }
 
 /**
@@ -29,9 +29,14 @@ import type {
   EnduroStorageLocation,
   LocationNotFound,
   LocationResponse,
+  LocationUsage,
   MoveStatusResult,
+  PlaceAipLegalHoldRequestBody,
+  ReleaseAipLegalHoldRequestBody,
   RequestAipDeletionRequestBody,
+  RestoreAipsRequestBody,
   ReviewAipDeletionRequestBody,
+  SearchResults,
   StorageEvent,
 } from '../models/index';
 import {
@@ -63,12 +68,22 @@ import {
     LocationNotFoundToJSON,
     LocationResponseFromJSON,
     LocationResponseToJSON,
+    LocationUsageFromJSON,
+    LocationUsageToJSON,
     MoveStatusResultFromJSON,
     MoveStatusResultToJSON,
+    PlaceAipLegalHoldRequestBodyFromJSON,
+    PlaceAipLegalHoldRequestBodyToJSON,
+    ReleaseAipLegalHoldRequestBodyFromJSON,
+    ReleaseAipLegalHoldRequestBodyToJSON,
     RequestAipDeletionRequestBodyFromJSON,
     RequestAipDeletionRequestBodyToJSON,
+    RestoreAipsRequestBodyFromJSON,
+    RestoreAipsRequestBodyToJSON,
     ReviewAipDeletionRequestBodyFromJSON,
     ReviewAipDeletionRequestBodyToJSON,
+    SearchResultsFromJSON,
+    SearchResultsToJSON,
     StorageEventFromJSON,
     StorageEventToJSON,
 } from '../models/index';
@@ -120,6 +135,7 @@ export interface StorageListAipsRequest {
     earliestCreatedTime?: Date;
     latestCreatedTime?: Date;
     status?: StorageListAipsStatusEnum;
+    metadataKey?: string;
     limit?: number;
     offset?: number;
 }
@@ -128,6 +144,10 @@ export interface StorageListLocationAipsRequest {
     uuid: string;
 }
 
+export interface StorageLocationUsageRequest {
+    uuid: string;
+}
+
 export interface StorageMoveAipRequest {
     uuid: string;
     confirmSipRequestBody: ConfirmSipRequestBody;
@@ -137,20 +157,40 @@ export interface StorageMoveAipStatusRequest {
     uuid: string;
 }
 
+export interface StoragePlaceAipLegalHoldRequest {
+    uuid: string;
+    placeAipLegalHoldRequestBody: PlaceAipLegalHoldRequestBody;
+}
+
 export interface StorageRejectAipRequest {
     uuid: string;
 }
 
+export interface StorageReleaseAipLegalHoldRequest {
+    uuid: string;
+    releaseAipLegalHoldRequestBody: ReleaseAipLegalHoldRequestBody;
+}
+
 export interface StorageRequestAipDeletionRequest {
     uuid: string;
     requestAipDeletionRequestBody: RequestAipDeletionRequestBody;
 }
 
+export interface StorageRestoreAipsRequest {
+    restoreAipsRequestBody: RestoreAipsRequestBody;
+}
+
 export interface StorageReviewAipDeletionRequest {
     uuid: string;
     reviewAipDeletionRequestBody: ReviewAipDeletionRequestBody;
 }
 
+export interface StorageSearchRequest {
+    query: string;
+    limit?: number;
+    offset?: number;
+}
+
 export interface StorageShowAipRequest {
     uuid: string;
 }
@@ -370,7 +410,7 @@ export interface StorageApiInterface {
      * Creates request options for storageListAipWorkflows without sending the request
      * @param {string} uuid Identifier of AIP
      * @param {'unspecified' | 'in progress' | 'done' | 'error' | 'queued' | 'pending' | 'canceled'} [status] 
-     * @param {'unspecified' | 'upload aip' | 'move aip' | 'delete aip'} [type] 
+     * @param {'unspecified' | 'upload aip' | 'move aip' | 'delete aip' | 'audit aip' | 'replicate aip' | 'restore aip'} [type] 
      * @throws {RequiredError}
      * @memberof StorageApiInterface
      */
@@ -381,7 +421,7 @@ export interface StorageApiInterface {
      * @summary list_aip_workflows storage
      * @param {string} uuid Identifier of AIP
      * @param {'unspecified' | 'in progress' | 'done' | 'error' | 'queued' | 'pending' | 'canceled'} [status] 
-     * @param {'unspecified' | 'upload aip' | 'move aip' | 'delete aip'} [type] 
+     * @param {'unspecified' | 'upload aip' | 'move aip' | 'delete aip' | 'audit aip' | 'replicate aip' | 'restore aip'} [type] 
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      * @memberof StorageApiInterface
@@ -400,6 +440,7 @@ export interface StorageApiInterface {
      * @param {Date} [earliestCreatedTime] 
      * @param {Date} [latestCreatedTime] 
      * @param {'unspecified' | 'stored' | 'pending' | 'processing' | 'deleted' | 'queued'} [status] 
+     * @param {string} [metadataKey] Filter by AIPs whose custom metadata has the given key
      * @param {number} [limit] Limit number of results to return
      * @param {number} [offset] Offset from the beginning of the found set
      * @throws {RequiredError}
@@ -414,6 +455,7 @@ export interface StorageApiInterface {
      * @param {Date} [earliestCreatedTime] 
      * @param {Date} [latestCreatedTime] 
      * @param {'unspecified' | 'stored' | 'pending' | 'processing' | 'deleted' | 'queued'} [status] 
+     * @param {string} [metadataKey] Filter by AIPs whose custom metadata has the given key
      * @param {number} [limit] Limit number of results to return
      * @param {number} [offset] Offset from the beginning of the found set
      * @param {*} [options] Override http request option.
@@ -474,6 +516,30 @@ export interface StorageApiInterface {
      */
     storageListLocations(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<LocationResponse>>;
 
+    /**
+     * Creates request options for storageLocationUsage without sending the request
+     * @param {string} uuid Identifier of location
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storageLocationUsageRequestOpts(requestParameters: StorageLocationUsageRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Show the storage usage of the location with UUID
+     * @summary location_usage storage
+     * @param {string} uuid Identifier of location
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storageLocationUsageRaw(requestParameters: StorageLocationUsageRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<LocationUsage>>;
+
+    /**
+     * Show the storage usage of the location with UUID
+     * location_usage storage
+     */
+    storageLocationUsage(requestParameters: StorageLocationUsageRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<LocationUsage>;
+
     /**
      * Creates request options for storageMonitor without sending the request
      * @throws {RequiredError}
@@ -546,6 +612,32 @@ export interface StorageApiInterface {
      */
     storageMoveAipStatus(requestParameters: StorageMoveAipStatusRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<MoveStatusResult>;
 
+    /**
+     * Creates request options for storagePlaceAipLegalHold without sending the request
+     * @param {string} uuid Identifier of AIP
+     * @param {PlaceAipLegalHoldRequestBody} placeAipLegalHoldRequestBody 
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storagePlaceAipLegalHoldRequestOpts(requestParameters: StoragePlaceAipLegalHoldRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Place a legal hold on an AIP to prevent its deletion and moves
+     * @summary place_aip_legal_hold storage
+     * @param {string} uuid Identifier of AIP
+     * @param {PlaceAipLegalHoldRequestBody} placeAipLegalHoldRequestBody 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storagePlaceAipLegalHoldRaw(requestParameters: StoragePlaceAipLegalHoldRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Place a legal hold on an AIP to prevent its deletion and moves
+     * place_aip_legal_hold storage
+     */
+    storagePlaceAipLegalHold(requestParameters: StoragePlaceAipLegalHoldRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
     /**
      * Creates request options for storageRejectAip without sending the request
      * @param {string} uuid Identifier of AIP
@@ -570,6 +662,32 @@ export interface StorageApiInterface {
      */
     storageRejectAip(requestParameters: StorageRejectAipRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Creates request options for storageReleaseAipLegalHold without sending the request
+     * @param {string} uuid Identifier of AIP
+     * @param {ReleaseAipLegalHoldRequestBody} releaseAipLegalHoldRequestBody 
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storageReleaseAipLegalHoldRequestOpts(requestParameters: StorageReleaseAipLegalHoldRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Release the active legal hold of an AIP
+     * @summary release_aip_legal_hold storage
+     * @param {string} uuid Identifier of AIP
+     * @param {ReleaseAipLegalHoldRequestBody} releaseAipLegalHoldRequestBody 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storageReleaseAipLegalHoldRaw(requestParameters: StorageReleaseAipLegalHoldRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Release the active legal hold of an AIP
+     * release_aip_legal_hold storage
+     */
+    storageReleaseAipLegalHold(requestParameters: StorageReleaseAipLegalHoldRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
     /**
      * Creates request options for storageRequestAipDeletion without sending the request
      * @param {string} uuid Identifier of AIP
@@ -596,6 +714,30 @@ export interface StorageApiInterface {
      */
     storageRequestAipDeletion(requestParameters: StorageRequestAipDeletionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Creates request options for storageRestoreAips without sending the request
+     * @param {RestoreAipsRequestBody} restoreAipsRequestBody 
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storageRestoreAipsRequestOpts(requestParameters: StorageRestoreAipsRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Restore AIPs to a restore location
+     * @summary restore_aips storage
+     * @param {RestoreAipsRequestBody} restoreAipsRequestBody 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storageRestoreAipsRaw(requestParameters: StorageRestoreAipsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Restore AIPs to a restore location
+     * restore_aips storage
+     */
+    storageRestoreAips(requestParameters: StorageRestoreAipsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
     /**
      * Creates request options for storageReviewAipDeletion without sending the request
      * @param {string} uuid Identifier of AIP
@@ -622,6 +764,34 @@ export interface StorageApiInterface {
      */
     storageReviewAipDeletion(requestParameters: StorageReviewAipDeletionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Creates request options for storageSearch without sending the request
+     * @param {string} query Search terms, all of them must match
+     * @param {number} [limit] Limit number of results to return
+     * @param {number} [offset] Offset from the beginning of the found set
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storageSearchRequestOpts(requestParameters: StorageSearchRequest): Promise<runtime.RequestOpts>;
+
+    /**
+     * Search AIPs by name and descriptive metadata
+     * @summary search storage
+     * @param {string} query Search terms, all of them must match
+     * @param {number} [limit] Limit number of results to return
+     * @param {number} [offset] Offset from the beginning of the found set
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof StorageApiInterface
+     */
+    storageSearchRaw(requestParameters: StorageSearchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SearchResults>>;
+
+    /**
+     * Search AIPs by name and descriptive metadata
+     * search storage
+     */
+    storageSearch(requestParameters: StorageSearchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SearchResults>;
+
     /**
      * Creates request options for storageShowAip without sending the request
      * @param {string} uuid Identifier of AIP
@@ -1206,6 +1376,10 @@ export class StorageApi extends runtime.BaseAPI implements StorageApiInterface {
             queryParameters['status'] = requestParameters['status'];
         }
 
+        if (requestParameters['metadataKey'] != null) {
+            queryParameters['metadata_key'] = requestParameters['metadataKey'];
+        }
+
         if (requestParameters['limit'] != null) {
             queryParameters['limit'] = requestParameters['limit'];
         }
@@ -1357,6 +1531,61 @@ export class StorageApi extends runtime.BaseAPI implements StorageApiInterface {
         return await response.value();
     }
 
+    /**
+     * Creates request options for storageLocationUsage without sending the request
+     */
+    async storageLocationUsageRequestOpts(requestParameters: StorageLocationUsageRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling storageLocationUsage().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/storage/locations/{uuid}/usage`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Show the storage usage of the location with UUID
+     * location_usage storage
+     */
+    async storageLocationUsageRaw(requestParameters: StorageLocationUsageRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<LocationUsage>> {
+        const requestOptions = await this.storageLocationUsageRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => LocationUsageFromJSON(jsonValue));
+    }
+
+    /**
+     * Show the storage usage of the location with UUID
+     * location_usage storage
+     */
+    async storageLocationUsage(requestParameters: StorageLocationUsageRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<LocationUsage> {
+        const response = await this.storageLocationUsageRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Creates request options for storageMonitor without sending the request
      */
@@ -1523,6 +1752,70 @@ export class StorageApi extends runtime.BaseAPI implements StorageApiInterface {
         return await response.value();
     }
 
+    /**
+     * Creates request options for storagePlaceAipLegalHold without sending the request
+     */
+    async storagePlaceAipLegalHoldRequestOpts(requestParameters: StoragePlaceAipLegalHoldRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling storagePlaceAipLegalHold().'
+            );
+        }
+
+        if (requestParameters['placeAipLegalHoldRequestBody'] == null) {
+            throw new runtime.RequiredError(
+                'placeAipLegalHoldRequestBody',
+                'Required parameter "placeAipLegalHoldRequestBody" was null or undefined when calling storagePlaceAipLegalHold().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/storage/aips/{uuid}/legal-hold`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: PlaceAipLegalHoldRequestBodyToJSON(requestParameters['placeAipLegalHoldRequestBody']),
+        };
+    }
+
+    /**
+     * Place a legal hold on an AIP to prevent its deletion and moves
+     * place_aip_legal_hold storage
+     */
+    async storagePlaceAipLegalHoldRaw(requestParameters: StoragePlaceAipLegalHoldRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const requestOptions = await this.storagePlaceAipLegalHoldRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Place a legal hold on an AIP to prevent its deletion and moves
+     * place_aip_legal_hold storage
+     */
+    async storagePlaceAipLegalHold(requestParameters: StoragePlaceAipLegalHoldRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.storagePlaceAipLegalHoldRaw(requestParameters, initOverrides);
+    }
+
     /**
      * Creates request options for storageRejectAip without sending the request
      */
@@ -1577,6 +1870,70 @@ export class StorageApi extends runtime.BaseAPI implements StorageApiInterface {
         await this.storageRejectAipRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Creates request options for storageReleaseAipLegalHold without sending the request
+     */
+    async storageReleaseAipLegalHoldRequestOpts(requestParameters: StorageReleaseAipLegalHoldRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['uuid'] == null) {
+            throw new runtime.RequiredError(
+                'uuid',
+                'Required parameter "uuid" was null or undefined when calling storageReleaseAipLegalHold().'
+            );
+        }
+
+        if (requestParameters['releaseAipLegalHoldRequestBody'] == null) {
+            throw new runtime.RequiredError(
+                'releaseAipLegalHoldRequestBody',
+                'Required parameter "releaseAipLegalHoldRequestBody" was null or undefined when calling storageReleaseAipLegalHold().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/storage/aips/{uuid}/legal-hold-release`;
+        urlPath = urlPath.replace(`{${"uuid"}}`, encodeURIComponent(String(requestParameters['uuid'])));
+
+        return {
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ReleaseAipLegalHoldRequestBodyToJSON(requestParameters['releaseAipLegalHoldRequestBody']),
+        };
+    }
+
+    /**
+     * Release the active legal hold of an AIP
+     * release_aip_legal_hold storage
+     */
+    async storageReleaseAipLegalHoldRaw(requestParameters: StorageReleaseAipLegalHoldRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const requestOptions = await this.storageReleaseAipLegalHoldRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Release the active legal hold of an AIP
+     * release_aip_legal_hold storage
+     */
+    async storageReleaseAipLegalHold(requestParameters: StorageReleaseAipLegalHoldRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.storageReleaseAipLegalHoldRaw(requestParameters, initOverrides);
+    }
+
     /**
      * Creates request options for storageRequestAipDeletion without sending the request
      */
@@ -1641,6 +1998,62 @@ export class StorageApi extends runtime.BaseAPI implements StorageApiInterface {
         await this.storageRequestAipDeletionRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Creates request options for storageRestoreAips without sending the request
+     */
+    async storageRestoreAipsRequestOpts(requestParameters: StorageRestoreAipsRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['restoreAipsRequestBody'] == null) {
+            throw new runtime.RequiredError(
+                'restoreAipsRequestBody',
+                'Required parameter "restoreAipsRequestBody" was null or undefined when calling storageRestoreAips().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/storage/aips/restore`;
+
+        return {
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: RestoreAipsRequestBodyToJSON(requestParameters['restoreAipsRequestBody']),
+        };
+    }
+
+    /**
+     * Restore AIPs to a restore location
+     * restore_aips storage
+     */
+    async storageRestoreAipsRaw(requestParameters: StorageRestoreAipsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const requestOptions = await this.storageRestoreAipsRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Restore AIPs to a restore location
+     * restore_aips storage
+     */
+    async storageRestoreAips(requestParameters: StorageRestoreAipsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.storageRestoreAipsRaw(requestParameters, initOverrides);
+    }
+
     /**
      * Creates request options for storageReviewAipDeletion without sending the request
      */
@@ -1705,6 +2118,72 @@ export class StorageApi extends runtime.BaseAPI implements StorageApiInterface {
         await this.storageReviewAipDeletionRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Creates request options for storageSearch without sending the request
+     */
+    async storageSearchRequestOpts(requestParameters: StorageSearchRequest): Promise<runtime.RequestOpts> {
+        if (requestParameters['query'] == null) {
+            throw new runtime.RequiredError(
+                'query',
+                'Required parameter "query" was null or undefined when calling storageSearch().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['query'] != null) {
+            queryParameters['query'] = requestParameters['query'];
+        }
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        if (requestParameters['offset'] != null) {
+            queryParameters['offset'] = requestParameters['offset'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearer_header_Authorization", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+
+        let urlPath = `/storage/search`;
+
+        return {
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        };
+    }
+
+    /**
+     * Search AIPs by name and descriptive metadata
+     * search storage
+     */
+    async storageSearchRaw(requestParameters: StorageSearchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SearchResults>> {
+        const requestOptions = await this.storageSearchRequestOpts(requestParameters);
+        const response = await this.request(requestOptions, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SearchResultsFromJSON(jsonValue));
+    }
+
+    /**
+     * Search AIPs by name and descriptive metadata
+     * search storage
+     */
+    async storageSearch(requestParameters: StorageSearchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SearchResults> {
+        const response = await this.storageSearchRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Creates request options for storageShowAip without sending the request
      */
@@ -1837,7 +2316,10 @@ export const StorageListAipWorkflowsTypeEnum = {
     Unspecified: 'unspecified',
     UploadAip: 'upload aip',
     MoveAip: 'move aip',
-    DeleteAip: 'delete aip'
+    DeleteAip: 'delete aip',
+    AuditAip: 'audit aip',
+    ReplicateAip: 'replicate aip',
+    RestoreAip: 'restore aip'
 } as const;
 export type StorageListAipWorkflowsTypeEnum = typeof StorageListAipWorkflowsTypeEnum[keyof typeof StorageListAipWorkflowsTypeEnum];
 /**
@@ -0,0 +1,123 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface AIPDeletionRequestCreatedEvent
+ */
+export interface AIPDeletionRequestCreatedEvent {
+    /**
+     * Identifier of AIP
+     * @type {string}
+     * @memberof AIPDeletionRequestCreatedEvent
+     */
+    aipUuid: string;
+    /**
+     * Reason for the deletion
+     * @type {string}
+     * @memberof AIPDeletionRequestCreatedEvent
+     */
+    reason: string;
+    /**
+     * User who requested the deletion
+     * @type {string}
+     * @memberof AIPDeletionRequestCreatedEvent
+     */
+    requester: string;
+    /**
+     * User who reviewed the deletion request
+     * @type {string}
+     * @memberof AIPDeletionRequestCreatedEvent
+     */
+    reviewer?: string;
+    /**
+     * Status of the deletion request
+     * @type {AIPDeletionRequestCreatedEventStatusEnum}
+     * @memberof AIPDeletionRequestCreatedEvent
+     */
+    status: AIPDeletionRequestCreatedEventStatusEnum;
+    /**
+     * Identifier of deletion request
+     * @type {string}
+     * @memberof AIPDeletionRequestCreatedEvent
+     */
+    uuid: string;
+}
+
+
+/**
+ * @export
+ */
+export const AIPDeletionRequestCreatedEventStatusEnum = {
+    Pending: 'pending',
+    Approved: 'approved',
+    Rejected: 'rejected',
+    Canceled: 'canceled'
+} as const;
+export type AIPDeletionRequestCreatedEventStatusEnum = typeof AIPDeletionRequestCreatedEventStatusEnum[keyof typeof AIPDeletionRequestCreatedEventStatusEnum];
+
+
+/**
+ * Check if a given object implements the AIPDeletionRequestCreatedEvent interface.
+ */
+export function instanceOfAIPDeletionRequestCreatedEvent(value: object): value is AIPDeletionRequestCreatedEvent {
+    if (!('aipUuid' in value) || value['aipUuid'] === undefined) return false;
+    if (!('reason' in value) || value['reason'] === undefined) return false;
+    if (!('requester' in value) || value['requester'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    if (!('uuid' in value) || value['uuid'] === undefined) return false;
+    return true;
+}
+
+export function AIPDeletionRequestCreatedEventFromJSON(json: any): AIPDeletionRequestCreatedEvent {
+    return AIPDeletionRequestCreatedEventFromJSONTyped(json, false);
+}
+
+export function AIPDeletionRequestCreatedEventFromJSONTyped(json: any, ignoreDiscriminator: boolean): AIPDeletionRequestCreatedEvent {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'aipUuid': json['aip_uuid'],
+        'reason': json['reason'],
+        'requester': json['requester'],
+        'reviewer': json['reviewer'] == null ? undefined : json['reviewer'],
+        'status': json['status'],
+        'uuid': json['uuid'],
+    };
+}
+
+export function AIPDeletionRequestCreatedEventToJSON(json: any): AIPDeletionRequestCreatedEvent {
+    return AIPDeletionRequestCreatedEventToJSONTyped(json, false);
+}
+
+export function AIPDeletionRequestCreatedEventToJSONTyped(value?: AIPDeletionRequestCreatedEvent | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'aip_uuid': value['aipUuid'],
+        'reason': value['reason'],
+        'requester': value['requester'],
+        'reviewer': value['reviewer'],
+        'status': value['status'],
+        'uuid': value['uuid'],
+    };
+}
+
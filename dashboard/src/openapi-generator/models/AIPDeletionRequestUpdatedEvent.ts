@@ -0,0 +1,123 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface AIPDeletionRequestUpdatedEvent
+ */
+export interface AIPDeletionRequestUpdatedEvent {
+    /**
+     * Identifier of AIP
+     * @type {string}
+     * @memberof AIPDeletionRequestUpdatedEvent
+     */
+    aipUuid: string;
+    /**
+     * Reason for the deletion
+     * @type {string}
+     * @memberof AIPDeletionRequestUpdatedEvent
+     */
+    reason: string;
+    /**
+     * User who requested the deletion
+     * @type {string}
+     * @memberof AIPDeletionRequestUpdatedEvent
+     */
+    requester: string;
+    /**
+     * User who reviewed the deletion request
+     * @type {string}
+     * @memberof AIPDeletionRequestUpdatedEvent
+     */
+    reviewer?: string;
+    /**
+     * Status of the deletion request
+     * @type {AIPDeletionRequestUpdatedEventStatusEnum}
+     * @memberof AIPDeletionRequestUpdatedEvent
+     */
+    status: AIPDeletionRequestUpdatedEventStatusEnum;
+    /**
+     * Identifier of deletion request
+     * @type {string}
+     * @memberof AIPDeletionRequestUpdatedEvent
+     */
+    uuid: string;
+}
+
+
+/**
+ * @export
+ */
+export const AIPDeletionRequestUpdatedEventStatusEnum = {
+    Pending: 'pending',
+    Approved: 'approved',
+    Rejected: 'rejected',
+    Canceled: 'canceled'
+} as const;
+export type AIPDeletionRequestUpdatedEventStatusEnum = typeof AIPDeletionRequestUpdatedEventStatusEnum[keyof typeof AIPDeletionRequestUpdatedEventStatusEnum];
+
+
+/**
+ * Check if a given object implements the AIPDeletionRequestUpdatedEvent interface.
+ */
+export function instanceOfAIPDeletionRequestUpdatedEvent(value: object): value is AIPDeletionRequestUpdatedEvent {
+    if (!('aipUuid' in value) || value['aipUuid'] === undefined) return false;
+    if (!('reason' in value) || value['reason'] === undefined) return false;
+    if (!('requester' in value) || value['requester'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    if (!('uuid' in value) || value['uuid'] === undefined) return false;
+    return true;
+}
+
+export function AIPDeletionRequestUpdatedEventFromJSON(json: any): AIPDeletionRequestUpdatedEvent {
+    return AIPDeletionRequestUpdatedEventFromJSONTyped(json, false);
+}
+
+export function AIPDeletionRequestUpdatedEventFromJSONTyped(json: any, ignoreDiscriminator: boolean): AIPDeletionRequestUpdatedEvent {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'aipUuid': json['aip_uuid'],
+        'reason': json['reason'],
+        'requester': json['requester'],
+        'reviewer': json['reviewer'] == null ? undefined : json['reviewer'],
+        'status': json['status'],
+        'uuid': json['uuid'],
+    };
+}
+
+export function AIPDeletionRequestUpdatedEventToJSON(json: any): AIPDeletionRequestUpdatedEvent {
+    return AIPDeletionRequestUpdatedEventToJSONTyped(json, false);
+}
+
+export function AIPDeletionRequestUpdatedEventToJSONTyped(value?: AIPDeletionRequestUpdatedEvent | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'aip_uuid': value['aipUuid'],
+        'reason': value['reason'],
+        'requester': value['requester'],
+        'reviewer': value['reviewer'],
+        'status': value['status'],
+        'uuid': value['uuid'],
+    };
+}
+
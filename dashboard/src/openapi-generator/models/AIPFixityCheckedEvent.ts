@@ -0,0 +1,132 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface AIPFixityCheckedEvent
+ */
+export interface AIPFixityCheckedEvent {
+    /**
+     * Checksum computed from the stored AIP
+     * @type {string}
+     * @memberof AIPFixityCheckedEvent
+     */
+    actualChecksum: string;
+    /**
+     * Identifier of AIP
+     * @type {string}
+     * @memberof AIPFixityCheckedEvent
+     */
+    aipUuid: string;
+    /**
+     * Time the fixity check was completed
+     * @type {Date}
+     * @memberof AIPFixityCheckedEvent
+     */
+    checkedAt: Date;
+    /**
+     * Algorithm of the checksums
+     * @type {string}
+     * @memberof AIPFixityCheckedEvent
+     */
+    checksumAlgorithm: string;
+    /**
+     * Checksum of the AIP stored in Enduro
+     * @type {string}
+     * @memberof AIPFixityCheckedEvent
+     */
+    expectedChecksum: string;
+    /**
+     * Result of the fixity check
+     * @type {AIPFixityCheckedEventStatusEnum}
+     * @memberof AIPFixityCheckedEvent
+     */
+    status: AIPFixityCheckedEventStatusEnum;
+    /**
+     * Identifier of fixity check
+     * @type {string}
+     * @memberof AIPFixityCheckedEvent
+     */
+    uuid: string;
+}
+
+
+/**
+ * @export
+ */
+export const AIPFixityCheckedEventStatusEnum = {
+    Passed: 'passed',
+    Failed: 'failed',
+    Baseline: 'baseline'
+} as const;
+export type AIPFixityCheckedEventStatusEnum = typeof AIPFixityCheckedEventStatusEnum[keyof typeof AIPFixityCheckedEventStatusEnum];
+
+
+/**
+ * Check if a given object implements the AIPFixityCheckedEvent interface.
+ */
+export function instanceOfAIPFixityCheckedEvent(value: object): value is AIPFixityCheckedEvent {
+    if (!('actualChecksum' in value) || value['actualChecksum'] === undefined) return false;
+    if (!('aipUuid' in value) || value['aipUuid'] === undefined) return false;
+    if (!('checkedAt' in value) || value['checkedAt'] === undefined) return false;
+    if (!('checksumAlgorithm' in value) || value['checksumAlgorithm'] === undefined) return false;
+    if (!('expectedChecksum' in value) || value['expectedChecksum'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    if (!('uuid' in value) || value['uuid'] === undefined) return false;
+    return true;
+}
+
+export function AIPFixityCheckedEventFromJSON(json: any): AIPFixityCheckedEvent {
+    return AIPFixityCheckedEventFromJSONTyped(json, false);
+}
+
+export function AIPFixityCheckedEventFromJSONTyped(json: any, ignoreDiscriminator: boolean): AIPFixityCheckedEvent {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'actualChecksum': json['actual_checksum'],
+        'aipUuid': json['aip_uuid'],
+        'checkedAt': (new Date(json['checked_at'])),
+        'checksumAlgorithm': json['checksum_algorithm'],
+        'expectedChecksum': json['expected_checksum'],
+        'status': json['status'],
+        'uuid': json['uuid'],
+    };
+}
+
+export function AIPFixityCheckedEventToJSON(json: any): AIPFixityCheckedEvent {
+    return AIPFixityCheckedEventToJSONTyped(json, false);
+}
+
+export function AIPFixityCheckedEventToJSONTyped(value?: AIPFixityCheckedEvent | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'actual_checksum': value['actualChecksum'],
+        'aip_uuid': value['aipUuid'],
+        'checked_at': value['checkedAt'].toISOString(),
+        'checksum_algorithm': value['checksumAlgorithm'],
+        'expected_checksum': value['expectedChecksum'],
+        'status': value['status'],
+        'uuid': value['uuid'],
+    };
+}}
+
@@ -13,6 +13,20 @@
  */
 
 import { mapValues } from '../runtime';
+import type { EnduroStorageAipLegalHold } from './EnduroStorageAipLegalHold';
+import {
+    EnduroStorageAipLegalHoldFromJSON,
+    EnduroStorageAipLegalHoldFromJSONTyped,
+    EnduroStorageAipLegalHoldToJSON,
+    EnduroStorageAipLegalHoldToJSONTyped,
+} from './EnduroStorageAipLegalHold';
+import type { EnduroStorageAipReplica } from './EnduroStorageAipReplica';
+import {
+    EnduroStorageAipReplicaFromJSON,
+    EnduroStorageAipReplicaFromJSONTyped,
+    EnduroStorageAipReplicaToJSON,
+    EnduroStorageAipReplicaToJSONTyped,
+} from './EnduroStorageAipReplica';
 /**
  * An AIP describes an AIP retrieved by the storage service. (default view)
  * @export
@@ -25,12 +39,36 @@ export interface AIPResponse {
      * @memberof AIPResponse
      */
     createdAt: Date;
+    /**
+     * Custom metadata copied from the SIP
+     * @type {{ [key: string]: any; }}
+     * @memberof AIPResponse
+     */
+    customMetadata?: { [key: string]: any; };
     /**
      * Deletion report key
      * @type {string}
      * @memberof AIPResponse
      */
     deletionReportKey?: string;
+    /**
+     * Disposal date of the AIP, set by its retention policy
+     * @type {Date}
+     * @memberof AIPResponse
+     */
+    disposalAt?: Date;
+    /**
+     * Number of files in the AIP
+     * @type {number}
+     * @memberof AIPResponse
+     */
+    fileCount?: number;
+    /**
+     * 
+     * @type {Array<EnduroStorageAipLegalHold>}
+     * @memberof AIPResponse
+     */
+    legalHolds?: Array<EnduroStorageAipLegalHold>;
     /**
      * Identifier of storage location
      * @type {string}
@@ -49,6 +87,18 @@ export interface AIPResponse {
      * @memberof AIPResponse
      */
     objectKey: string;
+    /**
+     * 
+     * @type {Array<EnduroStorageAipReplica>}
+     * @memberof AIPResponse
+     */
+    replicas?: Array<EnduroStorageAipReplica>;
+    /**
+     * Size of the AIP in bytes
+     * @type {number}
+     * @memberof AIPResponse
+     */
+    size?: number;
     /**
      * Status of the AIP
      * @type {AIPResponseStatusEnum}
@@ -101,10 +151,16 @@ export function AIPResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean
     return {
         
         'createdAt': (new Date(json['created_at'])),
+        'customMetadata': json['custom_metadata'] == null ? undefined : json['custom_metadata'],
         'deletionReportKey': json['deletion_report_key'] == null ? undefined : json['deletion_report_key'],
+        'disposalAt': json['disposal_at'] == null ? undefined : (new Date(json['disposal_at'])),
+        'fileCount': json['file_count'] == null ? undefined : json['file_count'],
+        'legalHolds': json['legal_holds'] == null ? undefined : ((json['legal_holds'] as Array<any>).map(EnduroStorageAipLegalHoldFromJSON)),
         'locationUuid': json['location_uuid'] == null ? undefined : json['location_uuid'],
         'name': json['name'],
         'objectKey': json['object_key'],
+        'replicas': json['replicas'] == null ? undefined : ((json['replicas'] as Array<any>).map(EnduroStorageAipReplicaFromJSON)),
+        'size': json['size'] == null ? undefined : json['size'],
         'status': json['status'],
         'uuid': json['uuid'],
     };
@@ -122,10 +178,16 @@ export function AIPResponseToJSONTyped(value?: AIPResponse | null, ignoreDiscrim
     return {
         
         'created_at': value['createdAt'].toISOString(),
+        'custom_metadata': value['customMetadata'],
         'deletion_report_key': value['deletionReportKey'],
+        'disposal_at': value['disposalAt'] == null ? value['disposalAt'] : value['disposalAt'].toISOString(),
+        'file_count': value['fileCount'],
+        'legal_holds': value['legalHolds'] == null ? undefined : ((value['legalHolds'] as Array<any>).map(EnduroStorageAipLegalHoldToJSON)),
         'location_uuid': value['locationUuid'],
         'name': value['name'],
         'object_key': value['objectKey'],
+        'replicas': value['replicas'] == null ? undefined : ((value['replicas'] as Array<any>).map(EnduroStorageAipReplicaToJSON)),
+        'size': value['size'],
         'status': value['status'],
         'uuid': value['uuid'],
     };
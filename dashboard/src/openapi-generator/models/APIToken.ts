@@ -0,0 +1,124 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * APIToken describes an API token.
+ * @export
+ * @interface APIToken
+ */
+export interface APIToken {
+    /**
+     * Access control attributes granted to the API token
+     * @type {Array<string>}
+     * @memberof APIToken
+     */
+    attributes?: Array<string>;
+    /**
+     * Creation datetime
+     * @type {Date}
+     * @memberof APIToken
+     */
+    createdAt: Date;
+    /**
+     * Expiration datetime
+     * @type {Date}
+     * @memberof APIToken
+     */
+    expiresAt?: Date;
+    /**
+     * Datetime of the last use of the API token
+     * @type {Date}
+     * @memberof APIToken
+     */
+    lastUsedAt?: Date;
+    /**
+     * Name of the API token
+     * @type {string}
+     * @memberof APIToken
+     */
+    name: string;
+    /**
+     * Revocation datetime
+     * @type {Date}
+     * @memberof APIToken
+     */
+    revokedAt?: Date;
+    /**
+     * Secret of the API token, only returned when the API token is created
+     * @type {string}
+     * @memberof APIToken
+     */
+    token?: string;
+    /**
+     * Identifier of the API token
+     * @type {string}
+     * @memberof APIToken
+     */
+    uuid: string;
+}
+
+/**
+ * Check if a given object implements the APIToken interface.
+ */
+export function instanceOfAPIToken(value: object): value is APIToken {
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('uuid' in value) || value['uuid'] === undefined) return false;
+    return true;
+}
+
+export function APITokenFromJSON(json: any): APIToken {
+    return APITokenFromJSONTyped(json, false);
+}
+
+export function APITokenFromJSONTyped(json: any, ignoreDiscriminator: boolean): APIToken {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'attributes': json['attributes'] == null ? undefined : json['attributes'],
+        'createdAt': (new Date(json['created_at'])),
+        'expiresAt': json['expires_at'] == null ? undefined : (new Date(json['expires_at'])),
+        'lastUsedAt': json['last_used_at'] == null ? undefined : (new Date(json['last_used_at'])),
+        'name': json['name'],
+        'revokedAt': json['revoked_at'] == null ? undefined : (new Date(json['revoked_at'])),
+        'token': json['token'] == null ? undefined : json['token'],
+        'uuid': json['uuid'],
+    };
+}
+
+export function APITokenToJSON(json: any): APIToken {
+    return APITokenToJSONTyped(json, false);
+}
+
+export function APITokenToJSONTyped(value?: APIToken | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'attributes': value['attributes'],
+        'created_at': value['createdAt'].toISOString(),
+        'expires_at': value['expiresAt'] == null ? value['expiresAt'] : value['expiresAt'].toISOString(),
+        'last_used_at': value['lastUsedAt'] == null ? value['lastUsedAt'] : value['lastUsedAt'].toISOString(),
+        'name': value['name'],
+        'revoked_at': value['revokedAt'] == null ? value['revokedAt'] : value['revokedAt'].toISOString(),
+        'token': value['token'],
+        'uuid': value['uuid'],
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { APIToken } from './APIToken';
+import {
+    APITokenFromJSON,
+    APITokenFromJSONTyped,
+    APITokenToJSON,
+    APITokenToJSONTyped,
+} from './APIToken';
+
+/**
+ * APITokens describes a list of API tokens.
+ * @export
+ * @interface APITokens
+ */
+export interface APITokens {
+    /**
+     * 
+     * @type {Array<APIToken>}
+     * @memberof APITokens
+     */
+    items: Array<APIToken>;
+}
+
+/**
+ * Check if a given object implements the APITokens interface.
+ */
+export function instanceOfAPITokens(value: object): value is APITokens {
+    if (!('items' in value) || value['items'] === undefined) return false;
+    return true;
+}
+
+export function APITokensFromJSON(json: any): APITokens {
+    return APITokensFromJSONTyped(json, false);
+}
+
+export function APITokensFromJSONTyped(json: any, ignoreDiscriminator: boolean): APITokens {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'items': ((json['items'] as Array<any>).map(APITokenFromJSON)),
+    };
+}
+
+export function APITokensToJSON(json: any): APITokens {
+    return APITokensToJSONTyped(json, false);
+}
+
+export function APITokensToJSONTyped(value?: APITokens | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'items': ((value['items'] as Array<any>).map(APITokenToJSON)),
+    };
+}
+
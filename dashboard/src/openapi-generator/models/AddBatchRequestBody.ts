@@ -30,7 +30,31 @@ export interface AddBatchRequestBody {
      * @type {Array<string>}
      * @memberof AddBatchRequestBody
      */
-    keys: Array<string>;
+    keys?: Array<string>;
+    /**
+     * Identifier of the storage location of the AIPs of the Batch
+     * @type {string}
+     * @memberof AddBatchRequestBody
+     */
+    locationId?: string;
+    /**
+     * Manifest listing the SIPs to ingest as part of the batch and their metadata, instead of keys
+     * @type {string}
+     * @memberof AddBatchRequestBody
+     */
+    manifest?: string;
+    /**
+     * Format of the manifest, defaults to csv
+     * @type {AddBatchRequestBodyManifestFormatEnum}
+     * @memberof AddBatchRequestBody
+     */
+    manifestFormat?: AddBatchRequestBodyManifestFormatEnum;
+    /**
+     * Name of the processing profile to use for the SIPs of the Batch
+     * @type {string}
+     * @memberof AddBatchRequestBody
+     */
+    processingProfile?: string;
     /**
      * Identifier of SIP source -- CURRENTLY NOT USED
      * @type {string}
@@ -39,11 +63,21 @@ export interface AddBatchRequestBody {
     sourceId: string;
 }
 
+
+/**
+ * @export
+ */
+export const AddBatchRequestBodyManifestFormatEnum = {
+    Csv: 'csv',
+    Json: 'json'
+} as const;
+export type AddBatchRequestBodyManifestFormatEnum = typeof AddBatchRequestBodyManifestFormatEnum[keyof typeof AddBatchRequestBodyManifestFormatEnum];
+
+
 /**
  * Check if a given object implements the AddBatchRequestBody interface.
  */
 export function instanceOfAddBatchRequestBody(value: object): value is AddBatchRequestBody {
-    if (!('keys' in value) || value['keys'] === undefined) return false;
     if (!('sourceId' in value) || value['sourceId'] === undefined) return false;
     return true;
 }
@@ -59,7 +93,11 @@ export function AddBatchRequestBodyFromJSONTyped(json: any, ignoreDiscriminator:
     return {
         
         'identifier': json['identifier'] == null ? undefined : json['identifier'],
-        'keys': json['keys'],
+        'keys': json['keys'] == null ? undefined : json['keys'],
+        'locationId': json['location_id'] == null ? undefined : json['location_id'],
+        'manifest': json['manifest'] == null ? undefined : json['manifest'],
+        'manifestFormat': json['manifest_format'] == null ? undefined : json['manifest_format'],
+        'processingProfile': json['processing_profile'] == null ? undefined : json['processing_profile'],
         'sourceId': json['source_id'],
     };
 }
@@ -77,6 +115,10 @@ export function AddBatchRequestBodyToJSONTyped(value?: AddBatchRequestBody | nul
         
         'identifier': value['identifier'],
         'keys': value['keys'],
+        'location_id': value['locationId'],
+        'manifest': value['manifest'],
+        'manifest_format': value['manifestFormat'],
+        'processing_profile': value['processingProfile'],
         'source_id': value['sourceId'],
     };
 }
@@ -25,6 +25,18 @@ export interface AddSipRequestBody {
      * @memberof AddSipRequestBody
      */
     key: string;
+    /**
+     * Identifier of the storage location of the AIP
+     * @type {string}
+     * @memberof AddSipRequestBody
+     */
+    locationId?: string;
+    /**
+     * Name of the processing profile to use for the SIP
+     * @type {string}
+     * @memberof AddSipRequestBody
+     */
+    processingProfile?: string;
     /**
      * Identifier of SIP source
      * @type {string}
@@ -53,6 +65,8 @@ export function AddSipRequestBodyFromJSONTyped(json: any, ignoreDiscriminator: b
     return {
         
         'key': json['key'],
+        'locationId': json['location_id'] == null ? undefined : json['location_id'],
+        'processingProfile': json['processing_profile'] == null ? undefined : json['processing_profile'],
         'sourceId': json['source_id'],
     };
 }
@@ -69,6 +83,8 @@ export function AddSipRequestBodyToJSONTyped(value?: AddSipRequestBody | null, i
     return {
         
         'key': value['key'],
+        'location_id': value['locationId'],
+        'processing_profile': value['processingProfile'],
         'source_id': value['sourceId'],
     };
 }
@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface CreateAPITokenRequestBody
+ */
+export interface CreateAPITokenRequestBody {
+    /**
+     * Access control attributes granted to the API token, defaults to the attributes of the user
+     * @type {Array<string>}
+     * @memberof CreateAPITokenRequestBody
+     */
+    attributes?: Array<string>;
+    /**
+     * Expiration datetime of the API token
+     * @type {Date}
+     * @memberof CreateAPITokenRequestBody
+     */
+    expiresAt: Date;
+    /**
+     * Name of the API token
+     * @type {string}
+     * @memberof CreateAPITokenRequestBody
+     */
+    name: string;
+}
+
+/**
+ * Check if a given object implements the CreateAPITokenRequestBody interface.
+ */
+export function instanceOfCreateAPITokenRequestBody(value: object): value is CreateAPITokenRequestBody {
+    if (!('expiresAt' in value) || value['expiresAt'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    return true;
+}
+
+export function CreateAPITokenRequestBodyFromJSON(json: any): CreateAPITokenRequestBody {
+    return CreateAPITokenRequestBodyFromJSONTyped(json, false);
+}
+
+export function CreateAPITokenRequestBodyFromJSONTyped(json: any, ignoreDiscriminator: boolean): CreateAPITokenRequestBody {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'attributes': json['attributes'] == null ? undefined : json['attributes'],
+        'expiresAt': (new Date(json['expires_at'])),
+        'name': json['name'],
+    };
+}
+
+export function CreateAPITokenRequestBodyToJSON(json: any): CreateAPITokenRequestBody {
+    return CreateAPITokenRequestBodyToJSONTyped(json, false);
+}
+
+export function CreateAPITokenRequestBodyToJSONTyped(value?: CreateAPITokenRequestBody | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'attributes': value['attributes'],
+        'expires_at': value['expiresAt'].toISOString(),
+        'name': value['name'],
+    };
+}
+
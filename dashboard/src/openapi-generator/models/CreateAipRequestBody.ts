@@ -19,12 +19,24 @@ import { mapValues } from '../runtime';
  * @interface CreateAipRequestBody
  */
 export interface CreateAipRequestBody {
+    /**
+     * Custom metadata of the AIP, copied from its SIP
+     * @type {{ [key: string]: any; }}
+     * @memberof CreateAipRequestBody
+     */
+    customMetadata?: { [key: string]: any; };
     /**
      * Identifier of the AIP's storage location
      * @type {string}
      * @memberof CreateAipRequestBody
      */
     locationUuid?: string;
+    /**
+     * Descriptive metadata of the AIP, e.g. Dublin Core fields from its METS file
+     * @type {{ [key: string]: string; }}
+     * @memberof CreateAipRequestBody
+     */
+    metadata?: { [key: string]: string; };
     /**
      * Name of the AIP
      * @type {string}
@@ -86,7 +98,9 @@ export function CreateAipRequestBodyFromJSONTyped(json: any, ignoreDiscriminator
     }
     return {
         
+        'customMetadata': json['custom_metadata'] == null ? undefined : json['custom_metadata'],
         'locationUuid': json['location_uuid'] == null ? undefined : json['location_uuid'],
+        'metadata': json['metadata'] == null ? undefined : json['metadata'],
         'name': json['name'],
         'objectKey': json['object_key'],
         'status': json['status'] == null ? undefined : json['status'],
@@ -105,7 +119,9 @@ export function CreateAipRequestBodyToJSONTyped(value?: CreateAipRequestBody | n
 
     return {
         
+        'custom_metadata': value['customMetadata'],
         'location_uuid': value['locationUuid'],
+        'metadata': value['metadata'],
         'name': value['name'],
         'object_key': value['objectKey'],
         'status': value['status'],
@@ -51,6 +51,12 @@ export interface CreateLocationRequestBody {
      * @memberof CreateLocationRequestBody
      */
     purpose: CreateLocationRequestBodyPurposeEnum;
+    /**
+     * Identifiers of the locations where the AIPs stored in this location are replicated
+     * @type {Array<string>}
+     * @memberof CreateLocationRequestBody
+     */
+    replicationTargets?: Array<string>;
     /**
      * 
      * @type {CreateLocationRequestBodySourceEnum}
@@ -65,7 +71,8 @@ export interface CreateLocationRequestBody {
  */
 export const CreateLocationRequestBodyPurposeEnum = {
     Unspecified: 'unspecified',
-    AipStore: 'aip_store'
+    AipStore: 'aip_store',
+    Restore: 'restore'
 } as const;
 export type CreateLocationRequestBodyPurposeEnum = typeof CreateLocationRequestBodyPurposeEnum[keyof typeof CreateLocationRequestBodyPurposeEnum];
 
@@ -106,6 +113,7 @@ export function CreateLocationRequestBodyFromJSONTyped(json: any, ignoreDiscrimi
         'description': json['description'] == null ? undefined : json['description'],
         'name': json['name'],
         'purpose': json['purpose'],
+        'replicationTargets': json['replication_targets'] == null ? undefined : json['replication_targets'],
         'source': json['source'],
     };
 }
@@ -125,6 +133,7 @@ export function CreateLocationRequestBodyToJSONTyped(value?: CreateLocationReque
         'description': value['description'],
         'name': value['name'],
         'purpose': value['purpose'],
+        'replication_targets': value['replicationTargets'],
         'source': value['source'],
     };
 }
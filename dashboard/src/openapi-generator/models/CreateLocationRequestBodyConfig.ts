@@ -47,6 +47,7 @@ export interface CreateLocationRequestBodyConfig {
  */
 export const CreateLocationRequestBodyConfigTypeEnum = {
     Amss: 'amss',
+    Filesystem: 'filesystem',
     S3: 's3',
     Sftp: 'sftp',
     Url: 'url'
@@ -35,6 +35,13 @@ import {
     AMSSConfigToJSONTyped,
 } from './AMSSConfig';
 import type { SFTPConfig } from './SFTPConfig';
+import type { FilesystemConfig } from './FilesystemConfig';
+import {
+    FilesystemConfigFromJSON,
+    FilesystemConfigFromJSONTyped,
+    FilesystemConfigToJSON,
+    FilesystemConfigToJSONTyped,
+} from './FilesystemConfig';
 import {
     SFTPConfigFromJSON,
     SFTPConfigFromJSONTyped,
@@ -66,6 +73,12 @@ export interface CreateLocationRequestBodyConfigValue {
      * @memberof CreateLocationRequestBodyConfigValue
      */
     username: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof CreateLocationRequestBodyConfigValue
+     */
+    path: string;
     /**
      * 
      * @type {string}
@@ -141,6 +154,7 @@ export function instanceOfCreateLocationRequestBodyConfigValue(value: object): v
     if (!('apiKey' in value) || value['apiKey'] === undefined) return false;
     if (!('url' in value) || value['url'] === undefined) return false;
     if (!('username' in value) || value['username'] === undefined) return false;
+    if (!('path' in value) || value['path'] === undefined) return false;
     if (!('bucket' in value) || value['bucket'] === undefined) return false;
     if (!('region' in value) || value['region'] === undefined) return false;
     if (!('address' in value) || value['address'] === undefined) return false;
@@ -162,6 +176,7 @@ export function CreateLocationRequestBodyConfigValueFromJSONTyped(json: any, ign
         'apiKey': json['api_key'],
         'url': json['url'],
         'username': json['username'],
+        'path': json['path'],
         'bucket': json['bucket'],
         'endpoint': json['endpoint'] == null ? undefined : json['endpoint'],
         'key': json['key'] == null ? undefined : json['key'],
@@ -190,6 +205,7 @@ export function CreateLocationRequestBodyConfigValueToJSONTyped(value?: CreateLo
         'api_key': value['apiKey'],
         'url': value['url'],
         'username': value['username'],
+        'path': value['path'],
         'bucket': value['bucket'],
         'endpoint': value['endpoint'],
         'key': value['key'],
@@ -0,0 +1,98 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface CreateSipUploadRequestBody
+ */
+export interface CreateSipUploadRequestBody {
+    /**
+     * Expected checksum of the SIP, e.g. "sha256:9f86d0..."
+     * @type {string}
+     * @memberof CreateSipUploadRequestBody
+     */
+    checksum?: string;
+    /**
+     * Identifier of the storage location of the AIP
+     * @type {string}
+     * @memberof CreateSipUploadRequestBody
+     */
+    locationId?: string;
+    /**
+     * File name of the SIP
+     * @type {string}
+     * @memberof CreateSipUploadRequestBody
+     */
+    name: string;
+    /**
+     * Name of the processing profile to use for the SIP
+     * @type {string}
+     * @memberof CreateSipUploadRequestBody
+     */
+    processingProfile?: string;
+    /**
+     * Size of the SIP in bytes
+     * @type {number}
+     * @memberof CreateSipUploadRequestBody
+     */
+    size: number;
+}
+
+/**
+ * Check if a given object implements the CreateSipUploadRequestBody interface.
+ */
+export function instanceOfCreateSipUploadRequestBody(value: object): value is CreateSipUploadRequestBody {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('size' in value) || value['size'] === undefined) return false;
+    return true;
+}
+
+export function CreateSipUploadRequestBodyFromJSON(json: any): CreateSipUploadRequestBody {
+    return CreateSipUploadRequestBodyFromJSONTyped(json, false);
+}
+
+export function CreateSipUploadRequestBodyFromJSONTyped(json: any, ignoreDiscriminator: boolean): CreateSipUploadRequestBody {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'checksum': json['checksum'] == null ? undefined : json['checksum'],
+        'locationId': json['location_id'] == null ? undefined : json['location_id'],
+        'name': json['name'],
+        'processingProfile': json['processing_profile'] == null ? undefined : json['processing_profile'],
+        'size': json['size'],
+    };
+}
+
+export function CreateSipUploadRequestBodyToJSON(json: any): CreateSipUploadRequestBody {
+    return CreateSipUploadRequestBodyToJSONTyped(json, false);
+}
+
+export function CreateSipUploadRequestBodyToJSONTyped(value?: CreateSipUploadRequestBody | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'checksum': value['checksum'],
+        'location_id': value['locationId'],
+        'name': value['name'],
+        'processing_profile': value['processingProfile'],
+        'size': value['size'],
+    };
+}
+
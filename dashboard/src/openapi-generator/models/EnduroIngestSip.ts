@@ -55,6 +55,12 @@ export interface EnduroIngestSip {
      * @memberof EnduroIngestSip
      */
     createdAt: Date;
+    /**
+     * Custom metadata returned by the child workflows
+     * @type {{ [key: string]: any; }}
+     * @memberof EnduroIngestSip
+     */
+    customMetadata?: { [key: string]: any; };
     /**
      * Package type in case of failure (SIP or PIP)
      * @type {EnduroIngestSipFailedAsEnum}
@@ -182,6 +188,7 @@ export function EnduroIngestSipFromJSONTyped(json: any, ignoreDiscriminator: boo
         'batchUuid': json['batch_uuid'] == null ? undefined : json['batch_uuid'],
         'completedAt': json['completed_at'] == null ? undefined : (new Date(json['completed_at'])),
         'createdAt': (new Date(json['created_at'])),
+        'customMetadata': json['custom_metadata'] == null ? undefined : json['custom_metadata'],
         'failedAs': json['failed_as'] == null ? undefined : json['failed_as'],
         'failedKey': json['failed_key'] == null ? undefined : json['failed_key'],
         'fileCount': json['file_count'] == null ? undefined : json['file_count'],
@@ -212,6 +219,7 @@ export function EnduroIngestSipToJSONTyped(value?: EnduroIngestSip | null, ignor
         'batch_uuid': value['batchUuid'],
         'completed_at': value['completedAt'] == null ? value['completedAt'] : value['completedAt'].toISOString(),
         'created_at': value['createdAt'].toISOString(),
+        'custom_metadata': value['customMetadata'],
         'failed_as': value['failedAs'],
         'failed_key': value['failedKey'],
         'file_count': value['fileCount'],
@@ -0,0 +1,117 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * AIPLegalHold describes a legal hold placed on an AIP to prevent its deletion and moves.
+ * @export
+ * @interface EnduroStorageAipLegalHold
+ */
+export interface EnduroStorageAipLegalHold {
+    /**
+     * Placement datetime
+     * @type {Date}
+     * @memberof EnduroStorageAipLegalHold
+     */
+    placedAt: Date;
+    /**
+     * User who placed the legal hold
+     * @type {string}
+     * @memberof EnduroStorageAipLegalHold
+     */
+    placedBy: string;
+    /**
+     * Reason of the legal hold
+     * @type {string}
+     * @memberof EnduroStorageAipLegalHold
+     */
+    reason: string;
+    /**
+     * Reason of the legal hold release
+     * @type {string}
+     * @memberof EnduroStorageAipLegalHold
+     */
+    releaseReason?: string;
+    /**
+     * Release datetime
+     * @type {Date}
+     * @memberof EnduroStorageAipLegalHold
+     */
+    releasedAt?: Date;
+    /**
+     * User who released the legal hold
+     * @type {string}
+     * @memberof EnduroStorageAipLegalHold
+     */
+    releasedBy?: string;
+    /**
+     * Identifier of the legal hold
+     * @type {string}
+     * @memberof EnduroStorageAipLegalHold
+     */
+    uuid: string;
+}
+
+/**
+ * Check if a given object implements the EnduroStorageAipLegalHold interface.
+ */
+export function instanceOfEnduroStorageAipLegalHold(value: object): value is EnduroStorageAipLegalHold {
+    if (!('placedAt' in value) || value['placedAt'] === undefined) return false;
+    if (!('placedBy' in value) || value['placedBy'] === undefined) return false;
+    if (!('reason' in value) || value['reason'] === undefined) return false;
+    if (!('uuid' in value) || value['uuid'] === undefined) return false;
+    return true;
+}
+
+export function EnduroStorageAipLegalHoldFromJSON(json: any): EnduroStorageAipLegalHold {
+    return EnduroStorageAipLegalHoldFromJSONTyped(json, false);
+}
+
+export function EnduroStorageAipLegalHoldFromJSONTyped(json: any, ignoreDiscriminator: boolean): EnduroStorageAipLegalHold {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'placedAt': (new Date(json['placed_at'])),
+        'placedBy': json['placed_by'],
+        'reason': json['reason'],
+        'releaseReason': json['release_reason'] == null ? undefined : json['release_reason'],
+        'releasedAt': json['released_at'] == null ? undefined : (new Date(json['released_at'])),
+        'releasedBy': json['released_by'] == null ? undefined : json['released_by'],
+        'uuid': json['uuid'],
+    };
+}
+
+export function EnduroStorageAipLegalHoldToJSON(json: any): EnduroStorageAipLegalHold {
+    return EnduroStorageAipLegalHoldToJSONTyped(json, false);
+}
+
+export function EnduroStorageAipLegalHoldToJSONTyped(value?: EnduroStorageAipLegalHold | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'placed_at': value['placedAt'].toISOString(),
+        'placed_by': value['placedBy'],
+        'reason': value['reason'],
+        'release_reason': value['releaseReason'],
+        'released_at': value['releasedAt'] == null ? value['releasedAt'] : value['releasedAt'].toISOString(),
+        'released_by': value['releasedBy'],
+        'uuid': value['uuid'],
+    };
+}
+
@@ -0,0 +1,104 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * AIPReplica describes a copy of an AIP in a replication location.
+ * @export
+ * @interface EnduroStorageAipReplica
+ */
+export interface EnduroStorageAipReplica {
+    /**
+     * Creation datetime
+     * @type {Date}
+     * @memberof EnduroStorageAipReplica
+     */
+    createdAt: Date;
+    /**
+     * Identifier of replication location
+     * @type {string}
+     * @memberof EnduroStorageAipReplica
+     */
+    locationUuid: string;
+    /**
+     * Replication datetime
+     * @type {Date}
+     * @memberof EnduroStorageAipReplica
+     */
+    replicatedAt?: Date;
+    /**
+     * Status of the replica
+     * @type {EnduroStorageAipReplicaStatusEnum}
+     * @memberof EnduroStorageAipReplica
+     */
+    status: EnduroStorageAipReplicaStatusEnum;
+}
+
+
+/**
+ * @export
+ */
+export const EnduroStorageAipReplicaStatusEnum = {
+    Pending: 'pending',
+    Replicated: 'replicated',
+    Failed: 'failed'
+} as const;
+export type EnduroStorageAipReplicaStatusEnum = typeof EnduroStorageAipReplicaStatusEnum[keyof typeof EnduroStorageAipReplicaStatusEnum];
+
+
+/**
+ * Check if a given object implements the EnduroStorageAipReplica interface.
+ */
+export function instanceOfEnduroStorageAipReplica(value: object): value is EnduroStorageAipReplica {
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('locationUuid' in value) || value['locationUuid'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    return true;
+}
+
+export function EnduroStorageAipReplicaFromJSON(json: any): EnduroStorageAipReplica {
+    return EnduroStorageAipReplicaFromJSONTyped(json, false);
+}
+
+export function EnduroStorageAipReplicaFromJSONTyped(json: any, ignoreDiscriminator: boolean): EnduroStorageAipReplica {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'createdAt': (new Date(json['created_at'])),
+        'locationUuid': json['location_uuid'],
+        'replicatedAt': json['replicated_at'] == null ? undefined : (new Date(json['replicated_at'])),
+        'status': json['status'],
+    };
+}
+
+export function EnduroStorageAipReplicaToJSON(json: any): EnduroStorageAipReplica {
+    return EnduroStorageAipReplicaToJSONTyped(json, false);
+}
+
+export function EnduroStorageAipReplicaToJSONTyped(value?: EnduroStorageAipReplica | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'created_at': value['createdAt'].toISOString(),
+        'location_uuid': value['locationUuid'],
+        'replicated_at': value['replicatedAt'] == null ? value['replicatedAt'] : value['replicatedAt'].toISOString(),
+        'status': value['status'],
+    };
+}
+
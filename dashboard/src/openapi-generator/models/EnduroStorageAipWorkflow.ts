@@ -99,7 +99,10 @@ export const EnduroStorageAipWorkflowTypeEnum = {
     Unspecified: 'unspecified',
     UploadAip: 'upload aip',
     MoveAip: 'move aip',
-    DeleteAip: 'delete aip'
+    DeleteAip: 'delete aip',
+    AuditAip: 'audit aip',
+    ReplicateAip: 'replicate aip',
+    RestoreAip: 'restore aip'
 } as const;
 export type EnduroStorageAipWorkflowTypeEnum = typeof EnduroStorageAipWorkflowTypeEnum[keyof typeof EnduroStorageAipWorkflowTypeEnum];
 
@@ -57,6 +57,12 @@ export interface EnduroStorageLocation {
      * @memberof EnduroStorageLocation
      */
     purpose: EnduroStorageLocationPurposeEnum;
+    /**
+     * Identifiers of the locations where the AIPs stored in this location are replicated
+     * @type {Array<string>}
+     * @memberof EnduroStorageLocation
+     */
+    replicationTargets?: Array<string>;
     /**
      * Data source of the location
      * @type {EnduroStorageLocationSourceEnum}
@@ -77,7 +83,8 @@ export interface EnduroStorageLocation {
  */
 export const EnduroStorageLocationPurposeEnum = {
     Unspecified: 'unspecified',
-    AipStore: 'aip_store'
+    AipStore: 'aip_store',
+    Restore: 'restore'
 } as const;
 export type EnduroStorageLocationPurposeEnum = typeof EnduroStorageLocationPurposeEnum[keyof typeof EnduroStorageLocationPurposeEnum];
 
@@ -121,6 +128,7 @@ export function EnduroStorageLocationFromJSONTyped(json: any, ignoreDiscriminato
         'description': json['description'] == null ? undefined : json['description'],
         'name': json['name'],
         'purpose': json['purpose'],
+        'replicationTargets': json['replication_targets'] == null ? undefined : json['replication_targets'],
         'source': json['source'],
         'uuid': json['uuid'],
     };
@@ -142,6 +150,7 @@ export function EnduroStorageLocationToJSONTyped(value?: EnduroStorageLocation |
         'description': value['description'],
         'name': value['name'],
         'purpose': value['purpose'],
+        'replication_targets': value['replicationTargets'],
         'source': value['source'],
         'uuid': value['uuid'],
     };
@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface FilesystemConfig
+ */
+export interface FilesystemConfig {
+    /**
+     * 
+     * @type {string}
+     * @memberof FilesystemConfig
+     */
+    path: string;
+}
+
+/**
+ * Check if a given object implements the FilesystemConfig interface.
+ */
+export function instanceOfFilesystemConfig(value: object): value is FilesystemConfig {
+    if (!('path' in value) || value['path'] === undefined) return false;
+    return true;
+}
+
+export function FilesystemConfigFromJSON(json: any): FilesystemConfig {
+    return FilesystemConfigFromJSONTyped(json, false);
+}
+
+export function FilesystemConfigFromJSONTyped(json: any, ignoreDiscriminator: boolean): FilesystemConfig {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'path': json['path'],
+    };
+}
+
+export function FilesystemConfigToJSON(json: any): FilesystemConfig {
+    return FilesystemConfigToJSONTyped(json, false);
+}
+
+export function FilesystemConfigToJSONTyped(value?: FilesystemConfig | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'path': value['path'],
+    };
+}
+
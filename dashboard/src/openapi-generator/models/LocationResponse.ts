@@ -43,6 +43,12 @@ export interface LocationResponse {
      * @memberof LocationResponse
      */
     purpose: LocationResponsePurposeEnum;
+    /**
+     * Identifiers of the locations where the AIPs stored in this location are replicated
+     * @type {Array<string>}
+     * @memberof LocationResponse
+     */
+    replicationTargets?: Array<string>;
     /**
      * Data source of the location
      * @type {LocationResponseSourceEnum}
@@ -63,7 +69,8 @@ export interface LocationResponse {
  */
 export const LocationResponsePurposeEnum = {
     Unspecified: 'unspecified',
-    AipStore: 'aip_store'
+    AipStore: 'aip_store',
+    Restore: 'restore'
 } as const;
 export type LocationResponsePurposeEnum = typeof LocationResponsePurposeEnum[keyof typeof LocationResponsePurposeEnum];
 
@@ -106,6 +113,7 @@ export function LocationResponseFromJSONTyped(json: any, ignoreDiscriminator: bo
         'description': json['description'] == null ? undefined : json['description'],
         'name': json['name'],
         'purpose': json['purpose'],
+        'replicationTargets': json['replication_targets'] == null ? undefined : json['replication_targets'],
         'source': json['source'],
         'uuid': json['uuid'],
     };
@@ -126,6 +134,7 @@ export function LocationResponseToJSONTyped(value?: LocationResponse | null, ign
         'description': value['description'],
         'name': value['name'],
         'purpose': value['purpose'],
+        'replication_targets': value['replicationTargets'],
         'source': value['source'],
         'uuid': value['uuid'],
     };
@@ -0,0 +1,101 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Storage usage of the AIPs in a location, excluding deleted AIPs.
+ * @export
+ * @interface LocationUsage
+ */
+export interface LocationUsage {
+    /**
+     * Number of AIPs in the location
+     * @type {number}
+     * @memberof LocationUsage
+     */
+    aipCount: number;
+    /**
+     * Total number of files in the AIPs
+     * @type {number}
+     * @memberof LocationUsage
+     */
+    fileCount: number;
+    /**
+     * Maximum total size of the AIPs in bytes, if configured
+     * @type {number}
+     * @memberof LocationUsage
+     */
+    quota?: number;
+    /**
+     * Whether the quota has been reached and new AIPs are rejected
+     * @type {boolean}
+     * @memberof LocationUsage
+     */
+    quotaExceeded: boolean;
+    /**
+     * Total size of the AIPs in bytes
+     * @type {number}
+     * @memberof LocationUsage
+     */
+    size: number;
+}
+
+/**
+ * Check if a given object implements the LocationUsage interface.
+ */
+export function instanceOfLocationUsage(value: object): value is LocationUsage {
+    if (!('aipCount' in value) || value['aipCount'] === undefined) return false;
+    if (!('fileCount' in value) || value['fileCount'] === undefined) return false;
+    if (!('quotaExceeded' in value) || value['quotaExceeded'] === undefined) return false;
+    if (!('size' in value) || value['size'] === undefined) return false;
+    return true;
+}
+
+export function LocationUsageFromJSON(json: any): LocationUsage {
+    return LocationUsageFromJSONTyped(json, false);
+}
+
+export function LocationUsageFromJSONTyped(json: any, ignoreDiscriminator: boolean): LocationUsage {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'aipCount': json['aip_count'],
+        'fileCount': json['file_count'],
+        'quota': json['quota'] == null ? undefined : json['quota'],
+        'quotaExceeded': json['quota_exceeded'],
+        'size': json['size'],
+    };
+}
+
+export function LocationUsageToJSON(json: any): LocationUsage {
+    return LocationUsageToJSONTyped(json, false);
+}
+
+export function LocationUsageToJSONTyped(value?: LocationUsage | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'aip_count': value['aipCount'],
+        'file_count': value['fileCount'],
+        'quota': value['quota'],
+        'quota_exceeded': value['quotaExceeded'],
+        'size': value['size'],
+    };
+}
+
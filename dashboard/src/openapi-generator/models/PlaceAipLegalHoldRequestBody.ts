@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface PlaceAipLegalHoldRequestBody
+ */
+export interface PlaceAipLegalHoldRequestBody {
+    /**
+     * 
+     * @type {string}
+     * @memberof PlaceAipLegalHoldRequestBody
+     */
+    reason: string;
+}
+
+/**
+ * Check if a given object implements the PlaceAipLegalHoldRequestBody interface.
+ */
+export function instanceOfPlaceAipLegalHoldRequestBody(value: object): value is PlaceAipLegalHoldRequestBody {
+    if (!('reason' in value) || value['reason'] === undefined) return false;
+    return true;
+}
+
+export function PlaceAipLegalHoldRequestBodyFromJSON(json: any): PlaceAipLegalHoldRequestBody {
+    return PlaceAipLegalHoldRequestBodyFromJSONTyped(json, false);
+}
+
+export function PlaceAipLegalHoldRequestBodyFromJSONTyped(json: any, ignoreDiscriminator: boolean): PlaceAipLegalHoldRequestBody {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'reason': json['reason'],
+    };
+}
+
+export function PlaceAipLegalHoldRequestBodyToJSON(json: any): PlaceAipLegalHoldRequestBody {
+    return PlaceAipLegalHoldRequestBodyToJSONTyped(json, false);
+}
+
+export function PlaceAipLegalHoldRequestBodyToJSONTyped(value?: PlaceAipLegalHoldRequestBody | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'reason': value['reason'],
+    };
+}
+
@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ReleaseAipLegalHoldRequestBody
+ */
+export interface ReleaseAipLegalHoldRequestBody {
+    /**
+     * 
+     * @type {string}
+     * @memberof ReleaseAipLegalHoldRequestBody
+     */
+    reason: string;
+}
+
+/**
+ * Check if a given object implements the ReleaseAipLegalHoldRequestBody interface.
+ */
+export function instanceOfReleaseAipLegalHoldRequestBody(value: object): value is ReleaseAipLegalHoldRequestBody {
+    if (!('reason' in value) || value['reason'] === undefined) return false;
+    return true;
+}
+
+export function ReleaseAipLegalHoldRequestBodyFromJSON(json: any): ReleaseAipLegalHoldRequestBody {
+    return ReleaseAipLegalHoldRequestBodyFromJSONTyped(json, false);
+}
+
+export function ReleaseAipLegalHoldRequestBodyFromJSONTyped(json: any, ignoreDiscriminator: boolean): ReleaseAipLegalHoldRequestBody {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'reason': json['reason'],
+    };
+}
+
+export function ReleaseAipLegalHoldRequestBodyToJSON(json: any): ReleaseAipLegalHoldRequestBody {
+    return ReleaseAipLegalHoldRequestBodyToJSONTyped(json, false);
+}
+
+export function ReleaseAipLegalHoldRequestBodyToJSONTyped(value?: ReleaseAipLegalHoldRequestBody | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'reason': value['reason'],
+    };
+}
+
@@ -0,0 +1,82 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface RestoreAipsRequestBody
+ */
+export interface RestoreAipsRequestBody {
+    /**
+     * Identifiers of the AIPs to restore
+     * @type {Array<string>}
+     * @memberof RestoreAipsRequestBody
+     */
+    aipUuids: Array<string>;
+    /**
+     * Identifier of the restore location
+     * @type {string}
+     * @memberof RestoreAipsRequestBody
+     */
+    locationUuid?: string;
+    /**
+     * Extract the AIP files in the restore location
+     * @type {boolean}
+     * @memberof RestoreAipsRequestBody
+     */
+    unpack?: boolean;
+}
+
+/**
+ * Check if a given object implements the RestoreAipsRequestBody interface.
+ */
+export function instanceOfRestoreAipsRequestBody(value: object): value is RestoreAipsRequestBody {
+    if (!('aipUuids' in value) || value['aipUuids'] === undefined) return false;
+    return true;
+}
+
+export function RestoreAipsRequestBodyFromJSON(json: any): RestoreAipsRequestBody {
+    return RestoreAipsRequestBodyFromJSONTyped(json, false);
+}
+
+export function RestoreAipsRequestBodyFromJSONTyped(json: any, ignoreDiscriminator: boolean): RestoreAipsRequestBody {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'aipUuids': json['aip_uuids'],
+        'locationUuid': json['location_uuid'] == null ? undefined : json['location_uuid'],
+        'unpack': json['unpack'] == null ? undefined : json['unpack'],
+    };
+}
+
+export function RestoreAipsRequestBodyToJSON(json: any): RestoreAipsRequestBody {
+    return RestoreAipsRequestBodyToJSONTyped(json, false);
+}
+
+export function RestoreAipsRequestBodyToJSONTyped(value?: RestoreAipsRequestBody | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'aip_uuids': value['aipUuids'],
+        'location_uuid': value['locationUuid'],
+        'unpack': value['unpack'],
+    };
+}
+
@@ -0,0 +1,109 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+import { mapValues } from '../runtime';
+/**
+ * SIPUpload describes a resumable SIP upload.
+ * @export
+ * @interface SIPUpload
+ */
+export interface SIPUpload {
+    /**
+     * Time after which the upload is discarded
+     * @type {Date}
+     * @memberof SIPUpload
+     */
+    expiresAt: Date;
+    /**
+     * File name of the SIP
+     * @type {string}
+     * @memberof SIPUpload
+     */
+    name: string;
+    /**
+     * Number of bytes received
+     * @type {number}
+     * @memberof SIPUpload
+     */
+    offset: number;
+    /**
+     * Identifier of the SIP, set when the upload is complete
+     * @type {string}
+     * @memberof SIPUpload
+     */
+    sipUuid?: string;
+    /**
+     * Size of the SIP in bytes
+     * @type {number}
+     * @memberof SIPUpload
+     */
+    size: number;
+    /**
+     * Identifier of the upload
+     * @type {string}
+     * @memberof SIPUpload
+     */
+    uuid: string;
+}
+
+/**
+ * Check if a given object implements the SIPUpload interface.
+ */
+export function instanceOfSIPUpload(value: object): value is SIPUpload {
+    if (!('expiresAt' in value) || value['expiresAt'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('offset' in value) || value['offset'] === undefined) return false;
+    if (!('size' in value) || value['size'] === undefined) return false;
+    if (!('uuid' in value) || value['uuid'] === undefined) return false;
+    return true;
+}
+
+export function SIPUploadFromJSON(json: any): SIPUpload {
+    return SIPUploadFromJSONTyped(json, false);
+}
+
+export function SIPUploadFromJSONTyped(json: any, ignoreDiscriminator: boolean): SIPUpload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'expiresAt': (new Date(json['expires_at'])),
+        'name': json['name'],
+        'offset': json['offset'],
+        'sipUuid': json['sip_uuid'] == null ? undefined : json['sip_uuid'],
+        'size': json['size'],
+        'uuid': json['uuid'],
+    };
+}
+
+export function SIPUploadToJSON(json: any): SIPUpload {
+    return SIPUploadToJSONTyped(json, false);
+}
+
+export function SIPUploadToJSONTyped(value?: SIPUpload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'expires_at': value['expiresAt'].toISOString(),
+        'name': value['name'],
+        'offset': value['offset'],
+        'sip_uuid': value['sipUuid'],
+        'size': value['size'],
+        'uuid': value['uuid'],
+    };
+}
+
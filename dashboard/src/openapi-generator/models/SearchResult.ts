@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * SearchResult describes a package matching a search query.
+ * @export
+ * @interface SearchResult
+ */
+export interface SearchResult {
+    /**
+     * Indexed metadata of the package, keyed by field name
+     * @type {{ [key: string]: string; }}
+     * @memberof SearchResult
+     */
+    fields?: { [key: string]: string; };
+    /**
+     * Name of the package
+     * @type {string}
+     * @memberof SearchResult
+     */
+    name: string;
+    /**
+     * Identifier of the package
+     * @type {string}
+     * @memberof SearchResult
+     */
+    uuid: string;
+}
+
+/**
+ * Check if a given object implements the SearchResult interface.
+ */
+export function instanceOfSearchResult(value: object): value is SearchResult {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('uuid' in value) || value['uuid'] === undefined) return false;
+    return true;
+}
+
+export function SearchResultFromJSON(json: any): SearchResult {
+    return SearchResultFromJSONTyped(json, false);
+}
+
+export function SearchResultFromJSONTyped(json: any, ignoreDiscriminator: boolean): SearchResult {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'fields': json['fields'] == null ? undefined : json['fields'],
+        'name': json['name'],
+        'uuid': json['uuid'],
+    };
+}
+
+export function SearchResultToJSON(json: any): SearchResult {
+    return SearchResultToJSONTyped(json, false);
+}
+
+export function SearchResultToJSONTyped(value?: SearchResult | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'fields': value['fields'],
+        'name': value['name'],
+        'uuid': value['uuid'],
+    };
+}
+
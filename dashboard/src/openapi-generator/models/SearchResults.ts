@@ -0,0 +1,90 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Enduro API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SearchResult } from './SearchResult';
+import {
+    SearchResultFromJSON,
+    SearchResultFromJSONTyped,
+    SearchResultToJSON,
+    SearchResultToJSONTyped,
+} from './SearchResult';
+import type { EnduroPage } from './EnduroPage';
+import {
+    EnduroPageFromJSON,
+    EnduroPageFromJSONTyped,
+    EnduroPageToJSON,
+    EnduroPageToJSONTyped,
+} from './EnduroPage';
+
+/**
+ * SearchResults describes a page of search results.
+ * @export
+ * @interface SearchResults
+ */
+export interface SearchResults {
+    /**
+     * 
+     * @type {Array<SearchResult>}
+     * @memberof SearchResults
+     */
+    items: Array<SearchResult>;
+    /**
+     * 
+     * @type {EnduroPage}
+     * @memberof SearchResults
+     */
+    page: EnduroPage;
+}
+
+/**
+ * Check if a given object implements the SearchResults interface.
+ */
+export function instanceOfSearchResults(value: object): value is SearchResults {
+    if (!('items' in value) || value['items'] === undefined) return false;
+    if (!('page' in value) || value['page'] === undefined) return false;
+    return true;
+}
+
+export function SearchResultsFromJSON(json: any): SearchResults {
+    return SearchResultsFromJSONTyped(json, false);
+}
+
+export function SearchResultsFromJSONTyped(json: any, ignoreDiscriminator: boolean): SearchResults {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'items': ((json['items'] as Array<any>).map(SearchResultFromJSON)),
+        'page': EnduroPageFromJSON(json['page']),
+    };
+}
+
+export function SearchResultsToJSON(json: any): SearchResults {
+    return SearchResultsToJSONTyped(json, false);
+}
+
+export function SearchResultsToJSONTyped(value?: SearchResults | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'items': ((value['items'] as Array<any>).map(SearchResultToJSON)),
+        'page': EnduroPageToJSON(value['page']),
+    };
+}
+
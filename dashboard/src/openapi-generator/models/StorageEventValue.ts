@@ -55,7 +55,10 @@ export const StorageEventValueTypeEnum = {
     AipWorkflowCreatedEvent: 'aip_workflow_created_event',
     AipWorkflowUpdatedEvent: 'aip_workflow_updated_event',
     AipTaskCreatedEvent: 'aip_task_created_event',
-    AipTaskUpdatedEvent: 'aip_task_updated_event'
+    AipTaskUpdatedEvent: 'aip_task_updated_event',
+    AipDeletionRequestCreatedEvent: 'aip_deletion_request_created_event',
+    AipDeletionRequestUpdatedEvent: 'aip_deletion_request_updated_event',
+    AipFixityCheckedEvent: 'aip_fixity_checked_event'
 } as const;
 export type StorageEventValueTypeEnum = typeof StorageEventValueTypeEnum[keyof typeof StorageEventValueTypeEnum];
 
@@ -90,6 +90,27 @@ import {
     AIPWorkflowUpdatedEventToJSON,
     AIPWorkflowUpdatedEventToJSONTyped,
 } from './AIPWorkflowUpdatedEvent';
+import type { AIPDeletionRequestCreatedEvent } from './AIPDeletionRequestCreatedEvent';
+import {
+    AIPDeletionRequestCreatedEventFromJSON,
+    AIPDeletionRequestCreatedEventFromJSONTyped,
+    AIPDeletionRequestCreatedEventToJSON,
+    AIPDeletionRequestCreatedEventToJSONTyped,
+} from './AIPDeletionRequestCreatedEvent';
+import type { AIPDeletionRequestUpdatedEvent } from './AIPDeletionRequestUpdatedEvent';
+import {
+    AIPDeletionRequestUpdatedEventFromJSON,
+    AIPDeletionRequestUpdatedEventFromJSONTyped,
+    AIPDeletionRequestUpdatedEventToJSON,
+    AIPDeletionRequestUpdatedEventToJSONTyped,
+} from './AIPDeletionRequestUpdatedEvent';
+import type { AIPFixityCheckedEvent } from './AIPFixityCheckedEvent';
+import {
+    AIPFixityCheckedEventFromJSON,
+    AIPFixityCheckedEventFromJSONTyped,
+    AIPFixityCheckedEventToJSON,
+    AIPFixityCheckedEventToJSONTyped,
+} from './AIPFixityCheckedEvent';
 
 /**
  * 
@@ -1,6 +1,9 @@
 /* tslint:disable */
 /* eslint-disable */
 export * from './AIPCreatedEvent';
+export * from './AIPDeletionRequestCreatedEvent';
+export * from './AIPDeletionRequestUpdatedEvent';
+export * from './AIPFixityCheckedEvent';
 export * from './AIPLocationUpdatedEvent';
 export * from './AIPNotFound';
 export * from './AIPResponse';
@@ -11,6 +14,8 @@ export * from './AIPUpdatedEvent';
 export * from './AIPWorkflowCreatedEvent';
 export * from './AIPWorkflowUpdatedEvent';
 export * from './AMSSConfig';
+export * from './APIToken';
+export * from './APITokens';
 export * from './AddBatchRequestBody';
 export * from './AddSipRequestBody';
 export * from './AddSipResponseBody';
@@ -20,11 +25,13 @@ export * from './BatchNotFound';
 export * from './BatchUpdatedEvent';
 export * from './CancelAipDeletionRequestBody';
 export * from './ConfirmSipRequestBody';
+export * from './CreateAPITokenRequestBody';
 export * from './CreateAipRequestBody';
 export * from './CreateLocationRequestBody';
 export * from './CreateLocationRequestBodyConfig';
 export * from './CreateLocationRequestBodyConfigValue';
 export * from './CreateLocationResult';
+export * from './CreateSipUploadRequestBody';
 export * from './EnduroAbout';
 export * from './EnduroChildworkflow';
 export * from './EnduroIngestBatch';
@@ -41,11 +48,14 @@ export * from './EnduroIngestUser';
 export * from './EnduroIngestUsers';
 export * from './EnduroPage';
 export * from './EnduroStorageAip';
+export * from './EnduroStorageAipLegalHold';
+export * from './EnduroStorageAipReplica';
 export * from './EnduroStorageAipTask';
 export * from './EnduroStorageAipWorkflow';
 export * from './EnduroStorageAipWorkflows';
 export * from './EnduroStorageAips';
 export * from './EnduroStorageLocation';
+export * from './FilesystemConfig';
 export * from './IngestEvent';
 export * from './IngestEventValue';
 export * from './IngestEventValueValue';
@@ -53,9 +63,13 @@ export * from './IngestPingEvent';
 export * from './LocationCreatedEvent';
 export * from './LocationNotFound';
 export * from './LocationResponse';
+export * from './LocationUsage';
 export * from './ModelError';
 export * from './MoveStatusResult';
+export * from './PlaceAipLegalHoldRequestBody';
+export * from './ReleaseAipLegalHoldRequestBody';
 export * from './RequestAipDeletionRequestBody';
+export * from './RestoreAipsRequestBody';
 export * from './ReviewAipDeletionRequestBody';
 export * from './ReviewBatchRequestBody';
 export * from './S3Config';
@@ -66,8 +80,11 @@ export * from './SIPStatusUpdatedEvent';
 export * from './SIPTaskCreatedEvent';
 export * from './SIPTaskUpdatedEvent';
 export * from './SIPUpdatedEvent';
+export * from './SIPUpload';
 export * from './SIPWorkflowCreatedEvent';
 export * from './SIPWorkflowUpdatedEvent';
+export * from './SearchResult';
+export * from './SearchResults';
 export * from './StorageEvent';
 export * from './StorageEventValue';
 export * from './StorageEventValueValue';
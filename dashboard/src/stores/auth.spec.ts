@@ -178,6 +178,16 @@ describe("useAuthStore", () => {
       required: ["ingest:sips:list", "storage:locations:list"],
       expected: false,
     },
+    {
+      title: "resource scoped matches",
+      enabled: true,
+      attributes: [
+        "ingest:sips:list:batch/ffdb12f4-1735-4022-b746-a9bf4a32109b",
+        "storage:*:location/*",
+      ],
+      required: ["ingest:sips:list", "storage:locations:list"],
+      expected: true,
+    },
     {
       title: "no match without wildcard",
       enabled: true,
@@ -64,9 +64,19 @@ export const useAuthStore = defineStore("auth", {
           return true;
         }
 
+        // Attributes scoped to specific resources (e.g.
+        // "storage:aips:list:location/<uuid>") grant access to the pages, the
+        // API filters the resources shown.
+        const granted = state.attributes.map((attr) => {
+          const i = attr.lastIndexOf(":");
+          return i !== -1 && attr.substring(i + 1).includes("/")
+            ? attr.substring(0, i)
+            : attr;
+        });
+
         for (let attr of required) {
           while (true) {
-            if (state.attributes.includes(attr)) {
+            if (granted.includes(attr)) {
               break;
             }
             const suffixIndex = attr.lastIndexOf(":*");
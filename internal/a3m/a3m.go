@@ -34,6 +34,10 @@ type CreateAIPActivityParams struct {
 	Name         string
 	Path         string
 	WorkflowUUID uuid.UUID
+
+	// Processing overrides the processing configuration of the worker when
+	// set, e.g. to apply a processing profile.
+	Processing *Processing
 }
 
 type CreateAIPActivityResult struct {
@@ -62,6 +66,11 @@ func (a *CreateAIPActivity) Execute(
 	logger := temporal_tools.GetLogger(ctx)
 	result := &CreateAIPActivityResult{}
 
+	proc := a.cfg.Processing
+	if opts.Processing != nil {
+		proc = *opts.Processing
+	}
+
 	var g run.Group
 
 	{
@@ -90,6 +99,11 @@ func (a *CreateAIPActivity) Execute(
 	}
 
 	{
+		// Cancel the a3m requests when the group is interrupted, e.g. when the
+		// activity is canceled and the heartbeat actor returns.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
 		g.Add(
 			func() error {
 				submitResp, err := a.client.Submit(
@@ -98,21 +112,21 @@ func (a *CreateAIPActivity) Execute(
 						Name: opts.Name,
 						Url:  fmt.Sprintf("file://%s", opts.Path),
 						Config: &transferservice.ProcessingConfig{
-							AssignUuidsToDirectories:                     a.cfg.AssignUuidsToDirectories,
-							ExamineContents:                              a.cfg.ExamineContents,
-							GenerateTransferStructureReport:              a.cfg.GenerateTransferStructureReport,
-							DocumentEmptyDirectories:                     a.cfg.DocumentEmptyDirectories,
-							ExtractPackages:                              a.cfg.ExtractPackages,
-							DeletePackagesAfterExtraction:                a.cfg.DeletePackagesAfterExtraction,
-							IdentifyTransfer:                             a.cfg.IdentifyTransfer,
-							IdentifySubmissionAndMetadata:                a.cfg.IdentifySubmissionAndMetadata,
-							IdentifyBeforeNormalization:                  a.cfg.IdentifyBeforeNormalization,
-							Normalize:                                    a.cfg.Normalize,
-							TranscribeFiles:                              a.cfg.TranscribeFiles,
-							PerformPolicyChecksOnOriginals:               a.cfg.PerformPolicyChecksOnOriginals,
-							PerformPolicyChecksOnPreservationDerivatives: a.cfg.PerformPolicyChecksOnPreservationDerivatives,
-							AipCompressionLevel:                          a.cfg.AipCompressionLevel,
-							AipCompressionAlgorithm:                      a.cfg.AipCompressionAlgorithm,
+							AssignUuidsToDirectories:                     proc.AssignUuidsToDirectories,
+							ExamineContents:                              proc.ExamineContents,
+							GenerateTransferStructureReport:              proc.GenerateTransferStructureReport,
+							DocumentEmptyDirectories:                     proc.DocumentEmptyDirectories,
+							ExtractPackages:                              proc.ExtractPackages,
+							DeletePackagesAfterExtraction:                proc.DeletePackagesAfterExtraction,
+							IdentifyTransfer:                             proc.IdentifyTransfer,
+							IdentifySubmissionAndMetadata:                proc.IdentifySubmissionAndMetadata,
+							IdentifyBeforeNormalization:                  proc.IdentifyBeforeNormalization,
+							Normalize:                                    proc.Normalize,
+							TranscribeFiles:                              proc.TranscribeFiles,
+							PerformPolicyChecksOnOriginals:               proc.PerformPolicyChecksOnOriginals,
+							PerformPolicyChecksOnPreservationDerivatives: proc.PerformPolicyChecksOnPreservationDerivatives,
+							AipCompressionLevel:                          proc.AipCompressionLevel,
+							AipCompressionAlgorithm:                      proc.AipCompressionAlgorithm,
 						},
 					},
 					grpc.WaitForReady(true),
@@ -130,7 +144,11 @@ func (a *CreateAIPActivity) Execute(
 					}
 
 					if readResp.Status == transferservice.PackageStatus_PACKAGE_STATUS_PROCESSING {
-						time.Sleep(time.Second / 2)
+						select {
+						case <-ctx.Done():
+							return ctx.Err()
+						case <-time.After(time.Second / 2):
+						}
 						continue
 					}
 
@@ -155,7 +173,9 @@ func (a *CreateAIPActivity) Execute(
 
 				return nil
 			},
-			func(error) {},
+			func(error) {
+				cancel()
+			},
 		)
 	}
 
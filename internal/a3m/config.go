@@ -5,6 +5,8 @@ import (
 
 	transferservice "buf.build/gen/go/artefactual/a3m/protocolbuffers/go/a3m/api/transferservice/v1beta1"
 	"go.artefactual.dev/tools/bucket"
+
+	"github.com/artefactual-sdps/enduro/internal/pres"
 )
 
 const (
@@ -74,3 +76,36 @@ var ProcessingDefault = Processing{
 	AipCompressionLevel:                          1,
 	AipCompressionAlgorithm:                      transferservice.ProcessingConfig_AIP_COMPRESSION_ALGORITHM_S7_BZIP2,
 }
+
+// WithProfile returns a copy of p with the settings of the given processing
+// profile applied.
+func (p Processing) WithProfile(o pres.A3mProcessing) Processing {
+	set := func(dst *bool, src *bool) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	set(&p.AssignUuidsToDirectories, o.AssignUuidsToDirectories)
+	set(&p.ExamineContents, o.ExamineContents)
+	set(&p.GenerateTransferStructureReport, o.GenerateTransferStructureReport)
+	set(&p.DocumentEmptyDirectories, o.DocumentEmptyDirectories)
+	set(&p.ExtractPackages, o.ExtractPackages)
+	set(&p.DeletePackagesAfterExtraction, o.DeletePackagesAfterExtraction)
+	set(&p.IdentifyTransfer, o.IdentifyTransfer)
+	set(&p.IdentifySubmissionAndMetadata, o.IdentifySubmissionAndMetadata)
+	set(&p.IdentifyBeforeNormalization, o.IdentifyBeforeNormalization)
+	set(&p.Normalize, o.Normalize)
+	set(&p.TranscribeFiles, o.TranscribeFiles)
+	set(&p.PerformPolicyChecksOnOriginals, o.PerformPolicyChecksOnOriginals)
+	set(&p.PerformPolicyChecksOnPreservationDerivatives, o.PerformPolicyChecksOnPreservationDerivatives)
+	if o.AipCompressionLevel != nil {
+		p.AipCompressionLevel = *o.AipCompressionLevel
+	}
+	if o.AipCompressionAlgorithm != nil {
+		p.AipCompressionAlgorithm = transferservice.ProcessingConfig_AIPCompressionAlgorithm(
+			*o.AipCompressionAlgorithm,
+		)
+	}
+
+	return p
+}
@@ -3,9 +3,11 @@ package a3m_test
 import (
 	"testing"
 
+	transferservice "buf.build/gen/go/artefactual/a3m/protocolbuffers/go/a3m/api/transferservice/v1beta1"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/fs"
 
+	"github.com/artefactual-sdps/enduro/internal/a3m"
 	"github.com/artefactual-sdps/enduro/internal/config"
 )
 
@@ -68,3 +70,38 @@ aipCompressionLevel = 10`,
 		})
 	}
 }
+
+func TestProcessingWithProfile(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := fs.NewDir(t, "",
+		fs.WithFile("enduro.toml", `
+[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[[preservation.profiles]]
+name = "email"
+
+[preservation.profiles.a3m]
+normalize = false
+examineContents = true
+aipCompressionLevel = 5
+aipCompressionAlgorithm = 7
+`),
+	)
+
+	var c config.Configuration
+	_, _, err := config.Read(&c, tmpDir.Join("enduro.toml"))
+	assert.NilError(t, err)
+
+	profile := c.Preservation.Profiles.ByName("email")
+	assert.Assert(t, profile != nil)
+
+	want := a3m.ProcessingDefault
+	want.Normalize = false
+	want.ExamineContents = true
+	want.AipCompressionLevel = 5
+	want.AipCompressionAlgorithm = transferservice.ProcessingConfig_AIP_COMPRESSION_ALGORITHM_S7_LZMA
+	assert.DeepEqual(t, c.A3m.Processing.WithProfile(profile.A3m), want)
+}
@@ -0,0 +1,64 @@
+package am
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.artefactual.dev/amclient"
+	temporal_tools "go.artefactual.dev/tools/temporal"
+)
+
+const AbortTransferActivityName = "abort-transfer-activity"
+
+type AbortTransferActivityParams struct {
+	// TransferID is the Archivematica transfer UUID.
+	TransferID string
+
+	// SIPID is the Archivematica SIP UUID, empty if the transfer hasn't
+	// reached the ingest stage.
+	SIPID string
+}
+
+type AbortTransferActivity struct {
+	tfrSvc    amclient.TransferService
+	ingestSvc amclient.IngestService
+}
+
+type AbortTransferActivityResult struct{}
+
+func NewAbortTransferActivity(
+	tfrSvc amclient.TransferService,
+	ingestSvc amclient.IngestService,
+) *AbortTransferActivity {
+	return &AbortTransferActivity{tfrSvc: tfrSvc, ingestSvc: ingestSvc}
+}
+
+// Execute removes the ingest and the transfer of a canceled workflow from
+// Archivematica. The Archivematica API can't stop the jobs already running, so
+// the units are hidden to prevent them from waiting for decisions or being
+// approved from the dashboard.
+func (a *AbortTransferActivity) Execute(
+	ctx context.Context,
+	params *AbortTransferActivityParams,
+) (*AbortTransferActivityResult, error) {
+	logger := temporal_tools.GetLogger(ctx)
+	logger.V(1).Info("Execute AbortTransferActivity",
+		"TransferID", params.TransferID,
+		"SIPID", params.SIPID,
+	)
+
+	var errs []error
+	if params.SIPID != "" {
+		if _, _, err := a.ingestSvc.Hide(ctx, params.SIPID); err != nil {
+			errs = append(errs, fmt.Errorf("hide ingest: %v", err))
+		}
+	}
+	if params.TransferID != "" {
+		if _, _, err := a.tfrSvc.Hide(ctx, params.TransferID); err != nil {
+			errs = append(errs, fmt.Errorf("hide transfer: %v", err))
+		}
+	}
+
+	return &AbortTransferActivityResult{}, errors.Join(errs...)
+}
@@ -0,0 +1,94 @@
+package am_test
+
+import (
+	"errors"
+	"testing"
+
+	"go.artefactual.dev/amclient"
+	"go.artefactual.dev/amclient/amclienttest"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/am"
+)
+
+func TestAbortTransferActivity(t *testing.T) {
+	t.Parallel()
+
+	transferID := "c5ecddb0-7a61-4234-80a9-fa7993e97867"
+	sipID := "9e8161cc-2815-4d6f-8a75-f003c41b257b"
+	activityErr := "activity error (type: abort-transfer-activity, scheduledEventID: 0, startedEventID: 0, identity: ): "
+
+	type test struct {
+		name       string
+		params     am.AbortTransferActivityParams
+		mockTfr    func(*amclienttest.MockTransferServiceMockRecorder)
+		mockIngest func(*amclienttest.MockIngestServiceMockRecorder)
+		errMsg     string
+	}
+	for _, tt := range []test{
+		{
+			name:   "Hides the transfer",
+			params: am.AbortTransferActivityParams{TransferID: transferID},
+			mockTfr: func(m *amclienttest.MockTransferServiceMockRecorder) {
+				m.Hide(mockutil.Context(), transferID).Return(&amclient.TransferHideResponse{Removed: true}, nil, nil)
+			},
+		},
+		{
+			name:   "Hides the ingest and the transfer",
+			params: am.AbortTransferActivityParams{TransferID: transferID, SIPID: sipID},
+			mockTfr: func(m *amclienttest.MockTransferServiceMockRecorder) {
+				m.Hide(mockutil.Context(), transferID).Return(&amclient.TransferHideResponse{Removed: true}, nil, nil)
+			},
+			mockIngest: func(m *amclienttest.MockIngestServiceMockRecorder) {
+				m.Hide(mockutil.Context(), sipID).Return(&amclient.IngestHideResponse{Removed: true}, nil, nil)
+			},
+		},
+		{
+			name:   "Errors when the units can't be hidden",
+			params: am.AbortTransferActivityParams{TransferID: transferID, SIPID: sipID},
+			mockTfr: func(m *amclienttest.MockTransferServiceMockRecorder) {
+				m.Hide(mockutil.Context(), transferID).Return(nil, nil, errors.New("transfer not found"))
+			},
+			mockIngest: func(m *amclienttest.MockIngestServiceMockRecorder) {
+				m.Hide(mockutil.Context(), sipID).Return(nil, nil, errors.New("server error"))
+			},
+			errMsg: "hide ingest: server error\nhide transfer: transfer not found",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			ctrl := gomock.NewController(t)
+
+			tfrSvc := amclienttest.NewMockTransferService(ctrl)
+			if tt.mockTfr != nil {
+				tt.mockTfr(tfrSvc.EXPECT())
+			}
+			ingestSvc := amclienttest.NewMockIngestService(ctrl)
+			if tt.mockIngest != nil {
+				tt.mockIngest(ingestSvc.EXPECT())
+			}
+
+			env.RegisterActivityWithOptions(
+				am.NewAbortTransferActivity(tfrSvc, ingestSvc).Execute,
+				temporalsdk_activity.RegisterOptions{
+					Name: am.AbortTransferActivityName,
+				},
+			)
+
+			_, err := env.ExecuteActivity(am.AbortTransferActivityName, &tt.params)
+			if tt.errMsg != "" {
+				assert.Error(t, err, activityErr+tt.errMsg)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
@@ -34,6 +34,10 @@ type StartTransferActivityParams struct {
 	// RelativePath is the PIP path relative to the Archivematica transfer
 	// source directory.
 	RelativePath string
+
+	// ProcessingConfig is the name of the Archivematica processing
+	// configuration to use. If empty, the configured default is used.
+	ProcessingConfig string
 }
 
 type StartTransferActivityResult struct {
@@ -66,7 +70,10 @@ func (a *StartTransferActivity) Execute(
 		"RelativePath", opts.RelativePath,
 	)
 
-	processingConfig := a.cfg.ProcessingConfig
+	processingConfig := opts.ProcessingConfig
+	if processingConfig == "" {
+		processingConfig = a.cfg.ProcessingConfig
+	}
 	if processingConfig == "" {
 		processingConfig = "automated" // Default value.
 	}
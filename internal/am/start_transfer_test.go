@@ -248,6 +248,71 @@ func TestStartTransferActivityChecksVersionEveryExecution(t *testing.T) {
 	assert.Equal(t, keys[2], keys[1])
 }
 
+func TestStartTransferActivityProcessingConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name   string
+		cfg    string
+		params string
+		want   string
+	}{
+		{
+			name: "Defaults to the automated processing config",
+			want: "automated",
+		},
+		{
+			name: "Uses the configured processing config",
+			cfg:  "default",
+			want: "default",
+		},
+		{
+			name:   "Uses the processing config from the params",
+			cfg:    "default",
+			params: "email",
+			want:   "email",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			packageService := amclienttest.NewMockPackageService(ctrl)
+			var got string
+			packageService.EXPECT().
+				Create(mockutil.Context(), gomock.Any()).
+				DoAndReturn(func(
+					_ context.Context,
+					req *amclient.PackageCreateRequest,
+				) (*amclient.PackageCreateResponse, *amclient.Response, error) {
+					got = req.ProcessingConfig
+					return &amclient.PackageCreateResponse{ID: "transfer-id"},
+						packageCreateResponse(http.StatusAccepted), nil
+				})
+
+			client := newStartTransferTestClient(
+				t,
+				packageService,
+				func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotImplemented)
+				},
+			)
+			activity := am.NewStartTransferActivity(&am.Config{ProcessingConfig: tt.cfg}, client)
+
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(activity.Execute, temporalsdk_activity.RegisterOptions{
+				Name: am.StartTransferActivityName,
+			})
+			params := startTransferParams()
+			params.ProcessingConfig = tt.params
+			_, err := env.ExecuteActivity(am.StartTransferActivityName, params)
+			assert.NilError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
 func newStartTransferTestClient(
 	t *testing.T,
 	packageService amclient.PackageService,
@@ -0,0 +1,152 @@
+// Package antivirus scans content for malware using the ClamAV daemon (clamd).
+package antivirus
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// chunkSize is the maximum size of the chunks streamed to clamd.
+const chunkSize = 64 * 1024
+
+// Result is the outcome of a scan.
+type Result struct {
+	// Infected is true when the content matched a malware signature.
+	Infected bool
+
+	// Signature is the name of the matched signature, if any.
+	Signature string
+}
+
+// Scanner scans content for malware.
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (*Result, error)
+}
+
+type clamdScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+var _ Scanner = (*clamdScanner)(nil)
+
+// NewClamdScanner returns a Scanner that streams content to the clamd daemon
+// configured in cfg using the INSTREAM command.
+func NewClamdScanner(cfg Config) (Scanner, error) {
+	network, address, err := cfg.network()
+	if err != nil {
+		return nil, fmt.Errorf("clamd: %v", err)
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	return &clamdScanner{network: network, address: address, timeout: timeout}, nil
+}
+
+func (s *clamdScanner) Scan(ctx context.Context, r io.Reader) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("clamd: dial: %v", err)
+	}
+	defer conn.Close()
+
+	// Unblock any pending read or write when the context is done.
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if err := s.stream(conn, r); err != nil {
+		// clamd replies with an error and closes the connection when the
+		// stream exceeds its size limit, so try to read the reply first.
+		if reply, rerr := readReply(conn); rerr == nil {
+			return parseReply(reply)
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("clamd: %v", ctx.Err())
+		}
+		return nil, fmt.Errorf("clamd: %v", err)
+	}
+
+	reply, err := readReply(conn)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("clamd: %v", ctx.Err())
+		}
+		return nil, fmt.Errorf("clamd: read reply: %v", err)
+	}
+
+	return parseReply(reply)
+}
+
+// stream sends the INSTREAM command followed by the content of r in chunks
+// prefixed by their length, and a zero-length chunk to mark the end.
+func (s *clamdScanner) stream(w io.Writer, r io.Reader) error {
+	if _, err := io.WriteString(w, "zINSTREAM\x00"); err != nil {
+		return fmt.Errorf("write command: %v", err)
+	}
+
+	buf := make([]byte, 4+chunkSize)
+	for {
+		n, err := r.Read(buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf, uint32(n)) // #nosec G115 -- n <= chunkSize.
+			if _, werr := w.Write(buf[:4+n]); werr != nil {
+				return fmt.Errorf("write chunk: %v", werr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read content: %v", err)
+		}
+	}
+
+	if _, err := w.Write([]byte{0, 0, 0, 0}); err != nil {
+		return fmt.Errorf("write end of stream: %v", err)
+	}
+
+	return nil
+}
+
+// readReply reads a null-terminated reply.
+func readReply(r io.Reader) (string, error) {
+	reply, err := bufio.NewReader(r).ReadString(0)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(reply, "\x00"), nil
+}
+
+// parseReply parses an INSTREAM reply, e.g. "stream: OK" or
+// "stream: Eicar-Test-Signature FOUND".
+func parseReply(reply string) (*Result, error) {
+	reply = strings.TrimSpace(reply)
+	status := strings.TrimPrefix(reply, "stream: ")
+
+	switch {
+	case status == "OK":
+		return &Result{}, nil
+	case strings.HasSuffix(status, " FOUND"):
+		return &Result{Infected: true, Signature: strings.TrimSuffix(status, " FOUND")}, nil
+	case strings.HasSuffix(status, " ERROR"):
+		return nil, fmt.Errorf("clamd: %s", strings.TrimSuffix(status, " ERROR"))
+	default:
+		return nil, fmt.Errorf("clamd: unexpected reply: %q", reply)
+	}
+}
@@ -0,0 +1,205 @@
+package antivirus_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/antivirus"
+)
+
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// clamd is a local stand-in for the ClamAV daemon that understands the
+// INSTREAM command and only knows the EICAR test signature.
+func clamd(t *testing.T, network string, maxSize int) string {
+	t.Helper()
+
+	address := "127.0.0.1:0"
+	if network == "unix" {
+		address = filepath.Join(t.TempDir(), "clamd.sock")
+	}
+
+	l, err := net.Listen(network, address)
+	assert.NilError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go handle(conn, maxSize)
+		}
+	}()
+
+	if network == "unix" {
+		return "unix://" + address
+	}
+
+	return "tcp://" + l.Addr().String()
+}
+
+func handle(conn net.Conn, maxSize int) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	cmd, err := r.ReadString(0)
+	if err != nil || cmd != "zINSTREAM\x00" {
+		_, _ = io.WriteString(conn, "UNKNOWN COMMAND\x00")
+		return
+	}
+
+	var content bytes.Buffer
+	for {
+		var size uint32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			return
+		}
+		if size == 0 {
+			break
+		}
+		if content.Len()+int(size) > maxSize {
+			_, _ = io.WriteString(conn, "INSTREAM size limit exceeded. ERROR\x00")
+			return
+		}
+		if _, err := io.CopyN(&content, r, int64(size)); err != nil {
+			return
+		}
+	}
+
+	if strings.Contains(content.String(), eicar) {
+		_, _ = io.WriteString(conn, "stream: Eicar-Test-Signature FOUND\x00")
+		return
+	}
+	_, _ = io.WriteString(conn, "stream: OK\x00")
+}
+
+func TestClamdScanner(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name    string
+		network string
+		maxSize int
+		content string
+		want    *antivirus.Result
+		wantErr string
+	}
+	for _, tt := range []test{
+		{
+			name:    "Scans clean content",
+			network: "tcp",
+			content: "clean content",
+			want:    &antivirus.Result{},
+		},
+		{
+			name:    "Scans infected content",
+			network: "tcp",
+			content: eicar,
+			want:    &antivirus.Result{Infected: true, Signature: "Eicar-Test-Signature"},
+		},
+		{
+			name:    "Scans infected content in multiple chunks",
+			network: "tcp",
+			content: strings.Repeat("a", 100_000) + eicar,
+			want:    &antivirus.Result{Infected: true, Signature: "Eicar-Test-Signature"},
+		},
+		{
+			name:    "Scans content over a unix socket",
+			network: "unix",
+			content: eicar,
+			want:    &antivirus.Result{Infected: true, Signature: "Eicar-Test-Signature"},
+		},
+		{
+			name:    "Errors when the content exceeds the clamd size limit",
+			network: "tcp",
+			maxSize: 10,
+			content: "too much content",
+			wantErr: "clamd: INSTREAM size limit exceeded.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			maxSize := tt.maxSize
+			if maxSize == 0 {
+				maxSize = 1_000_000
+			}
+
+			scanner, err := antivirus.NewClamdScanner(antivirus.Config{
+				Address: clamd(t, tt.network, maxSize),
+				Timeout: 10 * time.Second,
+			})
+			assert.NilError(t, err)
+
+			got, err := scanner.Scan(t.Context(), strings.NewReader(tt.content))
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestClamdScannerErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Errors when clamd is not available", func(t *testing.T) {
+		t.Parallel()
+
+		scanner, err := antivirus.NewClamdScanner(antivirus.Config{
+			Address: "unix://" + filepath.Join(t.TempDir(), "missing.sock"),
+		})
+		assert.NilError(t, err)
+
+		_, err = scanner.Scan(t.Context(), strings.NewReader("content"))
+		assert.ErrorContains(t, err, "clamd: dial:")
+	})
+
+	t.Run("Errors when the scan times out", func(t *testing.T) {
+		t.Parallel()
+
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NilError(t, err)
+		t.Cleanup(func() { l.Close() })
+
+		// Accept connections but never reply.
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				go func() { _, _ = io.Copy(io.Discard, conn) }()
+			}
+		}()
+
+		scanner, err := antivirus.NewClamdScanner(antivirus.Config{
+			Address: "tcp://" + l.Addr().String(),
+			Timeout: 50 * time.Millisecond,
+		})
+		assert.NilError(t, err)
+
+		_, err = scanner.Scan(t.Context(), strings.NewReader("content"))
+		assert.Error(t, err, "clamd: context deadline exceeded")
+	})
+
+	t.Run("Errors on invalid address", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := antivirus.NewClamdScanner(antivirus.Config{Address: "http://clamd:3310"})
+		assert.Error(t, err, `clamd: unsupported scheme: "http://clamd:3310"`)
+	})
+}
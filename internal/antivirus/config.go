@@ -0,0 +1,70 @@
+package antivirus
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// defaultTimeout is used when Config.Timeout is not set.
+const defaultTimeout = 5 * time.Minute
+
+type Config struct {
+	// Address is the clamd socket address, using the "tcp://host:port" or
+	// "unix:///path/to/clamd.sock" form. Scanning is disabled when empty.
+	Address string
+
+	// Timeout is the maximum time allowed to scan a single file (default: 5m).
+	Timeout time.Duration
+
+	// ScanUploads enables the scanning of SIPs that are not received from a
+	// watcher or a SIP source, e.g. SIPs uploaded via the API.
+	ScanUploads bool
+}
+
+// Enabled returns true when a clamd address is configured.
+func (c Config) Enabled() bool {
+	return c.Address != ""
+}
+
+// Validate implements config.ConfigurationValidator.
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		if c.ScanUploads {
+			return errors.New("address is required in the [antivirus] configuration when scanUploads is enabled")
+		}
+		return nil
+	}
+	if _, _, err := c.network(); err != nil {
+		return fmt.Errorf("invalid address in [antivirus] configuration: %v", err)
+	}
+	if c.Timeout < 0 {
+		return errors.New("timeout in [antivirus] configuration can't be negative")
+	}
+
+	return nil
+}
+
+// network returns the network and the address used to dial clamd.
+func (c Config) network() (string, string, error) {
+	u, err := url.Parse(c.Address)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch u.Scheme {
+	case "tcp":
+		if u.Host == "" {
+			return "", "", fmt.Errorf("missing host: %q", c.Address)
+		}
+		return "tcp", u.Host, nil
+	case "unix":
+		if u.Path == "" {
+			return "", "", fmt.Errorf("missing socket path: %q", c.Address)
+		}
+		return "unix", u.Path, nil
+	default:
+		return "", "", fmt.Errorf("unsupported scheme: %q", c.Address)
+	}
+}
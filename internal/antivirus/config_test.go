@@ -0,0 +1,63 @@
+package antivirus_test
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/antivirus"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name    string
+		cfg     antivirus.Config
+		wantErr string
+	}{
+		{
+			name: "Allows an empty config",
+		},
+		{
+			name: "Validates a TCP address",
+			cfg:  antivirus.Config{Address: "tcp://clamav:3310", Timeout: time.Minute, ScanUploads: true},
+		},
+		{
+			name: "Validates a unix socket address",
+			cfg:  antivirus.Config{Address: "unix:///run/clamav/clamd.ctl"},
+		},
+		{
+			name:    "Errors when scanUploads is set without an address",
+			cfg:     antivirus.Config{ScanUploads: true},
+			wantErr: "address is required in the [antivirus] configuration when scanUploads is enabled",
+		},
+		{
+			name:    "Errors on an unsupported scheme",
+			cfg:     antivirus.Config{Address: "clamav:3310"},
+			wantErr: `invalid address in [antivirus] configuration: unsupported scheme: "clamav:3310"`,
+		},
+		{
+			name:    "Errors on a missing host",
+			cfg:     antivirus.Config{Address: "tcp://"},
+			wantErr: `invalid address in [antivirus] configuration: missing host: "tcp://"`,
+		},
+		{
+			name:    "Errors on a negative timeout",
+			cfg:     antivirus.Config{Address: "tcp://clamav:3310", Timeout: -time.Second},
+			wantErr: "timeout in [antivirus] configuration can't be negative",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.cfg.Validate()
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
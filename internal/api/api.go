@@ -18,6 +18,7 @@ import (
 	"github.com/go-logr/logr"
 	"go.artefactual.dev/tools/middleware"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	goahttp "goa.design/goa/v3/http"
 	goamiddleware "goa.design/goa/v3/middleware"
@@ -38,6 +39,7 @@ func HTTPServer(
 	logger logr.Logger,
 	apiLogger *slog.Logger,
 	tp trace.TracerProvider,
+	mp metric.MeterProvider,
 	config *Config,
 	ingestsvc intingest.Service,
 	storagesvc intstorage.Service,
@@ -49,7 +51,7 @@ func HTTPServer(
 	mux.Use(otelhttp.NewMiddleware("api", otelhttp.WithTracerProvider(tp)))
 	mux.Use(middleware.Recover(logger))
 
-	operationInterceptors := newOperationInterceptors(logger)
+	operationInterceptors := newOperationInterceptors(logger, mp)
 
 	// Ingest service.
 	ingestEndpoints := ingest.NewEndpoints(
@@ -62,6 +64,8 @@ func HTTPServer(
 	ingestServer.DownloadSip = middleware.WriteTimeout(0)(ingestServer.DownloadSip)
 	ingestServer.UploadSip = middleware.WriteTimeout(0)(ingestServer.UploadSip)
 	ingestServer.UploadSip = middleware.ReadTimeout(0)(ingestServer.UploadSip)
+	ingestServer.UploadSipChunk = middleware.WriteTimeout(0)(ingestServer.UploadSipChunk)
+	ingestServer.UploadSipChunk = middleware.ReadTimeout(0)(ingestServer.UploadSipChunk)
 	ingestsvr.Mount(mux, ingestServer)
 
 	// Storage service.
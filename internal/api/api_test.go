@@ -82,6 +82,7 @@ func newTestAPI(t *testing.T) *testAPI {
 		logr.Discard(),
 		slog.New(slog.DiscardHandler),
 		nil,
+		nil,
 		&Config{Listen: ":0"},
 		ingestSvc,
 		storageSvc,
@@ -22,12 +22,15 @@ var BearerAuth = BearerSecurity("bearer", func() {
 	Scope(auth.IngestBatchesCreateAttr)
 	Scope(auth.IngestBatchesListAttr)
 	Scope(auth.IngestBatchesReadAttr)
+	Scope(auth.IngestBatchesRetryAttr)
 	Scope(auth.IngestBatchesReviewAttr)
+	Scope(auth.IngestSIPSCancelAttr)
 	Scope(auth.IngestSIPSCreateAttr)
 	Scope(auth.IngestSIPSDecisionAttr)
 	Scope(auth.IngestSIPSDownloadAttr)
 	Scope(auth.IngestSIPSListAttr)
 	Scope(auth.IngestSIPSReadAttr)
+	Scope(auth.IngestSIPSRetryAttr)
 	Scope(auth.IngestSIPSReviewAttr)
 	Scope(auth.IngestSIPSUploadAttr)
 	Scope(auth.IngestSIPSWorkflowsListAttr)
@@ -39,9 +42,11 @@ var BearerAuth = BearerSecurity("bearer", func() {
 	Scope(auth.StorageAIPSDeletionRequestAttr)
 	Scope(auth.StorageAIPSDeletionReviewAttr)
 	Scope(auth.StorageAIPSDownloadAttr)
+	Scope(auth.StorageAIPSLegalHoldAttr)
 	Scope(auth.StorageAIPSListAttr)
 	Scope(auth.StorageAIPSMoveAttr)
 	Scope(auth.StorageAIPSReadAttr)
+	Scope(auth.StorageAIPSRestoreAttr)
 	Scope(auth.StorageAIPSReviewAttr)
 	Scope(auth.StorageAIPSWorkflowsListAttr)
 	Scope(auth.StorageLocationsAIPSListAttr)
@@ -82,7 +87,7 @@ var _ = API("enduro", func() {
 		Consumes("application/json")
 	})
 	cors.Origin("$ENDURO_API_CORS_ORIGIN", func() {
-		cors.Methods("GET", "HEAD", "POST", "PUT", "DELETE", "OPTIONS")
-		cors.Headers("Authorization", "Content-Type")
+		cors.Methods("GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS")
+		cors.Headers("Authorization", "Content-Type", "Upload-Checksum", "Upload-Offset")
 	})
 })
@@ -47,6 +47,7 @@ var _ = Service("ingest", func() {
 			})
 			AttributeUUID("uploader_uuid", "UUID of the SIP uploader")
 			AttributeUUID("batch_uuid", "UUID of the related Batch")
+			Attribute("metadata_key", String, "Filter SIPs by the presence of a custom metadata key")
 			Attribute("limit", Int, "Limit number of results to return")
 			Attribute("offset", Int, "Offset from the beginning of the found set")
 
@@ -66,6 +67,7 @@ var _ = Service("ingest", func() {
 				Param("status")
 				Param("uploader_uuid")
 				Param("batch_uuid")
+				Param("metadata_key")
 				Param("limit")
 				Param("offset")
 			})
@@ -144,6 +146,44 @@ var _ = Service("ingest", func() {
 			Response("not_valid", StatusBadRequest)
 		})
 	})
+	Method("retry_sip", func() {
+		Description("Retry the processing of a failed SIP from its failed package")
+		BearerAuthScopes(auth.IngestSIPSRetryAttr)
+		Payload(func() {
+			AttributeUUID("uuid", "Identifier of SIP to look up")
+			BearerToken("token", String)
+			Required("uuid")
+		})
+		Error("not_found", SIPNotFound, "SIP not found")
+		Error("not_available")
+		Error("not_valid")
+		HTTP(func() {
+			POST("/sips/{uuid}/retry")
+			Response(StatusAccepted)
+			Response("not_found", StatusNotFound)
+			Response("not_available", StatusConflict)
+			Response("not_valid", StatusBadRequest)
+		})
+	})
+	Method("cancel_sip", func() {
+		Description("Cancel the processing of a queued or processing SIP")
+		BearerAuthScopes(auth.IngestSIPSCancelAttr)
+		Payload(func() {
+			AttributeUUID("uuid", "Identifier of SIP to look up")
+			BearerToken("token", String)
+			Required("uuid")
+		})
+		Error("not_found", SIPNotFound, "SIP not found")
+		Error("not_available")
+		Error("not_valid")
+		HTTP(func() {
+			POST("/sips/{uuid}/cancel")
+			Response(StatusAccepted)
+			Response("not_found", StatusNotFound)
+			Response("not_available", StatusConflict)
+			Response("not_valid", StatusBadRequest)
+		})
+	})
 	Method("show_sip_decision", func() {
 		Description("Show the active child workflow decision request for a SIP")
 		BearerAuthScopes(auth.IngestSIPSDecisionAttr)
@@ -194,6 +234,8 @@ var _ = Service("ingest", func() {
 		Payload(func() {
 			AttributeUUID("source_id", "Identifier of SIP source")
 			Attribute("key", String, "Key of the item to ingest")
+			Attribute("processing_profile", String, "Name of the processing profile to use for the SIP")
+			AttributeUUID("location_id", "Identifier of the storage location of the AIP")
 			BearerToken("token", String)
 			Required("source_id", "key")
 		})
@@ -256,6 +298,79 @@ var _ = Service("ingest", func() {
 			Response("internal_error", StatusInternalServerError)
 		})
 	})
+	Method("create_sip_upload", func() {
+		Description("Start a resumable SIP upload")
+		BearerAuthScopes(auth.IngestSIPSUploadAttr)
+		Payload(func() {
+			Attribute("name", String, "File name of the SIP")
+			Attribute("size", Int64, "Size of the SIP in bytes")
+			Attribute("checksum", String, "Expected checksum of the SIP, e.g. \"sha256:9f86d0...\"")
+			Attribute("processing_profile", String, "Name of the processing profile to use for the SIP")
+			AttributeUUID("location_id", "Identifier of the storage location of the AIP")
+			BearerToken("token", String)
+			Required("name", "size")
+		})
+		Result(SIPUpload)
+		Error("not_valid")
+		Error("internal_error")
+		HTTP(func() {
+			POST("/sips/uploads")
+			Response(StatusCreated)
+			Response("not_valid", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+	Method("show_sip_upload", func() {
+		Description("Show the progress of a resumable SIP upload")
+		BearerAuthScopes(auth.IngestSIPSUploadAttr)
+		Payload(func() {
+			AttributeUUID("uuid", "Identifier of the upload")
+			BearerToken("token", String)
+			Required("uuid")
+		})
+		Result(SIPUpload)
+		Error("not_found")
+		Error("not_valid")
+		Error("internal_error")
+		HTTP(func() {
+			GET("/sips/uploads/{uuid}")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("not_valid", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+	Method("upload_sip_chunk", func() {
+		Description("Upload the next chunk of a resumable SIP upload")
+		BearerAuthScopes(auth.IngestSIPSUploadAttr)
+		Payload(func() {
+			AttributeUUID("uuid", "Identifier of the upload")
+			Attribute("offset", Int64, "Offset of the chunk in the SIP, must match the upload offset")
+			Attribute("checksum", String, "Optional checksum of the chunk, e.g. \"sha256:9f86d0...\"")
+			BearerToken("token", String)
+			Required("uuid", "offset")
+		})
+		Result(SIPUpload)
+		Error("not_found")
+		Error("not_valid")
+		Error("not_available")
+		Error("internal_error")
+		HTTP(func() {
+			PATCH("/sips/uploads/{uuid}")
+			Header("offset:Upload-Offset")
+			Header("checksum:Upload-Checksum")
+
+			// Bypass request body decoder code generation so the chunk is
+			// streamed to the internal bucket.
+			SkipRequestBodyEncodeDecode()
+
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("not_valid", StatusBadRequest)
+			Response("not_available", StatusConflict)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
 	Method("download_sip_request", func() {
 		Description("Request access to SIP download")
 		BearerAuthScopes(auth.IngestSIPSDownloadAttr)
@@ -386,8 +501,22 @@ var _ = Service("ingest", func() {
 			AttributeUUID("source_id", "Identifier of SIP source -- CURRENTLY NOT USED")
 			Attribute("keys", ArrayOf(String), "Key of the SIPs to ingest as part of the batch")
 			Attribute("identifier", String, "Optional Batch identifier assigned by the user")
+			Attribute(
+				"processing_profile",
+				String,
+				"Name of the processing profile to use for the SIPs of the Batch",
+			)
+			Attribute(
+				"manifest",
+				String,
+				"Manifest listing the SIPs to ingest as part of the batch and their metadata, instead of keys",
+			)
+			Attribute("manifest_format", String, "Format of the manifest, defaults to csv", func() {
+				Enum("csv", "json")
+			})
+			AttributeUUID("location_id", "Identifier of the storage location of the AIPs of the Batch")
 			BearerToken("token", String)
-			Required("source_id", "keys")
+			Required("source_id")
 		})
 		Result(func() {
 			AttributeUUID("uuid", "Identifier of the ingested Batch")
@@ -481,6 +610,85 @@ var _ = Service("ingest", func() {
 			Response("internal_error", StatusInternalServerError)
 		})
 	})
+	Method("retry_batch", func() {
+		Description("Retry the processing of the failed SIPs of a Batch")
+		BearerAuthScopes(auth.IngestBatchesRetryAttr)
+		Payload(func() {
+			AttributeUUID("uuid", "Identifier of Batch to retry")
+			BearerToken("token", String)
+			Required("uuid")
+		})
+		Error("not_found", BatchNotFound, "Batch not found")
+		Error("not_available")
+		Error("not_valid")
+		HTTP(func() {
+			POST("/batches/{uuid}/retry")
+			Response(StatusAccepted)
+			Response("not_found", StatusNotFound)
+			Response("not_available", StatusConflict)
+			Response("not_valid", StatusBadRequest)
+		})
+	})
+	Method("create_api_token", func() {
+		Description("Create an API token for the current user")
+		BearerAuthScopes(auth.IngestAPITokensCreateAttr)
+		Payload(func() {
+			Attribute("name", String, "Name of the API token")
+			Attribute(
+				"attributes",
+				ArrayOf(String),
+				"Access control attributes granted to the API token, defaults to the attributes of the user",
+			)
+			Attribute("expires_at", String, "Expiration datetime of the API token", func() {
+				Format(FormatDateTime)
+			})
+			BearerToken("token", String)
+			Required("name", "expires_at")
+		})
+		Result(APIToken)
+		Error("not_valid")
+		Error("internal_error")
+		HTTP(func() {
+			POST("/api-tokens")
+			Response(StatusCreated)
+			Response("not_valid", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+	Method("list_api_tokens", func() {
+		Description("List the API tokens of the current user")
+		BearerAuthScopes(auth.IngestAPITokensListAttr)
+		Payload(func() {
+			BearerToken("token", String)
+		})
+		Result(APITokens)
+		Error("internal_error")
+		HTTP(func() {
+			GET("/api-tokens")
+			Response(StatusOK)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+	Method("revoke_api_token", func() {
+		Description("Revoke an API token of the current user")
+		BearerAuthScopes(auth.IngestAPITokensRevokeAttr)
+		Payload(func() {
+			AttributeUUID("uuid", "Identifier of the API token")
+			BearerToken("token", String)
+			Required("uuid")
+		})
+		Error("not_found")
+		Error("not_valid")
+		Error("internal_error")
+		HTTP(func() {
+			POST("/api-tokens/{uuid}/revoke")
+			Response(StatusNoContent)
+			Response("not_found", StatusNotFound)
+			Response("not_valid", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+	SearchMethod("Search SIPs by name, batch identifier and custom metadata", auth.IngestSIPSListAttr)
 })
 
 var EnumSIPStatus = func() {
@@ -523,6 +731,7 @@ var SIP = ResultType("application/vnd.enduro.ingest.sip", func() {
 			EnumBatchStatus()
 		})
 		Attribute("file_count", Int32, "Number of files in the SIP")
+		Attribute("custom_metadata", MapOf(String, Any), "Custom metadata returned by the child workflows")
 	})
 	Required("uuid", "status", "created_at")
 })
@@ -544,6 +753,46 @@ var SIPNotFound = Type("SIPNotFound", func() {
 	Required("message", "uuid")
 })
 
+var SIPUpload = Type("SIPUpload", func() {
+	Description("SIPUpload describes a resumable SIP upload.")
+	AttributeUUID("uuid", "Identifier of the upload")
+	Attribute("name", String, "File name of the SIP")
+	Attribute("size", Int64, "Size of the SIP in bytes")
+	Attribute("offset", Int64, "Number of bytes received")
+	Attribute("expires_at", String, "Time after which the upload is discarded", func() {
+		Format(FormatDateTime)
+	})
+	AttributeUUID("sip_uuid", "Identifier of the SIP, set when the upload is complete")
+	Required("uuid", "name", "size", "offset", "expires_at")
+})
+
+var APIToken = Type("APIToken", func() {
+	Description("APIToken describes an API token.")
+	AttributeUUID("uuid", "Identifier of the API token")
+	Attribute("name", String, "Name of the API token")
+	Attribute("attributes", ArrayOf(String), "Access control attributes granted to the API token")
+	Attribute("created_at", String, "Creation datetime", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("expires_at", String, "Expiration datetime", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("last_used_at", String, "Datetime of the last use of the API token", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("revoked_at", String, "Revocation datetime", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("token", String, "Secret of the API token, only returned when the API token is created")
+	Required("uuid", "name", "created_at")
+})
+
+var APITokens = Type("APITokens", func() {
+	Description("APITokens describes a list of API tokens.")
+	Attribute("items", ArrayOf(APIToken))
+	Required("items")
+})
+
 var SIPWorkflows = ResultType("application/vnd.enduro.ingest.sip.workflows", func() {
 	Description("SIPWorkflows describes the workflows of a SIP.")
 	TypeName("SIPWorkflows")
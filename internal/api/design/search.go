@@ -0,0 +1,48 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl" //nolint:staticcheck
+)
+
+var SearchResult = Type("SearchResult", func() {
+	Description("SearchResult describes a package matching a search query.")
+	TypedAttributeUUID("uuid", "Identifier of the package")
+	Attribute("name", String, "Name of the package")
+	Attribute("fields", MapOf(String, String), "Indexed metadata of the package, keyed by field name")
+	Required("uuid", "name")
+})
+
+var SearchResults = Type("SearchResults", func() {
+	Description("SearchResults describes a page of search results.")
+	Attribute("items", ArrayOf(SearchResult))
+	Attribute("page", Page)
+	Required("items", "page")
+})
+
+// SearchMethod declares the search method of a service. The desc describes the
+// packages found and scope is the scope required to call the method.
+func SearchMethod(desc, scope string) {
+	Method("search", func() {
+		Description(desc)
+		BearerAuthScopes(scope)
+		Payload(func() {
+			Attribute("query", String, "Search terms, all of them must match")
+			Attribute("limit", Int, "Limit number of results to return")
+			Attribute("offset", Int, "Offset from the beginning of the found set")
+			BearerToken("token", String)
+			Required("query")
+		})
+		Result(SearchResults)
+		Error("not_valid")
+		HTTP(func() {
+			GET("/search")
+			Response(StatusOK)
+			Response("not_valid", StatusBadRequest)
+			Params(func() {
+				Param("query")
+				Param("limit")
+				Param("offset")
+			})
+		})
+	})
+}
@@ -45,6 +45,7 @@ var _ = Service("storage", func() {
 			Attribute("status", String, func() {
 				EnumAIPStatus()
 			})
+			Attribute("metadata_key", String, "Filter AIPs by the presence of a custom metadata key")
 			Attribute("limit", Int, "Limit number of results to return")
 			Attribute("offset", Int, "Offset from the beginning of the found set")
 
@@ -63,6 +64,7 @@ var _ = Service("storage", func() {
 				Param("earliest_created_time")
 				Param("latest_created_time")
 				Param("status")
+				Param("metadata_key")
 				Param("limit")
 				Param("offset")
 			})
@@ -80,6 +82,8 @@ var _ = Service("storage", func() {
 				Default("unspecified")
 			})
 			TypedAttributeUUID("location_uuid", "Identifier of the AIP's storage location")
+			Attribute("metadata", MapOf(String, String), "Descriptive metadata of the AIP, e.g. Dublin Core fields from its METS file")
+			Attribute("custom_metadata", MapOf(String, Any), "Custom metadata of the AIP, copied from its SIP")
 			BearerToken("token", String)
 			Required("uuid", "name", "object_key")
 		})
@@ -189,6 +193,31 @@ var _ = Service("storage", func() {
 			Response("failed_dependency", StatusFailedDependency)
 		})
 	})
+	Method("restore_aips", func() {
+		Description("Restore AIPs to a restore location")
+		BearerAuthScopes(auth.StorageAIPSRestoreAttr)
+		Payload(func() {
+			Attribute("aip_uuids", ArrayOf(String, func() {
+				Format(FormatUUID)
+			}), "Identifiers of the AIPs to restore", func() {
+				MinLength(1)
+			})
+			TypedAttributeUUID("location_uuid", "Identifier of the restore location")
+			Attribute("unpack", Boolean, "Extract the AIP files in the restore location")
+			BearerToken("token", String)
+			Required("aip_uuids")
+		})
+		Error("not_found", AIPNotFound, "AIP not found")
+		Error("not_available")
+		Error("not_valid")
+		HTTP(func() {
+			POST("/aips/restore")
+			Response(StatusAccepted)
+			Response("not_found", StatusNotFound)
+			Response("not_available", StatusConflict)
+			Response("not_valid", StatusBadRequest)
+		})
+	})
 	Method("reject_aip", func() {
 		Description("Reject an AIP")
 		BearerAuthScopes(auth.StorageAIPSReviewAttr)
@@ -291,6 +320,46 @@ var _ = Service("storage", func() {
 			Response("internal_error", StatusInternalServerError)
 		})
 	})
+	Method("place_aip_legal_hold", func() {
+		Description("Place a legal hold on an AIP to prevent its deletion and moves")
+		BearerAuthScopes(auth.StorageAIPSLegalHoldAttr)
+		Payload(func() {
+			AttributeUUID("uuid", "Identifier of AIP")
+			BearerToken("token", String)
+			Attribute("reason", String)
+			Required("uuid", "reason")
+		})
+		Error("not_found", AIPNotFound, "AIP not found")
+		Error("not_valid")
+		Error("internal_error")
+		HTTP(func() {
+			POST("/aips/{uuid}/legal-hold")
+			Response(StatusCreated)
+			Response("not_found", StatusNotFound)
+			Response("not_valid", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+	Method("release_aip_legal_hold", func() {
+		Description("Release the active legal hold of an AIP")
+		BearerAuthScopes(auth.StorageAIPSLegalHoldAttr)
+		Payload(func() {
+			AttributeUUID("uuid", "Identifier of AIP")
+			BearerToken("token", String)
+			Attribute("reason", String)
+			Required("uuid", "reason")
+		})
+		Error("not_found", AIPNotFound, "AIP not found")
+		Error("not_valid")
+		Error("internal_error")
+		HTTP(func() {
+			POST("/aips/{uuid}/legal-hold-release")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("not_valid", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
 	Method("review_aip_deletion", func() {
 		Description("Review an AIP deletion request")
 		BearerAuthScopes(auth.StorageAIPSDeletionReviewAttr)
@@ -421,10 +490,14 @@ var _ = Service("storage", func() {
 			})
 			OneOf("config", func() {
 				Attribute("amss", AMSSConfig)
+				Attribute("filesystem", FilesystemConfig)
 				Attribute("s3", S3Config)
 				Attribute("sftp", SFTPConfig)
 				Attribute("url", URLConfig)
 			})
+			Attribute("replication_targets", ArrayOf(String, func() {
+				Format(FormatUUID)
+			}), "Identifiers of the locations where the AIPs stored in this location are replicated")
 			BearerToken("token", String)
 			Required("name", "source", "purpose")
 		})
@@ -472,6 +545,24 @@ var _ = Service("storage", func() {
 			Response("not_valid", StatusBadRequest)
 		})
 	})
+	Method("location_usage", func() {
+		Description("Show the storage usage of the location with UUID")
+		BearerAuthScopes(auth.StorageLocationsReadAttr)
+		Payload(func() {
+			// TODO: explore how we can use uuid.UUID that are also URL params.
+			AttributeUUID("uuid", "Identifier of location")
+			BearerToken("token", String)
+			Required("uuid")
+		})
+		Result(LocationUsage)
+		Error("not_found", LocationNotFound, "Storage location not found")
+		HTTP(func() {
+			GET("/locations/{uuid}/usage")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+	SearchMethod("Search AIPs by name and descriptive metadata", auth.StorageAIPSListAttr)
 })
 
 var AIPNotFound = Type("AIPNotFound", func() {
@@ -517,6 +608,7 @@ var Location = ResultType("application/vnd.enduro.storage.location", func() {
 		})
 		OneOf("config", func() {
 			Attribute("amss", AMSSConfig)
+			Attribute("filesystem", FilesystemConfig)
 			Attribute("s3", S3Config)
 			Attribute("sftp", SFTPConfig)
 			Attribute("url", URLConfig)
@@ -524,6 +616,9 @@ var Location = ResultType("application/vnd.enduro.storage.location", func() {
 		Attribute("created_at", String, "Creation datetime", func() {
 			Format(FormatDateTime)
 		})
+		Attribute("replication_targets", ArrayOf(String, func() {
+			Format(FormatUUID)
+		}), "Identifiers of the locations where the AIPs stored in this location are replicated")
 	})
 	View("default", func() {
 		Attribute("name")
@@ -532,6 +627,7 @@ var Location = ResultType("application/vnd.enduro.storage.location", func() {
 		Attribute("purpose")
 		Attribute("uuid")
 		Attribute("created_at")
+		Attribute("replication_targets")
 	})
 	Required("name", "source", "purpose", "uuid", "created_at")
 })
@@ -554,6 +650,16 @@ var MoveStatusResult = Type("MoveStatusResult", func() {
 	Required("done")
 })
 
+var LocationUsage = Type("LocationUsage", func() {
+	Description("Storage usage of the AIPs in a location, excluding deleted AIPs.")
+	Attribute("aip_count", Int, "Number of AIPs in the location")
+	Attribute("size", Int64, "Total size of the AIPs in bytes")
+	Attribute("file_count", Int64, "Total number of files in the AIPs")
+	Attribute("quota", Int64, "Maximum total size of the AIPs in bytes, if configured")
+	Attribute("quota_exceeded", Boolean, "Whether the quota has been reached and new AIPs are rejected")
+	Required("aip_count", "size", "file_count", "quota_exceeded")
+})
+
 var AIP = ResultType("application/vnd.enduro.storage.aip", func() {
 	Description("An AIP describes an AIP retrieved by the storage service.")
 	TypeName("AIP")
@@ -574,10 +680,59 @@ var AIP = ResultType("application/vnd.enduro.storage.aip", func() {
 			Format(FormatDateTime)
 		})
 		Attribute("deletion_report_key", String, "Deletion report key")
+		Attribute("disposal_at", String, "Disposal date of the AIP, set by its retention policy", func() {
+			Format(FormatDateTime)
+		})
+		Attribute("size", Int64, "Size of the AIP in bytes")
+		Attribute("file_count", Int, "Number of files in the AIP")
+		Attribute("replicas", CollectionOf(AIPReplica), "Replicas of the AIP in replication locations")
+		Attribute("legal_holds", CollectionOf(AIPLegalHold), "Legal holds placed on the AIP")
+		Attribute("custom_metadata", MapOf(String, Any), "Custom metadata copied from the SIP")
 	})
 	Required("name", "uuid", "status", "object_key", "created_at")
 })
 
+var EnumAIPReplicaStatus = func() {
+	Enum(enums.ReplicaStatusInterfaces()...)
+}
+
+var AIPReplica = ResultType("application/vnd.enduro.storage.aip.replica", func() {
+	Description("AIPReplica describes a copy of an AIP in a replication location.")
+	TypeName("AIPReplica")
+	Attributes(func() {
+		TypedAttributeUUID("location_uuid", "Identifier of replication location")
+		Attribute("status", String, "Status of the replica", func() {
+			EnumAIPReplicaStatus()
+		})
+		Attribute("created_at", String, "Creation datetime", func() {
+			Format(FormatDateTime)
+		})
+		Attribute("replicated_at", String, "Replication datetime", func() {
+			Format(FormatDateTime)
+		})
+	})
+	Required("location_uuid", "status", "created_at")
+})
+
+var AIPLegalHold = ResultType("application/vnd.enduro.storage.aip.legal-hold", func() {
+	Description("AIPLegalHold describes a legal hold placed on an AIP to prevent its deletion and moves.")
+	TypeName("AIPLegalHold")
+	Attributes(func() {
+		TypedAttributeUUID("uuid", "Identifier of the legal hold")
+		Attribute("reason", String, "Reason of the legal hold")
+		Attribute("placed_by", String, "User who placed the legal hold")
+		Attribute("placed_at", String, "Placement datetime", func() {
+			Format(FormatDateTime)
+		})
+		Attribute("released_by", String, "User who released the legal hold")
+		Attribute("release_reason", String, "Reason of the legal hold release")
+		Attribute("released_at", String, "Release datetime", func() {
+			Format(FormatDateTime)
+		})
+	})
+	Required("uuid", "reason", "placed_by", "placed_at")
+})
+
 var AIPs = ResultType("application/vnd.enduro.storage.aips", func() {
 	TypeName("AIPs")
 	Attribute("items", CollectionOf(AIP))
@@ -589,6 +744,14 @@ var EnumAIPStatus = func() {
 	Enum(enums.AIPStatusInterfaces()...)
 }
 
+var EnumDeletionRequestStatus = func() {
+	Enum(enums.DeletionRequestStatusInterfaces()...)
+}
+
+var EnumFixityCheckStatus = func() {
+	Enum(enums.FixityCheckStatusInterfaces()...)
+}
+
 var AMSSConfig = Type("AMSSConfig", func() {
 	ConvertTo(types.AMSSConfig{})
 
@@ -599,6 +762,12 @@ var AMSSConfig = Type("AMSSConfig", func() {
 	Required("api_key", "url", "username")
 })
 
+var FilesystemConfig = Type("FilesystemConfig", func() {
+	ConvertTo(types.FilesystemConfig{})
+	Attribute("path", String)
+	Required("path")
+})
+
 var S3Config = Type("S3Config", func() {
 	ConvertTo(types.S3Config{})
 
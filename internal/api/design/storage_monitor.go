@@ -20,6 +20,9 @@ var StorageEvent = Type("StorageEvent", func() {
 		Attribute("aip_workflow_updated_event", AIPWorkflowUpdatedEvent)
 		Attribute("aip_task_created_event", AIPTaskCreatedEvent)
 		Attribute("aip_task_updated_event", AIPTaskUpdatedEvent)
+		Attribute("aip_deletion_request_created_event", AIPDeletionRequestCreatedEvent)
+		Attribute("aip_deletion_request_updated_event", AIPDeletionRequestUpdatedEvent)
+		Attribute("aip_fixity_checked_event", AIPFixityCheckedEvent)
 	})
 })
 
@@ -80,3 +83,34 @@ var AIPTaskUpdatedEvent = Type("AIPTaskUpdatedEvent", func() {
 	Attribute("item", AIPTask)
 	Required("uuid", "item")
 })
+
+var AIPDeletionRequestCreatedEvent = Type("AIPDeletionRequestCreatedEvent", func() {
+	AIPDeletionRequestEventAttributes()
+})
+
+var AIPDeletionRequestUpdatedEvent = Type("AIPDeletionRequestUpdatedEvent", func() {
+	AIPDeletionRequestEventAttributes()
+})
+
+var AIPDeletionRequestEventAttributes = func() {
+	TypedAttributeUUID("uuid", "Identifier of deletion request")
+	TypedAttributeUUID("aip_uuid", "Identifier of AIP")
+	Attribute("status", String, "Status of the deletion request", func() { EnumDeletionRequestStatus() })
+	Attribute("reason", String, "Reason for the deletion")
+	Attribute("requester", String, "User who requested the deletion")
+	Attribute("reviewer", String, "User who reviewed the deletion request")
+	Required("uuid", "aip_uuid", "status", "reason", "requester")
+}
+
+var AIPFixityCheckedEvent = Type("AIPFixityCheckedEvent", func() {
+	TypedAttributeUUID("uuid", "Identifier of fixity check")
+	TypedAttributeUUID("aip_uuid", "Identifier of AIP")
+	Attribute("status", String, "Result of the fixity check", func() { EnumFixityCheckStatus() })
+	Attribute("checksum_algorithm", String, "Algorithm of the checksums")
+	Attribute("expected_checksum", String, "Checksum of the AIP stored in Enduro")
+	Attribute("actual_checksum", String, "Checksum computed from the stored AIP")
+	Attribute("checked_at", String, "Time the fixity check was completed", func() {
+		Format(FormatDateTime)
+	})
+	Required("uuid", "aip_uuid", "status", "checksum_algorithm", "expected_checksum", "actual_checksum", "checked_at")
+})
@@ -27,8 +27,8 @@ import (
 func UsageCommands() []string {
 	return []string{
 		"about about",
-		"ingest (monitor|list-sips|show-sip|list-sip-workflows|confirm-sip|reject-sip|show-sip-decision|submit-sip-decision|add-sip|upload-sip|download-sip-request|download-sip|list-users|list-sip-source-objects|add-batch|list-batches|show-batch|review-batch)",
-		"storage (monitor|list-aips|create-aip|download-aip-request|download-aip|move-aip|move-aip-status|reject-aip|show-aip|list-aip-workflows|aip-deletion-auto|request-aip-deletion|review-aip-deletion|cancel-aip-deletion|aip-deletion-report-request|aip-deletion-report|list-locations|create-location|show-location|list-location-aips)",
+		"ingest (monitor|list-sips|show-sip|list-sip-workflows|confirm-sip|reject-sip|retry-sip|cancel-sip|show-sip-decision|submit-sip-decision|add-sip|upload-sip|create-sip-upload|show-sip-upload|upload-sip-chunk|download-sip-request|download-sip|list-users|list-sip-source-objects|add-batch|list-batches|show-batch|review-batch|retry-batch|create-api-token|list-api-tokens|revoke-api-token|search)",
+		"storage (monitor|list-aips|create-aip|download-aip-request|download-aip|move-aip|move-aip-status|restore-aips|reject-aip|show-aip|list-aip-workflows|aip-deletion-auto|request-aip-deletion|place-aip-legal-hold|release-aip-legal-hold|review-aip-deletion|cancel-aip-deletion|aip-deletion-report-request|aip-deletion-report|list-locations|create-location|show-location|list-location-aips|location-usage|search)",
 	}
 }
 
@@ -68,6 +68,7 @@ func ParseEndpoint(
 		ingestListSipsStatusFlag              = ingestListSipsFlags.String("status", "", "")
 		ingestListSipsUploaderUUIDFlag        = ingestListSipsFlags.String("uploader-uuid", "", "")
 		ingestListSipsBatchUUIDFlag           = ingestListSipsFlags.String("batch-uuid", "", "")
+		ingestListSipsMetadataKeyFlag         = ingestListSipsFlags.String("metadata-key", "", "")
 		ingestListSipsLimitFlag               = ingestListSipsFlags.String("limit", "", "")
 		ingestListSipsOffsetFlag              = ingestListSipsFlags.String("offset", "", "")
 		ingestListSipsTokenFlag               = ingestListSipsFlags.String("token", "", "")
@@ -86,8 +87,14 @@ func ParseEndpoint(
 		ingestConfirmSipTokenFlag = ingestConfirmSipFlags.String("token", "", "")
 
 		ingestRejectSipFlags     = flag.NewFlagSet("reject-sip", flag.ExitOnError)
+		ingestRetrySipFlags      = flag.NewFlagSet("retry-sip", flag.ExitOnError)
+		ingestCancelSipFlags     = flag.NewFlagSet("cancel-sip", flag.ExitOnError)
 		ingestRejectSipUUIDFlag  = ingestRejectSipFlags.String("uuid", "REQUIRED", "Identifier of SIP to look up")
+		ingestRetrySipUUIDFlag   = ingestRetrySipFlags.String("uuid", "REQUIRED", "Identifier of SIP to look up")
+		ingestCancelSipUUIDFlag  = ingestCancelSipFlags.String("uuid", "REQUIRED", "Identifier of SIP to look up")
 		ingestRejectSipTokenFlag = ingestRejectSipFlags.String("token", "", "")
+		ingestRetrySipTokenFlag  = ingestRetrySipFlags.String("token", "", "")
+		ingestCancelSipTokenFlag = ingestCancelSipFlags.String("token", "", "")
 
 		ingestShowSipDecisionFlags     = flag.NewFlagSet("show-sip-decision", flag.ExitOnError)
 		ingestShowSipDecisionUUIDFlag  = ingestShowSipDecisionFlags.String("uuid", "REQUIRED", "Identifier of SIP to look up")
@@ -107,6 +114,21 @@ func ParseEndpoint(
 		ingestUploadSipTokenFlag       = ingestUploadSipFlags.String("token", "", "")
 		ingestUploadSipStreamFlag      = ingestUploadSipFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
 
+		ingestCreateSipUploadFlags     = flag.NewFlagSet("create-sip-upload", flag.ExitOnError)
+		ingestCreateSipUploadBodyFlag  = ingestCreateSipUploadFlags.String("body", "REQUIRED", "")
+		ingestCreateSipUploadTokenFlag = ingestCreateSipUploadFlags.String("token", "", "")
+
+		ingestShowSipUploadFlags     = flag.NewFlagSet("show-sip-upload", flag.ExitOnError)
+		ingestShowSipUploadUUIDFlag  = ingestShowSipUploadFlags.String("uuid", "REQUIRED", "Identifier of the upload")
+		ingestShowSipUploadTokenFlag = ingestShowSipUploadFlags.String("token", "", "")
+
+		ingestUploadSipChunkFlags        = flag.NewFlagSet("upload-sip-chunk", flag.ExitOnError)
+		ingestUploadSipChunkUUIDFlag     = ingestUploadSipChunkFlags.String("uuid", "REQUIRED", "Identifier of the upload")
+		ingestUploadSipChunkOffsetFlag   = ingestUploadSipChunkFlags.String("offset", "REQUIRED", "")
+		ingestUploadSipChunkChecksumFlag = ingestUploadSipChunkFlags.String("checksum", "", "")
+		ingestUploadSipChunkTokenFlag    = ingestUploadSipChunkFlags.String("token", "", "")
+		ingestUploadSipChunkStreamFlag   = ingestUploadSipChunkFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
 		ingestDownloadSipRequestFlags     = flag.NewFlagSet("download-sip-request", flag.ExitOnError)
 		ingestDownloadSipRequestUUIDFlag  = ingestDownloadSipRequestFlags.String("uuid", "REQUIRED", "Identifier of the SIP to download")
 		ingestDownloadSipRequestTokenFlag = ingestDownloadSipRequestFlags.String("token", "", "")
@@ -151,6 +173,27 @@ func ParseEndpoint(
 		ingestReviewBatchUUIDFlag  = ingestReviewBatchFlags.String("uuid", "REQUIRED", "Identifier of Batch to review")
 		ingestReviewBatchTokenFlag = ingestReviewBatchFlags.String("token", "", "")
 
+		ingestRetryBatchFlags     = flag.NewFlagSet("retry-batch", flag.ExitOnError)
+		ingestRetryBatchUUIDFlag  = ingestRetryBatchFlags.String("uuid", "REQUIRED", "Identifier of Batch to retry")
+		ingestRetryBatchTokenFlag = ingestRetryBatchFlags.String("token", "", "")
+
+		ingestCreateAPITokenFlags     = flag.NewFlagSet("create-api-token", flag.ExitOnError)
+		ingestCreateAPITokenBodyFlag  = ingestCreateAPITokenFlags.String("body", "REQUIRED", "")
+		ingestCreateAPITokenTokenFlag = ingestCreateAPITokenFlags.String("token", "", "")
+
+		ingestListAPITokensFlags     = flag.NewFlagSet("list-api-tokens", flag.ExitOnError)
+		ingestListAPITokensTokenFlag = ingestListAPITokensFlags.String("token", "", "")
+
+		ingestRevokeAPITokenFlags     = flag.NewFlagSet("revoke-api-token", flag.ExitOnError)
+		ingestRevokeAPITokenUUIDFlag  = ingestRevokeAPITokenFlags.String("uuid", "REQUIRED", "Identifier of the API token")
+		ingestRevokeAPITokenTokenFlag = ingestRevokeAPITokenFlags.String("token", "", "")
+
+		ingestSearchFlags      = flag.NewFlagSet("search", flag.ExitOnError)
+		ingestSearchQueryFlag  = ingestSearchFlags.String("query", "REQUIRED", "Search terms, all of them must match")
+		ingestSearchLimitFlag  = ingestSearchFlags.String("limit", "", "Limit number of results to return")
+		ingestSearchOffsetFlag = ingestSearchFlags.String("offset", "", "Offset from the beginning of the found set")
+		ingestSearchTokenFlag  = ingestSearchFlags.String("token", "", "")
+
 		storageFlags = flag.NewFlagSet("storage", flag.ContinueOnError)
 
 		storageMonitorFlags     = flag.NewFlagSet("monitor", flag.ExitOnError)
@@ -161,6 +204,7 @@ func ParseEndpoint(
 		storageListAipsEarliestCreatedTimeFlag = storageListAipsFlags.String("earliest-created-time", "", "")
 		storageListAipsLatestCreatedTimeFlag   = storageListAipsFlags.String("latest-created-time", "", "")
 		storageListAipsStatusFlag              = storageListAipsFlags.String("status", "", "")
+		storageListAipsMetadataKeyFlag         = storageListAipsFlags.String("metadata-key", "", "")
 		storageListAipsLimitFlag               = storageListAipsFlags.String("limit", "", "")
 		storageListAipsOffsetFlag              = storageListAipsFlags.String("offset", "", "")
 		storageListAipsTokenFlag               = storageListAipsFlags.String("token", "", "")
@@ -186,6 +230,10 @@ func ParseEndpoint(
 		storageMoveAipStatusUUIDFlag  = storageMoveAipStatusFlags.String("uuid", "REQUIRED", "Identifier of AIP")
 		storageMoveAipStatusTokenFlag = storageMoveAipStatusFlags.String("token", "", "")
 
+		storageRestoreAipsFlags     = flag.NewFlagSet("restore-aips", flag.ExitOnError)
+		storageRestoreAipsBodyFlag  = storageRestoreAipsFlags.String("body", "REQUIRED", "")
+		storageRestoreAipsTokenFlag = storageRestoreAipsFlags.String("token", "", "")
+
 		storageRejectAipFlags     = flag.NewFlagSet("reject-aip", flag.ExitOnError)
 		storageRejectAipUUIDFlag  = storageRejectAipFlags.String("uuid", "REQUIRED", "Identifier of AIP")
 		storageRejectAipTokenFlag = storageRejectAipFlags.String("token", "", "")
@@ -210,6 +258,16 @@ func ParseEndpoint(
 		storageRequestAipDeletionUUIDFlag  = storageRequestAipDeletionFlags.String("uuid", "REQUIRED", "Identifier of AIP")
 		storageRequestAipDeletionTokenFlag = storageRequestAipDeletionFlags.String("token", "", "")
 
+		storagePlaceAipLegalHoldFlags     = flag.NewFlagSet("place-aip-legal-hold", flag.ExitOnError)
+		storagePlaceAipLegalHoldBodyFlag  = storagePlaceAipLegalHoldFlags.String("body", "REQUIRED", "")
+		storagePlaceAipLegalHoldUUIDFlag  = storagePlaceAipLegalHoldFlags.String("uuid", "REQUIRED", "Identifier of AIP")
+		storagePlaceAipLegalHoldTokenFlag = storagePlaceAipLegalHoldFlags.String("token", "", "")
+
+		storageReleaseAipLegalHoldFlags     = flag.NewFlagSet("release-aip-legal-hold", flag.ExitOnError)
+		storageReleaseAipLegalHoldBodyFlag  = storageReleaseAipLegalHoldFlags.String("body", "REQUIRED", "")
+		storageReleaseAipLegalHoldUUIDFlag  = storageReleaseAipLegalHoldFlags.String("uuid", "REQUIRED", "Identifier of AIP")
+		storageReleaseAipLegalHoldTokenFlag = storageReleaseAipLegalHoldFlags.String("token", "", "")
+
 		storageReviewAipDeletionFlags     = flag.NewFlagSet("review-aip-deletion", flag.ExitOnError)
 		storageReviewAipDeletionBodyFlag  = storageReviewAipDeletionFlags.String("body", "REQUIRED", "")
 		storageReviewAipDeletionUUIDFlag  = storageReviewAipDeletionFlags.String("uuid", "REQUIRED", "Identifier of AIP")
@@ -242,6 +300,16 @@ func ParseEndpoint(
 		storageListLocationAipsFlags     = flag.NewFlagSet("list-location-aips", flag.ExitOnError)
 		storageListLocationAipsUUIDFlag  = storageListLocationAipsFlags.String("uuid", "REQUIRED", "Identifier of location")
 		storageListLocationAipsTokenFlag = storageListLocationAipsFlags.String("token", "", "")
+
+		storageLocationUsageFlags     = flag.NewFlagSet("location-usage", flag.ExitOnError)
+		storageLocationUsageUUIDFlag  = storageLocationUsageFlags.String("uuid", "REQUIRED", "Identifier of location")
+		storageLocationUsageTokenFlag = storageLocationUsageFlags.String("token", "", "")
+
+		storageSearchFlags      = flag.NewFlagSet("search", flag.ExitOnError)
+		storageSearchQueryFlag  = storageSearchFlags.String("query", "REQUIRED", "Search terms, all of them must match")
+		storageSearchLimitFlag  = storageSearchFlags.String("limit", "", "Limit number of results to return")
+		storageSearchOffsetFlag = storageSearchFlags.String("offset", "", "Offset from the beginning of the found set")
+		storageSearchTokenFlag  = storageSearchFlags.String("token", "", "")
 	)
 	aboutFlags.Usage = aboutUsage
 	aboutAboutFlags.Usage = aboutAboutUsage
@@ -253,10 +321,15 @@ func ParseEndpoint(
 	ingestListSipWorkflowsFlags.Usage = ingestListSipWorkflowsUsage
 	ingestConfirmSipFlags.Usage = ingestConfirmSipUsage
 	ingestRejectSipFlags.Usage = ingestRejectSipUsage
+	ingestRetrySipFlags.Usage = ingestRetrySipUsage
+	ingestCancelSipFlags.Usage = ingestCancelSipUsage
 	ingestShowSipDecisionFlags.Usage = ingestShowSipDecisionUsage
 	ingestSubmitSipDecisionFlags.Usage = ingestSubmitSipDecisionUsage
 	ingestAddSipFlags.Usage = ingestAddSipUsage
 	ingestUploadSipFlags.Usage = ingestUploadSipUsage
+	ingestCreateSipUploadFlags.Usage = ingestCreateSipUploadUsage
+	ingestShowSipUploadFlags.Usage = ingestShowSipUploadUsage
+	ingestUploadSipChunkFlags.Usage = ingestUploadSipChunkUsage
 	ingestDownloadSipRequestFlags.Usage = ingestDownloadSipRequestUsage
 	ingestDownloadSipFlags.Usage = ingestDownloadSipUsage
 	ingestListUsersFlags.Usage = ingestListUsersUsage
@@ -265,6 +338,11 @@ func ParseEndpoint(
 	ingestListBatchesFlags.Usage = ingestListBatchesUsage
 	ingestShowBatchFlags.Usage = ingestShowBatchUsage
 	ingestReviewBatchFlags.Usage = ingestReviewBatchUsage
+	ingestRetryBatchFlags.Usage = ingestRetryBatchUsage
+	ingestCreateAPITokenFlags.Usage = ingestCreateAPITokenUsage
+	ingestListAPITokensFlags.Usage = ingestListAPITokensUsage
+	ingestRevokeAPITokenFlags.Usage = ingestRevokeAPITokenUsage
+	ingestSearchFlags.Usage = ingestSearchUsage
 
 	storageFlags.Usage = storageUsage
 	storageMonitorFlags.Usage = storageMonitorUsage
@@ -274,11 +352,14 @@ func ParseEndpoint(
 	storageDownloadAipFlags.Usage = storageDownloadAipUsage
 	storageMoveAipFlags.Usage = storageMoveAipUsage
 	storageMoveAipStatusFlags.Usage = storageMoveAipStatusUsage
+	storageRestoreAipsFlags.Usage = storageRestoreAipsUsage
 	storageRejectAipFlags.Usage = storageRejectAipUsage
 	storageShowAipFlags.Usage = storageShowAipUsage
 	storageListAipWorkflowsFlags.Usage = storageListAipWorkflowsUsage
 	storageAipDeletionAutoFlags.Usage = storageAipDeletionAutoUsage
 	storageRequestAipDeletionFlags.Usage = storageRequestAipDeletionUsage
+	storagePlaceAipLegalHoldFlags.Usage = storagePlaceAipLegalHoldUsage
+	storageReleaseAipLegalHoldFlags.Usage = storageReleaseAipLegalHoldUsage
 	storageReviewAipDeletionFlags.Usage = storageReviewAipDeletionUsage
 	storageCancelAipDeletionFlags.Usage = storageCancelAipDeletionUsage
 	storageAipDeletionReportRequestFlags.Usage = storageAipDeletionReportRequestUsage
@@ -287,6 +368,8 @@ func ParseEndpoint(
 	storageCreateLocationFlags.Usage = storageCreateLocationUsage
 	storageShowLocationFlags.Usage = storageShowLocationUsage
 	storageListLocationAipsFlags.Usage = storageListLocationAipsUsage
+	storageLocationUsageFlags.Usage = storageLocationUsageUsage
+	storageSearchFlags.Usage = storageSearchUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -350,6 +433,10 @@ func ParseEndpoint(
 
 			case "reject-sip":
 				epf = ingestRejectSipFlags
+			case "retry-sip":
+				epf = ingestRetrySipFlags
+			case "cancel-sip":
+				epf = ingestCancelSipFlags
 
 			case "show-sip-decision":
 				epf = ingestShowSipDecisionFlags
@@ -363,6 +450,15 @@ func ParseEndpoint(
 			case "upload-sip":
 				epf = ingestUploadSipFlags
 
+			case "create-sip-upload":
+				epf = ingestCreateSipUploadFlags
+
+			case "show-sip-upload":
+				epf = ingestShowSipUploadFlags
+
+			case "upload-sip-chunk":
+				epf = ingestUploadSipChunkFlags
+
 			case "download-sip-request":
 				epf = ingestDownloadSipRequestFlags
 
@@ -387,6 +483,21 @@ func ParseEndpoint(
 			case "review-batch":
 				epf = ingestReviewBatchFlags
 
+			case "retry-batch":
+				epf = ingestRetryBatchFlags
+
+			case "create-api-token":
+				epf = ingestCreateAPITokenFlags
+
+			case "list-api-tokens":
+				epf = ingestListAPITokensFlags
+
+			case "revoke-api-token":
+				epf = ingestRevokeAPITokenFlags
+
+			case "search":
+				epf = ingestSearchFlags
+
 			}
 
 		case "storage":
@@ -412,6 +523,9 @@ func ParseEndpoint(
 			case "move-aip-status":
 				epf = storageMoveAipStatusFlags
 
+			case "restore-aips":
+				epf = storageRestoreAipsFlags
+
 			case "reject-aip":
 				epf = storageRejectAipFlags
 
@@ -427,6 +541,12 @@ func ParseEndpoint(
 			case "request-aip-deletion":
 				epf = storageRequestAipDeletionFlags
 
+			case "place-aip-legal-hold":
+				epf = storagePlaceAipLegalHoldFlags
+
+			case "release-aip-legal-hold":
+				epf = storageReleaseAipLegalHoldFlags
+
 			case "review-aip-deletion":
 				epf = storageReviewAipDeletionFlags
 
@@ -451,6 +571,12 @@ func ParseEndpoint(
 			case "list-location-aips":
 				epf = storageListLocationAipsFlags
 
+			case "location-usage":
+				epf = storageLocationUsageFlags
+
+			case "search":
+				epf = storageSearchFlags
+
 			}
 
 		}
@@ -488,7 +614,7 @@ func ParseEndpoint(
 				data, err = ingestc.BuildMonitorPayload(*ingestMonitorTokenFlag)
 			case "list-sips":
 				endpoint = c.ListSips()
-				data, err = ingestc.BuildListSipsPayload(*ingestListSipsNameFlag, *ingestListSipsAipUUIDFlag, *ingestListSipsEarliestCreatedTimeFlag, *ingestListSipsLatestCreatedTimeFlag, *ingestListSipsStatusFlag, *ingestListSipsUploaderUUIDFlag, *ingestListSipsBatchUUIDFlag, *ingestListSipsLimitFlag, *ingestListSipsOffsetFlag, *ingestListSipsTokenFlag)
+				data, err = ingestc.BuildListSipsPayload(*ingestListSipsNameFlag, *ingestListSipsAipUUIDFlag, *ingestListSipsEarliestCreatedTimeFlag, *ingestListSipsLatestCreatedTimeFlag, *ingestListSipsStatusFlag, *ingestListSipsUploaderUUIDFlag, *ingestListSipsBatchUUIDFlag, *ingestListSipsMetadataKeyFlag, *ingestListSipsLimitFlag, *ingestListSipsOffsetFlag, *ingestListSipsTokenFlag)
 			case "show-sip":
 				endpoint = c.ShowSip()
 				data, err = ingestc.BuildShowSipPayload(*ingestShowSipUUIDFlag, *ingestShowSipTokenFlag)
@@ -501,6 +627,12 @@ func ParseEndpoint(
 			case "reject-sip":
 				endpoint = c.RejectSip()
 				data, err = ingestc.BuildRejectSipPayload(*ingestRejectSipUUIDFlag, *ingestRejectSipTokenFlag)
+			case "retry-sip":
+				endpoint = c.RetrySip()
+				data, err = ingestc.BuildRetrySipPayload(*ingestRetrySipUUIDFlag, *ingestRetrySipTokenFlag)
+			case "cancel-sip":
+				endpoint = c.CancelSip()
+				data, err = ingestc.BuildCancelSipPayload(*ingestCancelSipUUIDFlag, *ingestCancelSipTokenFlag)
 			case "show-sip-decision":
 				endpoint = c.ShowSipDecision()
 				data, err = ingestc.BuildShowSipDecisionPayload(*ingestShowSipDecisionUUIDFlag, *ingestShowSipDecisionTokenFlag)
@@ -516,6 +648,18 @@ func ParseEndpoint(
 				if err == nil {
 					data, err = ingestc.BuildUploadSipStreamPayload(data, *ingestUploadSipStreamFlag)
 				}
+			case "create-sip-upload":
+				endpoint = c.CreateSipUpload()
+				data, err = ingestc.BuildCreateSipUploadPayload(*ingestCreateSipUploadBodyFlag, *ingestCreateSipUploadTokenFlag)
+			case "show-sip-upload":
+				endpoint = c.ShowSipUpload()
+				data, err = ingestc.BuildShowSipUploadPayload(*ingestShowSipUploadUUIDFlag, *ingestShowSipUploadTokenFlag)
+			case "upload-sip-chunk":
+				endpoint = c.UploadSipChunk()
+				data, err = ingestc.BuildUploadSipChunkPayload(*ingestUploadSipChunkUUIDFlag, *ingestUploadSipChunkOffsetFlag, *ingestUploadSipChunkChecksumFlag, *ingestUploadSipChunkTokenFlag)
+				if err == nil {
+					data, err = ingestc.BuildUploadSipChunkStreamPayload(data, *ingestUploadSipChunkStreamFlag)
+				}
 			case "download-sip-request":
 				endpoint = c.DownloadSipRequest()
 				data, err = ingestc.BuildDownloadSipRequestPayload(*ingestDownloadSipRequestUUIDFlag, *ingestDownloadSipRequestTokenFlag)
@@ -540,6 +684,21 @@ func ParseEndpoint(
 			case "review-batch":
 				endpoint = c.ReviewBatch()
 				data, err = ingestc.BuildReviewBatchPayload(*ingestReviewBatchBodyFlag, *ingestReviewBatchUUIDFlag, *ingestReviewBatchTokenFlag)
+			case "retry-batch":
+				endpoint = c.RetryBatch()
+				data, err = ingestc.BuildRetryBatchPayload(*ingestRetryBatchUUIDFlag, *ingestRetryBatchTokenFlag)
+			case "create-api-token":
+				endpoint = c.CreateAPIToken()
+				data, err = ingestc.BuildCreateAPITokenPayload(*ingestCreateAPITokenBodyFlag, *ingestCreateAPITokenTokenFlag)
+			case "list-api-tokens":
+				endpoint = c.ListAPITokens()
+				data, err = ingestc.BuildListAPITokensPayload(*ingestListAPITokensTokenFlag)
+			case "revoke-api-token":
+				endpoint = c.RevokeAPIToken()
+				data, err = ingestc.BuildRevokeAPITokenPayload(*ingestRevokeAPITokenUUIDFlag, *ingestRevokeAPITokenTokenFlag)
+			case "search":
+				endpoint = c.Search()
+				data, err = ingestc.BuildSearchPayload(*ingestSearchQueryFlag, *ingestSearchLimitFlag, *ingestSearchOffsetFlag, *ingestSearchTokenFlag)
 			}
 		case "storage":
 			c := storagec.NewClient(scheme, host, doer, enc, dec, restore)
@@ -549,7 +708,7 @@ func ParseEndpoint(
 				data, err = storagec.BuildMonitorPayload(*storageMonitorTokenFlag)
 			case "list-aips":
 				endpoint = c.ListAips()
-				data, err = storagec.BuildListAipsPayload(*storageListAipsQueryFlag, *storageListAipsEarliestCreatedTimeFlag, *storageListAipsLatestCreatedTimeFlag, *storageListAipsStatusFlag, *storageListAipsLimitFlag, *storageListAipsOffsetFlag, *storageListAipsTokenFlag)
+				data, err = storagec.BuildListAipsPayload(*storageListAipsQueryFlag, *storageListAipsEarliestCreatedTimeFlag, *storageListAipsLatestCreatedTimeFlag, *storageListAipsStatusFlag, *storageListAipsMetadataKeyFlag, *storageListAipsLimitFlag, *storageListAipsOffsetFlag, *storageListAipsTokenFlag)
 			case "create-aip":
 				endpoint = c.CreateAip()
 				data, err = storagec.BuildCreateAipPayload(*storageCreateAipBodyFlag, *storageCreateAipTokenFlag)
@@ -565,6 +724,9 @@ func ParseEndpoint(
 			case "move-aip-status":
 				endpoint = c.MoveAipStatus()
 				data, err = storagec.BuildMoveAipStatusPayload(*storageMoveAipStatusUUIDFlag, *storageMoveAipStatusTokenFlag)
+			case "restore-aips":
+				endpoint = c.RestoreAips()
+				data, err = storagec.BuildRestoreAipsPayload(*storageRestoreAipsBodyFlag, *storageRestoreAipsTokenFlag)
 			case "reject-aip":
 				endpoint = c.RejectAip()
 				data, err = storagec.BuildRejectAipPayload(*storageRejectAipUUIDFlag, *storageRejectAipTokenFlag)
@@ -580,6 +742,12 @@ func ParseEndpoint(
 			case "request-aip-deletion":
 				endpoint = c.RequestAipDeletion()
 				data, err = storagec.BuildRequestAipDeletionPayload(*storageRequestAipDeletionBodyFlag, *storageRequestAipDeletionUUIDFlag, *storageRequestAipDeletionTokenFlag)
+			case "place-aip-legal-hold":
+				endpoint = c.PlaceAipLegalHold()
+				data, err = storagec.BuildPlaceAipLegalHoldPayload(*storagePlaceAipLegalHoldBodyFlag, *storagePlaceAipLegalHoldUUIDFlag, *storagePlaceAipLegalHoldTokenFlag)
+			case "release-aip-legal-hold":
+				endpoint = c.ReleaseAipLegalHold()
+				data, err = storagec.BuildReleaseAipLegalHoldPayload(*storageReleaseAipLegalHoldBodyFlag, *storageReleaseAipLegalHoldUUIDFlag, *storageReleaseAipLegalHoldTokenFlag)
 			case "review-aip-deletion":
 				endpoint = c.ReviewAipDeletion()
 				data, err = storagec.BuildReviewAipDeletionPayload(*storageReviewAipDeletionBodyFlag, *storageReviewAipDeletionUUIDFlag, *storageReviewAipDeletionTokenFlag)
@@ -604,6 +772,12 @@ func ParseEndpoint(
 			case "list-location-aips":
 				endpoint = c.ListLocationAips()
 				data, err = storagec.BuildListLocationAipsPayload(*storageListLocationAipsUUIDFlag, *storageListLocationAipsTokenFlag)
+			case "location-usage":
+				endpoint = c.LocationUsage()
+				data, err = storagec.BuildLocationUsagePayload(*storageLocationUsageUUIDFlag, *storageLocationUsageTokenFlag)
+			case "search":
+				endpoint = c.Search()
+				data, err = storagec.BuildSearchPayload(*storageSearchQueryFlag, *storageSearchLimitFlag, *storageSearchOffsetFlag, *storageSearchTokenFlag)
 			}
 		}
 	}
@@ -653,10 +827,15 @@ func ingestUsage() {
 	fmt.Fprintln(os.Stderr, `    list-sip-workflows: List all workflows for a SIP`)
 	fmt.Fprintln(os.Stderr, `    confirm-sip: Signal the SIP has been reviewed and accepted`)
 	fmt.Fprintln(os.Stderr, `    reject-sip: Signal the SIP has been reviewed and rejected`)
+	fmt.Fprintln(os.Stderr, `    retry-sip: Retry the processing of a failed SIP from its failed package`)
+	fmt.Fprintln(os.Stderr, `    cancel-sip: Cancel the processing of a queued or processing SIP`)
 	fmt.Fprintln(os.Stderr, `    show-sip-decision: Show the active child workflow decision request for a SIP`)
 	fmt.Fprintln(os.Stderr, `    submit-sip-decision: Submit a selected child workflow decision option for a SIP`)
 	fmt.Fprintln(os.Stderr, `    add-sip: Ingest a SIP from a SIP Source`)
 	fmt.Fprintln(os.Stderr, `    upload-sip: Upload a SIP to trigger an ingest workflow`)
+	fmt.Fprintln(os.Stderr, `    create-sip-upload: Start a resumable SIP upload`)
+	fmt.Fprintln(os.Stderr, `    show-sip-upload: Show the progress of a resumable SIP upload`)
+	fmt.Fprintln(os.Stderr, `    upload-sip-chunk: Upload the next chunk of a resumable SIP upload`)
 	fmt.Fprintln(os.Stderr, `    download-sip-request: Request access to SIP download`)
 	fmt.Fprintln(os.Stderr, `    download-sip: Download the failed package related to a SIP. It will be the original SIP or the transformed PIP, based on the SIP's `+"`"+`failed_as`+"`"+` value.`)
 	fmt.Fprintln(os.Stderr, `    list-users: List all users`)
@@ -665,6 +844,11 @@ func ingestUsage() {
 	fmt.Fprintln(os.Stderr, `    list-batches: List all ingested Batches`)
 	fmt.Fprintln(os.Stderr, `    show-batch: Show Batch by UUID`)
 	fmt.Fprintln(os.Stderr, `    review-batch: Review a Batch awaiting user decision`)
+	fmt.Fprintln(os.Stderr, `    retry-batch: Retry the processing of the failed SIPs of a Batch`)
+	fmt.Fprintln(os.Stderr, `    create-api-token: Create an API token for the current user`)
+	fmt.Fprintln(os.Stderr, `    list-api-tokens: List the API tokens of the current user`)
+	fmt.Fprintln(os.Stderr, `    revoke-api-token: Revoke an API token of the current user`)
+	fmt.Fprintln(os.Stderr, `    search: Search SIPs by name, batch identifier and custom metadata`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s ingest COMMAND --help\n", os.Args[0])
@@ -697,6 +881,7 @@ func ingestListSipsUsage() {
 	fmt.Fprint(os.Stderr, " -status STRING")
 	fmt.Fprint(os.Stderr, " -uploader-uuid STRING")
 	fmt.Fprint(os.Stderr, " -batch-uuid STRING")
+	fmt.Fprint(os.Stderr, " -metadata-key STRING")
 	fmt.Fprint(os.Stderr, " -limit INT")
 	fmt.Fprint(os.Stderr, " -offset INT")
 	fmt.Fprint(os.Stderr, " -token STRING")
@@ -714,13 +899,14 @@ func ingestListSipsUsage() {
 	fmt.Fprintln(os.Stderr, `    -status STRING: `)
 	fmt.Fprintln(os.Stderr, `    -uploader-uuid STRING: `)
 	fmt.Fprintln(os.Stderr, `    -batch-uuid STRING: `)
+	fmt.Fprintln(os.Stderr, `    -metadata-key STRING: `)
 	fmt.Fprintln(os.Stderr, `    -limit INT: `)
 	fmt.Fprintln(os.Stderr, `    -offset INT: `)
 	fmt.Fprintln(os.Stderr, `    -token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest list-sips --name \"abc123\" --aip-uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --earliest-created-time \"1970-01-01T00:00:01Z\" --latest-created-time \"1970-01-01T00:00:01Z\" --status \"failed\" --uploader-uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --batch-uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --limit 1 --offset 1 --token \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest list-sips --name \"abc123\" --aip-uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --earliest-created-time \"1970-01-01T00:00:01Z\" --latest-created-time \"1970-01-01T00:00:01Z\" --status \"failed\" --uploader-uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --batch-uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --metadata-key \"abc123\" --limit 1 --offset 1 --token \"abc123\"")
 }
 
 func ingestShowSipUsage() {
@@ -805,6 +991,46 @@ func ingestRejectSipUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest reject-sip --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
 }
 
+func ingestRetrySipUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest retry-sip", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Retry the processing of a failed SIP from its failed package`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of SIP to look up`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest retry-sip --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
+}
+
+func ingestCancelSipUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest cancel-sip", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Cancel the processing of a queued or processing SIP`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of SIP to look up`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest cancel-sip --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
+}
+
 func ingestShowSipDecisionUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] ingest show-sip-decision", os.Args[0])
@@ -864,7 +1090,7 @@ func ingestAddSipUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest add-sip --body '{\n      \"key\": \"abc123\",\n      \"source_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }' --token \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest add-sip --body '{\n      \"key\": \"abc123\",\n      \"location_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"processing_profile\": \"abc123\",\n      \"source_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }' --token \"abc123\"")
 }
 
 func ingestUploadSipUsage() {
@@ -889,6 +1115,72 @@ func ingestUploadSipUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest upload-sip --content-type \"multipart/form-data; boundary=goa\" --token \"abc123\" --stream \"goa.png\"")
 }
 
+func ingestCreateSipUploadUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest create-sip-upload", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Start a resumable SIP upload`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest create-sip-upload --body '{\n      \"checksum\": \"abc123\",\n      \"location_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"name\": \"abc123\",\n      \"processing_profile\": \"abc123\",\n      \"size\": 1\n   }' --token \"abc123\"")
+}
+
+func ingestShowSipUploadUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest show-sip-upload", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Show the progress of a resumable SIP upload`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of the upload`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest show-sip-upload --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
+}
+
+func ingestUploadSipChunkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest upload-sip-chunk", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -offset INT64")
+	fmt.Fprint(os.Stderr, " -checksum STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprint(os.Stderr, " -stream STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Upload the next chunk of a resumable SIP upload`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of the upload`)
+	fmt.Fprintln(os.Stderr, `    -offset INT64: `)
+	fmt.Fprintln(os.Stderr, `    -checksum STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -stream STRING: path to file containing the streamed request body`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest upload-sip-chunk --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --offset 1 --checksum \"abc123\" --token \"abc123\" --stream \"goa.png\"")
+}
+
 func ingestDownloadSipRequestUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] ingest download-sip-request", os.Args[0])
@@ -996,7 +1288,7 @@ func ingestAddBatchUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest add-batch --body '{\n      \"identifier\": \"abc123\",\n      \"keys\": [\n         \"abc123\"\n      ],\n      \"source_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }' --token \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest add-batch --body '{\n      \"identifier\": \"abc123\",\n      \"keys\": [\n         \"abc123\"\n      ],\n      \"location_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"manifest\": \"abc123\",\n      \"manifest_format\": \"csv\",\n      \"processing_profile\": \"abc123\",\n      \"source_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }' --token \"abc123\"")
 }
 
 func ingestListBatchesUsage() {
@@ -1073,6 +1365,108 @@ func ingestReviewBatchUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest review-batch --body '{\n      \"continue\": false\n   }' --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
 }
 
+func ingestRetryBatchUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest retry-batch", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Retry the processing of the failed SIPs of a Batch`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of Batch to retry`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest retry-batch --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
+}
+
+func ingestCreateAPITokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest create-api-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create an API token for the current user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest create-api-token --body '{\n      \"attributes\": [\n         \"abc123\"\n      ],\n      \"expires_at\": \"1970-01-01T00:00:01Z\",\n      \"name\": \"abc123\"\n   }' --token \"abc123\"")
+}
+
+func ingestListAPITokensUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest list-api-tokens", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the API tokens of the current user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest list-api-tokens --token \"abc123\"")
+}
+
+func ingestRevokeAPITokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest revoke-api-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Revoke an API token of the current user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of the API token`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest revoke-api-token --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
+}
+
+func ingestSearchUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ingest search", os.Args[0])
+	fmt.Fprint(os.Stderr, " -query STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -offset INT")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Search SIPs by name, batch identifier and custom metadata`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -query STRING: Search terms, all of them must match`)
+	fmt.Fprintln(os.Stderr, `    -limit INT: Limit number of results to return`)
+	fmt.Fprintln(os.Stderr, `    -offset INT: Offset from the beginning of the found set`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest search --query \"abc123\" --limit 1 --offset 1 --token \"abc123\"")
+}
+
 // storageUsage displays the usage of the storage command and its subcommands.
 func storageUsage() {
 	fmt.Fprintln(os.Stderr, `The storage service manages locations and AIPs.`)
@@ -1085,11 +1479,14 @@ func storageUsage() {
 	fmt.Fprintln(os.Stderr, `    download-aip: Download AIP by AIPID`)
 	fmt.Fprintln(os.Stderr, `    move-aip: Move an AIP to a permanent storage location`)
 	fmt.Fprintln(os.Stderr, `    move-aip-status: Retrieve the status of a permanent storage location move of the AIP`)
+	fmt.Fprintln(os.Stderr, `    restore-aips: Restore AIPs to a restore location`)
 	fmt.Fprintln(os.Stderr, `    reject-aip: Reject an AIP`)
 	fmt.Fprintln(os.Stderr, `    show-aip: Show AIP by AIPID`)
 	fmt.Fprintln(os.Stderr, `    list-aip-workflows: List workflows related to an AIP`)
 	fmt.Fprintln(os.Stderr, `    aip-deletion-auto: AIP deletion with auto-approval`)
 	fmt.Fprintln(os.Stderr, `    request-aip-deletion: Request an AIP deletion`)
+	fmt.Fprintln(os.Stderr, `    place-aip-legal-hold: Place a legal hold on an AIP to prevent its deletion and moves`)
+	fmt.Fprintln(os.Stderr, `    release-aip-legal-hold: Release the active legal hold of an AIP`)
 	fmt.Fprintln(os.Stderr, `    review-aip-deletion: Review an AIP deletion request`)
 	fmt.Fprintln(os.Stderr, `    cancel-aip-deletion: Cancel an AIP deletion request`)
 	fmt.Fprintln(os.Stderr, `    aip-deletion-report-request: Request access to download a deletion report`)
@@ -1098,6 +1495,8 @@ func storageUsage() {
 	fmt.Fprintln(os.Stderr, `    create-location: Create a storage location`)
 	fmt.Fprintln(os.Stderr, `    show-location: Show location by UUID`)
 	fmt.Fprintln(os.Stderr, `    list-location-aips: List all the AIPs stored in the location with UUID`)
+	fmt.Fprintln(os.Stderr, `    location-usage: Show the storage usage of the location with UUID`)
+	fmt.Fprintln(os.Stderr, `    search: Search AIPs by name and descriptive metadata`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s storage COMMAND --help\n", os.Args[0])
@@ -1127,6 +1526,7 @@ func storageListAipsUsage() {
 	fmt.Fprint(os.Stderr, " -earliest-created-time STRING")
 	fmt.Fprint(os.Stderr, " -latest-created-time STRING")
 	fmt.Fprint(os.Stderr, " -status STRING")
+	fmt.Fprint(os.Stderr, " -metadata-key STRING")
 	fmt.Fprint(os.Stderr, " -limit INT")
 	fmt.Fprint(os.Stderr, " -offset INT")
 	fmt.Fprint(os.Stderr, " -token STRING")
@@ -1141,13 +1541,14 @@ func storageListAipsUsage() {
 	fmt.Fprintln(os.Stderr, `    -earliest-created-time STRING: `)
 	fmt.Fprintln(os.Stderr, `    -latest-created-time STRING: `)
 	fmt.Fprintln(os.Stderr, `    -status STRING: `)
+	fmt.Fprintln(os.Stderr, `    -metadata-key STRING: `)
 	fmt.Fprintln(os.Stderr, `    -limit INT: `)
 	fmt.Fprintln(os.Stderr, `    -offset INT: `)
 	fmt.Fprintln(os.Stderr, `    -token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage list-aips --query \"abc123\" --earliest-created-time \"1970-01-01T00:00:01Z\" --latest-created-time \"1970-01-01T00:00:01Z\" --status \"stored\" --limit 1 --offset 1 --token \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage list-aips --query \"abc123\" --earliest-created-time \"1970-01-01T00:00:01Z\" --latest-created-time \"1970-01-01T00:00:01Z\" --status \"stored\" --metadata-key \"abc123\" --limit 1 --offset 1 --token \"abc123\"")
 }
 
 func storageCreateAipUsage() {
@@ -1167,7 +1568,7 @@ func storageCreateAipUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage create-aip --body '{\n      \"location_uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"name\": \"abc123\",\n      \"object_key\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"status\": \"stored\",\n      \"uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }' --token \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage create-aip --body '{\n      \"custom_metadata\": {\n         \"abc123\": \"abc123\"\n      },\n      \"location_uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"metadata\": {\n         \"abc123\": \"abc123\"\n      },\n      \"name\": \"abc123\",\n      \"object_key\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"status\": \"stored\",\n      \"uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }' --token \"abc123\"")
 }
 
 func storageDownloadAipRequestUsage() {
@@ -1252,6 +1653,26 @@ func storageMoveAipStatusUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage move-aip-status --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
 }
 
+func storageRestoreAipsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] storage restore-aips", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Restore AIPs to a restore location`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage restore-aips --body '{\n      \"aip_uuids\": [\n         \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n      ],\n      \"location_uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"unpack\": false\n   }' --token \"abc123\"")
+}
+
 func storageRejectAipUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] storage reject-aip", os.Args[0])
@@ -1360,6 +1781,50 @@ func storageRequestAipDeletionUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage request-aip-deletion --body '{\n      \"reason\": \"abc123\"\n   }' --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
 }
 
+func storagePlaceAipLegalHoldUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] storage place-aip-legal-hold", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Place a legal hold on an AIP to prevent its deletion and moves`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of AIP`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage place-aip-legal-hold --body '{\n      \"reason\": \"abc123\"\n   }' --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
+}
+
+func storageReleaseAipLegalHoldUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] storage release-aip-legal-hold", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Release the active legal hold of an AIP`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of AIP`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage release-aip-legal-hold --body '{\n      \"reason\": \"abc123\"\n   }' --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
+}
+
 func storageReviewAipDeletionUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] storage review-aip-deletion", os.Args[0])
@@ -1479,7 +1944,7 @@ func storageCreateLocationUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage create-location --body '{\n      \"config\": {\n         \"bucket\": \"abc123\",\n         \"endpoint\": \"abc123\",\n         \"key\": \"abc123\",\n         \"path_style\": false,\n         \"profile\": \"abc123\",\n         \"region\": \"abc123\",\n         \"secret\": \"abc123\",\n         \"token\": \"abc123\"\n      },\n      \"description\": \"abc123\",\n      \"name\": \"abc123\",\n      \"purpose\": \"aip_store\",\n      \"source\": \"s3\"\n   }' --token \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage create-location --body '{\n      \"config\": {\n         \"bucket\": \"abc123\",\n         \"endpoint\": \"abc123\",\n         \"key\": \"abc123\",\n         \"path_style\": false,\n         \"profile\": \"abc123\",\n         \"region\": \"abc123\",\n         \"secret\": \"abc123\",\n         \"token\": \"abc123\"\n      },\n      \"description\": \"abc123\",\n      \"name\": \"abc123\",\n      \"purpose\": \"aip_store\",\n      \"replication_targets\": [\n         \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n      ],\n      \"source\": \"s3\"\n   }' --token \"abc123\"")
 }
 
 func storageShowLocationUsage() {
@@ -1521,3 +1986,47 @@ func storageListLocationAipsUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage list-location-aips --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
 }
+
+func storageLocationUsageUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] storage location-usage", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uuid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Show the storage usage of the location with UUID`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uuid STRING: Identifier of location`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage location-usage --uuid \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --token \"abc123\"")
+}
+
+func storageSearchUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] storage search", os.Args[0])
+	fmt.Fprint(os.Stderr, " -query STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -offset INT")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Search AIPs by name and descriptive metadata`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -query STRING: Search terms, all of them must match`)
+	fmt.Fprintln(os.Stderr, `    -limit INT: Limit number of results to return`)
+	fmt.Fprintln(os.Stderr, `    -offset INT: Offset from the beginning of the found set`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "storage search --query \"abc123\" --limit 1 --offset 1 --token \"abc123\"")
+}
@@ -34,7 +34,7 @@ func BuildMonitorPayload(ingestMonitorToken string) (*ingest.MonitorPayload, err
 
 // BuildListSipsPayload builds the payload for the ingest list_sips endpoint
 // from CLI flags.
-func BuildListSipsPayload(ingestListSipsName string, ingestListSipsAipUUID string, ingestListSipsEarliestCreatedTime string, ingestListSipsLatestCreatedTime string, ingestListSipsStatus string, ingestListSipsUploaderUUID string, ingestListSipsBatchUUID string, ingestListSipsLimit string, ingestListSipsOffset string, ingestListSipsToken string) (*ingest.ListSipsPayload, error) {
+func BuildListSipsPayload(ingestListSipsName string, ingestListSipsAipUUID string, ingestListSipsEarliestCreatedTime string, ingestListSipsLatestCreatedTime string, ingestListSipsStatus string, ingestListSipsUploaderUUID string, ingestListSipsBatchUUID string, ingestListSipsMetadataKey string, ingestListSipsLimit string, ingestListSipsOffset string, ingestListSipsToken string) (*ingest.ListSipsPayload, error) {
 	var err error
 	var name *string
 	{
@@ -104,6 +104,12 @@ func BuildListSipsPayload(ingestListSipsName string, ingestListSipsAipUUID strin
 			}
 		}
 	}
+	var metadataKey *string
+	{
+		if ingestListSipsMetadataKey != "" {
+			metadataKey = &ingestListSipsMetadataKey
+		}
+	}
 	var limit *int
 	{
 		if ingestListSipsLimit != "" {
@@ -142,6 +148,7 @@ func BuildListSipsPayload(ingestListSipsName string, ingestListSipsAipUUID strin
 	v.Status = status
 	v.UploaderUUID = uploaderUUID
 	v.BatchUUID = batchUUID
+	v.MetadataKey = metadataKey
 	v.Limit = limit
 	v.Offset = offset
 	v.Token = token
@@ -258,6 +265,56 @@ func BuildRejectSipPayload(ingestRejectSipUUID string, ingestRejectSipToken stri
 	return v, nil
 }
 
+// BuildRetrySipPayload builds the payload for the ingest retry_sip endpoint
+// from CLI flags.
+func BuildRetrySipPayload(ingestRetrySipUUID string, ingestRetrySipToken string) (*ingest.RetrySipPayload, error) {
+	var err error
+	var uuid string
+	{
+		uuid = ingestRetrySipUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if ingestRetrySipToken != "" {
+			token = &ingestRetrySipToken
+		}
+	}
+	v := &ingest.RetrySipPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCancelSipPayload builds the payload for the ingest cancel_sip endpoint
+// from CLI flags.
+func BuildCancelSipPayload(ingestCancelSipUUID string, ingestCancelSipToken string) (*ingest.CancelSipPayload, error) {
+	var err error
+	var uuid string
+	{
+		uuid = ingestCancelSipUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if ingestCancelSipToken != "" {
+			token = &ingestCancelSipToken
+		}
+	}
+	v := &ingest.CancelSipPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v, nil
+}
+
 // BuildShowSipDecisionPayload builds the payload for the ingest
 // show_sip_decision endpoint from CLI flags.
 func BuildShowSipDecisionPayload(ingestShowSipDecisionUUID string, ingestShowSipDecisionToken string) (*ingest.ShowSipDecisionPayload, error) {
@@ -325,9 +382,12 @@ func BuildAddSipPayload(ingestAddSipBody string, ingestAddSipToken string) (*ing
 	{
 		err = json.Unmarshal([]byte(ingestAddSipBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"key\": \"abc123\",\n      \"source_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"key\": \"abc123\",\n      \"location_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"processing_profile\": \"abc123\",\n      \"source_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.source_id", body.SourceID, goa.FormatUUID))
+		if body.LocationID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.location_id", *body.LocationID, goa.FormatUUID))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -339,8 +399,10 @@ func BuildAddSipPayload(ingestAddSipBody string, ingestAddSipToken string) (*ing
 		}
 	}
 	v := &ingest.AddSipPayload{
-		SourceID: body.SourceID,
-		Key:      body.Key,
+		SourceID:          body.SourceID,
+		Key:               body.Key,
+		ProcessingProfile: body.ProcessingProfile,
+		LocationID:        body.LocationID,
 	}
 	v.Token = token
 
@@ -374,6 +436,106 @@ func BuildUploadSipPayload(ingestUploadSipContentType string, ingestUploadSipTok
 	return v, nil
 }
 
+// BuildCreateSipUploadPayload builds the payload for the ingest
+// create_sip_upload endpoint from CLI flags.
+func BuildCreateSipUploadPayload(ingestCreateSipUploadBody string, ingestCreateSipUploadToken string) (*ingest.CreateSipUploadPayload, error) {
+	var err error
+	var body CreateSipUploadRequestBody
+	{
+		err = json.Unmarshal([]byte(ingestCreateSipUploadBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"checksum\": \"abc123\",\n      \"location_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"name\": \"abc123\",\n      \"processing_profile\": \"abc123\",\n      \"size\": 1\n   }'")
+		}
+		if body.LocationID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.location_id", *body.LocationID, goa.FormatUUID))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if ingestCreateSipUploadToken != "" {
+			token = &ingestCreateSipUploadToken
+		}
+	}
+	v := &ingest.CreateSipUploadPayload{
+		Name:              body.Name,
+		Size:              body.Size,
+		Checksum:          body.Checksum,
+		ProcessingProfile: body.ProcessingProfile,
+		LocationID:        body.LocationID,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildShowSipUploadPayload builds the payload for the ingest show_sip_upload
+// endpoint from CLI flags.
+func BuildShowSipUploadPayload(ingestShowSipUploadUUID string, ingestShowSipUploadToken string) (*ingest.ShowSipUploadPayload, error) {
+	var err error
+	var uuid string
+	{
+		uuid = ingestShowSipUploadUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if ingestShowSipUploadToken != "" {
+			token = &ingestShowSipUploadToken
+		}
+	}
+	v := &ingest.ShowSipUploadPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUploadSipChunkPayload builds the payload for the ingest upload_sip_chunk
+// endpoint from CLI flags.
+func BuildUploadSipChunkPayload(ingestUploadSipChunkUUID string, ingestUploadSipChunkOffset string, ingestUploadSipChunkChecksum string, ingestUploadSipChunkToken string) (*ingest.UploadSipChunkPayload, error) {
+	var err error
+	var uuid string
+	{
+		uuid = ingestUploadSipChunkUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var offset int64
+	{
+		offset, err = strconv.ParseInt(ingestUploadSipChunkOffset, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for offset, must be INT64")
+		}
+	}
+	var checksum *string
+	{
+		if ingestUploadSipChunkChecksum != "" {
+			checksum = &ingestUploadSipChunkChecksum
+		}
+	}
+	var token *string
+	{
+		if ingestUploadSipChunkToken != "" {
+			token = &ingestUploadSipChunkToken
+		}
+	}
+	v := &ingest.UploadSipChunkPayload{}
+	v.UUID = uuid
+	v.Offset = offset
+	v.Checksum = checksum
+	v.Token = token
+
+	return v, nil
+}
+
 // BuildDownloadSipRequestPayload builds the payload for the ingest
 // download_sip_request endpoint from CLI flags.
 func BuildDownloadSipRequestPayload(ingestDownloadSipRequestUUID string, ingestDownloadSipRequestToken string) (*ingest.DownloadSipRequestPayload, error) {
@@ -533,12 +695,17 @@ func BuildAddBatchPayload(ingestAddBatchBody string, ingestAddBatchToken string)
 	{
 		err = json.Unmarshal([]byte(ingestAddBatchBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"identifier\": \"abc123\",\n      \"keys\": [\n         \"abc123\"\n      ],\n      \"source_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }'")
-		}
-		if body.Keys == nil {
-			err = goa.MergeErrors(err, goa.MissingFieldError("keys", "body"))
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"identifier\": \"abc123\",\n      \"keys\": [\n         \"abc123\"\n      ],\n      \"location_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"manifest\": \"abc123\",\n      \"manifest_format\": \"csv\",\n      \"processing_profile\": \"abc123\",\n      \"source_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.source_id", body.SourceID, goa.FormatUUID))
+		if body.ManifestFormat != nil {
+			if !(*body.ManifestFormat == "csv" || *body.ManifestFormat == "json") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.manifest_format", *body.ManifestFormat, []any{"csv", "json"}))
+			}
+		}
+		if body.LocationID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.location_id", *body.LocationID, goa.FormatUUID))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -550,16 +717,18 @@ func BuildAddBatchPayload(ingestAddBatchBody string, ingestAddBatchToken string)
 		}
 	}
 	v := &ingest.AddBatchPayload{
-		SourceID:   body.SourceID,
-		Identifier: body.Identifier,
+		SourceID:          body.SourceID,
+		Identifier:        body.Identifier,
+		ProcessingProfile: body.ProcessingProfile,
+		Manifest:          body.Manifest,
+		ManifestFormat:    body.ManifestFormat,
+		LocationID:        body.LocationID,
 	}
 	if body.Keys != nil {
 		v.Keys = make([]string, len(body.Keys))
 		for i, val := range body.Keys {
 			v.Keys[i] = val
 		}
-	} else {
-		v.Keys = []string{}
 	}
 	v.Token = token
 
@@ -719,3 +888,151 @@ func BuildReviewBatchPayload(ingestReviewBatchBody string, ingestReviewBatchUUID
 
 	return v, nil
 }
+
+// BuildRetryBatchPayload builds the payload for the ingest retry_batch endpoint
+// from CLI flags.
+func BuildRetryBatchPayload(ingestRetryBatchUUID string, ingestRetryBatchToken string) (*ingest.RetryBatchPayload, error) {
+	var err error
+	var uuid string
+	{
+		uuid = ingestRetryBatchUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if ingestRetryBatchToken != "" {
+			token = &ingestRetryBatchToken
+		}
+	}
+	v := &ingest.RetryBatchPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreateAPITokenPayload builds the payload for the ingest create_api_token
+// endpoint from CLI flags.
+func BuildCreateAPITokenPayload(ingestCreateAPITokenBody string, ingestCreateAPITokenToken string) (*ingest.CreateAPITokenPayload, error) {
+	var err error
+	var body CreateAPITokenRequestBody
+	{
+		err = json.Unmarshal([]byte(ingestCreateAPITokenBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attributes\": [\n         \"abc123\"\n      ],\n      \"expires_at\": \"1970-01-01T00:00:01Z\",\n      \"name\": \"abc123\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", body.ExpiresAt, goa.FormatDateTime))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if ingestCreateAPITokenToken != "" {
+			token = &ingestCreateAPITokenToken
+		}
+	}
+	v := &ingest.CreateAPITokenPayload{
+		Name:      body.Name,
+		ExpiresAt: body.ExpiresAt,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make([]string, len(body.Attributes))
+		for i, val := range body.Attributes {
+			v.Attributes[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListAPITokensPayload builds the payload for the ingest list_api_tokens
+// endpoint from CLI flags.
+func BuildListAPITokensPayload(ingestListAPITokensToken string) (*ingest.ListAPITokensPayload, error) {
+	var token *string
+	{
+		if ingestListAPITokensToken != "" {
+			token = &ingestListAPITokensToken
+		}
+	}
+	v := &ingest.ListAPITokensPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokeAPITokenPayload builds the payload for the ingest revoke_api_token
+// endpoint from CLI flags.
+func BuildRevokeAPITokenPayload(ingestRevokeAPITokenUUID string, ingestRevokeAPITokenToken string) (*ingest.RevokeAPITokenPayload, error) {
+	var err error
+	var uuid string
+	{
+		uuid = ingestRevokeAPITokenUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if ingestRevokeAPITokenToken != "" {
+			token = &ingestRevokeAPITokenToken
+		}
+	}
+	v := &ingest.RevokeAPITokenPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildSearchPayload builds the payload for the ingest search endpoint from CLI
+// flags.
+func BuildSearchPayload(ingestSearchQuery string, ingestSearchLimit string, ingestSearchOffset string, ingestSearchToken string) (*ingest.SearchPayload, error) {
+	var err error
+	var query string
+	{
+		query = ingestSearchQuery
+	}
+	var limit *int
+	{
+		if ingestSearchLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(ingestSearchLimit, 10, strconv.IntSize)
+			val := int(v)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+		}
+	}
+	var offset *int
+	{
+		if ingestSearchOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(ingestSearchOffset, 10, strconv.IntSize)
+			val := int(v)
+			offset = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+		}
+	}
+	var token *string
+	{
+		if ingestSearchToken != "" {
+			token = &ingestSearchToken
+		}
+	}
+	v := &ingest.SearchPayload{}
+	v.Query = query
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
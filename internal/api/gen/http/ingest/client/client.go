@@ -44,6 +44,12 @@ type Client struct {
 	// RejectSip Doer is the HTTP client used to make requests to the reject_sip
 	// endpoint.
 	RejectSipDoer goahttp.Doer
+	// RetrySip Doer is the HTTP client used to make requests to the retry_sip
+	// endpoint.
+	RetrySipDoer goahttp.Doer
+	// CancelSip Doer is the HTTP client used to make requests to the cancel_sip
+	// endpoint.
+	CancelSipDoer goahttp.Doer
 
 	// ShowSipDecision Doer is the HTTP client used to make requests to the
 	// show_sip_decision endpoint.
@@ -60,6 +66,18 @@ type Client struct {
 	// endpoint.
 	UploadSipDoer goahttp.Doer
 
+	// CreateSipUpload Doer is the HTTP client used to make requests to the
+	// create_sip_upload endpoint.
+	CreateSipUploadDoer goahttp.Doer
+
+	// ShowSipUpload Doer is the HTTP client used to make requests to the
+	// show_sip_upload endpoint.
+	ShowSipUploadDoer goahttp.Doer
+
+	// UploadSipChunk Doer is the HTTP client used to make requests to the
+	// upload_sip_chunk endpoint.
+	UploadSipChunkDoer goahttp.Doer
+
 	// DownloadSipRequest Doer is the HTTP client used to make requests to the
 	// download_sip_request endpoint.
 	DownloadSipRequestDoer goahttp.Doer
@@ -92,6 +110,25 @@ type Client struct {
 	// review_batch endpoint.
 	ReviewBatchDoer goahttp.Doer
 
+	// RetryBatch Doer is the HTTP client used to make requests to the
+	// retry_batch endpoint.
+	RetryBatchDoer goahttp.Doer
+
+	// CreateAPIToken Doer is the HTTP client used to make requests to the
+	// create_api_token endpoint.
+	CreateAPITokenDoer goahttp.Doer
+
+	// ListAPITokens Doer is the HTTP client used to make requests to the
+	// list_api_tokens endpoint.
+	ListAPITokensDoer goahttp.Doer
+
+	// RevokeAPIToken Doer is the HTTP client used to make requests to the
+	// revoke_api_token endpoint.
+	RevokeAPITokenDoer goahttp.Doer
+
+	// Search Doer is the HTTP client used to make requests to the search endpoint.
+	SearchDoer goahttp.Doer
+
 	// CORS Doer is the HTTP client used to make requests to the  endpoint.
 	CORSDoer goahttp.Doer
 
@@ -121,10 +158,15 @@ func NewClient(
 		ListSipWorkflowsDoer:     doer,
 		ConfirmSipDoer:           doer,
 		RejectSipDoer:            doer,
+		RetrySipDoer:             doer,
+		CancelSipDoer:            doer,
 		ShowSipDecisionDoer:      doer,
 		SubmitSipDecisionDoer:    doer,
 		AddSipDoer:               doer,
 		UploadSipDoer:            doer,
+		CreateSipUploadDoer:      doer,
+		ShowSipUploadDoer:        doer,
+		UploadSipChunkDoer:       doer,
 		DownloadSipRequestDoer:   doer,
 		DownloadSipDoer:          doer,
 		ListUsersDoer:            doer,
@@ -133,6 +175,11 @@ func NewClient(
 		ListBatchesDoer:          doer,
 		ShowBatchDoer:            doer,
 		ReviewBatchDoer:          doer,
+		RetryBatchDoer:           doer,
+		CreateAPITokenDoer:       doer,
+		ListAPITokensDoer:        doer,
+		RevokeAPITokenDoer:       doer,
+		SearchDoer:               doer,
 		CORSDoer:                 doer,
 		RestoreResponseBody:      restoreBody,
 		scheme:                   scheme,
@@ -298,6 +345,54 @@ func (c *Client) RejectSip() goa.Endpoint {
 	}
 }
 
+// RetrySip returns an endpoint that makes HTTP requests to the ingest service
+// retry_sip server.
+func (c *Client) RetrySip() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRetrySipRequest(c.encoder)
+		decodeResponse = DecodeRetrySipResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRetrySipRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RetrySipDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "retry_sip", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CancelSip returns an endpoint that makes HTTP requests to the ingest service
+// cancel_sip server.
+func (c *Client) CancelSip() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCancelSipRequest(c.encoder)
+		decodeResponse = DecodeCancelSipResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCancelSipRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CancelSipDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "cancel_sip", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ShowSipDecision returns an endpoint that makes HTTP requests to the ingest
 // service show_sip_decision server.
 func (c *Client) ShowSipDecision() goa.Endpoint {
@@ -394,6 +489,78 @@ func (c *Client) UploadSip() goa.Endpoint {
 	}
 }
 
+// CreateSipUpload returns an endpoint that makes HTTP requests to the ingest
+// service create_sip_upload server.
+func (c *Client) CreateSipUpload() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateSipUploadRequest(c.encoder)
+		decodeResponse = DecodeCreateSipUploadResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateSipUploadRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateSipUploadDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "create_sip_upload", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ShowSipUpload returns an endpoint that makes HTTP requests to the ingest
+// service show_sip_upload server.
+func (c *Client) ShowSipUpload() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeShowSipUploadRequest(c.encoder)
+		decodeResponse = DecodeShowSipUploadResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildShowSipUploadRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ShowSipUploadDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "show_sip_upload", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UploadSipChunk returns an endpoint that makes HTTP requests to the ingest
+// service upload_sip_chunk server.
+func (c *Client) UploadSipChunk() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUploadSipChunkRequest(c.encoder)
+		decodeResponse = DecodeUploadSipChunkResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUploadSipChunkRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UploadSipChunkDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "upload_sip_chunk", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // DownloadSipRequest returns an endpoint that makes HTTP requests to the
 // ingest service download_sip_request server.
 func (c *Client) DownloadSipRequest() goa.Endpoint {
@@ -590,3 +757,123 @@ func (c *Client) ReviewBatch() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// RetryBatch returns an endpoint that makes HTTP requests to the ingest service
+// retry_batch server.
+func (c *Client) RetryBatch() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRetryBatchRequest(c.encoder)
+		decodeResponse = DecodeRetryBatchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRetryBatchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RetryBatchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "retry_batch", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateAPIToken returns an endpoint that makes HTTP requests to the ingest
+// service create_api_token server.
+func (c *Client) CreateAPIToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateAPITokenRequest(c.encoder)
+		decodeResponse = DecodeCreateAPITokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateAPITokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateAPITokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "create_api_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListAPITokens returns an endpoint that makes HTTP requests to the ingest
+// service list_api_tokens server.
+func (c *Client) ListAPITokens() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAPITokensRequest(c.encoder)
+		decodeResponse = DecodeListAPITokensResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAPITokensRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAPITokensDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "list_api_tokens", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeAPIToken returns an endpoint that makes HTTP requests to the ingest
+// service revoke_api_token server.
+func (c *Client) RevokeAPIToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeAPITokenRequest(c.encoder)
+		decodeResponse = DecodeRevokeAPITokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeAPITokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeAPITokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "revoke_api_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Search returns an endpoint that makes HTTP requests to the ingest service
+// search server.
+func (c *Client) Search() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSearchRequest(c.encoder)
+		decodeResponse = DecodeSearchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSearchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SearchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ingest", "search", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -192,6 +192,9 @@ func EncodeListSipsRequest(encoder func(*http.Request) goahttp.Encoder) func(*ht
 		if p.BatchUUID != nil {
 			values.Add("batch_uuid", *p.BatchUUID)
 		}
+		if p.MetadataKey != nil {
+			values.Add("metadata_key", *p.MetadataKey)
+		}
 		if p.Limit != nil {
 			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
 		}
@@ -721,6 +724,56 @@ func (c *Client) BuildRejectSipRequest(ctx context.Context, v any) (*http.Reques
 	return req, nil
 }
 
+// BuildRetrySipRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "retry_sip" endpoint
+func (c *Client) BuildRetrySipRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*ingest.RetrySipPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("ingest", "retry_sip", "*ingest.RetrySipPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RetrySipIngestPath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "retry_sip", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// BuildCancelSipRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "cancel_sip" endpoint
+func (c *Client) BuildCancelSipRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*ingest.CancelSipPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("ingest", "cancel_sip", "*ingest.CancelSipPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CancelSipIngestPath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "cancel_sip", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
 // EncodeRejectSipRequest returns an encoder for requests sent to the ingest
 // reject_sip server.
 func EncodeRejectSipRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
@@ -741,6 +794,46 @@ func EncodeRejectSipRequest(encoder func(*http.Request) goahttp.Encoder) func(*h
 	}
 }
 
+// EncodeRetrySipRequest returns an encoder for requests sent to the ingest
+// retry_sip server.
+func EncodeRetrySipRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.RetrySipPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "retry_sip", "*ingest.RetrySipPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// EncodeCancelSipRequest returns an encoder for requests sent to the ingest
+// cancel_sip server.
+func EncodeCancelSipRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.CancelSipPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "cancel_sip", "*ingest.CancelSipPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
 // DecodeRejectSipResponse returns a decoder for responses returned by the
 // ingest reject_sip endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
@@ -837,6 +930,198 @@ func DecodeRejectSipResponse(decoder func(*http.Response) goahttp.Decoder, resto
 	}
 }
 
+// DecodeRetrySipResponse returns a decoder for responses returned by the
+// ingest retry_sip endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRetrySipResponse may return the following errors:
+//   - "not_available" (type *goa.ServiceError): http.StatusConflict
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *ingest.SIPNotFound): http.StatusNotFound
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRetrySipResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusConflict:
+			var (
+				body RetrySipNotAvailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "retry_sip", err)
+			}
+			err = ValidateRetrySipNotAvailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "retry_sip", err)
+			}
+			return nil, NewRetrySipNotAvailable(&body)
+		case http.StatusBadRequest:
+			var (
+				body RetrySipNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "retry_sip", err)
+			}
+			err = ValidateRetrySipNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "retry_sip", err)
+			}
+			return nil, NewRetrySipNotValid(&body)
+		case http.StatusNotFound:
+			var (
+				body RetrySipNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "retry_sip", err)
+			}
+			err = ValidateRetrySipNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "retry_sip", err)
+			}
+			return nil, NewRetrySipNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "retry_sip", err)
+			}
+			return nil, NewRetrySipForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "retry_sip", err)
+			}
+			return nil, NewRetrySipUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "retry_sip", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// DecodeCancelSipResponse returns a decoder for responses returned by the
+// ingest cancel_sip endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCancelSipResponse may return the following errors:
+//   - "not_available" (type *goa.ServiceError): http.StatusConflict
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *ingest.SIPNotFound): http.StatusNotFound
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCancelSipResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusConflict:
+			var (
+				body CancelSipNotAvailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "cancel_sip", err)
+			}
+			err = ValidateCancelSipNotAvailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "cancel_sip", err)
+			}
+			return nil, NewCancelSipNotAvailable(&body)
+		case http.StatusBadRequest:
+			var (
+				body CancelSipNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "cancel_sip", err)
+			}
+			err = ValidateCancelSipNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "cancel_sip", err)
+			}
+			return nil, NewCancelSipNotValid(&body)
+		case http.StatusNotFound:
+			var (
+				body CancelSipNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "cancel_sip", err)
+			}
+			err = ValidateCancelSipNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "cancel_sip", err)
+			}
+			return nil, NewCancelSipNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "cancel_sip", err)
+			}
+			return nil, NewCancelSipForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "cancel_sip", err)
+			}
+			return nil, NewCancelSipUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "cancel_sip", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildShowSipDecisionRequest instantiates a HTTP request object with method
 // and path set to call the "ingest" service "show_sip_decision" endpoint
 func (c *Client) BuildShowSipDecisionRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -1478,24 +1763,13 @@ func BuildUploadSipStreamPayload(payload any, fpath string) (*ingest.UploadSipRe
 	}, nil
 }
 
-// BuildDownloadSipRequestRequest instantiates a HTTP request object with
-// method and path set to call the "ingest" service "download_sip_request"
-// endpoint
-func (c *Client) BuildDownloadSipRequestRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		uuid string
-	)
-	{
-		p, ok := v.(*ingest.DownloadSipRequestPayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("ingest", "download_sip_request", "*ingest.DownloadSipRequestPayload", v)
-		}
-		uuid = p.UUID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadSipRequestIngestPath(uuid)}
+// BuildCreateSipUploadRequest instantiates a HTTP request object with method
+// and path set to call the "ingest" service "create_sip_upload" endpoint
+func (c *Client) BuildCreateSipUploadRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateSipUploadIngestPath()}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("ingest", "download_sip_request", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("ingest", "create_sip_upload", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1504,13 +1778,13 @@ func (c *Client) BuildDownloadSipRequestRequest(ctx context.Context, v any) (*ht
 	return req, nil
 }
 
-// EncodeDownloadSipRequestRequest returns an encoder for requests sent to the
-// ingest download_sip_request server.
-func EncodeDownloadSipRequestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCreateSipUploadRequest returns an encoder for requests sent to the
+// ingest create_sip_upload server.
+func EncodeCreateSipUploadRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*ingest.DownloadSipRequestPayload)
+		p, ok := v.(*ingest.CreateSipUploadPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("ingest", "download_sip_request", "*ingest.DownloadSipRequestPayload", v)
+			return goahttp.ErrInvalidType("ingest", "create_sip_upload", "*ingest.CreateSipUploadPayload", v)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -1520,21 +1794,24 @@ func EncodeDownloadSipRequestRequest(encoder func(*http.Request) goahttp.Encoder
 				req.Header.Set("Authorization", head)
 			}
 		}
+		body := NewCreateSipUploadRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("ingest", "create_sip_upload", err)
+		}
 		return nil
 	}
 }
 
-// DecodeDownloadSipRequestResponse returns a decoder for responses returned by
-// the ingest download_sip_request endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeDownloadSipRequestResponse may return the following errors:
+// DecodeCreateSipUploadResponse returns a decoder for responses returned by the
+// ingest create_sip_upload endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateSipUploadResponse may return the following errors:
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
-//   - "not_found" (type *ingest.SIPNotFound): http.StatusNotFound
 //   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeDownloadSipRequestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCreateSipUploadResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1549,66 +1826,203 @@ func DecodeDownloadSipRequestResponse(decoder func(*http.Response) goahttp.Decod
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusCreated:
 			var (
-				ticket    *string
-				ticketRaw string
-
-				cookies = resp.Cookies()
+				body CreateSipUploadResponseBody
+				err  error
 			)
-			for _, c := range cookies {
-				switch c.Name {
-				case "enduro-sip-download-ticket":
-					ticketRaw = c.Value
-				}
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "create_sip_upload", err)
 			}
-			if ticketRaw != "" {
-				ticket = &ticketRaw
+			err = ValidateCreateSipUploadResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "create_sip_upload", err)
 			}
-			res := NewDownloadSipRequestResultOK(ticket)
+			res := NewCreateSipUploadSIPUploadCreated(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body DownloadSipRequestNotValidResponseBody
+				body CreateSipUploadNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+				return nil, goahttp.ErrDecodingError("ingest", "create_sip_upload", err)
 			}
-			err = ValidateDownloadSipRequestNotValidResponseBody(&body)
+			err = ValidateCreateSipUploadNotValidResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "download_sip_request", err)
+				return nil, goahttp.ErrValidationError("ingest", "create_sip_upload", err)
 			}
-			return nil, NewDownloadSipRequestNotValid(&body)
+			return nil, NewCreateSipUploadNotValid(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DownloadSipRequestInternalErrorResponseBody
+				body CreateSipUploadInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+				return nil, goahttp.ErrDecodingError("ingest", "create_sip_upload", err)
 			}
-			err = ValidateDownloadSipRequestInternalErrorResponseBody(&body)
+			err = ValidateCreateSipUploadInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "download_sip_request", err)
+				return nil, goahttp.ErrValidationError("ingest", "create_sip_upload", err)
 			}
-			return nil, NewDownloadSipRequestInternalError(&body)
+			return nil, NewCreateSipUploadInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "create_sip_upload", err)
+			}
+			return nil, NewCreateSipUploadForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "create_sip_upload", err)
+			}
+			return nil, NewCreateSipUploadUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "create_sip_upload", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildShowSipUploadRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "show_sip_upload" endpoint
+func (c *Client) BuildShowSipUploadRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*ingest.ShowSipUploadPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("ingest", "show_sip_upload", "*ingest.ShowSipUploadPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ShowSipUploadIngestPath(uuid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "show_sip_upload", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeShowSipUploadRequest returns an encoder for requests sent to the ingest
+// show_sip_upload server.
+func EncodeShowSipUploadRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.ShowSipUploadPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "show_sip_upload", "*ingest.ShowSipUploadPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeShowSipUploadResponse returns a decoder for responses returned by the
+// ingest show_sip_upload endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeShowSipUploadResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeShowSipUploadResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ShowSipUploadResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "show_sip_upload", err)
+			}
+			err = ValidateShowSipUploadResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "show_sip_upload", err)
+			}
+			res := NewShowSipUploadSIPUploadOK(&body)
+			return res, nil
 		case http.StatusNotFound:
 			var (
-				body DownloadSipRequestNotFoundResponseBody
+				body ShowSipUploadNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+				return nil, goahttp.ErrDecodingError("ingest", "show_sip_upload", err)
 			}
-			err = ValidateDownloadSipRequestNotFoundResponseBody(&body)
+			err = ValidateShowSipUploadNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "download_sip_request", err)
+				return nil, goahttp.ErrValidationError("ingest", "show_sip_upload", err)
 			}
-			return nil, NewDownloadSipRequestNotFound(&body)
+			return nil, NewShowSipUploadNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body ShowSipUploadNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "show_sip_upload", err)
+			}
+			err = ValidateShowSipUploadNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "show_sip_upload", err)
+			}
+			return nil, NewShowSipUploadNotValid(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ShowSipUploadInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "show_sip_upload", err)
+			}
+			err = ValidateShowSipUploadInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "show_sip_upload", err)
+			}
+			return nil, NewShowSipUploadInternalError(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1616,9 +2030,9 @@ func DecodeDownloadSipRequestResponse(decoder func(*http.Response) goahttp.Decod
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+				return nil, goahttp.ErrDecodingError("ingest", "show_sip_upload", err)
 			}
-			return nil, NewDownloadSipRequestForbidden(body)
+			return nil, NewShowSipUploadForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1626,33 +2040,34 @@ func DecodeDownloadSipRequestResponse(decoder func(*http.Response) goahttp.Decod
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+				return nil, goahttp.ErrDecodingError("ingest", "show_sip_upload", err)
 			}
-			return nil, NewDownloadSipRequestUnauthorized(body)
+			return nil, NewShowSipUploadUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("ingest", "download_sip_request", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("ingest", "show_sip_upload", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDownloadSipRequest instantiates a HTTP request object with method and
-// path set to call the "ingest" service "download_sip" endpoint
-func (c *Client) BuildDownloadSipRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildUploadSipChunkRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "upload_sip_chunk" endpoint
+func (c *Client) BuildUploadSipChunkRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		uuid string
+		body io.Reader
 	)
-	{
-		p, ok := v.(*ingest.DownloadSipPayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("ingest", "download_sip", "*ingest.DownloadSipPayload", v)
-		}
-		uuid = p.UUID
+	rd, ok := v.(*ingest.UploadSipChunkRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("ingest", "upload_sip_chunk", "ingest.UploadSipChunkRequestData", v)
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadSipIngestPath(uuid)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	p := rd.Payload
+	body = rd.Body
+	uuid = p.UUID
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadSipChunkIngestPath(uuid)}
+	req, err := http.NewRequest("PATCH", u.String(), body)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("ingest", "download_sip", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("ingest", "upload_sip_chunk", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1661,36 +2076,48 @@ func (c *Client) BuildDownloadSipRequest(ctx context.Context, v any) (*http.Requ
 	return req, nil
 }
 
-// EncodeDownloadSipRequest returns an encoder for requests sent to the ingest
-// download_sip server.
-func EncodeDownloadSipRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUploadSipChunkRequest returns an encoder for requests sent to the
+// ingest upload_sip_chunk server.
+func EncodeUploadSipChunkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*ingest.DownloadSipPayload)
+		data, ok := v.(*ingest.UploadSipChunkRequestData)
 		if !ok {
-			return goahttp.ErrInvalidType("ingest", "download_sip", "*ingest.DownloadSipPayload", v)
+			return goahttp.ErrInvalidType("ingest", "upload_sip_chunk", "*ingest.UploadSipChunkRequestData", v)
 		}
-		if p.Ticket != nil {
-			v := *p.Ticket
-			req.AddCookie(&http.Cookie{
-				Name:  "enduro-sip-download-ticket",
-				Value: v,
-			})
+		p := data.Payload
+		{
+			head := p.Offset
+			headStr := strconv.FormatInt(head, 10)
+			req.Header.Set("Upload-Offset", headStr)
+		}
+		if p.Checksum != nil {
+			head := *p.Checksum
+			req.Header.Set("Upload-Checksum", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
 		}
 		return nil
 	}
 }
 
-// DecodeDownloadSipResponse returns a decoder for responses returned by the
-// ingest download_sip endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeDownloadSipResponse may return the following errors:
+// DecodeUploadSipChunkResponse returns a decoder for responses returned by the
+// ingest upload_sip_chunk endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUploadSipChunkResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_available" (type *goa.ServiceError): http.StatusConflict
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
-//   - "not_found" (type *ingest.SIPNotFound): http.StatusNotFound
 //   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeDownloadSipResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUploadSipChunkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1701,83 +2128,1159 @@ func DecodeDownloadSipResponse(decoder func(*http.Response) goahttp.Decoder, res
 			defer func() {
 				resp.Body = io.NopCloser(bytes.NewBuffer(b))
 			}()
+		} else {
+			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				contentType        string
-				contentLength      int64
-				contentDisposition string
-				err                error
+				body UploadSipChunkResponseBody
+				err  error
 			)
-			contentTypeRaw := resp.Header.Get("Content-Type")
-			if contentTypeRaw == "" {
-				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "upload_sip_chunk", err)
 			}
-			contentType = contentTypeRaw
-			{
-				contentLengthRaw := resp.Header.Get("Content-Length")
-				if contentLengthRaw == "" {
-					return nil, goahttp.ErrValidationError("ingest", "download_sip", goa.MissingFieldError("content_length", "header"))
-				}
-				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
-				}
-				contentLength = v
+			err = ValidateUploadSipChunkResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "upload_sip_chunk", err)
 			}
-			contentDispositionRaw := resp.Header.Get("Content-Disposition")
-			if contentDispositionRaw == "" {
-				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			res := NewUploadSipChunkSIPUploadOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body UploadSipChunkNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "upload_sip_chunk", err)
 			}
-			contentDisposition = contentDispositionRaw
+			err = ValidateUploadSipChunkNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "download_sip", err)
+				return nil, goahttp.ErrValidationError("ingest", "upload_sip_chunk", err)
+			}
+			return nil, NewUploadSipChunkNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body UploadSipChunkNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "upload_sip_chunk", err)
+			}
+			err = ValidateUploadSipChunkNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "upload_sip_chunk", err)
+			}
+			return nil, NewUploadSipChunkNotValid(&body)
+		case http.StatusConflict:
+			var (
+				body UploadSipChunkNotAvailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "upload_sip_chunk", err)
+			}
+			err = ValidateUploadSipChunkNotAvailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "upload_sip_chunk", err)
+			}
+			return nil, NewUploadSipChunkNotAvailable(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UploadSipChunkInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "upload_sip_chunk", err)
+			}
+			err = ValidateUploadSipChunkInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "upload_sip_chunk", err)
+			}
+			return nil, NewUploadSipChunkInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "upload_sip_chunk", err)
+			}
+			return nil, NewUploadSipChunkForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "upload_sip_chunk", err)
+			}
+			return nil, NewUploadSipChunkUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "upload_sip_chunk", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildUploadSipChunkStreamPayload creates a streaming endpoint request
+// payload from the method payload and the path to the file to be streamed
+func BuildUploadSipChunkStreamPayload(payload any, fpath string) (*ingest.UploadSipChunkRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &ingest.UploadSipChunkRequestData{
+		Payload: payload.(*ingest.UploadSipChunkPayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildDownloadSipRequestRequest instantiates a HTTP request object with
+// method and path set to call the "ingest" service "download_sip_request"
+// endpoint
+func (c *Client) BuildDownloadSipRequestRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*ingest.DownloadSipRequestPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("ingest", "download_sip_request", "*ingest.DownloadSipRequestPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadSipRequestIngestPath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "download_sip_request", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDownloadSipRequestRequest returns an encoder for requests sent to the
+// ingest download_sip_request server.
+func EncodeDownloadSipRequestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.DownloadSipRequestPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "download_sip_request", "*ingest.DownloadSipRequestPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDownloadSipRequestResponse returns a decoder for responses returned by
+// the ingest download_sip_request endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDownloadSipRequestResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not_found" (type *ingest.SIPNotFound): http.StatusNotFound
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDownloadSipRequestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				ticket    *string
+				ticketRaw string
+
+				cookies = resp.Cookies()
+			)
+			for _, c := range cookies {
+				switch c.Name {
+				case "enduro-sip-download-ticket":
+					ticketRaw = c.Value
+				}
+			}
+			if ticketRaw != "" {
+				ticket = &ticketRaw
+			}
+			res := NewDownloadSipRequestResultOK(ticket)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body DownloadSipRequestNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+			}
+			err = ValidateDownloadSipRequestNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "download_sip_request", err)
+			}
+			return nil, NewDownloadSipRequestNotValid(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DownloadSipRequestInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+			}
+			err = ValidateDownloadSipRequestInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "download_sip_request", err)
+			}
+			return nil, NewDownloadSipRequestInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body DownloadSipRequestNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+			}
+			err = ValidateDownloadSipRequestNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "download_sip_request", err)
+			}
+			return nil, NewDownloadSipRequestNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+			}
+			return nil, NewDownloadSipRequestForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip_request", err)
+			}
+			return nil, NewDownloadSipRequestUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "download_sip_request", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDownloadSipRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "download_sip" endpoint
+func (c *Client) BuildDownloadSipRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*ingest.DownloadSipPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("ingest", "download_sip", "*ingest.DownloadSipPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadSipIngestPath(uuid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "download_sip", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDownloadSipRequest returns an encoder for requests sent to the ingest
+// download_sip server.
+func EncodeDownloadSipRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.DownloadSipPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "download_sip", "*ingest.DownloadSipPayload", v)
+		}
+		if p.Ticket != nil {
+			v := *p.Ticket
+			req.AddCookie(&http.Cookie{
+				Name:  "enduro-sip-download-ticket",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeDownloadSipResponse returns a decoder for responses returned by the
+// ingest download_sip endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDownloadSipResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not_found" (type *ingest.SIPNotFound): http.StatusNotFound
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDownloadSipResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentLength      int64
+				contentDisposition string
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			{
+				contentLengthRaw := resp.Header.Get("Content-Length")
+				if contentLengthRaw == "" {
+					return nil, goahttp.ErrValidationError("ingest", "download_sip", goa.MissingFieldError("content_length", "header"))
+				}
+				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
+				}
+				contentLength = v
+			}
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "download_sip", err)
+			}
+			res := NewDownloadSipResultOK(contentType, contentLength, contentDisposition)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body DownloadSipNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+			}
+			err = ValidateDownloadSipNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "download_sip", err)
+			}
+			return nil, NewDownloadSipNotValid(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DownloadSipInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+			}
+			err = ValidateDownloadSipInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "download_sip", err)
+			}
+			return nil, NewDownloadSipInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body DownloadSipNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+			}
+			err = ValidateDownloadSipNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "download_sip", err)
+			}
+			return nil, NewDownloadSipNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+			}
+			return nil, NewDownloadSipForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+			}
+			return nil, NewDownloadSipUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "download_sip", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListUsersRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "list_users" endpoint
+func (c *Client) BuildListUsersRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListUsersIngestPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "list_users", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListUsersRequest returns an encoder for requests sent to the ingest
+// list_users server.
+func EncodeListUsersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.ListUsersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "list_users", "*ingest.ListUsersPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Email != nil {
+			values.Add("email", *p.Email)
+		}
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.Offset != nil {
+			values.Add("offset", fmt.Sprintf("%v", *p.Offset))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListUsersResponse returns a decoder for responses returned by the
+// ingest list_users endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListUsersResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListUsersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListUsersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_users", err)
+			}
+			p := NewListUsersUsersOK(&body)
+			view := "default"
+			vres := &ingestviews.Users{Projected: p, View: view}
+			if err = ingestviews.ValidateUsers(vres); err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_users", err)
+			}
+			res := ingest.NewUsers(vres)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListUsersNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_users", err)
+			}
+			err = ValidateListUsersNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_users", err)
+			}
+			return nil, NewListUsersNotValid(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_users", err)
+			}
+			return nil, NewListUsersForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_users", err)
+			}
+			return nil, NewListUsersUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "list_users", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListSipSourceObjectsRequest instantiates a HTTP request object with
+// method and path set to call the "ingest" service "list_sip_source_objects"
+// endpoint
+func (c *Client) BuildListSipSourceObjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*ingest.ListSipSourceObjectsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("ingest", "list_sip_source_objects", "*ingest.ListSipSourceObjectsPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSipSourceObjectsIngestPath(uuid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "list_sip_source_objects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListSipSourceObjectsRequest returns an encoder for requests sent to
+// the ingest list_sip_source_objects server.
+func EncodeListSipSourceObjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.ListSipSourceObjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "list_sip_source_objects", "*ingest.ListSipSourceObjectsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListSipSourceObjectsResponse returns a decoder for responses returned
+// by the ingest list_sip_source_objects endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListSipSourceObjectsResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListSipSourceObjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListSipSourceObjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+			}
+			p := NewListSipSourceObjectsSIPSourceObjectsOK(&body)
+			view := "default"
+			vres := &ingestviews.SIPSourceObjects{Projected: p, View: view}
+			if err = ingestviews.ValidateSIPSourceObjects(vres); err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_sip_source_objects", err)
+			}
+			res := ingest.NewSIPSourceObjects(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ListSipSourceObjectsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+			}
+			err = ValidateListSipSourceObjectsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_sip_source_objects", err)
+			}
+			return nil, NewListSipSourceObjectsNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListSipSourceObjectsNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+			}
+			err = ValidateListSipSourceObjectsNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_sip_source_objects", err)
+			}
+			return nil, NewListSipSourceObjectsNotValid(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListSipSourceObjectsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+			}
+			err = ValidateListSipSourceObjectsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_sip_source_objects", err)
+			}
+			return nil, NewListSipSourceObjectsInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+			}
+			return nil, NewListSipSourceObjectsForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+			}
+			return nil, NewListSipSourceObjectsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "list_sip_source_objects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddBatchRequest instantiates a HTTP request object with method and path
+// set to call the "ingest" service "add_batch" endpoint
+func (c *Client) BuildAddBatchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddBatchIngestPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "add_batch", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddBatchRequest returns an encoder for requests sent to the ingest
+// add_batch server.
+func EncodeAddBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.AddBatchPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "add_batch", "*ingest.AddBatchPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewAddBatchRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("ingest", "add_batch", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAddBatchResponse returns a decoder for responses returned by the
+// ingest add_batch endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeAddBatchResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeAddBatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body AddBatchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+			}
+			err = ValidateAddBatchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "add_batch", err)
+			}
+			res := NewAddBatchResultCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AddBatchNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+			}
+			err = ValidateAddBatchNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "add_batch", err)
+			}
+			return nil, NewAddBatchNotValid(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AddBatchInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+			}
+			err = ValidateAddBatchInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "add_batch", err)
+			}
+			return nil, NewAddBatchInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+			}
+			return nil, NewAddBatchForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+			}
+			return nil, NewAddBatchUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "add_batch", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListBatchesRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "list_batches" endpoint
+func (c *Client) BuildListBatchesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListBatchesIngestPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "list_batches", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListBatchesRequest returns an encoder for requests sent to the ingest
+// list_batches server.
+func EncodeListBatchesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.ListBatchesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "list_batches", "*ingest.ListBatchesPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Identifier != nil {
+			values.Add("identifier", *p.Identifier)
+		}
+		if p.EarliestCreatedTime != nil {
+			values.Add("earliest_created_time", *p.EarliestCreatedTime)
+		}
+		if p.LatestCreatedTime != nil {
+			values.Add("latest_created_time", *p.LatestCreatedTime)
+		}
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		if p.UploaderUUID != nil {
+			values.Add("uploader_uuid", *p.UploaderUUID)
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.Offset != nil {
+			values.Add("offset", fmt.Sprintf("%v", *p.Offset))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListBatchesResponse returns a decoder for responses returned by the
+// ingest list_batches endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListBatchesResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListBatchesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListBatchesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+			}
+			p := NewListBatchesBatchesOK(&body)
+			view := "default"
+			vres := &ingestviews.Batches{Projected: p, View: view}
+			if err = ingestviews.ValidateBatches(vres); err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_batches", err)
+			}
+			res := ingest.NewBatches(vres)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBatchesNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+			}
+			err = ValidateListBatchesNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_batches", err)
+			}
+			return nil, NewListBatchesNotValid(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListBatchesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+			}
+			err = ValidateListBatchesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "list_batches", err)
+			}
+			return nil, NewListBatchesInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+			}
+			return nil, NewListBatchesForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+			}
+			return nil, NewListBatchesUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("ingest", "list_batches", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildShowBatchRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "show_batch" endpoint
+func (c *Client) BuildShowBatchRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*ingest.ShowBatchPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("ingest", "show_batch", "*ingest.ShowBatchPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ShowBatchIngestPath(uuid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("ingest", "show_batch", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeShowBatchRequest returns an encoder for requests sent to the ingest
+// show_batch server.
+func EncodeShowBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*ingest.ShowBatchPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("ingest", "show_batch", "*ingest.ShowBatchPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeShowBatchResponse returns a decoder for responses returned by the
+// ingest show_batch endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeShowBatchResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not_found" (type *ingest.BatchNotFound): http.StatusNotFound
+//   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeShowBatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ShowBatchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
+			}
+			p := NewShowBatchBatchOK(&body)
+			view := "default"
+			vres := &ingestviews.Batch{Projected: p, View: view}
+			if err = ingestviews.ValidateBatch(vres); err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "show_batch", err)
 			}
-			res := NewDownloadSipResultOK(contentType, contentLength, contentDisposition)
+			res := ingest.NewBatch(vres)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body DownloadSipNotValidResponseBody
+				body ShowBatchNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
 			}
-			err = ValidateDownloadSipNotValidResponseBody(&body)
+			err = ValidateShowBatchNotValidResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "download_sip", err)
+				return nil, goahttp.ErrValidationError("ingest", "show_batch", err)
 			}
-			return nil, NewDownloadSipNotValid(&body)
+			return nil, NewShowBatchNotValid(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DownloadSipInternalErrorResponseBody
+				body ShowBatchInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
 			}
-			err = ValidateDownloadSipInternalErrorResponseBody(&body)
+			err = ValidateShowBatchInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "download_sip", err)
+				return nil, goahttp.ErrValidationError("ingest", "show_batch", err)
 			}
-			return nil, NewDownloadSipInternalError(&body)
+			return nil, NewShowBatchInternalError(&body)
 		case http.StatusNotFound:
 			var (
-				body DownloadSipNotFoundResponseBody
+				body ShowBatchNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
 			}
-			err = ValidateDownloadSipNotFoundResponseBody(&body)
+			err = ValidateShowBatchNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "download_sip", err)
+				return nil, goahttp.ErrValidationError("ingest", "show_batch", err)
 			}
-			return nil, NewDownloadSipNotFound(&body)
+			return nil, NewShowBatchNotFound(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1785,9 +3288,9 @@ func DecodeDownloadSipResponse(decoder func(*http.Response) goahttp.Decoder, res
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
 			}
-			return nil, NewDownloadSipForbidden(body)
+			return nil, NewShowBatchForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1795,23 +3298,33 @@ func DecodeDownloadSipResponse(decoder func(*http.Response) goahttp.Decoder, res
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "download_sip", err)
+				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
 			}
-			return nil, NewDownloadSipUnauthorized(body)
+			return nil, NewShowBatchUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("ingest", "download_sip", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("ingest", "show_batch", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListUsersRequest instantiates a HTTP request object with method and
-// path set to call the "ingest" service "list_users" endpoint
-func (c *Client) BuildListUsersRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListUsersIngestPath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildReviewBatchRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "review_batch" endpoint
+func (c *Client) BuildReviewBatchRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*ingest.ReviewBatchPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("ingest", "review_batch", "*ingest.ReviewBatchPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReviewBatchIngestPath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("ingest", "list_users", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("ingest", "review_batch", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1820,13 +3333,13 @@ func (c *Client) BuildListUsersRequest(ctx context.Context, v any) (*http.Reques
 	return req, nil
 }
 
-// EncodeListUsersRequest returns an encoder for requests sent to the ingest
-// list_users server.
-func EncodeListUsersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeReviewBatchRequest returns an encoder for requests sent to the ingest
+// review_batch server.
+func EncodeReviewBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*ingest.ListUsersPayload)
+		p, ok := v.(*ingest.ReviewBatchPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("ingest", "list_users", "*ingest.ListUsersPayload", v)
+			return goahttp.ErrInvalidType("ingest", "review_batch", "*ingest.ReviewBatchPayload", v)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -1836,33 +3349,25 @@ func EncodeListUsersRequest(encoder func(*http.Request) goahttp.Encoder) func(*h
 				req.Header.Set("Authorization", head)
 			}
 		}
-		values := req.URL.Query()
-		if p.Email != nil {
-			values.Add("email", *p.Email)
-		}
-		if p.Name != nil {
-			values.Add("name", *p.Name)
-		}
-		if p.Limit != nil {
-			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
-		}
-		if p.Offset != nil {
-			values.Add("offset", fmt.Sprintf("%v", *p.Offset))
+		body := NewReviewBatchRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("ingest", "review_batch", err)
 		}
-		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeListUsersResponse returns a decoder for responses returned by the
-// ingest list_users endpoint. restoreBody controls whether the response body
+// DecodeReviewBatchResponse returns a decoder for responses returned by the
+// ingest review_batch endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
-// DecodeListUsersResponse may return the following errors:
+// DecodeReviewBatchResponse may return the following errors:
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not_found" (type *ingest.BatchNotFound): http.StatusNotFound
 //   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeListUsersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeReviewBatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1877,37 +3382,50 @@ func DecodeListUsersResponse(decoder func(*http.Response) goahttp.Decoder, resto
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusBadRequest:
 			var (
-				body ListUsersResponseBody
+				body ReviewBatchNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_users", err)
+				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
 			}
-			p := NewListUsersUsersOK(&body)
-			view := "default"
-			vres := &ingestviews.Users{Projected: p, View: view}
-			if err = ingestviews.ValidateUsers(vres); err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_users", err)
+			err = ValidateReviewBatchNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "review_batch", err)
 			}
-			res := ingest.NewUsers(vres)
-			return res, nil
-		case http.StatusBadRequest:
+			return nil, NewReviewBatchNotValid(&body)
+		case http.StatusInternalServerError:
 			var (
-				body ListUsersNotValidResponseBody
+				body ReviewBatchInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_users", err)
+				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
 			}
-			err = ValidateListUsersNotValidResponseBody(&body)
+			err = ValidateReviewBatchInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_users", err)
+				return nil, goahttp.ErrValidationError("ingest", "review_batch", err)
 			}
-			return nil, NewListUsersNotValid(&body)
+			return nil, NewReviewBatchInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ReviewBatchNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
+			}
+			err = ValidateReviewBatchNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "review_batch", err)
+			}
+			return nil, NewReviewBatchNotFound(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1915,9 +3433,9 @@ func DecodeListUsersResponse(decoder func(*http.Response) goahttp.Decoder, resto
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_users", err)
+				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
 			}
-			return nil, NewListUsersForbidden(body)
+			return nil, NewReviewBatchForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1925,34 +3443,33 @@ func DecodeListUsersResponse(decoder func(*http.Response) goahttp.Decoder, resto
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_users", err)
+				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
 			}
-			return nil, NewListUsersUnauthorized(body)
+			return nil, NewReviewBatchUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("ingest", "list_users", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("ingest", "review_batch", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListSipSourceObjectsRequest instantiates a HTTP request object with
-// method and path set to call the "ingest" service "list_sip_source_objects"
-// endpoint
-func (c *Client) BuildListSipSourceObjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildRetryBatchRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "retry_batch" endpoint
+func (c *Client) BuildRetryBatchRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		uuid string
 	)
 	{
-		p, ok := v.(*ingest.ListSipSourceObjectsPayload)
+		p, ok := v.(*ingest.RetryBatchPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("ingest", "list_sip_source_objects", "*ingest.ListSipSourceObjectsPayload", v)
+			return nil, goahttp.ErrInvalidType("ingest", "retry_batch", "*ingest.RetryBatchPayload", v)
 		}
 		uuid = p.UUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSipSourceObjectsIngestPath(uuid)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RetryBatchIngestPath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("ingest", "list_sip_source_objects", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("ingest", "retry_batch", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1961,13 +3478,13 @@ func (c *Client) BuildListSipSourceObjectsRequest(ctx context.Context, v any) (*
 	return req, nil
 }
 
-// EncodeListSipSourceObjectsRequest returns an encoder for requests sent to
-// the ingest list_sip_source_objects server.
-func EncodeListSipSourceObjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRetryBatchRequest returns an encoder for requests sent to the ingest
+// retry_batch server.
+func EncodeRetryBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*ingest.ListSipSourceObjectsPayload)
+		p, ok := v.(*ingest.RetryBatchPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("ingest", "list_sip_source_objects", "*ingest.ListSipSourceObjectsPayload", v)
+			return goahttp.ErrInvalidType("ingest", "retry_batch", "*ingest.RetryBatchPayload", v)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -1977,29 +3494,21 @@ func EncodeListSipSourceObjectsRequest(encoder func(*http.Request) goahttp.Encod
 				req.Header.Set("Authorization", head)
 			}
 		}
-		values := req.URL.Query()
-		if p.Limit != nil {
-			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
-		}
-		if p.Cursor != nil {
-			values.Add("cursor", *p.Cursor)
-		}
-		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeListSipSourceObjectsResponse returns a decoder for responses returned
-// by the ingest list_sip_source_objects endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeListSipSourceObjectsResponse may return the following errors:
-//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+// DecodeRetryBatchResponse returns a decoder for responses returned by the
+// ingest retry_batch endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRetryBatchResponse may return the following errors:
+//   - "not_available" (type *goa.ServiceError): http.StatusConflict
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
-//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not_found" (type *ingest.BatchNotFound): http.StatusNotFound
 //   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeListSipSourceObjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRetryBatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2014,65 +3523,50 @@ func DecodeListSipSourceObjectsResponse(decoder func(*http.Response) goahttp.Dec
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
-			var (
-				body ListSipSourceObjectsResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
-			}
-			p := NewListSipSourceObjectsSIPSourceObjectsOK(&body)
-			view := "default"
-			vres := &ingestviews.SIPSourceObjects{Projected: p, View: view}
-			if err = ingestviews.ValidateSIPSourceObjects(vres); err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_sip_source_objects", err)
-			}
-			res := ingest.NewSIPSourceObjects(vres)
-			return res, nil
-		case http.StatusNotFound:
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusConflict:
 			var (
-				body ListSipSourceObjectsNotFoundResponseBody
+				body RetryBatchNotAvailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+				return nil, goahttp.ErrDecodingError("ingest", "retry_batch", err)
 			}
-			err = ValidateListSipSourceObjectsNotFoundResponseBody(&body)
+			err = ValidateRetryBatchNotAvailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_sip_source_objects", err)
+				return nil, goahttp.ErrValidationError("ingest", "retry_batch", err)
 			}
-			return nil, NewListSipSourceObjectsNotFound(&body)
+			return nil, NewRetryBatchNotAvailable(&body)
 		case http.StatusBadRequest:
 			var (
-				body ListSipSourceObjectsNotValidResponseBody
+				body RetryBatchNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+				return nil, goahttp.ErrDecodingError("ingest", "retry_batch", err)
 			}
-			err = ValidateListSipSourceObjectsNotValidResponseBody(&body)
+			err = ValidateRetryBatchNotValidResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_sip_source_objects", err)
+				return nil, goahttp.ErrValidationError("ingest", "retry_batch", err)
 			}
-			return nil, NewListSipSourceObjectsNotValid(&body)
-		case http.StatusInternalServerError:
+			return nil, NewRetryBatchNotValid(&body)
+		case http.StatusNotFound:
 			var (
-				body ListSipSourceObjectsInternalErrorResponseBody
+				body RetryBatchNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+				return nil, goahttp.ErrDecodingError("ingest", "retry_batch", err)
 			}
-			err = ValidateListSipSourceObjectsInternalErrorResponseBody(&body)
+			err = ValidateRetryBatchNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_sip_source_objects", err)
+				return nil, goahttp.ErrValidationError("ingest", "retry_batch", err)
 			}
-			return nil, NewListSipSourceObjectsInternalError(&body)
+			return nil, NewRetryBatchNotFound(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -2080,9 +3574,9 @@ func DecodeListSipSourceObjectsResponse(decoder func(*http.Response) goahttp.Dec
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+				return nil, goahttp.ErrDecodingError("ingest", "retry_batch", err)
 			}
-			return nil, NewListSipSourceObjectsForbidden(body)
+			return nil, NewRetryBatchForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -2090,23 +3584,23 @@ func DecodeListSipSourceObjectsResponse(decoder func(*http.Response) goahttp.Dec
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_sip_source_objects", err)
+				return nil, goahttp.ErrDecodingError("ingest", "retry_batch", err)
 			}
-			return nil, NewListSipSourceObjectsUnauthorized(body)
+			return nil, NewRetryBatchUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("ingest", "list_sip_source_objects", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("ingest", "retry_batch", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildAddBatchRequest instantiates a HTTP request object with method and path
-// set to call the "ingest" service "add_batch" endpoint
-func (c *Client) BuildAddBatchRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddBatchIngestPath()}
+// BuildCreateAPITokenRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "create_api_token" endpoint
+func (c *Client) BuildCreateAPITokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateAPITokenIngestPath()}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("ingest", "add_batch", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("ingest", "create_api_token", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2115,13 +3609,13 @@ func (c *Client) BuildAddBatchRequest(ctx context.Context, v any) (*http.Request
 	return req, nil
 }
 
-// EncodeAddBatchRequest returns an encoder for requests sent to the ingest
-// add_batch server.
-func EncodeAddBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCreateAPITokenRequest returns an encoder for requests sent to the
+// ingest create_api_token server.
+func EncodeCreateAPITokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*ingest.AddBatchPayload)
+		p, ok := v.(*ingest.CreateAPITokenPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("ingest", "add_batch", "*ingest.AddBatchPayload", v)
+			return goahttp.ErrInvalidType("ingest", "create_api_token", "*ingest.CreateAPITokenPayload", v)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -2131,24 +3625,24 @@ func EncodeAddBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*ht
 				req.Header.Set("Authorization", head)
 			}
 		}
-		body := NewAddBatchRequestBody(p)
+		body := NewCreateAPITokenRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("ingest", "add_batch", err)
+			return goahttp.ErrEncodingError("ingest", "create_api_token", err)
 		}
 		return nil
 	}
 }
 
-// DecodeAddBatchResponse returns a decoder for responses returned by the
-// ingest add_batch endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeAddBatchResponse may return the following errors:
+// DecodeCreateAPITokenResponse returns a decoder for responses returned by the
+// ingest create_api_token endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateAPITokenResponse may return the following errors:
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeAddBatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCreateAPITokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2165,47 +3659,47 @@ func DecodeAddBatchResponse(decoder func(*http.Response) goahttp.Decoder, restor
 		switch resp.StatusCode {
 		case http.StatusCreated:
 			var (
-				body AddBatchResponseBody
+				body CreateAPITokenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "create_api_token", err)
 			}
-			err = ValidateAddBatchResponseBody(&body)
+			err = ValidateCreateAPITokenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "add_batch", err)
+				return nil, goahttp.ErrValidationError("ingest", "create_api_token", err)
 			}
-			res := NewAddBatchResultCreated(&body)
+			res := NewCreateAPITokenAPITokenCreated(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body AddBatchNotValidResponseBody
+				body CreateAPITokenNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "create_api_token", err)
 			}
-			err = ValidateAddBatchNotValidResponseBody(&body)
+			err = ValidateCreateAPITokenNotValidResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "add_batch", err)
+				return nil, goahttp.ErrValidationError("ingest", "create_api_token", err)
 			}
-			return nil, NewAddBatchNotValid(&body)
+			return nil, NewCreateAPITokenNotValid(&body)
 		case http.StatusInternalServerError:
 			var (
-				body AddBatchInternalErrorResponseBody
+				body CreateAPITokenInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "create_api_token", err)
 			}
-			err = ValidateAddBatchInternalErrorResponseBody(&body)
+			err = ValidateCreateAPITokenInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "add_batch", err)
+				return nil, goahttp.ErrValidationError("ingest", "create_api_token", err)
 			}
-			return nil, NewAddBatchInternalError(&body)
+			return nil, NewCreateAPITokenInternalError(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -2213,9 +3707,9 @@ func DecodeAddBatchResponse(decoder func(*http.Response) goahttp.Decoder, restor
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "create_api_token", err)
 			}
-			return nil, NewAddBatchForbidden(body)
+			return nil, NewCreateAPITokenForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -2223,23 +3717,23 @@ func DecodeAddBatchResponse(decoder func(*http.Response) goahttp.Decoder, restor
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "add_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "create_api_token", err)
 			}
-			return nil, NewAddBatchUnauthorized(body)
+			return nil, NewCreateAPITokenUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("ingest", "add_batch", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("ingest", "create_api_token", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListBatchesRequest instantiates a HTTP request object with method and
-// path set to call the "ingest" service "list_batches" endpoint
-func (c *Client) BuildListBatchesRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListBatchesIngestPath()}
+// BuildListAPITokensRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "list_api_tokens" endpoint
+func (c *Client) BuildListAPITokensRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAPITokensIngestPath()}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("ingest", "list_batches", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("ingest", "list_api_tokens", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2248,13 +3742,13 @@ func (c *Client) BuildListBatchesRequest(ctx context.Context, v any) (*http.Requ
 	return req, nil
 }
 
-// EncodeListBatchesRequest returns an encoder for requests sent to the ingest
-// list_batches server.
-func EncodeListBatchesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeListAPITokensRequest returns an encoder for requests sent to the ingest
+// list_api_tokens server.
+func EncodeListAPITokensRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*ingest.ListBatchesPayload)
+		p, ok := v.(*ingest.ListAPITokensPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("ingest", "list_batches", "*ingest.ListBatchesPayload", v)
+			return goahttp.ErrInvalidType("ingest", "list_api_tokens", "*ingest.ListAPITokensPayload", v)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -2264,43 +3758,19 @@ func EncodeListBatchesRequest(encoder func(*http.Request) goahttp.Encoder) func(
 				req.Header.Set("Authorization", head)
 			}
 		}
-		values := req.URL.Query()
-		if p.Identifier != nil {
-			values.Add("identifier", *p.Identifier)
-		}
-		if p.EarliestCreatedTime != nil {
-			values.Add("earliest_created_time", *p.EarliestCreatedTime)
-		}
-		if p.LatestCreatedTime != nil {
-			values.Add("latest_created_time", *p.LatestCreatedTime)
-		}
-		if p.Status != nil {
-			values.Add("status", *p.Status)
-		}
-		if p.UploaderUUID != nil {
-			values.Add("uploader_uuid", *p.UploaderUUID)
-		}
-		if p.Limit != nil {
-			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
-		}
-		if p.Offset != nil {
-			values.Add("offset", fmt.Sprintf("%v", *p.Offset))
-		}
-		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeListBatchesResponse returns a decoder for responses returned by the
-// ingest list_batches endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeListBatchesResponse may return the following errors:
-//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+// DecodeListAPITokensResponse returns a decoder for responses returned by the
+// ingest list_api_tokens endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListAPITokensResponse may return the following errors:
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeListBatchesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListAPITokensResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2317,49 +3787,33 @@ func DecodeListBatchesResponse(decoder func(*http.Response) goahttp.Decoder, res
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ListBatchesResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
-			}
-			p := NewListBatchesBatchesOK(&body)
-			view := "default"
-			vres := &ingestviews.Batches{Projected: p, View: view}
-			if err = ingestviews.ValidateBatches(vres); err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_batches", err)
-			}
-			res := ingest.NewBatches(vres)
-			return res, nil
-		case http.StatusBadRequest:
-			var (
-				body ListBatchesNotValidResponseBody
+				body ListAPITokensResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+				return nil, goahttp.ErrDecodingError("ingest", "list_api_tokens", err)
 			}
-			err = ValidateListBatchesNotValidResponseBody(&body)
+			err = ValidateListAPITokensResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_batches", err)
+				return nil, goahttp.ErrValidationError("ingest", "list_api_tokens", err)
 			}
-			return nil, NewListBatchesNotValid(&body)
+			res := NewListAPITokensAPITokensOK(&body)
+			return res, nil
 		case http.StatusInternalServerError:
 			var (
-				body ListBatchesInternalErrorResponseBody
+				body ListAPITokensInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+				return nil, goahttp.ErrDecodingError("ingest", "list_api_tokens", err)
 			}
-			err = ValidateListBatchesInternalErrorResponseBody(&body)
+			err = ValidateListAPITokensInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "list_batches", err)
+				return nil, goahttp.ErrValidationError("ingest", "list_api_tokens", err)
 			}
-			return nil, NewListBatchesInternalError(&body)
+			return nil, NewListAPITokensInternalError(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -2367,9 +3821,9 @@ func DecodeListBatchesResponse(decoder func(*http.Response) goahttp.Decoder, res
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+				return nil, goahttp.ErrDecodingError("ingest", "list_api_tokens", err)
 			}
-			return nil, NewListBatchesForbidden(body)
+			return nil, NewListAPITokensForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -2377,33 +3831,33 @@ func DecodeListBatchesResponse(decoder func(*http.Response) goahttp.Decoder, res
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "list_batches", err)
+				return nil, goahttp.ErrDecodingError("ingest", "list_api_tokens", err)
 			}
-			return nil, NewListBatchesUnauthorized(body)
+			return nil, NewListAPITokensUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("ingest", "list_batches", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("ingest", "list_api_tokens", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildShowBatchRequest instantiates a HTTP request object with method and
-// path set to call the "ingest" service "show_batch" endpoint
-func (c *Client) BuildShowBatchRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildRevokeAPITokenRequest instantiates a HTTP request object with method and
+// path set to call the "ingest" service "revoke_api_token" endpoint
+func (c *Client) BuildRevokeAPITokenRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		uuid string
 	)
 	{
-		p, ok := v.(*ingest.ShowBatchPayload)
+		p, ok := v.(*ingest.RevokeAPITokenPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("ingest", "show_batch", "*ingest.ShowBatchPayload", v)
+			return nil, goahttp.ErrInvalidType("ingest", "revoke_api_token", "*ingest.RevokeAPITokenPayload", v)
 		}
 		uuid = p.UUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ShowBatchIngestPath(uuid)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeAPITokenIngestPath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("ingest", "show_batch", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("ingest", "revoke_api_token", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2412,13 +3866,13 @@ func (c *Client) BuildShowBatchRequest(ctx context.Context, v any) (*http.Reques
 	return req, nil
 }
 
-// EncodeShowBatchRequest returns an encoder for requests sent to the ingest
-// show_batch server.
-func EncodeShowBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRevokeAPITokenRequest returns an encoder for requests sent to the
+// ingest revoke_api_token server.
+func EncodeRevokeAPITokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*ingest.ShowBatchPayload)
+		p, ok := v.(*ingest.RevokeAPITokenPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("ingest", "show_batch", "*ingest.ShowBatchPayload", v)
+			return goahttp.ErrInvalidType("ingest", "revoke_api_token", "*ingest.RevokeAPITokenPayload", v)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -2432,17 +3886,17 @@ func EncodeShowBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*h
 	}
 }
 
-// DecodeShowBatchResponse returns a decoder for responses returned by the
-// ingest show_batch endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeShowBatchResponse may return the following errors:
+// DecodeRevokeAPITokenResponse returns a decoder for responses returned by the
+// ingest revoke_api_token endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRevokeAPITokenResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
-//   - "not_found" (type *ingest.BatchNotFound): http.StatusNotFound
 //   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeShowBatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRevokeAPITokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2457,65 +3911,50 @@ func DecodeShowBatchResponse(decoder func(*http.Response) goahttp.Decoder, resto
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
 			var (
-				body ShowBatchResponseBody
+				body RevokeAPITokenNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "revoke_api_token", err)
 			}
-			p := NewShowBatchBatchOK(&body)
-			view := "default"
-			vres := &ingestviews.Batch{Projected: p, View: view}
-			if err = ingestviews.ValidateBatch(vres); err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "show_batch", err)
+			err = ValidateRevokeAPITokenNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("ingest", "revoke_api_token", err)
 			}
-			res := ingest.NewBatch(vres)
-			return res, nil
+			return nil, NewRevokeAPITokenNotFound(&body)
 		case http.StatusBadRequest:
 			var (
-				body ShowBatchNotValidResponseBody
+				body RevokeAPITokenNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "revoke_api_token", err)
 			}
-			err = ValidateShowBatchNotValidResponseBody(&body)
+			err = ValidateRevokeAPITokenNotValidResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "show_batch", err)
+				return nil, goahttp.ErrValidationError("ingest", "revoke_api_token", err)
 			}
-			return nil, NewShowBatchNotValid(&body)
+			return nil, NewRevokeAPITokenNotValid(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ShowBatchInternalErrorResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
-			}
-			err = ValidateShowBatchInternalErrorResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "show_batch", err)
-			}
-			return nil, NewShowBatchInternalError(&body)
-		case http.StatusNotFound:
-			var (
-				body ShowBatchNotFoundResponseBody
+				body RevokeAPITokenInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "revoke_api_token", err)
 			}
-			err = ValidateShowBatchNotFoundResponseBody(&body)
+			err = ValidateRevokeAPITokenInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "show_batch", err)
+				return nil, goahttp.ErrValidationError("ingest", "revoke_api_token", err)
 			}
-			return nil, NewShowBatchNotFound(&body)
+			return nil, NewRevokeAPITokenInternalError(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -2523,9 +3962,9 @@ func DecodeShowBatchResponse(decoder func(*http.Response) goahttp.Decoder, resto
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "revoke_api_token", err)
 			}
-			return nil, NewShowBatchForbidden(body)
+			return nil, NewRevokeAPITokenForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -2533,33 +3972,23 @@ func DecodeShowBatchResponse(decoder func(*http.Response) goahttp.Decoder, resto
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "show_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "revoke_api_token", err)
 			}
-			return nil, NewShowBatchUnauthorized(body)
+			return nil, NewRevokeAPITokenUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("ingest", "show_batch", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("ingest", "revoke_api_token", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildReviewBatchRequest instantiates a HTTP request object with method and
-// path set to call the "ingest" service "review_batch" endpoint
-func (c *Client) BuildReviewBatchRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		uuid string
-	)
-	{
-		p, ok := v.(*ingest.ReviewBatchPayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("ingest", "review_batch", "*ingest.ReviewBatchPayload", v)
-		}
-		uuid = p.UUID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReviewBatchIngestPath(uuid)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+// BuildSearchRequest instantiates a HTTP request object with method and path
+// set to call the "ingest" service "search" endpoint
+func (c *Client) BuildSearchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchIngestPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("ingest", "review_batch", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("ingest", "search", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2568,13 +3997,13 @@ func (c *Client) BuildReviewBatchRequest(ctx context.Context, v any) (*http.Requ
 	return req, nil
 }
 
-// EncodeReviewBatchRequest returns an encoder for requests sent to the ingest
-// review_batch server.
-func EncodeReviewBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeSearchRequest returns an encoder for requests sent to the ingest search
+// server.
+func EncodeSearchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*ingest.ReviewBatchPayload)
+		p, ok := v.(*ingest.SearchPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("ingest", "review_batch", "*ingest.ReviewBatchPayload", v)
+			return goahttp.ErrInvalidType("ingest", "search", "*ingest.SearchPayload", v)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -2584,25 +4013,28 @@ func EncodeReviewBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(
 				req.Header.Set("Authorization", head)
 			}
 		}
-		body := NewReviewBatchRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("ingest", "review_batch", err)
+		values := req.URL.Query()
+		values.Add("query", p.Query)
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.Offset != nil {
+			values.Add("offset", fmt.Sprintf("%v", *p.Offset))
 		}
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeReviewBatchResponse returns a decoder for responses returned by the
-// ingest review_batch endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeReviewBatchResponse may return the following errors:
+// DecodeSearchResponse returns a decoder for responses returned by the ingest
+// search endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeSearchResponse may return the following errors:
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
-//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
-//   - "not_found" (type *ingest.BatchNotFound): http.StatusNotFound
 //   - "forbidden" (type ingest.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type ingest.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeReviewBatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeSearchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2617,50 +4049,35 @@ func DecodeReviewBatchResponse(decoder func(*http.Response) goahttp.Decoder, res
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusAccepted:
-			return nil, nil
-		case http.StatusBadRequest:
-			var (
-				body ReviewBatchNotValidResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
-			}
-			err = ValidateReviewBatchNotValidResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "review_batch", err)
-			}
-			return nil, NewReviewBatchNotValid(&body)
-		case http.StatusInternalServerError:
+		case http.StatusOK:
 			var (
-				body ReviewBatchInternalErrorResponseBody
+				body SearchResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "search", err)
 			}
-			err = ValidateReviewBatchInternalErrorResponseBody(&body)
+			err = ValidateSearchResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "review_batch", err)
+				return nil, goahttp.ErrValidationError("ingest", "search", err)
 			}
-			return nil, NewReviewBatchInternalError(&body)
-		case http.StatusNotFound:
+			res := NewSearchResultsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
 			var (
-				body ReviewBatchNotFoundResponseBody
+				body SearchNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "search", err)
 			}
-			err = ValidateReviewBatchNotFoundResponseBody(&body)
+			err = ValidateSearchNotValidResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("ingest", "review_batch", err)
+				return nil, goahttp.ErrValidationError("ingest", "search", err)
 			}
-			return nil, NewReviewBatchNotFound(&body)
+			return nil, NewSearchNotValid(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -2668,9 +4085,9 @@ func DecodeReviewBatchResponse(decoder func(*http.Response) goahttp.Decoder, res
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "search", err)
 			}
-			return nil, NewReviewBatchForbidden(body)
+			return nil, NewSearchForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -2678,12 +4095,12 @@ func DecodeReviewBatchResponse(decoder func(*http.Response) goahttp.Decoder, res
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("ingest", "review_batch", err)
+				return nil, goahttp.ErrDecodingError("ingest", "search", err)
 			}
-			return nil, NewReviewBatchUnauthorized(body)
+			return nil, NewSearchUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("ingest", "review_batch", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("ingest", "search", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -2738,6 +4155,14 @@ func unmarshalSIPResponseBodyToIngestSIP(v *SIPResponseBody) *ingest.SIP {
 		BatchStatus:     v.BatchStatus,
 		FileCount:       v.FileCount,
 	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
 
 	return res
 }
@@ -2947,6 +4372,14 @@ func unmarshalSIPResponseBodyToIngestviewsSIPView(v *SIPResponseBody) *ingestvie
 		BatchStatus:     v.BatchStatus,
 		FileCount:       v.FileCount,
 	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
 
 	return res
 }
@@ -3058,3 +4491,62 @@ func unmarshalBatchResponseBodyToIngestviewsBatchView(v *BatchResponseBody) *ing
 
 	return res
 }
+
+// unmarshalAPITokenResponseBodyToIngestAPIToken builds a value of type
+// *ingest.APIToken from a value of type *APITokenResponseBody.
+func unmarshalAPITokenResponseBodyToIngestAPIToken(v *APITokenResponseBody) *ingest.APIToken {
+	if v == nil {
+		return nil
+	}
+	res := &ingest.APIToken{
+		UUID:       *v.UUID,
+		Name:       *v.Name,
+		CreatedAt:  *v.CreatedAt,
+		ExpiresAt:  v.ExpiresAt,
+		LastUsedAt: v.LastUsedAt,
+		RevokedAt:  v.RevokedAt,
+		Token:      v.Token,
+	}
+	if v.Attributes != nil {
+		res.Attributes = make([]string, len(v.Attributes))
+		for i, val := range v.Attributes {
+			res.Attributes[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalSearchResultResponseBodyToIngestSearchResult builds a value of type
+// *ingest.SearchResult from a value of type *SearchResultResponseBody.
+func unmarshalSearchResultResponseBodyToIngestSearchResult(v *SearchResultResponseBody) *ingest.SearchResult {
+	if v == nil {
+		return nil
+	}
+	res := &ingest.SearchResult{
+		UUID: *v.UUID,
+		Name: *v.Name,
+	}
+	if v.Fields != nil {
+		res.Fields = make(map[string]string, len(v.Fields))
+		for key, val := range v.Fields {
+			tk := key
+			tv := val
+			res.Fields[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalEnduroPageResponseBodyToIngestEnduroPage builds a value of type
+// *ingest.EnduroPage from a value of type *EnduroPageResponseBody.
+func unmarshalEnduroPageResponseBodyToIngestEnduroPage(v *EnduroPageResponseBody) *ingest.EnduroPage {
+	res := &ingest.EnduroPage{
+		Limit:  *v.Limit,
+		Offset: *v.Offset,
+		Total:  *v.Total,
+	}
+
+	return res
+}
@@ -42,6 +42,16 @@ func RejectSipIngestPath(uuid string) string {
 	return fmt.Sprintf("/ingest/sips/%v/reject", uuid)
 }
 
+// RetrySipIngestPath returns the URL path to the ingest service retry_sip HTTP endpoint.
+func RetrySipIngestPath(uuid string) string {
+	return fmt.Sprintf("/ingest/sips/%v/retry", uuid)
+}
+
+// CancelSipIngestPath returns the URL path to the ingest service cancel_sip HTTP endpoint.
+func CancelSipIngestPath(uuid string) string {
+	return fmt.Sprintf("/ingest/sips/%v/cancel", uuid)
+}
+
 // ShowSipDecisionIngestPath returns the URL path to the ingest service show_sip_decision HTTP endpoint.
 func ShowSipDecisionIngestPath(uuid string) string {
 	return fmt.Sprintf("/ingest/sips/%v/decision", uuid)
@@ -62,6 +72,21 @@ func UploadSipIngestPath() string {
 	return "/ingest/sips/upload"
 }
 
+// CreateSipUploadIngestPath returns the URL path to the ingest service create_sip_upload HTTP endpoint.
+func CreateSipUploadIngestPath() string {
+	return "/ingest/sips/uploads"
+}
+
+// ShowSipUploadIngestPath returns the URL path to the ingest service show_sip_upload HTTP endpoint.
+func ShowSipUploadIngestPath(uuid string) string {
+	return fmt.Sprintf("/ingest/sips/uploads/%v", uuid)
+}
+
+// UploadSipChunkIngestPath returns the URL path to the ingest service upload_sip_chunk HTTP endpoint.
+func UploadSipChunkIngestPath(uuid string) string {
+	return fmt.Sprintf("/ingest/sips/uploads/%v", uuid)
+}
+
 // DownloadSipRequestIngestPath returns the URL path to the ingest service download_sip_request HTTP endpoint.
 func DownloadSipRequestIngestPath(uuid string) string {
 	return fmt.Sprintf("/ingest/sips/%v/download", uuid)
@@ -101,3 +126,28 @@ func ShowBatchIngestPath(uuid string) string {
 func ReviewBatchIngestPath(uuid string) string {
 	return fmt.Sprintf("/ingest/batches/%v/review", uuid)
 }
+
+// RetryBatchIngestPath returns the URL path to the ingest service retry_batch HTTP endpoint.
+func RetryBatchIngestPath(uuid string) string {
+	return fmt.Sprintf("/ingest/batches/%v/retry", uuid)
+}
+
+// CreateAPITokenIngestPath returns the URL path to the ingest service create_api_token HTTP endpoint.
+func CreateAPITokenIngestPath() string {
+	return "/ingest/api-tokens"
+}
+
+// ListAPITokensIngestPath returns the URL path to the ingest service list_api_tokens HTTP endpoint.
+func ListAPITokensIngestPath() string {
+	return "/ingest/api-tokens"
+}
+
+// RevokeAPITokenIngestPath returns the URL path to the ingest service revoke_api_token HTTP endpoint.
+func RevokeAPITokenIngestPath(uuid string) string {
+	return fmt.Sprintf("/ingest/api-tokens/%v/revoke", uuid)
+}
+
+// SearchIngestPath returns the URL path to the ingest service search HTTP endpoint.
+func SearchIngestPath() string {
+	return "/ingest/search"
+}
@@ -39,6 +39,25 @@ type AddSipRequestBody struct {
 	SourceID string `form:"source_id" json:"source_id" xml:"source_id"`
 	// Key of the item to ingest
 	Key string `form:"key" json:"key" xml:"key"`
+	// Name of the processing profile to use for the SIP
+	ProcessingProfile *string `form:"processing_profile,omitempty" json:"processing_profile,omitempty" xml:"processing_profile,omitempty"`
+	// Identifier of the storage location of the AIP
+	LocationID *string `form:"location_id,omitempty" json:"location_id,omitempty" xml:"location_id,omitempty"`
+}
+
+// CreateSipUploadRequestBody is the type of the "ingest" service
+// "create_sip_upload" endpoint HTTP request body.
+type CreateSipUploadRequestBody struct {
+	// File name of the SIP
+	Name string `form:"name" json:"name" xml:"name"`
+	// Size of the SIP in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// Expected checksum of the SIP, e.g. "sha256:9f86d0..."
+	Checksum *string `form:"checksum,omitempty" json:"checksum,omitempty" xml:"checksum,omitempty"`
+	// Name of the processing profile to use for the SIP
+	ProcessingProfile *string `form:"processing_profile,omitempty" json:"processing_profile,omitempty" xml:"processing_profile,omitempty"`
+	// Identifier of the storage location of the AIP
+	LocationID *string `form:"location_id,omitempty" json:"location_id,omitempty" xml:"location_id,omitempty"`
 }
 
 // AddBatchRequestBody is the type of the "ingest" service "add_batch" endpoint
@@ -47,9 +66,18 @@ type AddBatchRequestBody struct {
 	// Identifier of SIP source -- CURRENTLY NOT USED
 	SourceID string `form:"source_id" json:"source_id" xml:"source_id"`
 	// Key of the SIPs to ingest as part of the batch
-	Keys []string `form:"keys" json:"keys" xml:"keys"`
+	Keys []string `form:"keys,omitempty" json:"keys,omitempty" xml:"keys,omitempty"`
 	// Optional Batch identifier assigned by the user
 	Identifier *string `form:"identifier,omitempty" json:"identifier,omitempty" xml:"identifier,omitempty"`
+	// Name of the processing profile to use for the SIPs of the Batch
+	ProcessingProfile *string `form:"processing_profile,omitempty" json:"processing_profile,omitempty" xml:"processing_profile,omitempty"`
+	// Manifest listing the SIPs to ingest as part of the batch and their metadata,
+	// instead of keys
+	Manifest *string `form:"manifest,omitempty" json:"manifest,omitempty" xml:"manifest,omitempty"`
+	// Format of the manifest, defaults to csv
+	ManifestFormat *string `form:"manifest_format,omitempty" json:"manifest_format,omitempty" xml:"manifest_format,omitempty"`
+	// Identifier of the storage location of the AIPs of the Batch
+	LocationID *string `form:"location_id,omitempty" json:"location_id,omitempty" xml:"location_id,omitempty"`
 }
 
 // ReviewBatchRequestBody is the type of the "ingest" service "review_batch"
@@ -58,6 +86,18 @@ type ReviewBatchRequestBody struct {
 	Continue bool `form:"continue" json:"continue" xml:"continue"`
 }
 
+// CreateAPITokenRequestBody is the type of the "ingest" service
+// "create_api_token" endpoint HTTP request body.
+type CreateAPITokenRequestBody struct {
+	// Name of the API token
+	Name string `form:"name" json:"name" xml:"name"`
+	// Access control attributes granted to the API token, defaults to the
+	// attributes of the user
+	Attributes []string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Expiration datetime of the API token
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+}
+
 // MonitorResponseBody is the type of the "ingest" service "monitor" endpoint
 // HTTP response body.
 type MonitorResponseBody struct {
@@ -106,6 +146,8 @@ type ShowSipResponseBody struct {
 	BatchStatus *string `form:"batch_status,omitempty" json:"batch_status,omitempty" xml:"batch_status,omitempty"`
 	// Number of files in the SIP
 	FileCount *int32 `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Custom metadata returned by the child workflows
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // ListSipWorkflowsResponseBody is the type of the "ingest" service
@@ -135,6 +177,57 @@ type UploadSipResponseBody struct {
 	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
 }
 
+// CreateSipUploadResponseBody is the type of the "ingest" service
+// "create_sip_upload" endpoint HTTP response body.
+type CreateSipUploadResponseBody struct {
+	// Identifier of the upload
+	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// File name of the SIP
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Size of the SIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of bytes received
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty" xml:"offset,omitempty"`
+	// Time after which the upload is discarded
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Identifier of the SIP, set when the upload is complete
+	SipUUID *string `form:"sip_uuid,omitempty" json:"sip_uuid,omitempty" xml:"sip_uuid,omitempty"`
+}
+
+// ShowSipUploadResponseBody is the type of the "ingest" service
+// "show_sip_upload" endpoint HTTP response body.
+type ShowSipUploadResponseBody struct {
+	// Identifier of the upload
+	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// File name of the SIP
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Size of the SIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of bytes received
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty" xml:"offset,omitempty"`
+	// Time after which the upload is discarded
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Identifier of the SIP, set when the upload is complete
+	SipUUID *string `form:"sip_uuid,omitempty" json:"sip_uuid,omitempty" xml:"sip_uuid,omitempty"`
+}
+
+// UploadSipChunkResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body.
+type UploadSipChunkResponseBody struct {
+	// Identifier of the upload
+	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// File name of the SIP
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Size of the SIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of bytes received
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty" xml:"offset,omitempty"`
+	// Time after which the upload is discarded
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Identifier of the SIP, set when the upload is complete
+	SipUUID *string `form:"sip_uuid,omitempty" json:"sip_uuid,omitempty" xml:"sip_uuid,omitempty"`
+}
+
 // ListUsersResponseBody is the type of the "ingest" service "list_users"
 // endpoint HTTP response body.
 type ListUsersResponseBody struct {
@@ -191,6 +284,40 @@ type ShowBatchResponseBody struct {
 	UploaderName *string `form:"uploader_name,omitempty" json:"uploader_name,omitempty" xml:"uploader_name,omitempty"`
 }
 
+// CreateAPITokenResponseBody is the type of the "ingest" service
+// "create_api_token" endpoint HTTP response body.
+type CreateAPITokenResponseBody struct {
+	// Identifier of the API token
+	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Name of the API token
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Access control attributes granted to the API token
+	Attributes []string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Creation datetime
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Expiration datetime
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Datetime of the last use of the API token
+	LastUsedAt *string `form:"last_used_at,omitempty" json:"last_used_at,omitempty" xml:"last_used_at,omitempty"`
+	// Revocation datetime
+	RevokedAt *string `form:"revoked_at,omitempty" json:"revoked_at,omitempty" xml:"revoked_at,omitempty"`
+	// Secret of the API token, only returned when the API token is created
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+}
+
+// ListAPITokensResponseBody is the type of the "ingest" service
+// "list_api_tokens" endpoint HTTP response body.
+type ListAPITokensResponseBody struct {
+	Items []*APITokenResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+}
+
+// SearchResponseBody is the type of the "ingest" service "search" endpoint HTTP
+// response body.
+type SearchResponseBody struct {
+	Items []*SearchResultResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	Page  *EnduroPageResponseBody     `form:"page,omitempty" json:"page,omitempty" xml:"page,omitempty"`
+}
+
 // MonitorInternalErrorResponseBody is the type of the "ingest" service
 // "monitor" endpoint HTTP response body for the "internal_error" error.
 type MonitorInternalErrorResponseBody struct {
@@ -326,6 +453,42 @@ type RejectSipNotAvailableResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// RetrySipNotAvailableResponseBody is the type of the "ingest" service
+// "retry_sip" endpoint HTTP response body for the "not_available" error.
+type RetrySipNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CancelSipNotAvailableResponseBody is the type of the "ingest" service
+// "cancel_sip" endpoint HTTP response body for the "not_available" error.
+type CancelSipNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // RejectSipNotValidResponseBody is the type of the "ingest" service
 // "reject_sip" endpoint HTTP response body for the "not_valid" error.
 type RejectSipNotValidResponseBody struct {
@@ -344,6 +507,42 @@ type RejectSipNotValidResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// RetrySipNotValidResponseBody is the type of the "ingest" service
+// "retry_sip" endpoint HTTP response body for the "not_valid" error.
+type RetrySipNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CancelSipNotValidResponseBody is the type of the "ingest" service
+// "cancel_sip" endpoint HTTP response body for the "not_valid" error.
+type CancelSipNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // RejectSipNotFoundResponseBody is the type of the "ingest" service
 // "reject_sip" endpoint HTTP response body for the "not_found" error.
 type RejectSipNotFoundResponseBody struct {
@@ -353,6 +552,24 @@ type RejectSipNotFoundResponseBody struct {
 	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
 }
 
+// RetrySipNotFoundResponseBody is the type of the "ingest" service
+// "retry_sip" endpoint HTTP response body for the "not_found" error.
+type RetrySipNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing SIP
+	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+}
+
+// CancelSipNotFoundResponseBody is the type of the "ingest" service
+// "cancel_sip" endpoint HTTP response body for the "not_found" error.
+type CancelSipNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing SIP
+	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+}
+
 // ShowSipDecisionInternalErrorResponseBody is the type of the "ingest" service
 // "show_sip_decision" endpoint HTTP response body for the "internal_error"
 // error.
@@ -574,6 +791,170 @@ type UploadSipInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// CreateSipUploadNotValidResponseBody is the type of the "ingest" service
+// "create_sip_upload" endpoint HTTP response body for the "not_valid" error.
+type CreateSipUploadNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateSipUploadInternalErrorResponseBody is the type of the "ingest" service
+// "create_sip_upload" endpoint HTTP response body for the "internal_error"
+// error.
+type CreateSipUploadInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ShowSipUploadNotFoundResponseBody is the type of the "ingest" service
+// "show_sip_upload" endpoint HTTP response body for the "not_found" error.
+type ShowSipUploadNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ShowSipUploadNotValidResponseBody is the type of the "ingest" service
+// "show_sip_upload" endpoint HTTP response body for the "not_valid" error.
+type ShowSipUploadNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ShowSipUploadInternalErrorResponseBody is the type of the "ingest" service
+// "show_sip_upload" endpoint HTTP response body for the "internal_error" error.
+type ShowSipUploadInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadSipChunkNotFoundResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body for the "not_found" error.
+type UploadSipChunkNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadSipChunkNotValidResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body for the "not_valid" error.
+type UploadSipChunkNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadSipChunkNotAvailableResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body for the "not_available" error.
+type UploadSipChunkNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadSipChunkInternalErrorResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body for the "internal_error"
+// error.
+type UploadSipChunkInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // DownloadSipRequestNotValidResponseBody is the type of the "ingest" service
 // "download_sip_request" endpoint HTTP response body for the "not_valid" error.
 type DownloadSipRequestNotValidResponseBody struct {
@@ -902,23 +1283,196 @@ type ReviewBatchNotFoundResponseBody struct {
 	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
 }
 
-// IngestPingEventResponseBody is used to define fields on response body types.
-type IngestPingEventResponseBody struct {
+// RetryBatchNotAvailableResponseBody is the type of the "ingest" service
+// "retry_batch" endpoint HTTP response body for the "not_available" error.
+type RetryBatchNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// SIPCreatedEventResponseBody is used to define fields on response body types.
-type SIPCreatedEventResponseBody struct {
-	// Identifier of SIP
-	UUID *uuid.UUID       `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
-	Item *SIPResponseBody `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
-}
-
-// SIPResponseBody is used to define fields on response body types.
-type SIPResponseBody struct {
-	// Identifier of SIP
-	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
-	// Name of the SIP
+// RetryBatchNotValidResponseBody is the type of the "ingest" service
+// "retry_batch" endpoint HTTP response body for the "not_valid" error.
+type RetryBatchNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RetryBatchNotFoundResponseBody is the type of the "ingest" service
+// "retry_batch" endpoint HTTP response body for the "not_found" error.
+type RetryBatchNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing Batch
+	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+}
+
+// CreateAPITokenNotValidResponseBody is the type of the "ingest" service
+// "create_api_token" endpoint HTTP response body for the "not_valid" error.
+type CreateAPITokenNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateAPITokenInternalErrorResponseBody is the type of the "ingest" service
+// "create_api_token" endpoint HTTP response body for the "internal_error"
+// error.
+type CreateAPITokenInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListAPITokensInternalErrorResponseBody is the type of the "ingest" service
+// "list_api_tokens" endpoint HTTP response body for the "internal_error" error.
+type ListAPITokensInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeAPITokenNotFoundResponseBody is the type of the "ingest" service
+// "revoke_api_token" endpoint HTTP response body for the "not_found" error.
+type RevokeAPITokenNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeAPITokenNotValidResponseBody is the type of the "ingest" service
+// "revoke_api_token" endpoint HTTP response body for the "not_valid" error.
+type RevokeAPITokenNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeAPITokenInternalErrorResponseBody is the type of the "ingest" service
+// "revoke_api_token" endpoint HTTP response body for the "internal_error"
+// error.
+type RevokeAPITokenInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SearchNotValidResponseBody is the type of the "ingest" service "search"
+// endpoint HTTP response body for the "not_valid" error.
+type SearchNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// IngestPingEventResponseBody is used to define fields on response body types.
+type IngestPingEventResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SIPCreatedEventResponseBody is used to define fields on response body types.
+type SIPCreatedEventResponseBody struct {
+	// Identifier of SIP
+	UUID *uuid.UUID       `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	Item *SIPResponseBody `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
+}
+
+// SIPResponseBody is used to define fields on response body types.
+type SIPResponseBody struct {
+	// Identifier of SIP
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Name of the SIP
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// Status of the SIP
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
@@ -948,6 +1502,8 @@ type SIPResponseBody struct {
 	BatchStatus *string `form:"batch_status,omitempty" json:"batch_status,omitempty" xml:"batch_status,omitempty"`
 	// Number of files in the SIP
 	FileCount *int32 `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Custom metadata returned by the child workflows
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // SIPUpdatedEventResponseBody is used to define fields on response body types.
@@ -1123,6 +1679,36 @@ type SIPSourceObjectResponseBody struct {
 // BatchResponseBodyCollection is used to define fields on response body types.
 type BatchResponseBodyCollection []*BatchResponseBody
 
+// APITokenResponseBody is used to define fields on response body types.
+type APITokenResponseBody struct {
+	// Identifier of the API token
+	UUID *string `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Name of the API token
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Access control attributes granted to the API token
+	Attributes []string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Creation datetime
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Expiration datetime
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Datetime of the last use of the API token
+	LastUsedAt *string `form:"last_used_at,omitempty" json:"last_used_at,omitempty" xml:"last_used_at,omitempty"`
+	// Revocation datetime
+	RevokedAt *string `form:"revoked_at,omitempty" json:"revoked_at,omitempty" xml:"revoked_at,omitempty"`
+	// Secret of the API token, only returned when the API token is created
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+}
+
+// SearchResultResponseBody is used to define fields on response body types.
+type SearchResultResponseBody struct {
+	// Identifier of the package
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Name of the package
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Indexed metadata of the package, keyed by field name
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
 // Value is a sum-type union.
 type Value struct {
 	kind                    ValueKind
@@ -1590,8 +2176,23 @@ func NewSubmitSipDecisionRequestBody(p *ingest.SubmitSipDecisionPayload) *Submit
 // "add_sip" endpoint of the "ingest" service.
 func NewAddSipRequestBody(p *ingest.AddSipPayload) *AddSipRequestBody {
 	body := &AddSipRequestBody{
-		SourceID: p.SourceID,
-		Key:      p.Key,
+		SourceID:          p.SourceID,
+		Key:               p.Key,
+		ProcessingProfile: p.ProcessingProfile,
+		LocationID:        p.LocationID,
+	}
+	return body
+}
+
+// NewCreateSipUploadRequestBody builds the HTTP request body from the payload
+// of the "create_sip_upload" endpoint of the "ingest" service.
+func NewCreateSipUploadRequestBody(p *ingest.CreateSipUploadPayload) *CreateSipUploadRequestBody {
+	body := &CreateSipUploadRequestBody{
+		Name:              p.Name,
+		Size:              p.Size,
+		Checksum:          p.Checksum,
+		ProcessingProfile: p.ProcessingProfile,
+		LocationID:        p.LocationID,
 	}
 	return body
 }
@@ -1600,16 +2201,18 @@ func NewAddSipRequestBody(p *ingest.AddSipPayload) *AddSipRequestBody {
 // "add_batch" endpoint of the "ingest" service.
 func NewAddBatchRequestBody(p *ingest.AddBatchPayload) *AddBatchRequestBody {
 	body := &AddBatchRequestBody{
-		SourceID:   p.SourceID,
-		Identifier: p.Identifier,
+		SourceID:          p.SourceID,
+		Identifier:        p.Identifier,
+		ProcessingProfile: p.ProcessingProfile,
+		Manifest:          p.Manifest,
+		ManifestFormat:    p.ManifestFormat,
+		LocationID:        p.LocationID,
 	}
 	if p.Keys != nil {
 		body.Keys = make([]string, len(p.Keys))
 		for i, val := range p.Keys {
 			body.Keys[i] = val
 		}
-	} else {
-		body.Keys = []string{}
 	}
 	return body
 }
@@ -1623,6 +2226,22 @@ func NewReviewBatchRequestBody(p *ingest.ReviewBatchPayload) *ReviewBatchRequest
 	return body
 }
 
+// NewCreateAPITokenRequestBody builds the HTTP request body from the payload of
+// the "create_api_token" endpoint of the "ingest" service.
+func NewCreateAPITokenRequestBody(p *ingest.CreateAPITokenPayload) *CreateAPITokenRequestBody {
+	body := &CreateAPITokenRequestBody{
+		Name:      p.Name,
+		ExpiresAt: p.ExpiresAt,
+	}
+	if p.Attributes != nil {
+		body.Attributes = make([]string, len(p.Attributes))
+		for i, val := range p.Attributes {
+			body.Attributes[i] = val
+		}
+	}
+	return body
+}
+
 // NewMonitorIngestEventOK builds a "ingest" service "monitor" endpoint result
 // from a HTTP "OK" response.
 func NewMonitorIngestEventOK(body *MonitorResponseBody) *ingest.IngestEvent {
@@ -1794,6 +2413,14 @@ func NewShowSipSIPOK(body *ShowSipResponseBody) *ingestviews.SIPView {
 		BatchStatus:     body.BatchStatus,
 		FileCount:       body.FileCount,
 	}
+	if body.CustomMetadata != nil {
+		v.CustomMetadata = make(map[string]any, len(body.CustomMetadata))
+		for key, val := range body.CustomMetadata {
+			tk := key
+			tv := val
+			v.CustomMetadata[tk] = tv
+		}
+	}
 
 	return v
 }
@@ -1956,9 +2583,9 @@ func NewRejectSipNotAvailable(body *RejectSipNotAvailableResponseBody) *goa.Serv
 	return v
 }
 
-// NewRejectSipNotValid builds a ingest service reject_sip endpoint not_valid
-// error.
-func NewRejectSipNotValid(body *RejectSipNotValidResponseBody) *goa.ServiceError {
+// NewRetrySipNotAvailable builds a ingest service retry_sip endpoint
+// not_available error.
+func NewRetrySipNotAvailable(body *RetrySipNotAvailableResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1971,50 +2598,24 @@ func NewRejectSipNotValid(body *RejectSipNotValidResponseBody) *goa.ServiceError
 	return v
 }
 
-// NewRejectSipNotFound builds a ingest service reject_sip endpoint not_found
-// error.
-func NewRejectSipNotFound(body *RejectSipNotFoundResponseBody) *ingest.SIPNotFound {
-	v := &ingest.SIPNotFound{
-		Message: *body.Message,
-		UUID:    *body.UUID,
+// NewCancelSipNotAvailable builds a ingest service cancel_sip endpoint
+// not_available error.
+func NewCancelSipNotAvailable(body *CancelSipNotAvailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
 
 	return v
 }
 
-// NewRejectSipForbidden builds a ingest service reject_sip endpoint forbidden
+// NewRejectSipNotValid builds a ingest service reject_sip endpoint not_valid
 // error.
-func NewRejectSipForbidden(body string) ingest.Forbidden {
-	v := ingest.Forbidden(body)
-
-	return v
-}
-
-// NewRejectSipUnauthorized builds a ingest service reject_sip endpoint
-// unauthorized error.
-func NewRejectSipUnauthorized(body string) ingest.Unauthorized {
-	v := ingest.Unauthorized(body)
-
-	return v
-}
-
-// NewShowSipDecisionSIPDecisionOK builds a "ingest" service
-// "show_sip_decision" endpoint result from a HTTP "OK" response.
-func NewShowSipDecisionSIPDecisionOK(body *ShowSipDecisionResponseBody) *ingestviews.SIPDecisionView {
-	v := &ingestviews.SIPDecisionView{
-		Message: body.Message,
-	}
-	v.Options = make([]string, len(body.Options))
-	for i, val := range body.Options {
-		v.Options[i] = val
-	}
-
-	return v
-}
-
-// NewShowSipDecisionInternalError builds a ingest service show_sip_decision
-// endpoint internal_error error.
-func NewShowSipDecisionInternalError(body *ShowSipDecisionInternalErrorResponseBody) *goa.ServiceError {
+func NewRejectSipNotValid(body *RejectSipNotValidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2027,9 +2628,9 @@ func NewShowSipDecisionInternalError(body *ShowSipDecisionInternalErrorResponseB
 	return v
 }
 
-// NewShowSipDecisionNotAvailable builds a ingest service show_sip_decision
-// endpoint not_available error.
-func NewShowSipDecisionNotAvailable(body *ShowSipDecisionNotAvailableResponseBody) *goa.ServiceError {
+// NewRetrySipNotValid builds a ingest service retry_sip endpoint not_valid
+// error.
+func NewRetrySipNotValid(body *RetrySipNotValidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2042,9 +2643,9 @@ func NewShowSipDecisionNotAvailable(body *ShowSipDecisionNotAvailableResponseBod
 	return v
 }
 
-// NewShowSipDecisionNotValid builds a ingest service show_sip_decision
-// endpoint not_valid error.
-func NewShowSipDecisionNotValid(body *ShowSipDecisionNotValidResponseBody) *goa.ServiceError {
+// NewCancelSipNotValid builds a ingest service cancel_sip endpoint not_valid
+// error.
+func NewCancelSipNotValid(body *CancelSipNotValidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2057,9 +2658,9 @@ func NewShowSipDecisionNotValid(body *ShowSipDecisionNotValidResponseBody) *goa.
 	return v
 }
 
-// NewShowSipDecisionNotFound builds a ingest service show_sip_decision
-// endpoint not_found error.
-func NewShowSipDecisionNotFound(body *ShowSipDecisionNotFoundResponseBody) *ingest.SIPNotFound {
+// NewRejectSipNotFound builds a ingest service reject_sip endpoint not_found
+// error.
+func NewRejectSipNotFound(body *RejectSipNotFoundResponseBody) *ingest.SIPNotFound {
 	v := &ingest.SIPNotFound{
 		Message: *body.Message,
 		UUID:    *body.UUID,
@@ -2068,29 +2669,169 @@ func NewShowSipDecisionNotFound(body *ShowSipDecisionNotFoundResponseBody) *inge
 	return v
 }
 
-// NewShowSipDecisionForbidden builds a ingest service show_sip_decision
-// endpoint forbidden error.
-func NewShowSipDecisionForbidden(body string) ingest.Forbidden {
-	v := ingest.Forbidden(body)
+// NewRetrySipNotFound builds a ingest service retry_sip endpoint not_found
+// error.
+func NewRetrySipNotFound(body *RetrySipNotFoundResponseBody) *ingest.SIPNotFound {
+	v := &ingest.SIPNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
 
 	return v
 }
 
-// NewShowSipDecisionUnauthorized builds a ingest service show_sip_decision
-// endpoint unauthorized error.
-func NewShowSipDecisionUnauthorized(body string) ingest.Unauthorized {
-	v := ingest.Unauthorized(body)
+// NewCancelSipNotFound builds a ingest service cancel_sip endpoint not_found
+// error.
+func NewCancelSipNotFound(body *CancelSipNotFoundResponseBody) *ingest.SIPNotFound {
+	v := &ingest.SIPNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
 
 	return v
 }
 
-// NewSubmitSipDecisionInternalError builds a ingest service
-// submit_sip_decision endpoint internal_error error.
-func NewSubmitSipDecisionInternalError(body *SubmitSipDecisionInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
+// NewRejectSipForbidden builds a ingest service reject_sip endpoint forbidden
+// error.
+func NewRejectSipForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewRetrySipForbidden builds a ingest service retry_sip endpoint forbidden
+// error.
+func NewRetrySipForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewCancelSipForbidden builds a ingest service cancel_sip endpoint forbidden
+// error.
+func NewCancelSipForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewRejectSipUnauthorized builds a ingest service reject_sip endpoint
+// unauthorized error.
+func NewRejectSipUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewRetrySipUnauthorized builds a ingest service retry_sip endpoint
+// unauthorized error.
+func NewRetrySipUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewCancelSipUnauthorized builds a ingest service cancel_sip endpoint
+// unauthorized error.
+func NewCancelSipUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewShowSipDecisionSIPDecisionOK builds a "ingest" service
+// "show_sip_decision" endpoint result from a HTTP "OK" response.
+func NewShowSipDecisionSIPDecisionOK(body *ShowSipDecisionResponseBody) *ingestviews.SIPDecisionView {
+	v := &ingestviews.SIPDecisionView{
+		Message: body.Message,
+	}
+	v.Options = make([]string, len(body.Options))
+	for i, val := range body.Options {
+		v.Options[i] = val
+	}
+
+	return v
+}
+
+// NewShowSipDecisionInternalError builds a ingest service show_sip_decision
+// endpoint internal_error error.
+func NewShowSipDecisionInternalError(body *ShowSipDecisionInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewShowSipDecisionNotAvailable builds a ingest service show_sip_decision
+// endpoint not_available error.
+func NewShowSipDecisionNotAvailable(body *ShowSipDecisionNotAvailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewShowSipDecisionNotValid builds a ingest service show_sip_decision
+// endpoint not_valid error.
+func NewShowSipDecisionNotValid(body *ShowSipDecisionNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewShowSipDecisionNotFound builds a ingest service show_sip_decision
+// endpoint not_found error.
+func NewShowSipDecisionNotFound(body *ShowSipDecisionNotFoundResponseBody) *ingest.SIPNotFound {
+	v := &ingest.SIPNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
+
+	return v
+}
+
+// NewShowSipDecisionForbidden builds a ingest service show_sip_decision
+// endpoint forbidden error.
+func NewShowSipDecisionForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewShowSipDecisionUnauthorized builds a ingest service show_sip_decision
+// endpoint unauthorized error.
+func NewShowSipDecisionUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewSubmitSipDecisionInternalError builds a ingest service
+// submit_sip_decision endpoint internal_error error.
+func NewSubmitSipDecisionInternalError(body *SubmitSipDecisionInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
 		Temporary: *body.Temporary,
 		Timeout:   *body.Timeout,
 		Fault:     *body.Fault,
@@ -2281,18 +3022,24 @@ func NewUploadSipUnauthorized(body string) ingest.Unauthorized {
 	return v
 }
 
-// NewDownloadSipRequestResultOK builds a "ingest" service
-// "download_sip_request" endpoint result from a HTTP "OK" response.
-func NewDownloadSipRequestResultOK(ticket *string) *ingest.DownloadSipRequestResult {
-	v := &ingest.DownloadSipRequestResult{}
-	v.Ticket = ticket
+// NewCreateSipUploadSIPUploadCreated builds a "ingest" service
+// "create_sip_upload" endpoint result from a HTTP "Created" response.
+func NewCreateSipUploadSIPUploadCreated(body *CreateSipUploadResponseBody) *ingest.SIPUpload {
+	v := &ingest.SIPUpload{
+		UUID:      *body.UUID,
+		Name:      *body.Name,
+		Size:      *body.Size,
+		Offset:    *body.Offset,
+		ExpiresAt: *body.ExpiresAt,
+		SipUUID:   body.SipUUID,
+	}
 
 	return v
 }
 
-// NewDownloadSipRequestNotValid builds a ingest service download_sip_request
-// endpoint not_valid error.
-func NewDownloadSipRequestNotValid(body *DownloadSipRequestNotValidResponseBody) *goa.ServiceError {
+// NewCreateSipUploadNotValid builds a ingest service create_sip_upload endpoint
+// not_valid error.
+func NewCreateSipUploadNotValid(body *CreateSipUploadNotValidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2305,9 +3052,9 @@ func NewDownloadSipRequestNotValid(body *DownloadSipRequestNotValidResponseBody)
 	return v
 }
 
-// NewDownloadSipRequestInternalError builds a ingest service
-// download_sip_request endpoint internal_error error.
-func NewDownloadSipRequestInternalError(body *DownloadSipRequestInternalErrorResponseBody) *goa.ServiceError {
+// NewCreateSipUploadInternalError builds a ingest service create_sip_upload
+// endpoint internal_error error.
+func NewCreateSipUploadInternalError(body *CreateSipUploadInternalErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2320,47 +3067,40 @@ func NewDownloadSipRequestInternalError(body *DownloadSipRequestInternalErrorRes
 	return v
 }
 
-// NewDownloadSipRequestNotFound builds a ingest service download_sip_request
-// endpoint not_found error.
-func NewDownloadSipRequestNotFound(body *DownloadSipRequestNotFoundResponseBody) *ingest.SIPNotFound {
-	v := &ingest.SIPNotFound{
-		Message: *body.Message,
-		UUID:    *body.UUID,
-	}
-
-	return v
-}
-
-// NewDownloadSipRequestForbidden builds a ingest service download_sip_request
+// NewCreateSipUploadForbidden builds a ingest service create_sip_upload
 // endpoint forbidden error.
-func NewDownloadSipRequestForbidden(body string) ingest.Forbidden {
+func NewCreateSipUploadForbidden(body string) ingest.Forbidden {
 	v := ingest.Forbidden(body)
 
 	return v
 }
 
-// NewDownloadSipRequestUnauthorized builds a ingest service
-// download_sip_request endpoint unauthorized error.
-func NewDownloadSipRequestUnauthorized(body string) ingest.Unauthorized {
+// NewCreateSipUploadUnauthorized builds a ingest service create_sip_upload
+// endpoint unauthorized error.
+func NewCreateSipUploadUnauthorized(body string) ingest.Unauthorized {
 	v := ingest.Unauthorized(body)
 
 	return v
 }
 
-// NewDownloadSipResultOK builds a "ingest" service "download_sip" endpoint
-// result from a HTTP "OK" response.
-func NewDownloadSipResultOK(contentType string, contentLength int64, contentDisposition string) *ingest.DownloadSipResult {
-	v := &ingest.DownloadSipResult{}
-	v.ContentType = contentType
-	v.ContentLength = contentLength
-	v.ContentDisposition = contentDisposition
+// NewShowSipUploadSIPUploadOK builds a "ingest" service "show_sip_upload"
+// endpoint result from a HTTP "OK" response.
+func NewShowSipUploadSIPUploadOK(body *ShowSipUploadResponseBody) *ingest.SIPUpload {
+	v := &ingest.SIPUpload{
+		UUID:      *body.UUID,
+		Name:      *body.Name,
+		Size:      *body.Size,
+		Offset:    *body.Offset,
+		ExpiresAt: *body.ExpiresAt,
+		SipUUID:   body.SipUUID,
+	}
 
 	return v
 }
 
-// NewDownloadSipNotValid builds a ingest service download_sip endpoint
-// not_valid error.
-func NewDownloadSipNotValid(body *DownloadSipNotValidResponseBody) *goa.ServiceError {
+// NewShowSipUploadNotFound builds a ingest service show_sip_upload endpoint
+// not_found error.
+func NewShowSipUploadNotFound(body *ShowSipUploadNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2373,9 +3113,9 @@ func NewDownloadSipNotValid(body *DownloadSipNotValidResponseBody) *goa.ServiceE
 	return v
 }
 
-// NewDownloadSipInternalError builds a ingest service download_sip endpoint
-// internal_error error.
-func NewDownloadSipInternalError(body *DownloadSipInternalErrorResponseBody) *goa.ServiceError {
+// NewShowSipUploadNotValid builds a ingest service show_sip_upload endpoint
+// not_valid error.
+func NewShowSipUploadNotValid(body *ShowSipUploadNotValidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2388,53 +3128,55 @@ func NewDownloadSipInternalError(body *DownloadSipInternalErrorResponseBody) *go
 	return v
 }
 
-// NewDownloadSipNotFound builds a ingest service download_sip endpoint
-// not_found error.
-func NewDownloadSipNotFound(body *DownloadSipNotFoundResponseBody) *ingest.SIPNotFound {
-	v := &ingest.SIPNotFound{
-		Message: *body.Message,
-		UUID:    *body.UUID,
+// NewShowSipUploadInternalError builds a ingest service show_sip_upload
+// endpoint internal_error error.
+func NewShowSipUploadInternalError(body *ShowSipUploadInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
 
 	return v
 }
 
-// NewDownloadSipForbidden builds a ingest service download_sip endpoint
+// NewShowSipUploadForbidden builds a ingest service show_sip_upload endpoint
 // forbidden error.
-func NewDownloadSipForbidden(body string) ingest.Forbidden {
+func NewShowSipUploadForbidden(body string) ingest.Forbidden {
 	v := ingest.Forbidden(body)
 
 	return v
 }
 
-// NewDownloadSipUnauthorized builds a ingest service download_sip endpoint
+// NewShowSipUploadUnauthorized builds a ingest service show_sip_upload endpoint
 // unauthorized error.
-func NewDownloadSipUnauthorized(body string) ingest.Unauthorized {
+func NewShowSipUploadUnauthorized(body string) ingest.Unauthorized {
 	v := ingest.Unauthorized(body)
 
 	return v
 }
 
-// NewListUsersUsersOK builds a "ingest" service "list_users" endpoint result
-// from a HTTP "OK" response.
-func NewListUsersUsersOK(body *ListUsersResponseBody) *ingestviews.UsersView {
-	v := &ingestviews.UsersView{}
-	v.Items = make([]*ingestviews.UserView, len(body.Items))
-	for i, val := range body.Items {
-		if val == nil {
-			v.Items[i] = nil
-			continue
-		}
-		v.Items[i] = unmarshalUserResponseBodyToIngestviewsUserView(val)
+// NewUploadSipChunkSIPUploadOK builds a "ingest" service "upload_sip_chunk"
+// endpoint result from a HTTP "OK" response.
+func NewUploadSipChunkSIPUploadOK(body *UploadSipChunkResponseBody) *ingest.SIPUpload {
+	v := &ingest.SIPUpload{
+		UUID:      *body.UUID,
+		Name:      *body.Name,
+		Size:      *body.Size,
+		Offset:    *body.Offset,
+		ExpiresAt: *body.ExpiresAt,
+		SipUUID:   body.SipUUID,
 	}
-	v.Page = unmarshalEnduroPageResponseBodyToIngestviewsEnduroPageView(body.Page)
 
 	return v
 }
 
-// NewListUsersNotValid builds a ingest service list_users endpoint not_valid
-// error.
-func NewListUsersNotValid(body *ListUsersNotValidResponseBody) *goa.ServiceError {
+// NewUploadSipChunkNotFound builds a ingest service upload_sip_chunk endpoint
+// not_found error.
+func NewUploadSipChunkNotFound(body *UploadSipChunkNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2447,44 +3189,271 @@ func NewListUsersNotValid(body *ListUsersNotValidResponseBody) *goa.ServiceError
 	return v
 }
 
-// NewListUsersForbidden builds a ingest service list_users endpoint forbidden
-// error.
-func NewListUsersForbidden(body string) ingest.Forbidden {
-	v := ingest.Forbidden(body)
-
-	return v
-}
-
-// NewListUsersUnauthorized builds a ingest service list_users endpoint
-// unauthorized error.
-func NewListUsersUnauthorized(body string) ingest.Unauthorized {
-	v := ingest.Unauthorized(body)
-
-	return v
-}
-
-// NewListSipSourceObjectsSIPSourceObjectsOK builds a "ingest" service
-// "list_sip_source_objects" endpoint result from a HTTP "OK" response.
-func NewListSipSourceObjectsSIPSourceObjectsOK(body *ListSipSourceObjectsResponseBody) *ingestviews.SIPSourceObjectsView {
-	v := &ingestviews.SIPSourceObjectsView{
-		Limit: body.Limit,
-		Next:  body.Next,
-	}
-	v.Objects = make([]*ingestviews.SIPSourceObjectView, len(body.Objects))
-	for i, val := range body.Objects {
-		if val == nil {
-			v.Objects[i] = nil
-			continue
-		}
-		v.Objects[i] = unmarshalSIPSourceObjectResponseBodyToIngestviewsSIPSourceObjectView(val)
-	}
-
-	return v
-}
-
-// NewListSipSourceObjectsNotFound builds a ingest service
-// list_sip_source_objects endpoint not_found error.
-func NewListSipSourceObjectsNotFound(body *ListSipSourceObjectsNotFoundResponseBody) *goa.ServiceError {
+// NewUploadSipChunkNotValid builds a ingest service upload_sip_chunk endpoint
+// not_valid error.
+func NewUploadSipChunkNotValid(body *UploadSipChunkNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadSipChunkNotAvailable builds a ingest service upload_sip_chunk
+// endpoint not_available error.
+func NewUploadSipChunkNotAvailable(body *UploadSipChunkNotAvailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadSipChunkInternalError builds a ingest service upload_sip_chunk
+// endpoint internal_error error.
+func NewUploadSipChunkInternalError(body *UploadSipChunkInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadSipChunkForbidden builds a ingest service upload_sip_chunk endpoint
+// forbidden error.
+func NewUploadSipChunkForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewUploadSipChunkUnauthorized builds a ingest service upload_sip_chunk
+// endpoint unauthorized error.
+func NewUploadSipChunkUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewDownloadSipRequestResultOK builds a "ingest" service
+// "download_sip_request" endpoint result from a HTTP "OK" response.
+func NewDownloadSipRequestResultOK(ticket *string) *ingest.DownloadSipRequestResult {
+	v := &ingest.DownloadSipRequestResult{}
+	v.Ticket = ticket
+
+	return v
+}
+
+// NewDownloadSipRequestNotValid builds a ingest service download_sip_request
+// endpoint not_valid error.
+func NewDownloadSipRequestNotValid(body *DownloadSipRequestNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSipRequestInternalError builds a ingest service
+// download_sip_request endpoint internal_error error.
+func NewDownloadSipRequestInternalError(body *DownloadSipRequestInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSipRequestNotFound builds a ingest service download_sip_request
+// endpoint not_found error.
+func NewDownloadSipRequestNotFound(body *DownloadSipRequestNotFoundResponseBody) *ingest.SIPNotFound {
+	v := &ingest.SIPNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
+
+	return v
+}
+
+// NewDownloadSipRequestForbidden builds a ingest service download_sip_request
+// endpoint forbidden error.
+func NewDownloadSipRequestForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewDownloadSipRequestUnauthorized builds a ingest service
+// download_sip_request endpoint unauthorized error.
+func NewDownloadSipRequestUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewDownloadSipResultOK builds a "ingest" service "download_sip" endpoint
+// result from a HTTP "OK" response.
+func NewDownloadSipResultOK(contentType string, contentLength int64, contentDisposition string) *ingest.DownloadSipResult {
+	v := &ingest.DownloadSipResult{}
+	v.ContentType = contentType
+	v.ContentLength = contentLength
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
+// NewDownloadSipNotValid builds a ingest service download_sip endpoint
+// not_valid error.
+func NewDownloadSipNotValid(body *DownloadSipNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSipInternalError builds a ingest service download_sip endpoint
+// internal_error error.
+func NewDownloadSipInternalError(body *DownloadSipInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSipNotFound builds a ingest service download_sip endpoint
+// not_found error.
+func NewDownloadSipNotFound(body *DownloadSipNotFoundResponseBody) *ingest.SIPNotFound {
+	v := &ingest.SIPNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
+
+	return v
+}
+
+// NewDownloadSipForbidden builds a ingest service download_sip endpoint
+// forbidden error.
+func NewDownloadSipForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewDownloadSipUnauthorized builds a ingest service download_sip endpoint
+// unauthorized error.
+func NewDownloadSipUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewListUsersUsersOK builds a "ingest" service "list_users" endpoint result
+// from a HTTP "OK" response.
+func NewListUsersUsersOK(body *ListUsersResponseBody) *ingestviews.UsersView {
+	v := &ingestviews.UsersView{}
+	v.Items = make([]*ingestviews.UserView, len(body.Items))
+	for i, val := range body.Items {
+		if val == nil {
+			v.Items[i] = nil
+			continue
+		}
+		v.Items[i] = unmarshalUserResponseBodyToIngestviewsUserView(val)
+	}
+	v.Page = unmarshalEnduroPageResponseBodyToIngestviewsEnduroPageView(body.Page)
+
+	return v
+}
+
+// NewListUsersNotValid builds a ingest service list_users endpoint not_valid
+// error.
+func NewListUsersNotValid(body *ListUsersNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsersForbidden builds a ingest service list_users endpoint forbidden
+// error.
+func NewListUsersForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewListUsersUnauthorized builds a ingest service list_users endpoint
+// unauthorized error.
+func NewListUsersUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewListSipSourceObjectsSIPSourceObjectsOK builds a "ingest" service
+// "list_sip_source_objects" endpoint result from a HTTP "OK" response.
+func NewListSipSourceObjectsSIPSourceObjectsOK(body *ListSipSourceObjectsResponseBody) *ingestviews.SIPSourceObjectsView {
+	v := &ingestviews.SIPSourceObjectsView{
+		Limit: body.Limit,
+		Next:  body.Next,
+	}
+	v.Objects = make([]*ingestviews.SIPSourceObjectView, len(body.Objects))
+	for i, val := range body.Objects {
+		if val == nil {
+			v.Objects[i] = nil
+			continue
+		}
+		v.Objects[i] = unmarshalSIPSourceObjectResponseBodyToIngestviewsSIPSourceObjectView(val)
+	}
+
+	return v
+}
+
+// NewListSipSourceObjectsNotFound builds a ingest service
+// list_sip_source_objects endpoint not_found error.
+func NewListSipSourceObjectsNotFound(body *ListSipSourceObjectsNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2795,8 +3764,287 @@ func NewReviewBatchUnauthorized(body string) ingest.Unauthorized {
 	return v
 }
 
-// ValidateMonitorResponseBody runs the validations defined on
-// MonitorResponseBody
+// NewRetryBatchNotAvailable builds a ingest service retry_batch endpoint
+// not_available error.
+func NewRetryBatchNotAvailable(body *RetryBatchNotAvailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRetryBatchNotValid builds a ingest service retry_batch endpoint not_valid
+// error.
+func NewRetryBatchNotValid(body *RetryBatchNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRetryBatchNotFound builds a ingest service retry_batch endpoint not_found
+// error.
+func NewRetryBatchNotFound(body *RetryBatchNotFoundResponseBody) *ingest.BatchNotFound {
+	v := &ingest.BatchNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
+
+	return v
+}
+
+// NewRetryBatchForbidden builds a ingest service retry_batch endpoint forbidden
+// error.
+func NewRetryBatchForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewRetryBatchUnauthorized builds a ingest service retry_batch endpoint
+// unauthorized error.
+func NewRetryBatchUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewCreateAPITokenAPITokenCreated builds a "ingest" service "create_api_token"
+// endpoint result from a HTTP "Created" response.
+func NewCreateAPITokenAPITokenCreated(body *CreateAPITokenResponseBody) *ingest.APIToken {
+	v := &ingest.APIToken{
+		UUID:       *body.UUID,
+		Name:       *body.Name,
+		CreatedAt:  *body.CreatedAt,
+		ExpiresAt:  body.ExpiresAt,
+		LastUsedAt: body.LastUsedAt,
+		RevokedAt:  body.RevokedAt,
+		Token:      body.Token,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make([]string, len(body.Attributes))
+		for i, val := range body.Attributes {
+			v.Attributes[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewCreateAPITokenNotValid builds a ingest service create_api_token endpoint
+// not_valid error.
+func NewCreateAPITokenNotValid(body *CreateAPITokenNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateAPITokenInternalError builds a ingest service create_api_token
+// endpoint internal_error error.
+func NewCreateAPITokenInternalError(body *CreateAPITokenInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateAPITokenForbidden builds a ingest service create_api_token endpoint
+// forbidden error.
+func NewCreateAPITokenForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewCreateAPITokenUnauthorized builds a ingest service create_api_token
+// endpoint unauthorized error.
+func NewCreateAPITokenUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewListAPITokensAPITokensOK builds a "ingest" service "list_api_tokens"
+// endpoint result from a HTTP "OK" response.
+func NewListAPITokensAPITokensOK(body *ListAPITokensResponseBody) *ingest.APITokens {
+	v := &ingest.APITokens{}
+	v.Items = make([]*ingest.APIToken, len(body.Items))
+	for i, val := range body.Items {
+		if val == nil {
+			v.Items[i] = nil
+			continue
+		}
+		v.Items[i] = unmarshalAPITokenResponseBodyToIngestAPIToken(val)
+	}
+
+	return v
+}
+
+// NewListAPITokensInternalError builds a ingest service list_api_tokens
+// endpoint internal_error error.
+func NewListAPITokensInternalError(body *ListAPITokensInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListAPITokensForbidden builds a ingest service list_api_tokens endpoint
+// forbidden error.
+func NewListAPITokensForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewListAPITokensUnauthorized builds a ingest service list_api_tokens endpoint
+// unauthorized error.
+func NewListAPITokensUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewRevokeAPITokenNotFound builds a ingest service revoke_api_token endpoint
+// not_found error.
+func NewRevokeAPITokenNotFound(body *RevokeAPITokenNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeAPITokenNotValid builds a ingest service revoke_api_token endpoint
+// not_valid error.
+func NewRevokeAPITokenNotValid(body *RevokeAPITokenNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeAPITokenInternalError builds a ingest service revoke_api_token
+// endpoint internal_error error.
+func NewRevokeAPITokenInternalError(body *RevokeAPITokenInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeAPITokenForbidden builds a ingest service revoke_api_token endpoint
+// forbidden error.
+func NewRevokeAPITokenForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewRevokeAPITokenUnauthorized builds a ingest service revoke_api_token
+// endpoint unauthorized error.
+func NewRevokeAPITokenUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// NewSearchResultsOK builds a "ingest" service "search" endpoint result from a
+// HTTP "OK" response.
+func NewSearchResultsOK(body *SearchResponseBody) *ingest.SearchResults {
+	v := &ingest.SearchResults{}
+	v.Items = make([]*ingest.SearchResult, len(body.Items))
+	for i, val := range body.Items {
+		if val == nil {
+			v.Items[i] = nil
+			continue
+		}
+		v.Items[i] = unmarshalSearchResultResponseBodyToIngestSearchResult(val)
+	}
+	v.Page = unmarshalEnduroPageResponseBodyToIngestEnduroPage(body.Page)
+
+	return v
+}
+
+// NewSearchNotValid builds a ingest service search endpoint not_valid error.
+func NewSearchNotValid(body *SearchNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSearchForbidden builds a ingest service search endpoint forbidden error.
+func NewSearchForbidden(body string) ingest.Forbidden {
+	v := ingest.Forbidden(body)
+
+	return v
+}
+
+// NewSearchUnauthorized builds a ingest service search endpoint unauthorized
+// error.
+func NewSearchUnauthorized(body string) ingest.Unauthorized {
+	v := ingest.Unauthorized(body)
+
+	return v
+}
+
+// ValidateMonitorResponseBody runs the validations defined on
+// MonitorResponseBody
 func ValidateMonitorResponseBody(body *MonitorResponseBody) (err error) {
 	switch string(body.Value.Kind()) {
 	case "sip_created_event":
@@ -2863,49 +4111,446 @@ func ValidateMonitorResponseBody(body *MonitorResponseBody) (err error) {
 			}
 		}
 	}
-
+
+	return
+}
+
+// ValidateAddSipResponseBody runs the validations defined on
+// add_sip_response_body
+func ValidateAddSipResponseBody(body *AddSipResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateUploadSipResponseBody runs the validations defined on
+// upload_sip_response_body
+func ValidateUploadSipResponseBody(body *UploadSipResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateCreateSipUploadResponseBody runs the validations defined on
+// create_sip_upload_response_body
+func ValidateCreateSipUploadResponseBody(body *CreateSipUploadResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.Offset == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("offset", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	if body.SipUUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sip_uuid", *body.SipUUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateShowSipUploadResponseBody runs the validations defined on
+// show_sip_upload_response_body
+func ValidateShowSipUploadResponseBody(body *ShowSipUploadResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.Offset == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("offset", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	if body.SipUUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sip_uuid", *body.SipUUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateUploadSipChunkResponseBody runs the validations defined on
+// upload_sip_chunk_response_body
+func ValidateUploadSipChunkResponseBody(body *UploadSipChunkResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.Offset == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("offset", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	if body.SipUUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sip_uuid", *body.SipUUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateAddBatchResponseBody runs the validations defined on
+// add_batch_response_body
+func ValidateAddBatchResponseBody(body *AddBatchResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateCreateAPITokenResponseBody runs the validations defined on
+// create_api_token_response_body
+func ValidateCreateAPITokenResponseBody(body *CreateAPITokenResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	if body.LastUsedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_used_at", *body.LastUsedAt, goa.FormatDateTime))
+	}
+	if body.RevokedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.revoked_at", *body.RevokedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListAPITokensResponseBody runs the validations defined on
+// list_api_tokens_response_body
+func ValidateListAPITokensResponseBody(body *ListAPITokensResponseBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateAPITokenResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSearchResponseBody runs the validations defined on
+// search_response_body
+func ValidateSearchResponseBody(body *SearchResponseBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	if body.Page == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("page", "body"))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateSearchResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Page != nil {
+		if err2 := ValidateEnduroPageResponseBody(body.Page); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateMonitorInternalErrorResponseBody runs the validations defined on
+// monitor_internal_error_response_body
+func ValidateMonitorInternalErrorResponseBody(body *MonitorInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSipsNotValidResponseBody runs the validations defined on
+// list_sips_not_valid_response_body
+func ValidateListSipsNotValidResponseBody(body *ListSipsNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateShowSipNotAvailableResponseBody runs the validations defined on
+// show_sip_not_available_response_body
+func ValidateShowSipNotAvailableResponseBody(body *ShowSipNotAvailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateShowSipNotFoundResponseBody runs the validations defined on
+// show_sip_not_found_response_body
+func ValidateShowSipNotFoundResponseBody(body *ShowSipNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateListSipWorkflowsNotFoundResponseBody runs the validations defined on
+// list_sip_workflows_not_found_response_body
+func ValidateListSipWorkflowsNotFoundResponseBody(body *ListSipWorkflowsNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateConfirmSipNotAvailableResponseBody runs the validations defined on
+// confirm_sip_not_available_response_body
+func ValidateConfirmSipNotAvailableResponseBody(body *ConfirmSipNotAvailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateConfirmSipNotValidResponseBody runs the validations defined on
+// confirm_sip_not_valid_response_body
+func ValidateConfirmSipNotValidResponseBody(body *ConfirmSipNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateConfirmSipNotFoundResponseBody runs the validations defined on
+// confirm_sip_not_found_response_body
+func ValidateConfirmSipNotFoundResponseBody(body *ConfirmSipNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateRejectSipNotAvailableResponseBody runs the validations defined on
+// reject_sip_not_available_response_body
+func ValidateRejectSipNotAvailableResponseBody(body *RejectSipNotAvailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
 	return
 }
 
-// ValidateAddSipResponseBody runs the validations defined on
-// add_sip_response_body
-func ValidateAddSipResponseBody(body *AddSipResponseBody) (err error) {
-	if body.UUID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+// ValidateRetrySipNotAvailableResponseBody runs the validations defined on
+// retry_sip_not_available_response_body
+func ValidateRetrySipNotAvailableResponseBody(body *RetrySipNotAvailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.UUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	return
-}
-
-// ValidateUploadSipResponseBody runs the validations defined on
-// upload_sip_response_body
-func ValidateUploadSipResponseBody(body *UploadSipResponseBody) (err error) {
-	if body.UUID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateAddBatchResponseBody runs the validations defined on
-// add_batch_response_body
-func ValidateAddBatchResponseBody(body *AddBatchResponseBody) (err error) {
-	if body.UUID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+// ValidateCancelSipNotAvailableResponseBody runs the validations defined on
+// cancel_sip_not_available_response_body
+func ValidateCancelSipNotAvailableResponseBody(body *CancelSipNotAvailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.UUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateMonitorInternalErrorResponseBody runs the validations defined on
-// monitor_internal_error_response_body
-func ValidateMonitorInternalErrorResponseBody(body *MonitorInternalErrorResponseBody) (err error) {
+// ValidateRejectSipNotValidResponseBody runs the validations defined on
+// reject_sip_not_valid_response_body
+func ValidateRejectSipNotValidResponseBody(body *RejectSipNotValidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2927,9 +4572,9 @@ func ValidateMonitorInternalErrorResponseBody(body *MonitorInternalErrorResponse
 	return
 }
 
-// ValidateListSipsNotValidResponseBody runs the validations defined on
-// list_sips_not_valid_response_body
-func ValidateListSipsNotValidResponseBody(body *ListSipsNotValidResponseBody) (err error) {
+// ValidateRetrySipNotValidResponseBody runs the validations defined on
+// retry_sip_not_valid_response_body
+func ValidateRetrySipNotValidResponseBody(body *RetrySipNotValidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2951,9 +4596,9 @@ func ValidateListSipsNotValidResponseBody(body *ListSipsNotValidResponseBody) (e
 	return
 }
 
-// ValidateShowSipNotAvailableResponseBody runs the validations defined on
-// show_sip_not_available_response_body
-func ValidateShowSipNotAvailableResponseBody(body *ShowSipNotAvailableResponseBody) (err error) {
+// ValidateCancelSipNotValidResponseBody runs the validations defined on
+// cancel_sip_not_valid_response_body
+func ValidateCancelSipNotValidResponseBody(body *CancelSipNotValidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2975,9 +4620,9 @@ func ValidateShowSipNotAvailableResponseBody(body *ShowSipNotAvailableResponseBo
 	return
 }
 
-// ValidateShowSipNotFoundResponseBody runs the validations defined on
-// show_sip_not_found_response_body
-func ValidateShowSipNotFoundResponseBody(body *ShowSipNotFoundResponseBody) (err error) {
+// ValidateRejectSipNotFoundResponseBody runs the validations defined on
+// reject_sip_not_found_response_body
+func ValidateRejectSipNotFoundResponseBody(body *RejectSipNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
@@ -2990,9 +4635,9 @@ func ValidateShowSipNotFoundResponseBody(body *ShowSipNotFoundResponseBody) (err
 	return
 }
 
-// ValidateListSipWorkflowsNotFoundResponseBody runs the validations defined on
-// list_sip_workflows_not_found_response_body
-func ValidateListSipWorkflowsNotFoundResponseBody(body *ListSipWorkflowsNotFoundResponseBody) (err error) {
+// ValidateRetrySipNotFoundResponseBody runs the validations defined on
+// retry_sip_not_found_response_body
+func ValidateRetrySipNotFoundResponseBody(body *RetrySipNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
@@ -3005,9 +4650,24 @@ func ValidateListSipWorkflowsNotFoundResponseBody(body *ListSipWorkflowsNotFound
 	return
 }
 
-// ValidateConfirmSipNotAvailableResponseBody runs the validations defined on
-// confirm_sip_not_available_response_body
-func ValidateConfirmSipNotAvailableResponseBody(body *ConfirmSipNotAvailableResponseBody) (err error) {
+// ValidateCancelSipNotFoundResponseBody runs the validations defined on
+// cancel_sip_not_found_response_body
+func ValidateCancelSipNotFoundResponseBody(body *CancelSipNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateShowSipDecisionInternalErrorResponseBody runs the validations
+// defined on show_sip_decision_internal_error_response_body
+func ValidateShowSipDecisionInternalErrorResponseBody(body *ShowSipDecisionInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3029,9 +4689,9 @@ func ValidateConfirmSipNotAvailableResponseBody(body *ConfirmSipNotAvailableResp
 	return
 }
 
-// ValidateConfirmSipNotValidResponseBody runs the validations defined on
-// confirm_sip_not_valid_response_body
-func ValidateConfirmSipNotValidResponseBody(body *ConfirmSipNotValidResponseBody) (err error) {
+// ValidateShowSipDecisionNotAvailableResponseBody runs the validations defined
+// on show_sip_decision_not_available_response_body
+func ValidateShowSipDecisionNotAvailableResponseBody(body *ShowSipDecisionNotAvailableResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3053,9 +4713,33 @@ func ValidateConfirmSipNotValidResponseBody(body *ConfirmSipNotValidResponseBody
 	return
 }
 
-// ValidateConfirmSipNotFoundResponseBody runs the validations defined on
-// confirm_sip_not_found_response_body
-func ValidateConfirmSipNotFoundResponseBody(body *ConfirmSipNotFoundResponseBody) (err error) {
+// ValidateShowSipDecisionNotValidResponseBody runs the validations defined on
+// show_sip_decision_not_valid_response_body
+func ValidateShowSipDecisionNotValidResponseBody(body *ShowSipDecisionNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateShowSipDecisionNotFoundResponseBody runs the validations defined on
+// show_sip_decision_not_found_response_body
+func ValidateShowSipDecisionNotFoundResponseBody(body *ShowSipDecisionNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
@@ -3068,9 +4752,9 @@ func ValidateConfirmSipNotFoundResponseBody(body *ConfirmSipNotFoundResponseBody
 	return
 }
 
-// ValidateRejectSipNotAvailableResponseBody runs the validations defined on
-// reject_sip_not_available_response_body
-func ValidateRejectSipNotAvailableResponseBody(body *RejectSipNotAvailableResponseBody) (err error) {
+// ValidateSubmitSipDecisionInternalErrorResponseBody runs the validations
+// defined on submit_sip_decision_internal_error_response_body
+func ValidateSubmitSipDecisionInternalErrorResponseBody(body *SubmitSipDecisionInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3092,9 +4776,9 @@ func ValidateRejectSipNotAvailableResponseBody(body *RejectSipNotAvailableRespon
 	return
 }
 
-// ValidateRejectSipNotValidResponseBody runs the validations defined on
-// reject_sip_not_valid_response_body
-func ValidateRejectSipNotValidResponseBody(body *RejectSipNotValidResponseBody) (err error) {
+// ValidateSubmitSipDecisionNotAvailableResponseBody runs the validations
+// defined on submit_sip_decision_not_available_response_body
+func ValidateSubmitSipDecisionNotAvailableResponseBody(body *SubmitSipDecisionNotAvailableResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3116,9 +4800,33 @@ func ValidateRejectSipNotValidResponseBody(body *RejectSipNotValidResponseBody)
 	return
 }
 
-// ValidateRejectSipNotFoundResponseBody runs the validations defined on
-// reject_sip_not_found_response_body
-func ValidateRejectSipNotFoundResponseBody(body *RejectSipNotFoundResponseBody) (err error) {
+// ValidateSubmitSipDecisionNotValidResponseBody runs the validations defined
+// on submit_sip_decision_not_valid_response_body
+func ValidateSubmitSipDecisionNotValidResponseBody(body *SubmitSipDecisionNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSubmitSipDecisionNotFoundResponseBody runs the validations defined
+// on submit_sip_decision_not_found_response_body
+func ValidateSubmitSipDecisionNotFoundResponseBody(body *SubmitSipDecisionNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
@@ -3131,9 +4839,9 @@ func ValidateRejectSipNotFoundResponseBody(body *RejectSipNotFoundResponseBody)
 	return
 }
 
-// ValidateShowSipDecisionInternalErrorResponseBody runs the validations
-// defined on show_sip_decision_internal_error_response_body
-func ValidateShowSipDecisionInternalErrorResponseBody(body *ShowSipDecisionInternalErrorResponseBody) (err error) {
+// ValidateAddSipNotValidResponseBody runs the validations defined on
+// add_sip_not_valid_response_body
+func ValidateAddSipNotValidResponseBody(body *AddSipNotValidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3155,9 +4863,9 @@ func ValidateShowSipDecisionInternalErrorResponseBody(body *ShowSipDecisionInter
 	return
 }
 
-// ValidateShowSipDecisionNotAvailableResponseBody runs the validations defined
-// on show_sip_decision_not_available_response_body
-func ValidateShowSipDecisionNotAvailableResponseBody(body *ShowSipDecisionNotAvailableResponseBody) (err error) {
+// ValidateAddSipInternalErrorResponseBody runs the validations defined on
+// add_sip_internal_error_response_body
+func ValidateAddSipInternalErrorResponseBody(body *AddSipInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3179,9 +4887,9 @@ func ValidateShowSipDecisionNotAvailableResponseBody(body *ShowSipDecisionNotAva
 	return
 }
 
-// ValidateShowSipDecisionNotValidResponseBody runs the validations defined on
-// show_sip_decision_not_valid_response_body
-func ValidateShowSipDecisionNotValidResponseBody(body *ShowSipDecisionNotValidResponseBody) (err error) {
+// ValidateUploadSipInvalidMediaTypeResponseBody runs the validations defined
+// on upload_sip_invalid_media_type_response_body
+func ValidateUploadSipInvalidMediaTypeResponseBody(body *UploadSipInvalidMediaTypeResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3203,24 +4911,33 @@ func ValidateShowSipDecisionNotValidResponseBody(body *ShowSipDecisionNotValidRe
 	return
 }
 
-// ValidateShowSipDecisionNotFoundResponseBody runs the validations defined on
-// show_sip_decision_not_found_response_body
-func ValidateShowSipDecisionNotFoundResponseBody(body *ShowSipDecisionNotFoundResponseBody) (err error) {
+// ValidateUploadSipInvalidMultipartRequestResponseBody runs the validations
+// defined on upload_sip_invalid_multipart_request_response_body
+func ValidateUploadSipInvalidMultipartRequestResponseBody(body *UploadSipInvalidMultipartRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UUID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.UUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateSubmitSipDecisionInternalErrorResponseBody runs the validations
-// defined on submit_sip_decision_internal_error_response_body
-func ValidateSubmitSipDecisionInternalErrorResponseBody(body *SubmitSipDecisionInternalErrorResponseBody) (err error) {
+// ValidateUploadSipInternalErrorResponseBody runs the validations defined on
+// upload_sip_internal_error_response_body
+func ValidateUploadSipInternalErrorResponseBody(body *UploadSipInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3242,9 +4959,9 @@ func ValidateSubmitSipDecisionInternalErrorResponseBody(body *SubmitSipDecisionI
 	return
 }
 
-// ValidateSubmitSipDecisionNotAvailableResponseBody runs the validations
-// defined on submit_sip_decision_not_available_response_body
-func ValidateSubmitSipDecisionNotAvailableResponseBody(body *SubmitSipDecisionNotAvailableResponseBody) (err error) {
+// ValidateCreateSipUploadNotValidResponseBody runs the validations defined on
+// create_sip_upload_not_valid_response_body
+func ValidateCreateSipUploadNotValidResponseBody(body *CreateSipUploadNotValidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3266,9 +4983,9 @@ func ValidateSubmitSipDecisionNotAvailableResponseBody(body *SubmitSipDecisionNo
 	return
 }
 
-// ValidateSubmitSipDecisionNotValidResponseBody runs the validations defined
-// on submit_sip_decision_not_valid_response_body
-func ValidateSubmitSipDecisionNotValidResponseBody(body *SubmitSipDecisionNotValidResponseBody) (err error) {
+// ValidateCreateSipUploadInternalErrorResponseBody runs the validations defined
+// on create_sip_upload_internal_error_response_body
+func ValidateCreateSipUploadInternalErrorResponseBody(body *CreateSipUploadInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3290,24 +5007,57 @@ func ValidateSubmitSipDecisionNotValidResponseBody(body *SubmitSipDecisionNotVal
 	return
 }
 
-// ValidateSubmitSipDecisionNotFoundResponseBody runs the validations defined
-// on submit_sip_decision_not_found_response_body
-func ValidateSubmitSipDecisionNotFoundResponseBody(body *SubmitSipDecisionNotFoundResponseBody) (err error) {
+// ValidateShowSipUploadNotFoundResponseBody runs the validations defined on
+// show_sip_upload_not_found_response_body
+func ValidateShowSipUploadNotFoundResponseBody(body *ShowSipUploadNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateShowSipUploadNotValidResponseBody runs the validations defined on
+// show_sip_upload_not_valid_response_body
+func ValidateShowSipUploadNotValidResponseBody(body *ShowSipUploadNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UUID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.UUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateAddSipNotValidResponseBody runs the validations defined on
-// add_sip_not_valid_response_body
-func ValidateAddSipNotValidResponseBody(body *AddSipNotValidResponseBody) (err error) {
+// ValidateShowSipUploadInternalErrorResponseBody runs the validations defined
+// on show_sip_upload_internal_error_response_body
+func ValidateShowSipUploadInternalErrorResponseBody(body *ShowSipUploadInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3329,9 +5079,9 @@ func ValidateAddSipNotValidResponseBody(body *AddSipNotValidResponseBody) (err e
 	return
 }
 
-// ValidateAddSipInternalErrorResponseBody runs the validations defined on
-// add_sip_internal_error_response_body
-func ValidateAddSipInternalErrorResponseBody(body *AddSipInternalErrorResponseBody) (err error) {
+// ValidateUploadSipChunkNotFoundResponseBody runs the validations defined on
+// upload_sip_chunk_not_found_response_body
+func ValidateUploadSipChunkNotFoundResponseBody(body *UploadSipChunkNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3353,9 +5103,9 @@ func ValidateAddSipInternalErrorResponseBody(body *AddSipInternalErrorResponseBo
 	return
 }
 
-// ValidateUploadSipInvalidMediaTypeResponseBody runs the validations defined
-// on upload_sip_invalid_media_type_response_body
-func ValidateUploadSipInvalidMediaTypeResponseBody(body *UploadSipInvalidMediaTypeResponseBody) (err error) {
+// ValidateUploadSipChunkNotValidResponseBody runs the validations defined on
+// upload_sip_chunk_not_valid_response_body
+func ValidateUploadSipChunkNotValidResponseBody(body *UploadSipChunkNotValidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3377,9 +5127,9 @@ func ValidateUploadSipInvalidMediaTypeResponseBody(body *UploadSipInvalidMediaTy
 	return
 }
 
-// ValidateUploadSipInvalidMultipartRequestResponseBody runs the validations
-// defined on upload_sip_invalid_multipart_request_response_body
-func ValidateUploadSipInvalidMultipartRequestResponseBody(body *UploadSipInvalidMultipartRequestResponseBody) (err error) {
+// ValidateUploadSipChunkNotAvailableResponseBody runs the validations defined
+// on upload_sip_chunk_not_available_response_body
+func ValidateUploadSipChunkNotAvailableResponseBody(body *UploadSipChunkNotAvailableResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3401,9 +5151,9 @@ func ValidateUploadSipInvalidMultipartRequestResponseBody(body *UploadSipInvalid
 	return
 }
 
-// ValidateUploadSipInternalErrorResponseBody runs the validations defined on
-// upload_sip_internal_error_response_body
-func ValidateUploadSipInternalErrorResponseBody(body *UploadSipInternalErrorResponseBody) (err error) {
+// ValidateUploadSipChunkInternalErrorResponseBody runs the validations defined
+// on upload_sip_chunk_internal_error_response_body
+func ValidateUploadSipChunkInternalErrorResponseBody(body *UploadSipChunkInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3869,6 +5619,237 @@ func ValidateReviewBatchNotFoundResponseBody(body *ReviewBatchNotFoundResponseBo
 	return
 }
 
+// ValidateRetryBatchNotAvailableResponseBody runs the validations defined on
+// retry_batch_not_available_response_body
+func ValidateRetryBatchNotAvailableResponseBody(body *RetryBatchNotAvailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRetryBatchNotValidResponseBody runs the validations defined on
+// retry_batch_not_valid_response_body
+func ValidateRetryBatchNotValidResponseBody(body *RetryBatchNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRetryBatchNotFoundResponseBody runs the validations defined on
+// retry_batch_not_found_response_body
+func ValidateRetryBatchNotFoundResponseBody(body *RetryBatchNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateCreateAPITokenNotValidResponseBody runs the validations defined on
+// create_api_token_not_valid_response_body
+func ValidateCreateAPITokenNotValidResponseBody(body *CreateAPITokenNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateAPITokenInternalErrorResponseBody runs the validations defined on
+// create_api_token_internal_error_response_body
+func ValidateCreateAPITokenInternalErrorResponseBody(body *CreateAPITokenInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListAPITokensInternalErrorResponseBody runs the validations defined on
+// list_api_tokens_internal_error_response_body
+func ValidateListAPITokensInternalErrorResponseBody(body *ListAPITokensInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeAPITokenNotFoundResponseBody runs the validations defined on
+// revoke_api_token_not_found_response_body
+func ValidateRevokeAPITokenNotFoundResponseBody(body *RevokeAPITokenNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeAPITokenNotValidResponseBody runs the validations defined on
+// revoke_api_token_not_valid_response_body
+func ValidateRevokeAPITokenNotValidResponseBody(body *RevokeAPITokenNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeAPITokenInternalErrorResponseBody runs the validations defined on
+// revoke_api_token_internal_error_response_body
+func ValidateRevokeAPITokenInternalErrorResponseBody(body *RevokeAPITokenInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSearchNotValidResponseBody runs the validations defined on
+// search_not_valid_response_body
+func ValidateSearchNotValidResponseBody(body *SearchNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateSIPCreatedEventResponseBody runs the validations defined on
 // SIPCreatedEventResponseBody
 func ValidateSIPCreatedEventResponseBody(body *SIPCreatedEventResponseBody) (err error) {
@@ -4311,3 +6292,45 @@ func ValidateBatchResponseBodyCollection(body BatchResponseBodyCollection) (err
 	}
 	return
 }
+
+// ValidateAPITokenResponseBody runs the validations defined on
+// APITokenResponseBody
+func ValidateAPITokenResponseBody(body *APITokenResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", *body.UUID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	if body.LastUsedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_used_at", *body.LastUsedAt, goa.FormatDateTime))
+	}
+	if body.RevokedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.revoked_at", *body.RevokedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSearchResultResponseBody runs the validations defined on
+// SearchResultResponseBody
+func ValidateSearchResultResponseBody(body *SearchResultResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	return
+}
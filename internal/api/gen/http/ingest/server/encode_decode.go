@@ -129,6 +129,7 @@ func DecodeListSipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 			status              *string
 			uploaderUUID        *string
 			batchUUID           *string
+			metadataKey         *string
 			limit               *int
 			offset              *int
 			token               *string
@@ -183,6 +184,10 @@ func DecodeListSipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		if batchUUID != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("batch_uuid", *batchUUID, goa.FormatUUID))
 		}
+		metadataKeyRaw := qp.Get("metadata_key")
+		if metadataKeyRaw != "" {
+			metadataKey = &metadataKeyRaw
+		}
 		{
 			limitRaw := qp.Get("limit")
 			if limitRaw != "" {
@@ -212,7 +217,7 @@ func DecodeListSipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		if err != nil {
 			return payload, err
 		}
-		payload = NewListSipsPayload(name, aipUUID, earliestCreatedTime, latestCreatedTime, status, uploaderUUID, batchUUID, limit, offset, token)
+		payload = NewListSipsPayload(name, aipUUID, earliestCreatedTime, latestCreatedTime, status, uploaderUUID, batchUUID, metadataKey, limit, offset, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -607,6 +612,24 @@ func EncodeRejectSipResponse(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
+// EncodeRetrySipResponse returns an encoder for responses returned by the
+// ingest retry_sip endpoint.
+func EncodeRetrySipResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusAccepted)
+		return nil
+	}
+}
+
+// EncodeCancelSipResponse returns an encoder for responses returned by the
+// ingest cancel_sip endpoint.
+func EncodeCancelSipResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusAccepted)
+		return nil
+	}
+}
+
 // DecodeRejectSipRequest returns a decoder for requests sent to the ingest
 // reject_sip endpoint.
 func DecodeRejectSipRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.RejectSipPayload, error) {
@@ -641,6 +664,74 @@ func DecodeRejectSipRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 	}
 }
 
+// DecodeRetrySipRequest returns a decoder for requests sent to the ingest
+// retry_sip endpoint.
+func DecodeRetrySipRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.RetrySipPayload, error) {
+	return func(r *http.Request) (*ingest.RetrySipPayload, error) {
+		var payload *ingest.RetrySipPayload
+		var (
+			uuid  string
+			token *string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewRetrySipPayload(uuid, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// DecodeCancelSipRequest returns a decoder for requests sent to the ingest
+// cancel_sip endpoint.
+func DecodeCancelSipRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.CancelSipPayload, error) {
+	return func(r *http.Request) (*ingest.CancelSipPayload, error) {
+		var payload *ingest.CancelSipPayload
+		var (
+			uuid  string
+			token *string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewCancelSipPayload(uuid, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
 // EncodeRejectSipError returns an encoder for errors returned by the
 // reject_sip ingest endpoint.
 func EncodeRejectSipError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
@@ -712,6 +803,148 @@ func EncodeRejectSipError(encoder func(context.Context, http.ResponseWriter) goa
 	}
 }
 
+// EncodeRetrySipError returns an encoder for errors returned by the
+// retry_sip ingest endpoint.
+func EncodeRetrySipError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_available":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRetrySipNotAvailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRetrySipNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *ingest.SIPNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRetrySipNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCancelSipError returns an encoder for errors returned by the
+// cancel_sip ingest endpoint.
+func EncodeCancelSipError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_available":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCancelSipNotAvailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCancelSipNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *ingest.SIPNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCancelSipNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeShowSipDecisionResponse returns an encoder for responses returned by
 // the ingest show_sip_decision endpoint.
 func EncodeShowSipDecisionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -1223,48 +1456,51 @@ func EncodeUploadSipError(encoder func(context.Context, http.ResponseWriter) goa
 	}
 }
 
-// EncodeDownloadSipRequestResponse returns an encoder for responses returned
-// by the ingest download_sip_request endpoint.
-func EncodeDownloadSipRequestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCreateSipUploadResponse returns an encoder for responses returned by
+// the ingest create_sip_upload endpoint.
+func EncodeCreateSipUploadResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*ingest.DownloadSipRequestResult)
-		if res.Ticket != nil {
-			ticket := *res.Ticket
-			http.SetCookie(w, &http.Cookie{
-				Name:     "enduro-sip-download-ticket",
-				Value:    ticket,
-				MaxAge:   5,
-				Secure:   true,
-				HttpOnly: true,
-			})
-		}
-		w.WriteHeader(http.StatusOK)
-		return nil
+		res, _ := v.(*ingest.SIPUpload)
+		enc := encoder(ctx, w)
+		body := NewCreateSipUploadResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeDownloadSipRequestRequest returns a decoder for requests sent to the
-// ingest download_sip_request endpoint.
-func DecodeDownloadSipRequestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.DownloadSipRequestPayload, error) {
-	return func(r *http.Request) (*ingest.DownloadSipRequestPayload, error) {
-		var payload *ingest.DownloadSipRequestPayload
+// DecodeCreateSipUploadRequest returns a decoder for requests sent to the
+// ingest create_sip_upload endpoint.
+func DecodeCreateSipUploadRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.CreateSipUploadPayload, error) {
+	return func(r *http.Request) (*ingest.CreateSipUploadPayload, error) {
+		var payload *ingest.CreateSipUploadPayload
 		var (
-			uuid  string
-			token *string
-			err   error
+			body CreateSipUploadRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateSipUploadRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
 
-			params = mux.Vars(r)
+		var (
+			token *string
 		)
-		uuid = params["uuid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
 		tokenRaw := r.Header.Get("Authorization")
 		if tokenRaw != "" {
 			token = &tokenRaw
 		}
-		if err != nil {
-			return payload, err
-		}
-		payload = NewDownloadSipRequestPayload(uuid, token)
+		payload = NewCreateSipUploadPayload(&body, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1277,9 +1513,9 @@ func DecodeDownloadSipRequestRequest(mux goahttp.Muxer, decoder func(*http.Reque
 	}
 }
 
-// EncodeDownloadSipRequestError returns an encoder for errors returned by the
-// download_sip_request ingest endpoint.
-func EncodeDownloadSipRequestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCreateSipUploadError returns an encoder for errors returned by the
+// create_sip_upload ingest endpoint.
+func EncodeCreateSipUploadError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1295,7 +1531,7 @@ func EncodeDownloadSipRequestError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDownloadSipRequestNotValidResponseBody(res)
+				body = NewCreateSipUploadNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1308,24 +1544,11 @@ func EncodeDownloadSipRequestError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDownloadSipRequestInternalErrorResponseBody(res)
+				body = NewCreateSipUploadInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "not_found":
-			var res *ingest.SIPNotFound
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewDownloadSipRequestNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
 		case "forbidden":
 			var res ingest.Forbidden
 			errors.As(v, &res)
@@ -1348,58 +1571,55 @@ func EncodeDownloadSipRequestError(encoder func(context.Context, http.ResponseWr
 	}
 }
 
-// EncodeDownloadSipResponse returns an encoder for responses returned by the
-// ingest download_sip endpoint.
-func EncodeDownloadSipResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeShowSipUploadResponse returns an encoder for responses returned by the
+// ingest show_sip_upload endpoint.
+func EncodeShowSipUploadResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*ingest.DownloadSipResult)
-		w.Header().Set("Content-Type", res.ContentType)
-		{
-			val := res.ContentLength
-			contentLengths := strconv.FormatInt(val, 10)
-			w.Header().Set("Content-Length", contentLengths)
-		}
-		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		res, _ := v.(*ingest.SIPUpload)
+		enc := encoder(ctx, w)
+		body := NewShowSipUploadResponseBody(res)
 		w.WriteHeader(http.StatusOK)
-		return nil
+		return enc.Encode(body)
 	}
 }
 
-// DecodeDownloadSipRequest returns a decoder for requests sent to the ingest
-// download_sip endpoint.
-func DecodeDownloadSipRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.DownloadSipPayload, error) {
-	return func(r *http.Request) (*ingest.DownloadSipPayload, error) {
-		var payload *ingest.DownloadSipPayload
+// DecodeShowSipUploadRequest returns a decoder for requests sent to the ingest
+// show_sip_upload endpoint.
+func DecodeShowSipUploadRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ShowSipUploadPayload, error) {
+	return func(r *http.Request) (*ingest.ShowSipUploadPayload, error) {
+		var payload *ingest.ShowSipUploadPayload
 		var (
-			uuid   string
-			ticket *string
-			err    error
-			c      *http.Cookie
+			uuid  string
+			token *string
+			err   error
 
 			params = mux.Vars(r)
 		)
 		uuid = params["uuid"]
 		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
-		c, _ = r.Cookie("enduro-sip-download-ticket")
-		var ticketRaw string
-		if c != nil {
-			ticketRaw = c.Value
-		}
-		if ticketRaw != "" {
-			ticket = &ticketRaw
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
 		}
 		if err != nil {
 			return payload, err
 		}
-		payload = NewDownloadSipPayload(uuid, ticket)
+		payload = NewShowSipUploadPayload(uuid, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
 }
 
-// EncodeDownloadSipError returns an encoder for errors returned by the
-// download_sip ingest endpoint.
-func EncodeDownloadSipError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeShowSipUploadError returns an encoder for errors returned by the
+// show_sip_upload ingest endpoint.
+func EncodeShowSipUploadError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1407,7 +1627,7 @@ func EncodeDownloadSipError(encoder func(context.Context, http.ResponseWriter) g
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "not_valid":
+		case "not_found":
 			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
@@ -1415,12 +1635,12 @@ func EncodeDownloadSipError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDownloadSipNotValidResponseBody(res)
+				body = NewShowSipUploadNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
-		case "internal_error":
+		case "not_valid":
 			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
@@ -1428,23 +1648,23 @@ func EncodeDownloadSipError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDownloadSipInternalErrorResponseBody(res)
+				body = NewShowSipUploadNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "not_found":
-			var res *ingest.SIPNotFound
+		case "internal_error":
+			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDownloadSipNotFoundResponseBody(res)
+				body = NewShowSipUploadInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
 		case "forbidden":
 			var res ingest.Forbidden
@@ -1468,61 +1688,48 @@ func EncodeDownloadSipError(encoder func(context.Context, http.ResponseWriter) g
 	}
 }
 
-// EncodeListUsersResponse returns an encoder for responses returned by the
-// ingest list_users endpoint.
-func EncodeListUsersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUploadSipChunkResponse returns an encoder for responses returned by the
+// ingest upload_sip_chunk endpoint.
+func EncodeUploadSipChunkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(*ingestviews.Users)
+		res, _ := v.(*ingest.SIPUpload)
 		enc := encoder(ctx, w)
-		body := NewListUsersResponseBody(res.Projected)
+		body := NewUploadSipChunkResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListUsersRequest returns a decoder for requests sent to the ingest
-// list_users endpoint.
-func DecodeListUsersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ListUsersPayload, error) {
-	return func(r *http.Request) (*ingest.ListUsersPayload, error) {
-		var payload *ingest.ListUsersPayload
+// DecodeUploadSipChunkRequest returns a decoder for requests sent to the ingest
+// upload_sip_chunk endpoint.
+func DecodeUploadSipChunkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.UploadSipChunkPayload, error) {
+	return func(r *http.Request) (*ingest.UploadSipChunkPayload, error) {
+		var payload *ingest.UploadSipChunkPayload
 		var (
-			email  *string
-			name   *string
-			limit  *int
-			offset *int
-			token  *string
-			err    error
+			uuid     string
+			offset   int64
+			checksum *string
+			token    *string
+			err      error
+
+			params = mux.Vars(r)
 		)
-		qp := r.URL.Query()
-		emailRaw := qp.Get("email")
-		if emailRaw != "" {
-			email = &emailRaw
-		}
-		nameRaw := qp.Get("name")
-		if nameRaw != "" {
-			name = &nameRaw
-		}
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
 		{
-			limitRaw := qp.Get("limit")
-			if limitRaw != "" {
-				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
-				}
-				pv := int(v)
-				limit = &pv
+			offsetRaw := r.Header.Get("Upload-Offset")
+			if offsetRaw == "" {
+				return payload, goa.MissingFieldError("offset", "header")
 			}
-		}
-		{
-			offsetRaw := qp.Get("offset")
-			if offsetRaw != "" {
-				v, err2 := strconv.ParseInt(offsetRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
-				}
-				pv := int(v)
-				offset = &pv
+			v, err2 := strconv.ParseInt(offsetRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
 			}
+			offset = v
+		}
+		checksumRaw := r.Header.Get("Upload-Checksum")
+		if checksumRaw != "" {
+			checksum = &checksumRaw
 		}
 		tokenRaw := r.Header.Get("Authorization")
 		if tokenRaw != "" {
@@ -1531,7 +1738,7 @@ func DecodeListUsersRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 		if err != nil {
 			return payload, err
 		}
-		payload = NewListUsersPayload(email, name, limit, offset, token)
+		payload = NewUploadSipChunkPayload(uuid, offset, checksum, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1544,9 +1751,9 @@ func DecodeListUsersRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 	}
 }
 
-// EncodeListUsersError returns an encoder for errors returned by the
-// list_users ingest endpoint.
-func EncodeListUsersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeUploadSipChunkError returns an encoder for errors returned by the
+// upload_sip_chunk ingest endpoint.
+func EncodeUploadSipChunkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1554,6 +1761,19 @@ func EncodeListUsersError(encoder func(context.Context, http.ResponseWriter) goa
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadSipChunkNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "not_valid":
 			var res *goa.ServiceError
 			errors.As(v, &res)
@@ -1562,11 +1782,37 @@ func EncodeListUsersError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListUsersNotValidResponseBody(res)
+				body = NewUploadSipChunkNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "not_available":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadSipChunkNotAvailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadSipChunkInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
 		case "forbidden":
 			var res ingest.Forbidden
 			errors.As(v, &res)
@@ -1589,50 +1835,40 @@ func EncodeListUsersError(encoder func(context.Context, http.ResponseWriter) goa
 	}
 }
 
-// EncodeListSipSourceObjectsResponse returns an encoder for responses returned
-// by the ingest list_sip_source_objects endpoint.
-func EncodeListSipSourceObjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeDownloadSipRequestResponse returns an encoder for responses returned
+// by the ingest download_sip_request endpoint.
+func EncodeDownloadSipRequestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(*ingestviews.SIPSourceObjects)
-		enc := encoder(ctx, w)
-		body := NewListSipSourceObjectsResponseBody(res.Projected)
+		res, _ := v.(*ingest.DownloadSipRequestResult)
+		if res.Ticket != nil {
+			ticket := *res.Ticket
+			http.SetCookie(w, &http.Cookie{
+				Name:     "enduro-sip-download-ticket",
+				Value:    ticket,
+				MaxAge:   5,
+				Secure:   true,
+				HttpOnly: true,
+			})
+		}
 		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
+		return nil
 	}
 }
 
-// DecodeListSipSourceObjectsRequest returns a decoder for requests sent to the
-// ingest list_sip_source_objects endpoint.
-func DecodeListSipSourceObjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ListSipSourceObjectsPayload, error) {
-	return func(r *http.Request) (*ingest.ListSipSourceObjectsPayload, error) {
-		var payload *ingest.ListSipSourceObjectsPayload
+// DecodeDownloadSipRequestRequest returns a decoder for requests sent to the
+// ingest download_sip_request endpoint.
+func DecodeDownloadSipRequestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.DownloadSipRequestPayload, error) {
+	return func(r *http.Request) (*ingest.DownloadSipRequestPayload, error) {
+		var payload *ingest.DownloadSipRequestPayload
 		var (
-			uuid   string
-			limit  *int
-			cursor *string
-			token  *string
-			err    error
+			uuid  string
+			token *string
+			err   error
 
 			params = mux.Vars(r)
 		)
 		uuid = params["uuid"]
 		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
-		qp := r.URL.Query()
-		{
-			limitRaw := qp.Get("limit")
-			if limitRaw != "" {
-				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
-				}
-				pv := int(v)
-				limit = &pv
-			}
-		}
-		cursorRaw := qp.Get("cursor")
-		if cursorRaw != "" {
-			cursor = &cursorRaw
-		}
 		tokenRaw := r.Header.Get("Authorization")
 		if tokenRaw != "" {
 			token = &tokenRaw
@@ -1640,7 +1876,7 @@ func DecodeListSipSourceObjectsRequest(mux goahttp.Muxer, decoder func(*http.Req
 		if err != nil {
 			return payload, err
 		}
-		payload = NewListSipSourceObjectsPayload(uuid, limit, cursor, token)
+		payload = NewDownloadSipRequestPayload(uuid, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1653,9 +1889,9 @@ func DecodeListSipSourceObjectsRequest(mux goahttp.Muxer, decoder func(*http.Req
 	}
 }
 
-// EncodeListSipSourceObjectsError returns an encoder for errors returned by
-// the list_sip_source_objects ingest endpoint.
-func EncodeListSipSourceObjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeDownloadSipRequestError returns an encoder for errors returned by the
+// download_sip_request ingest endpoint.
+func EncodeDownloadSipRequestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1663,7 +1899,7 @@ func EncodeListSipSourceObjectsError(encoder func(context.Context, http.Response
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "not_found":
+		case "not_valid":
 			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
@@ -1671,12 +1907,12 @@ func EncodeListSipSourceObjectsError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListSipSourceObjectsNotFoundResponseBody(res)
+				body = NewDownloadSipRequestNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "not_valid":
+		case "internal_error":
 			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
@@ -1684,23 +1920,23 @@ func EncodeListSipSourceObjectsError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListSipSourceObjectsNotValidResponseBody(res)
+				body = NewDownloadSipRequestInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "internal_error":
-			var res *goa.ServiceError
+		case "not_found":
+			var res *ingest.SIPNotFound
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListSipSourceObjectsInternalErrorResponseBody(res)
+				body = NewDownloadSipRequestNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "forbidden":
 			var res ingest.Forbidden
@@ -1724,25 +1960,793 @@ func EncodeListSipSourceObjectsError(encoder func(context.Context, http.Response
 	}
 }
 
-// EncodeAddBatchResponse returns an encoder for responses returned by the
-// ingest add_batch endpoint.
-func EncodeAddBatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeDownloadSipResponse returns an encoder for responses returned by the
+// ingest download_sip endpoint.
+func EncodeDownloadSipResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*ingest.DownloadSipResult)
+		w.Header().Set("Content-Type", res.ContentType)
+		{
+			val := res.ContentLength
+			contentLengths := strconv.FormatInt(val, 10)
+			w.Header().Set("Content-Length", contentLengths)
+		}
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDownloadSipRequest returns a decoder for requests sent to the ingest
+// download_sip endpoint.
+func DecodeDownloadSipRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.DownloadSipPayload, error) {
+	return func(r *http.Request) (*ingest.DownloadSipPayload, error) {
+		var payload *ingest.DownloadSipPayload
+		var (
+			uuid   string
+			ticket *string
+			err    error
+			c      *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		c, _ = r.Cookie("enduro-sip-download-ticket")
+		var ticketRaw string
+		if c != nil {
+			ticketRaw = c.Value
+		}
+		if ticketRaw != "" {
+			ticket = &ticketRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewDownloadSipPayload(uuid, ticket)
+
+		return payload, nil
+	}
+}
+
+// EncodeDownloadSipError returns an encoder for errors returned by the
+// download_sip ingest endpoint.
+func EncodeDownloadSipError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDownloadSipNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDownloadSipInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not_found":
+			var res *ingest.SIPNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDownloadSipNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListUsersResponse returns an encoder for responses returned by the
+// ingest list_users endpoint.
+func EncodeListUsersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*ingestviews.Users)
+		enc := encoder(ctx, w)
+		body := NewListUsersResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListUsersRequest returns a decoder for requests sent to the ingest
+// list_users endpoint.
+func DecodeListUsersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ListUsersPayload, error) {
+	return func(r *http.Request) (*ingest.ListUsersPayload, error) {
+		var payload *ingest.ListUsersPayload
+		var (
+			email  *string
+			name   *string
+			limit  *int
+			offset *int
+			token  *string
+			err    error
+		)
+		qp := r.URL.Query()
+		emailRaw := qp.Get("email")
+		if emailRaw != "" {
+			email = &emailRaw
+		}
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		{
+			offsetRaw := qp.Get("offset")
+			if offsetRaw != "" {
+				v, err2 := strconv.ParseInt(offsetRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
+				}
+				pv := int(v)
+				offset = &pv
+			}
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewListUsersPayload(email, name, limit, offset, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListUsersError returns an encoder for errors returned by the
+// list_users ingest endpoint.
+func EncodeListUsersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListSipSourceObjectsResponse returns an encoder for responses returned
+// by the ingest list_sip_source_objects endpoint.
+func EncodeListSipSourceObjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*ingestviews.SIPSourceObjects)
+		enc := encoder(ctx, w)
+		body := NewListSipSourceObjectsResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListSipSourceObjectsRequest returns a decoder for requests sent to the
+// ingest list_sip_source_objects endpoint.
+func DecodeListSipSourceObjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ListSipSourceObjectsPayload, error) {
+	return func(r *http.Request) (*ingest.ListSipSourceObjectsPayload, error) {
+		var payload *ingest.ListSipSourceObjectsPayload
+		var (
+			uuid   string
+			limit  *int
+			cursor *string
+			token  *string
+			err    error
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		qp := r.URL.Query()
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewListSipSourceObjectsPayload(uuid, limit, cursor, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListSipSourceObjectsError returns an encoder for errors returned by
+// the list_sip_source_objects ingest endpoint.
+func EncodeListSipSourceObjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListSipSourceObjectsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListSipSourceObjectsNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListSipSourceObjectsInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAddBatchResponse returns an encoder for responses returned by the
+// ingest add_batch endpoint.
+func EncodeAddBatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		res, _ := v.(*ingest.AddBatchResult)
 		enc := encoder(ctx, w)
-		body := NewAddBatchResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewAddBatchResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAddBatchRequest returns a decoder for requests sent to the ingest
+// add_batch endpoint.
+func DecodeAddBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.AddBatchPayload, error) {
+	return func(r *http.Request) (*ingest.AddBatchPayload, error) {
+		var payload *ingest.AddBatchPayload
+		var (
+			body AddBatchRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateAddBatchRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			token *string
+		)
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		payload = NewAddBatchPayload(&body, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeAddBatchError returns an encoder for errors returned by the add_batch
+// ingest endpoint.
+func EncodeAddBatchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddBatchNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddBatchInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListBatchesResponse returns an encoder for responses returned by the
+// ingest list_batches endpoint.
+func EncodeListBatchesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*ingestviews.Batches)
+		enc := encoder(ctx, w)
+		body := NewListBatchesResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListBatchesRequest returns a decoder for requests sent to the ingest
+// list_batches endpoint.
+func DecodeListBatchesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ListBatchesPayload, error) {
+	return func(r *http.Request) (*ingest.ListBatchesPayload, error) {
+		var payload *ingest.ListBatchesPayload
+		var (
+			identifier          *string
+			earliestCreatedTime *string
+			latestCreatedTime   *string
+			status              *string
+			uploaderUUID        *string
+			limit               *int
+			offset              *int
+			token               *string
+			err                 error
+		)
+		qp := r.URL.Query()
+		identifierRaw := qp.Get("identifier")
+		if identifierRaw != "" {
+			identifier = &identifierRaw
+		}
+		earliestCreatedTimeRaw := qp.Get("earliest_created_time")
+		if earliestCreatedTimeRaw != "" {
+			earliestCreatedTime = &earliestCreatedTimeRaw
+		}
+		if earliestCreatedTime != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("earliest_created_time", *earliestCreatedTime, goa.FormatDateTime))
+		}
+		latestCreatedTimeRaw := qp.Get("latest_created_time")
+		if latestCreatedTimeRaw != "" {
+			latestCreatedTime = &latestCreatedTimeRaw
+		}
+		if latestCreatedTime != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("latest_created_time", *latestCreatedTime, goa.FormatDateTime))
+		}
+		statusRaw := qp.Get("status")
+		if statusRaw != "" {
+			status = &statusRaw
+		}
+		if status != nil {
+			if !(*status == "queued" || *status == "processing" || *status == "pending" || *status == "ingested" || *status == "canceled" || *status == "failed") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"queued", "processing", "pending", "ingested", "canceled", "failed"}))
+			}
+		}
+		uploaderUUIDRaw := qp.Get("uploader_uuid")
+		if uploaderUUIDRaw != "" {
+			uploaderUUID = &uploaderUUIDRaw
+		}
+		if uploaderUUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("uploader_uuid", *uploaderUUID, goa.FormatUUID))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		{
+			offsetRaw := qp.Get("offset")
+			if offsetRaw != "" {
+				v, err2 := strconv.ParseInt(offsetRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
+				}
+				pv := int(v)
+				offset = &pv
+			}
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewListBatchesPayload(identifier, earliestCreatedTime, latestCreatedTime, status, uploaderUUID, limit, offset, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListBatchesError returns an encoder for errors returned by the
+// list_batches ingest endpoint.
+func EncodeListBatchesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListBatchesNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListBatchesInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeShowBatchResponse returns an encoder for responses returned by the
+// ingest show_batch endpoint.
+func EncodeShowBatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*ingestviews.Batch)
+		enc := encoder(ctx, w)
+		body := NewShowBatchResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeAddBatchRequest returns a decoder for requests sent to the ingest
-// add_batch endpoint.
-func DecodeAddBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.AddBatchPayload, error) {
-	return func(r *http.Request) (*ingest.AddBatchPayload, error) {
-		var payload *ingest.AddBatchPayload
+// DecodeShowBatchRequest returns a decoder for requests sent to the ingest
+// show_batch endpoint.
+func DecodeShowBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ShowBatchPayload, error) {
+	return func(r *http.Request) (*ingest.ShowBatchPayload, error) {
+		var payload *ingest.ShowBatchPayload
+		var (
+			uuid  string
+			token *string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewShowBatchPayload(uuid, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeShowBatchError returns an encoder for errors returned by the
+// show_batch ingest endpoint.
+func EncodeShowBatchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewShowBatchNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewShowBatchInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not_found":
+			var res *ingest.BatchNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewShowBatchNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeReviewBatchResponse returns an encoder for responses returned by the
+// ingest review_batch endpoint.
+func EncodeReviewBatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusAccepted)
+		return nil
+	}
+}
+
+// DecodeReviewBatchRequest returns a decoder for requests sent to the ingest
+// review_batch endpoint.
+func DecodeReviewBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ReviewBatchPayload, error) {
+	return func(r *http.Request) (*ingest.ReviewBatchPayload, error) {
+		var payload *ingest.ReviewBatchPayload
 		var (
-			body AddBatchRequestBody
+			body ReviewBatchRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -1756,19 +2760,27 @@ func DecodeAddBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateAddBatchRequestBody(&body)
+		err = ValidateReviewBatchRequestBody(&body)
 		if err != nil {
 			return payload, err
 		}
 
 		var (
+			uuid  string
 			token *string
+
+			params = mux.Vars(r)
 		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
 		tokenRaw := r.Header.Get("Authorization")
 		if tokenRaw != "" {
 			token = &tokenRaw
 		}
-		payload = NewAddBatchPayload(&body, token)
+		if err != nil {
+			return payload, err
+		}
+		payload = NewReviewBatchPayload(&body, uuid, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1781,9 +2793,9 @@ func DecodeAddBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 	}
 }
 
-// EncodeAddBatchError returns an encoder for errors returned by the add_batch
-// ingest endpoint.
-func EncodeAddBatchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeReviewBatchError returns an encoder for errors returned by the
+// review_batch ingest endpoint.
+func EncodeReviewBatchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1799,7 +2811,7 @@ func EncodeAddBatchError(encoder func(context.Context, http.ResponseWriter) goah
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewAddBatchNotValidResponseBody(res)
+				body = NewReviewBatchNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1812,11 +2824,24 @@ func EncodeAddBatchError(encoder func(context.Context, http.ResponseWriter) goah
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewAddBatchInternalErrorResponseBody(res)
+				body = NewReviewBatchInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "not_found":
+			var res *ingest.BatchNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReviewBatchNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "forbidden":
 			var res ingest.Forbidden
 			errors.As(v, &res)
@@ -1839,99 +2864,165 @@ func EncodeAddBatchError(encoder func(context.Context, http.ResponseWriter) goah
 	}
 }
 
-// EncodeListBatchesResponse returns an encoder for responses returned by the
-// ingest list_batches endpoint.
-func EncodeListBatchesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeRetryBatchResponse returns an encoder for responses returned by the
+// ingest retry_batch endpoint.
+func EncodeRetryBatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(*ingestviews.Batches)
-		enc := encoder(ctx, w)
-		body := NewListBatchesResponseBody(res.Projected)
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusAccepted)
+		return nil
 	}
 }
 
-// DecodeListBatchesRequest returns a decoder for requests sent to the ingest
-// list_batches endpoint.
-func DecodeListBatchesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ListBatchesPayload, error) {
-	return func(r *http.Request) (*ingest.ListBatchesPayload, error) {
-		var payload *ingest.ListBatchesPayload
+// DecodeRetryBatchRequest returns a decoder for requests sent to the ingest
+// retry_batch endpoint.
+func DecodeRetryBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.RetryBatchPayload, error) {
+	return func(r *http.Request) (*ingest.RetryBatchPayload, error) {
+		var payload *ingest.RetryBatchPayload
 		var (
-			identifier          *string
-			earliestCreatedTime *string
-			latestCreatedTime   *string
-			status              *string
-			uploaderUUID        *string
-			limit               *int
-			offset              *int
-			token               *string
-			err                 error
+			uuid  string
+			token *string
+			err   error
+
+			params = mux.Vars(r)
 		)
-		qp := r.URL.Query()
-		identifierRaw := qp.Get("identifier")
-		if identifierRaw != "" {
-			identifier = &identifierRaw
-		}
-		earliestCreatedTimeRaw := qp.Get("earliest_created_time")
-		if earliestCreatedTimeRaw != "" {
-			earliestCreatedTime = &earliestCreatedTimeRaw
-		}
-		if earliestCreatedTime != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("earliest_created_time", *earliestCreatedTime, goa.FormatDateTime))
-		}
-		latestCreatedTimeRaw := qp.Get("latest_created_time")
-		if latestCreatedTimeRaw != "" {
-			latestCreatedTime = &latestCreatedTimeRaw
-		}
-		if latestCreatedTime != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("latest_created_time", *latestCreatedTime, goa.FormatDateTime))
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
 		}
-		statusRaw := qp.Get("status")
-		if statusRaw != "" {
-			status = &statusRaw
+		if err != nil {
+			return payload, err
 		}
-		if status != nil {
-			if !(*status == "queued" || *status == "processing" || *status == "pending" || *status == "ingested" || *status == "canceled" || *status == "failed") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"queued", "processing", "pending", "ingested", "canceled", "failed"}))
+		payload = NewRetryBatchPayload(uuid, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
 			}
 		}
-		uploaderUUIDRaw := qp.Get("uploader_uuid")
-		if uploaderUUIDRaw != "" {
-			uploaderUUID = &uploaderUUIDRaw
+
+		return payload, nil
+	}
+}
+
+// EncodeRetryBatchError returns an encoder for errors returned by the
+// retry_batch ingest endpoint.
+func EncodeRetryBatchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
 		}
-		if uploaderUUID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("uploader_uuid", *uploaderUUID, goa.FormatUUID))
+		switch en.GoaErrorName() {
+		case "not_available":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRetryBatchNotAvailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRetryBatchNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *ingest.BatchNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRetryBatchNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
 		}
-		{
-			limitRaw := qp.Get("limit")
-			if limitRaw != "" {
-				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
-				}
-				pv := int(v)
-				limit = &pv
+	}
+}
+
+// EncodeCreateAPITokenResponse returns an encoder for responses returned by the
+// ingest create_api_token endpoint.
+func EncodeCreateAPITokenResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*ingest.APIToken)
+		enc := encoder(ctx, w)
+		body := NewCreateAPITokenResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateAPITokenRequest returns a decoder for requests sent to the ingest
+// create_api_token endpoint.
+func DecodeCreateAPITokenRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.CreateAPITokenPayload, error) {
+	return func(r *http.Request) (*ingest.CreateAPITokenPayload, error) {
+		var payload *ingest.CreateAPITokenPayload
+		var (
+			body CreateAPITokenRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
 			}
-		}
-		{
-			offsetRaw := qp.Get("offset")
-			if offsetRaw != "" {
-				v, err2 := strconv.ParseInt(offsetRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
-				}
-				pv := int(v)
-				offset = &pv
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
 			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateAPITokenRequestBody(&body)
+		if err != nil {
+			return payload, err
 		}
+
+		var (
+			token *string
+		)
 		tokenRaw := r.Header.Get("Authorization")
 		if tokenRaw != "" {
 			token = &tokenRaw
 		}
-		if err != nil {
-			return payload, err
-		}
-		payload = NewListBatchesPayload(identifier, earliestCreatedTime, latestCreatedTime, status, uploaderUUID, limit, offset, token)
+		payload = NewCreateAPITokenPayload(&body, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1944,9 +3035,9 @@ func DecodeListBatchesRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 	}
 }
 
-// EncodeListBatchesError returns an encoder for errors returned by the
-// list_batches ingest endpoint.
-func EncodeListBatchesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCreateAPITokenError returns an encoder for errors returned by the
+// create_api_token ingest endpoint.
+func EncodeCreateAPITokenError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1962,7 +3053,7 @@ func EncodeListBatchesError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListBatchesNotValidResponseBody(res)
+				body = NewCreateAPITokenNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1975,7 +3066,7 @@ func EncodeListBatchesError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListBatchesInternalErrorResponseBody(res)
+				body = NewCreateAPITokenInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2002,23 +3093,102 @@ func EncodeListBatchesError(encoder func(context.Context, http.ResponseWriter) g
 	}
 }
 
-// EncodeShowBatchResponse returns an encoder for responses returned by the
-// ingest show_batch endpoint.
-func EncodeShowBatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeListAPITokensResponse returns an encoder for responses returned by the
+// ingest list_api_tokens endpoint.
+func EncodeListAPITokensResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(*ingestviews.Batch)
+		res, _ := v.(*ingest.APITokens)
 		enc := encoder(ctx, w)
-		body := NewShowBatchResponseBody(res.Projected)
+		body := NewListAPITokensResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeShowBatchRequest returns a decoder for requests sent to the ingest
-// show_batch endpoint.
-func DecodeShowBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ShowBatchPayload, error) {
-	return func(r *http.Request) (*ingest.ShowBatchPayload, error) {
-		var payload *ingest.ShowBatchPayload
+// DecodeListAPITokensRequest returns a decoder for requests sent to the ingest
+// list_api_tokens endpoint.
+func DecodeListAPITokensRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ListAPITokensPayload, error) {
+	return func(r *http.Request) (*ingest.ListAPITokensPayload, error) {
+		var payload *ingest.ListAPITokensPayload
+		var (
+			token *string
+		)
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		payload = NewListAPITokensPayload(token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListAPITokensError returns an encoder for errors returned by the
+// list_api_tokens ingest endpoint.
+func EncodeListAPITokensError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListAPITokensInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "forbidden":
+			var res ingest.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res ingest.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRevokeAPITokenResponse returns an encoder for responses returned by the
+// ingest revoke_api_token endpoint.
+func EncodeRevokeAPITokenResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeRevokeAPITokenRequest returns a decoder for requests sent to the ingest
+// revoke_api_token endpoint.
+func DecodeRevokeAPITokenRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.RevokeAPITokenPayload, error) {
+	return func(r *http.Request) (*ingest.RevokeAPITokenPayload, error) {
+		var payload *ingest.RevokeAPITokenPayload
 		var (
 			uuid  string
 			token *string
@@ -2035,7 +3205,7 @@ func DecodeShowBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 		if err != nil {
 			return payload, err
 		}
-		payload = NewShowBatchPayload(uuid, token)
+		payload = NewRevokeAPITokenPayload(uuid, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2048,9 +3218,9 @@ func DecodeShowBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 	}
 }
 
-// EncodeShowBatchError returns an encoder for errors returned by the
-// show_batch ingest endpoint.
-func EncodeShowBatchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeRevokeAPITokenError returns an encoder for errors returned by the
+// revoke_api_token ingest endpoint.
+func EncodeRevokeAPITokenError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2058,7 +3228,7 @@ func EncodeShowBatchError(encoder func(context.Context, http.ResponseWriter) goa
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "not_valid":
+		case "not_found":
 			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
@@ -2066,12 +3236,12 @@ func EncodeShowBatchError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewShowBatchNotValidResponseBody(res)
+				body = NewRevokeAPITokenNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
-		case "internal_error":
+		case "not_valid":
 			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
@@ -2079,23 +3249,23 @@ func EncodeShowBatchError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewShowBatchInternalErrorResponseBody(res)
+				body = NewRevokeAPITokenNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "not_found":
-			var res *ingest.BatchNotFound
+		case "internal_error":
+			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewShowBatchNotFoundResponseBody(res)
+				body = NewRevokeAPITokenInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
 		case "forbidden":
 			var res ingest.Forbidden
@@ -2119,48 +3289,57 @@ func EncodeShowBatchError(encoder func(context.Context, http.ResponseWriter) goa
 	}
 }
 
-// EncodeReviewBatchResponse returns an encoder for responses returned by the
-// ingest review_batch endpoint.
-func EncodeReviewBatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeSearchResponse returns an encoder for responses returned by the ingest
+// search endpoint.
+func EncodeSearchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusAccepted)
-		return nil
+		res, _ := v.(*ingest.SearchResults)
+		enc := encoder(ctx, w)
+		body := NewSearchResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeReviewBatchRequest returns a decoder for requests sent to the ingest
-// review_batch endpoint.
-func DecodeReviewBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.ReviewBatchPayload, error) {
-	return func(r *http.Request) (*ingest.ReviewBatchPayload, error) {
-		var payload *ingest.ReviewBatchPayload
+// DecodeSearchRequest returns a decoder for requests sent to the ingest search
+// endpoint.
+func DecodeSearchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ingest.SearchPayload, error) {
+	return func(r *http.Request) (*ingest.SearchPayload, error) {
+		var payload *ingest.SearchPayload
 		var (
-			body ReviewBatchRequestBody
-			err  error
+			query  string
+			limit  *int
+			offset *int
+			token  *string
+			err    error
 		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return payload, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return payload, gerr
+		qp := r.URL.Query()
+		query = qp.Get("query")
+		if query == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("query", "query string"))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
 			}
-			return payload, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateReviewBatchRequestBody(&body)
-		if err != nil {
-			return payload, err
+		{
+			offsetRaw := qp.Get("offset")
+			if offsetRaw != "" {
+				v, err2 := strconv.ParseInt(offsetRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
+				}
+				pv := int(v)
+				offset = &pv
+			}
 		}
-
-		var (
-			uuid  string
-			token *string
-
-			params = mux.Vars(r)
-		)
-		uuid = params["uuid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
 		tokenRaw := r.Header.Get("Authorization")
 		if tokenRaw != "" {
 			token = &tokenRaw
@@ -2168,7 +3347,7 @@ func DecodeReviewBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 		if err != nil {
 			return payload, err
 		}
-		payload = NewReviewBatchPayload(&body, uuid, token)
+		payload = NewSearchPayload(query, limit, offset, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2181,9 +3360,9 @@ func DecodeReviewBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 	}
 }
 
-// EncodeReviewBatchError returns an encoder for errors returned by the
-// review_batch ingest endpoint.
-func EncodeReviewBatchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeSearchError returns an encoder for errors returned by the search ingest
+// endpoint.
+func EncodeSearchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2199,37 +3378,11 @@ func EncodeReviewBatchError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewReviewBatchNotValidResponseBody(res)
+				body = NewSearchNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "internal_error":
-			var res *goa.ServiceError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewReviewBatchInternalErrorResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
-			return enc.Encode(body)
-		case "not_found":
-			var res *ingest.BatchNotFound
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewReviewBatchNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
 		case "forbidden":
 			var res ingest.Forbidden
 			errors.As(v, &res)
@@ -2304,6 +3457,14 @@ func marshalIngestSIPToSIPResponseBody(v *ingest.SIP) *SIPResponseBody {
 		BatchStatus:     v.BatchStatus,
 		FileCount:       v.FileCount,
 	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
 
 	return res
 }
@@ -2527,6 +3688,14 @@ func marshalIngestviewsSIPViewToSIPResponseBody(v *ingestviews.SIPView) *SIPResp
 		BatchStatus:     v.BatchStatus,
 		FileCount:       v.FileCount,
 	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
 
 	return res
 }
@@ -2638,3 +3807,65 @@ func marshalIngestviewsBatchViewToBatchResponseBody(v *ingestviews.BatchView) *B
 
 	return res
 }
+
+// marshalIngestAPITokenToAPITokenResponseBody builds a value of type
+// *APITokenResponseBody from a value of type *ingest.APIToken.
+func marshalIngestAPITokenToAPITokenResponseBody(v *ingest.APIToken) *APITokenResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &APITokenResponseBody{
+		UUID:       v.UUID,
+		Name:       v.Name,
+		CreatedAt:  v.CreatedAt,
+		ExpiresAt:  v.ExpiresAt,
+		LastUsedAt: v.LastUsedAt,
+		RevokedAt:  v.RevokedAt,
+		Token:      v.Token,
+	}
+	if v.Attributes != nil {
+		res.Attributes = make([]string, len(v.Attributes))
+		for i, val := range v.Attributes {
+			res.Attributes[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalIngestSearchResultToSearchResultResponseBody builds a value of type
+// *SearchResultResponseBody from a value of type *ingest.SearchResult.
+func marshalIngestSearchResultToSearchResultResponseBody(v *ingest.SearchResult) *SearchResultResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &SearchResultResponseBody{
+		UUID: v.UUID,
+		Name: v.Name,
+	}
+	if v.Fields != nil {
+		res.Fields = make(map[string]string, len(v.Fields))
+		for key, val := range v.Fields {
+			tk := key
+			tv := val
+			res.Fields[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalIngestEnduroPageToEnduroPageResponseBody builds a value of type
+// *EnduroPageResponseBody from a value of type *ingest.EnduroPage.
+func marshalIngestEnduroPageToEnduroPageResponseBody(v *ingest.EnduroPage) *EnduroPageResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroPageResponseBody{
+		Limit:  v.Limit,
+		Offset: v.Offset,
+		Total:  v.Total,
+	}
+
+	return res
+}
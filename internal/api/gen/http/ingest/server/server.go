@@ -30,10 +30,15 @@ type Server struct {
 	ListSipWorkflows     http.Handler
 	ConfirmSip           http.Handler
 	RejectSip            http.Handler
+	RetrySip             http.Handler
+	CancelSip            http.Handler
 	ShowSipDecision      http.Handler
 	SubmitSipDecision    http.Handler
 	AddSip               http.Handler
 	UploadSip            http.Handler
+	CreateSipUpload      http.Handler
+	ShowSipUpload        http.Handler
+	UploadSipChunk       http.Handler
 	DownloadSipRequest   http.Handler
 	DownloadSip          http.Handler
 	ListUsers            http.Handler
@@ -42,6 +47,11 @@ type Server struct {
 	ListBatches          http.Handler
 	ShowBatch            http.Handler
 	ReviewBatch          http.Handler
+	RetryBatch           http.Handler
+	CreateAPIToken       http.Handler
+	ListAPITokens        http.Handler
+	RevokeAPIToken       http.Handler
+	Search               http.Handler
 	CORS                 http.Handler
 }
 
@@ -78,10 +88,15 @@ func New(
 			{"ListSipWorkflows", "GET", "/ingest/sips/{uuid}/workflows"},
 			{"ConfirmSip", "POST", "/ingest/sips/{uuid}/confirm"},
 			{"RejectSip", "POST", "/ingest/sips/{uuid}/reject"},
+			{"RetrySip", "POST", "/ingest/sips/{uuid}/retry"},
+			{"CancelSip", "POST", "/ingest/sips/{uuid}/cancel"},
 			{"ShowSipDecision", "GET", "/ingest/sips/{uuid}/decision"},
 			{"SubmitSipDecision", "POST", "/ingest/sips/{uuid}/decision"},
 			{"AddSip", "POST", "/ingest/sips"},
 			{"UploadSip", "POST", "/ingest/sips/upload"},
+			{"CreateSipUpload", "POST", "/ingest/sips/uploads"},
+			{"ShowSipUpload", "GET", "/ingest/sips/uploads/{uuid}"},
+			{"UploadSipChunk", "PATCH", "/ingest/sips/uploads/{uuid}"},
 			{"DownloadSipRequest", "POST", "/ingest/sips/{uuid}/download"},
 			{"DownloadSip", "GET", "/ingest/sips/{uuid}/download"},
 			{"ListUsers", "GET", "/ingest/users"},
@@ -90,20 +105,33 @@ func New(
 			{"ListBatches", "GET", "/ingest/batches"},
 			{"ShowBatch", "GET", "/ingest/batches/{uuid}"},
 			{"ReviewBatch", "POST", "/ingest/batches/{uuid}/review"},
+			{"RetryBatch", "POST", "/ingest/batches/{uuid}/retry"},
+			{"CreateAPIToken", "POST", "/ingest/api-tokens"},
+			{"ListAPITokens", "GET", "/ingest/api-tokens"},
+			{"RevokeAPIToken", "POST", "/ingest/api-tokens/{uuid}/revoke"},
+			{"Search", "GET", "/ingest/search"},
 			{"CORS", "OPTIONS", "/ingest/monitor"},
 			{"CORS", "OPTIONS", "/ingest/sips"},
 			{"CORS", "OPTIONS", "/ingest/sips/{uuid}"},
 			{"CORS", "OPTIONS", "/ingest/sips/{uuid}/workflows"},
 			{"CORS", "OPTIONS", "/ingest/sips/{uuid}/confirm"},
 			{"CORS", "OPTIONS", "/ingest/sips/{uuid}/reject"},
+			{"CORS", "OPTIONS", "/ingest/sips/{uuid}/retry"},
+			{"CORS", "OPTIONS", "/ingest/sips/{uuid}/cancel"},
 			{"CORS", "OPTIONS", "/ingest/sips/{uuid}/decision"},
 			{"CORS", "OPTIONS", "/ingest/sips/upload"},
+			{"CORS", "OPTIONS", "/ingest/sips/uploads"},
+			{"CORS", "OPTIONS", "/ingest/sips/uploads/{uuid}"},
 			{"CORS", "OPTIONS", "/ingest/sips/{uuid}/download"},
 			{"CORS", "OPTIONS", "/ingest/users"},
 			{"CORS", "OPTIONS", "/ingest/sip-sources/{uuid}/objects"},
 			{"CORS", "OPTIONS", "/ingest/batches"},
 			{"CORS", "OPTIONS", "/ingest/batches/{uuid}"},
 			{"CORS", "OPTIONS", "/ingest/batches/{uuid}/review"},
+			{"CORS", "OPTIONS", "/ingest/batches/{uuid}/retry"},
+			{"CORS", "OPTIONS", "/ingest/api-tokens"},
+			{"CORS", "OPTIONS", "/ingest/api-tokens/{uuid}/revoke"},
+			{"CORS", "OPTIONS", "/ingest/search"},
 		},
 		Monitor:              NewMonitorHandler(e.Monitor, mux, decoder, encoder, errhandler, formatter),
 		ListSips:             NewListSipsHandler(e.ListSips, mux, decoder, encoder, errhandler, formatter),
@@ -111,10 +139,15 @@ func New(
 		ListSipWorkflows:     NewListSipWorkflowsHandler(e.ListSipWorkflows, mux, decoder, encoder, errhandler, formatter),
 		ConfirmSip:           NewConfirmSipHandler(e.ConfirmSip, mux, decoder, encoder, errhandler, formatter),
 		RejectSip:            NewRejectSipHandler(e.RejectSip, mux, decoder, encoder, errhandler, formatter),
+		RetrySip:             NewRetrySipHandler(e.RetrySip, mux, decoder, encoder, errhandler, formatter),
+		CancelSip:            NewCancelSipHandler(e.CancelSip, mux, decoder, encoder, errhandler, formatter),
 		ShowSipDecision:      NewShowSipDecisionHandler(e.ShowSipDecision, mux, decoder, encoder, errhandler, formatter),
 		SubmitSipDecision:    NewSubmitSipDecisionHandler(e.SubmitSipDecision, mux, decoder, encoder, errhandler, formatter),
 		AddSip:               NewAddSipHandler(e.AddSip, mux, decoder, encoder, errhandler, formatter),
 		UploadSip:            NewUploadSipHandler(e.UploadSip, mux, decoder, encoder, errhandler, formatter),
+		CreateSipUpload:      NewCreateSipUploadHandler(e.CreateSipUpload, mux, decoder, encoder, errhandler, formatter),
+		ShowSipUpload:        NewShowSipUploadHandler(e.ShowSipUpload, mux, decoder, encoder, errhandler, formatter),
+		UploadSipChunk:       NewUploadSipChunkHandler(e.UploadSipChunk, mux, decoder, encoder, errhandler, formatter),
 		DownloadSipRequest:   NewDownloadSipRequestHandler(e.DownloadSipRequest, mux, decoder, encoder, errhandler, formatter),
 		DownloadSip:          NewDownloadSipHandler(e.DownloadSip, mux, decoder, encoder, errhandler, formatter),
 		ListUsers:            NewListUsersHandler(e.ListUsers, mux, decoder, encoder, errhandler, formatter),
@@ -123,6 +156,11 @@ func New(
 		ListBatches:          NewListBatchesHandler(e.ListBatches, mux, decoder, encoder, errhandler, formatter),
 		ShowBatch:            NewShowBatchHandler(e.ShowBatch, mux, decoder, encoder, errhandler, formatter),
 		ReviewBatch:          NewReviewBatchHandler(e.ReviewBatch, mux, decoder, encoder, errhandler, formatter),
+		RetryBatch:           NewRetryBatchHandler(e.RetryBatch, mux, decoder, encoder, errhandler, formatter),
+		CreateAPIToken:       NewCreateAPITokenHandler(e.CreateAPIToken, mux, decoder, encoder, errhandler, formatter),
+		ListAPITokens:        NewListAPITokensHandler(e.ListAPITokens, mux, decoder, encoder, errhandler, formatter),
+		RevokeAPIToken:       NewRevokeAPITokenHandler(e.RevokeAPIToken, mux, decoder, encoder, errhandler, formatter),
+		Search:               NewSearchHandler(e.Search, mux, decoder, encoder, errhandler, formatter),
 		CORS:                 NewCORSHandler(),
 	}
 }
@@ -138,10 +176,15 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.ListSipWorkflows = m(s.ListSipWorkflows)
 	s.ConfirmSip = m(s.ConfirmSip)
 	s.RejectSip = m(s.RejectSip)
+	s.RetrySip = m(s.RetrySip)
+	s.CancelSip = m(s.CancelSip)
 	s.ShowSipDecision = m(s.ShowSipDecision)
 	s.SubmitSipDecision = m(s.SubmitSipDecision)
 	s.AddSip = m(s.AddSip)
 	s.UploadSip = m(s.UploadSip)
+	s.CreateSipUpload = m(s.CreateSipUpload)
+	s.ShowSipUpload = m(s.ShowSipUpload)
+	s.UploadSipChunk = m(s.UploadSipChunk)
 	s.DownloadSipRequest = m(s.DownloadSipRequest)
 	s.DownloadSip = m(s.DownloadSip)
 	s.ListUsers = m(s.ListUsers)
@@ -150,6 +193,11 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.ListBatches = m(s.ListBatches)
 	s.ShowBatch = m(s.ShowBatch)
 	s.ReviewBatch = m(s.ReviewBatch)
+	s.RetryBatch = m(s.RetryBatch)
+	s.CreateAPIToken = m(s.CreateAPIToken)
+	s.ListAPITokens = m(s.ListAPITokens)
+	s.RevokeAPIToken = m(s.RevokeAPIToken)
+	s.Search = m(s.Search)
 	s.CORS = m(s.CORS)
 }
 
@@ -164,10 +212,15 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountListSipWorkflowsHandler(mux, h.ListSipWorkflows)
 	MountConfirmSipHandler(mux, h.ConfirmSip)
 	MountRejectSipHandler(mux, h.RejectSip)
+	MountRetrySipHandler(mux, h.RetrySip)
+	MountCancelSipHandler(mux, h.CancelSip)
 	MountShowSipDecisionHandler(mux, h.ShowSipDecision)
 	MountSubmitSipDecisionHandler(mux, h.SubmitSipDecision)
 	MountAddSipHandler(mux, h.AddSip)
 	MountUploadSipHandler(mux, h.UploadSip)
+	MountCreateSipUploadHandler(mux, h.CreateSipUpload)
+	MountShowSipUploadHandler(mux, h.ShowSipUpload)
+	MountUploadSipChunkHandler(mux, h.UploadSipChunk)
 	MountDownloadSipRequestHandler(mux, h.DownloadSipRequest)
 	MountDownloadSipHandler(mux, h.DownloadSip)
 	MountListUsersHandler(mux, h.ListUsers)
@@ -176,6 +229,11 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountListBatchesHandler(mux, h.ListBatches)
 	MountShowBatchHandler(mux, h.ShowBatch)
 	MountReviewBatchHandler(mux, h.ReviewBatch)
+	MountRetryBatchHandler(mux, h.RetryBatch)
+	MountCreateAPITokenHandler(mux, h.CreateAPIToken)
+	MountListAPITokensHandler(mux, h.ListAPITokens)
+	MountRevokeAPITokenHandler(mux, h.RevokeAPIToken)
+	MountSearchHandler(mux, h.Search)
 	MountCORSHandler(mux, h.CORS)
 }
 
@@ -462,6 +520,30 @@ func MountRejectSipHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("POST", "/ingest/sips/{uuid}/reject", f)
 }
 
+// MountRetrySipHandler configures the mux to serve the "ingest" service
+// "retry_sip" endpoint.
+func MountRetrySipHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/ingest/sips/{uuid}/retry", f)
+}
+
+// MountCancelSipHandler configures the mux to serve the "ingest" service
+// "cancel_sip" endpoint.
+func MountCancelSipHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/ingest/sips/{uuid}/cancel", f)
+}
+
 // NewRejectSipHandler creates a HTTP handler which loads the HTTP request and
 // calls the "ingest" service "reject_sip" endpoint.
 func NewRejectSipHandler(
@@ -503,6 +585,88 @@ func NewRejectSipHandler(
 	})
 }
 
+// NewRetrySipHandler creates a HTTP handler which loads the HTTP request and
+// calls the "ingest" service "retry_sip" endpoint.
+func NewRetrySipHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRetrySipRequest(mux, decoder)
+		encodeResponse = EncodeRetrySipResponse(encoder)
+		encodeError    = EncodeRetrySipError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "retry_sip")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// NewCancelSipHandler creates a HTTP handler which loads the HTTP request and
+// calls the "ingest" service "cancel_sip" endpoint.
+func NewCancelSipHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCancelSipRequest(mux, decoder)
+		encodeResponse = EncodeCancelSipResponse(encoder)
+		encodeError    = EncodeCancelSipError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "cancel_sip")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountShowSipDecisionHandler configures the mux to serve the "ingest" service
 // "show_sip_decision" endpoint.
 func MountShowSipDecisionHandler(mux goahttp.Muxer, h http.Handler) {
@@ -716,6 +880,166 @@ func NewUploadSipHandler(
 	})
 }
 
+// MountCreateSipUploadHandler configures the mux to serve the "ingest" service
+// "create_sip_upload" endpoint.
+func MountCreateSipUploadHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/ingest/sips/uploads", f)
+}
+
+// NewCreateSipUploadHandler creates a HTTP handler which loads the HTTP request
+// and calls the "ingest" service "create_sip_upload" endpoint.
+func NewCreateSipUploadHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateSipUploadRequest(mux, decoder)
+		encodeResponse = EncodeCreateSipUploadResponse(encoder)
+		encodeError    = EncodeCreateSipUploadError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_sip_upload")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountShowSipUploadHandler configures the mux to serve the "ingest" service
+// "show_sip_upload" endpoint.
+func MountShowSipUploadHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/ingest/sips/uploads/{uuid}", f)
+}
+
+// NewShowSipUploadHandler creates a HTTP handler which loads the HTTP request
+// and calls the "ingest" service "show_sip_upload" endpoint.
+func NewShowSipUploadHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeShowSipUploadRequest(mux, decoder)
+		encodeResponse = EncodeShowSipUploadResponse(encoder)
+		encodeError    = EncodeShowSipUploadError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "show_sip_upload")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUploadSipChunkHandler configures the mux to serve the "ingest" service
+// "upload_sip_chunk" endpoint.
+func MountUploadSipChunkHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/ingest/sips/uploads/{uuid}", f)
+}
+
+// NewUploadSipChunkHandler creates a HTTP handler which loads the HTTP request
+// and calls the "ingest" service "upload_sip_chunk" endpoint.
+func NewUploadSipChunkHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUploadSipChunkRequest(mux, decoder)
+		encodeResponse = EncodeUploadSipChunkResponse(encoder)
+		encodeError    = EncodeUploadSipChunkError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "upload_sip_chunk")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &ingest.UploadSipChunkRequestData{Payload: payload, Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountDownloadSipRequestHandler configures the mux to serve the "ingest"
 // service "download_sip_request" endpoint.
 func MountDownloadSipRequestHandler(mux goahttp.Muxer, h http.Handler) {
@@ -1175,6 +1499,271 @@ func NewReviewBatchHandler(
 	})
 }
 
+// MountRetryBatchHandler configures the mux to serve the "ingest" service
+// "retry_batch" endpoint.
+func MountRetryBatchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/ingest/batches/{uuid}/retry", f)
+}
+
+// NewRetryBatchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "ingest" service "retry_batch" endpoint.
+func NewRetryBatchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRetryBatchRequest(mux, decoder)
+		encodeResponse = EncodeRetryBatchResponse(encoder)
+		encodeError    = EncodeRetryBatchError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "retry_batch")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateAPITokenHandler configures the mux to serve the "ingest" service
+// "create_api_token" endpoint.
+func MountCreateAPITokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/ingest/api-tokens", f)
+}
+
+// NewCreateAPITokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "ingest" service "create_api_token" endpoint.
+func NewCreateAPITokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateAPITokenRequest(mux, decoder)
+		encodeResponse = EncodeCreateAPITokenResponse(encoder)
+		encodeError    = EncodeCreateAPITokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_api_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListAPITokensHandler configures the mux to serve the "ingest" service
+// "list_api_tokens" endpoint.
+func MountListAPITokensHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/ingest/api-tokens", f)
+}
+
+// NewListAPITokensHandler creates a HTTP handler which loads the HTTP request
+// and calls the "ingest" service "list_api_tokens" endpoint.
+func NewListAPITokensHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAPITokensRequest(mux, decoder)
+		encodeResponse = EncodeListAPITokensResponse(encoder)
+		encodeError    = EncodeListAPITokensError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_api_tokens")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRevokeAPITokenHandler configures the mux to serve the "ingest" service
+// "revoke_api_token" endpoint.
+func MountRevokeAPITokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/ingest/api-tokens/{uuid}/revoke", f)
+}
+
+// NewRevokeAPITokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "ingest" service "revoke_api_token" endpoint.
+func NewRevokeAPITokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeAPITokenRequest(mux, decoder)
+		encodeResponse = EncodeRevokeAPITokenResponse(encoder)
+		encodeError    = EncodeRevokeAPITokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revoke_api_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSearchHandler configures the mux to serve the "ingest" service "search"
+// endpoint.
+func MountSearchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleIngestOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/ingest/search", f)
+}
+
+// NewSearchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "ingest" service "search" endpoint.
+func NewSearchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSearchRequest(mux, decoder)
+		encodeResponse = EncodeSearchResponse(encoder)
+		encodeError    = EncodeSearchError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "search")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountCORSHandler configures the mux to serve the CORS endpoints for the
 // service ingest.
 func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
@@ -1185,14 +1774,22 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("OPTIONS", "/ingest/sips/{uuid}/workflows", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/sips/{uuid}/confirm", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/sips/{uuid}/reject", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/ingest/sips/{uuid}/retry", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/ingest/sips/{uuid}/cancel", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/sips/{uuid}/decision", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/sips/upload", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/ingest/sips/uploads", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/ingest/sips/uploads/{uuid}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/sips/{uuid}/download", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/users", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/sip-sources/{uuid}/objects", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/batches", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/batches/{uuid}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/ingest/batches/{uuid}/review", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/ingest/batches/{uuid}/retry", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/ingest/api-tokens", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/ingest/api-tokens/{uuid}/revoke", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/ingest/search", h.ServeHTTP)
 }
 
 // NewCORSHandler creates a HTTP handler which returns a simple 204 response.
@@ -1221,8 +1818,8 @@ func HandleIngestOrigin(h http.Handler) http.Handler {
 			w.Header().Set("Vary", "Origin")
 			if acrm := r.Header.Get("Access-Control-Request-Method"); acrm != "" {
 				// We are handling a preflight request
-				w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+				w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, Upload-Checksum, Upload-Offset")
 				w.WriteHeader(204)
 				return
 			}
@@ -39,6 +39,25 @@ type AddSipRequestBody struct {
 	SourceID *string `form:"source_id,omitempty" json:"source_id,omitempty" xml:"source_id,omitempty"`
 	// Key of the item to ingest
 	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Name of the processing profile to use for the SIP
+	ProcessingProfile *string `form:"processing_profile,omitempty" json:"processing_profile,omitempty" xml:"processing_profile,omitempty"`
+	// Identifier of the storage location of the AIP
+	LocationID *string `form:"location_id,omitempty" json:"location_id,omitempty" xml:"location_id,omitempty"`
+}
+
+// CreateSipUploadRequestBody is the type of the "ingest" service
+// "create_sip_upload" endpoint HTTP request body.
+type CreateSipUploadRequestBody struct {
+	// File name of the SIP
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Size of the SIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Expected checksum of the SIP, e.g. "sha256:9f86d0..."
+	Checksum *string `form:"checksum,omitempty" json:"checksum,omitempty" xml:"checksum,omitempty"`
+	// Name of the processing profile to use for the SIP
+	ProcessingProfile *string `form:"processing_profile,omitempty" json:"processing_profile,omitempty" xml:"processing_profile,omitempty"`
+	// Identifier of the storage location of the AIP
+	LocationID *string `form:"location_id,omitempty" json:"location_id,omitempty" xml:"location_id,omitempty"`
 }
 
 // AddBatchRequestBody is the type of the "ingest" service "add_batch" endpoint
@@ -50,6 +69,15 @@ type AddBatchRequestBody struct {
 	Keys []string `form:"keys,omitempty" json:"keys,omitempty" xml:"keys,omitempty"`
 	// Optional Batch identifier assigned by the user
 	Identifier *string `form:"identifier,omitempty" json:"identifier,omitempty" xml:"identifier,omitempty"`
+	// Name of the processing profile to use for the SIPs of the Batch
+	ProcessingProfile *string `form:"processing_profile,omitempty" json:"processing_profile,omitempty" xml:"processing_profile,omitempty"`
+	// Manifest listing the SIPs to ingest as part of the batch and their metadata,
+	// instead of keys
+	Manifest *string `form:"manifest,omitempty" json:"manifest,omitempty" xml:"manifest,omitempty"`
+	// Format of the manifest, defaults to csv
+	ManifestFormat *string `form:"manifest_format,omitempty" json:"manifest_format,omitempty" xml:"manifest_format,omitempty"`
+	// Identifier of the storage location of the AIPs of the Batch
+	LocationID *string `form:"location_id,omitempty" json:"location_id,omitempty" xml:"location_id,omitempty"`
 }
 
 // ReviewBatchRequestBody is the type of the "ingest" service "review_batch"
@@ -58,6 +86,18 @@ type ReviewBatchRequestBody struct {
 	Continue *bool `form:"continue,omitempty" json:"continue,omitempty" xml:"continue,omitempty"`
 }
 
+// CreateAPITokenRequestBody is the type of the "ingest" service
+// "create_api_token" endpoint HTTP request body.
+type CreateAPITokenRequestBody struct {
+	// Name of the API token
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Access control attributes granted to the API token, defaults to the
+	// attributes of the user
+	Attributes []string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Expiration datetime of the API token
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
 // MonitorResponseBody is the type of the "ingest" service "monitor" endpoint
 // HTTP response body.
 type MonitorResponseBody struct {
@@ -106,6 +146,8 @@ type ShowSipResponseBody struct {
 	BatchStatus *string `form:"batch_status,omitempty" json:"batch_status,omitempty" xml:"batch_status,omitempty"`
 	// Number of files in the SIP
 	FileCount *int32 `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Custom metadata returned by the child workflows
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // ListSipWorkflowsResponseBody is the type of the "ingest" service
@@ -135,6 +177,57 @@ type UploadSipResponseBody struct {
 	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
 }
 
+// CreateSipUploadResponseBody is the type of the "ingest" service
+// "create_sip_upload" endpoint HTTP response body.
+type CreateSipUploadResponseBody struct {
+	// Identifier of the upload
+	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
+	// File name of the SIP
+	Name string `form:"name" json:"name" xml:"name"`
+	// Size of the SIP in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// Number of bytes received
+	Offset int64 `form:"offset" json:"offset" xml:"offset"`
+	// Time after which the upload is discarded
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+	// Identifier of the SIP, set when the upload is complete
+	SipUUID *string `form:"sip_uuid,omitempty" json:"sip_uuid,omitempty" xml:"sip_uuid,omitempty"`
+}
+
+// ShowSipUploadResponseBody is the type of the "ingest" service
+// "show_sip_upload" endpoint HTTP response body.
+type ShowSipUploadResponseBody struct {
+	// Identifier of the upload
+	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
+	// File name of the SIP
+	Name string `form:"name" json:"name" xml:"name"`
+	// Size of the SIP in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// Number of bytes received
+	Offset int64 `form:"offset" json:"offset" xml:"offset"`
+	// Time after which the upload is discarded
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+	// Identifier of the SIP, set when the upload is complete
+	SipUUID *string `form:"sip_uuid,omitempty" json:"sip_uuid,omitempty" xml:"sip_uuid,omitempty"`
+}
+
+// UploadSipChunkResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body.
+type UploadSipChunkResponseBody struct {
+	// Identifier of the upload
+	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
+	// File name of the SIP
+	Name string `form:"name" json:"name" xml:"name"`
+	// Size of the SIP in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// Number of bytes received
+	Offset int64 `form:"offset" json:"offset" xml:"offset"`
+	// Time after which the upload is discarded
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+	// Identifier of the SIP, set when the upload is complete
+	SipUUID *string `form:"sip_uuid,omitempty" json:"sip_uuid,omitempty" xml:"sip_uuid,omitempty"`
+}
+
 // ListUsersResponseBody is the type of the "ingest" service "list_users"
 // endpoint HTTP response body.
 type ListUsersResponseBody struct {
@@ -191,6 +284,40 @@ type ShowBatchResponseBody struct {
 	UploaderName *string `form:"uploader_name,omitempty" json:"uploader_name,omitempty" xml:"uploader_name,omitempty"`
 }
 
+// CreateAPITokenResponseBody is the type of the "ingest" service
+// "create_api_token" endpoint HTTP response body.
+type CreateAPITokenResponseBody struct {
+	// Identifier of the API token
+	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
+	// Name of the API token
+	Name string `form:"name" json:"name" xml:"name"`
+	// Access control attributes granted to the API token
+	Attributes []string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Creation datetime
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Expiration datetime
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Datetime of the last use of the API token
+	LastUsedAt *string `form:"last_used_at,omitempty" json:"last_used_at,omitempty" xml:"last_used_at,omitempty"`
+	// Revocation datetime
+	RevokedAt *string `form:"revoked_at,omitempty" json:"revoked_at,omitempty" xml:"revoked_at,omitempty"`
+	// Secret of the API token, only returned when the API token is created
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+}
+
+// ListAPITokensResponseBody is the type of the "ingest" service
+// "list_api_tokens" endpoint HTTP response body.
+type ListAPITokensResponseBody struct {
+	Items []*APITokenResponseBody `form:"items" json:"items" xml:"items"`
+}
+
+// SearchResponseBody is the type of the "ingest" service "search" endpoint HTTP
+// response body.
+type SearchResponseBody struct {
+	Items []*SearchResultResponseBody `form:"items" json:"items" xml:"items"`
+	Page  *EnduroPageResponseBody     `form:"page" json:"page" xml:"page"`
+}
+
 // MonitorInternalErrorResponseBody is the type of the "ingest" service
 // "monitor" endpoint HTTP response body for the "internal_error" error.
 type MonitorInternalErrorResponseBody struct {
@@ -326,6 +453,42 @@ type RejectSipNotAvailableResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// RetrySipNotAvailableResponseBody is the type of the "ingest" service
+// "retry_sip" endpoint HTTP response body for the "not_available" error.
+type RetrySipNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CancelSipNotAvailableResponseBody is the type of the "ingest" service
+// "cancel_sip" endpoint HTTP response body for the "not_available" error.
+type CancelSipNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // RejectSipNotValidResponseBody is the type of the "ingest" service
 // "reject_sip" endpoint HTTP response body for the "not_valid" error.
 type RejectSipNotValidResponseBody struct {
@@ -344,6 +507,42 @@ type RejectSipNotValidResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// RetrySipNotValidResponseBody is the type of the "ingest" service
+// "retry_sip" endpoint HTTP response body for the "not_valid" error.
+type RetrySipNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CancelSipNotValidResponseBody is the type of the "ingest" service
+// "cancel_sip" endpoint HTTP response body for the "not_valid" error.
+type CancelSipNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // RejectSipNotFoundResponseBody is the type of the "ingest" service
 // "reject_sip" endpoint HTTP response body for the "not_found" error.
 type RejectSipNotFoundResponseBody struct {
@@ -353,6 +552,24 @@ type RejectSipNotFoundResponseBody struct {
 	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
 }
 
+// RetrySipNotFoundResponseBody is the type of the "ingest" service
+// "retry_sip" endpoint HTTP response body for the "not_found" error.
+type RetrySipNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing SIP
+	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
+}
+
+// CancelSipNotFoundResponseBody is the type of the "ingest" service
+// "cancel_sip" endpoint HTTP response body for the "not_found" error.
+type CancelSipNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing SIP
+	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
+}
+
 // ShowSipDecisionInternalErrorResponseBody is the type of the "ingest" service
 // "show_sip_decision" endpoint HTTP response body for the "internal_error"
 // error.
@@ -574,6 +791,170 @@ type UploadSipInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// CreateSipUploadNotValidResponseBody is the type of the "ingest" service
+// "create_sip_upload" endpoint HTTP response body for the "not_valid" error.
+type CreateSipUploadNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateSipUploadInternalErrorResponseBody is the type of the "ingest" service
+// "create_sip_upload" endpoint HTTP response body for the "internal_error"
+// error.
+type CreateSipUploadInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShowSipUploadNotFoundResponseBody is the type of the "ingest" service
+// "show_sip_upload" endpoint HTTP response body for the "not_found" error.
+type ShowSipUploadNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShowSipUploadNotValidResponseBody is the type of the "ingest" service
+// "show_sip_upload" endpoint HTTP response body for the "not_valid" error.
+type ShowSipUploadNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShowSipUploadInternalErrorResponseBody is the type of the "ingest" service
+// "show_sip_upload" endpoint HTTP response body for the "internal_error" error.
+type ShowSipUploadInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadSipChunkNotFoundResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body for the "not_found" error.
+type UploadSipChunkNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadSipChunkNotValidResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body for the "not_valid" error.
+type UploadSipChunkNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadSipChunkNotAvailableResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body for the "not_available" error.
+type UploadSipChunkNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadSipChunkInternalErrorResponseBody is the type of the "ingest" service
+// "upload_sip_chunk" endpoint HTTP response body for the "internal_error"
+// error.
+type UploadSipChunkInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // DownloadSipRequestNotValidResponseBody is the type of the "ingest" service
 // "download_sip_request" endpoint HTTP response body for the "not_valid" error.
 type DownloadSipRequestNotValidResponseBody struct {
@@ -902,20 +1283,193 @@ type ReviewBatchNotFoundResponseBody struct {
 	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
 }
 
-// IngestPingEventResponseBody is used to define fields on response body types.
-type IngestPingEventResponseBody struct {
-	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-}
-
-// SIPCreatedEventResponseBody is used to define fields on response body types.
-type SIPCreatedEventResponseBody struct {
-	// Identifier of SIP
-	UUID uuid.UUID        `form:"uuid" json:"uuid" xml:"uuid"`
-	Item *SIPResponseBody `form:"item" json:"item" xml:"item"`
+// RetryBatchNotAvailableResponseBody is the type of the "ingest" service
+// "retry_batch" endpoint HTTP response body for the "not_available" error.
+type RetryBatchNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// SIPResponseBody is used to define fields on response body types.
-type SIPResponseBody struct {
+// RetryBatchNotValidResponseBody is the type of the "ingest" service
+// "retry_batch" endpoint HTTP response body for the "not_valid" error.
+type RetryBatchNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RetryBatchNotFoundResponseBody is the type of the "ingest" service
+// "retry_batch" endpoint HTTP response body for the "not_found" error.
+type RetryBatchNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing Batch
+	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
+}
+
+// CreateAPITokenNotValidResponseBody is the type of the "ingest" service
+// "create_api_token" endpoint HTTP response body for the "not_valid" error.
+type CreateAPITokenNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateAPITokenInternalErrorResponseBody is the type of the "ingest" service
+// "create_api_token" endpoint HTTP response body for the "internal_error"
+// error.
+type CreateAPITokenInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListAPITokensInternalErrorResponseBody is the type of the "ingest" service
+// "list_api_tokens" endpoint HTTP response body for the "internal_error" error.
+type ListAPITokensInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeAPITokenNotFoundResponseBody is the type of the "ingest" service
+// "revoke_api_token" endpoint HTTP response body for the "not_found" error.
+type RevokeAPITokenNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeAPITokenNotValidResponseBody is the type of the "ingest" service
+// "revoke_api_token" endpoint HTTP response body for the "not_valid" error.
+type RevokeAPITokenNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeAPITokenInternalErrorResponseBody is the type of the "ingest" service
+// "revoke_api_token" endpoint HTTP response body for the "internal_error"
+// error.
+type RevokeAPITokenInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SearchNotValidResponseBody is the type of the "ingest" service "search"
+// endpoint HTTP response body for the "not_valid" error.
+type SearchNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// IngestPingEventResponseBody is used to define fields on response body types.
+type IngestPingEventResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SIPCreatedEventResponseBody is used to define fields on response body types.
+type SIPCreatedEventResponseBody struct {
+	// Identifier of SIP
+	UUID uuid.UUID        `form:"uuid" json:"uuid" xml:"uuid"`
+	Item *SIPResponseBody `form:"item" json:"item" xml:"item"`
+}
+
+// SIPResponseBody is used to define fields on response body types.
+type SIPResponseBody struct {
 	// Identifier of SIP
 	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
 	// Name of the SIP
@@ -948,6 +1502,8 @@ type SIPResponseBody struct {
 	BatchStatus *string `form:"batch_status,omitempty" json:"batch_status,omitempty" xml:"batch_status,omitempty"`
 	// Number of files in the SIP
 	FileCount *int32 `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Custom metadata returned by the child workflows
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // SIPUpdatedEventResponseBody is used to define fields on response body types.
@@ -1123,6 +1679,36 @@ type SIPSourceObjectResponseBody struct {
 // BatchResponseBodyCollection is used to define fields on response body types.
 type BatchResponseBodyCollection []*BatchResponseBody
 
+// APITokenResponseBody is used to define fields on response body types.
+type APITokenResponseBody struct {
+	// Identifier of the API token
+	UUID string `form:"uuid" json:"uuid" xml:"uuid"`
+	// Name of the API token
+	Name string `form:"name" json:"name" xml:"name"`
+	// Access control attributes granted to the API token
+	Attributes []string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Creation datetime
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Expiration datetime
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Datetime of the last use of the API token
+	LastUsedAt *string `form:"last_used_at,omitempty" json:"last_used_at,omitempty" xml:"last_used_at,omitempty"`
+	// Revocation datetime
+	RevokedAt *string `form:"revoked_at,omitempty" json:"revoked_at,omitempty" xml:"revoked_at,omitempty"`
+	// Secret of the API token, only returned when the API token is created
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+}
+
+// SearchResultResponseBody is used to define fields on response body types.
+type SearchResultResponseBody struct {
+	// Identifier of the package
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+	// Name of the package
+	Name string `form:"name" json:"name" xml:"name"`
+	// Indexed metadata of the package, keyed by field name
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
 // Value is a sum-type union.
 type Value struct {
 	kind                    ValueKind
@@ -1682,6 +2268,14 @@ func NewShowSipResponseBody(res *ingestviews.SIPView) *ShowSipResponseBody {
 		BatchStatus:     res.BatchStatus,
 		FileCount:       res.FileCount,
 	}
+	if res.CustomMetadata != nil {
+		body.CustomMetadata = make(map[string]any, len(res.CustomMetadata))
+		for key, val := range res.CustomMetadata {
+			tk := key
+			tv := val
+			body.CustomMetadata[tk] = tv
+		}
+	}
 	return body
 }
 
@@ -1737,6 +2331,48 @@ func NewUploadSipResponseBody(res *ingest.UploadSipResult) *UploadSipResponseBod
 	return body
 }
 
+// NewCreateSipUploadResponseBody builds the HTTP response body from the result
+// of the "create_sip_upload" endpoint of the "ingest" service.
+func NewCreateSipUploadResponseBody(res *ingest.SIPUpload) *CreateSipUploadResponseBody {
+	body := &CreateSipUploadResponseBody{
+		UUID:      res.UUID,
+		Name:      res.Name,
+		Size:      res.Size,
+		Offset:    res.Offset,
+		ExpiresAt: res.ExpiresAt,
+		SipUUID:   res.SipUUID,
+	}
+	return body
+}
+
+// NewShowSipUploadResponseBody builds the HTTP response body from the result of
+// the "show_sip_upload" endpoint of the "ingest" service.
+func NewShowSipUploadResponseBody(res *ingest.SIPUpload) *ShowSipUploadResponseBody {
+	body := &ShowSipUploadResponseBody{
+		UUID:      res.UUID,
+		Name:      res.Name,
+		Size:      res.Size,
+		Offset:    res.Offset,
+		ExpiresAt: res.ExpiresAt,
+		SipUUID:   res.SipUUID,
+	}
+	return body
+}
+
+// NewUploadSipChunkResponseBody builds the HTTP response body from the result
+// of the "upload_sip_chunk" endpoint of the "ingest" service.
+func NewUploadSipChunkResponseBody(res *ingest.SIPUpload) *UploadSipChunkResponseBody {
+	body := &UploadSipChunkResponseBody{
+		UUID:      res.UUID,
+		Name:      res.Name,
+		Size:      res.Size,
+		Offset:    res.Offset,
+		ExpiresAt: res.ExpiresAt,
+		SipUUID:   res.SipUUID,
+	}
+	return body
+}
+
 // NewListUsersResponseBody builds the HTTP response body from the result of
 // the "list_users" endpoint of the "ingest" service.
 func NewListUsersResponseBody(res *ingestviews.UsersView) *ListUsersResponseBody {
@@ -1830,6 +2466,68 @@ func NewShowBatchResponseBody(res *ingestviews.BatchView) *ShowBatchResponseBody
 	return body
 }
 
+// NewCreateAPITokenResponseBody builds the HTTP response body from the result
+// of the "create_api_token" endpoint of the "ingest" service.
+func NewCreateAPITokenResponseBody(res *ingest.APIToken) *CreateAPITokenResponseBody {
+	body := &CreateAPITokenResponseBody{
+		UUID:       res.UUID,
+		Name:       res.Name,
+		CreatedAt:  res.CreatedAt,
+		ExpiresAt:  res.ExpiresAt,
+		LastUsedAt: res.LastUsedAt,
+		RevokedAt:  res.RevokedAt,
+		Token:      res.Token,
+	}
+	if res.Attributes != nil {
+		body.Attributes = make([]string, len(res.Attributes))
+		for i, val := range res.Attributes {
+			body.Attributes[i] = val
+		}
+	}
+	return body
+}
+
+// NewListAPITokensResponseBody builds the HTTP response body from the result of
+// the "list_api_tokens" endpoint of the "ingest" service.
+func NewListAPITokensResponseBody(res *ingest.APITokens) *ListAPITokensResponseBody {
+	body := &ListAPITokensResponseBody{}
+	if res.Items != nil {
+		body.Items = make([]*APITokenResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			if val == nil {
+				body.Items[i] = nil
+				continue
+			}
+			body.Items[i] = marshalIngestAPITokenToAPITokenResponseBody(val)
+		}
+	} else {
+		body.Items = []*APITokenResponseBody{}
+	}
+	return body
+}
+
+// NewSearchResponseBody builds the HTTP response body from the result of the
+// "search" endpoint of the "ingest" service.
+func NewSearchResponseBody(res *ingest.SearchResults) *SearchResponseBody {
+	body := &SearchResponseBody{}
+	if res.Items != nil {
+		body.Items = make([]*SearchResultResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			if val == nil {
+				body.Items[i] = nil
+				continue
+			}
+			body.Items[i] = marshalIngestSearchResultToSearchResultResponseBody(val)
+		}
+	} else {
+		body.Items = []*SearchResultResponseBody{}
+	}
+	if res.Page != nil {
+		body.Page = marshalIngestEnduroPageToEnduroPageResponseBody(res.Page)
+	}
+	return body
+}
+
 // NewMonitorInternalErrorResponseBody builds the HTTP response body from the
 // result of the "monitor" endpoint of the "ingest" service.
 func NewMonitorInternalErrorResponseBody(res *goa.ServiceError) *MonitorInternalErrorResponseBody {
@@ -1944,6 +2642,34 @@ func NewRejectSipNotAvailableResponseBody(res *goa.ServiceError) *RejectSipNotAv
 	return body
 }
 
+// NewRetrySipNotAvailableResponseBody builds the HTTP response body from the
+// result of the "retry_sip" endpoint of the "ingest" service.
+func NewRetrySipNotAvailableResponseBody(res *goa.ServiceError) *RetrySipNotAvailableResponseBody {
+	body := &RetrySipNotAvailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCancelSipNotAvailableResponseBody builds the HTTP response body from the
+// result of the "cancel_sip" endpoint of the "ingest" service.
+func NewCancelSipNotAvailableResponseBody(res *goa.ServiceError) *CancelSipNotAvailableResponseBody {
+	body := &CancelSipNotAvailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewRejectSipNotValidResponseBody builds the HTTP response body from the
 // result of the "reject_sip" endpoint of the "ingest" service.
 func NewRejectSipNotValidResponseBody(res *goa.ServiceError) *RejectSipNotValidResponseBody {
@@ -1958,6 +2684,34 @@ func NewRejectSipNotValidResponseBody(res *goa.ServiceError) *RejectSipNotValidR
 	return body
 }
 
+// NewRetrySipNotValidResponseBody builds the HTTP response body from the
+// result of the "retry_sip" endpoint of the "ingest" service.
+func NewRetrySipNotValidResponseBody(res *goa.ServiceError) *RetrySipNotValidResponseBody {
+	body := &RetrySipNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCancelSipNotValidResponseBody builds the HTTP response body from the
+// result of the "cancel_sip" endpoint of the "ingest" service.
+func NewCancelSipNotValidResponseBody(res *goa.ServiceError) *CancelSipNotValidResponseBody {
+	body := &CancelSipNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewRejectSipNotFoundResponseBody builds the HTTP response body from the
 // result of the "reject_sip" endpoint of the "ingest" service.
 func NewRejectSipNotFoundResponseBody(res *ingest.SIPNotFound) *RejectSipNotFoundResponseBody {
@@ -1968,6 +2722,26 @@ func NewRejectSipNotFoundResponseBody(res *ingest.SIPNotFound) *RejectSipNotFoun
 	return body
 }
 
+// NewRetrySipNotFoundResponseBody builds the HTTP response body from the
+// result of the "retry_sip" endpoint of the "ingest" service.
+func NewRetrySipNotFoundResponseBody(res *ingest.SIPNotFound) *RetrySipNotFoundResponseBody {
+	body := &RetrySipNotFoundResponseBody{
+		Message: res.Message,
+		UUID:    res.UUID,
+	}
+	return body
+}
+
+// NewCancelSipNotFoundResponseBody builds the HTTP response body from the
+// result of the "cancel_sip" endpoint of the "ingest" service.
+func NewCancelSipNotFoundResponseBody(res *ingest.SIPNotFound) *CancelSipNotFoundResponseBody {
+	body := &CancelSipNotFoundResponseBody{
+		Message: res.Message,
+		UUID:    res.UUID,
+	}
+	return body
+}
+
 // NewShowSipDecisionInternalErrorResponseBody builds the HTTP response body
 // from the result of the "show_sip_decision" endpoint of the "ingest" service.
 func NewShowSipDecisionInternalErrorResponseBody(res *goa.ServiceError) *ShowSipDecisionInternalErrorResponseBody {
@@ -2144,10 +2918,10 @@ func NewUploadSipInternalErrorResponseBody(res *goa.ServiceError) *UploadSipInte
 	return body
 }
 
-// NewDownloadSipRequestNotValidResponseBody builds the HTTP response body from
-// the result of the "download_sip_request" endpoint of the "ingest" service.
-func NewDownloadSipRequestNotValidResponseBody(res *goa.ServiceError) *DownloadSipRequestNotValidResponseBody {
-	body := &DownloadSipRequestNotValidResponseBody{
+// NewCreateSipUploadNotValidResponseBody builds the HTTP response body from the
+// result of the "create_sip_upload" endpoint of the "ingest" service.
+func NewCreateSipUploadNotValidResponseBody(res *goa.ServiceError) *CreateSipUploadNotValidResponseBody {
+	body := &CreateSipUploadNotValidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2158,7 +2932,133 @@ func NewDownloadSipRequestNotValidResponseBody(res *goa.ServiceError) *DownloadS
 	return body
 }
 
-// NewDownloadSipRequestInternalErrorResponseBody builds the HTTP response body
+// NewCreateSipUploadInternalErrorResponseBody builds the HTTP response body
+// from the result of the "create_sip_upload" endpoint of the "ingest" service.
+func NewCreateSipUploadInternalErrorResponseBody(res *goa.ServiceError) *CreateSipUploadInternalErrorResponseBody {
+	body := &CreateSipUploadInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShowSipUploadNotFoundResponseBody builds the HTTP response body from the
+// result of the "show_sip_upload" endpoint of the "ingest" service.
+func NewShowSipUploadNotFoundResponseBody(res *goa.ServiceError) *ShowSipUploadNotFoundResponseBody {
+	body := &ShowSipUploadNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShowSipUploadNotValidResponseBody builds the HTTP response body from the
+// result of the "show_sip_upload" endpoint of the "ingest" service.
+func NewShowSipUploadNotValidResponseBody(res *goa.ServiceError) *ShowSipUploadNotValidResponseBody {
+	body := &ShowSipUploadNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShowSipUploadInternalErrorResponseBody builds the HTTP response body from
+// the result of the "show_sip_upload" endpoint of the "ingest" service.
+func NewShowSipUploadInternalErrorResponseBody(res *goa.ServiceError) *ShowSipUploadInternalErrorResponseBody {
+	body := &ShowSipUploadInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSipChunkNotFoundResponseBody builds the HTTP response body from the
+// result of the "upload_sip_chunk" endpoint of the "ingest" service.
+func NewUploadSipChunkNotFoundResponseBody(res *goa.ServiceError) *UploadSipChunkNotFoundResponseBody {
+	body := &UploadSipChunkNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSipChunkNotValidResponseBody builds the HTTP response body from the
+// result of the "upload_sip_chunk" endpoint of the "ingest" service.
+func NewUploadSipChunkNotValidResponseBody(res *goa.ServiceError) *UploadSipChunkNotValidResponseBody {
+	body := &UploadSipChunkNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSipChunkNotAvailableResponseBody builds the HTTP response body from
+// the result of the "upload_sip_chunk" endpoint of the "ingest" service.
+func NewUploadSipChunkNotAvailableResponseBody(res *goa.ServiceError) *UploadSipChunkNotAvailableResponseBody {
+	body := &UploadSipChunkNotAvailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSipChunkInternalErrorResponseBody builds the HTTP response body from
+// the result of the "upload_sip_chunk" endpoint of the "ingest" service.
+func NewUploadSipChunkInternalErrorResponseBody(res *goa.ServiceError) *UploadSipChunkInternalErrorResponseBody {
+	body := &UploadSipChunkInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDownloadSipRequestNotValidResponseBody builds the HTTP response body from
+// the result of the "download_sip_request" endpoint of the "ingest" service.
+func NewDownloadSipRequestNotValidResponseBody(res *goa.ServiceError) *DownloadSipRequestNotValidResponseBody {
+	body := &DownloadSipRequestNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDownloadSipRequestInternalErrorResponseBody builds the HTTP response body
 // from the result of the "download_sip_request" endpoint of the "ingest"
 // service.
 func NewDownloadSipRequestInternalErrorResponseBody(res *goa.ServiceError) *DownloadSipRequestInternalErrorResponseBody {
@@ -2412,6 +3312,142 @@ func NewReviewBatchNotFoundResponseBody(res *ingest.BatchNotFound) *ReviewBatchN
 	return body
 }
 
+// NewRetryBatchNotAvailableResponseBody builds the HTTP response body from the
+// result of the "retry_batch" endpoint of the "ingest" service.
+func NewRetryBatchNotAvailableResponseBody(res *goa.ServiceError) *RetryBatchNotAvailableResponseBody {
+	body := &RetryBatchNotAvailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRetryBatchNotValidResponseBody builds the HTTP response body from the
+// result of the "retry_batch" endpoint of the "ingest" service.
+func NewRetryBatchNotValidResponseBody(res *goa.ServiceError) *RetryBatchNotValidResponseBody {
+	body := &RetryBatchNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRetryBatchNotFoundResponseBody builds the HTTP response body from the
+// result of the "retry_batch" endpoint of the "ingest" service.
+func NewRetryBatchNotFoundResponseBody(res *ingest.BatchNotFound) *RetryBatchNotFoundResponseBody {
+	body := &RetryBatchNotFoundResponseBody{
+		Message: res.Message,
+		UUID:    res.UUID,
+	}
+	return body
+}
+
+// NewCreateAPITokenNotValidResponseBody builds the HTTP response body from the
+// result of the "create_api_token" endpoint of the "ingest" service.
+func NewCreateAPITokenNotValidResponseBody(res *goa.ServiceError) *CreateAPITokenNotValidResponseBody {
+	body := &CreateAPITokenNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateAPITokenInternalErrorResponseBody builds the HTTP response body from
+// the result of the "create_api_token" endpoint of the "ingest" service.
+func NewCreateAPITokenInternalErrorResponseBody(res *goa.ServiceError) *CreateAPITokenInternalErrorResponseBody {
+	body := &CreateAPITokenInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListAPITokensInternalErrorResponseBody builds the HTTP response body from
+// the result of the "list_api_tokens" endpoint of the "ingest" service.
+func NewListAPITokensInternalErrorResponseBody(res *goa.ServiceError) *ListAPITokensInternalErrorResponseBody {
+	body := &ListAPITokensInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeAPITokenNotFoundResponseBody builds the HTTP response body from the
+// result of the "revoke_api_token" endpoint of the "ingest" service.
+func NewRevokeAPITokenNotFoundResponseBody(res *goa.ServiceError) *RevokeAPITokenNotFoundResponseBody {
+	body := &RevokeAPITokenNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeAPITokenNotValidResponseBody builds the HTTP response body from the
+// result of the "revoke_api_token" endpoint of the "ingest" service.
+func NewRevokeAPITokenNotValidResponseBody(res *goa.ServiceError) *RevokeAPITokenNotValidResponseBody {
+	body := &RevokeAPITokenNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeAPITokenInternalErrorResponseBody builds the HTTP response body from
+// the result of the "revoke_api_token" endpoint of the "ingest" service.
+func NewRevokeAPITokenInternalErrorResponseBody(res *goa.ServiceError) *RevokeAPITokenInternalErrorResponseBody {
+	body := &RevokeAPITokenInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSearchNotValidResponseBody builds the HTTP response body from the result
+// of the "search" endpoint of the "ingest" service.
+func NewSearchNotValidResponseBody(res *goa.ServiceError) *SearchNotValidResponseBody {
+	body := &SearchNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewMonitorPayload builds a ingest service monitor endpoint payload.
 func NewMonitorPayload(token *string) *ingest.MonitorPayload {
 	v := &ingest.MonitorPayload{}
@@ -2421,7 +3457,7 @@ func NewMonitorPayload(token *string) *ingest.MonitorPayload {
 }
 
 // NewListSipsPayload builds a ingest service list_sips endpoint payload.
-func NewListSipsPayload(name *string, aipUUID *string, earliestCreatedTime *string, latestCreatedTime *string, status *string, uploaderUUID *string, batchUUID *string, limit *int, offset *int, token *string) *ingest.ListSipsPayload {
+func NewListSipsPayload(name *string, aipUUID *string, earliestCreatedTime *string, latestCreatedTime *string, status *string, uploaderUUID *string, batchUUID *string, metadataKey *string, limit *int, offset *int, token *string) *ingest.ListSipsPayload {
 	v := &ingest.ListSipsPayload{}
 	v.Name = name
 	v.AipUUID = aipUUID
@@ -2430,6 +3466,7 @@ func NewListSipsPayload(name *string, aipUUID *string, earliestCreatedTime *stri
 	v.Status = status
 	v.UploaderUUID = uploaderUUID
 	v.BatchUUID = batchUUID
+	v.MetadataKey = metadataKey
 	v.Limit = limit
 	v.Offset = offset
 	v.Token = token
@@ -2476,6 +3513,24 @@ func NewRejectSipPayload(uuid string, token *string) *ingest.RejectSipPayload {
 	return v
 }
 
+// NewRetrySipPayload builds a ingest service retry_sip endpoint payload.
+func NewRetrySipPayload(uuid string, token *string) *ingest.RetrySipPayload {
+	v := &ingest.RetrySipPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v
+}
+
+// NewCancelSipPayload builds a ingest service cancel_sip endpoint payload.
+func NewCancelSipPayload(uuid string, token *string) *ingest.CancelSipPayload {
+	v := &ingest.CancelSipPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v
+}
+
 // NewShowSipDecisionPayload builds a ingest service show_sip_decision endpoint
 // payload.
 func NewShowSipDecisionPayload(uuid string, token *string) *ingest.ShowSipDecisionPayload {
@@ -2501,8 +3556,10 @@ func NewSubmitSipDecisionPayload(body *SubmitSipDecisionRequestBody, uuid string
 // NewAddSipPayload builds a ingest service add_sip endpoint payload.
 func NewAddSipPayload(body *AddSipRequestBody, token *string) *ingest.AddSipPayload {
 	v := &ingest.AddSipPayload{
-		SourceID: *body.SourceID,
-		Key:      *body.Key,
+		SourceID:          *body.SourceID,
+		Key:               *body.Key,
+		ProcessingProfile: body.ProcessingProfile,
+		LocationID:        body.LocationID,
 	}
 	v.Token = token
 
@@ -2518,6 +3575,43 @@ func NewUploadSipPayload(contentType string, token *string) *ingest.UploadSipPay
 	return v
 }
 
+// NewCreateSipUploadPayload builds a ingest service create_sip_upload endpoint
+// payload.
+func NewCreateSipUploadPayload(body *CreateSipUploadRequestBody, token *string) *ingest.CreateSipUploadPayload {
+	v := &ingest.CreateSipUploadPayload{
+		Name:              *body.Name,
+		Size:              *body.Size,
+		Checksum:          body.Checksum,
+		ProcessingProfile: body.ProcessingProfile,
+		LocationID:        body.LocationID,
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewShowSipUploadPayload builds a ingest service show_sip_upload endpoint
+// payload.
+func NewShowSipUploadPayload(uuid string, token *string) *ingest.ShowSipUploadPayload {
+	v := &ingest.ShowSipUploadPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v
+}
+
+// NewUploadSipChunkPayload builds a ingest service upload_sip_chunk endpoint
+// payload.
+func NewUploadSipChunkPayload(uuid string, offset int64, checksum *string, token *string) *ingest.UploadSipChunkPayload {
+	v := &ingest.UploadSipChunkPayload{}
+	v.UUID = uuid
+	v.Offset = offset
+	v.Checksum = checksum
+	v.Token = token
+
+	return v
+}
+
 // NewDownloadSipRequestPayload builds a ingest service download_sip_request
 // endpoint payload.
 func NewDownloadSipRequestPayload(uuid string, token *string) *ingest.DownloadSipRequestPayload {
@@ -2564,12 +3658,18 @@ func NewListSipSourceObjectsPayload(uuid string, limit *int, cursor *string, tok
 // NewAddBatchPayload builds a ingest service add_batch endpoint payload.
 func NewAddBatchPayload(body *AddBatchRequestBody, token *string) *ingest.AddBatchPayload {
 	v := &ingest.AddBatchPayload{
-		SourceID:   *body.SourceID,
-		Identifier: body.Identifier,
-	}
-	v.Keys = make([]string, len(body.Keys))
-	for i, val := range body.Keys {
-		v.Keys[i] = val
+		SourceID:          *body.SourceID,
+		Identifier:        body.Identifier,
+		ProcessingProfile: body.ProcessingProfile,
+		Manifest:          body.Manifest,
+		ManifestFormat:    body.ManifestFormat,
+		LocationID:        body.LocationID,
+	}
+	if body.Keys != nil {
+		v.Keys = make([]string, len(body.Keys))
+		for i, val := range body.Keys {
+			v.Keys[i] = val
+		}
 	}
 	v.Token = token
 
@@ -2611,6 +3711,63 @@ func NewReviewBatchPayload(body *ReviewBatchRequestBody, uuid string, token *str
 	return v
 }
 
+// NewRetryBatchPayload builds a ingest service retry_batch endpoint payload.
+func NewRetryBatchPayload(uuid string, token *string) *ingest.RetryBatchPayload {
+	v := &ingest.RetryBatchPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v
+}
+
+// NewCreateAPITokenPayload builds a ingest service create_api_token endpoint
+// payload.
+func NewCreateAPITokenPayload(body *CreateAPITokenRequestBody, token *string) *ingest.CreateAPITokenPayload {
+	v := &ingest.CreateAPITokenPayload{
+		Name:      *body.Name,
+		ExpiresAt: *body.ExpiresAt,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make([]string, len(body.Attributes))
+		for i, val := range body.Attributes {
+			v.Attributes[i] = val
+		}
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewListAPITokensPayload builds a ingest service list_api_tokens endpoint
+// payload.
+func NewListAPITokensPayload(token *string) *ingest.ListAPITokensPayload {
+	v := &ingest.ListAPITokensPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewRevokeAPITokenPayload builds a ingest service revoke_api_token endpoint
+// payload.
+func NewRevokeAPITokenPayload(uuid string, token *string) *ingest.RevokeAPITokenPayload {
+	v := &ingest.RevokeAPITokenPayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v
+}
+
+// NewSearchPayload builds a ingest service search endpoint payload.
+func NewSearchPayload(query string, limit *int, offset *int, token *string) *ingest.SearchPayload {
+	v := &ingest.SearchPayload{}
+	v.Query = query
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
+
 // ValidateConfirmSipRequestBody runs the validations defined on
 // confirm_sip_request_body
 func ValidateConfirmSipRequestBody(body *ConfirmSipRequestBody) (err error) {
@@ -2641,6 +3798,24 @@ func ValidateAddSipRequestBody(body *AddSipRequestBody) (err error) {
 	if body.SourceID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.source_id", *body.SourceID, goa.FormatUUID))
 	}
+	if body.LocationID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.location_id", *body.LocationID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateCreateSipUploadRequestBody runs the validations defined on
+// create_sip_upload_request_body
+func ValidateCreateSipUploadRequestBody(body *CreateSipUploadRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.LocationID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.location_id", *body.LocationID, goa.FormatUUID))
+	}
 	return
 }
 
@@ -2650,12 +3825,17 @@ func ValidateAddBatchRequestBody(body *AddBatchRequestBody) (err error) {
 	if body.SourceID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("source_id", "body"))
 	}
-	if body.Keys == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("keys", "body"))
-	}
 	if body.SourceID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.source_id", *body.SourceID, goa.FormatUUID))
 	}
+	if body.ManifestFormat != nil {
+		if !(*body.ManifestFormat == "csv" || *body.ManifestFormat == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.manifest_format", *body.ManifestFormat, []any{"csv", "json"}))
+		}
+	}
+	if body.LocationID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.location_id", *body.LocationID, goa.FormatUUID))
+	}
 	return
 }
 
@@ -2667,3 +3847,18 @@ func ValidateReviewBatchRequestBody(body *ReviewBatchRequestBody) (err error) {
 	}
 	return
 }
+
+// ValidateCreateAPITokenRequestBody runs the validations defined on
+// create_api_token_request_body
+func ValidateCreateAPITokenRequestBody(body *CreateAPITokenRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
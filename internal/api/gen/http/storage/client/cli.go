@@ -34,7 +34,7 @@ func BuildMonitorPayload(storageMonitorToken string) (*storage.MonitorPayload, e
 
 // BuildListAipsPayload builds the payload for the storage list_aips endpoint
 // from CLI flags.
-func BuildListAipsPayload(storageListAipsQuery string, storageListAipsEarliestCreatedTime string, storageListAipsLatestCreatedTime string, storageListAipsStatus string, storageListAipsLimit string, storageListAipsOffset string, storageListAipsToken string) (*storage.ListAipsPayload, error) {
+func BuildListAipsPayload(storageListAipsQuery string, storageListAipsEarliestCreatedTime string, storageListAipsLatestCreatedTime string, storageListAipsStatus string, storageListAipsMetadataKey string, storageListAipsLimit string, storageListAipsOffset string, storageListAipsToken string) (*storage.ListAipsPayload, error) {
 	var err error
 	var query *string
 	{
@@ -74,6 +74,12 @@ func BuildListAipsPayload(storageListAipsQuery string, storageListAipsEarliestCr
 			}
 		}
 	}
+	var metadataKey *string
+	{
+		if storageListAipsMetadataKey != "" {
+			metadataKey = &storageListAipsMetadataKey
+		}
+	}
 	var limit *int
 	{
 		if storageListAipsLimit != "" {
@@ -109,6 +115,7 @@ func BuildListAipsPayload(storageListAipsQuery string, storageListAipsEarliestCr
 	v.EarliestCreatedTime = earliestCreatedTime
 	v.LatestCreatedTime = latestCreatedTime
 	v.Status = status
+	v.MetadataKey = metadataKey
 	v.Limit = limit
 	v.Offset = offset
 	v.Token = token
@@ -124,7 +131,7 @@ func BuildCreateAipPayload(storageCreateAipBody string, storageCreateAipToken st
 	{
 		err = json.Unmarshal([]byte(storageCreateAipBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"location_uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"name\": \"abc123\",\n      \"object_key\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"status\": \"stored\",\n      \"uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"custom_metadata\": {\n         \"abc123\": \"abc123\"\n      },\n      \"location_uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"metadata\": {\n         \"abc123\": \"abc123\"\n      },\n      \"name\": \"abc123\",\n      \"object_key\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"status\": \"stored\",\n      \"uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.uuid", body.UUID, goa.FormatUUID))
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.object_key", body.ObjectKey, goa.FormatUUID))
@@ -148,6 +155,22 @@ func BuildCreateAipPayload(storageCreateAipBody string, storageCreateAipToken st
 		Status:       body.Status,
 		LocationUUID: body.LocationUUID,
 	}
+	if body.Metadata != nil {
+		v.Metadata = make(map[string]string, len(body.Metadata))
+		for key, val := range body.Metadata {
+			tk := key
+			tv := val
+			v.Metadata[tk] = tv
+		}
+	}
+	if body.CustomMetadata != nil {
+		v.CustomMetadata = make(map[string]any, len(body.CustomMetadata))
+		for key, val := range body.CustomMetadata {
+			tk := key
+			tv := val
+			v.CustomMetadata[tk] = tv
+		}
+	}
 	{
 		var zero string
 		if v.Status == zero {
@@ -268,6 +291,52 @@ func BuildMoveAipStatusPayload(storageMoveAipStatusUUID string, storageMoveAipSt
 	return v, nil
 }
 
+// BuildRestoreAipsPayload builds the payload for the storage restore_aips
+// endpoint from CLI flags.
+func BuildRestoreAipsPayload(storageRestoreAipsBody string, storageRestoreAipsToken string) (*storage.RestoreAipsPayload, error) {
+	var err error
+	var body RestoreAipsRequestBody
+	{
+		err = json.Unmarshal([]byte(storageRestoreAipsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"aip_uuids\": [\n         \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n      ],\n      \"location_uuid\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"unpack\": false\n   }'")
+		}
+		if body.AipUuids == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuids", "body"))
+		}
+		if len(body.AipUuids) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.aip_uuids", body.AipUuids, len(body.AipUuids), 1, true))
+		}
+		for _, e := range body.AipUuids {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.aip_uuids[*]", e, goa.FormatUUID))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if storageRestoreAipsToken != "" {
+			token = &storageRestoreAipsToken
+		}
+	}
+	v := &storage.RestoreAipsPayload{
+		LocationUUID: body.LocationUUID,
+		Unpack:       body.Unpack,
+	}
+	if body.AipUuids != nil {
+		v.AipUuids = make([]string, len(body.AipUuids))
+		for i, val := range body.AipUuids {
+			v.AipUuids[i] = val
+		}
+	} else {
+		v.AipUuids = []string{}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
 // BuildRejectAipPayload builds the payload for the storage reject_aip endpoint
 // from CLI flags.
 func BuildRejectAipPayload(storageRejectAipUUID string, storageRejectAipToken string) (*storage.RejectAipPayload, error) {
@@ -346,8 +415,8 @@ func BuildListAipWorkflowsPayload(storageListAipWorkflowsUUID string, storageLis
 	{
 		if storageListAipWorkflowsType != "" {
 			type_ = &storageListAipWorkflowsType
-			if !(*type_ == "unspecified" || *type_ == "upload aip" || *type_ == "move aip" || *type_ == "delete aip") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("type", *type_, []any{"unspecified", "upload aip", "move aip", "delete aip"}))
+			if !(*type_ == "unspecified" || *type_ == "upload aip" || *type_ == "move aip" || *type_ == "delete aip" || *type_ == "audit aip" || *type_ == "replicate aip" || *type_ == "restore aip") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("type", *type_, []any{"unspecified", "upload aip", "move aip", "delete aip", "audit aip", "replicate aip", "restore aip"}))
 			}
 			if err != nil {
 				return nil, err
@@ -438,6 +507,74 @@ func BuildRequestAipDeletionPayload(storageRequestAipDeletionBody string, storag
 	return v, nil
 }
 
+// BuildPlaceAipLegalHoldPayload builds the payload for the storage
+// place_aip_legal_hold endpoint from CLI flags.
+func BuildPlaceAipLegalHoldPayload(storagePlaceAipLegalHoldBody string, storagePlaceAipLegalHoldUUID string, storagePlaceAipLegalHoldToken string) (*storage.PlaceAipLegalHoldPayload, error) {
+	var err error
+	var body PlaceAipLegalHoldRequestBody
+	{
+		err = json.Unmarshal([]byte(storagePlaceAipLegalHoldBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"reason\": \"abc123\"\n   }'")
+		}
+	}
+	var uuid string
+	{
+		uuid = storagePlaceAipLegalHoldUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if storagePlaceAipLegalHoldToken != "" {
+			token = &storagePlaceAipLegalHoldToken
+		}
+	}
+	v := &storage.PlaceAipLegalHoldPayload{
+		Reason: body.Reason,
+	}
+	v.UUID = uuid
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildReleaseAipLegalHoldPayload builds the payload for the storage
+// release_aip_legal_hold endpoint from CLI flags.
+func BuildReleaseAipLegalHoldPayload(storageReleaseAipLegalHoldBody string, storageReleaseAipLegalHoldUUID string, storageReleaseAipLegalHoldToken string) (*storage.ReleaseAipLegalHoldPayload, error) {
+	var err error
+	var body ReleaseAipLegalHoldRequestBody
+	{
+		err = json.Unmarshal([]byte(storageReleaseAipLegalHoldBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"reason\": \"abc123\"\n   }'")
+		}
+	}
+	var uuid string
+	{
+		uuid = storageReleaseAipLegalHoldUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if storageReleaseAipLegalHoldToken != "" {
+			token = &storageReleaseAipLegalHoldToken
+		}
+	}
+	v := &storage.ReleaseAipLegalHoldPayload{
+		Reason: body.Reason,
+	}
+	v.UUID = uuid
+	v.Token = token
+
+	return v, nil
+}
+
 // BuildReviewAipDeletionPayload builds the payload for the storage
 // review_aip_deletion endpoint from CLI flags.
 func BuildReviewAipDeletionPayload(storageReviewAipDeletionBody string, storageReviewAipDeletionUUID string, storageReviewAipDeletionToken string) (*storage.ReviewAipDeletionPayload, error) {
@@ -579,13 +716,16 @@ func BuildCreateLocationPayload(storageCreateLocationBody string, storageCreateL
 	{
 		err = json.Unmarshal([]byte(storageCreateLocationBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"config\": {\n         \"bucket\": \"abc123\",\n         \"endpoint\": \"abc123\",\n         \"key\": \"abc123\",\n         \"path_style\": false,\n         \"profile\": \"abc123\",\n         \"region\": \"abc123\",\n         \"secret\": \"abc123\",\n         \"token\": \"abc123\"\n      },\n      \"description\": \"abc123\",\n      \"name\": \"abc123\",\n      \"purpose\": \"aip_store\",\n      \"source\": \"s3\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"config\": {\n         \"bucket\": \"abc123\",\n         \"endpoint\": \"abc123\",\n         \"key\": \"abc123\",\n         \"path_style\": false,\n         \"profile\": \"abc123\",\n         \"region\": \"abc123\",\n         \"secret\": \"abc123\",\n         \"token\": \"abc123\"\n      },\n      \"description\": \"abc123\",\n      \"name\": \"abc123\",\n      \"purpose\": \"aip_store\",\n      \"replication_targets\": [\n         \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n      ],\n      \"source\": \"s3\"\n   }'")
 		}
 		if !(body.Source == "unspecified" || body.Source == "s3" || body.Source == "sftp" || body.Source == "amss" || body.Source == "filesystem") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", body.Source, []any{"unspecified", "s3", "sftp", "amss", "filesystem"}))
 		}
-		if !(body.Purpose == "unspecified" || body.Purpose == "aip_store") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.purpose", body.Purpose, []any{"unspecified", "aip_store"}))
+		if !(body.Purpose == "unspecified" || body.Purpose == "aip_store" || body.Purpose == "restore") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.purpose", body.Purpose, []any{"unspecified", "aip_store", "restore"}))
+		}
+		for _, e := range body.ReplicationTargets {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.replication_targets[*]", e, goa.FormatUUID))
 		}
 		if err != nil {
 			return nil, err
@@ -611,6 +751,12 @@ func BuildCreateLocationPayload(storageCreateLocationBody string, storageCreateL
 			u := v.Config
 			u.SetAmss((*storage.AMSSConfig)(obj))
 			v.Config = u
+		case "filesystem":
+			actual, _ := body.Config.AsFilesystem()
+			obj := marshalFilesystemConfigRequestBodyToStorageFilesystemConfig(actual)
+			u := v.Config
+			u.SetFilesystem((*storage.FilesystemConfig)(obj))
+			v.Config = u
 		case "s3":
 			actual, _ := body.Config.AsS3()
 			obj := marshalS3ConfigRequestBodyToStorageS3Config(actual)
@@ -631,6 +777,12 @@ func BuildCreateLocationPayload(storageCreateLocationBody string, storageCreateL
 			v.Config = u
 		}
 	}
+	if body.ReplicationTargets != nil {
+		v.ReplicationTargets = make([]string, len(body.ReplicationTargets))
+		for i, val := range body.ReplicationTargets {
+			v.ReplicationTargets[i] = val
+		}
+	}
 	v.Token = token
 
 	return v, nil
@@ -685,3 +837,75 @@ func BuildListLocationAipsPayload(storageListLocationAipsUUID string, storageLis
 
 	return v, nil
 }
+
+// BuildLocationUsagePayload builds the payload for the storage location_usage
+// endpoint from CLI flags.
+func BuildLocationUsagePayload(storageLocationUsageUUID string, storageLocationUsageToken string) (*storage.LocationUsagePayload, error) {
+	var err error
+	var uuid string
+	{
+		uuid = storageLocationUsageUUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if storageLocationUsageToken != "" {
+			token = &storageLocationUsageToken
+		}
+	}
+	v := &storage.LocationUsagePayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildSearchPayload builds the payload for the storage search endpoint from
+// CLI flags.
+func BuildSearchPayload(storageSearchQuery string, storageSearchLimit string, storageSearchOffset string, storageSearchToken string) (*storage.SearchPayload, error) {
+	var err error
+	var query string
+	{
+		query = storageSearchQuery
+	}
+	var limit *int
+	{
+		if storageSearchLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(storageSearchLimit, 10, strconv.IntSize)
+			val := int(v)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+		}
+	}
+	var offset *int
+	{
+		if storageSearchOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(storageSearchOffset, 10, strconv.IntSize)
+			val := int(v)
+			offset = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+		}
+	}
+	var token *string
+	{
+		if storageSearchToken != "" {
+			token = &storageSearchToken
+		}
+	}
+	v := &storage.SearchPayload{}
+	v.Query = query
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
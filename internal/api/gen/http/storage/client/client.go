@@ -49,6 +49,10 @@ type Client struct {
 	// move_aip_status endpoint.
 	MoveAipStatusDoer goahttp.Doer
 
+	// RestoreAips Doer is the HTTP client used to make requests to the
+	// restore_aips endpoint.
+	RestoreAipsDoer goahttp.Doer
+
 	// RejectAip Doer is the HTTP client used to make requests to the reject_aip
 	// endpoint.
 	RejectAipDoer goahttp.Doer
@@ -68,6 +72,12 @@ type Client struct {
 	// RequestAipDeletion Doer is the HTTP client used to make requests to the
 	// request_aip_deletion endpoint.
 	RequestAipDeletionDoer goahttp.Doer
+	// PlaceAipLegalHold Doer is the HTTP client used to make requests to the
+	// place_aip_legal_hold endpoint.
+	PlaceAipLegalHoldDoer goahttp.Doer
+	// ReleaseAipLegalHold Doer is the HTTP client used to make requests to the
+	// release_aip_legal_hold endpoint.
+	ReleaseAipLegalHoldDoer goahttp.Doer
 
 	// ReviewAipDeletion Doer is the HTTP client used to make requests to the
 	// review_aip_deletion endpoint.
@@ -101,6 +111,13 @@ type Client struct {
 	// list_location_aips endpoint.
 	ListLocationAipsDoer goahttp.Doer
 
+	// LocationUsage Doer is the HTTP client used to make requests to the
+	// location_usage endpoint.
+	LocationUsageDoer goahttp.Doer
+
+	// Search Doer is the HTTP client used to make requests to the search endpoint.
+	SearchDoer goahttp.Doer
+
 	// CORS Doer is the HTTP client used to make requests to the  endpoint.
 	CORSDoer goahttp.Doer
 
@@ -131,11 +148,14 @@ func NewClient(
 		DownloadAipDoer:              doer,
 		MoveAipDoer:                  doer,
 		MoveAipStatusDoer:            doer,
+		RestoreAipsDoer:              doer,
 		RejectAipDoer:                doer,
 		ShowAipDoer:                  doer,
 		ListAipWorkflowsDoer:         doer,
 		AipDeletionAutoDoer:          doer,
 		RequestAipDeletionDoer:       doer,
+		PlaceAipLegalHoldDoer:        doer,
+		ReleaseAipLegalHoldDoer:      doer,
 		ReviewAipDeletionDoer:        doer,
 		CancelAipDeletionDoer:        doer,
 		AipDeletionReportRequestDoer: doer,
@@ -144,6 +164,8 @@ func NewClient(
 		CreateLocationDoer:           doer,
 		ShowLocationDoer:             doer,
 		ListLocationAipsDoer:         doer,
+		LocationUsageDoer:            doer,
+		SearchDoer:                   doer,
 		CORSDoer:                     doer,
 		RestoreResponseBody:          restoreBody,
 		scheme:                       scheme,
@@ -338,6 +360,30 @@ func (c *Client) MoveAipStatus() goa.Endpoint {
 	}
 }
 
+// RestoreAips returns an endpoint that makes HTTP requests to the storage
+// service restore_aips server.
+func (c *Client) RestoreAips() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRestoreAipsRequest(c.encoder)
+		decodeResponse = DecodeRestoreAipsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRestoreAipsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RestoreAipsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("storage", "restore_aips", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // RejectAip returns an endpoint that makes HTTP requests to the storage
 // service reject_aip server.
 func (c *Client) RejectAip() goa.Endpoint {
@@ -458,6 +504,54 @@ func (c *Client) RequestAipDeletion() goa.Endpoint {
 	}
 }
 
+// PlaceAipLegalHold returns an endpoint that makes HTTP requests to the
+// storage service place_aip_legal_hold server.
+func (c *Client) PlaceAipLegalHold() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePlaceAipLegalHoldRequest(c.encoder)
+		decodeResponse = DecodePlaceAipLegalHoldResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPlaceAipLegalHoldRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PlaceAipLegalHoldDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("storage", "place_aip_legal_hold", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReleaseAipLegalHold returns an endpoint that makes HTTP requests to the
+// storage service release_aip_legal_hold server.
+func (c *Client) ReleaseAipLegalHold() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReleaseAipLegalHoldRequest(c.encoder)
+		decodeResponse = DecodeReleaseAipLegalHoldResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReleaseAipLegalHoldRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReleaseAipLegalHoldDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("storage", "release_aip_legal_hold", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ReviewAipDeletion returns an endpoint that makes HTTP requests to the
 // storage service review_aip_deletion server.
 func (c *Client) ReviewAipDeletion() goa.Endpoint {
@@ -654,3 +748,51 @@ func (c *Client) ListLocationAips() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// LocationUsage returns an endpoint that makes HTTP requests to the storage
+// service location_usage server.
+func (c *Client) LocationUsage() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeLocationUsageRequest(c.encoder)
+		decodeResponse = DecodeLocationUsageResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLocationUsageRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.LocationUsageDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("storage", "location_usage", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Search returns an endpoint that makes HTTP requests to the storage service
+// search server.
+func (c *Client) Search() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSearchRequest(c.encoder)
+		decodeResponse = DecodeSearchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSearchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SearchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("storage", "search", err)
+		}
+		return decodeResponse(resp)
+	}
+}
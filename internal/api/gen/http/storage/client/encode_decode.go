@@ -182,6 +182,9 @@ func EncodeListAipsRequest(encoder func(*http.Request) goahttp.Encoder) func(*ht
 		if p.Status != nil {
 			values.Add("status", *p.Status)
 		}
+		if p.MetadataKey != nil {
+			values.Add("metadata_key", *p.MetadataKey)
+		}
 		if p.Limit != nil {
 			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
 		}
@@ -1020,6 +1023,141 @@ func DecodeMoveAipStatusResponse(decoder func(*http.Response) goahttp.Decoder, r
 	}
 }
 
+// BuildRestoreAipsRequest instantiates a HTTP request object with method and
+// path set to call the "storage" service "restore_aips" endpoint
+func (c *Client) BuildRestoreAipsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestoreAipsStoragePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("storage", "restore_aips", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRestoreAipsRequest returns an encoder for requests sent to the storage
+// restore_aips server.
+func EncodeRestoreAipsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*storage.RestoreAipsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("storage", "restore_aips", "*storage.RestoreAipsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewRestoreAipsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("storage", "restore_aips", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRestoreAipsResponse returns a decoder for responses returned by the
+// storage restore_aips endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRestoreAipsResponse may return the following errors:
+//   - "not_available" (type *goa.ServiceError): http.StatusConflict
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *storage.AIPNotFound): http.StatusNotFound
+//   - "forbidden" (type storage.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type storage.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRestoreAipsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusConflict:
+			var (
+				body RestoreAipsNotAvailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "restore_aips", err)
+			}
+			err = ValidateRestoreAipsNotAvailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "restore_aips", err)
+			}
+			return nil, NewRestoreAipsNotAvailable(&body)
+		case http.StatusBadRequest:
+			var (
+				body RestoreAipsNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "restore_aips", err)
+			}
+			err = ValidateRestoreAipsNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "restore_aips", err)
+			}
+			return nil, NewRestoreAipsNotValid(&body)
+		case http.StatusNotFound:
+			var (
+				body RestoreAipsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "restore_aips", err)
+			}
+			err = ValidateRestoreAipsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "restore_aips", err)
+			}
+			return nil, NewRestoreAipsNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "restore_aips", err)
+			}
+			return nil, NewRestoreAipsForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "restore_aips", err)
+			}
+			return nil, NewRestoreAipsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("storage", "restore_aips", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildRejectAipRequest instantiates a HTTP request object with method and
 // path set to call the "storage" service "reject_aip" endpoint
 func (c *Client) BuildRejectAipRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -1592,6 +1730,58 @@ func (c *Client) BuildRequestAipDeletionRequest(ctx context.Context, v any) (*ht
 	return req, nil
 }
 
+// BuildPlaceAipLegalHoldRequest instantiates a HTTP request object with
+// method and path set to call the "storage" service "place_aip_legal_hold"
+// endpoint
+func (c *Client) BuildPlaceAipLegalHoldRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*storage.PlaceAipLegalHoldPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("storage", "place_aip_legal_hold", "*storage.PlaceAipLegalHoldPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PlaceAipLegalHoldStoragePath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("storage", "place_aip_legal_hold", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// BuildReleaseAipLegalHoldRequest instantiates a HTTP request object with
+// method and path set to call the "storage" service "release_aip_legal_hold"
+// endpoint
+func (c *Client) BuildReleaseAipLegalHoldRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*storage.ReleaseAipLegalHoldPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("storage", "release_aip_legal_hold", "*storage.ReleaseAipLegalHoldPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReleaseAipLegalHoldStoragePath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("storage", "release_aip_legal_hold", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
 // EncodeRequestAipDeletionRequest returns an encoder for requests sent to the
 // storage request_aip_deletion server.
 func EncodeRequestAipDeletionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
@@ -1616,6 +1806,54 @@ func EncodeRequestAipDeletionRequest(encoder func(*http.Request) goahttp.Encoder
 	}
 }
 
+// EncodePlaceAipLegalHoldRequest returns an encoder for requests sent to the
+// storage place_aip_legal_hold server.
+func EncodePlaceAipLegalHoldRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*storage.PlaceAipLegalHoldPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("storage", "place_aip_legal_hold", "*storage.PlaceAipLegalHoldPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewPlaceAipLegalHoldRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("storage", "place_aip_legal_hold", err)
+		}
+		return nil
+	}
+}
+
+// EncodeReleaseAipLegalHoldRequest returns an encoder for requests sent to the
+// storage release_aip_legal_hold server.
+func EncodeReleaseAipLegalHoldRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*storage.ReleaseAipLegalHoldPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("storage", "release_aip_legal_hold", "*storage.ReleaseAipLegalHoldPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewReleaseAipLegalHoldRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("storage", "release_aip_legal_hold", err)
+		}
+		return nil
+	}
+}
+
 // DecodeRequestAipDeletionResponse returns a decoder for responses returned by
 // the storage request_aip_deletion endpoint. restoreBody controls whether the
 // response body should be restored after having been read.
@@ -1712,66 +1950,17 @@ func DecodeRequestAipDeletionResponse(decoder func(*http.Response) goahttp.Decod
 	}
 }
 
-// BuildReviewAipDeletionRequest instantiates a HTTP request object with method
-// and path set to call the "storage" service "review_aip_deletion" endpoint
-func (c *Client) BuildReviewAipDeletionRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		uuid string
-	)
-	{
-		p, ok := v.(*storage.ReviewAipDeletionPayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("storage", "review_aip_deletion", "*storage.ReviewAipDeletionPayload", v)
-		}
-		uuid = p.UUID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReviewAipDeletionStoragePath(uuid)}
-	req, err := http.NewRequest("POST", u.String(), nil)
-	if err != nil {
-		return nil, goahttp.ErrInvalidURL("storage", "review_aip_deletion", u.String(), err)
-	}
-	if ctx != nil {
-		req = req.WithContext(ctx)
-	}
-
-	return req, nil
-}
-
-// EncodeReviewAipDeletionRequest returns an encoder for requests sent to the
-// storage review_aip_deletion server.
-func EncodeReviewAipDeletionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
-	return func(req *http.Request, v any) error {
-		p, ok := v.(*storage.ReviewAipDeletionPayload)
-		if !ok {
-			return goahttp.ErrInvalidType("storage", "review_aip_deletion", "*storage.ReviewAipDeletionPayload", v)
-		}
-		if p.Token != nil {
-			head := *p.Token
-			if !strings.Contains(head, " ") {
-				req.Header.Set("Authorization", "Bearer "+head)
-			} else {
-				req.Header.Set("Authorization", head)
-			}
-		}
-		body := NewReviewAipDeletionRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("storage", "review_aip_deletion", err)
-		}
-		return nil
-	}
-}
-
-// DecodeReviewAipDeletionResponse returns a decoder for responses returned by
-// the storage review_aip_deletion endpoint. restoreBody controls whether the
+// DecodePlaceAipLegalHoldResponse returns a decoder for responses returned by
+// the storage place_aip_legal_hold endpoint. restoreBody controls whether the
 // response body should be restored after having been read.
-// DecodeReviewAipDeletionResponse may return the following errors:
+// DecodePlaceAipLegalHoldResponse may return the following errors:
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "not_found" (type *storage.AIPNotFound): http.StatusNotFound
 //   - "forbidden" (type storage.Forbidden): http.StatusForbidden
 //   - "unauthorized" (type storage.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeReviewAipDeletionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodePlaceAipLegalHoldResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1786,50 +1975,50 @@ func DecodeReviewAipDeletionResponse(decoder func(*http.Response) goahttp.Decode
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusAccepted:
+		case http.StatusCreated:
 			return nil, nil
 		case http.StatusBadRequest:
 			var (
-				body ReviewAipDeletionNotValidResponseBody
+				body PlaceAipLegalHoldNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+				return nil, goahttp.ErrDecodingError("storage", "place_aip_legal_hold", err)
 			}
-			err = ValidateReviewAipDeletionNotValidResponseBody(&body)
+			err = ValidatePlaceAipLegalHoldNotValidResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("storage", "review_aip_deletion", err)
+				return nil, goahttp.ErrValidationError("storage", "place_aip_legal_hold", err)
 			}
-			return nil, NewReviewAipDeletionNotValid(&body)
+			return nil, NewPlaceAipLegalHoldNotValid(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ReviewAipDeletionInternalErrorResponseBody
+				body PlaceAipLegalHoldInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+				return nil, goahttp.ErrDecodingError("storage", "place_aip_legal_hold", err)
 			}
-			err = ValidateReviewAipDeletionInternalErrorResponseBody(&body)
+			err = ValidatePlaceAipLegalHoldInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("storage", "review_aip_deletion", err)
+				return nil, goahttp.ErrValidationError("storage", "place_aip_legal_hold", err)
 			}
-			return nil, NewReviewAipDeletionInternalError(&body)
+			return nil, NewPlaceAipLegalHoldInternalError(&body)
 		case http.StatusNotFound:
 			var (
-				body ReviewAipDeletionNotFoundResponseBody
+				body PlaceAipLegalHoldNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+				return nil, goahttp.ErrDecodingError("storage", "place_aip_legal_hold", err)
 			}
-			err = ValidateReviewAipDeletionNotFoundResponseBody(&body)
+			err = ValidatePlaceAipLegalHoldNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("storage", "review_aip_deletion", err)
+				return nil, goahttp.ErrValidationError("storage", "place_aip_legal_hold", err)
 			}
-			return nil, NewReviewAipDeletionNotFound(&body)
+			return nil, NewPlaceAipLegalHoldNotFound(&body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1837,9 +2026,9 @@ func DecodeReviewAipDeletionResponse(decoder func(*http.Response) goahttp.Decode
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+				return nil, goahttp.ErrDecodingError("storage", "place_aip_legal_hold", err)
 			}
-			return nil, NewReviewAipDeletionForbidden(body)
+			return nil, NewPlaceAipLegalHoldForbidden(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1847,25 +2036,266 @@ func DecodeReviewAipDeletionResponse(decoder func(*http.Response) goahttp.Decode
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+				return nil, goahttp.ErrDecodingError("storage", "place_aip_legal_hold", err)
 			}
-			return nil, NewReviewAipDeletionUnauthorized(body)
+			return nil, NewPlaceAipLegalHoldUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("storage", "review_aip_deletion", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("storage", "place_aip_legal_hold", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildCancelAipDeletionRequest instantiates a HTTP request object with method
-// and path set to call the "storage" service "cancel_aip_deletion" endpoint
-func (c *Client) BuildCancelAipDeletionRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		uuid string
-	)
-	{
-		p, ok := v.(*storage.CancelAipDeletionPayload)
-		if !ok {
+// DecodeReleaseAipLegalHoldResponse returns a decoder for responses returned by
+// the storage release_aip_legal_hold endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeReleaseAipLegalHoldResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not_found" (type *storage.AIPNotFound): http.StatusNotFound
+//   - "forbidden" (type storage.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type storage.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeReleaseAipLegalHoldResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body ReleaseAipLegalHoldNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "release_aip_legal_hold", err)
+			}
+			err = ValidateReleaseAipLegalHoldNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "release_aip_legal_hold", err)
+			}
+			return nil, NewReleaseAipLegalHoldNotValid(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ReleaseAipLegalHoldInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "release_aip_legal_hold", err)
+			}
+			err = ValidateReleaseAipLegalHoldInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "release_aip_legal_hold", err)
+			}
+			return nil, NewReleaseAipLegalHoldInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ReleaseAipLegalHoldNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "release_aip_legal_hold", err)
+			}
+			err = ValidateReleaseAipLegalHoldNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "release_aip_legal_hold", err)
+			}
+			return nil, NewReleaseAipLegalHoldNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "release_aip_legal_hold", err)
+			}
+			return nil, NewReleaseAipLegalHoldForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "release_aip_legal_hold", err)
+			}
+			return nil, NewReleaseAipLegalHoldUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("storage", "release_aip_legal_hold", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReviewAipDeletionRequest instantiates a HTTP request object with method
+// and path set to call the "storage" service "review_aip_deletion" endpoint
+func (c *Client) BuildReviewAipDeletionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*storage.ReviewAipDeletionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("storage", "review_aip_deletion", "*storage.ReviewAipDeletionPayload", v)
+		}
+		uuid = p.UUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReviewAipDeletionStoragePath(uuid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("storage", "review_aip_deletion", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReviewAipDeletionRequest returns an encoder for requests sent to the
+// storage review_aip_deletion server.
+func EncodeReviewAipDeletionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*storage.ReviewAipDeletionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("storage", "review_aip_deletion", "*storage.ReviewAipDeletionPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewReviewAipDeletionRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("storage", "review_aip_deletion", err)
+		}
+		return nil
+	}
+}
+
+// DecodeReviewAipDeletionResponse returns a decoder for responses returned by
+// the storage review_aip_deletion endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeReviewAipDeletionResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not_found" (type *storage.AIPNotFound): http.StatusNotFound
+//   - "forbidden" (type storage.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type storage.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeReviewAipDeletionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body ReviewAipDeletionNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+			}
+			err = ValidateReviewAipDeletionNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "review_aip_deletion", err)
+			}
+			return nil, NewReviewAipDeletionNotValid(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ReviewAipDeletionInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+			}
+			err = ValidateReviewAipDeletionInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "review_aip_deletion", err)
+			}
+			return nil, NewReviewAipDeletionInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ReviewAipDeletionNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+			}
+			err = ValidateReviewAipDeletionNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "review_aip_deletion", err)
+			}
+			return nil, NewReviewAipDeletionNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+			}
+			return nil, NewReviewAipDeletionForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "review_aip_deletion", err)
+			}
+			return nil, NewReviewAipDeletionUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("storage", "review_aip_deletion", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCancelAipDeletionRequest instantiates a HTTP request object with method
+// and path set to call the "storage" service "cancel_aip_deletion" endpoint
+func (c *Client) BuildCancelAipDeletionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*storage.CancelAipDeletionPayload)
+		if !ok {
 			return nil, goahttp.ErrInvalidType("storage", "cancel_aip_deletion", "*storage.CancelAipDeletionPayload", v)
 		}
 		uuid = p.UUID
@@ -2815,28 +3245,275 @@ func DecodeListLocationAipsResponse(decoder func(*http.Response) goahttp.Decoder
 	}
 }
 
-// unmarshalStoragePingEventResponseBodyToStorageStoragePingEvent builds a
-// value of type *storage.StoragePingEvent from a value of type
-// *StoragePingEventResponseBody.
-func unmarshalStoragePingEventResponseBodyToStorageStoragePingEvent(v *StoragePingEventResponseBody) *storage.StoragePingEvent {
-	if v == nil {
-		return nil
+// BuildLocationUsageRequest instantiates a HTTP request object with method and
+// path set to call the "storage" service "location_usage" endpoint
+func (c *Client) BuildLocationUsageRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uuid string
+	)
+	{
+		p, ok := v.(*storage.LocationUsagePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("storage", "location_usage", "*storage.LocationUsagePayload", v)
+		}
+		uuid = p.UUID
 	}
-	res := &storage.StoragePingEvent{
-		Message: v.Message,
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LocationUsageStoragePath(uuid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("storage", "location_usage", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
 	}
 
-	return res
+	return req, nil
 }
 
-// unmarshalLocationCreatedEventResponseBodyToStorageLocationCreatedEvent
-// builds a value of type *storage.LocationCreatedEvent from a value of type
-// *LocationCreatedEventResponseBody.
-func unmarshalLocationCreatedEventResponseBodyToStorageLocationCreatedEvent(v *LocationCreatedEventResponseBody) *storage.LocationCreatedEvent {
-	if v == nil {
-		return nil
-	}
-	res := &storage.LocationCreatedEvent{
+// EncodeLocationUsageRequest returns an encoder for requests sent to the
+// storage location_usage server.
+func EncodeLocationUsageRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*storage.LocationUsagePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("storage", "location_usage", "*storage.LocationUsagePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeLocationUsageResponse returns a decoder for responses returned by the
+// storage location_usage endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeLocationUsageResponse may return the following errors:
+//   - "not_found" (type *storage.LocationNotFound): http.StatusNotFound
+//   - "forbidden" (type storage.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type storage.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeLocationUsageResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body LocationUsageResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "location_usage", err)
+			}
+			err = ValidateLocationUsageResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "location_usage", err)
+			}
+			res := NewLocationUsageOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body LocationUsageNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "location_usage", err)
+			}
+			err = ValidateLocationUsageNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "location_usage", err)
+			}
+			return nil, NewLocationUsageNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "location_usage", err)
+			}
+			return nil, NewLocationUsageForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "location_usage", err)
+			}
+			return nil, NewLocationUsageUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("storage", "location_usage", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSearchRequest instantiates a HTTP request object with method and path
+// set to call the "storage" service "search" endpoint
+func (c *Client) BuildSearchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchStoragePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("storage", "search", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSearchRequest returns an encoder for requests sent to the storage
+// search server.
+func EncodeSearchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*storage.SearchPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("storage", "search", "*storage.SearchPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("query", p.Query)
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.Offset != nil {
+			values.Add("offset", fmt.Sprintf("%v", *p.Offset))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSearchResponse returns a decoder for responses returned by the storage
+// search endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeSearchResponse may return the following errors:
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - "forbidden" (type storage.Forbidden): http.StatusForbidden
+//   - "unauthorized" (type storage.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSearchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SearchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "search", err)
+			}
+			err = ValidateSearchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "search", err)
+			}
+			res := NewSearchResultsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SearchNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "search", err)
+			}
+			err = ValidateSearchNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("storage", "search", err)
+			}
+			return nil, NewSearchNotValid(&body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "search", err)
+			}
+			return nil, NewSearchForbidden(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("storage", "search", err)
+			}
+			return nil, NewSearchUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("storage", "search", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalStoragePingEventResponseBodyToStorageStoragePingEvent builds a
+// value of type *storage.StoragePingEvent from a value of type
+// *StoragePingEventResponseBody.
+func unmarshalStoragePingEventResponseBodyToStorageStoragePingEvent(v *StoragePingEventResponseBody) *storage.StoragePingEvent {
+	if v == nil {
+		return nil
+	}
+	res := &storage.StoragePingEvent{
+		Message: v.Message,
+	}
+
+	return res
+}
+
+// unmarshalLocationCreatedEventResponseBodyToStorageLocationCreatedEvent
+// builds a value of type *storage.LocationCreatedEvent from a value of type
+// *LocationCreatedEventResponseBody.
+func unmarshalLocationCreatedEventResponseBodyToStorageLocationCreatedEvent(v *LocationCreatedEventResponseBody) *storage.LocationCreatedEvent {
+	if v == nil {
+		return nil
+	}
+	res := &storage.LocationCreatedEvent{
 		UUID: *v.UUID,
 	}
 	res.Item = unmarshalLocationResponseBodyToStorageLocation(v.Item)
@@ -2863,6 +3540,12 @@ func unmarshalLocationResponseBodyToStorageLocation(v *LocationResponseBody) *st
 			u := res.Config
 			u.SetAmss((*storage.AMSSConfig)(obj))
 			res.Config = u
+		case "filesystem":
+			actual, _ := v.Config.AsFilesystem()
+			obj := unmarshalFilesystemConfigResponseBodyToStorageFilesystemConfig(actual)
+			u := res.Config
+			u.SetFilesystem((*storage.FilesystemConfig)(obj))
+			res.Config = u
 		case "s3":
 			actual, _ := v.Config.AsS3()
 			obj := unmarshalS3ConfigResponseBodyToStorageS3Config(actual)
@@ -2883,6 +3566,12 @@ func unmarshalLocationResponseBodyToStorageLocation(v *LocationResponseBody) *st
 			res.Config = u
 		}
 	}
+	if v.ReplicationTargets != nil {
+		res.ReplicationTargets = make([]string, len(v.ReplicationTargets))
+		for i, val := range v.ReplicationTargets {
+			res.ReplicationTargets[i] = val
+		}
+	}
 
 	return res
 }
@@ -2902,6 +3591,20 @@ func unmarshalAMSSConfigResponseBodyToStorageAMSSConfig(v *AMSSConfigResponseBod
 	return res
 }
 
+// unmarshalFilesystemConfigResponseBodyToStorageFilesystemConfig builds a value
+// of type *storage.FilesystemConfig from a value of type
+// *FilesystemConfigResponseBody.
+func unmarshalFilesystemConfigResponseBodyToStorageFilesystemConfig(v *FilesystemConfigResponseBody) *storage.FilesystemConfig {
+	if v == nil {
+		return nil
+	}
+	res := &storage.FilesystemConfig{
+		Path: *v.Path,
+	}
+
+	return res
+}
+
 // unmarshalS3ConfigResponseBodyToStorageS3Config builds a value of type
 // *storage.S3Config from a value of type *S3ConfigResponseBody.
 func unmarshalS3ConfigResponseBodyToStorageS3Config(v *S3ConfigResponseBody) *storage.S3Config {
@@ -2977,6 +3680,72 @@ func unmarshalAIPResponseBodyToStorageAIP(v *AIPResponseBody) *storage.AIP {
 		LocationUUID:      v.LocationUUID,
 		CreatedAt:         *v.CreatedAt,
 		DeletionReportKey: v.DeletionReportKey,
+		DisposalAt:        v.DisposalAt,
+		Size:              v.Size,
+		FileCount:         v.FileCount,
+	}
+	if v.Replicas != nil {
+		res.Replicas = make([]*storage.AIPReplica, len(v.Replicas))
+		for i, val := range v.Replicas {
+			if val == nil {
+				res.Replicas[i] = nil
+				continue
+			}
+			res.Replicas[i] = unmarshalAIPReplicaResponseBodyToStorageAIPReplica(val)
+		}
+	}
+	if v.LegalHolds != nil {
+		res.LegalHolds = make([]*storage.AIPLegalHold, len(v.LegalHolds))
+		for i, val := range v.LegalHolds {
+			if val == nil {
+				res.LegalHolds[i] = nil
+				continue
+			}
+			res.LegalHolds[i] = unmarshalAIPLegalHoldResponseBodyToStorageAIPLegalHold(val)
+		}
+	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalAIPReplicaResponseBodyToStorageAIPReplica builds a value of type
+// *storage.AIPReplica from a value of type *AIPReplicaResponseBody.
+func unmarshalAIPReplicaResponseBodyToStorageAIPReplica(v *AIPReplicaResponseBody) *storage.AIPReplica {
+	if v == nil {
+		return nil
+	}
+	res := &storage.AIPReplica{
+		LocationUUID: *v.LocationUUID,
+		Status:       *v.Status,
+		CreatedAt:    *v.CreatedAt,
+		ReplicatedAt: v.ReplicatedAt,
+	}
+
+	return res
+}
+
+// unmarshalAIPLegalHoldResponseBodyToStorageAIPLegalHold builds a value of type
+// *storage.AIPLegalHold from a value of type *AIPLegalHoldResponseBody.
+func unmarshalAIPLegalHoldResponseBodyToStorageAIPLegalHold(v *AIPLegalHoldResponseBody) *storage.AIPLegalHold {
+	if v == nil {
+		return nil
+	}
+	res := &storage.AIPLegalHold{
+		UUID:          *v.UUID,
+		Reason:        *v.Reason,
+		PlacedBy:      *v.PlacedBy,
+		PlacedAt:      *v.PlacedAt,
+		ReleasedBy:    v.ReleasedBy,
+		ReleaseReason: v.ReleaseReason,
+		ReleasedAt:    v.ReleasedAt,
 	}
 
 	return res
@@ -3132,6 +3901,64 @@ func unmarshalAIPTaskUpdatedEventResponseBodyToStorageAIPTaskUpdatedEvent(v *AIP
 	return res
 }
 
+// unmarshalAIPDeletionRequestCreatedEventResponseBodyToStorageAIPDeletionRequestCreatedEvent
+// builds a value of type *storage.AIPDeletionRequestCreatedEvent from a value
+// of type *AIPDeletionRequestCreatedEventResponseBody.
+func unmarshalAIPDeletionRequestCreatedEventResponseBodyToStorageAIPDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEventResponseBody) *storage.AIPDeletionRequestCreatedEvent {
+	if v == nil {
+		return nil
+	}
+	res := &storage.AIPDeletionRequestCreatedEvent{
+		UUID:      *v.UUID,
+		AipUUID:   *v.AipUUID,
+		Status:    *v.Status,
+		Reason:    *v.Reason,
+		Requester: *v.Requester,
+		Reviewer:  v.Reviewer,
+	}
+
+	return res
+}
+
+// unmarshalAIPDeletionRequestUpdatedEventResponseBodyToStorageAIPDeletionRequestUpdatedEvent
+// builds a value of type *storage.AIPDeletionRequestUpdatedEvent from a value
+// of type *AIPDeletionRequestUpdatedEventResponseBody.
+func unmarshalAIPDeletionRequestUpdatedEventResponseBodyToStorageAIPDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEventResponseBody) *storage.AIPDeletionRequestUpdatedEvent {
+	if v == nil {
+		return nil
+	}
+	res := &storage.AIPDeletionRequestUpdatedEvent{
+		UUID:      *v.UUID,
+		AipUUID:   *v.AipUUID,
+		Status:    *v.Status,
+		Reason:    *v.Reason,
+		Requester: *v.Requester,
+		Reviewer:  v.Reviewer,
+	}
+
+	return res
+}
+
+// unmarshalAIPFixityCheckedEventResponseBodyToStorageAIPFixityCheckedEvent
+// builds a value of type *storage.AIPFixityCheckedEvent from a value of type
+// *AIPFixityCheckedEventResponseBody.
+func unmarshalAIPFixityCheckedEventResponseBodyToStorageAIPFixityCheckedEvent(v *AIPFixityCheckedEventResponseBody) *storage.AIPFixityCheckedEvent {
+	if v == nil {
+		return nil
+	}
+	res := &storage.AIPFixityCheckedEvent{
+		UUID:              *v.UUID,
+		AipUUID:           *v.AipUUID,
+		Status:            *v.Status,
+		ChecksumAlgorithm: *v.ChecksumAlgorithm,
+		ExpectedChecksum:  *v.ExpectedChecksum,
+		ActualChecksum:    *v.ActualChecksum,
+		CheckedAt:         *v.CheckedAt,
+	}
+
+	return res
+}
+
 // unmarshalAIPResponseBodyToStorageviewsAIPView builds a value of type
 // *storageviews.AIPView from a value of type *AIPResponseBody.
 func unmarshalAIPResponseBodyToStorageviewsAIPView(v *AIPResponseBody) *storageviews.AIPView {
@@ -3143,6 +3970,74 @@ func unmarshalAIPResponseBodyToStorageviewsAIPView(v *AIPResponseBody) *storagev
 		LocationUUID:      v.LocationUUID,
 		CreatedAt:         v.CreatedAt,
 		DeletionReportKey: v.DeletionReportKey,
+		DisposalAt:        v.DisposalAt,
+		Size:              v.Size,
+		FileCount:         v.FileCount,
+	}
+	if v.Replicas != nil {
+		res.Replicas = make([]*storageviews.AIPReplicaView, len(v.Replicas))
+		for i, val := range v.Replicas {
+			if val == nil {
+				res.Replicas[i] = nil
+				continue
+			}
+			res.Replicas[i] = unmarshalAIPReplicaResponseBodyToStorageviewsAIPReplicaView(val)
+		}
+	}
+	if v.LegalHolds != nil {
+		res.LegalHolds = make([]*storageviews.AIPLegalHoldView, len(v.LegalHolds))
+		for i, val := range v.LegalHolds {
+			if val == nil {
+				res.LegalHolds[i] = nil
+				continue
+			}
+			res.LegalHolds[i] = unmarshalAIPLegalHoldResponseBodyToStorageviewsAIPLegalHoldView(val)
+		}
+	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalAIPReplicaResponseBodyToStorageviewsAIPReplicaView builds a value
+// of type *storageviews.AIPReplicaView from a value of type
+// *AIPReplicaResponseBody.
+func unmarshalAIPReplicaResponseBodyToStorageviewsAIPReplicaView(v *AIPReplicaResponseBody) *storageviews.AIPReplicaView {
+	if v == nil {
+		return nil
+	}
+	res := &storageviews.AIPReplicaView{
+		LocationUUID: v.LocationUUID,
+		Status:       v.Status,
+		CreatedAt:    v.CreatedAt,
+		ReplicatedAt: v.ReplicatedAt,
+	}
+
+	return res
+}
+
+// unmarshalAIPLegalHoldResponseBodyToStorageviewsAIPLegalHoldView builds a
+// value of type *storageviews.AIPLegalHoldView from a value of type
+// *AIPLegalHoldResponseBody.
+func unmarshalAIPLegalHoldResponseBodyToStorageviewsAIPLegalHoldView(v *AIPLegalHoldResponseBody) *storageviews.AIPLegalHoldView {
+	if v == nil {
+		return nil
+	}
+	res := &storageviews.AIPLegalHoldView{
+		UUID:          v.UUID,
+		Reason:        v.Reason,
+		PlacedBy:      v.PlacedBy,
+		PlacedAt:      v.PlacedAt,
+		ReleasedBy:    v.ReleasedBy,
+		ReleaseReason: v.ReleaseReason,
+		ReleasedAt:    v.ReleasedAt,
 	}
 
 	return res
@@ -3221,6 +4116,12 @@ func unmarshalLocationResponseToStorageviewsLocationView(v *LocationResponse) *s
 		UUID:        v.UUID,
 		CreatedAt:   v.CreatedAt,
 	}
+	if v.ReplicationTargets != nil {
+		res.ReplicationTargets = make([]string, len(v.ReplicationTargets))
+		for i, val := range v.ReplicationTargets {
+			res.ReplicationTargets[i] = val
+		}
+	}
 
 	return res
 }
@@ -3240,6 +4141,20 @@ func marshalStorageAMSSConfigToAMSSConfigRequestBody(v *storage.AMSSConfig) *AMS
 	return res
 }
 
+// marshalStorageFilesystemConfigToFilesystemConfigRequestBody builds a value of
+// type *FilesystemConfigRequestBody from a value of type
+// *storage.FilesystemConfig.
+func marshalStorageFilesystemConfigToFilesystemConfigRequestBody(v *storage.FilesystemConfig) *FilesystemConfigRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &FilesystemConfigRequestBody{
+		Path: v.Path,
+	}
+
+	return res
+}
+
 // marshalStorageS3ConfigToS3ConfigRequestBody builds a value of type
 // *S3ConfigRequestBody from a value of type *storage.S3Config.
 func marshalStorageS3ConfigToS3ConfigRequestBody(v *storage.S3Config) *S3ConfigRequestBody {
@@ -3304,6 +4219,20 @@ func marshalAMSSConfigRequestBodyToStorageAMSSConfig(v *AMSSConfigRequestBody) *
 	return res
 }
 
+// marshalFilesystemConfigRequestBodyToStorageFilesystemConfig builds a value of
+// type *storage.FilesystemConfig from a value of type
+// *FilesystemConfigRequestBody.
+func marshalFilesystemConfigRequestBodyToStorageFilesystemConfig(v *FilesystemConfigRequestBody) *storage.FilesystemConfig {
+	if v == nil {
+		return nil
+	}
+	res := &storage.FilesystemConfig{
+		Path: v.Path,
+	}
+
+	return res
+}
+
 // marshalS3ConfigRequestBodyToStorageS3Config builds a value of type
 // *storage.S3Config from a value of type *S3ConfigRequestBody.
 func marshalS3ConfigRequestBodyToStorageS3Config(v *S3ConfigRequestBody) *storage.S3Config {
@@ -3364,6 +4293,107 @@ func unmarshalAIPResponseToStorageviewsAIPView(v *AIPResponse) *storageviews.AIP
 		LocationUUID:      v.LocationUUID,
 		CreatedAt:         v.CreatedAt,
 		DeletionReportKey: v.DeletionReportKey,
+		DisposalAt:        v.DisposalAt,
+		Size:              v.Size,
+		FileCount:         v.FileCount,
+	}
+	if v.Replicas != nil {
+		res.Replicas = make([]*storageviews.AIPReplicaView, len(v.Replicas))
+		for i, val := range v.Replicas {
+			if val == nil {
+				res.Replicas[i] = nil
+				continue
+			}
+			res.Replicas[i] = unmarshalAIPReplicaResponseToStorageviewsAIPReplicaView(val)
+		}
+	}
+	if v.LegalHolds != nil {
+		res.LegalHolds = make([]*storageviews.AIPLegalHoldView, len(v.LegalHolds))
+		for i, val := range v.LegalHolds {
+			if val == nil {
+				res.LegalHolds[i] = nil
+				continue
+			}
+			res.LegalHolds[i] = unmarshalAIPLegalHoldResponseToStorageviewsAIPLegalHoldView(val)
+		}
+	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalAIPReplicaResponseToStorageviewsAIPReplicaView builds a value of
+// type *storageviews.AIPReplicaView from a value of type *AIPReplicaResponse.
+func unmarshalAIPReplicaResponseToStorageviewsAIPReplicaView(v *AIPReplicaResponse) *storageviews.AIPReplicaView {
+	if v == nil {
+		return nil
+	}
+	res := &storageviews.AIPReplicaView{
+		LocationUUID: v.LocationUUID,
+		Status:       v.Status,
+		CreatedAt:    v.CreatedAt,
+		ReplicatedAt: v.ReplicatedAt,
+	}
+
+	return res
+}
+
+// unmarshalAIPLegalHoldResponseToStorageviewsAIPLegalHoldView builds a value of
+// type *storageviews.AIPLegalHoldView from a value of type
+// *AIPLegalHoldResponse.
+func unmarshalAIPLegalHoldResponseToStorageviewsAIPLegalHoldView(v *AIPLegalHoldResponse) *storageviews.AIPLegalHoldView {
+	if v == nil {
+		return nil
+	}
+	res := &storageviews.AIPLegalHoldView{
+		UUID:          v.UUID,
+		Reason:        v.Reason,
+		PlacedBy:      v.PlacedBy,
+		PlacedAt:      v.PlacedAt,
+		ReleasedBy:    v.ReleasedBy,
+		ReleaseReason: v.ReleaseReason,
+		ReleasedAt:    v.ReleasedAt,
+	}
+
+	return res
+}
+
+// unmarshalSearchResultResponseBodyToStorageSearchResult builds a value of type
+// *storage.SearchResult from a value of type *SearchResultResponseBody.
+func unmarshalSearchResultResponseBodyToStorageSearchResult(v *SearchResultResponseBody) *storage.SearchResult {
+	if v == nil {
+		return nil
+	}
+	res := &storage.SearchResult{
+		UUID: *v.UUID,
+		Name: *v.Name,
+	}
+	if v.Fields != nil {
+		res.Fields = make(map[string]string, len(v.Fields))
+		for key, val := range v.Fields {
+			tk := key
+			tv := val
+			res.Fields[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalEnduroPageResponseBodyToStorageEnduroPage builds a value of type
+// *storage.EnduroPage from a value of type *EnduroPageResponseBody.
+func unmarshalEnduroPageResponseBodyToStorageEnduroPage(v *EnduroPageResponseBody) *storage.EnduroPage {
+	res := &storage.EnduroPage{
+		Limit:  *v.Limit,
+		Offset: *v.Offset,
+		Total:  *v.Total,
 	}
 
 	return res
@@ -31,6 +31,10 @@ type CreateAipRequestBody struct {
 	Status string `form:"status" json:"status" xml:"status"`
 	// Identifier of the AIP's storage location
 	LocationUUID *uuid.UUID `form:"location_uuid,omitempty" json:"location_uuid,omitempty" xml:"location_uuid,omitempty"`
+	// Descriptive metadata of the AIP, e.g. Dublin Core fields from its METS file
+	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Custom metadata of the AIP, copied from its SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // MoveAipRequestBody is the type of the "storage" service "move_aip" endpoint
@@ -40,6 +44,17 @@ type MoveAipRequestBody struct {
 	LocationUUID uuid.UUID `form:"location_uuid" json:"location_uuid" xml:"location_uuid"`
 }
 
+// RestoreAipsRequestBody is the type of the "storage" service "restore_aips"
+// endpoint HTTP request body.
+type RestoreAipsRequestBody struct {
+	// Identifiers of the AIPs to restore
+	AipUuids []string `form:"aip_uuids" json:"aip_uuids" xml:"aip_uuids"`
+	// Identifier of the restore location
+	LocationUUID *uuid.UUID `form:"location_uuid,omitempty" json:"location_uuid,omitempty" xml:"location_uuid,omitempty"`
+	// Extract the AIP files in the restore location
+	Unpack *bool `form:"unpack,omitempty" json:"unpack,omitempty" xml:"unpack,omitempty"`
+}
+
 // AipDeletionAutoRequestBody is the type of the "storage" service
 // "aip_deletion_auto" endpoint HTTP request body.
 type AipDeletionAutoRequestBody struct {
@@ -54,6 +69,18 @@ type RequestAipDeletionRequestBody struct {
 	Reason string `form:"reason" json:"reason" xml:"reason"`
 }
 
+// PlaceAipLegalHoldRequestBody is the type of the "storage" service
+// "place_aip_legal_hold" endpoint HTTP request body.
+type PlaceAipLegalHoldRequestBody struct {
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
+// ReleaseAipLegalHoldRequestBody is the type of the "storage" service
+// "release_aip_legal_hold" endpoint HTTP request body.
+type ReleaseAipLegalHoldRequestBody struct {
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
 // ReviewAipDeletionRequestBody is the type of the "storage" service
 // "review_aip_deletion" endpoint HTTP request body.
 type ReviewAipDeletionRequestBody struct {
@@ -76,6 +103,9 @@ type CreateLocationRequestBody struct {
 	Source      string  `form:"source" json:"source" xml:"source"`
 	Purpose     string  `form:"purpose" json:"purpose" xml:"purpose"`
 	Config      Config2 `form:"config,omitempty" json:"config,omitempty" xml:"config,omitempty"`
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string `form:"replication_targets,omitempty" json:"replication_targets,omitempty" xml:"replication_targets,omitempty"`
 }
 
 // MonitorResponseBody is the type of the "storage" service "monitor" endpoint
@@ -105,6 +135,18 @@ type CreateAipResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Deletion report key
 	DeletionReportKey *string `form:"deletion_report_key,omitempty" json:"deletion_report_key,omitempty" xml:"deletion_report_key,omitempty"`
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string `form:"disposal_at,omitempty" json:"disposal_at,omitempty" xml:"disposal_at,omitempty"`
+	// Size of the AIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of files in the AIP
+	FileCount *int `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionResponseBody `form:"replicas,omitempty" json:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionResponseBody `form:"legal_holds,omitempty" json:"legal_holds,omitempty" xml:"legal_holds,omitempty"`
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // MoveAipStatusResponseBody is the type of the "storage" service
@@ -127,6 +169,18 @@ type ShowAipResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Deletion report key
 	DeletionReportKey *string `form:"deletion_report_key,omitempty" json:"deletion_report_key,omitempty" xml:"deletion_report_key,omitempty"`
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string `form:"disposal_at,omitempty" json:"disposal_at,omitempty" xml:"disposal_at,omitempty"`
+	// Size of the AIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of files in the AIP
+	FileCount *int `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionResponseBody `form:"replicas,omitempty" json:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionResponseBody `form:"legal_holds,omitempty" json:"legal_holds,omitempty" xml:"legal_holds,omitempty"`
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // ListAipWorkflowsResponseBody is the type of the "storage" service
@@ -159,12 +213,37 @@ type ShowLocationResponseBody struct {
 	UUID    *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
 	// Creation datetime
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string `form:"replication_targets,omitempty" json:"replication_targets,omitempty" xml:"replication_targets,omitempty"`
+}
+
+// LocationUsageResponseBody is the type of the "storage" service
+// "location_usage" endpoint HTTP response body.
+type LocationUsageResponseBody struct {
+	// Number of AIPs in the location
+	AipCount *int `form:"aip_count,omitempty" json:"aip_count,omitempty" xml:"aip_count,omitempty"`
+	// Total size of the AIPs in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Total number of files in the AIPs
+	FileCount *int64 `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Maximum total size of the AIPs in bytes, if configured
+	Quota *int64 `form:"quota,omitempty" json:"quota,omitempty" xml:"quota,omitempty"`
+	// Whether the quota has been reached and new AIPs are rejected
+	QuotaExceeded *bool `form:"quota_exceeded,omitempty" json:"quota_exceeded,omitempty" xml:"quota_exceeded,omitempty"`
 }
 
 // AIPResponseCollection is the type of the "storage" service
 // "list_location_aips" endpoint HTTP response body.
 type AIPResponseCollection []*AIPResponse
 
+// SearchResponseBody is the type of the "storage" service "search" endpoint
+// HTTP response body.
+type SearchResponseBody struct {
+	Items []*SearchResultResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	Page  *EnduroPageResponseBody     `form:"page,omitempty" json:"page,omitempty" xml:"page,omitempty"`
+}
+
 // MonitorInternalErrorResponseBody is the type of the "storage" service
 // "monitor" endpoint HTTP response body for the "internal_error" error.
 type MonitorInternalErrorResponseBody struct {
@@ -401,6 +480,51 @@ type MoveAipStatusNotFoundResponseBody struct {
 	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
 }
 
+// RestoreAipsNotAvailableResponseBody is the type of the "storage" service
+// "restore_aips" endpoint HTTP response body for the "not_available" error.
+type RestoreAipsNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RestoreAipsNotValidResponseBody is the type of the "storage" service "restore_aips"
+// endpoint HTTP response body for the "not_valid" error.
+type RestoreAipsNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RestoreAipsNotFoundResponseBody is the type of the "storage" service "restore_aips"
+// endpoint HTTP response body for the "not_found" error.
+type RestoreAipsNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing AIP
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+}
+
 // RejectAipNotAvailableResponseBody is the type of the "storage" service
 // "reject_aip" endpoint HTTP response body for the "not_available" error.
 type RejectAipNotAvailableResponseBody struct {
@@ -528,6 +652,42 @@ type RequestAipDeletionNotValidResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// PlaceAipLegalHoldNotValidResponseBody is the type of the "storage" service
+// "place_aip_legal_hold" endpoint HTTP response body for the "not_valid" error.
+type PlaceAipLegalHoldNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReleaseAipLegalHoldNotValidResponseBody is the type of the "storage" service
+// "release_aip_legal_hold" endpoint HTTP response body for the "not_valid" error.
+type ReleaseAipLegalHoldNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // RequestAipDeletionInternalErrorResponseBody is the type of the "storage"
 // service "request_aip_deletion" endpoint HTTP response body for the
 // "internal_error" error.
@@ -547,6 +707,44 @@ type RequestAipDeletionInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// PlaceAipLegalHoldInternalErrorResponseBody is the type of the "storage"
+// service "place_aip_legal_hold" endpoint HTTP response body for the
+// "internal_error" error.
+type PlaceAipLegalHoldInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReleaseAipLegalHoldInternalErrorResponseBody is the type of the "storage"
+// service "release_aip_legal_hold" endpoint HTTP response body for the
+// "internal_error" error.
+type ReleaseAipLegalHoldInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // RequestAipDeletionNotFoundResponseBody is the type of the "storage" service
 // "request_aip_deletion" endpoint HTTP response body for the "not_found" error.
 type RequestAipDeletionNotFoundResponseBody struct {
@@ -556,6 +754,24 @@ type RequestAipDeletionNotFoundResponseBody struct {
 	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
 }
 
+// PlaceAipLegalHoldNotFoundResponseBody is the type of the "storage" service
+// "place_aip_legal_hold" endpoint HTTP response body for the "not_found" error.
+type PlaceAipLegalHoldNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing AIP
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+}
+
+// ReleaseAipLegalHoldNotFoundResponseBody is the type of the "storage" service
+// "release_aip_legal_hold" endpoint HTTP response body for the "not_found" error.
+type ReleaseAipLegalHoldNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing AIP
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+}
+
 // ReviewAipDeletionNotValidResponseBody is the type of the "storage" service
 // "review_aip_deletion" endpoint HTTP response body for the "not_valid" error.
 type ReviewAipDeletionNotValidResponseBody struct {
@@ -812,6 +1028,32 @@ type ListLocationAipsNotFoundResponseBody struct {
 	UUID    *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
 }
 
+// LocationUsageNotFoundResponseBody is the type of the "storage" service
+// "location_usage" endpoint HTTP response body for the "not_found" error.
+type LocationUsageNotFoundResponseBody struct {
+	// Message of error
+	Message *string    `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	UUID    *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+}
+
+// SearchNotValidResponseBody is the type of the "storage" service "search"
+// endpoint HTTP response body for the "not_valid" error.
+type SearchNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // StoragePingEventResponseBody is used to define fields on response body types.
 type StoragePingEventResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
@@ -839,6 +1081,9 @@ type LocationResponseBody struct {
 	Config  Config     `form:"config,omitempty" json:"config,omitempty" xml:"config,omitempty"`
 	// Creation datetime
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string `form:"replication_targets,omitempty" json:"replication_targets,omitempty" xml:"replication_targets,omitempty"`
 }
 
 // AMSSConfigResponseBody is used to define fields on response body types.
@@ -848,6 +1093,11 @@ type AMSSConfigResponseBody struct {
 	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 }
 
+// FilesystemConfigResponseBody is used to define fields on response body types.
+type FilesystemConfigResponseBody struct {
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+}
+
 // S3ConfigResponseBody is used to define fields on response body types.
 type S3ConfigResponseBody struct {
 	Bucket    *string `form:"bucket,omitempty" json:"bucket,omitempty" xml:"bucket,omitempty"`
@@ -893,6 +1143,56 @@ type AIPResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Deletion report key
 	DeletionReportKey *string `form:"deletion_report_key,omitempty" json:"deletion_report_key,omitempty" xml:"deletion_report_key,omitempty"`
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string `form:"disposal_at,omitempty" json:"disposal_at,omitempty" xml:"disposal_at,omitempty"`
+	// Size of the AIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of files in the AIP
+	FileCount *int `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionResponseBody `form:"replicas,omitempty" json:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionResponseBody `form:"legal_holds,omitempty" json:"legal_holds,omitempty" xml:"legal_holds,omitempty"`
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
+}
+
+// AIPReplicaCollectionResponseBody is used to define fields on response body
+// types.
+type AIPReplicaCollectionResponseBody []*AIPReplicaResponseBody
+
+// AIPReplicaResponseBody is used to define fields on response body types.
+type AIPReplicaResponseBody struct {
+	// Identifier of replication location
+	LocationUUID *uuid.UUID `form:"location_uuid,omitempty" json:"location_uuid,omitempty" xml:"location_uuid,omitempty"`
+	// Status of the replica
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Creation datetime
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Replication datetime
+	ReplicatedAt *string `form:"replicated_at,omitempty" json:"replicated_at,omitempty" xml:"replicated_at,omitempty"`
+}
+
+// AIPLegalHoldCollectionResponseBody is used to define fields on response body
+// types.
+type AIPLegalHoldCollectionResponseBody []*AIPLegalHoldResponseBody
+
+// AIPLegalHoldResponseBody is used to define fields on response body types.
+type AIPLegalHoldResponseBody struct {
+	// Identifier of the legal hold
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Reason of the legal hold
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// User who placed the legal hold
+	PlacedBy *string `form:"placed_by,omitempty" json:"placed_by,omitempty" xml:"placed_by,omitempty"`
+	// Placement datetime
+	PlacedAt *string `form:"placed_at,omitempty" json:"placed_at,omitempty" xml:"placed_at,omitempty"`
+	// User who released the legal hold
+	ReleasedBy *string `form:"released_by,omitempty" json:"released_by,omitempty" xml:"released_by,omitempty"`
+	// Reason of the legal hold release
+	ReleaseReason *string `form:"release_reason,omitempty" json:"release_reason,omitempty" xml:"release_reason,omitempty"`
+	// Release datetime
+	ReleasedAt *string `form:"released_at,omitempty" json:"released_at,omitempty" xml:"released_at,omitempty"`
 }
 
 // AIPUpdatedEventResponseBody is used to define fields on response body types.
@@ -980,6 +1280,59 @@ type AIPTaskUpdatedEventResponseBody struct {
 	Item *AIPTaskResponseBody `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
 }
 
+// AIPDeletionRequestCreatedEventResponseBody is used to define fields on
+// response body types.
+type AIPDeletionRequestCreatedEventResponseBody struct {
+	// Identifier of deletion request
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Identifier of AIP
+	AipUUID *uuid.UUID `form:"aip_uuid,omitempty" json:"aip_uuid,omitempty" xml:"aip_uuid,omitempty"`
+	// Status of the deletion request
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Reason for the deletion
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// User who requested the deletion
+	Requester *string `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// User who reviewed the deletion request
+	Reviewer *string `form:"reviewer,omitempty" json:"reviewer,omitempty" xml:"reviewer,omitempty"`
+}
+
+// AIPDeletionRequestUpdatedEventResponseBody is used to define fields on
+// response body types.
+type AIPDeletionRequestUpdatedEventResponseBody struct {
+	// Identifier of deletion request
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Identifier of AIP
+	AipUUID *uuid.UUID `form:"aip_uuid,omitempty" json:"aip_uuid,omitempty" xml:"aip_uuid,omitempty"`
+	// Status of the deletion request
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Reason for the deletion
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// User who requested the deletion
+	Requester *string `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// User who reviewed the deletion request
+	Reviewer *string `form:"reviewer,omitempty" json:"reviewer,omitempty" xml:"reviewer,omitempty"`
+}
+
+// AIPFixityCheckedEventResponseBody is used to define fields on response
+// body types.
+type AIPFixityCheckedEventResponseBody struct {
+	// Identifier of fixity check
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Identifier of AIP
+	AipUUID *uuid.UUID `form:"aip_uuid,omitempty" json:"aip_uuid,omitempty" xml:"aip_uuid,omitempty"`
+	// Result of the fixity check
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Algorithm of the checksums
+	ChecksumAlgorithm *string `form:"checksum_algorithm,omitempty" json:"checksum_algorithm,omitempty" xml:"checksum_algorithm,omitempty"`
+	// Checksum of the AIP stored in Enduro
+	ExpectedChecksum *string `form:"expected_checksum,omitempty" json:"expected_checksum,omitempty" xml:"expected_checksum,omitempty"`
+	// Checksum computed from the stored AIP
+	ActualChecksum *string `form:"actual_checksum,omitempty" json:"actual_checksum,omitempty" xml:"actual_checksum,omitempty"`
+	// Time the fixity check was completed
+	CheckedAt *string `form:"checked_at,omitempty" json:"checked_at,omitempty" xml:"checked_at,omitempty"`
+}
+
 // AIPResponseBodyCollection is used to define fields on response body types.
 type AIPResponseBodyCollection []*AIPResponseBody
 
@@ -1014,6 +1367,9 @@ type LocationResponse struct {
 	UUID    *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
 	// Creation datetime
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string `form:"replication_targets,omitempty" json:"replication_targets,omitempty" xml:"replication_targets,omitempty"`
 }
 
 // AMSSConfigRequestBody is used to define fields on request body types.
@@ -1023,6 +1379,11 @@ type AMSSConfigRequestBody struct {
 	Username string `form:"username" json:"username" xml:"username"`
 }
 
+// FilesystemConfigRequestBody is used to define fields on request body types.
+type FilesystemConfigRequestBody struct {
+	Path string `form:"path" json:"path" xml:"path"`
+}
+
 // S3ConfigRequestBody is used to define fields on request body types.
 type S3ConfigRequestBody struct {
 	Bucket    string  `form:"bucket" json:"bucket" xml:"bucket"`
@@ -1061,15 +1422,76 @@ type AIPResponse struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Deletion report key
 	DeletionReportKey *string `form:"deletion_report_key,omitempty" json:"deletion_report_key,omitempty" xml:"deletion_report_key,omitempty"`
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string `form:"disposal_at,omitempty" json:"disposal_at,omitempty" xml:"disposal_at,omitempty"`
+	// Size of the AIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of files in the AIP
+	FileCount *int `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionResponse `form:"replicas,omitempty" json:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionResponse `form:"legal_holds,omitempty" json:"legal_holds,omitempty" xml:"legal_holds,omitempty"`
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
+}
+
+// AIPReplicaCollectionResponse is used to define fields on response body
+// types.
+type AIPReplicaCollectionResponse []*AIPReplicaResponse
+
+// AIPReplicaResponse is used to define fields on response body types.
+type AIPReplicaResponse struct {
+	// Identifier of replication location
+	LocationUUID *uuid.UUID `form:"location_uuid,omitempty" json:"location_uuid,omitempty" xml:"location_uuid,omitempty"`
+	// Status of the replica
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Creation datetime
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Replication datetime
+	ReplicatedAt *string `form:"replicated_at,omitempty" json:"replicated_at,omitempty" xml:"replicated_at,omitempty"`
+}
+
+// AIPLegalHoldCollectionResponse is used to define fields on response body
+// types.
+type AIPLegalHoldCollectionResponse []*AIPLegalHoldResponse
+
+// AIPLegalHoldResponse is used to define fields on response body types.
+type AIPLegalHoldResponse struct {
+	// Identifier of the legal hold
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Reason of the legal hold
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// User who placed the legal hold
+	PlacedBy *string `form:"placed_by,omitempty" json:"placed_by,omitempty" xml:"placed_by,omitempty"`
+	// Placement datetime
+	PlacedAt *string `form:"placed_at,omitempty" json:"placed_at,omitempty" xml:"placed_at,omitempty"`
+	// User who released the legal hold
+	ReleasedBy *string `form:"released_by,omitempty" json:"released_by,omitempty" xml:"released_by,omitempty"`
+	// Reason of the legal hold release
+	ReleaseReason *string `form:"release_reason,omitempty" json:"release_reason,omitempty" xml:"release_reason,omitempty"`
+	// Release datetime
+	ReleasedAt *string `form:"released_at,omitempty" json:"released_at,omitempty" xml:"released_at,omitempty"`
+}
+
+// SearchResultResponseBody is used to define fields on response body types.
+type SearchResultResponseBody struct {
+	// Identifier of the package
+	UUID *uuid.UUID `form:"uuid,omitempty" json:"uuid,omitempty" xml:"uuid,omitempty"`
+	// Name of the package
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Indexed metadata of the package, keyed by field name
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 }
 
 // Config is a sum-type union.
 type Config struct {
-	kind ConfigKind
-	Amss *AMSSConfigResponseBody
-	S3   *S3ConfigResponseBody
-	Sftp *SFTPConfigResponseBody
-	URL  *URLConfigResponseBody
+	kind       ConfigKind
+	Amss       *AMSSConfigResponseBody
+	Filesystem *FilesystemConfigResponseBody
+	S3         *S3ConfigResponseBody
+	Sftp       *SFTPConfigResponseBody
+	URL        *URLConfigResponseBody
 }
 
 // ConfigKind enumerates the union variants for Config.
@@ -1078,6 +1500,8 @@ type ConfigKind string
 const (
 	// ConfigKindAmss identifies the amss branch of the union.
 	ConfigKindAmss ConfigKind = "amss"
+	// ConfigKindFilesystem identifies the filesystem branch of the union.
+	ConfigKindFilesystem ConfigKind = "filesystem"
 	// ConfigKindS3 identifies the s3 branch of the union.
 	ConfigKindS3 ConfigKind = "s3"
 	// ConfigKindSftp identifies the sftp branch of the union.
@@ -1113,6 +1537,28 @@ func (u *Config) SetAmss(v *AMSSConfigResponseBody) {
 	u.Amss = v
 }
 
+// NewConfigFilesystem constructs a Config with the filesystem branch set.
+func NewConfigFilesystem(v *FilesystemConfigResponseBody) Config {
+	return Config{
+		kind:       ConfigKindFilesystem,
+		Filesystem: v,
+	}
+}
+
+// AsFilesystem returns the value of the filesystem branch if set.
+func (u Config) AsFilesystem() (_ *FilesystemConfigResponseBody, ok bool) {
+	if u.kind != ConfigKindFilesystem {
+		return
+	}
+	return u.Filesystem, true
+}
+
+// SetFilesystem sets the filesystem branch of the union.
+func (u *Config) SetFilesystem(v *FilesystemConfigResponseBody) {
+	u.kind = ConfigKindFilesystem
+	u.Filesystem = v
+}
+
 // NewConfigS3 constructs a Config with the s3 branch set.
 func NewConfigS3(v *S3ConfigResponseBody) Config {
 	return Config{
@@ -1185,12 +1631,15 @@ func (u Config) Validate() error {
 	case "":
 		return goa.InvalidEnumValueError("type", "", []any{
 			string(ConfigKindAmss),
+			string(ConfigKindFilesystem),
 			string(ConfigKindS3),
 			string(ConfigKindSftp),
 			string(ConfigKindURL),
 		})
 	case ConfigKindAmss:
 		return nil
+	case ConfigKindFilesystem:
+		return nil
 	case ConfigKindS3:
 		return nil
 	case ConfigKindSftp:
@@ -1200,6 +1649,7 @@ func (u Config) Validate() error {
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(ConfigKindAmss),
+			string(ConfigKindFilesystem),
 			string(ConfigKindS3),
 			string(ConfigKindSftp),
 			string(ConfigKindURL),
@@ -1218,6 +1668,8 @@ func (u Config) MarshalJSON() ([]byte, error) {
 	switch u.kind {
 	case ConfigKindAmss:
 		value = u.Amss
+	case ConfigKindFilesystem:
+		value = u.Filesystem
 	case ConfigKindS3:
 		value = u.S3
 	case ConfigKindSftp:
@@ -1253,6 +1705,13 @@ func (u *Config) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = ConfigKindAmss
 		u.Amss = v
+	case string(ConfigKindFilesystem):
+		var v *FilesystemConfigResponseBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ConfigKindFilesystem
+		u.Filesystem = v
 	case string(ConfigKindS3):
 		var v *S3ConfigResponseBody
 		if err := json.Unmarshal(raw.Value, &v); err != nil {
@@ -1282,11 +1741,12 @@ func (u *Config) UnmarshalJSON(data []byte) error {
 
 // Config2 is a sum-type union.
 type Config2 struct {
-	kind Config2Kind
-	Amss *AMSSConfigRequestBody
-	S3   *S3ConfigRequestBody
-	Sftp *SFTPConfigRequestBody
-	URL  *URLConfigRequestBody
+	kind       Config2Kind
+	Amss       *AMSSConfigRequestBody
+	Filesystem *FilesystemConfigRequestBody
+	S3         *S3ConfigRequestBody
+	Sftp       *SFTPConfigRequestBody
+	URL        *URLConfigRequestBody
 }
 
 // Config2Kind enumerates the union variants for Config2.
@@ -1295,6 +1755,8 @@ type Config2Kind string
 const (
 	// Config2KindAmss identifies the amss branch of the union.
 	Config2KindAmss Config2Kind = "amss"
+	// Config2KindFilesystem identifies the filesystem branch of the union.
+	Config2KindFilesystem Config2Kind = "filesystem"
 	// Config2KindS3 identifies the s3 branch of the union.
 	Config2KindS3 Config2Kind = "s3"
 	// Config2KindSftp identifies the sftp branch of the union.
@@ -1330,6 +1792,28 @@ func (u *Config2) SetAmss(v *AMSSConfigRequestBody) {
 	u.Amss = v
 }
 
+// NewConfig2Filesystem constructs a Config2 with the filesystem branch set.
+func NewConfig2Filesystem(v *FilesystemConfigRequestBody) Config2 {
+	return Config2{
+		kind:       Config2KindFilesystem,
+		Filesystem: v,
+	}
+}
+
+// AsFilesystem returns the value of the filesystem branch if set.
+func (u Config2) AsFilesystem() (_ *FilesystemConfigRequestBody, ok bool) {
+	if u.kind != Config2KindFilesystem {
+		return
+	}
+	return u.Filesystem, true
+}
+
+// SetFilesystem sets the filesystem branch of the union.
+func (u *Config2) SetFilesystem(v *FilesystemConfigRequestBody) {
+	u.kind = Config2KindFilesystem
+	u.Filesystem = v
+}
+
 // NewConfig2S3 constructs a Config2 with the s3 branch set.
 func NewConfig2S3(v *S3ConfigRequestBody) Config2 {
 	return Config2{
@@ -1402,12 +1886,15 @@ func (u Config2) Validate() error {
 	case "":
 		return goa.InvalidEnumValueError("type", "", []any{
 			string(Config2KindAmss),
+			string(Config2KindFilesystem),
 			string(Config2KindS3),
 			string(Config2KindSftp),
 			string(Config2KindURL),
 		})
 	case Config2KindAmss:
 		return nil
+	case Config2KindFilesystem:
+		return nil
 	case Config2KindS3:
 		return nil
 	case Config2KindSftp:
@@ -1417,6 +1904,7 @@ func (u Config2) Validate() error {
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(Config2KindAmss),
+			string(Config2KindFilesystem),
 			string(Config2KindS3),
 			string(Config2KindSftp),
 			string(Config2KindURL),
@@ -1435,6 +1923,8 @@ func (u Config2) MarshalJSON() ([]byte, error) {
 	switch u.kind {
 	case Config2KindAmss:
 		value = u.Amss
+	case Config2KindFilesystem:
+		value = u.Filesystem
 	case Config2KindS3:
 		value = u.S3
 	case Config2KindSftp:
@@ -1470,6 +1960,13 @@ func (u *Config2) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = Config2KindAmss
 		u.Amss = v
+	case string(Config2KindFilesystem):
+		var v *FilesystemConfigRequestBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = Config2KindFilesystem
+		u.Filesystem = v
 	case string(Config2KindS3):
 		var v *S3ConfigRequestBody
 		if err := json.Unmarshal(raw.Value, &v); err != nil {
@@ -1499,17 +1996,20 @@ func (u *Config2) UnmarshalJSON(data []byte) error {
 
 // Value is a sum-type union.
 type Value struct {
-	kind                    ValueKind
-	StoragePingEvent        *StoragePingEventResponseBody
-	LocationCreatedEvent    *LocationCreatedEventResponseBody
-	AipCreatedEvent         *AIPCreatedEventResponseBody
-	AipUpdatedEvent         *AIPUpdatedEventResponseBody
-	AipStatusUpdatedEvent   *AIPStatusUpdatedEventResponseBody
-	AipLocationUpdatedEvent *AIPLocationUpdatedEventResponseBody
-	AipWorkflowCreatedEvent *AIPWorkflowCreatedEventResponseBody
-	AipWorkflowUpdatedEvent *AIPWorkflowUpdatedEventResponseBody
-	AipTaskCreatedEvent     *AIPTaskCreatedEventResponseBody
-	AipTaskUpdatedEvent     *AIPTaskUpdatedEventResponseBody
+	kind                           ValueKind
+	StoragePingEvent               *StoragePingEventResponseBody
+	LocationCreatedEvent           *LocationCreatedEventResponseBody
+	AipCreatedEvent                *AIPCreatedEventResponseBody
+	AipUpdatedEvent                *AIPUpdatedEventResponseBody
+	AipStatusUpdatedEvent          *AIPStatusUpdatedEventResponseBody
+	AipLocationUpdatedEvent        *AIPLocationUpdatedEventResponseBody
+	AipWorkflowCreatedEvent        *AIPWorkflowCreatedEventResponseBody
+	AipWorkflowUpdatedEvent        *AIPWorkflowUpdatedEventResponseBody
+	AipTaskCreatedEvent            *AIPTaskCreatedEventResponseBody
+	AipTaskUpdatedEvent            *AIPTaskUpdatedEventResponseBody
+	AipDeletionRequestCreatedEvent *AIPDeletionRequestCreatedEventResponseBody
+	AipDeletionRequestUpdatedEvent *AIPDeletionRequestUpdatedEventResponseBody
+	AipFixityCheckedEvent          *AIPFixityCheckedEventResponseBody
 }
 
 // ValueKind enumerates the union variants for Value.
@@ -1536,6 +2036,12 @@ const (
 	ValueKindAipTaskCreatedEvent ValueKind = "aip_task_created_event"
 	// ValueKindAipTaskUpdatedEvent identifies the aip_task_updated_event branch of the union.
 	ValueKindAipTaskUpdatedEvent ValueKind = "aip_task_updated_event"
+	// ValueKindAipDeletionRequestCreatedEvent identifies the aip_deletion_request_created_event branch of the union.
+	ValueKindAipDeletionRequestCreatedEvent ValueKind = "aip_deletion_request_created_event"
+	// ValueKindAipDeletionRequestUpdatedEvent identifies the aip_deletion_request_updated_event branch of the union.
+	ValueKindAipDeletionRequestUpdatedEvent ValueKind = "aip_deletion_request_updated_event"
+	// ValueKindAipFixityCheckedEvent identifies the aip_fixity_checked_event branch of the union.
+	ValueKindAipFixityCheckedEvent ValueKind = "aip_fixity_checked_event"
 )
 
 // Kind returns the discriminator value of the union.
@@ -1763,6 +2269,72 @@ func (u *Value) SetAipTaskUpdatedEvent(v *AIPTaskUpdatedEventResponseBody) {
 	u.AipTaskUpdatedEvent = v
 }
 
+// NewValueAipDeletionRequestCreatedEvent constructs a Value with the aip_deletion_request_created_event branch set.
+func NewValueAipDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEventResponseBody) Value {
+	return Value{
+		kind:                           ValueKindAipDeletionRequestCreatedEvent,
+		AipDeletionRequestCreatedEvent: v,
+	}
+}
+
+// AsAipDeletionRequestCreatedEvent returns the value of the aip_deletion_request_created_event branch if set.
+func (u Value) AsAipDeletionRequestCreatedEvent() (_ *AIPDeletionRequestCreatedEventResponseBody, ok bool) {
+	if u.kind != ValueKindAipDeletionRequestCreatedEvent {
+		return
+	}
+	return u.AipDeletionRequestCreatedEvent, true
+}
+
+// SetAipDeletionRequestCreatedEvent sets the aip_deletion_request_created_event branch of the union.
+func (u *Value) SetAipDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEventResponseBody) {
+	u.kind = ValueKindAipDeletionRequestCreatedEvent
+	u.AipDeletionRequestCreatedEvent = v
+}
+
+// NewValueAipDeletionRequestUpdatedEvent constructs a Value with the aip_deletion_request_updated_event branch set.
+func NewValueAipDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEventResponseBody) Value {
+	return Value{
+		kind:                           ValueKindAipDeletionRequestUpdatedEvent,
+		AipDeletionRequestUpdatedEvent: v,
+	}
+}
+
+// AsAipDeletionRequestUpdatedEvent returns the value of the aip_deletion_request_updated_event branch if set.
+func (u Value) AsAipDeletionRequestUpdatedEvent() (_ *AIPDeletionRequestUpdatedEventResponseBody, ok bool) {
+	if u.kind != ValueKindAipDeletionRequestUpdatedEvent {
+		return
+	}
+	return u.AipDeletionRequestUpdatedEvent, true
+}
+
+// SetAipDeletionRequestUpdatedEvent sets the aip_deletion_request_updated_event branch of the union.
+func (u *Value) SetAipDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEventResponseBody) {
+	u.kind = ValueKindAipDeletionRequestUpdatedEvent
+	u.AipDeletionRequestUpdatedEvent = v
+}
+
+// NewValueAipFixityCheckedEvent constructs a Value with the aip_fixity_checked_event branch set.
+func NewValueAipFixityCheckedEvent(v *AIPFixityCheckedEventResponseBody) Value {
+	return Value{
+		kind:                  ValueKindAipFixityCheckedEvent,
+		AipFixityCheckedEvent: v,
+	}
+}
+
+// AsAipFixityCheckedEvent returns the value of the aip_fixity_checked_event branch if set.
+func (u Value) AsAipFixityCheckedEvent() (_ *AIPFixityCheckedEventResponseBody, ok bool) {
+	if u.kind != ValueKindAipFixityCheckedEvent {
+		return
+	}
+	return u.AipFixityCheckedEvent, true
+}
+
+// SetAipFixityCheckedEvent sets the aip_fixity_checked_event branch of the union.
+func (u *Value) SetAipFixityCheckedEvent(v *AIPFixityCheckedEventResponseBody) {
+	u.kind = ValueKindAipFixityCheckedEvent
+	u.AipFixityCheckedEvent = v
+}
+
 // Validate ensures the union discriminant is valid.
 func (u Value) Validate() error {
 	switch u.kind {
@@ -1778,6 +2350,9 @@ func (u Value) Validate() error {
 			string(ValueKindAipWorkflowUpdatedEvent),
 			string(ValueKindAipTaskCreatedEvent),
 			string(ValueKindAipTaskUpdatedEvent),
+			string(ValueKindAipDeletionRequestCreatedEvent),
+			string(ValueKindAipDeletionRequestUpdatedEvent),
+			string(ValueKindAipFixityCheckedEvent),
 		})
 	case ValueKindStoragePingEvent:
 		return nil
@@ -1799,6 +2374,12 @@ func (u Value) Validate() error {
 		return nil
 	case ValueKindAipTaskUpdatedEvent:
 		return nil
+	case ValueKindAipDeletionRequestCreatedEvent:
+		return nil
+	case ValueKindAipDeletionRequestUpdatedEvent:
+		return nil
+	case ValueKindAipFixityCheckedEvent:
+		return nil
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(ValueKindStoragePingEvent),
@@ -1811,6 +2392,9 @@ func (u Value) Validate() error {
 			string(ValueKindAipWorkflowUpdatedEvent),
 			string(ValueKindAipTaskCreatedEvent),
 			string(ValueKindAipTaskUpdatedEvent),
+			string(ValueKindAipDeletionRequestCreatedEvent),
+			string(ValueKindAipDeletionRequestUpdatedEvent),
+			string(ValueKindAipFixityCheckedEvent),
 		})
 	}
 }
@@ -1844,6 +2428,12 @@ func (u Value) MarshalJSON() ([]byte, error) {
 		value = u.AipTaskCreatedEvent
 	case ValueKindAipTaskUpdatedEvent:
 		value = u.AipTaskUpdatedEvent
+	case ValueKindAipDeletionRequestCreatedEvent:
+		value = u.AipDeletionRequestCreatedEvent
+	case ValueKindAipDeletionRequestUpdatedEvent:
+		value = u.AipDeletionRequestUpdatedEvent
+	case ValueKindAipFixityCheckedEvent:
+		value = u.AipFixityCheckedEvent
 	default:
 		return nil, fmt.Errorf("unexpected Value discriminant %q", u.kind)
 	}
@@ -1936,6 +2526,27 @@ func (u *Value) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = ValueKindAipTaskUpdatedEvent
 		u.AipTaskUpdatedEvent = v
+	case string(ValueKindAipDeletionRequestCreatedEvent):
+		var v *AIPDeletionRequestCreatedEventResponseBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipDeletionRequestCreatedEvent
+		u.AipDeletionRequestCreatedEvent = v
+	case string(ValueKindAipDeletionRequestUpdatedEvent):
+		var v *AIPDeletionRequestUpdatedEventResponseBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipDeletionRequestUpdatedEvent
+		u.AipDeletionRequestUpdatedEvent = v
+	case string(ValueKindAipFixityCheckedEvent):
+		var v *AIPFixityCheckedEventResponseBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipFixityCheckedEvent
+		u.AipFixityCheckedEvent = v
 	default:
 		return fmt.Errorf("unexpected Value type %q", raw.Type)
 	}
@@ -1952,6 +2563,22 @@ func NewCreateAipRequestBody(p *storage.CreateAipPayload) *CreateAipRequestBody
 		Status:       p.Status,
 		LocationUUID: p.LocationUUID,
 	}
+	if p.Metadata != nil {
+		body.Metadata = make(map[string]string, len(p.Metadata))
+		for key, val := range p.Metadata {
+			tk := key
+			tv := val
+			body.Metadata[tk] = tv
+		}
+	}
+	if p.CustomMetadata != nil {
+		body.CustomMetadata = make(map[string]any, len(p.CustomMetadata))
+		for key, val := range p.CustomMetadata {
+			tk := key
+			tv := val
+			body.CustomMetadata[tk] = tv
+		}
+	}
 	{
 		var zero string
 		if body.Status == zero {
@@ -1970,7 +2597,25 @@ func NewMoveAipRequestBody(p *storage.MoveAipPayload) *MoveAipRequestBody {
 	return body
 }
 
-// NewAipDeletionAutoRequestBody builds the HTTP request body from the payload
+// NewRestoreAipsRequestBody builds the HTTP request body from the payload of
+// the "restore_aips" endpoint of the "storage" service.
+func NewRestoreAipsRequestBody(p *storage.RestoreAipsPayload) *RestoreAipsRequestBody {
+	body := &RestoreAipsRequestBody{
+		LocationUUID: p.LocationUUID,
+		Unpack:       p.Unpack,
+	}
+	if p.AipUuids != nil {
+		body.AipUuids = make([]string, len(p.AipUuids))
+		for i, val := range p.AipUuids {
+			body.AipUuids[i] = val
+		}
+	} else {
+		body.AipUuids = []string{}
+	}
+	return body
+}
+
+// NewAipDeletionAutoRequestBody builds the HTTP request body from the payload
 // of the "aip_deletion_auto" endpoint of the "storage" service.
 func NewAipDeletionAutoRequestBody(p *storage.AipDeletionAutoPayload) *AipDeletionAutoRequestBody {
 	body := &AipDeletionAutoRequestBody{
@@ -1989,6 +2634,24 @@ func NewRequestAipDeletionRequestBody(p *storage.RequestAipDeletionPayload) *Req
 	return body
 }
 
+// NewPlaceAipLegalHoldRequestBody builds the HTTP request body from the
+// payload of the "place_aip_legal_hold" endpoint of the "storage" service.
+func NewPlaceAipLegalHoldRequestBody(p *storage.PlaceAipLegalHoldPayload) *PlaceAipLegalHoldRequestBody {
+	body := &PlaceAipLegalHoldRequestBody{
+		Reason: p.Reason,
+	}
+	return body
+}
+
+// NewReleaseAipLegalHoldRequestBody builds the HTTP request body from the
+// payload of the "release_aip_legal_hold" endpoint of the "storage" service.
+func NewReleaseAipLegalHoldRequestBody(p *storage.ReleaseAipLegalHoldPayload) *ReleaseAipLegalHoldRequestBody {
+	body := &ReleaseAipLegalHoldRequestBody{
+		Reason: p.Reason,
+	}
+	return body
+}
+
 // NewReviewAipDeletionRequestBody builds the HTTP request body from the
 // payload of the "review_aip_deletion" endpoint of the "storage" service.
 func NewReviewAipDeletionRequestBody(p *storage.ReviewAipDeletionPayload) *ReviewAipDeletionRequestBody {
@@ -2024,6 +2687,12 @@ func NewCreateLocationRequestBody(p *storage.CreateLocationPayload) *CreateLocat
 			u := body.Config
 			u.SetAmss((*AMSSConfigRequestBody)(obj))
 			body.Config = u
+		case "filesystem":
+			actual, _ := p.Config.AsFilesystem()
+			obj := marshalStorageFilesystemConfigToFilesystemConfigRequestBody(actual)
+			u := body.Config
+			u.SetFilesystem((*FilesystemConfigRequestBody)(obj))
+			body.Config = u
 		case "s3":
 			actual, _ := p.Config.AsS3()
 			obj := marshalStorageS3ConfigToS3ConfigRequestBody(actual)
@@ -2044,6 +2713,12 @@ func NewCreateLocationRequestBody(p *storage.CreateLocationPayload) *CreateLocat
 			body.Config = u
 		}
 	}
+	if p.ReplicationTargets != nil {
+		body.ReplicationTargets = make([]string, len(p.ReplicationTargets))
+		for i, val := range p.ReplicationTargets {
+			body.ReplicationTargets[i] = val
+		}
+	}
 	return body
 }
 
@@ -2113,6 +2788,24 @@ func NewMonitorStorageEventOK(body *MonitorResponseBody) *storage.StorageEvent {
 			u := v.Value
 			u.SetAipTaskUpdatedEvent((*storage.AIPTaskUpdatedEvent)(obj))
 			v.Value = u
+		case "aip_deletion_request_created_event":
+			actual, _ := body.Value.AsAipDeletionRequestCreatedEvent()
+			obj := unmarshalAIPDeletionRequestCreatedEventResponseBodyToStorageAIPDeletionRequestCreatedEvent(actual)
+			u := v.Value
+			u.SetAipDeletionRequestCreatedEvent((*storage.AIPDeletionRequestCreatedEvent)(obj))
+			v.Value = u
+		case "aip_deletion_request_updated_event":
+			actual, _ := body.Value.AsAipDeletionRequestUpdatedEvent()
+			obj := unmarshalAIPDeletionRequestUpdatedEventResponseBodyToStorageAIPDeletionRequestUpdatedEvent(actual)
+			u := v.Value
+			u.SetAipDeletionRequestUpdatedEvent((*storage.AIPDeletionRequestUpdatedEvent)(obj))
+			v.Value = u
+		case "aip_fixity_checked_event":
+			actual, _ := body.Value.AsAipFixityCheckedEvent()
+			obj := unmarshalAIPFixityCheckedEventResponseBodyToStorageAIPFixityCheckedEvent(actual)
+			u := v.Value
+			u.SetAipFixityCheckedEvent((*storage.AIPFixityCheckedEvent)(obj))
+			v.Value = u
 		}
 	}
 
@@ -2224,6 +2917,37 @@ func NewCreateAipAIPOK(body *CreateAipResponseBody) *storageviews.AIPView {
 		LocationUUID:      body.LocationUUID,
 		CreatedAt:         body.CreatedAt,
 		DeletionReportKey: body.DeletionReportKey,
+		DisposalAt:        body.DisposalAt,
+		Size:              body.Size,
+		FileCount:         body.FileCount,
+	}
+	if body.Replicas != nil {
+		v.Replicas = make([]*storageviews.AIPReplicaView, len(body.Replicas))
+		for i, val := range body.Replicas {
+			if val == nil {
+				v.Replicas[i] = nil
+				continue
+			}
+			v.Replicas[i] = unmarshalAIPReplicaResponseBodyToStorageviewsAIPReplicaView(val)
+		}
+	}
+	if body.LegalHolds != nil {
+		v.LegalHolds = make([]*storageviews.AIPLegalHoldView, len(body.LegalHolds))
+		for i, val := range body.LegalHolds {
+			if val == nil {
+				v.LegalHolds[i] = nil
+				continue
+			}
+			v.LegalHolds[i] = unmarshalAIPLegalHoldResponseBodyToStorageviewsAIPLegalHoldView(val)
+		}
+	}
+	if body.CustomMetadata != nil {
+		v.CustomMetadata = make(map[string]any, len(body.CustomMetadata))
+		for key, val := range body.CustomMetadata {
+			tk := key
+			tv := val
+			v.CustomMetadata[tk] = tv
+		}
 	}
 
 	return v
@@ -2503,6 +3227,63 @@ func NewMoveAipStatusUnauthorized(body string) storage.Unauthorized {
 	return v
 }
 
+// NewRestoreAipsNotAvailable builds a storage service restore_aips endpoint
+// not_available error.
+func NewRestoreAipsNotAvailable(body *RestoreAipsNotAvailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRestoreAipsNotValid builds a storage service restore_aips endpoint not_valid
+// error.
+func NewRestoreAipsNotValid(body *RestoreAipsNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRestoreAipsNotFound builds a storage service restore_aips endpoint not_found
+// error.
+func NewRestoreAipsNotFound(body *RestoreAipsNotFoundResponseBody) *storage.AIPNotFound {
+	v := &storage.AIPNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
+
+	return v
+}
+
+// NewRestoreAipsForbidden builds a storage service restore_aips endpoint forbidden
+// error.
+func NewRestoreAipsForbidden(body string) storage.Forbidden {
+	v := storage.Forbidden(body)
+
+	return v
+}
+
+// NewRestoreAipsUnauthorized builds a storage service restore_aips endpoint
+// unauthorized error.
+func NewRestoreAipsUnauthorized(body string) storage.Unauthorized {
+	v := storage.Unauthorized(body)
+
+	return v
+}
+
 // NewRejectAipNotAvailable builds a storage service reject_aip endpoint
 // not_available error.
 func NewRejectAipNotAvailable(body *RejectAipNotAvailableResponseBody) *goa.ServiceError {
@@ -2571,6 +3352,37 @@ func NewShowAipAIPOK(body *ShowAipResponseBody) *storageviews.AIPView {
 		LocationUUID:      body.LocationUUID,
 		CreatedAt:         body.CreatedAt,
 		DeletionReportKey: body.DeletionReportKey,
+		DisposalAt:        body.DisposalAt,
+		Size:              body.Size,
+		FileCount:         body.FileCount,
+	}
+	if body.Replicas != nil {
+		v.Replicas = make([]*storageviews.AIPReplicaView, len(body.Replicas))
+		for i, val := range body.Replicas {
+			if val == nil {
+				v.Replicas[i] = nil
+				continue
+			}
+			v.Replicas[i] = unmarshalAIPReplicaResponseBodyToStorageviewsAIPReplicaView(val)
+		}
+	}
+	if body.LegalHolds != nil {
+		v.LegalHolds = make([]*storageviews.AIPLegalHoldView, len(body.LegalHolds))
+		for i, val := range body.LegalHolds {
+			if val == nil {
+				v.LegalHolds[i] = nil
+				continue
+			}
+			v.LegalHolds[i] = unmarshalAIPLegalHoldResponseBodyToStorageviewsAIPLegalHoldView(val)
+		}
+	}
+	if body.CustomMetadata != nil {
+		v.CustomMetadata = make(map[string]any, len(body.CustomMetadata))
+		for key, val := range body.CustomMetadata {
+			tk := key
+			tv := val
+			v.CustomMetadata[tk] = tv
+		}
 	}
 
 	return v
@@ -2720,6 +3532,36 @@ func NewRequestAipDeletionNotValid(body *RequestAipDeletionNotValidResponseBody)
 	return v
 }
 
+// NewPlaceAipLegalHoldNotValid builds a storage service place_aip_legal_hold
+// endpoint not_valid error.
+func NewPlaceAipLegalHoldNotValid(body *PlaceAipLegalHoldNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReleaseAipLegalHoldNotValid builds a storage service release_aip_legal_hold
+// endpoint not_valid error.
+func NewReleaseAipLegalHoldNotValid(body *ReleaseAipLegalHoldNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // NewRequestAipDeletionInternalError builds a storage service
 // request_aip_deletion endpoint internal_error error.
 func NewRequestAipDeletionInternalError(body *RequestAipDeletionInternalErrorResponseBody) *goa.ServiceError {
@@ -2735,6 +3577,36 @@ func NewRequestAipDeletionInternalError(body *RequestAipDeletionInternalErrorRes
 	return v
 }
 
+// NewPlaceAipLegalHoldInternalError builds a storage service
+// place_aip_legal_hold endpoint internal_error error.
+func NewPlaceAipLegalHoldInternalError(body *PlaceAipLegalHoldInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReleaseAipLegalHoldInternalError builds a storage service
+// release_aip_legal_hold endpoint internal_error error.
+func NewReleaseAipLegalHoldInternalError(body *ReleaseAipLegalHoldInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // NewRequestAipDeletionNotFound builds a storage service request_aip_deletion
 // endpoint not_found error.
 func NewRequestAipDeletionNotFound(body *RequestAipDeletionNotFoundResponseBody) *storage.AIPNotFound {
@@ -2746,6 +3618,28 @@ func NewRequestAipDeletionNotFound(body *RequestAipDeletionNotFoundResponseBody)
 	return v
 }
 
+// NewPlaceAipLegalHoldNotFound builds a storage service place_aip_legal_hold
+// endpoint not_found error.
+func NewPlaceAipLegalHoldNotFound(body *PlaceAipLegalHoldNotFoundResponseBody) *storage.AIPNotFound {
+	v := &storage.AIPNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
+
+	return v
+}
+
+// NewReleaseAipLegalHoldNotFound builds a storage service release_aip_legal_hold
+// endpoint not_found error.
+func NewReleaseAipLegalHoldNotFound(body *ReleaseAipLegalHoldNotFoundResponseBody) *storage.AIPNotFound {
+	v := &storage.AIPNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
+
+	return v
+}
+
 // NewRequestAipDeletionForbidden builds a storage service request_aip_deletion
 // endpoint forbidden error.
 func NewRequestAipDeletionForbidden(body string) storage.Forbidden {
@@ -2754,6 +3648,22 @@ func NewRequestAipDeletionForbidden(body string) storage.Forbidden {
 	return v
 }
 
+// NewPlaceAipLegalHoldForbidden builds a storage service place_aip_legal_hold
+// endpoint forbidden error.
+func NewPlaceAipLegalHoldForbidden(body string) storage.Forbidden {
+	v := storage.Forbidden(body)
+
+	return v
+}
+
+// NewReleaseAipLegalHoldForbidden builds a storage service release_aip_legal_hold
+// endpoint forbidden error.
+func NewReleaseAipLegalHoldForbidden(body string) storage.Forbidden {
+	v := storage.Forbidden(body)
+
+	return v
+}
+
 // NewRequestAipDeletionUnauthorized builds a storage service
 // request_aip_deletion endpoint unauthorized error.
 func NewRequestAipDeletionUnauthorized(body string) storage.Unauthorized {
@@ -2762,6 +3672,22 @@ func NewRequestAipDeletionUnauthorized(body string) storage.Unauthorized {
 	return v
 }
 
+// NewPlaceAipLegalHoldUnauthorized builds a storage service
+// place_aip_legal_hold endpoint unauthorized error.
+func NewPlaceAipLegalHoldUnauthorized(body string) storage.Unauthorized {
+	v := storage.Unauthorized(body)
+
+	return v
+}
+
+// NewReleaseAipLegalHoldUnauthorized builds a storage service
+// release_aip_legal_hold endpoint unauthorized error.
+func NewReleaseAipLegalHoldUnauthorized(body string) storage.Unauthorized {
+	v := storage.Unauthorized(body)
+
+	return v
+}
+
 // NewReviewAipDeletionNotValid builds a storage service review_aip_deletion
 // endpoint not_valid error.
 func NewReviewAipDeletionNotValid(body *ReviewAipDeletionNotValidResponseBody) *goa.ServiceError {
@@ -3101,6 +4027,12 @@ func NewShowLocationLocationOK(body *ShowLocationResponseBody) *storageviews.Loc
 		UUID:        body.UUID,
 		CreatedAt:   body.CreatedAt,
 	}
+	if body.ReplicationTargets != nil {
+		v.ReplicationTargets = make([]string, len(body.ReplicationTargets))
+		for i, val := range body.ReplicationTargets {
+			v.ReplicationTargets[i] = val
+		}
+	}
 
 	return v
 }
@@ -3189,6 +4121,93 @@ func NewListLocationAipsUnauthorized(body string) storage.Unauthorized {
 	return v
 }
 
+// NewLocationUsageOK builds a "storage" service "location_usage" endpoint
+// result from a HTTP "OK" response.
+func NewLocationUsageOK(body *LocationUsageResponseBody) *storage.LocationUsage {
+	v := &storage.LocationUsage{
+		AipCount:      *body.AipCount,
+		Size:          *body.Size,
+		FileCount:     *body.FileCount,
+		Quota:         body.Quota,
+		QuotaExceeded: *body.QuotaExceeded,
+	}
+
+	return v
+}
+
+// NewLocationUsageNotFound builds a storage service location_usage endpoint
+// not_found error.
+func NewLocationUsageNotFound(body *LocationUsageNotFoundResponseBody) *storage.LocationNotFound {
+	v := &storage.LocationNotFound{
+		Message: *body.Message,
+		UUID:    *body.UUID,
+	}
+
+	return v
+}
+
+// NewLocationUsageForbidden builds a storage service location_usage endpoint
+// forbidden error.
+func NewLocationUsageForbidden(body string) storage.Forbidden {
+	v := storage.Forbidden(body)
+
+	return v
+}
+
+// NewLocationUsageUnauthorized builds a storage service location_usage
+// endpoint unauthorized error.
+func NewLocationUsageUnauthorized(body string) storage.Unauthorized {
+	v := storage.Unauthorized(body)
+
+	return v
+}
+
+// NewSearchResultsOK builds a "storage" service "search" endpoint result from a
+// HTTP "OK" response.
+func NewSearchResultsOK(body *SearchResponseBody) *storage.SearchResults {
+	v := &storage.SearchResults{}
+	v.Items = make([]*storage.SearchResult, len(body.Items))
+	for i, val := range body.Items {
+		if val == nil {
+			v.Items[i] = nil
+			continue
+		}
+		v.Items[i] = unmarshalSearchResultResponseBodyToStorageSearchResult(val)
+	}
+	v.Page = unmarshalEnduroPageResponseBodyToStorageEnduroPage(body.Page)
+
+	return v
+}
+
+// NewSearchNotValid builds a storage service search endpoint not_valid error.
+func NewSearchNotValid(body *SearchNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSearchForbidden builds a storage service search endpoint forbidden error.
+func NewSearchForbidden(body string) storage.Forbidden {
+	v := storage.Forbidden(body)
+
+	return v
+}
+
+// NewSearchUnauthorized builds a storage service search endpoint unauthorized
+// error.
+func NewSearchUnauthorized(body string) storage.Unauthorized {
+	v := storage.Unauthorized(body)
+
+	return v
+}
+
 // ValidateMonitorResponseBody runs the validations defined on
 // MonitorResponseBody
 func ValidateMonitorResponseBody(body *MonitorResponseBody) (err error) {
@@ -3256,10 +4275,31 @@ func ValidateMonitorResponseBody(body *MonitorResponseBody) (err error) {
 				err = goa.MergeErrors(err, err2)
 			}
 		}
-	}
-
-	return
-}
+	case "aip_deletion_request_created_event":
+		actual, _ := body.Value.AsAipDeletionRequestCreatedEvent()
+		if actual != nil {
+			if err2 := ValidateAIPDeletionRequestCreatedEventResponseBody(actual); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	case "aip_deletion_request_updated_event":
+		actual, _ := body.Value.AsAipDeletionRequestUpdatedEvent()
+		if actual != nil {
+			if err2 := ValidateAIPDeletionRequestUpdatedEventResponseBody(actual); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	case "aip_fixity_checked_event":
+		actual, _ := body.Value.AsAipFixityCheckedEvent()
+		if actual != nil {
+			if err2 := ValidateAIPFixityCheckedEventResponseBody(actual); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+
+	return
+}
 
 // ValidateMoveAipStatusResponseBody runs the validations defined on
 // move_aip_status_response_body
@@ -3279,6 +4319,48 @@ func ValidateCreateLocationResponseBody(body *CreateLocationResponseBody) (err e
 	return
 }
 
+// ValidateLocationUsageResponseBody runs the validations defined on
+// location_usage_response_body
+func ValidateLocationUsageResponseBody(body *LocationUsageResponseBody) (err error) {
+	if body.AipCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("aip_count", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.FileCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("file_count", "body"))
+	}
+	if body.QuotaExceeded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quota_exceeded", "body"))
+	}
+	return
+}
+
+// ValidateSearchResponseBody runs the validations defined on
+// search_response_body
+func ValidateSearchResponseBody(body *SearchResponseBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	if body.Page == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("page", "body"))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateSearchResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Page != nil {
+		if err2 := ValidateEnduroPageResponseBody(body.Page); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
 // ValidateMonitorInternalErrorResponseBody runs the validations defined on
 // monitor_internal_error_response_body
 func ValidateMonitorInternalErrorResponseBody(body *MonitorInternalErrorResponseBody) (err error) {
@@ -3591,6 +4673,66 @@ func ValidateMoveAipStatusNotFoundResponseBody(body *MoveAipStatusNotFoundRespon
 	return
 }
 
+// ValidateRestoreAipsNotAvailableResponseBody runs the validations defined on
+// restore_aips_not_available_response_body
+func ValidateRestoreAipsNotAvailableResponseBody(body *RestoreAipsNotAvailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRestoreAipsNotValidResponseBody runs the validations defined on
+// restore_aips_not_valid_response_body
+func ValidateRestoreAipsNotValidResponseBody(body *RestoreAipsNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRestoreAipsNotFoundResponseBody runs the validations defined on
+// restore_aips_not_found_response_body
+func ValidateRestoreAipsNotFoundResponseBody(body *RestoreAipsNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	return
+}
+
 // ValidateRejectAipNotAvailableResponseBody runs the validations defined on
 // reject_aip_not_available_response_body
 func ValidateRejectAipNotAvailableResponseBody(body *RejectAipNotAvailableResponseBody) (err error) {
@@ -3759,6 +4901,54 @@ func ValidateRequestAipDeletionNotValidResponseBody(body *RequestAipDeletionNotV
 	return
 }
 
+// ValidatePlaceAipLegalHoldNotValidResponseBody runs the validations defined
+// on place_aip_legal_hold_not_valid_response_body
+func ValidatePlaceAipLegalHoldNotValidResponseBody(body *PlaceAipLegalHoldNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateReleaseAipLegalHoldNotValidResponseBody runs the validations defined
+// on release_aip_legal_hold_not_valid_response_body
+func ValidateReleaseAipLegalHoldNotValidResponseBody(body *ReleaseAipLegalHoldNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateRequestAipDeletionInternalErrorResponseBody runs the validations
 // defined on request_aip_deletion_internal_error_response_body
 func ValidateRequestAipDeletionInternalErrorResponseBody(body *RequestAipDeletionInternalErrorResponseBody) (err error) {
@@ -3783,6 +4973,54 @@ func ValidateRequestAipDeletionInternalErrorResponseBody(body *RequestAipDeletio
 	return
 }
 
+// ValidatePlaceAipLegalHoldInternalErrorResponseBody runs the validations
+// defined on place_aip_legal_hold_internal_error_response_body
+func ValidatePlaceAipLegalHoldInternalErrorResponseBody(body *PlaceAipLegalHoldInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateReleaseAipLegalHoldInternalErrorResponseBody runs the validations
+// defined on release_aip_legal_hold_internal_error_response_body
+func ValidateReleaseAipLegalHoldInternalErrorResponseBody(body *ReleaseAipLegalHoldInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateRequestAipDeletionNotFoundResponseBody runs the validations defined
 // on request_aip_deletion_not_found_response_body
 func ValidateRequestAipDeletionNotFoundResponseBody(body *RequestAipDeletionNotFoundResponseBody) (err error) {
@@ -3795,6 +5033,30 @@ func ValidateRequestAipDeletionNotFoundResponseBody(body *RequestAipDeletionNotF
 	return
 }
 
+// ValidatePlaceAipLegalHoldNotFoundResponseBody runs the validations defined
+// on place_aip_legal_hold_not_found_response_body
+func ValidatePlaceAipLegalHoldNotFoundResponseBody(body *PlaceAipLegalHoldNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	return
+}
+
+// ValidateReleaseAipLegalHoldNotFoundResponseBody runs the validations defined
+// on release_aip_legal_hold_not_found_response_body
+func ValidateReleaseAipLegalHoldNotFoundResponseBody(body *ReleaseAipLegalHoldNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	return
+}
+
 // ValidateReviewAipDeletionNotValidResponseBody runs the validations defined
 // on review_aip_deletion_not_valid_response_body
 func ValidateReviewAipDeletionNotValidResponseBody(body *ReviewAipDeletionNotValidResponseBody) (err error) {
@@ -4132,6 +5394,42 @@ func ValidateListLocationAipsNotFoundResponseBody(body *ListLocationAipsNotFound
 	return
 }
 
+// ValidateLocationUsageNotFoundResponseBody runs the validations defined on
+// location_usage_not_found_response_body
+func ValidateLocationUsageNotFoundResponseBody(body *LocationUsageNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	return
+}
+
+// ValidateSearchNotValidResponseBody runs the validations defined on
+// search_not_valid_response_body
+func ValidateSearchNotValidResponseBody(body *SearchNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateLocationCreatedEventResponseBody runs the validations defined on
 // LocationCreatedEventResponseBody
 func ValidateLocationCreatedEventResponseBody(body *LocationCreatedEventResponseBody) (err error) {
@@ -4173,8 +5471,8 @@ func ValidateLocationResponseBody(body *LocationResponseBody) (err error) {
 		}
 	}
 	if body.Purpose != nil {
-		if !(*body.Purpose == "unspecified" || *body.Purpose == "aip_store") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.purpose", *body.Purpose, []any{"unspecified", "aip_store"}))
+		if !(*body.Purpose == "unspecified" || *body.Purpose == "aip_store" || *body.Purpose == "restore") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.purpose", *body.Purpose, []any{"unspecified", "aip_store", "restore"}))
 		}
 	}
 	switch string(body.Config.Kind()) {
@@ -4185,6 +5483,13 @@ func ValidateLocationResponseBody(body *LocationResponseBody) (err error) {
 				err = goa.MergeErrors(err, err2)
 			}
 		}
+	case "filesystem":
+		actual, _ := body.Config.AsFilesystem()
+		if actual != nil {
+			if err2 := ValidateFilesystemConfigResponseBody(actual); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	case "s3":
 		actual, _ := body.Config.AsS3()
 		if actual != nil {
@@ -4211,6 +5516,9 @@ func ValidateLocationResponseBody(body *LocationResponseBody) (err error) {
 	if body.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
+	for _, e := range body.ReplicationTargets {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replication_targets[*]", e, goa.FormatUUID))
+	}
 	return
 }
 
@@ -4229,6 +5537,15 @@ func ValidateAMSSConfigResponseBody(body *AMSSConfigResponseBody) (err error) {
 	return
 }
 
+// ValidateFilesystemConfigResponseBody runs the validations defined on
+// FilesystemConfigResponseBody
+func ValidateFilesystemConfigResponseBody(body *FilesystemConfigResponseBody) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	return
+}
+
 // ValidateS3ConfigResponseBody runs the validations defined on
 // S3ConfigResponseBody
 func ValidateS3ConfigResponseBody(body *S3ConfigResponseBody) (err error) {
@@ -4310,6 +5627,94 @@ func ValidateAIPResponseBody(body *AIPResponseBody) (err error) {
 	if body.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
+	if body.DisposalAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.disposal_at", *body.DisposalAt, goa.FormatDateTime))
+	}
+	if body.Replicas != nil {
+		if err2 := ValidateAIPReplicaCollectionResponseBody(body.Replicas); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.LegalHolds != nil {
+		if err2 := ValidateAIPLegalHoldCollectionResponseBody(body.LegalHolds); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateAIPReplicaCollectionResponseBody runs the validations defined on
+// AIPReplicaCollectionResponseBody
+func ValidateAIPReplicaCollectionResponseBody(body AIPReplicaCollectionResponseBody) (err error) {
+	for _, e := range body {
+		if e != nil {
+			if err2 := ValidateAIPReplicaResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAIPReplicaResponseBody runs the validations defined on AIPReplicaResponseBody
+func ValidateAIPReplicaResponseBody(body *AIPReplicaResponseBody) (err error) {
+	if body.LocationUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("location_uuid", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "replicated" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "replicated", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ReplicatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replicated_at", *body.ReplicatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateAIPLegalHoldCollectionResponseBody runs the validations defined on
+// AIPLegalHoldCollectionResponseBody
+func ValidateAIPLegalHoldCollectionResponseBody(body AIPLegalHoldCollectionResponseBody) (err error) {
+	for _, e := range body {
+		if e != nil {
+			if err2 := ValidateAIPLegalHoldResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAIPLegalHoldResponseBody runs the validations defined on
+// AIPLegalHoldResponseBody
+func ValidateAIPLegalHoldResponseBody(body *AIPLegalHoldResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.PlacedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_by", "body"))
+	}
+	if body.PlacedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_at", "body"))
+	}
+	if body.PlacedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.placed_at", *body.PlacedAt, goa.FormatDateTime))
+	}
+	if body.ReleasedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.released_at", *body.ReleasedAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -4395,8 +5800,8 @@ func ValidateAIPWorkflowResponseBody(body *AIPWorkflowResponseBody) (err error)
 		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "body"))
 	}
 	if body.Type != nil {
-		if !(*body.Type == "unspecified" || *body.Type == "upload aip" || *body.Type == "move aip" || *body.Type == "delete aip") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"unspecified", "upload aip", "move aip", "delete aip"}))
+		if !(*body.Type == "unspecified" || *body.Type == "upload aip" || *body.Type == "move aip" || *body.Type == "delete aip" || *body.Type == "audit aip" || *body.Type == "replicate aip" || *body.Type == "restore aip") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"unspecified", "upload aip", "move aip", "delete aip", "audit aip", "replicate aip", "restore aip"}))
 		}
 	}
 	if body.Status != nil {
@@ -4511,6 +5916,93 @@ func ValidateAIPTaskUpdatedEventResponseBody(body *AIPTaskUpdatedEventResponseBo
 	return
 }
 
+// ValidateAIPDeletionRequestCreatedEventResponseBody runs the validations
+// defined on AIPDeletionRequestCreatedEventResponseBody
+func ValidateAIPDeletionRequestCreatedEventResponseBody(body *AIPDeletionRequestCreatedEventResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.AipUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.Requester == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requester", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "approved" || *body.Status == "rejected" || *body.Status == "canceled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "approved", "rejected", "canceled"}))
+		}
+	}
+	return
+}
+
+// ValidateAIPDeletionRequestUpdatedEventResponseBody runs the validations
+// defined on AIPDeletionRequestUpdatedEventResponseBody
+func ValidateAIPDeletionRequestUpdatedEventResponseBody(body *AIPDeletionRequestUpdatedEventResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.AipUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.Requester == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requester", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "approved" || *body.Status == "rejected" || *body.Status == "canceled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "approved", "rejected", "canceled"}))
+		}
+	}
+	return
+}
+
+// ValidateAIPFixityCheckedEventResponseBody runs the validations defined on
+// AIPFixityCheckedEventResponseBody
+func ValidateAIPFixityCheckedEventResponseBody(body *AIPFixityCheckedEventResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.AipUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.ChecksumAlgorithm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checksum_algorithm", "body"))
+	}
+	if body.ExpectedChecksum == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expected_checksum", "body"))
+	}
+	if body.ActualChecksum == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actual_checksum", "body"))
+	}
+	if body.CheckedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checked_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "passed" || *body.Status == "failed" || *body.Status == "baseline") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"passed", "failed", "baseline"}))
+		}
+	}
+	if body.CheckedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.checked_at", *body.CheckedAt, goa.FormatDateTime))
+	}
+	return
+}
+
 // ValidateAIPResponseBodyCollection runs the validations defined on
 // AIPResponseBodyCollection
 func ValidateAIPResponseBodyCollection(body AIPResponseBodyCollection) (err error) {
@@ -4588,13 +6080,16 @@ func ValidateLocationResponse(body *LocationResponse) (err error) {
 		}
 	}
 	if body.Purpose != nil {
-		if !(*body.Purpose == "unspecified" || *body.Purpose == "aip_store") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.purpose", *body.Purpose, []any{"unspecified", "aip_store"}))
+		if !(*body.Purpose == "unspecified" || *body.Purpose == "aip_store" || *body.Purpose == "restore") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.purpose", *body.Purpose, []any{"unspecified", "aip_store", "restore"}))
 		}
 	}
 	if body.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
+	for _, e := range body.ReplicationTargets {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replication_targets[*]", e, goa.FormatUUID))
+	}
 	return
 }
 
@@ -4623,5 +6118,105 @@ func ValidateAIPResponse(body *AIPResponse) (err error) {
 	if body.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
+	if body.DisposalAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.disposal_at", *body.DisposalAt, goa.FormatDateTime))
+	}
+	if body.Replicas != nil {
+		if err2 := ValidateAIPReplicaCollectionResponse(body.Replicas); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.LegalHolds != nil {
+		if err2 := ValidateAIPLegalHoldCollectionResponse(body.LegalHolds); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateAIPReplicaCollectionResponse runs the validations defined on
+// AIPReplicaCollectionResponse
+func ValidateAIPReplicaCollectionResponse(body AIPReplicaCollectionResponse) (err error) {
+	for _, e := range body {
+		if e != nil {
+			if err2 := ValidateAIPReplicaResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAIPReplicaResponse runs the validations defined on AIPReplicaResponse
+func ValidateAIPReplicaResponse(body *AIPReplicaResponse) (err error) {
+	if body.LocationUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("location_uuid", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "replicated" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "replicated", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ReplicatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replicated_at", *body.ReplicatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateAIPLegalHoldCollectionResponse runs the validations defined on
+// AIPLegalHoldCollectionResponse
+func ValidateAIPLegalHoldCollectionResponse(body AIPLegalHoldCollectionResponse) (err error) {
+	for _, e := range body {
+		if e != nil {
+			if err2 := ValidateAIPLegalHoldResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAIPLegalHoldResponse runs the validations defined on
+// AIPLegalHoldResponse
+func ValidateAIPLegalHoldResponse(body *AIPLegalHoldResponse) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.PlacedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_by", "body"))
+	}
+	if body.PlacedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_at", "body"))
+	}
+	if body.PlacedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.placed_at", *body.PlacedAt, goa.FormatDateTime))
+	}
+	if body.ReleasedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.released_at", *body.ReleasedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSearchResultResponseBody runs the validations defined on
+// SearchResultResponseBody
+func ValidateSearchResultResponseBody(body *SearchResultResponseBody) (err error) {
+	if body.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
 	return
 }
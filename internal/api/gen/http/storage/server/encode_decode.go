@@ -126,6 +126,7 @@ func DecodeListAipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 			earliestCreatedTime *string
 			latestCreatedTime   *string
 			status              *string
+			metadataKey         *string
 			limit               *int
 			offset              *int
 			token               *string
@@ -159,6 +160,10 @@ func DecodeListAipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"unspecified", "stored", "pending", "processing", "deleted", "queued"}))
 			}
 		}
+		metadataKeyRaw := qp.Get("metadata_key")
+		if metadataKeyRaw != "" {
+			metadataKey = &metadataKeyRaw
+		}
 		{
 			limitRaw := qp.Get("limit")
 			if limitRaw != "" {
@@ -188,7 +193,7 @@ func DecodeListAipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		if err != nil {
 			return payload, err
 		}
-		payload = NewListAipsPayload(query, earliestCreatedTime, latestCreatedTime, status, limit, offset, token)
+		payload = NewListAipsPayload(query, earliestCreatedTime, latestCreatedTime, status, metadataKey, limit, offset, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -843,6 +848,131 @@ func EncodeMoveAipStatusError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
+// EncodeRestoreAipsResponse returns an encoder for responses returned by the
+// storage restore_aips endpoint.
+func EncodeRestoreAipsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusAccepted)
+		return nil
+	}
+}
+
+// DecodeRestoreAipsRequest returns a decoder for requests sent to the storage
+// restore_aips endpoint.
+func DecodeRestoreAipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.RestoreAipsPayload, error) {
+	return func(r *http.Request) (*storage.RestoreAipsPayload, error) {
+		var payload *storage.RestoreAipsPayload
+		var (
+			body RestoreAipsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateRestoreAipsRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			token *string
+		)
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		payload = NewRestoreAipsPayload(&body, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRestoreAipsError returns an encoder for errors returned by the restore_aips
+// storage endpoint.
+func EncodeRestoreAipsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_available":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreAipsNotAvailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreAipsNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *storage.AIPNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreAipsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res storage.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res storage.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeRejectAipResponse returns an encoder for responses returned by the
 // storage reject_aip endpoint.
 func EncodeRejectAipResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -1091,8 +1221,8 @@ func DecodeListAipWorkflowsRequest(mux goahttp.Muxer, decoder func(*http.Request
 			type_ = &type_Raw
 		}
 		if type_ != nil {
-			if !(*type_ == "unspecified" || *type_ == "upload aip" || *type_ == "move aip" || *type_ == "delete aip") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("type", *type_, []any{"unspecified", "upload aip", "move aip", "delete aip"}))
+			if !(*type_ == "unspecified" || *type_ == "upload aip" || *type_ == "move aip" || *type_ == "delete aip" || *type_ == "audit aip" || *type_ == "replicate aip" || *type_ == "restore aip") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("type", *type_, []any{"unspecified", "upload aip", "move aip", "delete aip", "audit aip", "replicate aip", "restore aip"}))
 			}
 		}
 		tokenRaw := r.Header.Get("Authorization")
@@ -1302,6 +1432,24 @@ func EncodeRequestAipDeletionResponse(encoder func(context.Context, http.Respons
 	}
 }
 
+// EncodePlaceAipLegalHoldResponse returns an encoder for responses returned
+// by the storage place_aip_legal_hold endpoint.
+func EncodePlaceAipLegalHoldResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusCreated)
+		return nil
+	}
+}
+
+// EncodeReleaseAipLegalHoldResponse returns an encoder for responses returned
+// by the storage release_aip_legal_hold endpoint.
+func EncodeReleaseAipLegalHoldResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
 // DecodeRequestAipDeletionRequest returns a decoder for requests sent to the
 // storage request_aip_deletion endpoint.
 func DecodeRequestAipDeletionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.RequestAipDeletionPayload, error) {
@@ -1355,93 +1503,66 @@ func DecodeRequestAipDeletionRequest(mux goahttp.Muxer, decoder func(*http.Reque
 	}
 }
 
-// EncodeRequestAipDeletionError returns an encoder for errors returned by the
-// request_aip_deletion storage endpoint.
-func EncodeRequestAipDeletionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
-	encodeError := goahttp.ErrorEncoder(encoder, formatter)
-	return func(ctx context.Context, w http.ResponseWriter, v error) error {
-		var en goa.GoaErrorNamer
-		if !errors.As(v, &en) {
-			return encodeError(ctx, w, v)
-		}
-		switch en.GoaErrorName() {
-		case "not_valid":
-			var res *goa.ServiceError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewRequestAipDeletionNotValidResponseBody(res)
+// DecodePlaceAipLegalHoldRequest returns a decoder for requests sent to the
+// storage place_aip_legal_hold endpoint.
+func DecodePlaceAipLegalHoldRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.PlaceAipLegalHoldPayload, error) {
+	return func(r *http.Request) (*storage.PlaceAipLegalHoldPayload, error) {
+		var payload *storage.PlaceAipLegalHoldPayload
+		var (
+			body PlaceAipLegalHoldRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
 			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
-			return enc.Encode(body)
-		case "internal_error":
-			var res *goa.ServiceError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewRequestAipDeletionInternalErrorResponseBody(res)
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
 			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
-			return enc.Encode(body)
-		case "not_found":
-			var res *storage.AIPNotFound
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewRequestAipDeletionNotFoundResponseBody(res)
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePlaceAipLegalHoldRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			uuid  string
+			token *string
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewPlaceAipLegalHoldPayload(&body, uuid, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
 			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
-		case "forbidden":
-			var res storage.Forbidden
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			body := res
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusForbidden)
-			return enc.Encode(body)
-		case "unauthorized":
-			var res storage.Unauthorized
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			body := res
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusUnauthorized)
-			return enc.Encode(body)
-		default:
-			return encodeError(ctx, w, v)
 		}
-	}
-}
 
-// EncodeReviewAipDeletionResponse returns an encoder for responses returned by
-// the storage review_aip_deletion endpoint.
-func EncodeReviewAipDeletionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusAccepted)
-		return nil
+		return payload, nil
 	}
 }
 
-// DecodeReviewAipDeletionRequest returns a decoder for requests sent to the
-// storage review_aip_deletion endpoint.
-func DecodeReviewAipDeletionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.ReviewAipDeletionPayload, error) {
-	return func(r *http.Request) (*storage.ReviewAipDeletionPayload, error) {
-		var payload *storage.ReviewAipDeletionPayload
+// DecodeReleaseAipLegalHoldRequest returns a decoder for requests sent to the
+// storage release_aip_legal_hold endpoint.
+func DecodeReleaseAipLegalHoldRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.ReleaseAipLegalHoldPayload, error) {
+	return func(r *http.Request) (*storage.ReleaseAipLegalHoldPayload, error) {
+		var payload *storage.ReleaseAipLegalHoldPayload
 		var (
-			body ReviewAipDeletionRequestBody
+			body ReleaseAipLegalHoldRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -1455,7 +1576,7 @@ func DecodeReviewAipDeletionRequest(mux goahttp.Muxer, decoder func(*http.Reques
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateReviewAipDeletionRequestBody(&body)
+		err = ValidateReleaseAipLegalHoldRequestBody(&body)
 		if err != nil {
 			return payload, err
 		}
@@ -1475,7 +1596,7 @@ func DecodeReviewAipDeletionRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		if err != nil {
 			return payload, err
 		}
-		payload = NewReviewAipDeletionPayload(&body, uuid, token)
+		payload = NewReleaseAipLegalHoldPayload(&body, uuid, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1488,9 +1609,9 @@ func DecodeReviewAipDeletionRequest(mux goahttp.Muxer, decoder func(*http.Reques
 	}
 }
 
-// EncodeReviewAipDeletionError returns an encoder for errors returned by the
-// review_aip_deletion storage endpoint.
-func EncodeReviewAipDeletionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeRequestAipDeletionError returns an encoder for errors returned by the
+// request_aip_deletion storage endpoint.
+func EncodeRequestAipDeletionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1506,7 +1627,7 @@ func EncodeReviewAipDeletionError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewReviewAipDeletionNotValidResponseBody(res)
+				body = NewRequestAipDeletionNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1519,7 +1640,7 @@ func EncodeReviewAipDeletionError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewReviewAipDeletionInternalErrorResponseBody(res)
+				body = NewRequestAipDeletionInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1532,7 +1653,7 @@ func EncodeReviewAipDeletionError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewReviewAipDeletionNotFoundResponseBody(res)
+				body = NewRequestAipDeletionNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1559,11 +1680,286 @@ func EncodeReviewAipDeletionError(encoder func(context.Context, http.ResponseWri
 	}
 }
 
-// EncodeCancelAipDeletionResponse returns an encoder for responses returned by
-// the storage cancel_aip_deletion endpoint.
-func EncodeCancelAipDeletionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusAccepted)
+// EncodePlaceAipLegalHoldError returns an encoder for errors returned by the
+// place_aip_legal_hold storage endpoint.
+func EncodePlaceAipLegalHoldError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPlaceAipLegalHoldNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPlaceAipLegalHoldInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not_found":
+			var res *storage.AIPNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPlaceAipLegalHoldNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res storage.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res storage.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeReleaseAipLegalHoldError returns an encoder for errors returned by the
+// release_aip_legal_hold storage endpoint.
+func EncodeReleaseAipLegalHoldError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReleaseAipLegalHoldNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReleaseAipLegalHoldInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not_found":
+			var res *storage.AIPNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReleaseAipLegalHoldNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res storage.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res storage.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeReviewAipDeletionResponse returns an encoder for responses returned by
+// the storage review_aip_deletion endpoint.
+func EncodeReviewAipDeletionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusAccepted)
+		return nil
+	}
+}
+
+// DecodeReviewAipDeletionRequest returns a decoder for requests sent to the
+// storage review_aip_deletion endpoint.
+func DecodeReviewAipDeletionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.ReviewAipDeletionPayload, error) {
+	return func(r *http.Request) (*storage.ReviewAipDeletionPayload, error) {
+		var payload *storage.ReviewAipDeletionPayload
+		var (
+			body ReviewAipDeletionRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateReviewAipDeletionRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			uuid  string
+			token *string
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewReviewAipDeletionPayload(&body, uuid, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeReviewAipDeletionError returns an encoder for errors returned by the
+// review_aip_deletion storage endpoint.
+func EncodeReviewAipDeletionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReviewAipDeletionNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReviewAipDeletionInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not_found":
+			var res *storage.AIPNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReviewAipDeletionNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res storage.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res storage.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCancelAipDeletionResponse returns an encoder for responses returned by
+// the storage cancel_aip_deletion endpoint.
+func EncodeCancelAipDeletionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusAccepted)
 		return nil
 	}
 }
@@ -2046,7 +2442,189 @@ func DecodeCreateLocationRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if tokenRaw != "" {
 			token = &tokenRaw
 		}
-		payload = NewCreateLocationPayload(&body, token)
+		payload = NewCreateLocationPayload(&body, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateLocationError returns an encoder for errors returned by the
+// create_location storage endpoint.
+func EncodeCreateLocationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateLocationNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "forbidden":
+			var res storage.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res storage.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeShowLocationResponse returns an encoder for responses returned by the
+// storage show_location endpoint.
+func EncodeShowLocationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*storageviews.Location)
+		enc := encoder(ctx, w)
+		body := NewShowLocationResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeShowLocationRequest returns a decoder for requests sent to the storage
+// show_location endpoint.
+func DecodeShowLocationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.ShowLocationPayload, error) {
+	return func(r *http.Request) (*storage.ShowLocationPayload, error) {
+		var payload *storage.ShowLocationPayload
+		var (
+			uuid  string
+			token *string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewShowLocationPayload(uuid, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeShowLocationError returns an encoder for errors returned by the
+// show_location storage endpoint.
+func EncodeShowLocationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *storage.LocationNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewShowLocationNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "forbidden":
+			var res storage.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res storage.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListLocationAipsResponse returns an encoder for responses returned by
+// the storage list_location_aips endpoint.
+func EncodeListLocationAipsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(storageviews.AIPCollection)
+		enc := encoder(ctx, w)
+		body := NewAIPResponseCollection(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListLocationAipsRequest returns a decoder for requests sent to the
+// storage list_location_aips endpoint.
+func DecodeListLocationAipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.ListLocationAipsPayload, error) {
+	return func(r *http.Request) (*storage.ListLocationAipsPayload, error) {
+		var payload *storage.ListLocationAipsPayload
+		var (
+			uuid  string
+			token *string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		uuid = params["uuid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewListLocationAipsPayload(uuid, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2059,9 +2637,9 @@ func DecodeCreateLocationRequest(mux goahttp.Muxer, decoder func(*http.Request)
 	}
 }
 
-// EncodeCreateLocationError returns an encoder for errors returned by the
-// create_location storage endpoint.
-func EncodeCreateLocationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeListLocationAipsError returns an encoder for errors returned by the
+// list_location_aips storage endpoint.
+func EncodeListLocationAipsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2077,11 +2655,24 @@ func EncodeCreateLocationError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateLocationNotValidResponseBody(res)
+				body = NewListLocationAipsNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "not_found":
+			var res *storage.LocationNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListLocationAipsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "forbidden":
 			var res storage.Forbidden
 			errors.As(v, &res)
@@ -2104,23 +2695,23 @@ func EncodeCreateLocationError(encoder func(context.Context, http.ResponseWriter
 	}
 }
 
-// EncodeShowLocationResponse returns an encoder for responses returned by the
-// storage show_location endpoint.
-func EncodeShowLocationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeLocationUsageResponse returns an encoder for responses returned by the
+// storage location_usage endpoint.
+func EncodeLocationUsageResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(*storageviews.Location)
+		res, _ := v.(*storage.LocationUsage)
 		enc := encoder(ctx, w)
-		body := NewShowLocationResponseBody(res.Projected)
+		body := NewLocationUsageResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeShowLocationRequest returns a decoder for requests sent to the storage
-// show_location endpoint.
-func DecodeShowLocationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.ShowLocationPayload, error) {
-	return func(r *http.Request) (*storage.ShowLocationPayload, error) {
-		var payload *storage.ShowLocationPayload
+// DecodeLocationUsageRequest returns a decoder for requests sent to the
+// storage location_usage endpoint.
+func DecodeLocationUsageRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.LocationUsagePayload, error) {
+	return func(r *http.Request) (*storage.LocationUsagePayload, error) {
+		var payload *storage.LocationUsagePayload
 		var (
 			uuid  string
 			token *string
@@ -2137,7 +2728,7 @@ func DecodeShowLocationRequest(mux goahttp.Muxer, decoder func(*http.Request) go
 		if err != nil {
 			return payload, err
 		}
-		payload = NewShowLocationPayload(uuid, token)
+		payload = NewLocationUsagePayload(uuid, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2150,9 +2741,9 @@ func DecodeShowLocationRequest(mux goahttp.Muxer, decoder func(*http.Request) go
 	}
 }
 
-// EncodeShowLocationError returns an encoder for errors returned by the
-// show_location storage endpoint.
-func EncodeShowLocationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeLocationUsageError returns an encoder for errors returned by the
+// location_usage storage endpoint.
+func EncodeLocationUsageError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2168,7 +2759,7 @@ func EncodeShowLocationError(encoder func(context.Context, http.ResponseWriter)
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewShowLocationNotFoundResponseBody(res)
+				body = NewLocationUsageNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2195,32 +2786,57 @@ func EncodeShowLocationError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
-// EncodeListLocationAipsResponse returns an encoder for responses returned by
-// the storage list_location_aips endpoint.
-func EncodeListLocationAipsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeSearchResponse returns an encoder for responses returned by the storage
+// search endpoint.
+func EncodeSearchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(storageviews.AIPCollection)
+		res, _ := v.(*storage.SearchResults)
 		enc := encoder(ctx, w)
-		body := NewAIPResponseCollection(res.Projected)
+		body := NewSearchResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListLocationAipsRequest returns a decoder for requests sent to the
-// storage list_location_aips endpoint.
-func DecodeListLocationAipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.ListLocationAipsPayload, error) {
-	return func(r *http.Request) (*storage.ListLocationAipsPayload, error) {
-		var payload *storage.ListLocationAipsPayload
+// DecodeSearchRequest returns a decoder for requests sent to the storage search
+// endpoint.
+func DecodeSearchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*storage.SearchPayload, error) {
+	return func(r *http.Request) (*storage.SearchPayload, error) {
+		var payload *storage.SearchPayload
 		var (
-			uuid  string
-			token *string
-			err   error
-
-			params = mux.Vars(r)
+			query  string
+			limit  *int
+			offset *int
+			token  *string
+			err    error
 		)
-		uuid = params["uuid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("uuid", uuid, goa.FormatUUID))
+		qp := r.URL.Query()
+		query = qp.Get("query")
+		if query == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("query", "query string"))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		{
+			offsetRaw := qp.Get("offset")
+			if offsetRaw != "" {
+				v, err2 := strconv.ParseInt(offsetRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
+				}
+				pv := int(v)
+				offset = &pv
+			}
+		}
 		tokenRaw := r.Header.Get("Authorization")
 		if tokenRaw != "" {
 			token = &tokenRaw
@@ -2228,7 +2844,7 @@ func DecodeListLocationAipsRequest(mux goahttp.Muxer, decoder func(*http.Request
 		if err != nil {
 			return payload, err
 		}
-		payload = NewListLocationAipsPayload(uuid, token)
+		payload = NewSearchPayload(query, limit, offset, token)
 		if payload.Token != nil {
 			if strings.Contains(*payload.Token, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2241,9 +2857,9 @@ func DecodeListLocationAipsRequest(mux goahttp.Muxer, decoder func(*http.Request
 	}
 }
 
-// EncodeListLocationAipsError returns an encoder for errors returned by the
-// list_location_aips storage endpoint.
-func EncodeListLocationAipsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeSearchError returns an encoder for errors returned by the search
+// storage endpoint.
+func EncodeSearchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2259,24 +2875,11 @@ func EncodeListLocationAipsError(encoder func(context.Context, http.ResponseWrit
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListLocationAipsNotValidResponseBody(res)
+				body = NewSearchNotValidResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "not_found":
-			var res *storage.LocationNotFound
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewListLocationAipsNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
 		case "forbidden":
 			var res storage.Forbidden
 			errors.As(v, &res)
@@ -2349,6 +2952,12 @@ func marshalStorageLocationToLocationResponseBody(v *storage.Location) *Location
 			u := res.Config
 			u.SetAmss((*AMSSConfigResponseBody)(obj))
 			res.Config = u
+		case "filesystem":
+			actual, _ := v.Config.AsFilesystem()
+			obj := marshalStorageFilesystemConfigToFilesystemConfigResponseBody(actual)
+			u := res.Config
+			u.SetFilesystem((*FilesystemConfigResponseBody)(obj))
+			res.Config = u
 		case "s3":
 			actual, _ := v.Config.AsS3()
 			obj := marshalStorageS3ConfigToS3ConfigResponseBody(actual)
@@ -2369,6 +2978,12 @@ func marshalStorageLocationToLocationResponseBody(v *storage.Location) *Location
 			res.Config = u
 		}
 	}
+	if v.ReplicationTargets != nil {
+		res.ReplicationTargets = make([]string, len(v.ReplicationTargets))
+		for i, val := range v.ReplicationTargets {
+			res.ReplicationTargets[i] = val
+		}
+	}
 
 	return res
 }
@@ -2388,6 +3003,20 @@ func marshalStorageAMSSConfigToAMSSConfigResponseBody(v *storage.AMSSConfig) *AM
 	return res
 }
 
+// marshalStorageFilesystemConfigToFilesystemConfigResponseBody builds a value
+// of type *FilesystemConfigResponseBody from a value of type
+// *storage.FilesystemConfig.
+func marshalStorageFilesystemConfigToFilesystemConfigResponseBody(v *storage.FilesystemConfig) *FilesystemConfigResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &FilesystemConfigResponseBody{
+		Path: v.Path,
+	}
+
+	return res
+}
+
 // marshalStorageS3ConfigToS3ConfigResponseBody builds a value of type
 // *S3ConfigResponseBody from a value of type *storage.S3Config.
 func marshalStorageS3ConfigToS3ConfigResponseBody(v *storage.S3Config) *S3ConfigResponseBody {
@@ -2465,6 +3094,72 @@ func marshalStorageAIPToAIPResponseBody(v *storage.AIP) *AIPResponseBody {
 		LocationUUID:      v.LocationUUID,
 		CreatedAt:         v.CreatedAt,
 		DeletionReportKey: v.DeletionReportKey,
+		DisposalAt:        v.DisposalAt,
+		Size:              v.Size,
+		FileCount:         v.FileCount,
+	}
+	if v.Replicas != nil {
+		res.Replicas = make([]*AIPReplicaResponseBody, len(v.Replicas))
+		for i, val := range v.Replicas {
+			if val == nil {
+				res.Replicas[i] = nil
+				continue
+			}
+			res.Replicas[i] = marshalStorageAIPReplicaToAIPReplicaResponseBody(val)
+		}
+	}
+	if v.LegalHolds != nil {
+		res.LegalHolds = make([]*AIPLegalHoldResponseBody, len(v.LegalHolds))
+		for i, val := range v.LegalHolds {
+			if val == nil {
+				res.LegalHolds[i] = nil
+				continue
+			}
+			res.LegalHolds[i] = marshalStorageAIPLegalHoldToAIPLegalHoldResponseBody(val)
+		}
+	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalStorageAIPReplicaToAIPReplicaResponseBody builds a value of type
+// *AIPReplicaResponseBody from a value of type *storage.AIPReplica.
+func marshalStorageAIPReplicaToAIPReplicaResponseBody(v *storage.AIPReplica) *AIPReplicaResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AIPReplicaResponseBody{
+		LocationUUID: v.LocationUUID,
+		Status:       v.Status,
+		CreatedAt:    v.CreatedAt,
+		ReplicatedAt: v.ReplicatedAt,
+	}
+
+	return res
+}
+
+// marshalStorageAIPLegalHoldToAIPLegalHoldResponseBody builds a value of type
+// *AIPLegalHoldResponseBody from a value of type *storage.AIPLegalHold.
+func marshalStorageAIPLegalHoldToAIPLegalHoldResponseBody(v *storage.AIPLegalHold) *AIPLegalHoldResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AIPLegalHoldResponseBody{
+		UUID:          v.UUID,
+		Reason:        v.Reason,
+		PlacedBy:      v.PlacedBy,
+		PlacedAt:      v.PlacedAt,
+		ReleasedBy:    v.ReleasedBy,
+		ReleaseReason: v.ReleaseReason,
+		ReleasedAt:    v.ReleasedAt,
 	}
 
 	return res
@@ -2630,6 +3325,64 @@ func marshalStorageAIPTaskUpdatedEventToAIPTaskUpdatedEventResponseBody(v *stora
 	return res
 }
 
+// marshalStorageAIPDeletionRequestCreatedEventToAIPDeletionRequestCreatedEventResponseBody
+// builds a value of type *AIPDeletionRequestCreatedEventResponseBody from a
+// value of type *storage.AIPDeletionRequestCreatedEvent.
+func marshalStorageAIPDeletionRequestCreatedEventToAIPDeletionRequestCreatedEventResponseBody(v *storage.AIPDeletionRequestCreatedEvent) *AIPDeletionRequestCreatedEventResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AIPDeletionRequestCreatedEventResponseBody{
+		UUID:      v.UUID,
+		AipUUID:   v.AipUUID,
+		Status:    v.Status,
+		Reason:    v.Reason,
+		Requester: v.Requester,
+		Reviewer:  v.Reviewer,
+	}
+
+	return res
+}
+
+// marshalStorageAIPDeletionRequestUpdatedEventToAIPDeletionRequestUpdatedEventResponseBody
+// builds a value of type *AIPDeletionRequestUpdatedEventResponseBody from a
+// value of type *storage.AIPDeletionRequestUpdatedEvent.
+func marshalStorageAIPDeletionRequestUpdatedEventToAIPDeletionRequestUpdatedEventResponseBody(v *storage.AIPDeletionRequestUpdatedEvent) *AIPDeletionRequestUpdatedEventResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AIPDeletionRequestUpdatedEventResponseBody{
+		UUID:      v.UUID,
+		AipUUID:   v.AipUUID,
+		Status:    v.Status,
+		Reason:    v.Reason,
+		Requester: v.Requester,
+		Reviewer:  v.Reviewer,
+	}
+
+	return res
+}
+
+// marshalStorageAIPFixityCheckedEventToAIPFixityCheckedEventResponseBody
+// builds a value of type *AIPFixityCheckedEventResponseBody from a value of
+// type *storage.AIPFixityCheckedEvent.
+func marshalStorageAIPFixityCheckedEventToAIPFixityCheckedEventResponseBody(v *storage.AIPFixityCheckedEvent) *AIPFixityCheckedEventResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AIPFixityCheckedEventResponseBody{
+		UUID:              v.UUID,
+		AipUUID:           v.AipUUID,
+		Status:            v.Status,
+		ChecksumAlgorithm: v.ChecksumAlgorithm,
+		ExpectedChecksum:  v.ExpectedChecksum,
+		ActualChecksum:    v.ActualChecksum,
+		CheckedAt:         v.CheckedAt,
+	}
+
+	return res
+}
+
 // marshalStorageviewsAIPViewToAIPResponseBody builds a value of type
 // *AIPResponseBody from a value of type *storageviews.AIPView.
 func marshalStorageviewsAIPViewToAIPResponseBody(v *storageviews.AIPView) *AIPResponseBody {
@@ -2641,6 +3394,74 @@ func marshalStorageviewsAIPViewToAIPResponseBody(v *storageviews.AIPView) *AIPRe
 		LocationUUID:      v.LocationUUID,
 		CreatedAt:         *v.CreatedAt,
 		DeletionReportKey: v.DeletionReportKey,
+		DisposalAt:        v.DisposalAt,
+		Size:              v.Size,
+		FileCount:         v.FileCount,
+	}
+	if v.Replicas != nil {
+		res.Replicas = make([]*AIPReplicaResponseBody, len(v.Replicas))
+		for i, val := range v.Replicas {
+			if val == nil {
+				res.Replicas[i] = nil
+				continue
+			}
+			res.Replicas[i] = marshalStorageviewsAIPReplicaViewToAIPReplicaResponseBody(val)
+		}
+	}
+	if v.LegalHolds != nil {
+		res.LegalHolds = make([]*AIPLegalHoldResponseBody, len(v.LegalHolds))
+		for i, val := range v.LegalHolds {
+			if val == nil {
+				res.LegalHolds[i] = nil
+				continue
+			}
+			res.LegalHolds[i] = marshalStorageviewsAIPLegalHoldViewToAIPLegalHoldResponseBody(val)
+		}
+	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalStorageviewsAIPReplicaViewToAIPReplicaResponseBody builds a value of
+// type *AIPReplicaResponseBody from a value of type
+// *storageviews.AIPReplicaView.
+func marshalStorageviewsAIPReplicaViewToAIPReplicaResponseBody(v *storageviews.AIPReplicaView) *AIPReplicaResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AIPReplicaResponseBody{
+		LocationUUID: *v.LocationUUID,
+		Status:       *v.Status,
+		CreatedAt:    *v.CreatedAt,
+		ReplicatedAt: v.ReplicatedAt,
+	}
+
+	return res
+}
+
+// marshalStorageviewsAIPLegalHoldViewToAIPLegalHoldResponseBody builds a value
+// of type *AIPLegalHoldResponseBody from a value of type
+// *storageviews.AIPLegalHoldView.
+func marshalStorageviewsAIPLegalHoldViewToAIPLegalHoldResponseBody(v *storageviews.AIPLegalHoldView) *AIPLegalHoldResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AIPLegalHoldResponseBody{
+		UUID:          *v.UUID,
+		Reason:        *v.Reason,
+		PlacedBy:      *v.PlacedBy,
+		PlacedAt:      *v.PlacedAt,
+		ReleasedBy:    v.ReleasedBy,
+		ReleaseReason: v.ReleaseReason,
+		ReleasedAt:    v.ReleasedAt,
 	}
 
 	return res
@@ -2719,6 +3540,12 @@ func marshalStorageviewsLocationViewToLocationResponse(v *storageviews.LocationV
 		UUID:        *v.UUID,
 		CreatedAt:   *v.CreatedAt,
 	}
+	if v.ReplicationTargets != nil {
+		res.ReplicationTargets = make([]string, len(v.ReplicationTargets))
+		for i, val := range v.ReplicationTargets {
+			res.ReplicationTargets[i] = val
+		}
+	}
 
 	return res
 }
@@ -2738,6 +3565,20 @@ func unmarshalAMSSConfigRequestBodyToStorageAMSSConfig(v *AMSSConfigRequestBody)
 	return res
 }
 
+// unmarshalFilesystemConfigRequestBodyToStorageFilesystemConfig builds a value
+// of type *storage.FilesystemConfig from a value of type
+// *FilesystemConfigRequestBody.
+func unmarshalFilesystemConfigRequestBodyToStorageFilesystemConfig(v *FilesystemConfigRequestBody) *storage.FilesystemConfig {
+	if v == nil {
+		return nil
+	}
+	res := &storage.FilesystemConfig{
+		Path: *v.Path,
+	}
+
+	return res
+}
+
 // unmarshalS3ConfigRequestBodyToStorageS3Config builds a value of type
 // *storage.S3Config from a value of type *S3ConfigRequestBody.
 func unmarshalS3ConfigRequestBodyToStorageS3Config(v *S3ConfigRequestBody) *storage.S3Config {
@@ -2798,6 +3639,110 @@ func marshalStorageviewsAIPViewToAIPResponse(v *storageviews.AIPView) *AIPRespon
 		LocationUUID:      v.LocationUUID,
 		CreatedAt:         *v.CreatedAt,
 		DeletionReportKey: v.DeletionReportKey,
+		DisposalAt:        v.DisposalAt,
+		Size:              v.Size,
+		FileCount:         v.FileCount,
+	}
+	if v.Replicas != nil {
+		res.Replicas = make([]*AIPReplicaResponse, len(v.Replicas))
+		for i, val := range v.Replicas {
+			if val == nil {
+				res.Replicas[i] = nil
+				continue
+			}
+			res.Replicas[i] = marshalStorageviewsAIPReplicaViewToAIPReplicaResponse(val)
+		}
+	}
+	if v.LegalHolds != nil {
+		res.LegalHolds = make([]*AIPLegalHoldResponse, len(v.LegalHolds))
+		for i, val := range v.LegalHolds {
+			if val == nil {
+				res.LegalHolds[i] = nil
+				continue
+			}
+			res.LegalHolds[i] = marshalStorageviewsAIPLegalHoldViewToAIPLegalHoldResponse(val)
+		}
+	}
+	if v.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(v.CustomMetadata))
+		for key, val := range v.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalStorageviewsAIPReplicaViewToAIPReplicaResponse builds a value of type
+// *AIPReplicaResponse from a value of type *storageviews.AIPReplicaView.
+func marshalStorageviewsAIPReplicaViewToAIPReplicaResponse(v *storageviews.AIPReplicaView) *AIPReplicaResponse {
+	if v == nil {
+		return nil
+	}
+	res := &AIPReplicaResponse{
+		LocationUUID: *v.LocationUUID,
+		Status:       *v.Status,
+		CreatedAt:    *v.CreatedAt,
+		ReplicatedAt: v.ReplicatedAt,
+	}
+
+	return res
+}
+
+// marshalStorageviewsAIPLegalHoldViewToAIPLegalHoldResponse builds a value of
+// type *AIPLegalHoldResponse from a value of type
+// *storageviews.AIPLegalHoldView.
+func marshalStorageviewsAIPLegalHoldViewToAIPLegalHoldResponse(v *storageviews.AIPLegalHoldView) *AIPLegalHoldResponse {
+	if v == nil {
+		return nil
+	}
+	res := &AIPLegalHoldResponse{
+		UUID:          *v.UUID,
+		Reason:        *v.Reason,
+		PlacedBy:      *v.PlacedBy,
+		PlacedAt:      *v.PlacedAt,
+		ReleasedBy:    v.ReleasedBy,
+		ReleaseReason: v.ReleaseReason,
+		ReleasedAt:    v.ReleasedAt,
+	}
+
+	return res
+}
+
+// marshalStorageSearchResultToSearchResultResponseBody builds a value of type
+// *SearchResultResponseBody from a value of type *storage.SearchResult.
+func marshalStorageSearchResultToSearchResultResponseBody(v *storage.SearchResult) *SearchResultResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &SearchResultResponseBody{
+		UUID: v.UUID,
+		Name: v.Name,
+	}
+	if v.Fields != nil {
+		res.Fields = make(map[string]string, len(v.Fields))
+		for key, val := range v.Fields {
+			tk := key
+			tv := val
+			res.Fields[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalStorageEnduroPageToEnduroPageResponseBody builds a value of type
+// *EnduroPageResponseBody from a value of type *storage.EnduroPage.
+func marshalStorageEnduroPageToEnduroPageResponseBody(v *storage.EnduroPage) *EnduroPageResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroPageResponseBody{
+		Limit:  v.Limit,
+		Offset: v.Offset,
+		Total:  v.Total,
 	}
 
 	return res
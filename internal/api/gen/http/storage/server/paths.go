@@ -47,6 +47,11 @@ func MoveAipStatusStoragePath(uuid string) string {
 	return fmt.Sprintf("/storage/aips/%v/store", uuid)
 }
 
+// RestoreAipsStoragePath returns the URL path to the storage service restore_aips HTTP endpoint.
+func RestoreAipsStoragePath() string {
+	return "/storage/aips/restore"
+}
+
 // RejectAipStoragePath returns the URL path to the storage service reject_aip HTTP endpoint.
 func RejectAipStoragePath(uuid string) string {
 	return fmt.Sprintf("/storage/aips/%v/reject", uuid)
@@ -72,6 +77,16 @@ func RequestAipDeletionStoragePath(uuid string) string {
 	return fmt.Sprintf("/storage/aips/%v/deletion-request", uuid)
 }
 
+// PlaceAipLegalHoldStoragePath returns the URL path to the storage service place_aip_legal_hold HTTP endpoint.
+func PlaceAipLegalHoldStoragePath(uuid string) string {
+	return fmt.Sprintf("/storage/aips/%v/legal-hold", uuid)
+}
+
+// ReleaseAipLegalHoldStoragePath returns the URL path to the storage service release_aip_legal_hold HTTP endpoint.
+func ReleaseAipLegalHoldStoragePath(uuid string) string {
+	return fmt.Sprintf("/storage/aips/%v/legal-hold-release", uuid)
+}
+
 // ReviewAipDeletionStoragePath returns the URL path to the storage service review_aip_deletion HTTP endpoint.
 func ReviewAipDeletionStoragePath(uuid string) string {
 	return fmt.Sprintf("/storage/aips/%v/deletion-review", uuid)
@@ -111,3 +126,13 @@ func ShowLocationStoragePath(uuid string) string {
 func ListLocationAipsStoragePath(uuid string) string {
 	return fmt.Sprintf("/storage/locations/%v/aips", uuid)
 }
+
+// LocationUsageStoragePath returns the URL path to the storage service location_usage HTTP endpoint.
+func LocationUsageStoragePath(uuid string) string {
+	return fmt.Sprintf("/storage/locations/%v/usage", uuid)
+}
+
+// SearchStoragePath returns the URL path to the storage service search HTTP endpoint.
+func SearchStoragePath() string {
+	return "/storage/search"
+}
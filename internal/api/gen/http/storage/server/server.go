@@ -31,11 +31,14 @@ type Server struct {
 	DownloadAip              http.Handler
 	MoveAip                  http.Handler
 	MoveAipStatus            http.Handler
+	RestoreAips              http.Handler
 	RejectAip                http.Handler
 	ShowAip                  http.Handler
 	ListAipWorkflows         http.Handler
 	AipDeletionAuto          http.Handler
 	RequestAipDeletion       http.Handler
+	PlaceAipLegalHold        http.Handler
+	ReleaseAipLegalHold      http.Handler
 	ReviewAipDeletion        http.Handler
 	CancelAipDeletion        http.Handler
 	AipDeletionReportRequest http.Handler
@@ -44,6 +47,8 @@ type Server struct {
 	CreateLocation           http.Handler
 	ShowLocation             http.Handler
 	ListLocationAips         http.Handler
+	LocationUsage            http.Handler
+	Search                   http.Handler
 	CORS                     http.Handler
 }
 
@@ -81,11 +86,14 @@ func New(
 			{"DownloadAip", "GET", "/storage/aips/{uuid}/download"},
 			{"MoveAip", "POST", "/storage/aips/{uuid}/store"},
 			{"MoveAipStatus", "GET", "/storage/aips/{uuid}/store"},
+			{"RestoreAips", "POST", "/storage/aips/restore"},
 			{"RejectAip", "POST", "/storage/aips/{uuid}/reject"},
 			{"ShowAip", "GET", "/storage/aips/{uuid}"},
 			{"ListAipWorkflows", "GET", "/storage/aips/{uuid}/workflows"},
 			{"AipDeletionAuto", "POST", "/storage/aips/{uuid}/deletion-auto"},
 			{"RequestAipDeletion", "POST", "/storage/aips/{uuid}/deletion-request"},
+			{"PlaceAipLegalHold", "POST", "/storage/aips/{uuid}/legal-hold"},
+			{"ReleaseAipLegalHold", "POST", "/storage/aips/{uuid}/legal-hold-release"},
 			{"ReviewAipDeletion", "POST", "/storage/aips/{uuid}/deletion-review"},
 			{"CancelAipDeletion", "POST", "/storage/aips/{uuid}/deletion-cancel"},
 			{"AipDeletionReportRequest", "POST", "/storage/aips/{uuid}/deletion-report"},
@@ -94,10 +102,13 @@ func New(
 			{"CreateLocation", "POST", "/storage/locations"},
 			{"ShowLocation", "GET", "/storage/locations/{uuid}"},
 			{"ListLocationAips", "GET", "/storage/locations/{uuid}/aips"},
+			{"LocationUsage", "GET", "/storage/locations/{uuid}/usage"},
+			{"Search", "GET", "/storage/search"},
 			{"CORS", "OPTIONS", "/storage/monitor"},
 			{"CORS", "OPTIONS", "/storage/aips"},
 			{"CORS", "OPTIONS", "/storage/aips/{uuid}/download"},
 			{"CORS", "OPTIONS", "/storage/aips/{uuid}/store"},
+			{"CORS", "OPTIONS", "/storage/aips/restore"},
 			{"CORS", "OPTIONS", "/storage/aips/{uuid}/reject"},
 			{"CORS", "OPTIONS", "/storage/aips/{uuid}"},
 			{"CORS", "OPTIONS", "/storage/aips/{uuid}/workflows"},
@@ -109,6 +120,8 @@ func New(
 			{"CORS", "OPTIONS", "/storage/locations"},
 			{"CORS", "OPTIONS", "/storage/locations/{uuid}"},
 			{"CORS", "OPTIONS", "/storage/locations/{uuid}/aips"},
+			{"CORS", "OPTIONS", "/storage/locations/{uuid}/usage"},
+			{"CORS", "OPTIONS", "/storage/search"},
 		},
 		Monitor:                  NewMonitorHandler(e.Monitor, mux, decoder, encoder, errhandler, formatter),
 		ListAips:                 NewListAipsHandler(e.ListAips, mux, decoder, encoder, errhandler, formatter),
@@ -117,11 +130,14 @@ func New(
 		DownloadAip:              NewDownloadAipHandler(e.DownloadAip, mux, decoder, encoder, errhandler, formatter),
 		MoveAip:                  NewMoveAipHandler(e.MoveAip, mux, decoder, encoder, errhandler, formatter),
 		MoveAipStatus:            NewMoveAipStatusHandler(e.MoveAipStatus, mux, decoder, encoder, errhandler, formatter),
+		RestoreAips:              NewRestoreAipsHandler(e.RestoreAips, mux, decoder, encoder, errhandler, formatter),
 		RejectAip:                NewRejectAipHandler(e.RejectAip, mux, decoder, encoder, errhandler, formatter),
 		ShowAip:                  NewShowAipHandler(e.ShowAip, mux, decoder, encoder, errhandler, formatter),
 		ListAipWorkflows:         NewListAipWorkflowsHandler(e.ListAipWorkflows, mux, decoder, encoder, errhandler, formatter),
 		AipDeletionAuto:          NewAipDeletionAutoHandler(e.AipDeletionAuto, mux, decoder, encoder, errhandler, formatter),
 		RequestAipDeletion:       NewRequestAipDeletionHandler(e.RequestAipDeletion, mux, decoder, encoder, errhandler, formatter),
+		PlaceAipLegalHold:        NewPlaceAipLegalHoldHandler(e.PlaceAipLegalHold, mux, decoder, encoder, errhandler, formatter),
+		ReleaseAipLegalHold:      NewReleaseAipLegalHoldHandler(e.ReleaseAipLegalHold, mux, decoder, encoder, errhandler, formatter),
 		ReviewAipDeletion:        NewReviewAipDeletionHandler(e.ReviewAipDeletion, mux, decoder, encoder, errhandler, formatter),
 		CancelAipDeletion:        NewCancelAipDeletionHandler(e.CancelAipDeletion, mux, decoder, encoder, errhandler, formatter),
 		AipDeletionReportRequest: NewAipDeletionReportRequestHandler(e.AipDeletionReportRequest, mux, decoder, encoder, errhandler, formatter),
@@ -130,6 +146,8 @@ func New(
 		CreateLocation:           NewCreateLocationHandler(e.CreateLocation, mux, decoder, encoder, errhandler, formatter),
 		ShowLocation:             NewShowLocationHandler(e.ShowLocation, mux, decoder, encoder, errhandler, formatter),
 		ListLocationAips:         NewListLocationAipsHandler(e.ListLocationAips, mux, decoder, encoder, errhandler, formatter),
+		LocationUsage:            NewLocationUsageHandler(e.LocationUsage, mux, decoder, encoder, errhandler, formatter),
+		Search:                   NewSearchHandler(e.Search, mux, decoder, encoder, errhandler, formatter),
 		CORS:                     NewCORSHandler(),
 	}
 }
@@ -146,11 +164,14 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.DownloadAip = m(s.DownloadAip)
 	s.MoveAip = m(s.MoveAip)
 	s.MoveAipStatus = m(s.MoveAipStatus)
+	s.RestoreAips = m(s.RestoreAips)
 	s.RejectAip = m(s.RejectAip)
 	s.ShowAip = m(s.ShowAip)
 	s.ListAipWorkflows = m(s.ListAipWorkflows)
 	s.AipDeletionAuto = m(s.AipDeletionAuto)
 	s.RequestAipDeletion = m(s.RequestAipDeletion)
+	s.PlaceAipLegalHold = m(s.PlaceAipLegalHold)
+	s.ReleaseAipLegalHold = m(s.ReleaseAipLegalHold)
 	s.ReviewAipDeletion = m(s.ReviewAipDeletion)
 	s.CancelAipDeletion = m(s.CancelAipDeletion)
 	s.AipDeletionReportRequest = m(s.AipDeletionReportRequest)
@@ -159,6 +180,8 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.CreateLocation = m(s.CreateLocation)
 	s.ShowLocation = m(s.ShowLocation)
 	s.ListLocationAips = m(s.ListLocationAips)
+	s.LocationUsage = m(s.LocationUsage)
+	s.Search = m(s.Search)
 	s.CORS = m(s.CORS)
 }
 
@@ -174,11 +197,14 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountDownloadAipHandler(mux, h.DownloadAip)
 	MountMoveAipHandler(mux, h.MoveAip)
 	MountMoveAipStatusHandler(mux, h.MoveAipStatus)
+	MountRestoreAipsHandler(mux, h.RestoreAips)
 	MountRejectAipHandler(mux, h.RejectAip)
 	MountShowAipHandler(mux, h.ShowAip)
 	MountListAipWorkflowsHandler(mux, h.ListAipWorkflows)
 	MountAipDeletionAutoHandler(mux, h.AipDeletionAuto)
 	MountRequestAipDeletionHandler(mux, h.RequestAipDeletion)
+	MountPlaceAipLegalHoldHandler(mux, h.PlaceAipLegalHold)
+	MountReleaseAipLegalHoldHandler(mux, h.ReleaseAipLegalHold)
 	MountReviewAipDeletionHandler(mux, h.ReviewAipDeletion)
 	MountCancelAipDeletionHandler(mux, h.CancelAipDeletion)
 	MountAipDeletionReportRequestHandler(mux, h.AipDeletionReportRequest)
@@ -187,6 +213,8 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountCreateLocationHandler(mux, h.CreateLocation)
 	MountShowLocationHandler(mux, h.ShowLocation)
 	MountListLocationAipsHandler(mux, h.ListLocationAips)
+	MountLocationUsageHandler(mux, h.LocationUsage)
+	MountSearchHandler(mux, h.Search)
 	MountCORSHandler(mux, h.CORS)
 }
 
@@ -602,6 +630,59 @@ func NewMoveAipStatusHandler(
 	})
 }
 
+// MountRestoreAipsHandler configures the mux to serve the "storage" service
+// "restore_aips" endpoint.
+func MountRestoreAipsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleStorageOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/storage/aips/restore", f)
+}
+
+// NewRestoreAipsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "storage" service "restore_aips" endpoint.
+func NewRestoreAipsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRestoreAipsRequest(mux, decoder)
+		encodeResponse = EncodeRestoreAipsResponse(encoder)
+		encodeError    = EncodeRestoreAipsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "restore_aips")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "storage")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountRejectAipHandler configures the mux to serve the "storage" service
 // "reject_aip" endpoint.
 func MountRejectAipHandler(mux goahttp.Muxer, h http.Handler) {
@@ -826,6 +907,30 @@ func MountRequestAipDeletionHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("POST", "/storage/aips/{uuid}/deletion-request", f)
 }
 
+// MountPlaceAipLegalHoldHandler configures the mux to serve the "storage"
+// service "place_aip_legal_hold" endpoint.
+func MountPlaceAipLegalHoldHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleStorageOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/storage/aips/{uuid}/legal-hold", f)
+}
+
+// MountReleaseAipLegalHoldHandler configures the mux to serve the "storage"
+// service "release_aip_legal_hold" endpoint.
+func MountReleaseAipLegalHoldHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleStorageOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/storage/aips/{uuid}/legal-hold-release", f)
+}
+
 // NewRequestAipDeletionHandler creates a HTTP handler which loads the HTTP
 // request and calls the "storage" service "request_aip_deletion" endpoint.
 func NewRequestAipDeletionHandler(
@@ -867,6 +972,88 @@ func NewRequestAipDeletionHandler(
 	})
 }
 
+// NewPlaceAipLegalHoldHandler creates a HTTP handler which loads the HTTP
+// request and calls the "storage" service "place_aip_legal_hold" endpoint.
+func NewPlaceAipLegalHoldHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePlaceAipLegalHoldRequest(mux, decoder)
+		encodeResponse = EncodePlaceAipLegalHoldResponse(encoder)
+		encodeError    = EncodePlaceAipLegalHoldError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "place_aip_legal_hold")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "storage")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// NewReleaseAipLegalHoldHandler creates a HTTP handler which loads the HTTP
+// request and calls the "storage" service "release_aip_legal_hold" endpoint.
+func NewReleaseAipLegalHoldHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReleaseAipLegalHoldRequest(mux, decoder)
+		encodeResponse = EncodeReleaseAipLegalHoldResponse(encoder)
+		encodeError    = EncodeReleaseAipLegalHoldError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "release_aip_legal_hold")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "storage")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountReviewAipDeletionHandler configures the mux to serve the "storage"
 // service "review_aip_deletion" endpoint.
 func MountReviewAipDeletionHandler(mux goahttp.Muxer, h http.Handler) {
@@ -1327,6 +1514,112 @@ func NewListLocationAipsHandler(
 	})
 }
 
+// MountLocationUsageHandler configures the mux to serve the "storage" service
+// "location_usage" endpoint.
+func MountLocationUsageHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleStorageOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/storage/locations/{uuid}/usage", f)
+}
+
+// NewLocationUsageHandler creates a HTTP handler which loads the HTTP request
+// and calls the "storage" service "location_usage" endpoint.
+func NewLocationUsageHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeLocationUsageRequest(mux, decoder)
+		encodeResponse = EncodeLocationUsageResponse(encoder)
+		encodeError    = EncodeLocationUsageError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "location_usage")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "storage")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSearchHandler configures the mux to serve the "storage" service "search"
+// endpoint.
+func MountSearchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleStorageOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/storage/search", f)
+}
+
+// NewSearchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "storage" service "search" endpoint.
+func NewSearchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSearchRequest(mux, decoder)
+		encodeResponse = EncodeSearchResponse(encoder)
+		encodeError    = EncodeSearchError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "search")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "storage")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountCORSHandler configures the mux to serve the CORS endpoints for the
 // service storage.
 func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
@@ -1335,6 +1628,7 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("OPTIONS", "/storage/aips", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/storage/aips/{uuid}/download", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/storage/aips/{uuid}/store", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/storage/aips/restore", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/storage/aips/{uuid}/reject", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/storage/aips/{uuid}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/storage/aips/{uuid}/workflows", h.ServeHTTP)
@@ -1346,6 +1640,8 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("OPTIONS", "/storage/locations", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/storage/locations/{uuid}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/storage/locations/{uuid}/aips", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/storage/locations/{uuid}/usage", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/storage/search", h.ServeHTTP)
 }
 
 // NewCORSHandler creates a HTTP handler which returns a simple 204 response.
@@ -1374,8 +1670,8 @@ func HandleStorageOrigin(h http.Handler) http.Handler {
 			w.Header().Set("Vary", "Origin")
 			if acrm := r.Header.Get("Access-Control-Request-Method"); acrm != "" {
 				// We are handling a preflight request
-				w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+				w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, Upload-Checksum, Upload-Offset")
 				w.WriteHeader(204)
 				return
 			}
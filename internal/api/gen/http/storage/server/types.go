@@ -31,6 +31,10 @@ type CreateAipRequestBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	// Identifier of the AIP's storage location
 	LocationUUID *uuid.UUID `form:"location_uuid,omitempty" json:"location_uuid,omitempty" xml:"location_uuid,omitempty"`
+	// Descriptive metadata of the AIP, e.g. Dublin Core fields from its METS file
+	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Custom metadata of the AIP, copied from its SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // MoveAipRequestBody is the type of the "storage" service "move_aip" endpoint
@@ -40,6 +44,17 @@ type MoveAipRequestBody struct {
 	LocationUUID *uuid.UUID `form:"location_uuid,omitempty" json:"location_uuid,omitempty" xml:"location_uuid,omitempty"`
 }
 
+// RestoreAipsRequestBody is the type of the "storage" service "restore_aips"
+// endpoint HTTP request body.
+type RestoreAipsRequestBody struct {
+	// Identifiers of the AIPs to restore
+	AipUuids []string `form:"aip_uuids,omitempty" json:"aip_uuids,omitempty" xml:"aip_uuids,omitempty"`
+	// Identifier of the restore location
+	LocationUUID *uuid.UUID `form:"location_uuid,omitempty" json:"location_uuid,omitempty" xml:"location_uuid,omitempty"`
+	// Extract the AIP files in the restore location
+	Unpack *bool `form:"unpack,omitempty" json:"unpack,omitempty" xml:"unpack,omitempty"`
+}
+
 // AipDeletionAutoRequestBody is the type of the "storage" service
 // "aip_deletion_auto" endpoint HTTP request body.
 type AipDeletionAutoRequestBody struct {
@@ -54,6 +69,18 @@ type RequestAipDeletionRequestBody struct {
 	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
 }
 
+// PlaceAipLegalHoldRequestBody is the type of the "storage" service
+// "place_aip_legal_hold" endpoint HTTP request body.
+type PlaceAipLegalHoldRequestBody struct {
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// ReleaseAipLegalHoldRequestBody is the type of the "storage" service
+// "release_aip_legal_hold" endpoint HTTP request body.
+type ReleaseAipLegalHoldRequestBody struct {
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
 // ReviewAipDeletionRequestBody is the type of the "storage" service
 // "review_aip_deletion" endpoint HTTP request body.
 type ReviewAipDeletionRequestBody struct {
@@ -76,6 +103,9 @@ type CreateLocationRequestBody struct {
 	Source      *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
 	Purpose     *string `form:"purpose,omitempty" json:"purpose,omitempty" xml:"purpose,omitempty"`
 	Config      Config2 `form:"config,omitempty" json:"config,omitempty" xml:"config,omitempty"`
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string `form:"replication_targets,omitempty" json:"replication_targets,omitempty" xml:"replication_targets,omitempty"`
 }
 
 // MonitorResponseBody is the type of the "storage" service "monitor" endpoint
@@ -105,6 +135,18 @@ type CreateAipResponseBody struct {
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// Deletion report key
 	DeletionReportKey *string `form:"deletion_report_key,omitempty" json:"deletion_report_key,omitempty" xml:"deletion_report_key,omitempty"`
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string `form:"disposal_at,omitempty" json:"disposal_at,omitempty" xml:"disposal_at,omitempty"`
+	// Size of the AIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of files in the AIP
+	FileCount *int `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionResponseBody `form:"replicas,omitempty" json:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionResponseBody `form:"legal_holds,omitempty" json:"legal_holds,omitempty" xml:"legal_holds,omitempty"`
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // MoveAipStatusResponseBody is the type of the "storage" service
@@ -127,6 +169,18 @@ type ShowAipResponseBody struct {
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// Deletion report key
 	DeletionReportKey *string `form:"deletion_report_key,omitempty" json:"deletion_report_key,omitempty" xml:"deletion_report_key,omitempty"`
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string `form:"disposal_at,omitempty" json:"disposal_at,omitempty" xml:"disposal_at,omitempty"`
+	// Size of the AIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of files in the AIP
+	FileCount *int `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionResponseBody `form:"replicas,omitempty" json:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionResponseBody `form:"legal_holds,omitempty" json:"legal_holds,omitempty" xml:"legal_holds,omitempty"`
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
 }
 
 // ListAipWorkflowsResponseBody is the type of the "storage" service
@@ -159,12 +213,37 @@ type ShowLocationResponseBody struct {
 	UUID    uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
 	// Creation datetime
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string `form:"replication_targets,omitempty" json:"replication_targets,omitempty" xml:"replication_targets,omitempty"`
+}
+
+// LocationUsageResponseBody is the type of the "storage" service
+// "location_usage" endpoint HTTP response body.
+type LocationUsageResponseBody struct {
+	// Number of AIPs in the location
+	AipCount int `form:"aip_count" json:"aip_count" xml:"aip_count"`
+	// Total size of the AIPs in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// Total number of files in the AIPs
+	FileCount int64 `form:"file_count" json:"file_count" xml:"file_count"`
+	// Maximum total size of the AIPs in bytes, if configured
+	Quota *int64 `form:"quota,omitempty" json:"quota,omitempty" xml:"quota,omitempty"`
+	// Whether the quota has been reached and new AIPs are rejected
+	QuotaExceeded bool `form:"quota_exceeded" json:"quota_exceeded" xml:"quota_exceeded"`
 }
 
 // AIPResponseCollection is the type of the "storage" service
 // "list_location_aips" endpoint HTTP response body.
 type AIPResponseCollection []*AIPResponse
 
+// SearchResponseBody is the type of the "storage" service "search" endpoint
+// HTTP response body.
+type SearchResponseBody struct {
+	Items []*SearchResultResponseBody `form:"items" json:"items" xml:"items"`
+	Page  *EnduroPageResponseBody     `form:"page" json:"page" xml:"page"`
+}
+
 // MonitorInternalErrorResponseBody is the type of the "storage" service
 // "monitor" endpoint HTTP response body for the "internal_error" error.
 type MonitorInternalErrorResponseBody struct {
@@ -401,6 +480,51 @@ type MoveAipStatusNotFoundResponseBody struct {
 	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
 }
 
+// RestoreAipsNotAvailableResponseBody is the type of the "storage" service
+// "restore_aips" endpoint HTTP response body for the "not_available" error.
+type RestoreAipsNotAvailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RestoreAipsNotValidResponseBody is the type of the "storage" service "restore_aips"
+// endpoint HTTP response body for the "not_valid" error.
+type RestoreAipsNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RestoreAipsNotFoundResponseBody is the type of the "storage" service "restore_aips"
+// endpoint HTTP response body for the "not_found" error.
+type RestoreAipsNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing AIP
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+}
+
 // RejectAipNotAvailableResponseBody is the type of the "storage" service
 // "reject_aip" endpoint HTTP response body for the "not_available" error.
 type RejectAipNotAvailableResponseBody struct {
@@ -528,6 +652,42 @@ type RequestAipDeletionNotValidResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// PlaceAipLegalHoldNotValidResponseBody is the type of the "storage" service
+// "place_aip_legal_hold" endpoint HTTP response body for the "not_valid" error.
+type PlaceAipLegalHoldNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReleaseAipLegalHoldNotValidResponseBody is the type of the "storage" service
+// "release_aip_legal_hold" endpoint HTTP response body for the "not_valid" error.
+type ReleaseAipLegalHoldNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // RequestAipDeletionInternalErrorResponseBody is the type of the "storage"
 // service "request_aip_deletion" endpoint HTTP response body for the
 // "internal_error" error.
@@ -547,6 +707,44 @@ type RequestAipDeletionInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// PlaceAipLegalHoldInternalErrorResponseBody is the type of the "storage"
+// service "place_aip_legal_hold" endpoint HTTP response body for the
+// "internal_error" error.
+type PlaceAipLegalHoldInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReleaseAipLegalHoldInternalErrorResponseBody is the type of the "storage"
+// service "release_aip_legal_hold" endpoint HTTP response body for the
+// "internal_error" error.
+type ReleaseAipLegalHoldInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // RequestAipDeletionNotFoundResponseBody is the type of the "storage" service
 // "request_aip_deletion" endpoint HTTP response body for the "not_found" error.
 type RequestAipDeletionNotFoundResponseBody struct {
@@ -556,6 +754,24 @@ type RequestAipDeletionNotFoundResponseBody struct {
 	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
 }
 
+// PlaceAipLegalHoldNotFoundResponseBody is the type of the "storage" service
+// "place_aip_legal_hold" endpoint HTTP response body for the "not_found" error.
+type PlaceAipLegalHoldNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing AIP
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+}
+
+// ReleaseAipLegalHoldNotFoundResponseBody is the type of the "storage" service
+// "release_aip_legal_hold" endpoint HTTP response body for the "not_found" error.
+type ReleaseAipLegalHoldNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing AIP
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+}
+
 // ReviewAipDeletionNotValidResponseBody is the type of the "storage" service
 // "review_aip_deletion" endpoint HTTP response body for the "not_valid" error.
 type ReviewAipDeletionNotValidResponseBody struct {
@@ -812,6 +1028,32 @@ type ListLocationAipsNotFoundResponseBody struct {
 	UUID    uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
 }
 
+// LocationUsageNotFoundResponseBody is the type of the "storage" service
+// "location_usage" endpoint HTTP response body for the "not_found" error.
+type LocationUsageNotFoundResponseBody struct {
+	// Message of error
+	Message string    `form:"message" json:"message" xml:"message"`
+	UUID    uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+}
+
+// SearchNotValidResponseBody is the type of the "storage" service "search"
+// endpoint HTTP response body for the "not_valid" error.
+type SearchNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // StoragePingEventResponseBody is used to define fields on response body types.
 type StoragePingEventResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
@@ -839,6 +1081,9 @@ type LocationResponseBody struct {
 	Config  Config    `form:"config,omitempty" json:"config,omitempty" xml:"config,omitempty"`
 	// Creation datetime
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string `form:"replication_targets,omitempty" json:"replication_targets,omitempty" xml:"replication_targets,omitempty"`
 }
 
 // AMSSConfigResponseBody is used to define fields on response body types.
@@ -848,6 +1093,11 @@ type AMSSConfigResponseBody struct {
 	Username string `form:"username" json:"username" xml:"username"`
 }
 
+// FilesystemConfigResponseBody is used to define fields on response body types.
+type FilesystemConfigResponseBody struct {
+	Path string `form:"path" json:"path" xml:"path"`
+}
+
 // S3ConfigResponseBody is used to define fields on response body types.
 type S3ConfigResponseBody struct {
 	Bucket    string  `form:"bucket" json:"bucket" xml:"bucket"`
@@ -893,6 +1143,56 @@ type AIPResponseBody struct {
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// Deletion report key
 	DeletionReportKey *string `form:"deletion_report_key,omitempty" json:"deletion_report_key,omitempty" xml:"deletion_report_key,omitempty"`
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string `form:"disposal_at,omitempty" json:"disposal_at,omitempty" xml:"disposal_at,omitempty"`
+	// Size of the AIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of files in the AIP
+	FileCount *int `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionResponseBody `form:"replicas,omitempty" json:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionResponseBody `form:"legal_holds,omitempty" json:"legal_holds,omitempty" xml:"legal_holds,omitempty"`
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
+}
+
+// AIPReplicaCollectionResponseBody is used to define fields on response body
+// types.
+type AIPReplicaCollectionResponseBody []*AIPReplicaResponseBody
+
+// AIPReplicaResponseBody is used to define fields on response body types.
+type AIPReplicaResponseBody struct {
+	// Identifier of replication location
+	LocationUUID uuid.UUID `form:"location_uuid" json:"location_uuid" xml:"location_uuid"`
+	// Status of the replica
+	Status string `form:"status" json:"status" xml:"status"`
+	// Creation datetime
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Replication datetime
+	ReplicatedAt *string `form:"replicated_at,omitempty" json:"replicated_at,omitempty" xml:"replicated_at,omitempty"`
+}
+
+// AIPLegalHoldCollectionResponseBody is used to define fields on response body
+// types.
+type AIPLegalHoldCollectionResponseBody []*AIPLegalHoldResponseBody
+
+// AIPLegalHoldResponseBody is used to define fields on response body types.
+type AIPLegalHoldResponseBody struct {
+	// Identifier of the legal hold
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+	// Reason of the legal hold
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// User who placed the legal hold
+	PlacedBy string `form:"placed_by" json:"placed_by" xml:"placed_by"`
+	// Placement datetime
+	PlacedAt string `form:"placed_at" json:"placed_at" xml:"placed_at"`
+	// User who released the legal hold
+	ReleasedBy *string `form:"released_by,omitempty" json:"released_by,omitempty" xml:"released_by,omitempty"`
+	// Reason of the legal hold release
+	ReleaseReason *string `form:"release_reason,omitempty" json:"release_reason,omitempty" xml:"release_reason,omitempty"`
+	// Release datetime
+	ReleasedAt *string `form:"released_at,omitempty" json:"released_at,omitempty" xml:"released_at,omitempty"`
 }
 
 // AIPUpdatedEventResponseBody is used to define fields on response body types.
@@ -980,6 +1280,59 @@ type AIPTaskUpdatedEventResponseBody struct {
 	Item *AIPTaskResponseBody `form:"item" json:"item" xml:"item"`
 }
 
+// AIPDeletionRequestCreatedEventResponseBody is used to define fields on
+// response body types.
+type AIPDeletionRequestCreatedEventResponseBody struct {
+	// Identifier of deletion request
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+	// Identifier of AIP
+	AipUUID uuid.UUID `form:"aip_uuid" json:"aip_uuid" xml:"aip_uuid"`
+	// Status of the deletion request
+	Status string `form:"status" json:"status" xml:"status"`
+	// Reason for the deletion
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// User who requested the deletion
+	Requester string `form:"requester" json:"requester" xml:"requester"`
+	// User who reviewed the deletion request
+	Reviewer *string `form:"reviewer,omitempty" json:"reviewer,omitempty" xml:"reviewer,omitempty"`
+}
+
+// AIPDeletionRequestUpdatedEventResponseBody is used to define fields on
+// response body types.
+type AIPDeletionRequestUpdatedEventResponseBody struct {
+	// Identifier of deletion request
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+	// Identifier of AIP
+	AipUUID uuid.UUID `form:"aip_uuid" json:"aip_uuid" xml:"aip_uuid"`
+	// Status of the deletion request
+	Status string `form:"status" json:"status" xml:"status"`
+	// Reason for the deletion
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// User who requested the deletion
+	Requester string `form:"requester" json:"requester" xml:"requester"`
+	// User who reviewed the deletion request
+	Reviewer *string `form:"reviewer,omitempty" json:"reviewer,omitempty" xml:"reviewer,omitempty"`
+}
+
+// AIPFixityCheckedEventResponseBody is used to define fields on response
+// body types.
+type AIPFixityCheckedEventResponseBody struct {
+	// Identifier of fixity check
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+	// Identifier of AIP
+	AipUUID uuid.UUID `form:"aip_uuid" json:"aip_uuid" xml:"aip_uuid"`
+	// Result of the fixity check
+	Status string `form:"status" json:"status" xml:"status"`
+	// Algorithm of the checksums
+	ChecksumAlgorithm string `form:"checksum_algorithm" json:"checksum_algorithm" xml:"checksum_algorithm"`
+	// Checksum of the AIP stored in Enduro
+	ExpectedChecksum string `form:"expected_checksum" json:"expected_checksum" xml:"expected_checksum"`
+	// Checksum computed from the stored AIP
+	ActualChecksum string `form:"actual_checksum" json:"actual_checksum" xml:"actual_checksum"`
+	// Time the fixity check was completed
+	CheckedAt string `form:"checked_at" json:"checked_at" xml:"checked_at"`
+}
+
 // AIPResponseBodyCollection is used to define fields on response body types.
 type AIPResponseBodyCollection []*AIPResponseBody
 
@@ -1014,6 +1367,9 @@ type LocationResponse struct {
 	UUID    uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
 	// Creation datetime
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string `form:"replication_targets,omitempty" json:"replication_targets,omitempty" xml:"replication_targets,omitempty"`
 }
 
 // AIPResponse is used to define fields on response body types.
@@ -1029,6 +1385,56 @@ type AIPResponse struct {
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// Deletion report key
 	DeletionReportKey *string `form:"deletion_report_key,omitempty" json:"deletion_report_key,omitempty" xml:"deletion_report_key,omitempty"`
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string `form:"disposal_at,omitempty" json:"disposal_at,omitempty" xml:"disposal_at,omitempty"`
+	// Size of the AIP in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Number of files in the AIP
+	FileCount *int `form:"file_count,omitempty" json:"file_count,omitempty" xml:"file_count,omitempty"`
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionResponse `form:"replicas,omitempty" json:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionResponse `form:"legal_holds,omitempty" json:"legal_holds,omitempty" xml:"legal_holds,omitempty"`
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any `form:"custom_metadata,omitempty" json:"custom_metadata,omitempty" xml:"custom_metadata,omitempty"`
+}
+
+// AIPReplicaCollectionResponse is used to define fields on response body
+// types.
+type AIPReplicaCollectionResponse []*AIPReplicaResponse
+
+// AIPReplicaResponse is used to define fields on response body types.
+type AIPReplicaResponse struct {
+	// Identifier of replication location
+	LocationUUID uuid.UUID `form:"location_uuid" json:"location_uuid" xml:"location_uuid"`
+	// Status of the replica
+	Status string `form:"status" json:"status" xml:"status"`
+	// Creation datetime
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Replication datetime
+	ReplicatedAt *string `form:"replicated_at,omitempty" json:"replicated_at,omitempty" xml:"replicated_at,omitempty"`
+}
+
+// AIPLegalHoldCollectionResponse is used to define fields on response body
+// types.
+type AIPLegalHoldCollectionResponse []*AIPLegalHoldResponse
+
+// AIPLegalHoldResponse is used to define fields on response body types.
+type AIPLegalHoldResponse struct {
+	// Identifier of the legal hold
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+	// Reason of the legal hold
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// User who placed the legal hold
+	PlacedBy string `form:"placed_by" json:"placed_by" xml:"placed_by"`
+	// Placement datetime
+	PlacedAt string `form:"placed_at" json:"placed_at" xml:"placed_at"`
+	// User who released the legal hold
+	ReleasedBy *string `form:"released_by,omitempty" json:"released_by,omitempty" xml:"released_by,omitempty"`
+	// Reason of the legal hold release
+	ReleaseReason *string `form:"release_reason,omitempty" json:"release_reason,omitempty" xml:"release_reason,omitempty"`
+	// Release datetime
+	ReleasedAt *string `form:"released_at,omitempty" json:"released_at,omitempty" xml:"released_at,omitempty"`
 }
 
 // AMSSConfigRequestBody is used to define fields on request body types.
@@ -1038,6 +1444,11 @@ type AMSSConfigRequestBody struct {
 	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 }
 
+// FilesystemConfigRequestBody is used to define fields on request body types.
+type FilesystemConfigRequestBody struct {
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+}
+
 // S3ConfigRequestBody is used to define fields on request body types.
 type S3ConfigRequestBody struct {
 	Bucket    *string `form:"bucket,omitempty" json:"bucket,omitempty" xml:"bucket,omitempty"`
@@ -1063,13 +1474,24 @@ type URLConfigRequestBody struct {
 	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
 }
 
+// SearchResultResponseBody is used to define fields on response body types.
+type SearchResultResponseBody struct {
+	// Identifier of the package
+	UUID uuid.UUID `form:"uuid" json:"uuid" xml:"uuid"`
+	// Name of the package
+	Name string `form:"name" json:"name" xml:"name"`
+	// Indexed metadata of the package, keyed by field name
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
 // Config is a sum-type union.
 type Config struct {
-	kind ConfigKind
-	Amss *AMSSConfigResponseBody
-	S3   *S3ConfigResponseBody
-	Sftp *SFTPConfigResponseBody
-	URL  *URLConfigResponseBody
+	kind       ConfigKind
+	Amss       *AMSSConfigResponseBody
+	Filesystem *FilesystemConfigResponseBody
+	S3         *S3ConfigResponseBody
+	Sftp       *SFTPConfigResponseBody
+	URL        *URLConfigResponseBody
 }
 
 // ConfigKind enumerates the union variants for Config.
@@ -1078,6 +1500,8 @@ type ConfigKind string
 const (
 	// ConfigKindAmss identifies the amss branch of the union.
 	ConfigKindAmss ConfigKind = "amss"
+	// ConfigKindFilesystem identifies the filesystem branch of the union.
+	ConfigKindFilesystem ConfigKind = "filesystem"
 	// ConfigKindS3 identifies the s3 branch of the union.
 	ConfigKindS3 ConfigKind = "s3"
 	// ConfigKindSftp identifies the sftp branch of the union.
@@ -1113,6 +1537,28 @@ func (u *Config) SetAmss(v *AMSSConfigResponseBody) {
 	u.Amss = v
 }
 
+// NewConfigFilesystem constructs a Config with the filesystem branch set.
+func NewConfigFilesystem(v *FilesystemConfigResponseBody) Config {
+	return Config{
+		kind:       ConfigKindFilesystem,
+		Filesystem: v,
+	}
+}
+
+// AsFilesystem returns the value of the filesystem branch if set.
+func (u Config) AsFilesystem() (_ *FilesystemConfigResponseBody, ok bool) {
+	if u.kind != ConfigKindFilesystem {
+		return
+	}
+	return u.Filesystem, true
+}
+
+// SetFilesystem sets the filesystem branch of the union.
+func (u *Config) SetFilesystem(v *FilesystemConfigResponseBody) {
+	u.kind = ConfigKindFilesystem
+	u.Filesystem = v
+}
+
 // NewConfigS3 constructs a Config with the s3 branch set.
 func NewConfigS3(v *S3ConfigResponseBody) Config {
 	return Config{
@@ -1185,12 +1631,15 @@ func (u Config) Validate() error {
 	case "":
 		return goa.InvalidEnumValueError("type", "", []any{
 			string(ConfigKindAmss),
+			string(ConfigKindFilesystem),
 			string(ConfigKindS3),
 			string(ConfigKindSftp),
 			string(ConfigKindURL),
 		})
 	case ConfigKindAmss:
 		return nil
+	case ConfigKindFilesystem:
+		return nil
 	case ConfigKindS3:
 		return nil
 	case ConfigKindSftp:
@@ -1200,6 +1649,7 @@ func (u Config) Validate() error {
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(ConfigKindAmss),
+			string(ConfigKindFilesystem),
 			string(ConfigKindS3),
 			string(ConfigKindSftp),
 			string(ConfigKindURL),
@@ -1218,6 +1668,8 @@ func (u Config) MarshalJSON() ([]byte, error) {
 	switch u.kind {
 	case ConfigKindAmss:
 		value = u.Amss
+	case ConfigKindFilesystem:
+		value = u.Filesystem
 	case ConfigKindS3:
 		value = u.S3
 	case ConfigKindSftp:
@@ -1253,6 +1705,13 @@ func (u *Config) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = ConfigKindAmss
 		u.Amss = v
+	case string(ConfigKindFilesystem):
+		var v *FilesystemConfigResponseBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ConfigKindFilesystem
+		u.Filesystem = v
 	case string(ConfigKindS3):
 		var v *S3ConfigResponseBody
 		if err := json.Unmarshal(raw.Value, &v); err != nil {
@@ -1282,11 +1741,12 @@ func (u *Config) UnmarshalJSON(data []byte) error {
 
 // Config2 is a sum-type union.
 type Config2 struct {
-	kind Config2Kind
-	Amss *AMSSConfigRequestBody
-	S3   *S3ConfigRequestBody
-	Sftp *SFTPConfigRequestBody
-	URL  *URLConfigRequestBody
+	kind       Config2Kind
+	Amss       *AMSSConfigRequestBody
+	Filesystem *FilesystemConfigRequestBody
+	S3         *S3ConfigRequestBody
+	Sftp       *SFTPConfigRequestBody
+	URL        *URLConfigRequestBody
 }
 
 // Config2Kind enumerates the union variants for Config2.
@@ -1295,6 +1755,8 @@ type Config2Kind string
 const (
 	// Config2KindAmss identifies the amss branch of the union.
 	Config2KindAmss Config2Kind = "amss"
+	// Config2KindFilesystem identifies the filesystem branch of the union.
+	Config2KindFilesystem Config2Kind = "filesystem"
 	// Config2KindS3 identifies the s3 branch of the union.
 	Config2KindS3 Config2Kind = "s3"
 	// Config2KindSftp identifies the sftp branch of the union.
@@ -1330,6 +1792,28 @@ func (u *Config2) SetAmss(v *AMSSConfigRequestBody) {
 	u.Amss = v
 }
 
+// NewConfig2Filesystem constructs a Config2 with the filesystem branch set.
+func NewConfig2Filesystem(v *FilesystemConfigRequestBody) Config2 {
+	return Config2{
+		kind:       Config2KindFilesystem,
+		Filesystem: v,
+	}
+}
+
+// AsFilesystem returns the value of the filesystem branch if set.
+func (u Config2) AsFilesystem() (_ *FilesystemConfigRequestBody, ok bool) {
+	if u.kind != Config2KindFilesystem {
+		return
+	}
+	return u.Filesystem, true
+}
+
+// SetFilesystem sets the filesystem branch of the union.
+func (u *Config2) SetFilesystem(v *FilesystemConfigRequestBody) {
+	u.kind = Config2KindFilesystem
+	u.Filesystem = v
+}
+
 // NewConfig2S3 constructs a Config2 with the s3 branch set.
 func NewConfig2S3(v *S3ConfigRequestBody) Config2 {
 	return Config2{
@@ -1402,12 +1886,15 @@ func (u Config2) Validate() error {
 	case "":
 		return goa.InvalidEnumValueError("type", "", []any{
 			string(Config2KindAmss),
+			string(Config2KindFilesystem),
 			string(Config2KindS3),
 			string(Config2KindSftp),
 			string(Config2KindURL),
 		})
 	case Config2KindAmss:
 		return nil
+	case Config2KindFilesystem:
+		return nil
 	case Config2KindS3:
 		return nil
 	case Config2KindSftp:
@@ -1417,6 +1904,7 @@ func (u Config2) Validate() error {
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(Config2KindAmss),
+			string(Config2KindFilesystem),
 			string(Config2KindS3),
 			string(Config2KindSftp),
 			string(Config2KindURL),
@@ -1435,6 +1923,8 @@ func (u Config2) MarshalJSON() ([]byte, error) {
 	switch u.kind {
 	case Config2KindAmss:
 		value = u.Amss
+	case Config2KindFilesystem:
+		value = u.Filesystem
 	case Config2KindS3:
 		value = u.S3
 	case Config2KindSftp:
@@ -1470,6 +1960,13 @@ func (u *Config2) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = Config2KindAmss
 		u.Amss = v
+	case string(Config2KindFilesystem):
+		var v *FilesystemConfigRequestBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = Config2KindFilesystem
+		u.Filesystem = v
 	case string(Config2KindS3):
 		var v *S3ConfigRequestBody
 		if err := json.Unmarshal(raw.Value, &v); err != nil {
@@ -1499,17 +1996,20 @@ func (u *Config2) UnmarshalJSON(data []byte) error {
 
 // Value is a sum-type union.
 type Value struct {
-	kind                    ValueKind
-	StoragePingEvent        *StoragePingEventResponseBody
-	LocationCreatedEvent    *LocationCreatedEventResponseBody
-	AipCreatedEvent         *AIPCreatedEventResponseBody
-	AipUpdatedEvent         *AIPUpdatedEventResponseBody
-	AipStatusUpdatedEvent   *AIPStatusUpdatedEventResponseBody
-	AipLocationUpdatedEvent *AIPLocationUpdatedEventResponseBody
-	AipWorkflowCreatedEvent *AIPWorkflowCreatedEventResponseBody
-	AipWorkflowUpdatedEvent *AIPWorkflowUpdatedEventResponseBody
-	AipTaskCreatedEvent     *AIPTaskCreatedEventResponseBody
-	AipTaskUpdatedEvent     *AIPTaskUpdatedEventResponseBody
+	kind                           ValueKind
+	StoragePingEvent               *StoragePingEventResponseBody
+	LocationCreatedEvent           *LocationCreatedEventResponseBody
+	AipCreatedEvent                *AIPCreatedEventResponseBody
+	AipUpdatedEvent                *AIPUpdatedEventResponseBody
+	AipStatusUpdatedEvent          *AIPStatusUpdatedEventResponseBody
+	AipLocationUpdatedEvent        *AIPLocationUpdatedEventResponseBody
+	AipWorkflowCreatedEvent        *AIPWorkflowCreatedEventResponseBody
+	AipWorkflowUpdatedEvent        *AIPWorkflowUpdatedEventResponseBody
+	AipTaskCreatedEvent            *AIPTaskCreatedEventResponseBody
+	AipTaskUpdatedEvent            *AIPTaskUpdatedEventResponseBody
+	AipDeletionRequestCreatedEvent *AIPDeletionRequestCreatedEventResponseBody
+	AipDeletionRequestUpdatedEvent *AIPDeletionRequestUpdatedEventResponseBody
+	AipFixityCheckedEvent          *AIPFixityCheckedEventResponseBody
 }
 
 // ValueKind enumerates the union variants for Value.
@@ -1536,6 +2036,12 @@ const (
 	ValueKindAipTaskCreatedEvent ValueKind = "aip_task_created_event"
 	// ValueKindAipTaskUpdatedEvent identifies the aip_task_updated_event branch of the union.
 	ValueKindAipTaskUpdatedEvent ValueKind = "aip_task_updated_event"
+	// ValueKindAipDeletionRequestCreatedEvent identifies the aip_deletion_request_created_event branch of the union.
+	ValueKindAipDeletionRequestCreatedEvent ValueKind = "aip_deletion_request_created_event"
+	// ValueKindAipDeletionRequestUpdatedEvent identifies the aip_deletion_request_updated_event branch of the union.
+	ValueKindAipDeletionRequestUpdatedEvent ValueKind = "aip_deletion_request_updated_event"
+	// ValueKindAipFixityCheckedEvent identifies the aip_fixity_checked_event branch of the union.
+	ValueKindAipFixityCheckedEvent ValueKind = "aip_fixity_checked_event"
 )
 
 // Kind returns the discriminator value of the union.
@@ -1763,6 +2269,72 @@ func (u *Value) SetAipTaskUpdatedEvent(v *AIPTaskUpdatedEventResponseBody) {
 	u.AipTaskUpdatedEvent = v
 }
 
+// NewValueAipDeletionRequestCreatedEvent constructs a Value with the aip_deletion_request_created_event branch set.
+func NewValueAipDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEventResponseBody) Value {
+	return Value{
+		kind:                           ValueKindAipDeletionRequestCreatedEvent,
+		AipDeletionRequestCreatedEvent: v,
+	}
+}
+
+// AsAipDeletionRequestCreatedEvent returns the value of the aip_deletion_request_created_event branch if set.
+func (u Value) AsAipDeletionRequestCreatedEvent() (_ *AIPDeletionRequestCreatedEventResponseBody, ok bool) {
+	if u.kind != ValueKindAipDeletionRequestCreatedEvent {
+		return
+	}
+	return u.AipDeletionRequestCreatedEvent, true
+}
+
+// SetAipDeletionRequestCreatedEvent sets the aip_deletion_request_created_event branch of the union.
+func (u *Value) SetAipDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEventResponseBody) {
+	u.kind = ValueKindAipDeletionRequestCreatedEvent
+	u.AipDeletionRequestCreatedEvent = v
+}
+
+// NewValueAipDeletionRequestUpdatedEvent constructs a Value with the aip_deletion_request_updated_event branch set.
+func NewValueAipDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEventResponseBody) Value {
+	return Value{
+		kind:                           ValueKindAipDeletionRequestUpdatedEvent,
+		AipDeletionRequestUpdatedEvent: v,
+	}
+}
+
+// AsAipDeletionRequestUpdatedEvent returns the value of the aip_deletion_request_updated_event branch if set.
+func (u Value) AsAipDeletionRequestUpdatedEvent() (_ *AIPDeletionRequestUpdatedEventResponseBody, ok bool) {
+	if u.kind != ValueKindAipDeletionRequestUpdatedEvent {
+		return
+	}
+	return u.AipDeletionRequestUpdatedEvent, true
+}
+
+// SetAipDeletionRequestUpdatedEvent sets the aip_deletion_request_updated_event branch of the union.
+func (u *Value) SetAipDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEventResponseBody) {
+	u.kind = ValueKindAipDeletionRequestUpdatedEvent
+	u.AipDeletionRequestUpdatedEvent = v
+}
+
+// NewValueAipFixityCheckedEvent constructs a Value with the aip_fixity_checked_event branch set.
+func NewValueAipFixityCheckedEvent(v *AIPFixityCheckedEventResponseBody) Value {
+	return Value{
+		kind:                  ValueKindAipFixityCheckedEvent,
+		AipFixityCheckedEvent: v,
+	}
+}
+
+// AsAipFixityCheckedEvent returns the value of the aip_fixity_checked_event branch if set.
+func (u Value) AsAipFixityCheckedEvent() (_ *AIPFixityCheckedEventResponseBody, ok bool) {
+	if u.kind != ValueKindAipFixityCheckedEvent {
+		return
+	}
+	return u.AipFixityCheckedEvent, true
+}
+
+// SetAipFixityCheckedEvent sets the aip_fixity_checked_event branch of the union.
+func (u *Value) SetAipFixityCheckedEvent(v *AIPFixityCheckedEventResponseBody) {
+	u.kind = ValueKindAipFixityCheckedEvent
+	u.AipFixityCheckedEvent = v
+}
+
 // Validate ensures the union discriminant is valid.
 func (u Value) Validate() error {
 	switch u.kind {
@@ -1778,6 +2350,9 @@ func (u Value) Validate() error {
 			string(ValueKindAipWorkflowUpdatedEvent),
 			string(ValueKindAipTaskCreatedEvent),
 			string(ValueKindAipTaskUpdatedEvent),
+			string(ValueKindAipDeletionRequestCreatedEvent),
+			string(ValueKindAipDeletionRequestUpdatedEvent),
+			string(ValueKindAipFixityCheckedEvent),
 		})
 	case ValueKindStoragePingEvent:
 		return nil
@@ -1799,6 +2374,12 @@ func (u Value) Validate() error {
 		return nil
 	case ValueKindAipTaskUpdatedEvent:
 		return nil
+	case ValueKindAipDeletionRequestCreatedEvent:
+		return nil
+	case ValueKindAipDeletionRequestUpdatedEvent:
+		return nil
+	case ValueKindAipFixityCheckedEvent:
+		return nil
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(ValueKindStoragePingEvent),
@@ -1811,6 +2392,9 @@ func (u Value) Validate() error {
 			string(ValueKindAipWorkflowUpdatedEvent),
 			string(ValueKindAipTaskCreatedEvent),
 			string(ValueKindAipTaskUpdatedEvent),
+			string(ValueKindAipDeletionRequestCreatedEvent),
+			string(ValueKindAipDeletionRequestUpdatedEvent),
+			string(ValueKindAipFixityCheckedEvent),
 		})
 	}
 }
@@ -1844,6 +2428,12 @@ func (u Value) MarshalJSON() ([]byte, error) {
 		value = u.AipTaskCreatedEvent
 	case ValueKindAipTaskUpdatedEvent:
 		value = u.AipTaskUpdatedEvent
+	case ValueKindAipDeletionRequestCreatedEvent:
+		value = u.AipDeletionRequestCreatedEvent
+	case ValueKindAipDeletionRequestUpdatedEvent:
+		value = u.AipDeletionRequestUpdatedEvent
+	case ValueKindAipFixityCheckedEvent:
+		value = u.AipFixityCheckedEvent
 	default:
 		return nil, fmt.Errorf("unexpected Value discriminant %q", u.kind)
 	}
@@ -1936,6 +2526,27 @@ func (u *Value) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = ValueKindAipTaskUpdatedEvent
 		u.AipTaskUpdatedEvent = v
+	case string(ValueKindAipDeletionRequestCreatedEvent):
+		var v *AIPDeletionRequestCreatedEventResponseBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipDeletionRequestCreatedEvent
+		u.AipDeletionRequestCreatedEvent = v
+	case string(ValueKindAipDeletionRequestUpdatedEvent):
+		var v *AIPDeletionRequestUpdatedEventResponseBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipDeletionRequestUpdatedEvent
+		u.AipDeletionRequestUpdatedEvent = v
+	case string(ValueKindAipFixityCheckedEvent):
+		var v *AIPFixityCheckedEventResponseBody
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipFixityCheckedEvent
+		u.AipFixityCheckedEvent = v
 	default:
 		return fmt.Errorf("unexpected Value type %q", raw.Type)
 	}
@@ -2008,6 +2619,24 @@ func NewMonitorResponseBody(res *storage.StorageEvent) *MonitorResponseBody {
 			u := body.Value
 			u.SetAipTaskUpdatedEvent((*AIPTaskUpdatedEventResponseBody)(obj))
 			body.Value = u
+		case "aip_deletion_request_created_event":
+			actual, _ := res.Value.AsAipDeletionRequestCreatedEvent()
+			obj := marshalStorageAIPDeletionRequestCreatedEventToAIPDeletionRequestCreatedEventResponseBody(actual)
+			u := body.Value
+			u.SetAipDeletionRequestCreatedEvent((*AIPDeletionRequestCreatedEventResponseBody)(obj))
+			body.Value = u
+		case "aip_deletion_request_updated_event":
+			actual, _ := res.Value.AsAipDeletionRequestUpdatedEvent()
+			obj := marshalStorageAIPDeletionRequestUpdatedEventToAIPDeletionRequestUpdatedEventResponseBody(actual)
+			u := body.Value
+			u.SetAipDeletionRequestUpdatedEvent((*AIPDeletionRequestUpdatedEventResponseBody)(obj))
+			body.Value = u
+		case "aip_fixity_checked_event":
+			actual, _ := res.Value.AsAipFixityCheckedEvent()
+			obj := marshalStorageAIPFixityCheckedEventToAIPFixityCheckedEventResponseBody(actual)
+			u := body.Value
+			u.SetAipFixityCheckedEvent((*AIPFixityCheckedEventResponseBody)(obj))
+			body.Value = u
 		}
 	}
 	return body
@@ -2046,6 +2675,37 @@ func NewCreateAipResponseBody(res *storageviews.AIPView) *CreateAipResponseBody
 		LocationUUID:      res.LocationUUID,
 		CreatedAt:         *res.CreatedAt,
 		DeletionReportKey: res.DeletionReportKey,
+		DisposalAt:        res.DisposalAt,
+		Size:              res.Size,
+		FileCount:         res.FileCount,
+	}
+	if res.Replicas != nil {
+		body.Replicas = make([]*AIPReplicaResponseBody, len(res.Replicas))
+		for i, val := range res.Replicas {
+			if val == nil {
+				body.Replicas[i] = nil
+				continue
+			}
+			body.Replicas[i] = marshalStorageviewsAIPReplicaViewToAIPReplicaResponseBody(val)
+		}
+	}
+	if res.LegalHolds != nil {
+		body.LegalHolds = make([]*AIPLegalHoldResponseBody, len(res.LegalHolds))
+		for i, val := range res.LegalHolds {
+			if val == nil {
+				body.LegalHolds[i] = nil
+				continue
+			}
+			body.LegalHolds[i] = marshalStorageviewsAIPLegalHoldViewToAIPLegalHoldResponseBody(val)
+		}
+	}
+	if res.CustomMetadata != nil {
+		body.CustomMetadata = make(map[string]any, len(res.CustomMetadata))
+		for key, val := range res.CustomMetadata {
+			tk := key
+			tv := val
+			body.CustomMetadata[tk] = tv
+		}
 	}
 	return body
 }
@@ -2070,6 +2730,37 @@ func NewShowAipResponseBody(res *storageviews.AIPView) *ShowAipResponseBody {
 		LocationUUID:      res.LocationUUID,
 		CreatedAt:         *res.CreatedAt,
 		DeletionReportKey: res.DeletionReportKey,
+		DisposalAt:        res.DisposalAt,
+		Size:              res.Size,
+		FileCount:         res.FileCount,
+	}
+	if res.Replicas != nil {
+		body.Replicas = make([]*AIPReplicaResponseBody, len(res.Replicas))
+		for i, val := range res.Replicas {
+			if val == nil {
+				body.Replicas[i] = nil
+				continue
+			}
+			body.Replicas[i] = marshalStorageviewsAIPReplicaViewToAIPReplicaResponseBody(val)
+		}
+	}
+	if res.LegalHolds != nil {
+		body.LegalHolds = make([]*AIPLegalHoldResponseBody, len(res.LegalHolds))
+		for i, val := range res.LegalHolds {
+			if val == nil {
+				body.LegalHolds[i] = nil
+				continue
+			}
+			body.LegalHolds[i] = marshalStorageviewsAIPLegalHoldViewToAIPLegalHoldResponseBody(val)
+		}
+	}
+	if res.CustomMetadata != nil {
+		body.CustomMetadata = make(map[string]any, len(res.CustomMetadata))
+		for key, val := range res.CustomMetadata {
+			tk := key
+			tv := val
+			body.CustomMetadata[tk] = tv
+		}
 	}
 	return body
 }
@@ -2125,6 +2816,25 @@ func NewShowLocationResponseBody(res *storageviews.LocationView) *ShowLocationRe
 		UUID:        *res.UUID,
 		CreatedAt:   *res.CreatedAt,
 	}
+	if res.ReplicationTargets != nil {
+		body.ReplicationTargets = make([]string, len(res.ReplicationTargets))
+		for i, val := range res.ReplicationTargets {
+			body.ReplicationTargets[i] = val
+		}
+	}
+	return body
+}
+
+// NewLocationUsageResponseBody builds the HTTP response body from the result
+// of the "location_usage" endpoint of the "storage" service.
+func NewLocationUsageResponseBody(res *storage.LocationUsage) *LocationUsageResponseBody {
+	body := &LocationUsageResponseBody{
+		AipCount:      res.AipCount,
+		Size:          res.Size,
+		FileCount:     res.FileCount,
+		Quota:         res.Quota,
+		QuotaExceeded: res.QuotaExceeded,
+	}
 	return body
 }
 
@@ -2142,6 +2852,28 @@ func NewAIPResponseCollection(res storageviews.AIPCollectionView) AIPResponseCol
 	return body
 }
 
+// NewSearchResponseBody builds the HTTP response body from the result of the
+// "search" endpoint of the "storage" service.
+func NewSearchResponseBody(res *storage.SearchResults) *SearchResponseBody {
+	body := &SearchResponseBody{}
+	if res.Items != nil {
+		body.Items = make([]*SearchResultResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			if val == nil {
+				body.Items[i] = nil
+				continue
+			}
+			body.Items[i] = marshalStorageSearchResultToSearchResultResponseBody(val)
+		}
+	} else {
+		body.Items = []*SearchResultResponseBody{}
+	}
+	if res.Page != nil {
+		body.Page = marshalStorageEnduroPageToEnduroPageResponseBody(res.Page)
+	}
+	return body
+}
+
 // NewMonitorInternalErrorResponseBody builds the HTTP response body from the
 // result of the "monitor" endpoint of the "storage" service.
 func NewMonitorInternalErrorResponseBody(res *goa.ServiceError) *MonitorInternalErrorResponseBody {
@@ -2337,6 +3069,44 @@ func NewMoveAipStatusNotFoundResponseBody(res *storage.AIPNotFound) *MoveAipStat
 	return body
 }
 
+// NewRestoreAipsNotAvailableResponseBody builds the HTTP response body from the
+// result of the "restore_aips" endpoint of the "storage" service.
+func NewRestoreAipsNotAvailableResponseBody(res *goa.ServiceError) *RestoreAipsNotAvailableResponseBody {
+	body := &RestoreAipsNotAvailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRestoreAipsNotValidResponseBody builds the HTTP response body from the result
+// of the "restore_aips" endpoint of the "storage" service.
+func NewRestoreAipsNotValidResponseBody(res *goa.ServiceError) *RestoreAipsNotValidResponseBody {
+	body := &RestoreAipsNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRestoreAipsNotFoundResponseBody builds the HTTP response body from the result
+// of the "restore_aips" endpoint of the "storage" service.
+func NewRestoreAipsNotFoundResponseBody(res *storage.AIPNotFound) *RestoreAipsNotFoundResponseBody {
+	body := &RestoreAipsNotFoundResponseBody{
+		Message: res.Message,
+		UUID:    res.UUID,
+	}
+	return body
+}
+
 // NewRejectAipNotAvailableResponseBody builds the HTTP response body from the
 // result of the "reject_aip" endpoint of the "storage" service.
 func NewRejectAipNotAvailableResponseBody(res *goa.ServiceError) *RejectAipNotAvailableResponseBody {
@@ -2447,6 +3217,34 @@ func NewRequestAipDeletionNotValidResponseBody(res *goa.ServiceError) *RequestAi
 	return body
 }
 
+// NewPlaceAipLegalHoldNotValidResponseBody builds the HTTP response body from
+// the result of the "place_aip_legal_hold" endpoint of the "storage" service.
+func NewPlaceAipLegalHoldNotValidResponseBody(res *goa.ServiceError) *PlaceAipLegalHoldNotValidResponseBody {
+	body := &PlaceAipLegalHoldNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewReleaseAipLegalHoldNotValidResponseBody builds the HTTP response body from
+// the result of the "release_aip_legal_hold" endpoint of the "storage" service.
+func NewReleaseAipLegalHoldNotValidResponseBody(res *goa.ServiceError) *ReleaseAipLegalHoldNotValidResponseBody {
+	body := &ReleaseAipLegalHoldNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewRequestAipDeletionInternalErrorResponseBody builds the HTTP response body
 // from the result of the "request_aip_deletion" endpoint of the "storage"
 // service.
@@ -2462,6 +3260,36 @@ func NewRequestAipDeletionInternalErrorResponseBody(res *goa.ServiceError) *Requ
 	return body
 }
 
+// NewPlaceAipLegalHoldInternalErrorResponseBody builds the HTTP response body
+// from the result of the "place_aip_legal_hold" endpoint of the "storage"
+// service.
+func NewPlaceAipLegalHoldInternalErrorResponseBody(res *goa.ServiceError) *PlaceAipLegalHoldInternalErrorResponseBody {
+	body := &PlaceAipLegalHoldInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewReleaseAipLegalHoldInternalErrorResponseBody builds the HTTP response body
+// from the result of the "release_aip_legal_hold" endpoint of the "storage"
+// service.
+func NewReleaseAipLegalHoldInternalErrorResponseBody(res *goa.ServiceError) *ReleaseAipLegalHoldInternalErrorResponseBody {
+	body := &ReleaseAipLegalHoldInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewRequestAipDeletionNotFoundResponseBody builds the HTTP response body from
 // the result of the "request_aip_deletion" endpoint of the "storage" service.
 func NewRequestAipDeletionNotFoundResponseBody(res *storage.AIPNotFound) *RequestAipDeletionNotFoundResponseBody {
@@ -2472,6 +3300,26 @@ func NewRequestAipDeletionNotFoundResponseBody(res *storage.AIPNotFound) *Reques
 	return body
 }
 
+// NewPlaceAipLegalHoldNotFoundResponseBody builds the HTTP response body from
+// the result of the "place_aip_legal_hold" endpoint of the "storage" service.
+func NewPlaceAipLegalHoldNotFoundResponseBody(res *storage.AIPNotFound) *PlaceAipLegalHoldNotFoundResponseBody {
+	body := &PlaceAipLegalHoldNotFoundResponseBody{
+		Message: res.Message,
+		UUID:    res.UUID,
+	}
+	return body
+}
+
+// NewReleaseAipLegalHoldNotFoundResponseBody builds the HTTP response body from
+// the result of the "release_aip_legal_hold" endpoint of the "storage" service.
+func NewReleaseAipLegalHoldNotFoundResponseBody(res *storage.AIPNotFound) *ReleaseAipLegalHoldNotFoundResponseBody {
+	body := &ReleaseAipLegalHoldNotFoundResponseBody{
+		Message: res.Message,
+		UUID:    res.UUID,
+	}
+	return body
+}
+
 // NewReviewAipDeletionNotValidResponseBody builds the HTTP response body from
 // the result of the "review_aip_deletion" endpoint of the "storage" service.
 func NewReviewAipDeletionNotValidResponseBody(res *goa.ServiceError) *ReviewAipDeletionNotValidResponseBody {
@@ -2686,6 +3534,30 @@ func NewListLocationAipsNotFoundResponseBody(res *storage.LocationNotFound) *Lis
 	return body
 }
 
+// NewLocationUsageNotFoundResponseBody builds the HTTP response body from the
+// result of the "location_usage" endpoint of the "storage" service.
+func NewLocationUsageNotFoundResponseBody(res *storage.LocationNotFound) *LocationUsageNotFoundResponseBody {
+	body := &LocationUsageNotFoundResponseBody{
+		Message: res.Message,
+		UUID:    res.UUID,
+	}
+	return body
+}
+
+// NewSearchNotValidResponseBody builds the HTTP response body from the result
+// of the "search" endpoint of the "storage" service.
+func NewSearchNotValidResponseBody(res *goa.ServiceError) *SearchNotValidResponseBody {
+	body := &SearchNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewMonitorPayload builds a storage service monitor endpoint payload.
 func NewMonitorPayload(token *string) *storage.MonitorPayload {
 	v := &storage.MonitorPayload{}
@@ -2695,12 +3567,13 @@ func NewMonitorPayload(token *string) *storage.MonitorPayload {
 }
 
 // NewListAipsPayload builds a storage service list_aips endpoint payload.
-func NewListAipsPayload(query *string, earliestCreatedTime *string, latestCreatedTime *string, status *string, limit *int, offset *int, token *string) *storage.ListAipsPayload {
+func NewListAipsPayload(query *string, earliestCreatedTime *string, latestCreatedTime *string, status *string, metadataKey *string, limit *int, offset *int, token *string) *storage.ListAipsPayload {
 	v := &storage.ListAipsPayload{}
 	v.Query = query
 	v.EarliestCreatedTime = earliestCreatedTime
 	v.LatestCreatedTime = latestCreatedTime
 	v.Status = status
+	v.MetadataKey = metadataKey
 	v.Limit = limit
 	v.Offset = offset
 	v.Token = token
@@ -2722,6 +3595,22 @@ func NewCreateAipPayload(body *CreateAipRequestBody, token *string) *storage.Cre
 	if body.Status == nil {
 		v.Status = "unspecified"
 	}
+	if body.Metadata != nil {
+		v.Metadata = make(map[string]string, len(body.Metadata))
+		for key, val := range body.Metadata {
+			tk := key
+			tv := val
+			v.Metadata[tk] = tv
+		}
+	}
+	if body.CustomMetadata != nil {
+		v.CustomMetadata = make(map[string]any, len(body.CustomMetadata))
+		for key, val := range body.CustomMetadata {
+			tk := key
+			tv := val
+			v.CustomMetadata[tk] = tv
+		}
+	}
 	v.Token = token
 
 	return v
@@ -2767,6 +3656,21 @@ func NewMoveAipStatusPayload(uuid string, token *string) *storage.MoveAipStatusP
 	return v
 }
 
+// NewRestoreAipsPayload builds a storage service restore_aips endpoint payload.
+func NewRestoreAipsPayload(body *RestoreAipsRequestBody, token *string) *storage.RestoreAipsPayload {
+	v := &storage.RestoreAipsPayload{
+		LocationUUID: body.LocationUUID,
+		Unpack:       body.Unpack,
+	}
+	v.AipUuids = make([]string, len(body.AipUuids))
+	for i, val := range body.AipUuids {
+		v.AipUuids[i] = val
+	}
+	v.Token = token
+
+	return v
+}
+
 // NewRejectAipPayload builds a storage service reject_aip endpoint payload.
 func NewRejectAipPayload(uuid string, token *string) *storage.RejectAipPayload {
 	v := &storage.RejectAipPayload{}
@@ -2822,6 +3726,30 @@ func NewRequestAipDeletionPayload(body *RequestAipDeletionRequestBody, uuid stri
 	return v
 }
 
+// NewPlaceAipLegalHoldPayload builds a storage service place_aip_legal_hold
+// endpoint payload.
+func NewPlaceAipLegalHoldPayload(body *PlaceAipLegalHoldRequestBody, uuid string, token *string) *storage.PlaceAipLegalHoldPayload {
+	v := &storage.PlaceAipLegalHoldPayload{
+		Reason: *body.Reason,
+	}
+	v.UUID = uuid
+	v.Token = token
+
+	return v
+}
+
+// NewReleaseAipLegalHoldPayload builds a storage service release_aip_legal_hold
+// endpoint payload.
+func NewReleaseAipLegalHoldPayload(body *ReleaseAipLegalHoldRequestBody, uuid string, token *string) *storage.ReleaseAipLegalHoldPayload {
+	v := &storage.ReleaseAipLegalHoldPayload{
+		Reason: *body.Reason,
+	}
+	v.UUID = uuid
+	v.Token = token
+
+	return v
+}
+
 // NewReviewAipDeletionPayload builds a storage service review_aip_deletion
 // endpoint payload.
 func NewReviewAipDeletionPayload(body *ReviewAipDeletionRequestBody, uuid string, token *string) *storage.ReviewAipDeletionPayload {
@@ -2892,6 +3820,12 @@ func NewCreateLocationPayload(body *CreateLocationRequestBody, token *string) *s
 			u := v.Config
 			u.SetAmss((*storage.AMSSConfig)(obj))
 			v.Config = u
+		case "filesystem":
+			actual, _ := body.Config.AsFilesystem()
+			obj := unmarshalFilesystemConfigRequestBodyToStorageFilesystemConfig(actual)
+			u := v.Config
+			u.SetFilesystem((*storage.FilesystemConfig)(obj))
+			v.Config = u
 		case "s3":
 			actual, _ := body.Config.AsS3()
 			obj := unmarshalS3ConfigRequestBodyToStorageS3Config(actual)
@@ -2912,6 +3846,12 @@ func NewCreateLocationPayload(body *CreateLocationRequestBody, token *string) *s
 			v.Config = u
 		}
 	}
+	if body.ReplicationTargets != nil {
+		v.ReplicationTargets = make([]string, len(body.ReplicationTargets))
+		for i, val := range body.ReplicationTargets {
+			v.ReplicationTargets[i] = val
+		}
+	}
 	v.Token = token
 
 	return v
@@ -2937,6 +3877,27 @@ func NewListLocationAipsPayload(uuid string, token *string) *storage.ListLocatio
 	return v
 }
 
+// NewSearchPayload builds a storage service search endpoint payload.
+func NewSearchPayload(query string, limit *int, offset *int, token *string) *storage.SearchPayload {
+	v := &storage.SearchPayload{}
+	v.Query = query
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
+
+// NewLocationUsagePayload builds a storage service location_usage endpoint
+// payload.
+func NewLocationUsagePayload(uuid string, token *string) *storage.LocationUsagePayload {
+	v := &storage.LocationUsagePayload{}
+	v.UUID = uuid
+	v.Token = token
+
+	return v
+}
+
 // ValidateCreateAipRequestBody runs the validations defined on
 // create_aip_request_body
 func ValidateCreateAipRequestBody(body *CreateAipRequestBody) (err error) {
@@ -2972,6 +3933,23 @@ func ValidateMoveAipRequestBody(body *MoveAipRequestBody) (err error) {
 	return
 }
 
+// ValidateRestoreAipsRequestBody runs the validations defined on
+// restore_aips_request_body
+func ValidateRestoreAipsRequestBody(body *RestoreAipsRequestBody) (err error) {
+	if body.AipUuids == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuids", "body"))
+	}
+	if body.AipUuids != nil {
+		if len(body.AipUuids) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.aip_uuids", body.AipUuids, len(body.AipUuids), 1, true))
+		}
+	}
+	for _, e := range body.AipUuids {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.aip_uuids[*]", e, goa.FormatUUID))
+	}
+	return
+}
+
 // ValidateAipDeletionAutoRequestBody runs the validations defined on
 // aip_deletion_auto_request_body
 func ValidateAipDeletionAutoRequestBody(body *AipDeletionAutoRequestBody) (err error) {
@@ -2990,6 +3968,24 @@ func ValidateRequestAipDeletionRequestBody(body *RequestAipDeletionRequestBody)
 	return
 }
 
+// ValidatePlaceAipLegalHoldRequestBody runs the validations defined on
+// place_aip_legal_hold_request_body
+func ValidatePlaceAipLegalHoldRequestBody(body *PlaceAipLegalHoldRequestBody) (err error) {
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	return
+}
+
+// ValidateReleaseAipLegalHoldRequestBody runs the validations defined on
+// release_aip_legal_hold_request_body
+func ValidateReleaseAipLegalHoldRequestBody(body *ReleaseAipLegalHoldRequestBody) (err error) {
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	return
+}
+
 // ValidateReviewAipDeletionRequestBody runs the validations defined on
 // review_aip_deletion_request_body
 func ValidateReviewAipDeletionRequestBody(body *ReviewAipDeletionRequestBody) (err error) {
@@ -3017,10 +4013,13 @@ func ValidateCreateLocationRequestBody(body *CreateLocationRequestBody) (err err
 		}
 	}
 	if body.Purpose != nil {
-		if !(*body.Purpose == "unspecified" || *body.Purpose == "aip_store") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.purpose", *body.Purpose, []any{"unspecified", "aip_store"}))
+		if !(*body.Purpose == "unspecified" || *body.Purpose == "aip_store" || *body.Purpose == "restore") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.purpose", *body.Purpose, []any{"unspecified", "aip_store", "restore"}))
 		}
 	}
+	for _, e := range body.ReplicationTargets {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replication_targets[*]", e, goa.FormatUUID))
+	}
 	switch string(body.Config.Kind()) {
 	case "amss":
 		actual, _ := body.Config.AsAmss()
@@ -3029,6 +4028,13 @@ func ValidateCreateLocationRequestBody(body *CreateLocationRequestBody) (err err
 				err = goa.MergeErrors(err, err2)
 			}
 		}
+	case "filesystem":
+		actual, _ := body.Config.AsFilesystem()
+		if actual != nil {
+			if err2 := ValidateFilesystemConfigRequestBody(actual); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	case "s3":
 		actual, _ := body.Config.AsS3()
 		if actual != nil {
@@ -3070,6 +4076,15 @@ func ValidateAMSSConfigRequestBody(body *AMSSConfigRequestBody) (err error) {
 	return
 }
 
+// ValidateFilesystemConfigRequestBody runs the validations defined on
+// FilesystemConfigRequestBody
+func ValidateFilesystemConfigRequestBody(body *FilesystemConfigRequestBody) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	return
+}
+
 // ValidateS3ConfigRequestBody runs the validations defined on
 // S3ConfigRequestBody
 func ValidateS3ConfigRequestBody(body *S3ConfigRequestBody) (err error) {
@@ -23,10 +23,15 @@ type Client struct {
 	ListSipWorkflowsEndpoint     goa.Endpoint
 	ConfirmSipEndpoint           goa.Endpoint
 	RejectSipEndpoint            goa.Endpoint
+	RetrySipEndpoint             goa.Endpoint
+	CancelSipEndpoint            goa.Endpoint
 	ShowSipDecisionEndpoint      goa.Endpoint
 	SubmitSipDecisionEndpoint    goa.Endpoint
 	AddSipEndpoint               goa.Endpoint
 	UploadSipEndpoint            goa.Endpoint
+	CreateSipUploadEndpoint      goa.Endpoint
+	ShowSipUploadEndpoint        goa.Endpoint
+	UploadSipChunkEndpoint       goa.Endpoint
 	DownloadSipRequestEndpoint   goa.Endpoint
 	DownloadSipEndpoint          goa.Endpoint
 	ListUsersEndpoint            goa.Endpoint
@@ -35,10 +40,15 @@ type Client struct {
 	ListBatchesEndpoint          goa.Endpoint
 	ShowBatchEndpoint            goa.Endpoint
 	ReviewBatchEndpoint          goa.Endpoint
+	RetryBatchEndpoint           goa.Endpoint
+	CreateAPITokenEndpoint       goa.Endpoint
+	ListAPITokensEndpoint        goa.Endpoint
+	RevokeAPITokenEndpoint       goa.Endpoint
+	SearchEndpoint               goa.Endpoint
 }
 
 // NewClient initializes a "ingest" service client given the endpoints.
-func NewClient(monitor, listSips, showSip, listSipWorkflows, confirmSip, rejectSip, showSipDecision, submitSipDecision, addSip, uploadSip, downloadSipRequest, downloadSip, listUsers, listSipSourceObjects, addBatch, listBatches, showBatch, reviewBatch goa.Endpoint) *Client {
+func NewClient(monitor, listSips, showSip, listSipWorkflows, confirmSip, rejectSip, retrySip, cancelSip, showSipDecision, submitSipDecision, addSip, uploadSip, createSipUpload, showSipUpload, uploadSipChunk, downloadSipRequest, downloadSip, listUsers, listSipSourceObjects, addBatch, listBatches, showBatch, reviewBatch, retryBatch, createAPIToken, listAPITokens, revokeAPIToken, search goa.Endpoint) *Client {
 	return &Client{
 		MonitorEndpoint:              monitor,
 		ListSipsEndpoint:             listSips,
@@ -46,10 +56,15 @@ func NewClient(monitor, listSips, showSip, listSipWorkflows, confirmSip, rejectS
 		ListSipWorkflowsEndpoint:     listSipWorkflows,
 		ConfirmSipEndpoint:           confirmSip,
 		RejectSipEndpoint:            rejectSip,
+		RetrySipEndpoint:             retrySip,
+		CancelSipEndpoint:            cancelSip,
 		ShowSipDecisionEndpoint:      showSipDecision,
 		SubmitSipDecisionEndpoint:    submitSipDecision,
 		AddSipEndpoint:               addSip,
 		UploadSipEndpoint:            uploadSip,
+		CreateSipUploadEndpoint:      createSipUpload,
+		ShowSipUploadEndpoint:        showSipUpload,
+		UploadSipChunkEndpoint:       uploadSipChunk,
 		DownloadSipRequestEndpoint:   downloadSipRequest,
 		DownloadSipEndpoint:          downloadSip,
 		ListUsersEndpoint:            listUsers,
@@ -58,6 +73,11 @@ func NewClient(monitor, listSips, showSip, listSipWorkflows, confirmSip, rejectS
 		ListBatchesEndpoint:          listBatches,
 		ShowBatchEndpoint:            showBatch,
 		ReviewBatchEndpoint:          reviewBatch,
+		RetryBatchEndpoint:           retryBatch,
+		CreateAPITokenEndpoint:       createAPIToken,
+		ListAPITokensEndpoint:        listAPITokens,
+		RevokeAPITokenEndpoint:       revokeAPIToken,
+		SearchEndpoint:               search,
 	}
 }
 
@@ -149,6 +169,32 @@ func (c *Client) RejectSip(ctx context.Context, p *RejectSipPayload) (err error)
 	return
 }
 
+// RetrySip calls the "retry_sip" endpoint of the "ingest" service.
+// RetrySip may return the following errors:
+//   - "not_found" (type *SIPNotFound): SIP not found
+//   - "not_available" (type *goa.ServiceError)
+//   - "not_valid" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) RetrySip(ctx context.Context, p *RetrySipPayload) (err error) {
+	_, err = c.RetrySipEndpoint(ctx, p)
+	return
+}
+
+// CancelSip calls the "cancel_sip" endpoint of the "ingest" service.
+// CancelSip may return the following errors:
+//   - "not_found" (type *SIPNotFound): SIP not found
+//   - "not_available" (type *goa.ServiceError)
+//   - "not_valid" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) CancelSip(ctx context.Context, p *CancelSipPayload) (err error) {
+	_, err = c.CancelSipEndpoint(ctx, p)
+	return
+}
+
 // ShowSipDecision calls the "show_sip_decision" endpoint of the "ingest"
 // service.
 // ShowSipDecision may return the following errors:
@@ -216,6 +262,58 @@ func (c *Client) UploadSip(ctx context.Context, p *UploadSipPayload, req io.Read
 	return ires.(*UploadSipResult), nil
 }
 
+// CreateSipUpload calls the "create_sip_upload" endpoint of the "ingest"
+// service.
+// CreateSipUpload may return the following errors:
+//   - "not_valid" (type *goa.ServiceError)
+//   - "internal_error" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) CreateSipUpload(ctx context.Context, p *CreateSipUploadPayload) (res *SIPUpload, err error) {
+	var ires any
+	ires, err = c.CreateSipUploadEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SIPUpload), nil
+}
+
+// ShowSipUpload calls the "show_sip_upload" endpoint of the "ingest" service.
+// ShowSipUpload may return the following errors:
+//   - "not_found" (type *goa.ServiceError)
+//   - "not_valid" (type *goa.ServiceError)
+//   - "internal_error" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) ShowSipUpload(ctx context.Context, p *ShowSipUploadPayload) (res *SIPUpload, err error) {
+	var ires any
+	ires, err = c.ShowSipUploadEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SIPUpload), nil
+}
+
+// UploadSipChunk calls the "upload_sip_chunk" endpoint of the "ingest" service.
+// UploadSipChunk may return the following errors:
+//   - "not_found" (type *goa.ServiceError)
+//   - "not_valid" (type *goa.ServiceError)
+//   - "not_available" (type *goa.ServiceError)
+//   - "internal_error" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) UploadSipChunk(ctx context.Context, p *UploadSipChunkPayload, req io.ReadCloser) (res *SIPUpload, err error) {
+	var ires any
+	ires, err = c.UploadSipChunkEndpoint(ctx, &UploadSipChunkRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*SIPUpload), nil
+}
+
 // DownloadSipRequest calls the "download_sip_request" endpoint of the "ingest"
 // service.
 // DownloadSipRequest may return the following errors:
@@ -346,3 +444,77 @@ func (c *Client) ReviewBatch(ctx context.Context, p *ReviewBatchPayload) (err er
 	_, err = c.ReviewBatchEndpoint(ctx, p)
 	return
 }
+
+// RetryBatch calls the "retry_batch" endpoint of the "ingest" service.
+// RetryBatch may return the following errors:
+//   - "not_found" (type *BatchNotFound): Batch not found
+//   - "not_available" (type *goa.ServiceError)
+//   - "not_valid" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) RetryBatch(ctx context.Context, p *RetryBatchPayload) (err error) {
+	_, err = c.RetryBatchEndpoint(ctx, p)
+	return
+}
+
+// CreateAPIToken calls the "create_api_token" endpoint of the "ingest"
+// service.
+// CreateAPIToken may return the following errors:
+//   - "not_valid" (type *goa.ServiceError)
+//   - "internal_error" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) CreateAPIToken(ctx context.Context, p *CreateAPITokenPayload) (res *APIToken, err error) {
+	var ires any
+	ires, err = c.CreateAPITokenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*APIToken), nil
+}
+
+// ListAPITokens calls the "list_api_tokens" endpoint of the "ingest" service.
+// ListAPITokens may return the following errors:
+//   - "internal_error" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) ListAPITokens(ctx context.Context, p *ListAPITokensPayload) (res *APITokens, err error) {
+	var ires any
+	ires, err = c.ListAPITokensEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*APITokens), nil
+}
+
+// RevokeAPIToken calls the "revoke_api_token" endpoint of the "ingest"
+// service.
+// RevokeAPIToken may return the following errors:
+//   - "not_found" (type *goa.ServiceError)
+//   - "not_valid" (type *goa.ServiceError)
+//   - "internal_error" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) RevokeAPIToken(ctx context.Context, p *RevokeAPITokenPayload) (err error) {
+	_, err = c.RevokeAPITokenEndpoint(ctx, p)
+	return
+}
+
+// Search calls the "search" endpoint of the "ingest" service.
+// Search may return the following errors:
+//   - "not_valid" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) Search(ctx context.Context, p *SearchPayload) (res *SearchResults, err error) {
+	var ires any
+	ires, err = c.SearchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SearchResults), nil
+}
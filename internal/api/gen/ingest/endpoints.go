@@ -24,10 +24,15 @@ type Endpoints struct {
 	ListSipWorkflows     goa.Endpoint
 	ConfirmSip           goa.Endpoint
 	RejectSip            goa.Endpoint
+	RetrySip             goa.Endpoint
+	CancelSip            goa.Endpoint
 	ShowSipDecision      goa.Endpoint
 	SubmitSipDecision    goa.Endpoint
 	AddSip               goa.Endpoint
 	UploadSip            goa.Endpoint
+	CreateSipUpload      goa.Endpoint
+	ShowSipUpload        goa.Endpoint
+	UploadSipChunk       goa.Endpoint
 	DownloadSipRequest   goa.Endpoint
 	DownloadSip          goa.Endpoint
 	ListUsers            goa.Endpoint
@@ -36,6 +41,11 @@ type Endpoints struct {
 	ListBatches          goa.Endpoint
 	ShowBatch            goa.Endpoint
 	ReviewBatch          goa.Endpoint
+	RetryBatch           goa.Endpoint
+	CreateAPIToken       goa.Endpoint
+	ListAPITokens        goa.Endpoint
+	RevokeAPIToken       goa.Endpoint
+	Search               goa.Endpoint
 }
 
 // MonitorEndpointInput holds both the payload and the server stream of the
@@ -56,6 +66,15 @@ type UploadSipRequestData struct {
 	Body io.ReadCloser
 }
 
+// UploadSipChunkRequestData holds both the payload and the HTTP request body
+// reader of the "upload_sip_chunk" method.
+type UploadSipChunkRequestData struct {
+	// Payload is the method payload.
+	Payload *UploadSipChunkPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
 // DownloadSipResponseData holds both the result and the HTTP response body
 // reader of the "download_sip" method.
 type DownloadSipResponseData struct {
@@ -76,10 +95,15 @@ func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 		ListSipWorkflows:     NewListSipWorkflowsEndpoint(s, a.BearerAuth),
 		ConfirmSip:           NewConfirmSipEndpoint(s, a.BearerAuth),
 		RejectSip:            NewRejectSipEndpoint(s, a.BearerAuth),
+		RetrySip:             NewRetrySipEndpoint(s, a.BearerAuth),
+		CancelSip:            NewCancelSipEndpoint(s, a.BearerAuth),
 		ShowSipDecision:      NewShowSipDecisionEndpoint(s, a.BearerAuth),
 		SubmitSipDecision:    NewSubmitSipDecisionEndpoint(s, a.BearerAuth),
 		AddSip:               NewAddSipEndpoint(s, a.BearerAuth),
 		UploadSip:            NewUploadSipEndpoint(s, a.BearerAuth),
+		CreateSipUpload:      NewCreateSipUploadEndpoint(s, a.BearerAuth),
+		ShowSipUpload:        NewShowSipUploadEndpoint(s, a.BearerAuth),
+		UploadSipChunk:       NewUploadSipChunkEndpoint(s, a.BearerAuth),
 		DownloadSipRequest:   NewDownloadSipRequestEndpoint(s, a.BearerAuth),
 		DownloadSip:          NewDownloadSipEndpoint(s),
 		ListUsers:            NewListUsersEndpoint(s, a.BearerAuth),
@@ -88,6 +112,11 @@ func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 		ListBatches:          NewListBatchesEndpoint(s, a.BearerAuth),
 		ShowBatch:            NewShowBatchEndpoint(s, a.BearerAuth),
 		ReviewBatch:          NewReviewBatchEndpoint(s, a.BearerAuth),
+		RetryBatch:           NewRetryBatchEndpoint(s, a.BearerAuth),
+		CreateAPIToken:       NewCreateAPITokenEndpoint(s, a.BearerAuth),
+		ListAPITokens:        NewListAPITokensEndpoint(s, a.BearerAuth),
+		RevokeAPIToken:       NewRevokeAPITokenEndpoint(s, a.BearerAuth),
+		Search:               NewSearchEndpoint(s, a.BearerAuth),
 	}
 	endpoints.Monitor = WrapMonitorEndpoint(endpoints.Monitor, si)
 	endpoints.ListSips = WrapListSipsEndpoint(endpoints.ListSips, si)
@@ -95,10 +124,15 @@ func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 	endpoints.ListSipWorkflows = WrapListSipWorkflowsEndpoint(endpoints.ListSipWorkflows, si)
 	endpoints.ConfirmSip = WrapConfirmSipEndpoint(endpoints.ConfirmSip, si)
 	endpoints.RejectSip = WrapRejectSipEndpoint(endpoints.RejectSip, si)
+	endpoints.RetrySip = WrapRetrySipEndpoint(endpoints.RetrySip, si)
+	endpoints.CancelSip = WrapCancelSipEndpoint(endpoints.CancelSip, si)
 	endpoints.ShowSipDecision = WrapShowSipDecisionEndpoint(endpoints.ShowSipDecision, si)
 	endpoints.SubmitSipDecision = WrapSubmitSipDecisionEndpoint(endpoints.SubmitSipDecision, si)
 	endpoints.AddSip = WrapAddSipEndpoint(endpoints.AddSip, si)
 	endpoints.UploadSip = WrapUploadSipEndpoint(endpoints.UploadSip, si)
+	endpoints.CreateSipUpload = WrapCreateSipUploadEndpoint(endpoints.CreateSipUpload, si)
+	endpoints.ShowSipUpload = WrapShowSipUploadEndpoint(endpoints.ShowSipUpload, si)
+	endpoints.UploadSipChunk = WrapUploadSipChunkEndpoint(endpoints.UploadSipChunk, si)
 	endpoints.DownloadSipRequest = WrapDownloadSipRequestEndpoint(endpoints.DownloadSipRequest, si)
 	endpoints.DownloadSip = WrapDownloadSipEndpoint(endpoints.DownloadSip, si)
 	endpoints.ListUsers = WrapListUsersEndpoint(endpoints.ListUsers, si)
@@ -107,6 +141,11 @@ func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 	endpoints.ListBatches = WrapListBatchesEndpoint(endpoints.ListBatches, si)
 	endpoints.ShowBatch = WrapShowBatchEndpoint(endpoints.ShowBatch, si)
 	endpoints.ReviewBatch = WrapReviewBatchEndpoint(endpoints.ReviewBatch, si)
+	endpoints.RetryBatch = WrapRetryBatchEndpoint(endpoints.RetryBatch, si)
+	endpoints.CreateAPIToken = WrapCreateAPITokenEndpoint(endpoints.CreateAPIToken, si)
+	endpoints.ListAPITokens = WrapListAPITokensEndpoint(endpoints.ListAPITokens, si)
+	endpoints.RevokeAPIToken = WrapRevokeAPITokenEndpoint(endpoints.RevokeAPIToken, si)
+	endpoints.Search = WrapSearchEndpoint(endpoints.Search, si)
 	return endpoints
 }
 
@@ -118,10 +157,15 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.ListSipWorkflows = m(e.ListSipWorkflows)
 	e.ConfirmSip = m(e.ConfirmSip)
 	e.RejectSip = m(e.RejectSip)
+	e.RetrySip = m(e.RetrySip)
+	e.CancelSip = m(e.CancelSip)
 	e.ShowSipDecision = m(e.ShowSipDecision)
 	e.SubmitSipDecision = m(e.SubmitSipDecision)
 	e.AddSip = m(e.AddSip)
 	e.UploadSip = m(e.UploadSip)
+	e.CreateSipUpload = m(e.CreateSipUpload)
+	e.ShowSipUpload = m(e.ShowSipUpload)
+	e.UploadSipChunk = m(e.UploadSipChunk)
 	e.DownloadSipRequest = m(e.DownloadSipRequest)
 	e.DownloadSip = m(e.DownloadSip)
 	e.ListUsers = m(e.ListUsers)
@@ -130,6 +174,11 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.ListBatches = m(e.ListBatches)
 	e.ShowBatch = m(e.ShowBatch)
 	e.ReviewBatch = m(e.ReviewBatch)
+	e.RetryBatch = m(e.RetryBatch)
+	e.CreateAPIToken = m(e.CreateAPIToken)
+	e.ListAPITokens = m(e.ListAPITokens)
+	e.RevokeAPIToken = m(e.RevokeAPIToken)
+	e.Search = m(e.Search)
 }
 
 // NewMonitorEndpoint returns an endpoint function that calls the method
@@ -140,7 +189,7 @@ func NewMonitorEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.End
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{},
 		}
 		var token string
@@ -163,7 +212,7 @@ func NewListSipsEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.En
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:list"},
 		}
 		var token string
@@ -191,7 +240,7 @@ func NewShowSipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.End
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:read"},
 		}
 		var token string
@@ -219,7 +268,7 @@ func NewListSipWorkflowsEndpoint(s Service, authBearerFn security.AuthBearerFunc
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:workflows:list"},
 		}
 		var token string
@@ -247,7 +296,7 @@ func NewConfirmSipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:review"},
 		}
 		var token string
@@ -270,7 +319,7 @@ func NewRejectSipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.E
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:review"},
 		}
 		var token string
@@ -285,6 +334,52 @@ func NewRejectSipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.E
 	}
 }
 
+// NewRetrySipEndpoint returns an endpoint function that calls the method
+// "retry_sip" of service "ingest".
+func NewRetrySipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RetrySipPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:sips:retry"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RetrySip(ctx, p)
+	}
+}
+
+// NewCancelSipEndpoint returns an endpoint function that calls the method
+// "cancel_sip" of service "ingest".
+func NewCancelSipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CancelSipPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:sips:cancel"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.CancelSip(ctx, p)
+	}
+}
+
 // NewShowSipDecisionEndpoint returns an endpoint function that calls the
 // method "show_sip_decision" of service "ingest".
 func NewShowSipDecisionEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
@@ -293,7 +388,7 @@ func NewShowSipDecisionEndpoint(s Service, authBearerFn security.AuthBearerFunc)
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:decision"},
 		}
 		var token string
@@ -321,7 +416,7 @@ func NewSubmitSipDecisionEndpoint(s Service, authBearerFn security.AuthBearerFun
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:decision"},
 		}
 		var token string
@@ -344,7 +439,7 @@ func NewAddSipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endp
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:create"},
 		}
 		var token string
@@ -367,7 +462,7 @@ func NewUploadSipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.E
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:upload"},
 		}
 		var token string
@@ -382,6 +477,75 @@ func NewUploadSipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.E
 	}
 }
 
+// NewCreateSipUploadEndpoint returns an endpoint function that calls the method
+// "create_sip_upload" of service "ingest".
+func NewCreateSipUploadEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateSipUploadPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:sips:upload"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateSipUpload(ctx, p)
+	}
+}
+
+// NewShowSipUploadEndpoint returns an endpoint function that calls the
+// method "show_sip_upload" of service "ingest".
+func NewShowSipUploadEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ShowSipUploadPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:sips:upload"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ShowSipUpload(ctx, p)
+	}
+}
+
+// NewUploadSipChunkEndpoint returns an endpoint function that calls the method
+// "upload_sip_chunk" of service "ingest".
+func NewUploadSipChunkEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*UploadSipChunkRequestData)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:sips:upload"},
+		}
+		var token string
+		if ep.Payload.Token != nil {
+			token = *ep.Payload.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UploadSipChunk(ctx, ep.Payload, ep.Body)
+	}
+}
+
 // NewDownloadSipRequestEndpoint returns an endpoint function that calls the
 // method "download_sip_request" of service "ingest".
 func NewDownloadSipRequestEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
@@ -390,7 +554,7 @@ func NewDownloadSipRequestEndpoint(s Service, authBearerFn security.AuthBearerFu
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sips:download"},
 		}
 		var token string
@@ -426,7 +590,7 @@ func NewListUsersEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.E
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:users:list"},
 		}
 		var token string
@@ -454,7 +618,7 @@ func NewListSipSourceObjectsEndpoint(s Service, authBearerFn security.AuthBearer
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:sipsources:objects:list"},
 		}
 		var token string
@@ -482,7 +646,7 @@ func NewAddBatchEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.En
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:batches:create"},
 		}
 		var token string
@@ -505,7 +669,7 @@ func NewListBatchesEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:batches:list"},
 		}
 		var token string
@@ -533,7 +697,7 @@ func NewShowBatchEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.E
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:batches:read"},
 		}
 		var token string
@@ -561,7 +725,7 @@ func NewReviewBatchEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"ingest:batches:review"},
 		}
 		var token string
@@ -575,3 +739,118 @@ func NewReviewBatchEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa
 		return nil, s.ReviewBatch(ctx, p)
 	}
 }
+
+// NewRetryBatchEndpoint returns an endpoint function that calls the method
+// "retry_batch" of service "ingest".
+func NewRetryBatchEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RetryBatchPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:batches:retry"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RetryBatch(ctx, p)
+	}
+}
+
+// NewCreateAPITokenEndpoint returns an endpoint function that calls the method
+// "create_api_token" of service "ingest".
+func NewCreateAPITokenEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateAPITokenPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:apitokens:create"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateAPIToken(ctx, p)
+	}
+}
+
+// NewListAPITokensEndpoint returns an endpoint function that calls the method
+// "list_api_tokens" of service "ingest".
+func NewListAPITokensEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListAPITokensPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:apitokens:list"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListAPITokens(ctx, p)
+	}
+}
+
+// NewRevokeAPITokenEndpoint returns an endpoint function that calls the method
+// "revoke_api_token" of service "ingest".
+func NewRevokeAPITokenEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeAPITokenPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:apitokens:revoke"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RevokeAPIToken(ctx, p)
+	}
+}
+
+// NewSearchEndpoint returns an endpoint function that calls the method "search"
+// of service "ingest".
+func NewSearchEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"ingest:sips:list"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Search(ctx, p)
+	}
+}
@@ -98,6 +98,34 @@ func wrapRejectSipOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors)
 	}
 }
 
+// wrapOperationTimeoutRetrySip applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapRetrySipOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "RetrySip",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutCancelSip applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapCancelSipOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "CancelSip",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
 // wrapOperationTimeoutShowSipDecision applies the OperationTimeout server
 // interceptor to endpoints.
 func wrapShowSipDecisionOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -154,6 +182,48 @@ func wrapUploadSipOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors)
 	}
 }
 
+// wrapOperationTimeoutCreateSipUpload applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapCreateSipUploadOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "CreateSipUpload",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutShowSipUpload applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapShowSipUploadOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "ShowSipUpload",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutUploadSipChunk applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapUploadSipChunkOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "UploadSipChunk",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
 // wrapOperationTimeoutDownloadSipRequest applies the OperationTimeout server
 // interceptor to endpoints.
 func wrapDownloadSipRequestOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -265,3 +335,73 @@ func wrapReviewBatchOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors
 		return i.OperationTimeout(ctx, info, endpoint)
 	}
 }
+
+// wrapOperationTimeoutRetryBatch applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapRetryBatchOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "RetryBatch",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutCreateAPIToken applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapCreateAPITokenOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "CreateAPIToken",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutListAPITokens applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapListAPITokensOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "ListAPITokens",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutRevokeAPIToken applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapRevokeAPITokenOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "RevokeAPIToken",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutSearch applies the OperationTimeout server interceptor to
+// endpoints.
+func wrapSearchOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "ingest",
+			method:     "Search",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
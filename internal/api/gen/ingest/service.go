@@ -34,6 +34,10 @@ type Service interface {
 	ConfirmSip(context.Context, *ConfirmSipPayload) (err error)
 	// Signal the SIP has been reviewed and rejected
 	RejectSip(context.Context, *RejectSipPayload) (err error)
+	// Retry the processing of a failed SIP from its failed package
+	RetrySip(context.Context, *RetrySipPayload) (err error)
+	// Cancel the processing of a queued or processing SIP
+	CancelSip(context.Context, *CancelSipPayload) (err error)
 	// Show the active child workflow decision request for a SIP
 	ShowSipDecision(context.Context, *ShowSipDecisionPayload) (res *SIPDecision, err error)
 	// Submit a selected child workflow decision option for a SIP
@@ -42,6 +46,12 @@ type Service interface {
 	AddSip(context.Context, *AddSipPayload) (res *AddSipResult, err error)
 	// Upload a SIP to trigger an ingest workflow
 	UploadSip(context.Context, *UploadSipPayload, io.ReadCloser) (res *UploadSipResult, err error)
+	// Start a resumable SIP upload
+	CreateSipUpload(context.Context, *CreateSipUploadPayload) (res *SIPUpload, err error)
+	// Show the progress of a resumable SIP upload
+	ShowSipUpload(context.Context, *ShowSipUploadPayload) (res *SIPUpload, err error)
+	// Upload the next chunk of a resumable SIP upload
+	UploadSipChunk(context.Context, *UploadSipChunkPayload, io.ReadCloser) (res *SIPUpload, err error)
 	// Request access to SIP download
 	DownloadSipRequest(context.Context, *DownloadSipRequestPayload) (res *DownloadSipRequestResult, err error)
 	// Download the failed package related to a SIP. It will be the original SIP or
@@ -63,6 +73,16 @@ type Service interface {
 	ShowBatch(context.Context, *ShowBatchPayload) (res *Batch, err error)
 	// Review a Batch awaiting user decision
 	ReviewBatch(context.Context, *ReviewBatchPayload) (err error)
+	// Retry the processing of the failed SIPs of a Batch
+	RetryBatch(context.Context, *RetryBatchPayload) (err error)
+	// Create an API token for the current user
+	CreateAPIToken(context.Context, *CreateAPITokenPayload) (res *APIToken, err error)
+	// List the API tokens of the current user
+	ListAPITokens(context.Context, *ListAPITokensPayload) (res *APITokens, err error)
+	// Revoke an API token of the current user
+	RevokeAPIToken(context.Context, *RevokeAPITokenPayload) (err error)
+	// Search SIPs by name, batch identifier and custom metadata
+	Search(context.Context, *SearchPayload) (res *SearchResults, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -85,7 +105,7 @@ const ServiceName = "ingest"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [18]string{"monitor", "list_sips", "show_sip", "list_sip_workflows", "confirm_sip", "reject_sip", "show_sip_decision", "submit_sip_decision", "add_sip", "upload_sip", "download_sip_request", "download_sip", "list_users", "list_sip_source_objects", "add_batch", "list_batches", "show_batch", "review_batch"}
+var MethodNames = [28]string{"monitor", "list_sips", "show_sip", "list_sip_workflows", "confirm_sip", "reject_sip", "retry_sip", "cancel_sip", "show_sip_decision", "submit_sip_decision", "add_sip", "upload_sip", "create_sip_upload", "show_sip_upload", "upload_sip_chunk", "download_sip_request", "download_sip", "list_users", "list_sip_source_objects", "add_batch", "list_batches", "show_batch", "review_batch", "retry_batch", "create_api_token", "list_api_tokens", "revoke_api_token", "search"}
 
 // MonitorServerStream allows streaming instances of *IngestEvent to the client.
 type MonitorServerStream interface {
@@ -106,6 +126,31 @@ type MonitorClientStream interface {
 	RecvWithContext(context.Context) (*IngestEvent, error)
 }
 
+// APIToken is the result type of the ingest service create_api_token method.
+type APIToken struct {
+	// Identifier of the API token
+	UUID string
+	// Name of the API token
+	Name string
+	// Access control attributes granted to the API token
+	Attributes []string
+	// Creation datetime
+	CreatedAt string
+	// Expiration datetime
+	ExpiresAt *string
+	// Datetime of the last use of the API token
+	LastUsedAt *string
+	// Revocation datetime
+	RevokedAt *string
+	// Secret of the API token, only returned when the API token is created
+	Token *string
+}
+
+// APITokens is the result type of the ingest service list_api_tokens method.
+type APITokens struct {
+	Items []*APIToken
+}
+
 // AddBatchPayload is the payload type of the ingest service add_batch method.
 type AddBatchPayload struct {
 	// Identifier of SIP source -- CURRENTLY NOT USED
@@ -114,6 +159,15 @@ type AddBatchPayload struct {
 	Keys []string
 	// Optional Batch identifier assigned by the user
 	Identifier *string
+	// Name of the processing profile to use for the SIPs of the Batch
+	ProcessingProfile *string
+	// Manifest listing the SIPs to ingest as part of the batch and their metadata,
+	// instead of keys
+	Manifest *string
+	// Format of the manifest, defaults to csv
+	ManifestFormat *string
+	// Identifier of the storage location of the AIPs of the Batch
+	LocationID *string
 	Token      *string
 }
 
@@ -128,8 +182,12 @@ type AddSipPayload struct {
 	// Identifier of SIP source
 	SourceID string
 	// Key of the item to ingest
-	Key   string
-	Token *string
+	Key string
+	// Name of the processing profile to use for the SIP
+	ProcessingProfile *string
+	// Identifier of the storage location of the AIP
+	LocationID *string
+	Token      *string
 }
 
 // AddSipResult is the result type of the ingest service add_sip method.
@@ -190,6 +248,13 @@ type Batches struct {
 	Page  *EnduroPage
 }
 
+// CancelSipPayload is the payload type of the ingest service cancel_sip method.
+type CancelSipPayload struct {
+	// Identifier of SIP to look up
+	UUID  string
+	Token *string
+}
+
 // ConfirmSipPayload is the payload type of the ingest service confirm_sip
 // method.
 type ConfirmSipPayload struct {
@@ -200,6 +265,35 @@ type ConfirmSipPayload struct {
 	Token        *string
 }
 
+// CreateAPITokenPayload is the payload type of the ingest service
+// create_api_token method.
+type CreateAPITokenPayload struct {
+	// Name of the API token
+	Name string
+	// Access control attributes granted to the API token, defaults to the
+	// attributes of the user
+	Attributes []string
+	// Expiration datetime of the API token
+	ExpiresAt string
+	Token     *string
+}
+
+// CreateSipUploadPayload is the payload type of the ingest service
+// create_sip_upload method.
+type CreateSipUploadPayload struct {
+	// File name of the SIP
+	Name string
+	// Size of the SIP in bytes
+	Size int64
+	// Expected checksum of the SIP, e.g. "sha256:9f86d0..."
+	Checksum *string
+	// Name of the processing profile to use for the SIP
+	ProcessingProfile *string
+	// Identifier of the storage location of the AIP
+	LocationID *string
+	Token      *string
+}
+
 // DownloadSipPayload is the payload type of the ingest service download_sip
 // method.
 type DownloadSipPayload struct {
@@ -249,6 +343,12 @@ type IngestPingEvent struct {
 	Message *string
 }
 
+// ListAPITokensPayload is the payload type of the ingest service
+// list_api_tokens method.
+type ListAPITokensPayload struct {
+	Token *string
+}
+
 // ListBatchesPayload is the payload type of the ingest service list_batches
 // method.
 type ListBatchesPayload struct {
@@ -297,6 +397,8 @@ type ListSipsPayload struct {
 	UploaderUUID *string
 	// UUID of the related Batch
 	BatchUUID *string
+	// Filter SIPs by the presence of a custom metadata key
+	MetadataKey *string
 	// Limit number of results to return
 	Limit *int
 	// Offset from the beginning of the found set
@@ -329,6 +431,13 @@ type RejectSipPayload struct {
 	Token *string
 }
 
+// RetrySipPayload is the payload type of the ingest service retry_sip method.
+type RetrySipPayload struct {
+	// Identifier of SIP to look up
+	UUID  string
+	Token *string
+}
+
 // ReviewBatchPayload is the payload type of the ingest service review_batch
 // method.
 type ReviewBatchPayload struct {
@@ -338,6 +447,21 @@ type ReviewBatchPayload struct {
 	Token    *string
 }
 
+// RevokeAPITokenPayload is the payload type of the ingest service
+// revoke_api_token method.
+type RevokeAPITokenPayload struct {
+	// Identifier of the API token
+	UUID  string
+	Token *string
+}
+
+// RetryBatchPayload is the payload type of the ingest service retry_batch method.
+type RetryBatchPayload struct {
+	// Identifier of Batch to retry
+	UUID  string
+	Token *string
+}
+
 // SIP is the result type of the ingest service show_sip method.
 type SIP struct {
 	// Identifier of SIP
@@ -372,6 +496,8 @@ type SIP struct {
 	BatchStatus *string
 	// Number of files in the SIP
 	FileCount *int32
+	// Custom metadata returned by the child workflows
+	CustomMetadata map[string]any
 }
 
 type SIPCollection []*SIP
@@ -460,6 +586,22 @@ type SIPUpdatedEvent struct {
 	Item *SIP
 }
 
+// SIPUpload is the result type of the ingest service create_sip_upload method.
+type SIPUpload struct {
+	// Identifier of the upload
+	UUID string
+	// File name of the SIP
+	Name string
+	// Size of the SIP in bytes
+	Size int64
+	// Number of bytes received
+	Offset int64
+	// Time after which the upload is discarded
+	ExpiresAt string
+	// Identifier of the SIP, set when the upload is complete
+	SipUUID *string
+}
+
 // SIPWorkflow describes a workflow of a SIP.
 type SIPWorkflow struct {
 	// Identifier of the workflow
@@ -500,6 +642,33 @@ type SIPs struct {
 	Page  *EnduroPage
 }
 
+// SearchPayload is the payload type of the ingest service search method.
+type SearchPayload struct {
+	// Search terms, all of them must match
+	Query string
+	// Limit number of results to return
+	Limit *int
+	// Offset from the beginning of the found set
+	Offset *int
+	Token  *string
+}
+
+// SearchResult describes a package matching a search query.
+type SearchResult struct {
+	// Identifier of the package
+	UUID uuid.UUID
+	// Name of the package
+	Name string
+	// Indexed metadata of the package, keyed by field name
+	Fields map[string]string
+}
+
+// SearchResults describes a page of search results.
+type SearchResults struct {
+	Items []*SearchResult
+	Page  *EnduroPage
+}
+
 // ShowBatchPayload is the payload type of the ingest service show_batch method.
 type ShowBatchPayload struct {
 	// Identifier of Batch to show
@@ -522,6 +691,14 @@ type ShowSipPayload struct {
 	Token *string
 }
 
+// ShowSipUploadPayload is the payload type of the ingest service
+// show_sip_upload method.
+type ShowSipUploadPayload struct {
+	// Identifier of the upload
+	UUID  string
+	Token *string
+}
+
 // SubmitSipDecisionPayload is the payload type of the ingest service
 // submit_sip_decision method.
 type SubmitSipDecisionPayload struct {
@@ -532,6 +709,18 @@ type SubmitSipDecisionPayload struct {
 	Token  *string
 }
 
+// UploadSipChunkPayload is the payload type of the ingest service
+// upload_sip_chunk method.
+type UploadSipChunkPayload struct {
+	// Identifier of the upload
+	UUID string
+	// Offset of the chunk in the SIP, must match the upload offset
+	Offset int64
+	// Optional checksum of the chunk, e.g. "sha256:9f86d0..."
+	Checksum *string
+	Token    *string
+}
+
 // UploadSipPayload is the payload type of the ingest service upload_sip method.
 type UploadSipPayload struct {
 	// Content-Type header, must define value for multipart boundary.
@@ -1239,6 +1428,14 @@ func newSIP(vres *ingestviews.SIPView) *SIP {
 	if vres.CreatedAt != nil {
 		res.CreatedAt = *vres.CreatedAt
 	}
+	if vres.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(vres.CustomMetadata))
+		for key, val := range vres.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
 	return res
 }
 
@@ -1263,6 +1460,14 @@ func newSIPView(res *SIP) *ingestviews.SIPView {
 		BatchStatus:     res.BatchStatus,
 		FileCount:       res.FileCount,
 	}
+	if res.CustomMetadata != nil {
+		vres.CustomMetadata = make(map[string]any, len(res.CustomMetadata))
+		for key, val := range res.CustomMetadata {
+			tk := key
+			tv := val
+			vres.CustomMetadata[tk] = tv
+		}
+	}
 	return vres
 }
 
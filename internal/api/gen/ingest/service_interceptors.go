@@ -88,6 +88,24 @@ func WrapRejectSipEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endp
 	return endpoint
 }
 
+// WrapRetrySipEndpoint wraps the retry_sip endpoint with the server-side
+// interceptors defined in the design.
+func WrapRetrySipEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapRetrySipOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapCancelSipEndpoint wraps the cancel_sip endpoint with the server-side
+// interceptors defined in the design.
+func WrapCancelSipEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapCancelSipOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
 // WrapShowSipDecisionEndpoint wraps the show_sip_decision endpoint with the
 // server-side interceptors defined in the design.
 func WrapShowSipDecisionEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -124,6 +142,33 @@ func WrapUploadSipEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endp
 	return endpoint
 }
 
+// WrapCreateSipUploadEndpoint wraps the create_sip_upload endpoint with the
+// server-side interceptors defined in the design.
+func WrapCreateSipUploadEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapCreateSipUploadOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapShowSipUploadEndpoint wraps the show_sip_upload endpoint with the
+// server-side interceptors defined in the design.
+func WrapShowSipUploadEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapShowSipUploadOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapUploadSipChunkEndpoint wraps the upload_sip_chunk endpoint with the
+// server-side interceptors defined in the design.
+func WrapUploadSipChunkEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapUploadSipChunkOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
 // WrapDownloadSipRequestEndpoint wraps the download_sip_request endpoint with
 // the server-side interceptors defined in the design.
 func WrapDownloadSipRequestEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -196,6 +241,51 @@ func WrapReviewBatchEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.En
 	return endpoint
 }
 
+// WrapRetryBatchEndpoint wraps the retry_batch endpoint with the server-side
+// interceptors defined in the design.
+func WrapRetryBatchEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapRetryBatchOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapCreateAPITokenEndpoint wraps the create_api_token endpoint with the
+// server-side interceptors defined in the design.
+func WrapCreateAPITokenEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapCreateAPITokenOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapListAPITokensEndpoint wraps the list_api_tokens endpoint with the
+// server-side interceptors defined in the design.
+func WrapListAPITokensEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapListAPITokensOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapRevokeAPITokenEndpoint wraps the revoke_api_token endpoint with the
+// server-side interceptors defined in the design.
+func WrapRevokeAPITokenEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapRevokeAPITokenOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapSearchEndpoint wraps the search endpoint with the server-side
+// interceptors defined in the design.
+func WrapSearchEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapSearchOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
 // Public accessor methods for Info types
 
 // Service returns the name of the service handling the request.
@@ -131,6 +131,8 @@ type SIPView struct {
 	BatchStatus *string
 	// Number of files in the SIP
 	FileCount *int32
+	// Custom metadata returned by the child workflows
+	CustomMetadata map[string]any
 }
 
 // SIPUpdatedEventView is a type that runs validations on a projected type.
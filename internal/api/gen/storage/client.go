@@ -24,11 +24,14 @@ type Client struct {
 	DownloadAipEndpoint              goa.Endpoint
 	MoveAipEndpoint                  goa.Endpoint
 	MoveAipStatusEndpoint            goa.Endpoint
+	RestoreAipsEndpoint              goa.Endpoint
 	RejectAipEndpoint                goa.Endpoint
 	ShowAipEndpoint                  goa.Endpoint
 	ListAipWorkflowsEndpoint         goa.Endpoint
 	AipDeletionAutoEndpoint          goa.Endpoint
 	RequestAipDeletionEndpoint       goa.Endpoint
+	PlaceAipLegalHoldEndpoint        goa.Endpoint
+	ReleaseAipLegalHoldEndpoint      goa.Endpoint
 	ReviewAipDeletionEndpoint        goa.Endpoint
 	CancelAipDeletionEndpoint        goa.Endpoint
 	AipDeletionReportRequestEndpoint goa.Endpoint
@@ -37,10 +40,12 @@ type Client struct {
 	CreateLocationEndpoint           goa.Endpoint
 	ShowLocationEndpoint             goa.Endpoint
 	ListLocationAipsEndpoint         goa.Endpoint
+	LocationUsageEndpoint            goa.Endpoint
+	SearchEndpoint                   goa.Endpoint
 }
 
 // NewClient initializes a "storage" service client given the endpoints.
-func NewClient(monitor, listAips, createAip, downloadAipRequest, downloadAip, moveAip, moveAipStatus, rejectAip, showAip, listAipWorkflows, aipDeletionAuto, requestAipDeletion, reviewAipDeletion, cancelAipDeletion, aipDeletionReportRequest, aipDeletionReport, listLocations, createLocation, showLocation, listLocationAips goa.Endpoint) *Client {
+func NewClient(monitor, listAips, createAip, downloadAipRequest, downloadAip, moveAip, moveAipStatus, restoreAips, rejectAip, showAip, listAipWorkflows, aipDeletionAuto, requestAipDeletion, placeAipLegalHold, releaseAipLegalHold, reviewAipDeletion, cancelAipDeletion, aipDeletionReportRequest, aipDeletionReport, listLocations, createLocation, showLocation, listLocationAips, locationUsage, search goa.Endpoint) *Client {
 	return &Client{
 		MonitorEndpoint:                  monitor,
 		ListAipsEndpoint:                 listAips,
@@ -49,11 +54,14 @@ func NewClient(monitor, listAips, createAip, downloadAipRequest, downloadAip, mo
 		DownloadAipEndpoint:              downloadAip,
 		MoveAipEndpoint:                  moveAip,
 		MoveAipStatusEndpoint:            moveAipStatus,
+		RestoreAipsEndpoint:              restoreAips,
 		RejectAipEndpoint:                rejectAip,
 		ShowAipEndpoint:                  showAip,
 		ListAipWorkflowsEndpoint:         listAipWorkflows,
 		AipDeletionAutoEndpoint:          aipDeletionAuto,
 		RequestAipDeletionEndpoint:       requestAipDeletion,
+		PlaceAipLegalHoldEndpoint:        placeAipLegalHold,
+		ReleaseAipLegalHoldEndpoint:      releaseAipLegalHold,
 		ReviewAipDeletionEndpoint:        reviewAipDeletion,
 		CancelAipDeletionEndpoint:        cancelAipDeletion,
 		AipDeletionReportRequestEndpoint: aipDeletionReportRequest,
@@ -62,6 +70,8 @@ func NewClient(monitor, listAips, createAip, downloadAipRequest, downloadAip, mo
 		CreateLocationEndpoint:           createLocation,
 		ShowLocationEndpoint:             showLocation,
 		ListLocationAipsEndpoint:         listLocationAips,
+		LocationUsageEndpoint:            locationUsage,
+		SearchEndpoint:                   search,
 	}
 }
 
@@ -176,6 +186,19 @@ func (c *Client) MoveAipStatus(ctx context.Context, p *MoveAipStatusPayload) (re
 	return ires.(*MoveStatusResult), nil
 }
 
+// RestoreAips calls the "restore_aips" endpoint of the "storage" service.
+// RestoreAips may return the following errors:
+//   - "not_found" (type *AIPNotFound): AIP not found
+//   - "not_available" (type *goa.ServiceError)
+//   - "not_valid" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) RestoreAips(ctx context.Context, p *RestoreAipsPayload) (err error) {
+	_, err = c.RestoreAipsEndpoint(ctx, p)
+	return
+}
+
 // RejectAip calls the "reject_aip" endpoint of the "storage" service.
 // RejectAip may return the following errors:
 //   - "not_found" (type *AIPNotFound): AIP not found
@@ -248,6 +271,34 @@ func (c *Client) RequestAipDeletion(ctx context.Context, p *RequestAipDeletionPa
 	return
 }
 
+// PlaceAipLegalHold calls the "place_aip_legal_hold" endpoint of the
+// "storage" service.
+// PlaceAipLegalHold may return the following errors:
+//   - "not_found" (type *AIPNotFound): AIP not found
+//   - "not_valid" (type *goa.ServiceError)
+//   - "internal_error" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) PlaceAipLegalHold(ctx context.Context, p *PlaceAipLegalHoldPayload) (err error) {
+	_, err = c.PlaceAipLegalHoldEndpoint(ctx, p)
+	return
+}
+
+// ReleaseAipLegalHold calls the "release_aip_legal_hold" endpoint of the
+// "storage" service.
+// ReleaseAipLegalHold may return the following errors:
+//   - "not_found" (type *AIPNotFound): AIP not found
+//   - "not_valid" (type *goa.ServiceError)
+//   - "internal_error" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) ReleaseAipLegalHold(ctx context.Context, p *ReleaseAipLegalHoldPayload) (err error) {
+	_, err = c.ReleaseAipLegalHoldEndpoint(ctx, p)
+	return
+}
+
 // ReviewAipDeletion calls the "review_aip_deletion" endpoint of the "storage"
 // service.
 // ReviewAipDeletion may return the following errors:
@@ -373,3 +424,33 @@ func (c *Client) ListLocationAips(ctx context.Context, p *ListLocationAipsPayloa
 	}
 	return ires.(AIPCollection), nil
 }
+
+// LocationUsage calls the "location_usage" endpoint of the "storage" service.
+// LocationUsage may return the following errors:
+//   - "not_found" (type *LocationNotFound): Storage location not found
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) LocationUsage(ctx context.Context, p *LocationUsagePayload) (res *LocationUsage, err error) {
+	var ires any
+	ires, err = c.LocationUsageEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*LocationUsage), nil
+}
+
+// Search calls the "search" endpoint of the "storage" service.
+// Search may return the following errors:
+//   - "not_valid" (type *goa.ServiceError)
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - error: internal error
+func (c *Client) Search(ctx context.Context, p *SearchPayload) (res *SearchResults, err error) {
+	var ires any
+	ires, err = c.SearchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SearchResults), nil
+}
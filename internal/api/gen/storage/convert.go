@@ -22,6 +22,15 @@ func (t *AMSSConfig) ConvertToAMSSConfig() *types.AMSSConfig {
 	return v
 }
 
+// ConvertToFilesystemConfig creates an instance of FilesystemConfig initialized
+// from t.
+func (t *FilesystemConfig) ConvertToFilesystemConfig() *types.FilesystemConfig {
+	v := &types.FilesystemConfig{
+		Path: t.Path,
+	}
+	return v
+}
+
 // ConvertToS3Config creates an instance of S3Config initialized from t.
 func (t *S3Config) ConvertToS3Config() *types.S3Config {
 	v := &types.S3Config{
@@ -25,11 +25,14 @@ type Endpoints struct {
 	DownloadAip              goa.Endpoint
 	MoveAip                  goa.Endpoint
 	MoveAipStatus            goa.Endpoint
+	RestoreAips              goa.Endpoint
 	RejectAip                goa.Endpoint
 	ShowAip                  goa.Endpoint
 	ListAipWorkflows         goa.Endpoint
 	AipDeletionAuto          goa.Endpoint
 	RequestAipDeletion       goa.Endpoint
+	PlaceAipLegalHold        goa.Endpoint
+	ReleaseAipLegalHold      goa.Endpoint
 	ReviewAipDeletion        goa.Endpoint
 	CancelAipDeletion        goa.Endpoint
 	AipDeletionReportRequest goa.Endpoint
@@ -38,6 +41,8 @@ type Endpoints struct {
 	CreateLocation           goa.Endpoint
 	ShowLocation             goa.Endpoint
 	ListLocationAips         goa.Endpoint
+	LocationUsage            goa.Endpoint
+	Search                   goa.Endpoint
 }
 
 // MonitorEndpointInput holds both the payload and the server stream of the
@@ -79,11 +84,14 @@ func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 		DownloadAip:              NewDownloadAipEndpoint(s),
 		MoveAip:                  NewMoveAipEndpoint(s, a.BearerAuth),
 		MoveAipStatus:            NewMoveAipStatusEndpoint(s, a.BearerAuth),
+		RestoreAips:              NewRestoreAipsEndpoint(s, a.BearerAuth),
 		RejectAip:                NewRejectAipEndpoint(s, a.BearerAuth),
 		ShowAip:                  NewShowAipEndpoint(s, a.BearerAuth),
 		ListAipWorkflows:         NewListAipWorkflowsEndpoint(s, a.BearerAuth),
 		AipDeletionAuto:          NewAipDeletionAutoEndpoint(s, a.BearerAuth),
 		RequestAipDeletion:       NewRequestAipDeletionEndpoint(s, a.BearerAuth),
+		PlaceAipLegalHold:        NewPlaceAipLegalHoldEndpoint(s, a.BearerAuth),
+		ReleaseAipLegalHold:      NewReleaseAipLegalHoldEndpoint(s, a.BearerAuth),
 		ReviewAipDeletion:        NewReviewAipDeletionEndpoint(s, a.BearerAuth),
 		CancelAipDeletion:        NewCancelAipDeletionEndpoint(s, a.BearerAuth),
 		AipDeletionReportRequest: NewAipDeletionReportRequestEndpoint(s, a.BearerAuth),
@@ -92,6 +100,8 @@ func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 		CreateLocation:           NewCreateLocationEndpoint(s, a.BearerAuth),
 		ShowLocation:             NewShowLocationEndpoint(s, a.BearerAuth),
 		ListLocationAips:         NewListLocationAipsEndpoint(s, a.BearerAuth),
+		LocationUsage:            NewLocationUsageEndpoint(s, a.BearerAuth),
+		Search:                   NewSearchEndpoint(s, a.BearerAuth),
 	}
 	endpoints.Monitor = WrapMonitorEndpoint(endpoints.Monitor, si)
 	endpoints.ListAips = WrapListAipsEndpoint(endpoints.ListAips, si)
@@ -100,11 +110,14 @@ func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 	endpoints.DownloadAip = WrapDownloadAipEndpoint(endpoints.DownloadAip, si)
 	endpoints.MoveAip = WrapMoveAipEndpoint(endpoints.MoveAip, si)
 	endpoints.MoveAipStatus = WrapMoveAipStatusEndpoint(endpoints.MoveAipStatus, si)
+	endpoints.RestoreAips = WrapRestoreAipsEndpoint(endpoints.RestoreAips, si)
 	endpoints.RejectAip = WrapRejectAipEndpoint(endpoints.RejectAip, si)
 	endpoints.ShowAip = WrapShowAipEndpoint(endpoints.ShowAip, si)
 	endpoints.ListAipWorkflows = WrapListAipWorkflowsEndpoint(endpoints.ListAipWorkflows, si)
 	endpoints.AipDeletionAuto = WrapAipDeletionAutoEndpoint(endpoints.AipDeletionAuto, si)
 	endpoints.RequestAipDeletion = WrapRequestAipDeletionEndpoint(endpoints.RequestAipDeletion, si)
+	endpoints.PlaceAipLegalHold = WrapPlaceAipLegalHoldEndpoint(endpoints.PlaceAipLegalHold, si)
+	endpoints.ReleaseAipLegalHold = WrapReleaseAipLegalHoldEndpoint(endpoints.ReleaseAipLegalHold, si)
 	endpoints.ReviewAipDeletion = WrapReviewAipDeletionEndpoint(endpoints.ReviewAipDeletion, si)
 	endpoints.CancelAipDeletion = WrapCancelAipDeletionEndpoint(endpoints.CancelAipDeletion, si)
 	endpoints.AipDeletionReportRequest = WrapAipDeletionReportRequestEndpoint(endpoints.AipDeletionReportRequest, si)
@@ -113,6 +126,8 @@ func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 	endpoints.CreateLocation = WrapCreateLocationEndpoint(endpoints.CreateLocation, si)
 	endpoints.ShowLocation = WrapShowLocationEndpoint(endpoints.ShowLocation, si)
 	endpoints.ListLocationAips = WrapListLocationAipsEndpoint(endpoints.ListLocationAips, si)
+	endpoints.LocationUsage = WrapLocationUsageEndpoint(endpoints.LocationUsage, si)
+	endpoints.Search = WrapSearchEndpoint(endpoints.Search, si)
 	return endpoints
 }
 
@@ -125,11 +140,14 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.DownloadAip = m(e.DownloadAip)
 	e.MoveAip = m(e.MoveAip)
 	e.MoveAipStatus = m(e.MoveAipStatus)
+	e.RestoreAips = m(e.RestoreAips)
 	e.RejectAip = m(e.RejectAip)
 	e.ShowAip = m(e.ShowAip)
 	e.ListAipWorkflows = m(e.ListAipWorkflows)
 	e.AipDeletionAuto = m(e.AipDeletionAuto)
 	e.RequestAipDeletion = m(e.RequestAipDeletion)
+	e.PlaceAipLegalHold = m(e.PlaceAipLegalHold)
+	e.ReleaseAipLegalHold = m(e.ReleaseAipLegalHold)
 	e.ReviewAipDeletion = m(e.ReviewAipDeletion)
 	e.CancelAipDeletion = m(e.CancelAipDeletion)
 	e.AipDeletionReportRequest = m(e.AipDeletionReportRequest)
@@ -138,6 +156,8 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.CreateLocation = m(e.CreateLocation)
 	e.ShowLocation = m(e.ShowLocation)
 	e.ListLocationAips = m(e.ListLocationAips)
+	e.LocationUsage = m(e.LocationUsage)
+	e.Search = m(e.Search)
 }
 
 // NewMonitorEndpoint returns an endpoint function that calls the method
@@ -148,7 +168,7 @@ func NewMonitorEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.End
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{},
 		}
 		var token string
@@ -171,7 +191,7 @@ func NewListAipsEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.En
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:list"},
 		}
 		var token string
@@ -199,7 +219,7 @@ func NewCreateAipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.E
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:create"},
 		}
 		var token string
@@ -227,7 +247,7 @@ func NewDownloadAipRequestEndpoint(s Service, authBearerFn security.AuthBearerFu
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:download"},
 		}
 		var token string
@@ -263,7 +283,7 @@ func NewMoveAipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.End
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:move"},
 		}
 		var token string
@@ -286,7 +306,7 @@ func NewMoveAipStatusEndpoint(s Service, authBearerFn security.AuthBearerFunc) g
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:move"},
 		}
 		var token string
@@ -301,6 +321,29 @@ func NewMoveAipStatusEndpoint(s Service, authBearerFn security.AuthBearerFunc) g
 	}
 }
 
+// NewRestoreAipsEndpoint returns an endpoint function that calls the method
+// "restore_aips" of service "storage".
+func NewRestoreAipsEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RestoreAipsPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"storage:aips:restore"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RestoreAips(ctx, p)
+	}
+}
+
 // NewRejectAipEndpoint returns an endpoint function that calls the method
 // "reject_aip" of service "storage".
 func NewRejectAipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
@@ -309,7 +352,7 @@ func NewRejectAipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.E
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:review"},
 		}
 		var token string
@@ -332,7 +375,7 @@ func NewShowAipEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.End
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:read"},
 		}
 		var token string
@@ -360,7 +403,7 @@ func NewListAipWorkflowsEndpoint(s Service, authBearerFn security.AuthBearerFunc
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:workflows:list"},
 		}
 		var token string
@@ -388,7 +431,7 @@ func NewAipDeletionAutoEndpoint(s Service, authBearerFn security.AuthBearerFunc)
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:deletion:auto"},
 		}
 		var token string
@@ -411,7 +454,7 @@ func NewRequestAipDeletionEndpoint(s Service, authBearerFn security.AuthBearerFu
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:deletion:request"},
 		}
 		var token string
@@ -426,6 +469,52 @@ func NewRequestAipDeletionEndpoint(s Service, authBearerFn security.AuthBearerFu
 	}
 }
 
+// NewPlaceAipLegalHoldEndpoint returns an endpoint function that calls the
+// method "place_aip_legal_hold" of service "storage".
+func NewPlaceAipLegalHoldEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PlaceAipLegalHoldPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"storage:aips:legalhold"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.PlaceAipLegalHold(ctx, p)
+	}
+}
+
+// NewReleaseAipLegalHoldEndpoint returns an endpoint function that calls the
+// method "release_aip_legal_hold" of service "storage".
+func NewReleaseAipLegalHoldEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReleaseAipLegalHoldPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"storage:aips:legalhold"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.ReleaseAipLegalHold(ctx, p)
+	}
+}
+
 // NewReviewAipDeletionEndpoint returns an endpoint function that calls the
 // method "review_aip_deletion" of service "storage".
 func NewReviewAipDeletionEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
@@ -434,7 +523,7 @@ func NewReviewAipDeletionEndpoint(s Service, authBearerFn security.AuthBearerFun
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:deletion:review"},
 		}
 		var token string
@@ -457,7 +546,7 @@ func NewCancelAipDeletionEndpoint(s Service, authBearerFn security.AuthBearerFun
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:deletion:request"},
 		}
 		var token string
@@ -480,7 +569,7 @@ func NewAipDeletionReportRequestEndpoint(s Service, authBearerFn security.AuthBe
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:aips:deletion:report"},
 		}
 		var token string
@@ -516,7 +605,7 @@ func NewListLocationsEndpoint(s Service, authBearerFn security.AuthBearerFunc) g
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:locations:list"},
 		}
 		var token string
@@ -544,7 +633,7 @@ func NewCreateLocationEndpoint(s Service, authBearerFn security.AuthBearerFunc)
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:locations:create"},
 		}
 		var token string
@@ -567,7 +656,7 @@ func NewShowLocationEndpoint(s Service, authBearerFn security.AuthBearerFunc) go
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:locations:read"},
 		}
 		var token string
@@ -595,7 +684,7 @@ func NewListLocationAipsEndpoint(s Service, authBearerFn security.AuthBearerFunc
 		var err error
 		sc := security.BearerScheme{
 			Name:           "bearer",
-			Scopes:         []string{"ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:review", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
 			RequiredScopes: []string{"storage:locations:aips:list"},
 		}
 		var token string
@@ -614,3 +703,49 @@ func NewListLocationAipsEndpoint(s Service, authBearerFn security.AuthBearerFunc
 		return vres, nil
 	}
 }
+
+// NewLocationUsageEndpoint returns an endpoint function that calls the method
+// "location_usage" of service "storage".
+func NewLocationUsageEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*LocationUsagePayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"storage:locations:read"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.LocationUsage(ctx, p)
+	}
+}
+
+// NewSearchEndpoint returns an endpoint function that calls the method "search"
+// of service "storage".
+func NewSearchEndpoint(s Service, authBearerFn security.AuthBearerFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchPayload)
+		var err error
+		sc := security.BearerScheme{
+			Name:           "bearer",
+			Scopes:         []string{"ingest:apitokens:create", "ingest:apitokens:list", "ingest:apitokens:revoke", "ingest:batches:create", "ingest:batches:list", "ingest:batches:read", "ingest:batches:retry", "ingest:batches:review", "ingest:sips:cancel", "ingest:sips:create", "ingest:sips:decision", "ingest:sips:download", "ingest:sips:list", "ingest:sips:read", "ingest:sips:retry", "ingest:sips:review", "ingest:sips:upload", "ingest:sips:workflows:list", "ingest:sipsources:objects:list", "ingest:users:list", "storage:aips:create", "storage:aips:deletion:auto", "storage:aips:deletion:report", "storage:aips:deletion:request", "storage:aips:deletion:review", "storage:aips:download", "storage:aips:legalhold", "storage:aips:list", "storage:aips:move", "storage:aips:read", "storage:aips:restore", "storage:aips:review", "storage:aips:workflows:list", "storage:locations:aips:list", "storage:locations:create", "storage:locations:list", "storage:locations:read"},
+			RequiredScopes: []string{"storage:aips:list"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authBearerFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Search(ctx, p)
+	}
+}
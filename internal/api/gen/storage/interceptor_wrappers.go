@@ -112,6 +112,20 @@ func wrapMoveAipStatusOperationTimeout(endpoint goa.Endpoint, i ServerIntercepto
 	}
 }
 
+// wrapOperationTimeoutRestoreAips applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapRestoreAipsOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "storage",
+			method:     "RestoreAips",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
 // wrapOperationTimeoutRejectAip applies the OperationTimeout server
 // interceptor to endpoints.
 func wrapRejectAipOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -182,6 +196,34 @@ func wrapRequestAipDeletionOperationTimeout(endpoint goa.Endpoint, i ServerInter
 	}
 }
 
+// wrapOperationTimeoutPlaceAipLegalHold applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapPlaceAipLegalHoldOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "storage",
+			method:     "PlaceAipLegalHold",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutReleaseAipLegalHold applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapReleaseAipLegalHoldOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "storage",
+			method:     "ReleaseAipLegalHold",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
 // wrapOperationTimeoutReviewAipDeletion applies the OperationTimeout server
 // interceptor to endpoints.
 func wrapReviewAipDeletionOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -293,3 +335,31 @@ func wrapListLocationAipsOperationTimeout(endpoint goa.Endpoint, i ServerInterce
 		return i.OperationTimeout(ctx, info, endpoint)
 	}
 }
+
+// wrapOperationTimeoutLocationUsage applies the OperationTimeout server
+// interceptor to endpoints.
+func wrapLocationUsageOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "storage",
+			method:     "LocationUsage",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
+
+// wrapOperationTimeoutSearch applies the OperationTimeout server interceptor to
+// endpoints.
+func wrapSearchOperationTimeout(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &OperationTimeoutInfo{
+			service:    "storage",
+			method:     "Search",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.OperationTimeout(ctx, info, endpoint)
+	}
+}
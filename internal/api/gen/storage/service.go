@@ -40,6 +40,8 @@ type Service interface {
 	MoveAip(context.Context, *MoveAipPayload) (err error)
 	// Retrieve the status of a permanent storage location move of the AIP
 	MoveAipStatus(context.Context, *MoveAipStatusPayload) (res *MoveStatusResult, err error)
+	// Restore AIPs to a restore location
+	RestoreAips(context.Context, *RestoreAipsPayload) (err error)
 	// Reject an AIP
 	RejectAip(context.Context, *RejectAipPayload) (err error)
 	// Show AIP by AIPID
@@ -50,6 +52,10 @@ type Service interface {
 	AipDeletionAuto(context.Context, *AipDeletionAutoPayload) (err error)
 	// Request an AIP deletion
 	RequestAipDeletion(context.Context, *RequestAipDeletionPayload) (err error)
+	// Place a legal hold on an AIP to prevent its deletion and moves
+	PlaceAipLegalHold(context.Context, *PlaceAipLegalHoldPayload) (err error)
+	// Release the active legal hold of an AIP
+	ReleaseAipLegalHold(context.Context, *ReleaseAipLegalHoldPayload) (err error)
 	// Review an AIP deletion request
 	ReviewAipDeletion(context.Context, *ReviewAipDeletionPayload) (err error)
 	// Cancel an AIP deletion request
@@ -70,6 +76,10 @@ type Service interface {
 	ShowLocation(context.Context, *ShowLocationPayload) (res *Location, err error)
 	// List all the AIPs stored in the location with UUID
 	ListLocationAips(context.Context, *ListLocationAipsPayload) (res AIPCollection, err error)
+	// Show the storage usage of the location with UUID
+	LocationUsage(context.Context, *LocationUsagePayload) (res *LocationUsage, err error)
+	// Search AIPs by name and descriptive metadata
+	Search(context.Context, *SearchPayload) (res *SearchResults, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -92,7 +102,7 @@ const ServiceName = "storage"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [20]string{"monitor", "list_aips", "create_aip", "download_aip_request", "download_aip", "move_aip", "move_aip_status", "reject_aip", "show_aip", "list_aip_workflows", "aip_deletion_auto", "request_aip_deletion", "review_aip_deletion", "cancel_aip_deletion", "aip_deletion_report_request", "aip_deletion_report", "list_locations", "create_location", "show_location", "list_location_aips"}
+var MethodNames = [25]string{"monitor", "list_aips", "create_aip", "download_aip_request", "download_aip", "move_aip", "move_aip_status", "restore_aips", "reject_aip", "show_aip", "list_aip_workflows", "aip_deletion_auto", "request_aip_deletion", "place_aip_legal_hold", "release_aip_legal_hold", "review_aip_deletion", "cancel_aip_deletion", "aip_deletion_report_request", "aip_deletion_report", "list_locations", "create_location", "show_location", "list_location_aips", "location_usage", "search"}
 
 // MonitorServerStream allows streaming instances of *StorageEvent to the
 // client.
@@ -128,6 +138,18 @@ type AIP struct {
 	CreatedAt string
 	// Deletion report key
 	DeletionReportKey *string
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string
+	// Size of the AIP in bytes
+	Size *int64
+	// Number of files in the AIP
+	FileCount *int
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollection
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollection
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any
 }
 
 // AIPCollection is the result type of the storage service list_location_aips
@@ -140,6 +162,76 @@ type AIPCreatedEvent struct {
 	Item *AIP
 }
 
+type AIPDeletionRequestCreatedEvent struct {
+	// Identifier of deletion request
+	UUID uuid.UUID
+	// Identifier of AIP
+	AipUUID uuid.UUID
+	// Status of the deletion request
+	Status string
+	// Reason for the deletion
+	Reason string
+	// User who requested the deletion
+	Requester string
+	// User who reviewed the deletion request
+	Reviewer *string
+}
+
+type AIPDeletionRequestUpdatedEvent struct {
+	// Identifier of deletion request
+	UUID uuid.UUID
+	// Identifier of AIP
+	AipUUID uuid.UUID
+	// Status of the deletion request
+	Status string
+	// Reason for the deletion
+	Reason string
+	// User who requested the deletion
+	Requester string
+	// User who reviewed the deletion request
+	Reviewer *string
+}
+
+type AIPFixityCheckedEvent struct {
+	// Identifier of fixity check
+	UUID uuid.UUID
+	// Identifier of AIP
+	AipUUID uuid.UUID
+	// Result of the fixity check
+	Status string
+	// Algorithm of the checksums
+	ChecksumAlgorithm string
+	// Checksum of the AIP stored in Enduro
+	ExpectedChecksum string
+	// Checksum computed from the stored AIP
+	ActualChecksum string
+	// Time the fixity check was completed
+	CheckedAt string
+}
+
+// AIPLegalHold describes a legal hold placed on an AIP to prevent its deletion
+// and moves.
+type AIPLegalHold struct {
+	// Identifier of the legal hold
+	UUID uuid.UUID
+	// Reason of the legal hold
+	Reason string
+	// User who placed the legal hold
+	PlacedBy string
+	// Placement datetime
+	PlacedAt string
+	// User who released the legal hold
+	ReleasedBy *string
+	// Reason of the legal hold release
+	ReleaseReason *string
+	// Release datetime
+	ReleasedAt *string
+}
+
+type AIPReplicaCollection []*AIPReplica
+
+type AIPLegalHoldCollection []*AIPLegalHold
+
 type AIPLocationUpdatedEvent struct {
 	// Identifier of AIP
 	UUID uuid.UUID
@@ -155,6 +247,18 @@ type AIPNotFound struct {
 	UUID uuid.UUID
 }
 
+// AIPReplica describes a copy of an AIP in a replication location.
+type AIPReplica struct {
+	// Identifier of replication location
+	LocationUUID uuid.UUID
+	// Status of the replica
+	Status string
+	// Creation datetime
+	CreatedAt string
+	// Replication datetime
+	ReplicatedAt *string
+}
+
 type AIPStatusUpdatedEvent struct {
 	// Identifier of AIP
 	UUID   uuid.UUID
@@ -303,7 +407,11 @@ type CreateAipPayload struct {
 	Status string
 	// Identifier of the AIP's storage location
 	LocationUUID *uuid.UUID
-	Token        *string
+	// Descriptive metadata of the AIP, e.g. Dublin Core fields from its METS file
+	Metadata map[string]string
+	// Custom metadata of the AIP, copied from its SIP
+	CustomMetadata map[string]any
+	Token          *string
 }
 
 // CreateLocationPayload is the payload type of the storage service
@@ -314,7 +422,10 @@ type CreateLocationPayload struct {
 	Source      string
 	Purpose     string
 	Config      Config
-	Token       *string
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string
+	Token              *string
 }
 
 // CreateLocationResult is the result type of the storage service
@@ -363,6 +474,10 @@ type EnduroPage struct {
 	Total int
 }
 
+type FilesystemConfig struct {
+	Path string
+}
+
 // ListAipWorkflowsPayload is the payload type of the storage service
 // list_aip_workflows method.
 type ListAipWorkflowsPayload struct {
@@ -380,6 +495,8 @@ type ListAipsPayload struct {
 	EarliestCreatedTime *string
 	LatestCreatedTime   *string
 	Status              *string
+	// Filter AIPs by the presence of a custom metadata key
+	MetadataKey *string
 	// Limit number of results to return
 	Limit *int
 	// Offset from the beginning of the found set
@@ -415,6 +532,9 @@ type Location struct {
 	Config  Config
 	// Creation datetime
 	CreatedAt string
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string
 }
 
 // LocationCollection is the result type of the storage service list_locations
@@ -434,6 +554,29 @@ type LocationNotFound struct {
 	UUID    uuid.UUID
 }
 
+// LocationUsage is the result type of the storage service location_usage
+// method.
+type LocationUsage struct {
+	// Number of AIPs in the location
+	AipCount int
+	// Total size of the AIPs in bytes
+	Size int64
+	// Total number of files in the AIPs
+	FileCount int64
+	// Maximum total size of the AIPs in bytes, if configured
+	Quota *int64
+	// Whether the quota has been reached and new AIPs are rejected
+	QuotaExceeded bool
+}
+
+// LocationUsagePayload is the payload type of the storage service
+// location_usage method.
+type LocationUsagePayload struct {
+	// Identifier of location
+	UUID  string
+	Token *string
+}
+
 // MonitorPayload is the payload type of the storage service monitor method.
 type MonitorPayload struct {
 	Token *string
@@ -479,6 +622,36 @@ type RequestAipDeletionPayload struct {
 	Reason string
 }
 
+// PlaceAipLegalHoldPayload is the payload type of the storage service
+// place_aip_legal_hold method.
+type PlaceAipLegalHoldPayload struct {
+	// Identifier of AIP
+	UUID   string
+	Token  *string
+	Reason string
+}
+
+// ReleaseAipLegalHoldPayload is the payload type of the storage service
+// release_aip_legal_hold method.
+type ReleaseAipLegalHoldPayload struct {
+	// Identifier of AIP
+	UUID   string
+	Token  *string
+	Reason string
+}
+
+// RestoreAipsPayload is the payload type of the storage service restore_aips
+// method.
+type RestoreAipsPayload struct {
+	// Identifiers of the AIPs to restore
+	AipUuids []string
+	// Identifier of the restore location
+	LocationUUID *uuid.UUID
+	// Extract the AIP files in the restore location
+	Unpack *bool
+	Token  *string
+}
+
 // ReviewAipDeletionPayload is the payload type of the storage service
 // review_aip_deletion method.
 type ReviewAipDeletionPayload struct {
@@ -506,6 +679,33 @@ type SFTPConfig struct {
 	Directory string
 }
 
+// SearchPayload is the payload type of the storage service search method.
+type SearchPayload struct {
+	// Search terms, all of them must match
+	Query string
+	// Limit number of results to return
+	Limit *int
+	// Offset from the beginning of the found set
+	Offset *int
+	Token  *string
+}
+
+// SearchResult describes a package matching a search query.
+type SearchResult struct {
+	// Identifier of the package
+	UUID uuid.UUID
+	// Name of the package
+	Name string
+	// Indexed metadata of the package, keyed by field name
+	Fields map[string]string
+}
+
+// SearchResults describes a page of search results.
+type SearchResults struct {
+	Items []*SearchResult
+	Page  *EnduroPage
+}
+
 // ShowAipPayload is the payload type of the storage service show_aip method.
 type ShowAipPayload struct {
 	// Identifier of AIP
@@ -610,11 +810,12 @@ func (e Unauthorized) GoaErrorName() string {
 
 // Config is a sum-type union.
 type Config struct {
-	kind ConfigKind
-	Amss *AMSSConfig
-	S3   *S3Config
-	Sftp *SFTPConfig
-	URL  *URLConfig
+	kind       ConfigKind
+	Amss       *AMSSConfig
+	Filesystem *FilesystemConfig
+	S3         *S3Config
+	Sftp       *SFTPConfig
+	URL        *URLConfig
 }
 
 // ConfigKind enumerates the union variants for Config.
@@ -623,6 +824,8 @@ type ConfigKind string
 const (
 	// ConfigKindAmss identifies the amss branch of the union.
 	ConfigKindAmss ConfigKind = "amss"
+	// ConfigKindFilesystem identifies the filesystem branch of the union.
+	ConfigKindFilesystem ConfigKind = "filesystem"
 	// ConfigKindS3 identifies the s3 branch of the union.
 	ConfigKindS3 ConfigKind = "s3"
 	// ConfigKindSftp identifies the sftp branch of the union.
@@ -658,6 +861,28 @@ func (u *Config) SetAmss(v *AMSSConfig) {
 	u.Amss = v
 }
 
+// NewConfigFilesystem constructs a Config with the filesystem branch set.
+func NewConfigFilesystem(v *FilesystemConfig) Config {
+	return Config{
+		kind:       ConfigKindFilesystem,
+		Filesystem: v,
+	}
+}
+
+// AsFilesystem returns the value of the filesystem branch if set.
+func (u Config) AsFilesystem() (_ *FilesystemConfig, ok bool) {
+	if u.kind != ConfigKindFilesystem {
+		return
+	}
+	return u.Filesystem, true
+}
+
+// SetFilesystem sets the filesystem branch of the union.
+func (u *Config) SetFilesystem(v *FilesystemConfig) {
+	u.kind = ConfigKindFilesystem
+	u.Filesystem = v
+}
+
 // NewConfigS3 constructs a Config with the s3 branch set.
 func NewConfigS3(v *S3Config) Config {
 	return Config{
@@ -730,12 +955,15 @@ func (u Config) Validate() error {
 	case "":
 		return goa.InvalidEnumValueError("type", "", []any{
 			string(ConfigKindAmss),
+			string(ConfigKindFilesystem),
 			string(ConfigKindS3),
 			string(ConfigKindSftp),
 			string(ConfigKindURL),
 		})
 	case ConfigKindAmss:
 		return nil
+	case ConfigKindFilesystem:
+		return nil
 	case ConfigKindS3:
 		return nil
 	case ConfigKindSftp:
@@ -745,6 +973,7 @@ func (u Config) Validate() error {
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(ConfigKindAmss),
+			string(ConfigKindFilesystem),
 			string(ConfigKindS3),
 			string(ConfigKindSftp),
 			string(ConfigKindURL),
@@ -763,6 +992,8 @@ func (u Config) MarshalJSON() ([]byte, error) {
 	switch u.kind {
 	case ConfigKindAmss:
 		value = u.Amss
+	case ConfigKindFilesystem:
+		value = u.Filesystem
 	case ConfigKindS3:
 		value = u.S3
 	case ConfigKindSftp:
@@ -798,6 +1029,13 @@ func (u *Config) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = ConfigKindAmss
 		u.Amss = v
+	case string(ConfigKindFilesystem):
+		var v *FilesystemConfig
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ConfigKindFilesystem
+		u.Filesystem = v
 	case string(ConfigKindS3):
 		var v *S3Config
 		if err := json.Unmarshal(raw.Value, &v); err != nil {
@@ -827,17 +1065,20 @@ func (u *Config) UnmarshalJSON(data []byte) error {
 
 // Value is a sum-type union.
 type Value struct {
-	kind                    ValueKind
-	StoragePingEvent        *StoragePingEvent
-	LocationCreatedEvent    *LocationCreatedEvent
-	AipCreatedEvent         *AIPCreatedEvent
-	AipUpdatedEvent         *AIPUpdatedEvent
-	AipStatusUpdatedEvent   *AIPStatusUpdatedEvent
-	AipLocationUpdatedEvent *AIPLocationUpdatedEvent
-	AipWorkflowCreatedEvent *AIPWorkflowCreatedEvent
-	AipWorkflowUpdatedEvent *AIPWorkflowUpdatedEvent
-	AipTaskCreatedEvent     *AIPTaskCreatedEvent
-	AipTaskUpdatedEvent     *AIPTaskUpdatedEvent
+	kind                           ValueKind
+	StoragePingEvent               *StoragePingEvent
+	LocationCreatedEvent           *LocationCreatedEvent
+	AipCreatedEvent                *AIPCreatedEvent
+	AipUpdatedEvent                *AIPUpdatedEvent
+	AipStatusUpdatedEvent          *AIPStatusUpdatedEvent
+	AipLocationUpdatedEvent        *AIPLocationUpdatedEvent
+	AipWorkflowCreatedEvent        *AIPWorkflowCreatedEvent
+	AipWorkflowUpdatedEvent        *AIPWorkflowUpdatedEvent
+	AipTaskCreatedEvent            *AIPTaskCreatedEvent
+	AipTaskUpdatedEvent            *AIPTaskUpdatedEvent
+	AipDeletionRequestCreatedEvent *AIPDeletionRequestCreatedEvent
+	AipDeletionRequestUpdatedEvent *AIPDeletionRequestUpdatedEvent
+	AipFixityCheckedEvent          *AIPFixityCheckedEvent
 }
 
 // ValueKind enumerates the union variants for Value.
@@ -864,6 +1105,12 @@ const (
 	ValueKindAipTaskCreatedEvent ValueKind = "aip_task_created_event"
 	// ValueKindAipTaskUpdatedEvent identifies the aip_task_updated_event branch of the union.
 	ValueKindAipTaskUpdatedEvent ValueKind = "aip_task_updated_event"
+	// ValueKindAipDeletionRequestCreatedEvent identifies the aip_deletion_request_created_event branch of the union.
+	ValueKindAipDeletionRequestCreatedEvent ValueKind = "aip_deletion_request_created_event"
+	// ValueKindAipDeletionRequestUpdatedEvent identifies the aip_deletion_request_updated_event branch of the union.
+	ValueKindAipDeletionRequestUpdatedEvent ValueKind = "aip_deletion_request_updated_event"
+	// ValueKindAipFixityCheckedEvent identifies the aip_fixity_checked_event branch of the union.
+	ValueKindAipFixityCheckedEvent ValueKind = "aip_fixity_checked_event"
 )
 
 // Kind returns the discriminator value of the union.
@@ -1091,6 +1338,72 @@ func (u *Value) SetAipTaskUpdatedEvent(v *AIPTaskUpdatedEvent) {
 	u.AipTaskUpdatedEvent = v
 }
 
+// NewValueAipDeletionRequestCreatedEvent constructs a Value with the aip_deletion_request_created_event branch set.
+func NewValueAipDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEvent) Value {
+	return Value{
+		kind:                           ValueKindAipDeletionRequestCreatedEvent,
+		AipDeletionRequestCreatedEvent: v,
+	}
+}
+
+// AsAipDeletionRequestCreatedEvent returns the value of the aip_deletion_request_created_event branch if set.
+func (u Value) AsAipDeletionRequestCreatedEvent() (_ *AIPDeletionRequestCreatedEvent, ok bool) {
+	if u.kind != ValueKindAipDeletionRequestCreatedEvent {
+		return
+	}
+	return u.AipDeletionRequestCreatedEvent, true
+}
+
+// SetAipDeletionRequestCreatedEvent sets the aip_deletion_request_created_event branch of the union.
+func (u *Value) SetAipDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEvent) {
+	u.kind = ValueKindAipDeletionRequestCreatedEvent
+	u.AipDeletionRequestCreatedEvent = v
+}
+
+// NewValueAipDeletionRequestUpdatedEvent constructs a Value with the aip_deletion_request_updated_event branch set.
+func NewValueAipDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEvent) Value {
+	return Value{
+		kind:                           ValueKindAipDeletionRequestUpdatedEvent,
+		AipDeletionRequestUpdatedEvent: v,
+	}
+}
+
+// AsAipDeletionRequestUpdatedEvent returns the value of the aip_deletion_request_updated_event branch if set.
+func (u Value) AsAipDeletionRequestUpdatedEvent() (_ *AIPDeletionRequestUpdatedEvent, ok bool) {
+	if u.kind != ValueKindAipDeletionRequestUpdatedEvent {
+		return
+	}
+	return u.AipDeletionRequestUpdatedEvent, true
+}
+
+// SetAipDeletionRequestUpdatedEvent sets the aip_deletion_request_updated_event branch of the union.
+func (u *Value) SetAipDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEvent) {
+	u.kind = ValueKindAipDeletionRequestUpdatedEvent
+	u.AipDeletionRequestUpdatedEvent = v
+}
+
+// NewValueAipFixityCheckedEvent constructs a Value with the aip_fixity_checked_event branch set.
+func NewValueAipFixityCheckedEvent(v *AIPFixityCheckedEvent) Value {
+	return Value{
+		kind:                  ValueKindAipFixityCheckedEvent,
+		AipFixityCheckedEvent: v,
+	}
+}
+
+// AsAipFixityCheckedEvent returns the value of the aip_fixity_checked_event branch if set.
+func (u Value) AsAipFixityCheckedEvent() (_ *AIPFixityCheckedEvent, ok bool) {
+	if u.kind != ValueKindAipFixityCheckedEvent {
+		return
+	}
+	return u.AipFixityCheckedEvent, true
+}
+
+// SetAipFixityCheckedEvent sets the aip_fixity_checked_event branch of the union.
+func (u *Value) SetAipFixityCheckedEvent(v *AIPFixityCheckedEvent) {
+	u.kind = ValueKindAipFixityCheckedEvent
+	u.AipFixityCheckedEvent = v
+}
+
 // Validate ensures the union discriminant is valid.
 func (u Value) Validate() error {
 	switch u.kind {
@@ -1106,6 +1419,9 @@ func (u Value) Validate() error {
 			string(ValueKindAipWorkflowUpdatedEvent),
 			string(ValueKindAipTaskCreatedEvent),
 			string(ValueKindAipTaskUpdatedEvent),
+			string(ValueKindAipDeletionRequestCreatedEvent),
+			string(ValueKindAipDeletionRequestUpdatedEvent),
+			string(ValueKindAipFixityCheckedEvent),
 		})
 	case ValueKindStoragePingEvent:
 		return nil
@@ -1127,6 +1443,12 @@ func (u Value) Validate() error {
 		return nil
 	case ValueKindAipTaskUpdatedEvent:
 		return nil
+	case ValueKindAipDeletionRequestCreatedEvent:
+		return nil
+	case ValueKindAipDeletionRequestUpdatedEvent:
+		return nil
+	case ValueKindAipFixityCheckedEvent:
+		return nil
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(ValueKindStoragePingEvent),
@@ -1139,6 +1461,9 @@ func (u Value) Validate() error {
 			string(ValueKindAipWorkflowUpdatedEvent),
 			string(ValueKindAipTaskCreatedEvent),
 			string(ValueKindAipTaskUpdatedEvent),
+			string(ValueKindAipDeletionRequestCreatedEvent),
+			string(ValueKindAipDeletionRequestUpdatedEvent),
+			string(ValueKindAipFixityCheckedEvent),
 		})
 	}
 }
@@ -1172,6 +1497,12 @@ func (u Value) MarshalJSON() ([]byte, error) {
 		value = u.AipTaskCreatedEvent
 	case ValueKindAipTaskUpdatedEvent:
 		value = u.AipTaskUpdatedEvent
+	case ValueKindAipDeletionRequestCreatedEvent:
+		value = u.AipDeletionRequestCreatedEvent
+	case ValueKindAipDeletionRequestUpdatedEvent:
+		value = u.AipDeletionRequestUpdatedEvent
+	case ValueKindAipFixityCheckedEvent:
+		value = u.AipFixityCheckedEvent
 	default:
 		return nil, fmt.Errorf("unexpected Value discriminant %q", u.kind)
 	}
@@ -1264,6 +1595,27 @@ func (u *Value) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = ValueKindAipTaskUpdatedEvent
 		u.AipTaskUpdatedEvent = v
+	case string(ValueKindAipDeletionRequestCreatedEvent):
+		var v *AIPDeletionRequestCreatedEvent
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipDeletionRequestCreatedEvent
+		u.AipDeletionRequestCreatedEvent = v
+	case string(ValueKindAipDeletionRequestUpdatedEvent):
+		var v *AIPDeletionRequestUpdatedEvent
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipDeletionRequestUpdatedEvent
+		u.AipDeletionRequestUpdatedEvent = v
+	case string(ValueKindAipFixityCheckedEvent):
+		var v *AIPFixityCheckedEvent
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipFixityCheckedEvent
+		u.AipFixityCheckedEvent = v
 	default:
 		return fmt.Errorf("unexpected Value type %q", raw.Type)
 	}
@@ -1391,6 +1743,12 @@ func newLocation(vres *storageviews.LocationView) *Location {
 	if vres.CreatedAt != nil {
 		res.CreatedAt = *vres.CreatedAt
 	}
+	if vres.ReplicationTargets != nil {
+		res.ReplicationTargets = make([]string, len(vres.ReplicationTargets))
+		for i, val := range vres.ReplicationTargets {
+			res.ReplicationTargets[i] = val
+		}
+	}
 	if vres.Source == nil {
 		res.Source = "unspecified"
 	}
@@ -1411,6 +1769,12 @@ func newLocationView(res *Location) *storageviews.LocationView {
 		UUID:        &res.UUID,
 		CreatedAt:   &res.CreatedAt,
 	}
+	if res.ReplicationTargets != nil {
+		vres.ReplicationTargets = make([]string, len(res.ReplicationTargets))
+		for i, val := range res.ReplicationTargets {
+			vres.ReplicationTargets[i] = val
+		}
+	}
 	return vres
 }
 
@@ -1419,6 +1783,9 @@ func newAIP(vres *storageviews.AIPView) *AIP {
 	res := &AIP{
 		LocationUUID:      vres.LocationUUID,
 		DeletionReportKey: vres.DeletionReportKey,
+		DisposalAt:        vres.DisposalAt,
+		Size:              vres.Size,
+		FileCount:         vres.FileCount,
 	}
 	if vres.Name != nil {
 		res.Name = *vres.Name
@@ -1435,6 +1802,20 @@ func newAIP(vres *storageviews.AIPView) *AIP {
 	if vres.CreatedAt != nil {
 		res.CreatedAt = *vres.CreatedAt
 	}
+	if vres.Replicas != nil {
+		res.Replicas = newAIPReplicaCollection(vres.Replicas)
+	}
+	if vres.LegalHolds != nil {
+		res.LegalHolds = newAIPLegalHoldCollection(vres.LegalHolds)
+	}
+	if vres.CustomMetadata != nil {
+		res.CustomMetadata = make(map[string]any, len(vres.CustomMetadata))
+		for key, val := range vres.CustomMetadata {
+			tk := key
+			tv := val
+			res.CustomMetadata[tk] = tv
+		}
+	}
 	if vres.Status == nil {
 		res.Status = "unspecified"
 	}
@@ -1452,6 +1833,130 @@ func newAIPView(res *AIP) *storageviews.AIPView {
 		LocationUUID:      res.LocationUUID,
 		CreatedAt:         &res.CreatedAt,
 		DeletionReportKey: res.DeletionReportKey,
+		DisposalAt:        res.DisposalAt,
+		Size:              res.Size,
+		FileCount:         res.FileCount,
+	}
+	if res.Replicas != nil {
+		vres.Replicas = newAIPReplicaCollectionView(res.Replicas)
+	}
+	if res.LegalHolds != nil {
+		vres.LegalHolds = newAIPLegalHoldCollectionView(res.LegalHolds)
+	}
+	if res.CustomMetadata != nil {
+		vres.CustomMetadata = make(map[string]any, len(res.CustomMetadata))
+		for key, val := range res.CustomMetadata {
+			tk := key
+			tv := val
+			vres.CustomMetadata[tk] = tv
+		}
+	}
+	return vres
+}
+
+// newAIPReplicaCollection converts projected type AIPReplicaCollection to
+// service type AIPReplicaCollection.
+func newAIPReplicaCollection(vres storageviews.AIPReplicaCollectionView) AIPReplicaCollection {
+	res := make(AIPReplicaCollection, len(vres))
+	for i, n := range vres {
+		res[i] = newAIPReplica(n)
+	}
+	return res
+}
+
+// newAIPLegalHoldCollection converts projected type AIPLegalHoldCollection to
+// service type AIPLegalHoldCollection.
+func newAIPLegalHoldCollection(vres storageviews.AIPLegalHoldCollectionView) AIPLegalHoldCollection {
+	res := make(AIPLegalHoldCollection, len(vres))
+	for i, n := range vres {
+		res[i] = newAIPLegalHold(n)
+	}
+	return res
+}
+
+// newAIPReplicaCollectionView projects result type AIPReplicaCollection to
+// projected type AIPReplicaCollectionView using the "default" view.
+func newAIPReplicaCollectionView(res AIPReplicaCollection) storageviews.AIPReplicaCollectionView {
+	vres := make(storageviews.AIPReplicaCollectionView, len(res))
+	for i, n := range res {
+		vres[i] = newAIPReplicaView(n)
+	}
+	return vres
+}
+
+// newAIPLegalHoldCollectionView projects result type AIPLegalHoldCollection to
+// projected type AIPLegalHoldCollectionView using the "default" view.
+func newAIPLegalHoldCollectionView(res AIPLegalHoldCollection) storageviews.AIPLegalHoldCollectionView {
+	vres := make(storageviews.AIPLegalHoldCollectionView, len(res))
+	for i, n := range res {
+		vres[i] = newAIPLegalHoldView(n)
+	}
+	return vres
+}
+
+// newAIPReplica converts projected type AIPReplica to service type AIPReplica.
+func newAIPReplica(vres *storageviews.AIPReplicaView) *AIPReplica {
+	res := &AIPReplica{
+		ReplicatedAt: vres.ReplicatedAt,
+	}
+	if vres.LocationUUID != nil {
+		res.LocationUUID = *vres.LocationUUID
+	}
+	if vres.Status != nil {
+		res.Status = *vres.Status
+	}
+	if vres.CreatedAt != nil {
+		res.CreatedAt = *vres.CreatedAt
+	}
+	return res
+}
+
+// newAIPLegalHold converts projected type AIPLegalHold to service type
+// AIPLegalHold.
+func newAIPLegalHold(vres *storageviews.AIPLegalHoldView) *AIPLegalHold {
+	res := &AIPLegalHold{
+		ReleasedBy:    vres.ReleasedBy,
+		ReleaseReason: vres.ReleaseReason,
+		ReleasedAt:    vres.ReleasedAt,
+	}
+	if vres.UUID != nil {
+		res.UUID = *vres.UUID
+	}
+	if vres.Reason != nil {
+		res.Reason = *vres.Reason
+	}
+	if vres.PlacedBy != nil {
+		res.PlacedBy = *vres.PlacedBy
+	}
+	if vres.PlacedAt != nil {
+		res.PlacedAt = *vres.PlacedAt
+	}
+	return res
+}
+
+// newAIPReplicaView projects result type AIPReplica to projected type
+// AIPReplicaView using the "default" view.
+func newAIPReplicaView(res *AIPReplica) *storageviews.AIPReplicaView {
+	vres := &storageviews.AIPReplicaView{
+		LocationUUID: &res.LocationUUID,
+		Status:       &res.Status,
+		CreatedAt:    &res.CreatedAt,
+		ReplicatedAt: res.ReplicatedAt,
+	}
+	return vres
+}
+
+// newAIPLegalHoldView projects result type AIPLegalHold to projected type
+// AIPLegalHoldView using the "default" view.
+func newAIPLegalHoldView(res *AIPLegalHold) *storageviews.AIPLegalHoldView {
+	vres := &storageviews.AIPLegalHoldView{
+		UUID:          &res.UUID,
+		Reason:        &res.Reason,
+		PlacedBy:      &res.PlacedBy,
+		PlacedAt:      &res.PlacedAt,
+		ReleasedBy:    res.ReleasedBy,
+		ReleaseReason: res.ReleaseReason,
+		ReleasedAt:    res.ReleasedAt,
 	}
 	return vres
 }
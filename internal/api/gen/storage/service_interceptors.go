@@ -97,6 +97,15 @@ func WrapMoveAipStatusEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.
 	return endpoint
 }
 
+// WrapRestoreAipsEndpoint wraps the restore_aips endpoint with the
+// server-side interceptors defined in the design.
+func WrapRestoreAipsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapRestoreAipsOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
 // WrapRejectAipEndpoint wraps the reject_aip endpoint with the server-side
 // interceptors defined in the design.
 func WrapRejectAipEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -142,6 +151,24 @@ func WrapRequestAipDeletionEndpoint(endpoint goa.Endpoint, i ServerInterceptors)
 	return endpoint
 }
 
+// WrapPlaceAipLegalHoldEndpoint wraps the place_aip_legal_hold endpoint with
+// the server-side interceptors defined in the design.
+func WrapPlaceAipLegalHoldEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapPlaceAipLegalHoldOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapReleaseAipLegalHoldEndpoint wraps the release_aip_legal_hold endpoint with
+// the server-side interceptors defined in the design.
+func WrapReleaseAipLegalHoldEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapReleaseAipLegalHoldOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
 // WrapReviewAipDeletionEndpoint wraps the review_aip_deletion endpoint with
 // the server-side interceptors defined in the design.
 func WrapReviewAipDeletionEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -214,6 +241,24 @@ func WrapListLocationAipsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) g
 	return endpoint
 }
 
+// WrapLocationUsageEndpoint wraps the location_usage endpoint with the
+// server-side interceptors defined in the design.
+func WrapLocationUsageEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapLocationUsageOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
+// WrapSearchEndpoint wraps the search endpoint with the server-side
+// interceptors defined in the design.
+func WrapSearchEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	if i != nil {
+		endpoint = wrapSearchOperationTimeout(endpoint, i)
+	}
+	return endpoint
+}
+
 // Public accessor methods for Info types
 
 // Service returns the name of the service handling the request.
@@ -96,6 +96,9 @@ type LocationView struct {
 	Config  Config
 	// Creation datetime
 	CreatedAt *string
+	// Identifiers of the locations where the AIPs stored in this location are
+	// replicated
+	ReplicationTargets []string
 }
 
 // AMSSConfigView is a type that runs validations on a projected type.
@@ -105,6 +108,11 @@ type AMSSConfigView struct {
 	Username *string
 }
 
+// FilesystemConfigView is a type that runs validations on a projected type.
+type FilesystemConfigView struct {
+	Path *string
+}
+
 // S3ConfigView is a type that runs validations on a projected type.
 type S3ConfigView struct {
 	Bucket    *string
@@ -150,6 +158,55 @@ type AIPView struct {
 	CreatedAt *string
 	// Deletion report key
 	DeletionReportKey *string
+	// Disposal date of the AIP, set by its retention policy
+	DisposalAt *string
+	// Size of the AIP in bytes
+	Size *int64
+	// Number of files in the AIP
+	FileCount *int
+	// Replicas of the AIP in replication locations
+	Replicas AIPReplicaCollectionView
+	// Legal holds placed on the AIP
+	LegalHolds AIPLegalHoldCollectionView
+	// Custom metadata copied from the SIP
+	CustomMetadata map[string]any
+}
+
+// AIPReplicaCollectionView is a type that runs validations on a projected type.
+type AIPReplicaCollectionView []*AIPReplicaView
+
+// AIPReplicaView is a type that runs validations on a projected type.
+type AIPReplicaView struct {
+	// Identifier of replication location
+	LocationUUID *uuid.UUID
+	// Status of the replica
+	Status *string
+	// Creation datetime
+	CreatedAt *string
+	// Replication datetime
+	ReplicatedAt *string
+}
+
+// AIPLegalHoldCollectionView is a type that runs validations on a projected
+// type.
+type AIPLegalHoldCollectionView []*AIPLegalHoldView
+
+// AIPLegalHoldView is a type that runs validations on a projected type.
+type AIPLegalHoldView struct {
+	// Identifier of the legal hold
+	UUID *uuid.UUID
+	// Reason of the legal hold
+	Reason *string
+	// User who placed the legal hold
+	PlacedBy *string
+	// Placement datetime
+	PlacedAt *string
+	// User who released the legal hold
+	ReleasedBy *string
+	// Reason of the legal hold release
+	ReleaseReason *string
+	// Release datetime
+	ReleasedAt *string
 }
 
 // AIPUpdatedEventView is a type that runs validations on a projected type.
@@ -234,6 +291,59 @@ type AIPTaskUpdatedEventView struct {
 	Item *AIPTaskView
 }
 
+// AIPDeletionRequestCreatedEventView is a type that runs validations on a
+// projected type.
+type AIPDeletionRequestCreatedEventView struct {
+	// Identifier of deletion request
+	UUID *uuid.UUID
+	// Identifier of AIP
+	AipUUID *uuid.UUID
+	// Status of the deletion request
+	Status *string
+	// Reason for the deletion
+	Reason *string
+	// User who requested the deletion
+	Requester *string
+	// User who reviewed the deletion request
+	Reviewer *string
+}
+
+// AIPDeletionRequestUpdatedEventView is a type that runs validations on a
+// projected type.
+type AIPDeletionRequestUpdatedEventView struct {
+	// Identifier of deletion request
+	UUID *uuid.UUID
+	// Identifier of AIP
+	AipUUID *uuid.UUID
+	// Status of the deletion request
+	Status *string
+	// Reason for the deletion
+	Reason *string
+	// User who requested the deletion
+	Requester *string
+	// User who reviewed the deletion request
+	Reviewer *string
+}
+
+// AIPFixityCheckedEventView is a type that runs validations on a projected
+// type.
+type AIPFixityCheckedEventView struct {
+	// Identifier of fixity check
+	UUID *uuid.UUID
+	// Identifier of AIP
+	AipUUID *uuid.UUID
+	// Result of the fixity check
+	Status *string
+	// Algorithm of the checksums
+	ChecksumAlgorithm *string
+	// Checksum of the AIP stored in Enduro
+	ExpectedChecksum *string
+	// Checksum computed from the stored AIP
+	ActualChecksum *string
+	// Time the fixity check was completed
+	CheckedAt *string
+}
+
 // AIPsView is a type that runs validations on a projected type.
 type AIPsView struct {
 	Items AIPCollectionView
@@ -267,11 +377,12 @@ type LocationCollectionView []*LocationView
 
 // Config is a sum-type union.
 type Config struct {
-	kind ConfigKind
-	Amss *AMSSConfigView
-	S3   *S3ConfigView
-	Sftp *SFTPConfigView
-	URL  *URLConfigView
+	kind       ConfigKind
+	Amss       *AMSSConfigView
+	Filesystem *FilesystemConfigView
+	S3         *S3ConfigView
+	Sftp       *SFTPConfigView
+	URL        *URLConfigView
 }
 
 // ConfigKind enumerates the union variants for Config.
@@ -280,6 +391,8 @@ type ConfigKind string
 const (
 	// ConfigKindAmss identifies the amss branch of the union.
 	ConfigKindAmss ConfigKind = "amss"
+	// ConfigKindFilesystem identifies the filesystem branch of the union.
+	ConfigKindFilesystem ConfigKind = "filesystem"
 	// ConfigKindS3 identifies the s3 branch of the union.
 	ConfigKindS3 ConfigKind = "s3"
 	// ConfigKindSftp identifies the sftp branch of the union.
@@ -315,6 +428,28 @@ func (u *Config) SetAmss(v *AMSSConfigView) {
 	u.Amss = v
 }
 
+// NewConfigFilesystem constructs a Config with the filesystem branch set.
+func NewConfigFilesystem(v *FilesystemConfigView) Config {
+	return Config{
+		kind:       ConfigKindFilesystem,
+		Filesystem: v,
+	}
+}
+
+// AsFilesystem returns the value of the filesystem branch if set.
+func (u Config) AsFilesystem() (_ *FilesystemConfigView, ok bool) {
+	if u.kind != ConfigKindFilesystem {
+		return
+	}
+	return u.Filesystem, true
+}
+
+// SetFilesystem sets the filesystem branch of the union.
+func (u *Config) SetFilesystem(v *FilesystemConfigView) {
+	u.kind = ConfigKindFilesystem
+	u.Filesystem = v
+}
+
 // NewConfigS3 constructs a Config with the s3 branch set.
 func NewConfigS3(v *S3ConfigView) Config {
 	return Config{
@@ -387,12 +522,15 @@ func (u Config) Validate() error {
 	case "":
 		return goa.InvalidEnumValueError("type", "", []any{
 			string(ConfigKindAmss),
+			string(ConfigKindFilesystem),
 			string(ConfigKindS3),
 			string(ConfigKindSftp),
 			string(ConfigKindURL),
 		})
 	case ConfigKindAmss:
 		return nil
+	case ConfigKindFilesystem:
+		return nil
 	case ConfigKindS3:
 		return nil
 	case ConfigKindSftp:
@@ -402,6 +540,7 @@ func (u Config) Validate() error {
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(ConfigKindAmss),
+			string(ConfigKindFilesystem),
 			string(ConfigKindS3),
 			string(ConfigKindSftp),
 			string(ConfigKindURL),
@@ -420,6 +559,8 @@ func (u Config) MarshalJSON() ([]byte, error) {
 	switch u.kind {
 	case ConfigKindAmss:
 		value = u.Amss
+	case ConfigKindFilesystem:
+		value = u.Filesystem
 	case ConfigKindS3:
 		value = u.S3
 	case ConfigKindSftp:
@@ -455,6 +596,13 @@ func (u *Config) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = ConfigKindAmss
 		u.Amss = v
+	case string(ConfigKindFilesystem):
+		var v *FilesystemConfigView
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ConfigKindFilesystem
+		u.Filesystem = v
 	case string(ConfigKindS3):
 		var v *S3ConfigView
 		if err := json.Unmarshal(raw.Value, &v); err != nil {
@@ -484,17 +632,20 @@ func (u *Config) UnmarshalJSON(data []byte) error {
 
 // Value is a sum-type union.
 type Value struct {
-	kind                    ValueKind
-	StoragePingEvent        *StoragePingEventView
-	LocationCreatedEvent    *LocationCreatedEventView
-	AipCreatedEvent         *AIPCreatedEventView
-	AipUpdatedEvent         *AIPUpdatedEventView
-	AipStatusUpdatedEvent   *AIPStatusUpdatedEventView
-	AipLocationUpdatedEvent *AIPLocationUpdatedEventView
-	AipWorkflowCreatedEvent *AIPWorkflowCreatedEventView
-	AipWorkflowUpdatedEvent *AIPWorkflowUpdatedEventView
-	AipTaskCreatedEvent     *AIPTaskCreatedEventView
-	AipTaskUpdatedEvent     *AIPTaskUpdatedEventView
+	kind                           ValueKind
+	StoragePingEvent               *StoragePingEventView
+	LocationCreatedEvent           *LocationCreatedEventView
+	AipCreatedEvent                *AIPCreatedEventView
+	AipUpdatedEvent                *AIPUpdatedEventView
+	AipStatusUpdatedEvent          *AIPStatusUpdatedEventView
+	AipLocationUpdatedEvent        *AIPLocationUpdatedEventView
+	AipWorkflowCreatedEvent        *AIPWorkflowCreatedEventView
+	AipWorkflowUpdatedEvent        *AIPWorkflowUpdatedEventView
+	AipTaskCreatedEvent            *AIPTaskCreatedEventView
+	AipTaskUpdatedEvent            *AIPTaskUpdatedEventView
+	AipDeletionRequestCreatedEvent *AIPDeletionRequestCreatedEventView
+	AipDeletionRequestUpdatedEvent *AIPDeletionRequestUpdatedEventView
+	AipFixityCheckedEvent          *AIPFixityCheckedEventView
 }
 
 // ValueKind enumerates the union variants for Value.
@@ -521,6 +672,12 @@ const (
 	ValueKindAipTaskCreatedEvent ValueKind = "aip_task_created_event"
 	// ValueKindAipTaskUpdatedEvent identifies the aip_task_updated_event branch of the union.
 	ValueKindAipTaskUpdatedEvent ValueKind = "aip_task_updated_event"
+	// ValueKindAipDeletionRequestCreatedEvent identifies the aip_deletion_request_created_event branch of the union.
+	ValueKindAipDeletionRequestCreatedEvent ValueKind = "aip_deletion_request_created_event"
+	// ValueKindAipDeletionRequestUpdatedEvent identifies the aip_deletion_request_updated_event branch of the union.
+	ValueKindAipDeletionRequestUpdatedEvent ValueKind = "aip_deletion_request_updated_event"
+	// ValueKindAipFixityCheckedEvent identifies the aip_fixity_checked_event branch of the union.
+	ValueKindAipFixityCheckedEvent ValueKind = "aip_fixity_checked_event"
 )
 
 // Kind returns the discriminator value of the union.
@@ -748,6 +905,72 @@ func (u *Value) SetAipTaskUpdatedEvent(v *AIPTaskUpdatedEventView) {
 	u.AipTaskUpdatedEvent = v
 }
 
+// NewValueAipDeletionRequestCreatedEvent constructs a Value with the aip_deletion_request_created_event branch set.
+func NewValueAipDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEventView) Value {
+	return Value{
+		kind:                           ValueKindAipDeletionRequestCreatedEvent,
+		AipDeletionRequestCreatedEvent: v,
+	}
+}
+
+// AsAipDeletionRequestCreatedEvent returns the value of the aip_deletion_request_created_event branch if set.
+func (u Value) AsAipDeletionRequestCreatedEvent() (_ *AIPDeletionRequestCreatedEventView, ok bool) {
+	if u.kind != ValueKindAipDeletionRequestCreatedEvent {
+		return
+	}
+	return u.AipDeletionRequestCreatedEvent, true
+}
+
+// SetAipDeletionRequestCreatedEvent sets the aip_deletion_request_created_event branch of the union.
+func (u *Value) SetAipDeletionRequestCreatedEvent(v *AIPDeletionRequestCreatedEventView) {
+	u.kind = ValueKindAipDeletionRequestCreatedEvent
+	u.AipDeletionRequestCreatedEvent = v
+}
+
+// NewValueAipDeletionRequestUpdatedEvent constructs a Value with the aip_deletion_request_updated_event branch set.
+func NewValueAipDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEventView) Value {
+	return Value{
+		kind:                           ValueKindAipDeletionRequestUpdatedEvent,
+		AipDeletionRequestUpdatedEvent: v,
+	}
+}
+
+// AsAipDeletionRequestUpdatedEvent returns the value of the aip_deletion_request_updated_event branch if set.
+func (u Value) AsAipDeletionRequestUpdatedEvent() (_ *AIPDeletionRequestUpdatedEventView, ok bool) {
+	if u.kind != ValueKindAipDeletionRequestUpdatedEvent {
+		return
+	}
+	return u.AipDeletionRequestUpdatedEvent, true
+}
+
+// SetAipDeletionRequestUpdatedEvent sets the aip_deletion_request_updated_event branch of the union.
+func (u *Value) SetAipDeletionRequestUpdatedEvent(v *AIPDeletionRequestUpdatedEventView) {
+	u.kind = ValueKindAipDeletionRequestUpdatedEvent
+	u.AipDeletionRequestUpdatedEvent = v
+}
+
+// NewValueAipFixityCheckedEvent constructs a Value with the aip_fixity_checked_event branch set.
+func NewValueAipFixityCheckedEvent(v *AIPFixityCheckedEventView) Value {
+	return Value{
+		kind:                  ValueKindAipFixityCheckedEvent,
+		AipFixityCheckedEvent: v,
+	}
+}
+
+// AsAipFixityCheckedEvent returns the value of the aip_fixity_checked_event branch if set.
+func (u Value) AsAipFixityCheckedEvent() (_ *AIPFixityCheckedEventView, ok bool) {
+	if u.kind != ValueKindAipFixityCheckedEvent {
+		return
+	}
+	return u.AipFixityCheckedEvent, true
+}
+
+// SetAipFixityCheckedEvent sets the aip_fixity_checked_event branch of the union.
+func (u *Value) SetAipFixityCheckedEvent(v *AIPFixityCheckedEventView) {
+	u.kind = ValueKindAipFixityCheckedEvent
+	u.AipFixityCheckedEvent = v
+}
+
 // Validate ensures the union discriminant is valid.
 func (u Value) Validate() error {
 	switch u.kind {
@@ -763,6 +986,9 @@ func (u Value) Validate() error {
 			string(ValueKindAipWorkflowUpdatedEvent),
 			string(ValueKindAipTaskCreatedEvent),
 			string(ValueKindAipTaskUpdatedEvent),
+			string(ValueKindAipDeletionRequestCreatedEvent),
+			string(ValueKindAipDeletionRequestUpdatedEvent),
+			string(ValueKindAipFixityCheckedEvent),
 		})
 	case ValueKindStoragePingEvent:
 		return nil
@@ -784,6 +1010,12 @@ func (u Value) Validate() error {
 		return nil
 	case ValueKindAipTaskUpdatedEvent:
 		return nil
+	case ValueKindAipDeletionRequestCreatedEvent:
+		return nil
+	case ValueKindAipDeletionRequestUpdatedEvent:
+		return nil
+	case ValueKindAipFixityCheckedEvent:
+		return nil
 	default:
 		return goa.InvalidEnumValueError("type", u.kind, []any{
 			string(ValueKindStoragePingEvent),
@@ -796,6 +1028,9 @@ func (u Value) Validate() error {
 			string(ValueKindAipWorkflowUpdatedEvent),
 			string(ValueKindAipTaskCreatedEvent),
 			string(ValueKindAipTaskUpdatedEvent),
+			string(ValueKindAipDeletionRequestCreatedEvent),
+			string(ValueKindAipDeletionRequestUpdatedEvent),
+			string(ValueKindAipFixityCheckedEvent),
 		})
 	}
 }
@@ -829,6 +1064,12 @@ func (u Value) MarshalJSON() ([]byte, error) {
 		value = u.AipTaskCreatedEvent
 	case ValueKindAipTaskUpdatedEvent:
 		value = u.AipTaskUpdatedEvent
+	case ValueKindAipDeletionRequestCreatedEvent:
+		value = u.AipDeletionRequestCreatedEvent
+	case ValueKindAipDeletionRequestUpdatedEvent:
+		value = u.AipDeletionRequestUpdatedEvent
+	case ValueKindAipFixityCheckedEvent:
+		value = u.AipFixityCheckedEvent
 	default:
 		return nil, fmt.Errorf("unexpected Value discriminant %q", u.kind)
 	}
@@ -921,6 +1162,27 @@ func (u *Value) UnmarshalJSON(data []byte) error {
 		}
 		u.kind = ValueKindAipTaskUpdatedEvent
 		u.AipTaskUpdatedEvent = v
+	case string(ValueKindAipDeletionRequestCreatedEvent):
+		var v *AIPDeletionRequestCreatedEventView
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipDeletionRequestCreatedEvent
+		u.AipDeletionRequestCreatedEvent = v
+	case string(ValueKindAipDeletionRequestUpdatedEvent):
+		var v *AIPDeletionRequestUpdatedEventView
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipDeletionRequestUpdatedEvent
+		u.AipDeletionRequestUpdatedEvent = v
+	case string(ValueKindAipFixityCheckedEvent):
+		var v *AIPFixityCheckedEventView
+		if err := json.Unmarshal(raw.Value, &v); err != nil {
+			return err
+		}
+		u.kind = ValueKindAipFixityCheckedEvent
+		u.AipFixityCheckedEvent = v
 	default:
 		return fmt.Errorf("unexpected Value type %q", raw.Type)
 	}
@@ -945,6 +1207,9 @@ var (
 			"location_uuid",
 			"created_at",
 			"deletion_report_key",
+			"disposal_at",
+			"replicas",
+			"legal_holds",
 		},
 	}
 	// AIPWorkflowsMap is a map indexing the attribute names of AIPWorkflows by
@@ -964,6 +1229,7 @@ var (
 			"purpose",
 			"uuid",
 			"created_at",
+			"replication_targets",
 		},
 	}
 	// LocationMap is a map indexing the attribute names of Location by view name.
@@ -975,6 +1241,7 @@ var (
 			"purpose",
 			"uuid",
 			"created_at",
+			"replication_targets",
 		},
 	}
 	// AIPCollectionMap is a map indexing the attribute names of AIPCollection by
@@ -988,6 +1255,55 @@ var (
 			"location_uuid",
 			"created_at",
 			"deletion_report_key",
+			"disposal_at",
+			"replicas",
+			"legal_holds",
+		},
+	}
+	// AIPReplicaCollectionMap is a map indexing the attribute names of
+	// AIPReplicaCollection by view name.
+	AIPReplicaCollectionMap = map[string][]string{
+		"default": {
+			"location_uuid",
+			"status",
+			"created_at",
+			"replicated_at",
+		},
+	}
+	// AIPReplicaMap is a map indexing the attribute names of AIPReplica by view
+	// name.
+	AIPReplicaMap = map[string][]string{
+		"default": {
+			"location_uuid",
+			"status",
+			"created_at",
+			"replicated_at",
+		},
+	}
+	// AIPLegalHoldCollectionMap is a map indexing the attribute names of
+	// AIPLegalHoldCollection by view name.
+	AIPLegalHoldCollectionMap = map[string][]string{
+		"default": {
+			"uuid",
+			"reason",
+			"placed_by",
+			"placed_at",
+			"released_by",
+			"release_reason",
+			"released_at",
+		},
+	}
+	// AIPLegalHoldMap is a map indexing the attribute names of AIPLegalHold by
+	// view name.
+	AIPLegalHoldMap = map[string][]string{
+		"default": {
+			"uuid",
+			"reason",
+			"placed_by",
+			"placed_at",
+			"released_by",
+			"release_reason",
+			"released_at",
 		},
 	}
 	// AIPWorkflowMap is a map indexing the attribute names of AIPWorkflow by view
@@ -1208,6 +1524,27 @@ func ValidateStorageEventView(result *StorageEventView) (err error) {
 				err = goa.MergeErrors(err, err2)
 			}
 		}
+	case "aip_deletion_request_created_event":
+		actual, _ := result.Value.AsAipDeletionRequestCreatedEvent()
+		if actual != nil {
+			if err2 := ValidateAIPDeletionRequestCreatedEventView(actual); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	case "aip_deletion_request_updated_event":
+		actual, _ := result.Value.AsAipDeletionRequestUpdatedEvent()
+		if actual != nil {
+			if err2 := ValidateAIPDeletionRequestUpdatedEventView(actual); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	case "aip_fixity_checked_event":
+		actual, _ := result.Value.AsAipFixityCheckedEvent()
+		if actual != nil {
+			if err2 := ValidateAIPFixityCheckedEventView(actual); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
 
 	return
@@ -1261,13 +1598,16 @@ func ValidateLocationView(result *LocationView) (err error) {
 		}
 	}
 	if result.Purpose != nil {
-		if !(*result.Purpose == "unspecified" || *result.Purpose == "aip_store") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.purpose", *result.Purpose, []any{"unspecified", "aip_store"}))
+		if !(*result.Purpose == "unspecified" || *result.Purpose == "aip_store" || *result.Purpose == "restore") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.purpose", *result.Purpose, []any{"unspecified", "aip_store", "restore"}))
 		}
 	}
 	if result.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
 	}
+	for _, e := range result.ReplicationTargets {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.replication_targets[*]", e, goa.FormatUUID))
+	}
 	return
 }
 
@@ -1285,6 +1625,15 @@ func ValidateAMSSConfigView(result *AMSSConfigView) (err error) {
 	return
 }
 
+// ValidateFilesystemConfigView runs the validations defined on
+// FilesystemConfigView.
+func ValidateFilesystemConfigView(result *FilesystemConfigView) (err error) {
+	if result.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "result"))
+	}
+	return
+}
+
 // ValidateS3ConfigView runs the validations defined on S3ConfigView.
 func ValidateS3ConfigView(result *S3ConfigView) (err error) {
 	if result.Bucket == nil {
@@ -1364,6 +1713,91 @@ func ValidateAIPView(result *AIPView) (err error) {
 	if result.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
 	}
+	if result.DisposalAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.disposal_at", *result.DisposalAt, goa.FormatDateTime))
+	}
+	if result.Replicas != nil {
+		if err2 := ValidateAIPReplicaCollectionView(result.Replicas); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.LegalHolds != nil {
+		if err2 := ValidateAIPLegalHoldCollectionView(result.LegalHolds); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateAIPReplicaCollectionView runs the validations defined on
+// AIPReplicaCollectionView using the "default" view.
+func ValidateAIPReplicaCollectionView(result AIPReplicaCollectionView) (err error) {
+	for _, item := range result {
+		if err2 := ValidateAIPReplicaView(item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateAIPReplicaView runs the validations defined on AIPReplicaView using
+// the "default" view.
+func ValidateAIPReplicaView(result *AIPReplicaView) (err error) {
+	if result.LocationUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("location_uuid", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
+	}
+	if result.Status != nil {
+		if !(*result.Status == "pending" || *result.Status == "replicated" || *result.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"pending", "replicated", "failed"}))
+		}
+	}
+	if result.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
+	}
+	if result.ReplicatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.replicated_at", *result.ReplicatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateAIPLegalHoldCollectionView runs the validations defined on
+// AIPLegalHoldCollectionView using the "default" view.
+func ValidateAIPLegalHoldCollectionView(result AIPLegalHoldCollectionView) (err error) {
+	for _, item := range result {
+		if err2 := ValidateAIPLegalHoldView(item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateAIPLegalHoldView runs the validations defined on AIPLegalHoldView
+// using the "default" view.
+func ValidateAIPLegalHoldView(result *AIPLegalHoldView) (err error) {
+	if result.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "result"))
+	}
+	if result.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "result"))
+	}
+	if result.PlacedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_by", "result"))
+	}
+	if result.PlacedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_at", "result"))
+	}
+	if result.PlacedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.placed_at", *result.PlacedAt, goa.FormatDateTime))
+	}
+	if result.ReleasedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.released_at", *result.ReleasedAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -1449,8 +1883,8 @@ func ValidateAIPWorkflowViewSimple(result *AIPWorkflowView) (err error) {
 		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "result"))
 	}
 	if result.Type != nil {
-		if !(*result.Type == "unspecified" || *result.Type == "upload aip" || *result.Type == "move aip" || *result.Type == "delete aip") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"unspecified", "upload aip", "move aip", "delete aip"}))
+		if !(*result.Type == "unspecified" || *result.Type == "upload aip" || *result.Type == "move aip" || *result.Type == "delete aip" || *result.Type == "audit aip" || *result.Type == "replicate aip" || *result.Type == "restore aip") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"unspecified", "upload aip", "move aip", "delete aip", "audit aip", "replicate aip", "restore aip"}))
 		}
 	}
 	if result.Status != nil {
@@ -1486,8 +1920,8 @@ func ValidateAIPWorkflowView(result *AIPWorkflowView) (err error) {
 		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "result"))
 	}
 	if result.Type != nil {
-		if !(*result.Type == "unspecified" || *result.Type == "upload aip" || *result.Type == "move aip" || *result.Type == "delete aip") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"unspecified", "upload aip", "move aip", "delete aip"}))
+		if !(*result.Type == "unspecified" || *result.Type == "upload aip" || *result.Type == "move aip" || *result.Type == "delete aip" || *result.Type == "audit aip" || *result.Type == "replicate aip" || *result.Type == "restore aip") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"unspecified", "upload aip", "move aip", "delete aip", "audit aip", "replicate aip", "restore aip"}))
 		}
 	}
 	if result.Status != nil {
@@ -1600,6 +2034,93 @@ func ValidateAIPTaskUpdatedEventView(result *AIPTaskUpdatedEventView) (err error
 	return
 }
 
+// ValidateAIPDeletionRequestCreatedEventView runs the validations defined on
+// AIPDeletionRequestCreatedEventView.
+func ValidateAIPDeletionRequestCreatedEventView(result *AIPDeletionRequestCreatedEventView) (err error) {
+	if result.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "result"))
+	}
+	if result.AipUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "result"))
+	}
+	if result.Requester == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requester", "result"))
+	}
+	if result.Status != nil {
+		if !(*result.Status == "pending" || *result.Status == "approved" || *result.Status == "rejected" || *result.Status == "canceled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"pending", "approved", "rejected", "canceled"}))
+		}
+	}
+	return
+}
+
+// ValidateAIPDeletionRequestUpdatedEventView runs the validations defined on
+// AIPDeletionRequestUpdatedEventView.
+func ValidateAIPDeletionRequestUpdatedEventView(result *AIPDeletionRequestUpdatedEventView) (err error) {
+	if result.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "result"))
+	}
+	if result.AipUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "result"))
+	}
+	if result.Requester == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requester", "result"))
+	}
+	if result.Status != nil {
+		if !(*result.Status == "pending" || *result.Status == "approved" || *result.Status == "rejected" || *result.Status == "canceled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"pending", "approved", "rejected", "canceled"}))
+		}
+	}
+	return
+}
+
+// ValidateAIPFixityCheckedEventView runs the validations defined on
+// AIPFixityCheckedEventView.
+func ValidateAIPFixityCheckedEventView(result *AIPFixityCheckedEventView) (err error) {
+	if result.UUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uuid", "result"))
+	}
+	if result.AipUUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("aip_uuid", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.ChecksumAlgorithm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checksum_algorithm", "result"))
+	}
+	if result.ExpectedChecksum == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expected_checksum", "result"))
+	}
+	if result.ActualChecksum == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actual_checksum", "result"))
+	}
+	if result.CheckedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checked_at", "result"))
+	}
+	if result.Status != nil {
+		if !(*result.Status == "passed" || *result.Status == "failed" || *result.Status == "baseline") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"passed", "failed", "baseline"}))
+		}
+	}
+	if result.CheckedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.checked_at", *result.CheckedAt, goa.FormatDateTime))
+	}
+	return
+}
+
 // ValidateAIPsView runs the validations defined on AIPsView using the
 // "default" view.
 func ValidateAIPsView(result *AIPsView) (err error) {
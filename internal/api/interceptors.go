@@ -6,6 +6,10 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/trace"
 	goa "goa.design/goa/v3/pkg"
 
@@ -49,11 +53,26 @@ type operationInterceptors struct {
 	operationTimeout time.Duration
 	slowThreshold    time.Duration
 	rules            map[operationKey]operationRule
+	duration         metric.Float64Histogram
 }
 
-func newOperationInterceptors(logger logr.Logger) *operationInterceptors {
+func newOperationInterceptors(logger logr.Logger, mp metric.MeterProvider) *operationInterceptors {
+	if mp == nil {
+		mp = noop.NewMeterProvider()
+	}
+	duration, err := mp.Meter("github.com/artefactual-sdps/enduro/internal/api").Float64Histogram(
+		"enduro.api.operation.duration",
+		metric.WithDescription("Duration of the API operations."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(telemetry.OperationDurationBuckets...),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
 	return &operationInterceptors{
 		logger:           logger,
+		duration:         duration,
 		operationTimeout: defaultAPIOperationTimeout,
 		slowThreshold:    apiOperationSlowThreshold,
 		rules: map[operationKey]operationRule{
@@ -64,6 +83,9 @@ func newOperationInterceptors(logger logr.Logger) *operationInterceptors {
 			{service: "ingest", method: "UploadSip"}: {
 				skipTimeout: true,
 			},
+			{service: "ingest", method: "UploadSipChunk"}: {
+				skipTimeout: true,
+			},
 			{service: "ingest", method: "DownloadSip"}: {
 				skipTimeout: true,
 			},
@@ -85,8 +107,8 @@ type aboutServerInterceptors struct {
 	*operationInterceptors
 }
 
-func newAboutServerInterceptors(logger logr.Logger) *aboutServerInterceptors {
-	return &aboutServerInterceptors{operationInterceptors: newOperationInterceptors(logger)}
+func newAboutServerInterceptors(logger logr.Logger, mp metric.MeterProvider) *aboutServerInterceptors {
+	return &aboutServerInterceptors{operationInterceptors: newOperationInterceptors(logger, mp)}
 }
 
 func (i *aboutServerInterceptors) OperationTimeout(
@@ -101,8 +123,8 @@ type ingestServerInterceptors struct {
 	*operationInterceptors
 }
 
-func newIngestServerInterceptors(logger logr.Logger) *ingestServerInterceptors {
-	return &ingestServerInterceptors{operationInterceptors: newOperationInterceptors(logger)}
+func newIngestServerInterceptors(logger logr.Logger, mp metric.MeterProvider) *ingestServerInterceptors {
+	return &ingestServerInterceptors{operationInterceptors: newOperationInterceptors(logger, mp)}
 }
 
 func (i *ingestServerInterceptors) OperationTimeout(
@@ -117,8 +139,8 @@ type storageServerInterceptors struct {
 	*operationInterceptors
 }
 
-func newStorageServerInterceptors(logger logr.Logger) *storageServerInterceptors {
-	return &storageServerInterceptors{operationInterceptors: newOperationInterceptors(logger)}
+func newStorageServerInterceptors(logger logr.Logger, mp metric.MeterProvider) *storageServerInterceptors {
+	return &storageServerInterceptors{operationInterceptors: newOperationInterceptors(logger, mp)}
 }
 
 func (i *storageServerInterceptors) OperationTimeout(
@@ -156,6 +178,7 @@ func (i *operationInterceptors) handle(
 	res, err := next(ctx, info.RawPayload())
 	elapsed := time.Since(start)
 	telemetry.SetOperationDuration(span, elapsed)
+	i.recordDuration(parentCtx, info, elapsed, err != nil || errors.Is(ctx.Err(), context.DeadlineExceeded))
 
 	if hasTimeout &&
 		errors.Is(ctx.Err(), context.DeadlineExceeded) &&
@@ -205,6 +228,20 @@ func (i *operationInterceptors) handle(
 	return res, err
 }
 
+// recordDuration records the duration of an API operation.
+func (i *operationInterceptors) recordDuration(
+	ctx context.Context,
+	info operationInfo,
+	elapsed time.Duration,
+	failed bool,
+) {
+	i.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(
+		attribute.String("goa.service", info.Service()),
+		attribute.String("goa.method", info.Method()),
+		attribute.Bool("error", failed),
+	))
+}
+
 func (i *operationInterceptors) rule(info operationInfo) operationRule {
 	rule := operationRule{
 		timeout:       i.operationTimeout,
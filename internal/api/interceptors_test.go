@@ -10,6 +10,9 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric/noop"
+	otelsdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	goa "goa.design/goa/v3/pkg"
@@ -87,7 +90,7 @@ func TestOperationTimeoutStorageTransferRules(t *testing.T) {
 
 			endpoint := tt.wrap(
 				deadlineEndpoint(t, tt.wantDeadline),
-				newStorageServerInterceptors(logr.Discard()),
+				newStorageServerInterceptors(logr.Discard(), noop.NewMeterProvider()),
 			)
 
 			res, err := endpoint(context.Background(), "payload")
@@ -102,7 +105,7 @@ func TestOperationTimeoutUsesDeadlineForAboutMethods(t *testing.T) {
 
 	endpoint := goaabout.WrapAboutEndpoint(
 		deadlineEndpoint(t, true),
-		newAboutServerInterceptors(logr.Discard()),
+		newAboutServerInterceptors(logr.Discard(), noop.NewMeterProvider()),
 	)
 
 	res, err := endpoint(context.Background(), "payload")
@@ -113,10 +116,11 @@ func TestOperationTimeoutUsesDeadlineForAboutMethods(t *testing.T) {
 func TestOperationTimeoutSkipsIngestUploadAndDownload(t *testing.T) {
 	t.Parallel()
 
-	interceptors := newIngestServerInterceptors(logr.Discard())
+	interceptors := newIngestServerInterceptors(logr.Discard(), noop.NewMeterProvider())
 
 	for _, endpoint := range []goa.Endpoint{
 		goaingest.WrapUploadSipEndpoint(deadlineEndpoint(t, false), interceptors),
+		goaingest.WrapUploadSipChunkEndpoint(deadlineEndpoint(t, false), interceptors),
 		goaingest.WrapDownloadSipEndpoint(deadlineEndpoint(t, false), interceptors),
 	} {
 		res, err := endpoint(context.Background(), "payload")
@@ -133,7 +137,7 @@ func TestOperationTimeoutAnnotatesSpan(t *testing.T) {
 		func(ctx context.Context, _ any) (any, error) {
 			return "ok", nil
 		},
-		newStorageServerInterceptors(logr.Discard()),
+		newStorageServerInterceptors(logr.Discard(), noop.NewMeterProvider()),
 	)
 
 	res, err := endpoint(ctx, "payload")
@@ -198,6 +202,38 @@ func newTestSpan(t *testing.T) (*tracetest.SpanRecorder, context.Context, func()
 	return recorder, ctx, func() { span.End() }
 }
 
+func TestOperationDurationMetric(t *testing.T) {
+	t.Parallel()
+
+	reader := otelsdkmetric.NewManualReader()
+	mp := otelsdkmetric.NewMeterProvider(otelsdkmetric.WithReader(reader))
+	interceptors := newStorageServerInterceptors(logr.Discard(), mp)
+
+	endpoint := goastorage.WrapShowAipEndpoint(
+		func(ctx context.Context, _ any) (any, error) {
+			return nil, errors.New("not found")
+		},
+		interceptors,
+	)
+	_, err := endpoint(context.Background(), "payload")
+	assert.Error(t, err, "not found")
+
+	var rm metricdata.ResourceMetrics
+	assert.NilError(t, reader.Collect(t.Context(), &rm))
+	assert.Equal(t, len(rm.ScopeMetrics), 1)
+
+	m := rm.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, m.Name, "enduro.api.operation.duration")
+	dps := m.Data.(metricdata.Histogram[float64]).DataPoints
+	assert.Equal(t, len(dps), 1)
+	assert.Equal(t, dps[0].Count, uint64(1))
+	assert.Equal(t, dps[0].Attributes, attribute.NewSet(
+		attribute.String("goa.service", "storage"),
+		attribute.String("goa.method", "ShowAip"),
+		attribute.Bool("error", true),
+	))
+}
+
 func deadlineEndpoint(t *testing.T, wantDeadline bool) goa.Endpoint {
 	t.Helper()
 
@@ -213,7 +249,7 @@ func deadlineEndpoint(t *testing.T, wantDeadline bool) goa.Endpoint {
 }
 
 func newTestStorageServerInterceptors(timeout time.Duration) *storageServerInterceptors {
-	i := newOperationInterceptors(logr.Discard())
+	i := newOperationInterceptors(logr.Discard(), noop.NewMeterProvider())
 	i.operationTimeout = timeout
 
 	return &storageServerInterceptors{operationInterceptors: i}
@@ -0,0 +1,289 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
+)
+
+// APITokenPrefix is prepended to the secret of the Enduro API tokens to tell
+// them apart from the tokens issued by the OIDC providers.
+const APITokenPrefix = "enduro_"
+
+// apiTokenLastUsedInterval is the minimum time between updates of the last
+// used datetime of an API token, to avoid a database write on every request.
+const apiTokenLastUsedInterval = time.Minute
+
+// APIToken holds the API token data needed to verify an API token.
+type APIToken struct {
+	UUID       uuid.UUID
+	Attributes []string
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+
+	// Owner holds the identity claims of the user that created the API token.
+	Owner Claims
+}
+
+// APITokenStore provides access to the persisted API tokens.
+type APITokenStore interface {
+	// ReadAPIToken returns the API token with the given secret hash. It must
+	// return ErrUnauthorized if the API token doesn't exist.
+	ReadAPIToken(ctx context.Context, hash string) (*APIToken, error)
+	// SetAPITokenLastUsed sets the last used datetime of an API token.
+	SetAPITokenLastUsed(ctx context.Context, id uuid.UUID, t time.Time) error
+	// ListOwnerAPITokens returns the API tokens of the user with the given
+	// OIDC issuer and subject, or none if the user doesn't exist.
+	ListOwnerAPITokens(ctx context.Context, iss, sub string) ([]*APIToken, error)
+	// RevokeAPIToken sets the revoked datetime of an API token.
+	RevokeAPIToken(ctx context.Context, id uuid.UUID, t time.Time) error
+}
+
+// NewAPITokenSecret generates a new API token secret using random data from
+// rander. It returns the secret, which must only be shown once to the user,
+// and its hash, which is the only value that should be persisted.
+func NewAPITokenSecret(rander io.Reader) (secret, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := io.ReadFull(rander, b); err != nil {
+		return "", "", fmt.Errorf("generate API token secret: %v", err)
+	}
+
+	secret = APITokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return secret, HashAPITokenSecret(secret), nil
+}
+
+// HashAPITokenSecret returns the hex encoded SHA-256 hash of an API token
+// secret.
+func HashAPITokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+type APITokenVerifier struct {
+	store       APITokenStore
+	auditLogger *auditlog.Logger
+	clock       clockwork.Clock
+}
+
+var _ TokenVerifier = (*APITokenVerifier)(nil)
+
+func NewAPITokenVerifier(store APITokenStore, auditLogger *auditlog.Logger, clock clockwork.Clock) *APITokenVerifier {
+	if auditLogger == nil {
+		auditLogger = auditlog.Discard()
+	}
+	if clock == nil {
+		clock = clockwork.NewRealClock()
+	}
+
+	return &APITokenVerifier{store: store, auditLogger: auditLogger, clock: clock}
+}
+
+// Verify verifies an Enduro API token and returns the claims of its owner,
+// limited to the attributes granted to the API token. It returns
+// ErrUnauthorized for tokens without the APITokenPrefix, so other verifiers
+// can be tried, and for unknown, revoked and expired API tokens. Each
+// successful authentication is written to the audit log.
+func (t *APITokenVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	if !strings.HasPrefix(token, APITokenPrefix) {
+		return nil, ErrUnauthorized
+	}
+
+	apiToken, err := t.store.ReadAPIToken(ctx, HashAPITokenSecret(token))
+	if err != nil {
+		return nil, err
+	}
+
+	now := t.clock.Now()
+	if !apiToken.RevokedAt.IsZero() {
+		return nil, ErrUnauthorized
+	}
+	if !apiToken.ExpiresAt.IsZero() && !now.Before(apiToken.ExpiresAt) {
+		return nil, ErrUnauthorized
+	}
+
+	if now.Sub(apiToken.LastUsedAt) >= apiTokenLastUsedInterval {
+		if err := t.store.SetAPITokenLastUsed(ctx, apiToken.UUID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	t.auditLogger.Log(ctx, &auditlog.Event{
+		Level:      auditlog.LevelInfo,
+		Msg:        "API token used",
+		Type:       "APIToken.authenticate",
+		ResourceID: apiToken.UUID.String(),
+		User:       apiToken.Owner.Email,
+	})
+
+	claims := apiToken.Owner
+	claims.Attributes = apiToken.Attributes
+	claims.APIToken = true
+
+	return &claims, nil
+}
+
+// APITokenOwnerVerifier wraps the verifier of the OIDC tokens to revoke the
+// API tokens of the authenticated user that were granted attributes the user
+// no longer has, so an API token never outlives the access of its owner.
+type APITokenOwnerVerifier struct {
+	verifier    TokenVerifier
+	store       APITokenStore
+	auditLogger *auditlog.Logger
+	clock       clockwork.Clock
+
+	mu sync.Mutex
+	// checked holds, for each user, the attributes their API tokens were
+	// last checked against, to only check them when the attributes change.
+	checked map[string]string
+}
+
+var _ TokenVerifier = (*APITokenOwnerVerifier)(nil)
+
+func NewAPITokenOwnerVerifier(
+	verifier TokenVerifier,
+	store APITokenStore,
+	auditLogger *auditlog.Logger,
+	clock clockwork.Clock,
+) *APITokenOwnerVerifier {
+	if auditLogger == nil {
+		auditLogger = auditlog.Discard()
+	}
+	if clock == nil {
+		clock = clockwork.NewRealClock()
+	}
+
+	return &APITokenOwnerVerifier{
+		verifier:    verifier,
+		store:       store,
+		auditLogger: auditLogger,
+		clock:       clock,
+		checked:     map[string]string{},
+	}
+}
+
+// Verify verifies the token with the wrapped verifier and, the first time a
+// user authenticates with a set of attributes, revokes the API tokens of the
+// user granted attributes not included in the set.
+func (v *APITokenOwnerVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	claims, err := v.verifier.Verify(ctx, token)
+	if err != nil || claims == nil || claims.APIToken || (claims.Iss == "" && claims.Sub == "") {
+		return claims, err
+	}
+
+	user := claims.Iss + "\n" + claims.Sub
+	attrs := attributesKey(claims.Attributes)
+
+	v.mu.Lock()
+	checked, ok := v.checked[user]
+	v.mu.Unlock()
+	if ok && checked == attrs {
+		return claims, nil
+	}
+
+	if err := v.revokeAPITokens(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	v.checked[user] = attrs
+	v.mu.Unlock()
+
+	return claims, nil
+}
+
+func (v *APITokenOwnerVerifier) revokeAPITokens(ctx context.Context, claims *Claims) error {
+	tokens, err := v.store.ListOwnerAPITokens(ctx, claims.Iss, claims.Sub)
+	if err != nil {
+		return err
+	}
+
+	now := v.clock.Now()
+	for _, t := range tokens {
+		if !t.RevokedAt.IsZero() || (!t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)) {
+			continue
+		}
+		if grantsAPIToken(claims, t) {
+			continue
+		}
+
+		if err := v.store.RevokeAPIToken(ctx, t.UUID, now); err != nil {
+			return err
+		}
+
+		v.auditLogger.Log(ctx, &auditlog.Event{
+			Level:      auditlog.LevelInfo,
+			Msg:        "API token revoked, owner access changed",
+			Type:       "APIToken.revoke",
+			ResourceID: t.UUID.String(),
+			User:       claims.Email,
+		})
+	}
+
+	return nil
+}
+
+// grantsAPIToken reports whether the claims grant all the attributes of the
+// API token. API tokens without attributes bypass access control, so they are
+// only granted when access control is disabled for the user.
+func grantsAPIToken(claims *Claims, t *APIToken) bool {
+	if t.Attributes == nil {
+		return claims.Attributes == nil || slices.Contains(claims.Attributes, "*")
+	}
+
+	return claims.CheckAttributes(t.Attributes)
+}
+
+// attributesKey returns a key identifying a set of attributes, where nil
+// attributes (access control disabled) are equivalent to the "*" wildcard.
+func attributesKey(attrs []string) string {
+	if attrs == nil {
+		return "*"
+	}
+
+	attrs = slices.Clone(attrs)
+	slices.Sort(attrs)
+
+	return strings.Join(attrs, "\n")
+}
+
+// TokenVerifiers is a chain of token verifiers. A token is verified by the
+// first verifier that accepts it.
+type TokenVerifiers []TokenVerifier
+
+var _ TokenVerifier = (TokenVerifiers)(nil)
+
+func (t TokenVerifiers) Verify(ctx context.Context, token string) (*Claims, error) {
+	var errs []error
+	for _, verifier := range t {
+		claims, err := verifier.Verify(ctx, token)
+		if err == nil {
+			return claims, nil
+		}
+
+		if errors.Is(err, ErrUnauthorized) {
+			continue
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return nil, ErrUnauthorized
+}
@@ -0,0 +1,393 @@
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+)
+
+type apiTokenStore struct {
+	tokens   map[string]*auth.APIToken
+	lastUsed map[uuid.UUID]time.Time
+	owned    []*auth.APIToken
+	listed   int
+	revoked  map[uuid.UUID]time.Time
+	err      error
+}
+
+func (s *apiTokenStore) ReadAPIToken(ctx context.Context, hash string) (*auth.APIToken, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	t, ok := s.tokens[hash]
+	if !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	return t, nil
+}
+
+func (s *apiTokenStore) SetAPITokenLastUsed(ctx context.Context, id uuid.UUID, t time.Time) error {
+	s.lastUsed[id] = t
+	return nil
+}
+
+func (s *apiTokenStore) ListOwnerAPITokens(ctx context.Context, iss, sub string) ([]*auth.APIToken, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.listed++
+	return s.owned, nil
+}
+
+func (s *apiTokenStore) RevokeAPIToken(ctx context.Context, id uuid.UUID, t time.Time) error {
+	s.revoked[id] = t
+	return nil
+}
+
+type bufCloser struct {
+	*bytes.Buffer
+}
+
+func (b *bufCloser) Close() error {
+	return nil
+}
+
+func newAuditLogger() (*auditlog.Logger, *bufCloser) {
+	buf := &bufCloser{new(bytes.Buffer)}
+	return auditlog.New(buf, slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{}))), buf
+}
+
+type staticVerifier struct {
+	claims *auth.Claims
+	err    error
+}
+
+func (v staticVerifier) Verify(ctx context.Context, token string) (*auth.Claims, error) {
+	return v.claims, v.err
+}
+
+func TestNewAPITokenSecret(t *testing.T) {
+	t.Parallel()
+
+	secret, hash, err := auth.NewAPITokenSecret(strings.NewReader(strings.Repeat("a", 32)))
+	assert.NilError(t, err)
+	assert.Equal(t, secret, "enduro_YWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWE")
+	assert.Equal(t, hash, auth.HashAPITokenSecret(secret))
+	assert.Equal(t, len(hash), 64)
+
+	_, _, err = auth.NewAPITokenSecret(strings.NewReader("short"))
+	assert.ErrorContains(t, err, "generate API token secret")
+}
+
+func TestAPITokenVerifier(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	owner := auth.Claims{
+		Email: "nobody@example.com",
+		Iss:   "https://example.com/oidc",
+		Sub:   "1234567890",
+	}
+
+	type test struct {
+		name       string
+		token      string
+		apiToken   *auth.APIToken
+		storeErr   error
+		wantClaims *auth.Claims
+		wantErr    string
+		wantUsed   bool
+	}
+	for _, tt := range []test{
+		{
+			name:  "Verifies an API token",
+			token: "enduro_secret",
+			apiToken: &auth.APIToken{
+				UUID:       uuid.New(),
+				Attributes: []string{"ingest:sips:create"},
+				ExpiresAt:  now.Add(time.Hour),
+				Owner:      owner,
+			},
+			wantClaims: &auth.Claims{
+				Email:      "nobody@example.com",
+				Iss:        "https://example.com/oidc",
+				Sub:        "1234567890",
+				Attributes: []string{"ingest:sips:create"},
+				APIToken:   true,
+			},
+			wantUsed: true,
+		},
+		{
+			name:  "Doesn't update a recent last used datetime",
+			token: "enduro_secret",
+			apiToken: &auth.APIToken{
+				UUID:       uuid.New(),
+				LastUsedAt: now.Add(-time.Second),
+				Owner:      owner,
+			},
+			wantClaims: &auth.Claims{
+				Email:    "nobody@example.com",
+				Iss:      "https://example.com/oidc",
+				Sub:      "1234567890",
+				APIToken: true,
+			},
+		},
+		{
+			name:    "Rejects a token without the API token prefix",
+			token:   "eyJhbGciOiJSUzI1NiJ9",
+			wantErr: "unauthorized",
+		},
+		{
+			name:    "Rejects an unknown API token",
+			token:   "enduro_unknown",
+			wantErr: "unauthorized",
+		},
+		{
+			name:  "Rejects a revoked API token",
+			token: "enduro_secret",
+			apiToken: &auth.APIToken{
+				UUID:      uuid.New(),
+				RevokedAt: now.Add(-time.Hour),
+				Owner:     owner,
+			},
+			wantErr: "unauthorized",
+		},
+		{
+			name:  "Rejects an expired API token",
+			token: "enduro_secret",
+			apiToken: &auth.APIToken{
+				UUID:      uuid.New(),
+				ExpiresAt: now,
+				Owner:     owner,
+			},
+			wantErr: "unauthorized",
+		},
+		{
+			name:     "Returns store errors",
+			token:    "enduro_secret",
+			storeErr: errors.New("database is down"),
+			wantErr:  "database is down",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &apiTokenStore{
+				tokens:   map[string]*auth.APIToken{},
+				lastUsed: map[uuid.UUID]time.Time{},
+				err:      tt.storeErr,
+			}
+			if tt.apiToken != nil {
+				store.tokens[auth.HashAPITokenSecret("enduro_secret")] = tt.apiToken
+			}
+
+			auditLogger, buf := newAuditLogger()
+			v := auth.NewAPITokenVerifier(store, auditLogger, clockwork.NewFakeClockAt(now))
+			claims, err := v.Verify(t.Context(), tt.token)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				assert.Equal(t, buf.Len(), 0)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, claims, tt.wantClaims)
+
+			want := fmt.Sprintf(
+				`"level":"INFO","msg":"API token used","type":"APIToken.authenticate","resourceID":"%s","user":"nobody@example.com"`,
+				tt.apiToken.UUID,
+			)
+			assert.Assert(t, strings.Contains(buf.String(), want), "expected: %s, got: %s", want, buf.String())
+
+			if tt.wantUsed {
+				assert.Equal(t, store.lastUsed[tt.apiToken.UUID], now)
+			} else {
+				assert.Equal(t, len(store.lastUsed), 0)
+			}
+		})
+	}
+}
+
+func TestAPITokenOwnerVerifier(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	granted := &auth.APIToken{
+		UUID:       uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72"),
+		Attributes: []string{"ingest:sips:read"},
+		ExpiresAt:  now.Add(time.Hour),
+	}
+	notGranted := &auth.APIToken{
+		UUID:       uuid.MustParse("9566c74d-1003-4c4d-bbbb-0407d1e2c649"),
+		Attributes: []string{"ingest:sips:create"},
+		ExpiresAt:  now.Add(time.Hour),
+	}
+	unrestricted := &auth.APIToken{
+		UUID:      uuid.MustParse("81855ad8-681d-4d86-91e9-1e00167939cb"),
+		ExpiresAt: now.Add(time.Hour),
+	}
+	revoked := &auth.APIToken{
+		UUID:       uuid.MustParse("6694d2c4-22ac-4208-a007-2939487f6999"),
+		Attributes: []string{"ingest:sips:create"},
+		ExpiresAt:  now.Add(time.Hour),
+		RevokedAt:  now.Add(-time.Hour),
+	}
+	expired := &auth.APIToken{
+		UUID:       uuid.MustParse("eb9d18a4-4784-445d-87f3-c67cf22746e9"),
+		Attributes: []string{"ingest:sips:create"},
+		ExpiresAt:  now,
+	}
+	claims := func(attrs []string) *auth.Claims {
+		return &auth.Claims{
+			Email:      "nobody@example.com",
+			Iss:        "https://example.com/oidc",
+			Sub:        "1234567890",
+			Attributes: attrs,
+		}
+	}
+
+	type test struct {
+		name        string
+		claims      *auth.Claims
+		verifierErr error
+		storeErr    error
+		wantRevoked []uuid.UUID
+		wantErr     string
+	}
+	for _, tt := range []test{
+		{
+			name:        "Revokes the API tokens not granted by the owner attributes",
+			claims:      claims([]string{"ingest:sips:read"}),
+			wantRevoked: []uuid.UUID{notGranted.UUID, unrestricted.UUID},
+		},
+		{
+			name:        "Considers wildcards in the owner attributes",
+			claims:      claims([]string{"ingest:sips:*"}),
+			wantRevoked: []uuid.UUID{unrestricted.UUID},
+		},
+		{
+			name:   "Keeps all the API tokens when access control is disabled for the owner",
+			claims: claims(nil),
+		},
+		{
+			name:        "Revokes all the API tokens when the owner has no attributes",
+			claims:      claims([]string{}),
+			wantRevoked: []uuid.UUID{granted.UUID, notGranted.UUID, unrestricted.UUID},
+		},
+		{
+			name:        "Returns the wrapped verifier errors",
+			verifierErr: auth.ErrUnauthorized,
+			wantErr:     "unauthorized",
+		},
+		{
+			name:     "Returns store errors",
+			claims:   claims([]string{"ingest:sips:read"}),
+			storeErr: errors.New("database is down"),
+			wantErr:  "database is down",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &apiTokenStore{
+				owned:   []*auth.APIToken{granted, notGranted, unrestricted, revoked, expired},
+				revoked: map[uuid.UUID]time.Time{},
+				err:     tt.storeErr,
+			}
+			auditLogger, buf := newAuditLogger()
+			v := auth.NewAPITokenOwnerVerifier(
+				staticVerifier{claims: tt.claims, err: tt.verifierErr},
+				store,
+				auditLogger,
+				clockwork.NewFakeClockAt(now),
+			)
+
+			got, err := v.Verify(t.Context(), "token")
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				assert.Equal(t, len(store.revoked), 0)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.claims)
+
+			assert.Equal(t, len(store.revoked), len(tt.wantRevoked))
+			for _, id := range tt.wantRevoked {
+				assert.Equal(t, store.revoked[id], now)
+
+				want := fmt.Sprintf(
+					`"msg":"API token revoked, owner access changed","type":"APIToken.revoke","resourceID":"%s"`,
+					id,
+				)
+				assert.Assert(t, strings.Contains(buf.String(), want), "expected: %s, got: %s", want, buf.String())
+			}
+
+			// The API tokens are only checked again when the attributes change.
+			_, err = v.Verify(t.Context(), "token")
+			assert.NilError(t, err)
+			assert.Equal(t, store.listed, 1)
+		})
+	}
+}
+
+func TestTokenVerifiers(t *testing.T) {
+	t.Parallel()
+
+	claims := &auth.Claims{Email: "nobody@example.com"}
+
+	type test struct {
+		name       string
+		verifiers  auth.TokenVerifiers
+		wantClaims *auth.Claims
+		wantErr    string
+	}
+	for _, tt := range []test{
+		{
+			name: "Returns the claims of the first verifier accepting the token",
+			verifiers: auth.TokenVerifiers{
+				staticVerifier{err: auth.ErrUnauthorized},
+				staticVerifier{claims: claims},
+			},
+			wantClaims: claims,
+		},
+		{
+			name: "Returns unauthorized if no verifier accepts the token",
+			verifiers: auth.TokenVerifiers{
+				staticVerifier{err: auth.ErrUnauthorized},
+				staticVerifier{err: auth.ErrUnauthorized},
+			},
+			wantErr: "unauthorized",
+		},
+		{
+			name: "Returns the verifier errors",
+			verifiers: auth.TokenVerifiers{
+				staticVerifier{err: errors.New("database is down")},
+				staticVerifier{err: auth.ErrUnauthorized},
+			},
+			wantErr: "database is down",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.verifiers.Verify(t.Context(), "token")
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.wantClaims)
+		})
+	}
+}
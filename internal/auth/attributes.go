@@ -1,15 +1,21 @@
 package auth
 
 const (
+	IngestAPITokensCreateAttr       = "ingest:apitokens:create"
+	IngestAPITokensListAttr         = "ingest:apitokens:list"
+	IngestAPITokensRevokeAttr       = "ingest:apitokens:revoke"
 	IngestBatchesCreateAttr         = "ingest:batches:create"
 	IngestBatchesListAttr           = "ingest:batches:list"
 	IngestBatchesReadAttr           = "ingest:batches:read"
+	IngestBatchesRetryAttr          = "ingest:batches:retry"
 	IngestBatchesReviewAttr         = "ingest:batches:review"
+	IngestSIPSCancelAttr            = "ingest:sips:cancel"
 	IngestSIPSCreateAttr            = "ingest:sips:create"
 	IngestSIPSDecisionAttr          = "ingest:sips:decision"
 	IngestSIPSDownloadAttr          = "ingest:sips:download"
 	IngestSIPSListAttr              = "ingest:sips:list"
 	IngestSIPSReadAttr              = "ingest:sips:read"
+	IngestSIPSRetryAttr             = "ingest:sips:retry"
 	IngestSIPSReviewAttr            = "ingest:sips:review"
 	IngestSIPSUploadAttr            = "ingest:sips:upload"
 	IngestSIPSWorkflowsListAttr     = "ingest:sips:workflows:list"
@@ -21,9 +27,11 @@ const (
 	StorageAIPSDeletionRequestAttr  = "storage:aips:deletion:request"
 	StorageAIPSDeletionReviewAttr   = "storage:aips:deletion:review"
 	StorageAIPSDownloadAttr         = "storage:aips:download"
+	StorageAIPSLegalHoldAttr        = "storage:aips:legalhold"
 	StorageAIPSListAttr             = "storage:aips:list"
 	StorageAIPSMoveAttr             = "storage:aips:move"
 	StorageAIPSReadAttr             = "storage:aips:read"
+	StorageAIPSRestoreAttr          = "storage:aips:restore"
 	StorageAIPSReviewAttr           = "storage:aips:review"
 	StorageAIPSWorkflowsListAttr    = "storage:aips:workflows:list"
 	StorageLocationsAIPSListAttr    = "storage:locations:aips:list"
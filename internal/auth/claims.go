@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"slices"
 	"strings"
+
+	"github.com/google/uuid"
 )
 
 type Claims struct {
@@ -19,6 +21,9 @@ type Claims struct {
 	// UnmarshalBinary methods below. We use the `enduro_internal_attributes`
 	// JSON key to reduce the possibility of a conflict when the JWT is parsed.
 	Attributes []string `json:"enduro_internal_attributes,omitempty"`
+	// APIToken is set when the claims were obtained from an Enduro API token
+	// instead of an OIDC token.
+	APIToken bool `json:"enduro_internal_api_token,omitempty"`
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler for Redis compatibility.
@@ -37,29 +42,76 @@ func (c *Claims) UnmarshalBinary(data []byte) error {
 // by exact match or by having an ancestor with wildcard. For example, a claim
 // with "*" or "ingest:*" as one of it's attributes will verify all ingest
 // actions, like "ingest:sips:list", "ingest:sips:read", etc.
+//
+// Required attributes scoped to a resource (see ScopedAttribute) are verified
+// by claim attributes without scope, or scoped to the same resource or to all
+// the resources of the same kind. For example, "storage:aips:read" or
+// "storage:aips:read:location/*" will verify
+// "storage:aips:read:location/<uuid>".
 func (c *Claims) CheckAttributes(required []string) bool {
 	// Authentication disabled, access control disabled or all wildcard in claims.
 	if c == nil || c.Attributes == nil || slices.Contains(c.Attributes, "*") {
 		return true
 	}
 
-	// Check for all required attributes considering wildcards.
-	for _, attr := range required {
-		for !slices.Contains(c.Attributes, attr) {
+	// Check for all required attributes considering wildcards and scopes.
+	for _, req := range required {
+		reqAttr, reqScope := splitScope(req)
+		if !slices.ContainsFunc(c.Attributes, func(attr string) bool {
+			attr, scope := splitScope(attr)
+			return matchAttribute(attr, reqAttr) && matchScope(scope, reqScope)
+		}) {
+			return false
+		}
+	}
 
-			attr, _ = strings.CutSuffix(attr, ":*")
-			lastColonIndex := strings.LastIndex(attr, ":")
-			if lastColonIndex == -1 {
-				return false
-			}
+	return true
+}
 
-			attr = attr[:lastColonIndex] + ":*"
+// CheckAttributesInAnyScope verifies all required attributes are present in
+// the claim attributes, either without scope or scoped to at least one
+// resource of a kind supported by the attribute (see ScopedAttributes). It's
+// used to grant access to the endpoints that apply the resource scopes of the
+// user to their results.
+func (c *Claims) CheckAttributesInAnyScope(required []string) bool {
+	for _, req := range required {
+		if c.CheckAttributes([]string{req}) {
+			continue
+		}
+		if !slices.ContainsFunc(c.Attributes, func(attr string) bool {
+			attr, scope := splitScope(attr)
+			return scope != "" && matchAttribute(attr, req) && scopable(req, scopeKind(scope))
+		}) {
+			return false
 		}
 	}
 
 	return true
 }
 
+// AttributeScope returns the UUIDs of the resources of the given kind for
+// which attr is granted. The all result is true when attr is granted for all
+// the resources, in which case no UUIDs are returned.
+func (c *Claims) AttributeScope(attr, kind string) (ids []uuid.UUID, all bool) {
+	if c.CheckAttributes([]string{attr}) || c.CheckAttributes([]string{attr + ":" + kind + "/*"}) {
+		return nil, true
+	}
+
+	for _, a := range c.Attributes {
+		a, scope := splitScope(a)
+		if scope == "" || scopeKind(scope) != kind || !matchAttribute(a, attr) {
+			continue
+		}
+		id, err := uuid.Parse(strings.TrimPrefix(scope, kind+"/"))
+		if err != nil || slices.Contains(ids, id) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, false
+}
+
 // DisplayName returns the email claim if available, falling back to the
 // preferred username and then to the name. It returns an empty string if none
 // are set.
@@ -4,12 +4,19 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/assert/cmp"
 
 	"github.com/artefactual-sdps/enduro/internal/auth"
 )
 
+var (
+	locationA = uuid.MustParse("0a8c8f6e-1e6a-4f0f-9a5b-3a4c0e7b1d21")
+	locationB = uuid.MustParse("5e2b1f3c-7d4a-4b8e-8c6f-2d9a0b1c3e45")
+	locationC = uuid.MustParse("9f1d2c3b-4a5e-4f6d-8b7c-1e2f3a4b5c67")
+)
+
 func TestUserClaimsFromContext(t *testing.T) {
 	t.Parallel()
 
@@ -144,6 +151,46 @@ func TestCheckAttributes(t *testing.T) {
 			attributes: []string{"ingest:sips:list:something"},
 			want:       false,
 		},
+		{
+			name: "Checks a scoped attribute with an attribute without scope",
+			claims: &auth.Claims{
+				Attributes: []string{auth.StorageAIPSReadAttr},
+			},
+			attributes: []string{auth.ScopedAttribute(auth.StorageAIPSReadAttr, auth.LocationScope, locationA)},
+			want:       true,
+		},
+		{
+			name: "Checks a scoped attribute with the same scope",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:*:location/" + locationA.String()},
+			},
+			attributes: []string{auth.ScopedAttribute(auth.StorageAIPSReadAttr, auth.LocationScope, locationA)},
+			want:       true,
+		},
+		{
+			name: "Checks a scoped attribute with a wildcard scope",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:read:location/*"},
+			},
+			attributes: []string{auth.ScopedAttribute(auth.StorageAIPSReadAttr, auth.LocationScope, locationA)},
+			want:       true,
+		},
+		{
+			name: "Checks a scoped attribute with a different scope",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:read:location/" + locationB.String()},
+			},
+			attributes: []string{auth.ScopedAttribute(auth.StorageAIPSReadAttr, auth.LocationScope, locationA)},
+			want:       false,
+		},
+		{
+			name: "Checks an attribute without scope with a scoped attribute",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:read:location/" + locationA.String()},
+			},
+			attributes: []string{auth.StorageAIPSReadAttr},
+			want:       false,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
@@ -153,6 +200,121 @@ func TestCheckAttributes(t *testing.T) {
 	}
 }
 
+func TestCheckAttributesInAnyScope(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name       string
+		claims     *auth.Claims
+		attributes []string
+		want       bool
+	}
+	for _, tt := range []test{
+		{
+			name:       "Checks attributes on nil claim (auth disabled)",
+			attributes: []string{auth.StorageAIPSListAttr},
+			want:       true,
+		},
+		{
+			name: "Checks an attribute without scope",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:*"},
+			},
+			attributes: []string{auth.StorageAIPSListAttr},
+			want:       true,
+		},
+		{
+			name: "Checks an attribute scoped to a resource",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:list:location/" + locationA.String()},
+			},
+			attributes: []string{auth.StorageAIPSListAttr},
+			want:       true,
+		},
+		{
+			name: "Checks an attribute scoped to an unsupported resource kind",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:list:source/" + locationA.String()},
+			},
+			attributes: []string{auth.StorageAIPSListAttr},
+			want:       false,
+		},
+		{
+			name: "Checks an attribute that can't be scoped",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:move:location/" + locationA.String()},
+			},
+			attributes: []string{auth.StorageAIPSMoveAttr},
+			want:       false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.claims.CheckAttributesInAnyScope(tt.attributes), tt.want)
+		})
+	}
+}
+
+func TestAttributeScope(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name    string
+		claims  *auth.Claims
+		wantIDs []uuid.UUID
+		wantAll bool
+	}
+	for _, tt := range []test{
+		{
+			name:    "Returns all on nil claim (auth disabled)",
+			wantAll: true,
+		},
+		{
+			name: "Returns all for an attribute without scope",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:*", "storage:aips:list:location/" + locationA.String()},
+			},
+			wantAll: true,
+		},
+		{
+			name: "Returns all for a wildcard scope",
+			claims: &auth.Claims{
+				Attributes: []string{"storage:aips:list:location/*"},
+			},
+			wantAll: true,
+		},
+		{
+			name: "Returns the scoped resources",
+			claims: &auth.Claims{
+				Attributes: []string{
+					"storage:aips:list:location/" + locationA.String(),
+					"storage:aips:*:location/" + locationB.String(),
+					"storage:aips:list:location/" + locationA.String(),
+					"storage:aips:read:location/" + locationC.String(),
+					"storage:aips:list:location/invalid",
+					"ingest:sips:list:source/" + locationC.String(),
+				},
+			},
+			wantIDs: []uuid.UUID{locationA, locationB},
+		},
+		{
+			name: "Returns no resources",
+			claims: &auth.Claims{
+				Attributes: []string{auth.StorageAIPSReadAttr},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ids, all := tt.claims.AttributeScope(auth.StorageAIPSListAttr, auth.LocationScope)
+			assert.DeepEqual(t, ids, tt.wantIDs)
+			assert.Equal(t, all, tt.wantAll)
+		})
+	}
+}
+
 func TestDisplayName(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Scope kinds used to grant an attribute for specific resources. A scoped
+// attribute appends the scope kind and the resource UUID to the attribute,
+// e.g. "storage:aips:read:location/<uuid>" or "ingest:sips:list:source/<uuid>".
+// A "*" in place of the UUID grants the attribute for all the resources of
+// that kind.
+const (
+	LocationScope  = "location"
+	BatchScope     = "batch"
+	SIPSourceScope = "source"
+)
+
+// ScopedAttributes lists the attributes that can be granted for specific
+// resources and the scope kinds supported by each of them. The endpoints
+// requiring these attributes filter their results, or check the requested
+// resource, using the resource scopes of the user. Other attributes must be
+// granted without a scope.
+var ScopedAttributes = map[string][]string{
+	IngestBatchesListAttr:        {BatchScope, SIPSourceScope},
+	IngestBatchesReadAttr:        {BatchScope, SIPSourceScope},
+	IngestSIPSListAttr:           {BatchScope, SIPSourceScope},
+	IngestSIPSReadAttr:           {BatchScope, SIPSourceScope},
+	StorageAIPSDownloadAttr:      {LocationScope},
+	StorageAIPSListAttr:          {LocationScope},
+	StorageAIPSReadAttr:          {LocationScope},
+	StorageAIPSWorkflowsListAttr: {LocationScope},
+	StorageLocationsAIPSListAttr: {LocationScope},
+	StorageLocationsListAttr:     {LocationScope},
+	StorageLocationsReadAttr:     {LocationScope},
+}
+
+// ScopedAttribute returns attr scoped to the resource of the given kind and
+// UUID.
+func ScopedAttribute(attr, kind string, id uuid.UUID) string {
+	return attr + ":" + kind + "/" + id.String()
+}
+
+// splitScope splits a scoped attribute into the attribute and the scope
+// ("<kind>/<uuid>"). The scope is empty if the attribute is not scoped.
+func splitScope(attr string) (string, string) {
+	i := strings.LastIndex(attr, ":")
+	if i == -1 || !strings.Contains(attr[i+1:], "/") {
+		return attr, ""
+	}
+
+	return attr[:i], attr[i+1:]
+}
+
+// scopeKind returns the kind of a "<kind>/<uuid>" scope.
+func scopeKind(scope string) string {
+	kind, _, _ := strings.Cut(scope, "/")
+	return kind
+}
+
+// matchAttribute reports whether the granted attribute matches the required
+// one, by exact match or by having an ancestor with wildcard. For example,
+// "*" or "ingest:*" match "ingest:sips:list".
+func matchAttribute(granted, required string) bool {
+	if granted == "*" || granted == required {
+		return true
+	}
+
+	prefix, ok := strings.CutSuffix(granted, "*")
+	return ok && strings.HasSuffix(prefix, ":") && strings.HasPrefix(required, prefix)
+}
+
+// matchScope reports whether the granted scope matches the required one. An
+// empty granted scope matches all the resources.
+func matchScope(granted, required string) bool {
+	if granted == "" {
+		return true
+	}
+	if required == "" {
+		return false
+	}
+
+	return granted == required || granted == scopeKind(required)+"/*"
+}
+
+// scopable reports whether attr can be granted for resources of kind.
+func scopable(attr, kind string) bool {
+	return slices.Contains(ScopedAttributes[attr], kind)
+}
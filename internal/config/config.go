@@ -21,6 +21,7 @@ import (
 
 	"github.com/artefactual-sdps/enduro/internal/a3m"
 	"github.com/artefactual-sdps/enduro/internal/am"
+	"github.com/artefactual-sdps/enduro/internal/antivirus"
 	"github.com/artefactual-sdps/enduro/internal/api"
 	"github.com/artefactual-sdps/enduro/internal/auditlog"
 	"github.com/artefactual-sdps/enduro/internal/bagit"
@@ -30,11 +31,13 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/ingest"
 	"github.com/artefactual-sdps/enduro/internal/premis"
 	"github.com/artefactual-sdps/enduro/internal/pres"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/sipsource"
 	"github.com/artefactual-sdps/enduro/internal/storage"
 	"github.com/artefactual-sdps/enduro/internal/telemetry"
 	"github.com/artefactual-sdps/enduro/internal/temporal"
 	"github.com/artefactual-sdps/enduro/internal/watcher"
+	"github.com/artefactual-sdps/enduro/internal/webhook"
 )
 
 var logLevels = []string{
@@ -93,6 +96,7 @@ type Configuration struct {
 
 	A3m             a3m.Config
 	AM              am.Config
+	Antivirus       antivirus.Config
 	InternalAPI     api.Config
 	API             api.Config
 	BagIt           bagcreate.Config
@@ -103,6 +107,7 @@ type Configuration struct {
 	ExtractActivity archiveextract.Config
 	Ingest          ingest.Config
 	Preservation    pres.Config
+	Search          search.Config
 	SIPSource       sipsource.Config
 	Storage         storage.Config
 	Temporal        temporal.Config
@@ -112,24 +117,100 @@ type Configuration struct {
 	Telemetry       telemetry.Config
 	ValidatePREMIS  premis.Config
 	Auditlog        auditlog.Config
+	Webhooks        webhook.Config
 }
 
 func (c *Configuration) Validate() error {
 	return errors.Join(
 		c.LogFormat.Validate(),
 		c.A3m.Validate(),
+		c.Antivirus.Validate(),
+		c.validateAntivirusScans(),
 		c.API.Validate(),
 		c.InternalAPI.Validate(),
 		c.BagIt.Validate(),
 		c.BagItValidator.Validate(),
 		c.ChildWorkflows.Validate(),
 		c.Ingest.Validate(),
+		c.Preservation.Validate(),
+		c.validateProcessingProfiles(),
+		c.Search.Validate(),
 		c.SIPSource.Validate(),
+		c.Storage.Validate(),
 		c.ValidatePREMIS.Validate(),
 		c.Watcher.Validate(),
+		c.Webhooks.Validate(),
 	)
 }
 
+// validateAntivirusScans checks that a clamd address is configured when
+// antivirus scanning is enabled for a watcher or the SIP source.
+func (c *Configuration) validateAntivirusScans() error {
+	if c.Antivirus.Enabled() {
+		return nil
+	}
+
+	var errs error
+	scanErr := func(section, name string) error {
+		return fmt.Errorf(
+			"scanAntivirus in [%s] %q config requires an address in the [antivirus] configuration",
+			section,
+			name,
+		)
+	}
+	if c.Watcher.Embedded != nil && c.Watcher.Embedded.ScanAntivirus {
+		errs = errors.Join(errs, scanErr("watcher.embedded", c.Watcher.Embedded.Name))
+	}
+	for _, fs := range c.Watcher.Filesystem {
+		if fs != nil && fs.ScanAntivirus {
+			errs = errors.Join(errs, scanErr("watcher.filesystem", fs.Name))
+		}
+	}
+	for _, minio := range c.Watcher.Minio {
+		if minio != nil && minio.ScanAntivirus {
+			errs = errors.Join(errs, scanErr("watcher.minio", minio.Name))
+		}
+	}
+	if c.SIPSource.ScanAntivirus {
+		errs = errors.Join(errs, scanErr("sipsource", c.SIPSource.Name))
+	}
+
+	return errs
+}
+
+// validateProcessingProfiles checks that the processing profiles selected by
+// the watchers and the SIP source are defined in the [[preservation.profiles]]
+// configuration.
+func (c *Configuration) validateProcessingProfiles() error {
+	var errs error
+	check := func(section, name, profile string) {
+		if profile != "" && c.Preservation.Profiles.ByName(profile) == nil {
+			errs = errors.Join(errs, fmt.Errorf(
+				"processingProfile in [%s] %q config: unknown profile %q",
+				section,
+				name,
+				profile,
+			))
+		}
+	}
+	if c.Watcher.Embedded != nil {
+		check("watcher.embedded", c.Watcher.Embedded.Name, c.Watcher.Embedded.ProcessingProfile)
+	}
+	for _, fs := range c.Watcher.Filesystem {
+		if fs != nil {
+			check("watcher.filesystem", fs.Name, fs.ProcessingProfile)
+		}
+	}
+	for _, minio := range c.Watcher.Minio {
+		if minio != nil {
+			check("watcher.minio", minio.Name, minio.ProcessingProfile)
+		}
+	}
+	check("sipsource", c.SIPSource.Name, c.SIPSource.ProcessingProfile)
+
+	return errs
+}
+
 func Read(config *Configuration, configFile string) (found bool, configFileUsed string, err error) {
 	v := viper.New()
 
@@ -143,11 +224,25 @@ func Read(config *Configuration, configFile string) (found bool, configFileUsed
 	v.SetDefault("api.listen", "127.0.0.1:9000")
 	v.SetDefault("bagitvalidator.poolSize", 1)
 	v.SetDefault("debugListen", "127.0.0.1:9001")
+	v.SetDefault("ingest.apiTokenMaxLifetime", 90*24*time.Hour)
 	v.SetDefault("logFormat", LogFormatJSON)
 	v.SetDefault("preservation.taskqueue", temporal.A3mWorkerTaskQueue)
+	v.SetDefault("search.backend", search.BackendSQL)
+	v.SetDefault("storage.aipDeletion.minApprovers", 1)
+	v.SetDefault("storage.fixity.batchSize", 100)
+	v.SetDefault("storage.fixity.schedule", "0 2 * * 0")
+	v.SetDefault("storage.retention.batchSize", 100)
+	v.SetDefault("storage.retention.schedule", "0 3 * * *")
+	v.SetDefault("storage.restore.ttl", 7*24*time.Hour)
 	v.SetDefault("storage.taskqueue", temporal.GlobalTaskQueue)
+	v.SetDefault("telemetry.metrics.interval", time.Minute)
 	v.SetDefault("temporal.taskqueue", temporal.GlobalTaskQueue)
 	v.SetDefault("upload.maxSize", 4294967296)
+	v.SetDefault("upload.sessionExpiry", 24*time.Hour)
+	v.SetDefault("webhooks.initialInterval", time.Second)
+	v.SetDefault("webhooks.maxAttempts", 5)
+	v.SetDefault("webhooks.maxInterval", time.Minute)
+	v.SetDefault("webhooks.timeout", 10*time.Second)
 	v.SetEnvPrefix("enduro")
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
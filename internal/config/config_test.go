@@ -21,8 +21,11 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/config"
 	"github.com/artefactual-sdps/enduro/internal/ingest"
 	"github.com/artefactual-sdps/enduro/internal/pres"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/telemetry"
 	"github.com/artefactual-sdps/enduro/internal/temporal"
+	"github.com/artefactual-sdps/enduro/internal/webhook"
 )
 
 const testConfig = `# Config
@@ -182,6 +185,7 @@ func TestConfigRead(t *testing.T) {
 					},
 				},
 				Ingest: ingest.Config{
+					APITokenMaxLifetime: 90 * 24 * time.Hour,
 					Storage: ingest.StorageConfig{
 						Address:                    "storage-api:9000",
 						DefaultPermanentLocationID: uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1"),
@@ -205,15 +209,44 @@ func TestConfigRead(t *testing.T) {
 				Preservation: pres.Config{
 					TaskQueue: "a3m",
 				},
+				Search: search.Config{
+					Backend: "sql",
+				},
 				Storage: storage.Config{
 					TaskQueue: "global",
+					AIPDeletion: storage.AIPDeletionConfig{
+						MinApprovers: 1,
+					},
+					Fixity: storage.FixityConfig{
+						Schedule:  "0 2 * * 0",
+						BatchSize: 100,
+					},
+					Retention: storage.RetentionConfig{
+						Schedule:  "0 3 * * *",
+						BatchSize: 100,
+					},
+					Restore: storage.RestoreConfig{
+						TTL: 7 * 24 * time.Hour,
+					},
 				},
 				Temporal: temporal.Config{
 					Address:   "host:port",
 					TaskQueue: "global",
 				},
 				Upload: ingest.UploadConfig{
-					MaxSize: 4294967296,
+					MaxSize:       4294967296,
+					SessionExpiry: 24 * time.Hour,
+				},
+				Telemetry: telemetry.Config{
+					Metrics: telemetry.MetricsConfig{
+						Interval: time.Minute,
+					},
+				},
+				Webhooks: webhook.Config{
+					Timeout:         10 * time.Second,
+					MaxAttempts:     5,
+					InitialInterval: time.Second,
+					MaxInterval:     time.Minute,
 				},
 			},
 		},
@@ -253,6 +286,7 @@ defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"`,
 					PoolSize: 1,
 				},
 				Ingest: ingest.Config{
+					APITokenMaxLifetime: 90 * 24 * time.Hour,
 					Storage: ingest.StorageConfig{
 						Address:                    "storage-api:9000",
 						DefaultPermanentLocationID: uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1"),
@@ -261,14 +295,43 @@ defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"`,
 				Preservation: pres.Config{
 					TaskQueue: "a3m",
 				},
+				Search: search.Config{
+					Backend: "sql",
+				},
 				Storage: storage.Config{
 					TaskQueue: "global",
+					AIPDeletion: storage.AIPDeletionConfig{
+						MinApprovers: 1,
+					},
+					Fixity: storage.FixityConfig{
+						Schedule:  "0 2 * * 0",
+						BatchSize: 100,
+					},
+					Retention: storage.RetentionConfig{
+						Schedule:  "0 3 * * *",
+						BatchSize: 100,
+					},
+					Restore: storage.RestoreConfig{
+						TTL: 7 * 24 * time.Hour,
+					},
 				},
 				Temporal: temporal.Config{
 					TaskQueue: "global",
 				},
 				Upload: ingest.UploadConfig{
-					MaxSize: 4294967296,
+					MaxSize:       4294967296,
+					SessionExpiry: 24 * time.Hour,
+				},
+				Telemetry: telemetry.Config{
+					Metrics: telemetry.MetricsConfig{
+						Interval: time.Minute,
+					},
+				},
+				Webhooks: webhook.Config{
+					Timeout:         10 * time.Second,
+					MaxAttempts:     5,
+					InitialInterval: time.Second,
+					MaxInterval:     time.Minute,
 				},
 			},
 		},
@@ -315,6 +378,115 @@ defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
 aipCompressionLevel = 10`,
 			wantErr: "failed to validate the provided config: AipCompressionLevel: 10 is outside valid range (0 to 9)",
 		},
+		{
+			name: "Returns error if fixity config is invalid",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[storage.fixity]
+enabled = true
+batchSize = 0`,
+			wantErr: "failed to validate the provided config: fixity: batchSize must be greater than zero",
+		},
+		{
+			name: "Returns error if restore config is invalid",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[storage.restore]
+ttl = "0"`,
+			wantErr: "failed to validate the provided config: restore: ttl must not be zero",
+		},
+		{
+			name: "Returns error if a storage quota is invalid",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[[storage.quotas]]
+locationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+maxSize = 0`,
+			wantErr: "failed to validate the provided config: quota: maxSize must be greater than zero: f2cc963f-c14d-4eaa-b950-bd207189a1f1",
+		},
+		{
+			name: "Returns error if a retention policy is invalid",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[[storage.retention.policies]]
+locationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+period = "0"`,
+			wantErr: "failed to validate the provided config: retention: policy period must be greater than zero: f2cc963f-c14d-4eaa-b950-bd207189a1f1",
+		},
+		{
+			name: "Returns error if webhooks config is invalid",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[[webhooks.endpoints]]
+name = "catalogue"
+url = "https://catalogue.example.com/hooks/enduro"
+secret = "secret"
+events = ["sip_ingested_event"]`,
+			wantErr: `failed to validate the provided config: webhook: unknown event type: "sip_ingested_event"`,
+		},
+		{
+			name: "Returns error if antivirus scanning is enabled without an address",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[[watcher.minio]]
+name = "dev-minio"
+scanAntivirus = true`,
+			wantErr: `failed to validate the provided config: scanAntivirus in [watcher.minio] "dev-minio" config requires an address in the [antivirus] configuration`,
+		},
+		{
+			name: "Returns error if a processing profile is invalid",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[[preservation.profiles]]
+name = "email"
+
+[[preservation.profiles]]
+name = "email"
+
+[preservation.profiles.a3m]
+aipCompressionLevel = 10`,
+			wantErr: `failed to validate the provided config: [[preservation.profiles]] "email": duplicate name
+[[preservation.profiles]] "email": AipCompressionLevel: 10 is outside valid range (0 to 9)`,
+		},
+		{
+			name: "Returns error if a watcher uses an unknown processing profile",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[[preservation.profiles]]
+name = "email"
+
+[[watcher.filesystem]]
+name = "dev-fs"
+path = "/tmp"
+processingProfile = "images"`,
+			wantErr: `failed to validate the provided config: processingProfile in [watcher.filesystem] "dev-fs" config: unknown profile "images"`,
+		},
+		{
+			name: "Returns error if antivirus config is invalid",
+			config: `[ingest.storage]
+address = "storage-api:9000"
+defaultPermanentLocationId = "f2cc963f-c14d-4eaa-b950-bd207189a1f1"
+
+[antivirus]
+address = "clamav:3310"`,
+			wantErr: `failed to validate the provided config: invalid address in [antivirus] configuration: unsupported scheme: "clamav:3310"`,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
@@ -0,0 +1,149 @@
+// Package dataexport exports the Enduro databases to a portable archive and
+// imports an archive into empty databases.
+//
+// An archive is a JSON lines document. The first line holds the archive
+// header, followed by one line per table row and a footer with the number of
+// rows exported. Rows are keyed by column name and their values are encoded
+// independently of the database driver, so an archive can be imported into a
+// database that uses a different driver than the exported one. Tables are
+// exported in dependency order, keeping their primary keys, which preserves the
+// UUIDs and the relations between the rows.
+package dataexport
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+
+	ingest_migrate "github.com/artefactual-sdps/enduro/internal/persistence/ent/db/migrate"
+	storage_migrate "github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/migrate"
+)
+
+const (
+	// Format identifies the Enduro export archives.
+	Format = "enduro-export"
+
+	// Version is the version of the archives written by Export. Import rejects
+	// archives with a greater version.
+	Version = 1
+)
+
+const (
+	IngestDatabase  = "ingest"
+	StorageDatabase = "storage"
+)
+
+// ErrNotEmpty is returned when importing into a database that has data.
+var ErrNotEmpty = errors.New("database is not empty")
+
+// Database is a database included in an archive.
+type Database struct {
+	// Name identifies the database in the archive.
+	Name string
+
+	// Driver is the database driver (e.g. "mysql", "postgres" or "sqlite3").
+	Driver string
+
+	// DB is the database handler.
+	DB *sql.DB
+
+	// Tables are the tables of the database, as defined by its Ent schema.
+	Tables []*schema.Table
+}
+
+// Ingest returns the ingest Database.
+func Ingest(driver string, db *sql.DB) Database {
+	return Database{
+		Name:   IngestDatabase,
+		Driver: driver,
+		DB:     db,
+		Tables: ingest_migrate.Tables,
+	}
+}
+
+// Storage returns the storage Database.
+func Storage(driver string, db *sql.DB) Database {
+	return Database{
+		Name:   StorageDatabase,
+		Driver: driver,
+		DB:     db,
+		Tables: storage_migrate.Tables,
+	}
+}
+
+// Header is the first record of an archive.
+type Header struct {
+	Format        string    `json:"format"`
+	Version       int       `json:"version"`
+	EnduroVersion string    `json:"enduro_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Databases     []string  `json:"databases"`
+	// Scrubbed reports whether the secrets were removed from the archive.
+	Scrubbed bool `json:"scrubbed"`
+}
+
+// Footer is the last record of an archive, used to detect truncated archives.
+type Footer struct {
+	Rows int `json:"rows"`
+}
+
+// record is a line of an archive.
+type record struct {
+	Header   *Header                    `json:"header,omitempty"`
+	Database string                     `json:"database,omitempty"`
+	Table    string                     `json:"table,omitempty"`
+	Row      map[string]json.RawMessage `json:"row,omitempty"`
+	Footer   *Footer                    `json:"footer,omitempty"`
+}
+
+// sortTables returns tables sorted so that every table comes after the tables
+// it references.
+func sortTables(tables []*schema.Table) []*schema.Table {
+	sorted := make([]*schema.Table, 0, len(tables))
+	visited := map[string]bool{}
+
+	var visit func(t *schema.Table)
+	visit = func(t *schema.Table) {
+		if visited[t.Name] {
+			return
+		}
+		visited[t.Name] = true
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable != nil {
+				visit(fk.RefTable)
+			}
+		}
+		sorted = append(sorted, t)
+	}
+
+	for _, t := range tables {
+		visit(t)
+	}
+
+	return sorted
+}
+
+// findTable returns the table with the given name, or nil.
+func findTable(tables []*schema.Table, name string) *schema.Table {
+	for _, t := range tables {
+		if t.Name == name {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// findColumn returns the column with the given name, or nil.
+func findColumn(t *schema.Table, name string) *schema.Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
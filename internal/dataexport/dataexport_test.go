@@ -0,0 +1,331 @@
+package dataexport_test
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/dataexport"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+	ingest_db "github.com/artefactual-sdps/enduro/internal/persistence/ent/db"
+	storage_enums "github.com/artefactual-sdps/enduro/internal/storage/enums"
+	storage_db "github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+var (
+	batchUUID    = uuid.MustParse("7b1a1c36-8f3c-4d5e-9f2a-0c3b0a8e6d11")
+	sipUUID      = uuid.MustParse("e2ace0da-8697-453d-9ea1-4c9b62309e54")
+	aipUUID      = uuid.MustParse("30223842-0650-4f79-80bd-7bf43b810656")
+	locationUUID = uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1")
+	createdAt    = time.Date(2026, 10, 17, 12, 30, 0, 500, time.UTC)
+)
+
+type databases struct {
+	ingest      *ingest_db.Client
+	storage     *storage_db.Client
+	ingestDB    *sql.DB
+	storageDB   *sql.DB
+	exportedDBs []dataexport.Database
+}
+
+func openSQLite(t *testing.T, name string) *sql.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s_%s?mode=memory&cache=shared&_fk=1", t.Name(), name)
+	sqlDB, err := sql.Open("sqlite3", dsn)
+	assert.NilError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	return sqlDB
+}
+
+func setUp(t *testing.T, prefix string) databases {
+	t.Helper()
+
+	ingestDB := openSQLite(t, prefix+"ingest")
+	ingestc := ingest_db.NewClient(ingest_db.Driver(entsql.OpenDB(dialect.SQLite, ingestDB)))
+	assert.NilError(t, ingestc.Schema.Create(t.Context()))
+
+	storageDB := openSQLite(t, prefix+"storage")
+	storagec := storage_db.NewClient(storage_db.Driver(entsql.OpenDB(dialect.SQLite, storageDB)))
+	assert.NilError(t, storagec.Schema.Create(t.Context()))
+
+	return databases{
+		ingest:    ingestc,
+		storage:   storagec,
+		ingestDB:  ingestDB,
+		storageDB: storageDB,
+		exportedDBs: []dataexport.Database{
+			dataexport.Ingest(dialect.SQLite, ingestDB),
+			dataexport.Storage(dialect.SQLite, storageDB),
+		},
+	}
+}
+
+func populate(t *testing.T, dbs databases) {
+	t.Helper()
+	ctx := t.Context()
+
+	user, err := dbs.ingest.User.Create().
+		SetUUID(uuid.New()).
+		SetEmail("nobody@example.com").
+		SetName("Nobody Example").
+		SetCreatedAt(createdAt).
+		SetOidcIss("http://keycloak:7470/realms/artefactual").
+		SetOidcSub("1234567890").
+		Save(ctx)
+	assert.NilError(t, err)
+
+	batch, err := dbs.ingest.Batch.Create().
+		SetUUID(batchUUID).
+		SetIdentifier("batch-1").
+		SetStatus(enums.BatchStatusIngested).
+		SetSipsCount(1).
+		SetCreatedAt(createdAt).
+		SetUploader(user).
+		Save(ctx)
+	assert.NilError(t, err)
+
+	sip, err := dbs.ingest.SIP.Create().
+		SetUUID(sipUUID).
+		SetName("Test SIP").
+		SetAipID(aipUUID).
+		SetStatus(enums.SIPStatusIngested).
+		SetCreatedAt(createdAt).
+		SetUploader(user).
+		SetBatch(batch).
+		Save(ctx)
+	assert.NilError(t, err)
+
+	wf, err := dbs.ingest.Workflow.Create().
+		SetUUID(uuid.New()).
+		SetTemporalID("processing-workflow-1").
+		SetType(enums.WorkflowTypeCreateAip).
+		SetStatus(int8(enums.WorkflowStatusDone)).
+		SetStartedAt(createdAt).
+		SetSip(sip).
+		Save(ctx)
+	assert.NilError(t, err)
+
+	_, err = dbs.ingest.Task.Create().
+		SetUUID(uuid.New()).
+		SetName("Validate SIP").
+		SetStatus(int8(enums.TaskStatusDone)).
+		SetNote("Validated\nwith \"quotes\" and ünicode").
+		SetWorkflow(wf).
+		Save(ctx)
+	assert.NilError(t, err)
+
+	location, err := dbs.storage.Location.Create().
+		SetName("AIP store").
+		SetDescription("S3 bucket").
+		SetSource(storage_enums.LocationSourceS3).
+		SetPurpose(storage_enums.LocationPurposeAipStore).
+		SetUUID(locationUUID).
+		SetConfig(types.LocationConfig{
+			Value: &types.S3Config{
+				Bucket: "aips",
+				Region: "eu-west-1",
+				Key:    "key",
+				Secret: "secret",
+			},
+		}).
+		SetCreatedAt(createdAt).
+		Save(ctx)
+	assert.NilError(t, err)
+
+	aip, err := dbs.storage.AIP.Create().
+		SetName("Test AIP").
+		SetAipID(aipUUID).
+		SetObjectKey(aipUUID).
+		SetStatus(storage_enums.AIPStatusStored).
+		SetCreatedAt(createdAt).
+		SetLocation(location).
+		SetSize(1024).
+		Save(ctx)
+	assert.NilError(t, err)
+
+	swf, err := dbs.storage.Workflow.Create().
+		SetUUID(uuid.New()).
+		SetTemporalID("upload-aip-1").
+		SetType(storage_enums.WorkflowTypeUploadAip).
+		SetStatus(storage_enums.WorkflowStatusDone).
+		SetAip(aip).
+		Save(ctx)
+	assert.NilError(t, err)
+
+	_, err = dbs.storage.Task.Create().
+		SetUUID(uuid.New()).
+		SetName("Upload AIP").
+		SetStatus(storage_enums.TaskStatusDone).
+		SetNote("").
+		SetWorkflow(swf).
+		Save(ctx)
+	assert.NilError(t, err)
+}
+
+func export(t *testing.T, dbs databases, opts dataexport.ExportOptions) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	n, err := dataexport.Export(t.Context(), &buf, opts, dbs.exportedDBs...)
+	assert.NilError(t, err)
+	assert.Equal(t, n, 9)
+
+	return buf.Bytes()
+}
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	src := setUp(t, "src")
+	populate(t, src)
+	archive := export(t, src, dataexport.ExportOptions{
+		ScrubSecrets: true,
+		Now:          func() time.Time { return createdAt },
+	})
+
+	dst := setUp(t, "dst")
+	n, err := dataexport.Import(t.Context(), bytes.NewReader(archive), dst.exportedDBs...)
+	assert.NilError(t, err)
+	assert.Equal(t, n, 9)
+
+	ctx := t.Context()
+
+	sip, err := dst.ingest.SIP.Query().
+		WithBatch().
+		WithUploader().
+		WithWorkflows(func(q *ingest_db.WorkflowQuery) { q.WithTasks() }).
+		Only(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, sip.UUID, sipUUID)
+	assert.Equal(t, sip.AipID, aipUUID)
+	assert.Equal(t, sip.Status, enums.SIPStatusIngested)
+	assert.Assert(t, sip.CreatedAt.Equal(createdAt))
+	assert.Equal(t, sip.Edges.Batch.UUID, batchUUID)
+	assert.Equal(t, sip.Edges.Uploader.Email, "nobody@example.com")
+	assert.Equal(t, len(sip.Edges.Workflows), 1)
+	assert.Equal(t, len(sip.Edges.Workflows[0].Edges.Tasks), 1)
+	assert.Equal(t, sip.Edges.Workflows[0].Edges.Tasks[0].Note, "Validated\nwith \"quotes\" and ünicode")
+
+	aip, err := dst.storage.AIP.Query().
+		WithLocation().
+		WithWorkflows(func(q *storage_db.WorkflowQuery) { q.WithTasks() }).
+		Only(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, aip.AipID, aipUUID)
+	assert.Equal(t, aip.Size, int64(1024))
+	assert.Equal(t, aip.Edges.Location.UUID, locationUUID)
+	assert.DeepEqual(t, aip.Edges.Location.Config, types.LocationConfig{
+		Value: &types.S3Config{
+			Bucket: "aips",
+			Region: "eu-west-1",
+		},
+	})
+	assert.Equal(t, len(aip.Edges.Workflows), 1)
+	assert.Equal(t, len(aip.Edges.Workflows[0].Edges.Tasks), 1)
+
+	// New rows don't conflict with the imported primary keys.
+	_, err = dst.ingest.SIP.Create().
+		SetUUID(uuid.New()).
+		SetName("New SIP").
+		SetStatus(enums.SIPStatusQueued).
+		Save(ctx)
+	assert.NilError(t, err)
+}
+
+func TestExportKeepsSecrets(t *testing.T) {
+	t.Parallel()
+
+	src := setUp(t, "src")
+	populate(t, src)
+	archive := export(t, src, dataexport.ExportOptions{})
+
+	assert.Assert(t, strings.Contains(string(archive), `"secret":"secret"`))
+}
+
+func TestImportErrors(t *testing.T) {
+	t.Parallel()
+
+	src := setUp(t, "src")
+	populate(t, src)
+	archive := export(t, src, dataexport.ExportOptions{})
+	lines := strings.SplitAfter(strings.TrimSpace(string(archive)), "\n")
+
+	for _, tc := range []struct {
+		name    string
+		archive string
+		wantErr string
+	}{
+		{
+			name:    "Fails with an empty archive",
+			archive: "",
+			wantErr: "read header: EOF",
+		},
+		{
+			name:    "Fails with an invalid format",
+			archive: `{"header":{"format":"other","version":1}}`,
+			wantErr: "not an Enduro export archive",
+		},
+		{
+			name:    "Fails with an unsupported version",
+			archive: `{"header":{"format":"enduro-export","version":2}}`,
+			wantErr: "unsupported archive version: 2",
+		},
+		{
+			name:    "Fails with an unknown database",
+			archive: `{"header":{"format":"enduro-export","version":1,"databases":["other"]}}`,
+			wantErr: "missing other database to import the archive",
+		},
+		{
+			name:    "Fails with a missing footer",
+			archive: strings.Join(lines[:len(lines)-1], ""),
+			wantErr: "archive is truncated: missing footer",
+		},
+		{
+			name:    "Fails with missing rows",
+			archive: strings.Join(append(lines[:len(lines)-2:len(lines)-2], lines[len(lines)-1]), ""),
+			wantErr: "archive is truncated: read 8 rows, expected 9",
+		},
+		{
+			name:    "Fails with an unknown column",
+			archive: lines[0] + `{"database":"ingest","table":"sip","row":{"foo":1}}` + "\n",
+			wantErr: `import ingest table "sip": unknown column "foo"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dst := setUp(t, "dst")
+			_, err := dataexport.Import(t.Context(), strings.NewReader(tc.archive), dst.exportedDBs...)
+			assert.Error(t, err, tc.wantErr)
+
+			// Nothing is imported on error.
+			n, err := dst.ingest.User.Query().Count(t.Context())
+			assert.NilError(t, err)
+			assert.Equal(t, n, 0)
+		})
+	}
+}
+
+func TestImportNotEmpty(t *testing.T) {
+	t.Parallel()
+
+	src := setUp(t, "src")
+	populate(t, src)
+	archive := export(t, src, dataexport.ExportOptions{})
+
+	_, err := dataexport.Import(t.Context(), bytes.NewReader(archive), src.exportedDBs...)
+	assert.ErrorIs(t, err, dataexport.ErrNotEmpty)
+	assert.ErrorContains(t, err, `database is not empty: ingest table "batch" has 1 rows`)
+}
@@ -0,0 +1,209 @@
+package dataexport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+	"github.com/artefactual-sdps/enduro/internal/version"
+)
+
+// ExportOptions configures an export.
+type ExportOptions struct {
+	// ScrubSecrets removes the secrets (e.g. credentials) from the location
+	// configs of the storage database.
+	ScrubSecrets bool
+
+	// Now returns the creation time of the archive, defaults to time.Now.
+	Now func() time.Time
+}
+
+// scrubber removes the secrets from a column value.
+type scrubber func(json.RawMessage) (json.RawMessage, error)
+
+// scrubbers are the scrubbers of the columns holding secrets, keyed by
+// database, table and column names.
+var scrubbers = map[[3]string]scrubber{
+	{StorageDatabase, "location", "config"}: scrubLocationConfig,
+}
+
+// Export writes an archive of dbs to w and returns the number of rows written.
+func Export(ctx context.Context, w io.Writer, opts ExportOptions, dbs ...Database) (int, error) {
+	now := time.Now
+	if opts.Now != nil {
+		now = opts.Now
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	header := &Header{
+		Format:        Format,
+		Version:       Version,
+		EnduroVersion: version.Long,
+		CreatedAt:     now().UTC(),
+		Databases:     make([]string, len(dbs)),
+		Scrubbed:      opts.ScrubSecrets,
+	}
+	for i, d := range dbs {
+		header.Databases[i] = d.Name
+	}
+	if err := enc.Encode(record{Header: header}); err != nil {
+		return 0, fmt.Errorf("write header: %v", err)
+	}
+
+	var rows int
+	for _, d := range dbs {
+		for _, t := range sortTables(d.Tables) {
+			n, err := exportTable(ctx, enc, d, t, opts.ScrubSecrets)
+			rows += n
+			if err != nil {
+				return rows, fmt.Errorf("export %s table %q: %v", d.Name, t.Name, err)
+			}
+		}
+	}
+
+	if err := enc.Encode(record{Footer: &Footer{Rows: rows}}); err != nil {
+		return rows, fmt.Errorf("write footer: %v", err)
+	}
+
+	return rows, nil
+}
+
+func exportTable(
+	ctx context.Context,
+	enc *json.Encoder,
+	d Database,
+	t *schema.Table,
+	scrub bool,
+) (int, error) {
+	columns := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		columns[i] = c.Name
+	}
+	orderBy := make([]string, len(t.PrimaryKey))
+	for i, c := range t.PrimaryKey {
+		orderBy[i] = c.Name
+	}
+
+	query, args := entsql.Dialect(d.Driver).
+		Select(columns...).
+		From(entsql.Table(t.Name)).
+		OrderBy(orderBy...).
+		Query()
+	res, err := d.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var n int
+	for res.Next() {
+		dest := make([]any, len(t.Columns))
+		for i, c := range t.Columns {
+			dest[i] = scanDest(c.Type)
+		}
+		if err := res.Scan(dest...); err != nil {
+			return n, err
+		}
+
+		row := make(map[string]json.RawMessage, len(t.Columns))
+		for i, c := range t.Columns {
+			v, err := encodeValue(c.Type, dest[i])
+			if err != nil {
+				return n, fmt.Errorf("column %q: %v", c.Name, err)
+			}
+			if s, ok := scrubbers[[3]string{d.Name, t.Name, c.Name}]; ok && scrub && string(v) != "null" {
+				if v, err = s(v); err != nil {
+					return n, fmt.Errorf("column %q: scrub: %v", c.Name, err)
+				}
+			}
+			row[c.Name] = v
+		}
+
+		if err := enc.Encode(record{Database: d.Name, Table: t.Name, Row: row}); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	return n, res.Err()
+}
+
+// scanDest returns a scan destination for a column of type t.
+func scanDest(t field.Type) any {
+	switch {
+	case t == field.TypeBool:
+		return new(sql.NullBool)
+	case t == field.TypeTime:
+		return new(sql.NullTime)
+	case t.Integer():
+		return new(sql.NullInt64)
+	case t.Float():
+		return new(sql.NullFloat64)
+	case t == field.TypeBytes:
+		return new([]byte)
+	default:
+		// Strings, enums, UUIDs and JSON values.
+		return new(sql.NullString)
+	}
+}
+
+// encodeValue returns the JSON encoding of a value scanned in dest. Times are
+// encoded as RFC 3339 strings in UTC and JSON values are embedded as is.
+func encodeValue(t field.Type, dest any) (json.RawMessage, error) {
+	var v any
+	switch d := dest.(type) {
+	case *sql.NullBool:
+		if d.Valid {
+			v = d.Bool
+		}
+	case *sql.NullTime:
+		if d.Valid {
+			v = d.Time.UTC().Format(time.RFC3339Nano)
+		}
+	case *sql.NullInt64:
+		if d.Valid {
+			v = d.Int64
+		}
+	case *sql.NullFloat64:
+		if d.Valid {
+			v = d.Float64
+		}
+	case *[]byte:
+		if *d != nil {
+			v = *d
+		}
+	case *sql.NullString:
+		if d.Valid {
+			if t == field.TypeJSON {
+				if !json.Valid([]byte(d.String)) {
+					return nil, fmt.Errorf("invalid JSON value")
+				}
+				return json.RawMessage(d.String), nil
+			}
+			v = d.String
+		}
+	default:
+		return nil, fmt.Errorf("unexpected scan destination: %T", dest)
+	}
+
+	return json.Marshal(v)
+}
+
+func scrubLocationConfig(v json.RawMessage) (json.RawMessage, error) {
+	var cfg types.LocationConfig
+	if err := json.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(cfg.Scrubbed())
+}
@@ -0,0 +1,244 @@
+package dataexport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Import restores the archive read from r into dbs and returns the number of
+// rows imported. The databases must have an up to date schema and no data.
+// The rows of each database are imported in a single transaction.
+func Import(ctx context.Context, r io.Reader, dbs ...Database) (int, error) {
+	for _, d := range dbs {
+		if err := checkEmpty(ctx, d); err != nil {
+			return 0, err
+		}
+	}
+
+	dec := json.NewDecoder(r)
+
+	var rec record
+	if err := dec.Decode(&rec); err != nil {
+		return 0, fmt.Errorf("read header: %v", err)
+	}
+	if err := checkHeader(rec.Header, dbs); err != nil {
+		return 0, err
+	}
+
+	txs := make(map[string]*sql.Tx, len(dbs))
+	defer func() {
+		for _, tx := range txs {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, d := range dbs {
+		tx, err := d.DB.BeginTx(ctx, nil)
+		if err != nil {
+			return 0, fmt.Errorf("begin %s transaction: %v", d.Name, err)
+		}
+		txs[d.Name] = tx
+	}
+
+	var (
+		rows   int
+		footer *Footer
+	)
+	for {
+		rec = record{}
+		if err := dec.Decode(&rec); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return rows, fmt.Errorf("read record %d: %v", rows+1, err)
+		}
+		if footer != nil {
+			return rows, errors.New("unexpected record after the archive footer")
+		}
+		if rec.Footer != nil {
+			footer = rec.Footer
+			continue
+		}
+
+		i := slices.IndexFunc(dbs, func(d Database) bool { return d.Name == rec.Database })
+		if i < 0 {
+			return rows, fmt.Errorf("unknown database: %q", rec.Database)
+		}
+		if err := importRow(ctx, txs[rec.Database], dbs[i], rec.Table, rec.Row); err != nil {
+			return rows, fmt.Errorf("import %s table %q: %v", rec.Database, rec.Table, err)
+		}
+		rows++
+	}
+
+	if footer == nil {
+		return rows, errors.New("archive is truncated: missing footer")
+	}
+	if footer.Rows != rows {
+		return rows, fmt.Errorf("archive is truncated: read %d rows, expected %d", rows, footer.Rows)
+	}
+
+	for _, d := range dbs {
+		if err := resetSequences(ctx, txs[d.Name], d); err != nil {
+			return rows, fmt.Errorf("reset %s sequences: %v", d.Name, err)
+		}
+	}
+	for _, d := range dbs {
+		if err := txs[d.Name].Commit(); err != nil {
+			return rows, fmt.Errorf("commit %s transaction: %v", d.Name, err)
+		}
+		delete(txs, d.Name)
+	}
+
+	return rows, nil
+}
+
+func checkHeader(h *Header, dbs []Database) error {
+	if h == nil || h.Format != Format {
+		return errors.New("not an Enduro export archive")
+	}
+	if h.Version < 1 || h.Version > Version {
+		return fmt.Errorf("unsupported archive version: %d", h.Version)
+	}
+	for _, name := range h.Databases {
+		if !slices.ContainsFunc(dbs, func(d Database) bool { return d.Name == name }) {
+			return fmt.Errorf("missing %s database to import the archive", name)
+		}
+	}
+
+	return nil
+}
+
+// checkEmpty returns ErrNotEmpty if any table of d has rows.
+func checkEmpty(ctx context.Context, d Database) error {
+	for _, t := range d.Tables {
+		query, args := entsql.Dialect(d.Driver).
+			Select(entsql.Count("*")).
+			From(entsql.Table(t.Name)).
+			Query()
+
+		var n int
+		if err := d.DB.QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+			return fmt.Errorf("count %s table %q rows: %v", d.Name, t.Name, err)
+		}
+		if n > 0 {
+			return fmt.Errorf("%w: %s table %q has %d rows", ErrNotEmpty, d.Name, t.Name, n)
+		}
+	}
+
+	return nil
+}
+
+func importRow(ctx context.Context, tx *sql.Tx, d Database, table string, row map[string]json.RawMessage) error {
+	t := findTable(d.Tables, table)
+	if t == nil {
+		return errors.New("unknown table")
+	}
+	if len(row) == 0 {
+		return errors.New("empty row")
+	}
+
+	columns := make([]string, 0, len(row))
+	for name := range row {
+		columns = append(columns, name)
+	}
+	slices.Sort(columns)
+
+	values := make([]any, len(columns))
+	for i, name := range columns {
+		c := findColumn(t, name)
+		if c == nil {
+			return fmt.Errorf("unknown column %q", name)
+		}
+		v, err := decodeValue(c.Type, row[name])
+		if err != nil {
+			return fmt.Errorf("column %q: %v", name, err)
+		}
+		values[i] = v
+	}
+
+	query, args := entsql.Dialect(d.Driver).
+		Insert(t.Name).
+		Columns(columns...).
+		Values(values...).
+		Query()
+	_, err := tx.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// decodeValue decodes the JSON value raw of a column of type t.
+func decodeValue(t field.Type, raw json.RawMessage) (any, error) {
+	if string(raw) == "null" {
+		return nil, nil
+	}
+
+	switch {
+	case t == field.TypeBool:
+		return decode[bool](raw)
+	case t == field.TypeTime:
+		s, err := decode[string](raw)
+		if err != nil {
+			return nil, err
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	case t.Integer():
+		return decode[int64](raw)
+	case t.Float():
+		return decode[float64](raw)
+	case t == field.TypeBytes:
+		return decode[[]byte](raw)
+	case t == field.TypeJSON:
+		return string(raw), nil
+	case t == field.TypeUUID:
+		s, err := decode[string](raw)
+		if err != nil {
+			return nil, err
+		}
+		return uuid.Parse(s)
+	default:
+		return decode[string](raw)
+	}
+}
+
+func decode[T any](raw json.RawMessage) (T, error) {
+	var v T
+	err := json.Unmarshal(raw, &v)
+
+	return v, err
+}
+
+// resetSequences restarts the PostgreSQL identity sequences after the greatest
+// imported ID. MySQL and SQLite update their auto increment counters on insert.
+func resetSequences(ctx context.Context, tx *sql.Tx, d Database) error {
+	if d.Driver != dialect.Postgres {
+		return nil
+	}
+
+	for _, t := range d.Tables {
+		if len(t.PrimaryKey) != 1 || !t.PrimaryKey[0].Increment {
+			continue
+		}
+
+		b := entsql.Dialect(d.Driver).Select()
+		table, column := b.Quote(t.Name), t.PrimaryKey[0].Name
+		query := fmt.Sprintf(
+			"SELECT setval(pg_get_serial_sequence($1, $2), COALESCE(MAX(%[1]s), 1), MAX(%[1]s) IS NOT NULL) FROM %[2]s",
+			b.Quote(column),
+			table,
+		)
+		if _, err := tx.ExecContext(ctx, query, table, column); err != nil {
+			return fmt.Errorf("table %q: %v", t.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package datatypes
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/db"
+)
+
+// APIToken represents an Enduro API token in the data store.
+type APIToken struct {
+	ID   int
+	UUID uuid.UUID
+	Name string
+
+	// TokenHash is the hex encoded SHA-256 hash of the token secret. The secret
+	// itself is never persisted.
+	TokenHash string
+
+	// Attributes are the access control attributes granted to the API token.
+	// A nil value means access control is disabled for the token.
+	Attributes []string
+
+	// CreatedAt is an optional field. If the value is zero when creating a new
+	// API token, CreatedAt will be set to the current time.
+	CreatedAt time.Time
+
+	// Optional datetimes, a zero value means not set.
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+
+	// Owner is the user that created the API token.
+	Owner *User
+}
+
+// Goa returns the API representation of the API token. The token secret is
+// not included because it's not persisted.
+func (t *APIToken) Goa() *goaingest.APIToken {
+	if t == nil {
+		return nil
+	}
+
+	return &goaingest.APIToken{
+		UUID:       t.UUID.String(),
+		Name:       t.Name,
+		Attributes: t.Attributes,
+		CreatedAt:  db.FormatTime(t.CreatedAt),
+		ExpiresAt:  db.FormatOptionalZeroTime(t.ExpiresAt),
+		LastUsedAt: db.FormatOptionalZeroTime(t.LastUsedAt),
+		RevokedAt:  db.FormatOptionalZeroTime(t.RevokedAt),
+	}
+}
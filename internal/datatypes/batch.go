@@ -21,6 +21,10 @@ type Batch struct {
 	StartedAt   time.Time
 	CompletedAt time.Time
 
+	// SIPSourceID is the identifier of the SIP source the Batch was added
+	// from, if any.
+	SIPSourceID uuid.NullUUID
+
 	// Uploader is the user that uploaded the Batch.
 	Uploader *User
 }
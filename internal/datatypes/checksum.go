@@ -1,10 +1,104 @@
 package datatypes
 
+import (
+	"crypto/md5"  // #nosec G501 -- MD5 is offered for depositor manifest compatibility.
+	"crypto/sha1" // #nosec G505 -- SHA-1 is offered for depositor manifest compatibility.
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+)
+
 type ChecksumAlgo string
 
-const ChecksumAlgoSHA256 ChecksumAlgo = "SHA-256"
+const (
+	ChecksumAlgoMD5    ChecksumAlgo = "MD5"
+	ChecksumAlgoSHA1   ChecksumAlgo = "SHA-1"
+	ChecksumAlgoSHA256 ChecksumAlgo = "SHA-256"
+	ChecksumAlgoSHA512 ChecksumAlgo = "SHA-512"
+)
+
+// ChecksumAlgos lists the supported checksum algorithms, from strongest to
+// weakest.
+var ChecksumAlgos = []ChecksumAlgo{
+	ChecksumAlgoSHA512,
+	ChecksumAlgoSHA256,
+	ChecksumAlgoSHA1,
+	ChecksumAlgoMD5,
+}
+
+// ParseChecksumAlgo parses a checksum algorithm name. The name is case
+// insensitive and the dash is optional, e.g. "sha256" and "SHA-256" are both
+// valid.
+func ParseChecksumAlgo(s string) (ChecksumAlgo, error) {
+	name := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "-", "")
+	for _, a := range ChecksumAlgos {
+		if name == a.Short() {
+			return a, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported checksum algorithm: %q", s)
+}
+
+// Short returns the lowercase name of the algorithm without dashes, e.g.
+// "sha256", as used in checksum file extensions.
+func (a ChecksumAlgo) Short() string {
+	return strings.ReplaceAll(strings.ToLower(string(a)), "-", "")
+}
+
+// New returns a new hash.Hash computing the checksum algorithm, or nil if the
+// algorithm is not supported.
+func (a ChecksumAlgo) New() hash.Hash {
+	switch a {
+	case ChecksumAlgoMD5:
+		return md5.New() // #nosec G401 -- See import comment.
+	case ChecksumAlgoSHA1:
+		return sha1.New() // #nosec G401 -- See import comment.
+	case ChecksumAlgoSHA256:
+		return sha256.New()
+	case ChecksumAlgoSHA512:
+		return sha512.New()
+	default:
+		return nil
+	}
+}
 
 type Checksum struct {
 	Algorithm ChecksumAlgo
 	Hash      string
 }
+
+// ParseChecksum parses a checksum in the "<algorithm>:<hash>" form, e.g.
+// "sha256:9f86d0...". If the algorithm prefix is omitted, defaultAlgo is used.
+// The hash must be a hex string of the right length for the algorithm.
+func ParseChecksum(s string, defaultAlgo ChecksumAlgo) (Checksum, error) {
+	algo := defaultAlgo
+	value := strings.TrimSpace(s)
+	if name, h, ok := strings.Cut(value, ":"); ok {
+		a, err := ParseChecksumAlgo(name)
+		if err != nil {
+			return Checksum{}, err
+		}
+		algo, value = a, strings.TrimSpace(h)
+	}
+
+	h := algo.New()
+	if h == nil {
+		return Checksum{}, fmt.Errorf("unsupported checksum algorithm: %q", algo)
+	}
+
+	b, err := hex.DecodeString(value)
+	if err != nil || len(b) != h.Size() {
+		return Checksum{}, fmt.Errorf("invalid %s hash: %q", algo, value)
+	}
+
+	return Checksum{Algorithm: algo, Hash: strings.ToLower(value)}, nil
+}
+
+// String returns the checksum in the "<algorithm>:<hash>" form.
+func (c Checksum) String() string {
+	return fmt.Sprintf("%s:%s", c.Algorithm.Short(), c.Hash)
+}
@@ -0,0 +1,92 @@
+package datatypes_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+)
+
+const sha256Hash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestParseChecksumAlgo(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		in      string
+		want    datatypes.ChecksumAlgo
+		wantErr string
+	}{
+		{in: "md5", want: datatypes.ChecksumAlgoMD5},
+		{in: "SHA-1", want: datatypes.ChecksumAlgoSHA1},
+		{in: "sha256", want: datatypes.ChecksumAlgoSHA256},
+		{in: " Sha-512 ", want: datatypes.ChecksumAlgoSHA512},
+		{in: "crc32", wantErr: `unsupported checksum algorithm: "crc32"`},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := datatypes.ParseChecksumAlgo(tt.in)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestParseChecksum(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name    string
+		in      string
+		want    datatypes.Checksum
+		wantErr string
+	}{
+		{
+			name: "Parses a checksum with an algorithm prefix",
+			in:   "md5:098F6BCD4621D373CADE4E832627B4F6",
+			want: datatypes.Checksum{
+				Algorithm: datatypes.ChecksumAlgoMD5,
+				Hash:      "098f6bcd4621d373cade4e832627b4f6",
+			},
+		},
+		{
+			name: "Uses the default algorithm without a prefix",
+			in:   sha256Hash,
+			want: datatypes.Checksum{Algorithm: datatypes.ChecksumAlgoSHA256, Hash: sha256Hash},
+		},
+		{
+			name:    "Errors on an unsupported algorithm",
+			in:      "crc32:d87f7e0c",
+			wantErr: `unsupported checksum algorithm: "crc32"`,
+		},
+		{
+			name:    "Errors on a hash with the wrong length",
+			in:      "sha1:" + sha256Hash,
+			wantErr: `invalid SHA-1 hash: "` + sha256Hash + `"`,
+		},
+		{
+			name:    "Errors on a non-hex hash",
+			in:      "not-a-hash",
+			wantErr: `invalid SHA-256 hash: "not-a-hash"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := datatypes.ParseChecksum(tt.in, datatypes.ChecksumAlgoSHA256)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+			assert.Equal(t, got.String(), tt.want.Algorithm.Short()+":"+tt.want.Hash)
+		})
+	}
+}
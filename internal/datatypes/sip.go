@@ -1,6 +1,7 @@
 package datatypes
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -39,6 +40,10 @@ type SIP struct {
 	// Batch is the batch this SIP belongs to.
 	Batch *Batch
 
+	// SIPSourceID is the identifier of the SIP source the SIP was added from,
+	// if any.
+	SIPSourceID uuid.NullUUID
+
 	// FileCount is the number of files in the SIP.
 	FileCount int32
 
@@ -49,6 +54,25 @@ type SIP struct {
 	// ChecksumHash is the hash of a SIP archive (e.g. zip file) before it is
 	// extracted for processing.
 	ChecksumHash string
+
+	// ProcessingProfile is the name of the processing profile selected for
+	// the SIP, if any.
+	ProcessingProfile string
+
+	// LocationID is the identifier of the storage location requested for the
+	// AIP when the SIP was submitted, if any.
+	LocationID uuid.NullUUID
+
+	// ExpectedChecksum is the checksum of the SIP provided by the depositor,
+	// if any.
+	ExpectedChecksum *Checksum
+
+	// WatcherName is the name of the watcher that received the SIP, if any.
+	WatcherName string
+
+	// CustomMetadata is the opaque JSON metadata returned by the child
+	// workflows that processed the SIP.
+	CustomMetadata map[string]json.RawMessage
 }
 
 // Goa returns the API representation of the SIP.
@@ -91,6 +115,12 @@ func (s *SIP) Goa() *goaingest.SIP {
 	if s.FileCount > 0 {
 		col.FileCount = &s.FileCount
 	}
+	if len(s.CustomMetadata) > 0 {
+		col.CustomMetadata = make(map[string]any, len(s.CustomMetadata))
+		for k, v := range s.CustomMetadata {
+			col.CustomMetadata[k] = v
+		}
+	}
 
 	return &col
 }
@@ -1,7 +1,8 @@
 package db
 
 type Config struct {
-	// Driver specifies the database driver (e.g. "mysql" or "sqlite3").
+	// Driver specifies the database driver (e.g. "mysql", "postgres" or
+	// "sqlite3").
 	Driver string
 
 	// DSN (Data Source Name) specifies the database connection information.
@@ -9,12 +9,17 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"github.com/XSAM/otelsql"
 	"github.com/go-logr/logr"
 	mysqldriver "github.com/go-sql-driver/mysql"
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
 	"github.com/golang-migrate/migrate/v4/database/mysql"
+	pgxmigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
 	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/codes"
@@ -47,11 +52,16 @@ func Connect(ctx context.Context, tp trace.TracerProvider, driver, dsn string) (
 	switch driver {
 	case "mysql":
 		db, err = ConnectMySQL(tp, dsn)
+	case "postgres":
+		db, err = ConnectPostgres(tp, dsn)
 	case "sqlite3":
 		db, err = ConnectSQLite(tp, dsn)
 	default:
 		return nil, fmt.Errorf("unsupported database driver: %q", driver)
 	}
+	if err != nil {
+		return nil, err
+	}
 
 	// Verify connection.
 	ctx, span := tp.Tracer("db.verify").Start(ctx, "sql.ping")
@@ -116,6 +126,47 @@ func ConnectMySQL(tp trace.TracerProvider, dsn string) (db *sql.DB, err error) {
 	return db, nil
 }
 
+// ConnectPostgres returns a PostgreSQL database handler which is safe for
+// concurrent access.
+func ConnectPostgres(tp trace.TracerProvider, dsn string) (db *sql.DB, err error) {
+	config, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing dsn: %w", err)
+	}
+	config.RuntimeParams["timezone"] = "UTC"
+
+	db = otelsql.OpenDB(stdlib.GetConnector(*config),
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithTracerProvider(tp),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			Ping:           true,
+			DisableErrSkip: true,
+		}),
+	)
+
+	// Set reasonable defaults in the built-in pool.
+	db.SetMaxOpenConns(100)
+	db.SetMaxIdleConns(10)
+	db.SetConnMaxLifetime(time.Hour)
+
+	// Register Prometheus collector.
+	c := prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: "src",
+			Subsystem: "postgres_app_" + config.Database,
+			Name:      "open_connections",
+			Help:      "Number of open connections to PostgreSQL DB, as reported by sql.DB.Stats()",
+		},
+		func() float64 {
+			s := db.Stats()
+			return float64(s.OpenConnections)
+		},
+	)
+	prometheus.MustRegister(c)
+
+	return db, nil
+}
+
 // ConnectSQLite returns a SQLlite database handler which is NOT safe for
 // concurrent access.
 func ConnectSQLite(tp trace.TracerProvider, dsn string) (db *sql.DB, err error) {
@@ -149,27 +200,27 @@ func ConnectSQLite(tp trace.TracerProvider, dsn string) (db *sql.DB, err error)
 	return db, err
 }
 
-func MigrateEnduroDatabase(logger logr.Logger, db *sql.DB) error {
-	src, err := enduroSourceDriver()
+func MigrateEnduroDatabase(logger logr.Logger, driver string, db *sql.DB) error {
+	src, err := enduroSourceDriver(driver)
 	if err != nil {
 		return fmt.Errorf("error loading migration sources: %v", err)
 	}
 
-	return up(logger, db, src)
+	return up(logger, driver, db, src)
 }
 
-func MigrateEnduroStorageDatabase(logger logr.Logger, db *sql.DB) error {
-	src, err := persistence.SourceDriver()
+func MigrateEnduroStorageDatabase(logger logr.Logger, driver string, db *sql.DB) error {
+	src, err := persistence.SourceDriver(driver)
 	if err != nil {
 		return fmt.Errorf("error loading migration sources: %v", err)
 	}
 
-	return up(logger, db, src)
+	return up(logger, driver, db, src)
 }
 
 // up migrates the database.
-func up(logger logr.Logger, db *sql.DB, src source.Driver) error {
-	m, err := newMigrate(logger, db, src)
+func up(logger logr.Logger, driver string, db *sql.DB, src source.Driver) error {
+	m, err := newMigrate(logger, driver, db, src)
 	if err != nil {
 		return fmt.Errorf("error creating golang-migrate object: %v", err)
 	}
@@ -182,13 +233,21 @@ func up(logger logr.Logger, db *sql.DB, src source.Driver) error {
 }
 
 // newMigrate builds the golang-migrate object.
-func newMigrate(logger logr.Logger, db *sql.DB, src source.Driver) (*migrate.Migrate, error) {
-	driver, err := mysql.WithInstance(db, &mysql.Config{})
+func newMigrate(logger logr.Logger, driver string, db *sql.DB, src source.Driver) (*migrate.Migrate, error) {
+	var (
+		dbDriver database.Driver
+		err      error
+	)
+	if driver == dialect.Postgres {
+		dbDriver, err = pgxmigrate.WithInstance(db, &pgxmigrate.Config{})
+	} else {
+		dbDriver, err = mysql.WithInstance(db, &mysql.Config{})
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error creating migrate driver: %w", err)
 	}
 
-	m, err := migrate.NewWithInstance("source", src, "driver", driver)
+	m, err := migrate.NewWithInstance("source", src, "driver", dbDriver)
 	if err != nil {
 		return nil, fmt.Errorf("error creating migrate instance: %w", err)
 	}
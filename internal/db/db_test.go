@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"os"
 	"testing"
 
@@ -9,6 +10,8 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	"go.opentelemetry.io/otel/trace/noop"
 	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/db/dbtest"
 )
 
 func TestConnect(t *testing.T) {
@@ -45,6 +48,20 @@ CREATE TABLE IF NOT EXISTS test (
 		assert.Equal(t, id, 1)
 		assert.Equal(t, name, "Foo Bar")
 	})
+
+	t.Run("Fails to parse a PostgreSQL DSN", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Connect(context.Background(), noop.NewTracerProvider(), "postgres", "postgres://localhost:invalid")
+		assert.ErrorContains(t, err, "error parsing dsn")
+	})
+
+	t.Run("Fails with an unsupported driver", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Connect(context.Background(), noop.NewTracerProvider(), "oracle", "")
+		assert.Error(t, err, `unsupported database driver: "oracle"`)
+	})
 }
 
 func TestMigrateEnduroDatabase(t *testing.T) {
@@ -61,11 +78,29 @@ func TestMigrateEnduroDatabase(t *testing.T) {
 			os.Remove(dbfile)
 		}()
 
-		err = MigrateEnduroDatabase(logr.Discard(), db)
+		err = MigrateEnduroDatabase(logr.Discard(), "sqlite3", db)
 		assert.Error(
 			t,
 			err,
 			"error creating golang-migrate object: error creating migrate driver: no such function: DATABASE in line 0: SELECT DATABASE()",
 		)
 	})
+
+	t.Run("Migrates PostgreSQL databases", func(t *testing.T) {
+		t.Parallel()
+
+		for _, migrate := range []func(logr.Logger, string, *sql.DB) error{
+			MigrateEnduroDatabase,
+			MigrateEnduroStorageDatabase,
+		} {
+			db, ok := dbtest.Postgres(t)
+			if !ok {
+				t.Skipf("%s is not set", dbtest.PostgresDSNEnv)
+			}
+
+			assert.NilError(t, migrate(logr.Discard(), "postgres", db))
+			// Running the migrations again is a no-op.
+			assert.NilError(t, migrate(logr.Discard(), "postgres", db))
+		}
+	})
 }
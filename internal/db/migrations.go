@@ -3,13 +3,21 @@ package db
 import (
 	"embed"
 
+	"entgo.io/ent/dialect"
 	"github.com/golang-migrate/migrate/v4/source"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 )
 
-//go:embed migrations/*.sql
+//go:embed migrations/*.sql migrations/postgres/*.sql
 var fs embed.FS
 
-func enduroSourceDriver() (source.Driver, error) {
+// enduroSourceDriver returns the migration sources of the given database
+// driver. PostgreSQL has its own set of migrations, all other drivers use the
+// MySQL migrations.
+func enduroSourceDriver(driver string) (source.Driver, error) {
+	if driver == dialect.Postgres {
+		return iofs.New(fs, "migrations/postgres")
+	}
+
 	return iofs.New(fs, "migrations")
 }
@@ -0,0 +1,2 @@
+-- Modify "sip" table
+ALTER TABLE `sip` ADD COLUMN `processing_profile` varchar(255) NULL;
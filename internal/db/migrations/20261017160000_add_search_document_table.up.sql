@@ -0,0 +1,4 @@
+-- Create "search_document" table
+CREATE TABLE `search_document` (`id` bigint NOT NULL AUTO_INCREMENT, `uuid` char(36) NOT NULL, `name` varchar(2048) NOT NULL, `content` longtext NOT NULL, `fields` json NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), FULLTEXT INDEX `search_document_fulltext_idx` (`name`, `content`), UNIQUE INDEX `uuid` (`uuid`)) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- Index existing SIPs
+INSERT INTO `search_document` (`uuid`, `name`, `content`, `fields`, `updated_at`) SELECT `sip`.`uuid`, `sip`.`name`, COALESCE(`batch`.`identifier`, ''), IF(`batch`.`identifier` IS NULL, NULL, JSON_OBJECT('batch', `batch`.`identifier`)), CURRENT_TIMESTAMP FROM `sip` LEFT JOIN `batch` ON `batch`.`id` = `sip`.`batch_id`;
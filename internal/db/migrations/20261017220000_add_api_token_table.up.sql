@@ -0,0 +1,2 @@
+-- Create "api_token" table
+CREATE TABLE `api_token` (`id` bigint NOT NULL AUTO_INCREMENT, `uuid` char(36) NOT NULL, `name` varchar(255) NOT NULL, `token_hash` varchar(64) NOT NULL, `attributes` json NULL, `created_at` timestamp NOT NULL, `expires_at` timestamp NULL, `last_used_at` timestamp NULL, `revoked_at` timestamp NULL, `owner_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `api_token_owner_id_idx` (`owner_id`), UNIQUE INDEX `token_hash` (`token_hash`), UNIQUE INDEX `uuid` (`uuid`), CONSTRAINT `api_token_user_api_tokens` FOREIGN KEY (`owner_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,4 @@
+-- Modify "batch" table
+ALTER TABLE `batch` ADD COLUMN `sip_source_id` char(36) NULL, ADD INDEX `batch_sip_source_id_idx` (`sip_source_id`);
+-- Modify "sip" table
+ALTER TABLE `sip` ADD COLUMN `sip_source_id` char(36) NULL, ADD INDEX `sip_sip_source_id_idx` (`sip_source_id`);
@@ -0,0 +1,2 @@
+-- Modify "sip" table
+ALTER TABLE `sip` ADD COLUMN `expected_checksum` varchar(255) NULL;
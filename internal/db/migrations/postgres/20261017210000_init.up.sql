@@ -0,0 +1,132 @@
+-- enable trigram matching, used by the search document index
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+-- create "search_document" table
+CREATE TABLE "search_document" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "content" text NOT NULL,
+  "fields" jsonb NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "search_document_uuid_key" to table: "search_document"
+CREATE UNIQUE INDEX "search_document_uuid_key" ON "search_document" ("uuid");
+-- create index "search_document_fulltext_idx" to table: "search_document"
+CREATE INDEX "search_document_fulltext_idx" ON "search_document" USING GIN ("name" gin_trgm_ops, "content" gin_trgm_ops);
+-- create "user" table
+CREATE TABLE "user" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "email" character varying NULL,
+  "name" character varying NULL,
+  "oidc_iss" character varying NULL,
+  "oidc_sub" character varying NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "user_uuid_key" to table: "user"
+CREATE UNIQUE INDEX "user_uuid_key" ON "user" ("uuid");
+-- create index "user_oidc_iss_idx" to table: "user"
+CREATE INDEX "user_oidc_iss_idx" ON "user" ("oidc_iss");
+-- create index "user_oidc_sub_idx" to table: "user"
+CREATE INDEX "user_oidc_sub_idx" ON "user" ("oidc_sub");
+-- create index "user_oidc_iss_sub_unique_idx" to table: "user"
+CREATE UNIQUE INDEX "user_oidc_iss_sub_unique_idx" ON "user" ("oidc_iss", "oidc_sub");
+-- create "batch" table
+CREATE TABLE "batch" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "identifier" character varying NOT NULL,
+  "status" character varying NOT NULL,
+  "sips_count" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "uploader_id" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "batch_user_uploaded_batches" FOREIGN KEY ("uploader_id") REFERENCES "user" ("id") ON DELETE SET NULL
+);
+-- create index "batch_uuid_key" to table: "batch"
+CREATE UNIQUE INDEX "batch_uuid_key" ON "batch" ("uuid");
+-- create index "batch_identifier_idx" to table: "batch"
+CREATE INDEX "batch_identifier_idx" ON "batch" ("identifier");
+-- create index "batch_status_idx" to table: "batch"
+CREATE INDEX "batch_status_idx" ON "batch" ("status");
+-- create index "batch_created_at_idx" to table: "batch"
+CREATE INDEX "batch_created_at_idx" ON "batch" ("created_at");
+-- create index "batch_started_at_idx" to table: "batch"
+CREATE INDEX "batch_started_at_idx" ON "batch" ("started_at");
+-- create index "batch_uploader_id_idx" to table: "batch"
+CREATE INDEX "batch_uploader_id_idx" ON "batch" ("uploader_id");
+-- create "sip" table
+CREATE TABLE "sip" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "aip_id" uuid NULL,
+  "status" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "failed_as" character varying NULL,
+  "failed_key" character varying NULL,
+  "file_count" integer NULL,
+  "checksum_algorithm" character varying NULL,
+  "checksum_hash" character varying NULL,
+  "processing_profile" character varying NULL,
+  "custom_metadata" jsonb NULL,
+  "batch_id" bigint NULL,
+  "uploader_id" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sip_batch_sips" FOREIGN KEY ("batch_id") REFERENCES "batch" ("id") ON DELETE SET NULL,
+  CONSTRAINT "sip_user_uploaded_sips" FOREIGN KEY ("uploader_id") REFERENCES "user" ("id") ON DELETE SET NULL
+);
+-- create index "sip_uuid_key" to table: "sip"
+CREATE UNIQUE INDEX "sip_uuid_key" ON "sip" ("uuid");
+-- create index "sip_name_idx" to table: "sip"
+CREATE INDEX "sip_name_idx" ON "sip" ("name");
+-- create index "sip_aip_id_idx" to table: "sip"
+CREATE INDEX "sip_aip_id_idx" ON "sip" ("aip_id");
+-- create index "sip_status_idx" to table: "sip"
+CREATE INDEX "sip_status_idx" ON "sip" ("status");
+-- create index "sip_created_at_idx" to table: "sip"
+CREATE INDEX "sip_created_at_idx" ON "sip" ("created_at");
+-- create index "sip_started_at_idx" to table: "sip"
+CREATE INDEX "sip_started_at_idx" ON "sip" ("started_at");
+-- create index "sip_uploader_id_idx" to table: "sip"
+CREATE INDEX "sip_uploader_id_idx" ON "sip" ("uploader_id");
+-- create index "sip_batch_id_idx" to table: "sip"
+CREATE INDEX "sip_batch_id_idx" ON "sip" ("batch_id");
+-- create index "sip_checksum_idx" to table: "sip"
+CREATE INDEX "sip_checksum_idx" ON "sip" ("checksum_algorithm", "checksum_hash");
+-- create "workflow" table
+CREATE TABLE "workflow" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "temporal_id" character varying NOT NULL,
+  "type" character varying NOT NULL,
+  "status" smallint NOT NULL,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "sip_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "workflow_sip_workflows" FOREIGN KEY ("sip_id") REFERENCES "sip" ("id") ON DELETE CASCADE
+);
+-- create index "workflow_uuid_key" to table: "workflow"
+CREATE UNIQUE INDEX "workflow_uuid_key" ON "workflow" ("uuid");
+-- create "task" table
+CREATE TABLE "task" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "status" smallint NOT NULL,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "note" text NOT NULL,
+  "workflow_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "task_workflow_tasks" FOREIGN KEY ("workflow_id") REFERENCES "workflow" ("id") ON DELETE CASCADE
+);
+-- create index "task_uuid_key" to table: "task"
+CREATE UNIQUE INDEX "task_uuid_key" ON "task" ("uuid");
@@ -0,0 +1,21 @@
+-- create "api_token" table
+CREATE TABLE "api_token" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "token_hash" character varying NOT NULL,
+  "attributes" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NULL,
+  "last_used_at" timestamptz NULL,
+  "revoked_at" timestamptz NULL,
+  "owner_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "api_token_user_api_tokens" FOREIGN KEY ("owner_id") REFERENCES "user" ("id") ON DELETE CASCADE
+);
+-- create index "api_token_uuid_key" to table: "api_token"
+CREATE UNIQUE INDEX "api_token_uuid_key" ON "api_token" ("uuid");
+-- create index "api_token_token_hash_key" to table: "api_token"
+CREATE UNIQUE INDEX "api_token_token_hash_key" ON "api_token" ("token_hash");
+-- create index "api_token_owner_id_idx" to table: "api_token"
+CREATE INDEX "api_token_owner_id_idx" ON "api_token" ("owner_id");
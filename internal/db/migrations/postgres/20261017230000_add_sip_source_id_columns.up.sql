@@ -0,0 +1,8 @@
+-- modify "batch" table
+ALTER TABLE "batch" ADD COLUMN "sip_source_id" uuid NULL;
+-- create index "batch_sip_source_id_idx" to table: "batch"
+CREATE INDEX "batch_sip_source_id_idx" ON "batch" ("sip_source_id");
+-- modify "sip" table
+ALTER TABLE "sip" ADD COLUMN "sip_source_id" uuid NULL;
+-- create index "sip_sip_source_id_idx" to table: "sip"
+CREATE INDEX "sip_sip_source_id_idx" ON "sip" ("sip_source_id");
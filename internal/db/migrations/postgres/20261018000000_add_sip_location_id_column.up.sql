@@ -0,0 +1,2 @@
+-- modify "sip" table
+ALTER TABLE "sip" ADD COLUMN "location_id" uuid NULL;
@@ -0,0 +1,2 @@
+-- modify "sip" table
+ALTER TABLE "sip" ADD COLUMN "expected_checksum" character varying NULL;
@@ -0,0 +1,2 @@
+-- modify "sip" table
+ALTER TABLE "sip" ADD COLUMN "watcher_name" character varying NULL;
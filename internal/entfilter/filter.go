@@ -3,6 +3,7 @@ package entfilter
 import (
 	"slices"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"golang.org/x/exp/maps"
@@ -210,7 +211,7 @@ func (f *Filter[Q, O, P]) Contains(column string, value *string) {
 	}
 
 	f.addFilter(column, func(s *sql.Selector) {
-		s.Where(sql.Contains(s.C(column), *value))
+		s.Where(contains(s, column, *value))
 	})
 }
 
@@ -224,13 +225,31 @@ func (f *Filter[Q, O, P]) ContainsAny(columns []string, value *string) {
 	f.addFilter("", func(s *sql.Selector) {
 		predicates := make([]*sql.Predicate, 0, len(columns))
 		for _, column := range columns {
-			predicates = append(predicates, sql.Contains(s.C(column), *value))
+			predicates = append(predicates, contains(s, column, *value))
 		}
 
 		s.Where(sql.Or(predicates...))
 	})
 }
 
+// contains returns a predicate matching the rows where column contains value.
+// MySQL and SQLite use a case insensitive LIKE comparison. PostgreSQL LIKE is
+// case sensitive and not supported by non text columns (e.g. UUIDs), so the
+// column is cast to text and both sides are lowercased instead.
+func contains(s *sql.Selector, column, value string) *sql.Predicate {
+	if s.Dialect() != dialect.Postgres {
+		return sql.Contains(s.C(column), value)
+	}
+
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString("POSITION(LOWER(").
+			Arg(value).
+			WriteString(") IN LOWER(CAST(").
+			Ident(s.C(column)).
+			WriteString(" AS TEXT))) > 0")
+	})
+}
+
 // Equals adds a filter on column being equal to value. If value implements the
 // validator interface, value is validated before the filter is added.
 func (f *Filter[Q, O, P]) Equals(column string, value any) {
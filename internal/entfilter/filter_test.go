@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
@@ -21,16 +22,17 @@ type orderOpt func(*sql.Selector)
 
 // query is a querier like *db.SIPQuery for testing.
 type query struct {
-	table  string
-	limit  int
-	offset int
-	order  []string
-	where  string
-	args   []any
+	dialect string
+	table   string
+	limit   int
+	offset  int
+	order   []string
+	where   string
+	args    []any
 }
 
 func (q *query) Where(preds ...pred) *query {
-	sel := sql.Select().From(sql.Table(q.table))
+	sel := sql.Dialect(q.dialect).Select().From(sql.Table(q.table))
 	for _, pred := range preds {
 		pred(sel)
 	}
@@ -62,12 +64,13 @@ func (q *query) Order(fn ...orderOpt) *query {
 
 func (q query) Clone() *query {
 	return &query{
-		table:  q.table,
-		limit:  q.limit,
-		offset: q.offset,
-		order:  slices.Clone(q.order),
-		where:  q.where,
-		args:   slices.Clone(q.args),
+		dialect: q.dialect,
+		table:   q.table,
+		limit:   q.limit,
+		offset:  q.offset,
+		order:   slices.Clone(q.order),
+		where:   q.where,
+		args:    slices.Clone(q.args),
 	}
 }
 
@@ -390,6 +393,27 @@ func TestFilter(t *testing.T) {
 		assert.DeepEqual(t, whole.args, []any{"%Joe%", "%Joe%"})
 	})
 
+	t.Run("Adds a case insensitive contains filter in PostgreSQL", func(t *testing.T) {
+		t.Parallel()
+
+		f := entfilter.NewFilter(
+			&query{dialect: dialect.Postgres, table: "data"},
+			newSortableFields("id"),
+		)
+
+		name := "Joe"
+		f.ContainsAny([]string{"name", "aip_id"}, &name)
+		_, whole := f.Apply()
+
+		assert.Equal(
+			t,
+			whole.where,
+			`POSITION(LOWER($1) IN LOWER(CAST("data"."name" AS TEXT))) > 0 OR `+
+				`POSITION(LOWER($2) IN LOWER(CAST("data"."aip_id" AS TEXT))) > 0`,
+		)
+		assert.DeepEqual(t, whole.args, []any{"Joe", "Joe"})
+	})
+
 	t.Run("Adds an equality filter", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,66 @@
+package event
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// metricsService wraps an event service to record the number of active
+// subscriptions.
+type metricsService[T any] struct {
+	Service[T]
+	subscriptions metric.Int64UpDownCounter
+	attrs         metric.MeasurementOption
+}
+
+var _ Service[any] = (*metricsService[any])(nil)
+
+// WithMetrics returns svc wrapped to record its active subscriptions in the
+// "enduro.event.subscriptions" metric, using name as the service attribute.
+func WithMetrics[T any](svc Service[T], mp metric.MeterProvider, name string) Service[T] {
+	subscriptions, err := mp.Meter("github.com/artefactual-sdps/enduro/internal/event").Int64UpDownCounter(
+		"enduro.event.subscriptions",
+		metric.WithDescription("Number of active event subscriptions."),
+		metric.WithUnit("{subscription}"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return &metricsService[T]{
+		Service:       svc,
+		subscriptions: subscriptions,
+		attrs:         metric.WithAttributes(attribute.String("service", name)),
+	}
+}
+
+func (s *metricsService[T]) Subscribe(ctx context.Context) (Subscription[T], error) {
+	sub, err := s.Service.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.subscriptions.Add(ctx, 1, s.attrs)
+
+	return &metricsSubscription[T]{Subscription: sub, service: s}, nil
+}
+
+// metricsSubscription decrements the active subscriptions of its service once
+// it's closed.
+type metricsSubscription[T any] struct {
+	Subscription[T]
+	service *metricsService[T]
+	once    sync.Once
+}
+
+func (s *metricsSubscription[T]) Close() error {
+	s.once.Do(func() {
+		s.service.subscriptions.Add(context.Background(), -1, s.service.attrs)
+	})
+
+	return s.Subscription.Close()
+}
@@ -0,0 +1,65 @@
+package event
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelsdk_metric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"gotest.tools/v3/assert"
+)
+
+func TestWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	reader := otelsdk_metric.NewManualReader()
+	mp := otelsdk_metric.NewMeterProvider(otelsdk_metric.WithReader(reader))
+	svc := WithMetrics(NewServiceInMem[string](), mp, "ingest")
+
+	assertSubscriptions := func(want int64) {
+		t.Helper()
+
+		var rm metricdata.ResourceMetrics
+		assert.NilError(t, reader.Collect(ctx, &rm))
+		assert.Equal(t, len(rm.ScopeMetrics), 1)
+		metricdatatest.AssertEqual(
+			t,
+			metricdata.Metrics{
+				Name:        "enduro.event.subscriptions",
+				Description: "Number of active event subscriptions.",
+				Unit:        "{subscription}",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{
+							Attributes: attribute.NewSet(attribute.String("service", "ingest")),
+							Value:      want,
+						},
+					},
+				},
+			},
+			rm.ScopeMetrics[0].Metrics[0],
+			metricdatatest.IgnoreTimestamp(),
+		)
+	}
+
+	subA, err := svc.Subscribe(ctx)
+	assert.NilError(t, err)
+	subB, err := svc.Subscribe(ctx)
+	assert.NilError(t, err)
+	assertSubscriptions(2)
+
+	// Closing a subscription twice only decrements the counter once.
+	assert.NilError(t, subA.Close())
+	assert.NilError(t, subA.Close())
+	assertSubscriptions(1)
+
+	// Events are still published to the subscriptions.
+	svc.PublishEvent(ctx, "event")
+	assert.Equal(t, <-subB.C(), "event")
+
+	assert.NilError(t, subB.Close())
+	assertSubscriptions(0)
+}
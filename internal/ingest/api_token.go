@@ -0,0 +1,285 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+)
+
+// defaultAPITokenMaxLifetime is used when no API token maximum lifetime is
+// configured.
+const defaultAPITokenMaxLifetime = 90 * 24 * time.Hour
+
+// CreateAPIToken creates an API token for the current user. The API token is
+// granted the requested attributes, which must be a subset of the user
+// attributes, or all the user attributes if none are requested. Attributes
+// must be requested when access control is disabled for the user, so the API
+// token never bypasses it. The API token must expire within the configured
+// maximum lifetime. The token secret is only returned in this response.
+func (svc *ingestImpl) CreateAPIToken(
+	ctx context.Context,
+	payload *goaingest.CreateAPITokenPayload,
+) (*goaingest.APIToken, error) {
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		return nil, goaingest.MakeNotValid(err)
+	}
+	if claims == nil {
+		return nil, goaingest.MakeNotValid(errors.New("API tokens require authentication"))
+	}
+
+	// Don't allow a leaked API token to be used to create new API tokens.
+	if claims.APIToken {
+		return nil, ErrForbidden
+	}
+
+	if payload.Name == "" {
+		return nil, goaingest.MakeNotValid(errors.New("empty name"))
+	}
+
+	attrs := claims.Attributes
+	if payload.Attributes != nil {
+		for _, attr := range payload.Attributes {
+			if !claims.CheckAttributes([]string{attr}) {
+				return nil, goaingest.MakeNotValid(fmt.Errorf("attribute %q is not granted to the user", attr))
+			}
+		}
+		attrs = payload.Attributes
+	}
+	if attrs == nil {
+		return nil, goaingest.MakeNotValid(errors.New("attributes are required when access control is disabled"))
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, payload.ExpiresAt)
+	if err != nil {
+		return nil, goaingest.MakeNotValid(errors.New("invalid expires_at"))
+	}
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, goaingest.MakeNotValid(errors.New("expires_at must be in the future"))
+	}
+	if expiresAt.After(now.Add(svc.apiTokenMaxLifetime)) {
+		return nil, goaingest.MakeNotValid(
+			fmt.Errorf("expires_at exceeds the maximum API token lifetime of %s", svc.apiTokenMaxLifetime),
+		)
+	}
+
+	secret, hash, err := auth.NewAPITokenSecret(svc.rander)
+	if err != nil {
+		svc.logger.Error(err, "create API token")
+		return nil, ErrInternalError
+	}
+
+	t := &datatypes.APIToken{
+		UUID:       uuid.Must(uuid.NewRandomFromReader(svc.rander)),
+		Name:       payload.Name,
+		TokenHash:  hash,
+		Attributes: attrs,
+		ExpiresAt:  expiresAt,
+		Owner: &datatypes.User{
+			UUID:    uuid.Must(uuid.NewRandomFromReader(svc.rander)),
+			Email:   claims.Email,
+			Name:    claims.Name,
+			OIDCIss: claims.Iss,
+			OIDCSub: claims.Sub,
+		},
+	}
+	if err := svc.perSvc.CreateAPIToken(ctx, t); err != nil {
+		svc.logger.Error(err, "create API token")
+		return nil, ErrInternalError
+	}
+
+	svc.auditLogger.Log(ctx, apiTokenAuditEvent(t, "API token created", "APIToken.create", claims))
+
+	res := t.Goa()
+	res.Token = &secret
+
+	return res, nil
+}
+
+// ListAPITokens lists the API tokens of the current user.
+func (svc *ingestImpl) ListAPITokens(
+	ctx context.Context,
+	payload *goaingest.ListAPITokensPayload,
+) (*goaingest.APITokens, error) {
+	res := &goaingest.APITokens{Items: []*goaingest.APIToken{}}
+
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		svc.logger.Error(err, "list API tokens")
+		return nil, ErrInternalError
+	}
+	if claims == nil {
+		return res, nil
+	}
+
+	u, err := svc.perSvc.ReadOIDCUser(ctx, claims.Iss, claims.Sub)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return res, nil
+	} else if err != nil {
+		svc.logger.Error(err, "list API tokens: read user")
+		return nil, ErrInternalError
+	}
+
+	tokens, err := svc.perSvc.ListAPITokens(ctx, u.UUID)
+	if err != nil {
+		svc.logger.Error(err, "list API tokens")
+		return nil, ErrInternalError
+	}
+
+	for _, t := range tokens {
+		res.Items = append(res.Items, t.Goa())
+	}
+
+	return res, nil
+}
+
+// RevokeAPIToken revokes an API token of the current user. Revoked API tokens
+// are kept to show their details but they can't be used anymore.
+func (svc *ingestImpl) RevokeAPIToken(ctx context.Context, payload *goaingest.RevokeAPITokenPayload) error {
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		return goaingest.MakeNotValid(err)
+	}
+	if claims == nil {
+		return goaingest.MakeNotValid(errors.New("API tokens require authentication"))
+	}
+
+	id, err := uuid.Parse(payload.UUID)
+	if err != nil {
+		return goaingest.MakeNotValid(errors.New("invalid UUID"))
+	}
+
+	// API tokens of other users are reported as not found.
+	t, err := svc.perSvc.ReadAPIToken(ctx, id)
+	if errors.Is(err, persistence.ErrNotFound) || (err == nil && !ownsAPIToken(claims, t)) {
+		return goaingest.MakeNotFound(errors.New("API token not found"))
+	} else if err != nil {
+		svc.logger.Error(err, "revoke API token: read API token", "api_token_uuid", id)
+		return ErrInternalError
+	}
+
+	if !t.RevokedAt.IsZero() {
+		return goaingest.MakeNotValid(errors.New("API token is already revoked"))
+	}
+
+	t, err = svc.perSvc.UpdateAPIToken(ctx, id, func(t *datatypes.APIToken) (*datatypes.APIToken, error) {
+		t.RevokedAt = time.Now()
+		return t, nil
+	})
+	if err != nil {
+		svc.logger.Error(err, "revoke API token", "api_token_uuid", id)
+		return ErrInternalError
+	}
+
+	svc.auditLogger.Log(ctx, apiTokenAuditEvent(t, "API token revoked", "APIToken.revoke", claims))
+
+	return nil
+}
+
+func ownsAPIToken(claims *auth.Claims, t *datatypes.APIToken) bool {
+	return t.Owner != nil && t.Owner.OIDCIss == claims.Iss && t.Owner.OIDCSub == claims.Sub
+}
+
+func apiTokenAuditEvent(t *datatypes.APIToken, msg, typ string, claims *auth.Claims) *auditlog.Event {
+	return &auditlog.Event{
+		Level:      auditlog.LevelInfo,
+		Msg:        msg,
+		Type:       typ,
+		ResourceID: t.UUID.String(),
+		User:       claims.Email,
+	}
+}
+
+type apiTokenStore struct {
+	perSvc persistence.Service
+}
+
+var _ auth.APITokenStore = (*apiTokenStore)(nil)
+
+// NewAPITokenStore returns an auth.APITokenStore that reads and updates the
+// API tokens using the ingest persistence service.
+func NewAPITokenStore(perSvc persistence.Service) *apiTokenStore {
+	return &apiTokenStore{perSvc: perSvc}
+}
+
+func (s *apiTokenStore) ReadAPIToken(ctx context.Context, hash string) (*auth.APIToken, error) {
+	t, err := s.perSvc.ReadAPITokenByHash(ctx, hash)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return nil, auth.ErrUnauthorized
+	} else if err != nil {
+		return nil, err
+	}
+	if t.Owner == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return authAPIToken(t), nil
+}
+
+func (s *apiTokenStore) SetAPITokenLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	_, err := s.perSvc.UpdateAPIToken(ctx, id, func(t *datatypes.APIToken) (*datatypes.APIToken, error) {
+		t.LastUsedAt = usedAt
+		return t, nil
+	})
+
+	return err
+}
+
+func (s *apiTokenStore) ListOwnerAPITokens(ctx context.Context, iss, sub string) ([]*auth.APIToken, error) {
+	u, err := s.perSvc.ReadOIDCUser(ctx, iss, sub)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.perSvc.ListAPITokens(ctx, u.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*auth.APIToken, 0, len(tokens))
+	for _, t := range tokens {
+		if t.Owner == nil {
+			continue
+		}
+		res = append(res, authAPIToken(t))
+	}
+
+	return res, nil
+}
+
+func (s *apiTokenStore) RevokeAPIToken(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	_, err := s.perSvc.UpdateAPIToken(ctx, id, func(t *datatypes.APIToken) (*datatypes.APIToken, error) {
+		t.RevokedAt = revokedAt
+		return t, nil
+	})
+
+	return err
+}
+
+func authAPIToken(t *datatypes.APIToken) *auth.APIToken {
+	return &auth.APIToken{
+		UUID:       t.UUID,
+		Attributes: t.Attributes,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		RevokedAt:  t.RevokedAt,
+		Owner: auth.Claims{
+			Email: t.Owner.Email,
+			Name:  t.Owner.Name,
+			Iss:   t.Owner.OIDCIss,
+			Sub:   t.Owner.OIDCSub,
+		},
+	}
+}
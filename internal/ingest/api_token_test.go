@@ -0,0 +1,449 @@
+package ingest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+)
+
+var (
+	apiTokenUUID = uuid.MustParse("0a4c3b5f-0d8a-4c8a-8f5e-6d7b3f1e2a90")
+	ownerUUID    = uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72")
+	userClaims   = &auth.Claims{
+		Email:      "nobody@example.com",
+		Name:       "Test User",
+		Iss:        "https://example.com/oidc",
+		Sub:        "1234567890",
+		Attributes: []string{"ingest:sips:*", "ingest:apitokens:create"},
+	}
+)
+
+func TestCreateAPIToken(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	for _, tt := range []struct {
+		name      string
+		claims    *auth.Claims
+		payload   *goaingest.CreateAPITokenPayload
+		mock      func(*persistence_fake.MockService)
+		wantAttrs []string
+		wantErr   string
+	}{
+		{
+			name:      "Creates an API token with the user attributes",
+			claims:    userClaims,
+			payload:   &goaingest.CreateAPITokenPayload{Name: "Scripted ingest", ExpiresAt: expiresAt},
+			wantAttrs: []string{"ingest:sips:*", "ingest:apitokens:create"},
+			mock: func(psvc *persistence_fake.MockService) {
+				psvc.EXPECT().
+					CreateAPIToken(mockutil.Context(), mockutil.Func(
+						"should set the token hash and owner",
+						func(token *datatypes.APIToken) error {
+							if len(token.TokenHash) != 64 {
+								return fmt.Errorf("unexpected token hash: %q", token.TokenHash)
+							}
+							if token.Owner == nil || token.Owner.OIDCSub != "1234567890" {
+								return errors.New("unexpected owner")
+							}
+							return nil
+						},
+					)).
+					DoAndReturn(func(ctx context.Context, token *datatypes.APIToken) error {
+						token.CreatedAt = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+						return nil
+					})
+			},
+		},
+		{
+			name:   "Creates an API token with a subset of the user attributes",
+			claims: userClaims,
+			payload: &goaingest.CreateAPITokenPayload{
+				Name:       "Scripted ingest",
+				ExpiresAt:  expiresAt,
+				Attributes: []string{"ingest:sips:create"},
+			},
+			wantAttrs: []string{"ingest:sips:create"},
+			mock: func(psvc *persistence_fake.MockService) {
+				psvc.EXPECT().CreateAPIToken(mockutil.Context(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Creates an API token with the requested attributes when access control is disabled",
+			claims: &auth.Claims{
+				Iss: "https://example.com/oidc",
+				Sub: "1234567890",
+			},
+			payload: &goaingest.CreateAPITokenPayload{
+				Name:       "Scripted ingest",
+				ExpiresAt:  expiresAt,
+				Attributes: []string{"ingest:sips:create"},
+			},
+			wantAttrs: []string{"ingest:sips:create"},
+			mock: func(psvc *persistence_fake.MockService) {
+				psvc.EXPECT().CreateAPIToken(mockutil.Context(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "Fails when authentication is disabled",
+			payload: &goaingest.CreateAPITokenPayload{Name: "Scripted ingest", ExpiresAt: expiresAt},
+			wantErr: "API tokens require authentication",
+		},
+		{
+			name: "Fails when using an API token",
+			claims: &auth.Claims{
+				Iss:      "https://example.com/oidc",
+				Sub:      "1234567890",
+				APIToken: true,
+			},
+			payload: &goaingest.CreateAPITokenPayload{Name: "Scripted ingest", ExpiresAt: expiresAt},
+			wantErr: "Forbidden",
+		},
+		{
+			name:   "Fails when an attribute is not granted to the user",
+			claims: userClaims,
+			payload: &goaingest.CreateAPITokenPayload{
+				Name:       "Scripted ingest",
+				ExpiresAt:  expiresAt,
+				Attributes: []string{"storage:aips:move"},
+			},
+			wantErr: `attribute "storage:aips:move" is not granted to the user`,
+		},
+		{
+			name:   "Fails when the expiration is in the past",
+			claims: userClaims,
+			payload: &goaingest.CreateAPITokenPayload{
+				Name:      "Scripted ingest",
+				ExpiresAt: "2020-01-01T00:00:00Z",
+			},
+			wantErr: "expires_at must be in the future",
+		},
+		{
+			name:   "Fails when the expiration exceeds the maximum lifetime",
+			claims: userClaims,
+			payload: &goaingest.CreateAPITokenPayload{
+				Name:      "Scripted ingest",
+				ExpiresAt: time.Now().Add(100 * 24 * time.Hour).UTC().Format(time.RFC3339),
+			},
+			wantErr: "expires_at exceeds the maximum API token lifetime of 2160h0m0s",
+		},
+		{
+			name:   "Fails when the expiration is invalid",
+			claims: userClaims,
+			payload: &goaingest.CreateAPITokenPayload{
+				Name:      "Scripted ingest",
+				ExpiresAt: "tomorrow",
+			},
+			wantErr: "invalid expires_at",
+		},
+		{
+			name: "Fails when access control is disabled and no attributes are requested",
+			claims: &auth.Claims{
+				Iss: "https://example.com/oidc",
+				Sub: "1234567890",
+			},
+			payload: &goaingest.CreateAPITokenPayload{Name: "Scripted ingest", ExpiresAt: expiresAt},
+			wantErr: "attributes are required when access control is disabled",
+		},
+		{
+			name:    "Fails when the API token can't be persisted",
+			claims:  userClaims,
+			payload: &goaingest.CreateAPITokenPayload{Name: "Scripted ingest", ExpiresAt: expiresAt},
+			mock: func(psvc *persistence_fake.MockService) {
+				psvc.EXPECT().CreateAPIToken(mockutil.Context(), gomock.Any()).Return(persistence.ErrInternal)
+			},
+			wantErr: "internal error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, psvc, _ := testSvc(t, nil, 0)
+			if tt.mock != nil {
+				tt.mock(psvc)
+			}
+
+			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+
+			got, err := svc.CreateAPIToken(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, got.Name, "Scripted ingest")
+			assert.DeepEqual(t, got.Attributes, tt.wantAttrs)
+			assert.Assert(t, got.Token != nil && strings.HasPrefix(*got.Token, auth.APITokenPrefix))
+		})
+	}
+}
+
+func TestListAPITokens(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Lists the API tokens of the user", func(t *testing.T) {
+		t.Parallel()
+
+		svc, psvc, _ := testSvc(t, nil, 0)
+		psvc.EXPECT().
+			ReadOIDCUser(mockutil.Context(), "https://example.com/oidc", "1234567890").
+			Return(&datatypes.User{UUID: ownerUUID}, nil)
+		psvc.EXPECT().
+			ListAPITokens(mockutil.Context(), ownerUUID).
+			Return([]*datatypes.APIToken{
+				{
+					UUID:      apiTokenUUID,
+					Name:      "Scripted ingest",
+					TokenHash: "hash",
+					CreatedAt: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+				},
+			}, nil)
+
+		got, err := svc.ListAPITokens(auth.WithUserClaims(t.Context(), userClaims), &goaingest.ListAPITokensPayload{})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, &goaingest.APITokens{
+			Items: []*goaingest.APIToken{
+				{
+					UUID:      apiTokenUUID.String(),
+					Name:      "Scripted ingest",
+					CreatedAt: "2026-10-17T12:00:00Z",
+				},
+			},
+		})
+	})
+
+	t.Run("Returns an empty list for unknown users", func(t *testing.T) {
+		t.Parallel()
+
+		svc, psvc, _ := testSvc(t, nil, 0)
+		psvc.EXPECT().
+			ReadOIDCUser(mockutil.Context(), "https://example.com/oidc", "1234567890").
+			Return(nil, persistence.ErrNotFound)
+
+		got, err := svc.ListAPITokens(auth.WithUserClaims(t.Context(), userClaims), &goaingest.ListAPITokensPayload{})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, &goaingest.APITokens{Items: []*goaingest.APIToken{}})
+	})
+}
+
+func TestRevokeAPIToken(t *testing.T) {
+	t.Parallel()
+
+	owned := func() *datatypes.APIToken {
+		return &datatypes.APIToken{
+			UUID: apiTokenUUID,
+			Name: "Scripted ingest",
+			Owner: &datatypes.User{
+				UUID:    ownerUUID,
+				OIDCIss: "https://example.com/oidc",
+				OIDCSub: "1234567890",
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name    string
+		mock    func(*persistence_fake.MockService)
+		wantErr string
+	}{
+		{
+			name: "Revokes an API token",
+			mock: func(psvc *persistence_fake.MockService) {
+				psvc.EXPECT().ReadAPIToken(mockutil.Context(), apiTokenUUID).Return(owned(), nil)
+				psvc.EXPECT().
+					UpdateAPIToken(mockutil.Context(), apiTokenUUID, mockutil.Func(
+						"should set the revocation datetime",
+						func(upd persistence.APITokenUpdater) error {
+							token, err := upd(owned())
+							if err != nil {
+								return err
+							}
+							if token.RevokedAt.IsZero() {
+								return errors.New("revocation datetime not set")
+							}
+							return nil
+						},
+					)).
+					Return(owned(), nil)
+			},
+		},
+		{
+			name: "Fails when the API token doesn't exist",
+			mock: func(psvc *persistence_fake.MockService) {
+				psvc.EXPECT().ReadAPIToken(mockutil.Context(), apiTokenUUID).Return(nil, persistence.ErrNotFound)
+			},
+			wantErr: "API token not found",
+		},
+		{
+			name: "Fails when the API token belongs to another user",
+			mock: func(psvc *persistence_fake.MockService) {
+				token := owned()
+				token.Owner.OIDCSub = "other"
+				psvc.EXPECT().ReadAPIToken(mockutil.Context(), apiTokenUUID).Return(token, nil)
+			},
+			wantErr: "API token not found",
+		},
+		{
+			name: "Fails when the API token is already revoked",
+			mock: func(psvc *persistence_fake.MockService) {
+				token := owned()
+				token.RevokedAt = time.Now()
+				psvc.EXPECT().ReadAPIToken(mockutil.Context(), apiTokenUUID).Return(token, nil)
+			},
+			wantErr: "API token is already revoked",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, psvc, _ := testSvc(t, nil, 0)
+			tt.mock(psvc)
+
+			err := svc.RevokeAPIToken(
+				auth.WithUserClaims(t.Context(), userClaims),
+				&goaingest.RevokeAPITokenPayload{UUID: apiTokenUUID.String()},
+			)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestAPITokenStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Reads an API token with the owner claims", func(t *testing.T) {
+		t.Parallel()
+
+		_, psvc, _ := testSvc(t, nil, 0)
+		psvc.EXPECT().
+			ReadAPITokenByHash(mockutil.Context(), "hash").
+			Return(&datatypes.APIToken{
+				UUID:       apiTokenUUID,
+				Attributes: []string{"ingest:sips:create"},
+				Owner: &datatypes.User{
+					Email:   "nobody@example.com",
+					OIDCIss: "https://example.com/oidc",
+					OIDCSub: "1234567890",
+				},
+			}, nil)
+
+		got, err := ingest.NewAPITokenStore(psvc).ReadAPIToken(t.Context(), "hash")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, &auth.APIToken{
+			UUID:       apiTokenUUID,
+			Attributes: []string{"ingest:sips:create"},
+			Owner: auth.Claims{
+				Email: "nobody@example.com",
+				Iss:   "https://example.com/oidc",
+				Sub:   "1234567890",
+			},
+		})
+	})
+
+	t.Run("Returns unauthorized for unknown API tokens", func(t *testing.T) {
+		t.Parallel()
+
+		_, psvc, _ := testSvc(t, nil, 0)
+		psvc.EXPECT().ReadAPITokenByHash(mockutil.Context(), "hash").Return(nil, persistence.ErrNotFound)
+
+		_, err := ingest.NewAPITokenStore(psvc).ReadAPIToken(t.Context(), "hash")
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+	})
+
+	t.Run("Lists the API tokens of an owner", func(t *testing.T) {
+		t.Parallel()
+
+		owner := &datatypes.User{
+			UUID:    uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72"),
+			Email:   "nobody@example.com",
+			OIDCIss: "https://example.com/oidc",
+			OIDCSub: "1234567890",
+		}
+
+		_, psvc, _ := testSvc(t, nil, 0)
+		psvc.EXPECT().ReadOIDCUser(mockutil.Context(), owner.OIDCIss, owner.OIDCSub).Return(owner, nil)
+		psvc.EXPECT().
+			ListAPITokens(mockutil.Context(), owner.UUID).
+			Return([]*datatypes.APIToken{
+				{
+					UUID:       apiTokenUUID,
+					Attributes: []string{"ingest:sips:create"},
+					Owner:      owner,
+				},
+			}, nil)
+
+		got, err := ingest.NewAPITokenStore(psvc).ListOwnerAPITokens(t.Context(), owner.OIDCIss, owner.OIDCSub)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, []*auth.APIToken{
+			{
+				UUID:       apiTokenUUID,
+				Attributes: []string{"ingest:sips:create"},
+				Owner: auth.Claims{
+					Email: "nobody@example.com",
+					Iss:   "https://example.com/oidc",
+					Sub:   "1234567890",
+				},
+			},
+		})
+	})
+
+	t.Run("Lists no API tokens for unknown owners", func(t *testing.T) {
+		t.Parallel()
+
+		_, psvc, _ := testSvc(t, nil, 0)
+		psvc.EXPECT().ReadOIDCUser(mockutil.Context(), "iss", "sub").Return(nil, persistence.ErrNotFound)
+
+		got, err := ingest.NewAPITokenStore(psvc).ListOwnerAPITokens(t.Context(), "iss", "sub")
+		assert.NilError(t, err)
+		assert.Equal(t, len(got), 0)
+	})
+
+	t.Run("Revokes an API token", func(t *testing.T) {
+		t.Parallel()
+
+		revokedAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+		_, psvc, _ := testSvc(t, nil, 0)
+		psvc.EXPECT().
+			UpdateAPIToken(mockutil.Context(), apiTokenUUID, mockutil.Func(
+				"should set the revocation datetime",
+				func(upd persistence.APITokenUpdater) error {
+					token, err := upd(&datatypes.APIToken{})
+					if err != nil {
+						return err
+					}
+					if !token.RevokedAt.Equal(revokedAt) {
+						return fmt.Errorf("unexpected revocation datetime: %s", token.RevokedAt)
+					}
+					return nil
+				},
+			)).
+			Return(&datatypes.APIToken{}, nil)
+
+		err := ingest.NewAPITokenStore(psvc).RevokeAPIToken(t.Context(), apiTokenUUID, revokedAt)
+		assert.NilError(t, err)
+	})
+}
@@ -0,0 +1,176 @@
+package ingest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
+)
+
+const (
+	// BatchManifestFormatCSV is a batch manifest with a header row and one
+	// row per SIP.
+	BatchManifestFormatCSV = "csv"
+
+	// BatchManifestFormatJSON is a batch manifest with an array of objects,
+	// one per SIP.
+	BatchManifestFormatJSON = "json"
+)
+
+// Batch manifest CSV columns. Any other column is a custom field of the SIP.
+const (
+	manifestKeyColumn               = "key"
+	manifestTitleColumn             = "title"
+	manifestProcessingProfileColumn = "processing_profile"
+	manifestLocationIDColumn        = "location_id"
+)
+
+// batchManifestEntry is a SIP of a JSON batch manifest.
+type batchManifestEntry struct {
+	Key               string                 `json:"key"`
+	Title             string                 `json:"title"`
+	ProcessingProfile string                 `json:"processing_profile"`
+	LocationID        string                 `json:"location_id"`
+	Metadata          childwf.CustomMetadata `json:"metadata"`
+}
+
+// parseBatchManifest parses a batch manifest in the given format and returns
+// the SIPs listed in it. It returns an error if the manifest is malformed,
+// lists no SIPs, or lists a SIP without a key, with an invalid location ID or
+// more than once.
+func parseBatchManifest(manifest, format string) ([]*BatchSIP, error) {
+	var (
+		entries []*batchManifestEntry
+		err     error
+	)
+	switch format {
+	case BatchManifestFormatCSV:
+		entries, err = parseCSVBatchManifest(manifest)
+	case BatchManifestFormatJSON:
+		entries, err = parseJSONBatchManifest(manifest)
+	default:
+		return nil, fmt.Errorf("unknown manifest format: %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("manifest lists no SIPs")
+	}
+
+	sips := make([]*BatchSIP, len(entries))
+	keys := make(map[string]struct{}, len(entries))
+	for i, e := range entries {
+		key := strings.TrimSpace(e.Key)
+		if key == "" {
+			return nil, fmt.Errorf("SIP %d: missing key", i+1)
+		}
+		if _, ok := keys[key]; ok {
+			return nil, fmt.Errorf("SIP %d: duplicate key %q", i+1, key)
+		}
+		keys[key] = struct{}{}
+
+		sip := &BatchSIP{
+			Key:               key,
+			Title:             strings.TrimSpace(e.Title),
+			ProcessingProfile: strings.TrimSpace(e.ProcessingProfile),
+			CustomMetadata:    e.Metadata,
+		}
+		if id := strings.TrimSpace(e.LocationID); id != "" {
+			locationID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("SIP %d: invalid location_id %q", i+1, id)
+			}
+			sip.LocationID = &locationID
+		}
+		sips[i] = sip
+	}
+
+	return sips, nil
+}
+
+func parseCSVBatchManifest(manifest string) ([]*batchManifestEntry, error) {
+	r := csv.NewReader(strings.NewReader(manifest))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read CSV header: %v", err)
+	}
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+		if header[i] == "" {
+			return nil, fmt.Errorf("column %d: missing name", i+1)
+		}
+		if slices.Contains(header[:i], header[i]) {
+			return nil, fmt.Errorf("column %d: duplicate name %q", i+1, header[i])
+		}
+	}
+	if !slices.Contains(header, manifestKeyColumn) {
+		return nil, fmt.Errorf("missing %q column", manifestKeyColumn)
+	}
+
+	var entries []*batchManifestEntry
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read CSV: %v", err)
+		}
+
+		e := &batchManifestEntry{}
+		for i, value := range record {
+			switch header[i] {
+			case manifestKeyColumn:
+				e.Key = value
+			case manifestTitleColumn:
+				e.Title = value
+			case manifestProcessingProfileColumn:
+				e.ProcessingProfile = value
+			case manifestLocationIDColumn:
+				e.LocationID = value
+			default:
+				// Custom fields are carried as JSON strings, empty values
+				// are left out.
+				if value == "" {
+					continue
+				}
+				v, _ := json.Marshal(value) // Marshaling a string can't fail.
+				if e.Metadata == nil {
+					e.Metadata = make(childwf.CustomMetadata)
+				}
+				e.Metadata[header[i]] = v
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func parseJSONBatchManifest(manifest string) ([]*batchManifestEntry, error) {
+	dec := json.NewDecoder(strings.NewReader(manifest))
+	dec.DisallowUnknownFields()
+
+	var entries []*batchManifestEntry
+	if err := dec.Decode(&entries); err != nil {
+		return nil, fmt.Errorf("decode JSON: %v", err)
+	}
+	if slices.Contains(entries, nil) {
+		return nil, errors.New("decode JSON: unexpected null SIP")
+	}
+
+	return entries, nil
+}
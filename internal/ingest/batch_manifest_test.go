@@ -0,0 +1,164 @@
+package ingest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
+)
+
+func TestParseBatchManifest(t *testing.T) {
+	t.Parallel()
+
+	locationID := uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1")
+
+	for _, tt := range []struct {
+		name     string
+		manifest string
+		format   string
+		want     []*BatchSIP
+		wantErr  string
+	}{
+		{
+			name: "Parses a CSV manifest",
+			manifest: `key,title,processing_profile,location_id,collection,box
+sip1.zip,Letters,fast,f2cc963f-c14d-4eaa-b950-bd207189a1f1,Smith,1
+sip2.zip,,,,Smith,
+`,
+			format: BatchManifestFormatCSV,
+			want: []*BatchSIP{
+				{
+					Key:               "sip1.zip",
+					Title:             "Letters",
+					ProcessingProfile: "fast",
+					LocationID:        &locationID,
+					CustomMetadata: childwf.CustomMetadata{
+						"collection": json.RawMessage(`"Smith"`),
+						"box":        json.RawMessage(`"1"`),
+					},
+				},
+				{
+					Key: "sip2.zip",
+					CustomMetadata: childwf.CustomMetadata{
+						"collection": json.RawMessage(`"Smith"`),
+					},
+				},
+			},
+		},
+		{
+			name: "Parses a JSON manifest",
+			manifest: `[
+				{
+					"key": "sip1.zip",
+					"title": "Letters",
+					"processing_profile": "fast",
+					"location_id": "f2cc963f-c14d-4eaa-b950-bd207189a1f1",
+					"metadata": {"collection": "Smith", "boxes": [1, 2]}
+				},
+				{"key": "sip2.zip"}
+			]`,
+			format: BatchManifestFormatJSON,
+			want: []*BatchSIP{
+				{
+					Key:               "sip1.zip",
+					Title:             "Letters",
+					ProcessingProfile: "fast",
+					LocationID:        &locationID,
+					CustomMetadata: childwf.CustomMetadata{
+						"collection": json.RawMessage(`"Smith"`),
+						"boxes":      json.RawMessage(`[1, 2]`),
+					},
+				},
+				{Key: "sip2.zip"},
+			},
+		},
+		{
+			name:     "Errors on an unknown format",
+			manifest: "key\nsip1.zip\n",
+			format:   "xml",
+			wantErr:  `unknown manifest format: "xml"`,
+		},
+		{
+			name:     "Errors on an empty manifest",
+			manifest: "",
+			format:   BatchManifestFormatCSV,
+			wantErr:  "manifest lists no SIPs",
+		},
+		{
+			name:     "Errors on a CSV manifest without SIPs",
+			manifest: "key,title\n",
+			format:   BatchManifestFormatCSV,
+			wantErr:  "manifest lists no SIPs",
+		},
+		{
+			name:     "Errors on a CSV manifest without a key column",
+			manifest: "title\nLetters\n",
+			format:   BatchManifestFormatCSV,
+			wantErr:  `missing "key" column`,
+		},
+		{
+			name:     "Errors on a CSV manifest with duplicate columns",
+			manifest: "key,title,title\nsip1.zip,Letters,Letters\n",
+			format:   BatchManifestFormatCSV,
+			wantErr:  `column 3: duplicate name "title"`,
+		},
+		{
+			name:     "Errors on a CSV manifest with an unnamed column",
+			manifest: "key,\nsip1.zip,Letters\n",
+			format:   BatchManifestFormatCSV,
+			wantErr:  "column 2: missing name",
+		},
+		{
+			name:     "Errors on a malformed CSV manifest",
+			manifest: "key,title\nsip1.zip\n",
+			format:   BatchManifestFormatCSV,
+			wantErr:  "read CSV: record on line 2: wrong number of fields",
+		},
+		{
+			name:     "Errors on a SIP without key",
+			manifest: "key,title\nsip1.zip,Letters\n,Photos\n",
+			format:   BatchManifestFormatCSV,
+			wantErr:  "SIP 2: missing key",
+		},
+		{
+			name:     "Errors on a duplicate key",
+			manifest: `[{"key": "sip1.zip"}, {"key": "sip1.zip"}]`,
+			format:   BatchManifestFormatJSON,
+			wantErr:  `SIP 2: duplicate key "sip1.zip"`,
+		},
+		{
+			name:     "Errors on an invalid location ID",
+			manifest: `[{"key": "sip1.zip", "location_id": "invalid"}]`,
+			format:   BatchManifestFormatJSON,
+			wantErr:  `SIP 1: invalid location_id "invalid"`,
+		},
+		{
+			name:     "Errors on an unknown JSON field",
+			manifest: `[{"key": "sip1.zip", "name": "Letters"}]`,
+			format:   BatchManifestFormatJSON,
+			wantErr:  `decode JSON: json: unknown field "name"`,
+		},
+		{
+			name:     "Errors on a null JSON SIP",
+			manifest: `[{"key": "sip1.zip"}, null]`,
+			format:   BatchManifestFormatJSON,
+			wantErr:  "decode JSON: unexpected null SIP",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseBatchManifest(tt.manifest, tt.format)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+)
+
+// CancelSip requests the cancellation of the processing workflow of a queued
+// or processing SIP. The workflow cleanup sets the SIP status to "canceled".
+func (svc *ingestImpl) CancelSip(ctx context.Context, payload *goaingest.CancelSipPayload) error {
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		return goaingest.MakeNotValid(err)
+	}
+
+	sip, err := svc.readSIP(ctx, payload.UUID)
+	if err != nil {
+		return err
+	}
+
+	if sip.Status != enums.SIPStatusQueued && sip.Status != enums.SIPStatusProcessing {
+		return goaingest.MakeNotValid(fmt.Errorf("SIP with status %q can't be canceled", sip.Status))
+	}
+	if sip.Batch != nil {
+		return goaingest.MakeNotValid(errors.New("SIP belongs to a batch and can't be canceled individually"))
+	}
+
+	if err := svc.tc.CancelWorkflow(ctx, ProcessingWorkflowID(sip.UUID), ""); err != nil {
+		svc.logger.Error(err, "cancel SIP: cancel processing workflow", "sip_uuid", sip.UUID)
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+
+	svc.auditLogger.Log(ctx, sipCancelAuditEvent(sip, claims))
+
+	return nil
+}
+
+func sipCancelAuditEvent(s *datatypes.SIP, claims *auth.Claims) *auditlog.Event {
+	e := &auditlog.Event{
+		Level:      auditlog.LevelInfo,
+		Msg:        "SIP cancel requested",
+		Type:       "SIP.cancel",
+		ResourceID: s.UUID.String(),
+	}
+	if claims != nil {
+		e.User = claims.Email
+	}
+
+	return e
+}
@@ -0,0 +1,131 @@
+package ingest_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_mocks "go.temporal.io/sdk/mocks"
+	"gotest.tools/v3/assert"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+)
+
+func TestCancelSip(t *testing.T) {
+	t.Parallel()
+
+	processingSIP := func() *datatypes.SIP {
+		return &datatypes.SIP{
+			UUID:   sipUUID,
+			Name:   "processing.zip",
+			Status: enums.SIPStatusProcessing,
+		}
+	}
+
+	workflowID := fmt.Sprintf("processing-workflow-%s", sipUUID)
+
+	for _, tt := range []struct {
+		name    string
+		payload *goaingest.CancelSipPayload
+		claims  *auth.Claims
+		mock    func(*persistence_fake.MockService, *temporalsdk_mocks.Client)
+		wantErr string
+	}{
+		{
+			name:    "Fails to cancel a SIP (invalid UUID)",
+			payload: &goaingest.CancelSipPayload{UUID: "invalid-uuid"},
+			wantErr: "invalid UUID",
+		},
+		{
+			name:    "Fails to cancel a SIP (SIP not found)",
+			payload: &goaingest.CancelSipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(nil, persistence.ErrNotFound)
+			},
+			wantErr: "SIP not found.",
+		},
+		{
+			name:    "Fails to cancel a SIP (ingested status)",
+			payload: &goaingest.CancelSipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := processingSIP()
+				sip.Status = enums.SIPStatusIngested
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+			},
+			wantErr: `SIP with status "ingested" can't be canceled`,
+		},
+		{
+			name:    "Fails to cancel a SIP (batch SIP)",
+			payload: &goaingest.CancelSipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := processingSIP()
+				sip.Batch = &datatypes.Batch{UUID: uuid.New()}
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+			},
+			wantErr: "SIP belongs to a batch and can't be canceled individually",
+		},
+		{
+			name:    "Fails to cancel a SIP (workflow not canceled)",
+			payload: &goaingest.CancelSipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(processingSIP(), nil)
+				tc.On("CancelWorkflow", mock.Anything, workflowID, "").Return(errors.New("temporal error"))
+			},
+			wantErr: "cannot perform operation",
+		},
+		{
+			name:    "Cancels a queued SIP",
+			payload: &goaingest.CancelSipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := processingSIP()
+				sip.Status = enums.SIPStatusQueued
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+				tc.On("CancelWorkflow", mock.Anything, workflowID, "").Return(nil)
+			},
+		},
+		{
+			name:    "Cancels a processing SIP",
+			payload: &goaingest.CancelSipPayload{UUID: sipUUID.String()},
+			claims: &auth.Claims{
+				Email: "nobody@example.com",
+				Iss:   "http://keycloak:7470/realms/artefactual",
+				Sub:   "1234",
+			},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(processingSIP(), nil)
+				tc.On("CancelWorkflow", mock.Anything, workflowID, "").Return(nil)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, psvc, tc := testSvc(t, nil, 0)
+			if tt.mock != nil {
+				tt.mock(psvc, tc)
+			}
+
+			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+
+			err := svc.CancelSip(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			tc.AssertExpectations(t)
+		})
+	}
+}
@@ -1,12 +1,18 @@
 package ingest
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"go.artefactual.dev/tools/clientauth"
+
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
 )
 
 type Config struct {
@@ -21,13 +27,23 @@ type Config struct {
 	// "validated". If the SIP status is "error", "failed" or "canceled" the
 	// SIP will be ignored when checking for duplicates.
 	//
-	// A checksum is calculated and stored for every SIP archive ingested by
-	// Enduro, regardless of this setting. When `allowDuplicates` is false, a
+	// A checksum is calculated and stored for every SIP ingested by Enduro,
+	// regardless of this setting. When `allowDuplicates` is false, a
 	// new ingest's checksum will be checked against all previously ingested SIP
 	// checksums, even if `allowDuplicates` was true when the old SIPs were
 	// ingested.
 	AllowDuplicates bool
 
+	// ChecksumAlgorithm is the algorithm used to calculate SIP checksums, one
+	// of "md5", "sha1", "sha256" or "sha512" (default: "sha256"). SIPs
+	// submitted as a directory use a tree hash calculated with the same
+	// algorithm.
+	ChecksumAlgorithm string
+
+	// APITokenMaxLifetime is the maximum duration between the creation and
+	// the expiration of an API token (default: 90 days).
+	APITokenMaxLifetime time.Duration
+
 	Storage StorageConfig
 }
 
@@ -35,6 +51,53 @@ type StorageConfig struct {
 	Address                    string
 	DefaultPermanentLocationID uuid.UUID
 	OIDC                       StorageOIDCConfig
+
+	// AIPMetadataKeys lists the keys of the SIP custom metadata that are
+	// copied onto the AIP when it's created in storage. No custom metadata is
+	// copied by default.
+	AIPMetadataKeys []string
+
+	// LocationRules route the AIPs of the SIPs matching them to a storage
+	// location other than the default permanent location. Rules are evaluated
+	// in order and the first matching rule wins. A location requested when
+	// the SIP is submitted takes precedence over the rules.
+	LocationRules []LocationRule
+}
+
+// LocationRule selects the storage location of the AIPs of the SIPs matching
+// all of its criteria, at least one criterion is required. String criteria
+// are patterns using the path.Match syntax, e.g. "*@example.com".
+type LocationRule struct {
+	// LocationID is the identifier of the storage location of the AIPs, it
+	// must be an "aip_store" location.
+	LocationID uuid.UUID
+
+	// Watcher matches the name of the watcher that received the SIP.
+	Watcher string
+
+	// SIPSourceID matches the identifier of the SIP source of the SIP.
+	SIPSourceID uuid.UUID
+
+	// Uploader matches the email of the user who submitted the SIP.
+	Uploader string
+
+	// Batch matches the identifier of the batch of the SIP.
+	Batch string
+
+	// MetadataKey and MetadataValue match a custom metadata value of the SIP.
+	// String values are matched unquoted, other values by their JSON
+	// encoding. Without MetadataValue, any SIP with the key matches.
+	MetadataKey   string
+	MetadataValue string
+}
+
+// SIPLocationAttrs are the attributes of a SIP that location rules match.
+type SIPLocationAttrs struct {
+	Watcher        string
+	SIPSourceID    uuid.UUID
+	Uploader       string
+	Batch          string
+	CustomMetadata childwf.CustomMetadata
 }
 
 type StorageOIDCConfig struct {
@@ -43,7 +106,30 @@ type StorageOIDCConfig struct {
 }
 
 func (c Config) Validate() error {
-	return c.Storage.Validate()
+	var errs []error
+
+	if c.ChecksumAlgorithm != "" {
+		if _, err := datatypes.ParseChecksumAlgo(c.ChecksumAlgorithm); err != nil {
+			errs = append(errs, fmt.Errorf("invalid checksumAlgorithm in [ingest] configuration: %v", err))
+		}
+	}
+
+	if err := c.Storage.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ChecksumAlgo returns the configured SIP checksum algorithm, SHA-256 if it's
+// not set or invalid.
+func (c Config) ChecksumAlgo() datatypes.ChecksumAlgo {
+	algo, err := datatypes.ParseChecksumAlgo(c.ChecksumAlgorithm)
+	if err != nil {
+		return datatypes.ChecksumAlgoSHA256
+	}
+
+	return algo
 }
 
 func (c StorageConfig) Validate() error {
@@ -60,9 +146,98 @@ func (c StorageConfig) Validate() error {
 		errs = append(errs, err)
 	}
 
+	for i, r := range c.LocationRules {
+		if err := r.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("storage location rule %d: %v", i+1, err))
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
+// AIPLocationID returns the location ID of the first location rule matching
+// the SIP attributes and true, or the default permanent location ID and false
+// if no rule matches.
+func (c StorageConfig) AIPLocationID(attrs SIPLocationAttrs) (uuid.UUID, bool) {
+	for _, r := range c.LocationRules {
+		if r.Matches(attrs) {
+			return r.LocationID, true
+		}
+	}
+
+	return c.DefaultPermanentLocationID, false
+}
+
+func (r LocationRule) Validate() error {
+	var errs []error
+
+	if r.LocationID == uuid.Nil {
+		errs = append(errs, errors.New("missing locationId"))
+	}
+	if r.Watcher == "" && r.SIPSourceID == uuid.Nil && r.Uploader == "" && r.Batch == "" && r.MetadataKey == "" {
+		errs = append(errs, errors.New("missing matching criteria"))
+	}
+	if r.MetadataValue != "" && r.MetadataKey == "" {
+		errs = append(errs, errors.New("metadataValue requires metadataKey"))
+	}
+	for _, p := range [][2]string{
+		{"watcher", r.Watcher},
+		{"uploader", r.Uploader},
+		{"batch", r.Batch},
+		{"metadataValue", r.MetadataValue},
+	} {
+		if _, err := path.Match(p[1], ""); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s pattern: %q", p[0], p[1]))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Matches reports whether the SIP attributes match all the rule criteria.
+func (r LocationRule) Matches(attrs SIPLocationAttrs) bool {
+	if r.Watcher != "" && !matchPattern(r.Watcher, attrs.Watcher) {
+		return false
+	}
+	if r.SIPSourceID != uuid.Nil && r.SIPSourceID != attrs.SIPSourceID {
+		return false
+	}
+	if r.Uploader != "" && !matchPattern(r.Uploader, attrs.Uploader) {
+		return false
+	}
+	if r.Batch != "" && !matchPattern(r.Batch, attrs.Batch) {
+		return false
+	}
+	if r.MetadataKey != "" {
+		raw, ok := attrs.CustomMetadata[r.MetadataKey]
+		if !ok {
+			return false
+		}
+		if r.MetadataValue != "" {
+			value := string(raw)
+			var s string
+			if err := json.Unmarshal(raw, &s); err == nil {
+				value = s
+			}
+			if !matchPattern(r.MetadataValue, value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchPattern reports whether a non-empty value matches the pattern.
+func matchPattern(pattern, value string) bool {
+	if value == "" {
+		return false
+	}
+	ok, _ := path.Match(pattern, value)
+
+	return ok
+}
+
 func (c StorageOIDCConfig) Validate() error {
 	if !c.Enabled {
 		return nil
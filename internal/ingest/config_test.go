@@ -1,15 +1,55 @@
 package ingest_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"go.artefactual.dev/tools/clientauth"
 	"gotest.tools/v3/assert"
 
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
 	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
 )
 
+func TestConfigChecksumAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	storage := ingest.StorageConfig{
+		Address:                    "127.0.0.1:9000",
+		DefaultPermanentLocationID: uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1"),
+	}
+
+	t.Run("Defaults to SHA-256", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := ingest.Config{Storage: storage}
+		assert.NilError(t, cfg.Validate())
+		assert.Equal(t, cfg.ChecksumAlgo(), datatypes.ChecksumAlgoSHA256)
+	})
+
+	t.Run("Uses the configured algorithm", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := ingest.Config{ChecksumAlgorithm: "sha512", Storage: storage}
+		assert.NilError(t, cfg.Validate())
+		assert.Equal(t, cfg.ChecksumAlgo(), datatypes.ChecksumAlgoSHA512)
+	})
+
+	t.Run("Fails validation with an unsupported algorithm", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := ingest.Config{ChecksumAlgorithm: "crc32", Storage: storage}
+		assert.Error(
+			t,
+			cfg.Validate(),
+			`invalid checksumAlgorithm in [ingest] configuration: unsupported checksum algorithm: "crc32"`,
+		)
+	})
+}
+
 func TestStorageConfigValidate(t *testing.T) {
 	t.Parallel()
 
@@ -152,3 +192,151 @@ func TestStorageOIDCConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageConfigLocationRules(t *testing.T) {
+	t.Parallel()
+
+	defaultLocationID := uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1")
+	sipSourceID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	locationID1 := uuid.MustParse("7c7ea8e7-5c8c-4d5e-9a31-fa6a0a2d2a5c")
+	locationID2 := uuid.MustParse("0f1a2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d")
+	locationID3 := uuid.MustParse("6e0c7b2e-1d5a-4b8e-9f3c-2a1b0c9d8e7f")
+
+	cfg := ingest.StorageConfig{
+		Address:                    "127.0.0.1:9000",
+		DefaultPermanentLocationID: defaultLocationID,
+		LocationRules: []ingest.LocationRule{
+			{LocationID: locationID1, Watcher: "dev-*", Uploader: "*@example.com"},
+			{LocationID: locationID2, SIPSourceID: sipSourceID},
+			{LocationID: locationID3, MetadataKey: "collection", MetadataValue: "Smith*"},
+			{LocationID: locationID1, Batch: "Batch-2026-*"},
+			{LocationID: locationID2, MetadataKey: "restricted", MetadataValue: "true"},
+		},
+	}
+	assert.NilError(t, cfg.Validate())
+
+	for _, tt := range []struct {
+		name      string
+		attrs     ingest.SIPLocationAttrs
+		want      uuid.UUID
+		wantMatch bool
+	}{
+		{
+			name:      "Matches all the criteria of a rule",
+			attrs:     ingest.SIPLocationAttrs{Watcher: "dev-watcher", Uploader: "nobody@example.com"},
+			want:      locationID1,
+			wantMatch: true,
+		},
+		{
+			name:  "Requires all the criteria of a rule",
+			attrs: ingest.SIPLocationAttrs{Watcher: "dev-watcher"},
+			want:  defaultLocationID,
+		},
+		{
+			name:      "Matches a SIP source",
+			attrs:     ingest.SIPLocationAttrs{SIPSourceID: sipSourceID},
+			want:      locationID2,
+			wantMatch: true,
+		},
+		{
+			name: "Matches a custom metadata string value",
+			attrs: ingest.SIPLocationAttrs{
+				CustomMetadata: childwf.CustomMetadata{"collection": json.RawMessage(`"Smith papers"`)},
+			},
+			want:      locationID3,
+			wantMatch: true,
+		},
+		{
+			name: "Matches a custom metadata JSON value",
+			attrs: ingest.SIPLocationAttrs{
+				CustomMetadata: childwf.CustomMetadata{"restricted": json.RawMessage(`true`)},
+			},
+			want:      locationID2,
+			wantMatch: true,
+		},
+		{
+			name:      "Matches a batch",
+			attrs:     ingest.SIPLocationAttrs{Batch: "Batch-2026-10"},
+			want:      locationID1,
+			wantMatch: true,
+		},
+		{
+			name: "Returns the default location when no rule matches",
+			attrs: ingest.SIPLocationAttrs{
+				Watcher:        "prod-watcher",
+				CustomMetadata: childwf.CustomMetadata{"collection": json.RawMessage(`"Jones"`)},
+			},
+			want: defaultLocationID,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := cfg.AIPLocationID(tt.attrs)
+			assert.Equal(t, got, tt.want)
+			assert.Equal(t, ok, tt.wantMatch)
+		})
+	}
+}
+
+func TestLocationRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	locationID := uuid.MustParse("7c7ea8e7-5c8c-4d5e-9a31-fa6a0a2d2a5c")
+
+	for _, tt := range []struct {
+		name    string
+		rule    ingest.LocationRule
+		wantErr string
+	}{
+		{
+			name: "Passes validation",
+			rule: ingest.LocationRule{LocationID: locationID, MetadataKey: "collection"},
+		},
+		{
+			name:    "Requires a location ID",
+			rule:    ingest.LocationRule{Watcher: "dev-*"},
+			wantErr: "missing locationId",
+		},
+		{
+			name:    "Requires matching criteria",
+			rule:    ingest.LocationRule{LocationID: locationID},
+			wantErr: "missing matching criteria",
+		},
+		{
+			name:    "Requires a metadata key with a metadata value",
+			rule:    ingest.LocationRule{LocationID: locationID, Watcher: "dev-*", MetadataValue: "Smith"},
+			wantErr: "metadataValue requires metadataKey",
+		},
+		{
+			name:    "Rejects an invalid pattern",
+			rule:    ingest.LocationRule{LocationID: locationID, Uploader: "[a-"},
+			wantErr: `invalid uploader pattern: "[a-"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.rule.Validate()
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+
+	t.Run("Storage config validation reports the invalid rule", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := ingest.StorageConfig{
+			Address:                    "127.0.0.1:9000",
+			DefaultPermanentLocationID: locationID,
+			LocationRules: []ingest.LocationRule{
+				{LocationID: locationID, Watcher: "dev-*"},
+				{Watcher: "dev-*"},
+			},
+		}
+		assert.Error(t, cfg.Validate(), "storage location rule 2: missing locationId")
+	})
+}
@@ -113,13 +113,14 @@ func listSipsPayloadToSIPFilter(payload *goaingest.ListSipsPayload) (*persistenc
 	}
 
 	pf := persistence.SIPFilter{
-		AIPID:      aipID,
-		Name:       payload.Name,
-		Status:     status,
-		CreatedAt:  createdAt,
-		UploaderID: uploaderID,
-		BatchID:    batchID,
-		Sort:       entfilter.NewSort().AddCol("id", true),
+		AIPID:       aipID,
+		Name:        payload.Name,
+		Status:      status,
+		CreatedAt:   createdAt,
+		UploaderID:  uploaderID,
+		BatchID:     batchID,
+		MetadataKey: payload.MetadataKey,
+		Sort:        entfilter.NewSort().AddCol("id", true),
 		Page: persistence.Page{
 			Limit:  ref.DerefZero(payload.Limit),
 			Offset: ref.DerefZero(payload.Offset),
@@ -19,6 +19,7 @@ import (
 	datatypes "github.com/artefactual-sdps/enduro/internal/datatypes"
 	enums "github.com/artefactual-sdps/enduro/internal/enums"
 	persistence "github.com/artefactual-sdps/enduro/internal/persistence"
+	childwf "github.com/artefactual-sdps/enduro/pkg/childwf"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -125,6 +126,82 @@ func (c *MockServiceAddSipCall) DoAndReturn(f func(context.Context, *ingest.AddS
 	return c
 }
 
+// CancelSip mocks base method.
+func (m *MockService) CancelSip(arg0 context.Context, arg1 *ingest.CancelSipPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSip", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelSip indicates an expected call of CancelSip.
+func (mr *MockServiceMockRecorder) CancelSip(arg0, arg1 any) *MockServiceCancelSipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSip", reflect.TypeOf((*MockService)(nil).CancelSip), arg0, arg1)
+	return &MockServiceCancelSipCall{Call: call}
+}
+
+// MockServiceCancelSipCall wrap *gomock.Call
+type MockServiceCancelSipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCancelSipCall) Return(arg0 error) *MockServiceCancelSipCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCancelSipCall) Do(f func(context.Context, *ingest.CancelSipPayload) error) *MockServiceCancelSipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCancelSipCall) DoAndReturn(f func(context.Context, *ingest.CancelSipPayload) error) *MockServiceCancelSipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CheckAIPLocation mocks base method.
+func (m *MockService) CheckAIPLocation(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAIPLocation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAIPLocation indicates an expected call of CheckAIPLocation.
+func (mr *MockServiceMockRecorder) CheckAIPLocation(ctx, id any) *MockServiceCheckAIPLocationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAIPLocation", reflect.TypeOf((*MockService)(nil).CheckAIPLocation), ctx, id)
+	return &MockServiceCheckAIPLocationCall{Call: call}
+}
+
+// MockServiceCheckAIPLocationCall wrap *gomock.Call
+type MockServiceCheckAIPLocationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCheckAIPLocationCall) Return(arg0 error) *MockServiceCheckAIPLocationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCheckAIPLocationCall) Do(f func(context.Context, uuid.UUID) error) *MockServiceCheckAIPLocationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCheckAIPLocationCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockServiceCheckAIPLocationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CompleteTask mocks base method.
 func (m *MockService) CompleteTask(ctx context.Context, ID int, status enums.TaskStatus, completedAt time.Time, note *string) error {
 	m.ctrl.T.Helper()
@@ -239,6 +316,45 @@ func (c *MockServiceConfirmSipCall) DoAndReturn(f func(context.Context, *ingest.
 	return c
 }
 
+// CreateAPIToken mocks base method.
+func (m *MockService) CreateAPIToken(arg0 context.Context, arg1 *ingest.CreateAPITokenPayload) (*ingest.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", arg0, arg1)
+	ret0, _ := ret[0].(*ingest.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockServiceMockRecorder) CreateAPIToken(arg0, arg1 any) *MockServiceCreateAPITokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockService)(nil).CreateAPIToken), arg0, arg1)
+	return &MockServiceCreateAPITokenCall{Call: call}
+}
+
+// MockServiceCreateAPITokenCall wrap *gomock.Call
+type MockServiceCreateAPITokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCreateAPITokenCall) Return(arg0 *ingest.APIToken, arg1 error) *MockServiceCreateAPITokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCreateAPITokenCall) Do(f func(context.Context, *ingest.CreateAPITokenPayload) (*ingest.APIToken, error)) *MockServiceCreateAPITokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCreateAPITokenCall) DoAndReturn(f func(context.Context, *ingest.CreateAPITokenPayload) (*ingest.APIToken, error)) *MockServiceCreateAPITokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateSIP mocks base method.
 func (m *MockService) CreateSIP(arg0 context.Context, arg1 *datatypes.SIP) error {
 	m.ctrl.T.Helper()
@@ -277,6 +393,45 @@ func (c *MockServiceCreateSIPCall) DoAndReturn(f func(context.Context, *datatype
 	return c
 }
 
+// CreateSipUpload mocks base method.
+func (m *MockService) CreateSipUpload(arg0 context.Context, arg1 *ingest.CreateSipUploadPayload) (*ingest.SIPUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSipUpload", arg0, arg1)
+	ret0, _ := ret[0].(*ingest.SIPUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSipUpload indicates an expected call of CreateSipUpload.
+func (mr *MockServiceMockRecorder) CreateSipUpload(arg0, arg1 any) *MockServiceCreateSipUploadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSipUpload", reflect.TypeOf((*MockService)(nil).CreateSipUpload), arg0, arg1)
+	return &MockServiceCreateSipUploadCall{Call: call}
+}
+
+// MockServiceCreateSipUploadCall wrap *gomock.Call
+type MockServiceCreateSipUploadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCreateSipUploadCall) Return(arg0 *ingest.SIPUpload, arg1 error) *MockServiceCreateSipUploadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCreateSipUploadCall) Do(f func(context.Context, *ingest.CreateSipUploadPayload) (*ingest.SIPUpload, error)) *MockServiceCreateSipUploadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCreateSipUploadCall) DoAndReturn(f func(context.Context, *ingest.CreateSipUploadPayload) (*ingest.SIPUpload, error)) *MockServiceCreateSipUploadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateTask mocks base method.
 func (m *MockService) CreateTask(ctx context.Context, task *datatypes.Task) error {
 	m.ctrl.T.Helper()
@@ -509,6 +664,83 @@ func (c *MockServiceFindDuplicateSIPCall) DoAndReturn(f func(context.Context, uu
 	return c
 }
 
+// IndexSIP mocks base method.
+func (m *MockService) IndexSIP(ctx context.Context, id uuid.UUID, md childwf.CustomMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexSIP", ctx, id, md)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexSIP indicates an expected call of IndexSIP.
+func (mr *MockServiceMockRecorder) IndexSIP(ctx, id, md any) *MockServiceIndexSIPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexSIP", reflect.TypeOf((*MockService)(nil).IndexSIP), ctx, id, md)
+	return &MockServiceIndexSIPCall{Call: call}
+}
+
+// MockServiceIndexSIPCall wrap *gomock.Call
+type MockServiceIndexSIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceIndexSIPCall) Return(arg0 error) *MockServiceIndexSIPCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceIndexSIPCall) Do(f func(context.Context, uuid.UUID, childwf.CustomMetadata) error) *MockServiceIndexSIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceIndexSIPCall) DoAndReturn(f func(context.Context, uuid.UUID, childwf.CustomMetadata) error) *MockServiceIndexSIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAPITokens mocks base method.
+func (m *MockService) ListAPITokens(arg0 context.Context, arg1 *ingest.ListAPITokensPayload) (*ingest.APITokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokens", arg0, arg1)
+	ret0, _ := ret[0].(*ingest.APITokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockServiceMockRecorder) ListAPITokens(arg0, arg1 any) *MockServiceListAPITokensCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockService)(nil).ListAPITokens), arg0, arg1)
+	return &MockServiceListAPITokensCall{Call: call}
+}
+
+// MockServiceListAPITokensCall wrap *gomock.Call
+type MockServiceListAPITokensCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListAPITokensCall) Return(arg0 *ingest.APITokens, arg1 error) *MockServiceListAPITokensCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListAPITokensCall) Do(f func(context.Context, *ingest.ListAPITokensPayload) (*ingest.APITokens, error)) *MockServiceListAPITokensCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListAPITokensCall) DoAndReturn(f func(context.Context, *ingest.ListAPITokensPayload) (*ingest.APITokens, error)) *MockServiceListAPITokensCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListBatches mocks base method.
 func (m *MockService) ListBatches(arg0 context.Context, arg1 *ingest.ListBatchesPayload) (*ingest.Batches, error) {
 	m.ctrl.T.Helper()
@@ -780,6 +1012,82 @@ func (c *MockServiceRejectSipCall) DoAndReturn(f func(context.Context, *ingest.R
 	return c
 }
 
+// RetryBatch mocks base method.
+func (m *MockService) RetryBatch(arg0 context.Context, arg1 *ingest.RetryBatchPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryBatch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryBatch indicates an expected call of RetryBatch.
+func (mr *MockServiceMockRecorder) RetryBatch(arg0, arg1 any) *MockServiceRetryBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryBatch", reflect.TypeOf((*MockService)(nil).RetryBatch), arg0, arg1)
+	return &MockServiceRetryBatchCall{Call: call}
+}
+
+// MockServiceRetryBatchCall wrap *gomock.Call
+type MockServiceRetryBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRetryBatchCall) Return(arg0 error) *MockServiceRetryBatchCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRetryBatchCall) Do(f func(context.Context, *ingest.RetryBatchPayload) error) *MockServiceRetryBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRetryBatchCall) DoAndReturn(f func(context.Context, *ingest.RetryBatchPayload) error) *MockServiceRetryBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RetrySip mocks base method.
+func (m *MockService) RetrySip(arg0 context.Context, arg1 *ingest.RetrySipPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrySip", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetrySip indicates an expected call of RetrySip.
+func (mr *MockServiceMockRecorder) RetrySip(arg0, arg1 any) *MockServiceRetrySipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrySip", reflect.TypeOf((*MockService)(nil).RetrySip), arg0, arg1)
+	return &MockServiceRetrySipCall{Call: call}
+}
+
+// MockServiceRetrySipCall wrap *gomock.Call
+type MockServiceRetrySipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRetrySipCall) Return(arg0 error) *MockServiceRetrySipCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRetrySipCall) Do(f func(context.Context, *ingest.RetrySipPayload) error) *MockServiceRetrySipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRetrySipCall) DoAndReturn(f func(context.Context, *ingest.RetrySipPayload) error) *MockServiceRetrySipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReviewBatch mocks base method.
 func (m *MockService) ReviewBatch(arg0 context.Context, arg1 *ingest.ReviewBatchPayload) error {
 	m.ctrl.T.Helper()
@@ -818,6 +1126,83 @@ func (c *MockServiceReviewBatchCall) DoAndReturn(f func(context.Context, *ingest
 	return c
 }
 
+// RevokeAPIToken mocks base method.
+func (m *MockService) RevokeAPIToken(arg0 context.Context, arg1 *ingest.RevokeAPITokenPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIToken indicates an expected call of RevokeAPIToken.
+func (mr *MockServiceMockRecorder) RevokeAPIToken(arg0, arg1 any) *MockServiceRevokeAPITokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIToken", reflect.TypeOf((*MockService)(nil).RevokeAPIToken), arg0, arg1)
+	return &MockServiceRevokeAPITokenCall{Call: call}
+}
+
+// MockServiceRevokeAPITokenCall wrap *gomock.Call
+type MockServiceRevokeAPITokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRevokeAPITokenCall) Return(arg0 error) *MockServiceRevokeAPITokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRevokeAPITokenCall) Do(f func(context.Context, *ingest.RevokeAPITokenPayload) error) *MockServiceRevokeAPITokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRevokeAPITokenCall) DoAndReturn(f func(context.Context, *ingest.RevokeAPITokenPayload) error) *MockServiceRevokeAPITokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Search mocks base method.
+func (m *MockService) Search(arg0 context.Context, arg1 *ingest.SearchPayload) (*ingest.SearchResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(*ingest.SearchResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockServiceMockRecorder) Search(arg0, arg1 any) *MockServiceSearchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockService)(nil).Search), arg0, arg1)
+	return &MockServiceSearchCall{Call: call}
+}
+
+// MockServiceSearchCall wrap *gomock.Call
+type MockServiceSearchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSearchCall) Return(arg0 *ingest.SearchResults, arg1 error) *MockServiceSearchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSearchCall) Do(f func(context.Context, *ingest.SearchPayload) (*ingest.SearchResults, error)) *MockServiceSearchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSearchCall) DoAndReturn(f func(context.Context, *ingest.SearchPayload) (*ingest.SearchResults, error)) *MockServiceSearchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetStatus mocks base method.
 func (m *MockService) SetStatus(ctx context.Context, id uuid.UUID, status enums.SIPStatus) error {
 	m.ctrl.T.Helper()
@@ -1049,6 +1434,45 @@ func (c *MockServiceShowSipDecisionCall) DoAndReturn(f func(context.Context, *in
 	return c
 }
 
+// ShowSipUpload mocks base method.
+func (m *MockService) ShowSipUpload(arg0 context.Context, arg1 *ingest.ShowSipUploadPayload) (*ingest.SIPUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowSipUpload", arg0, arg1)
+	ret0, _ := ret[0].(*ingest.SIPUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowSipUpload indicates an expected call of ShowSipUpload.
+func (mr *MockServiceMockRecorder) ShowSipUpload(arg0, arg1 any) *MockServiceShowSipUploadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSipUpload", reflect.TypeOf((*MockService)(nil).ShowSipUpload), arg0, arg1)
+	return &MockServiceShowSipUploadCall{Call: call}
+}
+
+// MockServiceShowSipUploadCall wrap *gomock.Call
+type MockServiceShowSipUploadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceShowSipUploadCall) Return(arg0 *ingest.SIPUpload, arg1 error) *MockServiceShowSipUploadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceShowSipUploadCall) Do(f func(context.Context, *ingest.ShowSipUploadPayload) (*ingest.SIPUpload, error)) *MockServiceShowSipUploadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceShowSipUploadCall) DoAndReturn(f func(context.Context, *ingest.ShowSipUploadPayload) (*ingest.SIPUpload, error)) *MockServiceShowSipUploadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SubmitSipDecision mocks base method.
 func (m *MockService) SubmitSipDecision(arg0 context.Context, arg1 *ingest.SubmitSipDecisionPayload) error {
 	m.ctrl.T.Helper()
@@ -1203,3 +1627,42 @@ func (c *MockServiceUploadSipCall) DoAndReturn(f func(context.Context, *ingest.U
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UploadSipChunk mocks base method.
+func (m *MockService) UploadSipChunk(arg0 context.Context, arg1 *ingest.UploadSipChunkPayload, arg2 io.ReadCloser) (*ingest.SIPUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadSipChunk", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*ingest.SIPUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadSipChunk indicates an expected call of UploadSipChunk.
+func (mr *MockServiceMockRecorder) UploadSipChunk(arg0, arg1, arg2 any) *MockServiceUploadSipChunkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSipChunk", reflect.TypeOf((*MockService)(nil).UploadSipChunk), arg0, arg1, arg2)
+	return &MockServiceUploadSipChunkCall{Call: call}
+}
+
+// MockServiceUploadSipChunkCall wrap *gomock.Call
+type MockServiceUploadSipChunkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceUploadSipChunkCall) Return(arg0 *ingest.SIPUpload, arg1 error) *MockServiceUploadSipChunkCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceUploadSipChunkCall) Do(f func(context.Context, *ingest.UploadSipChunkPayload, io.ReadCloser) (*ingest.SIPUpload, error)) *MockServiceUploadSipChunkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceUploadSipChunkCall) DoAndReturn(f func(context.Context, *ingest.UploadSipChunkPayload, io.ReadCloser) (*ingest.SIPUpload, error)) *MockServiceUploadSipChunkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -40,7 +40,7 @@ func (svc *ingestImpl) BearerAuth(
 		return ctx, ErrUnauthorized
 	}
 
-	if !claims.CheckAttributes(scheme.RequiredScopes) {
+	if !claims.CheckAttributesInAnyScope(scheme.RequiredScopes) {
 		return ctx, ErrForbidden
 	}
 
@@ -64,6 +64,27 @@ func (svc *ingestImpl) AddSip(ctx context.Context, payload *goaingest.AddSipPayl
 		return nil, goaingest.MakeNotValid(errors.New("empty Key"))
 	}
 
+	profile := svc.sipSource.ProcessingProfile()
+	if payload.ProcessingProfile != nil && *payload.ProcessingProfile != "" {
+		profile = *payload.ProcessingProfile
+	}
+	if err := svc.checkProcessingProfile(profile); err != nil {
+		return nil, goaingest.MakeNotValid(err)
+	}
+
+	var locationID *uuid.UUID
+	if payload.LocationID != nil {
+		id, err := svc.parseAIPLocationID(ctx, *payload.LocationID)
+		if errors.Is(err, ErrInvalid) {
+			return nil, goaingest.MakeNotValid(err)
+		}
+		if err != nil {
+			svc.logger.Error(err, "add SIP")
+			return nil, ErrInternalError
+		}
+		locationID = &id
+	}
+
 	claims, err := checkClaims(ctx)
 	if err != nil {
 		return nil, goaingest.MakeNotValid(err)
@@ -71,9 +92,14 @@ func (svc *ingestImpl) AddSip(ctx context.Context, payload *goaingest.AddSipPayl
 
 	// Add a new SIP to the persistence layer.
 	s := &datatypes.SIP{
-		UUID:   uuid.Must(uuid.NewRandomFromReader(svc.rander)),
-		Name:   payload.Key,
-		Status: enums.SIPStatusQueued,
+		UUID:              uuid.Must(uuid.NewRandomFromReader(svc.rander)),
+		Name:              payload.Key,
+		Status:            enums.SIPStatusQueued,
+		ProcessingProfile: profile,
+		SIPSourceID:       uuid.NullUUID{UUID: sourceID, Valid: true},
+	}
+	if locationID != nil {
+		s.LocationID = uuid.NullUUID{UUID: *locationID, Valid: true}
 	}
 
 	// If claims is nil, it means authentication is not enabled.
@@ -94,19 +120,22 @@ func (svc *ingestImpl) AddSip(ctx context.Context, payload *goaingest.AddSipPayl
 
 	// Initialize the processing workflow.
 	req := ProcessingWorkflowRequest{
-		User:            childWorkflowUserFromClaims(claims),
-		SIPUUID:         s.UUID,
-		SIPSourceID:     sourceID,
-		SIPName:         s.Name,
-		Type:            enums.WorkflowTypeCreateAip,
-		Key:             payload.Key,
-		RetentionPeriod: svc.sipSource.RetentionPeriod(),
+		User:              childWorkflowUserFromClaims(claims),
+		SIPUUID:           s.UUID,
+		SIPSourceID:       sourceID,
+		SIPName:           s.Name,
+		Type:              enums.WorkflowTypeCreateAip,
+		Key:               payload.Key,
+		RetentionPeriod:   svc.sipSource.RetentionPeriod(),
+		ProcessingProfile: profile,
+		LocationID:        locationID,
 	}
 	if err := InitProcessingWorkflow(ctx, svc.tc, svc.taskQueue, &req); err != nil {
 		// Delete SIP from persistence.
 		err = errors.Join(
 			err,
 			withFailedIngestCleanupContext(ctx, func(cleanupCtx context.Context) error {
+				svc.unindexSIP(cleanupCtx, s.UUID)
 				return svc.perSvc.DeleteSIP(cleanupCtx, s.UUID)
 			}),
 		)
@@ -114,6 +143,7 @@ func (svc *ingestImpl) AddSip(ctx context.Context, payload *goaingest.AddSipPayl
 		return nil, ErrInternalError
 	}
 
+	svc.indexSIP(ctx, s)
 	PublishEvent(ctx, svc.evsvc, sipToCreatedEvent(s))
 	svc.auditLogger.Log(ctx, sipIngestAuditEvent(s))
 
@@ -127,6 +157,16 @@ func (svc *ingestImpl) AddSip(ctx context.Context, payload *goaingest.AddSipPayl
 	return &goaingest.AddSipResult{UUID: s.UUID.String()}, nil
 }
 
+// checkProcessingProfile returns an error if name is not empty and it's not
+// the name of a configured processing profile.
+func (svc *ingestImpl) checkProcessingProfile(name string) error {
+	if name != "" && svc.processingProfiles.ByName(name) == nil {
+		return fmt.Errorf("unknown processing profile: %q", name)
+	}
+
+	return nil
+}
+
 // List all SIPs. It implements goaingest.Service.
 func (svc *ingestImpl) ListSips(ctx context.Context, payload *goaingest.ListSipsPayload) (*goaingest.SIPs, error) {
 	if payload == nil {
@@ -137,6 +177,7 @@ func (svc *ingestImpl) ListSips(ctx context.Context, payload *goaingest.ListSips
 	if err != nil {
 		return nil, err
 	}
+	pf.Scope = resourceScope(ctx, auth.IngestSIPSListAttr)
 
 	r, pg, err := svc.perSvc.ListSIPs(ctx, pf)
 	if err != nil {
@@ -172,6 +213,9 @@ func (svc *ingestImpl) ShowSip(
 	} else if err != nil {
 		return nil, goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
 	}
+	if err := checkSIPScope(ctx, auth.IngestSIPSReadAttr, s); err != nil {
+		return nil, err
+	}
 
 	return s.Goa(), nil
 }
@@ -302,9 +346,11 @@ func (svc *ingestImpl) pendingSIPWorkflowTemporalID(ctx context.Context, sipID s
 	if err != nil {
 		return "", err
 	}
-	if goaworkflows == nil || len(goaworkflows.Workflows) == 0 || len(goaworkflows.Workflows) > 1 {
+	if goaworkflows == nil || len(goaworkflows.Workflows) == 0 {
 		return "", goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
 	}
+
+	// Workflows are listed newest first, a retried SIP may have older ones.
 	if goaworkflows.Workflows[0].Status != enums.WorkflowStatusPending.String() {
 		return "", goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
 	}
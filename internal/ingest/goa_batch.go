@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
 	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
 	"github.com/artefactual-sdps/enduro/internal/enums"
 	"github.com/artefactual-sdps/enduro/internal/persistence"
+	"github.com/artefactual-sdps/enduro/internal/sipsource"
 )
 
 func (svc *ingestImpl) AddBatch(
@@ -26,8 +29,20 @@ func (svc *ingestImpl) AddBatch(
 		return nil, goaingest.MakeNotValid(errors.New("invalid SourceID"))
 	}
 
-	if len(payload.Keys) == 0 {
-		return nil, goaingest.MakeNotValid(errors.New("empty Keys"))
+	sips, err := svc.batchSIPs(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.setBatchSIPLocations(ctx, payload.LocationID, sips); err != nil {
+		return nil, err
+	}
+
+	profile := svc.sipSource.ProcessingProfile()
+	if payload.ProcessingProfile != nil && *payload.ProcessingProfile != "" {
+		profile = *payload.ProcessingProfile
+	}
+	if err := svc.checkProcessingProfile(profile); err != nil {
+		return nil, goaingest.MakeNotValid(err)
 	}
 
 	claims, err := checkClaims(ctx)
@@ -42,10 +57,11 @@ func (svc *ingestImpl) AddBatch(
 		identifier = *payload.Identifier
 	}
 	b := &datatypes.Batch{
-		UUID:       bUUID,
-		Status:     enums.BatchStatusQueued,
-		Identifier: identifier,
-		SIPSCount:  len(payload.Keys),
+		UUID:        bUUID,
+		Status:      enums.BatchStatusQueued,
+		Identifier:  identifier,
+		SIPSCount:   len(sips),
+		SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 	}
 
 	if claims != nil {
@@ -64,11 +80,15 @@ func (svc *ingestImpl) AddBatch(
 	}
 
 	req := BatchWorkflowRequest{
-		User:            childWorkflowUserFromClaims(claims),
-		Batch:           *b,
-		SIPSourceID:     sourceID,
-		Keys:            payload.Keys,
-		RetentionPeriod: svc.sipSource.RetentionPeriod(),
+		User:              childWorkflowUserFromClaims(claims),
+		Batch:             *b,
+		SIPSourceID:       sourceID,
+		Keys:              batchSIPKeys(sips),
+		RetentionPeriod:   svc.sipSource.RetentionPeriod(),
+		ProcessingProfile: profile,
+	}
+	if payload.Manifest != nil || payload.LocationID != nil {
+		req.SIPs = sips
 	}
 	if err := InitBatchWorkflow(ctx, svc.tc, svc.taskQueue, &req); err != nil {
 		// Delete Batch from persistence.
@@ -82,12 +102,112 @@ func (svc *ingestImpl) AddBatch(
 		return nil, ErrInternalError
 	}
 
+	svc.metrics.recordBatchSize(ctx, len(sips))
 	PublishEvent(ctx, svc.evsvc, batchToCreatedEvent(b))
 	svc.auditLogger.Log(ctx, batchIngestAuditEvent(b))
 
 	return &goaingest.AddBatchResult{UUID: bUUID.String()}, nil
 }
 
+// batchSIPs returns the SIPs to ingest as part of the batch requested in
+// payload, either listed by key or in a manifest. SIPs listed in a manifest
+// must exist in the SIP source and use a valid processing profile.
+func (svc *ingestImpl) batchSIPs(ctx context.Context, payload *goaingest.AddBatchPayload) ([]*BatchSIP, error) {
+	if payload.Manifest == nil {
+		if len(payload.Keys) == 0 {
+			return nil, goaingest.MakeNotValid(errors.New("empty Keys"))
+		}
+
+		sips := make([]*BatchSIP, len(payload.Keys))
+		for i, key := range payload.Keys {
+			sips[i] = &BatchSIP{Key: key}
+		}
+		return sips, nil
+	}
+
+	if len(payload.Keys) > 0 {
+		return nil, goaingest.MakeNotValid(errors.New("keys and manifest are mutually exclusive"))
+	}
+
+	format := BatchManifestFormatCSV
+	if payload.ManifestFormat != nil && *payload.ManifestFormat != "" {
+		format = *payload.ManifestFormat
+	}
+	sips, err := parseBatchManifest(*payload.Manifest, format)
+	if err != nil {
+		return nil, goaingest.MakeNotValid(fmt.Errorf("invalid manifest: %v", err))
+	}
+
+	var missing []string
+	for _, sip := range sips {
+		if err := svc.checkProcessingProfile(sip.ProcessingProfile); err != nil {
+			return nil, goaingest.MakeNotValid(fmt.Errorf("invalid manifest: SIP %q: %v", sip.Key, err))
+		}
+
+		ok, err := svc.sipSource.Exists(ctx, sip.Key)
+		if err != nil {
+			if errors.Is(err, sipsource.ErrInvalidSource) {
+				return nil, goaingest.MakeNotValid(errors.New("invalid SIP source"))
+			}
+			svc.logger.Error(err, "AddBatch: check SIP source object")
+			return nil, ErrInternalError
+		}
+		if !ok {
+			missing = append(missing, sip.Key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, goaingest.MakeNotValid(fmt.Errorf(
+			"invalid manifest: SIPs not found in the SIP source: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+
+	return sips, nil
+}
+
+// setBatchSIPLocations sets the AIP storage location requested for the batch
+// on the SIPs without a location of their own, then checks that all the
+// requested locations are "aip_store" locations.
+func (svc *ingestImpl) setBatchSIPLocations(ctx context.Context, locationID *string, sips []*BatchSIP) error {
+	if locationID != nil {
+		id, err := uuid.Parse(*locationID)
+		if err != nil {
+			return goaingest.MakeNotValid(errors.New("invalid location_id"))
+		}
+		for _, sip := range sips {
+			if sip.LocationID == nil {
+				sip.LocationID = &id
+			}
+		}
+	}
+
+	var ids []uuid.UUID
+	for _, sip := range sips {
+		if sip.LocationID != nil {
+			ids = append(ids, *sip.LocationID)
+		}
+	}
+	err := svc.checkAIPLocations(ctx, ids...)
+	if errors.Is(err, ErrInvalid) {
+		return goaingest.MakeNotValid(err)
+	}
+	if err != nil {
+		svc.logger.Error(err, "AddBatch: check AIP locations")
+		return ErrInternalError
+	}
+
+	return nil
+}
+
+func batchSIPKeys(sips []*BatchSIP) []string {
+	keys := make([]string, len(sips))
+	for i, sip := range sips {
+		keys[i] = sip.Key
+	}
+	return keys
+}
+
 func (svc *ingestImpl) ListBatches(
 	ctx context.Context,
 	payload *goaingest.ListBatchesPayload,
@@ -100,6 +220,7 @@ func (svc *ingestImpl) ListBatches(
 	if err != nil {
 		return nil, err
 	}
+	pf.Scope = resourceScope(ctx, auth.IngestBatchesListAttr)
 
 	r, pg, err := svc.perSvc.ListBatches(ctx, pf)
 	if err != nil {
@@ -136,6 +257,9 @@ func (svc *ingestImpl) ShowBatch(
 		svc.logger.Error(err, "ShowBatch")
 		return nil, ErrInternalError
 	}
+	if err := checkBatchScope(ctx, auth.IngestBatchesReadAttr, b); err != nil {
+		return nil, err
+	}
 
 	return b.Goa(), nil
 }
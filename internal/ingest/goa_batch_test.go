@@ -2,11 +2,15 @@ package ingest_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"go.artefactual.dev/tools/mockutil"
@@ -14,16 +18,24 @@ import (
 	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
 	temporalsdk_client "go.temporal.io/sdk/client"
 	temporalsdk_mocks "go.temporal.io/sdk/mocks"
+	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
 
 	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
 	"github.com/artefactual-sdps/enduro/internal/auth"
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
 	"github.com/artefactual-sdps/enduro/internal/entfilter"
 	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/event"
 	"github.com/artefactual-sdps/enduro/internal/ingest"
+	ingest_fake "github.com/artefactual-sdps/enduro/internal/ingest/fake"
 	"github.com/artefactual-sdps/enduro/internal/persistence"
 	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+	"github.com/artefactual-sdps/enduro/internal/pres"
+	"github.com/artefactual-sdps/enduro/internal/sipsource"
+	sipsource_fake "github.com/artefactual-sdps/enduro/internal/sipsource/fake"
 	"github.com/artefactual-sdps/enduro/internal/timerange"
 	"github.com/artefactual-sdps/enduro/pkg/childwf"
 )
@@ -37,16 +49,18 @@ func TestAddBatch(t *testing.T) {
 	keys := []string{"sip1.zip", "sip2.zip", "sip3.zip"}
 	identifier := new("custom-identifier")
 	batch := &datatypes.Batch{
-		UUID:       batchUUID,
-		Identifier: fmt.Sprintf("Batch-%s", batchUUID.String()),
-		Status:     enums.BatchStatusQueued,
-		SIPSCount:  len(keys),
+		UUID:        batchUUID,
+		Identifier:  fmt.Sprintf("Batch-%s", batchUUID.String()),
+		Status:      enums.BatchStatusQueued,
+		SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+		SIPSCount:   len(keys),
 	}
 	batchWithUploader := &datatypes.Batch{
-		UUID:       batchUUID,
-		Identifier: fmt.Sprintf("Batch-%s", batchUUID.String()),
-		Status:     enums.BatchStatusQueued,
-		SIPSCount:  len(keys),
+		UUID:        batchUUID,
+		Identifier:  fmt.Sprintf("Batch-%s", batchUUID.String()),
+		Status:      enums.BatchStatusQueued,
+		SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+		SIPSCount:   len(keys),
 		Uploader: &datatypes.User{
 			UUID:    userUUID,
 			Email:   "nobody@example.com",
@@ -79,6 +93,15 @@ func TestAddBatch(t *testing.T) {
 			payload: &goaingest.AddBatchPayload{SourceID: sourceID.String()},
 			wantErr: "empty Keys",
 		},
+		{
+			name: "Returns not valid error (unknown processing profile)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID:          sourceID.String(),
+				Keys:              keys,
+				ProcessingProfile: new("unknown"),
+			},
+			wantErr: `unknown processing profile: "unknown"`,
+		},
 		{
 			name:    "Returns not valid error (invalid claims Iss)",
 			payload: &goaingest.AddBatchPayload{SourceID: sourceID.String(), Keys: keys},
@@ -98,10 +121,11 @@ func TestAddBatch(t *testing.T) {
 				psvc.EXPECT().CreateBatch(
 					ctx,
 					&datatypes.Batch{
-						UUID:       batchUUID,
-						Identifier: *identifier,
-						Status:     enums.BatchStatusQueued,
-						SIPSCount:  len(keys),
+						UUID:        batchUUID,
+						Identifier:  *identifier,
+						Status:      enums.BatchStatusQueued,
+						SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+						SIPSCount:   len(keys),
 					},
 				).Return(errors.New("persistence error"))
 			},
@@ -234,6 +258,203 @@ func TestAddBatch(t *testing.T) {
 	}
 }
 
+func TestAddBatchManifest(t *testing.T) {
+	t.Parallel()
+
+	sourceID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	batchUUID := uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72")
+	locationID := uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1")
+	manifest := `key,title,processing_profile,location_id,collection
+sip1.zip,Letters,fast,f2cc963f-c14d-4eaa-b950-bd207189a1f1,Smith
+sip2.zip,,,,
+`
+	batch := &datatypes.Batch{
+		UUID:        batchUUID,
+		Identifier:  fmt.Sprintf("Batch-%s", batchUUID.String()),
+		Status:      enums.BatchStatusQueued,
+		SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+		SIPSCount:   2,
+	}
+
+	for _, tt := range []struct {
+		name    string
+		payload *goaingest.AddBatchPayload
+		mock    func(context.Context, *sipsource_fake.MockSIPSourceMockRecorder, *persistence_fake.MockService, *temporalsdk_mocks.Client)
+		want    *goaingest.AddBatchResult
+		wantErr string
+	}{
+		{
+			name: "Returns not valid error (keys and manifest)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID: sourceID.String(),
+				Keys:     []string{"sip1.zip"},
+				Manifest: &manifest,
+			},
+			wantErr: "keys and manifest are mutually exclusive",
+		},
+		{
+			name: "Returns not valid error (invalid manifest)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID:       sourceID.String(),
+				Manifest:       new(`[{"title": "Letters"}]`),
+				ManifestFormat: new(ingest.BatchManifestFormatJSON),
+			},
+			wantErr: "invalid manifest: SIP 1: missing key",
+		},
+		{
+			name: "Returns not valid error (unknown SIP processing profile)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID: sourceID.String(),
+				Manifest: new("key,processing_profile\nsip1.zip,unknown\n"),
+			},
+			wantErr: `invalid manifest: SIP "sip1.zip": unknown processing profile: "unknown"`,
+		},
+		{
+			name: "Returns not valid error (missing SIPs)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID: sourceID.String(),
+				Manifest: &manifest,
+			},
+			mock: func(
+				ctx context.Context,
+				src *sipsource_fake.MockSIPSourceMockRecorder,
+				psvc *persistence_fake.MockService,
+				tc *temporalsdk_mocks.Client,
+			) {
+				src.Exists(ctx, "sip1.zip").Return(false, nil)
+				src.Exists(ctx, "sip2.zip").Return(false, nil)
+			},
+			wantErr: "invalid manifest: SIPs not found in the SIP source: sip1.zip, sip2.zip",
+		},
+		{
+			name: "Returns not valid error (invalid SIP source)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID: sourceID.String(),
+				Manifest: &manifest,
+			},
+			mock: func(
+				ctx context.Context,
+				src *sipsource_fake.MockSIPSourceMockRecorder,
+				psvc *persistence_fake.MockService,
+				tc *temporalsdk_mocks.Client,
+			) {
+				src.Exists(ctx, "sip1.zip").Return(false, sipsource.ErrInvalidSource)
+			},
+			wantErr: "invalid SIP source",
+		},
+		{
+			name: "Returns internal error (SIP source error)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID: sourceID.String(),
+				Manifest: &manifest,
+			},
+			mock: func(
+				ctx context.Context,
+				src *sipsource_fake.MockSIPSourceMockRecorder,
+				psvc *persistence_fake.MockService,
+				tc *temporalsdk_mocks.Client,
+			) {
+				src.Exists(ctx, "sip1.zip").Return(false, errors.New("bucket error"))
+			},
+			wantErr: "internal error",
+		},
+		{
+			name: "Adds a batch from a manifest",
+			payload: &goaingest.AddBatchPayload{
+				SourceID: sourceID.String(),
+				Manifest: &manifest,
+			},
+			mock: func(
+				ctx context.Context,
+				src *sipsource_fake.MockSIPSourceMockRecorder,
+				psvc *persistence_fake.MockService,
+				tc *temporalsdk_mocks.Client,
+			) {
+				src.Exists(ctx, "sip1.zip").Return(true, nil)
+				src.Exists(ctx, "sip2.zip").Return(true, nil)
+				src.ProcessingProfile().Return("").AnyTimes()
+				src.RetentionPeriod().Return(time.Duration(-1))
+
+				psvc.EXPECT().CreateBatch(mockutil.Context(), batch).Return(nil)
+
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					temporalsdk_client.StartWorkflowOptions{
+						ID:                    fmt.Sprintf("%s-%s", ingest.BatchWorkflowName, batchUUID.String()),
+						TaskQueue:             "test",
+						WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+					},
+					ingest.BatchWorkflowName,
+					&ingest.BatchWorkflowRequest{
+						Batch:           *batch,
+						SIPSourceID:     sourceID,
+						Keys:            []string{"sip1.zip", "sip2.zip"},
+						RetentionPeriod: -1,
+						SIPs: []*ingest.BatchSIP{
+							{
+								Key:               "sip1.zip",
+								Title:             "Letters",
+								ProcessingProfile: "fast",
+								LocationID:        &locationID,
+								CustomMetadata: childwf.CustomMetadata{
+									"collection": json.RawMessage(`"Smith"`),
+								},
+							},
+							{Key: "sip2.zip"},
+						},
+					},
+				).Return(nil, nil)
+			},
+			want: &goaingest.AddBatchResult{UUID: batchUUID.String()},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			src := sipsource_fake.NewMockSIPSource(gomock.NewController(t))
+			psvc := persistence_fake.NewMockService(gomock.NewController(t))
+			tc := new(temporalsdk_mocks.Client)
+			if tt.mock != nil {
+				tt.mock(ctx, src.EXPECT(), psvc, tc)
+			} else {
+				src.EXPECT().ProcessingProfile().Return("").AnyTimes()
+			}
+			sc := ingest_fake.NewMockStorageClient(gomock.NewController(t))
+			sc.EXPECT().
+				ListLocations(ctx, &goastorage.ListLocationsPayload{}).
+				Return(goastorage.LocationCollection{{UUID: locationID, Purpose: "aip_store"}}, nil).
+				AnyTimes()
+
+			svc := ingest.NewService(ingest.ServiceParams{
+				Logger:             logr.Discard(),
+				TemporalClient:     tc,
+				EventService:       event.NewServiceNop[*goaingest.IngestEvent](),
+				PersistenceService: psvc,
+				TaskQueue:          "test",
+				Rander:             rand.New(rand.NewSource(1)), // #nosec: G404
+				SIPSource:          src,
+				ProcessingProfiles: pres.Profiles{{Name: "fast"}},
+				StorageClient:      sc,
+				AuditLogger: auditlog.NewFromConfig(auditlog.Config{
+					Filepath: filepath.Join(t.TempDir(), "audit.log"),
+				}),
+			})
+
+			re, err := svc.AddBatch(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.DeepEqual(t, re, tt.want)
+			tc.AssertExpectations(t)
+		})
+	}
+}
+
 func TestShowBatch(t *testing.T) {
 	t.Parallel()
 
@@ -243,9 +464,12 @@ func TestShowBatch(t *testing.T) {
 	startedAt := time.Date(2024, 9, 25, 9, 31, 11, 0, time.UTC)
 	completedAt := time.Date(2024, 9, 25, 9, 31, 12, 0, time.UTC)
 
+	sourceID := uuid.New()
+
 	for _, tt := range []struct {
 		name    string
 		payload *goaingest.ShowBatchPayload
+		claims  *auth.Claims
 		mock    func(context.Context, *persistence_fake.MockService)
 		want    *goaingest.Batch
 		wantErr string
@@ -303,12 +527,55 @@ func TestShowBatch(t *testing.T) {
 				UploaderName:  new("Test User"),
 			},
 		},
+		{
+			name:    "Returns batch in the user SIP source scope",
+			payload: &goaingest.ShowBatchPayload{UUID: batchUUID.String()},
+			claims: &auth.Claims{
+				Attributes: []string{auth.ScopedAttribute(auth.IngestBatchesReadAttr, auth.SIPSourceScope, sourceID)},
+			},
+			mock: func(ctx context.Context, psvc *persistence_fake.MockService) {
+				psvc.EXPECT().ReadBatch(ctx, batchUUID).Return(&datatypes.Batch{
+					UUID:        batchUUID,
+					Identifier:  "batch-identifier",
+					Status:      enums.BatchStatusIngested,
+					SIPSCount:   3,
+					CreatedAt:   createdAt,
+					SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+				}, nil)
+			},
+			want: &goaingest.Batch{
+				UUID:       batchUUID,
+				Identifier: "batch-identifier",
+				Status:     enums.BatchStatusIngested.String(),
+				SipsCount:  3,
+				CreatedAt:  createdAt.Format(time.RFC3339),
+			},
+		},
+		{
+			name:    "Returns not found error for a batch out of the user scope",
+			payload: &goaingest.ShowBatchPayload{UUID: batchUUID.String()},
+			claims: &auth.Claims{
+				Attributes: []string{auth.ScopedAttribute(auth.IngestBatchesReadAttr, auth.BatchScope, uuid.New())},
+			},
+			mock: func(ctx context.Context, psvc *persistence_fake.MockService) {
+				psvc.EXPECT().ReadBatch(ctx, batchUUID).Return(&datatypes.Batch{
+					UUID:        batchUUID,
+					Identifier:  "batch-identifier",
+					Status:      enums.BatchStatusIngested,
+					SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+				}, nil)
+			},
+			wantErr: "Batch not found.",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
 			svc, psvc, _ := testSvc(t, nil, 0)
 			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
 			if tt.mock != nil {
 				tt.mock(ctx, psvc)
 			}
@@ -472,6 +739,7 @@ func TestListBatches(t *testing.T) {
 	for _, tt := range []struct {
 		name         string
 		payload      *goaingest.ListBatchesPayload
+		claims       *auth.Claims
 		mockRecorder func(*persistence_fake.MockServiceMockRecorder)
 		want         *goaingest.Batches
 		wantErr      string
@@ -526,6 +794,42 @@ func TestListBatches(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Returns the batches in the user resource scope",
+			claims: &auth.Claims{
+				Attributes: []string{auth.ScopedAttribute(auth.IngestBatchesListAttr, auth.BatchScope, batchUUID3)},
+			},
+			mockRecorder: func(mr *persistence_fake.MockServiceMockRecorder) {
+				mr.ListBatches(
+					mockutil.Context(),
+					&persistence.BatchFilter{
+						Sort: entfilter.NewSort().AddCol("id", true),
+						Scope: &persistence.ResourceScope{
+							BatchIDs: []uuid.UUID{batchUUID3},
+						},
+					},
+				).Return(
+					testBatches[2:],
+					&persistence.Page{Limit: 20, Total: 1},
+					nil,
+				)
+			},
+			want: &goaingest.Batches{
+				Items: goaingest.BatchCollection{
+					{
+						UUID:       batchUUID3,
+						Identifier: "Batch 3",
+						SipsCount:  1,
+						Status:     enums.BatchStatusFailed.String(),
+						CreatedAt:  "2024-10-01T17:13:26Z",
+					},
+				},
+				Page: &goaingest.EnduroPage{
+					Limit: 20,
+					Total: 1,
+				},
+			},
+		},
 		{
 			name: "Returns filtered batches",
 			payload: &goaingest.ListBatchesPayload{
@@ -643,7 +947,12 @@ func TestListBatches(t *testing.T) {
 				tt.mockRecorder(psvc.EXPECT())
 			}
 
-			got, err := svc.ListBatches(t.Context(), tt.payload)
+			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+
+			got, err := svc.ListBatches(ctx, tt.payload)
 			if tt.wantErr != "" {
 				assert.Error(t, err, tt.wantErr)
 				return
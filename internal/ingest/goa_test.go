@@ -177,6 +177,55 @@ func TestListSipWorkflowsErrors(t *testing.T) {
 	}
 }
 
+func TestShowSipScope(t *testing.T) {
+	t.Parallel()
+
+	sipUUID := uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72")
+	batchUUID := uuid.MustParse("ffdb12f4-1735-4022-b746-a9bf4a32109b")
+	sip := &datatypes.SIP{
+		UUID:   sipUUID,
+		Status: enums.SIPStatusIngested,
+		Batch:  &datatypes.Batch{UUID: batchUUID},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		attrs   []string
+		wantErr string
+	}{
+		{
+			name:  "Returns a SIP in the user batch scope",
+			attrs: []string{auth.ScopedAttribute(auth.IngestSIPSReadAttr, auth.BatchScope, batchUUID)},
+		},
+		{
+			name:  "Returns a SIP with a batch wildcard scope",
+			attrs: []string{auth.IngestSIPSReadAttr + ":batch/*"},
+		},
+		{
+			name:    "Returns not found for a SIP out of the user scope",
+			attrs:   []string{auth.ScopedAttribute(auth.IngestSIPSReadAttr, auth.SIPSourceScope, batchUUID)},
+			wantErr: "SIP not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			psvc := persistence_fake.NewMockService(gomock.NewController(t))
+			psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+			svc := ingest.NewService(ingest.ServiceParams{PersistenceService: psvc})
+
+			ctx := auth.WithUserClaims(t.Context(), &auth.Claims{Attributes: tt.attrs})
+			got, err := svc.ShowSip(ctx, &goaingest.ShowSipPayload{UUID: sipUUID.String()})
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, got.UUID, sipUUID)
+		})
+	}
+}
+
 func TestShowSipDecision(t *testing.T) {
 	t.Parallel()
 
@@ -663,6 +712,15 @@ func TestAddSIP(t *testing.T) {
 			claims:  &auth.Claims{Iss: "http://keycloak:7470/realms/artefactual"},
 			wantErr: "invalid user claims: missing Sub",
 		},
+		{
+			name: "Returns not valid error (unknown processing profile)",
+			payload: &goaingest.AddSipPayload{
+				SourceID:          sourceID.String(),
+				Key:               key,
+				ProcessingProfile: ref.New("unknown"),
+			},
+			wantErr: `unknown processing profile: "unknown"`,
+		},
 		{
 			name:    "Returns persistence error",
 			payload: &goaingest.AddSipPayload{SourceID: sourceID.String(), Key: key},
@@ -670,9 +728,10 @@ func TestAddSIP(t *testing.T) {
 				psvc.EXPECT().CreateSIP(
 					ctx,
 					&datatypes.SIP{
-						UUID:   sipUUID,
-						Name:   key,
-						Status: enums.SIPStatusQueued,
+						UUID:        sipUUID,
+						Name:        key,
+						Status:      enums.SIPStatusQueued,
+						SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 					},
 				).Return(errors.New("persistence error"))
 			},
@@ -686,9 +745,10 @@ func TestAddSIP(t *testing.T) {
 				psvc.EXPECT().CreateSIP(
 					ctx,
 					&datatypes.SIP{
-						UUID:   sipUUID,
-						Name:   key,
-						Status: enums.SIPStatusQueued,
+						UUID:        sipUUID,
+						Name:        key,
+						Status:      enums.SIPStatusQueued,
+						SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 					},
 				).DoAndReturn(func(ctx context.Context, s *datatypes.SIP) error {
 					s.ID = 1
@@ -730,9 +790,10 @@ func TestAddSIP(t *testing.T) {
 				psvc.EXPECT().CreateSIP(
 					mockutil.Context(),
 					mockutil.Eq(&datatypes.SIP{
-						UUID:   sipUUID,
-						Name:   key,
-						Status: enums.SIPStatusQueued,
+						UUID:        sipUUID,
+						Name:        key,
+						Status:      enums.SIPStatusQueued,
+						SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 					}),
 				).Return(nil)
 
@@ -756,6 +817,46 @@ func TestAddSIP(t *testing.T) {
 			},
 			want: &goaingest.AddSipResult{UUID: sipUUID.String()},
 		},
+		{
+			name: "Uploads a SIP with a processing profile",
+			payload: &goaingest.AddSipPayload{
+				SourceID:          sourceID.String(),
+				Key:               key,
+				ProcessingProfile: ref.New("fast"),
+			},
+			mock: func(ctx context.Context, psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().CreateSIP(
+					mockutil.Context(),
+					mockutil.Eq(&datatypes.SIP{
+						UUID:              sipUUID,
+						Name:              key,
+						Status:            enums.SIPStatusQueued,
+						SIPSourceID:       uuid.NullUUID{UUID: sourceID, Valid: true},
+						ProcessingProfile: "fast",
+					}),
+				).Return(nil)
+
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					temporalsdk_client.StartWorkflowOptions{
+						ID:                    fmt.Sprintf("processing-workflow-%s", sipUUID.String()),
+						TaskQueue:             "test",
+						WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+					},
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID:           sipUUID,
+						SIPSourceID:       sourceID,
+						SIPName:           key,
+						Type:              enums.WorkflowTypeCreateAip,
+						Key:               key,
+						ProcessingProfile: "fast",
+					},
+				).Return(nil, nil)
+			},
+			want: &goaingest.AddSipResult{UUID: sipUUID.String()},
+		},
 		{
 			name:    "Uploads a SIP and creates a user",
 			payload: &goaingest.AddSipPayload{SourceID: sourceID.String(), Key: key},
@@ -769,9 +870,10 @@ func TestAddSIP(t *testing.T) {
 				psvc.EXPECT().CreateSIP(
 					mockutil.Context(),
 					mockutil.Eq(&datatypes.SIP{
-						UUID:   sipUUID,
-						Name:   key,
-						Status: enums.SIPStatusQueued,
+						UUID:        sipUUID,
+						Name:        key,
+						Status:      enums.SIPStatusQueued,
+						SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 						Uploader: &datatypes.User{
 							UUID:    userUUID,
 							Email:   "nobody@example.com",
@@ -895,6 +997,7 @@ func TestListSIPs(t *testing.T) {
 	type test struct {
 		name         string
 		payload      *goaingest.ListSipsPayload
+		claims       *auth.Claims
 		mockRecorder func(mr *persistence_fake.MockServiceMockRecorder)
 		want         *goaingest.SIPs
 		wantErr      string
@@ -957,6 +1060,54 @@ func TestListSIPs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Returns the SIPs in the user resource scope",
+			claims: &auth.Claims{
+				Attributes: []string{
+					"ingest:sips:list:batch/ffdb12f4-1735-4022-b746-a9bf4a32109b",
+					"ingest:sips:list:source/123e4567-e89b-12d3-a456-426614174000",
+					"ingest:sips:read:batch/*",
+				},
+			},
+			mockRecorder: func(mr *persistence_fake.MockServiceMockRecorder) {
+				mr.ListSIPs(
+					mockutil.Context(),
+					&persistence.SIPFilter{
+						Sort: entfilter.NewSort().AddCol("id", true),
+						Scope: &persistence.ResourceScope{
+							BatchIDs: []uuid.UUID{
+								uuid.MustParse("ffdb12f4-1735-4022-b746-a9bf4a32109b"),
+							},
+							SIPSourceIDs: []uuid.UUID{
+								uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+							},
+						},
+					},
+				).Return(
+					testSIPs[1:2],
+					&persistence.Page{Limit: 20, Total: 1},
+					nil,
+				)
+			},
+			want: &goaingest.SIPs{
+				Items: goaingest.SIPCollection{
+					{
+						UUID:        sipUUID2,
+						Name:        new("Test SIP 2"),
+						Status:      enums.SIPStatusProcessing.String(),
+						AipUUID:     new("ffdb12f4-1735-4022-b746-a9bf4a32109b"),
+						CreatedAt:   "2024-10-01T17:13:26Z",
+						StartedAt:   new("2024-10-01T17:13:27Z"),
+						CompletedAt: new("2024-10-01T17:13:28Z"),
+						FileCount:   new(int32(4)),
+					},
+				},
+				Page: &goaingest.EnduroPage{
+					Limit: 20,
+					Total: 1,
+				},
+			},
+		},
 		{
 			name: "Returns filtered SIPs",
 			payload: &goaingest.ListSipsPayload{
@@ -1103,6 +1254,10 @@ func TestListSIPs(t *testing.T) {
 				PersistenceService: perSvc,
 			})
 
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+
 			got, err := svc.ListSips(ctx, tt.payload)
 			if tt.wantErr != "" {
 				assert.Error(t, err, tt.wantErr)
@@ -11,6 +11,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	temporalsdk_client "go.temporal.io/sdk/client"
 	"gocloud.dev/blob"
 
@@ -21,7 +23,10 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/enums"
 	"github.com/artefactual-sdps/enduro/internal/event"
 	"github.com/artefactual-sdps/enduro/internal/persistence"
+	"github.com/artefactual-sdps/enduro/internal/pres"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/sipsource"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
 )
 
 const (
@@ -61,6 +66,8 @@ type Service interface {
 		note *string,
 	) error
 	UpdateBatch(context.Context, uuid.UUID, persistence.BatchUpdater) (*datatypes.Batch, error)
+	IndexSIP(ctx context.Context, id uuid.UUID, md childwf.CustomMetadata) error
+	CheckAIPLocation(ctx context.Context, id uuid.UUID) error
 }
 
 type ingestImpl struct {
@@ -74,9 +81,16 @@ type ingestImpl struct {
 	internalStorage       *blob.Bucket
 	uploadMaxSize         int64
 	uploadRetentionPeriod time.Duration
+	uploadSessionExpiry   time.Duration
+	apiTokenMaxLifetime   time.Duration
 	rander                io.Reader
 	sipSource             sipsource.SIPSource
 	auditLogger           *auditlog.Logger
+	processingProfiles    pres.Profiles
+	presTaskQueue         string
+	searchBackend         search.Backend
+	storageClient         StorageClient
+	metrics               *ingestMetrics
 }
 
 var _ Service = (*ingestImpl)(nil)
@@ -93,25 +107,52 @@ type ServiceParams struct {
 	InternalStorage       *blob.Bucket
 	UploadMaxSize         int64
 	UploadRetentionPeriod time.Duration
+	UploadSessionExpiry   time.Duration
+	APITokenMaxLifetime   time.Duration
 	Rander                io.Reader
 	SIPSource             sipsource.SIPSource
 	AuditLogger           *auditlog.Logger
+	ProcessingProfiles    pres.Profiles
+	PresTaskQueue         string
+	SearchBackend         search.Backend
+	StorageClient         StorageClient
+	MeterProvider         metric.MeterProvider
 }
 
 func NewService(params ServiceParams) *ingestImpl {
+	if params.UploadSessionExpiry <= 0 {
+		params.UploadSessionExpiry = defaultUploadSessionExpiry
+	}
+	if params.APITokenMaxLifetime <= 0 {
+		params.APITokenMaxLifetime = defaultAPITokenMaxLifetime
+	}
+	if params.SearchBackend == nil {
+		params.SearchBackend = search.NopBackend{}
+	}
+	if params.MeterProvider == nil {
+		params.MeterProvider = noop.NewMeterProvider()
+	}
+
 	return &ingestImpl{
-		logger:          params.Logger,
-		tc:              params.TemporalClient,
-		evsvc:           params.EventService,
-		perSvc:          params.PersistenceService,
-		tokenVerifier:   params.TokenVerifier,
-		ticketProvider:  params.TicketProvider,
-		taskQueue:       params.TaskQueue,
-		internalStorage: params.InternalStorage,
-		uploadMaxSize:   params.UploadMaxSize,
-		rander:          params.Rander,
-		sipSource:       params.SIPSource,
-		auditLogger:     params.AuditLogger,
+		logger:              params.Logger,
+		tc:                  params.TemporalClient,
+		evsvc:               params.EventService,
+		perSvc:              params.PersistenceService,
+		tokenVerifier:       params.TokenVerifier,
+		ticketProvider:      params.TicketProvider,
+		taskQueue:           params.TaskQueue,
+		internalStorage:     params.InternalStorage,
+		uploadMaxSize:       params.UploadMaxSize,
+		uploadSessionExpiry: params.UploadSessionExpiry,
+		apiTokenMaxLifetime: params.APITokenMaxLifetime,
+		rander:              params.Rander,
+		sipSource:           params.SIPSource,
+		auditLogger:         params.AuditLogger,
+		processingProfiles:  params.ProcessingProfiles,
+		presTaskQueue:       params.PresTaskQueue,
+		searchBackend:       params.SearchBackend,
+		storageClient:       params.StorageClient,
+		metrics:             newIngestMetrics(params.MeterProvider),
 	}
 }
 
@@ -123,6 +164,7 @@ func (svc *ingestImpl) CreateSIP(ctx context.Context, s *datatypes.SIP) error {
 		return fmt.Errorf("ingest: create SIP: %v", err)
 	}
 
+	svc.indexSIP(ctx, s)
 	PublishEvent(ctx, svc.evsvc, sipToCreatedEvent(s))
 	svc.auditLogger.Log(ctx, sipIngestAuditEvent(s))
 
@@ -134,10 +176,15 @@ func (svc *ingestImpl) UpdateSIP(
 	id uuid.UUID,
 	upd persistence.SIPUpdater,
 ) (*datatypes.SIP, error) {
-	s, err := svc.perSvc.UpdateSIP(ctx, id, upd)
+	var prev enums.SIPStatus
+	s, err := svc.perSvc.UpdateSIP(ctx, id, func(s *datatypes.SIP) (*datatypes.SIP, error) {
+		prev = s.Status
+		return upd(s)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("ingest: update SIP: %v", err)
 	}
+	svc.metrics.recordSIPStatus(ctx, prev, s.Status)
 
 	ev := &goaingest.SIPUpdatedEvent{UUID: id, Item: s.Goa()}
 	PublishEvent(ctx, svc.evsvc, ev)
@@ -187,13 +234,16 @@ func (svc *ingestImpl) FindDuplicateSIP(
 }
 
 func (svc *ingestImpl) SetStatus(ctx context.Context, id uuid.UUID, status enums.SIPStatus) error {
+	var prev enums.SIPStatus
 	_, err := svc.perSvc.UpdateSIP(ctx, id, func(s *datatypes.SIP) (*datatypes.SIP, error) {
+		prev = s.Status
 		s.Status = status
 		return s, nil
 	})
 	if err != nil {
 		return fmt.Errorf("error updating SIP: %v", err)
 	}
+	svc.metrics.recordSIPStatus(ctx, prev, status)
 
 	ev := &goaingest.SIPStatusUpdatedEvent{UUID: id, Status: status.String()}
 	PublishEvent(ctx, svc.evsvc, ev)
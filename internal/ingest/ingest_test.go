@@ -29,6 +29,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/ingest"
 	"github.com/artefactual-sdps/enduro/internal/persistence"
 	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+	"github.com/artefactual-sdps/enduro/internal/pres"
 	"github.com/artefactual-sdps/enduro/internal/sipsource"
 	"github.com/artefactual-sdps/enduro/internal/workflow/activities"
 )
@@ -56,6 +57,7 @@ func testSvc(t *testing.T, internalBucket *blob.Bucket, uploadMaxSize int64) (
 		UploadMaxSize:      uploadMaxSize,
 		Rander:             rand.New(rand.NewSource(1)), // #nosec: G404
 		SIPSource:          &sipsource.BucketSource{},
+		ProcessingProfiles: pres.Profiles{{Name: "fast"}},
 		AuditLogger: auditlog.NewFromConfig(auditlog.Config{
 			Filepath: filepath.Join(t.TempDir(), "audit.log"),
 		}),
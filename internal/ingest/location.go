@@ -0,0 +1,71 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	storage_enums "github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/temporal"
+)
+
+// CheckAIPLocation returns an ErrInvalid error if id doesn't identify an
+// "aip_store" storage location, or if the AIP storage location can't be
+// selected with the preservation system.
+func (svc *ingestImpl) CheckAIPLocation(ctx context.Context, id uuid.UUID) error {
+	return svc.checkAIPLocations(ctx, id)
+}
+
+// checkAIPLocations returns an ErrInvalid error listing the IDs that don't
+// identify an "aip_store" storage location, or any error listing the storage
+// locations. Archivematica stores the AIPs in its own AMSS location, so any
+// location is rejected when it's the preservation system.
+func (svc *ingestImpl) checkAIPLocations(ctx context.Context, ids ...uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if svc.presTaskQueue == temporal.AmWorkerTaskQueue {
+		return fmt.Errorf("%w: AIP storage location can't be selected with Archivematica", ErrInvalid)
+	}
+	if svc.storageClient == nil {
+		return errors.New("check AIP locations: missing storage client")
+	}
+
+	locations, err := svc.storageClient.ListLocations(ctx, &goastorage.ListLocationsPayload{})
+	if err != nil {
+		return fmt.Errorf("check AIP locations: %v", err)
+	}
+
+	var invalid []string
+	for _, id := range ids {
+		if !slices.ContainsFunc(locations, func(l *goastorage.Location) bool {
+			return l.UUID == id && l.Purpose == storage_enums.LocationPurposeAipStore.String()
+		}) && !slices.Contains(invalid, id.String()) {
+			invalid = append(invalid, id.String())
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("%w: AIP storage location: %s", ErrInvalid, strings.Join(invalid, ", "))
+	}
+
+	return nil
+}
+
+// parseAIPLocationID parses the identifier of the AIP storage location
+// requested with a SIP and checks that it's an "aip_store" location.
+func (svc *ingestImpl) parseAIPLocationID(ctx context.Context, id string) (uuid.UUID, error) {
+	locationID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: location_id", ErrInvalid)
+	}
+	if err := svc.checkAIPLocations(ctx, locationID); err != nil {
+		return uuid.Nil, err
+	}
+
+	return locationID, nil
+}
@@ -0,0 +1,496 @@
+package ingest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
+	temporalsdk_client "go.temporal.io/sdk/client"
+	temporalsdk_mocks "go.temporal.io/sdk/mocks"
+	"go.uber.org/mock/gomock"
+	"gocloud.dev/blob/memblob"
+	"gotest.tools/v3/assert"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/event"
+	"github.com/artefactual-sdps/enduro/internal/ingest"
+	ingest_fake "github.com/artefactual-sdps/enduro/internal/ingest/fake"
+	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+	"github.com/artefactual-sdps/enduro/internal/pres"
+	sipsource_fake "github.com/artefactual-sdps/enduro/internal/sipsource/fake"
+	"github.com/artefactual-sdps/enduro/internal/temporal"
+)
+
+var (
+	aipStoreLocationID = uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1")
+	amssLocationID     = uuid.MustParse("e0ed8b2a-8ae2-4546-b5d8-f0090919df04")
+	testLocations      = goastorage.LocationCollection{
+		{UUID: aipStoreLocationID, Purpose: "aip_store"},
+		{UUID: amssLocationID, Purpose: "unspecified"},
+	}
+)
+
+type locationTestMocks struct {
+	src  *sipsource_fake.MockSIPSource
+	psvc *persistence_fake.MockService
+	sc   *ingest_fake.MockStorageClient
+	tc   *temporalsdk_mocks.Client
+}
+
+func locationTestSvc(t *testing.T, presTaskQueue string) (ingest.Service, *locationTestMocks) {
+	t.Helper()
+
+	m := &locationTestMocks{
+		src:  sipsource_fake.NewMockSIPSource(gomock.NewController(t)),
+		psvc: persistence_fake.NewMockService(gomock.NewController(t)),
+		sc:   ingest_fake.NewMockStorageClient(gomock.NewController(t)),
+		tc:   new(temporalsdk_mocks.Client),
+	}
+	m.src.EXPECT().ProcessingProfile().Return("").AnyTimes()
+	m.src.EXPECT().RetentionPeriod().Return(time.Duration(-1)).AnyTimes()
+
+	svc := ingest.NewService(ingest.ServiceParams{
+		Logger:             logr.Discard(),
+		TemporalClient:     m.tc,
+		EventService:       event.NewServiceNop[*goaingest.IngestEvent](),
+		PersistenceService: m.psvc,
+		TaskQueue:          "test",
+		PresTaskQueue:      presTaskQueue,
+		InternalStorage:    memblob.OpenBucket(nil),
+		UploadMaxSize:      1024,
+		Rander:             rand.New(rand.NewSource(1)), // #nosec: G404
+		SIPSource:          m.src,
+		ProcessingProfiles: pres.Profiles{{Name: "fast"}},
+		StorageClient:      m.sc,
+		AuditLogger: auditlog.NewFromConfig(auditlog.Config{
+			Filepath: filepath.Join(t.TempDir(), "audit.log"),
+		}),
+	})
+
+	return svc, m
+}
+
+func TestCheckAIPLocation(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name          string
+		id            uuid.UUID
+		presTaskQueue string
+		mock          func(context.Context, *ingest_fake.MockStorageClient)
+		wantErr       string
+	}{
+		{
+			name: "Accepts an aip_store location",
+			id:   aipStoreLocationID,
+			mock: func(ctx context.Context, sc *ingest_fake.MockStorageClient) {
+				sc.EXPECT().ListLocations(ctx, &goastorage.ListLocationsPayload{}).Return(testLocations, nil)
+			},
+		},
+		{
+			name: "Rejects a location with another purpose",
+			id:   amssLocationID,
+			mock: func(ctx context.Context, sc *ingest_fake.MockStorageClient) {
+				sc.EXPECT().ListLocations(ctx, &goastorage.ListLocationsPayload{}).Return(testLocations, nil)
+			},
+			wantErr: "invalid: AIP storage location: " + amssLocationID.String(),
+		},
+		{
+			name: "Fails to list the storage locations",
+			id:   aipStoreLocationID,
+			mock: func(ctx context.Context, sc *ingest_fake.MockStorageClient) {
+				sc.EXPECT().
+					ListLocations(ctx, &goastorage.ListLocationsPayload{}).
+					Return(nil, errors.New("storage error"))
+			},
+			wantErr: "check AIP locations: storage error",
+		},
+		{
+			name:          "Rejects any location with Archivematica",
+			id:            aipStoreLocationID,
+			presTaskQueue: temporal.AmWorkerTaskQueue,
+			wantErr:       "invalid: AIP storage location can't be selected with Archivematica",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			svc, m := locationTestSvc(t, tt.presTaskQueue)
+			if tt.mock != nil {
+				tt.mock(ctx, m.sc)
+			}
+
+			err := svc.CheckAIPLocation(ctx, tt.id)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestAddSIPLocation(t *testing.T) {
+	t.Parallel()
+
+	sourceID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	sipUUID := uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72")
+	key := "sip.zip"
+
+	for _, tt := range []struct {
+		name          string
+		payload       *goaingest.AddSipPayload
+		presTaskQueue string
+		mock          func(context.Context, *locationTestMocks)
+		wantErr       string
+	}{
+		{
+			name: "Returns not valid error (invalid location ID)",
+			payload: &goaingest.AddSipPayload{
+				SourceID:   sourceID.String(),
+				Key:        key,
+				LocationID: new("invalid"),
+			},
+			wantErr: "invalid: location_id",
+		},
+		{
+			name: "Returns not valid error (not an aip_store location)",
+			payload: &goaingest.AddSipPayload{
+				SourceID:   sourceID.String(),
+				Key:        key,
+				LocationID: new(amssLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.sc.EXPECT().ListLocations(ctx, &goastorage.ListLocationsPayload{}).Return(testLocations, nil)
+			},
+			wantErr: "invalid: AIP storage location: " + amssLocationID.String(),
+		},
+		{
+			name: "Returns internal error (storage error)",
+			payload: &goaingest.AddSipPayload{
+				SourceID:   sourceID.String(),
+				Key:        key,
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.sc.EXPECT().
+					ListLocations(ctx, &goastorage.ListLocationsPayload{}).
+					Return(nil, errors.New("storage error"))
+			},
+			wantErr: "internal error",
+		},
+		{
+			name: "Returns not valid error (Archivematica preservation system)",
+			payload: &goaingest.AddSipPayload{
+				SourceID:   sourceID.String(),
+				Key:        key,
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			presTaskQueue: temporal.AmWorkerTaskQueue,
+			wantErr:       "invalid: AIP storage location can't be selected with Archivematica",
+		},
+		{
+			name: "Adds a SIP with a location",
+			payload: &goaingest.AddSipPayload{
+				SourceID:   sourceID.String(),
+				Key:        key,
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.sc.EXPECT().ListLocations(ctx, &goastorage.ListLocationsPayload{}).Return(testLocations, nil)
+				m.psvc.EXPECT().CreateSIP(
+					mockutil.Context(),
+					mockutil.Eq(&datatypes.SIP{
+						UUID:        sipUUID,
+						Name:        key,
+						Status:      enums.SIPStatusQueued,
+						SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+						LocationID:  uuid.NullUUID{UUID: aipStoreLocationID, Valid: true},
+					}),
+				).Return(nil)
+				m.tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					temporalsdk_client.StartWorkflowOptions{
+						ID:                    fmt.Sprintf("processing-workflow-%s", sipUUID.String()),
+						TaskQueue:             "test",
+						WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+					},
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID:         sipUUID,
+						SIPSourceID:     sourceID,
+						SIPName:         key,
+						Type:            enums.WorkflowTypeCreateAip,
+						Key:             key,
+						RetentionPeriod: -1,
+						LocationID:      &aipStoreLocationID,
+					},
+				).Return(nil, nil)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			svc, m := locationTestSvc(t, tt.presTaskQueue)
+			if tt.mock != nil {
+				tt.mock(ctx, m)
+			}
+
+			re, err := svc.AddSip(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, re, &goaingest.AddSipResult{UUID: sipUUID.String()})
+			m.tc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAddBatchLocation(t *testing.T) {
+	t.Parallel()
+
+	sourceID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	batchUUID := uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72")
+	manifest := fmt.Sprintf("key,location_id\nsip1.zip,%s\nsip2.zip,\n", amssLocationID)
+	batch := &datatypes.Batch{
+		UUID:        batchUUID,
+		Identifier:  fmt.Sprintf("Batch-%s", batchUUID.String()),
+		Status:      enums.BatchStatusQueued,
+		SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+		SIPSCount:   2,
+	}
+
+	for _, tt := range []struct {
+		name          string
+		payload       *goaingest.AddBatchPayload
+		presTaskQueue string
+		mock          func(context.Context, *locationTestMocks)
+		wantErr       string
+	}{
+		{
+			name: "Returns not valid error (invalid location ID)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID:   sourceID.String(),
+				Keys:       []string{"sip1.zip", "sip2.zip"},
+				LocationID: new("invalid"),
+			},
+			wantErr: "invalid location_id",
+		},
+		{
+			name: "Returns not valid error (manifest location is not an aip_store location)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID:   sourceID.String(),
+				Manifest:   &manifest,
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.src.EXPECT().Exists(ctx, "sip1.zip").Return(true, nil)
+				m.src.EXPECT().Exists(ctx, "sip2.zip").Return(true, nil)
+				m.sc.EXPECT().ListLocations(ctx, &goastorage.ListLocationsPayload{}).Return(testLocations, nil)
+			},
+			wantErr: "invalid: AIP storage location: " + amssLocationID.String(),
+		},
+		{
+			name: "Returns internal error (storage error)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID:   sourceID.String(),
+				Keys:       []string{"sip1.zip", "sip2.zip"},
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.sc.EXPECT().
+					ListLocations(ctx, &goastorage.ListLocationsPayload{}).
+					Return(nil, errors.New("storage error"))
+			},
+			wantErr: "internal error",
+		},
+		{
+			name: "Returns not valid error (Archivematica preservation system)",
+			payload: &goaingest.AddBatchPayload{
+				SourceID:   sourceID.String(),
+				Keys:       []string{"sip1.zip", "sip2.zip"},
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			presTaskQueue: temporal.AmWorkerTaskQueue,
+			wantErr:       "invalid: AIP storage location can't be selected with Archivematica",
+		},
+		{
+			name: "Adds a batch with a location",
+			payload: &goaingest.AddBatchPayload{
+				SourceID:   sourceID.String(),
+				Keys:       []string{"sip1.zip", "sip2.zip"},
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.sc.EXPECT().ListLocations(ctx, &goastorage.ListLocationsPayload{}).Return(testLocations, nil)
+				m.psvc.EXPECT().CreateBatch(mockutil.Context(), batch).Return(nil)
+				m.tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					temporalsdk_client.StartWorkflowOptions{
+						ID:                    fmt.Sprintf("%s-%s", ingest.BatchWorkflowName, batchUUID.String()),
+						TaskQueue:             "test",
+						WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+					},
+					ingest.BatchWorkflowName,
+					&ingest.BatchWorkflowRequest{
+						Batch:           *batch,
+						SIPSourceID:     sourceID,
+						Keys:            []string{"sip1.zip", "sip2.zip"},
+						RetentionPeriod: -1,
+						SIPs: []*ingest.BatchSIP{
+							{Key: "sip1.zip", LocationID: &aipStoreLocationID},
+							{Key: "sip2.zip", LocationID: &aipStoreLocationID},
+						},
+					},
+				).Return(nil, nil)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			svc, m := locationTestSvc(t, tt.presTaskQueue)
+			if tt.mock != nil {
+				tt.mock(ctx, m)
+			}
+
+			re, err := svc.AddBatch(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, re, &goaingest.AddBatchResult{UUID: batchUUID.String()})
+			m.tc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateSipUploadLocation(t *testing.T) {
+	t.Parallel()
+
+	uploadUUID := uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72")
+	sipUUID := uuid.MustParse("9566c74d-1003-4c4d-bbbb-0407d1e2c649")
+	content := "<binary zip data>"
+
+	for _, tt := range []struct {
+		name    string
+		payload *goaingest.CreateSipUploadPayload
+		mock    func(context.Context, *locationTestMocks)
+		wantErr string
+	}{
+		{
+			name: "Returns not valid error (not an aip_store location)",
+			payload: &goaingest.CreateSipUploadPayload{
+				Name:       "first.zip",
+				Size:       int64(len(content)),
+				LocationID: new(amssLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.sc.EXPECT().ListLocations(ctx, &goastorage.ListLocationsPayload{}).Return(testLocations, nil)
+			},
+			wantErr: "invalid: AIP storage location: " + amssLocationID.String(),
+		},
+		{
+			name: "Returns internal error (storage error)",
+			payload: &goaingest.CreateSipUploadPayload{
+				Name:       "first.zip",
+				Size:       int64(len(content)),
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.sc.EXPECT().
+					ListLocations(ctx, &goastorage.ListLocationsPayload{}).
+					Return(nil, errors.New("storage error"))
+			},
+			wantErr: "internal error",
+		},
+		{
+			name: "Uploads a SIP with a location",
+			payload: &goaingest.CreateSipUploadPayload{
+				Name:       "first.zip",
+				Size:       int64(len(content)),
+				LocationID: new(aipStoreLocationID.String()),
+			},
+			mock: func(ctx context.Context, m *locationTestMocks) {
+				m.sc.EXPECT().ListLocations(ctx, &goastorage.ListLocationsPayload{}).Return(testLocations, nil)
+				m.psvc.EXPECT().CreateSIP(
+					mockutil.Context(),
+					mockutil.Eq(&datatypes.SIP{
+						UUID:       sipUUID,
+						Name:       "first.zip",
+						Status:     enums.SIPStatusQueued,
+						LocationID: uuid.NullUUID{UUID: aipStoreLocationID, Valid: true},
+					}),
+				).Return(nil)
+				m.tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					temporalsdk_client.StartWorkflowOptions{
+						ID:                    fmt.Sprintf("processing-workflow-%s", sipUUID.String()),
+						TaskQueue:             "test",
+						WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+					},
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID:    sipUUID,
+						SIPName:    "first.zip",
+						Type:       enums.WorkflowTypeCreateAip,
+						Key:        fmt.Sprintf("%sfirst-%s.zip", ingest.SIPPrefix, sipUUID),
+						Extension:  ".zip",
+						LocationID: &aipStoreLocationID,
+					},
+				).Return(nil, nil)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			svc, m := locationTestSvc(t, "")
+			if tt.mock != nil {
+				tt.mock(ctx, m)
+			}
+
+			_, err := svc.CreateSipUpload(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			re, err := svc.UploadSipChunk(
+				ctx,
+				&goaingest.UploadSipChunkPayload{UUID: uploadUUID.String()},
+				io.NopCloser(strings.NewReader(content)),
+			)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, re.SipUUID, new(sipUUID.String()))
+			m.tc.AssertExpectations(t)
+		})
+	}
+}
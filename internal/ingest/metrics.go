@@ -0,0 +1,112 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/telemetry"
+)
+
+const meterName = "github.com/artefactual-sdps/enduro/internal/ingest"
+
+// finalSIPStatuses are the statuses of the SIPs that completed processing.
+var finalSIPStatuses = []enums.SIPStatus{
+	enums.SIPStatusIngested,
+	enums.SIPStatusFailed,
+	enums.SIPStatusError,
+	enums.SIPStatusCanceled,
+}
+
+// ingestMetrics holds the instruments used to record the ingest metrics.
+type ingestMetrics struct {
+	sips          metric.Int64Counter
+	taskDuration  metric.Float64Histogram
+	uploadedBytes metric.Int64Counter
+	batchSize     metric.Int64Histogram
+}
+
+func newIngestMetrics(mp metric.MeterProvider) *ingestMetrics {
+	meter := mp.Meter(meterName)
+	m := &ingestMetrics{}
+
+	var err, errs error
+	m.sips, err = meter.Int64Counter(
+		"enduro.ingest.sips",
+		metric.WithDescription("Number of SIPs that completed processing, by final status."),
+		metric.WithUnit("{sip}"),
+	)
+	errs = errors.Join(errs, err)
+
+	m.taskDuration, err = meter.Float64Histogram(
+		"enduro.ingest.task.duration",
+		metric.WithDescription("Duration of the SIP workflow tasks, from creation to completion."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(telemetry.ProcessingDurationBuckets...),
+	)
+	errs = errors.Join(errs, err)
+
+	m.uploadedBytes, err = meter.Int64Counter(
+		"enduro.ingest.upload.size",
+		metric.WithDescription("Number of bytes uploaded to the internal bucket."),
+		metric.WithUnit("By"),
+	)
+	errs = errors.Join(errs, err)
+
+	m.batchSize, err = meter.Int64Histogram(
+		"enduro.ingest.batch.size",
+		metric.WithDescription("Number of SIPs in the batches started."),
+		metric.WithUnit("{sip}"),
+		metric.WithExplicitBucketBoundaries(1, 5, 10, 25, 50, 100, 250, 500, 1000, 5000),
+	)
+	errs = errors.Join(errs, err)
+
+	if errs != nil {
+		otel.Handle(errs)
+	}
+
+	return m
+}
+
+// recordSIPStatus counts a SIP that completed processing, only when its status
+// changes from a non final status to a final one.
+func (m *ingestMetrics) recordSIPStatus(ctx context.Context, prev, status enums.SIPStatus) {
+	if slices.Contains(finalSIPStatuses, prev) || !slices.Contains(finalSIPStatuses, status) {
+		return
+	}
+
+	m.sips.Add(ctx, 1, metric.WithAttributes(attribute.String("status", status.String())))
+}
+
+// recordTaskDuration records the duration of a completed task.
+func (m *ingestMetrics) recordTaskDuration(ctx context.Context, task *datatypes.Task) {
+	if task.StartedAt.IsZero() || task.CompletedAt.IsZero() {
+		return
+	}
+
+	m.taskDuration.Record(
+		ctx,
+		task.CompletedAt.Sub(task.StartedAt).Seconds(),
+		metric.WithAttributes(
+			attribute.String("task.name", task.Name),
+			attribute.String("task.status", task.Status.String()),
+		),
+	)
+}
+
+// recordUpload counts the bytes uploaded, using mode to distinguish direct
+// and chunked uploads.
+func (m *ingestMetrics) recordUpload(ctx context.Context, mode string, n int64) {
+	m.uploadedBytes.Add(ctx, n, metric.WithAttributes(attribute.String("upload.mode", mode)))
+}
+
+// recordBatchSize records the number of SIPs of a started batch.
+func (m *ingestMetrics) recordBatchSize(ctx context.Context, size int) {
+	m.batchSize.Record(ctx, int64(size))
+}
@@ -0,0 +1,89 @@
+package ingest
+
+import (
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelsdk_metric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+)
+
+func collectMetrics(t *testing.T, reader otelsdk_metric.Reader) map[string]metricdata.Aggregation {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	assert.NilError(t, reader.Collect(t.Context(), &rm))
+
+	metrics := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	return metrics
+}
+
+func TestIngestMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Counts SIPs reaching a final status", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		reader := otelsdk_metric.NewManualReader()
+		m := newIngestMetrics(otelsdk_metric.NewMeterProvider(otelsdk_metric.WithReader(reader)))
+
+		m.recordSIPStatus(ctx, enums.SIPStatusProcessing, enums.SIPStatusIngested)
+		m.recordSIPStatus(ctx, enums.SIPStatusProcessing, enums.SIPStatusFailed)
+		m.recordSIPStatus(ctx, enums.SIPStatusValidated, enums.SIPStatusIngested)
+		// Non final and already final statuses are ignored.
+		m.recordSIPStatus(ctx, enums.SIPStatusQueued, enums.SIPStatusProcessing)
+		m.recordSIPStatus(ctx, enums.SIPStatusIngested, enums.SIPStatusCanceled)
+		m.recordSIPStatus(ctx, enums.SIPStatusError, enums.SIPStatusError)
+
+		sum := collectMetrics(t, reader)["enduro.ingest.sips"].(metricdata.Sum[int64])
+		got := map[string]int64{}
+		for _, dp := range sum.DataPoints {
+			status, _ := dp.Attributes.Value("status")
+			got[status.AsString()] = dp.Value
+		}
+		assert.DeepEqual(t, got, map[string]int64{"ingested": 2, "failed": 1})
+	})
+
+	t.Run("Records task durations", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		reader := otelsdk_metric.NewManualReader()
+		m := newIngestMetrics(otelsdk_metric.NewMeterProvider(otelsdk_metric.WithReader(reader)))
+
+		startedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		m.recordTaskDuration(ctx, &datatypes.Task{
+			Name:        "Validate SIP",
+			Status:      enums.TaskStatusDone,
+			StartedAt:   startedAt,
+			CompletedAt: startedAt.Add(90 * time.Second),
+		})
+		// Tasks without start date are ignored.
+		m.recordTaskDuration(ctx, &datatypes.Task{
+			Name:        "Validate SIP",
+			Status:      enums.TaskStatusDone,
+			CompletedAt: startedAt,
+		})
+
+		hist := collectMetrics(t, reader)["enduro.ingest.task.duration"].(metricdata.Histogram[float64])
+		assert.Equal(t, len(hist.DataPoints), 1)
+		assert.Equal(t, hist.DataPoints[0].Count, uint64(1))
+		assert.Equal(t, hist.DataPoints[0].Sum, float64(90))
+		assert.Equal(t, hist.DataPoints[0].Attributes, attribute.NewSet(
+			attribute.String("task.name", "Validate SIP"),
+			attribute.String("task.status", "done"),
+		))
+	})
+}
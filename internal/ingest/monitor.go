@@ -2,8 +2,11 @@ package ingest
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
+
 	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
 	"github.com/artefactual-sdps/enduro/internal/auth"
 )
@@ -62,12 +65,16 @@ func (svc *ingestImpl) Monitor(
 			case goaingest.ValueKindIngestPingEvent:
 				// Is this event even sent through this channel?
 			case goaingest.ValueKindSipCreatedEvent:
-				if !claims.CheckAttributes([]string{auth.IngestSIPSListAttr}) {
+				if !svc.eventSIPInScope(ctx, claims, []string{auth.IngestSIPSListAttr}, event) {
 					continue
 				}
 			case goaingest.ValueKindSipUpdatedEvent, goaingest.ValueKindSipStatusUpdatedEvent:
-				if !claims.CheckAttributes([]string{auth.IngestSIPSListAttr}) &&
-					!claims.CheckAttributes([]string{auth.IngestSIPSReadAttr}) {
+				if !svc.eventSIPInScope(
+					ctx,
+					claims,
+					[]string{auth.IngestSIPSListAttr, auth.IngestSIPSReadAttr},
+					event,
+				) {
 					continue
 				}
 			case goaingest.ValueKindSipWorkflowCreatedEvent,
@@ -78,12 +85,16 @@ func (svc *ingestImpl) Monitor(
 					continue
 				}
 			case goaingest.ValueKindBatchCreatedEvent:
-				if !claims.CheckAttributes([]string{auth.IngestBatchesListAttr}) {
+				if !svc.eventBatchInScope(ctx, claims, []string{auth.IngestBatchesListAttr}, event) {
 					continue
 				}
 			case goaingest.ValueKindBatchUpdatedEvent:
-				if !claims.CheckAttributes([]string{auth.IngestBatchesListAttr}) &&
-					!claims.CheckAttributes([]string{auth.IngestBatchesReadAttr}) {
+				if !svc.eventBatchInScope(
+					ctx,
+					claims,
+					[]string{auth.IngestBatchesListAttr, auth.IngestBatchesReadAttr},
+					event,
+				) {
 					continue
 				}
 			default:
@@ -99,3 +110,82 @@ func (svc *ingestImpl) Monitor(
 		}
 	}
 }
+
+// scopedAttrs reports whether the user is granted any of attrs for all the
+// resources (global) or only for specific resources (scoped).
+func scopedAttrs(claims *auth.Claims, attrs []string) (global, scoped bool) {
+	global = slices.ContainsFunc(attrs, func(attr string) bool {
+		return claims.CheckAttributes([]string{attr})
+	})
+	scoped = slices.ContainsFunc(attrs, func(attr string) bool {
+		return claims.CheckAttributesInAnyScope([]string{attr})
+	})
+
+	return global, scoped
+}
+
+// eventSIPInScope reports whether the user is granted any of attrs for the
+// batch or the SIP source of the SIP in the event. The SIP is only read when
+// the user attributes are scoped to specific batches or SIP sources.
+func (svc *ingestImpl) eventSIPInScope(
+	ctx context.Context,
+	claims *auth.Claims,
+	attrs []string,
+	event *goaingest.IngestEvent,
+) bool {
+	global, scoped := scopedAttrs(claims, attrs)
+	if global || !scoped {
+		return global
+	}
+
+	var id uuid.UUID
+	switch event.Value.Kind() {
+	case goaingest.ValueKindSipCreatedEvent:
+		id = event.Value.SipCreatedEvent.UUID
+	case goaingest.ValueKindSipUpdatedEvent:
+		id = event.Value.SipUpdatedEvent.UUID
+	case goaingest.ValueKindSipStatusUpdatedEvent:
+		id = event.Value.SipStatusUpdatedEvent.UUID
+	}
+
+	sip, err := svc.perSvc.ReadSIP(ctx, id)
+	if err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(attrs, func(attr string) bool {
+		return sipInScope(claims, attr, sip)
+	})
+}
+
+// eventBatchInScope reports whether the user is granted any of attrs for the
+// batch in the event or its SIP source. The batch is only read when the user
+// attributes are scoped to specific batches or SIP sources.
+func (svc *ingestImpl) eventBatchInScope(
+	ctx context.Context,
+	claims *auth.Claims,
+	attrs []string,
+	event *goaingest.IngestEvent,
+) bool {
+	global, scoped := scopedAttrs(claims, attrs)
+	if global || !scoped {
+		return global
+	}
+
+	var id uuid.UUID
+	switch event.Value.Kind() {
+	case goaingest.ValueKindBatchCreatedEvent:
+		id = event.Value.BatchCreatedEvent.UUID
+	case goaingest.ValueKindBatchUpdatedEvent:
+		id = event.Value.BatchUpdatedEvent.UUID
+	}
+
+	batch, err := svc.perSvc.ReadBatch(ctx, id)
+	if err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(attrs, func(attr string) bool {
+		return batchInScope(claims, attr, batch)
+	})
+}
@@ -8,12 +8,17 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
 
 	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
 	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
 	"github.com/artefactual-sdps/enduro/internal/event"
 	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
 )
 
 // mockMonitorServerStream implements goaingest.MonitorServerStream for testing.
@@ -47,6 +52,9 @@ func TestMonitor(t *testing.T) {
 	t.Parallel()
 
 	testUUID := uuid.New()
+	scopedSIPID := uuid.New()
+	scopedBatchID := uuid.New()
+	scopedSourceID := uuid.New()
 	allEvents := []*goaingest.IngestEvent{
 		{Value: ingest.NewEventValue(&goaingest.SIPCreatedEvent{UUID: testUUID})},
 		{Value: ingest.NewEventValue(&goaingest.SIPUpdatedEvent{UUID: testUUID})},
@@ -74,6 +82,7 @@ func TestMonitor(t *testing.T) {
 	for _, tt := range []struct {
 		name       string
 		claims     *auth.Claims
+		mock       func(*persistence_fake.MockService)
 		events     []*goaingest.IngestEvent
 		wantEvents []*goaingest.IngestEvent
 	}{
@@ -119,15 +128,60 @@ func TestMonitor(t *testing.T) {
 				{Value: ingest.NewEventValue(&goaingest.SIPStatusUpdatedEvent{UUID: testUUID})},
 			},
 		},
+		{
+			name: "Filters events based on batch and SIP source scoped permissions",
+			claims: &auth.Claims{
+				Email:         "test@example.com",
+				EmailVerified: true,
+				Attributes: []string{
+					auth.ScopedAttribute(auth.IngestSIPSListAttr, auth.SIPSourceScope, scopedSourceID),
+					auth.ScopedAttribute(auth.IngestBatchesListAttr, auth.BatchScope, scopedBatchID),
+				},
+			},
+			mock: func(psvc *persistence_fake.MockService) {
+				psvc.EXPECT().
+					ReadSIP(mockutil.Context(), scopedSIPID).
+					Return(&datatypes.SIP{
+						UUID:        scopedSIPID,
+						SIPSourceID: uuid.NullUUID{UUID: scopedSourceID, Valid: true},
+					}, nil)
+				psvc.EXPECT().
+					ReadSIP(mockutil.Context(), testUUID).
+					Return(&datatypes.SIP{UUID: testUUID}, nil)
+				psvc.EXPECT().
+					ReadBatch(mockutil.Context(), scopedBatchID).
+					Return(&datatypes.Batch{UUID: scopedBatchID}, nil)
+				psvc.EXPECT().
+					ReadBatch(mockutil.Context(), testUUID).
+					Return(nil, persistence.ErrNotFound)
+			},
+			events: []*goaingest.IngestEvent{
+				{Value: ingest.NewEventValue(&goaingest.SIPCreatedEvent{UUID: scopedSIPID})},
+				{Value: ingest.NewEventValue(&goaingest.SIPCreatedEvent{UUID: testUUID})},
+				{Value: ingest.NewEventValue(&goaingest.SIPWorkflowCreatedEvent{UUID: scopedSIPID})},
+				{Value: ingest.NewEventValue(&goaingest.BatchCreatedEvent{UUID: scopedBatchID})},
+				{Value: ingest.NewEventValue(&goaingest.BatchCreatedEvent{UUID: testUUID})},
+			},
+			wantEvents: []*goaingest.IngestEvent{
+				{Value: ingest.NewEventValue(&goaingest.IngestPingEvent{Message: new("Hello")})},
+				{Value: ingest.NewEventValue(&goaingest.SIPCreatedEvent{UUID: scopedSIPID})},
+				{Value: ingest.NewEventValue(&goaingest.BatchCreatedEvent{UUID: scopedBatchID})},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
 			evsvc := event.NewServiceInMem[*goaingest.IngestEvent]()
 			stream := &mockMonitorServerStream{}
+			psvc := persistence_fake.NewMockService(gomock.NewController(t))
+			if tt.mock != nil {
+				tt.mock(psvc)
+			}
 
 			svc := ingest.NewService(ingest.ServiceParams{
-				EventService: evsvc,
+				EventService:       evsvc,
+				PersistenceService: psvc,
 			})
 
 			// Create a context that will be cancelled to stop the monitor.
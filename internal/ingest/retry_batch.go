@@ -0,0 +1,170 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/entfilter"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+)
+
+// RetryBatch starts a new batch workflow for a batch that ended with a failed
+// or ingested status, processing again the SIPs of the batch that ended with
+// an error or failed status from the failed SIP/PIP stored in the internal
+// bucket. The new workflow reuses the same batch and SIPs.
+func (svc *ingestImpl) RetryBatch(ctx context.Context, payload *goaingest.RetryBatchPayload) error {
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		return goaingest.MakeNotValid(err)
+	}
+
+	batchUUID, err := uuid.Parse(payload.UUID)
+	if err != nil {
+		return goaingest.MakeNotValid(errors.New("invalid UUID"))
+	}
+
+	b, err := svc.perSvc.ReadBatch(ctx, batchUUID)
+	if err == persistence.ErrNotFound {
+		return &goaingest.BatchNotFound{UUID: payload.UUID, Message: "Batch not found"}
+	} else if err != nil {
+		svc.logger.Error(err, "retry batch: read batch", "batch_uuid", batchUUID)
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+
+	// Canceled batches can't be retried, their ingested SIPs were cleared.
+	if b.Status != enums.BatchStatusFailed && b.Status != enums.BatchStatusIngested {
+		return goaingest.MakeNotValid(fmt.Errorf("batch with status %q can't be retried", b.Status))
+	}
+
+	sips, err := svc.batchSIPList(ctx, batchUUID)
+	if err != nil {
+		svc.logger.Error(err, "retry batch: list SIPs", "batch_uuid", batchUUID)
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+
+	var retried int
+	for _, sip := range sips {
+		if sip.Status != enums.SIPStatusError && sip.Status != enums.SIPStatusFailed {
+			continue
+		}
+
+		// Check that failed as and failed key values are set.
+		if sip.FailedAs == "" || sip.FailedKey == "" {
+			return goaingest.MakeNotValid(fmt.Errorf("SIP %q has no failed values", sip.UUID))
+		}
+		if sip.FailedAs == enums.SIPFailedAsPIP {
+			return goaingest.MakeNotValid(
+				fmt.Errorf("SIP %q failed after preprocessing and can't be retried", sip.UUID),
+			)
+		}
+
+		// Check if the failed SIP/PIP exists in the internal bucket.
+		exists, err := svc.internalStorage.Exists(ctx, sip.FailedKey)
+		if err != nil {
+			svc.logger.Error(err, "retry batch: check failed SIP/PIP file", "key", sip.FailedKey)
+			return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+		}
+		if !exists {
+			return goaingest.MakeNotValid(
+				fmt.Errorf("SIP %q: failed SIP/PIP file not found in the internal storage", sip.UUID),
+			)
+		}
+
+		retried++
+	}
+	if retried == 0 {
+		return goaingest.MakeNotValid(errors.New("batch has no failed SIPs"))
+	}
+
+	// Queue the batch before starting the workflow, so the workflow status
+	// updates aren't overwritten.
+	prevStatus, prevCompletedAt := b.Status, b.CompletedAt
+	if err := svc.setBatchStatus(ctx, batchUUID, enums.BatchStatusQueued, time.Time{}); err != nil {
+		svc.logger.Error(err, "retry batch: set status", "batch_uuid", batchUUID)
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+	b.Status = enums.BatchStatusQueued
+	b.CompletedAt = time.Time{}
+
+	retrySIPs := make([]datatypes.SIP, len(sips))
+	for i, sip := range sips {
+		retrySIPs[i] = *sip
+	}
+
+	req := BatchWorkflowRequest{
+		User:      childWorkflowUserFromClaims(claims),
+		Batch:     *b,
+		RetrySIPs: retrySIPs,
+	}
+	if err := InitBatchWorkflow(ctx, svc.tc, svc.taskQueue, &req); err != nil {
+		err = errors.Join(err, svc.setBatchStatus(ctx, batchUUID, prevStatus, prevCompletedAt))
+		svc.logger.Error(err, "retry batch: start batch workflow", "batch_uuid", batchUUID)
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+
+	svc.auditLogger.Log(ctx, batchRetryAuditEvent(b, claims))
+
+	return nil
+}
+
+// batchSIPList returns all the SIPs of a batch, sorted by creation.
+func (svc *ingestImpl) batchSIPList(ctx context.Context, batchUUID uuid.UUID) ([]*datatypes.SIP, error) {
+	var sips []*datatypes.SIP
+	for {
+		r, pg, err := svc.perSvc.ListSIPs(ctx, &persistence.SIPFilter{
+			BatchID: &batchUUID,
+			Sort:    entfilter.NewSort().AddCol("id", false),
+			Page: persistence.Page{
+				Limit:  1000,
+				Offset: len(sips),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		sips = append(sips, r...)
+		if len(r) == 0 || pg == nil || len(sips) >= pg.Total {
+			break
+		}
+	}
+
+	return sips, nil
+}
+
+func (svc *ingestImpl) setBatchStatus(
+	ctx context.Context,
+	id uuid.UUID,
+	status enums.BatchStatus,
+	completedAt time.Time,
+) error {
+	_, err := svc.perSvc.UpdateBatch(ctx, id, func(b *datatypes.Batch) (*datatypes.Batch, error) {
+		b.Status = status
+		b.CompletedAt = completedAt
+		return b, nil
+	})
+
+	return err
+}
+
+func batchRetryAuditEvent(b *datatypes.Batch, claims *auth.Claims) *auditlog.Event {
+	e := &auditlog.Event{
+		Level:      auditlog.LevelInfo,
+		Msg:        "Batch retry started",
+		Type:       "Batch.retry",
+		ResourceID: b.UUID.String(),
+	}
+	if claims != nil {
+		e.User = claims.Email
+	}
+
+	return e
+}
@@ -0,0 +1,244 @@
+package ingest_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
+	temporalsdk_client "go.temporal.io/sdk/client"
+	temporalsdk_mocks "go.temporal.io/sdk/mocks"
+	"gotest.tools/v3/assert"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/entfilter"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
+)
+
+func TestRetryBatch(t *testing.T) {
+	t.Parallel()
+
+	batchUUID := uuid.New()
+	ingestedUUID := uuid.New()
+	completedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	failedBatch := func() *datatypes.Batch {
+		return &datatypes.Batch{
+			UUID:        batchUUID,
+			Identifier:  "batch-1",
+			Status:      enums.BatchStatusFailed,
+			CompletedAt: completedAt,
+		}
+	}
+
+	batchSIPs := func() []*datatypes.SIP {
+		return []*datatypes.SIP{
+			{
+				UUID:   ingestedUUID,
+				Name:   "ingested.zip",
+				Status: enums.SIPStatusIngested,
+			},
+			{
+				UUID:      sipUUID,
+				Name:      "failed.zip",
+				Status:    enums.SIPStatusFailed,
+				FailedAs:  enums.SIPFailedAsSIP,
+				FailedKey: key,
+			},
+		}
+	}
+
+	expectList := func(psvc *persistence_fake.MockService, sips []*datatypes.SIP) {
+		psvc.EXPECT().
+			ListSIPs(
+				mockutil.Context(),
+				&persistence.SIPFilter{
+					BatchID: &batchUUID,
+					Sort:    entfilter.NewSort().AddCol("id", false),
+					Page:    persistence.Page{Limit: 1000},
+				},
+			).
+			Return(sips, &persistence.Page{Limit: 1000, Total: len(sips)}, nil)
+	}
+
+	expectStatus := func(psvc *persistence_fake.MockService, status enums.BatchStatus, completedAt time.Time) {
+		psvc.EXPECT().
+			UpdateBatch(
+				mockutil.Context(),
+				batchUUID,
+				mockutil.Func(
+					fmt.Sprintf("should set batch status to %s", status),
+					func(upd persistence.BatchUpdater) error {
+						updated, err := upd(&datatypes.Batch{})
+						assert.NilError(t, err)
+						assert.Equal(t, updated.Status, status)
+						assert.Equal(t, updated.CompletedAt, completedAt)
+						return nil
+					},
+				),
+			).
+			Return(&datatypes.Batch{UUID: batchUUID, Status: status}, nil)
+	}
+
+	startOpts := temporalsdk_client.StartWorkflowOptions{
+		ID:                    ingest.BatchWorkflowID(batchUUID),
+		TaskQueue:             "test",
+		WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}
+
+	for _, tt := range []struct {
+		name    string
+		payload *goaingest.RetryBatchPayload
+		claims  *auth.Claims
+		mock    func(*persistence_fake.MockService, *temporalsdk_mocks.Client)
+		wantErr string
+	}{
+		{
+			name:    "Fails to retry a batch (invalid UUID)",
+			payload: &goaingest.RetryBatchPayload{UUID: "invalid-uuid"},
+			wantErr: "invalid UUID",
+		},
+		{
+			name:    "Fails to retry a batch (batch not found)",
+			payload: &goaingest.RetryBatchPayload{UUID: batchUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadBatch(mockutil.Context(), batchUUID).Return(nil, persistence.ErrNotFound)
+			},
+			wantErr: "Batch not found.",
+		},
+		{
+			name:    "Fails to retry a batch (canceled status)",
+			payload: &goaingest.RetryBatchPayload{UUID: batchUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				b := failedBatch()
+				b.Status = enums.BatchStatusCanceled
+				psvc.EXPECT().ReadBatch(mockutil.Context(), batchUUID).Return(b, nil)
+			},
+			wantErr: `batch with status "canceled" can't be retried`,
+		},
+		{
+			name:    "Fails to retry a batch (no failed SIPs)",
+			payload: &goaingest.RetryBatchPayload{UUID: batchUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadBatch(mockutil.Context(), batchUUID).Return(failedBatch(), nil)
+				expectList(psvc, batchSIPs()[:1])
+			},
+			wantErr: "batch has no failed SIPs",
+		},
+		{
+			name:    "Fails to retry a batch (missing failed values)",
+			payload: &goaingest.RetryBatchPayload{UUID: batchUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sips := batchSIPs()
+				sips[1].FailedKey = ""
+				psvc.EXPECT().ReadBatch(mockutil.Context(), batchUUID).Return(failedBatch(), nil)
+				expectList(psvc, sips)
+			},
+			wantErr: fmt.Sprintf("SIP %q has no failed values", sipUUID),
+		},
+		{
+			name:    "Fails to retry a batch (failed as PIP)",
+			payload: &goaingest.RetryBatchPayload{UUID: batchUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sips := batchSIPs()
+				sips[1].FailedAs = enums.SIPFailedAsPIP
+				psvc.EXPECT().ReadBatch(mockutil.Context(), batchUUID).Return(failedBatch(), nil)
+				expectList(psvc, sips)
+			},
+			wantErr: fmt.Sprintf("SIP %q failed after preprocessing and can't be retried", sipUUID),
+		},
+		{
+			name:    "Fails to retry a batch (failed file not found)",
+			payload: &goaingest.RetryBatchPayload{UUID: batchUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sips := batchSIPs()
+				sips[1].FailedKey = "missing"
+				psvc.EXPECT().ReadBatch(mockutil.Context(), batchUUID).Return(failedBatch(), nil)
+				expectList(psvc, sips)
+			},
+			wantErr: fmt.Sprintf("SIP %q: failed SIP/PIP file not found in the internal storage", sipUUID),
+		},
+		{
+			name:    "Fails to retry a batch (workflow not started)",
+			payload: &goaingest.RetryBatchPayload{UUID: batchUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadBatch(mockutil.Context(), batchUUID).Return(failedBatch(), nil)
+				expectList(psvc, batchSIPs())
+				expectStatus(psvc, enums.BatchStatusQueued, time.Time{})
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					startOpts,
+					ingest.BatchWorkflowName,
+					mock.Anything,
+				).Return(nil, errors.New("temporal error"))
+				expectStatus(psvc, enums.BatchStatusFailed, completedAt)
+			},
+			wantErr: "cannot perform operation",
+		},
+		{
+			name:    "Retries a batch",
+			payload: &goaingest.RetryBatchPayload{UUID: batchUUID.String()},
+			claims: &auth.Claims{
+				Email: "nobody@example.com",
+				Iss:   "http://keycloak:7470/realms/artefactual",
+				Sub:   "1234",
+			},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadBatch(mockutil.Context(), batchUUID).Return(failedBatch(), nil)
+				expectList(psvc, batchSIPs())
+				expectStatus(psvc, enums.BatchStatusQueued, time.Time{})
+
+				sips := batchSIPs()
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					startOpts,
+					ingest.BatchWorkflowName,
+					&ingest.BatchWorkflowRequest{
+						User: &childwf.User{Email: "nobody@example.com"},
+						Batch: datatypes.Batch{
+							UUID:       batchUUID,
+							Identifier: "batch-1",
+							Status:     enums.BatchStatusQueued,
+						},
+						RetrySIPs: []datatypes.SIP{*sips[0], *sips[1]},
+					},
+				).Return(nil, nil)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, psvc, tc := testSvc(t, setupBucket(t, ""), 0)
+			if tt.mock != nil {
+				tt.mock(psvc, tc)
+			}
+
+			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+
+			err := svc.RetryBatch(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			tc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+)
+
+// RetrySip starts a new processing workflow for a SIP that ended with an
+// error or failed status, using the failed SIP stored in the internal bucket
+// as input. The new workflow is linked to the same SIP. SIPs that failed after
+// preprocessing, stored as a PIP, can't be retried.
+func (svc *ingestImpl) RetrySip(ctx context.Context, payload *goaingest.RetrySipPayload) error {
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		return goaingest.MakeNotValid(err)
+	}
+
+	sip, err := svc.readSIP(ctx, payload.UUID)
+	if err != nil {
+		return err
+	}
+
+	if sip.Status != enums.SIPStatusError && sip.Status != enums.SIPStatusFailed {
+		return goaingest.MakeNotValid(fmt.Errorf("SIP with status %q can't be retried", sip.Status))
+	}
+	if sip.Batch != nil {
+		return goaingest.MakeNotValid(errors.New("SIP belongs to a batch and can't be retried individually"))
+	}
+
+	// Check that failed as and failed key values are set.
+	if sip.FailedAs == "" || sip.FailedKey == "" {
+		return goaingest.MakeNotValid(errors.New("SIP has no failed values"))
+	}
+
+	// A failed PIP has already been preprocessed, retrying it would run the
+	// preprocessing child workflow on its output.
+	if sip.FailedAs == enums.SIPFailedAsPIP {
+		return goaingest.MakeNotValid(errors.New("SIP failed after preprocessing and can't be retried"))
+	}
+
+	// Check if the failed SIP/PIP exists in the internal bucket.
+	exists, err := svc.internalStorage.Exists(ctx, sip.FailedKey)
+	if err != nil {
+		svc.logger.Error(err, "retry SIP: check failed SIP/PIP file", "key", sip.FailedKey)
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+	if !exists {
+		return &goaingest.SIPNotFound{
+			UUID:    payload.UUID,
+			Message: "Failed SIP/PIP file not found in the internal storage",
+		}
+	}
+
+	// Reuse the type of the most recent workflow.
+	workflows, err := svc.perSvc.ListWorkflowsBySIP(ctx, sip.UUID)
+	if err != nil {
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+	wType := enums.WorkflowTypeCreateAip
+	if len(workflows) > 0 && workflows[0].Type.IsValid() {
+		wType = workflows[0].Type
+	}
+
+	// Queue the SIP before starting the workflow, so the workflow status
+	// updates aren't overwritten.
+	prevStatus := sip.Status
+	if err := svc.SetStatus(ctx, sip.UUID, enums.SIPStatusQueued); err != nil {
+		svc.logger.Error(err, "retry SIP: set status", "sip_uuid", sip.UUID)
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+
+	req := ProcessingWorkflowRequest{
+		User:              childWorkflowUserFromClaims(claims),
+		SIPUUID:           sip.UUID,
+		SIPName:           sip.Name,
+		Type:              wType,
+		WatcherName:       sip.WatcherName,
+		SIPSourceID:       sip.SIPSourceID.UUID,
+		Key:               sip.FailedKey,
+		Retry:             true,
+		Extension:         failedSIPExtension(sip),
+		RetentionPeriod:   svc.uploadRetentionPeriod,
+		ExpectedChecksum:  sip.ExpectedChecksum,
+		ProcessingProfile: sip.ProcessingProfile,
+	}
+	if sip.LocationID.Valid {
+		req.LocationID = &sip.LocationID.UUID
+	}
+	if err := InitProcessingWorkflow(ctx, svc.tc, svc.taskQueue, &req); err != nil {
+		err = errors.Join(err, svc.SetStatus(ctx, sip.UUID, prevStatus))
+		svc.logger.Error(err, "retry SIP: start processing workflow", "sip_uuid", sip.UUID)
+		return goaingest.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+
+	svc.auditLogger.Log(ctx, sipRetryAuditEvent(sip, claims))
+
+	return nil
+}
+
+// failedSIPExtension returns the extension of the original SIP, which follows
+// the SIP UUID in its failed key.
+func failedSIPExtension(s *datatypes.SIP) string {
+	id := s.UUID.String()
+	i := strings.LastIndex(s.FailedKey, id)
+	if i < 0 {
+		return ""
+	}
+
+	return s.FailedKey[i+len(id):]
+}
+
+func sipRetryAuditEvent(s *datatypes.SIP, claims *auth.Claims) *auditlog.Event {
+	e := &auditlog.Event{
+		Level:      auditlog.LevelInfo,
+		Msg:        "SIP retry started",
+		Type:       "SIP.retry",
+		ResourceID: s.UUID.String(),
+	}
+	if claims != nil {
+		e.User = claims.Email
+	}
+
+	return e
+}
@@ -0,0 +1,306 @@
+package ingest_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
+	temporalsdk_client "go.temporal.io/sdk/client"
+	temporalsdk_mocks "go.temporal.io/sdk/mocks"
+	"gotest.tools/v3/assert"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
+)
+
+func TestRetrySip(t *testing.T) {
+	t.Parallel()
+
+	sourceID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	locationID := uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1")
+
+	failedSIP := func() *datatypes.SIP {
+		return &datatypes.SIP{
+			UUID:      sipUUID,
+			Name:      "failed.zip",
+			Status:    enums.SIPStatusFailed,
+			FailedAs:  enums.SIPFailedAsSIP,
+			FailedKey: key,
+		}
+	}
+
+	expectStatus := func(psvc *persistence_fake.MockService, status enums.SIPStatus) {
+		psvc.EXPECT().
+			UpdateSIP(
+				mockutil.Context(),
+				sipUUID,
+				mockutil.Func(
+					fmt.Sprintf("should set SIP status to %s", status),
+					func(upd persistence.SIPUpdater) error {
+						updated, err := upd(&datatypes.SIP{})
+						assert.NilError(t, err)
+						assert.Equal(t, updated.Status, status)
+						return nil
+					},
+				),
+			).
+			Return(&datatypes.SIP{UUID: sipUUID, Status: status}, nil)
+	}
+
+	startOpts := temporalsdk_client.StartWorkflowOptions{
+		ID:                    fmt.Sprintf("processing-workflow-%s", sipUUID),
+		TaskQueue:             "test",
+		WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}
+
+	watcherKey := fmt.Sprintf("%sfailed-%s.tar.gz", ingest.FailedSIPPrefix, sipUUID)
+
+	for _, tt := range []struct {
+		name    string
+		payload *goaingest.RetrySipPayload
+		claims  *auth.Claims
+		// failedKey is written to the internal bucket, in addition to key.
+		failedKey string
+		mock      func(*persistence_fake.MockService, *temporalsdk_mocks.Client)
+		wantErr   string
+	}{
+		{
+			name:    "Fails to retry a SIP (invalid UUID)",
+			payload: &goaingest.RetrySipPayload{UUID: "invalid-uuid"},
+			wantErr: "invalid UUID",
+		},
+		{
+			name:    "Fails to retry a SIP (SIP not found)",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(nil, persistence.ErrNotFound)
+			},
+			wantErr: "SIP not found.",
+		},
+		{
+			name:    "Fails to retry a SIP (ingested status)",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := failedSIP()
+				sip.Status = enums.SIPStatusIngested
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+			},
+			wantErr: `SIP with status "ingested" can't be retried`,
+		},
+		{
+			name:    "Fails to retry a SIP (batch SIP)",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := failedSIP()
+				sip.Batch = &datatypes.Batch{UUID: uuid.New()}
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+			},
+			wantErr: "SIP belongs to a batch and can't be retried individually",
+		},
+		{
+			name:    "Fails to retry a SIP (missing failed values)",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().
+					ReadSIP(mockutil.Context(), sipUUID).
+					Return(&datatypes.SIP{UUID: sipUUID, Status: enums.SIPStatusError}, nil)
+			},
+			wantErr: "SIP has no failed values",
+		},
+		{
+			name:    "Fails to retry a SIP (failed as PIP)",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := failedSIP()
+				sip.FailedAs = enums.SIPFailedAsPIP
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+			},
+			wantErr: "SIP failed after preprocessing and can't be retried",
+		},
+		{
+			name:    "Fails to retry a SIP (failed file not found)",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := failedSIP()
+				sip.FailedKey = "missing"
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+			},
+			wantErr: "SIP not found.",
+		},
+		{
+			name:    "Fails to retry a SIP (workflow not started)",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(failedSIP(), nil)
+				psvc.EXPECT().ListWorkflowsBySIP(mockutil.Context(), sipUUID).Return(nil, nil)
+				expectStatus(psvc, enums.SIPStatusQueued)
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					startOpts,
+					ingest.ProcessingWorkflowName,
+					mock.Anything,
+				).Return(nil, errors.New("temporal error"))
+				expectStatus(psvc, enums.SIPStatusFailed)
+			},
+			wantErr: "cannot perform operation",
+		},
+		{
+			name:    "Retries a SIP",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			claims: &auth.Claims{
+				Email: "nobody@example.com",
+				Iss:   "http://keycloak:7470/realms/artefactual",
+				Sub:   "1234",
+			},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(failedSIP(), nil)
+				psvc.EXPECT().
+					ListWorkflowsBySIP(mockutil.Context(), sipUUID).
+					Return([]*datatypes.Workflow{
+						{Type: enums.WorkflowTypeCreateAndReviewAip, Status: enums.WorkflowStatusError},
+					}, nil)
+				expectStatus(psvc, enums.SIPStatusQueued)
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					startOpts,
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						User:    &childwf.User{Email: "nobody@example.com"},
+						SIPUUID: sipUUID,
+						SIPName: "failed.zip",
+						Type:    enums.WorkflowTypeCreateAndReviewAip,
+						Key:     key,
+						Retry:   true,
+					},
+				).Return(nil, nil)
+			},
+		},
+		{
+			name:    "Retries a SIP from a SIP source with a location",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := failedSIP()
+				sip.SIPSourceID = uuid.NullUUID{UUID: sourceID, Valid: true}
+				sip.LocationID = uuid.NullUUID{UUID: locationID, Valid: true}
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+				psvc.EXPECT().ListWorkflowsBySIP(mockutil.Context(), sipUUID).Return(nil, nil)
+				expectStatus(psvc, enums.SIPStatusQueued)
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					startOpts,
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID:     sipUUID,
+						SIPName:     "failed.zip",
+						Type:        enums.WorkflowTypeCreateAip,
+						SIPSourceID: sourceID,
+						Key:         key,
+						Retry:       true,
+						LocationID:  &locationID,
+					},
+				).Return(nil, nil)
+			},
+		},
+		{
+			name:    "Retries a SIP with an expected checksum",
+			payload: &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := failedSIP()
+				sip.ExpectedChecksum = &datatypes.Checksum{
+					Algorithm: datatypes.ChecksumAlgoSHA256,
+					Hash:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+				}
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+				psvc.EXPECT().ListWorkflowsBySIP(mockutil.Context(), sipUUID).Return(nil, nil)
+				expectStatus(psvc, enums.SIPStatusQueued)
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					startOpts,
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID: sipUUID,
+						SIPName: "failed.zip",
+						Type:    enums.WorkflowTypeCreateAip,
+						Key:     key,
+						Retry:   true,
+						ExpectedChecksum: &datatypes.Checksum{
+							Algorithm: datatypes.ChecksumAlgoSHA256,
+							Hash:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+						},
+					},
+				).Return(nil, nil)
+			},
+		},
+		{
+			name:      "Retries a SIP received by a watcher",
+			payload:   &goaingest.RetrySipPayload{UUID: sipUUID.String()},
+			failedKey: watcherKey,
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				sip := failedSIP()
+				sip.Name = "failed.tar.gz"
+				sip.FailedKey = watcherKey
+				sip.WatcherName = "watcher"
+				psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(sip, nil)
+				psvc.EXPECT().ListWorkflowsBySIP(mockutil.Context(), sipUUID).Return(nil, nil)
+				expectStatus(psvc, enums.SIPStatusQueued)
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					startOpts,
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID:     sipUUID,
+						SIPName:     "failed.tar.gz",
+						Type:        enums.WorkflowTypeCreateAip,
+						WatcherName: "watcher",
+						Key:         watcherKey,
+						Retry:       true,
+						Extension:   ".tar.gz",
+					},
+				).Return(nil, nil)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := setupBucket(t, "")
+			if tt.failedKey != "" {
+				assert.NilError(t, b.WriteAll(t.Context(), tt.failedKey, content, nil))
+			}
+
+			svc, psvc, tc := testSvc(t, b, 0)
+			if tt.mock != nil {
+				tt.mock(psvc, tc)
+			}
+
+			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+
+			err := svc.RetrySip(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			tc.AssertExpectations(t)
+		})
+	}
+}
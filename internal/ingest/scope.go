@@ -0,0 +1,88 @@
+package ingest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+)
+
+// resourceScope returns the persistence scope limiting the SIPs or batches to
+// the batches and SIP sources for which the user is granted attr, or nil if
+// attr is granted for all the batches or all the SIP sources.
+func resourceScope(ctx context.Context, attr string) *persistence.ResourceScope {
+	claims := auth.UserClaimsFromContext(ctx)
+
+	batchIDs, all := claims.AttributeScope(attr, auth.BatchScope)
+	if all {
+		return nil
+	}
+	sourceIDs, all := claims.AttributeScope(attr, auth.SIPSourceScope)
+	if all {
+		return nil
+	}
+
+	return &persistence.ResourceScope{BatchIDs: batchIDs, SIPSourceIDs: sourceIDs}
+}
+
+// inResourceScope reports whether the user is granted attr for the batch or
+// the SIP source.
+func inResourceScope(claims *auth.Claims, attr string, batchID *uuid.UUID, sourceID uuid.NullUUID) bool {
+	if claims.CheckAttributes([]string{attr}) {
+		return true
+	}
+	if batchID != nil && claims.CheckAttributes(
+		[]string{auth.ScopedAttribute(attr, auth.BatchScope, *batchID)},
+	) {
+		return true
+	}
+	if sourceID.Valid && claims.CheckAttributes(
+		[]string{auth.ScopedAttribute(attr, auth.SIPSourceScope, sourceID.UUID)},
+	) {
+		return true
+	}
+
+	return false
+}
+
+// sipInScope reports whether the user is granted attr for the batch or the SIP
+// source of the SIP.
+func sipInScope(claims *auth.Claims, attr string, sip *datatypes.SIP) bool {
+	var batchID *uuid.UUID
+	if sip.Batch != nil {
+		batchID = &sip.Batch.UUID
+	}
+
+	return inResourceScope(claims, attr, batchID, sip.SIPSourceID)
+}
+
+// batchInScope reports whether the user is granted attr for the batch or its
+// SIP source.
+func batchInScope(claims *auth.Claims, attr string, batch *datatypes.Batch) bool {
+	return inResourceScope(claims, attr, &batch.UUID, batch.SIPSourceID)
+}
+
+// checkSIPScope returns a SIP not found error if the user is not granted attr
+// for the batch or the SIP source of the SIP, to hide the SIPs of other
+// batches and SIP sources from the users with scoped attributes.
+func checkSIPScope(ctx context.Context, attr string, sip *datatypes.SIP) error {
+	if !sipInScope(auth.UserClaimsFromContext(ctx), attr, sip) {
+		return &goaingest.SIPNotFound{UUID: sip.UUID.String(), Message: "SIP not found"}
+	}
+
+	return nil
+}
+
+// checkBatchScope returns a batch not found error if the user is not granted
+// attr for the batch or its SIP source.
+func checkBatchScope(ctx context.Context, attr string, batch *datatypes.Batch) error {
+	if !batchInScope(auth.UserClaimsFromContext(ctx), attr, batch) {
+		return &goaingest.BatchNotFound{UUID: batch.UUID.String(), Message: "Batch not found"}
+	}
+
+	return nil
+}
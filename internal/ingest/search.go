@@ -0,0 +1,112 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/google/uuid"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/search"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
+)
+
+// searchBatchField is the name of the search document field holding the
+// identifier of the batch of a SIP.
+const searchBatchField = "batch"
+
+// sipDocument returns the search document of s. The batch identifier is added
+// to the given metadata fields, replacing any field with the same name.
+func sipDocument(s *datatypes.SIP, fields map[string]string) *search.Document {
+	doc := &search.Document{
+		UUID:   s.UUID,
+		Name:   s.Name,
+		Fields: make(map[string]string, len(fields)+1),
+	}
+	maps.Copy(doc.Fields, fields)
+	if s.Batch != nil && s.Batch.Identifier != "" {
+		doc.Fields[searchBatchField] = s.Batch.Identifier
+	}
+
+	return doc
+}
+
+// indexSIP adds s to the search index. Indexing errors are logged and not
+// returned because they don't prevent the ingest of the SIP.
+func (svc *ingestImpl) indexSIP(ctx context.Context, s *datatypes.SIP) {
+	if err := svc.searchBackend.Index(ctx, sipDocument(s, nil)); err != nil {
+		svc.logger.Error(err, "index SIP", "sip_uuid", s.UUID)
+	}
+}
+
+// unindexSIP removes a SIP from the search index. Errors are logged and not
+// returned because they don't prevent the removal of the SIP.
+func (svc *ingestImpl) unindexSIP(ctx context.Context, id uuid.UUID) {
+	if err := svc.searchBackend.Delete(ctx, id); err != nil {
+		svc.logger.Error(err, "unindex SIP", "sip_uuid", id)
+	}
+}
+
+// IndexSIP updates the search document of the SIP identified by id, adding the
+// custom metadata returned by the child workflows.
+func (svc *ingestImpl) IndexSIP(ctx context.Context, id uuid.UUID, md childwf.CustomMetadata) error {
+	s, err := svc.perSvc.ReadSIP(ctx, id)
+	if err != nil {
+		return fmt.Errorf("index SIP: %v", err)
+	}
+
+	if err := svc.searchBackend.Index(ctx, sipDocument(s, search.MetadataFields(md))); err != nil {
+		return fmt.Errorf("index SIP: %v", err)
+	}
+
+	return nil
+}
+
+// Search returns the SIPs matching the payload query. It implements
+// goaingest.Service. The search index doesn't record the SIP batches or
+// sources, so searching requires the SIPs list attribute for all of them.
+func (svc *ingestImpl) Search(ctx context.Context, payload *goaingest.SearchPayload) (*goaingest.SearchResults, error) {
+	if !auth.UserClaimsFromContext(ctx).CheckAttributes([]string{auth.IngestSIPSListAttr}) {
+		return nil, ErrForbidden
+	}
+
+	var limit, offset int
+	if payload.Limit != nil {
+		limit = *payload.Limit
+	}
+	if payload.Offset != nil {
+		offset = *payload.Offset
+	}
+	q := search.NewQuery(payload.Query, limit, offset)
+	if len(q.Terms()) == 0 {
+		return nil, goaingest.MakeNotValid(errors.New("query has no search terms"))
+	}
+
+	r, total, err := svc.searchBackend.Search(ctx, q)
+	if err != nil {
+		svc.logger.Error(err, "search SIPs")
+		return nil, goaingest.MakeInternalError(err)
+	}
+
+	items := make([]*goaingest.SearchResult, len(r))
+	for i, res := range r {
+		items[i] = &goaingest.SearchResult{
+			UUID:   res.UUID,
+			Name:   res.Name,
+			Fields: res.Fields,
+		}
+	}
+
+	return &goaingest.SearchResults{
+		Items: items,
+		Page: &goaingest.EnduroPage{
+			Limit:  q.Limit,
+			Offset: q.Offset,
+			Total:  total,
+		},
+	}, nil
+}
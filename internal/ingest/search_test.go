@@ -0,0 +1,208 @@
+package ingest_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.artefactual.dev/tools/ref"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/event"
+	"github.com/artefactual-sdps/enduro/internal/ingest"
+	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+	"github.com/artefactual-sdps/enduro/internal/search"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
+)
+
+// fakeSearchBackend records the indexed documents and returns the configured
+// search results.
+type fakeSearchBackend struct {
+	docs    []*search.Document
+	query   *search.Query
+	results []*search.Result
+	total   int
+	err     error
+}
+
+var _ search.Backend = (*fakeSearchBackend)(nil)
+
+func (b *fakeSearchBackend) Index(_ context.Context, doc *search.Document) error {
+	b.docs = append(b.docs, doc)
+	return b.err
+}
+
+func (b *fakeSearchBackend) Delete(context.Context, uuid.UUID) error {
+	return b.err
+}
+
+func (b *fakeSearchBackend) Search(_ context.Context, q *search.Query) ([]*search.Result, int, error) {
+	b.query = q
+	return b.results, b.total, b.err
+}
+
+func testSearchSvc(t *testing.T, backend search.Backend) (ingest.Service, *persistence_fake.MockService) {
+	t.Helper()
+
+	psvc := persistence_fake.NewMockService(gomock.NewController(t))
+	ingestsvc := ingest.NewService(ingest.ServiceParams{
+		Logger:             logr.Discard(),
+		DB:                 &sql.DB{},
+		EventService:       event.NewServiceNop[*goaingest.IngestEvent](),
+		PersistenceService: psvc,
+		SearchBackend:      backend,
+	})
+
+	return ingestsvc, psvc
+}
+
+func TestIndexSIP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Indexes a SIP with custom metadata", func(t *testing.T) {
+		t.Parallel()
+
+		backend := &fakeSearchBackend{}
+		svc, psvc := testSearchSvc(t, backend)
+		psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(&datatypes.SIP{
+			UUID:  sipUUID,
+			Name:  "Test SIP",
+			Batch: &datatypes.Batch{Identifier: "batch-1"},
+		}, nil)
+
+		err := svc.IndexSIP(t.Context(), sipUUID, childwf.CustomMetadata{
+			"external_id": json.RawMessage(`"12345"`),
+			"flags":       json.RawMessage(`{"validated":true}`),
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, backend.docs, []*search.Document{
+			{
+				UUID: sipUUID,
+				Name: "Test SIP",
+				Fields: map[string]string{
+					"batch":       "batch-1",
+					"external_id": "12345",
+					"flags":       `{"validated":true}`,
+				},
+			},
+		})
+	})
+
+	t.Run("Errors when the SIP can't be read", func(t *testing.T) {
+		t.Parallel()
+
+		svc, psvc := testSearchSvc(t, &fakeSearchBackend{})
+		psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(nil, errors.New("persistence error"))
+
+		err := svc.IndexSIP(t.Context(), sipUUID, nil)
+		assert.Error(t, err, "index SIP: persistence error")
+	})
+
+	t.Run("Errors when the SIP can't be indexed", func(t *testing.T) {
+		t.Parallel()
+
+		svc, psvc := testSearchSvc(t, &fakeSearchBackend{err: errors.New("backend error")})
+		psvc.EXPECT().ReadSIP(mockutil.Context(), sipUUID).Return(&datatypes.SIP{UUID: sipUUID}, nil)
+
+		err := svc.IndexSIP(t.Context(), sipUUID, nil)
+		assert.Error(t, err, "index SIP: backend error")
+	})
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name      string
+		payload   *goaingest.SearchPayload
+		claims    *auth.Claims
+		backend   *fakeSearchBackend
+		want      *goaingest.SearchResults
+		wantQuery *search.Query
+		wantErr   string
+	}{
+		{
+			name:    "Returns the matching SIPs",
+			payload: &goaingest.SearchPayload{Query: "vecteur 2024", Limit: ref.New(10), Offset: ref.New(5)},
+			backend: &fakeSearchBackend{
+				results: []*search.Result{
+					{
+						UUID:   sipUUID,
+						Name:   "Vecteur 2024",
+						Fields: map[string]string{"batch": "batch-1"},
+					},
+				},
+				total: 6,
+			},
+			want: &goaingest.SearchResults{
+				Items: []*goaingest.SearchResult{
+					{
+						UUID:   sipUUID,
+						Name:   "Vecteur 2024",
+						Fields: map[string]string{"batch": "batch-1"},
+					},
+				},
+				Page: &goaingest.EnduroPage{Limit: 10, Offset: 5, Total: 6},
+			},
+			wantQuery: &search.Query{Text: "vecteur 2024", Limit: 10, Offset: 5},
+		},
+		{
+			name:      "Uses the default limit",
+			payload:   &goaingest.SearchPayload{Query: "vecteur"},
+			backend:   &fakeSearchBackend{},
+			want:      &goaingest.SearchResults{Items: []*goaingest.SearchResult{}, Page: &goaingest.EnduroPage{Limit: search.DefaultLimit}},
+			wantQuery: &search.Query{Text: "vecteur", Limit: search.DefaultLimit},
+		},
+		{
+			name:    "Errors when the query has no search terms",
+			payload: &goaingest.SearchPayload{Query: " * "},
+			backend: &fakeSearchBackend{},
+			wantErr: "query has no search terms",
+		},
+		{
+			name:      "Errors when the search fails",
+			payload:   &goaingest.SearchPayload{Query: "vecteur"},
+			backend:   &fakeSearchBackend{err: errors.New("backend error")},
+			wantQuery: &search.Query{Text: "vecteur", Limit: search.DefaultLimit},
+			wantErr:   "backend error",
+		},
+		{
+			name:    "Errors when the user is only granted scoped attributes",
+			payload: &goaingest.SearchPayload{Query: "vecteur"},
+			claims: &auth.Claims{
+				Attributes: []string{auth.ScopedAttribute(auth.IngestSIPSListAttr, auth.BatchScope, uuid.New())},
+			},
+			backend: &fakeSearchBackend{},
+			wantErr: "Forbidden",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, _ := testSearchSvc(t, tt.backend)
+			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+
+			got, err := svc.Search(ctx, tt.payload)
+			assert.DeepEqual(t, tt.backend.query, tt.wantQuery)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
@@ -96,11 +96,14 @@ func NewStorageClient(
 		storageHTTPClient.DownloadAip(),
 		storageHTTPClient.MoveAip(),
 		storageHTTPClient.MoveAipStatus(),
+		storageHTTPClient.RestoreAips(),
 		storageHTTPClient.RejectAip(),
 		storageHTTPClient.ShowAip(),
 		storageHTTPClient.ListAipWorkflows(),
 		storageHTTPClient.AipDeletionAuto(),
 		storageHTTPClient.RequestAipDeletion(),
+		storageHTTPClient.PlaceAipLegalHold(),
+		storageHTTPClient.ReleaseAipLegalHold(),
 		storageHTTPClient.ReviewAipDeletion(),
 		storageHTTPClient.CancelAipDeletion(),
 		storageHTTPClient.AipDeletionReportRequest(),
@@ -109,5 +112,7 @@ func NewStorageClient(
 		storageHTTPClient.CreateLocation(),
 		storageHTTPClient.ShowLocation(),
 		storageHTTPClient.ListLocationAips(),
+		storageHTTPClient.LocationUsage(),
+		storageHTTPClient.Search(),
 	), nil
 }
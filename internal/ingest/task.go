@@ -71,6 +71,7 @@ func (svc *ingestImpl) CompleteTask(
 	if err != nil {
 		return fmt.Errorf("error updating task: %v", err)
 	}
+	svc.metrics.recordTaskDuration(ctx, task)
 
 	PublishEvent(ctx, svc.evsvc, &goaingest.SIPTaskUpdatedEvent{
 		UUID: task.UUID,
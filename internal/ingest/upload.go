@@ -7,6 +7,7 @@ import (
 	"io"
 	"mime"
 	"mime/multipart"
+	"slices"
 	"strings"
 	"time"
 
@@ -20,12 +21,30 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/enums"
 )
 
+const (
+	// checksumFieldName is the name of the optional multipart form field used
+	// to send the expected SIP checksum, e.g. "sha256:9f86d0...".
+	checksumFieldName = "checksum"
+
+	// processingProfileFieldName is the name of the optional multipart form
+	// field used to select the processing profile of the SIP.
+	processingProfileFieldName = "processing_profile"
+
+	// locationIDFieldName is the name of the optional multipart form field
+	// used to select the storage location of the AIP.
+	locationIDFieldName = "location_id"
+)
+
 type UploadConfig struct {
 	MaxSize int64
 
 	// RetentionPeriod is the duration for which SIPs should be retained after
 	// a successful ingest. If negative, SIPs will be retained indefinitely.
 	RetentionPeriod time.Duration
+
+	// SessionExpiry is the duration after which an incomplete resumable upload
+	// is discarded, counted from the start of the upload.
+	SessionExpiry time.Duration
 }
 
 func (svc *ingestImpl) UploadSip(
@@ -57,6 +76,57 @@ func (svc *ingestImpl) UploadSip(
 		return nil, goaingest.MakeInvalidMultipartRequest(errors.New("invalid multipart request"))
 	}
 
+	// Read the optional expected checksum, processing profile and location
+	// fields, which must be sent before the file part.
+	var (
+		expected   *datatypes.Checksum
+		profile    string
+		locationID *uuid.UUID
+	)
+	for part.FileName() == "" && slices.Contains(
+		[]string{checksumFieldName, processingProfileFieldName, locationIDFieldName},
+		part.FormName(),
+	) {
+		switch part.FormName() {
+		case checksumFieldName:
+			c, err := readChecksumField(part)
+			if err != nil {
+				return nil, goaingest.MakeInvalidMultipartRequest(err)
+			}
+			expected = &c
+		case processingProfileFieldName:
+			profile, err = readFormField(part)
+			if err != nil {
+				return nil, goaingest.MakeInvalidMultipartRequest(err)
+			}
+			if err := svc.checkProcessingProfile(profile); err != nil {
+				return nil, goaingest.MakeInvalidMultipartRequest(err)
+			}
+		case locationIDFieldName:
+			v, err := readFormField(part)
+			if err != nil {
+				return nil, goaingest.MakeInvalidMultipartRequest(err)
+			}
+			id, err := svc.parseAIPLocationID(ctx, v)
+			if errors.Is(err, ErrInvalid) {
+				return nil, goaingest.MakeInvalidMultipartRequest(err)
+			}
+			if err != nil {
+				svc.logger.Error(err, "upload SIP")
+				return nil, ErrInternalError
+			}
+			locationID = &id
+		}
+
+		part, err = mr.NextPart()
+		if err == io.EOF {
+			return nil, goaingest.MakeInvalidMultipartRequest(errors.New("missing file part in upload"))
+		}
+		if err != nil {
+			return nil, goaingest.MakeInvalidMultipartRequest(errors.New("invalid multipart request"))
+		}
+	}
+
 	// Identify file format to add extension in the object key.
 	format, stream, err := archives.Identify(ctx, part.FileName(), part)
 	if err != nil {
@@ -73,7 +143,7 @@ func (svc *ingestImpl) UploadSip(
 		return nil, err
 	}
 
-	_, copyErr := io.Copy(wr, stream)
+	n, copyErr := io.Copy(wr, stream)
 	closeErr := wr.Close()
 
 	if copyErr != nil {
@@ -82,6 +152,7 @@ func (svc *ingestImpl) UploadSip(
 	if closeErr != nil {
 		return nil, closeErr
 	}
+	svc.metrics.recordUpload(ctx, "direct", n)
 
 	if err := svc.initSIP(
 		ctx,
@@ -91,6 +162,9 @@ func (svc *ingestImpl) UploadSip(
 		ext,
 		enums.WorkflowTypeCreateAip,
 		claims,
+		expected,
+		profile,
+		locationID,
 	); err != nil {
 		// Delete SIP from internal bucket.
 		err := errors.Join(
@@ -114,11 +188,19 @@ func (svc *ingestImpl) initSIP(
 	extension string,
 	wType enums.WorkflowType,
 	claims *auth.Claims,
+	expected *datatypes.Checksum,
+	profile string,
+	locationID *uuid.UUID,
 ) error {
 	s := &datatypes.SIP{
-		UUID:   id,
-		Name:   name,
-		Status: enums.SIPStatusQueued,
+		UUID:              id,
+		Name:              name,
+		Status:            enums.SIPStatusQueued,
+		ProcessingProfile: profile,
+		ExpectedChecksum:  expected,
+	}
+	if locationID != nil {
+		s.LocationID = uuid.NullUUID{UUID: *locationID, Valid: true}
 	}
 
 	// If claims is nil, it means authentication is not enabled.
@@ -137,30 +219,61 @@ func (svc *ingestImpl) initSIP(
 	}
 
 	req := ProcessingWorkflowRequest{
-		User:            childWorkflowUserFromClaims(claims),
-		SIPUUID:         id,
-		SIPName:         name,
-		Type:            wType,
-		Key:             key,
-		Extension:       extension,
-		RetentionPeriod: svc.uploadRetentionPeriod,
+		User:              childWorkflowUserFromClaims(claims),
+		SIPUUID:           id,
+		SIPName:           name,
+		Type:              wType,
+		Key:               key,
+		Extension:         extension,
+		RetentionPeriod:   svc.uploadRetentionPeriod,
+		ExpectedChecksum:  expected,
+		ProcessingProfile: profile,
+		LocationID:        locationID,
 	}
 	if err := InitProcessingWorkflow(ctx, svc.tc, svc.taskQueue, &req); err != nil {
 		// Delete SIP from persistence.
 		return errors.Join(
 			err,
 			withFailedIngestCleanupContext(ctx, func(cleanupCtx context.Context) error {
+				svc.unindexSIP(cleanupCtx, id)
 				return svc.perSvc.DeleteSIP(cleanupCtx, id)
 			}),
 		)
 	}
 
+	svc.indexSIP(ctx, s)
 	PublishEvent(ctx, svc.evsvc, sipToCreatedEvent(s))
 	svc.auditLogger.Log(ctx, sipIngestAuditEvent(s))
 
 	return nil
 }
 
+// readChecksumField parses the expected checksum form field. The checksum
+// algorithm defaults to SHA-256 when the value has no algorithm prefix.
+func readChecksumField(part *multipart.Part) (datatypes.Checksum, error) {
+	v, err := readFormField(part)
+	if err != nil {
+		return datatypes.Checksum{}, err
+	}
+
+	c, err := datatypes.ParseChecksum(v, datatypes.ChecksumAlgoSHA256)
+	if err != nil {
+		return datatypes.Checksum{}, fmt.Errorf("invalid checksum: %v", err)
+	}
+
+	return c, nil
+}
+
+// readFormField returns the trimmed value of a short multipart form field.
+func readFormField(part *multipart.Part) (string, error) {
+	b, err := io.ReadAll(io.LimitReader(part, 1024))
+	if err != nil {
+		return "", errors.New("invalid multipart request")
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
+
 func checkClaims(ctx context.Context) (*auth.Claims, error) {
 	claims := auth.UserClaimsFromContext(ctx)
 	if claims == nil {
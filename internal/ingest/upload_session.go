@@ -0,0 +1,637 @@
+package ingest
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mholt/archives"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+)
+
+const (
+	// UploadSessionPrefix is the prefix used to store the state and the chunks
+	// of resumable SIP uploads in the internal bucket.
+	UploadSessionPrefix = "uploads/"
+
+	// defaultUploadSessionExpiry is used when no session expiry is configured.
+	defaultUploadSessionExpiry = 24 * time.Hour
+)
+
+var errUploadNotFound = goaingest.MakeNotFound(errors.New("upload not found"))
+
+// uploadSession is the state of a resumable SIP upload. It's stored as JSON in
+// the internal bucket, next to the chunks received so far.
+type uploadSession struct {
+	UUID              uuid.UUID           `json:"uuid"`
+	Name              string              `json:"name"`
+	Size              int64               `json:"size"`
+	Checksum          *datatypes.Checksum `json:"checksum,omitempty"`
+	ProcessingProfile string              `json:"processing_profile,omitempty"`
+	LocationID        *uuid.UUID          `json:"location_id,omitempty"`
+	OIDCIss           string              `json:"oidc_iss,omitempty"`
+	OIDCSub           string              `json:"oidc_sub,omitempty"`
+	ExpiresAt         time.Time           `json:"expires_at"`
+
+	// SIPUUID is set once all the chunks have been received and the SIP has
+	// been created. The session is kept until it expires so clients can
+	// recover the SIP UUID if the response to the last chunk is lost.
+	SIPUUID *uuid.UUID `json:"sip_uuid,omitempty"`
+}
+
+func (s *uploadSession) goa(offset int64) *goaingest.SIPUpload {
+	res := &goaingest.SIPUpload{
+		UUID:      s.UUID.String(),
+		Name:      s.Name,
+		Size:      s.Size,
+		Offset:    offset,
+		ExpiresAt: s.ExpiresAt.Format(time.RFC3339),
+	}
+	if s.SIPUUID != nil {
+		res.SipUUID = new(s.SIPUUID.String())
+	}
+
+	return res
+}
+
+func uploadSessionDir(id uuid.UUID) string {
+	return UploadSessionPrefix + id.String() + "/"
+}
+
+func uploadSessionKey(id uuid.UUID) string {
+	return uploadSessionDir(id) + "session.json"
+}
+
+// uploadCompleteKey returns the key of the marker object written when the last
+// chunk of an upload is received.
+func uploadCompleteKey(id uuid.UUID) string {
+	return uploadSessionDir(id) + "complete"
+}
+
+func uploadChunkPrefix(id uuid.UUID) string {
+	return uploadSessionDir(id) + "chunks/"
+}
+
+// uploadChunkKey returns the key of the chunk starting at offset. Offsets are
+// zero-padded so listing the chunks returns them in upload order.
+func uploadChunkKey(id uuid.UUID, offset int64) string {
+	return fmt.Sprintf("%s%020d", uploadChunkPrefix(id), offset)
+}
+
+// CreateSipUpload starts a resumable SIP upload. The SIP is sent in one or
+// more chunks with UploadSipChunk and the processing workflow is started once
+// the last chunk is received and verified.
+func (svc *ingestImpl) CreateSipUpload(
+	ctx context.Context,
+	payload *goaingest.CreateSipUploadPayload,
+) (*goaingest.SIPUpload, error) {
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		return nil, goaingest.MakeNotValid(err)
+	}
+
+	name := strings.TrimSpace(payload.Name)
+	if name == "" || name != filepath.Base(name) {
+		return nil, goaingest.MakeNotValid(errors.New("invalid name"))
+	}
+	if payload.Size <= 0 {
+		return nil, goaingest.MakeNotValid(errors.New("size must be greater than zero"))
+	}
+	if payload.Size > svc.uploadMaxSize {
+		return nil, goaingest.MakeNotValid(
+			fmt.Errorf("size exceeds the maximum upload size of %d bytes", svc.uploadMaxSize),
+		)
+	}
+
+	var expected *datatypes.Checksum
+	if payload.Checksum != nil {
+		c, err := datatypes.ParseChecksum(*payload.Checksum, datatypes.ChecksumAlgoSHA256)
+		if err != nil {
+			return nil, goaingest.MakeNotValid(fmt.Errorf("invalid checksum: %v", err))
+		}
+		expected = &c
+	}
+
+	var profile string
+	if payload.ProcessingProfile != nil {
+		profile = *payload.ProcessingProfile
+		if err := svc.checkProcessingProfile(profile); err != nil {
+			return nil, goaingest.MakeNotValid(err)
+		}
+	}
+
+	var locationID *uuid.UUID
+	if payload.LocationID != nil {
+		id, err := svc.parseAIPLocationID(ctx, *payload.LocationID)
+		if errors.Is(err, ErrInvalid) {
+			return nil, goaingest.MakeNotValid(err)
+		}
+		if err != nil {
+			svc.logger.Error(err, "create SIP upload")
+			return nil, ErrInternalError
+		}
+		locationID = &id
+	}
+
+	// Remove expired sessions before starting a new one.
+	svc.deleteExpiredUploadSessions(ctx)
+
+	s := &uploadSession{
+		UUID:              uuid.Must(uuid.NewRandomFromReader(svc.rander)),
+		Name:              name,
+		Size:              payload.Size,
+		Checksum:          expected,
+		ProcessingProfile: profile,
+		LocationID:        locationID,
+		ExpiresAt:         time.Now().Add(svc.uploadSessionExpiry).UTC().Truncate(time.Second),
+	}
+	if claims != nil {
+		s.OIDCIss = claims.Iss
+		s.OIDCSub = claims.Sub
+	}
+
+	if err := svc.writeUploadSession(ctx, s); err != nil {
+		svc.logger.Error(err, "create SIP upload")
+		return nil, ErrInternalError
+	}
+
+	return s.goa(0), nil
+}
+
+// ShowSipUpload returns the progress of a resumable SIP upload.
+func (svc *ingestImpl) ShowSipUpload(
+	ctx context.Context,
+	payload *goaingest.ShowSipUploadPayload,
+) (*goaingest.SIPUpload, error) {
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		return nil, goaingest.MakeNotValid(err)
+	}
+
+	s, err := svc.readUploadSession(ctx, payload.UUID, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	_, offset, err := svc.listUploadChunks(ctx, s)
+	if err != nil {
+		svc.logger.Error(err, "show SIP upload: list chunks", "uuid", s.UUID)
+		return nil, ErrInternalError
+	}
+
+	return s.goa(offset), nil
+}
+
+// UploadSipChunk stores the next chunk of a resumable SIP upload in the
+// internal bucket. The chunk offset must match the current upload offset and,
+// if a chunk checksum is given, the chunk is discarded when it doesn't match.
+// When the last chunk is received, the chunks are assembled into the SIP, the
+// SIP size and expected checksum are verified and the SIP is ingested. If the
+// completion fails after all the chunks have been received, an empty request at
+// the upload size offset completes the upload again.
+func (svc *ingestImpl) UploadSipChunk(
+	ctx context.Context,
+	payload *goaingest.UploadSipChunkPayload,
+	req io.ReadCloser,
+) (*goaingest.SIPUpload, error) {
+	defer req.Close()
+
+	claims, err := checkClaims(ctx)
+	if err != nil {
+		return nil, goaingest.MakeNotValid(err)
+	}
+
+	var chunkSum *datatypes.Checksum
+	if payload.Checksum != nil {
+		c, err := datatypes.ParseChecksum(*payload.Checksum, datatypes.ChecksumAlgoSHA256)
+		if err != nil {
+			return nil, goaingest.MakeNotValid(fmt.Errorf("invalid checksum: %v", err))
+		}
+		chunkSum = &c
+	}
+
+	s, err := svc.readUploadSession(ctx, payload.UUID, claims)
+	if err != nil {
+		return nil, err
+	}
+	if s.SIPUUID != nil {
+		return nil, goaingest.MakeNotAvailable(errors.New("upload is already complete"))
+	}
+
+	keys, offset, err := svc.listUploadChunks(ctx, s)
+	if err != nil {
+		svc.logger.Error(err, "upload SIP chunk: list chunks", "uuid", s.UUID)
+		return nil, ErrInternalError
+	}
+	if payload.Offset != offset {
+		return nil, goaingest.MakeNotAvailable(
+			fmt.Errorf("offset mismatch: the upload offset is %d", offset),
+		)
+	}
+
+	// All the chunks have been received, retry the upload completion.
+	if offset == s.Size {
+		if n, _ := io.Copy(io.Discard, io.LimitReader(req, 1)); n > 0 {
+			return nil, goaingest.MakeNotValid(errors.New("chunk exceeds the upload size"))
+		}
+		if err := svc.completeUpload(ctx, s, keys, claims); err != nil {
+			return nil, err
+		}
+
+		return s.goa(offset), nil
+	}
+
+	key := uploadChunkKey(s.UUID, offset)
+	var algo datatypes.ChecksumAlgo
+	if chunkSum != nil {
+		algo = chunkSum.Algorithm
+	}
+	n, sum, err := svc.writeUploadObject(ctx, key, io.LimitReader(req, s.Size-offset+1), algo)
+	if err != nil {
+		svc.deleteUploadObject(ctx, key)
+		svc.logger.Error(err, "upload SIP chunk", "uuid", s.UUID)
+		return nil, ErrInternalError
+	}
+
+	var verr error
+	switch {
+	case n == 0:
+		verr = errors.New("empty chunk")
+	case offset+n > s.Size:
+		verr = errors.New("chunk exceeds the upload size")
+	case chunkSum != nil && sum != chunkSum.Hash:
+		verr = errors.New("chunk checksum mismatch")
+	}
+	if verr != nil {
+		svc.deleteUploadObject(ctx, key)
+		return nil, goaingest.MakeNotValid(verr)
+	}
+	svc.metrics.recordUpload(ctx, "chunked", n)
+
+	keys = append(keys, key)
+	offset += n
+	if offset < s.Size {
+		return s.goa(offset), nil
+	}
+
+	if err := svc.completeUpload(ctx, s, keys, claims); err != nil {
+		return nil, err
+	}
+
+	return s.goa(offset), nil
+}
+
+// completeUpload assembles the upload chunks into a SIP in the internal
+// bucket, verifies its size and expected checksum and starts its processing
+// workflow. The upload session is then updated with the SIP UUID and the
+// chunks are deleted. Uploads that fail verification are deleted.
+//
+// The upload is first marked as completing with a conditional write, so only
+// one of the concurrent requests sending the last chunk creates the SIP. The
+// mark is removed when the completion fails, so it can be retried.
+func (svc *ingestImpl) completeUpload(
+	ctx context.Context,
+	s *uploadSession,
+	keys []string,
+	claims *auth.Claims,
+) (e error) {
+	err := svc.internalStorage.WriteAll(ctx, uploadCompleteKey(s.UUID), nil, &blob.WriterOptions{
+		IfNotExist: true,
+	})
+	if gcerrors.Code(err) == gcerrors.FailedPrecondition {
+		return goaingest.MakeNotAvailable(errors.New("upload is already being completed"))
+	}
+	if err != nil {
+		svc.logger.Error(err, "complete SIP upload: mark session", "uuid", s.UUID)
+		return ErrInternalError
+	}
+	defer func() {
+		if e != nil {
+			svc.deleteUploadObject(ctx, uploadCompleteKey(s.UUID))
+		}
+	}()
+
+	r := &uploadChunkReader{ctx: ctx, bucket: svc.internalStorage, keys: keys}
+	defer r.Close()
+
+	// Identify file format to add extension in the object key.
+	format, stream, err := archives.Identify(ctx, s.Name, r)
+	if err != nil {
+		svc.deleteUpload(ctx, s.UUID)
+		return goaingest.MakeNotValid(errors.New("unable to identify format"))
+	}
+
+	// Remove the format extension from the filename if it is included.
+	ext := format.Extension()
+	name := strings.TrimSuffix(s.Name, ext)
+	sipUUID := uuid.Must(uuid.NewRandomFromReader(svc.rander))
+	objectKey := fmt.Sprintf("%s%s-%s%s", SIPPrefix, name, sipUUID.String(), ext)
+
+	var algo datatypes.ChecksumAlgo
+	if s.Checksum != nil {
+		algo = s.Checksum.Algorithm
+	}
+	n, sum, err := svc.writeUploadObject(ctx, objectKey, stream, algo)
+	if err != nil {
+		svc.deleteUploadObject(ctx, objectKey)
+		svc.logger.Error(err, "complete SIP upload", "uuid", s.UUID)
+		return ErrInternalError
+	}
+
+	var verr error
+	switch {
+	case n != s.Size:
+		verr = fmt.Errorf("size mismatch: received %d bytes", n)
+	case s.Checksum != nil && sum != s.Checksum.Hash:
+		verr = fmt.Errorf("checksum mismatch: received %s:%s", algo.Short(), sum)
+	}
+	if verr != nil {
+		svc.deleteUploadObject(ctx, objectKey)
+		svc.deleteUpload(ctx, s.UUID)
+		return goaingest.MakeNotValid(verr)
+	}
+
+	if err := svc.initSIP(
+		ctx,
+		sipUUID,
+		s.Name,
+		objectKey,
+		ext,
+		enums.WorkflowTypeCreateAip,
+		claims,
+		s.Checksum,
+		s.ProcessingProfile,
+		s.LocationID,
+	); err != nil {
+		svc.deleteUploadObject(ctx, objectKey)
+		svc.logger.Error(err, "complete SIP upload")
+		return ErrInternalError
+	}
+
+	s.SIPUUID = &sipUUID
+	if err := svc.writeUploadSession(ctx, s); err != nil {
+		svc.logger.Error(err, "complete SIP upload: update session", "uuid", s.UUID)
+	}
+	for _, key := range keys {
+		if err := svc.internalStorage.Delete(ctx, key); err != nil {
+			svc.logger.Error(err, "complete SIP upload: delete chunk", "key", key)
+		}
+	}
+
+	return nil
+}
+
+// writeUploadObject copies r to the internal bucket object at key. It returns
+// the number of bytes written and, when algo is set, their hex encoded hash.
+func (svc *ingestImpl) writeUploadObject(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	algo datatypes.ChecksumAlgo,
+) (int64, string, error) {
+	w, err := svc.internalStorage.NewWriter(ctx, key, &blob.WriterOptions{})
+	if err != nil {
+		return 0, "", err
+	}
+
+	var dst io.Writer = w
+	h := algo.New()
+	if h != nil {
+		dst = io.MultiWriter(w, h)
+	}
+
+	n, copyErr := io.Copy(dst, r)
+	closeErr := w.Close()
+	if copyErr != nil {
+		return 0, "", copyErr
+	}
+	if closeErr != nil {
+		return 0, "", closeErr
+	}
+
+	var sum string
+	if h != nil {
+		sum = hex.EncodeToString(h.Sum(nil))
+	}
+
+	return n, sum, nil
+}
+
+// deleteUploadObject deletes an object from the internal bucket after a failed
+// upload. Errors are logged and otherwise ignored.
+func (svc *ingestImpl) deleteUploadObject(ctx context.Context, key string) {
+	err := withFailedIngestCleanupContext(ctx, func(cleanupCtx context.Context) error {
+		return svc.internalStorage.Delete(cleanupCtx, key)
+	})
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		svc.logger.Error(err, "delete SIP upload object", "key", key)
+	}
+}
+
+// readUploadSession returns the upload session with the given id. Expired
+// sessions and sessions started by other users are reported as not found.
+func (svc *ingestImpl) readUploadSession(
+	ctx context.Context,
+	id string,
+	claims *auth.Claims,
+) (*uploadSession, error) {
+	uploadUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, goaingest.MakeNotValid(errors.New("invalid UUID"))
+	}
+
+	s, err := svc.loadUploadSession(ctx, uploadUUID)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, errUploadNotFound
+		}
+		svc.logger.Error(err, "read SIP upload session", "uuid", id)
+		return nil, ErrInternalError
+	}
+
+	if time.Now().After(s.ExpiresAt) {
+		return nil, errUploadNotFound
+	}
+	if claims != nil && (claims.Iss != s.OIDCIss || claims.Sub != s.OIDCSub) {
+		return nil, errUploadNotFound
+	}
+
+	return s, nil
+}
+
+func (svc *ingestImpl) loadUploadSession(ctx context.Context, id uuid.UUID) (*uploadSession, error) {
+	b, err := svc.internalStorage.ReadAll(ctx, uploadSessionKey(id))
+	if err != nil {
+		return nil, err
+	}
+
+	var s uploadSession
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("decode upload session: %v", err)
+	}
+
+	return &s, nil
+}
+
+func (svc *ingestImpl) writeUploadSession(ctx context.Context, s *uploadSession) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("encode upload session: %v", err)
+	}
+
+	return svc.internalStorage.WriteAll(ctx, uploadSessionKey(s.UUID), b, &blob.WriterOptions{
+		ContentType: "application/json",
+	})
+}
+
+// listUploadChunks returns the keys of the chunks received for an upload, in
+// order, and the current upload offset.
+func (svc *ingestImpl) listUploadChunks(ctx context.Context, s *uploadSession) ([]string, int64, error) {
+	if s.SIPUUID != nil {
+		return nil, s.Size, nil
+	}
+
+	var (
+		keys   []string
+		offset int64
+	)
+	prefix := uploadChunkPrefix(s.UUID)
+	iter := svc.internalStorage.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// Ignore chunks that don't follow the previous chunk.
+		start, err := strconv.ParseInt(strings.TrimPrefix(obj.Key, prefix), 10, 64)
+		if err != nil || start != offset {
+			break
+		}
+
+		keys = append(keys, obj.Key)
+		offset += obj.Size
+	}
+
+	return keys, offset, nil
+}
+
+// deleteUpload deletes the state and the chunks of an upload. Errors are
+// logged and otherwise ignored.
+func (svc *ingestImpl) deleteUpload(ctx context.Context, id uuid.UUID) {
+	err := withFailedIngestCleanupContext(ctx, func(cleanupCtx context.Context) error {
+		iter := svc.internalStorage.List(&blob.ListOptions{Prefix: uploadSessionDir(id)})
+		for {
+			obj, err := iter.Next(cleanupCtx)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := svc.internalStorage.Delete(cleanupCtx, obj.Key); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		svc.logger.Error(err, "delete SIP upload", "uuid", id)
+	}
+}
+
+// deleteExpiredUploadSessions deletes the uploads whose session has expired.
+// Errors are logged and otherwise ignored.
+func (svc *ingestImpl) deleteExpiredUploadSessions(ctx context.Context) {
+	iter := svc.internalStorage.List(&blob.ListOptions{
+		Prefix:    UploadSessionPrefix,
+		Delimiter: "/",
+	})
+	now := time.Now()
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			svc.logger.Error(err, "delete expired SIP uploads")
+			return
+		}
+		if !obj.IsDir {
+			continue
+		}
+
+		id, err := uuid.Parse(strings.TrimSuffix(strings.TrimPrefix(obj.Key, UploadSessionPrefix), "/"))
+		if err != nil {
+			continue
+		}
+
+		s, err := svc.loadUploadSession(ctx, id)
+		if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			continue
+		}
+		if s == nil || now.After(s.ExpiresAt) {
+			svc.deleteUpload(ctx, id)
+		}
+	}
+}
+
+// uploadChunkReader reads the chunks of an upload in order, opening each chunk
+// only when the previous one has been read.
+type uploadChunkReader struct {
+	ctx    context.Context
+	bucket *blob.Bucket
+	keys   []string
+	cur    io.ReadCloser
+}
+
+func (r *uploadChunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if len(r.keys) == 0 {
+				return 0, io.EOF
+			}
+			cur, err := r.bucket.NewReader(r.ctx, r.keys[0], nil)
+			if err != nil {
+				return 0, err
+			}
+			r.cur, r.keys = cur, r.keys[1:]
+		}
+
+		n, err := r.cur.Read(p)
+		if err == io.EOF {
+			_ = r.cur.Close()
+			r.cur = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+
+		return n, err
+	}
+}
+
+func (r *uploadChunkReader) Close() error {
+	if r.cur == nil {
+		return nil
+	}
+
+	return r.cur.Close()
+}
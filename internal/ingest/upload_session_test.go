@@ -0,0 +1,568 @@
+package ingest_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
+	temporalsdk_client "go.temporal.io/sdk/client"
+	temporalsdk_mocks "go.temporal.io/sdk/mocks"
+	"go.uber.org/mock/gomock"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+	"gotest.tools/v3/assert"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/ingest"
+	persistence_fake "github.com/artefactual-sdps/enduro/internal/persistence/fake"
+)
+
+const uploadContent = "<binary zip data>"
+
+var uploadUUID = uuid.MustParse("52fdfc07-2182-454f-963f-5f0f9a621d72")
+
+func sha256Checksum(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func writeUploadSession(t *testing.T, b *blob.Bucket, session string) {
+	t.Helper()
+
+	key := fmt.Sprintf("%s%s/session.json", ingest.UploadSessionPrefix, uploadUUID)
+	err := b.WriteAll(t.Context(), key, []byte(session), nil)
+	assert.NilError(t, err)
+}
+
+func listKeys(t *testing.T, b *blob.Bucket, prefix string) []string {
+	t.Helper()
+
+	var keys []string
+	iter := b.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(t.Context())
+		if err == io.EOF {
+			break
+		}
+		assert.NilError(t, err)
+		keys = append(keys, obj.Key)
+	}
+
+	return keys
+}
+
+func TestCreateSipUpload(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name    string
+		payload *goaingest.CreateSipUploadPayload
+		claims  *auth.Claims
+		want    *goaingest.SIPUpload
+		wantErr string
+	}{
+		{
+			name:    "Fails with an invalid name",
+			payload: &goaingest.CreateSipUploadPayload{Name: "../first.zip", Size: 17},
+			wantErr: "invalid name",
+		},
+		{
+			name:    "Fails with an invalid size",
+			payload: &goaingest.CreateSipUploadPayload{Name: "first.zip"},
+			wantErr: "size must be greater than zero",
+		},
+		{
+			name:    "Fails when the size exceeds the maximum upload size",
+			payload: &goaingest.CreateSipUploadPayload{Name: "first.zip", Size: 1025},
+			wantErr: "size exceeds the maximum upload size of 1024 bytes",
+		},
+		{
+			name: "Fails with an invalid checksum",
+			payload: &goaingest.CreateSipUploadPayload{
+				Name:     "first.zip",
+				Size:     17,
+				Checksum: new("md5:1234"),
+			},
+			wantErr: `invalid checksum: invalid MD5 hash: "1234"`,
+		},
+		{
+			name: "Fails with an unknown processing profile",
+			payload: &goaingest.CreateSipUploadPayload{
+				Name:              "first.zip",
+				Size:              17,
+				ProcessingProfile: new("unknown"),
+			},
+			wantErr: `unknown processing profile: "unknown"`,
+		},
+		{
+			name: "Fails with an invalid location",
+			payload: &goaingest.CreateSipUploadPayload{
+				Name:       "first.zip",
+				Size:       17,
+				LocationID: new("invalid"),
+			},
+			wantErr: "invalid: location_id",
+		},
+		{
+			name: "Fails with invalid claims",
+			payload: &goaingest.CreateSipUploadPayload{
+				Name: "first.zip",
+				Size: 17,
+			},
+			claims:  &auth.Claims{Email: "nobody@example.com"},
+			wantErr: "invalid user claims: missing Iss",
+		},
+		{
+			name: "Creates an upload",
+			payload: &goaingest.CreateSipUploadPayload{
+				Name:              "first.zip",
+				Size:              17,
+				Checksum:          new(sha256Checksum(uploadContent)),
+				ProcessingProfile: new("fast"),
+			},
+			want: &goaingest.SIPUpload{
+				UUID: uploadUUID.String(),
+				Name: "first.zip",
+				Size: 17,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := memblob.OpenBucket(nil)
+			svc, _, _ := testSvc(t, b, 1024)
+			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+
+			re, err := svc.CreateSipUpload(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				assert.Equal(t, len(listKeys(t, b, ingest.UploadSessionPrefix)), 0)
+				return
+			}
+			assert.NilError(t, err)
+
+			expiresAt, err := time.Parse(time.RFC3339, re.ExpiresAt)
+			assert.NilError(t, err)
+			assert.Assert(t, expiresAt.After(time.Now().Add(23*time.Hour)))
+
+			re.ExpiresAt = ""
+			assert.DeepEqual(t, re, tt.want)
+
+			// Make sure the session has been stored.
+			assert.DeepEqual(t, listKeys(t, b, ingest.UploadSessionPrefix), []string{
+				fmt.Sprintf("%s%s/session.json", ingest.UploadSessionPrefix, uploadUUID),
+			})
+		})
+	}
+}
+
+func TestCreateSipUploadDeletesExpiredUploads(t *testing.T) {
+	t.Parallel()
+
+	b := memblob.OpenBucket(nil)
+	svc, _, _ := testSvc(t, b, 1024)
+
+	expiredUUID := "9566c74d-1003-4c4d-bbbb-0407d1e2c649"
+	expiredPrefix := ingest.UploadSessionPrefix + expiredUUID + "/"
+	assert.NilError(t, b.WriteAll(t.Context(), expiredPrefix+"session.json", []byte(
+		`{"uuid":"`+expiredUUID+`","name":"old.zip","size":17,"expires_at":"2020-01-01T00:00:00Z"}`,
+	), nil))
+	assert.NilError(t, b.WriteAll(t.Context(), expiredPrefix+"chunks/00000000000000000000", []byte("<binary"), nil))
+
+	_, err := svc.CreateSipUpload(t.Context(), &goaingest.CreateSipUploadPayload{Name: "first.zip", Size: 17})
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, listKeys(t, b, ingest.UploadSessionPrefix), []string{
+		fmt.Sprintf("%s%s/session.json", ingest.UploadSessionPrefix, uploadUUID),
+	})
+}
+
+func TestShowSipUpload(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	session := fmt.Sprintf(
+		`{"uuid":%q,"name":"first.zip","size":17,"oidc_iss":"issuer","oidc_sub":"subject","expires_at":%q}`,
+		uploadUUID, expiresAt.Format(time.RFC3339),
+	)
+	chunkKey := fmt.Sprintf("%s%s/chunks/%020d", ingest.UploadSessionPrefix, uploadUUID, 0)
+
+	for _, tt := range []struct {
+		name    string
+		uuid    string
+		session string
+		chunks  map[string]string
+		claims  *auth.Claims
+		want    *goaingest.SIPUpload
+		wantErr string
+	}{
+		{
+			name:    "Fails with an invalid UUID",
+			uuid:    "invalid-uuid",
+			wantErr: "invalid UUID",
+		},
+		{
+			name:    "Fails when the upload doesn't exist",
+			uuid:    uploadUUID.String(),
+			wantErr: "upload not found",
+		},
+		{
+			name: "Fails when the upload has expired",
+			uuid: uploadUUID.String(),
+			session: fmt.Sprintf(
+				`{"uuid":%q,"name":"first.zip","size":17,"expires_at":"2020-01-01T00:00:00Z"}`,
+				uploadUUID,
+			),
+			wantErr: "upload not found",
+		},
+		{
+			name:    "Fails when the upload belongs to another user",
+			uuid:    uploadUUID.String(),
+			session: session,
+			claims:  &auth.Claims{Iss: "issuer", Sub: "other"},
+			wantErr: "upload not found",
+		},
+		{
+			name:    "Shows an upload",
+			uuid:    uploadUUID.String(),
+			session: session,
+			chunks: map[string]string{
+				chunkKey: "<binary",
+				// Chunks that don't follow the previous chunk are ignored.
+				fmt.Sprintf("%s%s/chunks/%020d", ingest.UploadSessionPrefix, uploadUUID, 10): "data>",
+			},
+			claims: &auth.Claims{Iss: "issuer", Sub: "subject"},
+			want: &goaingest.SIPUpload{
+				UUID:      uploadUUID.String(),
+				Name:      "first.zip",
+				Size:      17,
+				Offset:    7,
+				ExpiresAt: expiresAt.Format(time.RFC3339),
+			},
+		},
+		{
+			name: "Shows a complete upload",
+			uuid: uploadUUID.String(),
+			session: fmt.Sprintf(
+				`{"uuid":%q,"name":"first.zip","size":17,"expires_at":%q,"sip_uuid":%q}`,
+				uploadUUID, expiresAt.Format(time.RFC3339), sipUUID,
+			),
+			want: &goaingest.SIPUpload{
+				UUID:      uploadUUID.String(),
+				Name:      "first.zip",
+				Size:      17,
+				Offset:    17,
+				ExpiresAt: expiresAt.Format(time.RFC3339),
+				SipUUID:   new(sipUUID.String()),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := memblob.OpenBucket(nil)
+			svc, _, _ := testSvc(t, b, 1024)
+			ctx := t.Context()
+			if tt.claims != nil {
+				ctx = auth.WithUserClaims(ctx, tt.claims)
+			}
+			if tt.session != "" {
+				writeUploadSession(t, b, tt.session)
+			}
+			for key, data := range tt.chunks {
+				assert.NilError(t, b.WriteAll(ctx, key, []byte(data), nil))
+			}
+
+			re, err := svc.ShowSipUpload(ctx, &goaingest.ShowSipUploadPayload{UUID: tt.uuid})
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, re, tt.want)
+		})
+	}
+}
+
+func TestUploadSipChunk(t *testing.T) {
+	t.Parallel()
+
+	uuid1 := uuid.MustParse("9566c74d-1003-4c4d-bbbb-0407d1e2c649")
+	key := fmt.Sprintf("%sfirst-%s.zip", ingest.SIPPrefix, uuid1)
+	chunksPrefix := fmt.Sprintf("%s%s/chunks/", ingest.UploadSessionPrefix, uploadUUID)
+
+	type chunk struct {
+		offset   int64
+		data     string
+		checksum *string
+	}
+
+	for _, tt := range []struct {
+		name       string
+		checksum   *string
+		completing bool
+		chunks     []chunk
+		mock       func(*persistence_fake.MockService, *temporalsdk_mocks.Client)
+		want       *goaingest.SIPUpload
+		wantErr    string
+		wantChunks []string
+		wantSIP    bool
+	}{
+		{
+			name:    "Fails when the offset doesn't match the upload offset",
+			chunks:  []chunk{{offset: 7, data: "data>"}},
+			wantErr: "offset mismatch: the upload offset is 0",
+		},
+		{
+			name:    "Fails with an invalid chunk checksum",
+			chunks:  []chunk{{data: "<binary", checksum: new("md5:1234")}},
+			wantErr: `invalid checksum: invalid MD5 hash: "1234"`,
+		},
+		{
+			name: "Fails when the chunk checksum doesn't match",
+			chunks: []chunk{{
+				data:     "<binary",
+				checksum: new("sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"),
+			}},
+			wantErr: "chunk checksum mismatch",
+		},
+		{
+			name:    "Fails when the chunk is empty",
+			chunks:  []chunk{{data: ""}},
+			wantErr: "empty chunk",
+		},
+		{
+			name:    "Fails when the chunk exceeds the upload size",
+			chunks:  []chunk{{data: uploadContent + "extra"}},
+			wantErr: "chunk exceeds the upload size",
+		},
+		{
+			name:   "Stores a chunk",
+			chunks: []chunk{{data: "<binary", checksum: new(sha256Checksum("<binary"))}},
+			want: &goaingest.SIPUpload{
+				UUID:   uploadUUID.String(),
+				Name:   "first.zip",
+				Size:   17,
+				Offset: 7,
+			},
+			wantChunks: []string{chunksPrefix + "00000000000000000000"},
+		},
+		{
+			name:     "Fails when the SIP checksum doesn't match",
+			checksum: new("sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"),
+			chunks: []chunk{
+				{data: "<binary"},
+				{offset: 7, data: " zip data>"},
+			},
+			wantErr: "checksum mismatch: received " + sha256Checksum(uploadContent),
+		},
+		{
+			name:       "Fails when the upload is already being completed",
+			completing: true,
+			chunks: []chunk{
+				{data: "<binary"},
+				{offset: 7, data: " zip data>"},
+			},
+			wantErr:    "upload is already being completed",
+			wantChunks: []string{chunksPrefix + "00000000000000000000", chunksPrefix + "00000000000000000007"},
+		},
+		{
+			name:     "Completes an upload",
+			checksum: new(sha256Checksum(uploadContent)),
+			chunks: []chunk{
+				{data: "<binary"},
+				{offset: 7, data: " zip data>"},
+			},
+			mock: func(psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().CreateSIP(
+					mockutil.Context(),
+					mockutil.Eq(&datatypes.SIP{
+						UUID:   uuid1,
+						Name:   "first.zip",
+						Status: enums.SIPStatusQueued,
+						ExpectedChecksum: &datatypes.Checksum{
+							Algorithm: datatypes.ChecksumAlgoSHA256,
+							Hash:      strings.TrimPrefix(sha256Checksum(uploadContent), "sha256:"),
+						},
+					}),
+				).Return(nil)
+
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					temporalsdk_client.StartWorkflowOptions{
+						ID:                    fmt.Sprintf("processing-workflow-%s", uuid1.String()),
+						TaskQueue:             "test",
+						WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+					},
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID:   uuid1,
+						SIPName:   "first.zip",
+						Type:      enums.WorkflowTypeCreateAip,
+						Key:       key,
+						Extension: ".zip",
+						ExpectedChecksum: &datatypes.Checksum{
+							Algorithm: datatypes.ChecksumAlgoSHA256,
+							Hash:      strings.TrimPrefix(sha256Checksum(uploadContent), "sha256:"),
+						},
+					},
+				).Return(nil, nil)
+			},
+			want: &goaingest.SIPUpload{
+				UUID:    uploadUUID.String(),
+				Name:    "first.zip",
+				Size:    17,
+				Offset:  17,
+				SipUUID: new(uuid1.String()),
+			},
+			wantSIP: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := memblob.OpenBucket(nil)
+			svc, psvc, tc := testSvc(t, b, 1024)
+			ctx := t.Context()
+			if tt.mock != nil {
+				tt.mock(psvc, tc)
+			}
+
+			_, err := svc.CreateSipUpload(ctx, &goaingest.CreateSipUploadPayload{
+				Name:     "first.zip",
+				Size:     int64(len(uploadContent)),
+				Checksum: tt.checksum,
+			})
+			assert.NilError(t, err)
+
+			if tt.completing {
+				// Another request has received the last chunk.
+				key := fmt.Sprintf("%s%s/complete", ingest.UploadSessionPrefix, uploadUUID)
+				assert.NilError(t, b.WriteAll(ctx, key, nil, nil))
+			}
+
+			var re *goaingest.SIPUpload
+			for _, c := range tt.chunks {
+				re, err = svc.UploadSipChunk(
+					ctx,
+					&goaingest.UploadSipChunkPayload{
+						UUID:     uploadUUID.String(),
+						Offset:   c.offset,
+						Checksum: c.checksum,
+					},
+					io.NopCloser(strings.NewReader(c.data)),
+				)
+				if err != nil {
+					break
+				}
+			}
+
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+
+				// On any error, the SIP should not exist.
+				_, err = b.ReadAll(ctx, key)
+				assert.ErrorContains(t, err, fmt.Sprintf("blob (key %q) (code=NotFound): blob not found", key))
+				assert.DeepEqual(t, listKeys(t, b, chunksPrefix), tt.wantChunks)
+
+				return
+			}
+			assert.NilError(t, err)
+
+			re.ExpiresAt = ""
+			assert.DeepEqual(t, re, tt.want)
+			assert.DeepEqual(t, listKeys(t, b, chunksPrefix), tt.wantChunks)
+
+			if tt.wantSIP {
+				data, err := b.ReadAll(ctx, key)
+				assert.NilError(t, err)
+				assert.Equal(t, string(data), uploadContent)
+
+				// The session reports the SIP after the upload is complete.
+				re, err = svc.ShowSipUpload(ctx, &goaingest.ShowSipUploadPayload{UUID: uploadUUID.String()})
+				assert.NilError(t, err)
+				assert.DeepEqual(t, re.SipUUID, new(uuid1.String()))
+
+				_, err = svc.UploadSipChunk(
+					ctx,
+					&goaingest.UploadSipChunkPayload{UUID: uploadUUID.String(), Offset: 17},
+					io.NopCloser(strings.NewReader("more")),
+				)
+				assert.Error(t, err, "upload is already complete")
+			}
+		})
+	}
+
+	t.Run("Retries a failed upload completion", func(t *testing.T) {
+		t.Parallel()
+
+		b := memblob.OpenBucket(nil)
+		svc, psvc, tc := testSvc(t, b, 1024)
+		ctx := t.Context()
+
+		psvc.EXPECT().CreateSIP(mockutil.Context(), gomock.Any()).Return(errors.New("connection refused"))
+		psvc.EXPECT().CreateSIP(mockutil.Context(), gomock.Any()).Return(nil)
+		tc.On(
+			"ExecuteWorkflow",
+			mock.AnythingOfType("*context.timerCtx"),
+			mock.Anything,
+			ingest.ProcessingWorkflowName,
+			mock.AnythingOfType("*ingest.ProcessingWorkflowRequest"),
+		).Return(nil, nil)
+
+		_, err := svc.CreateSipUpload(ctx, &goaingest.CreateSipUploadPayload{
+			Name: "first.zip",
+			Size: int64(len(uploadContent)),
+		})
+		assert.NilError(t, err)
+
+		_, err = svc.UploadSipChunk(
+			ctx,
+			&goaingest.UploadSipChunkPayload{UUID: uploadUUID.String()},
+			io.NopCloser(strings.NewReader(uploadContent)),
+		)
+		assert.Error(t, err, "internal error")
+
+		// The upload isn't marked as completing and keeps its chunks.
+		completeKey := fmt.Sprintf("%s%s/complete", ingest.UploadSessionPrefix, uploadUUID)
+		exists, err := b.Exists(ctx, completeKey)
+		assert.NilError(t, err)
+		assert.Assert(t, !exists)
+		assert.DeepEqual(t, listKeys(t, b, chunksPrefix), []string{chunksPrefix + "00000000000000000000"})
+
+		// Only an empty request is accepted at the upload size offset.
+		_, err = svc.UploadSipChunk(
+			ctx,
+			&goaingest.UploadSipChunkPayload{UUID: uploadUUID.String(), Offset: 17},
+			io.NopCloser(strings.NewReader("more")),
+		)
+		assert.Error(t, err, "chunk exceeds the upload size")
+
+		re, err := svc.UploadSipChunk(
+			ctx,
+			&goaingest.UploadSipChunkPayload{UUID: uploadUUID.String(), Offset: 17},
+			io.NopCloser(strings.NewReader("")),
+		)
+		assert.NilError(t, err)
+		assert.Equal(t, re.Offset, int64(17))
+		assert.Assert(t, re.SipUUID != nil)
+		assert.DeepEqual(t, listKeys(t, b, chunksPrefix), []string(nil))
+	})
+}
@@ -46,6 +46,76 @@ Content-Type: application/zip
 --foobar--
 `
 
+const checksumMultipartBody = `Content-Type: multipart/form-data; boundary="foobar"
+
+--foobar
+Content-Disposition: form-data; name="checksum"
+
+sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+--foobar
+Content-Disposition: form-data; name="field1"; filename="first.zip"
+Content-Type: application/zip
+
+<binary zip data>
+--foobar--
+`
+
+const invalidChecksumMultipartBody = `Content-Type: multipart/form-data; boundary="foobar"
+
+--foobar
+Content-Disposition: form-data; name="checksum"
+
+md5:1234
+--foobar
+Content-Disposition: form-data; name="field1"; filename="first.zip"
+Content-Type: application/zip
+
+<binary zip data>
+--foobar--
+`
+
+const profileMultipartBody = `Content-Type: multipart/form-data; boundary="foobar"
+
+--foobar
+Content-Disposition: form-data; name="processing_profile"
+
+fast
+--foobar
+Content-Disposition: form-data; name="field1"; filename="first.zip"
+Content-Type: application/zip
+
+<binary zip data>
+--foobar--
+`
+
+const invalidProfileMultipartBody = `Content-Type: multipart/form-data; boundary="foobar"
+
+--foobar
+Content-Disposition: form-data; name="processing_profile"
+
+unknown
+--foobar
+Content-Disposition: form-data; name="field1"; filename="first.zip"
+Content-Type: application/zip
+
+<binary zip data>
+--foobar--
+`
+
+const invalidLocationMultipartBody = `Content-Type: multipart/form-data; boundary="foobar"
+
+--foobar
+Content-Disposition: form-data; name="location_id"
+
+invalid
+--foobar
+Content-Disposition: form-data; name="field1"; filename="first.zip"
+Content-Type: application/zip
+
+<binary zip data>
+--foobar--
+`
+
 func TestUpload(t *testing.T) {
 	t.Parallel()
 
@@ -84,6 +154,27 @@ func TestUpload(t *testing.T) {
 			maxUploadSize: 102400000,
 			wantErr:       "missing file part in upload",
 		},
+		{
+			name:          "Returns invalid_multipart_request if the checksum is invalid",
+			multipartBody: invalidChecksumMultipartBody,
+			contentType:   "multipart/form-data; boundary=foobar",
+			maxUploadSize: 102400000,
+			wantErr:       `invalid checksum: invalid MD5 hash: "1234"`,
+		},
+		{
+			name:          "Returns invalid_multipart_request if the processing profile is unknown",
+			multipartBody: invalidProfileMultipartBody,
+			contentType:   "multipart/form-data; boundary=foobar",
+			maxUploadSize: 102400000,
+			wantErr:       `unknown processing profile: "unknown"`,
+		},
+		{
+			name:          "Returns invalid_multipart_request if the location ID is invalid",
+			multipartBody: invalidLocationMultipartBody,
+			contentType:   "multipart/form-data; boundary=foobar",
+			maxUploadSize: 102400000,
+			wantErr:       "invalid: location_id",
+		},
 		{
 			name:          "Returns invalid_multipart_request if unable to identify format",
 			multipartBody: txtMultipartBody,
@@ -189,6 +280,86 @@ func TestUpload(t *testing.T) {
 			maxUploadSize: 102400000,
 			want:          &goaingest.UploadSipResult{UUID: uuid0.String()},
 		},
+		{
+			name: "Uploads a SIP with an expected checksum",
+			mock: func(ctx context.Context, psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().CreateSIP(
+					mockutil.Context(),
+					mockutil.Eq(&datatypes.SIP{
+						UUID:   uuid0,
+						Name:   "first.zip",
+						Status: enums.SIPStatusQueued,
+						ExpectedChecksum: &datatypes.Checksum{
+							Algorithm: datatypes.ChecksumAlgoSHA256,
+							Hash:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+						},
+					}),
+				).Return(nil)
+
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					temporalsdk_client.StartWorkflowOptions{
+						ID:                    fmt.Sprintf("processing-workflow-%s", uuid0.String()),
+						TaskQueue:             "test",
+						WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+					},
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID:   uuid0,
+						SIPName:   "first.zip",
+						Type:      enums.WorkflowTypeCreateAip,
+						Key:       key,
+						Extension: ".zip",
+						ExpectedChecksum: &datatypes.Checksum{
+							Algorithm: datatypes.ChecksumAlgoSHA256,
+							Hash:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+						},
+					},
+				).Return(nil, nil)
+			},
+			multipartBody: checksumMultipartBody,
+			contentType:   "multipart/form-data; boundary=foobar",
+			maxUploadSize: 102400000,
+			want:          &goaingest.UploadSipResult{UUID: uuid0.String()},
+		},
+		{
+			name: "Uploads a SIP with a processing profile",
+			mock: func(ctx context.Context, psvc *persistence_fake.MockService, tc *temporalsdk_mocks.Client) {
+				psvc.EXPECT().CreateSIP(
+					mockutil.Context(),
+					mockutil.Eq(&datatypes.SIP{
+						UUID:              uuid0,
+						Name:              "first.zip",
+						Status:            enums.SIPStatusQueued,
+						ProcessingProfile: "fast",
+					}),
+				).Return(nil)
+
+				tc.On(
+					"ExecuteWorkflow",
+					mock.AnythingOfType("*context.timerCtx"),
+					temporalsdk_client.StartWorkflowOptions{
+						ID:                    fmt.Sprintf("processing-workflow-%s", uuid0.String()),
+						TaskQueue:             "test",
+						WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+					},
+					ingest.ProcessingWorkflowName,
+					&ingest.ProcessingWorkflowRequest{
+						SIPUUID:           uuid0,
+						SIPName:           "first.zip",
+						Type:              enums.WorkflowTypeCreateAip,
+						Key:               key,
+						Extension:         ".zip",
+						ProcessingProfile: "fast",
+					},
+				).Return(nil, nil)
+			},
+			multipartBody: profileMultipartBody,
+			contentType:   "multipart/form-data; boundary=foobar",
+			maxUploadSize: 102400000,
+			want:          &goaingest.UploadSipResult{UUID: uuid0.String()},
+		},
 		{
 			name: "Uploads a SIP and creates a user",
 			claims: &auth.Claims{
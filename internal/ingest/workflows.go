@@ -63,9 +63,45 @@ type (
 		// Keys contains the keys of the SIP objects.
 		Keys []string
 
+		// SIPs contains the SIPs listed in the batch manifest, with their
+		// metadata, when the batch was submitted with a manifest. It takes
+		// precedence over Keys.
+		SIPs []*BatchSIP
+
+		// RetrySIPs contains all the SIPs of the batch when the batch is
+		// retried. The SIPs with an error or failed status are processed again
+		// from their failed SIP/PIP, Keys and SIPs are ignored.
+		RetrySIPs []datatypes.SIP
+
 		// RetentionPeriod is the duration for which SIPs should be retained after
 		// a successful ingest. If negative, SIPs will be retained indefinitely.
 		RetentionPeriod time.Duration
+
+		// ProcessingProfile is the name of the processing profile to use for
+		// the SIPs of the batch, if any.
+		ProcessingProfile string
+	}
+
+	// BatchSIP is a SIP of a batch, with the metadata provided for it in the
+	// batch manifest.
+	BatchSIP struct {
+		// Key is the key of the SIP object in the SIP source.
+		Key string
+
+		// Title is the name given to the SIP, if any. Defaults to the key.
+		Title string
+
+		// ProcessingProfile is the name of the processing profile to use for
+		// the SIP, if any. Defaults to the processing profile of the batch.
+		ProcessingProfile string
+
+		// LocationID is the identifier of the storage location where the AIP
+		// is stored, if any. Defaults to the default permanent location.
+		LocationID *uuid.UUID
+
+		// CustomMetadata contains the custom fields of the SIP, carried to the
+		// child workflows.
+		CustomMetadata childwf.CustomMetadata
 	}
 
 	ProcessingWorkflowRequest struct {
@@ -99,6 +135,11 @@ type (
 		// Key is the key of the blob.
 		Key string
 
+		// Retry indicates whether the SIP is retried from its failed copy in
+		// the internal bucket. Key is then the failed key, and the SIP is not
+		// downloaded from or deleted in its SIP source or watcher.
+		Retry bool
+
 		// IsDir indicates whether the blob is a directory (used by the filesystem watcher).
 		IsDir bool
 
@@ -108,6 +149,26 @@ type (
 
 		// BatchUUID is the UUID of the batch this SIP belongs to, if any.
 		BatchUUID uuid.UUID
+
+		// BatchIdentifier is the identifier of the batch this SIP belongs to,
+		// if any.
+		BatchIdentifier string
+
+		// ExpectedChecksum is the SIP checksum provided by the depositor, if
+		// any. The workflow fails if it doesn't match the calculated checksum.
+		ExpectedChecksum *datatypes.Checksum
+
+		// ProcessingProfile is the name of the processing profile to use for
+		// the SIP, if any.
+		ProcessingProfile string
+
+		// LocationID is the identifier of the storage location where the AIP
+		// is stored. If nil, the default permanent location is used.
+		LocationID *uuid.UUID
+
+		// CustomMetadata is opaque metadata provided with the SIP, e.g. in a
+		// batch manifest, carried to the child workflows.
+		CustomMetadata childwf.CustomMetadata
 	}
 
 	// ProcessingWorkflowResult is returned by the SIP processing workflow to
@@ -123,6 +184,21 @@ type (
 	}
 )
 
+// BatchSIPs returns the SIPs of the batch, either from the batch manifest or
+// from the batch keys.
+func (r *BatchWorkflowRequest) BatchSIPs() []*BatchSIP {
+	if len(r.SIPs) > 0 {
+		return r.SIPs
+	}
+
+	sips := make([]*BatchSIP, len(r.Keys))
+	for i, key := range r.Keys {
+		sips[i] = &BatchSIP{Key: key}
+	}
+
+	return sips
+}
+
 func InitBatchWorkflow(
 	ctx context.Context,
 	tc temporalsdk_client.Client,
@@ -151,7 +227,7 @@ func InitProcessingWorkflow(
 	defer cancel()
 
 	opts := temporalsdk_client.StartWorkflowOptions{
-		ID:                    fmt.Sprintf("%s-%s", ProcessingWorkflowName, req.SIPUUID.String()),
+		ID:                    ProcessingWorkflowID(req.SIPUUID),
 		TaskQueue:             taskQueue,
 		WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 	}
@@ -164,6 +240,10 @@ func BatchWorkflowID(batchID uuid.UUID) string {
 	return fmt.Sprintf("%s-%s", BatchWorkflowName, batchID)
 }
 
+func ProcessingWorkflowID(sipID uuid.UUID) string {
+	return fmt.Sprintf("%s-%s", ProcessingWorkflowName, sipID)
+}
+
 func childWorkflowUserFromClaims(claims *auth.Claims) *childwf.User {
 	if claims == nil || claims.Email == "" {
 		return nil
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/apitoken"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/user"
+)
+
+// CreateAPIToken creates and persists a new API token, finding or creating its
+// owner by OIDC issuer and subject.
+func (c *client) CreateAPIToken(ctx context.Context, t *datatypes.APIToken) error {
+	// Validate required fields.
+	if t.UUID == uuid.Nil {
+		return newRequiredFieldError("UUID")
+	}
+	if t.Name == "" {
+		return newRequiredFieldError("Name")
+	}
+	if t.TokenHash == "" {
+		return newRequiredFieldError("TokenHash")
+	}
+	if t.Attributes == nil {
+		return newRequiredFieldError("Attributes")
+	}
+	if t.ExpiresAt.IsZero() {
+		return newRequiredFieldError("ExpiresAt")
+	}
+	if t.Owner == nil {
+		return newRequiredFieldError("Owner")
+	}
+
+	tx, err := c.ent.BeginTx(ctx, nil)
+	if err != nil {
+		return newDBErrorWithDetails(err, "create API token")
+	}
+
+	ownerID, err := findOrCreateOIDCUser(ctx, tx.Client(), t.Owner)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	q := tx.APIToken.Create().
+		SetUUID(t.UUID).
+		SetName(t.Name).
+		SetTokenHash(t.TokenHash).
+		SetAttributes(t.Attributes).
+		SetExpiresAt(t.ExpiresAt).
+		SetOwnerID(ownerID)
+
+	// Add optional fields.
+	if !t.CreatedAt.IsZero() {
+		q.SetCreatedAt(t.CreatedAt)
+	}
+
+	// Save the API token.
+	dbt, err := q.Save(ctx)
+	if err != nil {
+		return rollback(tx, newDBErrorWithDetails(err, "create API token"))
+	}
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, newDBError(err))
+	}
+
+	// Update the API token with DB data, to get generated values (e.g. ID).
+	// Manually set the owner because the dbt result doesn't include the edge.
+	owner := t.Owner
+	*t = *convertAPIToken(dbt)
+	t.Owner = owner
+
+	return nil
+}
+
+// ReadAPIToken retrieves an API token and its owner by UUID.
+func (c *client) ReadAPIToken(ctx context.Context, id uuid.UUID) (*datatypes.APIToken, error) {
+	dbt, err := c.ent.APIToken.Query().
+		Where(apitoken.UUID(id)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, newDBError(err)
+	}
+
+	return convertAPIToken(dbt), nil
+}
+
+// ReadAPITokenByHash retrieves an API token and its owner by the hash of the
+// token secret.
+func (c *client) ReadAPITokenByHash(ctx context.Context, hash string) (*datatypes.APIToken, error) {
+	// Validate required fields.
+	if hash == "" {
+		return nil, newRequiredFieldError("hash")
+	}
+
+	dbt, err := c.ent.APIToken.Query().
+		Where(apitoken.TokenHash(hash)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, newDBError(err)
+	}
+
+	return convertAPIToken(dbt), nil
+}
+
+// ListAPITokens retrieves the API tokens of the user identified by ownerID,
+// sorted by creation.
+func (c *client) ListAPITokens(ctx context.Context, ownerID uuid.UUID) ([]*datatypes.APIToken, error) {
+	r, err := c.ent.APIToken.Query().
+		Where(apitoken.HasOwnerWith(user.UUID(ownerID))).
+		WithOwner().
+		Order(db.Asc(apitoken.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, newDBError(err)
+	}
+
+	tokens := make([]*datatypes.APIToken, len(r))
+	for i, dbt := range r {
+		tokens[i] = convertAPIToken(dbt)
+	}
+
+	return tokens, nil
+}
+
+// UpdateAPIToken updates the persisted API token identified by id using the
+// updater function. Only the name, last used and revoked datetimes can be
+// updated.
+func (c *client) UpdateAPIToken(
+	ctx context.Context,
+	id uuid.UUID,
+	updater persistence.APITokenUpdater,
+) (*datatypes.APIToken, error) {
+	tx, err := c.ent.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, newDBError(err)
+	}
+
+	// Get the current API token data from the database.
+	dbt, err := tx.APIToken.Query().
+		Where(apitoken.UUID(id)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, newDBError(err))
+	}
+
+	// Keep database ID and owner in case they are changed by the updater.
+	dbID := dbt.ID
+	owner := dbt.Edges.Owner
+
+	// Get an updated datatypes.APIToken from the updater function.
+	up, err := updater(convertAPIToken(dbt))
+	if err != nil {
+		return nil, rollback(tx, newUpdaterError(err))
+	}
+
+	q := tx.APIToken.UpdateOneID(dbID).SetName(up.Name)
+
+	// Set optional column values.
+	if !up.LastUsedAt.IsZero() {
+		q.SetLastUsedAt(up.LastUsedAt)
+	}
+	if !up.RevokedAt.IsZero() {
+		q.SetRevokedAt(up.RevokedAt)
+	}
+
+	// Save changes.
+	dbt, err = q.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, newDBError(err))
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, rollback(tx, newDBError(err))
+	}
+
+	dbt.Edges.Owner = owner
+
+	return convertAPIToken(dbt), nil
+}
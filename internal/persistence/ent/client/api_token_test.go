@@ -0,0 +1,117 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/persistence"
+)
+
+func TestAPIToken(t *testing.T) {
+	t.Parallel()
+
+	tokenUUID := uuid.New()
+	ownerUUID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	usedAt := time.Now().Truncate(time.Second)
+
+	newToken := func(id uuid.UUID, hash string, attrs []string) *datatypes.APIToken {
+		return &datatypes.APIToken{
+			UUID:       id,
+			Name:       "Scripted ingest",
+			TokenHash:  hash,
+			Attributes: attrs,
+			ExpiresAt:  expiresAt,
+			Owner: &datatypes.User{
+				UUID:    ownerUUID,
+				Email:   "nobody@example.com",
+				Name:    "Test User",
+				OIDCIss: "https://example.com/oidc",
+				OIDCSub: "1234567890",
+			},
+		}
+	}
+
+	t.Run("Creates, reads, lists and updates API tokens", func(t *testing.T) {
+		t.Parallel()
+
+		_, svc := setUpClient(t, logr.Discard())
+		ctx := t.Context()
+
+		token := newToken(tokenUUID, "hash-1", []string{"ingest:sips:create"})
+		err := svc.CreateAPIToken(ctx, token)
+		assert.NilError(t, err)
+		assert.Assert(t, token.ID > 0)
+		assert.Assert(t, !token.CreatedAt.IsZero())
+
+		// A second token with empty attributes for the same owner.
+		err = svc.CreateAPIToken(ctx, newToken(uuid.New(), "hash-2", []string{}))
+		assert.NilError(t, err)
+
+		got, err := svc.ReadAPITokenByHash(ctx, "hash-1")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, token, cmpopts.EquateApproxTime(time.Second))
+
+		tokens, err := svc.ListAPITokens(ctx, ownerUUID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(tokens), 2)
+		assert.Equal(t, tokens[0].UUID, tokenUUID)
+		assert.Equal(t, len(tokens[1].Attributes), 0)
+
+		got, err = svc.UpdateAPIToken(ctx, tokenUUID, func(t *datatypes.APIToken) (*datatypes.APIToken, error) {
+			t.LastUsedAt = usedAt
+			t.RevokedAt = usedAt
+			return t, nil
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, got.LastUsedAt.Equal(usedAt))
+		assert.Assert(t, got.RevokedAt.Equal(usedAt))
+		assert.Equal(t, got.Owner.UUID, ownerUUID)
+
+		got, err = svc.ReadAPIToken(ctx, tokenUUID)
+		assert.NilError(t, err)
+		assert.Assert(t, got.RevokedAt.Equal(usedAt))
+	})
+
+	t.Run("Errors when a required field is missing", func(t *testing.T) {
+		t.Parallel()
+
+		_, svc := setUpClient(t, logr.Discard())
+
+		token := newToken(tokenUUID, "", []string{})
+		err := svc.CreateAPIToken(t.Context(), token)
+		assert.Error(t, err, "invalid data error: field \"TokenHash\" is required")
+
+		token = newToken(tokenUUID, "hash-1", nil)
+		err = svc.CreateAPIToken(t.Context(), token)
+		assert.Error(t, err, "invalid data error: field \"Attributes\" is required")
+
+		token = newToken(tokenUUID, "hash-1", []string{})
+		token.ExpiresAt = time.Time{}
+		err = svc.CreateAPIToken(t.Context(), token)
+		assert.Error(t, err, "invalid data error: field \"ExpiresAt\" is required")
+	})
+
+	t.Run("Errors when the API token is not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, svc := setUpClient(t, logr.Discard())
+
+		_, err := svc.ReadAPITokenByHash(t.Context(), "unknown")
+		assert.Assert(t, errors.Is(err, persistence.ErrNotFound))
+
+		_, err = svc.UpdateAPIToken(
+			t.Context(),
+			tokenUUID,
+			func(t *datatypes.APIToken) (*datatypes.APIToken, error) { return t, nil },
+		)
+		assert.Assert(t, errors.Is(err, persistence.ErrNotFound))
+	})
+}
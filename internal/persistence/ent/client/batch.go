@@ -52,6 +52,9 @@ func (c *client) CreateBatch(ctx context.Context, b *datatypes.Batch) error {
 	if !b.CompletedAt.IsZero() {
 		q.SetCompletedAt(b.CompletedAt)
 	}
+	if b.SIPSourceID.Valid {
+		q.SetSipSourceID(b.SIPSourceID.UUID)
+	}
 
 	// If Uploader is set, find or create the user and link it to the Batch.
 	if b.Uploader != nil {
@@ -195,6 +198,12 @@ func (c *client) ListBatches(ctx context.Context, f *persistence.BatchFilter) (
 	if f.UploaderID != nil {
 		q.Where(batch.HasUploaderWith(user.UUID(*f.UploaderID)))
 	}
+	if f.Scope != nil {
+		q.Where(batch.Or(
+			batch.UUIDIn(f.Scope.BatchIDs...),
+			batch.SipSourceIDIn(f.Scope.SIPSourceIDs...),
+		))
+	}
 
 	page, whole := filterBatches(q, f)
 
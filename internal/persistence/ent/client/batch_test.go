@@ -509,6 +509,7 @@ func TestListBatches(t *testing.T) {
 	batchUUID3 := uuid.New()
 	uploaderID := uuid.New()
 	uploaderID2 := uuid.New()
+	sourceID := uuid.New()
 	started := time.Date(2024, 9, 25, 9, 31, 11, 0, time.UTC)
 	started2 := time.Date(2024, 9, 25, 10, 3, 42, 0, time.UTC)
 	completed := started.Add(time.Second)
@@ -960,6 +961,71 @@ func TestListBatches(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Returns Batches filtered by resource scope",
+			data: []*datatypes.Batch{
+				{
+					UUID:        batchUUID,
+					Identifier:  "Test Batch 1",
+					Status:      enums.BatchStatusIngested,
+					SIPSCount:   5,
+					StartedAt:   started,
+					CompletedAt: completed,
+				},
+				{
+					UUID:        batchUUID2,
+					Identifier:  "Test Batch 2",
+					Status:      enums.BatchStatusProcessing,
+					SIPSCount:   5,
+					StartedAt:   started2,
+					CompletedAt: completed2,
+					SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+				},
+				{
+					UUID:        batchUUID3,
+					Identifier:  "Test Batch 3",
+					Status:      enums.BatchStatusCanceled,
+					SIPSCount:   5,
+					StartedAt:   started2,
+					CompletedAt: completed2,
+				},
+			},
+			batchFilter: &persistence.BatchFilter{
+				Scope: &persistence.ResourceScope{
+					BatchIDs:     []uuid.UUID{batchUUID},
+					SIPSourceIDs: []uuid.UUID{sourceID},
+				},
+			},
+			want: results{
+				data: []*datatypes.Batch{
+					{
+						ID:          1,
+						UUID:        batchUUID,
+						Identifier:  "Test Batch 1",
+						Status:      enums.BatchStatusIngested,
+						SIPSCount:   5,
+						CreatedAt:   time.Now(),
+						StartedAt:   started,
+						CompletedAt: completed,
+					},
+					{
+						ID:          2,
+						UUID:        batchUUID2,
+						Identifier:  "Test Batch 2",
+						Status:      enums.BatchStatusProcessing,
+						SIPSCount:   5,
+						CreatedAt:   time.Now(),
+						StartedAt:   started2,
+						CompletedAt: completed2,
+						SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+					},
+				},
+				page: &persistence.Page{
+					Limit: entfilter.DefaultPageSize,
+					Total: 2,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -980,6 +1046,9 @@ func TestListBatches(t *testing.T) {
 						SetStartedAt(batch.StartedAt).
 						SetCompletedAt(batch.CompletedAt)
 
+					if batch.SIPSourceID.Valid {
+						q.SetSipSourceID(batch.SIPSourceID.UUID)
+					}
 					if batch.Uploader != nil {
 						user, err := entc.User.Create().
 							SetUUID(batch.Uploader.UUID).
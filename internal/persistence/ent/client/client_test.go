@@ -5,11 +5,15 @@ import (
 	"testing"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"gotest.tools/v3/assert"
 
+	endurodb "github.com/artefactual-sdps/enduro/internal/db"
+	"github.com/artefactual-sdps/enduro/internal/db/dbtest"
 	"github.com/artefactual-sdps/enduro/internal/enums"
 	"github.com/artefactual-sdps/enduro/internal/persistence"
 	entclient "github.com/artefactual-sdps/enduro/internal/persistence/ent/client"
@@ -27,15 +31,25 @@ func setUpClient(t *testing.T, logger logr.Logger) (*db.Client, persistence.Serv
 	return setUpClientWithOpts(t, logger)
 }
 
+// setUpClientWithOpts returns a client of an in-memory SQLite database, or of
+// a PostgreSQL schema created by the Enduro migrations when the
+// dbtest.PostgresDSNEnv environment variable is set.
 func setUpClientWithOpts(
 	t *testing.T,
 	logger logr.Logger,
-	opts ...enttest.Option,
+	opts ...db.Option,
 ) (*db.Client, persistence.Service) {
 	t.Helper()
 
-	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
-	entc := enttest.Open(t, "sqlite3", dsn, opts...)
+	var entc *db.Client
+	if sqlDB, ok := dbtest.Postgres(t); ok {
+		err := endurodb.MigrateEnduroDatabase(logr.Discard(), "postgres", sqlDB)
+		assert.NilError(t, err)
+		entc = db.NewClient(append(opts, db.Driver(entsql.OpenDB(dialect.Postgres, sqlDB)))...)
+	} else {
+		dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+		entc = enttest.Open(t, "sqlite3", dsn, enttest.WithOptions(opts...))
+	}
 	t.Cleanup(func() { entc.Close() })
 
 	c := entclient.New(logger, entc)
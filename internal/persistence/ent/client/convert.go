@@ -27,12 +27,26 @@ func convertSIP(sip *db.SIP) *datatypes.SIP {
 		FileCount:         sip.FileCount,
 		ChecksumAlgorithm: sip.ChecksumAlgorithm,
 		ChecksumHash:      sip.ChecksumHash,
+		ProcessingProfile: sip.ProcessingProfile,
+		WatcherName:       sip.WatcherName,
+		CustomMetadata:    sip.CustomMetadata,
 	}
 
 	// Convert optional fields.
 	if sip.AipID != uuid.Nil {
 		s.AIPID = uuid.NullUUID{UUID: sip.AipID, Valid: true}
 	}
+	if sip.SipSourceID != uuid.Nil {
+		s.SIPSourceID = uuid.NullUUID{UUID: sip.SipSourceID, Valid: true}
+	}
+	if sip.LocationID != uuid.Nil {
+		s.LocationID = uuid.NullUUID{UUID: sip.LocationID, Valid: true}
+	}
+	if sip.ExpectedChecksum != "" {
+		if c, err := datatypes.ParseChecksum(sip.ExpectedChecksum, datatypes.ChecksumAlgoSHA256); err == nil {
+			s.ExpectedChecksum = &c
+		}
+	}
 	if sip.Edges.Uploader != nil {
 		s.Uploader = convertUser(sip.Edges.Uploader)
 	}
@@ -81,6 +95,27 @@ func convertUser(dbu *db.User) *datatypes.User {
 	}
 }
 
+// convertAPIToken converts an entgo `db.APIToken` representation to a
+// `datatypes.APIToken` representation.
+func convertAPIToken(t *db.APIToken) *datatypes.APIToken {
+	r := datatypes.APIToken{
+		ID:         t.ID,
+		UUID:       t.UUID,
+		Name:       t.Name,
+		TokenHash:  t.TokenHash,
+		Attributes: t.Attributes,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  normalizeTime(t.ExpiresAt),
+		LastUsedAt: normalizeTime(t.LastUsedAt),
+		RevokedAt:  normalizeTime(t.RevokedAt),
+	}
+	if t.Edges.Owner != nil {
+		r.Owner = convertUser(t.Edges.Owner)
+	}
+
+	return &r
+}
+
 // convertBatch converts an entgo `db.Batch` representation to a
 // `datatypes.Batch` representation.
 func convertBatch(batch *db.Batch) *datatypes.Batch {
@@ -97,6 +132,9 @@ func convertBatch(batch *db.Batch) *datatypes.Batch {
 	}
 
 	// Convert optional fields.
+	if batch.SipSourceID != uuid.Nil {
+		b.SIPSourceID = uuid.NullUUID{UUID: batch.SipSourceID, Valid: true}
+	}
 	if batch.Edges.Uploader != nil {
 		b.Uploader = convertUser(batch.Edges.Uploader)
 	}
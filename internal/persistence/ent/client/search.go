@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/predicate"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/searchdocument"
+	"github.com/artefactual-sdps/enduro/internal/search"
+)
+
+// IndexSearchDocument adds doc to the search index, replacing any document
+// with the same UUID.
+func (c *client) IndexSearchDocument(ctx context.Context, doc *search.Document) error {
+	if doc.UUID == uuid.Nil {
+		return newRequiredFieldError("UUID")
+	}
+
+	err := c.ent.SearchDocument.Create().
+		SetUUID(doc.UUID).
+		SetName(doc.Name).
+		SetContent(doc.Content()).
+		SetFields(doc.Fields).
+		OnConflictColumns(searchdocument.FieldUUID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return newDBErrorWithDetails(err, "index search document")
+	}
+
+	return nil
+}
+
+// DeleteSearchDocument removes the document identified by id from the search
+// index. It doesn't return an error if the document doesn't exist.
+func (c *client) DeleteSearchDocument(ctx context.Context, id uuid.UUID) error {
+	_, err := c.ent.SearchDocument.Delete().Where(searchdocument.UUID(id)).Exec(ctx)
+	if err != nil {
+		return newDBErrorWithDetails(err, "delete search document")
+	}
+
+	return nil
+}
+
+// SearchDocuments returns the page of search documents matching q, and the
+// total number of matching documents.
+func (c *client) SearchDocuments(ctx context.Context, q *search.Query) ([]*search.Result, int, error) {
+	if q == nil || len(q.Terms()) == 0 {
+		return []*search.Result{}, 0, nil
+	}
+
+	whole := c.ent.SearchDocument.Query().Where(predicate.SearchDocument(search.SQLPredicate(q)))
+	total, err := whole.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, newDBErrorWithDetails(err, "search documents")
+	}
+
+	r, err := whole.
+		Order(searchdocument.OrderOption(search.SQLOrder(q))).
+		Limit(q.Limit).
+		Offset(q.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, newDBErrorWithDetails(err, "search documents")
+	}
+
+	res := make([]*search.Result, len(r))
+	for i, doc := range r {
+		res[i] = &search.Result{UUID: doc.UUID, Name: doc.Name, Fields: doc.Fields}
+	}
+
+	return res, total, nil
+}
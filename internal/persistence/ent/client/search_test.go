@@ -0,0 +1,159 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/search"
+)
+
+func TestIndexSearchDocument(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Replaces a document with the same UUID", func(t *testing.T) {
+		t.Parallel()
+
+		_, svc := setUpClient(t, logr.Discard())
+		ctx := t.Context()
+
+		err := svc.IndexSearchDocument(ctx, &search.Document{UUID: sipUUID, Name: "Test SIP"})
+		assert.NilError(t, err)
+
+		err = svc.IndexSearchDocument(ctx, &search.Document{
+			UUID:   sipUUID,
+			Name:   "Test SIP",
+			Fields: map[string]string{"batch": "Annual reports"},
+		})
+		assert.NilError(t, err)
+
+		res, total, err := svc.SearchDocuments(ctx, search.NewQuery("annual", 0, 0))
+		assert.NilError(t, err)
+		assert.Equal(t, total, 1)
+		assert.DeepEqual(t, res, []*search.Result{
+			{
+				UUID:   sipUUID,
+				Name:   "Test SIP",
+				Fields: map[string]string{"batch": "Annual reports"},
+			},
+		})
+	})
+
+	t.Run("Errors when UUID is missing", func(t *testing.T) {
+		t.Parallel()
+
+		_, svc := setUpClient(t, logr.Discard())
+
+		err := svc.IndexSearchDocument(t.Context(), &search.Document{Name: "Test SIP"})
+		assert.Error(t, err, "invalid data error: field \"UUID\" is required")
+	})
+}
+
+func TestDeleteSearchDocument(t *testing.T) {
+	t.Parallel()
+
+	_, svc := setUpClient(t, logr.Discard())
+	ctx := t.Context()
+
+	err := svc.IndexSearchDocument(ctx, &search.Document{UUID: sipUUID, Name: "Test SIP"})
+	assert.NilError(t, err)
+
+	err = svc.DeleteSearchDocument(ctx, sipUUID)
+	assert.NilError(t, err)
+
+	_, total, err := svc.SearchDocuments(ctx, search.NewQuery("test", 0, 0))
+	assert.NilError(t, err)
+	assert.Equal(t, total, 0)
+
+	// Deleting a missing document doesn't fail.
+	err = svc.DeleteSearchDocument(ctx, sipUUID)
+	assert.NilError(t, err)
+}
+
+func TestSearchDocuments(t *testing.T) {
+	t.Parallel()
+
+	docs := []*search.Document{
+		{
+			UUID:   uuid.MustParse("6b1c1f5a-9a5e-4b8e-8e0a-0f3b0c3f5f01"),
+			Name:   "annual-report-2023.zip",
+			Fields: map[string]string{"batch": "Reports", "title": "Annual report"},
+		},
+		{
+			UUID:   uuid.MustParse("6b1c1f5a-9a5e-4b8e-8e0a-0f3b0c3f5f02"),
+			Name:   "minutes.zip",
+			Fields: map[string]string{"batch": "Reports", "title": "Board minutes"},
+		},
+		{
+			UUID: uuid.MustParse("6b1c1f5a-9a5e-4b8e-8e0a-0f3b0c3f5f03"),
+			Name: "photos.zip",
+		},
+	}
+
+	type test struct {
+		name      string
+		query     *search.Query
+		wantNames []string
+		wantTotal int
+	}
+	for _, tt := range []test{
+		{
+			name:      "Matches names",
+			query:     search.NewQuery("photos", 0, 0),
+			wantNames: []string{"photos.zip"},
+			wantTotal: 1,
+		},
+		{
+			name:      "Matches fields ignoring case",
+			query:     search.NewQuery("REPORTS", 0, 0),
+			wantNames: []string{"annual-report-2023.zip", "minutes.zip"},
+			wantTotal: 2,
+		},
+		{
+			name:      "Requires all the terms",
+			query:     search.NewQuery("reports board", 0, 0),
+			wantNames: []string{"minutes.zip"},
+			wantTotal: 1,
+		},
+		{
+			name:      "Matches the UUID",
+			query:     search.NewQuery("6b1c1f5a-9a5e-4b8e-8e0a-0f3b0c3f5f03", 0, 0),
+			wantNames: []string{"photos.zip"},
+			wantTotal: 1,
+		},
+		{
+			name:      "Returns a page of results",
+			query:     search.NewQuery("reports", 1, 1),
+			wantNames: []string{"minutes.zip"},
+			wantTotal: 2,
+		},
+		{
+			name:      "Returns no results without terms",
+			query:     search.NewQuery(" ", 0, 0),
+			wantNames: []string{},
+			wantTotal: 0,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, svc := setUpClient(t, logr.Discard())
+			ctx := t.Context()
+			for _, doc := range docs {
+				assert.NilError(t, svc.IndexSearchDocument(ctx, doc))
+			}
+
+			res, total, err := svc.SearchDocuments(ctx, tt.query)
+			assert.NilError(t, err)
+			assert.Equal(t, total, tt.wantTotal)
+
+			names := make([]string, len(res))
+			for i, r := range res {
+				names[i] = r.Name
+			}
+			assert.DeepEqual(t, names, tt.wantNames)
+		})
+	}
+}
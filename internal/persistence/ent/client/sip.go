@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
@@ -52,6 +54,18 @@ func (c *client) CreateSIP(ctx context.Context, s *datatypes.SIP) error {
 	if !s.CompletedAt.IsZero() {
 		q.SetCompletedAt(s.CompletedAt)
 	}
+	if s.SIPSourceID.Valid {
+		q.SetSipSourceID(s.SIPSourceID.UUID)
+	}
+	if s.LocationID.Valid {
+		q.SetLocationID(s.LocationID.UUID)
+	}
+	if s.ExpectedChecksum != nil {
+		q.SetExpectedChecksum(s.ExpectedChecksum.String())
+	}
+	if s.WatcherName != "" {
+		q.SetWatcherName(s.WatcherName)
+	}
 	if s.FileCount > 0 {
 		q.SetFileCount(s.FileCount)
 	}
@@ -61,6 +75,12 @@ func (c *client) CreateSIP(ctx context.Context, s *datatypes.SIP) error {
 	if s.ChecksumHash != "" {
 		q.SetChecksumHash(s.ChecksumHash)
 	}
+	if s.ProcessingProfile != "" {
+		q.SetProcessingProfile(s.ProcessingProfile)
+	}
+	if len(s.CustomMetadata) > 0 {
+		q.SetCustomMetadata(s.CustomMetadata)
+	}
 
 	// If Uploader is set, find or create the user and link it to the SIP.
 	if s.Uploader != nil {
@@ -180,6 +200,9 @@ func (c *client) UpdateSIP(
 	if up.ChecksumHash != "" {
 		q.SetChecksumHash(up.ChecksumHash)
 	}
+	if len(up.CustomMetadata) > 0 {
+		q.SetCustomMetadata(up.CustomMetadata)
+	}
 
 	// Save changes.
 	dbs, err = q.Save(ctx)
@@ -242,6 +265,17 @@ func (c *client) ListSIPs(ctx context.Context, f *persistence.SIPFilter) (
 	if f.BatchID != nil {
 		q.Where(sip.HasBatchWith(batch.UUID(*f.BatchID)))
 	}
+	if f.MetadataKey != nil {
+		q.Where(func(s *sql.Selector) {
+			s.Where(sqljson.HasKey(s.C(sip.FieldCustomMetadata), sqljson.Path(*f.MetadataKey)))
+		})
+	}
+	if f.Scope != nil {
+		q.Where(sip.Or(
+			sip.HasBatchWith(batch.UUIDIn(f.Scope.BatchIDs...)),
+			sip.SipSourceIDIn(f.Scope.SIPSourceIDs...),
+		))
+	}
 
 	page, whole := filterSIPs(q, f)
 
@@ -1,6 +1,7 @@
 package client_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -48,6 +49,12 @@ func TestCreateSIP(t *testing.T) {
 				FileCount:         8,
 				ChecksumAlgorithm: "SHA-256",
 				ChecksumHash:      "73475cb40a568e8da8a045ced110137e159f890ac4da883b6b17dc651b3a8049",
+				ProcessingProfile: "email",
+				ExpectedChecksum: &datatypes.Checksum{
+					Algorithm: datatypes.ChecksumAlgoSHA256,
+					Hash:      "73475cb40a568e8da8a045ced110137e159f890ac4da883b6b17dc651b3a8049",
+				},
+				WatcherName: "watcher",
 			},
 			want: &datatypes.SIP{
 				ID:                1,
@@ -61,6 +68,12 @@ func TestCreateSIP(t *testing.T) {
 				FileCount:         8,
 				ChecksumAlgorithm: "SHA-256",
 				ChecksumHash:      "73475cb40a568e8da8a045ced110137e159f890ac4da883b6b17dc651b3a8049",
+				ProcessingProfile: "email",
+				ExpectedChecksum: &datatypes.Checksum{
+					Algorithm: datatypes.ChecksumAlgoSHA256,
+					Hash:      "73475cb40a568e8da8a045ced110137e159f890ac4da883b6b17dc651b3a8049",
+				},
+				WatcherName: "watcher",
 			},
 		},
 		{
@@ -618,6 +631,7 @@ func TestListSIPs(t *testing.T) {
 	uploaderID2 := uuid.New()
 	batchID := uuid.New()
 	batchID2 := uuid.New()
+	sourceID := uuid.New()
 
 	started := time.Date(2024, 9, 25, 9, 31, 11, 0, time.UTC)
 	started2 := time.Date(2024, 9, 25, 10, 3, 42, 0, time.UTC)
@@ -1269,6 +1283,114 @@ func TestListSIPs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Returns SIPs filtered by resource scope",
+			data: []*datatypes.SIP{
+				{
+					UUID:        sipUUID,
+					Name:        "Test SIP 1",
+					AIPID:       aipID,
+					Status:      enums.SIPStatusIngested,
+					StartedAt:   started,
+					CompletedAt: completed,
+					Batch: &datatypes.Batch{
+						UUID:       batchID,
+						Identifier: "Test Batch 1",
+						Status:     enums.BatchStatusIngested,
+						SIPSCount:  5,
+						CreatedAt:  time.Now(),
+					},
+					ChecksumAlgorithm: checksumAlgo,
+					ChecksumHash:      checksum,
+				},
+				{
+					UUID:              sipUUID2,
+					Name:              "Test SIP 2",
+					AIPID:             aipID2,
+					Status:            enums.SIPStatusProcessing,
+					StartedAt:         started2,
+					CompletedAt:       completed2,
+					SIPSourceID:       uuid.NullUUID{UUID: sourceID, Valid: true},
+					ChecksumAlgorithm: checksumAlgo,
+					ChecksumHash:      checksum2,
+				},
+				{
+					UUID:        sipUUID3,
+					Name:        "Test SIP 3",
+					Status:      enums.SIPStatusError,
+					StartedAt:   started2,
+					CompletedAt: completed2,
+				},
+			},
+			filter: &persistence.SIPFilter{
+				Scope: &persistence.ResourceScope{
+					BatchIDs:     []uuid.UUID{batchID},
+					SIPSourceIDs: []uuid.UUID{sourceID},
+				},
+			},
+			want: results{
+				data: []*datatypes.SIP{
+					{
+						ID:          1,
+						UUID:        sipUUID,
+						Name:        "Test SIP 1",
+						AIPID:       aipID,
+						Status:      enums.SIPStatusIngested,
+						CreatedAt:   time.Now(),
+						StartedAt:   started,
+						CompletedAt: completed,
+						Batch: &datatypes.Batch{
+							ID:         1,
+							UUID:       batchID,
+							Identifier: "Test Batch 1",
+							Status:     enums.BatchStatusIngested,
+							SIPSCount:  5,
+							CreatedAt:  time.Now(),
+						},
+						ChecksumAlgorithm: checksumAlgo,
+						ChecksumHash:      checksum,
+					},
+					{
+						ID:                2,
+						UUID:              sipUUID2,
+						Name:              "Test SIP 2",
+						AIPID:             aipID2,
+						Status:            enums.SIPStatusProcessing,
+						CreatedAt:         time.Now(),
+						StartedAt:         started2,
+						CompletedAt:       completed2,
+						SIPSourceID:       uuid.NullUUID{UUID: sourceID, Valid: true},
+						ChecksumAlgorithm: checksumAlgo,
+						ChecksumHash:      checksum2,
+					},
+				},
+				page: &persistence.Page{
+					Limit: entfilter.DefaultPageSize,
+					Total: 2,
+				},
+			},
+		},
+		{
+			name: "Returns no SIPs with an empty resource scope",
+			data: []*datatypes.SIP{
+				{
+					UUID:        sipUUID,
+					Name:        "Test SIP 1",
+					Status:      enums.SIPStatusIngested,
+					StartedAt:   started,
+					CompletedAt: completed,
+				},
+			},
+			filter: &persistence.SIPFilter{
+				Scope: &persistence.ResourceScope{},
+			},
+			want: results{
+				data: []*datatypes.SIP{},
+				page: &persistence.Page{
+					Limit: entfilter.DefaultPageSize,
+				},
+			},
+		},
 		{
 			name: "Returns SIPs filtered by checksum",
 			data: []*datatypes.SIP{
@@ -1340,6 +1462,57 @@ func TestListSIPs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Returns SIPs filtered by metadata key",
+			data: []*datatypes.SIP{
+				{
+					UUID:      sipUUID,
+					Name:      "Test SIP 1",
+					Status:    enums.SIPStatusIngested,
+					StartedAt: started,
+					CustomMetadata: map[string]json.RawMessage{
+						"external_id": json.RawMessage(`"12345"`),
+					},
+				},
+				{
+					UUID:      sipUUID2,
+					Name:      "Test SIP 2",
+					Status:    enums.SIPStatusIngested,
+					StartedAt: started2,
+					CustomMetadata: map[string]json.RawMessage{
+						"department": json.RawMessage(`"archives"`),
+					},
+				},
+				{
+					UUID:      sipUUID3,
+					Name:      "Test SIP 3",
+					Status:    enums.SIPStatusIngested,
+					StartedAt: started2,
+				},
+			},
+			filter: &persistence.SIPFilter{
+				MetadataKey: new("external_id"),
+			},
+			want: results{
+				data: []*datatypes.SIP{
+					{
+						ID:        1,
+						UUID:      sipUUID,
+						Name:      "Test SIP 1",
+						Status:    enums.SIPStatusIngested,
+						CreatedAt: time.Now(),
+						StartedAt: started,
+						CustomMetadata: map[string]json.RawMessage{
+							"external_id": json.RawMessage(`"12345"`),
+						},
+					},
+				},
+				page: &persistence.Page{
+					Limit: entfilter.DefaultPageSize,
+					Total: 1,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1371,6 +1544,12 @@ func TestListSIPs(t *testing.T) {
 					if sip.FailedKey != "" {
 						q.SetFailedKey(sip.FailedKey)
 					}
+					if len(sip.CustomMetadata) > 0 {
+						q.SetCustomMetadata(sip.CustomMetadata)
+					}
+					if sip.SIPSourceID.Valid {
+						q.SetSipSourceID(sip.SIPSourceID.UUID)
+					}
 					if sip.Uploader != nil {
 						user, err := entc.User.Create().
 							SetUUID(sip.Uploader.UUID).
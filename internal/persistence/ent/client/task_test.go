@@ -15,7 +15,6 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/enums"
 	"github.com/artefactual-sdps/enduro/internal/persistence"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db"
-	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/enttest"
 )
 
 func addDBFixtures(t *testing.T, entc *db.Client) {
@@ -235,18 +234,16 @@ func TestCreateTasksUsesBatching(t *testing.T) {
 	entc, svc := setUpClientWithOpts(
 		t,
 		logr.Discard(),
-		enttest.WithOptions(
-			db.Debug(),
-			db.Log(func(args ...any) {
-				q := strings.ToUpper(fmt.Sprint(args...))
-				if strings.Contains(q, "BEGIN") {
-					begins++
-				}
-				if strings.Contains(q, "INSERT") {
-					inserts++
-				}
-			}),
-		),
+		db.Debug(),
+		db.Log(func(args ...any) {
+			q := strings.ToUpper(fmt.Sprint(args...))
+			if strings.Contains(q, "BEGIN") {
+				begins++
+			}
+			if strings.Contains(q, "INSERT") {
+				inserts++
+			}
+		}),
 	)
 
 	ctx := t.Context()
@@ -36,6 +36,8 @@ type Batch struct {
 	CompletedAt time.Time `json:"completed_at,omitempty"`
 	// UploaderID holds the value of the "uploader_id" field.
 	UploaderID int `json:"uploader_id,omitempty"`
+	// SipSourceID holds the value of the "sip_source_id" field.
+	SipSourceID uuid.UUID `json:"sip_source_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BatchQuery when eager-loading is set.
 	Edges        BatchEdges `json:"edges"`
@@ -84,7 +86,7 @@ func (*Batch) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case batch.FieldCreatedAt, batch.FieldStartedAt, batch.FieldCompletedAt:
 			values[i] = new(sql.NullTime)
-		case batch.FieldUUID:
+		case batch.FieldUUID, batch.FieldSipSourceID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -155,6 +157,12 @@ func (_m *Batch) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UploaderID = int(value.Int64)
 			}
+		case batch.FieldSipSourceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field sip_source_id", values[i])
+			} else if value != nil {
+				_m.SipSourceID = *value
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -224,6 +232,9 @@ func (_m *Batch) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("uploader_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UploaderID))
+	builder.WriteString(", ")
+	builder.WriteString("sip_source_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SipSourceID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,8 @@ const (
 	FieldCompletedAt = "completed_at"
 	// FieldUploaderID holds the string denoting the uploader_id field in the database.
 	FieldUploaderID = "uploader_id"
+	// FieldSipSourceID holds the string denoting the sip_source_id field in the database.
+	FieldSipSourceID = "sip_source_id"
 	// EdgeSips holds the string denoting the sips edge name in mutations.
 	EdgeSips = "sips"
 	// EdgeUploader holds the string denoting the uploader edge name in mutations.
@@ -65,6 +67,7 @@ var Columns = []string{
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldUploaderID,
+	FieldSipSourceID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -144,6 +147,11 @@ func ByUploaderID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUploaderID, opts...).ToFunc()
 }
 
+// BySipSourceID orders the results by the sip_source_id field.
+func BySipSourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSipSourceID, opts...).ToFunc()
+}
+
 // BySipsCount orders the results by sips count.
 func BySipsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
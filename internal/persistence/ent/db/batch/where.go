@@ -92,6 +92,11 @@ func UploaderID(v int) predicate.Batch {
 	return predicate.Batch(sql.FieldEQ(FieldUploaderID, v))
 }
 
+// SipSourceID applies equality check predicate on the "sip_source_id" field. It's identical to SipSourceIDEQ.
+func SipSourceID(v uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldEQ(FieldSipSourceID, v))
+}
+
 // UUIDEQ applies the EQ predicate on the "uuid" field.
 func UUIDEQ(v uuid.UUID) predicate.Batch {
 	return predicate.Batch(sql.FieldEQ(FieldUUID, v))
@@ -437,6 +442,56 @@ func UploaderIDNotNil() predicate.Batch {
 	return predicate.Batch(sql.FieldNotNull(FieldUploaderID))
 }
 
+// SipSourceIDEQ applies the EQ predicate on the "sip_source_id" field.
+func SipSourceIDEQ(v uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldEQ(FieldSipSourceID, v))
+}
+
+// SipSourceIDNEQ applies the NEQ predicate on the "sip_source_id" field.
+func SipSourceIDNEQ(v uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldNEQ(FieldSipSourceID, v))
+}
+
+// SipSourceIDIn applies the In predicate on the "sip_source_id" field.
+func SipSourceIDIn(vs ...uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldIn(FieldSipSourceID, vs...))
+}
+
+// SipSourceIDNotIn applies the NotIn predicate on the "sip_source_id" field.
+func SipSourceIDNotIn(vs ...uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldNotIn(FieldSipSourceID, vs...))
+}
+
+// SipSourceIDGT applies the GT predicate on the "sip_source_id" field.
+func SipSourceIDGT(v uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldGT(FieldSipSourceID, v))
+}
+
+// SipSourceIDGTE applies the GTE predicate on the "sip_source_id" field.
+func SipSourceIDGTE(v uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldGTE(FieldSipSourceID, v))
+}
+
+// SipSourceIDLT applies the LT predicate on the "sip_source_id" field.
+func SipSourceIDLT(v uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldLT(FieldSipSourceID, v))
+}
+
+// SipSourceIDLTE applies the LTE predicate on the "sip_source_id" field.
+func SipSourceIDLTE(v uuid.UUID) predicate.Batch {
+	return predicate.Batch(sql.FieldLTE(FieldSipSourceID, v))
+}
+
+// SipSourceIDIsNil applies the IsNil predicate on the "sip_source_id" field.
+func SipSourceIDIsNil() predicate.Batch {
+	return predicate.Batch(sql.FieldIsNull(FieldSipSourceID))
+}
+
+// SipSourceIDNotNil applies the NotNil predicate on the "sip_source_id" field.
+func SipSourceIDNotNil() predicate.Batch {
+	return predicate.Batch(sql.FieldNotNull(FieldSipSourceID))
+}
+
 // HasSips applies the HasEdge predicate on the "sips" edge.
 func HasSips() predicate.Batch {
 	return predicate.Batch(func(s *sql.Selector) {
@@ -106,6 +106,20 @@ func (_c *BatchCreate) SetNillableUploaderID(v *int) *BatchCreate {
 	return _c
 }
 
+// SetSipSourceID sets the "sip_source_id" field.
+func (_c *BatchCreate) SetSipSourceID(v uuid.UUID) *BatchCreate {
+	_c.mutation.SetSipSourceID(v)
+	return _c
+}
+
+// SetNillableSipSourceID sets the "sip_source_id" field if the given value is not nil.
+func (_c *BatchCreate) SetNillableSipSourceID(v *uuid.UUID) *BatchCreate {
+	if v != nil {
+		_c.SetSipSourceID(*v)
+	}
+	return _c
+}
+
 // AddSipIDs adds the "sips" edge to the SIP entity by IDs.
 func (_c *BatchCreate) AddSipIDs(ids ...int) *BatchCreate {
 	_c.mutation.AddSipIDs(ids...)
@@ -254,6 +268,10 @@ func (_c *BatchCreate) createSpec() (*Batch, *sqlgraph.CreateSpec) {
 		_spec.SetField(batch.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = value
 	}
+	if value, ok := _c.mutation.SipSourceID(); ok {
+		_spec.SetField(batch.FieldSipSourceID, field.TypeUUID, value)
+		_node.SipSourceID = value
+	}
 	if nodes := _c.mutation.SipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -435,6 +453,24 @@ func (u *BatchUpsert) ClearUploaderID() *BatchUpsert {
 	return u
 }
 
+// SetSipSourceID sets the "sip_source_id" field.
+func (u *BatchUpsert) SetSipSourceID(v uuid.UUID) *BatchUpsert {
+	u.Set(batch.FieldSipSourceID, v)
+	return u
+}
+
+// UpdateSipSourceID sets the "sip_source_id" field to the value that was provided on create.
+func (u *BatchUpsert) UpdateSipSourceID() *BatchUpsert {
+	u.SetExcluded(batch.FieldSipSourceID)
+	return u
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (u *BatchUpsert) ClearSipSourceID() *BatchUpsert {
+	u.SetNull(batch.FieldSipSourceID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -595,6 +631,27 @@ func (u *BatchUpsertOne) ClearUploaderID() *BatchUpsertOne {
 	})
 }
 
+// SetSipSourceID sets the "sip_source_id" field.
+func (u *BatchUpsertOne) SetSipSourceID(v uuid.UUID) *BatchUpsertOne {
+	return u.Update(func(s *BatchUpsert) {
+		s.SetSipSourceID(v)
+	})
+}
+
+// UpdateSipSourceID sets the "sip_source_id" field to the value that was provided on create.
+func (u *BatchUpsertOne) UpdateSipSourceID() *BatchUpsertOne {
+	return u.Update(func(s *BatchUpsert) {
+		s.UpdateSipSourceID()
+	})
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (u *BatchUpsertOne) ClearSipSourceID() *BatchUpsertOne {
+	return u.Update(func(s *BatchUpsert) {
+		s.ClearSipSourceID()
+	})
+}
+
 // Exec executes the query.
 func (u *BatchUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -921,6 +978,27 @@ func (u *BatchUpsertBulk) ClearUploaderID() *BatchUpsertBulk {
 	})
 }
 
+// SetSipSourceID sets the "sip_source_id" field.
+func (u *BatchUpsertBulk) SetSipSourceID(v uuid.UUID) *BatchUpsertBulk {
+	return u.Update(func(s *BatchUpsert) {
+		s.SetSipSourceID(v)
+	})
+}
+
+// UpdateSipSourceID sets the "sip_source_id" field to the value that was provided on create.
+func (u *BatchUpsertBulk) UpdateSipSourceID() *BatchUpsertBulk {
+	return u.Update(func(s *BatchUpsert) {
+		s.UpdateSipSourceID()
+	})
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (u *BatchUpsertBulk) ClearSipSourceID() *BatchUpsertBulk {
+	return u.Update(func(s *BatchUpsert) {
+		s.ClearSipSourceID()
+	})
+}
+
 // Exec executes the query.
 func (u *BatchUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
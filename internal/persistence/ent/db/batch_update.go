@@ -16,6 +16,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/predicate"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/sip"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/user"
+	"github.com/google/uuid"
 )
 
 // BatchUpdate is the builder for updating Batch entities.
@@ -140,6 +141,26 @@ func (_u *BatchUpdate) ClearUploaderID() *BatchUpdate {
 	return _u
 }
 
+// SetSipSourceID sets the "sip_source_id" field.
+func (_u *BatchUpdate) SetSipSourceID(v uuid.UUID) *BatchUpdate {
+	_u.mutation.SetSipSourceID(v)
+	return _u
+}
+
+// SetNillableSipSourceID sets the "sip_source_id" field if the given value is not nil.
+func (_u *BatchUpdate) SetNillableSipSourceID(v *uuid.UUID) *BatchUpdate {
+	if v != nil {
+		_u.SetSipSourceID(*v)
+	}
+	return _u
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (_u *BatchUpdate) ClearSipSourceID() *BatchUpdate {
+	_u.mutation.ClearSipSourceID()
+	return _u
+}
+
 // AddSipIDs adds the "sips" edge to the SIP entity by IDs.
 func (_u *BatchUpdate) AddSipIDs(ids ...int) *BatchUpdate {
 	_u.mutation.AddSipIDs(ids...)
@@ -275,6 +296,12 @@ func (_u *BatchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(batch.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SipSourceID(); ok {
+		_spec.SetField(batch.FieldSipSourceID, field.TypeUUID, value)
+	}
+	if _u.mutation.SipSourceIDCleared() {
+		_spec.ClearField(batch.FieldSipSourceID, field.TypeUUID)
+	}
 	if _u.mutation.SipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -478,6 +505,26 @@ func (_u *BatchUpdateOne) ClearUploaderID() *BatchUpdateOne {
 	return _u
 }
 
+// SetSipSourceID sets the "sip_source_id" field.
+func (_u *BatchUpdateOne) SetSipSourceID(v uuid.UUID) *BatchUpdateOne {
+	_u.mutation.SetSipSourceID(v)
+	return _u
+}
+
+// SetNillableSipSourceID sets the "sip_source_id" field if the given value is not nil.
+func (_u *BatchUpdateOne) SetNillableSipSourceID(v *uuid.UUID) *BatchUpdateOne {
+	if v != nil {
+		_u.SetSipSourceID(*v)
+	}
+	return _u
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (_u *BatchUpdateOne) ClearSipSourceID() *BatchUpdateOne {
+	_u.mutation.ClearSipSourceID()
+	return _u
+}
+
 // AddSipIDs adds the "sips" edge to the SIP entity by IDs.
 func (_u *BatchUpdateOne) AddSipIDs(ids ...int) *BatchUpdateOne {
 	_u.mutation.AddSipIDs(ids...)
@@ -643,6 +690,12 @@ func (_u *BatchUpdateOne) sqlSave(ctx context.Context) (_node *Batch, err error)
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(batch.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SipSourceID(); ok {
+		_spec.SetField(batch.FieldSipSourceID, field.TypeUUID, value)
+	}
+	if _u.mutation.SipSourceIDCleared() {
+		_spec.ClearField(batch.FieldSipSourceID, field.TypeUUID)
+	}
 	if _u.mutation.SipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
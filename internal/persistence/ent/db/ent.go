@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/apitoken"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/batch"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/searchdocument"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/sip"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/user"
@@ -77,11 +79,13 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			batch.Table:    batch.ValidColumn,
-			sip.Table:      sip.ValidColumn,
-			task.Table:     task.ValidColumn,
-			user.Table:     user.ValidColumn,
-			workflow.Table: workflow.ValidColumn,
+			apitoken.Table:       apitoken.ValidColumn,
+			batch.Table:          batch.ValidColumn,
+			sip.Table:            sip.ValidColumn,
+			searchdocument.Table: searchdocument.ValidColumn,
+			task.Table:           task.ValidColumn,
+			user.Table:           user.ValidColumn,
+			workflow.Table:       workflow.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
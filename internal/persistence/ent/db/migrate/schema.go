@@ -9,6 +9,40 @@ import (
 )
 
 var (
+	// APITokenColumns holds the columns for the "api_token" table.
+	APITokenColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uuid", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "attributes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "owner_id", Type: field.TypeInt},
+	}
+	// APITokenTable holds the schema information for the "api_token" table.
+	APITokenTable = &schema.Table{
+		Name:       "api_token",
+		Columns:    APITokenColumns,
+		PrimaryKey: []*schema.Column{APITokenColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_token_user_api_tokens",
+				Columns:    []*schema.Column{APITokenColumns[9]},
+				RefColumns: []*schema.Column{UserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "api_token_owner_id_idx",
+				Unique:  false,
+				Columns: []*schema.Column{APITokenColumns[9]},
+			},
+		},
+	}
 	// BatchColumns holds the columns for the "batch" table.
 	BatchColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -19,6 +53,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sip_source_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "uploader_id", Type: field.TypeInt, Nullable: true},
 	}
 	// BatchTable holds the schema information for the "batch" table.
@@ -29,7 +64,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "batch_user_uploaded_batches",
-				Columns:    []*schema.Column{BatchColumns[8]},
+				Columns:    []*schema.Column{BatchColumns[9]},
 				RefColumns: []*schema.Column{UserColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -48,6 +83,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{BatchColumns[3]},
 			},
+			{
+				Name:    "batch_sip_source_id_idx",
+				Unique:  false,
+				Columns: []*schema.Column{BatchColumns[8]},
+			},
 			{
 				Name:    "batch_created_at_idx",
 				Unique:  false,
@@ -61,7 +101,7 @@ var (
 			{
 				Name:    "batch_uploader_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{BatchColumns[8]},
+				Columns: []*schema.Column{BatchColumns[9]},
 			},
 		},
 	}
@@ -80,6 +120,12 @@ var (
 		{Name: "file_count", Type: field.TypeInt32, Nullable: true},
 		{Name: "checksum_algorithm", Type: field.TypeString, Nullable: true},
 		{Name: "checksum_hash", Type: field.TypeString, Nullable: true},
+		{Name: "processing_profile", Type: field.TypeString, Nullable: true},
+		{Name: "custom_metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "sip_source_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "location_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "expected_checksum", Type: field.TypeString, Nullable: true},
+		{Name: "watcher_name", Type: field.TypeString, Nullable: true},
 		{Name: "batch_id", Type: field.TypeInt, Nullable: true},
 		{Name: "uploader_id", Type: field.TypeInt, Nullable: true},
 	}
@@ -91,13 +137,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sip_batch_sips",
-				Columns:    []*schema.Column{SipColumns[13]},
+				Columns:    []*schema.Column{SipColumns[19]},
 				RefColumns: []*schema.Column{BatchColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "sip_user_uploaded_sips",
-				Columns:    []*schema.Column{SipColumns[14]},
+				Columns:    []*schema.Column{SipColumns[20]},
 				RefColumns: []*schema.Column{UserColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -121,6 +167,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SipColumns[4]},
 			},
+			{
+				Name:    "sip_sip_source_id_idx",
+				Unique:  false,
+				Columns: []*schema.Column{SipColumns[15]},
+			},
 			{
 				Name:    "sip_created_at_idx",
 				Unique:  false,
@@ -134,12 +185,12 @@ var (
 			{
 				Name:    "sip_uploader_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{SipColumns[14]},
+				Columns: []*schema.Column{SipColumns[20]},
 			},
 			{
 				Name:    "sip_batch_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{SipColumns[13]},
+				Columns: []*schema.Column{SipColumns[19]},
 			},
 			{
 				Name:    "sip_checksum_idx",
@@ -148,6 +199,38 @@ var (
 			},
 		},
 	}
+	// SearchDocumentColumns holds the columns for the "search_document" table.
+	SearchDocumentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uuid", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 2048},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SearchDocumentTable holds the schema information for the "search_document" table.
+	SearchDocumentTable = &schema.Table{
+		Name:       "search_document",
+		Columns:    SearchDocumentColumns,
+		PrimaryKey: []*schema.Column{SearchDocumentColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "search_document_fulltext_idx",
+				Unique:  false,
+				Columns: []*schema.Column{SearchDocumentColumns[2], SearchDocumentColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"mysql":    "FULLTEXT",
+						"postgres": "GIN",
+					},
+					OpClassColumns: map[string]string{
+						"content": "gin_trgm_ops",
+						"name":    "gin_trgm_ops",
+					},
+				},
+			},
+		},
+	}
 	// TaskColumns holds the columns for the "task" table.
 	TaskColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -239,8 +322,10 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokenTable,
 		BatchTable,
 		SipTable,
+		SearchDocumentTable,
 		TaskTable,
 		UserTable,
 		WorkflowTable,
@@ -248,6 +333,10 @@ var (
 )
 
 func init() {
+	APITokenTable.ForeignKeys[0].RefTable = UserTable
+	APITokenTable.Annotation = &entsql.Annotation{
+		Table: "api_token",
+	}
 	BatchTable.ForeignKeys[0].RefTable = UserTable
 	BatchTable.Annotation = &entsql.Annotation{
 		Table: "batch",
@@ -257,6 +346,10 @@ func init() {
 	SipTable.Annotation = &entsql.Annotation{
 		Table: "sip",
 	}
+	SearchDocumentTable.Annotation = &entsql.Annotation{
+		Table:     "search_document",
+		Collation: "utf8mb4_unicode_ci",
+	}
 	TaskTable.ForeignKeys[0].RefTable = WorkflowTable
 	TaskTable.Annotation = &entsql.Annotation{
 		Table: "task",
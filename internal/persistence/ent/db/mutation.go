@@ -4,6 +4,7 @@ package db
 
 import (
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"sync"
@@ -12,8 +13,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/artefactual-sdps/enduro/internal/enums"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/apitoken"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/batch"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/predicate"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/searchdocument"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/sip"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/user"
@@ -30,49 +33,49 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBatch    = "Batch"
-	TypeSIP      = "SIP"
-	TypeTask     = "Task"
-	TypeUser     = "User"
-	TypeWorkflow = "Workflow"
+	TypeAPIToken       = "APIToken"
+	TypeBatch          = "Batch"
+	TypeSIP            = "SIP"
+	TypeSearchDocument = "SearchDocument"
+	TypeTask           = "Task"
+	TypeUser           = "User"
+	TypeWorkflow       = "Workflow"
 )
 
-// BatchMutation represents an operation that mutates the Batch nodes in the graph.
-type BatchMutation struct {
+// APITokenMutation represents an operation that mutates the APIToken nodes in the graph.
+type APITokenMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	uuid            *uuid.UUID
-	identifier      *string
-	status          *enums.BatchStatus
-	sips_count      *int
-	addsips_count   *int
-	created_at      *time.Time
-	started_at      *time.Time
-	completed_at    *time.Time
-	clearedFields   map[string]struct{}
-	sips            map[int]struct{}
-	removedsips     map[int]struct{}
-	clearedsips     bool
-	uploader        *int
-	cleareduploader bool
-	done            bool
-	oldValue        func(context.Context) (*Batch, error)
-	predicates      []predicate.Batch
-}
-
-var _ ent.Mutation = (*BatchMutation)(nil)
-
-// batchOption allows management of the mutation configuration using functional options.
-type batchOption func(*BatchMutation)
-
-// newBatchMutation creates new mutation for the Batch entity.
-func newBatchMutation(c config, op Op, opts ...batchOption) *BatchMutation {
-	m := &BatchMutation{
+	op               Op
+	typ              string
+	id               *int
+	uuid             *uuid.UUID
+	name             *string
+	token_hash       *string
+	attributes       *[]string
+	appendattributes []string
+	created_at       *time.Time
+	expires_at       *time.Time
+	last_used_at     *time.Time
+	revoked_at       *time.Time
+	clearedFields    map[string]struct{}
+	owner            *int
+	clearedowner     bool
+	done             bool
+	oldValue         func(context.Context) (*APIToken, error)
+	predicates       []predicate.APIToken
+}
+
+var _ ent.Mutation = (*APITokenMutation)(nil)
+
+// apitokenOption allows management of the mutation configuration using functional options.
+type apitokenOption func(*APITokenMutation)
+
+// newAPITokenMutation creates new mutation for the APIToken entity.
+func newAPITokenMutation(c config, op Op, opts ...apitokenOption) *APITokenMutation {
+	m := &APITokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBatch,
+		typ:           TypeAPIToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -81,20 +84,20 @@ func newBatchMutation(c config, op Op, opts ...batchOption) *BatchMutation {
 	return m
 }
 
-// withBatchID sets the ID field of the mutation.
-func withBatchID(id int) batchOption {
-	return func(m *BatchMutation) {
+// withAPITokenID sets the ID field of the mutation.
+func withAPITokenID(id int) apitokenOption {
+	return func(m *APITokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Batch
+			value *APIToken
 		)
-		m.oldValue = func(ctx context.Context) (*Batch, error) {
+		m.oldValue = func(ctx context.Context) (*APIToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Batch.Get(ctx, id)
+					value, err = m.Client().APIToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -103,10 +106,10 @@ func withBatchID(id int) batchOption {
 	}
 }
 
-// withBatch sets the old Batch of the mutation.
-func withBatch(node *Batch) batchOption {
-	return func(m *BatchMutation) {
-		m.oldValue = func(context.Context) (*Batch, error) {
+// withAPIToken sets the old APIToken of the mutation.
+func withAPIToken(node *APIToken) apitokenOption {
+	return func(m *APITokenMutation) {
+		m.oldValue = func(context.Context) (*APIToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -115,7 +118,7 @@ func withBatch(node *Batch) batchOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BatchMutation) Client() *Client {
+func (m APITokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -123,7 +126,7 @@ func (m BatchMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BatchMutation) Tx() (*Tx, error) {
+func (m APITokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -134,7 +137,7 @@ func (m BatchMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BatchMutation) ID() (id int, exists bool) {
+func (m *APITokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -145,7 +148,7 @@ func (m *BatchMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BatchMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *APITokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -154,19 +157,19 @@ func (m *BatchMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Batch.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUUID sets the "uuid" field.
-func (m *BatchMutation) SetUUID(u uuid.UUID) {
+func (m *APITokenMutation) SetUUID(u uuid.UUID) {
 	m.uuid = &u
 }
 
 // UUID returns the value of the "uuid" field in the mutation.
-func (m *BatchMutation) UUID() (r uuid.UUID, exists bool) {
+func (m *APITokenMutation) UUID() (r uuid.UUID, exists bool) {
 	v := m.uuid
 	if v == nil {
 		return
@@ -174,10 +177,10 @@ func (m *BatchMutation) UUID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldUUID returns the old "uuid" field's value of the Batch entity.
-// If the Batch object wasn't provided to the builder, the object is fetched from the database.
+// OldUUID returns the old "uuid" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BatchMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *APITokenMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
 	}
@@ -192,145 +195,154 @@ func (m *BatchMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
 }
 
 // ResetUUID resets all changes to the "uuid" field.
-func (m *BatchMutation) ResetUUID() {
+func (m *APITokenMutation) ResetUUID() {
 	m.uuid = nil
 }
 
-// SetIdentifier sets the "identifier" field.
-func (m *BatchMutation) SetIdentifier(s string) {
-	m.identifier = &s
+// SetName sets the "name" field.
+func (m *APITokenMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Identifier returns the value of the "identifier" field in the mutation.
-func (m *BatchMutation) Identifier() (r string, exists bool) {
-	v := m.identifier
+// Name returns the value of the "name" field in the mutation.
+func (m *APITokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIdentifier returns the old "identifier" field's value of the Batch entity.
-// If the Batch object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BatchMutation) OldIdentifier(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIdentifier is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIdentifier requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIdentifier: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Identifier, nil
+	return oldValue.Name, nil
 }
 
-// ResetIdentifier resets all changes to the "identifier" field.
-func (m *BatchMutation) ResetIdentifier() {
-	m.identifier = nil
+// ResetName resets all changes to the "name" field.
+func (m *APITokenMutation) ResetName() {
+	m.name = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *BatchMutation) SetStatus(es enums.BatchStatus) {
-	m.status = &es
+// SetTokenHash sets the "token_hash" field.
+func (m *APITokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *BatchMutation) Status() (r enums.BatchStatus, exists bool) {
-	v := m.status
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *APITokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Batch entity.
-// If the Batch object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BatchMutation) OldStatus(ctx context.Context) (v enums.BatchStatus, err error) {
+func (m *APITokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *BatchMutation) ResetStatus() {
-	m.status = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *APITokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetSipsCount sets the "sips_count" field.
-func (m *BatchMutation) SetSipsCount(i int) {
-	m.sips_count = &i
-	m.addsips_count = nil
+// SetAttributes sets the "attributes" field.
+func (m *APITokenMutation) SetAttributes(s []string) {
+	m.attributes = &s
+	m.appendattributes = nil
 }
 
-// SipsCount returns the value of the "sips_count" field in the mutation.
-func (m *BatchMutation) SipsCount() (r int, exists bool) {
-	v := m.sips_count
+// Attributes returns the value of the "attributes" field in the mutation.
+func (m *APITokenMutation) Attributes() (r []string, exists bool) {
+	v := m.attributes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSipsCount returns the old "sips_count" field's value of the Batch entity.
-// If the Batch object wasn't provided to the builder, the object is fetched from the database.
+// OldAttributes returns the old "attributes" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BatchMutation) OldSipsCount(ctx context.Context) (v int, err error) {
+func (m *APITokenMutation) OldAttributes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSipsCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSipsCount requires an ID field in the mutation")
+		return v, errors.New("OldAttributes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSipsCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
 	}
-	return oldValue.SipsCount, nil
+	return oldValue.Attributes, nil
 }
 
-// AddSipsCount adds i to the "sips_count" field.
-func (m *BatchMutation) AddSipsCount(i int) {
-	if m.addsips_count != nil {
-		*m.addsips_count += i
-	} else {
-		m.addsips_count = &i
-	}
+// AppendAttributes adds s to the "attributes" field.
+func (m *APITokenMutation) AppendAttributes(s []string) {
+	m.appendattributes = append(m.appendattributes, s...)
 }
 
-// AddedSipsCount returns the value that was added to the "sips_count" field in this mutation.
-func (m *BatchMutation) AddedSipsCount() (r int, exists bool) {
-	v := m.addsips_count
-	if v == nil {
-		return
+// AppendedAttributes returns the list of values that were appended to the "attributes" field in this mutation.
+func (m *APITokenMutation) AppendedAttributes() ([]string, bool) {
+	if len(m.appendattributes) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendattributes, true
 }
 
-// ResetSipsCount resets all changes to the "sips_count" field.
-func (m *BatchMutation) ResetSipsCount() {
-	m.sips_count = nil
-	m.addsips_count = nil
+// ClearAttributes clears the value of the "attributes" field.
+func (m *APITokenMutation) ClearAttributes() {
+	m.attributes = nil
+	m.appendattributes = nil
+	m.clearedFields[apitoken.FieldAttributes] = struct{}{}
+}
+
+// AttributesCleared returns if the "attributes" field was cleared in this mutation.
+func (m *APITokenMutation) AttributesCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldAttributes]
+	return ok
+}
+
+// ResetAttributes resets all changes to the "attributes" field.
+func (m *APITokenMutation) ResetAttributes() {
+	m.attributes = nil
+	m.appendattributes = nil
+	delete(m.clearedFields, apitoken.FieldAttributes)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *BatchMutation) SetCreatedAt(t time.Time) {
+func (m *APITokenMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BatchMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *APITokenMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -338,10 +350,10 @@ func (m *BatchMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Batch entity.
-// If the Batch object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BatchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APITokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -356,247 +368,229 @@ func (m *BatchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BatchMutation) ResetCreatedAt() {
+func (m *APITokenMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *BatchMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *APITokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *BatchMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APITokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the Batch entity.
-// If the Batch object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BatchMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APITokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *BatchMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[batch.FieldStartedAt] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *APITokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apitoken.FieldExpiresAt] = struct{}{}
 }
 
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *BatchMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[batch.FieldStartedAt]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *APITokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldExpiresAt]
 	return ok
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *BatchMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, batch.FieldStartedAt)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APITokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apitoken.FieldExpiresAt)
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *BatchMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APITokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *BatchMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APITokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the Batch entity.
-// If the Batch object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BatchMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APITokenMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.CompletedAt, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *BatchMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[batch.FieldCompletedAt] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APITokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apitoken.FieldLastUsedAt] = struct{}{}
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *BatchMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[batch.FieldCompletedAt]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APITokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldLastUsedAt]
 	return ok
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *BatchMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, batch.FieldCompletedAt)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APITokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apitoken.FieldLastUsedAt)
 }
 
-// SetUploaderID sets the "uploader_id" field.
-func (m *BatchMutation) SetUploaderID(i int) {
-	m.uploader = &i
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APITokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// UploaderID returns the value of the "uploader_id" field in the mutation.
-func (m *BatchMutation) UploaderID() (r int, exists bool) {
-	v := m.uploader
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APITokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUploaderID returns the old "uploader_id" field's value of the Batch entity.
-// If the Batch object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BatchMutation) OldUploaderID(ctx context.Context) (v int, err error) {
+func (m *APITokenMutation) OldRevokedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploaderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploaderID requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploaderID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.UploaderID, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ClearUploaderID clears the value of the "uploader_id" field.
-func (m *BatchMutation) ClearUploaderID() {
-	m.uploader = nil
-	m.clearedFields[batch.FieldUploaderID] = struct{}{}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APITokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apitoken.FieldRevokedAt] = struct{}{}
 }
 
-// UploaderIDCleared returns if the "uploader_id" field was cleared in this mutation.
-func (m *BatchMutation) UploaderIDCleared() bool {
-	_, ok := m.clearedFields[batch.FieldUploaderID]
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APITokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldRevokedAt]
 	return ok
 }
 
-// ResetUploaderID resets all changes to the "uploader_id" field.
-func (m *BatchMutation) ResetUploaderID() {
-	m.uploader = nil
-	delete(m.clearedFields, batch.FieldUploaderID)
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APITokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apitoken.FieldRevokedAt)
 }
 
-// AddSipIDs adds the "sips" edge to the SIP entity by ids.
-func (m *BatchMutation) AddSipIDs(ids ...int) {
-	if m.sips == nil {
-		m.sips = make(map[int]struct{})
+// SetOwnerID sets the "owner_id" field.
+func (m *APITokenMutation) SetOwnerID(i int) {
+	m.owner = &i
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *APITokenMutation) OwnerID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.sips[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APITokenMutation) OldOwnerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
 	}
+	return oldValue.OwnerID, nil
 }
 
-// ClearSips clears the "sips" edge to the SIP entity.
-func (m *BatchMutation) ClearSips() {
-	m.clearedsips = true
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *APITokenMutation) ResetOwnerID() {
+	m.owner = nil
 }
 
-// SipsCleared reports if the "sips" edge to the SIP entity was cleared.
-func (m *BatchMutation) SipsCleared() bool {
-	return m.clearedsips
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *APITokenMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[apitoken.FieldOwnerID] = struct{}{}
 }
 
-// RemoveSipIDs removes the "sips" edge to the SIP entity by IDs.
-func (m *BatchMutation) RemoveSipIDs(ids ...int) {
-	if m.removedsips == nil {
-		m.removedsips = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.sips, ids[i])
-		m.removedsips[ids[i]] = struct{}{}
-	}
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *APITokenMutation) OwnerCleared() bool {
+	return m.clearedowner
 }
 
-// RemovedSips returns the removed IDs of the "sips" edge to the SIP entity.
-func (m *BatchMutation) RemovedSipsIDs() (ids []int) {
-	for id := range m.removedsips {
-		ids = append(ids, id)
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *APITokenMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// SipsIDs returns the "sips" edge IDs in the mutation.
-func (m *BatchMutation) SipsIDs() (ids []int) {
-	for id := range m.sips {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetSips resets all changes to the "sips" edge.
-func (m *BatchMutation) ResetSips() {
-	m.sips = nil
-	m.clearedsips = false
-	m.removedsips = nil
-}
-
-// ClearUploader clears the "uploader" edge to the User entity.
-func (m *BatchMutation) ClearUploader() {
-	m.cleareduploader = true
-	m.clearedFields[batch.FieldUploaderID] = struct{}{}
-}
-
-// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
-func (m *BatchMutation) UploaderCleared() bool {
-	return m.UploaderIDCleared() || m.cleareduploader
-}
-
-// UploaderIDs returns the "uploader" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UploaderID instead. It exists only for internal usage by the builders.
-func (m *BatchMutation) UploaderIDs() (ids []int) {
-	if id := m.uploader; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUploader resets all changes to the "uploader" edge.
-func (m *BatchMutation) ResetUploader() {
-	m.uploader = nil
-	m.cleareduploader = false
+// ResetOwner resets all changes to the "owner" edge.
+func (m *APITokenMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// Where appends a list predicates to the BatchMutation builder.
-func (m *BatchMutation) Where(ps ...predicate.Batch) {
+// Where appends a list predicates to the APITokenMutation builder.
+func (m *APITokenMutation) Where(ps ...predicate.APIToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BatchMutation builder. Using this method,
+// WhereP appends storage-level predicates to the APITokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BatchMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Batch, len(ps))
+func (m *APITokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -604,48 +598,51 @@ func (m *BatchMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BatchMutation) Op() Op {
+func (m *APITokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BatchMutation) SetOp(op Op) {
+func (m *APITokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Batch).
-func (m *BatchMutation) Type() string {
+// Type returns the node type of this mutation (APIToken).
+func (m *APITokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BatchMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *APITokenMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.uuid != nil {
-		fields = append(fields, batch.FieldUUID)
+		fields = append(fields, apitoken.FieldUUID)
 	}
-	if m.identifier != nil {
-		fields = append(fields, batch.FieldIdentifier)
+	if m.name != nil {
+		fields = append(fields, apitoken.FieldName)
 	}
-	if m.status != nil {
-		fields = append(fields, batch.FieldStatus)
+	if m.token_hash != nil {
+		fields = append(fields, apitoken.FieldTokenHash)
 	}
-	if m.sips_count != nil {
-		fields = append(fields, batch.FieldSipsCount)
+	if m.attributes != nil {
+		fields = append(fields, apitoken.FieldAttributes)
 	}
 	if m.created_at != nil {
-		fields = append(fields, batch.FieldCreatedAt)
+		fields = append(fields, apitoken.FieldCreatedAt)
 	}
-	if m.started_at != nil {
-		fields = append(fields, batch.FieldStartedAt)
+	if m.expires_at != nil {
+		fields = append(fields, apitoken.FieldExpiresAt)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, batch.FieldCompletedAt)
+	if m.last_used_at != nil {
+		fields = append(fields, apitoken.FieldLastUsedAt)
 	}
-	if m.uploader != nil {
-		fields = append(fields, batch.FieldUploaderID)
+	if m.revoked_at != nil {
+		fields = append(fields, apitoken.FieldRevokedAt)
+	}
+	if m.owner != nil {
+		fields = append(fields, apitoken.FieldOwnerID)
 	}
 	return fields
 }
@@ -653,24 +650,26 @@ func (m *BatchMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BatchMutation) Field(name string) (ent.Value, bool) {
+func (m *APITokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case batch.FieldUUID:
+	case apitoken.FieldUUID:
 		return m.UUID()
-	case batch.FieldIdentifier:
-		return m.Identifier()
-	case batch.FieldStatus:
-		return m.Status()
-	case batch.FieldSipsCount:
-		return m.SipsCount()
-	case batch.FieldCreatedAt:
+	case apitoken.FieldName:
+		return m.Name()
+	case apitoken.FieldTokenHash:
+		return m.TokenHash()
+	case apitoken.FieldAttributes:
+		return m.Attributes()
+	case apitoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case batch.FieldStartedAt:
-		return m.StartedAt()
-	case batch.FieldCompletedAt:
-		return m.CompletedAt()
-	case batch.FieldUploaderID:
-		return m.UploaderID()
+	case apitoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apitoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apitoken.FieldRevokedAt:
+		return m.RevokedAt()
+	case apitoken.FieldOwnerID:
+		return m.OwnerID()
 	}
 	return nil, false
 }
@@ -678,110 +677,114 @@ func (m *BatchMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BatchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APITokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case batch.FieldUUID:
+	case apitoken.FieldUUID:
 		return m.OldUUID(ctx)
-	case batch.FieldIdentifier:
-		return m.OldIdentifier(ctx)
-	case batch.FieldStatus:
-		return m.OldStatus(ctx)
-	case batch.FieldSipsCount:
-		return m.OldSipsCount(ctx)
-	case batch.FieldCreatedAt:
+	case apitoken.FieldName:
+		return m.OldName(ctx)
+	case apitoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case apitoken.FieldAttributes:
+		return m.OldAttributes(ctx)
+	case apitoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case batch.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case batch.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case batch.FieldUploaderID:
-		return m.OldUploaderID(ctx)
+	case apitoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apitoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apitoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case apitoken.FieldOwnerID:
+		return m.OldOwnerID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Batch field %s", name)
+	return nil, fmt.Errorf("unknown APIToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BatchMutation) SetField(name string, value ent.Value) error {
+func (m *APITokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case batch.FieldUUID:
+	case apitoken.FieldUUID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUUID(v)
 		return nil
-	case batch.FieldIdentifier:
+	case apitoken.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIdentifier(v)
+		m.SetName(v)
 		return nil
-	case batch.FieldStatus:
-		v, ok := value.(enums.BatchStatus)
+	case apitoken.FieldTokenHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetTokenHash(v)
 		return nil
-	case batch.FieldSipsCount:
-		v, ok := value.(int)
+	case apitoken.FieldAttributes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSipsCount(v)
+		m.SetAttributes(v)
 		return nil
-	case batch.FieldCreatedAt:
+	case apitoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case batch.FieldStartedAt:
+	case apitoken.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetExpiresAt(v)
 		return nil
-	case batch.FieldCompletedAt:
+	case apitoken.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case batch.FieldUploaderID:
+	case apitoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case apitoken.FieldOwnerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUploaderID(v)
+		m.SetOwnerID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Batch field %s", name)
+	return fmt.Errorf("unknown APIToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BatchMutation) AddedFields() []string {
+func (m *APITokenMutation) AddedFields() []string {
 	var fields []string
-	if m.addsips_count != nil {
-		fields = append(fields, batch.FieldSipsCount)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BatchMutation) AddedField(name string) (ent.Value, bool) {
+func (m *APITokenMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case batch.FieldSipsCount:
-		return m.AddedSipsCount()
 	}
 	return nil, false
 }
@@ -789,115 +792,108 @@ func (m *BatchMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BatchMutation) AddField(name string, value ent.Value) error {
+func (m *APITokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case batch.FieldSipsCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSipsCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Batch numeric field %s", name)
+	return fmt.Errorf("unknown APIToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BatchMutation) ClearedFields() []string {
+func (m *APITokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(batch.FieldStartedAt) {
-		fields = append(fields, batch.FieldStartedAt)
+	if m.FieldCleared(apitoken.FieldAttributes) {
+		fields = append(fields, apitoken.FieldAttributes)
 	}
-	if m.FieldCleared(batch.FieldCompletedAt) {
-		fields = append(fields, batch.FieldCompletedAt)
+	if m.FieldCleared(apitoken.FieldExpiresAt) {
+		fields = append(fields, apitoken.FieldExpiresAt)
 	}
-	if m.FieldCleared(batch.FieldUploaderID) {
-		fields = append(fields, batch.FieldUploaderID)
+	if m.FieldCleared(apitoken.FieldLastUsedAt) {
+		fields = append(fields, apitoken.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apitoken.FieldRevokedAt) {
+		fields = append(fields, apitoken.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BatchMutation) FieldCleared(name string) bool {
+func (m *APITokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BatchMutation) ClearField(name string) error {
+func (m *APITokenMutation) ClearField(name string) error {
 	switch name {
-	case batch.FieldStartedAt:
-		m.ClearStartedAt()
+	case apitoken.FieldAttributes:
+		m.ClearAttributes()
 		return nil
-	case batch.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case apitoken.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
-	case batch.FieldUploaderID:
-		m.ClearUploaderID()
+	case apitoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apitoken.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Batch nullable field %s", name)
+	return fmt.Errorf("unknown APIToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BatchMutation) ResetField(name string) error {
+func (m *APITokenMutation) ResetField(name string) error {
 	switch name {
-	case batch.FieldUUID:
+	case apitoken.FieldUUID:
 		m.ResetUUID()
 		return nil
-	case batch.FieldIdentifier:
-		m.ResetIdentifier()
+	case apitoken.FieldName:
+		m.ResetName()
 		return nil
-	case batch.FieldStatus:
-		m.ResetStatus()
+	case apitoken.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case batch.FieldSipsCount:
-		m.ResetSipsCount()
+	case apitoken.FieldAttributes:
+		m.ResetAttributes()
 		return nil
-	case batch.FieldCreatedAt:
+	case apitoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case batch.FieldStartedAt:
-		m.ResetStartedAt()
+	case apitoken.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case batch.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case apitoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case batch.FieldUploaderID:
-		m.ResetUploaderID()
+	case apitoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case apitoken.FieldOwnerID:
+		m.ResetOwnerID()
 		return nil
 	}
-	return fmt.Errorf("unknown Batch field %s", name)
+	return fmt.Errorf("unknown APIToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BatchMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.sips != nil {
-		edges = append(edges, batch.EdgeSips)
-	}
-	if m.uploader != nil {
-		edges = append(edges, batch.EdgeUploader)
+func (m *APITokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, apitoken.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BatchMutation) AddedIDs(name string) []ent.Value {
+func (m *APITokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case batch.EdgeSips:
-		ids := make([]ent.Value, 0, len(m.sips))
-		for id := range m.sips {
-			ids = append(ids, id)
-		}
-		return ids
-	case batch.EdgeUploader:
-		if id := m.uploader; id != nil {
+	case apitoken.EdgeOwner:
+		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -905,120 +901,95 @@ func (m *BatchMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BatchMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedsips != nil {
-		edges = append(edges, batch.EdgeSips)
-	}
+func (m *APITokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BatchMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case batch.EdgeSips:
-		ids := make([]ent.Value, 0, len(m.removedsips))
-		for id := range m.removedsips {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *APITokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BatchMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedsips {
-		edges = append(edges, batch.EdgeSips)
-	}
-	if m.cleareduploader {
-		edges = append(edges, batch.EdgeUploader)
+func (m *APITokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, apitoken.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BatchMutation) EdgeCleared(name string) bool {
+func (m *APITokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case batch.EdgeSips:
-		return m.clearedsips
-	case batch.EdgeUploader:
-		return m.cleareduploader
+	case apitoken.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BatchMutation) ClearEdge(name string) error {
+func (m *APITokenMutation) ClearEdge(name string) error {
 	switch name {
-	case batch.EdgeUploader:
-		m.ClearUploader()
+	case apitoken.EdgeOwner:
+		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Batch unique edge %s", name)
+	return fmt.Errorf("unknown APIToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BatchMutation) ResetEdge(name string) error {
+func (m *APITokenMutation) ResetEdge(name string) error {
 	switch name {
-	case batch.EdgeSips:
-		m.ResetSips()
-		return nil
-	case batch.EdgeUploader:
-		m.ResetUploader()
+	case apitoken.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Batch edge %s", name)
+	return fmt.Errorf("unknown APIToken edge %s", name)
 }
 
-// SIPMutation represents an operation that mutates the SIP nodes in the graph.
-type SIPMutation struct {
+// BatchMutation represents an operation that mutates the Batch nodes in the graph.
+type BatchMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	uuid               *uuid.UUID
-	name               *string
-	aip_id             *uuid.UUID
-	status             *enums.SIPStatus
-	created_at         *time.Time
-	started_at         *time.Time
-	completed_at       *time.Time
-	failed_as          *enums.SIPFailedAs
-	failed_key         *string
-	file_count         *int32
-	addfile_count      *int32
-	checksum_algorithm *string
-	checksum_hash      *string
-	clearedFields      map[string]struct{}
-	workflows          map[int]struct{}
-	removedworkflows   map[int]struct{}
-	clearedworkflows   bool
-	uploader           *int
-	cleareduploader    bool
-	batch              *int
-	clearedbatch       bool
-	done               bool
-	oldValue           func(context.Context) (*SIP, error)
-	predicates         []predicate.SIP
+	op              Op
+	typ             string
+	id              *int
+	uuid            *uuid.UUID
+	identifier      *string
+	status          *enums.BatchStatus
+	sips_count      *int
+	addsips_count   *int
+	created_at      *time.Time
+	started_at      *time.Time
+	completed_at    *time.Time
+	sip_source_id   *uuid.UUID
+	clearedFields   map[string]struct{}
+	sips            map[int]struct{}
+	removedsips     map[int]struct{}
+	clearedsips     bool
+	uploader        *int
+	cleareduploader bool
+	done            bool
+	oldValue        func(context.Context) (*Batch, error)
+	predicates      []predicate.Batch
 }
 
-var _ ent.Mutation = (*SIPMutation)(nil)
+var _ ent.Mutation = (*BatchMutation)(nil)
 
-// sipOption allows management of the mutation configuration using functional options.
-type sipOption func(*SIPMutation)
+// batchOption allows management of the mutation configuration using functional options.
+type batchOption func(*BatchMutation)
 
-// newSIPMutation creates new mutation for the SIP entity.
-func newSIPMutation(c config, op Op, opts ...sipOption) *SIPMutation {
-	m := &SIPMutation{
+// newBatchMutation creates new mutation for the Batch entity.
+func newBatchMutation(c config, op Op, opts ...batchOption) *BatchMutation {
+	m := &BatchMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSIP,
+		typ:           TypeBatch,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1027,20 +998,20 @@ func newSIPMutation(c config, op Op, opts ...sipOption) *SIPMutation {
 	return m
 }
 
-// withSIPID sets the ID field of the mutation.
-func withSIPID(id int) sipOption {
-	return func(m *SIPMutation) {
+// withBatchID sets the ID field of the mutation.
+func withBatchID(id int) batchOption {
+	return func(m *BatchMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SIP
+			value *Batch
 		)
-		m.oldValue = func(ctx context.Context) (*SIP, error) {
+		m.oldValue = func(ctx context.Context) (*Batch, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SIP.Get(ctx, id)
+					value, err = m.Client().Batch.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1049,10 +1020,10 @@ func withSIPID(id int) sipOption {
 	}
 }
 
-// withSIP sets the old SIP of the mutation.
-func withSIP(node *SIP) sipOption {
-	return func(m *SIPMutation) {
-		m.oldValue = func(context.Context) (*SIP, error) {
+// withBatch sets the old Batch of the mutation.
+func withBatch(node *Batch) batchOption {
+	return func(m *BatchMutation) {
+		m.oldValue = func(context.Context) (*Batch, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1061,7 +1032,7 @@ func withSIP(node *SIP) sipOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SIPMutation) Client() *Client {
+func (m BatchMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1069,7 +1040,7 @@ func (m SIPMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SIPMutation) Tx() (*Tx, error) {
+func (m BatchMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -1080,7 +1051,7 @@ func (m SIPMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SIPMutation) ID() (id int, exists bool) {
+func (m *BatchMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1091,7 +1062,7 @@ func (m *SIPMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SIPMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BatchMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1100,19 +1071,19 @@ func (m *SIPMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SIP.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Batch.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUUID sets the "uuid" field.
-func (m *SIPMutation) SetUUID(u uuid.UUID) {
+func (m *BatchMutation) SetUUID(u uuid.UUID) {
 	m.uuid = &u
 }
 
 // UUID returns the value of the "uuid" field in the mutation.
-func (m *SIPMutation) UUID() (r uuid.UUID, exists bool) {
+func (m *BatchMutation) UUID() (r uuid.UUID, exists bool) {
 	v := m.uuid
 	if v == nil {
 		return
@@ -1120,10 +1091,10 @@ func (m *SIPMutation) UUID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldUUID returns the old "uuid" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldUUID returns the old "uuid" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BatchMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
 	}
@@ -1138,138 +1109,145 @@ func (m *SIPMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
 }
 
 // ResetUUID resets all changes to the "uuid" field.
-func (m *SIPMutation) ResetUUID() {
+func (m *BatchMutation) ResetUUID() {
 	m.uuid = nil
 }
 
-// SetName sets the "name" field.
-func (m *SIPMutation) SetName(s string) {
-	m.name = &s
+// SetIdentifier sets the "identifier" field.
+func (m *BatchMutation) SetIdentifier(s string) {
+	m.identifier = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SIPMutation) Name() (r string, exists bool) {
-	v := m.name
+// Identifier returns the value of the "identifier" field in the mutation.
+func (m *BatchMutation) Identifier() (r string, exists bool) {
+	v := m.identifier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldIdentifier returns the old "identifier" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BatchMutation) OldIdentifier(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldIdentifier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldIdentifier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldIdentifier: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Identifier, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SIPMutation) ResetName() {
-	m.name = nil
+// ResetIdentifier resets all changes to the "identifier" field.
+func (m *BatchMutation) ResetIdentifier() {
+	m.identifier = nil
 }
 
-// SetAipID sets the "aip_id" field.
-func (m *SIPMutation) SetAipID(u uuid.UUID) {
-	m.aip_id = &u
+// SetStatus sets the "status" field.
+func (m *BatchMutation) SetStatus(es enums.BatchStatus) {
+	m.status = &es
 }
 
-// AipID returns the value of the "aip_id" field in the mutation.
-func (m *SIPMutation) AipID() (r uuid.UUID, exists bool) {
-	v := m.aip_id
+// Status returns the value of the "status" field in the mutation.
+func (m *BatchMutation) Status() (r enums.BatchStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAipID returns the old "aip_id" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldAipID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BatchMutation) OldStatus(ctx context.Context) (v enums.BatchStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAipID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAipID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAipID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.AipID, nil
-}
-
-// ClearAipID clears the value of the "aip_id" field.
-func (m *SIPMutation) ClearAipID() {
-	m.aip_id = nil
-	m.clearedFields[sip.FieldAipID] = struct{}{}
-}
-
-// AipIDCleared returns if the "aip_id" field was cleared in this mutation.
-func (m *SIPMutation) AipIDCleared() bool {
-	_, ok := m.clearedFields[sip.FieldAipID]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetAipID resets all changes to the "aip_id" field.
-func (m *SIPMutation) ResetAipID() {
-	m.aip_id = nil
-	delete(m.clearedFields, sip.FieldAipID)
+// ResetStatus resets all changes to the "status" field.
+func (m *BatchMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *SIPMutation) SetStatus(es enums.SIPStatus) {
-	m.status = &es
+// SetSipsCount sets the "sips_count" field.
+func (m *BatchMutation) SetSipsCount(i int) {
+	m.sips_count = &i
+	m.addsips_count = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SIPMutation) Status() (r enums.SIPStatus, exists bool) {
-	v := m.status
+// SipsCount returns the value of the "sips_count" field in the mutation.
+func (m *BatchMutation) SipsCount() (r int, exists bool) {
+	v := m.sips_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldSipsCount returns the old "sips_count" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldStatus(ctx context.Context) (v enums.SIPStatus, err error) {
+func (m *BatchMutation) OldSipsCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldSipsCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldSipsCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldSipsCount: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.SipsCount, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SIPMutation) ResetStatus() {
-	m.status = nil
+// AddSipsCount adds i to the "sips_count" field.
+func (m *BatchMutation) AddSipsCount(i int) {
+	if m.addsips_count != nil {
+		*m.addsips_count += i
+	} else {
+		m.addsips_count = &i
+	}
+}
+
+// AddedSipsCount returns the value that was added to the "sips_count" field in this mutation.
+func (m *BatchMutation) AddedSipsCount() (r int, exists bool) {
+	v := m.addsips_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSipsCount resets all changes to the "sips_count" field.
+func (m *BatchMutation) ResetSipsCount() {
+	m.sips_count = nil
+	m.addsips_count = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SIPMutation) SetCreatedAt(t time.Time) {
+func (m *BatchMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SIPMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BatchMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1277,10 +1255,10 @@ func (m *SIPMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BatchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1295,17 +1273,17 @@ func (m *SIPMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SIPMutation) ResetCreatedAt() {
+func (m *BatchMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetStartedAt sets the "started_at" field.
-func (m *SIPMutation) SetStartedAt(t time.Time) {
+func (m *BatchMutation) SetStartedAt(t time.Time) {
 	m.started_at = &t
 }
 
 // StartedAt returns the value of the "started_at" field in the mutation.
-func (m *SIPMutation) StartedAt() (r time.Time, exists bool) {
+func (m *BatchMutation) StartedAt() (r time.Time, exists bool) {
 	v := m.started_at
 	if v == nil {
 		return
@@ -1313,10 +1291,10 @@ func (m *SIPMutation) StartedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BatchMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
@@ -1331,30 +1309,30 @@ func (m *SIPMutation) OldStartedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ClearStartedAt clears the value of the "started_at" field.
-func (m *SIPMutation) ClearStartedAt() {
+func (m *BatchMutation) ClearStartedAt() {
 	m.started_at = nil
-	m.clearedFields[sip.FieldStartedAt] = struct{}{}
+	m.clearedFields[batch.FieldStartedAt] = struct{}{}
 }
 
 // StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *SIPMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[sip.FieldStartedAt]
+func (m *BatchMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[batch.FieldStartedAt]
 	return ok
 }
 
 // ResetStartedAt resets all changes to the "started_at" field.
-func (m *SIPMutation) ResetStartedAt() {
+func (m *BatchMutation) ResetStartedAt() {
 	m.started_at = nil
-	delete(m.clearedFields, sip.FieldStartedAt)
+	delete(m.clearedFields, batch.FieldStartedAt)
 }
 
 // SetCompletedAt sets the "completed_at" field.
-func (m *SIPMutation) SetCompletedAt(t time.Time) {
+func (m *BatchMutation) SetCompletedAt(t time.Time) {
 	m.completed_at = &t
 }
 
 // CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *SIPMutation) CompletedAt() (r time.Time, exists bool) {
+func (m *BatchMutation) CompletedAt() (r time.Time, exists bool) {
 	v := m.completed_at
 	if v == nil {
 		return
@@ -1362,10 +1340,10 @@ func (m *SIPMutation) CompletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletedAt returns the old "completed_at" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BatchMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
@@ -1380,504 +1358,2811 @@ func (m *SIPMutation) OldCompletedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ClearCompletedAt clears the value of the "completed_at" field.
-func (m *SIPMutation) ClearCompletedAt() {
+func (m *BatchMutation) ClearCompletedAt() {
 	m.completed_at = nil
-	m.clearedFields[sip.FieldCompletedAt] = struct{}{}
+	m.clearedFields[batch.FieldCompletedAt] = struct{}{}
 }
 
 // CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *SIPMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[sip.FieldCompletedAt]
+func (m *BatchMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[batch.FieldCompletedAt]
 	return ok
 }
 
 // ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *SIPMutation) ResetCompletedAt() {
+func (m *BatchMutation) ResetCompletedAt() {
 	m.completed_at = nil
-	delete(m.clearedFields, sip.FieldCompletedAt)
+	delete(m.clearedFields, batch.FieldCompletedAt)
 }
 
-// SetFailedAs sets the "failed_as" field.
-func (m *SIPMutation) SetFailedAs(efa enums.SIPFailedAs) {
-	m.failed_as = &efa
+// SetUploaderID sets the "uploader_id" field.
+func (m *BatchMutation) SetUploaderID(i int) {
+	m.uploader = &i
 }
 
-// FailedAs returns the value of the "failed_as" field in the mutation.
-func (m *SIPMutation) FailedAs() (r enums.SIPFailedAs, exists bool) {
-	v := m.failed_as
+// UploaderID returns the value of the "uploader_id" field in the mutation.
+func (m *BatchMutation) UploaderID() (r int, exists bool) {
+	v := m.uploader
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFailedAs returns the old "failed_as" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldUploaderID returns the old "uploader_id" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldFailedAs(ctx context.Context) (v enums.SIPFailedAs, err error) {
+func (m *BatchMutation) OldUploaderID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFailedAs is only allowed on UpdateOne operations")
+		return v, errors.New("OldUploaderID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFailedAs requires an ID field in the mutation")
+		return v, errors.New("OldUploaderID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFailedAs: %w", err)
+		return v, fmt.Errorf("querying old value for OldUploaderID: %w", err)
 	}
-	return oldValue.FailedAs, nil
+	return oldValue.UploaderID, nil
 }
 
-// ClearFailedAs clears the value of the "failed_as" field.
-func (m *SIPMutation) ClearFailedAs() {
-	m.failed_as = nil
-	m.clearedFields[sip.FieldFailedAs] = struct{}{}
+// ClearUploaderID clears the value of the "uploader_id" field.
+func (m *BatchMutation) ClearUploaderID() {
+	m.uploader = nil
+	m.clearedFields[batch.FieldUploaderID] = struct{}{}
 }
 
-// FailedAsCleared returns if the "failed_as" field was cleared in this mutation.
-func (m *SIPMutation) FailedAsCleared() bool {
-	_, ok := m.clearedFields[sip.FieldFailedAs]
+// UploaderIDCleared returns if the "uploader_id" field was cleared in this mutation.
+func (m *BatchMutation) UploaderIDCleared() bool {
+	_, ok := m.clearedFields[batch.FieldUploaderID]
 	return ok
 }
 
-// ResetFailedAs resets all changes to the "failed_as" field.
-func (m *SIPMutation) ResetFailedAs() {
-	m.failed_as = nil
-	delete(m.clearedFields, sip.FieldFailedAs)
+// ResetUploaderID resets all changes to the "uploader_id" field.
+func (m *BatchMutation) ResetUploaderID() {
+	m.uploader = nil
+	delete(m.clearedFields, batch.FieldUploaderID)
 }
 
-// SetFailedKey sets the "failed_key" field.
-func (m *SIPMutation) SetFailedKey(s string) {
-	m.failed_key = &s
+// SetSipSourceID sets the "sip_source_id" field.
+func (m *BatchMutation) SetSipSourceID(u uuid.UUID) {
+	m.sip_source_id = &u
 }
 
-// FailedKey returns the value of the "failed_key" field in the mutation.
-func (m *SIPMutation) FailedKey() (r string, exists bool) {
-	v := m.failed_key
+// SipSourceID returns the value of the "sip_source_id" field in the mutation.
+func (m *BatchMutation) SipSourceID() (r uuid.UUID, exists bool) {
+	v := m.sip_source_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFailedKey returns the old "failed_key" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldSipSourceID returns the old "sip_source_id" field's value of the Batch entity.
+// If the Batch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldFailedKey(ctx context.Context) (v string, err error) {
+func (m *BatchMutation) OldSipSourceID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFailedKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldSipSourceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFailedKey requires an ID field in the mutation")
+		return v, errors.New("OldSipSourceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFailedKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldSipSourceID: %w", err)
 	}
-	return oldValue.FailedKey, nil
+	return oldValue.SipSourceID, nil
 }
 
-// ClearFailedKey clears the value of the "failed_key" field.
-func (m *SIPMutation) ClearFailedKey() {
-	m.failed_key = nil
-	m.clearedFields[sip.FieldFailedKey] = struct{}{}
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (m *BatchMutation) ClearSipSourceID() {
+	m.sip_source_id = nil
+	m.clearedFields[batch.FieldSipSourceID] = struct{}{}
 }
 
-// FailedKeyCleared returns if the "failed_key" field was cleared in this mutation.
-func (m *SIPMutation) FailedKeyCleared() bool {
-	_, ok := m.clearedFields[sip.FieldFailedKey]
+// SipSourceIDCleared returns if the "sip_source_id" field was cleared in this mutation.
+func (m *BatchMutation) SipSourceIDCleared() bool {
+	_, ok := m.clearedFields[batch.FieldSipSourceID]
 	return ok
 }
 
-// ResetFailedKey resets all changes to the "failed_key" field.
-func (m *SIPMutation) ResetFailedKey() {
-	m.failed_key = nil
-	delete(m.clearedFields, sip.FieldFailedKey)
+// ResetSipSourceID resets all changes to the "sip_source_id" field.
+func (m *BatchMutation) ResetSipSourceID() {
+	m.sip_source_id = nil
+	delete(m.clearedFields, batch.FieldSipSourceID)
 }
 
-// SetUploaderID sets the "uploader_id" field.
-func (m *SIPMutation) SetUploaderID(i int) {
-	m.uploader = &i
+// AddSipIDs adds the "sips" edge to the SIP entity by ids.
+func (m *BatchMutation) AddSipIDs(ids ...int) {
+	if m.sips == nil {
+		m.sips = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sips[ids[i]] = struct{}{}
+	}
 }
 
-// UploaderID returns the value of the "uploader_id" field in the mutation.
-func (m *SIPMutation) UploaderID() (r int, exists bool) {
-	v := m.uploader
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearSips clears the "sips" edge to the SIP entity.
+func (m *BatchMutation) ClearSips() {
+	m.clearedsips = true
 }
 
-// OldUploaderID returns the old "uploader_id" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldUploaderID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploaderID is only allowed on UpdateOne operations")
+// SipsCleared reports if the "sips" edge to the SIP entity was cleared.
+func (m *BatchMutation) SipsCleared() bool {
+	return m.clearedsips
+}
+
+// RemoveSipIDs removes the "sips" edge to the SIP entity by IDs.
+func (m *BatchMutation) RemoveSipIDs(ids ...int) {
+	if m.removedsips == nil {
+		m.removedsips = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploaderID requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.sips, ids[i])
+		m.removedsips[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploaderID: %w", err)
+}
+
+// RemovedSips returns the removed IDs of the "sips" edge to the SIP entity.
+func (m *BatchMutation) RemovedSipsIDs() (ids []int) {
+	for id := range m.removedsips {
+		ids = append(ids, id)
 	}
-	return oldValue.UploaderID, nil
+	return
 }
 
-// ClearUploaderID clears the value of the "uploader_id" field.
-func (m *SIPMutation) ClearUploaderID() {
-	m.uploader = nil
-	m.clearedFields[sip.FieldUploaderID] = struct{}{}
+// SipsIDs returns the "sips" edge IDs in the mutation.
+func (m *BatchMutation) SipsIDs() (ids []int) {
+	for id := range m.sips {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UploaderIDCleared returns if the "uploader_id" field was cleared in this mutation.
-func (m *SIPMutation) UploaderIDCleared() bool {
-	_, ok := m.clearedFields[sip.FieldUploaderID]
-	return ok
+// ResetSips resets all changes to the "sips" edge.
+func (m *BatchMutation) ResetSips() {
+	m.sips = nil
+	m.clearedsips = false
+	m.removedsips = nil
 }
 
-// ResetUploaderID resets all changes to the "uploader_id" field.
-func (m *SIPMutation) ResetUploaderID() {
-	m.uploader = nil
-	delete(m.clearedFields, sip.FieldUploaderID)
+// ClearUploader clears the "uploader" edge to the User entity.
+func (m *BatchMutation) ClearUploader() {
+	m.cleareduploader = true
+	m.clearedFields[batch.FieldUploaderID] = struct{}{}
 }
 
-// SetBatchID sets the "batch_id" field.
-func (m *SIPMutation) SetBatchID(i int) {
-	m.batch = &i
+// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
+func (m *BatchMutation) UploaderCleared() bool {
+	return m.UploaderIDCleared() || m.cleareduploader
 }
 
-// BatchID returns the value of the "batch_id" field in the mutation.
-func (m *SIPMutation) BatchID() (r int, exists bool) {
-	v := m.batch
-	if v == nil {
-		return
+// UploaderIDs returns the "uploader" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UploaderID instead. It exists only for internal usage by the builders.
+func (m *BatchMutation) UploaderIDs() (ids []int) {
+	if id := m.uploader; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldBatchID returns the old "batch_id" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldBatchID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBatchID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBatchID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBatchID: %w", err)
-	}
-	return oldValue.BatchID, nil
+// ResetUploader resets all changes to the "uploader" edge.
+func (m *BatchMutation) ResetUploader() {
+	m.uploader = nil
+	m.cleareduploader = false
 }
 
-// ClearBatchID clears the value of the "batch_id" field.
-func (m *SIPMutation) ClearBatchID() {
-	m.batch = nil
-	m.clearedFields[sip.FieldBatchID] = struct{}{}
+// Where appends a list predicates to the BatchMutation builder.
+func (m *BatchMutation) Where(ps ...predicate.Batch) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// BatchIDCleared returns if the "batch_id" field was cleared in this mutation.
-func (m *SIPMutation) BatchIDCleared() bool {
-	_, ok := m.clearedFields[sip.FieldBatchID]
-	return ok
+// WhereP appends storage-level predicates to the BatchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BatchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Batch, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetBatchID resets all changes to the "batch_id" field.
-func (m *SIPMutation) ResetBatchID() {
-	m.batch = nil
-	delete(m.clearedFields, sip.FieldBatchID)
+// Op returns the operation name.
+func (m *BatchMutation) Op() Op {
+	return m.op
 }
 
-// SetFileCount sets the "file_count" field.
-func (m *SIPMutation) SetFileCount(i int32) {
-	m.file_count = &i
-	m.addfile_count = nil
+// SetOp allows setting the mutation operation.
+func (m *BatchMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// FileCount returns the value of the "file_count" field in the mutation.
-func (m *SIPMutation) FileCount() (r int32, exists bool) {
-	v := m.file_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Batch).
+func (m *BatchMutation) Type() string {
+	return m.typ
 }
 
-// OldFileCount returns the old "file_count" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldFileCount(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileCount is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BatchMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.uuid != nil {
+		fields = append(fields, batch.FieldUUID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileCount requires an ID field in the mutation")
+	if m.identifier != nil {
+		fields = append(fields, batch.FieldIdentifier)
+	}
+	if m.status != nil {
+		fields = append(fields, batch.FieldStatus)
+	}
+	if m.sips_count != nil {
+		fields = append(fields, batch.FieldSipsCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, batch.FieldCreatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, batch.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, batch.FieldCompletedAt)
+	}
+	if m.uploader != nil {
+		fields = append(fields, batch.FieldUploaderID)
+	}
+	if m.sip_source_id != nil {
+		fields = append(fields, batch.FieldSipSourceID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BatchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case batch.FieldUUID:
+		return m.UUID()
+	case batch.FieldIdentifier:
+		return m.Identifier()
+	case batch.FieldStatus:
+		return m.Status()
+	case batch.FieldSipsCount:
+		return m.SipsCount()
+	case batch.FieldCreatedAt:
+		return m.CreatedAt()
+	case batch.FieldStartedAt:
+		return m.StartedAt()
+	case batch.FieldCompletedAt:
+		return m.CompletedAt()
+	case batch.FieldUploaderID:
+		return m.UploaderID()
+	case batch.FieldSipSourceID:
+		return m.SipSourceID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BatchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case batch.FieldUUID:
+		return m.OldUUID(ctx)
+	case batch.FieldIdentifier:
+		return m.OldIdentifier(ctx)
+	case batch.FieldStatus:
+		return m.OldStatus(ctx)
+	case batch.FieldSipsCount:
+		return m.OldSipsCount(ctx)
+	case batch.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case batch.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case batch.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case batch.FieldUploaderID:
+		return m.OldUploaderID(ctx)
+	case batch.FieldSipSourceID:
+		return m.OldSipSourceID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Batch field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BatchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case batch.FieldUUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUID(v)
+		return nil
+	case batch.FieldIdentifier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdentifier(v)
+		return nil
+	case batch.FieldStatus:
+		v, ok := value.(enums.BatchStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case batch.FieldSipsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSipsCount(v)
+		return nil
+	case batch.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case batch.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case batch.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case batch.FieldUploaderID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploaderID(v)
+		return nil
+	case batch.FieldSipSourceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSipSourceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Batch field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BatchMutation) AddedFields() []string {
+	var fields []string
+	if m.addsips_count != nil {
+		fields = append(fields, batch.FieldSipsCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BatchMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case batch.FieldSipsCount:
+		return m.AddedSipsCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BatchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case batch.FieldSipsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSipsCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Batch numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BatchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(batch.FieldStartedAt) {
+		fields = append(fields, batch.FieldStartedAt)
+	}
+	if m.FieldCleared(batch.FieldCompletedAt) {
+		fields = append(fields, batch.FieldCompletedAt)
+	}
+	if m.FieldCleared(batch.FieldUploaderID) {
+		fields = append(fields, batch.FieldUploaderID)
+	}
+	if m.FieldCleared(batch.FieldSipSourceID) {
+		fields = append(fields, batch.FieldSipSourceID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BatchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BatchMutation) ClearField(name string) error {
+	switch name {
+	case batch.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case batch.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case batch.FieldUploaderID:
+		m.ClearUploaderID()
+		return nil
+	case batch.FieldSipSourceID:
+		m.ClearSipSourceID()
+		return nil
+	}
+	return fmt.Errorf("unknown Batch nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BatchMutation) ResetField(name string) error {
+	switch name {
+	case batch.FieldUUID:
+		m.ResetUUID()
+		return nil
+	case batch.FieldIdentifier:
+		m.ResetIdentifier()
+		return nil
+	case batch.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case batch.FieldSipsCount:
+		m.ResetSipsCount()
+		return nil
+	case batch.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case batch.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case batch.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case batch.FieldUploaderID:
+		m.ResetUploaderID()
+		return nil
+	case batch.FieldSipSourceID:
+		m.ResetSipSourceID()
+		return nil
+	}
+	return fmt.Errorf("unknown Batch field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BatchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.sips != nil {
+		edges = append(edges, batch.EdgeSips)
+	}
+	if m.uploader != nil {
+		edges = append(edges, batch.EdgeUploader)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BatchMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case batch.EdgeSips:
+		ids := make([]ent.Value, 0, len(m.sips))
+		for id := range m.sips {
+			ids = append(ids, id)
+		}
+		return ids
+	case batch.EdgeUploader:
+		if id := m.uploader; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BatchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedsips != nil {
+		edges = append(edges, batch.EdgeSips)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BatchMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case batch.EdgeSips:
+		ids := make([]ent.Value, 0, len(m.removedsips))
+		for id := range m.removedsips {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BatchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsips {
+		edges = append(edges, batch.EdgeSips)
+	}
+	if m.cleareduploader {
+		edges = append(edges, batch.EdgeUploader)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BatchMutation) EdgeCleared(name string) bool {
+	switch name {
+	case batch.EdgeSips:
+		return m.clearedsips
+	case batch.EdgeUploader:
+		return m.cleareduploader
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BatchMutation) ClearEdge(name string) error {
+	switch name {
+	case batch.EdgeUploader:
+		m.ClearUploader()
+		return nil
+	}
+	return fmt.Errorf("unknown Batch unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BatchMutation) ResetEdge(name string) error {
+	switch name {
+	case batch.EdgeSips:
+		m.ResetSips()
+		return nil
+	case batch.EdgeUploader:
+		m.ResetUploader()
+		return nil
+	}
+	return fmt.Errorf("unknown Batch edge %s", name)
+}
+
+// SIPMutation represents an operation that mutates the SIP nodes in the graph.
+type SIPMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	uuid               *uuid.UUID
+	name               *string
+	aip_id             *uuid.UUID
+	status             *enums.SIPStatus
+	created_at         *time.Time
+	started_at         *time.Time
+	completed_at       *time.Time
+	failed_as          *enums.SIPFailedAs
+	failed_key         *string
+	file_count         *int32
+	addfile_count      *int32
+	checksum_algorithm *string
+	checksum_hash      *string
+	processing_profile *string
+	custom_metadata    *map[string]jsontext.Value
+	sip_source_id      *uuid.UUID
+	location_id        *uuid.UUID
+	expected_checksum  *string
+	watcher_name       *string
+	clearedFields      map[string]struct{}
+	workflows          map[int]struct{}
+	removedworkflows   map[int]struct{}
+	clearedworkflows   bool
+	uploader           *int
+	cleareduploader    bool
+	batch              *int
+	clearedbatch       bool
+	done               bool
+	oldValue           func(context.Context) (*SIP, error)
+	predicates         []predicate.SIP
+}
+
+var _ ent.Mutation = (*SIPMutation)(nil)
+
+// sipOption allows management of the mutation configuration using functional options.
+type sipOption func(*SIPMutation)
+
+// newSIPMutation creates new mutation for the SIP entity.
+func newSIPMutation(c config, op Op, opts ...sipOption) *SIPMutation {
+	m := &SIPMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSIP,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSIPID sets the ID field of the mutation.
+func withSIPID(id int) sipOption {
+	return func(m *SIPMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SIP
+		)
+		m.oldValue = func(ctx context.Context) (*SIP, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SIP.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSIP sets the old SIP of the mutation.
+func withSIP(node *SIP) sipOption {
+	return func(m *SIPMutation) {
+		m.oldValue = func(context.Context) (*SIP, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SIPMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SIPMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SIPMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SIPMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SIP.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUUID sets the "uuid" field.
+func (m *SIPMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
+}
+
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *SIPMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUID returns the old "uuid" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	}
+	return oldValue.UUID, nil
+}
+
+// ResetUUID resets all changes to the "uuid" field.
+func (m *SIPMutation) ResetUUID() {
+	m.uuid = nil
+}
+
+// SetName sets the "name" field.
+func (m *SIPMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SIPMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SIPMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAipID sets the "aip_id" field.
+func (m *SIPMutation) SetAipID(u uuid.UUID) {
+	m.aip_id = &u
+}
+
+// AipID returns the value of the "aip_id" field in the mutation.
+func (m *SIPMutation) AipID() (r uuid.UUID, exists bool) {
+	v := m.aip_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAipID returns the old "aip_id" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldAipID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAipID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAipID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAipID: %w", err)
+	}
+	return oldValue.AipID, nil
+}
+
+// ClearAipID clears the value of the "aip_id" field.
+func (m *SIPMutation) ClearAipID() {
+	m.aip_id = nil
+	m.clearedFields[sip.FieldAipID] = struct{}{}
+}
+
+// AipIDCleared returns if the "aip_id" field was cleared in this mutation.
+func (m *SIPMutation) AipIDCleared() bool {
+	_, ok := m.clearedFields[sip.FieldAipID]
+	return ok
+}
+
+// ResetAipID resets all changes to the "aip_id" field.
+func (m *SIPMutation) ResetAipID() {
+	m.aip_id = nil
+	delete(m.clearedFields, sip.FieldAipID)
+}
+
+// SetStatus sets the "status" field.
+func (m *SIPMutation) SetStatus(es enums.SIPStatus) {
+	m.status = &es
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SIPMutation) Status() (r enums.SIPStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldStatus(ctx context.Context) (v enums.SIPStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SIPMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SIPMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SIPMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SIPMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *SIPMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SIPMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *SIPMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[sip.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *SIPMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[sip.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SIPMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, sip.FieldStartedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *SIPMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *SIPMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *SIPMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[sip.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *SIPMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[sip.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *SIPMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, sip.FieldCompletedAt)
+}
+
+// SetFailedAs sets the "failed_as" field.
+func (m *SIPMutation) SetFailedAs(efa enums.SIPFailedAs) {
+	m.failed_as = &efa
+}
+
+// FailedAs returns the value of the "failed_as" field in the mutation.
+func (m *SIPMutation) FailedAs() (r enums.SIPFailedAs, exists bool) {
+	v := m.failed_as
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedAs returns the old "failed_as" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldFailedAs(ctx context.Context) (v enums.SIPFailedAs, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedAs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedAs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedAs: %w", err)
+	}
+	return oldValue.FailedAs, nil
+}
+
+// ClearFailedAs clears the value of the "failed_as" field.
+func (m *SIPMutation) ClearFailedAs() {
+	m.failed_as = nil
+	m.clearedFields[sip.FieldFailedAs] = struct{}{}
+}
+
+// FailedAsCleared returns if the "failed_as" field was cleared in this mutation.
+func (m *SIPMutation) FailedAsCleared() bool {
+	_, ok := m.clearedFields[sip.FieldFailedAs]
+	return ok
+}
+
+// ResetFailedAs resets all changes to the "failed_as" field.
+func (m *SIPMutation) ResetFailedAs() {
+	m.failed_as = nil
+	delete(m.clearedFields, sip.FieldFailedAs)
+}
+
+// SetFailedKey sets the "failed_key" field.
+func (m *SIPMutation) SetFailedKey(s string) {
+	m.failed_key = &s
+}
+
+// FailedKey returns the value of the "failed_key" field in the mutation.
+func (m *SIPMutation) FailedKey() (r string, exists bool) {
+	v := m.failed_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedKey returns the old "failed_key" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldFailedKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedKey: %w", err)
+	}
+	return oldValue.FailedKey, nil
+}
+
+// ClearFailedKey clears the value of the "failed_key" field.
+func (m *SIPMutation) ClearFailedKey() {
+	m.failed_key = nil
+	m.clearedFields[sip.FieldFailedKey] = struct{}{}
+}
+
+// FailedKeyCleared returns if the "failed_key" field was cleared in this mutation.
+func (m *SIPMutation) FailedKeyCleared() bool {
+	_, ok := m.clearedFields[sip.FieldFailedKey]
+	return ok
+}
+
+// ResetFailedKey resets all changes to the "failed_key" field.
+func (m *SIPMutation) ResetFailedKey() {
+	m.failed_key = nil
+	delete(m.clearedFields, sip.FieldFailedKey)
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (m *SIPMutation) SetUploaderID(i int) {
+	m.uploader = &i
+}
+
+// UploaderID returns the value of the "uploader_id" field in the mutation.
+func (m *SIPMutation) UploaderID() (r int, exists bool) {
+	v := m.uploader
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploaderID returns the old "uploader_id" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldUploaderID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploaderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploaderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploaderID: %w", err)
+	}
+	return oldValue.UploaderID, nil
+}
+
+// ClearUploaderID clears the value of the "uploader_id" field.
+func (m *SIPMutation) ClearUploaderID() {
+	m.uploader = nil
+	m.clearedFields[sip.FieldUploaderID] = struct{}{}
+}
+
+// UploaderIDCleared returns if the "uploader_id" field was cleared in this mutation.
+func (m *SIPMutation) UploaderIDCleared() bool {
+	_, ok := m.clearedFields[sip.FieldUploaderID]
+	return ok
+}
+
+// ResetUploaderID resets all changes to the "uploader_id" field.
+func (m *SIPMutation) ResetUploaderID() {
+	m.uploader = nil
+	delete(m.clearedFields, sip.FieldUploaderID)
+}
+
+// SetBatchID sets the "batch_id" field.
+func (m *SIPMutation) SetBatchID(i int) {
+	m.batch = &i
+}
+
+// BatchID returns the value of the "batch_id" field in the mutation.
+func (m *SIPMutation) BatchID() (r int, exists bool) {
+	v := m.batch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBatchID returns the old "batch_id" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldBatchID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatchID: %w", err)
+	}
+	return oldValue.BatchID, nil
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (m *SIPMutation) ClearBatchID() {
+	m.batch = nil
+	m.clearedFields[sip.FieldBatchID] = struct{}{}
+}
+
+// BatchIDCleared returns if the "batch_id" field was cleared in this mutation.
+func (m *SIPMutation) BatchIDCleared() bool {
+	_, ok := m.clearedFields[sip.FieldBatchID]
+	return ok
+}
+
+// ResetBatchID resets all changes to the "batch_id" field.
+func (m *SIPMutation) ResetBatchID() {
+	m.batch = nil
+	delete(m.clearedFields, sip.FieldBatchID)
+}
+
+// SetFileCount sets the "file_count" field.
+func (m *SIPMutation) SetFileCount(i int32) {
+	m.file_count = &i
+	m.addfile_count = nil
+}
+
+// FileCount returns the value of the "file_count" field in the mutation.
+func (m *SIPMutation) FileCount() (r int32, exists bool) {
+	v := m.file_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileCount returns the old "file_count" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldFileCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileCount: %w", err)
+	}
+	return oldValue.FileCount, nil
+}
+
+// AddFileCount adds i to the "file_count" field.
+func (m *SIPMutation) AddFileCount(i int32) {
+	if m.addfile_count != nil {
+		*m.addfile_count += i
+	} else {
+		m.addfile_count = &i
+	}
+}
+
+// AddedFileCount returns the value that was added to the "file_count" field in this mutation.
+func (m *SIPMutation) AddedFileCount() (r int32, exists bool) {
+	v := m.addfile_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFileCount clears the value of the "file_count" field.
+func (m *SIPMutation) ClearFileCount() {
+	m.file_count = nil
+	m.addfile_count = nil
+	m.clearedFields[sip.FieldFileCount] = struct{}{}
+}
+
+// FileCountCleared returns if the "file_count" field was cleared in this mutation.
+func (m *SIPMutation) FileCountCleared() bool {
+	_, ok := m.clearedFields[sip.FieldFileCount]
+	return ok
+}
+
+// ResetFileCount resets all changes to the "file_count" field.
+func (m *SIPMutation) ResetFileCount() {
+	m.file_count = nil
+	m.addfile_count = nil
+	delete(m.clearedFields, sip.FieldFileCount)
+}
+
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (m *SIPMutation) SetChecksumAlgorithm(s string) {
+	m.checksum_algorithm = &s
+}
+
+// ChecksumAlgorithm returns the value of the "checksum_algorithm" field in the mutation.
+func (m *SIPMutation) ChecksumAlgorithm() (r string, exists bool) {
+	v := m.checksum_algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksumAlgorithm returns the old "checksum_algorithm" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldChecksumAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksumAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksumAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksumAlgorithm: %w", err)
+	}
+	return oldValue.ChecksumAlgorithm, nil
+}
+
+// ClearChecksumAlgorithm clears the value of the "checksum_algorithm" field.
+func (m *SIPMutation) ClearChecksumAlgorithm() {
+	m.checksum_algorithm = nil
+	m.clearedFields[sip.FieldChecksumAlgorithm] = struct{}{}
+}
+
+// ChecksumAlgorithmCleared returns if the "checksum_algorithm" field was cleared in this mutation.
+func (m *SIPMutation) ChecksumAlgorithmCleared() bool {
+	_, ok := m.clearedFields[sip.FieldChecksumAlgorithm]
+	return ok
+}
+
+// ResetChecksumAlgorithm resets all changes to the "checksum_algorithm" field.
+func (m *SIPMutation) ResetChecksumAlgorithm() {
+	m.checksum_algorithm = nil
+	delete(m.clearedFields, sip.FieldChecksumAlgorithm)
+}
+
+// SetChecksumHash sets the "checksum_hash" field.
+func (m *SIPMutation) SetChecksumHash(s string) {
+	m.checksum_hash = &s
+}
+
+// ChecksumHash returns the value of the "checksum_hash" field in the mutation.
+func (m *SIPMutation) ChecksumHash() (r string, exists bool) {
+	v := m.checksum_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksumHash returns the old "checksum_hash" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldChecksumHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksumHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksumHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksumHash: %w", err)
+	}
+	return oldValue.ChecksumHash, nil
+}
+
+// ClearChecksumHash clears the value of the "checksum_hash" field.
+func (m *SIPMutation) ClearChecksumHash() {
+	m.checksum_hash = nil
+	m.clearedFields[sip.FieldChecksumHash] = struct{}{}
+}
+
+// ChecksumHashCleared returns if the "checksum_hash" field was cleared in this mutation.
+func (m *SIPMutation) ChecksumHashCleared() bool {
+	_, ok := m.clearedFields[sip.FieldChecksumHash]
+	return ok
+}
+
+// ResetChecksumHash resets all changes to the "checksum_hash" field.
+func (m *SIPMutation) ResetChecksumHash() {
+	m.checksum_hash = nil
+	delete(m.clearedFields, sip.FieldChecksumHash)
+}
+
+// SetProcessingProfile sets the "processing_profile" field.
+func (m *SIPMutation) SetProcessingProfile(s string) {
+	m.processing_profile = &s
+}
+
+// ProcessingProfile returns the value of the "processing_profile" field in the mutation.
+func (m *SIPMutation) ProcessingProfile() (r string, exists bool) {
+	v := m.processing_profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessingProfile returns the old "processing_profile" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldProcessingProfile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessingProfile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessingProfile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessingProfile: %w", err)
+	}
+	return oldValue.ProcessingProfile, nil
+}
+
+// ClearProcessingProfile clears the value of the "processing_profile" field.
+func (m *SIPMutation) ClearProcessingProfile() {
+	m.processing_profile = nil
+	m.clearedFields[sip.FieldProcessingProfile] = struct{}{}
+}
+
+// ProcessingProfileCleared returns if the "processing_profile" field was cleared in this mutation.
+func (m *SIPMutation) ProcessingProfileCleared() bool {
+	_, ok := m.clearedFields[sip.FieldProcessingProfile]
+	return ok
+}
+
+// ResetProcessingProfile resets all changes to the "processing_profile" field.
+func (m *SIPMutation) ResetProcessingProfile() {
+	m.processing_profile = nil
+	delete(m.clearedFields, sip.FieldProcessingProfile)
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (m *SIPMutation) SetCustomMetadata(value map[string]jsontext.Value) {
+	m.custom_metadata = &value
+}
+
+// CustomMetadata returns the value of the "custom_metadata" field in the mutation.
+func (m *SIPMutation) CustomMetadata() (r map[string]jsontext.Value, exists bool) {
+	v := m.custom_metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCustomMetadata returns the old "custom_metadata" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldCustomMetadata(ctx context.Context) (v map[string]jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCustomMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCustomMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCustomMetadata: %w", err)
+	}
+	return oldValue.CustomMetadata, nil
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (m *SIPMutation) ClearCustomMetadata() {
+	m.custom_metadata = nil
+	m.clearedFields[sip.FieldCustomMetadata] = struct{}{}
+}
+
+// CustomMetadataCleared returns if the "custom_metadata" field was cleared in this mutation.
+func (m *SIPMutation) CustomMetadataCleared() bool {
+	_, ok := m.clearedFields[sip.FieldCustomMetadata]
+	return ok
+}
+
+// ResetCustomMetadata resets all changes to the "custom_metadata" field.
+func (m *SIPMutation) ResetCustomMetadata() {
+	m.custom_metadata = nil
+	delete(m.clearedFields, sip.FieldCustomMetadata)
+}
+
+// SetSipSourceID sets the "sip_source_id" field.
+func (m *SIPMutation) SetSipSourceID(u uuid.UUID) {
+	m.sip_source_id = &u
+}
+
+// SipSourceID returns the value of the "sip_source_id" field in the mutation.
+func (m *SIPMutation) SipSourceID() (r uuid.UUID, exists bool) {
+	v := m.sip_source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSipSourceID returns the old "sip_source_id" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldSipSourceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSipSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSipSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSipSourceID: %w", err)
+	}
+	return oldValue.SipSourceID, nil
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (m *SIPMutation) ClearSipSourceID() {
+	m.sip_source_id = nil
+	m.clearedFields[sip.FieldSipSourceID] = struct{}{}
+}
+
+// SipSourceIDCleared returns if the "sip_source_id" field was cleared in this mutation.
+func (m *SIPMutation) SipSourceIDCleared() bool {
+	_, ok := m.clearedFields[sip.FieldSipSourceID]
+	return ok
+}
+
+// ResetSipSourceID resets all changes to the "sip_source_id" field.
+func (m *SIPMutation) ResetSipSourceID() {
+	m.sip_source_id = nil
+	delete(m.clearedFields, sip.FieldSipSourceID)
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *SIPMutation) SetLocationID(u uuid.UUID) {
+	m.location_id = &u
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *SIPMutation) LocationID() (r uuid.UUID, exists bool) {
+	v := m.location_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldLocationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *SIPMutation) ClearLocationID() {
+	m.location_id = nil
+	m.clearedFields[sip.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *SIPMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[sip.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *SIPMutation) ResetLocationID() {
+	m.location_id = nil
+	delete(m.clearedFields, sip.FieldLocationID)
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (m *SIPMutation) SetExpectedChecksum(s string) {
+	m.expected_checksum = &s
+}
+
+// ExpectedChecksum returns the value of the "expected_checksum" field in the mutation.
+func (m *SIPMutation) ExpectedChecksum() (r string, exists bool) {
+	v := m.expected_checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpectedChecksum returns the old "expected_checksum" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldExpectedChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedChecksum: %w", err)
+	}
+	return oldValue.ExpectedChecksum, nil
+}
+
+// ClearExpectedChecksum clears the value of the "expected_checksum" field.
+func (m *SIPMutation) ClearExpectedChecksum() {
+	m.expected_checksum = nil
+	m.clearedFields[sip.FieldExpectedChecksum] = struct{}{}
+}
+
+// ExpectedChecksumCleared returns if the "expected_checksum" field was cleared in this mutation.
+func (m *SIPMutation) ExpectedChecksumCleared() bool {
+	_, ok := m.clearedFields[sip.FieldExpectedChecksum]
+	return ok
+}
+
+// ResetExpectedChecksum resets all changes to the "expected_checksum" field.
+func (m *SIPMutation) ResetExpectedChecksum() {
+	m.expected_checksum = nil
+	delete(m.clearedFields, sip.FieldExpectedChecksum)
+}
+
+// SetWatcherName sets the "watcher_name" field.
+func (m *SIPMutation) SetWatcherName(s string) {
+	m.watcher_name = &s
+}
+
+// WatcherName returns the value of the "watcher_name" field in the mutation.
+func (m *SIPMutation) WatcherName() (r string, exists bool) {
+	v := m.watcher_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWatcherName returns the old "watcher_name" field's value of the SIP entity.
+// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SIPMutation) OldWatcherName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWatcherName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWatcherName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWatcherName: %w", err)
+	}
+	return oldValue.WatcherName, nil
+}
+
+// ClearWatcherName clears the value of the "watcher_name" field.
+func (m *SIPMutation) ClearWatcherName() {
+	m.watcher_name = nil
+	m.clearedFields[sip.FieldWatcherName] = struct{}{}
+}
+
+// WatcherNameCleared returns if the "watcher_name" field was cleared in this mutation.
+func (m *SIPMutation) WatcherNameCleared() bool {
+	_, ok := m.clearedFields[sip.FieldWatcherName]
+	return ok
+}
+
+// ResetWatcherName resets all changes to the "watcher_name" field.
+func (m *SIPMutation) ResetWatcherName() {
+	m.watcher_name = nil
+	delete(m.clearedFields, sip.FieldWatcherName)
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the Workflow entity by ids.
+func (m *SIPMutation) AddWorkflowIDs(ids ...int) {
+	if m.workflows == nil {
+		m.workflows = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.workflows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWorkflows clears the "workflows" edge to the Workflow entity.
+func (m *SIPMutation) ClearWorkflows() {
+	m.clearedworkflows = true
+}
+
+// WorkflowsCleared reports if the "workflows" edge to the Workflow entity was cleared.
+func (m *SIPMutation) WorkflowsCleared() bool {
+	return m.clearedworkflows
+}
+
+// RemoveWorkflowIDs removes the "workflows" edge to the Workflow entity by IDs.
+func (m *SIPMutation) RemoveWorkflowIDs(ids ...int) {
+	if m.removedworkflows == nil {
+		m.removedworkflows = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.workflows, ids[i])
+		m.removedworkflows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWorkflows returns the removed IDs of the "workflows" edge to the Workflow entity.
+func (m *SIPMutation) RemovedWorkflowsIDs() (ids []int) {
+	for id := range m.removedworkflows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WorkflowsIDs returns the "workflows" edge IDs in the mutation.
+func (m *SIPMutation) WorkflowsIDs() (ids []int) {
+	for id := range m.workflows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWorkflows resets all changes to the "workflows" edge.
+func (m *SIPMutation) ResetWorkflows() {
+	m.workflows = nil
+	m.clearedworkflows = false
+	m.removedworkflows = nil
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (m *SIPMutation) ClearUploader() {
+	m.cleareduploader = true
+	m.clearedFields[sip.FieldUploaderID] = struct{}{}
+}
+
+// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
+func (m *SIPMutation) UploaderCleared() bool {
+	return m.UploaderIDCleared() || m.cleareduploader
+}
+
+// UploaderIDs returns the "uploader" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UploaderID instead. It exists only for internal usage by the builders.
+func (m *SIPMutation) UploaderIDs() (ids []int) {
+	if id := m.uploader; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUploader resets all changes to the "uploader" edge.
+func (m *SIPMutation) ResetUploader() {
+	m.uploader = nil
+	m.cleareduploader = false
+}
+
+// ClearBatch clears the "batch" edge to the Batch entity.
+func (m *SIPMutation) ClearBatch() {
+	m.clearedbatch = true
+	m.clearedFields[sip.FieldBatchID] = struct{}{}
+}
+
+// BatchCleared reports if the "batch" edge to the Batch entity was cleared.
+func (m *SIPMutation) BatchCleared() bool {
+	return m.BatchIDCleared() || m.clearedbatch
+}
+
+// BatchIDs returns the "batch" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BatchID instead. It exists only for internal usage by the builders.
+func (m *SIPMutation) BatchIDs() (ids []int) {
+	if id := m.batch; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBatch resets all changes to the "batch" edge.
+func (m *SIPMutation) ResetBatch() {
+	m.batch = nil
+	m.clearedbatch = false
+}
+
+// Where appends a list predicates to the SIPMutation builder.
+func (m *SIPMutation) Where(ps ...predicate.SIP) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SIPMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SIPMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SIP, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SIPMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SIPMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SIP).
+func (m *SIPMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SIPMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.uuid != nil {
+		fields = append(fields, sip.FieldUUID)
+	}
+	if m.name != nil {
+		fields = append(fields, sip.FieldName)
+	}
+	if m.aip_id != nil {
+		fields = append(fields, sip.FieldAipID)
+	}
+	if m.status != nil {
+		fields = append(fields, sip.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, sip.FieldCreatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, sip.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, sip.FieldCompletedAt)
+	}
+	if m.failed_as != nil {
+		fields = append(fields, sip.FieldFailedAs)
+	}
+	if m.failed_key != nil {
+		fields = append(fields, sip.FieldFailedKey)
+	}
+	if m.uploader != nil {
+		fields = append(fields, sip.FieldUploaderID)
+	}
+	if m.batch != nil {
+		fields = append(fields, sip.FieldBatchID)
+	}
+	if m.file_count != nil {
+		fields = append(fields, sip.FieldFileCount)
+	}
+	if m.checksum_algorithm != nil {
+		fields = append(fields, sip.FieldChecksumAlgorithm)
+	}
+	if m.checksum_hash != nil {
+		fields = append(fields, sip.FieldChecksumHash)
+	}
+	if m.processing_profile != nil {
+		fields = append(fields, sip.FieldProcessingProfile)
+	}
+	if m.custom_metadata != nil {
+		fields = append(fields, sip.FieldCustomMetadata)
+	}
+	if m.sip_source_id != nil {
+		fields = append(fields, sip.FieldSipSourceID)
+	}
+	if m.location_id != nil {
+		fields = append(fields, sip.FieldLocationID)
+	}
+	if m.expected_checksum != nil {
+		fields = append(fields, sip.FieldExpectedChecksum)
+	}
+	if m.watcher_name != nil {
+		fields = append(fields, sip.FieldWatcherName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SIPMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sip.FieldUUID:
+		return m.UUID()
+	case sip.FieldName:
+		return m.Name()
+	case sip.FieldAipID:
+		return m.AipID()
+	case sip.FieldStatus:
+		return m.Status()
+	case sip.FieldCreatedAt:
+		return m.CreatedAt()
+	case sip.FieldStartedAt:
+		return m.StartedAt()
+	case sip.FieldCompletedAt:
+		return m.CompletedAt()
+	case sip.FieldFailedAs:
+		return m.FailedAs()
+	case sip.FieldFailedKey:
+		return m.FailedKey()
+	case sip.FieldUploaderID:
+		return m.UploaderID()
+	case sip.FieldBatchID:
+		return m.BatchID()
+	case sip.FieldFileCount:
+		return m.FileCount()
+	case sip.FieldChecksumAlgorithm:
+		return m.ChecksumAlgorithm()
+	case sip.FieldChecksumHash:
+		return m.ChecksumHash()
+	case sip.FieldProcessingProfile:
+		return m.ProcessingProfile()
+	case sip.FieldCustomMetadata:
+		return m.CustomMetadata()
+	case sip.FieldSipSourceID:
+		return m.SipSourceID()
+	case sip.FieldLocationID:
+		return m.LocationID()
+	case sip.FieldExpectedChecksum:
+		return m.ExpectedChecksum()
+	case sip.FieldWatcherName:
+		return m.WatcherName()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SIPMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sip.FieldUUID:
+		return m.OldUUID(ctx)
+	case sip.FieldName:
+		return m.OldName(ctx)
+	case sip.FieldAipID:
+		return m.OldAipID(ctx)
+	case sip.FieldStatus:
+		return m.OldStatus(ctx)
+	case sip.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sip.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case sip.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case sip.FieldFailedAs:
+		return m.OldFailedAs(ctx)
+	case sip.FieldFailedKey:
+		return m.OldFailedKey(ctx)
+	case sip.FieldUploaderID:
+		return m.OldUploaderID(ctx)
+	case sip.FieldBatchID:
+		return m.OldBatchID(ctx)
+	case sip.FieldFileCount:
+		return m.OldFileCount(ctx)
+	case sip.FieldChecksumAlgorithm:
+		return m.OldChecksumAlgorithm(ctx)
+	case sip.FieldChecksumHash:
+		return m.OldChecksumHash(ctx)
+	case sip.FieldProcessingProfile:
+		return m.OldProcessingProfile(ctx)
+	case sip.FieldCustomMetadata:
+		return m.OldCustomMetadata(ctx)
+	case sip.FieldSipSourceID:
+		return m.OldSipSourceID(ctx)
+	case sip.FieldLocationID:
+		return m.OldLocationID(ctx)
+	case sip.FieldExpectedChecksum:
+		return m.OldExpectedChecksum(ctx)
+	case sip.FieldWatcherName:
+		return m.OldWatcherName(ctx)
+	}
+	return nil, fmt.Errorf("unknown SIP field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SIPMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sip.FieldUUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUID(v)
+		return nil
+	case sip.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sip.FieldAipID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAipID(v)
+		return nil
+	case sip.FieldStatus:
+		v, ok := value.(enums.SIPStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case sip.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sip.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case sip.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case sip.FieldFailedAs:
+		v, ok := value.(enums.SIPFailedAs)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedAs(v)
+		return nil
+	case sip.FieldFailedKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedKey(v)
+		return nil
+	case sip.FieldUploaderID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploaderID(v)
+		return nil
+	case sip.FieldBatchID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatchID(v)
+		return nil
+	case sip.FieldFileCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileCount(v)
+		return nil
+	case sip.FieldChecksumAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksumAlgorithm(v)
+		return nil
+	case sip.FieldChecksumHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksumHash(v)
+		return nil
+	case sip.FieldProcessingProfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessingProfile(v)
+		return nil
+	case sip.FieldCustomMetadata:
+		v, ok := value.(map[string]jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomMetadata(v)
+		return nil
+	case sip.FieldSipSourceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSipSourceID(v)
+		return nil
+	case sip.FieldLocationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	case sip.FieldExpectedChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedChecksum(v)
+		return nil
+	case sip.FieldWatcherName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWatcherName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SIP field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SIPMutation) AddedFields() []string {
+	var fields []string
+	if m.addfile_count != nil {
+		fields = append(fields, sip.FieldFileCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SIPMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sip.FieldFileCount:
+		return m.AddedFileCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SIPMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sip.FieldFileCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SIP numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SIPMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sip.FieldAipID) {
+		fields = append(fields, sip.FieldAipID)
+	}
+	if m.FieldCleared(sip.FieldStartedAt) {
+		fields = append(fields, sip.FieldStartedAt)
+	}
+	if m.FieldCleared(sip.FieldCompletedAt) {
+		fields = append(fields, sip.FieldCompletedAt)
+	}
+	if m.FieldCleared(sip.FieldFailedAs) {
+		fields = append(fields, sip.FieldFailedAs)
+	}
+	if m.FieldCleared(sip.FieldFailedKey) {
+		fields = append(fields, sip.FieldFailedKey)
+	}
+	if m.FieldCleared(sip.FieldUploaderID) {
+		fields = append(fields, sip.FieldUploaderID)
+	}
+	if m.FieldCleared(sip.FieldBatchID) {
+		fields = append(fields, sip.FieldBatchID)
+	}
+	if m.FieldCleared(sip.FieldFileCount) {
+		fields = append(fields, sip.FieldFileCount)
+	}
+	if m.FieldCleared(sip.FieldChecksumAlgorithm) {
+		fields = append(fields, sip.FieldChecksumAlgorithm)
+	}
+	if m.FieldCleared(sip.FieldChecksumHash) {
+		fields = append(fields, sip.FieldChecksumHash)
+	}
+	if m.FieldCleared(sip.FieldProcessingProfile) {
+		fields = append(fields, sip.FieldProcessingProfile)
+	}
+	if m.FieldCleared(sip.FieldCustomMetadata) {
+		fields = append(fields, sip.FieldCustomMetadata)
+	}
+	if m.FieldCleared(sip.FieldSipSourceID) {
+		fields = append(fields, sip.FieldSipSourceID)
+	}
+	if m.FieldCleared(sip.FieldLocationID) {
+		fields = append(fields, sip.FieldLocationID)
+	}
+	if m.FieldCleared(sip.FieldExpectedChecksum) {
+		fields = append(fields, sip.FieldExpectedChecksum)
+	}
+	if m.FieldCleared(sip.FieldWatcherName) {
+		fields = append(fields, sip.FieldWatcherName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SIPMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SIPMutation) ClearField(name string) error {
+	switch name {
+	case sip.FieldAipID:
+		m.ClearAipID()
+		return nil
+	case sip.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case sip.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case sip.FieldFailedAs:
+		m.ClearFailedAs()
+		return nil
+	case sip.FieldFailedKey:
+		m.ClearFailedKey()
+		return nil
+	case sip.FieldUploaderID:
+		m.ClearUploaderID()
+		return nil
+	case sip.FieldBatchID:
+		m.ClearBatchID()
+		return nil
+	case sip.FieldFileCount:
+		m.ClearFileCount()
+		return nil
+	case sip.FieldChecksumAlgorithm:
+		m.ClearChecksumAlgorithm()
+		return nil
+	case sip.FieldChecksumHash:
+		m.ClearChecksumHash()
+		return nil
+	case sip.FieldProcessingProfile:
+		m.ClearProcessingProfile()
+		return nil
+	case sip.FieldCustomMetadata:
+		m.ClearCustomMetadata()
+		return nil
+	case sip.FieldSipSourceID:
+		m.ClearSipSourceID()
+		return nil
+	case sip.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	case sip.FieldExpectedChecksum:
+		m.ClearExpectedChecksum()
+		return nil
+	case sip.FieldWatcherName:
+		m.ClearWatcherName()
+		return nil
+	}
+	return fmt.Errorf("unknown SIP nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SIPMutation) ResetField(name string) error {
+	switch name {
+	case sip.FieldUUID:
+		m.ResetUUID()
+		return nil
+	case sip.FieldName:
+		m.ResetName()
+		return nil
+	case sip.FieldAipID:
+		m.ResetAipID()
+		return nil
+	case sip.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case sip.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sip.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case sip.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case sip.FieldFailedAs:
+		m.ResetFailedAs()
+		return nil
+	case sip.FieldFailedKey:
+		m.ResetFailedKey()
+		return nil
+	case sip.FieldUploaderID:
+		m.ResetUploaderID()
+		return nil
+	case sip.FieldBatchID:
+		m.ResetBatchID()
+		return nil
+	case sip.FieldFileCount:
+		m.ResetFileCount()
+		return nil
+	case sip.FieldChecksumAlgorithm:
+		m.ResetChecksumAlgorithm()
+		return nil
+	case sip.FieldChecksumHash:
+		m.ResetChecksumHash()
+		return nil
+	case sip.FieldProcessingProfile:
+		m.ResetProcessingProfile()
+		return nil
+	case sip.FieldCustomMetadata:
+		m.ResetCustomMetadata()
+		return nil
+	case sip.FieldSipSourceID:
+		m.ResetSipSourceID()
+		return nil
+	case sip.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	case sip.FieldExpectedChecksum:
+		m.ResetExpectedChecksum()
+		return nil
+	case sip.FieldWatcherName:
+		m.ResetWatcherName()
+		return nil
+	}
+	return fmt.Errorf("unknown SIP field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SIPMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workflows != nil {
+		edges = append(edges, sip.EdgeWorkflows)
+	}
+	if m.uploader != nil {
+		edges = append(edges, sip.EdgeUploader)
+	}
+	if m.batch != nil {
+		edges = append(edges, sip.EdgeBatch)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SIPMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sip.EdgeWorkflows:
+		ids := make([]ent.Value, 0, len(m.workflows))
+		for id := range m.workflows {
+			ids = append(ids, id)
+		}
+		return ids
+	case sip.EdgeUploader:
+		if id := m.uploader; id != nil {
+			return []ent.Value{*id}
+		}
+	case sip.EdgeBatch:
+		if id := m.batch; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SIPMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedworkflows != nil {
+		edges = append(edges, sip.EdgeWorkflows)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SIPMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sip.EdgeWorkflows:
+		ids := make([]ent.Value, 0, len(m.removedworkflows))
+		for id := range m.removedworkflows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SIPMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkflows {
+		edges = append(edges, sip.EdgeWorkflows)
 	}
-	return oldValue.FileCount, nil
+	if m.cleareduploader {
+		edges = append(edges, sip.EdgeUploader)
+	}
+	if m.clearedbatch {
+		edges = append(edges, sip.EdgeBatch)
+	}
+	return edges
 }
 
-// AddFileCount adds i to the "file_count" field.
-func (m *SIPMutation) AddFileCount(i int32) {
-	if m.addfile_count != nil {
-		*m.addfile_count += i
-	} else {
-		m.addfile_count = &i
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SIPMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sip.EdgeWorkflows:
+		return m.clearedworkflows
+	case sip.EdgeUploader:
+		return m.cleareduploader
+	case sip.EdgeBatch:
+		return m.clearedbatch
 	}
+	return false
 }
 
-// AddedFileCount returns the value that was added to the "file_count" field in this mutation.
-func (m *SIPMutation) AddedFileCount() (r int32, exists bool) {
-	v := m.addfile_count
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SIPMutation) ClearEdge(name string) error {
+	switch name {
+	case sip.EdgeUploader:
+		m.ClearUploader()
+		return nil
+	case sip.EdgeBatch:
+		m.ClearBatch()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown SIP unique edge %s", name)
 }
 
-// ClearFileCount clears the value of the "file_count" field.
-func (m *SIPMutation) ClearFileCount() {
-	m.file_count = nil
-	m.addfile_count = nil
-	m.clearedFields[sip.FieldFileCount] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SIPMutation) ResetEdge(name string) error {
+	switch name {
+	case sip.EdgeWorkflows:
+		m.ResetWorkflows()
+		return nil
+	case sip.EdgeUploader:
+		m.ResetUploader()
+		return nil
+	case sip.EdgeBatch:
+		m.ResetBatch()
+		return nil
+	}
+	return fmt.Errorf("unknown SIP edge %s", name)
 }
 
-// FileCountCleared returns if the "file_count" field was cleared in this mutation.
-func (m *SIPMutation) FileCountCleared() bool {
-	_, ok := m.clearedFields[sip.FieldFileCount]
-	return ok
+// SearchDocumentMutation represents an operation that mutates the SearchDocument nodes in the graph.
+type SearchDocumentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	uuid          *uuid.UUID
+	name          *string
+	content       *string
+	fields        *map[string]string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SearchDocument, error)
+	predicates    []predicate.SearchDocument
 }
 
-// ResetFileCount resets all changes to the "file_count" field.
-func (m *SIPMutation) ResetFileCount() {
-	m.file_count = nil
-	m.addfile_count = nil
-	delete(m.clearedFields, sip.FieldFileCount)
-}
+var _ ent.Mutation = (*SearchDocumentMutation)(nil)
 
-// SetChecksumAlgorithm sets the "checksum_algorithm" field.
-func (m *SIPMutation) SetChecksumAlgorithm(s string) {
-	m.checksum_algorithm = &s
-}
+// searchdocumentOption allows management of the mutation configuration using functional options.
+type searchdocumentOption func(*SearchDocumentMutation)
 
-// ChecksumAlgorithm returns the value of the "checksum_algorithm" field in the mutation.
-func (m *SIPMutation) ChecksumAlgorithm() (r string, exists bool) {
-	v := m.checksum_algorithm
-	if v == nil {
-		return
+// newSearchDocumentMutation creates new mutation for the SearchDocument entity.
+func newSearchDocumentMutation(c config, op Op, opts ...searchdocumentOption) *SearchDocumentMutation {
+	m := &SearchDocumentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSearchDocument,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldChecksumAlgorithm returns the old "checksum_algorithm" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldChecksumAlgorithm(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecksumAlgorithm is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecksumAlgorithm requires an ID field in the mutation")
+// withSearchDocumentID sets the ID field of the mutation.
+func withSearchDocumentID(id int) searchdocumentOption {
+	return func(m *SearchDocumentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SearchDocument
+		)
+		m.oldValue = func(ctx context.Context) (*SearchDocument, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SearchDocument.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecksumAlgorithm: %w", err)
+}
+
+// withSearchDocument sets the old SearchDocument of the mutation.
+func withSearchDocument(node *SearchDocument) searchdocumentOption {
+	return func(m *SearchDocumentMutation) {
+		m.oldValue = func(context.Context) (*SearchDocument, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.ChecksumAlgorithm, nil
 }
 
-// ClearChecksumAlgorithm clears the value of the "checksum_algorithm" field.
-func (m *SIPMutation) ClearChecksumAlgorithm() {
-	m.checksum_algorithm = nil
-	m.clearedFields[sip.FieldChecksumAlgorithm] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SearchDocumentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ChecksumAlgorithmCleared returns if the "checksum_algorithm" field was cleared in this mutation.
-func (m *SIPMutation) ChecksumAlgorithmCleared() bool {
-	_, ok := m.clearedFields[sip.FieldChecksumAlgorithm]
-	return ok
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SearchDocumentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetChecksumAlgorithm resets all changes to the "checksum_algorithm" field.
-func (m *SIPMutation) ResetChecksumAlgorithm() {
-	m.checksum_algorithm = nil
-	delete(m.clearedFields, sip.FieldChecksumAlgorithm)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SearchDocumentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetChecksumHash sets the "checksum_hash" field.
-func (m *SIPMutation) SetChecksumHash(s string) {
-	m.checksum_hash = &s
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SearchDocumentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SearchDocument.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// ChecksumHash returns the value of the "checksum_hash" field in the mutation.
-func (m *SIPMutation) ChecksumHash() (r string, exists bool) {
-	v := m.checksum_hash
+// SetUUID sets the "uuid" field.
+func (m *SearchDocumentMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
+}
+
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *SearchDocumentMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChecksumHash returns the old "checksum_hash" field's value of the SIP entity.
-// If the SIP object wasn't provided to the builder, the object is fetched from the database.
+// OldUUID returns the old "uuid" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SIPMutation) OldChecksumHash(ctx context.Context) (v string, err error) {
+func (m *SearchDocumentMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecksumHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecksumHash requires an ID field in the mutation")
+		return v, errors.New("OldUUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecksumHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
 	}
-	return oldValue.ChecksumHash, nil
+	return oldValue.UUID, nil
 }
 
-// ClearChecksumHash clears the value of the "checksum_hash" field.
-func (m *SIPMutation) ClearChecksumHash() {
-	m.checksum_hash = nil
-	m.clearedFields[sip.FieldChecksumHash] = struct{}{}
+// ResetUUID resets all changes to the "uuid" field.
+func (m *SearchDocumentMutation) ResetUUID() {
+	m.uuid = nil
 }
 
-// ChecksumHashCleared returns if the "checksum_hash" field was cleared in this mutation.
-func (m *SIPMutation) ChecksumHashCleared() bool {
-	_, ok := m.clearedFields[sip.FieldChecksumHash]
-	return ok
+// SetName sets the "name" field.
+func (m *SearchDocumentMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetChecksumHash resets all changes to the "checksum_hash" field.
-func (m *SIPMutation) ResetChecksumHash() {
-	m.checksum_hash = nil
-	delete(m.clearedFields, sip.FieldChecksumHash)
+// Name returns the value of the "name" field in the mutation.
+func (m *SearchDocumentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddWorkflowIDs adds the "workflows" edge to the Workflow entity by ids.
-func (m *SIPMutation) AddWorkflowIDs(ids ...int) {
-	if m.workflows == nil {
-		m.workflows = make(map[int]struct{})
+// OldName returns the old "name" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.workflows[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ClearWorkflows clears the "workflows" edge to the Workflow entity.
-func (m *SIPMutation) ClearWorkflows() {
-	m.clearedworkflows = true
-}
-
-// WorkflowsCleared reports if the "workflows" edge to the Workflow entity was cleared.
-func (m *SIPMutation) WorkflowsCleared() bool {
-	return m.clearedworkflows
+// ResetName resets all changes to the "name" field.
+func (m *SearchDocumentMutation) ResetName() {
+	m.name = nil
 }
-
-// RemoveWorkflowIDs removes the "workflows" edge to the Workflow entity by IDs.
-func (m *SIPMutation) RemoveWorkflowIDs(ids ...int) {
-	if m.removedworkflows == nil {
-		m.removedworkflows = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.workflows, ids[i])
-		m.removedworkflows[ids[i]] = struct{}{}
-	}
+
+// SetContent sets the "content" field.
+func (m *SearchDocumentMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// RemovedWorkflows returns the removed IDs of the "workflows" edge to the Workflow entity.
-func (m *SIPMutation) RemovedWorkflowsIDs() (ids []int) {
-	for id := range m.removedworkflows {
-		ids = append(ids, id)
+// Content returns the value of the "content" field in the mutation.
+func (m *SearchDocumentMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// WorkflowsIDs returns the "workflows" edge IDs in the mutation.
-func (m *SIPMutation) WorkflowsIDs() (ids []int) {
-	for id := range m.workflows {
-		ids = append(ids, id)
+// OldContent returns the old "content" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// ResetWorkflows resets all changes to the "workflows" edge.
-func (m *SIPMutation) ResetWorkflows() {
-	m.workflows = nil
-	m.clearedworkflows = false
-	m.removedworkflows = nil
+// ResetContent resets all changes to the "content" field.
+func (m *SearchDocumentMutation) ResetContent() {
+	m.content = nil
 }
 
-// ClearUploader clears the "uploader" edge to the User entity.
-func (m *SIPMutation) ClearUploader() {
-	m.cleareduploader = true
-	m.clearedFields[sip.FieldUploaderID] = struct{}{}
+// SetFields sets the "fields" field.
+func (m *SearchDocumentMutation) SetFields(value map[string]string) {
+	m.fields = &value
 }
 
-// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
-func (m *SIPMutation) UploaderCleared() bool {
-	return m.UploaderIDCleared() || m.cleareduploader
+// GetFields returns the value of the "fields" field in the mutation.
+func (m *SearchDocumentMutation) GetFields() (r map[string]string, exists bool) {
+	v := m.fields
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UploaderIDs returns the "uploader" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UploaderID instead. It exists only for internal usage by the builders.
-func (m *SIPMutation) UploaderIDs() (ids []int) {
-	if id := m.uploader; id != nil {
-		ids = append(ids, *id)
+// OldFields returns the old "fields" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldFields(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFields is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFields requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFields: %w", err)
+	}
+	return oldValue.Fields, nil
 }
 
-// ResetUploader resets all changes to the "uploader" edge.
-func (m *SIPMutation) ResetUploader() {
-	m.uploader = nil
-	m.cleareduploader = false
+// ClearFields clears the value of the "fields" field.
+func (m *SearchDocumentMutation) ClearFields() {
+	m.fields = nil
+	m.clearedFields[searchdocument.FieldFields] = struct{}{}
 }
 
-// ClearBatch clears the "batch" edge to the Batch entity.
-func (m *SIPMutation) ClearBatch() {
-	m.clearedbatch = true
-	m.clearedFields[sip.FieldBatchID] = struct{}{}
+// FieldsCleared returns if the "fields" field was cleared in this mutation.
+func (m *SearchDocumentMutation) FieldsCleared() bool {
+	_, ok := m.clearedFields[searchdocument.FieldFields]
+	return ok
 }
 
-// BatchCleared reports if the "batch" edge to the Batch entity was cleared.
-func (m *SIPMutation) BatchCleared() bool {
-	return m.BatchIDCleared() || m.clearedbatch
+// ResetFields resets all changes to the "fields" field.
+func (m *SearchDocumentMutation) ResetFields() {
+	m.fields = nil
+	delete(m.clearedFields, searchdocument.FieldFields)
 }
 
-// BatchIDs returns the "batch" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BatchID instead. It exists only for internal usage by the builders.
-func (m *SIPMutation) BatchIDs() (ids []int) {
-	if id := m.batch; id != nil {
-		ids = append(ids, *id)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SearchDocumentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SearchDocumentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetBatch resets all changes to the "batch" edge.
-func (m *SIPMutation) ResetBatch() {
-	m.batch = nil
-	m.clearedbatch = false
+// OldUpdatedAt returns the old "updated_at" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// Where appends a list predicates to the SIPMutation builder.
-func (m *SIPMutation) Where(ps ...predicate.SIP) {
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SearchDocumentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SearchDocumentMutation builder.
+func (m *SearchDocumentMutation) Where(ps ...predicate.SearchDocument) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SIPMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SearchDocumentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SIPMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SIP, len(ps))
+func (m *SearchDocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchDocument, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1885,66 +4170,39 @@ func (m *SIPMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SIPMutation) Op() Op {
+func (m *SearchDocumentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SIPMutation) SetOp(op Op) {
+func (m *SearchDocumentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SIP).
-func (m *SIPMutation) Type() string {
+// Type returns the node type of this mutation (SearchDocument).
+func (m *SearchDocumentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SIPMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *SearchDocumentMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.uuid != nil {
-		fields = append(fields, sip.FieldUUID)
+		fields = append(fields, searchdocument.FieldUUID)
 	}
 	if m.name != nil {
-		fields = append(fields, sip.FieldName)
-	}
-	if m.aip_id != nil {
-		fields = append(fields, sip.FieldAipID)
-	}
-	if m.status != nil {
-		fields = append(fields, sip.FieldStatus)
-	}
-	if m.created_at != nil {
-		fields = append(fields, sip.FieldCreatedAt)
-	}
-	if m.started_at != nil {
-		fields = append(fields, sip.FieldStartedAt)
-	}
-	if m.completed_at != nil {
-		fields = append(fields, sip.FieldCompletedAt)
-	}
-	if m.failed_as != nil {
-		fields = append(fields, sip.FieldFailedAs)
-	}
-	if m.failed_key != nil {
-		fields = append(fields, sip.FieldFailedKey)
-	}
-	if m.uploader != nil {
-		fields = append(fields, sip.FieldUploaderID)
-	}
-	if m.batch != nil {
-		fields = append(fields, sip.FieldBatchID)
+		fields = append(fields, searchdocument.FieldName)
 	}
-	if m.file_count != nil {
-		fields = append(fields, sip.FieldFileCount)
+	if m.content != nil {
+		fields = append(fields, searchdocument.FieldContent)
 	}
-	if m.checksum_algorithm != nil {
-		fields = append(fields, sip.FieldChecksumAlgorithm)
+	if m.fields != nil {
+		fields = append(fields, searchdocument.FieldFields)
 	}
-	if m.checksum_hash != nil {
-		fields = append(fields, sip.FieldChecksumHash)
+	if m.updated_at != nil {
+		fields = append(fields, searchdocument.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1952,36 +4210,18 @@ func (m *SIPMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SIPMutation) Field(name string) (ent.Value, bool) {
+func (m *SearchDocumentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sip.FieldUUID:
+	case searchdocument.FieldUUID:
 		return m.UUID()
-	case sip.FieldName:
+	case searchdocument.FieldName:
 		return m.Name()
-	case sip.FieldAipID:
-		return m.AipID()
-	case sip.FieldStatus:
-		return m.Status()
-	case sip.FieldCreatedAt:
-		return m.CreatedAt()
-	case sip.FieldStartedAt:
-		return m.StartedAt()
-	case sip.FieldCompletedAt:
-		return m.CompletedAt()
-	case sip.FieldFailedAs:
-		return m.FailedAs()
-	case sip.FieldFailedKey:
-		return m.FailedKey()
-	case sip.FieldUploaderID:
-		return m.UploaderID()
-	case sip.FieldBatchID:
-		return m.BatchID()
-	case sip.FieldFileCount:
-		return m.FileCount()
-	case sip.FieldChecksumAlgorithm:
-		return m.ChecksumAlgorithm()
-	case sip.FieldChecksumHash:
-		return m.ChecksumHash()
+	case searchdocument.FieldContent:
+		return m.Content()
+	case searchdocument.FieldFields:
+		return m.GetFields()
+	case searchdocument.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1989,434 +4229,185 @@ func (m *SIPMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SIPMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SearchDocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sip.FieldUUID:
+	case searchdocument.FieldUUID:
 		return m.OldUUID(ctx)
-	case sip.FieldName:
+	case searchdocument.FieldName:
 		return m.OldName(ctx)
-	case sip.FieldAipID:
-		return m.OldAipID(ctx)
-	case sip.FieldStatus:
-		return m.OldStatus(ctx)
-	case sip.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case sip.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case sip.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case sip.FieldFailedAs:
-		return m.OldFailedAs(ctx)
-	case sip.FieldFailedKey:
-		return m.OldFailedKey(ctx)
-	case sip.FieldUploaderID:
-		return m.OldUploaderID(ctx)
-	case sip.FieldBatchID:
-		return m.OldBatchID(ctx)
-	case sip.FieldFileCount:
-		return m.OldFileCount(ctx)
-	case sip.FieldChecksumAlgorithm:
-		return m.OldChecksumAlgorithm(ctx)
-	case sip.FieldChecksumHash:
-		return m.OldChecksumHash(ctx)
+	case searchdocument.FieldContent:
+		return m.OldContent(ctx)
+	case searchdocument.FieldFields:
+		return m.OldFields(ctx)
+	case searchdocument.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SIP field %s", name)
+	return nil, fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SIPMutation) SetField(name string, value ent.Value) error {
+func (m *SearchDocumentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sip.FieldUUID:
+	case searchdocument.FieldUUID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUUID(v)
 		return nil
-	case sip.FieldName:
+	case searchdocument.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case sip.FieldAipID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAipID(v)
-		return nil
-	case sip.FieldStatus:
-		v, ok := value.(enums.SIPStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case sip.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case sip.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
-	case sip.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
-		return nil
-	case sip.FieldFailedAs:
-		v, ok := value.(enums.SIPFailedAs)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFailedAs(v)
-		return nil
-	case sip.FieldFailedKey:
+	case searchdocument.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFailedKey(v)
-		return nil
-	case sip.FieldUploaderID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUploaderID(v)
-		return nil
-	case sip.FieldBatchID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBatchID(v)
-		return nil
-	case sip.FieldFileCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFileCount(v)
+		m.SetContent(v)
 		return nil
-	case sip.FieldChecksumAlgorithm:
-		v, ok := value.(string)
+	case searchdocument.FieldFields:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecksumAlgorithm(v)
+		m.SetFields(v)
 		return nil
-	case sip.FieldChecksumHash:
-		v, ok := value.(string)
+	case searchdocument.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecksumHash(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SIP field %s", name)
+	return fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SIPMutation) AddedFields() []string {
-	var fields []string
-	if m.addfile_count != nil {
-		fields = append(fields, sip.FieldFileCount)
-	}
-	return fields
+func (m *SearchDocumentMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SIPMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case sip.FieldFileCount:
-		return m.AddedFileCount()
-	}
+func (m *SearchDocumentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SIPMutation) AddField(name string, value ent.Value) error {
+func (m *SearchDocumentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case sip.FieldFileCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFileCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown SIP numeric field %s", name)
+	return fmt.Errorf("unknown SearchDocument numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SIPMutation) ClearedFields() []string {
+func (m *SearchDocumentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(sip.FieldAipID) {
-		fields = append(fields, sip.FieldAipID)
-	}
-	if m.FieldCleared(sip.FieldStartedAt) {
-		fields = append(fields, sip.FieldStartedAt)
-	}
-	if m.FieldCleared(sip.FieldCompletedAt) {
-		fields = append(fields, sip.FieldCompletedAt)
-	}
-	if m.FieldCleared(sip.FieldFailedAs) {
-		fields = append(fields, sip.FieldFailedAs)
-	}
-	if m.FieldCleared(sip.FieldFailedKey) {
-		fields = append(fields, sip.FieldFailedKey)
-	}
-	if m.FieldCleared(sip.FieldUploaderID) {
-		fields = append(fields, sip.FieldUploaderID)
-	}
-	if m.FieldCleared(sip.FieldBatchID) {
-		fields = append(fields, sip.FieldBatchID)
-	}
-	if m.FieldCleared(sip.FieldFileCount) {
-		fields = append(fields, sip.FieldFileCount)
-	}
-	if m.FieldCleared(sip.FieldChecksumAlgorithm) {
-		fields = append(fields, sip.FieldChecksumAlgorithm)
-	}
-	if m.FieldCleared(sip.FieldChecksumHash) {
-		fields = append(fields, sip.FieldChecksumHash)
+	if m.FieldCleared(searchdocument.FieldFields) {
+		fields = append(fields, searchdocument.FieldFields)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SIPMutation) FieldCleared(name string) bool {
+func (m *SearchDocumentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SIPMutation) ClearField(name string) error {
+func (m *SearchDocumentMutation) ClearField(name string) error {
 	switch name {
-	case sip.FieldAipID:
-		m.ClearAipID()
-		return nil
-	case sip.FieldStartedAt:
-		m.ClearStartedAt()
-		return nil
-	case sip.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	case sip.FieldFailedAs:
-		m.ClearFailedAs()
-		return nil
-	case sip.FieldFailedKey:
-		m.ClearFailedKey()
-		return nil
-	case sip.FieldUploaderID:
-		m.ClearUploaderID()
-		return nil
-	case sip.FieldBatchID:
-		m.ClearBatchID()
-		return nil
-	case sip.FieldFileCount:
-		m.ClearFileCount()
-		return nil
-	case sip.FieldChecksumAlgorithm:
-		m.ClearChecksumAlgorithm()
-		return nil
-	case sip.FieldChecksumHash:
-		m.ClearChecksumHash()
+	case searchdocument.FieldFields:
+		m.ClearFields()
 		return nil
 	}
-	return fmt.Errorf("unknown SIP nullable field %s", name)
+	return fmt.Errorf("unknown SearchDocument nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SIPMutation) ResetField(name string) error {
+func (m *SearchDocumentMutation) ResetField(name string) error {
 	switch name {
-	case sip.FieldUUID:
+	case searchdocument.FieldUUID:
 		m.ResetUUID()
 		return nil
-	case sip.FieldName:
+	case searchdocument.FieldName:
 		m.ResetName()
 		return nil
-	case sip.FieldAipID:
-		m.ResetAipID()
-		return nil
-	case sip.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case sip.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case sip.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case sip.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
-	case sip.FieldFailedAs:
-		m.ResetFailedAs()
-		return nil
-	case sip.FieldFailedKey:
-		m.ResetFailedKey()
-		return nil
-	case sip.FieldUploaderID:
-		m.ResetUploaderID()
-		return nil
-	case sip.FieldBatchID:
-		m.ResetBatchID()
-		return nil
-	case sip.FieldFileCount:
-		m.ResetFileCount()
+	case searchdocument.FieldContent:
+		m.ResetContent()
 		return nil
-	case sip.FieldChecksumAlgorithm:
-		m.ResetChecksumAlgorithm()
+	case searchdocument.FieldFields:
+		m.ResetFields()
 		return nil
-	case sip.FieldChecksumHash:
-		m.ResetChecksumHash()
+	case searchdocument.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SIP field %s", name)
+	return fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SIPMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.workflows != nil {
-		edges = append(edges, sip.EdgeWorkflows)
-	}
-	if m.uploader != nil {
-		edges = append(edges, sip.EdgeUploader)
-	}
-	if m.batch != nil {
-		edges = append(edges, sip.EdgeBatch)
-	}
+func (m *SearchDocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SIPMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case sip.EdgeWorkflows:
-		ids := make([]ent.Value, 0, len(m.workflows))
-		for id := range m.workflows {
-			ids = append(ids, id)
-		}
-		return ids
-	case sip.EdgeUploader:
-		if id := m.uploader; id != nil {
-			return []ent.Value{*id}
-		}
-	case sip.EdgeBatch:
-		if id := m.batch; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SearchDocumentMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SIPMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedworkflows != nil {
-		edges = append(edges, sip.EdgeWorkflows)
-	}
+func (m *SearchDocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SIPMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case sip.EdgeWorkflows:
-		ids := make([]ent.Value, 0, len(m.removedworkflows))
-		for id := range m.removedworkflows {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SearchDocumentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SIPMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedworkflows {
-		edges = append(edges, sip.EdgeWorkflows)
-	}
-	if m.cleareduploader {
-		edges = append(edges, sip.EdgeUploader)
-	}
-	if m.clearedbatch {
-		edges = append(edges, sip.EdgeBatch)
-	}
+func (m *SearchDocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SIPMutation) EdgeCleared(name string) bool {
-	switch name {
-	case sip.EdgeWorkflows:
-		return m.clearedworkflows
-	case sip.EdgeUploader:
-		return m.cleareduploader
-	case sip.EdgeBatch:
-		return m.clearedbatch
-	}
+func (m *SearchDocumentMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SIPMutation) ClearEdge(name string) error {
-	switch name {
-	case sip.EdgeUploader:
-		m.ClearUploader()
-		return nil
-	case sip.EdgeBatch:
-		m.ClearBatch()
-		return nil
-	}
-	return fmt.Errorf("unknown SIP unique edge %s", name)
+func (m *SearchDocumentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchDocument unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SIPMutation) ResetEdge(name string) error {
-	switch name {
-	case sip.EdgeWorkflows:
-		m.ResetWorkflows()
-		return nil
-	case sip.EdgeUploader:
-		m.ResetUploader()
-		return nil
-	case sip.EdgeBatch:
-		m.ResetBatch()
-		return nil
-	}
-	return fmt.Errorf("unknown SIP edge %s", name)
+func (m *SearchDocumentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchDocument edge %s", name)
 }
 
 // TaskMutation represents an operation that mutates the Task nodes in the graph.
@@ -3219,6 +5210,9 @@ type UserMutation struct {
 	uploaded_batches        map[int]struct{}
 	removeduploaded_batches map[int]struct{}
 	cleareduploaded_batches bool
+	api_tokens              map[int]struct{}
+	removedapi_tokens       map[int]struct{}
+	clearedapi_tokens       bool
 	done                    bool
 	oldValue                func(context.Context) (*User, error)
 	predicates              []predicate.User
@@ -3698,6 +5692,60 @@ func (m *UserMutation) ResetUploadedBatches() {
 	m.removeduploaded_batches = nil
 }
 
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by ids.
+func (m *UserMutation) AddAPITokenIDs(ids ...int) {
+	if m.api_tokens == nil {
+		m.api_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.api_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPITokens clears the "api_tokens" edge to the APIToken entity.
+func (m *UserMutation) ClearAPITokens() {
+	m.clearedapi_tokens = true
+}
+
+// APITokensCleared reports if the "api_tokens" edge to the APIToken entity was cleared.
+func (m *UserMutation) APITokensCleared() bool {
+	return m.clearedapi_tokens
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to the APIToken entity by IDs.
+func (m *UserMutation) RemoveAPITokenIDs(ids ...int) {
+	if m.removedapi_tokens == nil {
+		m.removedapi_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.api_tokens, ids[i])
+		m.removedapi_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPITokens returns the removed IDs of the "api_tokens" edge to the APIToken entity.
+func (m *UserMutation) RemovedAPITokensIDs() (ids []int) {
+	for id := range m.removedapi_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
+func (m *UserMutation) APITokensIDs() (ids []int) {
+	for id := range m.api_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPITokens resets all changes to the "api_tokens" edge.
+func (m *UserMutation) ResetAPITokens() {
+	m.api_tokens = nil
+	m.clearedapi_tokens = false
+	m.removedapi_tokens = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -3943,13 +5991,16 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.uploaded_sips != nil {
 		edges = append(edges, user.EdgeUploadedSips)
 	}
 	if m.uploaded_batches != nil {
 		edges = append(edges, user.EdgeUploadedBatches)
 	}
+	if m.api_tokens != nil {
+		edges = append(edges, user.EdgeAPITokens)
+	}
 	return edges
 }
 
@@ -3969,19 +6020,28 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeAPITokens:
+		ids := make([]ent.Value, 0, len(m.api_tokens))
+		for id := range m.api_tokens {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.removeduploaded_sips != nil {
 		edges = append(edges, user.EdgeUploadedSips)
 	}
 	if m.removeduploaded_batches != nil {
 		edges = append(edges, user.EdgeUploadedBatches)
 	}
+	if m.removedapi_tokens != nil {
+		edges = append(edges, user.EdgeAPITokens)
+	}
 	return edges
 }
 
@@ -4001,19 +6061,28 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeAPITokens:
+		ids := make([]ent.Value, 0, len(m.removedapi_tokens))
+		for id := range m.removedapi_tokens {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.cleareduploaded_sips {
 		edges = append(edges, user.EdgeUploadedSips)
 	}
 	if m.cleareduploaded_batches {
 		edges = append(edges, user.EdgeUploadedBatches)
 	}
+	if m.clearedapi_tokens {
+		edges = append(edges, user.EdgeAPITokens)
+	}
 	return edges
 }
 
@@ -4025,6 +6094,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.cleareduploaded_sips
 	case user.EdgeUploadedBatches:
 		return m.cleareduploaded_batches
+	case user.EdgeAPITokens:
+		return m.clearedapi_tokens
 	}
 	return false
 }
@@ -4047,6 +6118,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeUploadedBatches:
 		m.ResetUploadedBatches()
 		return nil
+	case user.EdgeAPITokens:
+		m.ResetAPITokens()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -5,7 +5,9 @@ package db
 import (
 	"time"
 
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/apitoken"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/batch"
+	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/searchdocument"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/sip"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/persistence/ent/db/user"
@@ -17,6 +19,16 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apitokenFields := schema.APIToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenFields[4].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	// apitokenDescOwnerID is the schema descriptor for owner_id field.
+	apitokenDescOwnerID := apitokenFields[8].Descriptor()
+	// apitoken.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	apitoken.OwnerIDValidator = apitokenDescOwnerID.Validators[0].(func(int) error)
 	batchFields := schema.Batch{}.Fields()
 	_ = batchFields
 	// batchDescSipsCount is the schema descriptor for sips_count field.
@@ -49,6 +61,14 @@ func init() {
 	sipDescFileCount := sipFields[11].Descriptor()
 	// sip.FileCountValidator is a validator for the "file_count" field. It is called by the builders before save.
 	sip.FileCountValidator = sipDescFileCount.Validators[0].(func(int32) error)
+	searchdocumentFields := schema.SearchDocument{}.Fields()
+	_ = searchdocumentFields
+	// searchdocumentDescUpdatedAt is the schema descriptor for updated_at field.
+	searchdocumentDescUpdatedAt := searchdocumentFields[4].Descriptor()
+	// searchdocument.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	searchdocument.DefaultUpdatedAt = searchdocumentDescUpdatedAt.Default.(func() time.Time)
+	// searchdocument.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	searchdocument.UpdateDefaultUpdatedAt = searchdocumentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
 	// taskDescWorkflowID is the schema descriptor for workflow_id field.
@@ -3,6 +3,8 @@
 package db
 
 import (
+	"encoding/json"
+	"encoding/json/jsontext"
 	"fmt"
 	"strings"
 	"time"
@@ -49,6 +51,18 @@ type SIP struct {
 	ChecksumAlgorithm string `json:"checksum_algorithm,omitempty"`
 	// ChecksumHash holds the value of the "checksum_hash" field.
 	ChecksumHash string `json:"checksum_hash,omitempty"`
+	// ProcessingProfile holds the value of the "processing_profile" field.
+	ProcessingProfile string `json:"processing_profile,omitempty"`
+	// CustomMetadata holds the value of the "custom_metadata" field.
+	CustomMetadata map[string]jsontext.Value `json:"custom_metadata,omitempty"`
+	// SipSourceID holds the value of the "sip_source_id" field.
+	SipSourceID uuid.UUID `json:"sip_source_id,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID uuid.UUID `json:"location_id,omitempty"`
+	// ExpectedChecksum holds the value of the "expected_checksum" field.
+	ExpectedChecksum string `json:"expected_checksum,omitempty"`
+	// WatcherName holds the value of the "watcher_name" field.
+	WatcherName string `json:"watcher_name,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SIPQuery when eager-loading is set.
 	Edges        SIPEdges `json:"edges"`
@@ -104,13 +118,15 @@ func (*SIP) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case sip.FieldCustomMetadata:
+			values[i] = new([]byte)
 		case sip.FieldID, sip.FieldUploaderID, sip.FieldBatchID, sip.FieldFileCount:
 			values[i] = new(sql.NullInt64)
-		case sip.FieldName, sip.FieldStatus, sip.FieldFailedAs, sip.FieldFailedKey, sip.FieldChecksumAlgorithm, sip.FieldChecksumHash:
+		case sip.FieldName, sip.FieldStatus, sip.FieldFailedAs, sip.FieldFailedKey, sip.FieldChecksumAlgorithm, sip.FieldChecksumHash, sip.FieldProcessingProfile, sip.FieldExpectedChecksum, sip.FieldWatcherName:
 			values[i] = new(sql.NullString)
 		case sip.FieldCreatedAt, sip.FieldStartedAt, sip.FieldCompletedAt:
 			values[i] = new(sql.NullTime)
-		case sip.FieldUUID, sip.FieldAipID:
+		case sip.FieldUUID, sip.FieldAipID, sip.FieldSipSourceID, sip.FieldLocationID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -217,6 +233,44 @@ func (_m *SIP) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ChecksumHash = value.String
 			}
+		case sip.FieldProcessingProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field processing_profile", values[i])
+			} else if value.Valid {
+				_m.ProcessingProfile = value.String
+			}
+		case sip.FieldCustomMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CustomMetadata); err != nil {
+					return fmt.Errorf("unmarshal field custom_metadata: %w", err)
+				}
+			}
+		case sip.FieldSipSourceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field sip_source_id", values[i])
+			} else if value != nil {
+				_m.SipSourceID = *value
+			}
+		case sip.FieldLocationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value != nil {
+				_m.LocationID = *value
+			}
+		case sip.FieldExpectedChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_checksum", values[i])
+			} else if value.Valid {
+				_m.ExpectedChecksum = value.String
+			}
+		case sip.FieldWatcherName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field watcher_name", values[i])
+			} else if value.Valid {
+				_m.WatcherName = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -309,6 +363,24 @@ func (_m *SIP) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("checksum_hash=")
 	builder.WriteString(_m.ChecksumHash)
+	builder.WriteString(", ")
+	builder.WriteString("processing_profile=")
+	builder.WriteString(_m.ProcessingProfile)
+	builder.WriteString(", ")
+	builder.WriteString("custom_metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomMetadata))
+	builder.WriteString(", ")
+	builder.WriteString("sip_source_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SipSourceID))
+	builder.WriteString(", ")
+	builder.WriteString("location_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LocationID))
+	builder.WriteString(", ")
+	builder.WriteString("expected_checksum=")
+	builder.WriteString(_m.ExpectedChecksum)
+	builder.WriteString(", ")
+	builder.WriteString("watcher_name=")
+	builder.WriteString(_m.WatcherName)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -44,6 +44,18 @@ const (
 	FieldChecksumAlgorithm = "checksum_algorithm"
 	// FieldChecksumHash holds the string denoting the checksum_hash field in the database.
 	FieldChecksumHash = "checksum_hash"
+	// FieldProcessingProfile holds the string denoting the processing_profile field in the database.
+	FieldProcessingProfile = "processing_profile"
+	// FieldCustomMetadata holds the string denoting the custom_metadata field in the database.
+	FieldCustomMetadata = "custom_metadata"
+	// FieldSipSourceID holds the string denoting the sip_source_id field in the database.
+	FieldSipSourceID = "sip_source_id"
+	// FieldLocationID holds the string denoting the location_id field in the database.
+	FieldLocationID = "location_id"
+	// FieldExpectedChecksum holds the string denoting the expected_checksum field in the database.
+	FieldExpectedChecksum = "expected_checksum"
+	// FieldWatcherName holds the string denoting the watcher_name field in the database.
+	FieldWatcherName = "watcher_name"
 	// EdgeWorkflows holds the string denoting the workflows edge name in mutations.
 	EdgeWorkflows = "workflows"
 	// EdgeUploader holds the string denoting the uploader edge name in mutations.
@@ -92,6 +104,12 @@ var Columns = []string{
 	FieldFileCount,
 	FieldChecksumAlgorithm,
 	FieldChecksumHash,
+	FieldProcessingProfile,
+	FieldCustomMetadata,
+	FieldSipSourceID,
+	FieldLocationID,
+	FieldExpectedChecksum,
+	FieldWatcherName,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -213,6 +231,31 @@ func ByChecksumHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChecksumHash, opts...).ToFunc()
 }
 
+// ByProcessingProfile orders the results by the processing_profile field.
+func ByProcessingProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessingProfile, opts...).ToFunc()
+}
+
+// BySipSourceID orders the results by the sip_source_id field.
+func BySipSourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSipSourceID, opts...).ToFunc()
+}
+
+// ByLocationID orders the results by the location_id field.
+func ByLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocationID, opts...).ToFunc()
+}
+
+// ByExpectedChecksum orders the results by the expected_checksum field.
+func ByExpectedChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedChecksum, opts...).ToFunc()
+}
+
+// ByWatcherName orders the results by the watcher_name field.
+func ByWatcherName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWatcherName, opts...).ToFunc()
+}
+
 // ByWorkflowsCount orders the results by workflows count.
 func ByWorkflowsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
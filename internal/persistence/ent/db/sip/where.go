@@ -117,6 +117,31 @@ func ChecksumHash(v string) predicate.SIP {
 	return predicate.SIP(sql.FieldEQ(FieldChecksumHash, v))
 }
 
+// ProcessingProfile applies equality check predicate on the "processing_profile" field. It's identical to ProcessingProfileEQ.
+func ProcessingProfile(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldProcessingProfile, v))
+}
+
+// SipSourceID applies equality check predicate on the "sip_source_id" field. It's identical to SipSourceIDEQ.
+func SipSourceID(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldSipSourceID, v))
+}
+
+// LocationID applies equality check predicate on the "location_id" field. It's identical to LocationIDEQ.
+func LocationID(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldLocationID, v))
+}
+
+// ExpectedChecksum applies equality check predicate on the "expected_checksum" field. It's identical to ExpectedChecksumEQ.
+func ExpectedChecksum(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldExpectedChecksum, v))
+}
+
+// WatcherName applies equality check predicate on the "watcher_name" field. It's identical to WatcherNameEQ.
+func WatcherName(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldWatcherName, v))
+}
+
 // UUIDEQ applies the EQ predicate on the "uuid" field.
 func UUIDEQ(v uuid.UUID) predicate.SIP {
 	return predicate.SIP(sql.FieldEQ(FieldUUID, v))
@@ -817,6 +842,341 @@ func ChecksumHashContainsFold(v string) predicate.SIP {
 	return predicate.SIP(sql.FieldContainsFold(FieldChecksumHash, v))
 }
 
+// ProcessingProfileEQ applies the EQ predicate on the "processing_profile" field.
+func ProcessingProfileEQ(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileNEQ applies the NEQ predicate on the "processing_profile" field.
+func ProcessingProfileNEQ(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldNEQ(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileIn applies the In predicate on the "processing_profile" field.
+func ProcessingProfileIn(vs ...string) predicate.SIP {
+	return predicate.SIP(sql.FieldIn(FieldProcessingProfile, vs...))
+}
+
+// ProcessingProfileNotIn applies the NotIn predicate on the "processing_profile" field.
+func ProcessingProfileNotIn(vs ...string) predicate.SIP {
+	return predicate.SIP(sql.FieldNotIn(FieldProcessingProfile, vs...))
+}
+
+// ProcessingProfileGT applies the GT predicate on the "processing_profile" field.
+func ProcessingProfileGT(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldGT(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileGTE applies the GTE predicate on the "processing_profile" field.
+func ProcessingProfileGTE(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldGTE(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileLT applies the LT predicate on the "processing_profile" field.
+func ProcessingProfileLT(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldLT(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileLTE applies the LTE predicate on the "processing_profile" field.
+func ProcessingProfileLTE(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldLTE(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileContains applies the Contains predicate on the "processing_profile" field.
+func ProcessingProfileContains(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldContains(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileHasPrefix applies the HasPrefix predicate on the "processing_profile" field.
+func ProcessingProfileHasPrefix(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldHasPrefix(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileHasSuffix applies the HasSuffix predicate on the "processing_profile" field.
+func ProcessingProfileHasSuffix(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldHasSuffix(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileIsNil applies the IsNil predicate on the "processing_profile" field.
+func ProcessingProfileIsNil() predicate.SIP {
+	return predicate.SIP(sql.FieldIsNull(FieldProcessingProfile))
+}
+
+// ProcessingProfileNotNil applies the NotNil predicate on the "processing_profile" field.
+func ProcessingProfileNotNil() predicate.SIP {
+	return predicate.SIP(sql.FieldNotNull(FieldProcessingProfile))
+}
+
+// ProcessingProfileEqualFold applies the EqualFold predicate on the "processing_profile" field.
+func ProcessingProfileEqualFold(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEqualFold(FieldProcessingProfile, v))
+}
+
+// ProcessingProfileContainsFold applies the ContainsFold predicate on the "processing_profile" field.
+func ProcessingProfileContainsFold(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldContainsFold(FieldProcessingProfile, v))
+}
+
+// CustomMetadataIsNil applies the IsNil predicate on the "custom_metadata" field.
+func CustomMetadataIsNil() predicate.SIP {
+	return predicate.SIP(sql.FieldIsNull(FieldCustomMetadata))
+}
+
+// CustomMetadataNotNil applies the NotNil predicate on the "custom_metadata" field.
+func CustomMetadataNotNil() predicate.SIP {
+	return predicate.SIP(sql.FieldNotNull(FieldCustomMetadata))
+}
+
+// SipSourceIDEQ applies the EQ predicate on the "sip_source_id" field.
+func SipSourceIDEQ(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldSipSourceID, v))
+}
+
+// SipSourceIDNEQ applies the NEQ predicate on the "sip_source_id" field.
+func SipSourceIDNEQ(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldNEQ(FieldSipSourceID, v))
+}
+
+// SipSourceIDIn applies the In predicate on the "sip_source_id" field.
+func SipSourceIDIn(vs ...uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldIn(FieldSipSourceID, vs...))
+}
+
+// SipSourceIDNotIn applies the NotIn predicate on the "sip_source_id" field.
+func SipSourceIDNotIn(vs ...uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldNotIn(FieldSipSourceID, vs...))
+}
+
+// SipSourceIDGT applies the GT predicate on the "sip_source_id" field.
+func SipSourceIDGT(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldGT(FieldSipSourceID, v))
+}
+
+// SipSourceIDGTE applies the GTE predicate on the "sip_source_id" field.
+func SipSourceIDGTE(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldGTE(FieldSipSourceID, v))
+}
+
+// SipSourceIDLT applies the LT predicate on the "sip_source_id" field.
+func SipSourceIDLT(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldLT(FieldSipSourceID, v))
+}
+
+// SipSourceIDLTE applies the LTE predicate on the "sip_source_id" field.
+func SipSourceIDLTE(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldLTE(FieldSipSourceID, v))
+}
+
+// SipSourceIDIsNil applies the IsNil predicate on the "sip_source_id" field.
+func SipSourceIDIsNil() predicate.SIP {
+	return predicate.SIP(sql.FieldIsNull(FieldSipSourceID))
+}
+
+// SipSourceIDNotNil applies the NotNil predicate on the "sip_source_id" field.
+func SipSourceIDNotNil() predicate.SIP {
+	return predicate.SIP(sql.FieldNotNull(FieldSipSourceID))
+}
+
+// LocationIDEQ applies the EQ predicate on the "location_id" field.
+func LocationIDEQ(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldLocationID, v))
+}
+
+// LocationIDNEQ applies the NEQ predicate on the "location_id" field.
+func LocationIDNEQ(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldNEQ(FieldLocationID, v))
+}
+
+// LocationIDIn applies the In predicate on the "location_id" field.
+func LocationIDIn(vs ...uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldIn(FieldLocationID, vs...))
+}
+
+// LocationIDNotIn applies the NotIn predicate on the "location_id" field.
+func LocationIDNotIn(vs ...uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldNotIn(FieldLocationID, vs...))
+}
+
+// LocationIDGT applies the GT predicate on the "location_id" field.
+func LocationIDGT(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldGT(FieldLocationID, v))
+}
+
+// LocationIDGTE applies the GTE predicate on the "location_id" field.
+func LocationIDGTE(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldGTE(FieldLocationID, v))
+}
+
+// LocationIDLT applies the LT predicate on the "location_id" field.
+func LocationIDLT(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldLT(FieldLocationID, v))
+}
+
+// LocationIDLTE applies the LTE predicate on the "location_id" field.
+func LocationIDLTE(v uuid.UUID) predicate.SIP {
+	return predicate.SIP(sql.FieldLTE(FieldLocationID, v))
+}
+
+// LocationIDIsNil applies the IsNil predicate on the "location_id" field.
+func LocationIDIsNil() predicate.SIP {
+	return predicate.SIP(sql.FieldIsNull(FieldLocationID))
+}
+
+// LocationIDNotNil applies the NotNil predicate on the "location_id" field.
+func LocationIDNotNil() predicate.SIP {
+	return predicate.SIP(sql.FieldNotNull(FieldLocationID))
+}
+
+// ExpectedChecksumEQ applies the EQ predicate on the "expected_checksum" field.
+func ExpectedChecksumEQ(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumNEQ applies the NEQ predicate on the "expected_checksum" field.
+func ExpectedChecksumNEQ(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldNEQ(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumIn applies the In predicate on the "expected_checksum" field.
+func ExpectedChecksumIn(vs ...string) predicate.SIP {
+	return predicate.SIP(sql.FieldIn(FieldExpectedChecksum, vs...))
+}
+
+// ExpectedChecksumNotIn applies the NotIn predicate on the "expected_checksum" field.
+func ExpectedChecksumNotIn(vs ...string) predicate.SIP {
+	return predicate.SIP(sql.FieldNotIn(FieldExpectedChecksum, vs...))
+}
+
+// ExpectedChecksumGT applies the GT predicate on the "expected_checksum" field.
+func ExpectedChecksumGT(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldGT(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumGTE applies the GTE predicate on the "expected_checksum" field.
+func ExpectedChecksumGTE(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldGTE(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumLT applies the LT predicate on the "expected_checksum" field.
+func ExpectedChecksumLT(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldLT(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumLTE applies the LTE predicate on the "expected_checksum" field.
+func ExpectedChecksumLTE(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldLTE(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumContains applies the Contains predicate on the "expected_checksum" field.
+func ExpectedChecksumContains(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldContains(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumHasPrefix applies the HasPrefix predicate on the "expected_checksum" field.
+func ExpectedChecksumHasPrefix(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldHasPrefix(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumHasSuffix applies the HasSuffix predicate on the "expected_checksum" field.
+func ExpectedChecksumHasSuffix(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldHasSuffix(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumIsNil applies the IsNil predicate on the "expected_checksum" field.
+func ExpectedChecksumIsNil() predicate.SIP {
+	return predicate.SIP(sql.FieldIsNull(FieldExpectedChecksum))
+}
+
+// ExpectedChecksumNotNil applies the NotNil predicate on the "expected_checksum" field.
+func ExpectedChecksumNotNil() predicate.SIP {
+	return predicate.SIP(sql.FieldNotNull(FieldExpectedChecksum))
+}
+
+// ExpectedChecksumEqualFold applies the EqualFold predicate on the "expected_checksum" field.
+func ExpectedChecksumEqualFold(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEqualFold(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumContainsFold applies the ContainsFold predicate on the "expected_checksum" field.
+func ExpectedChecksumContainsFold(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldContainsFold(FieldExpectedChecksum, v))
+}
+
+// WatcherNameEQ applies the EQ predicate on the "watcher_name" field.
+func WatcherNameEQ(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEQ(FieldWatcherName, v))
+}
+
+// WatcherNameNEQ applies the NEQ predicate on the "watcher_name" field.
+func WatcherNameNEQ(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldNEQ(FieldWatcherName, v))
+}
+
+// WatcherNameIn applies the In predicate on the "watcher_name" field.
+func WatcherNameIn(vs ...string) predicate.SIP {
+	return predicate.SIP(sql.FieldIn(FieldWatcherName, vs...))
+}
+
+// WatcherNameNotIn applies the NotIn predicate on the "watcher_name" field.
+func WatcherNameNotIn(vs ...string) predicate.SIP {
+	return predicate.SIP(sql.FieldNotIn(FieldWatcherName, vs...))
+}
+
+// WatcherNameGT applies the GT predicate on the "watcher_name" field.
+func WatcherNameGT(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldGT(FieldWatcherName, v))
+}
+
+// WatcherNameGTE applies the GTE predicate on the "watcher_name" field.
+func WatcherNameGTE(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldGTE(FieldWatcherName, v))
+}
+
+// WatcherNameLT applies the LT predicate on the "watcher_name" field.
+func WatcherNameLT(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldLT(FieldWatcherName, v))
+}
+
+// WatcherNameLTE applies the LTE predicate on the "watcher_name" field.
+func WatcherNameLTE(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldLTE(FieldWatcherName, v))
+}
+
+// WatcherNameContains applies the Contains predicate on the "watcher_name" field.
+func WatcherNameContains(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldContains(FieldWatcherName, v))
+}
+
+// WatcherNameHasPrefix applies the HasPrefix predicate on the "watcher_name" field.
+func WatcherNameHasPrefix(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldHasPrefix(FieldWatcherName, v))
+}
+
+// WatcherNameHasSuffix applies the HasSuffix predicate on the "watcher_name" field.
+func WatcherNameHasSuffix(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldHasSuffix(FieldWatcherName, v))
+}
+
+// WatcherNameIsNil applies the IsNil predicate on the "watcher_name" field.
+func WatcherNameIsNil() predicate.SIP {
+	return predicate.SIP(sql.FieldIsNull(FieldWatcherName))
+}
+
+// WatcherNameNotNil applies the NotNil predicate on the "watcher_name" field.
+func WatcherNameNotNil() predicate.SIP {
+	return predicate.SIP(sql.FieldNotNull(FieldWatcherName))
+}
+
+// WatcherNameEqualFold applies the EqualFold predicate on the "watcher_name" field.
+func WatcherNameEqualFold(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldEqualFold(FieldWatcherName, v))
+}
+
+// WatcherNameContainsFold applies the ContainsFold predicate on the "watcher_name" field.
+func WatcherNameContainsFold(v string) predicate.SIP {
+	return predicate.SIP(sql.FieldContainsFold(FieldWatcherName, v))
+}
+
 // HasWorkflows applies the HasEdge predicate on the "workflows" edge.
 func HasWorkflows() predicate.SIP {
 	return predicate.SIP(func(s *sql.Selector) {
@@ -4,6 +4,7 @@ package db
 
 import (
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"time"
@@ -199,6 +200,82 @@ func (_c *SIPCreate) SetNillableChecksumHash(v *string) *SIPCreate {
 	return _c
 }
 
+// SetProcessingProfile sets the "processing_profile" field.
+func (_c *SIPCreate) SetProcessingProfile(v string) *SIPCreate {
+	_c.mutation.SetProcessingProfile(v)
+	return _c
+}
+
+// SetNillableProcessingProfile sets the "processing_profile" field if the given value is not nil.
+func (_c *SIPCreate) SetNillableProcessingProfile(v *string) *SIPCreate {
+	if v != nil {
+		_c.SetProcessingProfile(*v)
+	}
+	return _c
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (_c *SIPCreate) SetCustomMetadata(v map[string]jsontext.Value) *SIPCreate {
+	_c.mutation.SetCustomMetadata(v)
+	return _c
+}
+
+// SetSipSourceID sets the "sip_source_id" field.
+func (_c *SIPCreate) SetSipSourceID(v uuid.UUID) *SIPCreate {
+	_c.mutation.SetSipSourceID(v)
+	return _c
+}
+
+// SetNillableSipSourceID sets the "sip_source_id" field if the given value is not nil.
+func (_c *SIPCreate) SetNillableSipSourceID(v *uuid.UUID) *SIPCreate {
+	if v != nil {
+		_c.SetSipSourceID(*v)
+	}
+	return _c
+}
+
+// SetLocationID sets the "location_id" field.
+func (_c *SIPCreate) SetLocationID(v uuid.UUID) *SIPCreate {
+	_c.mutation.SetLocationID(v)
+	return _c
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (_c *SIPCreate) SetNillableLocationID(v *uuid.UUID) *SIPCreate {
+	if v != nil {
+		_c.SetLocationID(*v)
+	}
+	return _c
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (_c *SIPCreate) SetExpectedChecksum(v string) *SIPCreate {
+	_c.mutation.SetExpectedChecksum(v)
+	return _c
+}
+
+// SetNillableExpectedChecksum sets the "expected_checksum" field if the given value is not nil.
+func (_c *SIPCreate) SetNillableExpectedChecksum(v *string) *SIPCreate {
+	if v != nil {
+		_c.SetExpectedChecksum(*v)
+	}
+	return _c
+}
+
+// SetWatcherName sets the "watcher_name" field.
+func (_c *SIPCreate) SetWatcherName(v string) *SIPCreate {
+	_c.mutation.SetWatcherName(v)
+	return _c
+}
+
+// SetNillableWatcherName sets the "watcher_name" field if the given value is not nil.
+func (_c *SIPCreate) SetNillableWatcherName(v *string) *SIPCreate {
+	if v != nil {
+		_c.SetWatcherName(*v)
+	}
+	return _c
+}
+
 // AddWorkflowIDs adds the "workflows" edge to the Workflow entity by IDs.
 func (_c *SIPCreate) AddWorkflowIDs(ids ...int) *SIPCreate {
 	_c.mutation.AddWorkflowIDs(ids...)
@@ -379,6 +456,30 @@ func (_c *SIPCreate) createSpec() (*SIP, *sqlgraph.CreateSpec) {
 		_spec.SetField(sip.FieldChecksumHash, field.TypeString, value)
 		_node.ChecksumHash = value
 	}
+	if value, ok := _c.mutation.ProcessingProfile(); ok {
+		_spec.SetField(sip.FieldProcessingProfile, field.TypeString, value)
+		_node.ProcessingProfile = value
+	}
+	if value, ok := _c.mutation.CustomMetadata(); ok {
+		_spec.SetField(sip.FieldCustomMetadata, field.TypeJSON, value)
+		_node.CustomMetadata = value
+	}
+	if value, ok := _c.mutation.SipSourceID(); ok {
+		_spec.SetField(sip.FieldSipSourceID, field.TypeUUID, value)
+		_node.SipSourceID = value
+	}
+	if value, ok := _c.mutation.LocationID(); ok {
+		_spec.SetField(sip.FieldLocationID, field.TypeUUID, value)
+		_node.LocationID = value
+	}
+	if value, ok := _c.mutation.ExpectedChecksum(); ok {
+		_spec.SetField(sip.FieldExpectedChecksum, field.TypeString, value)
+		_node.ExpectedChecksum = value
+	}
+	if value, ok := _c.mutation.WatcherName(); ok {
+		_spec.SetField(sip.FieldWatcherName, field.TypeString, value)
+		_node.WatcherName = value
+	}
 	if nodes := _c.mutation.WorkflowsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -691,6 +792,114 @@ func (u *SIPUpsert) ClearChecksumHash() *SIPUpsert {
 	return u
 }
 
+// SetProcessingProfile sets the "processing_profile" field.
+func (u *SIPUpsert) SetProcessingProfile(v string) *SIPUpsert {
+	u.Set(sip.FieldProcessingProfile, v)
+	return u
+}
+
+// UpdateProcessingProfile sets the "processing_profile" field to the value that was provided on create.
+func (u *SIPUpsert) UpdateProcessingProfile() *SIPUpsert {
+	u.SetExcluded(sip.FieldProcessingProfile)
+	return u
+}
+
+// ClearProcessingProfile clears the value of the "processing_profile" field.
+func (u *SIPUpsert) ClearProcessingProfile() *SIPUpsert {
+	u.SetNull(sip.FieldProcessingProfile)
+	return u
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (u *SIPUpsert) SetCustomMetadata(v map[string]jsontext.Value) *SIPUpsert {
+	u.Set(sip.FieldCustomMetadata, v)
+	return u
+}
+
+// UpdateCustomMetadata sets the "custom_metadata" field to the value that was provided on create.
+func (u *SIPUpsert) UpdateCustomMetadata() *SIPUpsert {
+	u.SetExcluded(sip.FieldCustomMetadata)
+	return u
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (u *SIPUpsert) ClearCustomMetadata() *SIPUpsert {
+	u.SetNull(sip.FieldCustomMetadata)
+	return u
+}
+
+// SetSipSourceID sets the "sip_source_id" field.
+func (u *SIPUpsert) SetSipSourceID(v uuid.UUID) *SIPUpsert {
+	u.Set(sip.FieldSipSourceID, v)
+	return u
+}
+
+// UpdateSipSourceID sets the "sip_source_id" field to the value that was provided on create.
+func (u *SIPUpsert) UpdateSipSourceID() *SIPUpsert {
+	u.SetExcluded(sip.FieldSipSourceID)
+	return u
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (u *SIPUpsert) ClearSipSourceID() *SIPUpsert {
+	u.SetNull(sip.FieldSipSourceID)
+	return u
+}
+
+// SetLocationID sets the "location_id" field.
+func (u *SIPUpsert) SetLocationID(v uuid.UUID) *SIPUpsert {
+	u.Set(sip.FieldLocationID, v)
+	return u
+}
+
+// UpdateLocationID sets the "location_id" field to the value that was provided on create.
+func (u *SIPUpsert) UpdateLocationID() *SIPUpsert {
+	u.SetExcluded(sip.FieldLocationID)
+	return u
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (u *SIPUpsert) ClearLocationID() *SIPUpsert {
+	u.SetNull(sip.FieldLocationID)
+	return u
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (u *SIPUpsert) SetExpectedChecksum(v string) *SIPUpsert {
+	u.Set(sip.FieldExpectedChecksum, v)
+	return u
+}
+
+// UpdateExpectedChecksum sets the "expected_checksum" field to the value that was provided on create.
+func (u *SIPUpsert) UpdateExpectedChecksum() *SIPUpsert {
+	u.SetExcluded(sip.FieldExpectedChecksum)
+	return u
+}
+
+// ClearExpectedChecksum clears the value of the "expected_checksum" field.
+func (u *SIPUpsert) ClearExpectedChecksum() *SIPUpsert {
+	u.SetNull(sip.FieldExpectedChecksum)
+	return u
+}
+
+// SetWatcherName sets the "watcher_name" field.
+func (u *SIPUpsert) SetWatcherName(v string) *SIPUpsert {
+	u.Set(sip.FieldWatcherName, v)
+	return u
+}
+
+// UpdateWatcherName sets the "watcher_name" field to the value that was provided on create.
+func (u *SIPUpsert) UpdateWatcherName() *SIPUpsert {
+	u.SetExcluded(sip.FieldWatcherName)
+	return u
+}
+
+// ClearWatcherName clears the value of the "watcher_name" field.
+func (u *SIPUpsert) ClearWatcherName() *SIPUpsert {
+	u.SetNull(sip.FieldWatcherName)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -984,6 +1193,132 @@ func (u *SIPUpsertOne) ClearChecksumHash() *SIPUpsertOne {
 	})
 }
 
+// SetProcessingProfile sets the "processing_profile" field.
+func (u *SIPUpsertOne) SetProcessingProfile(v string) *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetProcessingProfile(v)
+	})
+}
+
+// UpdateProcessingProfile sets the "processing_profile" field to the value that was provided on create.
+func (u *SIPUpsertOne) UpdateProcessingProfile() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateProcessingProfile()
+	})
+}
+
+// ClearProcessingProfile clears the value of the "processing_profile" field.
+func (u *SIPUpsertOne) ClearProcessingProfile() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearProcessingProfile()
+	})
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (u *SIPUpsertOne) SetCustomMetadata(v map[string]jsontext.Value) *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetCustomMetadata(v)
+	})
+}
+
+// UpdateCustomMetadata sets the "custom_metadata" field to the value that was provided on create.
+func (u *SIPUpsertOne) UpdateCustomMetadata() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateCustomMetadata()
+	})
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (u *SIPUpsertOne) ClearCustomMetadata() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearCustomMetadata()
+	})
+}
+
+// SetSipSourceID sets the "sip_source_id" field.
+func (u *SIPUpsertOne) SetSipSourceID(v uuid.UUID) *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetSipSourceID(v)
+	})
+}
+
+// UpdateSipSourceID sets the "sip_source_id" field to the value that was provided on create.
+func (u *SIPUpsertOne) UpdateSipSourceID() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateSipSourceID()
+	})
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (u *SIPUpsertOne) ClearSipSourceID() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearSipSourceID()
+	})
+}
+
+// SetLocationID sets the "location_id" field.
+func (u *SIPUpsertOne) SetLocationID(v uuid.UUID) *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetLocationID(v)
+	})
+}
+
+// UpdateLocationID sets the "location_id" field to the value that was provided on create.
+func (u *SIPUpsertOne) UpdateLocationID() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateLocationID()
+	})
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (u *SIPUpsertOne) ClearLocationID() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearLocationID()
+	})
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (u *SIPUpsertOne) SetExpectedChecksum(v string) *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetExpectedChecksum(v)
+	})
+}
+
+// UpdateExpectedChecksum sets the "expected_checksum" field to the value that was provided on create.
+func (u *SIPUpsertOne) UpdateExpectedChecksum() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateExpectedChecksum()
+	})
+}
+
+// ClearExpectedChecksum clears the value of the "expected_checksum" field.
+func (u *SIPUpsertOne) ClearExpectedChecksum() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearExpectedChecksum()
+	})
+}
+
+// SetWatcherName sets the "watcher_name" field.
+func (u *SIPUpsertOne) SetWatcherName(v string) *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetWatcherName(v)
+	})
+}
+
+// UpdateWatcherName sets the "watcher_name" field to the value that was provided on create.
+func (u *SIPUpsertOne) UpdateWatcherName() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateWatcherName()
+	})
+}
+
+// ClearWatcherName clears the value of the "watcher_name" field.
+func (u *SIPUpsertOne) ClearWatcherName() *SIPUpsertOne {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearWatcherName()
+	})
+}
+
 // Exec executes the query.
 func (u *SIPUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1443,6 +1778,132 @@ func (u *SIPUpsertBulk) ClearChecksumHash() *SIPUpsertBulk {
 	})
 }
 
+// SetProcessingProfile sets the "processing_profile" field.
+func (u *SIPUpsertBulk) SetProcessingProfile(v string) *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetProcessingProfile(v)
+	})
+}
+
+// UpdateProcessingProfile sets the "processing_profile" field to the value that was provided on create.
+func (u *SIPUpsertBulk) UpdateProcessingProfile() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateProcessingProfile()
+	})
+}
+
+// ClearProcessingProfile clears the value of the "processing_profile" field.
+func (u *SIPUpsertBulk) ClearProcessingProfile() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearProcessingProfile()
+	})
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (u *SIPUpsertBulk) SetCustomMetadata(v map[string]jsontext.Value) *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetCustomMetadata(v)
+	})
+}
+
+// UpdateCustomMetadata sets the "custom_metadata" field to the value that was provided on create.
+func (u *SIPUpsertBulk) UpdateCustomMetadata() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateCustomMetadata()
+	})
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (u *SIPUpsertBulk) ClearCustomMetadata() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearCustomMetadata()
+	})
+}
+
+// SetSipSourceID sets the "sip_source_id" field.
+func (u *SIPUpsertBulk) SetSipSourceID(v uuid.UUID) *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetSipSourceID(v)
+	})
+}
+
+// UpdateSipSourceID sets the "sip_source_id" field to the value that was provided on create.
+func (u *SIPUpsertBulk) UpdateSipSourceID() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateSipSourceID()
+	})
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (u *SIPUpsertBulk) ClearSipSourceID() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearSipSourceID()
+	})
+}
+
+// SetLocationID sets the "location_id" field.
+func (u *SIPUpsertBulk) SetLocationID(v uuid.UUID) *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetLocationID(v)
+	})
+}
+
+// UpdateLocationID sets the "location_id" field to the value that was provided on create.
+func (u *SIPUpsertBulk) UpdateLocationID() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateLocationID()
+	})
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (u *SIPUpsertBulk) ClearLocationID() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearLocationID()
+	})
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (u *SIPUpsertBulk) SetExpectedChecksum(v string) *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetExpectedChecksum(v)
+	})
+}
+
+// UpdateExpectedChecksum sets the "expected_checksum" field to the value that was provided on create.
+func (u *SIPUpsertBulk) UpdateExpectedChecksum() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateExpectedChecksum()
+	})
+}
+
+// ClearExpectedChecksum clears the value of the "expected_checksum" field.
+func (u *SIPUpsertBulk) ClearExpectedChecksum() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearExpectedChecksum()
+	})
+}
+
+// SetWatcherName sets the "watcher_name" field.
+func (u *SIPUpsertBulk) SetWatcherName(v string) *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.SetWatcherName(v)
+	})
+}
+
+// UpdateWatcherName sets the "watcher_name" field to the value that was provided on create.
+func (u *SIPUpsertBulk) UpdateWatcherName() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.UpdateWatcherName()
+	})
+}
+
+// ClearWatcherName clears the value of the "watcher_name" field.
+func (u *SIPUpsertBulk) ClearWatcherName() *SIPUpsertBulk {
+	return u.Update(func(s *SIPUpsert) {
+		s.ClearWatcherName()
+	})
+}
+
 // Exec executes the query.
 func (u *SIPUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
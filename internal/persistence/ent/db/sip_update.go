@@ -4,6 +4,7 @@ package db
 
 import (
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"time"
@@ -268,6 +269,118 @@ func (_u *SIPUpdate) ClearChecksumHash() *SIPUpdate {
 	return _u
 }
 
+// SetProcessingProfile sets the "processing_profile" field.
+func (_u *SIPUpdate) SetProcessingProfile(v string) *SIPUpdate {
+	_u.mutation.SetProcessingProfile(v)
+	return _u
+}
+
+// SetNillableProcessingProfile sets the "processing_profile" field if the given value is not nil.
+func (_u *SIPUpdate) SetNillableProcessingProfile(v *string) *SIPUpdate {
+	if v != nil {
+		_u.SetProcessingProfile(*v)
+	}
+	return _u
+}
+
+// ClearProcessingProfile clears the value of the "processing_profile" field.
+func (_u *SIPUpdate) ClearProcessingProfile() *SIPUpdate {
+	_u.mutation.ClearProcessingProfile()
+	return _u
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (_u *SIPUpdate) SetCustomMetadata(v map[string]jsontext.Value) *SIPUpdate {
+	_u.mutation.SetCustomMetadata(v)
+	return _u
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (_u *SIPUpdate) ClearCustomMetadata() *SIPUpdate {
+	_u.mutation.ClearCustomMetadata()
+	return _u
+}
+
+// SetSipSourceID sets the "sip_source_id" field.
+func (_u *SIPUpdate) SetSipSourceID(v uuid.UUID) *SIPUpdate {
+	_u.mutation.SetSipSourceID(v)
+	return _u
+}
+
+// SetNillableSipSourceID sets the "sip_source_id" field if the given value is not nil.
+func (_u *SIPUpdate) SetNillableSipSourceID(v *uuid.UUID) *SIPUpdate {
+	if v != nil {
+		_u.SetSipSourceID(*v)
+	}
+	return _u
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (_u *SIPUpdate) ClearSipSourceID() *SIPUpdate {
+	_u.mutation.ClearSipSourceID()
+	return _u
+}
+
+// SetLocationID sets the "location_id" field.
+func (_u *SIPUpdate) SetLocationID(v uuid.UUID) *SIPUpdate {
+	_u.mutation.SetLocationID(v)
+	return _u
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (_u *SIPUpdate) SetNillableLocationID(v *uuid.UUID) *SIPUpdate {
+	if v != nil {
+		_u.SetLocationID(*v)
+	}
+	return _u
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (_u *SIPUpdate) ClearLocationID() *SIPUpdate {
+	_u.mutation.ClearLocationID()
+	return _u
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (_u *SIPUpdate) SetExpectedChecksum(v string) *SIPUpdate {
+	_u.mutation.SetExpectedChecksum(v)
+	return _u
+}
+
+// SetNillableExpectedChecksum sets the "expected_checksum" field if the given value is not nil.
+func (_u *SIPUpdate) SetNillableExpectedChecksum(v *string) *SIPUpdate {
+	if v != nil {
+		_u.SetExpectedChecksum(*v)
+	}
+	return _u
+}
+
+// ClearExpectedChecksum clears the value of the "expected_checksum" field.
+func (_u *SIPUpdate) ClearExpectedChecksum() *SIPUpdate {
+	_u.mutation.ClearExpectedChecksum()
+	return _u
+}
+
+// SetWatcherName sets the "watcher_name" field.
+func (_u *SIPUpdate) SetWatcherName(v string) *SIPUpdate {
+	_u.mutation.SetWatcherName(v)
+	return _u
+}
+
+// SetNillableWatcherName sets the "watcher_name" field if the given value is not nil.
+func (_u *SIPUpdate) SetNillableWatcherName(v *string) *SIPUpdate {
+	if v != nil {
+		_u.SetWatcherName(*v)
+	}
+	return _u
+}
+
+// ClearWatcherName clears the value of the "watcher_name" field.
+func (_u *SIPUpdate) ClearWatcherName() *SIPUpdate {
+	_u.mutation.ClearWatcherName()
+	return _u
+}
+
 // AddWorkflowIDs adds the "workflows" edge to the Workflow entity by IDs.
 func (_u *SIPUpdate) AddWorkflowIDs(ids ...int) *SIPUpdate {
 	_u.mutation.AddWorkflowIDs(ids...)
@@ -457,6 +570,42 @@ func (_u *SIPUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ChecksumHashCleared() {
 		_spec.ClearField(sip.FieldChecksumHash, field.TypeString)
 	}
+	if value, ok := _u.mutation.ProcessingProfile(); ok {
+		_spec.SetField(sip.FieldProcessingProfile, field.TypeString, value)
+	}
+	if _u.mutation.ProcessingProfileCleared() {
+		_spec.ClearField(sip.FieldProcessingProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.CustomMetadata(); ok {
+		_spec.SetField(sip.FieldCustomMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.CustomMetadataCleared() {
+		_spec.ClearField(sip.FieldCustomMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SipSourceID(); ok {
+		_spec.SetField(sip.FieldSipSourceID, field.TypeUUID, value)
+	}
+	if _u.mutation.SipSourceIDCleared() {
+		_spec.ClearField(sip.FieldSipSourceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.LocationID(); ok {
+		_spec.SetField(sip.FieldLocationID, field.TypeUUID, value)
+	}
+	if _u.mutation.LocationIDCleared() {
+		_spec.ClearField(sip.FieldLocationID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ExpectedChecksum(); ok {
+		_spec.SetField(sip.FieldExpectedChecksum, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedChecksumCleared() {
+		_spec.ClearField(sip.FieldExpectedChecksum, field.TypeString)
+	}
+	if value, ok := _u.mutation.WatcherName(); ok {
+		_spec.SetField(sip.FieldWatcherName, field.TypeString, value)
+	}
+	if _u.mutation.WatcherNameCleared() {
+		_spec.ClearField(sip.FieldWatcherName, field.TypeString)
+	}
 	if _u.mutation.WorkflowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -815,6 +964,118 @@ func (_u *SIPUpdateOne) ClearChecksumHash() *SIPUpdateOne {
 	return _u
 }
 
+// SetProcessingProfile sets the "processing_profile" field.
+func (_u *SIPUpdateOne) SetProcessingProfile(v string) *SIPUpdateOne {
+	_u.mutation.SetProcessingProfile(v)
+	return _u
+}
+
+// SetNillableProcessingProfile sets the "processing_profile" field if the given value is not nil.
+func (_u *SIPUpdateOne) SetNillableProcessingProfile(v *string) *SIPUpdateOne {
+	if v != nil {
+		_u.SetProcessingProfile(*v)
+	}
+	return _u
+}
+
+// ClearProcessingProfile clears the value of the "processing_profile" field.
+func (_u *SIPUpdateOne) ClearProcessingProfile() *SIPUpdateOne {
+	_u.mutation.ClearProcessingProfile()
+	return _u
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (_u *SIPUpdateOne) SetCustomMetadata(v map[string]jsontext.Value) *SIPUpdateOne {
+	_u.mutation.SetCustomMetadata(v)
+	return _u
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (_u *SIPUpdateOne) ClearCustomMetadata() *SIPUpdateOne {
+	_u.mutation.ClearCustomMetadata()
+	return _u
+}
+
+// SetSipSourceID sets the "sip_source_id" field.
+func (_u *SIPUpdateOne) SetSipSourceID(v uuid.UUID) *SIPUpdateOne {
+	_u.mutation.SetSipSourceID(v)
+	return _u
+}
+
+// SetNillableSipSourceID sets the "sip_source_id" field if the given value is not nil.
+func (_u *SIPUpdateOne) SetNillableSipSourceID(v *uuid.UUID) *SIPUpdateOne {
+	if v != nil {
+		_u.SetSipSourceID(*v)
+	}
+	return _u
+}
+
+// ClearSipSourceID clears the value of the "sip_source_id" field.
+func (_u *SIPUpdateOne) ClearSipSourceID() *SIPUpdateOne {
+	_u.mutation.ClearSipSourceID()
+	return _u
+}
+
+// SetLocationID sets the "location_id" field.
+func (_u *SIPUpdateOne) SetLocationID(v uuid.UUID) *SIPUpdateOne {
+	_u.mutation.SetLocationID(v)
+	return _u
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (_u *SIPUpdateOne) SetNillableLocationID(v *uuid.UUID) *SIPUpdateOne {
+	if v != nil {
+		_u.SetLocationID(*v)
+	}
+	return _u
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (_u *SIPUpdateOne) ClearLocationID() *SIPUpdateOne {
+	_u.mutation.ClearLocationID()
+	return _u
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (_u *SIPUpdateOne) SetExpectedChecksum(v string) *SIPUpdateOne {
+	_u.mutation.SetExpectedChecksum(v)
+	return _u
+}
+
+// SetNillableExpectedChecksum sets the "expected_checksum" field if the given value is not nil.
+func (_u *SIPUpdateOne) SetNillableExpectedChecksum(v *string) *SIPUpdateOne {
+	if v != nil {
+		_u.SetExpectedChecksum(*v)
+	}
+	return _u
+}
+
+// ClearExpectedChecksum clears the value of the "expected_checksum" field.
+func (_u *SIPUpdateOne) ClearExpectedChecksum() *SIPUpdateOne {
+	_u.mutation.ClearExpectedChecksum()
+	return _u
+}
+
+// SetWatcherName sets the "watcher_name" field.
+func (_u *SIPUpdateOne) SetWatcherName(v string) *SIPUpdateOne {
+	_u.mutation.SetWatcherName(v)
+	return _u
+}
+
+// SetNillableWatcherName sets the "watcher_name" field if the given value is not nil.
+func (_u *SIPUpdateOne) SetNillableWatcherName(v *string) *SIPUpdateOne {
+	if v != nil {
+		_u.SetWatcherName(*v)
+	}
+	return _u
+}
+
+// ClearWatcherName clears the value of the "watcher_name" field.
+func (_u *SIPUpdateOne) ClearWatcherName() *SIPUpdateOne {
+	_u.mutation.ClearWatcherName()
+	return _u
+}
+
 // AddWorkflowIDs adds the "workflows" edge to the Workflow entity by IDs.
 func (_u *SIPUpdateOne) AddWorkflowIDs(ids ...int) *SIPUpdateOne {
 	_u.mutation.AddWorkflowIDs(ids...)
@@ -1034,6 +1295,42 @@ func (_u *SIPUpdateOne) sqlSave(ctx context.Context) (_node *SIP, err error) {
 	if _u.mutation.ChecksumHashCleared() {
 		_spec.ClearField(sip.FieldChecksumHash, field.TypeString)
 	}
+	if value, ok := _u.mutation.ProcessingProfile(); ok {
+		_spec.SetField(sip.FieldProcessingProfile, field.TypeString, value)
+	}
+	if _u.mutation.ProcessingProfileCleared() {
+		_spec.ClearField(sip.FieldProcessingProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.CustomMetadata(); ok {
+		_spec.SetField(sip.FieldCustomMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.CustomMetadataCleared() {
+		_spec.ClearField(sip.FieldCustomMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SipSourceID(); ok {
+		_spec.SetField(sip.FieldSipSourceID, field.TypeUUID, value)
+	}
+	if _u.mutation.SipSourceIDCleared() {
+		_spec.ClearField(sip.FieldSipSourceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.LocationID(); ok {
+		_spec.SetField(sip.FieldLocationID, field.TypeUUID, value)
+	}
+	if _u.mutation.LocationIDCleared() {
+		_spec.ClearField(sip.FieldLocationID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ExpectedChecksum(); ok {
+		_spec.SetField(sip.FieldExpectedChecksum, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedChecksumCleared() {
+		_spec.ClearField(sip.FieldExpectedChecksum, field.TypeString)
+	}
+	if value, ok := _u.mutation.WatcherName(); ok {
+		_spec.SetField(sip.FieldWatcherName, field.TypeString, value)
+	}
+	if _u.mutation.WatcherNameCleared() {
+		_spec.ClearField(sip.FieldWatcherName, field.TypeString)
+	}
 	if _u.mutation.WorkflowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -42,9 +42,11 @@ type UserEdges struct {
 	UploadedSips []*SIP `json:"uploaded_sips,omitempty"`
 	// UploadedBatches holds the value of the uploaded_batches edge.
 	UploadedBatches []*Batch `json:"uploaded_batches,omitempty"`
+	// APITokens holds the value of the api_tokens edge.
+	APITokens []*APIToken `json:"api_tokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UploadedSipsOrErr returns the UploadedSips value or an error if the edge
@@ -65,6 +67,15 @@ func (e UserEdges) UploadedBatchesOrErr() ([]*Batch, error) {
 	return nil, &NotLoadedError{edge: "uploaded_batches"}
 }
 
+// APITokensOrErr returns the APITokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APITokensOrErr() ([]*APIToken, error) {
+	if e.loadedTypes[2] {
+		return e.APITokens, nil
+	}
+	return nil, &NotLoadedError{edge: "api_tokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -158,6 +169,11 @@ func (_m *User) QueryUploadedBatches() *BatchQuery {
 	return NewUserClient(_m.config).QueryUploadedBatches(_m)
 }
 
+// QueryAPITokens queries the "api_tokens" edge of the User entity.
+func (_m *User) QueryAPITokens() *APITokenQuery {
+	return NewUserClient(_m.config).QueryAPITokens(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -30,6 +30,8 @@ const (
 	EdgeUploadedSips = "uploaded_sips"
 	// EdgeUploadedBatches holds the string denoting the uploaded_batches edge name in mutations.
 	EdgeUploadedBatches = "uploaded_batches"
+	// EdgeAPITokens holds the string denoting the api_tokens edge name in mutations.
+	EdgeAPITokens = "api_tokens"
 	// Table holds the table name of the user in the database.
 	Table = "user"
 	// UploadedSipsTable is the table that holds the uploaded_sips relation/edge.
@@ -46,6 +48,13 @@ const (
 	UploadedBatchesInverseTable = "batch"
 	// UploadedBatchesColumn is the table column denoting the uploaded_batches relation/edge.
 	UploadedBatchesColumn = "uploader_id"
+	// APITokensTable is the table that holds the api_tokens relation/edge.
+	APITokensTable = "api_token"
+	// APITokensInverseTable is the table name for the APIToken entity.
+	// It exists in this package in order to avoid circular dependency with the "apitoken" package.
+	APITokensInverseTable = "api_token"
+	// APITokensColumn is the table column denoting the api_tokens relation/edge.
+	APITokensColumn = "owner_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -139,6 +148,20 @@ func ByUploadedBatches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUploadedBatchesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAPITokensCount orders the results by api_tokens count.
+func ByAPITokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPITokensStep(), opts...)
+	}
+}
+
+// ByAPITokens orders the results by api_tokens terms.
+func ByAPITokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPITokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUploadedSipsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -153,3 +176,10 @@ func newUploadedBatchesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, UploadedBatchesTable, UploadedBatchesColumn),
 	)
 }
+func newAPITokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APITokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APITokensTable, APITokensColumn),
+	)
+}
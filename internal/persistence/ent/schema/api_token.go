@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIToken holds the schema definition for the APIToken entity.
+type APIToken struct {
+	ent.Schema
+}
+
+// Annotations of the APIToken.
+func (APIToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "api_token"},
+	}
+}
+
+// Fields of the APIToken.
+func (APIToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("uuid", uuid.UUID{}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			Annotations(entsql.Annotation{
+				Size: 255,
+			}),
+		// token_hash is the hex encoded SHA-256 hash of the token secret, the
+		// secret itself is never stored.
+		field.String("token_hash").
+			Unique().
+			Immutable().
+			Sensitive().
+			Annotations(entsql.Annotation{
+				Size: 64,
+			}),
+		// attributes are the access control attributes granted to the token,
+		// nil when access control was disabled for its owner.
+		field.JSON("attributes", []string{}).
+			Optional(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("expires_at").
+			Optional(),
+		field.Time("last_used_at").
+			Optional(),
+		field.Time("revoked_at").
+			Optional(),
+		field.Int("owner_id").
+			Positive(),
+	}
+}
+
+// Edges of the APIToken.
+func (APIToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Field("owner_id").
+			Ref("api_tokens").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the APIToken.
+func (APIToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id").
+			StorageKey("api_token_owner_id_idx"),
+	}
+}
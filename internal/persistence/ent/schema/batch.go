@@ -50,6 +50,10 @@ func (Batch) Fields() []ent.Field {
 		field.Int("uploader_id").
 			Optional().
 			Positive(),
+		// sip_source_id is the identifier of the SIP source the batch was
+		// added from.
+		field.UUID("sip_source_id", uuid.UUID{}).
+			Optional(),
 	}
 }
 
@@ -73,6 +77,8 @@ func (Batch) Indexes() []ent.Index {
 			Annotations(entsql.Prefix(50)),
 		index.Fields("status").
 			StorageKey("batch_status_idx"),
+		index.Fields("sip_source_id").
+			StorageKey("batch_sip_source_id_idx"),
 		index.Fields("created_at").
 			StorageKey("batch_created_at_idx"),
 		index.Fields("started_at").
@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"encoding/json"
 	"time"
 
 	"entgo.io/ent"
@@ -70,6 +71,30 @@ func (SIP) Fields() []ent.Field {
 		// is extracted for processing.
 		field.String("checksum_hash").
 			Optional(),
+		// processing_profile is the name of the processing profile selected
+		// for the SIP, if any.
+		field.String("processing_profile").
+			Optional(),
+		// custom_metadata is the opaque JSON metadata returned by the child
+		// workflows, merged in the order they ran.
+		field.JSON("custom_metadata", map[string]json.RawMessage{}).
+			Optional(),
+		// sip_source_id is the identifier of the SIP source the SIP was added
+		// from, if any.
+		field.UUID("sip_source_id", uuid.UUID{}).
+			Optional(),
+		// location_id is the identifier of the storage location requested
+		// for the AIP when the SIP was submitted, if any.
+		field.UUID("location_id", uuid.UUID{}).
+			Optional(),
+		// expected_checksum is the checksum of the SIP provided by the
+		// depositor, if any, in the "<algorithm>:<hash>" form.
+		field.String("expected_checksum").
+			Optional(),
+		// watcher_name is the name of the watcher that received the SIP, if
+		// any.
+		field.String("watcher_name").
+			Optional(),
 	}
 }
 
@@ -99,6 +124,8 @@ func (SIP) Indexes() []ent.Index {
 			StorageKey("sip_aip_id_idx"),
 		index.Fields("status").
 			StorageKey("sip_status_idx"),
+		index.Fields("sip_source_id").
+			StorageKey("sip_sip_source_id_idx"),
 		index.Fields("created_at").
 			StorageKey("sip_created_at_idx"),
 		index.Fields("started_at").
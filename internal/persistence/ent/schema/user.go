@@ -61,6 +61,8 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.SetNull)),
 		edge.To("uploaded_batches", Batch.Type).
 			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("api_tokens", APIToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
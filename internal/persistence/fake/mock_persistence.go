@@ -15,6 +15,7 @@ import (
 
 	datatypes "github.com/artefactual-sdps/enduro/internal/datatypes"
 	persistence "github.com/artefactual-sdps/enduro/internal/persistence"
+	search "github.com/artefactual-sdps/enduro/internal/search"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -43,6 +44,44 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateAPIToken mocks base method.
+func (m *MockService) CreateAPIToken(arg0 context.Context, arg1 *datatypes.APIToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockServiceMockRecorder) CreateAPIToken(arg0, arg1 any) *MockServiceCreateAPITokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockService)(nil).CreateAPIToken), arg0, arg1)
+	return &MockServiceCreateAPITokenCall{Call: call}
+}
+
+// MockServiceCreateAPITokenCall wrap *gomock.Call
+type MockServiceCreateAPITokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCreateAPITokenCall) Return(arg0 error) *MockServiceCreateAPITokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCreateAPITokenCall) Do(f func(context.Context, *datatypes.APIToken) error) *MockServiceCreateAPITokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCreateAPITokenCall) DoAndReturn(f func(context.Context, *datatypes.APIToken) error) *MockServiceCreateAPITokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateBatch mocks base method.
 func (m *MockService) CreateBatch(arg0 context.Context, arg1 *datatypes.Batch) error {
 	m.ctrl.T.Helper()
@@ -347,6 +386,121 @@ func (c *MockServiceDeleteSIPCall) DoAndReturn(f func(context.Context, uuid.UUID
 	return c
 }
 
+// DeleteSearchDocument mocks base method.
+func (m *MockService) DeleteSearchDocument(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSearchDocument", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSearchDocument indicates an expected call of DeleteSearchDocument.
+func (mr *MockServiceMockRecorder) DeleteSearchDocument(arg0, arg1 any) *MockServiceDeleteSearchDocumentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSearchDocument", reflect.TypeOf((*MockService)(nil).DeleteSearchDocument), arg0, arg1)
+	return &MockServiceDeleteSearchDocumentCall{Call: call}
+}
+
+// MockServiceDeleteSearchDocumentCall wrap *gomock.Call
+type MockServiceDeleteSearchDocumentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceDeleteSearchDocumentCall) Return(arg0 error) *MockServiceDeleteSearchDocumentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceDeleteSearchDocumentCall) Do(f func(context.Context, uuid.UUID) error) *MockServiceDeleteSearchDocumentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceDeleteSearchDocumentCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockServiceDeleteSearchDocumentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IndexSearchDocument mocks base method.
+func (m *MockService) IndexSearchDocument(arg0 context.Context, arg1 *search.Document) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexSearchDocument", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexSearchDocument indicates an expected call of IndexSearchDocument.
+func (mr *MockServiceMockRecorder) IndexSearchDocument(arg0, arg1 any) *MockServiceIndexSearchDocumentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexSearchDocument", reflect.TypeOf((*MockService)(nil).IndexSearchDocument), arg0, arg1)
+	return &MockServiceIndexSearchDocumentCall{Call: call}
+}
+
+// MockServiceIndexSearchDocumentCall wrap *gomock.Call
+type MockServiceIndexSearchDocumentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceIndexSearchDocumentCall) Return(arg0 error) *MockServiceIndexSearchDocumentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceIndexSearchDocumentCall) Do(f func(context.Context, *search.Document) error) *MockServiceIndexSearchDocumentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceIndexSearchDocumentCall) DoAndReturn(f func(context.Context, *search.Document) error) *MockServiceIndexSearchDocumentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAPITokens mocks base method.
+func (m *MockService) ListAPITokens(ctx context.Context, ownerID uuid.UUID) ([]*datatypes.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokens", ctx, ownerID)
+	ret0, _ := ret[0].([]*datatypes.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockServiceMockRecorder) ListAPITokens(ctx, ownerID any) *MockServiceListAPITokensCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockService)(nil).ListAPITokens), ctx, ownerID)
+	return &MockServiceListAPITokensCall{Call: call}
+}
+
+// MockServiceListAPITokensCall wrap *gomock.Call
+type MockServiceListAPITokensCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListAPITokensCall) Return(arg0 []*datatypes.APIToken, arg1 error) *MockServiceListAPITokensCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListAPITokensCall) Do(f func(context.Context, uuid.UUID) ([]*datatypes.APIToken, error)) *MockServiceListAPITokensCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListAPITokensCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]*datatypes.APIToken, error)) *MockServiceListAPITokensCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListBatches mocks base method.
 func (m *MockService) ListBatches(arg0 context.Context, arg1 *persistence.BatchFilter) ([]*datatypes.Batch, *persistence.Page, error) {
 	m.ctrl.T.Helper()
@@ -506,6 +660,84 @@ func (c *MockServiceListWorkflowsBySIPCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// ReadAPIToken mocks base method.
+func (m *MockService) ReadAPIToken(arg0 context.Context, arg1 uuid.UUID) (*datatypes.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAPIToken", arg0, arg1)
+	ret0, _ := ret[0].(*datatypes.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAPIToken indicates an expected call of ReadAPIToken.
+func (mr *MockServiceMockRecorder) ReadAPIToken(arg0, arg1 any) *MockServiceReadAPITokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAPIToken", reflect.TypeOf((*MockService)(nil).ReadAPIToken), arg0, arg1)
+	return &MockServiceReadAPITokenCall{Call: call}
+}
+
+// MockServiceReadAPITokenCall wrap *gomock.Call
+type MockServiceReadAPITokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceReadAPITokenCall) Return(arg0 *datatypes.APIToken, arg1 error) *MockServiceReadAPITokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceReadAPITokenCall) Do(f func(context.Context, uuid.UUID) (*datatypes.APIToken, error)) *MockServiceReadAPITokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceReadAPITokenCall) DoAndReturn(f func(context.Context, uuid.UUID) (*datatypes.APIToken, error)) *MockServiceReadAPITokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadAPITokenByHash mocks base method.
+func (m *MockService) ReadAPITokenByHash(ctx context.Context, hash string) (*datatypes.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAPITokenByHash", ctx, hash)
+	ret0, _ := ret[0].(*datatypes.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAPITokenByHash indicates an expected call of ReadAPITokenByHash.
+func (mr *MockServiceMockRecorder) ReadAPITokenByHash(ctx, hash any) *MockServiceReadAPITokenByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAPITokenByHash", reflect.TypeOf((*MockService)(nil).ReadAPITokenByHash), ctx, hash)
+	return &MockServiceReadAPITokenByHashCall{Call: call}
+}
+
+// MockServiceReadAPITokenByHashCall wrap *gomock.Call
+type MockServiceReadAPITokenByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceReadAPITokenByHashCall) Return(arg0 *datatypes.APIToken, arg1 error) *MockServiceReadAPITokenByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceReadAPITokenByHashCall) Do(f func(context.Context, string) (*datatypes.APIToken, error)) *MockServiceReadAPITokenByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceReadAPITokenByHashCall) DoAndReturn(f func(context.Context, string) (*datatypes.APIToken, error)) *MockServiceReadAPITokenByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReadBatch mocks base method.
 func (m *MockService) ReadBatch(arg0 context.Context, arg1 uuid.UUID) (*datatypes.Batch, error) {
 	m.ctrl.T.Helper()
@@ -701,6 +933,85 @@ func (c *MockServiceReadWorkflowCall) DoAndReturn(f func(context.Context, int) (
 	return c
 }
 
+// SearchDocuments mocks base method.
+func (m *MockService) SearchDocuments(arg0 context.Context, arg1 *search.Query) ([]*search.Result, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchDocuments", arg0, arg1)
+	ret0, _ := ret[0].([]*search.Result)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchDocuments indicates an expected call of SearchDocuments.
+func (mr *MockServiceMockRecorder) SearchDocuments(arg0, arg1 any) *MockServiceSearchDocumentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchDocuments", reflect.TypeOf((*MockService)(nil).SearchDocuments), arg0, arg1)
+	return &MockServiceSearchDocumentsCall{Call: call}
+}
+
+// MockServiceSearchDocumentsCall wrap *gomock.Call
+type MockServiceSearchDocumentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSearchDocumentsCall) Return(arg0 []*search.Result, arg1 int, arg2 error) *MockServiceSearchDocumentsCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSearchDocumentsCall) Do(f func(context.Context, *search.Query) ([]*search.Result, int, error)) *MockServiceSearchDocumentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSearchDocumentsCall) DoAndReturn(f func(context.Context, *search.Query) ([]*search.Result, int, error)) *MockServiceSearchDocumentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateAPIToken mocks base method.
+func (m *MockService) UpdateAPIToken(arg0 context.Context, arg1 uuid.UUID, arg2 persistence.APITokenUpdater) (*datatypes.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAPIToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*datatypes.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAPIToken indicates an expected call of UpdateAPIToken.
+func (mr *MockServiceMockRecorder) UpdateAPIToken(arg0, arg1, arg2 any) *MockServiceUpdateAPITokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAPIToken", reflect.TypeOf((*MockService)(nil).UpdateAPIToken), arg0, arg1, arg2)
+	return &MockServiceUpdateAPITokenCall{Call: call}
+}
+
+// MockServiceUpdateAPITokenCall wrap *gomock.Call
+type MockServiceUpdateAPITokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceUpdateAPITokenCall) Return(arg0 *datatypes.APIToken, arg1 error) *MockServiceUpdateAPITokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceUpdateAPITokenCall) Do(f func(context.Context, uuid.UUID, persistence.APITokenUpdater) (*datatypes.APIToken, error)) *MockServiceUpdateAPITokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceUpdateAPITokenCall) DoAndReturn(f func(context.Context, uuid.UUID, persistence.APITokenUpdater) (*datatypes.APIToken, error)) *MockServiceUpdateAPITokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateBatch mocks base method.
 func (m *MockService) UpdateBatch(arg0 context.Context, arg1 uuid.UUID, arg2 persistence.BatchUpdater) (*datatypes.Batch, error) {
 	m.ctrl.T.Helper()
@@ -34,6 +34,14 @@ func (p *Page) Goa() *goaingest.EnduroPage {
 	}
 }
 
+// ResourceScope limits the results to the ones related to any of the given
+// batches or SIP sources. It's used to apply the resource scoped attributes of
+// the user, a nil scope doesn't limit the results.
+type ResourceScope struct {
+	BatchIDs     []uuid.UUID
+	SIPSourceIDs []uuid.UUID
+}
+
 type SIPFilter struct {
 	// Name filters for SIPs whose names contain the given string.
 	Name *string
@@ -46,6 +54,12 @@ type SIPFilter struct {
 	ChecksumAlgorithm *string
 	ChecksumHash      *string
 
+	// MetadataKey filters for SIPs whose custom metadata has the given key.
+	MetadataKey *string
+
+	// Scope limits the SIPs to the ones in the given batches or SIP sources.
+	Scope *ResourceScope
+
 	entfilter.Sort
 	Page
 }
@@ -67,6 +81,9 @@ type BatchFilter struct {
 	CreatedAt  *timerange.Range
 	UploaderID *uuid.UUID
 
+	// Scope limits the batches to the given batches or SIP sources.
+	Scope *ResourceScope
+
 	entfilter.Sort
 	Page
 }
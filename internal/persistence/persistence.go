@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/search"
 )
 
 var (
@@ -25,6 +26,7 @@ type (
 	TaskUpdater     func(*datatypes.Task) (*datatypes.Task, error)
 	BatchUpdater    func(*datatypes.Batch) (*datatypes.Batch, error)
 	WorkflowUpdater func(*datatypes.Workflow) (*datatypes.Workflow, error)
+	APITokenUpdater func(*datatypes.APIToken) (*datatypes.APIToken, error)
 )
 
 type Service interface {
@@ -63,9 +65,31 @@ type Service interface {
 	// ListUsers retrieves a list of users based on the provided filter.
 	ListUsers(context.Context, *UserFilter) ([]*datatypes.User, *Page, error)
 
+	// CreateAPIToken persists a new API token, finding or creating its owner
+	// by OIDC issuer and subject, then updates the API token to add
+	// auto-generated data (e.g. ID, CreatedAt).
+	CreateAPIToken(context.Context, *datatypes.APIToken) error
+	// ReadAPIToken retrieves an API token and its owner by UUID.
+	ReadAPIToken(context.Context, uuid.UUID) (*datatypes.APIToken, error)
+	// ReadAPITokenByHash retrieves an API token and its owner by the hash of
+	// the token secret.
+	ReadAPITokenByHash(ctx context.Context, hash string) (*datatypes.APIToken, error)
+	// ListAPITokens retrieves the API tokens owned by a user.
+	ListAPITokens(ctx context.Context, ownerID uuid.UUID) ([]*datatypes.APIToken, error)
+	UpdateAPIToken(context.Context, uuid.UUID, APITokenUpdater) (*datatypes.APIToken, error)
+
 	CreateBatch(context.Context, *datatypes.Batch) error
 	UpdateBatch(context.Context, uuid.UUID, BatchUpdater) (*datatypes.Batch, error)
 	DeleteBatch(context.Context, uuid.UUID) error
 	ReadBatch(context.Context, uuid.UUID) (*datatypes.Batch, error)
 	ListBatches(context.Context, *BatchFilter) ([]*datatypes.Batch, *Page, error)
+
+	// IndexSearchDocument adds a document to the search index, replacing any
+	// document with the same UUID.
+	IndexSearchDocument(context.Context, *search.Document) error
+	// DeleteSearchDocument removes a document from the search index.
+	DeleteSearchDocument(context.Context, uuid.UUID) error
+	// SearchDocuments returns the page of search documents matching a query
+	// and the total number of matching documents.
+	SearchDocuments(context.Context, *search.Query) ([]*search.Result, int, error)
 }
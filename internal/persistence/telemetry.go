@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/telemetry"
 )
 
@@ -256,6 +257,78 @@ func (w *wrapper) ListUsers(ctx context.Context, f *UserFilter) ([]*datatypes.Us
 	return r, p, nil
 }
 
+func (w *wrapper) CreateAPIToken(ctx context.Context, t *datatypes.APIToken) error {
+	ctx, span := w.tracer.Start(ctx, "CreateAPIToken")
+	defer span.End()
+
+	err := w.wrapped.CreateAPIToken(ctx, t)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "CreateAPIToken")
+	}
+
+	return nil
+}
+
+func (w *wrapper) ReadAPIToken(ctx context.Context, id uuid.UUID) (*datatypes.APIToken, error) {
+	ctx, span := w.tracer.Start(ctx, "ReadAPIToken")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id.String()))
+
+	r, err := w.wrapped.ReadAPIToken(ctx, id)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, updateError(err, "ReadAPIToken")
+	}
+
+	return r, nil
+}
+
+func (w *wrapper) ReadAPITokenByHash(ctx context.Context, hash string) (*datatypes.APIToken, error) {
+	ctx, span := w.tracer.Start(ctx, "ReadAPITokenByHash")
+	defer span.End()
+
+	r, err := w.wrapped.ReadAPITokenByHash(ctx, hash)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, updateError(err, "ReadAPITokenByHash")
+	}
+
+	return r, nil
+}
+
+func (w *wrapper) ListAPITokens(ctx context.Context, ownerID uuid.UUID) ([]*datatypes.APIToken, error) {
+	ctx, span := w.tracer.Start(ctx, "ListAPITokens")
+	defer span.End()
+	span.SetAttributes(attribute.String("ownerID", ownerID.String()))
+
+	r, err := w.wrapped.ListAPITokens(ctx, ownerID)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, updateError(err, "ListAPITokens")
+	}
+
+	return r, nil
+}
+
+func (w *wrapper) UpdateAPIToken(
+	ctx context.Context,
+	id uuid.UUID,
+	updater APITokenUpdater,
+) (*datatypes.APIToken, error) {
+	ctx, span := w.tracer.Start(ctx, "UpdateAPIToken")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id.String()))
+
+	r, err := w.wrapped.UpdateAPIToken(ctx, id, updater)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, updateError(err, "UpdateAPIToken")
+	}
+
+	return r, nil
+}
+
 func (w *wrapper) CreateBatch(ctx context.Context, p *datatypes.Batch) error {
 	ctx, span := w.tracer.Start(ctx, "CreateBatch")
 	defer span.End()
@@ -323,3 +396,44 @@ func (w *wrapper) ListBatches(ctx context.Context, f *BatchFilter) ([]*datatypes
 
 	return r, pg, nil
 }
+
+func (w *wrapper) IndexSearchDocument(ctx context.Context, doc *search.Document) error {
+	ctx, span := w.tracer.Start(ctx, "IndexSearchDocument")
+	defer span.End()
+	span.SetAttributes(attribute.String("uuid", doc.UUID.String()))
+
+	err := w.wrapped.IndexSearchDocument(ctx, doc)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "IndexSearchDocument")
+	}
+
+	return nil
+}
+
+func (w *wrapper) DeleteSearchDocument(ctx context.Context, id uuid.UUID) error {
+	ctx, span := w.tracer.Start(ctx, "DeleteSearchDocument")
+	defer span.End()
+	span.SetAttributes(attribute.String("uuid", id.String()))
+
+	err := w.wrapped.DeleteSearchDocument(ctx, id)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "DeleteSearchDocument")
+	}
+
+	return nil
+}
+
+func (w *wrapper) SearchDocuments(ctx context.Context, q *search.Query) ([]*search.Result, int, error) {
+	ctx, span := w.tracer.Start(ctx, "SearchDocuments")
+	defer span.End()
+
+	r, total, err := w.wrapped.SearchDocuments(ctx, q)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, 0, updateError(err, "SearchDocuments")
+	}
+
+	return r, total, nil
+}
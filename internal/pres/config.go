@@ -1,5 +1,15 @@
 package pres
 
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	minCompressionLevel = 0
+	maxCompressionLevel = 9
+)
+
 type Config struct {
 	// TaskQueue sets the Temporal task queue to use for the processing workflow
 	// (e.g. ` temporal.A3mWorkerTaskQueue`, ` temporal.AMWorkerTaskQueue`).
@@ -7,4 +17,92 @@ type Config struct {
 	// workflow - a3m or Archivematica, and is used to branch the processing
 	// workflow logic.
 	TaskQueue string
+
+	// Profiles lists the named processing profiles that can be selected per
+	// SIP to override the default processing configuration of the
+	// preservation system.
+	Profiles Profiles
+}
+
+func (c Config) Validate() error {
+	return c.Profiles.Validate()
+}
+
+// Profile is a named set of processing settings.
+type Profile struct {
+	// Name identifies the profile in the watcher, SIP source and API
+	// configurations.
+	Name string
+
+	// AMProcessingConfig is the name of the Archivematica processing
+	// configuration used for the SIP. If empty, the processingConfig value of
+	// the [am] configuration is used.
+	AMProcessingConfig string
+
+	// A3m overrides the [a3m.processing] configuration for the SIP. Settings
+	// that are not set keep their [a3m.processing] value.
+	A3m A3mProcessing
+}
+
+// A3mProcessing mirrors the a3m.Processing configuration with optional values.
+type A3mProcessing struct {
+	AssignUuidsToDirectories                     *bool
+	ExamineContents                              *bool
+	GenerateTransferStructureReport              *bool
+	DocumentEmptyDirectories                     *bool
+	ExtractPackages                              *bool
+	DeletePackagesAfterExtraction                *bool
+	IdentifyTransfer                             *bool
+	IdentifySubmissionAndMetadata                *bool
+	IdentifyBeforeNormalization                  *bool
+	Normalize                                    *bool
+	TranscribeFiles                              *bool
+	PerformPolicyChecksOnOriginals               *bool
+	PerformPolicyChecksOnPreservationDerivatives *bool
+	AipCompressionLevel                          *int32
+	AipCompressionAlgorithm                      *int32
+}
+
+type Profiles []*Profile
+
+// ByName returns the profile with the given name, or nil if there is none.
+func (p Profiles) ByName(name string) *Profile {
+	for _, profile := range p {
+		if profile != nil && profile.Name == name {
+			return profile
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that every profile has a unique name and valid settings.
+func (p Profiles) Validate() error {
+	var errs error
+	names := make(map[string]struct{}, len(p))
+	for i, profile := range p {
+		if profile == nil {
+			continue
+		}
+		if profile.Name == "" {
+			errs = errors.Join(errs, fmt.Errorf("[[preservation.profiles]] %d: missing name", i+1))
+			continue
+		}
+		if _, ok := names[profile.Name]; ok {
+			errs = errors.Join(errs, fmt.Errorf("[[preservation.profiles]] %q: duplicate name", profile.Name))
+		}
+		names[profile.Name] = struct{}{}
+
+		if l := profile.A3m.AipCompressionLevel; l != nil && (*l < minCompressionLevel || *l > maxCompressionLevel) {
+			errs = errors.Join(errs, fmt.Errorf(
+				"[[preservation.profiles]] %q: AipCompressionLevel: %d is outside valid range (%d to %d)",
+				profile.Name,
+				*l,
+				minCompressionLevel,
+				maxCompressionLevel,
+			))
+		}
+	}
+
+	return errs
 }
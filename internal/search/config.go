@@ -0,0 +1,56 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// BackendSQL indexes documents in the ingest and storage databases using
+	// their full-text search capabilities. It's the default backend.
+	BackendSQL = "sql"
+
+	// BackendNone disables indexing, searches return no results.
+	BackendNone = "none"
+)
+
+type Config struct {
+	// Backend is the name of the search backend: "sql" (default) or "none".
+	Backend string
+}
+
+// Validate implements config.ConfigurationValidator.
+func (c Config) Validate() error {
+	switch c.Backend {
+	case "", BackendSQL, BackendNone:
+		return nil
+	default:
+		return fmt.Errorf("invalid backend in [search] configuration: %q", c.Backend)
+	}
+}
+
+// New returns the search backend selected by cfg. store keeps the documents
+// when the "sql" backend is selected.
+func New(cfg Config, store Store) (Backend, error) {
+	switch cfg.Backend {
+	case "", BackendSQL:
+		return NewSQLBackend(store), nil
+	case BackendNone:
+		return NopBackend{}, nil
+	default:
+		return nil, fmt.Errorf("search: unknown backend %q", cfg.Backend)
+	}
+}
+
+// NopBackend is a Backend that doesn't index any document.
+type NopBackend struct{}
+
+var _ Backend = NopBackend{}
+
+func (NopBackend) Index(context.Context, *Document) error { return nil }
+
+func (NopBackend) Delete(context.Context, uuid.UUID) error { return nil }
+
+func (NopBackend) Search(context.Context, *Query) ([]*Result, int, error) { return nil, 0, nil }
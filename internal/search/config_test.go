@@ -0,0 +1,37 @@
+package search_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/search"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range []string{"", search.BackendSQL, search.BackendNone} {
+		assert.NilError(t, search.Config{Backend: backend}.Validate())
+	}
+
+	err := search.Config{Backend: "solr"}.Validate()
+	assert.Error(t, err, `invalid backend in [search] configuration: "solr"`)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	b, err := search.New(search.Config{}, nil)
+	assert.NilError(t, err)
+	assert.Assert(t, b != nil)
+	_, ok := b.(*search.SQLBackend)
+	assert.Assert(t, ok)
+
+	b, err = search.New(search.Config{Backend: search.BackendNone}, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, b, search.Backend(search.NopBackend{}))
+
+	_, err = search.New(search.Config{Backend: "solr"}, nil)
+	assert.Error(t, err, `search: unknown backend "solr"`)
+}
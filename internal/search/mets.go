@@ -0,0 +1,207 @@
+package search
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/mholt/archives"
+)
+
+const (
+	dcNamespace      = "http://purl.org/dc/elements/1.1/"
+	dctermsNamespace = "http://purl.org/dc/terms/"
+
+	// AccessionNumberField is the name of the field holding the accession
+	// number recorded in the METS file of an AIP.
+	AccessionNumberField = "accession_number"
+
+	// accessionNotePrefix prefixes the accession number in the outcome note
+	// of the PREMIS registration event.
+	accessionNotePrefix = "accession#"
+)
+
+// ErrMETSNotFound is returned when an AIP doesn't include a METS file.
+var ErrMETSNotFound = errors.New("METS file not found")
+
+var errMETSFound = errors.New("METS file found")
+
+// ReadAIPMETS returns the document fields found in the METS file of the AIP
+// archive at aipPath.
+func ReadAIPMETS(ctx context.Context, aipPath string) (map[string]string, error) {
+	f, err := os.Open(aipPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	format, stream, err := archives.Identify(ctx, f.Name(), f)
+	if err != nil {
+		return nil, err
+	}
+	ex, ok := format.(archives.Extractor)
+	if !ok {
+		return nil, fmt.Errorf("unsupported archive format: %s", format.Extension())
+	}
+
+	var fields map[string]string
+	err = ex.Extract(ctx, stream, func(ctx context.Context, fi archives.FileInfo) error {
+		if !fi.Mode().IsRegular() || !isMETSFile(fi.NameInArchive) {
+			return nil
+		}
+
+		r, err := fi.Open()
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		fields, err = ParseMETS(r)
+		if err != nil {
+			return fmt.Errorf("parse %s: %v", fi.NameInArchive, err)
+		}
+
+		return errMETSFound
+	})
+	if errors.Is(err, errMETSFound) {
+		return fields, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, ErrMETSNotFound
+}
+
+// isMETSFile reports whether name is the path of the AIP METS file in the
+// payload directory of the AIP bag, e.g. "aip-uuid/data/METS.uuid.xml".
+func isMETSFile(name string) bool {
+	name = path.Clean(name)
+	base := path.Base(name)
+
+	return path.Base(path.Dir(name)) == "data" &&
+		strings.HasPrefix(base, "METS.") &&
+		strings.HasSuffix(base, ".xml")
+}
+
+// ParseMETS returns the Dublin Core elements and the accession number found in
+// an Archivematica METS document. Dublin Core elements are keyed by their
+// prefixed name, e.g. "dc:title", and repeated elements are joined by "; ".
+//
+// Only the descriptive metadata of the "objects" directory is used, which
+// describes the whole AIP. If the structural map doesn't reference it, the
+// first Dublin Core section is used.
+func ParseMETS(r io.Reader) (map[string]string, error) {
+	var (
+		dmdSecs   = map[string]map[string][]string{}
+		firstDC   string
+		objectsDC []string
+
+		dmdSecID   string
+		inDC       bool
+		eventType  string
+		eventNote  string
+		accessions []string
+	)
+
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "dmdSec":
+				dmdSecID = attr(t, "ID")
+			case t.Name.Local == "mdWrap" && dmdSecID != "":
+				inDC = attr(t, "MDTYPE") == "DC"
+			case inDC && t.Name.Local == "dublincore":
+				// Archivematica wraps the elements in a dcterms:dublincore
+				// element, descend into it.
+			case inDC && (t.Name.Space == dcNamespace || t.Name.Space == dctermsNamespace):
+				var v string
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return nil, err
+				}
+				v = strings.TrimSpace(v)
+				if v == "" {
+					continue
+				}
+				if dmdSecs[dmdSecID] == nil {
+					dmdSecs[dmdSecID] = map[string][]string{}
+					if firstDC == "" {
+						firstDC = dmdSecID
+					}
+				}
+				key := "dc:" + t.Name.Local
+				dmdSecs[dmdSecID][key] = append(dmdSecs[dmdSecID][key], v)
+			case t.Name.Local == "div" && attr(t, "LABEL") == "objects":
+				if ids := attr(t, "DMDID"); ids != "" && objectsDC == nil {
+					objectsDC = strings.Fields(ids)
+				}
+			case t.Name.Local == "event":
+				eventType, eventNote = "", ""
+			case t.Name.Local == "eventType":
+				if err := d.DecodeElement(&eventType, &t); err != nil {
+					return nil, err
+				}
+			case t.Name.Local == "eventOutcomeDetailNote":
+				if err := d.DecodeElement(&eventNote, &t); err != nil {
+					return nil, err
+				}
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "dmdSec":
+				dmdSecID, inDC = "", false
+			case "mdWrap":
+				inDC = false
+			case "event":
+				note := strings.TrimSpace(eventNote)
+				if strings.TrimSpace(eventType) == "registration" && strings.HasPrefix(note, accessionNotePrefix) {
+					accessions = append(accessions, strings.TrimPrefix(note, accessionNotePrefix))
+				}
+			}
+		}
+	}
+
+	ids := objectsDC
+	if len(ids) == 0 && firstDC != "" {
+		ids = []string{firstDC}
+	}
+
+	fields := map[string]string{}
+	for _, id := range ids {
+		for k, v := range dmdSecs[id] {
+			if _, ok := fields[k]; !ok {
+				fields[k] = strings.Join(v, "; ")
+			}
+		}
+	}
+	if len(accessions) > 0 {
+		fields[AccessionNumberField] = strings.Join(accessions, "; ")
+	}
+
+	return fields, nil
+}
+
+func attr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+
+	return ""
+}
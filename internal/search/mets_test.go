@@ -0,0 +1,118 @@
+package search_test
+
+import (
+	"archive/tar"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/search"
+)
+
+var wantMETSFields = map[string]string{
+	"dc:title":                  "Annual report",
+	"dc:creator":                "Finance department",
+	"dc:subject":                "Budgets; Accounting",
+	search.AccessionNumberField: "2023-001",
+}
+
+func TestParseMETS(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Parses the objects Dublin Core and accession number", func(t *testing.T) {
+		t.Parallel()
+
+		f, err := os.Open("testdata/METS.xml")
+		assert.NilError(t, err)
+		defer f.Close()
+
+		fields, err := search.ParseMETS(f)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, fields, wantMETSFields)
+	})
+
+	t.Run("Uses the first Dublin Core section without objects metadata", func(t *testing.T) {
+		t.Parallel()
+
+		fields, err := search.ParseMETS(strings.NewReader(`<?xml version="1.0"?>
+<mets:mets xmlns:mets="http://www.loc.gov/METS/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <mets:dmdSec ID="dmdSec_1">
+    <mets:mdWrap MDTYPE="DC">
+      <mets:xmlData><dc:title>Transfer title</dc:title></mets:xmlData>
+    </mets:mdWrap>
+  </mets:dmdSec>
+  <mets:structMap><mets:div LABEL="objects"></mets:div></mets:structMap>
+</mets:mets>`))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, fields, map[string]string{"dc:title": "Transfer title"})
+	})
+
+	t.Run("Errors on invalid XML", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := search.ParseMETS(strings.NewReader("<mets:mets>"))
+		assert.ErrorContains(t, err, "XML syntax error")
+	})
+}
+
+// writeTar writes an AIP tar archive with the given files to a temporary
+// directory and returns its path.
+func writeTar(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	p := filepath.Join(t.TempDir(), "aip.tar")
+	f, err := os.Create(p)
+	assert.NilError(t, err)
+	defer f.Close()
+
+	w := tar.NewWriter(f)
+	for name, content := range files {
+		err := w.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))})
+		assert.NilError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, w.Close())
+
+	return p
+}
+
+func TestReadAIPMETS(t *testing.T) {
+	t.Parallel()
+
+	mets, err := os.ReadFile("testdata/METS.xml")
+	assert.NilError(t, err)
+
+	t.Run("Reads the METS file of the AIP", func(t *testing.T) {
+		t.Parallel()
+
+		p := writeTar(t, map[string]string{
+			"aip/bagit.txt":                                          "BagIt-Version: 0.97\n",
+			"aip/data/objects/METS.fake.xml":                         "<invalid>",
+			"aip/data/METS.0b6a4e1e-8e0e-4c1b-9b8e-2b3c7f1d5a6e.xml": string(mets),
+		})
+
+		fields, err := search.ReadAIPMETS(t.Context(), p)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, fields, wantMETSFields)
+	})
+
+	t.Run("Errors when the AIP has no METS file", func(t *testing.T) {
+		t.Parallel()
+
+		p := writeTar(t, map[string]string{"aip/bagit.txt": "BagIt-Version: 0.97\n"})
+
+		_, err := search.ReadAIPMETS(t.Context(), p)
+		assert.ErrorIs(t, err, search.ErrMETSNotFound)
+	})
+
+	t.Run("Errors when the AIP doesn't exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := search.ReadAIPMETS(t.Context(), filepath.Join(t.TempDir(), "missing.tar"))
+		assert.ErrorContains(t, err, "no such file or directory")
+	})
+}
@@ -0,0 +1,125 @@
+// Package search indexes SIPs and AIPs so they can be found by their names,
+// identifiers and descriptive metadata.
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// Document is the indexed representation of a SIP or an AIP.
+type Document struct {
+	// UUID identifies the SIP or AIP.
+	UUID uuid.UUID
+
+	// Name is the name of the SIP or AIP.
+	Name string
+
+	// Fields holds the descriptive metadata of the SIP or AIP, e.g. the batch
+	// identifier or the Dublin Core title, keyed by field name.
+	Fields map[string]string
+}
+
+// Content returns the full-text content of the document fields, sorted by
+// field name.
+func (d *Document) Content() string {
+	values := make([]string, 0, len(d.Fields))
+	for _, k := range slices.Sorted(maps.Keys(d.Fields)) {
+		if v := strings.TrimSpace(d.Fields[k]); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return strings.Join(values, "\n")
+}
+
+const (
+	// DefaultLimit is the number of results returned when the query limit is
+	// not set.
+	DefaultLimit = 20
+
+	// MaxLimit is the maximum number of results returned by a query.
+	MaxLimit = 1000
+)
+
+// Query is a search request.
+type Query struct {
+	// Text is the text to search for. Documents must contain all the terms
+	// of the text.
+	Text string
+
+	// Limit is the maximum number of results to return.
+	Limit int
+
+	// Offset is the number of results to skip.
+	Offset int
+}
+
+// NewQuery returns a query for text returning the page of results defined by
+// limit and offset. A limit of zero or less is set to DefaultLimit, and a
+// limit greater than MaxLimit to MaxLimit.
+func NewQuery(text string, limit, offset int) *Query {
+	switch {
+	case limit <= 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	return &Query{Text: text, Limit: limit, Offset: max(offset, 0)}
+}
+
+// Terms returns the words of the query text. Characters other than letters,
+// digits and the "-", "_" and "." word separators are ignored.
+func (q *Query) Terms() []string {
+	return strings.FieldsFunc(q.Text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' && r != '.'
+	})
+}
+
+// Result is a document matching a search query.
+type Result struct {
+	UUID   uuid.UUID
+	Name   string
+	Fields map[string]string
+}
+
+// Backend indexes documents and searches them.
+type Backend interface {
+	// Index adds doc to the index, replacing any document with the same UUID.
+	Index(ctx context.Context, doc *Document) error
+
+	// Delete removes the document identified by id from the index.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Search returns a page of the documents matching q, most relevant first,
+	// and the total number of matching documents.
+	Search(ctx context.Context, q *Query) ([]*Result, int, error)
+}
+
+// MetadataFields converts opaque JSON metadata (e.g. the custom metadata
+// returned by child workflows) to document fields. String values are indexed
+// as they are, other values using their JSON encoding.
+func MetadataFields(md map[string]json.RawMessage) map[string]string {
+	if len(md) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]string, len(md))
+	for k, raw := range md {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			fields[k] = s
+			continue
+		}
+		fields[k] = string(raw)
+	}
+
+	return fields
+}
@@ -0,0 +1,85 @@
+package search_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/search"
+)
+
+func TestDocumentContent(t *testing.T) {
+	t.Parallel()
+
+	doc := &search.Document{
+		Fields: map[string]string{
+			"title":   "Annual report",
+			"batch":   "Reports",
+			"subject": "  ",
+		},
+	}
+	assert.Equal(t, doc.Content(), "Reports\nAnnual report")
+}
+
+func TestNewQuery(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		limit  int
+		offset int
+		want   *search.Query
+	}
+	for _, tt := range []test{
+		{
+			name: "Sets the default limit",
+			want: &search.Query{Text: "report", Limit: search.DefaultLimit},
+		},
+		{
+			name:   "Caps the limit",
+			limit:  search.MaxLimit + 1,
+			offset: 10,
+			want:   &search.Query{Text: "report", Limit: search.MaxLimit, Offset: 10},
+		},
+		{
+			name:   "Ignores a negative offset",
+			limit:  5,
+			offset: -1,
+			want:   &search.Query{Text: "report", Limit: 5},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.DeepEqual(t, search.NewQuery("report", tt.limit, tt.offset), tt.want)
+		})
+	}
+}
+
+func TestQueryTerms(t *testing.T) {
+	t.Parallel()
+
+	q := search.NewQuery(` "annual report" 2023-001, (dc:title) v1.2 `, 0, 0)
+	assert.DeepEqual(t, q.Terms(), []string{"annual", "report", "2023-001", "dc", "title", "v1.2"})
+
+	q = search.NewQuery(" *+ ", 0, 0)
+	assert.Equal(t, len(q.Terms()), 0)
+}
+
+func TestMetadataFields(t *testing.T) {
+	t.Parallel()
+
+	fields := search.MetadataFields(map[string]json.RawMessage{
+		"title":  json.RawMessage(`"Annual report"`),
+		"pages":  json.RawMessage(`12`),
+		"topics": json.RawMessage(`["budget","finance"]`),
+	})
+	assert.DeepEqual(t, fields, map[string]string{
+		"title":  "Annual report",
+		"pages":  "12",
+		"topics": `["budget","finance"]`,
+	})
+
+	assert.Assert(t, search.MetadataFields(nil) == nil)
+}
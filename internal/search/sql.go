@@ -0,0 +1,113 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Column names of the search document tables used by the SQL backend.
+const (
+	uuidColumn    = "uuid"
+	nameColumn    = "name"
+	contentColumn = "content"
+)
+
+// Store is implemented by the persistence services keeping the search
+// documents of the SQL backend.
+type Store interface {
+	IndexSearchDocument(ctx context.Context, doc *Document) error
+	DeleteSearchDocument(ctx context.Context, id uuid.UUID) error
+	SearchDocuments(ctx context.Context, q *Query) ([]*Result, int, error)
+}
+
+// SQLBackend is the Backend that keeps the documents in a Store.
+type SQLBackend struct {
+	store Store
+}
+
+var _ Backend = (*SQLBackend)(nil)
+
+// NewSQLBackend returns a Backend that keeps the documents in store.
+func NewSQLBackend(store Store) *SQLBackend {
+	return &SQLBackend{store: store}
+}
+
+func (b *SQLBackend) Index(ctx context.Context, doc *Document) error {
+	return b.store.IndexSearchDocument(ctx, doc)
+}
+
+func (b *SQLBackend) Delete(ctx context.Context, id uuid.UUID) error {
+	return b.store.DeleteSearchDocument(ctx, id)
+}
+
+func (b *SQLBackend) Search(ctx context.Context, q *Query) ([]*Result, int, error) {
+	return b.store.SearchDocuments(ctx, q)
+}
+
+// SQLPredicate returns a predicate matching the search documents that contain
+// all the terms of q in their name or content, or whose UUID is the text of q.
+// MySQL uses the full-text index of the table, other dialects fall back to
+// case-insensitive substring matching.
+func SQLPredicate(q *Query) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		var match *sql.Predicate
+		terms := q.Terms()
+		if s.Dialect() == dialect.MySQL {
+			match = sql.P(func(b *sql.Builder) {
+				b.WriteString(matchExpr(s)).WriteString(" AGAINST (").Arg(booleanQuery(terms)).WriteString(" IN BOOLEAN MODE)")
+			})
+		} else {
+			preds := make([]*sql.Predicate, len(terms))
+			for i, t := range terms {
+				preds[i] = sql.Or(
+					sql.ContainsFold(s.C(nameColumn), t),
+					sql.ContainsFold(s.C(contentColumn), t),
+				)
+			}
+			match = sql.And(preds...)
+		}
+
+		if id, err := uuid.Parse(strings.TrimSpace(q.Text)); err == nil {
+			match = sql.Or(match, sql.EQ(s.C(uuidColumn), id))
+		}
+
+		s.Where(match)
+	}
+}
+
+// SQLOrder orders the search documents matching q by relevance in MySQL, and
+// by name in other dialects.
+func SQLOrder(q *Query) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if s.Dialect() == dialect.MySQL {
+			s.OrderExprFunc(func(b *sql.Builder) {
+				b.WriteString(matchExpr(s)).WriteString(" AGAINST (").Arg(booleanQuery(q.Terms())).WriteString(" IN BOOLEAN MODE) DESC")
+			})
+		}
+		s.OrderBy(s.C(nameColumn), s.C(uuidColumn))
+	}
+}
+
+func matchExpr(s *sql.Selector) string {
+	return "MATCH (" + s.C(nameColumn) + ", " + s.C(contentColumn) + ")"
+}
+
+// booleanQuery returns a MySQL boolean mode full-text query requiring all the
+// terms, matching them as word prefixes. Terms including word separators are
+// matched as phrases because MySQL splits them into several words.
+func booleanQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		if strings.ContainsAny(t, "-.") {
+			parts[i] = `+"` + t + `"`
+		} else {
+			parts[i] = "+" + t + "*"
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
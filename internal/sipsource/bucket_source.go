@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +25,8 @@ type BucketSource struct {
 	// retentionPeriod is the duration for which SIPs should be retained after
 	// a successful ingest. If negative, SIPs will be retained indefinitely.
 	retentionPeriod time.Duration
+	// processingProfile is the name of the default processing profile.
+	processingProfile string
 }
 
 var _ SIPSource = (*BucketSource)(nil)
@@ -41,10 +44,11 @@ func NewBucketSource(ctx context.Context, cfg *Config) (*BucketSource, error) {
 	}
 
 	return &BucketSource{
-		ID:              cfg.ID,
-		Bucket:          bucket,
-		Name:            cfg.Name,
-		retentionPeriod: cfg.RetentionPeriod,
+		ID:                cfg.ID,
+		Bucket:            bucket,
+		Name:              cfg.Name,
+		retentionPeriod:   cfg.RetentionPeriod,
+		processingProfile: cfg.ProcessingProfile,
 	}, nil
 }
 
@@ -143,6 +147,40 @@ func (s *BucketSource) ListObjects(ctx context.Context, opts ListOptions) (*Page
 	return &Page{Objects: page, Limit: opts.Limit, NextToken: next}, nil
 }
 
+// Exists reports whether an object with the given key exists in the SIP source
+// bucket. A key is also considered to exist when it's the prefix of other
+// objects, e.g. a directory.
+//
+// If the source bucket is not configured, Exists returns an ErrInvalidSource
+// error.
+func (s *BucketSource) Exists(ctx context.Context, key string) (bool, error) {
+	if s.Bucket == nil {
+		return false, ErrInvalidSource
+	}
+
+	ok, err := s.Bucket.Exists(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("SIP bucket source: exists: %w", err)
+	}
+	if ok {
+		return true, nil
+	}
+
+	iter := s.Bucket.List(&blob.ListOptions{Prefix: strings.TrimSuffix(key, "/") + "/"})
+	if _, err := iter.Next(ctx); err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, fmt.Errorf("SIP bucket source: exists: %w", err)
+	}
+
+	return true, nil
+}
+
 func (s *BucketSource) RetentionPeriod() time.Duration {
 	return s.retentionPeriod
 }
+
+func (s *BucketSource) ProcessingProfile() string {
+	return s.processingProfile
+}
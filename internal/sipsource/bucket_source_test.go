@@ -70,8 +70,8 @@ func TestNewBucketSource(t *testing.T) {
 
 			assert.NilError(t, err)
 			assert.DeepEqual(t, got, tt.want,
-				// We can't compare the Bucket and retentionPeriod directly, so ignore them.
-				cmpopts.IgnoreFields(sipsource.BucketSource{}, "Bucket", "retentionPeriod"),
+				// We can't compare the Bucket and unexported fields directly, so ignore them.
+				cmpopts.IgnoreFields(sipsource.BucketSource{}, "Bucket", "retentionPeriod", "processingProfile"),
 			)
 		})
 	}
@@ -283,6 +283,44 @@ func TestListItems(t *testing.T) {
 	}
 }
 
+func TestExists(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source, err := sipsource.NewBucketSource(ctx, &sipsource.Config{
+		ID:     uuid.New(),
+		Name:   "Test bucket source",
+		Bucket: &bucket.Config{URL: "mem://"},
+	})
+	assert.NilError(t, err)
+	defer source.Close()
+
+	assert.NilError(t, source.Bucket.WriteAll(ctx, "sip1.zip", []byte("sip1"), nil))
+	assert.NilError(t, source.Bucket.WriteAll(ctx, "sip2/data.txt", []byte("data"), nil))
+
+	for _, tt := range []struct {
+		key  string
+		want bool
+	}{
+		{key: "sip1.zip", want: true},
+		{key: "sip2", want: true},
+		{key: "sip2/", want: true},
+		{key: "sip3.zip", want: false},
+		{key: "sip", want: false},
+	} {
+		got, err := source.Exists(ctx, tt.key)
+		assert.NilError(t, err)
+		assert.Equal(t, got, tt.want, tt.key)
+	}
+
+	t.Run("Returns an error if the source is not configured", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := (&sipsource.BucketSource{}).Exists(ctx, "sip1.zip")
+		assert.ErrorIs(t, err, sipsource.ErrInvalidSource)
+	})
+}
+
 func TestRetentionPeriod(t *testing.T) {
 	t.Parallel()
 
@@ -355,3 +393,20 @@ func TestRetentionPeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessingProfile(t *testing.T) {
+	t.Parallel()
+
+	source, err := sipsource.NewBucketSource(context.Background(), &sipsource.Config{
+		ID:   uuid.New(),
+		Name: "Test SIP Source",
+		Bucket: &bucket.Config{
+			URL: "mem://",
+		},
+		ProcessingProfile: "email",
+	})
+	assert.NilError(t, err)
+	defer source.Close()
+
+	assert.Equal(t, source.ProcessingProfile(), "email")
+}
@@ -30,6 +30,15 @@ type Config struct {
 	// RetentionPeriod is the duration for which SIPs should be retained after
 	// a successful ingest. If negative, SIPs will be retained indefinitely.
 	RetentionPeriod time.Duration
+
+	// ScanAntivirus enables the antivirus scanning of the SIPs ingested from
+	// this source. It requires the [antivirus] configuration.
+	ScanAntivirus bool
+
+	// ProcessingProfile is the name of the [[preservation.profiles]] processing
+	// profile used for the SIPs ingested from this source, unless another
+	// profile is requested.
+	ProcessingProfile string
 }
 
 func (c *Config) Validate() error {
@@ -80,6 +80,45 @@ func (c *MockSIPSourceCloseCall) DoAndReturn(f func() error) *MockSIPSourceClose
 	return c
 }
 
+// Exists mocks base method.
+func (m *MockSIPSource) Exists(ctx context.Context, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockSIPSourceMockRecorder) Exists(ctx, key any) *MockSIPSourceExistsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockSIPSource)(nil).Exists), ctx, key)
+	return &MockSIPSourceExistsCall{Call: call}
+}
+
+// MockSIPSourceExistsCall wrap *gomock.Call
+type MockSIPSourceExistsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSIPSourceExistsCall) Return(arg0 bool, arg1 error) *MockSIPSourceExistsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSIPSourceExistsCall) Do(f func(context.Context, string) (bool, error)) *MockSIPSourceExistsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSIPSourceExistsCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockSIPSourceExistsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListObjects mocks base method.
 func (m *MockSIPSource) ListObjects(arg0 context.Context, arg1 sipsource.ListOptions) (*sipsource.Page, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +158,44 @@ func (c *MockSIPSourceListObjectsCall) DoAndReturn(f func(context.Context, sipso
 	return c
 }
 
+// ProcessingProfile mocks base method.
+func (m *MockSIPSource) ProcessingProfile() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessingProfile")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProcessingProfile indicates an expected call of ProcessingProfile.
+func (mr *MockSIPSourceMockRecorder) ProcessingProfile() *MockSIPSourceProcessingProfileCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessingProfile", reflect.TypeOf((*MockSIPSource)(nil).ProcessingProfile))
+	return &MockSIPSourceProcessingProfileCall{Call: call}
+}
+
+// MockSIPSourceProcessingProfileCall wrap *gomock.Call
+type MockSIPSourceProcessingProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSIPSourceProcessingProfileCall) Return(arg0 string) *MockSIPSourceProcessingProfileCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSIPSourceProcessingProfileCall) Do(f func() string) *MockSIPSourceProcessingProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSIPSourceProcessingProfileCall) DoAndReturn(f func() string) *MockSIPSourceProcessingProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RetentionPeriod mocks base method.
 func (m *MockSIPSource) RetentionPeriod() time.Duration {
 	m.ctrl.T.Helper()
@@ -19,12 +19,20 @@ type SIPSource interface {
 	// ListObjects returns a paged list of items in the SIP source.
 	ListObjects(context.Context, ListOptions) (*Page, error)
 
+	// Exists reports whether an object with the given key exists in the SIP
+	// source.
+	Exists(ctx context.Context, key string) (bool, error)
+
 	// Close releases resources associated with the SIP source.
 	Close() error
 
 	// RetentionPeriod returns the duration for which SIPs should be retained
 	// after a successful ingest. If negative, SIPs will be retained indefinitely.
 	RetentionPeriod() time.Duration
+
+	// ProcessingProfile returns the name of the default processing profile for
+	// the SIPs ingested from this source, or an empty string if there is none.
+	ProcessingProfile() string
 }
 
 // ListOptions specifies options for listing SIP source objects.
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jonboulle/clockwork"
@@ -119,6 +120,20 @@ func (a *AIPDeletionReportActivity) loadData(
 		return nil, fmt.Errorf("AIP deletion report: load data: ReadWorkflow: %v", err)
 	}
 
+	// List all the approvers when the request was approved by several users.
+	approvals, err := a.storageSvc.ListDeletionRequestApprovals(ctx, drs[0].DBID)
+	if err != nil {
+		return nil, fmt.Errorf("AIP deletion report: load data: ListDeletionRequestApprovals: %v", err)
+	}
+	reviewer := drs[0].Reviewer
+	if len(approvals) > 0 {
+		approvers := make([]string, len(approvals))
+		for i, ap := range approvals {
+			approvers[i] = ap.Approver
+		}
+		reviewer = strings.Join(approvers, ", ")
+	}
+
 	d := types.DeletionReportData{
 		AIPName:            aip.Name,
 		AIPUUID:            aip.UUID,
@@ -129,7 +144,7 @@ func (a *AIPDeletionReportActivity) loadData(
 		RequestedAt:        drs[0].RequestedAt,
 		Requester:          drs[0].Requester,
 		ReviewedAt:         drs[0].ReviewedAt,
-		Reviewer:           drs[0].Reviewer,
+		Reviewer:           reviewer,
 		Status:             drs[0].Status.String(),
 		StorageLocation:    aip.LocationUUID.String(),
 		StorageSystem:      "Enduro Storage Service",
@@ -66,6 +66,20 @@ func expectReadWorkflows(msvc *fake.MockService, id int) {
 		}, nil)
 }
 
+func expectListDeletionRequestApprovals(msvc *fake.MockService, id int) {
+	msvc.EXPECT().
+		ListDeletionRequestApprovals(mockutil.Context(), id).
+		Return([]*types.DeletionRequestApproval{
+			{
+				DeletionRequestDBID: id,
+				Approver:            "reviewer@example.com",
+				ApproverIss:         "issuer",
+				ApproverSub:         "subject-2",
+				ApprovedAt:          time.Date(2025, 10, 27, 8, 20, 40, 0, time.UTC),
+			},
+		}, nil)
+}
+
 func expectLocation(t *testing.T, msvc *fake.MockService) {
 	t.Helper()
 
@@ -110,6 +124,7 @@ func expectSvc(t *testing.T, msvc *fake.MockService, aipID uuid.UUID) {
 	expectReadAIP(msvc, aipID)
 	expectListDeletionRequests(msvc, aipID)
 	expectReadWorkflows(msvc, 1)
+	expectListDeletionRequestApprovals(msvc, 1)
 	expectLocation(t, msvc)
 	expectUpdateAIP(t, msvc, aipID)
 }
@@ -233,6 +248,22 @@ func TestAIPDeletionReportActivity(t *testing.T) {
 			},
 			wantErr: "AIP deletion report: load data: ReadWorkflow: internal error",
 		},
+		{
+			name:         "Errors if ListDeletionRequestApprovals fails",
+			templatePath: templatePath,
+			expectedSvc: func(t *testing.T, msvc *fake.MockService, aipID uuid.UUID) {
+				expectReadAIP(msvc, aipID)
+				expectListDeletionRequests(msvc, aipID)
+				expectReadWorkflows(msvc, 1)
+				msvc.EXPECT().
+					ListDeletionRequestApprovals(mockutil.Context(), 1).
+					Return(nil, errors.New("internal error"))
+			},
+			params: activities.AIPDeletionReportActivityParams{
+				AIPID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			wantErr: "AIP deletion report: load data: ListDeletionRequestApprovals: internal error",
+		},
 		{
 			name:         "Errors if updating AIP fails",
 			templatePath: templatePath,
@@ -240,6 +271,7 @@ func TestAIPDeletionReportActivity(t *testing.T) {
 				expectReadAIP(msvc, aipID)
 				expectListDeletionRequests(msvc, aipID)
 				expectReadWorkflows(msvc, 1)
+				expectListDeletionRequestApprovals(msvc, 1)
 				expectLocation(t, msvc)
 				msvc.EXPECT().
 					UpdateAIP(
@@ -268,6 +300,7 @@ func TestAIPDeletionReportActivity(t *testing.T) {
 				expectReadAIP(msvc, aipID)
 				expectListDeletionRequests(msvc, aipID)
 				expectReadWorkflows(msvc, 1)
+				expectListDeletionRequestApprovals(msvc, 1)
 				expectLocation(t, msvc)
 			},
 			expectedFormFill: func(t *testing.T, mff *pdf_fake.MockFormFiller, aipID uuid.UUID) {
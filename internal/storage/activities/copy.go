@@ -2,15 +2,30 @@ package activities
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
+	"os"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mholt/archives"
+	temporal_tools "go.artefactual.dev/tools/temporal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+	"github.com/artefactual-sdps/enduro/internal/telemetry"
 )
 
 type CopyToPermanentLocationActivity struct {
-	storagesvc storage.Service
+	storagesvc    storage.Service
+	countFiles    bool
+	storeDuration metric.Float64Histogram
 }
 
 type CopyToPermanentLocationActivityParams struct {
@@ -20,8 +35,29 @@ type CopyToPermanentLocationActivityParams struct {
 
 type CopyToPermanentLocationActivityResult struct{}
 
-func NewCopyToPermanentLocationActivity(storagesvc storage.Service) *CopyToPermanentLocationActivity {
-	return &CopyToPermanentLocationActivity{storagesvc: storagesvc}
+// NewCopyToPermanentLocationActivity returns a
+// CopyToPermanentLocationActivity. If countFiles is set, the files of the AIP
+// are counted from a temporary local copy.
+func NewCopyToPermanentLocationActivity(
+	storagesvc storage.Service,
+	countFiles bool,
+	mp metric.MeterProvider,
+) *CopyToPermanentLocationActivity {
+	storeDuration, err := mp.Meter("github.com/artefactual-sdps/enduro/internal/storage").Float64Histogram(
+		"enduro.storage.aip.store.duration",
+		metric.WithDescription("Duration of the AIP copies to their permanent location."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(telemetry.ProcessingDurationBuckets...),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return &CopyToPermanentLocationActivity{
+		storagesvc:    storagesvc,
+		countFiles:    countFiles,
+		storeDuration: storeDuration,
+	}
 }
 
 func (a *CopyToPermanentLocationActivity) Execute(
@@ -50,13 +86,35 @@ func (a *CopyToPermanentLocationActivity) Execute(
 	}
 	defer bucket.Close()
 
+	// Keep a local copy of the AIP to count its files.
+	var tmp *os.File
+	if a.countFiles {
+		tmp, err = os.CreateTemp("", "enduro-aip-*")
+		if err != nil {
+			return &CopyToPermanentLocationActivityResult{}, err
+		}
+		defer func() {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}()
+	}
+
 	writer, err := bucket.NewWriter(ctx, params.AIPID.String(), nil)
 	if err != nil {
 		return &CopyToPermanentLocationActivityResult{}, err
 	}
 
-	_, copyErr := io.Copy(writer, reader)
+	// Compute the AIP checksum and size while copying.
+	hash := sha256.New()
+	dst := io.MultiWriter(writer, hash)
+	if tmp != nil {
+		dst = io.MultiWriter(writer, hash, tmp)
+	}
+
+	start := time.Now()
+	size, copyErr := io.Copy(dst, reader)
 	closeErr := writer.Close()
+	a.recordStoreDuration(ctx, params.LocationID, time.Since(start), errors.Join(copyErr, closeErr))
 
 	if copyErr != nil {
 		return &CopyToPermanentLocationActivityResult{}, copyErr
@@ -65,5 +123,78 @@ func (a *CopyToPermanentLocationActivity) Execute(
 		return &CopyToPermanentLocationActivityResult{}, closeErr
 	}
 
+	// The file count is only informative, leave it unset if the AIP can't be
+	// read as an archive.
+	var files int
+	if tmp != nil {
+		files, err = countFiles(ctx, tmp)
+		if err != nil {
+			temporal_tools.GetLogger(ctx).Error(err, "Unable to count the AIP files.", "AIPID", params.AIPID)
+		}
+	}
+
+	// Record the checksum as the fixity baseline if the AIP doesn't have one.
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	_, err = a.storagesvc.UpdateAIP(ctx, params.AIPID, func(aip *types.AIP) (*types.AIP, error) {
+		if aip.ChecksumHash == "" {
+			aip.ChecksumAlgorithm = FixityChecksumAlgorithm
+			aip.ChecksumHash = checksum
+		}
+		aip.Size = size
+		aip.FileCount = files
+		return aip, nil
+	})
+	if err != nil {
+		return &CopyToPermanentLocationActivityResult{}, err
+	}
+
 	return &CopyToPermanentLocationActivityResult{}, nil
 }
+
+// recordStoreDuration records the duration of an AIP copy to the location
+// identified by locationID.
+func (a *CopyToPermanentLocationActivity) recordStoreDuration(
+	ctx context.Context,
+	locationID uuid.UUID,
+	d time.Duration,
+	err error,
+) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+
+	a.storeDuration.Record(ctx, d.Seconds(), metric.WithAttributes(
+		attribute.String("location.id", locationID.String()),
+		attribute.String("status", status),
+	))
+}
+
+// countFiles returns the number of regular files in the AIP archive f.
+func countFiles(ctx context.Context, f *os.File) (int, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	format, stream, err := archives.Identify(ctx, f.Name(), f)
+	if err != nil {
+		return 0, err
+	}
+	ex, ok := format.(archives.Extractor)
+	if !ok {
+		return 0, fmt.Errorf("unsupported archive format: %s", format.Extension())
+	}
+
+	var files int
+	err = ex.Extract(ctx, stream, func(ctx context.Context, fi archives.FileInfo) error {
+		if fi.Mode().IsRegular() {
+			files++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return files, nil
+}
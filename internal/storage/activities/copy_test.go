@@ -0,0 +1,186 @@
+package activities_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.opentelemetry.io/otel/attribute"
+	otelsdk_metric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	tfs "gotest.tools/v3/fs"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+func TestCopyToPermanentLocationActivity(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	locationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	goaAIP := &goastorage.AIP{
+		UUID: aipID,
+		Name: "Test AIP",
+	}
+
+	checksum := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+
+	type test struct {
+		name       string
+		countFiles bool
+		content    func(*testing.T) string
+		stored     *types.AIP
+		want       func(content string) *types.AIP
+		wantErr    string
+	}
+	for _, tc := range []test{
+		{
+			name:       "Records the AIP checksum, size and file count",
+			countFiles: true,
+			content: func(t *testing.T) string {
+				return zipArchive(t, map[string]string{
+					"bag/bagit.txt":       "BagIt-Version: 0.97",
+					"bag/data/object.txt": "object",
+				})
+			},
+			stored: &types.AIP{UUID: aipID},
+			want: func(content string) *types.AIP {
+				return &types.AIP{
+					UUID:              aipID,
+					ChecksumAlgorithm: "sha256",
+					ChecksumHash:      checksum(content),
+					Size:              int64(len(content)),
+					FileCount:         2,
+				}
+			},
+		},
+		{
+			name: "Doesn't count the AIP files when disabled",
+			content: func(t *testing.T) string {
+				return zipArchive(t, map[string]string{"bag/bagit.txt": "BagIt-Version: 0.97"})
+			},
+			stored: &types.AIP{UUID: aipID},
+			want: func(content string) *types.AIP {
+				return &types.AIP{
+					UUID:              aipID,
+					ChecksumAlgorithm: "sha256",
+					ChecksumHash:      checksum(content),
+					Size:              int64(len(content)),
+				}
+			},
+		},
+		{
+			name:       "Leaves the file count unset when the AIP isn't an archive",
+			countFiles: true,
+			stored:     &types.AIP{UUID: aipID, ChecksumAlgorithm: "sha256", ChecksumHash: "abc"},
+			want: func(content string) *types.AIP {
+				return &types.AIP{
+					UUID:              aipID,
+					ChecksumAlgorithm: "sha256",
+					ChecksumHash:      "abc",
+					Size:              int64(len(content)),
+				}
+			},
+		},
+		{
+			name:    "Errors when the AIP can't be updated",
+			stored:  &types.AIP{UUID: aipID},
+			wantErr: "update AIP: not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			td := tfs.NewDir(t, "enduro-copy-test")
+			content := "AIP content"
+			if tc.content != nil {
+				content = tc.content(t)
+			}
+
+			msvc := fake.NewMockService(gomock.NewController(t))
+			msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+			msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+			msvc.EXPECT().Location(mockutil.Context(), locationID).Return(restoreLocation(t, locationID, td.Path()), nil)
+
+			if tc.wantErr != "" {
+				msvc.EXPECT().
+					UpdateAIP(mockutil.Context(), aipID, gomock.Any()).
+					Return(nil, errors.New("update AIP: not found"))
+			} else {
+				want := tc.want(content)
+				msvc.EXPECT().
+					UpdateAIP(
+						mockutil.Context(),
+						aipID,
+						mockutil.Func(
+							"Records the stored AIP details",
+							func(updater persistence.AIPUpdater) error {
+								got, err := updater(tc.stored)
+								assert.NilError(t, err)
+								assert.DeepEqual(t, got, want)
+								return nil
+							},
+						),
+					).
+					Return(want, nil)
+			}
+
+			reader := otelsdk_metric.NewManualReader()
+			mp := otelsdk_metric.NewMeterProvider(otelsdk_metric.WithReader(reader))
+
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(
+				activities.NewCopyToPermanentLocationActivity(msvc, tc.countFiles, mp).Execute,
+				temporalsdk_activity.RegisterOptions{
+					Name: storage.CopyToPermanentLocationActivityName,
+				},
+			)
+
+			_, err := env.ExecuteActivity(
+				storage.CopyToPermanentLocationActivityName,
+				&activities.CopyToPermanentLocationActivityParams{AIPID: aipID, LocationID: locationID},
+			)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			b, err := os.ReadFile(filepath.Join(td.Path(), aipID.String()))
+			assert.NilError(t, err)
+			assert.Equal(t, string(b), content)
+
+			var rm metricdata.ResourceMetrics
+			assert.NilError(t, reader.Collect(t.Context(), &rm))
+			assert.Equal(t, len(rm.ScopeMetrics), 1)
+			m := rm.ScopeMetrics[0].Metrics[0]
+			assert.Equal(t, m.Name, "enduro.storage.aip.store.duration")
+			dps := m.Data.(metricdata.Histogram[float64]).DataPoints
+			assert.Equal(t, len(dps), 1)
+			assert.Equal(t, dps[0].Count, uint64(1))
+			assert.Equal(t, dps[0].Attributes, attribute.NewSet(
+				attribute.String("location.id", locationID.String()),
+				attribute.String("status", "ok"),
+			))
+		})
+	}
+}
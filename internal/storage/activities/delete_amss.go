@@ -12,6 +12,7 @@ import (
 	ssclient_models "go.artefactual.dev/ssclient/kiota/models"
 	temporal_tools "go.artefactual.dev/tools/temporal"
 
+	"github.com/artefactual-sdps/enduro/internal/storage"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
 )
 
@@ -28,6 +29,10 @@ var errDeletionRequestAlreadyExists = errors.New("deletion request already exist
 // DeleteFromAMSSLocationActivity deletes an AIP stored in Archivematica
 // Storage Service.
 //
+// The activity fails without requesting the deletion if the AIP is under a
+// legal hold, which may have been placed after the Enduro deletion request was
+// reviewed.
+//
 // If the activity is configured for automatic approval, it immediately approves
 // newly created deletion requests and returns success. Otherwise it polls the
 // AIP status until AMSS finishes processing the request.
@@ -37,6 +42,8 @@ var errDeletionRequestAlreadyExists = errors.New("deletion request already exist
 // path, but it cannot auto-approve the request because AMSS does not return an
 // event ID for the existing request.
 type DeleteFromAMSSLocationActivity struct {
+	// The storage service used to check the AIP legal holds.
+	storagesvc storage.Service
 	// The HTTP client to use for AMSS API calls.
 	httpClient *http.Client
 	// Whether to automatically approve deletion requests.
@@ -55,6 +62,7 @@ type DeleteFromAMSSLocationActivityResult struct {
 }
 
 func NewDeleteFromAMSSLocationActivity(
+	storagesvc storage.Service,
 	httpClient *http.Client,
 	approve bool,
 	pollInterval time.Duration,
@@ -63,6 +71,7 @@ func NewDeleteFromAMSSLocationActivity(
 		pollInterval = defaultDeleteFromAMSSPollInterval
 	}
 	return &DeleteFromAMSSLocationActivity{
+		storagesvc:   storagesvc,
 		httpClient:   httpClient,
 		approve:      approve,
 		pollInterval: pollInterval,
@@ -76,6 +85,13 @@ func (a *DeleteFromAMSSLocationActivity) Execute(
 	h := temporal_tools.StartAutoHeartbeat(ctx)
 	defer h.Stop()
 
+	if err := a.storagesvc.CheckAIPLegalHold(ctx, params.AIPUUID); err != nil {
+		if errors.Is(err, storage.ErrLegalHold) {
+			return nil, temporal_tools.NewNonRetryableError(err)
+		}
+		return nil, fmt.Errorf("check legal hold: %v", err)
+	}
+
 	// We build a fresh ssclient per execution. We could cache clients by AMSS
 	// connection config, but the shared HTTP client already preserves transport
 	// reuse and the extra caching complexity does not seem justified here yet.
@@ -10,12 +10,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-cleanhttp"
+	"go.artefactual.dev/tools/mockutil"
 	temporalsdk_activity "go.temporal.io/sdk/activity"
 	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
 
 	"github.com/artefactual-sdps/enduro/internal/storage"
 	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
 )
 
@@ -120,6 +123,7 @@ func TestDeleteFromAMSSLocationActivity(t *testing.T) {
 		approve bool
 		url     string
 		poll    time.Duration
+		holdErr error
 		handler http.HandlerFunc
 		want    activities.DeleteFromAMSSLocationActivityResult
 		wantErr string
@@ -141,6 +145,24 @@ func TestDeleteFromAMSSLocationActivity(t *testing.T) {
 			},
 			want: activities.DeleteFromAMSSLocationActivityResult{Deleted: true},
 		},
+		{
+			name:    "Fails when the AIP is under legal hold",
+			approve: true,
+			holdErr: storage.ErrLegalHold,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Fatalf("unexpected request to %s", r.URL.Path)
+			},
+			wantErr: "AIP is under legal hold",
+		},
+		{
+			name:    "Fails checking the AIP legal hold",
+			approve: true,
+			holdErr: storage.ErrInternalError,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Fatalf("unexpected request to %s", r.URL.Path)
+			},
+			wantErr: "check legal hold: internal error",
+		},
 		{
 			name:    "Fails getting pipeline UUID (HTTP error)",
 			approve: true,
@@ -503,8 +525,13 @@ func TestDeleteFromAMSSLocationActivity(t *testing.T) {
 				pollInterval = time.Microsecond
 			}
 
+			msvc := fake.NewMockService(gomock.NewController(t))
+			msvc.EXPECT().
+				CheckAIPLegalHold(mockutil.Context(), uuid.MustParse(aipUUID)).
+				Return(tt.holdErr)
+
 			env.RegisterActivityWithOptions(
-				activities.NewDeleteFromAMSSLocationActivity(httpClient, tt.approve, pollInterval).Execute,
+				activities.NewDeleteFromAMSSLocationActivity(msvc, httpClient, tt.approve, pollInterval).Execute,
 				temporalsdk_activity.RegisterOptions{Name: storage.DeleteFromAMSSLocationActivityName},
 			)
 
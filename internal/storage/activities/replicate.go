@@ -0,0 +1,109 @@
+package activities
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	temporal_tools "go.artefactual.dev/tools/temporal"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+)
+
+type ReplicateAIPActivity struct {
+	storagesvc storage.Service
+}
+
+type ReplicateAIPActivityParams struct {
+	AIPID uuid.UUID
+
+	// LocationID is the replication target location.
+	LocationID uuid.UUID
+}
+
+type ReplicateAIPActivityResult struct {
+	ChecksumAlgorithm string
+	Checksum          string
+}
+
+func NewReplicateAIPActivity(storagesvc storage.Service) *ReplicateAIPActivity {
+	return &ReplicateAIPActivity{storagesvc: storagesvc}
+}
+
+// Execute copies the AIP object from its location to the replication target
+// and verifies the replica by reading it back and comparing its checksum with
+// the checksum computed while copying.
+func (a *ReplicateAIPActivity) Execute(
+	ctx context.Context,
+	params *ReplicateAIPActivityParams,
+) (*ReplicateAIPActivityResult, error) {
+	h := temporal_tools.StartAutoHeartbeat(ctx)
+	defer h.Stop()
+
+	aip, err := a.storagesvc.ReadAip(ctx, params.AIPID)
+	if err != nil {
+		return nil, fmt.Errorf("read AIP: %v", err)
+	}
+
+	reader, err := a.storagesvc.AipReader(ctx, aip)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	l, err := a.storagesvc.Location(ctx, params.LocationID)
+	if err != nil {
+		return nil, fmt.Errorf("read location: %v", err)
+	}
+
+	bucket, err := l.OpenBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	key := params.AIPID.String()
+	writer, err := bucket.NewWriter(ctx, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute the AIP checksum while copying.
+	hash := sha256.New()
+	_, copyErr := io.Copy(io.MultiWriter(writer, hash), reader)
+	closeErr := writer.Close()
+
+	if copyErr != nil {
+		return nil, fmt.Errorf("copy AIP: %v", copyErr)
+	}
+	if closeErr != nil {
+		return nil, fmt.Errorf("copy AIP: %v", closeErr)
+	}
+	expected := hex.EncodeToString(hash.Sum(nil))
+
+	// Read the replica back to verify it.
+	replica, err := bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("read replica: %v", err)
+	}
+	defer replica.Close()
+
+	hash.Reset()
+	if _, err := io.Copy(hash, replica); err != nil {
+		return nil, fmt.Errorf("read replica: %v", err)
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != expected {
+		return nil, fmt.Errorf(
+			"replica checksum mismatch: expected %s: %s, actual %s: %s",
+			FixityChecksumAlgorithm, expected, FixityChecksumAlgorithm, actual,
+		)
+	}
+
+	return &ReplicateAIPActivityResult{
+		ChecksumAlgorithm: FixityChecksumAlgorithm,
+		Checksum:          expected,
+	}, nil
+}
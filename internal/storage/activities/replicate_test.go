@@ -0,0 +1,128 @@
+package activities_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	tfs "gotest.tools/v3/fs"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
+)
+
+func TestReplicateAIPActivity(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	targetID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	content := "AIP content"
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+
+	goaAIP := &goastorage.AIP{
+		UUID:         aipID,
+		Name:         "Test AIP",
+		LocationUUID: new(uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")),
+	}
+
+	targetLocation := func(t *testing.T, path string) storage.Location {
+		t.Helper()
+
+		l, err := storage.NewLocation(&goastorage.Location{
+			UUID:   targetID,
+			Config: goastorage.NewConfigURL(&goastorage.URLConfig{URL: "file://" + path}),
+		})
+		assert.NilError(t, err)
+
+		return l
+	}
+
+	type test struct {
+		name    string
+		mock    func(*testing.T, *fake.MockService, string)
+		want    activities.ReplicateAIPActivityResult
+		wantErr string
+	}
+	for _, tc := range []test{
+		{
+			name: "Copies the AIP to the replication target",
+			mock: func(t *testing.T, msvc *fake.MockService, path string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				msvc.EXPECT().Location(mockutil.Context(), targetID).Return(targetLocation(t, path), nil)
+			},
+			want: activities.ReplicateAIPActivityResult{
+				ChecksumAlgorithm: "sha256",
+				Checksum:          checksum,
+			},
+		},
+		{
+			name: "Errors when the AIP can't be read",
+			mock: func(t *testing.T, msvc *fake.MockService, path string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(nil, errors.New("not found"))
+			},
+			wantErr: "read AIP: not found",
+		},
+		{
+			name: "Errors when the target location can't be read",
+			mock: func(t *testing.T, msvc *fake.MockService, path string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				msvc.EXPECT().Location(mockutil.Context(), targetID).Return(nil, errors.New("not found"))
+			},
+			wantErr: "read location: not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			td := tfs.NewDir(t, "enduro-replicate-test")
+			msvc := fake.NewMockService(gomock.NewController(t))
+			if tc.mock != nil {
+				tc.mock(t, msvc, td.Path())
+			}
+
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(
+				activities.NewReplicateAIPActivity(msvc).Execute,
+				temporalsdk_activity.RegisterOptions{
+					Name: storage.ReplicateAIPActivityName,
+				},
+			)
+
+			enc, err := env.ExecuteActivity(
+				storage.ReplicateAIPActivityName,
+				&activities.ReplicateAIPActivityParams{
+					AIPID:      aipID,
+					LocationID: targetID,
+				},
+			)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			var res activities.ReplicateAIPActivityResult
+			_ = enc.Get(&res)
+			assert.DeepEqual(t, res, tc.want)
+
+			b, err := os.ReadFile(filepath.Join(td.Path(), aipID.String()))
+			assert.NilError(t, err)
+			assert.Equal(t, string(b), content)
+		})
+	}
+}
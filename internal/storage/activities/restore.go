@@ -0,0 +1,293 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mholt/archives"
+	"go.artefactual.dev/tools/bucket"
+	"go.artefactual.dev/tools/fsutil"
+	temporal_tools "go.artefactual.dev/tools/temporal"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+)
+
+type RestoreAIPActivity struct {
+	storagesvc storage.Service
+	bucketCfg  *bucket.Config
+}
+
+type RestoreAIPActivityParams struct {
+	AIPID uuid.UUID
+
+	// RestoreID identifies the restore request. It's included in the restored
+	// AIP key so overlapping restores of the same AIP don't share objects.
+	RestoreID uuid.UUID
+
+	// LocationID is the restore location. If nil, the AIP is restored to the
+	// configured restore bucket.
+	LocationID uuid.UUID
+
+	// Unpack determines whether the AIP is extracted in the restore location.
+	Unpack bool
+}
+
+type RestoreAIPActivityResult struct {
+	// Key is the object key of the restored AIP or, if the AIP has been
+	// unpacked, the key prefix of its files.
+	Key string
+
+	// Files is the number of files extracted from the AIP when unpacked.
+	Files int
+}
+
+// NewRestoreAIPActivity returns a RestoreAIPActivity. bucketCfg configures the
+// default restore bucket and it can be nil if there isn't one.
+func NewRestoreAIPActivity(storagesvc storage.Service, bucketCfg *bucket.Config) *RestoreAIPActivity {
+	return &RestoreAIPActivity{storagesvc: storagesvc, bucketCfg: bucketCfg}
+}
+
+// Execute copies the AIP object from its location to the restore location. If
+// params.Unpack is set, the AIP is extracted and its files are written under a
+// key prefix named after the AIP and the restore request instead.
+func (a *RestoreAIPActivity) Execute(
+	ctx context.Context,
+	params *RestoreAIPActivityParams,
+) (*RestoreAIPActivityResult, error) {
+	h := temporal_tools.StartAutoHeartbeat(ctx)
+	defer h.Stop()
+
+	aip, err := a.storagesvc.ReadAip(ctx, params.AIPID)
+	if err != nil {
+		return nil, fmt.Errorf("read AIP: %v", err)
+	}
+
+	reader, err := a.storagesvc.AipReader(ctx, aip)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	b, err := openRestoreBucket(ctx, a.storagesvc, a.bucketCfg, params.LocationID)
+	if err != nil {
+		return nil, err
+	}
+	defer b.Close()
+
+	name := fmt.Sprintf("%s-%s", fsutil.BaseNoExt(aip.Name), aip.UUID)
+	if params.RestoreID != uuid.Nil {
+		name = fmt.Sprintf("%s-%s", name, params.RestoreID)
+	}
+
+	if !params.Unpack {
+		// The AIP object key has no extension, so it's derived from the archive
+		// format of the object. Objects that aren't identified as an archive
+		// are restored without an extension.
+		var ext string
+		format, stream, err := archives.Identify(ctx, "", reader)
+		if err == nil {
+			ext = format.Extension()
+		} else if !errors.Is(err, archives.NoMatch) {
+			return nil, fmt.Errorf("identify AIP format: %v", err)
+		}
+
+		key := name + ext
+		if err := writeObject(ctx, b, key, stream); err != nil {
+			return nil, fmt.Errorf("copy AIP: %v", err)
+		}
+		return &RestoreAIPActivityResult{Key: key}, nil
+	}
+
+	// Archive formats like 7z and zip need random access to be extracted, so
+	// the AIP is downloaded to a temporary file first.
+	f, err := os.CreateTemp("", "enduro-restore-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		return nil, fmt.Errorf("download AIP: %v", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("download AIP: %v", err)
+	}
+
+	prefix := name + "/"
+	files, err := unpackAIP(ctx, b, prefix, f)
+	if err != nil {
+		return nil, fmt.Errorf("unpack AIP: %v", err)
+	}
+
+	return &RestoreAIPActivityResult{Key: prefix, Files: files}, nil
+}
+
+// unpackAIP extracts the archive read from f and writes its files to b under
+// the given key prefix. It returns the number of files written.
+func unpackAIP(ctx context.Context, b *blob.Bucket, prefix string, f *os.File) (int, error) {
+	format, stream, err := archives.Identify(ctx, f.Name(), f)
+	if err != nil {
+		return 0, err
+	}
+	ex, ok := format.(archives.Extractor)
+	if !ok {
+		return 0, fmt.Errorf("unsupported archive format: %s", format.Extension())
+	}
+
+	var files int
+	err = ex.Extract(ctx, stream, func(ctx context.Context, fi archives.FileInfo) error {
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		name := path.Clean(fi.NameInArchive)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("invalid file path: %q", fi.NameInArchive)
+		}
+
+		r, err := fi.Open()
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		if err := writeObject(ctx, b, prefix+name, r); err != nil {
+			return err
+		}
+		files++
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return files, nil
+}
+
+func writeObject(ctx context.Context, b *blob.Bucket, key string, r io.Reader) error {
+	w, err := b.NewWriter(ctx, key, nil)
+	if err != nil {
+		return err
+	}
+
+	_, copyErr := io.Copy(w, r)
+	closeErr := w.Close()
+
+	return errors.Join(copyErr, closeErr)
+}
+
+type DeleteRestoredAIPActivity struct {
+	storagesvc storage.Service
+	bucketCfg  *bucket.Config
+}
+
+type DeleteRestoredAIPActivityParams struct {
+	// LocationID is the restore location. If nil, the restored AIP is deleted
+	// from the configured restore bucket.
+	LocationID uuid.UUID
+
+	// Key is the object key of the restored AIP or, if it ends with a slash,
+	// the key prefix of the unpacked AIP files.
+	Key string
+}
+
+type DeleteRestoredAIPActivityResult struct{}
+
+// NewDeleteRestoredAIPActivity returns a DeleteRestoredAIPActivity. bucketCfg
+// configures the default restore bucket and it can be nil if there isn't one.
+func NewDeleteRestoredAIPActivity(
+	storagesvc storage.Service,
+	bucketCfg *bucket.Config,
+) *DeleteRestoredAIPActivity {
+	return &DeleteRestoredAIPActivity{storagesvc: storagesvc, bucketCfg: bucketCfg}
+}
+
+// Execute deletes a restored AIP from the restore location. Objects that have
+// already been deleted are ignored.
+func (a *DeleteRestoredAIPActivity) Execute(
+	ctx context.Context,
+	params *DeleteRestoredAIPActivityParams,
+) (*DeleteRestoredAIPActivityResult, error) {
+	b, err := openRestoreBucket(ctx, a.storagesvc, a.bucketCfg, params.LocationID)
+	if err != nil {
+		return nil, err
+	}
+	defer b.Close()
+
+	if !strings.HasSuffix(params.Key, "/") {
+		if err := deleteObject(ctx, b, params.Key); err != nil {
+			return nil, err
+		}
+		return &DeleteRestoredAIPActivityResult{}, nil
+	}
+
+	iter := b.List(&blob.ListOptions{Prefix: params.Key})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list restored AIP files: %v", err)
+		}
+		if obj.IsDir {
+			continue
+		}
+		if err := deleteObject(ctx, b, obj.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	return &DeleteRestoredAIPActivityResult{}, nil
+}
+
+func deleteObject(ctx context.Context, b *blob.Bucket, key string) error {
+	err := b.Delete(ctx, key)
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return fmt.Errorf("delete %q: %v", key, err)
+	}
+
+	return nil
+}
+
+// openRestoreBucket opens the bucket of the restore location, or the
+// configured restore bucket if locationID is nil.
+func openRestoreBucket(
+	ctx context.Context,
+	storagesvc storage.Service,
+	bucketCfg *bucket.Config,
+	locationID uuid.UUID,
+) (*blob.Bucket, error) {
+	if locationID == uuid.Nil {
+		if bucketCfg == nil {
+			return nil, errors.New("restore bucket not configured")
+		}
+		b, err := bucket.NewWithConfig(ctx, bucketCfg)
+		if err != nil {
+			return nil, fmt.Errorf("open restore bucket: %v", err)
+		}
+		return b, nil
+	}
+
+	l, err := storagesvc.Location(ctx, locationID)
+	if err != nil {
+		return nil, fmt.Errorf("read location: %v", err)
+	}
+
+	b, err := l.OpenBucket(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("open restore location: %v", err)
+	}
+
+	return b, nil
+}
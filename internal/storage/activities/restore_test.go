@@ -0,0 +1,399 @@
+package activities_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/bucket"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	tfs "gotest.tools/v3/fs"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
+)
+
+func zipArchive(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		assert.NilError(t, err)
+		_, err = f.Write([]byte(content))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, w.Close())
+
+	return buf.String()
+}
+
+func restoreLocation(t *testing.T, locationID uuid.UUID, path string) storage.Location {
+	t.Helper()
+
+	l, err := storage.NewLocation(&goastorage.Location{
+		UUID:   locationID,
+		Config: goastorage.NewConfigURL(&goastorage.URLConfig{URL: "file://" + path}),
+	})
+	assert.NilError(t, err)
+
+	return l
+}
+
+func TestRestoreAIPActivity(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	restoreID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	locationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	name := "Test AIP-" + aipID.String() + "-" + restoreID.String()
+
+	goaAIP := &goastorage.AIP{
+		UUID:         aipID,
+		Name:         "Test AIP",
+		LocationUUID: new(uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")),
+	}
+
+	type test struct {
+		name        string
+		params      activities.RestoreAIPActivityParams
+		content     func(*testing.T) string
+		mock        func(*testing.T, *fake.MockService, string, string)
+		noBucketCfg bool
+		want        activities.RestoreAIPActivityResult
+		wantArchive bool
+		wantFiles   map[string]string
+		wantErr     string
+	}
+	for _, tc := range []test{
+		{
+			name:   "Copies the AIP to the restore location",
+			params: activities.RestoreAIPActivityParams{AIPID: aipID, RestoreID: restoreID, LocationID: locationID},
+			content: func(t *testing.T) string {
+				return zipArchive(t, map[string]string{"bag/bagit.txt": "BagIt-Version: 0.97"})
+			},
+			mock: func(t *testing.T, msvc *fake.MockService, path, content string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				msvc.EXPECT().Location(mockutil.Context(), locationID).Return(restoreLocation(t, locationID, path), nil)
+			},
+			want:        activities.RestoreAIPActivityResult{Key: name + ".zip"},
+			wantArchive: true,
+		},
+		{
+			name:   "Copies the AIP to the configured restore bucket",
+			params: activities.RestoreAIPActivityParams{AIPID: aipID, RestoreID: restoreID},
+			content: func(t *testing.T) string {
+				return zipArchive(t, map[string]string{"bag/bagit.txt": "BagIt-Version: 0.97"})
+			},
+			mock: func(t *testing.T, msvc *fake.MockService, path, content string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+			},
+			want:        activities.RestoreAIPActivityResult{Key: name + ".zip"},
+			wantArchive: true,
+		},
+		{
+			name:   "Copies an AIP that isn't an archive without an extension",
+			params: activities.RestoreAIPActivityParams{AIPID: aipID, RestoreID: restoreID},
+			mock: func(t *testing.T, msvc *fake.MockService, path, content string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+			},
+			want:      activities.RestoreAIPActivityResult{Key: name},
+			wantFiles: map[string]string{name: "AIP content"},
+		},
+		{
+			name:   "Unpacks the AIP in the restore location",
+			params: activities.RestoreAIPActivityParams{AIPID: aipID, RestoreID: restoreID, LocationID: locationID, Unpack: true},
+			content: func(t *testing.T) string {
+				return zipArchive(t, map[string]string{
+					"bag/bagit.txt":       "BagIt-Version: 0.97",
+					"bag/data/object.txt": "object",
+				})
+			},
+			mock: func(t *testing.T, msvc *fake.MockService, path, content string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				msvc.EXPECT().Location(mockutil.Context(), locationID).Return(restoreLocation(t, locationID, path), nil)
+			},
+			want: activities.RestoreAIPActivityResult{Key: name + "/", Files: 2},
+			wantFiles: map[string]string{
+				name + "/bag/bagit.txt":       "BagIt-Version: 0.97",
+				name + "/bag/data/object.txt": "object",
+			},
+		},
+		{
+			name:   "Errors when the AIP has a file outside its root",
+			params: activities.RestoreAIPActivityParams{AIPID: aipID, RestoreID: restoreID, LocationID: locationID, Unpack: true},
+			content: func(t *testing.T) string {
+				return zipArchive(t, map[string]string{"../object.txt": "object"})
+			},
+			mock: func(t *testing.T, msvc *fake.MockService, path, content string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				msvc.EXPECT().Location(mockutil.Context(), locationID).Return(restoreLocation(t, locationID, path), nil)
+			},
+			wantErr: `invalid file path: "../object.txt"`,
+		},
+		{
+			name:   "Errors when the AIP can't be read",
+			params: activities.RestoreAIPActivityParams{AIPID: aipID, RestoreID: restoreID, LocationID: locationID},
+			mock: func(t *testing.T, msvc *fake.MockService, path, content string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(nil, errors.New("not found"))
+			},
+			wantErr: "read AIP: not found",
+		},
+		{
+			name:   "Errors when the restore location can't be read",
+			params: activities.RestoreAIPActivityParams{AIPID: aipID, RestoreID: restoreID, LocationID: locationID},
+			mock: func(t *testing.T, msvc *fake.MockService, path, content string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				msvc.EXPECT().Location(mockutil.Context(), locationID).Return(nil, errors.New("not found"))
+			},
+			wantErr: "read location: not found",
+		},
+		{
+			name:   "Errors when the restore bucket isn't configured",
+			params: activities.RestoreAIPActivityParams{AIPID: aipID, RestoreID: restoreID},
+			mock: func(t *testing.T, msvc *fake.MockService, path, content string) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+			},
+			noBucketCfg: true,
+			wantErr:     "restore bucket not configured",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			td := tfs.NewDir(t, "enduro-restore-test")
+			content := "AIP content"
+			if tc.content != nil {
+				content = tc.content(t)
+			}
+
+			msvc := fake.NewMockService(gomock.NewController(t))
+			if tc.mock != nil {
+				tc.mock(t, msvc, td.Path(), content)
+			}
+
+			var bucketCfg *bucket.Config
+			if !tc.noBucketCfg {
+				bucketCfg = &bucket.Config{URL: "file://" + td.Path()}
+			}
+
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(
+				activities.NewRestoreAIPActivity(msvc, bucketCfg).Execute,
+				temporalsdk_activity.RegisterOptions{
+					Name: storage.RestoreAIPActivityName,
+				},
+			)
+
+			enc, err := env.ExecuteActivity(storage.RestoreAIPActivityName, &tc.params)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			var res activities.RestoreAIPActivityResult
+			_ = enc.Get(&res)
+			assert.DeepEqual(t, res, tc.want)
+
+			if tc.wantArchive {
+				b, err := os.ReadFile(filepath.Join(td.Path(), filepath.FromSlash(tc.want.Key)))
+				assert.NilError(t, err)
+				assert.Equal(t, string(b), content)
+			}
+			for key, want := range tc.wantFiles {
+				b, err := os.ReadFile(filepath.Join(td.Path(), filepath.FromSlash(key)))
+				assert.NilError(t, err)
+				assert.Equal(t, string(b), want)
+			}
+		})
+	}
+}
+
+func TestDeleteRestoredAIPActivity(t *testing.T) {
+	t.Parallel()
+
+	locationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	type test struct {
+		name      string
+		key       string
+		mock      func(*testing.T, *fake.MockService, string)
+		wantFiles []string
+		wantErr   string
+	}
+	for _, tc := range []test{
+		{
+			name: "Deletes a restored AIP",
+			key:  "aip.7z",
+			mock: func(t *testing.T, msvc *fake.MockService, path string) {
+				msvc.EXPECT().Location(mockutil.Context(), locationID).Return(restoreLocation(t, locationID, path), nil)
+			},
+			wantFiles: []string{"aip/bagit.txt", "aip/data/object.txt", "other.7z"},
+		},
+		{
+			name: "Deletes the files of an unpacked AIP",
+			key:  "aip/",
+			mock: func(t *testing.T, msvc *fake.MockService, path string) {
+				msvc.EXPECT().Location(mockutil.Context(), locationID).Return(restoreLocation(t, locationID, path), nil)
+			},
+			wantFiles: []string{"aip.7z", "other.7z"},
+		},
+		{
+			name: "Ignores a restored AIP that has already been deleted",
+			key:  "missing.7z",
+			mock: func(t *testing.T, msvc *fake.MockService, path string) {
+				msvc.EXPECT().Location(mockutil.Context(), locationID).Return(restoreLocation(t, locationID, path), nil)
+			},
+			wantFiles: []string{"aip/bagit.txt", "aip/data/object.txt", "aip.7z", "other.7z"},
+		},
+		{
+			name: "Errors when the restore location can't be read",
+			key:  "aip.7z",
+			mock: func(t *testing.T, msvc *fake.MockService, path string) {
+				msvc.EXPECT().Location(mockutil.Context(), locationID).Return(nil, errors.New("not found"))
+			},
+			wantErr: "read location: not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			td := tfs.NewDir(t, "enduro-restore-test",
+				tfs.WithFile("aip.7z", "AIP content"),
+				tfs.WithFile("other.7z", "AIP content"),
+				tfs.WithDir("aip",
+					tfs.WithFile("bagit.txt", "BagIt-Version: 0.97"),
+					tfs.WithDir("data", tfs.WithFile("object.txt", "object")),
+				),
+			)
+			msvc := fake.NewMockService(gomock.NewController(t))
+			if tc.mock != nil {
+				tc.mock(t, msvc, td.Path())
+			}
+
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(
+				activities.NewDeleteRestoredAIPActivity(msvc, nil).Execute,
+				temporalsdk_activity.RegisterOptions{
+					Name: storage.DeleteRestoredAIPActivityName,
+				},
+			)
+
+			_, err := env.ExecuteActivity(
+				storage.DeleteRestoredAIPActivityName,
+				&activities.DeleteRestoredAIPActivityParams{
+					LocationID: locationID,
+					Key:        tc.key,
+				},
+			)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			var files []string
+			err = filepath.WalkDir(td.Path(), func(path string, d os.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+				rel, err := filepath.Rel(td.Path(), path)
+				if err != nil {
+					return err
+				}
+				files = append(files, filepath.ToSlash(rel))
+				return nil
+			})
+			assert.NilError(t, err)
+			assert.DeepEqual(t, files, tc.wantFiles)
+		})
+	}
+}
+
+func TestOverlappingRestores(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	locationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	restoreIDs := []uuid.UUID{
+		uuid.MustParse("423e4567-e89b-12d3-a456-426614174000"),
+		uuid.MustParse("523e4567-e89b-12d3-a456-426614174000"),
+	}
+	goaAIP := &goastorage.AIP{UUID: aipID, Name: "Test AIP"}
+	content := zipArchive(t, map[string]string{"bag/bagit.txt": "BagIt-Version: 0.97"})
+
+	td := tfs.NewDir(t, "enduro-restore-test")
+	msvc := fake.NewMockService(gomock.NewController(t))
+	msvc.EXPECT().
+		Location(mockutil.Context(), locationID).
+		Return(restoreLocation(t, locationID, td.Path()), nil).
+		AnyTimes()
+
+	ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+	env := ts.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(
+		activities.NewRestoreAIPActivity(msvc, nil).Execute,
+		temporalsdk_activity.RegisterOptions{Name: storage.RestoreAIPActivityName},
+	)
+	env.RegisterActivityWithOptions(
+		activities.NewDeleteRestoredAIPActivity(msvc, nil).Execute,
+		temporalsdk_activity.RegisterOptions{Name: storage.DeleteRestoredAIPActivityName},
+	)
+
+	// Restore the same AIP twice to the same location.
+	var keys []string
+	for _, restoreID := range restoreIDs {
+		msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+		msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+
+		enc, err := env.ExecuteActivity(
+			storage.RestoreAIPActivityName,
+			&activities.RestoreAIPActivityParams{
+				AIPID:      aipID,
+				RestoreID:  restoreID,
+				LocationID: locationID,
+				Unpack:     true,
+			},
+		)
+		assert.NilError(t, err)
+
+		var res activities.RestoreAIPActivityResult
+		_ = enc.Get(&res)
+		keys = append(keys, res.Key)
+	}
+	assert.Assert(t, keys[0] != keys[1])
+
+	// Expiring the first restore must keep the files of the second one.
+	_, err := env.ExecuteActivity(
+		storage.DeleteRestoredAIPActivityName,
+		&activities.DeleteRestoredAIPActivityParams{LocationID: locationID, Key: keys[0]},
+	)
+	assert.NilError(t, err)
+
+	_, err = os.Stat(filepath.Join(td.Path(), filepath.FromSlash(keys[0]), "bag", "bagit.txt"))
+	assert.Assert(t, os.IsNotExist(err))
+	b, err := os.ReadFile(filepath.Join(td.Path(), filepath.FromSlash(keys[1]), "bag", "bagit.txt"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(b), "BagIt-Version: 0.97")
+}
@@ -0,0 +1,124 @@
+package activities
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	temporal_tools "go.artefactual.dev/tools/temporal"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+// FixityChecksumAlgorithm is the algorithm used to compute the stored AIP
+// checksums that fixity checks are verified against.
+const FixityChecksumAlgorithm = "sha256"
+
+type VerifyAIPFixityActivity struct {
+	storagesvc storage.Service
+}
+
+type VerifyAIPFixityActivityParams struct {
+	AIPID        uuid.UUID
+	WorkflowDBID int
+}
+
+type VerifyAIPFixityActivityResult struct {
+	// Status is the outcome of the fixity check. It's baseline when the AIP
+	// had no stored checksum and the computed checksum has been recorded as
+	// the baseline for future checks, the AIP fixity is not verified then.
+	Status enums.FixityCheckStatus
+
+	// ChecksumAlgorithm is the algorithm used to compute the checksums.
+	ChecksumAlgorithm string
+
+	// ExpectedChecksum is the checksum stored for the AIP.
+	ExpectedChecksum string
+
+	// ActualChecksum is the checksum computed from the stored AIP object.
+	ActualChecksum string
+}
+
+func NewVerifyAIPFixityActivity(storagesvc storage.Service) *VerifyAIPFixityActivity {
+	return &VerifyAIPFixityActivity{storagesvc: storagesvc}
+}
+
+// Execute streams the AIP object from its location, computes its checksum and
+// compares it with the checksum stored for the AIP. If the AIP has no stored
+// checksum, the computed value is recorded as the baseline and the check has a
+// baseline status instead of passed. The result of the check is persisted as a
+// fixity check record.
+func (a *VerifyAIPFixityActivity) Execute(
+	ctx context.Context,
+	params *VerifyAIPFixityActivityParams,
+) (*VerifyAIPFixityActivityResult, error) {
+	h := temporal_tools.StartAutoHeartbeat(ctx)
+	defer h.Stop()
+
+	aip, err := a.storagesvc.ReadAip(ctx, params.AIPID)
+	if err != nil {
+		return nil, fmt.Errorf("read AIP: %v", err)
+	}
+
+	reader, err := a.storagesvc.AipReader(ctx, aip)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return nil, fmt.Errorf("read AIP object: %v", err)
+	}
+	actual := hex.EncodeToString(hash.Sum(nil))
+
+	res := &VerifyAIPFixityActivityResult{
+		Status:            enums.FixityCheckStatusPassed,
+		ChecksumAlgorithm: FixityChecksumAlgorithm,
+		ActualChecksum:    actual,
+	}
+
+	// Read the stored checksum, recording the computed one if missing.
+	_, err = a.storagesvc.UpdateAIP(ctx, params.AIPID, func(aip *types.AIP) (*types.AIP, error) {
+		if aip.ChecksumHash == "" {
+			aip.ChecksumAlgorithm = FixityChecksumAlgorithm
+			aip.ChecksumHash = actual
+			res.Status = enums.FixityCheckStatusBaseline
+		}
+		if aip.ChecksumAlgorithm != FixityChecksumAlgorithm {
+			return nil, fmt.Errorf("unsupported checksum algorithm: %q", aip.ChecksumAlgorithm)
+		}
+		res.ExpectedChecksum = aip.ChecksumHash
+
+		return aip, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update AIP: %v", err)
+	}
+
+	if res.ActualChecksum != res.ExpectedChecksum {
+		res.Status = enums.FixityCheckStatusFailed
+	}
+
+	err = a.storagesvc.CreateFixityCheck(ctx, &types.FixityCheck{
+		UUID:              uuid.New(),
+		ChecksumAlgorithm: res.ChecksumAlgorithm,
+		ExpectedChecksum:  res.ExpectedChecksum,
+		ActualChecksum:    res.ActualChecksum,
+		Status:            res.Status,
+		CheckedAt:         time.Now(),
+		AIPUUID:           params.AIPID,
+		WorkflowDBID:      params.WorkflowDBID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create fixity check: %v", err)
+	}
+
+	return res, nil
+}
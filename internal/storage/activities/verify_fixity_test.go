@@ -0,0 +1,221 @@
+package activities_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"go.uber.org/mock/gomock"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/memblob"
+	"gotest.tools/v3/assert"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+func aipReader(t *testing.T, key, content string) *blob.Reader {
+	t.Helper()
+
+	b, err := blob.OpenBucket(t.Context(), "mem://")
+	assert.NilError(t, err)
+	t.Cleanup(func() { b.Close() })
+
+	assert.NilError(t, b.WriteAll(t.Context(), key, []byte(content), nil))
+
+	r, err := b.NewReader(t.Context(), key, nil)
+	assert.NilError(t, err)
+
+	return r
+}
+
+func TestVerifyAIPFixityActivity(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	content := "AIP content"
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+	badChecksum := "0000000000000000000000000000000000000000000000000000000000000000"
+
+	goaAIP := &goastorage.AIP{
+		UUID:         aipID,
+		Name:         "Test AIP",
+		LocationUUID: new(uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")),
+	}
+
+	expectUpdateAIP := func(t *testing.T, msvc *fake.MockService, stored, want *types.AIP) {
+		msvc.EXPECT().
+			UpdateAIP(
+				mockutil.Context(),
+				aipID,
+				mockutil.Func(
+					"Reads the stored AIP checksum",
+					func(updater persistence.AIPUpdater) error {
+						got, err := updater(stored)
+						assert.NilError(t, err)
+						assert.DeepEqual(t, got, want)
+						return nil
+					},
+				),
+			).
+			Return(want, nil)
+	}
+
+	expectCreateFixityCheck := func(msvc *fake.MockService, expected string, status enums.FixityCheckStatus) {
+		msvc.EXPECT().
+			CreateFixityCheck(
+				mockutil.Context(),
+				mockutil.Func(
+					"Creates a fixity check",
+					func(fc *types.FixityCheck) error {
+						if fc.AIPUUID != aipID ||
+							fc.WorkflowDBID != 1 ||
+							fc.ChecksumAlgorithm != "sha256" ||
+							fc.ExpectedChecksum != expected ||
+							fc.ActualChecksum != checksum ||
+							fc.Status != status {
+							return errors.New("unexpected fixity check")
+						}
+						return nil
+					},
+				),
+			).
+			Return(nil)
+	}
+
+	type test struct {
+		name    string
+		mock    func(*testing.T, *fake.MockService)
+		want    activities.VerifyAIPFixityActivityResult
+		wantErr string
+	}
+	for _, tc := range []test{
+		{
+			name: "Passes when the checksum matches the stored value",
+			mock: func(t *testing.T, msvc *fake.MockService) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				stored := &types.AIP{UUID: aipID, ChecksumAlgorithm: "sha256", ChecksumHash: checksum}
+				expectUpdateAIP(t, msvc, stored, stored)
+				expectCreateFixityCheck(msvc, checksum, enums.FixityCheckStatusPassed)
+			},
+			want: activities.VerifyAIPFixityActivityResult{
+				Status:            enums.FixityCheckStatusPassed,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  checksum,
+				ActualChecksum:    checksum,
+			},
+		},
+		{
+			name: "Fails when the checksum doesn't match the stored value",
+			mock: func(t *testing.T, msvc *fake.MockService) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				stored := &types.AIP{UUID: aipID, ChecksumAlgorithm: "sha256", ChecksumHash: badChecksum}
+				expectUpdateAIP(t, msvc, stored, stored)
+				expectCreateFixityCheck(msvc, badChecksum, enums.FixityCheckStatusFailed)
+			},
+			want: activities.VerifyAIPFixityActivityResult{
+				Status:            enums.FixityCheckStatusFailed,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  badChecksum,
+				ActualChecksum:    checksum,
+			},
+		},
+		{
+			name: "Records a baseline checksum when the AIP has none",
+			mock: func(t *testing.T, msvc *fake.MockService) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				expectUpdateAIP(
+					t,
+					msvc,
+					&types.AIP{UUID: aipID},
+					&types.AIP{UUID: aipID, ChecksumAlgorithm: "sha256", ChecksumHash: checksum},
+				)
+				expectCreateFixityCheck(msvc, checksum, enums.FixityCheckStatusBaseline)
+			},
+			want: activities.VerifyAIPFixityActivityResult{
+				Status:            enums.FixityCheckStatusBaseline,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  checksum,
+				ActualChecksum:    checksum,
+			},
+		},
+		{
+			name: "Errors when the AIP can't be read",
+			mock: func(t *testing.T, msvc *fake.MockService) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(nil, errors.New("not found"))
+			},
+			wantErr: "read AIP: not found",
+		},
+		{
+			name: "Errors when the AIP object can't be opened",
+			mock: func(t *testing.T, msvc *fake.MockService) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(nil, errors.New("new AIP reader: not found"))
+			},
+			wantErr: "new AIP reader: not found",
+		},
+		{
+			name: "Errors when the stored checksum algorithm is unsupported",
+			mock: func(t *testing.T, msvc *fake.MockService) {
+				msvc.EXPECT().ReadAip(mockutil.Context(), aipID).Return(goaAIP, nil)
+				msvc.EXPECT().AipReader(mockutil.Context(), goaAIP).Return(aipReader(t, aipID.String(), content), nil)
+				msvc.EXPECT().
+					UpdateAIP(mockutil.Context(), aipID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, updater persistence.AIPUpdater) (*types.AIP, error) {
+						return updater(&types.AIP{UUID: aipID, ChecksumAlgorithm: "md5", ChecksumHash: "abc"})
+					})
+			},
+			wantErr: "update AIP: unsupported checksum algorithm: \"md5\"",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			msvc := fake.NewMockService(gomock.NewController(t))
+			if tc.mock != nil {
+				tc.mock(t, msvc)
+			}
+
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(
+				activities.NewVerifyAIPFixityActivity(msvc).Execute,
+				temporalsdk_activity.RegisterOptions{
+					Name: storage.VerifyAIPFixityActivityName,
+				},
+			)
+
+			enc, err := env.ExecuteActivity(
+				storage.VerifyAIPFixityActivityName,
+				&activities.VerifyAIPFixityActivityParams{
+					AIPID:        aipID,
+					WorkflowDBID: 1,
+				},
+			)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			var res activities.VerifyAIPFixityActivityResult
+			_ = enc.Get(&res)
+			assert.DeepEqual(t, res, tc.want)
+		})
+	}
+}
@@ -1,6 +1,12 @@
 package storage
 
 import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
 	"go.artefactual.dev/tools/bucket"
 
 	"github.com/artefactual-sdps/enduro/internal/event"
@@ -12,6 +18,83 @@ type Config struct {
 	Database    Database
 	Event       event.Config
 	AIPDeletion AIPDeletionConfig
+	Fixity      FixityConfig
+	Retention   RetentionConfig
+	Restore     RestoreConfig
+	Quotas      []QuotaConfig
+
+	// FilesystemRoots lists the directories under which filesystem locations
+	// can be created. Filesystem locations can't be created when it's empty.
+	FilesystemRoots []string
+
+	// CountAIPFiles determines whether the files of an AIP are counted when
+	// it's stored. Archive formats like 7z and zip can't be read as a stream,
+	// so counting the files needs a temporary local copy of each AIP.
+	CountAIPFiles bool
+}
+
+func (c Config) Validate() error {
+	errs := []error{
+		c.AIPDeletion.Validate(),
+		c.Fixity.Validate(),
+		c.Retention.Validate(),
+		c.Restore.Validate(),
+		c.validateRetentionAutoApproval(),
+	}
+	for _, q := range c.Quotas {
+		errs = append(errs, q.Validate())
+	}
+	for _, root := range c.FilesystemRoots {
+		if !filepath.IsAbs(root) {
+			errs = append(errs, fmt.Errorf("filesystemRoots: path must be absolute: %q", root))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateRetentionAutoApproval returns an error if the retention deletion
+// requests are auto-approved for a location whose approval policy requires
+// more than one approver, as the deletion workflow refuses to auto-approve
+// them.
+func (c Config) validateRetentionAutoApproval() error {
+	if !c.Retention.Enabled {
+		return nil
+	}
+
+	var errs []error
+	for _, p := range c.Retention.Policies {
+		if p.AutoApprove && c.AIPDeletion.ApprovalPolicy(&p.LocationID).MinApprovers > 1 {
+			errs = append(errs, fmt.Errorf(
+				"retention: policy autoApprove requires a single deletion approver: %s", p.LocationID,
+			))
+		}
+	}
+	if c.Retention.AutoApprove {
+		if c.AIPDeletion.ApprovalPolicy(nil).MinApprovers > 1 {
+			errs = append(errs, errors.New("retention: autoApprove requires a single deletion approver"))
+		}
+		for _, p := range c.AIPDeletion.ApprovalPolicies {
+			if _, ok := c.Retention.Policy(p.LocationID); !ok && p.MinApprovers > 1 {
+				errs = append(errs, fmt.Errorf(
+					"retention: autoApprove requires a single deletion approver: %s", p.LocationID,
+				))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Quota returns the quota configured for a location, if any.
+func (c Config) Quota(locationID uuid.UUID) (QuotaConfig, bool) {
+	for _, q := range c.Quotas {
+		if q.LocationID == locationID {
+			return q, true
+		}
+	}
+
+	return QuotaConfig{}, false
 }
 
 type Database struct {
@@ -37,4 +120,226 @@ type AIPDeletionConfig struct {
 	// generate AIP deletion reports. If ReportTemplatePath is empty, AIP
 	// deletion reports will not be generated.
 	ReportTemplatePath string
+
+	// MinApprovers is the number of distinct users that must approve an AIP
+	// deletion request before the AIP is deleted.
+	MinApprovers int
+
+	// RequesterCanApprove determines whether the user who requested an AIP
+	// deletion can also approve it, counting as one of the approvers.
+	RequesterCanApprove bool
+
+	// ApprovalPolicies override MinApprovers and RequesterCanApprove for the
+	// AIPs stored in specific locations.
+	ApprovalPolicies []DeletionApprovalPolicy
+}
+
+// ApprovalPolicy returns the approval policy of the deletion requests of the
+// AIPs stored in a location, which is the policy configured for the location
+// or the default one.
+func (c AIPDeletionConfig) ApprovalPolicy(locationID *uuid.UUID) DeletionApprovalPolicy {
+	if locationID != nil {
+		for _, p := range c.ApprovalPolicies {
+			if p.LocationID == *locationID {
+				return p
+			}
+		}
+	}
+
+	return DeletionApprovalPolicy{
+		MinApprovers:        max(c.MinApprovers, 1),
+		RequesterCanApprove: c.RequesterCanApprove,
+	}
+}
+
+func (c AIPDeletionConfig) Validate() error {
+	var errs []error
+	if c.MinApprovers < 0 {
+		errs = append(errs, errors.New("aipDeletion: minApprovers must not be negative"))
+	}
+	for _, p := range c.ApprovalPolicies {
+		errs = append(errs, p.Validate())
+	}
+
+	return errors.Join(errs...)
+}
+
+type DeletionApprovalPolicy struct {
+	// LocationID is the identifier of the storage location the policy
+	// applies to.
+	LocationID uuid.UUID
+
+	// MinApprovers is the number of distinct users that must approve the
+	// deletion requests of the AIPs stored in the location.
+	MinApprovers int
+
+	// RequesterCanApprove determines whether the user who requested the
+	// deletion of an AIP stored in the location can also approve it.
+	RequesterCanApprove bool
+}
+
+func (p DeletionApprovalPolicy) Validate() error {
+	var errs []error
+	if p.LocationID == uuid.Nil {
+		errs = append(errs, errors.New("aipDeletion: missing approval policy locationID"))
+	}
+	if p.MinApprovers < 1 {
+		errs = append(errs, fmt.Errorf("aipDeletion: approval policy minApprovers must be greater than zero: %s", p.LocationID))
+	}
+
+	return errors.Join(errs...)
+}
+
+type FixityConfig struct {
+	// Enabled determines whether stored AIPs are periodically audited by
+	// recomputing their checksums and comparing them with the stored values.
+	Enabled bool
+
+	// Schedule is the cron expression used to start the fixity audit
+	// (e.g. "0 2 * * 0" runs it every Sunday at 02:00 UTC).
+	Schedule string
+
+	// BatchSize is the number of AIPs audited in a single workflow run before
+	// the audit workflow continues as new, which keeps its history bounded.
+	BatchSize int
+}
+
+func (c FixityConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if c.Schedule == "" {
+		errs = append(errs, errors.New("fixity: missing schedule"))
+	}
+	if c.BatchSize < 1 {
+		errs = append(errs, errors.New("fixity: batchSize must be greater than zero"))
+	}
+
+	return errors.Join(errs...)
+}
+
+type RetentionConfig struct {
+	// Enabled determines whether AIPs past their disposal date are
+	// periodically found and requested for deletion. Disposal dates are
+	// recorded when AIPs are stored even if the schedule isn't enabled.
+	Enabled bool
+
+	// Schedule is the cron expression used to start the retention workflow
+	// (e.g. "0 3 * * *" runs it every day at 03:00 UTC).
+	Schedule string
+
+	// BatchSize is the maximum number of deletion requests created in a
+	// single run of the retention workflow.
+	BatchSize int
+
+	// MetadataKey is the custom metadata key holding the disposal date of an
+	// AIP, as an RFC 3339 timestamp or a "YYYY-MM-DD" date. When set, it takes
+	// precedence over the retention policy of the AIP location.
+	MetadataKey string
+
+	// AutoApprove determines whether the deletion requests of AIPs stored in
+	// locations without a retention policy are automatically approved.
+	AutoApprove bool
+
+	// Policies are the retention policies of the storage locations.
+	Policies []RetentionPolicy
+}
+
+// Policy returns the retention policy of a location, if any.
+func (c RetentionConfig) Policy(locationID uuid.UUID) (RetentionPolicy, bool) {
+	for _, p := range c.Policies {
+		if p.LocationID == locationID {
+			return p, true
+		}
+	}
+
+	return RetentionPolicy{}, false
+}
+
+func (c RetentionConfig) Validate() error {
+	var errs []error
+	if c.Enabled {
+		if c.Schedule == "" {
+			errs = append(errs, errors.New("retention: missing schedule"))
+		}
+		if c.BatchSize < 1 {
+			errs = append(errs, errors.New("retention: batchSize must be greater than zero"))
+		}
+	}
+	for _, p := range c.Policies {
+		errs = append(errs, p.Validate())
+	}
+
+	return errors.Join(errs...)
+}
+
+type RetentionPolicy struct {
+	// LocationID is the identifier of the storage location the policy
+	// applies to.
+	LocationID uuid.UUID
+
+	// Period is the duration AIPs are kept in the location, counted from their
+	// creation, before they are due for deletion.
+	Period time.Duration
+
+	// AutoApprove determines whether the deletion requests created for the
+	// AIPs in the location are automatically approved. When false, they
+	// await review.
+	AutoApprove bool
+}
+
+func (p RetentionPolicy) Validate() error {
+	var errs []error
+	if p.LocationID == uuid.Nil {
+		errs = append(errs, errors.New("retention: missing policy locationID"))
+	}
+	if p.Period <= 0 {
+		errs = append(errs, fmt.Errorf("retention: policy period must be greater than zero: %s", p.LocationID))
+	}
+
+	return errors.Join(errs...)
+}
+
+type RestoreConfig struct {
+	// Bucket is the default location where AIPs are restored when a restore
+	// request doesn't specify a storage location, e.g. the SIP source bucket.
+	// If nil, restore requests must specify a location.
+	Bucket *bucket.Config
+
+	// TTL is the duration for which restored AIPs are kept in the restore
+	// location before they are deleted. If negative, restored AIPs are kept
+	// indefinitely.
+	TTL time.Duration
+}
+
+type QuotaConfig struct {
+	// LocationID is the identifier of the storage location the quota applies
+	// to.
+	LocationID uuid.UUID
+
+	// MaxSize is the maximum total size in bytes of the AIPs stored in the
+	// location. New AIPs are rejected once it is reached.
+	MaxSize int64
+}
+
+func (c QuotaConfig) Validate() error {
+	var errs []error
+	if c.LocationID == uuid.Nil {
+		errs = append(errs, errors.New("quota: missing locationID"))
+	}
+	if c.MaxSize < 1 {
+		errs = append(errs, fmt.Errorf("quota: maxSize must be greater than zero: %s", c.LocationID))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c RestoreConfig) Validate() error {
+	if c.TTL == 0 {
+		return errors.New("restore: ttl must not be zero")
+	}
+
+	return nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/auth"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
 )
 
 func checkClaims(claims *auth.Claims) error {
@@ -91,6 +92,14 @@ func (s *serviceImpl) requestAIPDeletion(
 		return goastorage.MakeNotValid(errors.New("AIP is not stored"))
 	}
 
+	if err := s.CheckAIPLegalHold(ctx, aipID); err != nil {
+		return err
+	}
+
+	if autoApprove && s.config.AIPDeletion.ApprovalPolicy(aip.LocationUUID).MinApprovers > 1 {
+		return goastorage.MakeNotValid(errors.New("AIP deletion can't be auto-approved: multiple approvers required"))
+	}
+
 	_, err = InitStorageDeleteWorkflow(ctx, s.tc, &StorageDeleteWorkflowRequest{
 		AIPID:       aipID,
 		Reason:      reason,
@@ -139,8 +148,27 @@ func (s *serviceImpl) ReviewAipDeletion(ctx context.Context, payload *goastorage
 		return ErrInternalError
 	}
 
+	// The requester can only approve their own request when the approval
+	// policy of the AIP location allows it.
 	if drs[0].RequesterIss == claims.Iss && drs[0].RequesterSub == claims.Sub {
-		return goastorage.MakeNotValid(errors.New("requester cannot review their own request"))
+		policy := s.config.AIPDeletion.ApprovalPolicy(aip.LocationUUID)
+		if !payload.Approved || !policy.RequesterCanApprove {
+			return goastorage.MakeNotValid(errors.New("requester cannot review their own request"))
+		}
+	}
+
+	// A user can approve the request only once.
+	if payload.Approved {
+		approvals, err := s.ListDeletionRequestApprovals(ctx, drs[0].DBID)
+		if err != nil {
+			s.logger.Error(err, "error listing deletion request approvals", "aip_id", aipID)
+			return ErrInternalError
+		}
+		for _, a := range approvals {
+			if a.ApproverIss == claims.Iss && a.ApproverSub == claims.Sub {
+				return goastorage.MakeNotValid(errors.New("user has already approved the request"))
+			}
+		}
 	}
 
 	status := enums.DeletionRequestStatusRejected
@@ -218,3 +246,53 @@ func (s *serviceImpl) CancelAipDeletion(
 
 	return nil
 }
+
+func (s *serviceImpl) CreateDeletionRequest(ctx context.Context, dr *types.DeletionRequest) error {
+	if err := s.storagePersistence.CreateDeletionRequest(ctx, dr); err != nil {
+		return err
+	}
+	s.auditLogger.Log(ctx, deletionRequestAuditEvent(dr))
+
+	PublishEvent(ctx, s.evsvc, &goastorage.AIPDeletionRequestCreatedEvent{
+		UUID:      dr.UUID,
+		AipUUID:   dr.AIPUUID,
+		Status:    dr.Status.String(),
+		Reason:    dr.Reason,
+		Requester: dr.Requester,
+		Reviewer:  deletionRequestReviewer(dr),
+	})
+
+	return nil
+}
+
+func (s *serviceImpl) UpdateDeletionRequest(
+	ctx context.Context,
+	id int,
+	upd persistence.DeletionRequestUpdater,
+) (*types.DeletionRequest, error) {
+	dr, err := s.storagePersistence.UpdateDeletionRequest(ctx, id, upd)
+	if err != nil {
+		return nil, err
+	}
+	s.auditLogger.Log(ctx, deletionRequestAuditEvent(dr))
+
+	PublishEvent(ctx, s.evsvc, &goastorage.AIPDeletionRequestUpdatedEvent{
+		UUID:      dr.UUID,
+		AipUUID:   dr.AIPUUID,
+		Status:    dr.Status.String(),
+		Reason:    dr.Reason,
+		Requester: dr.Requester,
+		Reviewer:  deletionRequestReviewer(dr),
+	})
+
+	return dr, nil
+}
+
+// deletionRequestReviewer returns the reviewer of dr, or nil if the deletion
+// request hasn't been reviewed yet.
+func deletionRequestReviewer(dr *types.DeletionRequest) *string {
+	if dr.Reviewer == "" {
+		return nil
+	}
+	return &dr.Reviewer
+}
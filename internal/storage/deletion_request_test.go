@@ -20,6 +20,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/fake"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
+	"go.artefactual.dev/tools/bucket"
 )
 
 func TestAipDeletionAuto(t *testing.T) {
@@ -28,6 +29,7 @@ func TestAipDeletionAuto(t *testing.T) {
 	for _, tt := range []struct {
 		name    string
 		claims  *auth.Claims
+		config  *storage.Config
 		payload *goastorage.AipDeletionAutoPayload
 		mock    func(context.Context, *fake.MockStorage, *temporalsdk_mocks.Client)
 		wantErr string
@@ -48,6 +50,7 @@ func TestAipDeletionAuto(t *testing.T) {
 					&goastorage.AIP{Status: enums.AIPStatusStored.String()},
 					nil,
 				)
+				s.EXPECT().HasActiveAIPLegalHold(ctx, aipID).Return(false, nil)
 				tc.On(
 					"ExecuteWorkflow",
 					mock.AnythingOfType("*context.timerCtx"),
@@ -87,6 +90,7 @@ func TestAipDeletionAuto(t *testing.T) {
 					&goastorage.AIP{Status: enums.AIPStatusStored.String()},
 					nil,
 				)
+				s.EXPECT().HasActiveAIPLegalHold(ctx, aipID).Return(false, nil)
 				tc.On(
 					"ExecuteWorkflow",
 					mock.AnythingOfType("*context.timerCtx"),
@@ -120,6 +124,7 @@ func TestAipDeletionAuto(t *testing.T) {
 					&goastorage.AIP{Status: enums.AIPStatusStored.String()},
 					nil,
 				)
+				s.EXPECT().HasActiveAIPLegalHold(ctx, aipID).Return(false, nil)
 				tc.On(
 					"ExecuteWorkflow",
 					mock.AnythingOfType("*context.timerCtx"),
@@ -142,6 +147,31 @@ func TestAipDeletionAuto(t *testing.T) {
 				).Return(nil, nil)
 			},
 		},
+		{
+			name: "Fails to request auto-approved AIP deletion (multiple approvers required)",
+			claims: &auth.Claims{
+				Email: "requester@example.com",
+				Iss:   "issuer",
+				Sub:   "subject",
+			},
+			config: &storage.Config{
+				TaskQueue:   "global",
+				Internal:    bucket.Config{URL: "mem://"},
+				AIPDeletion: storage.AIPDeletionConfig{MinApprovers: 2},
+			},
+			payload: &goastorage.AipDeletionAutoPayload{
+				UUID:   aipID.String(),
+				Reason: "Reason",
+			},
+			mock: func(ctx context.Context, s *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(
+					&goastorage.AIP{Status: enums.AIPStatusStored.String()},
+					nil,
+				)
+				s.EXPECT().HasActiveAIPLegalHold(ctx, aipID).Return(false, nil)
+			},
+			wantErr: "AIP deletion can't be auto-approved: multiple approvers required",
+		},
 		{
 			name:    "Fails to request auto-approved AIP deletion (missing claims)",
 			claims:  &auth.Claims{},
@@ -167,7 +197,7 @@ func TestAipDeletionAuto(t *testing.T) {
 			t.Parallel()
 
 			ctx := auth.WithUserClaims(context.Background(), tt.claims)
-			attrs := &setUpAttrs{}
+			attrs := &setUpAttrs{config: tt.config}
 			svc := setUpService(t, ctx, attrs)
 
 			if tt.mock != nil {
@@ -276,6 +306,23 @@ func TestRequestAipDeletion(t *testing.T) {
 			},
 			wantErr: "AIP is not stored",
 		},
+		{
+			name: "Fails to request AIP deletion (AIP under legal hold)",
+			claims: &auth.Claims{
+				Email: "requester@example.com",
+				Iss:   "issuer",
+				Sub:   "subject",
+			},
+			payload: &goastorage.RequestAipDeletionPayload{
+				UUID:   aipID.String(),
+				Reason: "Reason",
+			},
+			mock: func(ctx context.Context, s *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{Status: enums.AIPStatusStored.String()}, nil)
+				s.EXPECT().HasActiveAIPLegalHold(ctx, aipID).Return(true, nil)
+			},
+			wantErr: "AIP is under legal hold",
+		},
 		{
 			name: "Fails to request AIP deletion (init workflow failure)",
 			claims: &auth.Claims{
@@ -289,6 +336,7 @@ func TestRequestAipDeletion(t *testing.T) {
 			},
 			mock: func(ctx context.Context, s *fake.MockStorage, tc *temporalsdk_mocks.Client) {
 				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{Status: enums.AIPStatusStored.String()}, nil)
+				s.EXPECT().HasActiveAIPLegalHold(ctx, aipID).Return(false, nil)
 				tc.On(
 					"ExecuteWorkflow",
 					mock.AnythingOfType("*context.timerCtx"),
@@ -323,6 +371,7 @@ func TestRequestAipDeletion(t *testing.T) {
 			},
 			mock: func(ctx context.Context, s *fake.MockStorage, tc *temporalsdk_mocks.Client) {
 				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{Status: enums.AIPStatusStored.String()}, nil)
+				s.EXPECT().HasActiveAIPLegalHold(ctx, aipID).Return(false, nil)
 				tc.On(
 					"ExecuteWorkflow",
 					mock.AnythingOfType("*context.timerCtx"),
@@ -371,6 +420,7 @@ func TestReviewAipDeletion(t *testing.T) {
 	type test struct {
 		name    string
 		claims  *auth.Claims
+		config  *storage.Config
 		payload *goastorage.ReviewAipDeletionPayload
 		mock    func(context.Context, *fake.MockStorage, *temporalsdk_mocks.Client)
 		wantErr string
@@ -528,6 +578,114 @@ func TestReviewAipDeletion(t *testing.T) {
 			},
 			wantErr: "internal error",
 		},
+		{
+			name: "Fails to review AIP deletion (approvals read error)",
+			claims: &auth.Claims{
+				Email: "reviewer@example.com",
+				Iss:   "issuer",
+				Sub:   "subject-2",
+			},
+			payload: &goastorage.ReviewAipDeletionPayload{
+				UUID:     aipID.String(),
+				Approved: true,
+			},
+			mock: func(ctx context.Context, s *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{Status: enums.AIPStatusPending.String()}, nil)
+				s.EXPECT().ListDeletionRequests(ctx, &persistence.DeletionRequestFilter{
+					AIPUUID: new(aipID),
+					Status:  ref.New(enums.DeletionRequestStatusPending),
+				}).Return([]*types.DeletionRequest{
+					{
+						DBID:         1,
+						RequesterIss: "issuer",
+						RequesterSub: "subject",
+					},
+				}, nil)
+				s.EXPECT().ListDeletionRequestApprovals(ctx, 1).Return(nil, errors.New("persistence error"))
+			},
+			wantErr: "internal error",
+		},
+		{
+			name: "Fails to review AIP deletion (already approved by the user)",
+			claims: &auth.Claims{
+				Email: "reviewer@example.com",
+				Iss:   "issuer",
+				Sub:   "subject-2",
+			},
+			payload: &goastorage.ReviewAipDeletionPayload{
+				UUID:     aipID.String(),
+				Approved: true,
+			},
+			mock: func(ctx context.Context, s *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{Status: enums.AIPStatusPending.String()}, nil)
+				s.EXPECT().ListDeletionRequests(ctx, &persistence.DeletionRequestFilter{
+					AIPUUID: new(aipID),
+					Status:  ref.New(enums.DeletionRequestStatusPending),
+				}).Return([]*types.DeletionRequest{
+					{
+						DBID:         1,
+						RequesterIss: "issuer",
+						RequesterSub: "subject",
+					},
+				}, nil)
+				s.EXPECT().ListDeletionRequestApprovals(ctx, 1).Return([]*types.DeletionRequestApproval{
+					{
+						DeletionRequestDBID: 1,
+						Approver:            "reviewer@example.com",
+						ApproverIss:         "issuer",
+						ApproverSub:         "subject-2",
+					},
+				}, nil)
+			},
+			wantErr: "user has already approved the request",
+		},
+		{
+			name: "Reviews AIP deletion (requester approval allowed by the policy)",
+			claims: &auth.Claims{
+				Email: "requester@example.com",
+				Iss:   "issuer",
+				Sub:   "subject",
+			},
+			config: &storage.Config{
+				TaskQueue: "global",
+				Internal:  bucket.Config{URL: "mem://"},
+				AIPDeletion: storage.AIPDeletionConfig{
+					MinApprovers:        2,
+					RequesterCanApprove: true,
+				},
+			},
+			payload: &goastorage.ReviewAipDeletionPayload{
+				UUID:     aipID.String(),
+				Approved: true,
+			},
+			mock: func(ctx context.Context, s *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{Status: enums.AIPStatusPending.String()}, nil)
+				s.EXPECT().ListDeletionRequests(ctx, &persistence.DeletionRequestFilter{
+					AIPUUID: new(aipID),
+					Status:  ref.New(enums.DeletionRequestStatusPending),
+				}).Return([]*types.DeletionRequest{
+					{
+						DBID:         1,
+						RequesterIss: "issuer",
+						RequesterSub: "subject",
+					},
+				}, nil)
+				s.EXPECT().ListDeletionRequestApprovals(ctx, 1).Return(nil, nil)
+				tc.On(
+					"SignalWorkflow",
+					mock.AnythingOfType("*context.valueCtx"),
+					fmt.Sprintf("%s-%s", storage.StorageDeleteWorkflowName, aipID),
+					"",
+					storage.DeletionDecisionSignalName,
+					storage.DeletionDecisionSignal{
+						Status:    enums.DeletionRequestStatusApproved,
+						UserEmail: "requester@example.com",
+						UserIss:   "issuer",
+						UserSub:   "subject",
+					},
+				).Return(nil)
+			},
+		},
 		{
 			name: "Reviews AIP deletion",
 			claims: &auth.Claims{
@@ -550,6 +708,7 @@ func TestReviewAipDeletion(t *testing.T) {
 						RequesterSub: "subject",
 					},
 				}, nil)
+				s.EXPECT().ListDeletionRequestApprovals(ctx, 0).Return(nil, nil)
 				tc.On(
 					"SignalWorkflow",
 					mock.AnythingOfType("*context.valueCtx"),
@@ -570,7 +729,7 @@ func TestReviewAipDeletion(t *testing.T) {
 			t.Parallel()
 
 			ctx := auth.WithUserClaims(context.Background(), tt.claims)
-			attrs := &setUpAttrs{}
+			attrs := &setUpAttrs{config: tt.config}
 			svc := setUpService(t, ctx, attrs)
 
 			if tt.mock != nil {
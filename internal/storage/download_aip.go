@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/google/uuid"
 	"go.artefactual.dev/tools/fsutil"
 	"gocloud.dev/gcerrors"
 
@@ -19,11 +20,20 @@ func (s *serviceImpl) DownloadAipRequest(
 	ctx context.Context,
 	payload *goastorage.DownloadAipRequestPayload,
 ) (*goastorage.DownloadAipRequestResult, error) {
-	aip, err := s.ShowAip(ctx, &goastorage.ShowAipPayload{UUID: payload.UUID})
+	aipID, err := uuid.Parse(payload.UUID)
+	if err != nil {
+		return nil, goastorage.MakeNotValid(errors.New("cannot perform operation"))
+	}
+
+	aip, err := s.ReadAip(ctx, aipID)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := checkAIPScope(ctx, auth.StorageAIPSDownloadAttr, aip); err != nil {
+		return nil, err
+	}
+
 	if aip.Status != enums.AIPStatusStored.String() && aip.Status != enums.AIPStatusPending.String() {
 		return nil, goastorage.MakeNotValid(errors.New("AIP is not available for download"))
 	}
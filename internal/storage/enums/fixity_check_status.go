@@ -0,0 +1,10 @@
+package enums
+
+/*
+ENUM(
+passed
+failed
+baseline
+)
+*/
+type FixityCheckStatus string
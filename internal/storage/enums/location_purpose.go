@@ -4,6 +4,7 @@ package enums
 ENUM(
 unspecified
 aip_store
+restore
 )
 */
 type LocationPurpose string
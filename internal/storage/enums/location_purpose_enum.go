@@ -14,6 +14,7 @@ import (
 const (
 	LocationPurposeUnspecified LocationPurpose = "unspecified"
 	LocationPurposeAipStore    LocationPurpose = "aip_store"
+	LocationPurposeRestore     LocationPurpose = "restore"
 )
 
 var ErrInvalidLocationPurpose = fmt.Errorf("not a valid LocationPurpose, try [%s]", strings.Join(_LocationPurposeNames, ", "))
@@ -21,6 +22,7 @@ var ErrInvalidLocationPurpose = fmt.Errorf("not a valid LocationPurpose, try [%s
 var _LocationPurposeNames = []string{
 	string(LocationPurposeUnspecified),
 	string(LocationPurposeAipStore),
+	string(LocationPurposeRestore),
 }
 
 // LocationPurposeNames returns a list of possible string values of LocationPurpose.
@@ -45,6 +47,7 @@ func (x LocationPurpose) IsValid() bool {
 var _LocationPurposeValue = map[string]LocationPurpose{
 	"unspecified": LocationPurposeUnspecified,
 	"aip_store":   LocationPurposeAipStore,
+	"restore":     LocationPurposeRestore,
 }
 
 // ParseLocationPurpose attempts to convert a string to a LocationPurpose.
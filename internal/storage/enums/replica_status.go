@@ -0,0 +1,10 @@
+package enums
+
+/*
+ENUM(
+pending
+replicated
+failed
+)
+*/
+type ReplicaStatus string
@@ -6,6 +6,9 @@ unspecified
 upload aip
 move aip
 delete aip
+audit aip
+replicate aip
+restore aip
 )
 */
 type WorkflowType string
@@ -12,10 +12,13 @@ import (
 )
 
 const (
-	WorkflowTypeUnspecified WorkflowType = "unspecified"
-	WorkflowTypeUploadAip   WorkflowType = "upload aip"
-	WorkflowTypeMoveAip     WorkflowType = "move aip"
-	WorkflowTypeDeleteAip   WorkflowType = "delete aip"
+	WorkflowTypeUnspecified  WorkflowType = "unspecified"
+	WorkflowTypeUploadAip    WorkflowType = "upload aip"
+	WorkflowTypeMoveAip      WorkflowType = "move aip"
+	WorkflowTypeDeleteAip    WorkflowType = "delete aip"
+	WorkflowTypeAuditAip     WorkflowType = "audit aip"
+	WorkflowTypeReplicateAip WorkflowType = "replicate aip"
+	WorkflowTypeRestoreAip   WorkflowType = "restore aip"
 )
 
 var ErrInvalidWorkflowType = fmt.Errorf("not a valid WorkflowType, try [%s]", strings.Join(_WorkflowTypeNames, ", "))
@@ -25,6 +28,9 @@ var _WorkflowTypeNames = []string{
 	string(WorkflowTypeUploadAip),
 	string(WorkflowTypeMoveAip),
 	string(WorkflowTypeDeleteAip),
+	string(WorkflowTypeAuditAip),
+	string(WorkflowTypeReplicateAip),
+	string(WorkflowTypeRestoreAip),
 }
 
 // WorkflowTypeNames returns a list of possible string values of WorkflowType.
@@ -47,10 +53,13 @@ func (x WorkflowType) IsValid() bool {
 }
 
 var _WorkflowTypeValue = map[string]WorkflowType{
-	"unspecified": WorkflowTypeUnspecified,
-	"upload aip":  WorkflowTypeUploadAip,
-	"move aip":    WorkflowTypeMoveAip,
-	"delete aip":  WorkflowTypeDeleteAip,
+	"unspecified":   WorkflowTypeUnspecified,
+	"upload aip":    WorkflowTypeUploadAip,
+	"move aip":      WorkflowTypeMoveAip,
+	"delete aip":    WorkflowTypeDeleteAip,
+	"audit aip":     WorkflowTypeAuditAip,
+	"replicate aip": WorkflowTypeReplicateAip,
+	"restore aip":   WorkflowTypeRestoreAip,
 }
 
 // ParseWorkflowType attempts to convert a string to a WorkflowType.
@@ -42,7 +42,10 @@ type Event interface {
 		*goastorage.AIPWorkflowCreatedEvent |
 		*goastorage.AIPWorkflowUpdatedEvent |
 		*goastorage.AIPTaskCreatedEvent |
-		*goastorage.AIPTaskUpdatedEvent
+		*goastorage.AIPTaskUpdatedEvent |
+		*goastorage.AIPDeletionRequestCreatedEvent |
+		*goastorage.AIPDeletionRequestUpdatedEvent |
+		*goastorage.AIPFixityCheckedEvent
 }
 
 // PublishEvent publishes a storage event with type safety.
@@ -72,6 +75,12 @@ func NewEventValue[E Event](event E) goastorage.Value {
 		return goastorage.NewValueAipTaskCreatedEvent(e)
 	case *goastorage.AIPTaskUpdatedEvent:
 		return goastorage.NewValueAipTaskUpdatedEvent(e)
+	case *goastorage.AIPDeletionRequestCreatedEvent:
+		return goastorage.NewValueAipDeletionRequestCreatedEvent(e)
+	case *goastorage.AIPDeletionRequestUpdatedEvent:
+		return goastorage.NewValueAipDeletionRequestUpdatedEvent(e)
+	case *goastorage.AIPFixityCheckedEvent:
+		return goastorage.NewValueAipFixityCheckedEvent(e)
 	default:
 		panic(fmt.Sprintf("unsupported storage event type %T", event))
 	}
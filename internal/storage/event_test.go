@@ -27,6 +27,9 @@ func TestPublishEventTypes(t *testing.T) {
 	storage.PublishEvent(ctx, svc, &goastorage.AIPWorkflowUpdatedEvent{})
 	storage.PublishEvent(ctx, svc, &goastorage.AIPTaskCreatedEvent{})
 	storage.PublishEvent(ctx, svc, &goastorage.AIPTaskUpdatedEvent{})
+	storage.PublishEvent(ctx, svc, &goastorage.AIPDeletionRequestCreatedEvent{})
+	storage.PublishEvent(ctx, svc, &goastorage.AIPDeletionRequestUpdatedEvent{})
+	storage.PublishEvent(ctx, svc, &goastorage.AIPFixityCheckedEvent{})
 }
 
 func TestEventSerializer(t *testing.T) {
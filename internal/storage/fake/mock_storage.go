@@ -13,6 +13,7 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	storage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	storage0 "github.com/artefactual-sdps/enduro/internal/storage"
@@ -242,6 +243,82 @@ func (c *MockServiceCancelAipDeletionCall) DoAndReturn(f func(context.Context, *
 	return c
 }
 
+// CheckAIPLegalHold mocks base method.
+func (m *MockService) CheckAIPLegalHold(ctx context.Context, aipID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAIPLegalHold", ctx, aipID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAIPLegalHold indicates an expected call of CheckAIPLegalHold.
+func (mr *MockServiceMockRecorder) CheckAIPLegalHold(ctx, aipID any) *MockServiceCheckAIPLegalHoldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAIPLegalHold", reflect.TypeOf((*MockService)(nil).CheckAIPLegalHold), ctx, aipID)
+	return &MockServiceCheckAIPLegalHoldCall{Call: call}
+}
+
+// MockServiceCheckAIPLegalHoldCall wrap *gomock.Call
+type MockServiceCheckAIPLegalHoldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCheckAIPLegalHoldCall) Return(arg0 error) *MockServiceCheckAIPLegalHoldCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCheckAIPLegalHoldCall) Do(f func(context.Context, uuid.UUID) error) *MockServiceCheckAIPLegalHoldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCheckAIPLegalHoldCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockServiceCheckAIPLegalHoldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateAIPReplica mocks base method.
+func (m *MockService) CreateAIPReplica(arg0 context.Context, arg1 *types.AIPReplica) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAIPReplica", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAIPReplica indicates an expected call of CreateAIPReplica.
+func (mr *MockServiceMockRecorder) CreateAIPReplica(arg0, arg1 any) *MockServiceCreateAIPReplicaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAIPReplica", reflect.TypeOf((*MockService)(nil).CreateAIPReplica), arg0, arg1)
+	return &MockServiceCreateAIPReplicaCall{Call: call}
+}
+
+// MockServiceCreateAIPReplicaCall wrap *gomock.Call
+type MockServiceCreateAIPReplicaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCreateAIPReplicaCall) Return(arg0 error) *MockServiceCreateAIPReplicaCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCreateAIPReplicaCall) Do(f func(context.Context, *types.AIPReplica) error) *MockServiceCreateAIPReplicaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCreateAIPReplicaCall) DoAndReturn(f func(context.Context, *types.AIPReplica) error) *MockServiceCreateAIPReplicaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateAip mocks base method.
 func (m *MockService) CreateAip(arg0 context.Context, arg1 *storage.CreateAipPayload) (*storage.AIP, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +396,82 @@ func (c *MockServiceCreateDeletionRequestCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// CreateDeletionRequestApproval mocks base method.
+func (m *MockService) CreateDeletionRequestApproval(arg0 context.Context, arg1 *types.DeletionRequestApproval) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeletionRequestApproval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeletionRequestApproval indicates an expected call of CreateDeletionRequestApproval.
+func (mr *MockServiceMockRecorder) CreateDeletionRequestApproval(arg0, arg1 any) *MockServiceCreateDeletionRequestApprovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeletionRequestApproval", reflect.TypeOf((*MockService)(nil).CreateDeletionRequestApproval), arg0, arg1)
+	return &MockServiceCreateDeletionRequestApprovalCall{Call: call}
+}
+
+// MockServiceCreateDeletionRequestApprovalCall wrap *gomock.Call
+type MockServiceCreateDeletionRequestApprovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCreateDeletionRequestApprovalCall) Return(arg0 error) *MockServiceCreateDeletionRequestApprovalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCreateDeletionRequestApprovalCall) Do(f func(context.Context, *types.DeletionRequestApproval) error) *MockServiceCreateDeletionRequestApprovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCreateDeletionRequestApprovalCall) DoAndReturn(f func(context.Context, *types.DeletionRequestApproval) error) *MockServiceCreateDeletionRequestApprovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateFixityCheck mocks base method.
+func (m *MockService) CreateFixityCheck(arg0 context.Context, arg1 *types.FixityCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFixityCheck", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFixityCheck indicates an expected call of CreateFixityCheck.
+func (mr *MockServiceMockRecorder) CreateFixityCheck(arg0, arg1 any) *MockServiceCreateFixityCheckCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFixityCheck", reflect.TypeOf((*MockService)(nil).CreateFixityCheck), arg0, arg1)
+	return &MockServiceCreateFixityCheckCall{Call: call}
+}
+
+// MockServiceCreateFixityCheckCall wrap *gomock.Call
+type MockServiceCreateFixityCheckCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCreateFixityCheckCall) Return(arg0 error) *MockServiceCreateFixityCheckCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCreateFixityCheckCall) Do(f func(context.Context, *types.FixityCheck) error) *MockServiceCreateFixityCheckCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCreateFixityCheckCall) DoAndReturn(f func(context.Context, *types.FixityCheck) error) *MockServiceCreateFixityCheckCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateLocation mocks base method.
 func (m *MockService) CreateLocation(arg0 context.Context, arg1 *storage.CreateLocationPayload) (*storage.CreateLocationResult, error) {
 	m.ctrl.T.Helper()
@@ -434,6 +587,45 @@ func (c *MockServiceCreateWorkflowCall) DoAndReturn(f func(context.Context, *typ
 	return c
 }
 
+// DeleteAIPReplicas mocks base method.
+func (m *MockService) DeleteAIPReplicas(ctx context.Context, aipID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAIPReplicas", ctx, aipID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAIPReplicas indicates an expected call of DeleteAIPReplicas.
+func (mr *MockServiceMockRecorder) DeleteAIPReplicas(ctx, aipID any) *MockServiceDeleteAIPReplicasCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAIPReplicas", reflect.TypeOf((*MockService)(nil).DeleteAIPReplicas), ctx, aipID)
+	return &MockServiceDeleteAIPReplicasCall{Call: call}
+}
+
+// MockServiceDeleteAIPReplicasCall wrap *gomock.Call
+type MockServiceDeleteAIPReplicasCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceDeleteAIPReplicasCall) Return(arg0 []uuid.UUID, arg1 error) *MockServiceDeleteAIPReplicasCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceDeleteAIPReplicasCall) Do(f func(context.Context, uuid.UUID) ([]uuid.UUID, error)) *MockServiceDeleteAIPReplicasCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceDeleteAIPReplicasCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]uuid.UUID, error)) *MockServiceDeleteAIPReplicasCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteAip mocks base method.
 func (m *MockService) DeleteAip(ctx context.Context, aipID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -629,6 +821,45 @@ func (c *MockServiceListAipsCall) DoAndReturn(f func(context.Context, *storage.L
 	return c
 }
 
+// ListDeletionRequestApprovals mocks base method.
+func (m *MockService) ListDeletionRequestApprovals(ctx context.Context, deletionRequestDBID int) ([]*types.DeletionRequestApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletionRequestApprovals", ctx, deletionRequestDBID)
+	ret0, _ := ret[0].([]*types.DeletionRequestApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletionRequestApprovals indicates an expected call of ListDeletionRequestApprovals.
+func (mr *MockServiceMockRecorder) ListDeletionRequestApprovals(ctx, deletionRequestDBID any) *MockServiceListDeletionRequestApprovalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletionRequestApprovals", reflect.TypeOf((*MockService)(nil).ListDeletionRequestApprovals), ctx, deletionRequestDBID)
+	return &MockServiceListDeletionRequestApprovalsCall{Call: call}
+}
+
+// MockServiceListDeletionRequestApprovalsCall wrap *gomock.Call
+type MockServiceListDeletionRequestApprovalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListDeletionRequestApprovalsCall) Return(arg0 []*types.DeletionRequestApproval, arg1 error) *MockServiceListDeletionRequestApprovalsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListDeletionRequestApprovalsCall) Do(f func(context.Context, int) ([]*types.DeletionRequestApproval, error)) *MockServiceListDeletionRequestApprovalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListDeletionRequestApprovalsCall) DoAndReturn(f func(context.Context, int) ([]*types.DeletionRequestApproval, error)) *MockServiceListDeletionRequestApprovalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListDeletionRequests mocks base method.
 func (m *MockService) ListDeletionRequests(arg0 context.Context, arg1 *persistence.DeletionRequestFilter) ([]*types.DeletionRequest, error) {
 	m.ctrl.T.Helper()
@@ -668,6 +899,45 @@ func (c *MockServiceListDeletionRequestsCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// ListDisposableAIPs mocks base method.
+func (m *MockService) ListDisposableAIPs(arg0 context.Context, arg1 time.Time, arg2 int) ([]*types.AIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisposableAIPs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*types.AIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisposableAIPs indicates an expected call of ListDisposableAIPs.
+func (mr *MockServiceMockRecorder) ListDisposableAIPs(arg0, arg1, arg2 any) *MockServiceListDisposableAIPsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisposableAIPs", reflect.TypeOf((*MockService)(nil).ListDisposableAIPs), arg0, arg1, arg2)
+	return &MockServiceListDisposableAIPsCall{Call: call}
+}
+
+// MockServiceListDisposableAIPsCall wrap *gomock.Call
+type MockServiceListDisposableAIPsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListDisposableAIPsCall) Return(arg0 []*types.AIP, arg1 error) *MockServiceListDisposableAIPsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListDisposableAIPsCall) Do(f func(context.Context, time.Time, int) ([]*types.AIP, error)) *MockServiceListDisposableAIPsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListDisposableAIPsCall) DoAndReturn(f func(context.Context, time.Time, int) ([]*types.AIP, error)) *MockServiceListDisposableAIPsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListLocationAips mocks base method.
 func (m *MockService) ListLocationAips(arg0 context.Context, arg1 *storage.ListLocationAipsPayload) (storage.AIPCollection, error) {
 	m.ctrl.T.Helper()
@@ -785,6 +1055,45 @@ func (c *MockServiceLocationCall) DoAndReturn(f func(context.Context, uuid.UUID)
 	return c
 }
 
+// LocationUsage mocks base method.
+func (m *MockService) LocationUsage(arg0 context.Context, arg1 *storage.LocationUsagePayload) (*storage.LocationUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocationUsage", arg0, arg1)
+	ret0, _ := ret[0].(*storage.LocationUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocationUsage indicates an expected call of LocationUsage.
+func (mr *MockServiceMockRecorder) LocationUsage(arg0, arg1 any) *MockServiceLocationUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocationUsage", reflect.TypeOf((*MockService)(nil).LocationUsage), arg0, arg1)
+	return &MockServiceLocationUsageCall{Call: call}
+}
+
+// MockServiceLocationUsageCall wrap *gomock.Call
+type MockServiceLocationUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceLocationUsageCall) Return(arg0 *storage.LocationUsage, arg1 error) *MockServiceLocationUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceLocationUsageCall) Do(f func(context.Context, *storage.LocationUsagePayload) (*storage.LocationUsage, error)) *MockServiceLocationUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceLocationUsageCall) DoAndReturn(f func(context.Context, *storage.LocationUsagePayload) (*storage.LocationUsage, error)) *MockServiceLocationUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Monitor mocks base method.
 func (m *MockService) Monitor(arg0 context.Context, arg1 *storage.MonitorPayload, arg2 storage.MonitorServerStream) error {
 	m.ctrl.T.Helper()
@@ -900,6 +1209,44 @@ func (c *MockServiceMoveAipStatusCall) DoAndReturn(f func(context.Context, *stor
 	return c
 }
 
+// PlaceAipLegalHold mocks base method.
+func (m *MockService) PlaceAipLegalHold(arg0 context.Context, arg1 *storage.PlaceAipLegalHoldPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceAipLegalHold", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaceAipLegalHold indicates an expected call of PlaceAipLegalHold.
+func (mr *MockServiceMockRecorder) PlaceAipLegalHold(arg0, arg1 any) *MockServicePlaceAipLegalHoldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceAipLegalHold", reflect.TypeOf((*MockService)(nil).PlaceAipLegalHold), arg0, arg1)
+	return &MockServicePlaceAipLegalHoldCall{Call: call}
+}
+
+// MockServicePlaceAipLegalHoldCall wrap *gomock.Call
+type MockServicePlaceAipLegalHoldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicePlaceAipLegalHoldCall) Return(arg0 error) *MockServicePlaceAipLegalHoldCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicePlaceAipLegalHoldCall) Do(f func(context.Context, *storage.PlaceAipLegalHoldPayload) error) *MockServicePlaceAipLegalHoldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicePlaceAipLegalHoldCall) DoAndReturn(f func(context.Context, *storage.PlaceAipLegalHoldPayload) error) *MockServicePlaceAipLegalHoldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReadAip mocks base method.
 func (m *MockService) ReadAip(ctx context.Context, aipID uuid.UUID) (*storage.AIP, error) {
 	m.ctrl.T.Helper()
@@ -1094,6 +1441,44 @@ func (c *MockServiceRejectAipCall) DoAndReturn(f func(context.Context, *storage.
 	return c
 }
 
+// ReleaseAipLegalHold mocks base method.
+func (m *MockService) ReleaseAipLegalHold(arg0 context.Context, arg1 *storage.ReleaseAipLegalHoldPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAipLegalHold", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseAipLegalHold indicates an expected call of ReleaseAipLegalHold.
+func (mr *MockServiceMockRecorder) ReleaseAipLegalHold(arg0, arg1 any) *MockServiceReleaseAipLegalHoldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAipLegalHold", reflect.TypeOf((*MockService)(nil).ReleaseAipLegalHold), arg0, arg1)
+	return &MockServiceReleaseAipLegalHoldCall{Call: call}
+}
+
+// MockServiceReleaseAipLegalHoldCall wrap *gomock.Call
+type MockServiceReleaseAipLegalHoldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceReleaseAipLegalHoldCall) Return(arg0 error) *MockServiceReleaseAipLegalHoldCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceReleaseAipLegalHoldCall) Do(f func(context.Context, *storage.ReleaseAipLegalHoldPayload) error) *MockServiceReleaseAipLegalHoldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceReleaseAipLegalHoldCall) DoAndReturn(f func(context.Context, *storage.ReleaseAipLegalHoldPayload) error) *MockServiceReleaseAipLegalHoldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RequestAipDeletion mocks base method.
 func (m *MockService) RequestAipDeletion(arg0 context.Context, arg1 *storage.RequestAipDeletionPayload) error {
 	m.ctrl.T.Helper()
@@ -1132,6 +1517,44 @@ func (c *MockServiceRequestAipDeletionCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// RestoreAips mocks base method.
+func (m *MockService) RestoreAips(arg0 context.Context, arg1 *storage.RestoreAipsPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAips", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAips indicates an expected call of RestoreAips.
+func (mr *MockServiceMockRecorder) RestoreAips(arg0, arg1 any) *MockServiceRestoreAipsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAips", reflect.TypeOf((*MockService)(nil).RestoreAips), arg0, arg1)
+	return &MockServiceRestoreAipsCall{Call: call}
+}
+
+// MockServiceRestoreAipsCall wrap *gomock.Call
+type MockServiceRestoreAipsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRestoreAipsCall) Return(arg0 error) *MockServiceRestoreAipsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRestoreAipsCall) Do(f func(context.Context, *storage.RestoreAipsPayload) error) *MockServiceRestoreAipsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRestoreAipsCall) DoAndReturn(f func(context.Context, *storage.RestoreAipsPayload) error) *MockServiceRestoreAipsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReviewAipDeletion mocks base method.
 func (m *MockService) ReviewAipDeletion(arg0 context.Context, arg1 *storage.ReviewAipDeletionPayload) error {
 	m.ctrl.T.Helper()
@@ -1170,6 +1593,45 @@ func (c *MockServiceReviewAipDeletionCall) DoAndReturn(f func(context.Context, *
 	return c
 }
 
+// Search mocks base method.
+func (m *MockService) Search(arg0 context.Context, arg1 *storage.SearchPayload) (*storage.SearchResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(*storage.SearchResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockServiceMockRecorder) Search(arg0, arg1 any) *MockServiceSearchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockService)(nil).Search), arg0, arg1)
+	return &MockServiceSearchCall{Call: call}
+}
+
+// MockServiceSearchCall wrap *gomock.Call
+type MockServiceSearchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSearchCall) Return(arg0 *storage.SearchResults, arg1 error) *MockServiceSearchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSearchCall) Do(f func(context.Context, *storage.SearchPayload) (*storage.SearchResults, error)) *MockServiceSearchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSearchCall) DoAndReturn(f func(context.Context, *storage.SearchPayload) (*storage.SearchResults, error)) *MockServiceSearchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ShowAip mocks base method.
 func (m *MockService) ShowAip(arg0 context.Context, arg1 *storage.ShowAipPayload) (*storage.AIP, error) {
 	m.ctrl.T.Helper()
@@ -1287,6 +1749,44 @@ func (c *MockServiceUpdateAIPCall) DoAndReturn(f func(context.Context, uuid.UUID
 	return c
 }
 
+// UpdateAIPReplicaStatus mocks base method.
+func (m *MockService) UpdateAIPReplicaStatus(ctx context.Context, aipID uuid.UUID, locationID uuid.UUID, status enums.ReplicaStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAIPReplicaStatus", ctx, aipID, locationID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAIPReplicaStatus indicates an expected call of UpdateAIPReplicaStatus.
+func (mr *MockServiceMockRecorder) UpdateAIPReplicaStatus(ctx, aipID, locationID, status any) *MockServiceUpdateAIPReplicaStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAIPReplicaStatus", reflect.TypeOf((*MockService)(nil).UpdateAIPReplicaStatus), ctx, aipID, locationID, status)
+	return &MockServiceUpdateAIPReplicaStatusCall{Call: call}
+}
+
+// MockServiceUpdateAIPReplicaStatusCall wrap *gomock.Call
+type MockServiceUpdateAIPReplicaStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceUpdateAIPReplicaStatusCall) Return(arg0 error) *MockServiceUpdateAIPReplicaStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceUpdateAIPReplicaStatusCall) Do(f func(context.Context, uuid.UUID, uuid.UUID, enums.ReplicaStatus) error) *MockServiceUpdateAIPReplicaStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceUpdateAIPReplicaStatusCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID, enums.ReplicaStatus) error) *MockServiceUpdateAIPReplicaStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateAipLocationID mocks base method.
 func (m *MockService) UpdateAipLocationID(ctx context.Context, aipID, locationID uuid.UUID) error {
 	m.ctrl.T.Helper()
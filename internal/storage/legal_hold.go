@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+func (s *serviceImpl) PlaceAipLegalHold(ctx context.Context, payload *goastorage.PlaceAipLegalHoldPayload) error {
+	if payload == nil {
+		payload = &goastorage.PlaceAipLegalHoldPayload{}
+	}
+
+	// Authentication must be enabled to record who placed the hold.
+	claims := auth.UserClaimsFromContext(ctx)
+	if err := checkClaims(claims); err != nil {
+		return err
+	}
+
+	aipID, err := uuid.Parse(payload.UUID)
+	if err != nil {
+		return goastorage.MakeNotValid(errors.New("invalid UUID"))
+	}
+	if payload.Reason == "" {
+		return goastorage.MakeNotValid(errors.New("invalid reason"))
+	}
+
+	if _, err := s.ReadAip(ctx, aipID); err != nil {
+		return err
+	}
+
+	// Persistence rejects a second active hold, which also covers concurrent
+	// requests.
+	err = s.storagePersistence.CreateAIPLegalHold(ctx, &types.AIPLegalHold{
+		UUID:     uuid.Must(uuid.NewRandomFromReader(s.rander)),
+		AIPUUID:  aipID,
+		Reason:   payload.Reason,
+		PlacedBy: claims.DisplayName(),
+	})
+	if errors.Is(err, persistence.ErrActiveAIPLegalHold) {
+		return goastorage.MakeNotValid(persistence.ErrActiveAIPLegalHold)
+	}
+	if err != nil {
+		s.logger.Error(err, "error creating AIP legal hold", "aip_id", aipID)
+		return ErrInternalError
+	}
+
+	s.publishAIPUpdated(ctx, aipID)
+
+	return nil
+}
+
+func (s *serviceImpl) ReleaseAipLegalHold(ctx context.Context, payload *goastorage.ReleaseAipLegalHoldPayload) error {
+	if payload == nil {
+		payload = &goastorage.ReleaseAipLegalHoldPayload{}
+	}
+
+	// Authentication must be enabled to record who released the hold.
+	claims := auth.UserClaimsFromContext(ctx)
+	if err := checkClaims(claims); err != nil {
+		return err
+	}
+
+	aipID, err := uuid.Parse(payload.UUID)
+	if err != nil {
+		return goastorage.MakeNotValid(errors.New("invalid UUID"))
+	}
+	if payload.Reason == "" {
+		return goastorage.MakeNotValid(errors.New("invalid reason"))
+	}
+
+	if _, err := s.ReadAip(ctx, aipID); err != nil {
+		return err
+	}
+
+	err = s.storagePersistence.ReleaseAIPLegalHold(ctx, aipID, claims.DisplayName(), payload.Reason)
+	if errors.Is(err, persistence.ErrNoActiveAIPLegalHold) {
+		return goastorage.MakeNotValid(persistence.ErrNoActiveAIPLegalHold)
+	}
+	if err != nil {
+		s.logger.Error(err, "error releasing AIP legal hold", "aip_id", aipID)
+		return ErrInternalError
+	}
+
+	s.publishAIPUpdated(ctx, aipID)
+
+	return nil
+}
+
+// CheckAIPLegalHold returns ErrLegalHold if the AIP is under an active legal
+// hold, which prevents its deletion and moves.
+func (s *serviceImpl) CheckAIPLegalHold(ctx context.Context, aipID uuid.UUID) error {
+	held, err := s.storagePersistence.HasActiveAIPLegalHold(ctx, aipID)
+	if err != nil {
+		s.logger.Error(err, "error checking AIP legal hold", "aip_id", aipID)
+		return ErrInternalError
+	}
+	if held {
+		return ErrLegalHold
+	}
+
+	return nil
+}
+
+// publishAIPUpdated publishes an AIPUpdatedEvent with the current state of the
+// AIP, e.g. after its legal holds have changed.
+func (s *serviceImpl) publishAIPUpdated(ctx context.Context, aipID uuid.UUID) {
+	aip, err := s.ReadAip(ctx, aipID)
+	if err != nil {
+		s.logger.Error(err, "error reading AIP", "aip_id", aipID)
+		return
+	}
+
+	PublishEvent(ctx, s.evsvc, &goastorage.AIPUpdatedEvent{
+		UUID: aipID,
+		Item: aip,
+	})
+}
@@ -0,0 +1,213 @@
+package storage_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/fake"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+func TestPlaceAipLegalHold(t *testing.T) {
+	t.Parallel()
+
+	claims := &auth.Claims{
+		Email: "officer@example.com",
+		Iss:   "issuer",
+		Sub:   "subject",
+	}
+
+	for _, tt := range []struct {
+		name    string
+		claims  *auth.Claims
+		payload *goastorage.PlaceAipLegalHoldPayload
+		mock    func(context.Context, *fake.MockStorage)
+		wantErr string
+	}{
+		{
+			name:   "Places a legal hold on an AIP",
+			claims: claims,
+			payload: &goastorage.PlaceAipLegalHoldPayload{
+				UUID:   aipID.String(),
+				Reason: "Litigation",
+			},
+			mock: func(ctx context.Context, s *fake.MockStorage) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{UUID: aipID}, nil).Times(2)
+				s.EXPECT().CreateAIPLegalHold(ctx, &types.AIPLegalHold{
+					UUID:     uuid0,
+					AIPUUID:  aipID,
+					Reason:   "Litigation",
+					PlacedBy: "officer@example.com",
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "Fails to place a legal hold (missing claims)",
+			payload: &goastorage.PlaceAipLegalHoldPayload{UUID: aipID.String(), Reason: "Litigation"},
+			wantErr: "authentication is required",
+		},
+		{
+			name:    "Fails to place a legal hold (invalid UUID)",
+			claims:  claims,
+			payload: &goastorage.PlaceAipLegalHoldPayload{UUID: "invalid", Reason: "Litigation"},
+			wantErr: "invalid UUID",
+		},
+		{
+			name:    "Fails to place a legal hold (missing reason)",
+			claims:  claims,
+			payload: &goastorage.PlaceAipLegalHoldPayload{UUID: aipID.String()},
+			wantErr: "invalid reason",
+		},
+		{
+			name:    "Fails to place a legal hold (AIP not found)",
+			claims:  claims,
+			payload: &goastorage.PlaceAipLegalHoldPayload{UUID: aipID.String(), Reason: "Litigation"},
+			mock: func(ctx context.Context, s *fake.MockStorage) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(nil, &goastorage.AIPNotFound{})
+			},
+			wantErr: "AIP not found.",
+		},
+		{
+			name:    "Fails to place a legal hold (already under legal hold)",
+			claims:  claims,
+			payload: &goastorage.PlaceAipLegalHoldPayload{UUID: aipID.String(), Reason: "Litigation"},
+			mock: func(ctx context.Context, s *fake.MockStorage) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{UUID: aipID}, nil)
+				s.EXPECT().CreateAIPLegalHold(ctx, &types.AIPLegalHold{
+					UUID:     uuid0,
+					AIPUUID:  aipID,
+					Reason:   "Litigation",
+					PlacedBy: "officer@example.com",
+				}).Return(fmt.Errorf("create AIP legal hold: %w", persistence.ErrActiveAIPLegalHold))
+			},
+			wantErr: "AIP is already under legal hold",
+		},
+		{
+			name:    "Fails to place a legal hold (persistence error)",
+			claims:  claims,
+			payload: &goastorage.PlaceAipLegalHoldPayload{UUID: aipID.String(), Reason: "Litigation"},
+			mock: func(ctx context.Context, s *fake.MockStorage) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{UUID: aipID}, nil)
+				s.EXPECT().CreateAIPLegalHold(ctx, &types.AIPLegalHold{
+					UUID:     uuid0,
+					AIPUUID:  aipID,
+					Reason:   "Litigation",
+					PlacedBy: "officer@example.com",
+				}).Return(errors.New("database error"))
+			},
+			wantErr: "internal error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := auth.WithUserClaims(context.Background(), tt.claims)
+			attrs := &setUpAttrs{}
+			svc := setUpService(t, ctx, attrs)
+
+			if tt.mock != nil {
+				tt.mock(ctx, attrs.persistenceMock)
+			}
+
+			err := svc.PlaceAipLegalHold(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestReleaseAipLegalHold(t *testing.T) {
+	t.Parallel()
+
+	claims := &auth.Claims{
+		Email: "officer@example.com",
+		Iss:   "issuer",
+		Sub:   "subject",
+	}
+
+	for _, tt := range []struct {
+		name    string
+		claims  *auth.Claims
+		payload *goastorage.ReleaseAipLegalHoldPayload
+		mock    func(context.Context, *fake.MockStorage)
+		wantErr string
+	}{
+		{
+			name:   "Releases the legal hold of an AIP",
+			claims: claims,
+			payload: &goastorage.ReleaseAipLegalHoldPayload{
+				UUID:   aipID.String(),
+				Reason: "Case closed",
+			},
+			mock: func(ctx context.Context, s *fake.MockStorage) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{UUID: aipID}, nil).Times(2)
+				s.EXPECT().ReleaseAIPLegalHold(ctx, aipID, "officer@example.com", "Case closed").Return(nil)
+			},
+		},
+		{
+			name:    "Fails to release a legal hold (missing sub claim)",
+			claims:  &auth.Claims{Email: "officer@example.com"},
+			payload: &goastorage.ReleaseAipLegalHoldPayload{UUID: aipID.String(), Reason: "Case closed"},
+			wantErr: "sub claim is required",
+		},
+		{
+			name:    "Fails to release a legal hold (missing reason)",
+			claims:  claims,
+			payload: &goastorage.ReleaseAipLegalHoldPayload{UUID: aipID.String()},
+			wantErr: "invalid reason",
+		},
+		{
+			name:    "Fails to release a legal hold (not under legal hold)",
+			claims:  claims,
+			payload: &goastorage.ReleaseAipLegalHoldPayload{UUID: aipID.String(), Reason: "Case closed"},
+			mock: func(ctx context.Context, s *fake.MockStorage) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{UUID: aipID}, nil)
+				s.EXPECT().
+					ReleaseAIPLegalHold(ctx, aipID, "officer@example.com", "Case closed").
+					Return(fmt.Errorf("release AIP legal hold: %w", persistence.ErrNoActiveAIPLegalHold))
+			},
+			wantErr: "AIP is not under legal hold",
+		},
+		{
+			name:    "Fails to release a legal hold (persistence error)",
+			claims:  claims,
+			payload: &goastorage.ReleaseAipLegalHoldPayload{UUID: aipID.String(), Reason: "Case closed"},
+			mock: func(ctx context.Context, s *fake.MockStorage) {
+				s.EXPECT().ReadAIP(ctx, aipID).Return(&goastorage.AIP{UUID: aipID}, nil)
+				s.EXPECT().
+					ReleaseAIPLegalHold(ctx, aipID, "officer@example.com", "Case closed").
+					Return(errors.New("database error"))
+			},
+			wantErr: "internal error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := auth.WithUserClaims(context.Background(), tt.claims)
+			attrs := &setUpAttrs{}
+			svc := setUpService(t, ctx, attrs)
+
+			if tt.mock != nil {
+				tt.mock(ctx, attrs.persistenceMock)
+			}
+
+			err := svc.ReleaseAipLegalHold(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
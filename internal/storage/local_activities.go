@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +20,151 @@ func ReadAIPLocalActivity(
 	return storagesvc.ReadAip(ctx, aipID)
 }
 
+type ListStoredAIPsLocalActivityParams struct {
+	Limit  int
+	Offset int
+}
+
+type ListStoredAIPsLocalActivityResult struct {
+	AIPIDs []uuid.UUID
+	Total  int
+}
+
+func ListStoredAIPsLocalActivity(
+	ctx context.Context,
+	storagesvc Service,
+	params *ListStoredAIPsLocalActivityParams,
+) (*ListStoredAIPsLocalActivityResult, error) {
+	aips, err := storagesvc.ListAips(ctx, &goastorage.ListAipsPayload{
+		Status: new(enums.AIPStatusStored.String()),
+		Limit:  new(params.Limit),
+		Offset: new(params.Offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListStoredAIPsLocalActivityResult{
+		AIPIDs: make([]uuid.UUID, len(aips.Items)),
+	}
+	for i, aip := range aips.Items {
+		res.AIPIDs[i] = aip.UUID
+	}
+	if aips.Page != nil {
+		res.Total = aips.Page.Total
+	}
+
+	return res, nil
+}
+
+type ListDisposableAIPsLocalActivityParams struct {
+	DueBy time.Time
+	Limit int
+}
+
+type ListDisposableAIPsLocalActivityResult struct {
+	AIPs []*DisposableAIP
+}
+
+// DisposableAIP is an AIP past its disposal date.
+type DisposableAIP struct {
+	AIPID      uuid.UUID
+	LocationID *uuid.UUID
+	DisposalAt time.Time
+}
+
+func ListDisposableAIPsLocalActivity(
+	ctx context.Context,
+	storagesvc Service,
+	params *ListDisposableAIPsLocalActivityParams,
+) (*ListDisposableAIPsLocalActivityResult, error) {
+	aips, err := storagesvc.ListDisposableAIPs(ctx, params.DueBy, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListDisposableAIPsLocalActivityResult{
+		AIPs: make([]*DisposableAIP, len(aips)),
+	}
+	for i, aip := range aips {
+		res.AIPs[i] = &DisposableAIP{
+			AIPID:      aip.UUID,
+			LocationID: aip.LocationUUID,
+		}
+		if aip.DisposalAt != nil {
+			res.AIPs[i].DisposalAt = *aip.DisposalAt
+		}
+	}
+
+	return res, nil
+}
+
+type CreateAIPReplicasLocalActivityParams struct {
+	AIPID uuid.UUID
+}
+
+type CreateAIPReplicasLocalActivityResult struct {
+	// LocationIDs are the replication targets of the AIP location.
+	LocationIDs []uuid.UUID
+}
+
+// CreateAIPReplicasLocalActivity creates a pending replica of the AIP for each
+// replication target of its location.
+func CreateAIPReplicasLocalActivity(
+	ctx context.Context,
+	storagesvc Service,
+	params *CreateAIPReplicasLocalActivityParams,
+) (*CreateAIPReplicasLocalActivityResult, error) {
+	aip, err := storagesvc.ReadAip(ctx, params.AIPID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &CreateAIPReplicasLocalActivityResult{}
+	if aip.LocationUUID == nil {
+		return res, nil
+	}
+
+	loc, err := storagesvc.ReadLocation(ctx, *aip.LocationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range loc.ReplicationTargets {
+		locationID, err := uuid.Parse(target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid replication target: %q", target)
+		}
+
+		err = storagesvc.CreateAIPReplica(ctx, &types.AIPReplica{
+			AIPUUID:      params.AIPID,
+			LocationUUID: locationID,
+			Status:       enums.ReplicaStatusPending,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		res.LocationIDs = append(res.LocationIDs, locationID)
+	}
+
+	return res, nil
+}
+
+type UpdateAIPReplicaStatusLocalActivityParams struct {
+	AIPID      uuid.UUID
+	LocationID uuid.UUID
+	Status     enums.ReplicaStatus
+}
+
+func UpdateAIPReplicaStatusLocalActivity(
+	ctx context.Context,
+	storagesvc Service,
+	params *UpdateAIPReplicaStatusLocalActivityParams,
+) error {
+	return storagesvc.UpdateAIPReplicaStatus(ctx, params.AIPID, params.LocationID, params.Status)
+}
+
 type UpdateAIPLocationLocalActivityParams struct {
 	AIPID      uuid.UUID
 	LocationID uuid.UUID
@@ -57,6 +203,29 @@ func DeleteAIPLocalActivity(
 	return storagesvc.DeleteAip(ctx, params.AIPID)
 }
 
+type DeleteAIPReplicasLocalActivityParams struct {
+	AIPID uuid.UUID
+}
+
+type DeleteAIPReplicasLocalActivityResult struct {
+	// LocationIDs are the replication locations the replicas were deleted
+	// from.
+	LocationIDs []uuid.UUID
+}
+
+func DeleteAIPReplicasLocalActivity(
+	ctx context.Context,
+	storagesvc Service,
+	params *DeleteAIPReplicasLocalActivityParams,
+) (*DeleteAIPReplicasLocalActivityResult, error) {
+	locationIDs, err := storagesvc.DeleteAIPReplicas(ctx, params.AIPID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteAIPReplicasLocalActivityResult{LocationIDs: locationIDs}, nil
+}
+
 type ReadLocationInfoLocalActivityResult struct {
 	Source enums.LocationSource
 	Config types.LocationConfig
@@ -198,36 +367,72 @@ func CompleteTaskLocalActivity(
 }
 
 type CreateDeletionRequestLocalActivityParams struct {
-	Requester    string
-	RequesterIss string
-	RequesterSub string
-	Reason       string
-	AIPUUID      uuid.UUID
-	WorkflowDBID int
+	Requester         string
+	RequesterIss      string
+	RequesterSub      string
+	Reason            string
+	RequiredApprovals int
+	AIPUUID           uuid.UUID
+	WorkflowDBID      int
+}
+
+type CreateDeletionRequestLocalActivityResult struct {
+	// DBID is the database ID of the deletion request.
+	DBID int
+	// RequiredApprovals is the number of approvals persisted on the deletion
+	// request.
+	RequiredApprovals int
 }
 
+// CreateDeletionRequestLocalActivity persists a pending deletion request and
+// reads it back, so the workflow relies on the stored approval requirements
+// instead of re-reading the current configuration.
 func CreateDeletionRequestLocalActivity(
 	ctx context.Context,
 	storagesvc Service,
 	params *CreateDeletionRequestLocalActivityParams,
-) (int, error) {
+) (*CreateDeletionRequestLocalActivityResult, error) {
 	dr := &types.DeletionRequest{
-		UUID:         uuid.New(),
-		Requester:    params.Requester,
-		RequesterIss: params.RequesterIss,
-		RequesterSub: params.RequesterSub,
-		RequestedAt:  time.Now(),
-		Reason:       params.Reason,
-		Status:       enums.DeletionRequestStatusPending,
-		AIPUUID:      params.AIPUUID,
-		WorkflowDBID: params.WorkflowDBID,
+		UUID:              uuid.New(),
+		Requester:         params.Requester,
+		RequesterIss:      params.RequesterIss,
+		RequesterSub:      params.RequesterSub,
+		RequestedAt:       time.Now(),
+		Reason:            params.Reason,
+		Status:            enums.DeletionRequestStatusPending,
+		RequiredApprovals: params.RequiredApprovals,
+		AIPUUID:           params.AIPUUID,
+		WorkflowDBID:      params.WorkflowDBID,
 	}
 	err := storagesvc.CreateDeletionRequest(ctx, dr)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return dr.DBID, nil
+	persisted, err := storagesvc.ReadDeletionRequest(ctx, dr.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateDeletionRequestLocalActivityResult{
+		DBID:              persisted.DBID,
+		RequiredApprovals: persisted.RequiredApprovals,
+	}, nil
+}
+
+func CreateDeletionRequestApprovalLocalActivity(
+	ctx context.Context,
+	storagesvc Service,
+	dbID int,
+	approval DeletionDecisionSignal,
+) error {
+	return storagesvc.CreateDeletionRequestApproval(ctx, &types.DeletionRequestApproval{
+		DeletionRequestDBID: dbID,
+		Approver:            approval.UserEmail,
+		ApproverIss:         approval.UserIss,
+		ApproverSub:         approval.UserSub,
+		ApprovedAt:          time.Now(),
+	})
 }
 
 func UpdateDeletionRequestLocalActivity(
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	"go.artefactual.dev/tools/mockutil"
 	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
@@ -31,6 +32,125 @@ func TestReadAIPLocalActivity(t *testing.T) {
 	assert.DeepEqual(t, re, aip)
 }
 
+func TestListStoredAIPsLocalActivity(t *testing.T) {
+	t.Parallel()
+
+	svc := fake.NewMockService(gomock.NewController(t))
+	ctx := context.Background()
+	aipID2 := uuid.MustParse("52a5dbbe-1d4f-4b5e-9d49-7c0e2a8e0b0e")
+	svc.EXPECT().
+		ListAips(ctx, &goastorage.ListAipsPayload{
+			Status: new("stored"),
+			Limit:  new(2),
+			Offset: new(4),
+		}).
+		Return(&goastorage.AIPs{
+			Items: []*goastorage.AIP{
+				{UUID: aipID},
+				{UUID: aipID2},
+			},
+			Page: &goastorage.EnduroPage{Limit: 2, Offset: 4, Total: 10},
+		}, nil)
+
+	re, err := storage.ListStoredAIPsLocalActivity(ctx, svc, &storage.ListStoredAIPsLocalActivityParams{
+		Limit:  2,
+		Offset: 4,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, re, &storage.ListStoredAIPsLocalActivityResult{
+		AIPIDs: []uuid.UUID{aipID, aipID2},
+		Total:  10,
+	})
+}
+
+func TestListDisposableAIPsLocalActivity(t *testing.T) {
+	t.Parallel()
+
+	svc := fake.NewMockService(gomock.NewController(t))
+	ctx := context.Background()
+	dueBy := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	disposalAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	aipID2 := uuid.MustParse("52a5dbbe-1d4f-4b5e-9d49-7c0e2a8e0b0e")
+	svc.EXPECT().
+		ListDisposableAIPs(ctx, dueBy, 2).
+		Return([]*types.AIP{
+			{UUID: aipID, LocationUUID: &locationID, DisposalAt: &disposalAt},
+			{UUID: aipID2, DisposalAt: &disposalAt},
+		}, nil)
+
+	re, err := storage.ListDisposableAIPsLocalActivity(ctx, svc, &storage.ListDisposableAIPsLocalActivityParams{
+		DueBy: dueBy,
+		Limit: 2,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, re, &storage.ListDisposableAIPsLocalActivityResult{
+		AIPs: []*storage.DisposableAIP{
+			{AIPID: aipID, LocationID: &locationID, DisposalAt: disposalAt},
+			{AIPID: aipID2, DisposalAt: disposalAt},
+		},
+	})
+}
+
+func TestCreateAIPReplicasLocalActivity(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.MustParse("7ad7b1cf-8d4c-4d2b-9c3a-21c1d8ef2a63")
+
+	t.Run("Creates a pending replica for each replication target", func(t *testing.T) {
+		t.Parallel()
+
+		svc := fake.NewMockService(gomock.NewController(t))
+		ctx := context.Background()
+		svc.EXPECT().ReadAip(ctx, aipID).Return(&goastorage.AIP{UUID: aipID, LocationUUID: &locationID}, nil)
+		svc.EXPECT().ReadLocation(ctx, locationID).Return(&goastorage.Location{
+			UUID:               locationID,
+			ReplicationTargets: []string{targetID.String()},
+		}, nil)
+		svc.EXPECT().CreateAIPReplica(ctx, &types.AIPReplica{
+			AIPUUID:      aipID,
+			LocationUUID: targetID,
+			Status:       enums.ReplicaStatusPending,
+		}).Return(nil)
+
+		re, err := storage.CreateAIPReplicasLocalActivity(ctx, svc, &storage.CreateAIPReplicasLocalActivityParams{
+			AIPID: aipID,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, re, &storage.CreateAIPReplicasLocalActivityResult{
+			LocationIDs: []uuid.UUID{targetID},
+		})
+	})
+
+	t.Run("Returns no targets if the AIP has no location", func(t *testing.T) {
+		t.Parallel()
+
+		svc := fake.NewMockService(gomock.NewController(t))
+		ctx := context.Background()
+		svc.EXPECT().ReadAip(ctx, aipID).Return(&goastorage.AIP{UUID: aipID}, nil)
+
+		re, err := storage.CreateAIPReplicasLocalActivity(ctx, svc, &storage.CreateAIPReplicasLocalActivityParams{
+			AIPID: aipID,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, re, &storage.CreateAIPReplicasLocalActivityResult{})
+	})
+}
+
+func TestUpdateAIPReplicaStatusLocalActivity(t *testing.T) {
+	t.Parallel()
+
+	svc := fake.NewMockService(gomock.NewController(t))
+	ctx := context.Background()
+	svc.EXPECT().UpdateAIPReplicaStatus(ctx, aipID, locationID, enums.ReplicaStatusReplicated).Return(nil)
+
+	err := storage.UpdateAIPReplicaStatusLocalActivity(ctx, svc, &storage.UpdateAIPReplicaStatusLocalActivityParams{
+		AIPID:      aipID,
+		LocationID: locationID,
+		Status:     enums.ReplicaStatusReplicated,
+	})
+	assert.NilError(t, err)
+}
+
 func TestUpdateAIPLocationLocalActivity(t *testing.T) {
 	t.Parallel()
 
@@ -257,14 +377,15 @@ func TestCreateDeletionRequestLocalActivity(t *testing.T) {
 	ctx := context.Background()
 	dbID := 1
 	dr := &types.DeletionRequest{
-		Requester:    "requester@example.com",
-		RequesterIss: "issuer",
-		RequesterSub: "subject",
-		RequestedAt:  time.Now(),
-		Reason:       "Reason",
-		Status:       enums.DeletionRequestStatusPending,
-		AIPUUID:      aipID,
-		WorkflowDBID: 1,
+		Requester:         "requester@example.com",
+		RequesterIss:      "issuer",
+		RequesterSub:      "subject",
+		RequestedAt:       time.Now(),
+		Reason:            "Reason",
+		Status:            enums.DeletionRequestStatusPending,
+		AIPUUID:           aipID,
+		WorkflowDBID:      1,
+		RequiredApprovals: 2,
 	}
 	svc.EXPECT().
 		CreateDeletionRequest(
@@ -273,19 +394,58 @@ func TestCreateDeletionRequestLocalActivity(t *testing.T) {
 		).
 		DoAndReturn(func(ctx context.Context, d *types.DeletionRequest) error {
 			d.DBID = dbID
+			dr.UUID = d.UUID
 			return nil
 		})
+	svc.EXPECT().
+		ReadDeletionRequest(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id uuid.UUID) (*types.DeletionRequest, error) {
+			assert.Equal(t, id, dr.UUID)
+			return &types.DeletionRequest{DBID: dbID, UUID: id, RequiredApprovals: dr.RequiredApprovals}, nil
+		})
 
 	re, err := storage.CreateDeletionRequestLocalActivity(ctx, svc, &storage.CreateDeletionRequestLocalActivityParams{
-		Requester:    dr.Requester,
-		RequesterIss: dr.RequesterIss,
-		RequesterSub: dr.RequesterSub,
-		Reason:       dr.Reason,
-		AIPUUID:      dr.AIPUUID,
-		WorkflowDBID: dr.WorkflowDBID,
+		Requester:         dr.Requester,
+		RequesterIss:      dr.RequesterIss,
+		RequesterSub:      dr.RequesterSub,
+		Reason:            dr.Reason,
+		AIPUUID:           dr.AIPUUID,
+		WorkflowDBID:      dr.WorkflowDBID,
+		RequiredApprovals: dr.RequiredApprovals,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, re, &storage.CreateDeletionRequestLocalActivityResult{
+		DBID:              dbID,
+		RequiredApprovals: dr.RequiredApprovals,
+	})
+}
+
+func TestCreateDeletionRequestApprovalLocalActivity(t *testing.T) {
+	t.Parallel()
+
+	svc := fake.NewMockService(gomock.NewController(t))
+	ctx := context.Background()
+	dbID := 1
+	svc.EXPECT().
+		CreateDeletionRequestApproval(
+			ctx,
+			mockutil.Eq(&types.DeletionRequestApproval{
+				DeletionRequestDBID: dbID,
+				Approver:            "reviewer@example.com",
+				ApproverIss:         "issuer",
+				ApproverSub:         "subject-2",
+				ApprovedAt:          time.Now(),
+			}, mockutil.EquateNearlySameTime()),
+		).
+		Return(nil)
+
+	err := storage.CreateDeletionRequestApprovalLocalActivity(ctx, svc, dbID, storage.DeletionDecisionSignal{
+		Status:    enums.DeletionRequestStatusApproved,
+		UserEmail: "reviewer@example.com",
+		UserIss:   "issuer",
+		UserSub:   "subject-2",
 	})
 	assert.NilError(t, err)
-	assert.Equal(t, re, dbID)
 }
 
 func TestReviewDeletionRequestLocalActivity(t *testing.T) {
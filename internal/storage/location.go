@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/google/uuid"
 	"go.artefactual.dev/tools/bucket"
@@ -99,6 +102,12 @@ func ConvertGoaLocationConfigToLocationConfig(goaConfig goastorage.Config) (type
 			return types.LocationConfig{}, errors.New("invalid configuration")
 		}
 		config.Value = c.ConvertToAMSSConfig()
+	case goastorage.ConfigKindFilesystem:
+		c, _ := goaConfig.AsFilesystem()
+		if c == nil {
+			return types.LocationConfig{}, errors.New("invalid configuration")
+		}
+		config.Value = c.ConvertToFilesystemConfig()
 	default:
 		return types.LocationConfig{}, fmt.Errorf("unsupported config type: %T", goaConfig)
 	}
@@ -109,3 +118,34 @@ func ConvertGoaLocationConfigToLocationConfig(goaConfig goastorage.Config) (type
 
 	return config, nil
 }
+
+// filesystemLocationPath returns the cleaned path of a filesystem location
+// with the symbolic links resolved. It returns an error if the path isn't an
+// existing directory under one of the allowed roots.
+func filesystemLocationPath(roots []string, path string) (string, error) {
+	if len(roots) == 0 {
+		return "", errors.New("filesystem locations are not enabled")
+	}
+
+	path, err := filepath.EvalSymlinks(filepath.Clean(path))
+	if err != nil {
+		return "", errors.New("path: directory not found")
+	}
+	if fi, err := os.Stat(path); err != nil || !fi.IsDir() {
+		return "", errors.New("path: not a directory")
+	}
+
+	for _, root := range roots {
+		root, err := filepath.EvalSymlinks(filepath.Clean(root))
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return path, nil
+	}
+
+	return "", errors.New("path: not under an allowed root directory")
+}
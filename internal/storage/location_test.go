@@ -106,6 +106,16 @@ func TestNewLocation(t *testing.T) {
 			},
 			uuid: locationID,
 		},
+		{
+			name: "Returns a filesystem location",
+			location: &goastorage.Location{
+				UUID: locationID,
+				Config: goastorage.NewConfigFilesystem(&goastorage.FilesystemConfig{
+					Path: "/mnt/nas/aips",
+				}),
+			},
+			uuid: locationID,
+		},
 		{
 			name: "Errors when URL Config is empty",
 			location: &goastorage.Location{
@@ -150,6 +160,26 @@ func TestLocation_Bucket(t *testing.T) {
 			},
 			uuid: locationID,
 		},
+		{
+			name: "Returns a filesystem config bucket",
+			location: &goastorage.Location{
+				UUID: locationID,
+				Config: goastorage.NewConfigFilesystem(&goastorage.FilesystemConfig{
+					Path: t.TempDir(),
+				}),
+			},
+			uuid: locationID,
+		},
+		{
+			name: "Errors on a missing filesystem directory",
+			location: &goastorage.Location{
+				UUID: locationID,
+				Config: goastorage.NewConfigFilesystem(&goastorage.FilesystemConfig{
+					Path: "/nonexistent/enduro/aips",
+				}),
+			},
+			errMsg: "open filesystem bucket: ",
+		},
 		{
 			name: "Errors on an invalid bucket driver",
 			location: &goastorage.Location{
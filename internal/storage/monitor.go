@@ -2,10 +2,14 @@ package storage
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
+
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
 )
 
 func (s *serviceImpl) Monitor(
@@ -62,25 +66,36 @@ func (s *serviceImpl) Monitor(
 			case goastorage.ValueKindStoragePingEvent:
 				// Is this event even sent through this channel?
 			case goastorage.ValueKindLocationCreatedEvent:
-				if !claims.CheckAttributes([]string{auth.StorageLocationsListAttr}) {
+				locationID := event.Value.LocationCreatedEvent.UUID
+				if !inLocationScope(claims, auth.StorageLocationsListAttr, &locationID) {
 					continue
 				}
 			case goastorage.ValueKindAipCreatedEvent:
-				if !claims.CheckAttributes([]string{auth.StorageAIPSListAttr}) {
+				if !s.eventAIPInScope(ctx, claims, []string{auth.StorageAIPSListAttr}, event) {
 					continue
 				}
 			case goastorage.ValueKindAipUpdatedEvent,
 				goastorage.ValueKindAipStatusUpdatedEvent,
 				goastorage.ValueKindAipLocationUpdatedEvent:
-				if !claims.CheckAttributes([]string{auth.StorageAIPSListAttr}) &&
-					!claims.CheckAttributes([]string{auth.StorageAIPSReadAttr}) {
+				if !s.eventAIPInScope(
+					ctx,
+					claims,
+					[]string{auth.StorageAIPSListAttr, auth.StorageAIPSReadAttr},
+					event,
+				) {
 					continue
 				}
 			case goastorage.ValueKindAipWorkflowCreatedEvent,
 				goastorage.ValueKindAipWorkflowUpdatedEvent,
 				goastorage.ValueKindAipTaskCreatedEvent,
 				goastorage.ValueKindAipTaskUpdatedEvent:
-				if !claims.CheckAttributes([]string{auth.StorageAIPSWorkflowsListAttr}) {
+				if !s.eventAIPInScope(ctx, claims, []string{auth.StorageAIPSWorkflowsListAttr}, event) {
+					continue
+				}
+			case goastorage.ValueKindAipDeletionRequestCreatedEvent,
+				goastorage.ValueKindAipDeletionRequestUpdatedEvent,
+				goastorage.ValueKindAipFixityCheckedEvent:
+				if !s.eventAIPInScope(ctx, claims, []string{auth.StorageAIPSReadAttr}, event) {
 					continue
 				}
 			default:
@@ -96,3 +111,84 @@ func (s *serviceImpl) Monitor(
 		}
 	}
 }
+
+// eventAIPInScope reports whether the user is granted any of attrs for the
+// location of the AIP in the event. The location is only looked up when the
+// user attributes are scoped to specific locations, reading the AIP if the
+// event doesn't include it.
+func (s *serviceImpl) eventAIPInScope(
+	ctx context.Context,
+	claims *auth.Claims,
+	attrs []string,
+	event *goastorage.StorageEvent,
+) bool {
+	if slices.ContainsFunc(attrs, func(attr string) bool { return claims.CheckAttributes([]string{attr}) }) {
+		return true
+	}
+	if !slices.ContainsFunc(attrs, func(attr string) bool {
+		return claims.CheckAttributesInAnyScope([]string{attr})
+	}) {
+		return false
+	}
+
+	var locationID, aipID *uuid.UUID
+	switch event.Value.Kind() {
+	case goastorage.ValueKindAipCreatedEvent:
+		if item := event.Value.AipCreatedEvent.Item; item != nil {
+			locationID = item.LocationUUID
+		}
+	case goastorage.ValueKindAipUpdatedEvent:
+		if item := event.Value.AipUpdatedEvent.Item; item != nil {
+			locationID = item.LocationUUID
+		}
+	case goastorage.ValueKindAipLocationUpdatedEvent:
+		locationID = &event.Value.AipLocationUpdatedEvent.LocationUUID
+	case goastorage.ValueKindAipStatusUpdatedEvent:
+		aipID = &event.Value.AipStatusUpdatedEvent.UUID
+	case goastorage.ValueKindAipWorkflowCreatedEvent:
+		if item := event.Value.AipWorkflowCreatedEvent.Item; item != nil {
+			aipID = &item.AipUUID
+		}
+	case goastorage.ValueKindAipWorkflowUpdatedEvent:
+		if item := event.Value.AipWorkflowUpdatedEvent.Item; item != nil {
+			aipID = &item.AipUUID
+		}
+	case goastorage.ValueKindAipTaskCreatedEvent:
+		aipID = s.taskAIPID(ctx, event.Value.AipTaskCreatedEvent.Item)
+	case goastorage.ValueKindAipTaskUpdatedEvent:
+		aipID = s.taskAIPID(ctx, event.Value.AipTaskUpdatedEvent.Item)
+	case goastorage.ValueKindAipDeletionRequestCreatedEvent:
+		aipID = &event.Value.AipDeletionRequestCreatedEvent.AipUUID
+	case goastorage.ValueKindAipDeletionRequestUpdatedEvent:
+		aipID = &event.Value.AipDeletionRequestUpdatedEvent.AipUUID
+	case goastorage.ValueKindAipFixityCheckedEvent:
+		aipID = &event.Value.AipFixityCheckedEvent.AipUUID
+	}
+
+	if aipID != nil {
+		aip, err := s.ReadAip(ctx, *aipID)
+		if err != nil {
+			return false
+		}
+		locationID = aip.LocationUUID
+	}
+
+	return slices.ContainsFunc(attrs, func(attr string) bool {
+		return inLocationScope(claims, attr, locationID)
+	})
+}
+
+// taskAIPID returns the identifier of the AIP of the task workflow, or nil if
+// the workflow can't be read.
+func (s *serviceImpl) taskAIPID(ctx context.Context, task *goastorage.AIPTask) *uuid.UUID {
+	if task == nil {
+		return nil
+	}
+
+	workflows, err := s.storagePersistence.ListWorkflows(ctx, &persistence.WorkflowFilter{UUID: &task.WorkflowUUID})
+	if err != nil || len(workflows) == 0 {
+		return nil
+	}
+
+	return &workflows[0].AipUUID
+}
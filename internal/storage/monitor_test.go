@@ -9,11 +9,14 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
 
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	"github.com/artefactual-sdps/enduro/internal/auth"
 	"github.com/artefactual-sdps/enduro/internal/event"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/fake"
 )
 
 // mockMonitorServerStream implements goastorage.MonitorServerStream for testing.
@@ -47,6 +50,9 @@ func TestMonitor(t *testing.T) {
 	t.Parallel()
 
 	testUUID := uuid.New()
+	scopedLocationID := uuid.New()
+	scopedAIPID := uuid.New()
+	scopedWorkflowID := uuid.New()
 	allEvents := []*goastorage.StorageEvent{
 		{Value: NewEventValue(&goastorage.LocationCreatedEvent{UUID: testUUID})},
 		{Value: NewEventValue(&goastorage.AIPCreatedEvent{UUID: testUUID})},
@@ -57,6 +63,9 @@ func TestMonitor(t *testing.T) {
 		{Value: NewEventValue(&goastorage.AIPWorkflowUpdatedEvent{UUID: testUUID})},
 		{Value: NewEventValue(&goastorage.AIPTaskCreatedEvent{UUID: testUUID})},
 		{Value: NewEventValue(&goastorage.AIPTaskUpdatedEvent{UUID: testUUID})},
+		{Value: NewEventValue(&goastorage.AIPDeletionRequestCreatedEvent{UUID: testUUID})},
+		{Value: NewEventValue(&goastorage.AIPDeletionRequestUpdatedEvent{UUID: testUUID})},
+		{Value: NewEventValue(&goastorage.AIPFixityCheckedEvent{UUID: testUUID})},
 	}
 	allWantEvents := []*goastorage.StorageEvent{
 		{Value: NewEventValue(&goastorage.StoragePingEvent{Message: new("Hello")})},
@@ -69,11 +78,15 @@ func TestMonitor(t *testing.T) {
 		{Value: NewEventValue(&goastorage.AIPWorkflowUpdatedEvent{UUID: testUUID})},
 		{Value: NewEventValue(&goastorage.AIPTaskCreatedEvent{UUID: testUUID})},
 		{Value: NewEventValue(&goastorage.AIPTaskUpdatedEvent{UUID: testUUID})},
+		{Value: NewEventValue(&goastorage.AIPDeletionRequestCreatedEvent{UUID: testUUID})},
+		{Value: NewEventValue(&goastorage.AIPDeletionRequestUpdatedEvent{UUID: testUUID})},
+		{Value: NewEventValue(&goastorage.AIPFixityCheckedEvent{UUID: testUUID})},
 	}
 
 	for _, tt := range []struct {
 		name       string
 		claims     *auth.Claims
+		mock       func(m *fake.MockStorageMockRecorder)
 		events     []*goastorage.StorageEvent
 		wantEvents []*goastorage.StorageEvent
 	}{
@@ -119,6 +132,102 @@ func TestMonitor(t *testing.T) {
 				{Value: NewEventValue(&goastorage.AIPUpdatedEvent{UUID: testUUID})},
 				{Value: NewEventValue(&goastorage.AIPStatusUpdatedEvent{UUID: testUUID})},
 				{Value: NewEventValue(&goastorage.AIPLocationUpdatedEvent{UUID: testUUID})},
+				{Value: NewEventValue(&goastorage.AIPDeletionRequestCreatedEvent{UUID: testUUID})},
+				{Value: NewEventValue(&goastorage.AIPDeletionRequestUpdatedEvent{UUID: testUUID})},
+				{Value: NewEventValue(&goastorage.AIPFixityCheckedEvent{UUID: testUUID})},
+			},
+		},
+		{
+			name: "Filters events based on location scoped permissions",
+			claims: &auth.Claims{
+				Email:         "test@example.com",
+				EmailVerified: true,
+				Attributes: []string{
+					auth.ScopedAttribute(auth.StorageLocationsListAttr, auth.LocationScope, scopedLocationID),
+					auth.ScopedAttribute(auth.StorageAIPSListAttr, auth.LocationScope, scopedLocationID),
+				},
+			},
+			events: []*goastorage.StorageEvent{
+				{Value: NewEventValue(&goastorage.LocationCreatedEvent{UUID: scopedLocationID})},
+				{Value: NewEventValue(&goastorage.LocationCreatedEvent{UUID: testUUID})},
+				{Value: NewEventValue(&goastorage.AIPCreatedEvent{
+					UUID: testUUID,
+					Item: &goastorage.AIP{UUID: testUUID, LocationUUID: &scopedLocationID},
+				})},
+				{Value: NewEventValue(&goastorage.AIPCreatedEvent{
+					UUID: testUUID,
+					Item: &goastorage.AIP{UUID: testUUID, LocationUUID: &testUUID},
+				})},
+				{Value: NewEventValue(&goastorage.AIPCreatedEvent{UUID: testUUID})},
+				{Value: NewEventValue(&goastorage.AIPLocationUpdatedEvent{
+					UUID:         testUUID,
+					LocationUUID: scopedLocationID,
+				})},
+				{Value: NewEventValue(&goastorage.AIPLocationUpdatedEvent{UUID: testUUID, LocationUUID: testUUID})},
+				{Value: NewEventValue(&goastorage.AIPWorkflowCreatedEvent{UUID: testUUID})},
+			},
+			wantEvents: []*goastorage.StorageEvent{
+				{Value: NewEventValue(&goastorage.StoragePingEvent{Message: new("Hello")})},
+				{Value: NewEventValue(&goastorage.LocationCreatedEvent{UUID: scopedLocationID})},
+				{Value: NewEventValue(&goastorage.AIPCreatedEvent{
+					UUID: testUUID,
+					Item: &goastorage.AIP{UUID: testUUID, LocationUUID: &scopedLocationID},
+				})},
+				{Value: NewEventValue(&goastorage.AIPLocationUpdatedEvent{
+					UUID:         testUUID,
+					LocationUUID: scopedLocationID,
+				})},
+			},
+		},
+		{
+			name: "Filters workflow and task events based on location scoped permissions",
+			claims: &auth.Claims{
+				Email:         "test@example.com",
+				EmailVerified: true,
+				Attributes: []string{
+					auth.ScopedAttribute(auth.StorageAIPSWorkflowsListAttr, auth.LocationScope, scopedLocationID),
+				},
+			},
+			mock: func(m *fake.MockStorageMockRecorder) {
+				m.ReadAIP(gomock.Any(), scopedAIPID).
+					Return(&goastorage.AIP{UUID: scopedAIPID, LocationUUID: &scopedLocationID}, nil).
+					Times(2)
+				m.ReadAIP(gomock.Any(), testUUID).
+					Return(&goastorage.AIP{UUID: testUUID, LocationUUID: &testUUID}, nil)
+				m.ListWorkflows(gomock.Any(), &persistence.WorkflowFilter{UUID: &scopedWorkflowID}).
+					Return(goastorage.AIPWorkflowCollection{{UUID: scopedWorkflowID, AipUUID: scopedAIPID}}, nil)
+				m.ListWorkflows(gomock.Any(), &persistence.WorkflowFilter{UUID: &testUUID}).
+					Return(goastorage.AIPWorkflowCollection{}, nil)
+			},
+			events: []*goastorage.StorageEvent{
+				{Value: NewEventValue(&goastorage.AIPWorkflowCreatedEvent{
+					UUID: scopedWorkflowID,
+					Item: &goastorage.AIPWorkflow{UUID: scopedWorkflowID, AipUUID: scopedAIPID},
+				})},
+				{Value: NewEventValue(&goastorage.AIPWorkflowUpdatedEvent{
+					UUID: testUUID,
+					Item: &goastorage.AIPWorkflow{UUID: testUUID, AipUUID: testUUID},
+				})},
+				{Value: NewEventValue(&goastorage.AIPTaskCreatedEvent{
+					UUID: testUUID,
+					Item: &goastorage.AIPTask{UUID: testUUID, WorkflowUUID: scopedWorkflowID},
+				})},
+				{Value: NewEventValue(&goastorage.AIPTaskUpdatedEvent{
+					UUID: testUUID,
+					Item: &goastorage.AIPTask{UUID: testUUID, WorkflowUUID: testUUID},
+				})},
+				{Value: NewEventValue(&goastorage.AIPStatusUpdatedEvent{UUID: scopedAIPID})},
+			},
+			wantEvents: []*goastorage.StorageEvent{
+				{Value: NewEventValue(&goastorage.StoragePingEvent{Message: new("Hello")})},
+				{Value: NewEventValue(&goastorage.AIPWorkflowCreatedEvent{
+					UUID: scopedWorkflowID,
+					Item: &goastorage.AIPWorkflow{UUID: scopedWorkflowID, AipUUID: scopedAIPID},
+				})},
+				{Value: NewEventValue(&goastorage.AIPTaskCreatedEvent{
+					UUID: testUUID,
+					Item: &goastorage.AIPTask{UUID: testUUID, WorkflowUUID: scopedWorkflowID},
+				})},
 			},
 		},
 	} {
@@ -127,10 +236,15 @@ func TestMonitor(t *testing.T) {
 
 			evsvc := event.NewServiceInMem[*goastorage.StorageEvent]()
 			stream := &mockMonitorServerStream{}
+			psMock := fake.NewMockStorage(gomock.NewController(t))
+			if tt.mock != nil {
+				tt.mock(psMock.EXPECT())
+			}
 
 			svc := &serviceImpl{
-				logger: logr.Discard(),
-				evsvc:  evsvc,
+				logger:             logr.Discard(),
+				evsvc:              evsvc,
+				storagePersistence: psMock,
 			}
 
 			// Create a context that will be cancelled to stop the monitor.
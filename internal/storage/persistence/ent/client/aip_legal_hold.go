@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+// CreateAIPLegalHold persists a legal hold placed on an AIP. It returns
+// persistence.ErrActiveAIPLegalHold if the AIP is already under an active
+// legal hold.
+func (c *Client) CreateAIPLegalHold(ctx context.Context, h *types.AIPLegalHold) error {
+	aipDBID, err := c.c.AIP.Query().Where(aip.AipID(h.AIPUUID)).OnlyID(ctx)
+	if err != nil {
+		return fmt.Errorf("create AIP legal hold: %v", err)
+	}
+
+	q := c.c.AIPLegalHold.Create().
+		SetUUID(h.UUID).
+		SetReason(h.Reason).
+		SetPlacedBy(h.PlacedBy).
+		SetAipID(aipDBID).
+		SetActiveAipID(aipDBID)
+
+	if !h.PlacedAt.IsZero() {
+		q.SetPlacedAt(h.PlacedAt)
+	}
+
+	dbh, err := q.Save(ctx)
+	if err != nil {
+		// The unique active_aip_id index rejects a second active hold.
+		if db.IsConstraintError(err) {
+			return fmt.Errorf("create AIP legal hold: %w", persistence.ErrActiveAIPLegalHold)
+		}
+		return fmt.Errorf("create AIP legal hold: %v", err)
+	}
+
+	h.DBID = dbh.ID
+	h.PlacedAt = dbh.PlacedAt
+
+	return nil
+}
+
+// HasActiveAIPLegalHold reports whether an AIP is under a legal hold that
+// hasn't been released.
+func (c *Client) HasActiveAIPLegalHold(ctx context.Context, aipID uuid.UUID) (bool, error) {
+	ok, err := c.c.AIPLegalHold.Query().
+		Where(
+			aiplegalhold.HasAipWith(aip.AipID(aipID)),
+			aiplegalhold.ReleasedAtIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check AIP legal hold: %v", err)
+	}
+
+	return ok, nil
+}
+
+// ReleaseAIPLegalHold releases the active legal hold of an AIP. It returns
+// persistence.ErrNoActiveAIPLegalHold if the AIP isn't under an active legal
+// hold.
+func (c *Client) ReleaseAIPLegalHold(ctx context.Context, aipID uuid.UUID, releasedBy, reason string) error {
+	n, err := c.c.AIPLegalHold.Update().
+		Where(
+			aiplegalhold.HasAipWith(aip.AipID(aipID)),
+			aiplegalhold.ReleasedAtIsNil(),
+		).
+		SetReleasedBy(releasedBy).
+		SetReleaseReason(reason).
+		SetReleasedAt(time.Now()).
+		ClearActiveAipID().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("release AIP legal hold: %v", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("release AIP legal hold: %w", persistence.ErrNoActiveAIPLegalHold)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+var legalHoldID = uuid.MustParse("1b1e9a2e-03cd-4fa6-9e11-3b1f1a7e4a2d")
+
+func initialDataForAIPLegalHoldTests(t *testing.T, ctx context.Context, entc *db.Client) {
+	t.Helper()
+
+	entc.AIP.Create().
+		SetName("AIP 1").
+		SetAipID(aipID).
+		SetObjectKey(objectKey).
+		SetStatus(enums.AIPStatusStored).
+		SaveX(ctx)
+}
+
+func TestCreateAIPLegalHold(t *testing.T) {
+	t.Parallel()
+
+	placedAt := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name    string
+		h       *types.AIPLegalHold
+		wantErr string
+	}
+	for _, tt := range []test{
+		{
+			name: "Creates an AIP legal hold",
+			h: &types.AIPLegalHold{
+				UUID:     legalHoldID,
+				AIPUUID:  aipID,
+				Reason:   "Litigation",
+				PlacedBy: "officer@example.com",
+				PlacedAt: placedAt,
+			},
+		},
+		{
+			name: "Fails to create a legal hold on a missing AIP",
+			h: &types.AIPLegalHold{
+				UUID:     legalHoldID,
+				AIPUUID:  uuid.New(),
+				Reason:   "Litigation",
+				PlacedBy: "officer@example.com",
+			},
+			wantErr: "create AIP legal hold: db: aip not found",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			entc, c := setUpClient(t)
+			initialDataForAIPLegalHoldTests(t, ctx, entc)
+
+			err := c.CreateAIPLegalHold(ctx, tt.h)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.h.DBID, 1)
+
+			dbh := entc.AIPLegalHold.GetX(ctx, tt.h.DBID)
+			assert.Equal(t, dbh.UUID, legalHoldID)
+			assert.Equal(t, dbh.Reason, "Litigation")
+			assert.Equal(t, dbh.PlacedBy, "officer@example.com")
+			assert.Equal(t, dbh.PlacedAt, placedAt)
+			assert.Equal(t, dbh.AipID, 1)
+		})
+	}
+}
+
+func TestAIPLegalHold(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Places and releases a legal hold", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForAIPLegalHoldTests(t, ctx, entc)
+
+		held, err := c.HasActiveAIPLegalHold(ctx, aipID)
+		assert.NilError(t, err)
+		assert.Assert(t, !held)
+
+		err = c.CreateAIPLegalHold(ctx, &types.AIPLegalHold{
+			UUID:     legalHoldID,
+			AIPUUID:  aipID,
+			Reason:   "Litigation",
+			PlacedBy: "officer@example.com",
+		})
+		assert.NilError(t, err)
+
+		held, err = c.HasActiveAIPLegalHold(ctx, aipID)
+		assert.NilError(t, err)
+		assert.Assert(t, held)
+
+		err = c.ReleaseAIPLegalHold(ctx, aipID, "counsel@example.com", "Case closed")
+		assert.NilError(t, err)
+
+		held, err = c.HasActiveAIPLegalHold(ctx, aipID)
+		assert.NilError(t, err)
+		assert.Assert(t, !held)
+
+		dbh := entc.AIPLegalHold.GetX(ctx, 1)
+		assert.Assert(t, !dbh.ReleasedAt.IsZero())
+		assert.Assert(t, dbh.ActiveAipID == nil)
+
+		aip, err := c.ReadAIP(ctx, aipID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, aip.LegalHolds, goastorage.AIPLegalHoldCollection{
+			{
+				UUID:          legalHoldID,
+				Reason:        "Litigation",
+				PlacedBy:      "officer@example.com",
+				PlacedAt:      dbh.PlacedAt.Format(time.RFC3339),
+				ReleasedBy:    new("counsel@example.com"),
+				ReleaseReason: new("Case closed"),
+				ReleasedAt:    new(dbh.ReleasedAt.Format(time.RFC3339)),
+			},
+		})
+	})
+
+	t.Run("Errors when there is no active legal hold to release", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForAIPLegalHoldTests(t, ctx, entc)
+
+		err := c.ReleaseAIPLegalHold(ctx, aipID, "counsel@example.com", "Case closed")
+		assert.Error(t, err, "release AIP legal hold: AIP is not under legal hold")
+		assert.Assert(t, errors.Is(err, persistence.ErrNoActiveAIPLegalHold))
+	})
+
+	t.Run("Allows a single active legal hold per AIP", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForAIPLegalHoldTests(t, ctx, entc)
+
+		err := c.CreateAIPLegalHold(ctx, &types.AIPLegalHold{
+			UUID:     legalHoldID,
+			AIPUUID:  aipID,
+			Reason:   "Litigation",
+			PlacedBy: "officer@example.com",
+		})
+		assert.NilError(t, err)
+
+		err = c.CreateAIPLegalHold(ctx, &types.AIPLegalHold{
+			UUID:     uuid.New(),
+			AIPUUID:  aipID,
+			Reason:   "Audit",
+			PlacedBy: "auditor@example.com",
+		})
+		assert.Error(t, err, "create AIP legal hold: AIP is already under legal hold")
+		assert.Assert(t, errors.Is(err, persistence.ErrActiveAIPLegalHold))
+
+		// A new hold can be placed once the active one is released.
+		err = c.ReleaseAIPLegalHold(ctx, aipID, "counsel@example.com", "Case closed")
+		assert.NilError(t, err)
+
+		err = c.CreateAIPLegalHold(ctx, &types.AIPLegalHold{
+			UUID:     uuid.New(),
+			AIPUUID:  aipID,
+			Reason:   "Audit",
+			PlacedBy: "auditor@example.com",
+		})
+		assert.NilError(t, err)
+	})
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+// CreateAIPReplica persists the replica of an AIP in a replication location.
+// If the replica already exists its status is reset to the given value.
+func (c *Client) CreateAIPReplica(ctx context.Context, r *types.AIPReplica) error {
+	aipDBID, err := c.c.AIP.Query().Where(aip.AipID(r.AIPUUID)).OnlyID(ctx)
+	if err != nil {
+		return fmt.Errorf("create AIP replica: %v", err)
+	}
+	locationDBID, err := c.c.Location.Query().Where(location.UUID(r.LocationUUID)).OnlyID(ctx)
+	if err != nil {
+		return fmt.Errorf("create AIP replica: %v", err)
+	}
+
+	q := c.c.AIPReplica.Create().
+		SetAipID(aipDBID).
+		SetLocationID(locationDBID).
+		SetStatus(r.Status)
+
+	if !r.ReplicatedAt.IsZero() {
+		q.SetReplicatedAt(r.ReplicatedAt)
+	}
+
+	id, err := q.
+		OnConflictColumns(aipreplica.FieldAipID, aipreplica.FieldLocationID).
+		Update(func(u *db.AIPReplicaUpsert) {
+			u.UpdateStatus()
+			u.UpdateReplicatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return fmt.Errorf("create AIP replica: %v", err)
+	}
+
+	dbr, err := c.c.AIPReplica.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("create AIP replica: %v", err)
+	}
+
+	r.DBID = dbr.ID
+	r.CreatedAt = dbr.CreatedAt
+	r.ReplicatedAt = dbr.ReplicatedAt
+
+	return nil
+}
+
+// UpdateAIPReplicaStatus updates the status of the replica of an AIP in a
+// replication location. The replication time is set when the status is
+// replicated.
+func (c *Client) UpdateAIPReplicaStatus(
+	ctx context.Context,
+	aipID, locationID uuid.UUID,
+	status enums.ReplicaStatus,
+) error {
+	q := c.c.AIPReplica.Update().
+		Where(
+			aipreplica.HasAipWith(aip.AipID(aipID)),
+			aipreplica.HasLocationWith(location.UUID(locationID)),
+		).
+		SetStatus(status)
+
+	if status == enums.ReplicaStatusReplicated {
+		q.SetReplicatedAt(time.Now())
+	} else {
+		q.ClearReplicatedAt()
+	}
+
+	n, err := q.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update AIP replica status: %v", err)
+	}
+	if n != 1 {
+		return fmt.Errorf("update AIP replica status: %w", ErrUnexpectedUpdateResults)
+	}
+
+	return nil
+}
+
+// DeleteAIPReplica deletes the replica of an AIP in a replication location. It
+// doesn't fail if the replica doesn't exist.
+func (c *Client) DeleteAIPReplica(ctx context.Context, aipID, locationID uuid.UUID) error {
+	_, err := c.c.AIPReplica.Delete().
+		Where(
+			aipreplica.HasAipWith(aip.AipID(aipID)),
+			aipreplica.HasLocationWith(location.UUID(locationID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete AIP replica: %v", err)
+	}
+
+	return nil
+}
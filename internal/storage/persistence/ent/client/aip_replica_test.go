@@ -0,0 +1,239 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+func initialDataForAIPReplicaTests(t *testing.T, ctx context.Context, entc *db.Client) {
+	t.Helper()
+
+	entc.AIP.Create().
+		SetName("AIP 1").
+		SetAipID(aipID).
+		SetObjectKey(objectKey).
+		SetStatus(enums.AIPStatusStored).
+		SaveX(ctx)
+
+	entc.Location.Create().
+		SetName("Replica location").
+		SetDescription("Replica location").
+		SetSource(enums.LocationSourceS3).
+		SetPurpose(enums.LocationPurposeAipStore).
+		SetUUID(locationID).
+		SetConfig(types.LocationConfig{Value: &types.URLConfig{URL: "mem://"}}).
+		SaveX(ctx)
+}
+
+func TestCreateAIPReplica(t *testing.T) {
+	t.Parallel()
+
+	replicatedAt := time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name    string
+		r       *types.AIPReplica
+		want    *db.AIPReplica
+		wantErr string
+	}
+	for _, tt := range []test{
+		{
+			name: "Creates a pending AIP replica",
+			r: &types.AIPReplica{
+				AIPUUID:      aipID,
+				LocationUUID: locationID,
+				Status:       enums.ReplicaStatusPending,
+			},
+			want: &db.AIPReplica{
+				ID:         1,
+				Status:     enums.ReplicaStatusPending,
+				AipID:      1,
+				LocationID: 1,
+			},
+		},
+		{
+			name: "Creates a replicated AIP replica",
+			r: &types.AIPReplica{
+				AIPUUID:      aipID,
+				LocationUUID: locationID,
+				Status:       enums.ReplicaStatusReplicated,
+				ReplicatedAt: replicatedAt,
+			},
+			want: &db.AIPReplica{
+				ID:           1,
+				Status:       enums.ReplicaStatusReplicated,
+				ReplicatedAt: replicatedAt,
+				AipID:        1,
+				LocationID:   1,
+			},
+		},
+		{
+			name: "Fails to create a replica of a missing AIP",
+			r: &types.AIPReplica{
+				AIPUUID:      uuid.New(),
+				LocationUUID: locationID,
+				Status:       enums.ReplicaStatusPending,
+			},
+			wantErr: "create AIP replica: db: aip not found",
+		},
+		{
+			name: "Fails to create a replica in a missing location",
+			r: &types.AIPReplica{
+				AIPUUID:      aipID,
+				LocationUUID: uuid.New(),
+				Status:       enums.ReplicaStatusPending,
+			},
+			wantErr: "create AIP replica: db: location not found",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			entc, c := setUpClient(t)
+			initialDataForAIPReplicaTests(t, ctx, entc)
+
+			err := c.CreateAIPReplica(ctx, tt.r)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.r.DBID, tt.want.ID)
+
+			dbr := entc.AIPReplica.GetX(ctx, tt.r.DBID)
+			assert.DeepEqual(
+				t,
+				dbr,
+				tt.want,
+				cmpopts.IgnoreFields(db.AIPReplica{}, "CreatedAt", "config", "Edges", "selectValues"),
+			)
+		})
+	}
+
+	t.Run("Resets the status of an existing replica", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForAIPReplicaTests(t, ctx, entc)
+
+		err := c.CreateAIPReplica(ctx, &types.AIPReplica{
+			AIPUUID:      aipID,
+			LocationUUID: locationID,
+			Status:       enums.ReplicaStatusFailed,
+		})
+		assert.NilError(t, err)
+
+		r := &types.AIPReplica{
+			AIPUUID:      aipID,
+			LocationUUID: locationID,
+			Status:       enums.ReplicaStatusPending,
+		}
+		err = c.CreateAIPReplica(ctx, r)
+		assert.NilError(t, err)
+		assert.Equal(t, r.DBID, 1)
+		assert.Equal(t, entc.AIPReplica.Query().CountX(ctx), 1)
+		assert.Equal(t, entc.AIPReplica.GetX(ctx, 1).Status, enums.ReplicaStatusPending)
+	})
+}
+
+func TestUpdateAIPReplicaStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Updates the status of a replica", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForAIPReplicaTests(t, ctx, entc)
+
+		err := c.CreateAIPReplica(ctx, &types.AIPReplica{
+			AIPUUID:      aipID,
+			LocationUUID: locationID,
+			Status:       enums.ReplicaStatusPending,
+		})
+		assert.NilError(t, err)
+
+		err = c.UpdateAIPReplicaStatus(ctx, aipID, locationID, enums.ReplicaStatusReplicated)
+		assert.NilError(t, err)
+
+		dbr := entc.AIPReplica.Query().Where(aipreplica.AipID(1)).OnlyX(ctx)
+		assert.Equal(t, dbr.Status, enums.ReplicaStatusReplicated)
+		assert.Assert(t, !dbr.ReplicatedAt.IsZero())
+
+		aip, err := c.ReadAIP(ctx, aipID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, aip.Replicas, goastorage.AIPReplicaCollection{
+			{
+				LocationUUID: locationID,
+				Status:       "replicated",
+				CreatedAt:    dbr.CreatedAt.Format(time.RFC3339),
+				ReplicatedAt: new(dbr.ReplicatedAt.Format(time.RFC3339)),
+			},
+		})
+
+		err = c.UpdateAIPReplicaStatus(ctx, aipID, locationID, enums.ReplicaStatusFailed)
+		assert.NilError(t, err)
+
+		dbr = entc.AIPReplica.Query().Where(aipreplica.AipID(1)).OnlyX(ctx)
+		assert.Equal(t, dbr.Status, enums.ReplicaStatusFailed)
+		assert.Assert(t, dbr.ReplicatedAt.IsZero())
+	})
+
+	t.Run("Errors when the replica doesn't exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForAIPReplicaTests(t, ctx, entc)
+
+		err := c.UpdateAIPReplicaStatus(ctx, aipID, locationID, enums.ReplicaStatusReplicated)
+		assert.Error(t, err, "update AIP replica status: update operation had unexpected results")
+	})
+}
+
+func TestDeleteAIPReplica(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Deletes a replica", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForAIPReplicaTests(t, ctx, entc)
+
+		err := c.CreateAIPReplica(ctx, &types.AIPReplica{
+			AIPUUID:      aipID,
+			LocationUUID: locationID,
+			Status:       enums.ReplicaStatusReplicated,
+		})
+		assert.NilError(t, err)
+
+		err = c.DeleteAIPReplica(ctx, aipID, locationID)
+		assert.NilError(t, err)
+		assert.Equal(t, entc.AIPReplica.Query().CountX(ctx), 0)
+	})
+
+	t.Run("Ignores a missing replica", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForAIPReplicaTests(t, ctx, entc)
+
+		err := c.DeleteAIPReplica(ctx, aipID, locationID)
+		assert.NilError(t, err)
+	})
+}
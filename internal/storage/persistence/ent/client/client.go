@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 	"go.artefactual.dev/tools/ref"
 
@@ -14,6 +18,9 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
@@ -53,6 +60,22 @@ func (c *Client) CreateAIP(ctx context.Context, goaaip *goastorage.AIP) (*goasto
 		q.SetDeletionReportKey(*goaaip.DeletionReportKey)
 	}
 
+	if len(goaaip.CustomMetadata) > 0 {
+		q.SetCustomMetadata(goaaip.CustomMetadata)
+	}
+
+	if goaaip.Size != nil {
+		q.SetSize(*goaaip.Size)
+	}
+
+	if goaaip.DisposalAt != nil {
+		t, err := time.Parse(time.RFC3339, *goaaip.DisposalAt)
+		if err != nil {
+			return nil, goastorage.MakeNotValid(errors.New("disposal_at: invalid value"))
+		}
+		q.SetDisposalAt(t)
+	}
+
 	if goaaip.LocationUUID != nil {
 		id, err := c.c.Location.Query().
 			Where(location.UUID(*goaaip.LocationUUID)).
@@ -77,7 +100,11 @@ func (c *Client) CreateAIP(ctx context.Context, goaaip *goastorage.AIP) (*goasto
 	return aipAsGoa(ctx, a), nil
 }
 
-func (c *Client) ListAIPs(ctx context.Context, payload *goastorage.ListAipsPayload) (*goastorage.AIPs, error) {
+func (c *Client) ListAIPs(
+	ctx context.Context,
+	payload *goastorage.ListAipsPayload,
+	scope *persistence.AIPScope,
+) (*goastorage.AIPs, error) {
 	if payload == nil {
 		payload = &goastorage.ListAipsPayload{}
 	}
@@ -96,7 +123,17 @@ func (c *Client) ListAIPs(ctx context.Context, payload *goastorage.ListAipsPaylo
 		status = &s
 	}
 
-	qf := entfilter.NewFilter(c.c.AIP.Query(), entfilter.SortableFields{
+	q := c.c.AIP.Query()
+	if payload.MetadataKey != nil {
+		q.Where(func(s *sql.Selector) {
+			s.Where(sqljson.HasKey(s.C(aip.FieldCustomMetadata), sqljson.Path(*payload.MetadataKey)))
+		})
+	}
+	if scope != nil {
+		q.Where(aip.HasLocationWith(location.UUIDIn(scope.LocationIDs...)))
+	}
+
+	qf := entfilter.NewFilter(q, entfilter.SortableFields{
 		aip.FieldID: {Name: "ID", Default: true},
 	})
 	qf.ContainsAny([]string{aip.FieldName, aip.FieldAipID}, payload.Query)
@@ -132,6 +169,40 @@ func (c *Client) ListAIPs(ctx context.Context, payload *goastorage.ListAipsPaylo
 	return r, err
 }
 
+// ListDisposableAIPs returns the stored AIPs with a disposal date on or before
+// f.DueBy, ordered by disposal date. The AIPs under an active legal hold, or
+// with a pending or rejected deletion request, are excluded.
+func (c *Client) ListDisposableAIPs(ctx context.Context, f *persistence.DisposalFilter) ([]*types.AIP, error) {
+	q := c.c.AIP.Query().
+		WithLocation().
+		Where(
+			aip.StatusEQ(enums.AIPStatusStored),
+			aip.DisposalAtLTE(f.DueBy),
+			aip.Not(aip.HasDeletionRequestsWith(
+				deletionrequest.StatusIn(
+					enums.DeletionRequestStatusPending,
+					enums.DeletionRequestStatusRejected,
+				),
+			)),
+			aip.Not(aip.HasLegalHoldsWith(aiplegalhold.ReleasedAtIsNil())),
+		)
+	if f.Limit > 0 {
+		q.Limit(f.Limit)
+	}
+
+	res, err := q.Order(aip.ByDisposalAt(), aip.ByID()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	aips := make([]*types.AIP, len(res))
+	for i, a := range res {
+		aips[i] = convertDBAIP(a)
+	}
+
+	return aips, nil
+}
+
 func (c *Client) ReadAIP(ctx context.Context, aipID uuid.UUID) (*goastorage.AIP, error) {
 	a, err := c.c.AIP.Query().
 		Where(
@@ -262,6 +333,31 @@ func (c *Client) UpdateAIP(
 		updated = true
 	}
 
+	if up.ChecksumAlgorithm != "" && up.ChecksumAlgorithm != dbAIP.ChecksumAlgorithm {
+		q.SetChecksumAlgorithm(up.ChecksumAlgorithm)
+		updated = true
+	}
+
+	if up.ChecksumHash != "" && up.ChecksumHash != dbAIP.ChecksumHash {
+		q.SetChecksumHash(up.ChecksumHash)
+		updated = true
+	}
+
+	if up.Size > 0 && up.Size != dbAIP.Size {
+		q.SetSize(up.Size)
+		updated = true
+	}
+
+	if up.FileCount > 0 && up.FileCount != dbAIP.FileCount {
+		q.SetFileCount(up.FileCount)
+		updated = true
+	}
+
+	if up.DisposalAt != nil && (dbAIP.DisposalAt == nil || !up.DisposalAt.Equal(*dbAIP.DisposalAt)) {
+		q.SetDisposalAt(*up.DisposalAt)
+		updated = true
+	}
+
 	// If no changes were made return the existing AIP.
 	if !updated {
 		return convertDBAIP(dbAIP), aipAsGoa(ctx, dbAIP), rollback(tx, nil)
@@ -293,6 +389,10 @@ func (c *Client) ListWorkflows(
 ) (goastorage.AIPWorkflowCollection, error) {
 	q := c.c.Workflow.Query()
 
+	if f.UUID != nil {
+		q = q.Where(workflow.UUID(*f.UUID))
+	}
+
 	if f.AIPUUID != nil {
 		q = q.Where(workflow.HasAipWith(aip.AipID(*f.AIPUUID)))
 	}
@@ -349,18 +449,56 @@ func (c *Client) CreateLocation(
 
 	q.SetConfig(ref.DerefZero(config))
 
+	targets, err := c.replicationTargets(ctx, location.ReplicationTargets)
+	if err != nil {
+		return nil, err
+	}
+	q.AddReplicationTargets(targets...)
+
 	l, err := q.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	l.Edges.ReplicationTargets = targets
 
 	return locationAsGoa(l), nil
 }
 
+// replicationTargets loads the locations identified by the given UUIDs.
+func (c *Client) replicationTargets(ctx context.Context, ids []string) ([]*db.Location, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	uuids := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		u, err := uuid.Parse(id)
+		if err != nil {
+			return nil, goastorage.MakeNotValid(errors.New("replication_targets: invalid UUID"))
+		}
+		uuids = append(uuids, u)
+	}
+
+	targets, err := c.c.Location.Query().
+		Where(location.UUIDIn(uuids...)).
+		All(ctx)
+	if err != nil {
+		return nil, goastorage.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+
+	for _, u := range uuids {
+		if !slices.ContainsFunc(targets, func(l *db.Location) bool { return l.UUID == u }) {
+			return nil, goastorage.MakeNotValid(fmt.Errorf("replication_targets: location %s not found", u))
+		}
+	}
+
+	return targets, nil
+}
+
 func (c *Client) ListLocations(ctx context.Context) (goastorage.LocationCollection, error) {
 	locations := []*goastorage.Location{}
 
-	res, err := c.c.Location.Query().All(ctx)
+	res, err := c.c.Location.Query().WithReplicationTargets().All(ctx)
 	for _, item := range res {
 		locations = append(locations, locationAsGoa(item))
 	}
@@ -373,6 +511,7 @@ func (c *Client) ReadLocation(ctx context.Context, locationID uuid.UUID) (*goast
 		Where(
 			location.UUID(locationID),
 		).
+		WithReplicationTargets().
 		Only(ctx)
 	if err != nil {
 		if db.IsNotFound(err) {
@@ -398,3 +537,51 @@ func (c *Client) LocationAIPs(ctx context.Context, locationID uuid.UUID) (goasto
 
 	return aips, nil
 }
+
+// LocationUsage returns the number, total size and total file count of the
+// AIPs stored in a location, including the AIPs replicated to it and excluding
+// deleted AIPs and failed replicas.
+func (c *Client) LocationUsage(ctx context.Context, locationID uuid.UUID) (*types.LocationUsage, error) {
+	var res []struct {
+		Count     int   `json:"count"`
+		Size      int64 `json:"size"`
+		FileCount int64 `json:"file_count"`
+	}
+	err := c.c.AIP.Query().
+		Where(
+			aip.Or(
+				aip.HasLocationWith(location.UUID(locationID)),
+				aip.HasReplicasWith(
+					aipreplica.HasLocationWith(location.UUID(locationID)),
+					aipreplica.StatusNEQ(enums.ReplicaStatusFailed),
+				),
+			),
+			aip.StatusNEQ(enums.AIPStatusDeleted),
+		).
+		Aggregate(
+			db.Count(),
+			sumAs(aip.FieldSize),
+			sumAs(aip.FieldFileCount),
+		).
+		Scan(ctx, &res)
+	if err != nil {
+		return nil, fmt.Errorf("location usage: %v", err)
+	}
+
+	u := &types.LocationUsage{}
+	if len(res) > 0 {
+		u.AIPCount = res[0].Count
+		u.Size = res[0].Size
+		u.FileCount = res[0].FileCount
+	}
+
+	return u, nil
+}
+
+// sumAs returns an aggregate function that sums the values of field, as zero
+// when there are no values, and names the result after the field.
+func sumAs(field string) db.AggregateFunc {
+	return func(s *sql.Selector) string {
+		return sql.As(fmt.Sprintf("COALESCE(%s, 0)", sql.Sum(s.C(field))), field)
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
@@ -15,6 +18,8 @@ import (
 	"gotest.tools/v3/assert"
 
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	endurodb "github.com/artefactual-sdps/enduro/internal/db"
+	"github.com/artefactual-sdps/enduro/internal/db/dbtest"
 	"github.com/artefactual-sdps/enduro/internal/entfilter"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
@@ -39,11 +44,21 @@ func fakeNow() time.Time {
 	return t
 }
 
+// setUpClient returns a client of an in-memory SQLite database, or of a
+// PostgreSQL schema created by the storage migrations when the
+// dbtest.PostgresDSNEnv environment variable is set.
 func setUpClient(t *testing.T) (*db.Client, *client.Client) {
 	t.Helper()
 
-	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
-	entc := enttest.Open(t, "sqlite3", dsn)
+	var entc *db.Client
+	if sqlDB, ok := dbtest.Postgres(t); ok {
+		err := endurodb.MigrateEnduroStorageDatabase(logr.Discard(), "postgres", sqlDB)
+		assert.NilError(t, err)
+		entc = db.NewClient(db.Driver(entsql.OpenDB(dialect.Postgres, sqlDB)))
+	} else {
+		dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+		entc = enttest.Open(t, "sqlite3", dsn)
+	}
 	t.Cleanup(func() { entc.Close() })
 
 	c := client.NewClient(entc)
@@ -114,6 +129,8 @@ func TestCreateAIP(t *testing.T) {
 				Status:            "stored",
 				LocationUUID:      new(locationID),
 				DeletionReportKey: &deletionReportKey,
+				DisposalAt:        new("2030-01-02T00:00:00Z"),
+				Size:              new(int64(1024)),
 			},
 			want: &goastorage.AIP{
 				Name:              "test_aip",
@@ -123,6 +140,8 @@ func TestCreateAIP(t *testing.T) {
 				LocationUUID:      new(locationID),
 				CreatedAt:         fakeNow().Format(time.RFC3339),
 				DeletionReportKey: &deletionReportKey,
+				DisposalAt:        new("2030-01-02T00:00:00Z"),
+				Size:              new(int64(1024)),
 			},
 		},
 		{
@@ -135,6 +154,16 @@ func TestCreateAIP(t *testing.T) {
 			},
 			wantErr: "Storage location not found.",
 		},
+		{
+			name: "Errors if disposal date is invalid",
+			params: &goastorage.AIP{
+				Name:       "test_aip",
+				UUID:       aipID,
+				ObjectKey:  objectKey,
+				DisposalAt: new("2030-01-02"),
+			},
+			wantErr: "disposal_at: invalid value",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
@@ -177,11 +206,13 @@ func TestListAIPs(t *testing.T) {
 	aipID2 := uuid.MustParse("7ba9a118-a662-4047-8547-64bc752b91c6")
 	objectKey := aipID
 	objectKey2 := aipID2
+	scopedLocationID := uuid.MustParse("c6e3f4a5-2b1d-4e8f-9a7c-5d3b1e0f2a48")
 
 	tests := []struct {
 		name    string
 		data    func(t *testing.T, ctx context.Context, entc *db.Client)
 		payload *goastorage.ListAipsPayload
+		scope   *persistence.AIPScope
 		want    *goastorage.AIPs
 		wantErr string
 	}{
@@ -462,6 +493,119 @@ func TestListAIPs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Returns AIPs filtered by metadata key",
+			data: func(t *testing.T, ctx context.Context, entc *db.Client) {
+				entc.AIP.Create().
+					SetName("Test AIP 1").
+					SetAipID(aipID).
+					SetObjectKey(objectKey).
+					SetStatus(enums.AIPStatusStored).
+					SetCreatedAt(time.Date(2025, 5, 8, 10, 53, 12, 0, time.UTC)).
+					SetCustomMetadata(map[string]any{"external_id": "12345"}).
+					ExecX(ctx)
+
+				entc.AIP.Create().
+					SetName("Test AIP 2").
+					SetAipID(aipID2).
+					SetObjectKey(objectKey2).
+					SetStatus(enums.AIPStatusStored).
+					SetCreatedAt(time.Date(2025, 5, 8, 10, 53, 48, 0, time.UTC)).
+					SetCustomMetadata(map[string]any{"department": "archives"}).
+					ExecX(ctx)
+			},
+			payload: &goastorage.ListAipsPayload{
+				MetadataKey: new("external_id"),
+			},
+			want: &goastorage.AIPs{
+				Items: []*goastorage.AIP{
+					{
+						Name:           "Test AIP 1",
+						UUID:           aipID,
+						ObjectKey:      objectKey,
+						Status:         "stored",
+						CreatedAt:      "2025-05-08T10:53:12Z",
+						CustomMetadata: map[string]any{"external_id": "12345"},
+					},
+				},
+				Page: &goastorage.EnduroPage{
+					Limit:  entfilter.DefaultPageSize,
+					Offset: 0,
+					Total:  1,
+				},
+			},
+		},
+		{
+			name: "Returns AIPs stored in the scoped locations",
+			data: func(t *testing.T, ctx context.Context, entc *db.Client) {
+				loc := entc.Location.Create().
+					SetName("perma-aips-1").
+					SetDescription("").
+					SetSource(enums.LocationSourceS3).
+					SetPurpose(enums.LocationPurposeAipStore).
+					SetConfig(types.LocationConfig{
+						Value: &types.S3Config{Bucket: "perma-aips-1"},
+					}).
+					SetUUID(scopedLocationID).
+					SaveX(ctx)
+
+				entc.AIP.Create().
+					SetName("Test AIP 1").
+					SetAipID(aipID).
+					SetObjectKey(objectKey).
+					SetStatus(enums.AIPStatusStored).
+					SetLocation(loc).
+					SetCreatedAt(time.Date(2025, 5, 8, 10, 53, 12, 0, time.UTC)).
+					ExecX(ctx)
+
+				entc.AIP.Create().
+					SetName("Test AIP 2").
+					SetAipID(aipID2).
+					SetObjectKey(objectKey2).
+					SetStatus(enums.AIPStatusStored).
+					SetCreatedAt(time.Date(2025, 5, 8, 10, 53, 48, 0, time.UTC)).
+					ExecX(ctx)
+			},
+			scope: &persistence.AIPScope{LocationIDs: []uuid.UUID{scopedLocationID}},
+			want: &goastorage.AIPs{
+				Items: []*goastorage.AIP{
+					{
+						Name:         "Test AIP 1",
+						UUID:         aipID,
+						ObjectKey:    objectKey,
+						Status:       "stored",
+						LocationUUID: &scopedLocationID,
+						CreatedAt:    "2025-05-08T10:53:12Z",
+					},
+				},
+				Page: &goastorage.EnduroPage{
+					Limit:  entfilter.DefaultPageSize,
+					Offset: 0,
+					Total:  1,
+				},
+			},
+		},
+		{
+			name: "Returns no AIPs with an empty scope",
+			data: func(t *testing.T, ctx context.Context, entc *db.Client) {
+				entc.AIP.Create().
+					SetName("Test AIP 1").
+					SetAipID(aipID).
+					SetObjectKey(objectKey).
+					SetStatus(enums.AIPStatusStored).
+					SetCreatedAt(time.Date(2025, 5, 8, 10, 53, 12, 0, time.UTC)).
+					ExecX(ctx)
+			},
+			scope: &persistence.AIPScope{},
+			want: &goastorage.AIPs{
+				Items: []*goastorage.AIP{},
+				Page: &goastorage.EnduroPage{
+					Limit:  entfilter.DefaultPageSize,
+					Offset: 0,
+					Total:  0,
+				},
+			},
+		},
 		{
 			name: "Invalid status filter",
 			payload: &goastorage.ListAipsPayload{
@@ -489,7 +633,7 @@ func TestListAIPs(t *testing.T) {
 				tt.data(t, ctx, entc)
 			}
 
-			aips, err := c.ListAIPs(ctx, tt.payload)
+			aips, err := c.ListAIPs(ctx, tt.payload, tt.scope)
 			if tt.wantErr != "" {
 				assert.ErrorContains(t, err, tt.wantErr)
 				return
@@ -500,6 +644,145 @@ func TestListAIPs(t *testing.T) {
 	}
 }
 
+func TestListDisposableAIPs(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	entc, c := setUpClientWithHooks(t)
+
+	aipID2 := uuid.MustParse("96e182a0-31ab-4738-a620-1ff1954d9ecb")
+	aipID3 := uuid.MustParse("7fc0be04-5bd3-4e3b-8ee7-b5d9cfb9aa4d")
+	aipID4 := uuid.MustParse("4ebd3e21-3b6f-44b4-a4b1-22b3bd5e4f5b")
+	aipID5 := uuid.MustParse("0f4ac6c1-4a8c-4d3a-a0b5-f1bf1fd7a16b")
+	aipID6 := uuid.MustParse("5b0e3b54-3d67-4a5f-9c2b-8c1f4a3f7e21")
+	dueBy := fakeNow().Add(24 * time.Hour)
+
+	create := func(id uuid.UUID, status enums.AIPStatus, disposalAt *time.Time) *db.AIP {
+		return entc.AIP.Create().
+			SetName("AIP").
+			SetAipID(id).
+			SetObjectKey(uuid.New()).
+			SetStatus(status).
+			SetNillableDisposalAt(disposalAt).
+			SaveX(ctx)
+	}
+
+	// Due AIPs, the second one is due first.
+	create(aipID, enums.AIPStatusStored, new(fakeNow().Add(2*time.Hour)))
+	create(aipID2, enums.AIPStatusStored, new(fakeNow().Add(time.Hour)))
+	// Not due, not stored or without a disposal date.
+	create(aipID3, enums.AIPStatusStored, new(fakeNow().Add(48*time.Hour)))
+	create(aipID4, enums.AIPStatusDeleted, new(fakeNow()))
+	create(uuid.New(), enums.AIPStatusStored, nil)
+	// Due, but with a rejected or pending deletion request.
+	for _, status := range []enums.DeletionRequestStatus{
+		enums.DeletionRequestStatusRejected,
+		enums.DeletionRequestStatusPending,
+	} {
+		a := create(uuid.New(), enums.AIPStatusStored, new(fakeNow()))
+		entc.DeletionRequest.Create().
+			SetUUID(uuid.New()).
+			SetRequester("user@example.com").
+			SetRequesterIss("issuer").
+			SetRequesterSub("subject").
+			SetRequestedAt(fakeNow()).
+			SetReason("Reason").
+			SetStatus(status).
+			SetAipID(a.ID).
+			SaveX(ctx)
+	}
+	// Due, but under a legal hold.
+	held := create(uuid.New(), enums.AIPStatusStored, new(fakeNow()))
+	entc.AIPLegalHold.Create().
+		SetUUID(uuid.New()).
+		SetReason("Litigation").
+		SetPlacedBy("user@example.com").
+		SetAipID(held.ID).
+		SaveX(ctx)
+	// Due, with a canceled deletion request and a released legal hold.
+	released := create(aipID5, enums.AIPStatusStored, new(fakeNow()))
+	entc.DeletionRequest.Create().
+		SetUUID(uuid.New()).
+		SetRequester("user@example.com").
+		SetRequesterIss("issuer").
+		SetRequesterSub("subject").
+		SetRequestedAt(fakeNow()).
+		SetReason("Reason").
+		SetStatus(enums.DeletionRequestStatusCanceled).
+		SetAipID(released.ID).
+		SaveX(ctx)
+	entc.AIPLegalHold.Create().
+		SetUUID(uuid.New()).
+		SetReason("Litigation").
+		SetPlacedBy("user@example.com").
+		SetReleasedBy("user@example.com").
+		SetReleaseReason("Settled").
+		SetReleasedAt(fakeNow()).
+		SetAipID(released.ID).
+		SaveX(ctx)
+	// Due last, after a deletion request approved but not yet executed.
+	approved := create(aipID6, enums.AIPStatusStored, new(fakeNow().Add(3*time.Hour)))
+	entc.DeletionRequest.Create().
+		SetUUID(uuid.New()).
+		SetRequester("user@example.com").
+		SetRequesterIss("issuer").
+		SetRequesterSub("subject").
+		SetRequestedAt(fakeNow()).
+		SetReason("Reason").
+		SetStatus(enums.DeletionRequestStatusApproved).
+		SetAipID(approved.ID).
+		SaveX(ctx)
+
+	t.Run("Lists due AIPs ordered by disposal date", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := c.ListDisposableAIPs(ctx, &persistence.DisposalFilter{DueBy: dueBy})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, []*types.AIP{
+			{
+				UUID:       aipID5,
+				Name:       "AIP",
+				Status:     enums.AIPStatusStored,
+				CreatedAt:  fakeNow(),
+				DisposalAt: new(fakeNow()),
+			},
+			{
+				UUID:       aipID2,
+				Name:       "AIP",
+				Status:     enums.AIPStatusStored,
+				CreatedAt:  fakeNow(),
+				DisposalAt: new(fakeNow().Add(time.Hour)),
+			},
+			{
+				UUID:       aipID,
+				Name:       "AIP",
+				Status:     enums.AIPStatusStored,
+				CreatedAt:  fakeNow(),
+				DisposalAt: new(fakeNow().Add(2 * time.Hour)),
+			},
+			{
+				UUID:       aipID6,
+				Name:       "AIP",
+				Status:     enums.AIPStatusStored,
+				CreatedAt:  fakeNow(),
+				DisposalAt: new(fakeNow().Add(3 * time.Hour)),
+			},
+		}, cmpopts.IgnoreFields(types.AIP{}, "ObjectKey"))
+	})
+
+	t.Run("Limits the number of AIPs", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := c.ListDisposableAIPs(ctx, &persistence.DisposalFilter{
+			DueBy: dueBy,
+			Limit: 1,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(got), 1)
+		assert.Equal(t, got[0].UUID, aipID5)
+	})
+}
+
 func TestReadAIP(t *testing.T) {
 	t.Parallel()
 
@@ -574,6 +857,24 @@ func TestUpdateAIP(t *testing.T) {
 				DeletionReportKey: new("reports/deletion_report.pdf"),
 			},
 		},
+		{
+			name:  "Records the AIP size and file count",
+			aipID: aipID,
+			updater: func(aip *types.AIP) (*types.AIP, error) {
+				aip.Size = 1024
+				aip.FileCount = 12
+				return aip, nil
+			},
+			want: &types.AIP{
+				UUID:      aipID,
+				Name:      "AIP",
+				CreatedAt: fakeNow(),
+				ObjectKey: objectKey,
+				Status:    enums.AIPStatusProcessing,
+				Size:      1024,
+				FileCount: 12,
+			},
+		},
 		{
 			name:  "Keeps nil location when only status changes",
 			aipID: aipID,
@@ -645,6 +946,42 @@ func TestUpdateAIP(t *testing.T) {
 				LocationUUID: &locID1,
 			},
 		},
+		{
+			name:         "Updates AIP checksum",
+			aipID:        aipID,
+			initLocation: true,
+			updater: func(aip *types.AIP) (*types.AIP, error) {
+				aip.ChecksumAlgorithm = "sha256"
+				aip.ChecksumHash = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+				return aip, nil
+			},
+			want: &types.AIP{
+				UUID:              aipID,
+				Name:              "AIP",
+				CreatedAt:         fakeNow(),
+				ObjectKey:         objectKey,
+				Status:            enums.AIPStatusProcessing,
+				LocationUUID:      &locID1,
+				ChecksumAlgorithm: "sha256",
+				ChecksumHash:      "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+			},
+		},
+		{
+			name:  "Updates AIP disposal date",
+			aipID: aipID,
+			updater: func(aip *types.AIP) (*types.AIP, error) {
+				aip.DisposalAt = new(fakeNow().Add(time.Hour))
+				return aip, nil
+			},
+			want: &types.AIP{
+				UUID:       aipID,
+				Name:       "AIP",
+				CreatedAt:  fakeNow(),
+				ObjectKey:  objectKey,
+				Status:     enums.AIPStatusProcessing,
+				DisposalAt: new(fakeNow().Add(time.Hour)),
+			},
+		},
 		{
 			name:  "Errors if AIP not found",
 			aipID: uuid.MustParse("f1508f95-cab7-447f-b6a2-e01bf7c64558"),
@@ -935,6 +1272,32 @@ func TestListWorkflows(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "Returns workflows matching UUID",
+			filter: persistence.WorkflowFilter{UUID: &workflowUUID1},
+			want: goastorage.AIPWorkflowCollection{
+				{
+					UUID:        workflowUUID1,
+					TemporalID:  "temporal-id-1",
+					Type:        enums.WorkflowTypeMoveAip.String(),
+					Status:      enums.WorkflowStatusDone.String(),
+					StartedAt:   new(startedAt.Format(time.RFC3339)),
+					CompletedAt: new(completedAt.Format(time.RFC3339)),
+					AipUUID:     aipID,
+					Tasks: goastorage.AIPTaskCollection{
+						{
+							UUID:         taskUUID1,
+							Name:         "Task 1",
+							Status:       enums.TaskStatusDone.String(),
+							StartedAt:    new(startedAt.Format(time.RFC3339)),
+							CompletedAt:  new(completedAt.Format(time.RFC3339)),
+							Note:         new("Note 1"),
+							WorkflowUUID: workflowUUID1,
+						},
+					},
+				},
+			},
+		},
 		{
 			name:   "Returns workflows matching status",
 			filter: persistence.WorkflowFilter{Status: ref.New(enums.WorkflowStatusInProgress)},
@@ -1137,6 +1500,101 @@ func TestCreateURLLocation(t *testing.T) {
 	assert.DeepEqual(t, dblocation.Config.Value, &types.URLConfig{URL: "mem://"})
 }
 
+func TestCreateFilesystemLocation(t *testing.T) {
+	t.Parallel()
+
+	entc, c := setUpClientWithHooks(t)
+	ctx := context.Background()
+
+	l, err := c.CreateLocation(
+		ctx,
+		&goastorage.Location{
+			Name:        "test_filesystem_location",
+			Description: new("location description"),
+			Source:      enums.LocationSourceFilesystem.String(),
+			Purpose:     enums.LocationPurposeAipStore.String(),
+			UUID:        locationID,
+		},
+		&types.LocationConfig{
+			Value: &types.FilesystemConfig{
+				Path: "/mnt/nas/aips",
+			},
+		},
+	)
+	assert.NilError(t, err)
+	assert.DeepEqual(
+		t,
+		l.Config,
+		goastorage.NewConfigFilesystem(&goastorage.FilesystemConfig{Path: "/mnt/nas/aips"}),
+		cmpopts.IgnoreUnexported(goastorage.Config{}),
+	)
+
+	dblocation, err := entc.Location.Query().Where(location.UUID(l.UUID)).Only(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, dblocation.Name, "test_filesystem_location")
+	assert.Equal(t, dblocation.Source, enums.LocationSourceFilesystem)
+	assert.Equal(t, dblocation.Purpose, enums.LocationPurposeAipStore)
+	assert.DeepEqual(t, dblocation.Config.Value, &types.FilesystemConfig{Path: "/mnt/nas/aips"})
+}
+
+func TestCreateLocationWithReplicationTargets(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.MustParse("7ba9a118-a662-4047-8547-64bc752b91c6")
+	newLocation := func(targets ...string) *goastorage.Location {
+		return &goastorage.Location{
+			Name:               "test_location",
+			Source:             enums.LocationSourceS3.String(),
+			Purpose:            enums.LocationPurposeAipStore.String(),
+			UUID:               locationID,
+			ReplicationTargets: targets,
+		}
+	}
+	config := &types.LocationConfig{Value: &types.URLConfig{URL: "mem://"}}
+
+	t.Run("Creates a location with replication targets", func(t *testing.T) {
+		t.Parallel()
+
+		entc, c := setUpClientWithHooks(t)
+		ctx := t.Context()
+
+		entc.Location.Create().
+			SetName("Replica location").
+			SetDescription("replica location").
+			SetSource(enums.LocationSourceS3).
+			SetPurpose(enums.LocationPurposeAipStore).
+			SetUUID(targetID).
+			SetConfig(*config).
+			SaveX(ctx)
+
+		l, err := c.CreateLocation(ctx, newLocation(targetID.String()), config)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, l.ReplicationTargets, []string{targetID.String()})
+
+		l, err = c.ReadLocation(ctx, locationID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, l.ReplicationTargets, []string{targetID.String()})
+	})
+
+	t.Run("Fails if a replication target doesn't exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, c := setUpClientWithHooks(t)
+
+		_, err := c.CreateLocation(t.Context(), newLocation(targetID.String()), config)
+		assert.Error(t, err, "replication_targets: location "+targetID.String()+" not found")
+	})
+
+	t.Run("Fails if a replication target is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, c := setUpClientWithHooks(t)
+
+		_, err := c.CreateLocation(t.Context(), newLocation("12345"), config)
+		assert.Error(t, err, "replication_targets: invalid UUID")
+	})
+}
+
 func TestListLocations(t *testing.T) {
 	t.Parallel()
 
@@ -1391,3 +1849,101 @@ func TestLocationAIPs(t *testing.T) {
 		assert.Assert(t, len(aips) == 0)
 	})
 }
+
+func TestLocationUsage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Returns the usage totals", func(t *testing.T) {
+		t.Parallel()
+
+		entc, c := setUpClientWithHooks(t)
+		l := entc.Location.Create().
+			SetName("Location").
+			SetDescription("location").
+			SetSource(enums.LocationSourceS3).
+			SetPurpose(enums.LocationPurposeAipStore).
+			SetUUID(locationID).
+			SetConfig(types.LocationConfig{
+				Value: &types.S3Config{
+					Bucket: "perma-aips-1",
+				},
+			}).
+			SaveX(context.Background())
+
+		for _, a := range []struct {
+			status    enums.AIPStatus
+			size      int64
+			fileCount int
+		}{
+			{status: enums.AIPStatusStored, size: 1024, fileCount: 10},
+			{status: enums.AIPStatusStored, size: 2048, fileCount: 5},
+			{status: enums.AIPStatusPending},
+			{status: enums.AIPStatusDeleted, size: 4096, fileCount: 20},
+		} {
+			entc.AIP.Create().
+				SetName("AIP").
+				SetAipID(uuid.New()).
+				SetObjectKey(uuid.New()).
+				SetStatus(a.status).
+				SetSize(a.size).
+				SetFileCount(a.fileCount).
+				SetLocation(l).
+				SaveX(context.Background())
+		}
+
+		// AIPs stored in another location and replicated to this location.
+		other := entc.Location.Create().
+			SetName("Other location").
+			SetDescription("location").
+			SetSource(enums.LocationSourceS3).
+			SetPurpose(enums.LocationPurposeAipStore).
+			SetUUID(uuid.New()).
+			SetConfig(types.LocationConfig{
+				Value: &types.S3Config{
+					Bucket: "perma-aips-2",
+				},
+			}).
+			SaveX(context.Background())
+		for _, r := range []struct {
+			status enums.ReplicaStatus
+			size   int64
+		}{
+			{status: enums.ReplicaStatusReplicated, size: 512},
+			{status: enums.ReplicaStatusPending, size: 256},
+			{status: enums.ReplicaStatusFailed, size: 8192},
+		} {
+			a := entc.AIP.Create().
+				SetName("AIP").
+				SetAipID(uuid.New()).
+				SetObjectKey(uuid.New()).
+				SetStatus(enums.AIPStatusStored).
+				SetSize(r.size).
+				SetFileCount(1).
+				SetLocation(other).
+				SaveX(context.Background())
+			entc.AIPReplica.Create().
+				SetAip(a).
+				SetLocation(l).
+				SetStatus(r.status).
+				SaveX(context.Background())
+		}
+
+		usage, err := c.LocationUsage(context.Background(), locationID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, usage, &types.LocationUsage{
+			AIPCount:  5,
+			Size:      3840,
+			FileCount: 17,
+		})
+	})
+
+	t.Run("Returns zero totals for an empty location", func(t *testing.T) {
+		t.Parallel()
+
+		_, c := setUpClientWithHooks(t)
+
+		usage, err := c.LocationUsage(context.Background(), locationID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, usage, &types.LocationUsage{})
+	})
+}
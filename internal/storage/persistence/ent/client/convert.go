@@ -24,6 +24,10 @@ func locationAsGoa(loc *db.Location) *goastorage.Location {
 		l.Config = config
 	}
 
+	for _, target := range loc.Edges.ReplicationTargets {
+		l.ReplicationTargets = append(l.ReplicationTargets, target.UUID.String())
+	}
+
 	return l
 }
 
@@ -57,6 +61,10 @@ func locationConfigAsGoa(config types.LocationConfig) (goastorage.Config, bool)
 		return goastorage.NewConfigURL(&goastorage.URLConfig{
 			URL: c.URL,
 		}), true
+	case *types.FilesystemConfig:
+		return goastorage.NewConfigFilesystem(&goastorage.FilesystemConfig{
+			Path: c.Path,
+		}), true
 	default:
 		return goastorage.Config{}, false
 	}
@@ -75,15 +83,79 @@ func aipAsGoa(ctx context.Context, a *db.AIP) *goastorage.AIP {
 		p.DeletionReportKey = &a.DeletionReportKey
 	}
 
+	if a.Size > 0 {
+		p.Size = &a.Size
+	}
+
+	if a.FileCount > 0 {
+		p.FileCount = &a.FileCount
+	}
+
+	if len(a.CustomMetadata) > 0 {
+		p.CustomMetadata = a.CustomMetadata
+	}
+
+	if a.DisposalAt != nil {
+		p.DisposalAt = new(a.DisposalAt.Format(time.RFC3339))
+	}
+
 	// TODO: should we use UUID as the foreign key?
 	l, err := a.QueryLocation().Only(ctx)
 	if err == nil {
 		p.LocationUUID = &l.UUID
 	}
 
+	replicas, err := a.QueryReplicas().WithLocation().All(ctx)
+	if err == nil {
+		for _, r := range replicas {
+			p.Replicas = append(p.Replicas, aipReplicaAsGoa(r))
+		}
+	}
+
+	holds, err := a.QueryLegalHolds().All(ctx)
+	if err == nil {
+		for _, h := range holds {
+			p.LegalHolds = append(p.LegalHolds, aipLegalHoldAsGoa(h))
+		}
+	}
+
 	return p
 }
 
+func aipReplicaAsGoa(r *db.AIPReplica) *goastorage.AIPReplica {
+	rep := &goastorage.AIPReplica{
+		Status:       r.Status.String(),
+		CreatedAt:    r.CreatedAt.Format(time.RFC3339),
+		ReplicatedAt: endurodb.FormatOptionalZeroTime(r.ReplicatedAt),
+	}
+
+	if r.Edges.Location != nil {
+		rep.LocationUUID = r.Edges.Location.UUID
+	}
+
+	return rep
+}
+
+func aipLegalHoldAsGoa(h *db.AIPLegalHold) *goastorage.AIPLegalHold {
+	lh := &goastorage.AIPLegalHold{
+		UUID:       h.UUID,
+		Reason:     h.Reason,
+		PlacedBy:   h.PlacedBy,
+		PlacedAt:   h.PlacedAt.Format(time.RFC3339),
+		ReleasedAt: endurodb.FormatOptionalZeroTime(h.ReleasedAt),
+	}
+
+	if h.ReleasedBy != "" {
+		lh.ReleasedBy = &h.ReleasedBy
+	}
+
+	if h.ReleaseReason != "" {
+		lh.ReleaseReason = &h.ReleaseReason
+	}
+
+	return lh
+}
+
 func workflowAsGoa(dbw *db.Workflow) *goastorage.AIPWorkflow {
 	w := &goastorage.AIPWorkflow{
 		UUID:        dbw.UUID,
@@ -137,6 +209,11 @@ func convertDBAIP(dba *db.AIP) *types.AIP {
 		ObjectKey:         dba.ObjectKey,
 		Status:            dba.Status,
 		DeletionReportKey: nil,
+		ChecksumAlgorithm: dba.ChecksumAlgorithm,
+		ChecksumHash:      dba.ChecksumHash,
+		Size:              dba.Size,
+		FileCount:         dba.FileCount,
+		DisposalAt:        dba.DisposalAt,
 	}
 
 	if dba.Edges.Location != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
 )
 
@@ -36,6 +37,9 @@ func (c *Client) CreateDeletionRequest(ctx context.Context, dr *types.DeletionRe
 	if !dr.RequestedAt.IsZero() {
 		q.SetRequestedAt(dr.RequestedAt)
 	}
+	if dr.RequiredApprovals > 0 {
+		q.SetRequiredApprovals(dr.RequiredApprovals)
+	}
 
 	dbdr, err := q.Save(ctx)
 	if err != nil {
@@ -145,21 +149,74 @@ func (c *Client) ReadDeletionRequest(
 	return convertDeletionRequest(dr), nil
 }
 
+// CreateDeletionRequestApproval persists the approval of a deletion request.
+func (c *Client) CreateDeletionRequestApproval(ctx context.Context, a *types.DeletionRequestApproval) error {
+	q := c.c.DeletionRequestApproval.Create().
+		SetApprover(a.Approver).
+		SetApproverIss(a.ApproverIss).
+		SetApproverSub(a.ApproverSub).
+		SetDeletionRequestID(a.DeletionRequestDBID)
+
+	if !a.ApprovedAt.IsZero() {
+		q.SetApprovedAt(a.ApprovedAt)
+	}
+
+	dba, err := q.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create deletion request approval: %v", err)
+	}
+
+	a.DBID = dba.ID
+	a.ApprovedAt = dba.ApprovedAt
+
+	return nil
+}
+
+// ListDeletionRequestApprovals returns the approvals of a deletion request in
+// the order they were given.
+func (c *Client) ListDeletionRequestApprovals(
+	ctx context.Context,
+	deletionRequestDBID int,
+) ([]*types.DeletionRequestApproval, error) {
+	r, err := c.c.DeletionRequestApproval.Query().
+		Where(deletionrequestapproval.DeletionRequestID(deletionRequestDBID)).
+		Order(deletionrequestapproval.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list deletion request approvals: %v", err)
+	}
+
+	approvals := make([]*types.DeletionRequestApproval, len(r))
+	for i, a := range r {
+		approvals[i] = &types.DeletionRequestApproval{
+			DBID:                a.ID,
+			DeletionRequestDBID: a.DeletionRequestID,
+			Approver:            a.Approver,
+			ApproverIss:         a.ApproverIss,
+			ApproverSub:         a.ApproverSub,
+			ApprovedAt:          a.ApprovedAt,
+		}
+	}
+
+	return approvals, nil
+}
+
 func convertDeletionRequest(dbdr *db.DeletionRequest) *types.DeletionRequest {
 	dr := &types.DeletionRequest{
-		DBID:         dbdr.ID,
-		UUID:         dbdr.UUID,
-		Requester:    dbdr.Requester,
-		RequesterIss: dbdr.RequesterIss,
-		RequesterSub: dbdr.RequesterSub,
-		Reviewer:     dbdr.Reviewer,
-		ReviewerIss:  dbdr.ReviewerIss,
-		ReviewerSub:  dbdr.ReviewerSub,
-		Reason:       dbdr.Reason,
-		Status:       dbdr.Status,
-		RequestedAt:  dbdr.RequestedAt,
-		ReviewedAt:   dbdr.ReviewedAt,
-		WorkflowDBID: dbdr.WorkflowID,
+		DBID:              dbdr.ID,
+		UUID:              dbdr.UUID,
+		Requester:         dbdr.Requester,
+		RequesterIss:      dbdr.RequesterIss,
+		RequesterSub:      dbdr.RequesterSub,
+		Reviewer:          dbdr.Reviewer,
+		ReviewerIss:       dbdr.ReviewerIss,
+		ReviewerSub:       dbdr.ReviewerSub,
+		Reason:            dbdr.Reason,
+		Status:            dbdr.Status,
+		RequestedAt:       dbdr.RequestedAt,
+		ReviewedAt:        dbdr.ReviewedAt,
+		RequiredApprovals: dbdr.RequiredApprovals,
+		WorkflowDBID:      dbdr.WorkflowID,
 	}
 
 	if dbdr.Edges.Aip != nil {
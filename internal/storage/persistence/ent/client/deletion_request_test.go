@@ -71,16 +71,17 @@ func TestCreateDeletionRequest(t *testing.T) {
 				WorkflowDBID: 1,
 			},
 			want: &db.DeletionRequest{
-				ID:           1,
-				UUID:         drUUID,
-				AipID:        1,
-				Reason:       "Reason",
-				Requester:    "requester@example.com",
-				RequesterIss: "issuer",
-				RequesterSub: "sub",
-				RequestedAt:  requestedAt,
-				Status:       enums.DeletionRequestStatusPending,
-				WorkflowID:   1,
+				ID:                1,
+				UUID:              drUUID,
+				AipID:             1,
+				Reason:            "Reason",
+				Requester:         "requester@example.com",
+				RequesterIss:      "issuer",
+				RequesterSub:      "sub",
+				RequestedAt:       requestedAt,
+				Status:            enums.DeletionRequestStatusPending,
+				RequiredApprovals: 1,
+				WorkflowID:        1,
 			},
 		},
 		{
@@ -141,28 +142,30 @@ func TestListDeletionRequests(t *testing.T) {
 			name: "Lists all Deletion Requests when filter is nil",
 			want: []*types.DeletionRequest{
 				{
-					DBID:         1,
-					UUID:         drUUID1,
-					Reason:       "Reason 1",
-					Requester:    "requester@example.com",
-					RequesterIss: "issuer",
-					RequesterSub: "sub",
-					RequestedAt:  requestedAt1,
-					Status:       enums.DeletionRequestStatusPending,
-					AIPUUID:      aipID,
-					WorkflowDBID: 1,
+					DBID:              1,
+					UUID:              drUUID1,
+					Reason:            "Reason 1",
+					Requester:         "requester@example.com",
+					RequesterIss:      "issuer",
+					RequesterSub:      "sub",
+					RequestedAt:       requestedAt1,
+					Status:            enums.DeletionRequestStatusPending,
+					AIPUUID:           aipID,
+					RequiredApprovals: 1,
+					WorkflowDBID:      1,
 				},
 				{
-					DBID:         2,
-					UUID:         drUUID2,
-					Reason:       "Reason 2",
-					Requester:    "requester@example.com",
-					RequesterIss: "issuer",
-					RequesterSub: "sub",
-					RequestedAt:  requestedAt2,
-					Status:       enums.DeletionRequestStatusApproved,
-					AIPUUID:      aipID,
-					WorkflowDBID: 2,
+					DBID:              2,
+					UUID:              drUUID2,
+					Reason:            "Reason 2",
+					Requester:         "requester@example.com",
+					RequesterIss:      "issuer",
+					RequesterSub:      "sub",
+					RequestedAt:       requestedAt2,
+					Status:            enums.DeletionRequestStatusApproved,
+					AIPUUID:           aipID,
+					RequiredApprovals: 1,
+					WorkflowDBID:      2,
 				},
 			},
 		},
@@ -173,16 +176,17 @@ func TestListDeletionRequests(t *testing.T) {
 			},
 			want: []*types.DeletionRequest{
 				{
-					DBID:         1,
-					UUID:         drUUID1,
-					Reason:       "Reason 1",
-					Requester:    "requester@example.com",
-					RequesterIss: "issuer",
-					RequesterSub: "sub",
-					RequestedAt:  requestedAt1,
-					Status:       enums.DeletionRequestStatusPending,
-					AIPUUID:      aipID,
-					WorkflowDBID: 1,
+					DBID:              1,
+					UUID:              drUUID1,
+					Reason:            "Reason 1",
+					Requester:         "requester@example.com",
+					RequesterIss:      "issuer",
+					RequesterSub:      "sub",
+					RequestedAt:       requestedAt1,
+					Status:            enums.DeletionRequestStatusPending,
+					AIPUUID:           aipID,
+					RequiredApprovals: 1,
+					WorkflowDBID:      1,
 				},
 			},
 		},
@@ -193,28 +197,30 @@ func TestListDeletionRequests(t *testing.T) {
 			},
 			want: []*types.DeletionRequest{
 				{
-					DBID:         1,
-					UUID:         drUUID1,
-					Reason:       "Reason 1",
-					Requester:    "requester@example.com",
-					RequesterIss: "issuer",
-					RequesterSub: "sub",
-					RequestedAt:  requestedAt1,
-					Status:       enums.DeletionRequestStatusPending,
-					AIPUUID:      aipID,
-					WorkflowDBID: 1,
+					DBID:              1,
+					UUID:              drUUID1,
+					Reason:            "Reason 1",
+					Requester:         "requester@example.com",
+					RequesterIss:      "issuer",
+					RequesterSub:      "sub",
+					RequestedAt:       requestedAt1,
+					Status:            enums.DeletionRequestStatusPending,
+					AIPUUID:           aipID,
+					RequiredApprovals: 1,
+					WorkflowDBID:      1,
 				},
 				{
-					DBID:         2,
-					UUID:         drUUID2,
-					Reason:       "Reason 2",
-					Requester:    "requester@example.com",
-					RequesterIss: "issuer",
-					RequesterSub: "sub",
-					RequestedAt:  requestedAt2,
-					Status:       enums.DeletionRequestStatusApproved,
-					AIPUUID:      aipID,
-					WorkflowDBID: 2,
+					DBID:              2,
+					UUID:              drUUID2,
+					Reason:            "Reason 2",
+					Requester:         "requester@example.com",
+					RequesterIss:      "issuer",
+					RequesterSub:      "sub",
+					RequestedAt:       requestedAt2,
+					Status:            enums.DeletionRequestStatusApproved,
+					AIPUUID:           aipID,
+					RequiredApprovals: 1,
+					WorkflowDBID:      2,
 				},
 			},
 		},
@@ -292,20 +298,21 @@ func TestUpdateDeletionRequest(t *testing.T) {
 				return dr, nil
 			},
 			want: &types.DeletionRequest{
-				DBID:         1,
-				UUID:         drUUID,
-				Reason:       "Reason",
-				Requester:    "requester@example.com",
-				RequesterIss: "issuer",
-				RequesterSub: "sub",
-				RequestedAt:  requestedAt,
-				Reviewer:     "reviewer@example.com",
-				ReviewerIss:  "issuer",
-				ReviewerSub:  "sub2",
-				ReviewedAt:   reviewedAt,
-				Status:       enums.DeletionRequestStatusApproved,
-				AIPUUID:      aipID,
-				WorkflowDBID: 1,
+				DBID:              1,
+				UUID:              drUUID,
+				Reason:            "Reason",
+				Requester:         "requester@example.com",
+				RequesterIss:      "issuer",
+				RequesterSub:      "sub",
+				RequestedAt:       requestedAt,
+				Reviewer:          "reviewer@example.com",
+				ReviewerIss:       "issuer",
+				ReviewerSub:       "sub2",
+				ReviewedAt:        reviewedAt,
+				Status:            enums.DeletionRequestStatusApproved,
+				AIPUUID:           aipID,
+				RequiredApprovals: 1,
+				WorkflowDBID:      1,
 			},
 		},
 		{
@@ -319,16 +326,17 @@ func TestUpdateDeletionRequest(t *testing.T) {
 				return dr, nil
 			},
 			want: &types.DeletionRequest{
-				DBID:         1,
-				UUID:         drUUID,
-				Reason:       "Reason",
-				Requester:    "requester@example.com",
-				RequesterIss: "issuer",
-				RequesterSub: "sub",
-				RequestedAt:  requestedAt,
-				Status:       enums.DeletionRequestStatusPending,
-				AIPUUID:      aipID,
-				WorkflowDBID: 1,
+				DBID:              1,
+				UUID:              drUUID,
+				Reason:            "Reason",
+				Requester:         "requester@example.com",
+				RequesterIss:      "issuer",
+				RequesterSub:      "sub",
+				RequestedAt:       requestedAt,
+				Status:            enums.DeletionRequestStatusPending,
+				AIPUUID:           aipID,
+				RequiredApprovals: 1,
+				WorkflowDBID:      1,
 			},
 		},
 		{
@@ -398,20 +406,21 @@ func TestReadDeletionRequests(t *testing.T) {
 			name: "Reads a deletion request",
 			id:   drUUID,
 			want: &types.DeletionRequest{
-				DBID:         1,
-				UUID:         drUUID,
-				Requester:    "requester@example.com",
-				RequesterIss: "issuer",
-				RequesterSub: "sub",
-				Reviewer:     "reviewer@example.com",
-				ReviewerIss:  "issuer",
-				ReviewerSub:  "sub",
-				Reason:       "Test reason",
-				Status:       enums.DeletionRequestStatusApproved,
-				RequestedAt:  requestedAt,
-				ReviewedAt:   reviewedAt,
-				AIPUUID:      aipID,
-				WorkflowDBID: 1,
+				DBID:              1,
+				UUID:              drUUID,
+				Requester:         "requester@example.com",
+				RequesterIss:      "issuer",
+				RequesterSub:      "sub",
+				Reviewer:          "reviewer@example.com",
+				ReviewerIss:       "issuer",
+				ReviewerSub:       "sub",
+				Reason:            "Test reason",
+				Status:            enums.DeletionRequestStatusApproved,
+				RequestedAt:       requestedAt,
+				ReviewedAt:        reviewedAt,
+				AIPUUID:           aipID,
+				RequiredApprovals: 1,
+				WorkflowDBID:      1,
 			},
 		},
 		{
@@ -454,3 +463,87 @@ func TestReadDeletionRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestDeletionRequestApprovals(t *testing.T) {
+	t.Parallel()
+
+	approvedAt := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Creates and lists deletion request approvals", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		entc, c := setUpClient(t)
+		initialDataForDeletionRequestTests(t, ctx, entc)
+
+		dr := &types.DeletionRequest{
+			UUID:              uuid.New(),
+			AIPUUID:           aipID,
+			Reason:            "Reason",
+			Requester:         "requester@example.com",
+			RequesterIss:      "issuer",
+			RequesterSub:      "sub",
+			RequiredApprovals: 2,
+			WorkflowDBID:      1,
+		}
+		err := c.CreateDeletionRequest(ctx, dr)
+		assert.NilError(t, err)
+		assert.Equal(t, entc.DeletionRequest.GetX(ctx, dr.DBID).RequiredApprovals, 2)
+
+		for _, a := range []*types.DeletionRequestApproval{
+			{
+				DeletionRequestDBID: dr.DBID,
+				Approver:            "reviewer1@example.com",
+				ApproverIss:         "issuer",
+				ApproverSub:         "sub-1",
+				ApprovedAt:          approvedAt,
+			},
+			{
+				DeletionRequestDBID: dr.DBID,
+				Approver:            "reviewer2@example.com",
+				ApproverIss:         "issuer",
+				ApproverSub:         "sub-2",
+				ApprovedAt:          approvedAt.Add(time.Hour),
+			},
+		} {
+			err := c.CreateDeletionRequestApproval(ctx, a)
+			assert.NilError(t, err)
+		}
+
+		approvals, err := c.ListDeletionRequestApprovals(ctx, dr.DBID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, approvals, []*types.DeletionRequestApproval{
+			{
+				DBID:                1,
+				DeletionRequestDBID: dr.DBID,
+				Approver:            "reviewer1@example.com",
+				ApproverIss:         "issuer",
+				ApproverSub:         "sub-1",
+				ApprovedAt:          approvedAt,
+			},
+			{
+				DBID:                2,
+				DeletionRequestDBID: dr.DBID,
+				Approver:            "reviewer2@example.com",
+				ApproverIss:         "issuer",
+				ApproverSub:         "sub-2",
+				ApprovedAt:          approvedAt.Add(time.Hour),
+			},
+		})
+	})
+
+	t.Run("Fails to create an approval for a missing deletion request", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		_, c := setUpClient(t)
+
+		err := c.CreateDeletionRequestApproval(ctx, &types.DeletionRequestApproval{
+			DeletionRequestDBID: 1,
+			Approver:            "reviewer@example.com",
+			ApproverIss:         "issuer",
+			ApproverSub:         "sub",
+		})
+		assert.ErrorContains(t, err, "create deletion request approval: ")
+	})
+}
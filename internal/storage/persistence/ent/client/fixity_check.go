@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+func (c *Client) CreateFixityCheck(ctx context.Context, fc *types.FixityCheck) error {
+	aipDBID, err := c.c.AIP.Query().Where(aip.AipID(fc.AIPUUID)).OnlyID(ctx)
+	if err != nil {
+		return fmt.Errorf("create fixity check: %v", err)
+	}
+
+	q := c.c.FixityCheck.Create().
+		SetUUID(fc.UUID).
+		SetChecksumAlgorithm(fc.ChecksumAlgorithm).
+		SetExpectedChecksum(fc.ExpectedChecksum).
+		SetActualChecksum(fc.ActualChecksum).
+		SetStatus(fc.Status).
+		SetAipID(aipDBID)
+
+	if fc.WorkflowDBID != 0 {
+		q.SetWorkflowID(fc.WorkflowDBID)
+	}
+	if !fc.CheckedAt.IsZero() {
+		q.SetCheckedAt(fc.CheckedAt)
+	}
+
+	dbfc, err := q.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create fixity check: %v", err)
+	}
+
+	fc.DBID = dbfc.ID
+	fc.CheckedAt = dbfc.CheckedAt
+
+	return nil
+}
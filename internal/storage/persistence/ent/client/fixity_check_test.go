@@ -0,0 +1,114 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+func TestCreateFixityCheck(t *testing.T) {
+	t.Parallel()
+
+	fcUUID := uuid.New()
+	checkedAt := time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name    string
+		fc      *types.FixityCheck
+		want    *db.FixityCheck
+		wantErr string
+	}
+
+	for _, tt := range []test{
+		{
+			name: "Creates a fixity check",
+			fc: &types.FixityCheck{
+				UUID:              fcUUID,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "abc123",
+				Status:            enums.FixityCheckStatusPassed,
+				CheckedAt:         checkedAt,
+				AIPUUID:           aipID,
+				WorkflowDBID:      1,
+			},
+			want: &db.FixityCheck{
+				ID:                1,
+				UUID:              fcUUID,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "abc123",
+				Status:            enums.FixityCheckStatusPassed,
+				CheckedAt:         checkedAt,
+				AipID:             1,
+				WorkflowID:        1,
+			},
+		},
+		{
+			name: "Creates a failed fixity check without a workflow",
+			fc: &types.FixityCheck{
+				UUID:              fcUUID,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "def456",
+				Status:            enums.FixityCheckStatusFailed,
+				CheckedAt:         checkedAt,
+				AIPUUID:           aipID,
+			},
+			want: &db.FixityCheck{
+				ID:                1,
+				UUID:              fcUUID,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "def456",
+				Status:            enums.FixityCheckStatusFailed,
+				CheckedAt:         checkedAt,
+				AipID:             1,
+			},
+		},
+		{
+			name:    "Fails to create a fixity check without AIP UUID",
+			fc:      &types.FixityCheck{UUID: fcUUID},
+			wantErr: "create fixity check: db: aip not found",
+		},
+		{
+			name: "Fails to create a fixity check with an invalid status",
+			fc: &types.FixityCheck{
+				UUID:    fcUUID,
+				AIPUUID: aipID,
+				Status:  enums.FixityCheckStatus("unknown"),
+			},
+			wantErr: "create fixity check: db: validator failed for field \"FixityCheck.status\": fixitycheck: invalid enum value for status field: \"unknown\"",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			entc, c := setUpClient(t)
+			initialDataForDeletionRequestTests(t, ctx, entc)
+
+			err := c.CreateFixityCheck(ctx, tt.fc)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			dbfc := entc.FixityCheck.GetX(ctx, tt.fc.DBID)
+			assert.DeepEqual(
+				t,
+				dbfc,
+				tt.want,
+				cmpopts.IgnoreFields(db.FixityCheck{}, "config", "Edges", "selectValues"),
+			)
+		})
+	}
+}
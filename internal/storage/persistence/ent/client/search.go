@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/search"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/searchdocument"
+)
+
+// IndexSearchDocument adds doc to the search index, replacing any document
+// with the same UUID.
+func (c *Client) IndexSearchDocument(ctx context.Context, doc *search.Document) error {
+	err := c.c.SearchDocument.Create().
+		SetUUID(doc.UUID).
+		SetName(doc.Name).
+		SetContent(doc.Content()).
+		SetFields(doc.Fields).
+		OnConflictColumns(searchdocument.FieldUUID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("index search document: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteSearchDocument removes the document identified by id from the search
+// index. It doesn't return an error if the document doesn't exist.
+func (c *Client) DeleteSearchDocument(ctx context.Context, id uuid.UUID) error {
+	_, err := c.c.SearchDocument.Delete().Where(searchdocument.UUID(id)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete search document: %v", err)
+	}
+
+	return nil
+}
+
+// SearchDocuments returns the page of search documents matching q, and the
+// total number of matching documents.
+func (c *Client) SearchDocuments(ctx context.Context, q *search.Query) ([]*search.Result, int, error) {
+	if q == nil || len(q.Terms()) == 0 {
+		return []*search.Result{}, 0, nil
+	}
+
+	whole := c.c.SearchDocument.Query().Where(predicate.SearchDocument(search.SQLPredicate(q)))
+	total, err := whole.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search documents: %v", err)
+	}
+
+	r, err := whole.
+		Order(searchdocument.OrderOption(search.SQLOrder(q))).
+		Limit(q.Limit).
+		Offset(q.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search documents: %v", err)
+	}
+
+	res := make([]*search.Result, len(r))
+	for i, doc := range r {
+		res[i] = &search.Result{UUID: doc.UUID, Name: doc.Name, Fields: doc.Fields}
+	}
+
+	return res, total, nil
+}
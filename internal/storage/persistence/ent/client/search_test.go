@@ -0,0 +1,51 @@
+package client_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/search"
+)
+
+func TestSearchDocuments(t *testing.T) {
+	t.Parallel()
+
+	_, c := setUpClient(t)
+	ctx := t.Context()
+
+	err := c.IndexSearchDocument(ctx, &search.Document{
+		UUID: aipID,
+		Name: "aip.7z",
+		Fields: map[string]string{
+			"dc:title":                  "Annual report",
+			search.AccessionNumberField: "2023-001",
+		},
+	})
+	assert.NilError(t, err)
+
+	res, total, err := c.SearchDocuments(ctx, search.NewQuery("annual 2023-001", 0, 0))
+	assert.NilError(t, err)
+	assert.Equal(t, total, 1)
+	assert.DeepEqual(t, res, []*search.Result{
+		{
+			UUID: aipID,
+			Name: "aip.7z",
+			Fields: map[string]string{
+				"dc:title":                  "Annual report",
+				search.AccessionNumberField: "2023-001",
+			},
+		},
+	})
+
+	_, total, err = c.SearchDocuments(ctx, search.NewQuery("minutes", 0, 0))
+	assert.NilError(t, err)
+	assert.Equal(t, total, 0)
+
+	err = c.DeleteSearchDocument(ctx, aipID)
+	assert.NilError(t, err)
+
+	_, total, err = c.SearchDocuments(ctx, search.NewQuery("annual", 0, 0))
+	assert.NilError(t, err)
+	assert.Equal(t, total, 0)
+}
@@ -3,6 +3,7 @@
 package db
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -34,6 +35,18 @@ type AIP struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// DeletionReportKey holds the value of the "deletion_report_key" field.
 	DeletionReportKey string `json:"deletion_report_key,omitempty"`
+	// ChecksumAlgorithm holds the value of the "checksum_algorithm" field.
+	ChecksumAlgorithm string `json:"checksum_algorithm,omitempty"`
+	// ChecksumHash holds the value of the "checksum_hash" field.
+	ChecksumHash string `json:"checksum_hash,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// FileCount holds the value of the "file_count" field.
+	FileCount int `json:"file_count,omitempty"`
+	// CustomMetadata holds the value of the "custom_metadata" field.
+	CustomMetadata map[string]interface{} `json:"custom_metadata,omitempty"`
+	// DisposalAt holds the value of the "disposal_at" field.
+	DisposalAt *time.Time `json:"disposal_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AIPQuery when eager-loading is set.
 	Edges        AIPEdges `json:"edges"`
@@ -48,9 +61,15 @@ type AIPEdges struct {
 	Workflows []*Workflow `json:"workflows,omitempty"`
 	// DeletionRequests holds the value of the deletion_requests edge.
 	DeletionRequests []*DeletionRequest `json:"deletion_requests,omitempty"`
+	// FixityChecks holds the value of the fixity_checks edge.
+	FixityChecks []*FixityCheck `json:"fixity_checks,omitempty"`
+	// Replicas holds the value of the replicas edge.
+	Replicas []*AIPReplica `json:"replicas,omitempty"`
+	// LegalHolds holds the value of the legal_holds edge.
+	LegalHolds []*AIPLegalHold `json:"legal_holds,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // LocationOrErr returns the Location value or an error if the edge
@@ -82,16 +101,45 @@ func (e AIPEdges) DeletionRequestsOrErr() ([]*DeletionRequest, error) {
 	return nil, &NotLoadedError{edge: "deletion_requests"}
 }
 
+// FixityChecksOrErr returns the FixityChecks value or an error if the edge
+// was not loaded in eager-loading.
+func (e AIPEdges) FixityChecksOrErr() ([]*FixityCheck, error) {
+	if e.loadedTypes[3] {
+		return e.FixityChecks, nil
+	}
+	return nil, &NotLoadedError{edge: "fixity_checks"}
+}
+
+// ReplicasOrErr returns the Replicas value or an error if the edge
+// was not loaded in eager-loading.
+func (e AIPEdges) ReplicasOrErr() ([]*AIPReplica, error) {
+	if e.loadedTypes[4] {
+		return e.Replicas, nil
+	}
+	return nil, &NotLoadedError{edge: "replicas"}
+}
+
+// LegalHoldsOrErr returns the LegalHolds value or an error if the edge
+// was not loaded in eager-loading.
+func (e AIPEdges) LegalHoldsOrErr() ([]*AIPLegalHold, error) {
+	if e.loadedTypes[5] {
+		return e.LegalHolds, nil
+	}
+	return nil, &NotLoadedError{edge: "legal_holds"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*AIP) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case aip.FieldID, aip.FieldLocationID:
+		case aip.FieldCustomMetadata:
+			values[i] = new([]byte)
+		case aip.FieldID, aip.FieldLocationID, aip.FieldSize, aip.FieldFileCount:
 			values[i] = new(sql.NullInt64)
-		case aip.FieldName, aip.FieldStatus, aip.FieldDeletionReportKey:
+		case aip.FieldName, aip.FieldStatus, aip.FieldDeletionReportKey, aip.FieldChecksumAlgorithm, aip.FieldChecksumHash:
 			values[i] = new(sql.NullString)
-		case aip.FieldCreatedAt:
+		case aip.FieldCreatedAt, aip.FieldDisposalAt:
 			values[i] = new(sql.NullTime)
 		case aip.FieldAipID, aip.FieldObjectKey:
 			values[i] = new(uuid.UUID)
@@ -158,6 +206,45 @@ func (_m *AIP) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DeletionReportKey = value.String
 			}
+		case aip.FieldChecksumAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum_algorithm", values[i])
+			} else if value.Valid {
+				_m.ChecksumAlgorithm = value.String
+			}
+		case aip.FieldChecksumHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum_hash", values[i])
+			} else if value.Valid {
+				_m.ChecksumHash = value.String
+			}
+		case aip.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case aip.FieldFileCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_count", values[i])
+			} else if value.Valid {
+				_m.FileCount = int(value.Int64)
+			}
+		case aip.FieldCustomMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CustomMetadata); err != nil {
+					return fmt.Errorf("unmarshal field custom_metadata: %w", err)
+				}
+			}
+		case aip.FieldDisposalAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disposal_at", values[i])
+			} else if value.Valid {
+				_m.DisposalAt = new(time.Time)
+				*_m.DisposalAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -186,6 +273,21 @@ func (_m *AIP) QueryDeletionRequests() *DeletionRequestQuery {
 	return NewAIPClient(_m.config).QueryDeletionRequests(_m)
 }
 
+// QueryFixityChecks queries the "fixity_checks" edge of the AIP entity.
+func (_m *AIP) QueryFixityChecks() *FixityCheckQuery {
+	return NewAIPClient(_m.config).QueryFixityChecks(_m)
+}
+
+// QueryReplicas queries the "replicas" edge of the AIP entity.
+func (_m *AIP) QueryReplicas() *AIPReplicaQuery {
+	return NewAIPClient(_m.config).QueryReplicas(_m)
+}
+
+// QueryLegalHolds queries the "legal_holds" edge of the AIP entity.
+func (_m *AIP) QueryLegalHolds() *AIPLegalHoldQuery {
+	return NewAIPClient(_m.config).QueryLegalHolds(_m)
+}
+
 // Update returns a builder for updating this AIP.
 // Note that you need to call AIP.Unwrap() before calling this method if this AIP
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -229,6 +331,26 @@ func (_m *AIP) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("deletion_report_key=")
 	builder.WriteString(_m.DeletionReportKey)
+	builder.WriteString(", ")
+	builder.WriteString("checksum_algorithm=")
+	builder.WriteString(_m.ChecksumAlgorithm)
+	builder.WriteString(", ")
+	builder.WriteString("checksum_hash=")
+	builder.WriteString(_m.ChecksumHash)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("file_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FileCount))
+	builder.WriteString(", ")
+	builder.WriteString("custom_metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomMetadata))
+	builder.WriteString(", ")
+	if v := _m.DisposalAt; v != nil {
+		builder.WriteString("disposal_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -30,12 +30,30 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldDeletionReportKey holds the string denoting the deletion_report_key field in the database.
 	FieldDeletionReportKey = "deletion_report_key"
+	// FieldChecksumAlgorithm holds the string denoting the checksum_algorithm field in the database.
+	FieldChecksumAlgorithm = "checksum_algorithm"
+	// FieldChecksumHash holds the string denoting the checksum_hash field in the database.
+	FieldChecksumHash = "checksum_hash"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldFileCount holds the string denoting the file_count field in the database.
+	FieldFileCount = "file_count"
+	// FieldCustomMetadata holds the string denoting the custom_metadata field in the database.
+	FieldCustomMetadata = "custom_metadata"
+	// FieldDisposalAt holds the string denoting the disposal_at field in the database.
+	FieldDisposalAt = "disposal_at"
 	// EdgeLocation holds the string denoting the location edge name in mutations.
 	EdgeLocation = "location"
 	// EdgeWorkflows holds the string denoting the workflows edge name in mutations.
 	EdgeWorkflows = "workflows"
 	// EdgeDeletionRequests holds the string denoting the deletion_requests edge name in mutations.
 	EdgeDeletionRequests = "deletion_requests"
+	// EdgeFixityChecks holds the string denoting the fixity_checks edge name in mutations.
+	EdgeFixityChecks = "fixity_checks"
+	// EdgeReplicas holds the string denoting the replicas edge name in mutations.
+	EdgeReplicas = "replicas"
+	// EdgeLegalHolds holds the string denoting the legal_holds edge name in mutations.
+	EdgeLegalHolds = "legal_holds"
 	// Table holds the table name of the aip in the database.
 	Table = "aip"
 	// LocationTable is the table that holds the location relation/edge.
@@ -59,6 +77,27 @@ const (
 	DeletionRequestsInverseTable = "deletion_request"
 	// DeletionRequestsColumn is the table column denoting the deletion_requests relation/edge.
 	DeletionRequestsColumn = "aip_id"
+	// FixityChecksTable is the table that holds the fixity_checks relation/edge.
+	FixityChecksTable = "fixity_check"
+	// FixityChecksInverseTable is the table name for the FixityCheck entity.
+	// It exists in this package in order to avoid circular dependency with the "fixitycheck" package.
+	FixityChecksInverseTable = "fixity_check"
+	// FixityChecksColumn is the table column denoting the fixity_checks relation/edge.
+	FixityChecksColumn = "aip_id"
+	// ReplicasTable is the table that holds the replicas relation/edge.
+	ReplicasTable = "aip_replica"
+	// ReplicasInverseTable is the table name for the AIPReplica entity.
+	// It exists in this package in order to avoid circular dependency with the "aipreplica" package.
+	ReplicasInverseTable = "aip_replica"
+	// ReplicasColumn is the table column denoting the replicas relation/edge.
+	ReplicasColumn = "aip_id"
+	// LegalHoldsTable is the table that holds the legal_holds relation/edge.
+	LegalHoldsTable = "aip_legal_hold"
+	// LegalHoldsInverseTable is the table name for the AIPLegalHold entity.
+	// It exists in this package in order to avoid circular dependency with the "aiplegalhold" package.
+	LegalHoldsInverseTable = "aip_legal_hold"
+	// LegalHoldsColumn is the table column denoting the legal_holds relation/edge.
+	LegalHoldsColumn = "aip_id"
 )
 
 // Columns holds all SQL columns for aip fields.
@@ -71,6 +110,12 @@ var Columns = []string{
 	FieldObjectKey,
 	FieldCreatedAt,
 	FieldDeletionReportKey,
+	FieldChecksumAlgorithm,
+	FieldChecksumHash,
+	FieldSize,
+	FieldFileCount,
+	FieldCustomMetadata,
+	FieldDisposalAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -141,6 +186,31 @@ func ByDeletionReportKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletionReportKey, opts...).ToFunc()
 }
 
+// ByChecksumAlgorithm orders the results by the checksum_algorithm field.
+func ByChecksumAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksumAlgorithm, opts...).ToFunc()
+}
+
+// ByChecksumHash orders the results by the checksum_hash field.
+func ByChecksumHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksumHash, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByFileCount orders the results by the file_count field.
+func ByFileCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileCount, opts...).ToFunc()
+}
+
+// ByDisposalAt orders the results by the disposal_at field.
+func ByDisposalAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisposalAt, opts...).ToFunc()
+}
+
 // ByLocationField orders the results by location field.
 func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -175,6 +245,48 @@ func ByDeletionRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newDeletionRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByFixityChecksCount orders the results by fixity_checks count.
+func ByFixityChecksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFixityChecksStep(), opts...)
+	}
+}
+
+// ByFixityChecks orders the results by fixity_checks terms.
+func ByFixityChecks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFixityChecksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReplicasCount orders the results by replicas count.
+func ByReplicasCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReplicasStep(), opts...)
+	}
+}
+
+// ByReplicas orders the results by replicas terms.
+func ByReplicas(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplicasStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLegalHoldsCount orders the results by legal_holds count.
+func ByLegalHoldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLegalHoldsStep(), opts...)
+	}
+}
+
+// ByLegalHolds orders the results by legal_holds terms.
+func ByLegalHolds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLegalHoldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newLocationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -196,3 +308,24 @@ func newDeletionRequestsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DeletionRequestsTable, DeletionRequestsColumn),
 	)
 }
+func newFixityChecksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FixityChecksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FixityChecksTable, FixityChecksColumn),
+	)
+}
+func newReplicasStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReplicasInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReplicasTable, ReplicasColumn),
+	)
+}
+func newLegalHoldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LegalHoldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LegalHoldsTable, LegalHoldsColumn),
+	)
+}
@@ -87,6 +87,31 @@ func DeletionReportKey(v string) predicate.AIP {
 	return predicate.AIP(sql.FieldEQ(FieldDeletionReportKey, v))
 }
 
+// ChecksumAlgorithm applies equality check predicate on the "checksum_algorithm" field. It's identical to ChecksumAlgorithmEQ.
+func ChecksumAlgorithm(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumHash applies equality check predicate on the "checksum_hash" field. It's identical to ChecksumHashEQ.
+func ChecksumHash(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldChecksumHash, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldSize, v))
+}
+
+// FileCount applies equality check predicate on the "file_count" field. It's identical to FileCountEQ.
+func FileCount(v int) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldFileCount, v))
+}
+
+// DisposalAt applies equality check predicate on the "disposal_at" field. It's identical to DisposalAtEQ.
+func DisposalAt(v time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldDisposalAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.AIP {
 	return predicate.AIP(sql.FieldEQ(FieldName, v))
@@ -407,6 +432,316 @@ func DeletionReportKeyContainsFold(v string) predicate.AIP {
 	return predicate.AIP(sql.FieldContainsFold(FieldDeletionReportKey, v))
 }
 
+// ChecksumAlgorithmEQ applies the EQ predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmEQ(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmNEQ applies the NEQ predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmNEQ(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldNEQ(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmIn applies the In predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmIn(vs ...string) predicate.AIP {
+	return predicate.AIP(sql.FieldIn(FieldChecksumAlgorithm, vs...))
+}
+
+// ChecksumAlgorithmNotIn applies the NotIn predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmNotIn(vs ...string) predicate.AIP {
+	return predicate.AIP(sql.FieldNotIn(FieldChecksumAlgorithm, vs...))
+}
+
+// ChecksumAlgorithmGT applies the GT predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmGT(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldGT(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmGTE applies the GTE predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmGTE(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldGTE(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmLT applies the LT predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmLT(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldLT(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmLTE applies the LTE predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmLTE(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldLTE(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmContains applies the Contains predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmContains(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldContains(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmHasPrefix applies the HasPrefix predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmHasPrefix(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldHasPrefix(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmHasSuffix applies the HasSuffix predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmHasSuffix(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldHasSuffix(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmIsNil applies the IsNil predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmIsNil() predicate.AIP {
+	return predicate.AIP(sql.FieldIsNull(FieldChecksumAlgorithm))
+}
+
+// ChecksumAlgorithmNotNil applies the NotNil predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmNotNil() predicate.AIP {
+	return predicate.AIP(sql.FieldNotNull(FieldChecksumAlgorithm))
+}
+
+// ChecksumAlgorithmEqualFold applies the EqualFold predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmEqualFold(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldEqualFold(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmContainsFold applies the ContainsFold predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmContainsFold(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldContainsFold(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumHashEQ applies the EQ predicate on the "checksum_hash" field.
+func ChecksumHashEQ(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldChecksumHash, v))
+}
+
+// ChecksumHashNEQ applies the NEQ predicate on the "checksum_hash" field.
+func ChecksumHashNEQ(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldNEQ(FieldChecksumHash, v))
+}
+
+// ChecksumHashIn applies the In predicate on the "checksum_hash" field.
+func ChecksumHashIn(vs ...string) predicate.AIP {
+	return predicate.AIP(sql.FieldIn(FieldChecksumHash, vs...))
+}
+
+// ChecksumHashNotIn applies the NotIn predicate on the "checksum_hash" field.
+func ChecksumHashNotIn(vs ...string) predicate.AIP {
+	return predicate.AIP(sql.FieldNotIn(FieldChecksumHash, vs...))
+}
+
+// ChecksumHashGT applies the GT predicate on the "checksum_hash" field.
+func ChecksumHashGT(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldGT(FieldChecksumHash, v))
+}
+
+// ChecksumHashGTE applies the GTE predicate on the "checksum_hash" field.
+func ChecksumHashGTE(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldGTE(FieldChecksumHash, v))
+}
+
+// ChecksumHashLT applies the LT predicate on the "checksum_hash" field.
+func ChecksumHashLT(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldLT(FieldChecksumHash, v))
+}
+
+// ChecksumHashLTE applies the LTE predicate on the "checksum_hash" field.
+func ChecksumHashLTE(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldLTE(FieldChecksumHash, v))
+}
+
+// ChecksumHashContains applies the Contains predicate on the "checksum_hash" field.
+func ChecksumHashContains(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldContains(FieldChecksumHash, v))
+}
+
+// ChecksumHashHasPrefix applies the HasPrefix predicate on the "checksum_hash" field.
+func ChecksumHashHasPrefix(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldHasPrefix(FieldChecksumHash, v))
+}
+
+// ChecksumHashHasSuffix applies the HasSuffix predicate on the "checksum_hash" field.
+func ChecksumHashHasSuffix(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldHasSuffix(FieldChecksumHash, v))
+}
+
+// ChecksumHashIsNil applies the IsNil predicate on the "checksum_hash" field.
+func ChecksumHashIsNil() predicate.AIP {
+	return predicate.AIP(sql.FieldIsNull(FieldChecksumHash))
+}
+
+// ChecksumHashNotNil applies the NotNil predicate on the "checksum_hash" field.
+func ChecksumHashNotNil() predicate.AIP {
+	return predicate.AIP(sql.FieldNotNull(FieldChecksumHash))
+}
+
+// ChecksumHashEqualFold applies the EqualFold predicate on the "checksum_hash" field.
+func ChecksumHashEqualFold(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldEqualFold(FieldChecksumHash, v))
+}
+
+// ChecksumHashContainsFold applies the ContainsFold predicate on the "checksum_hash" field.
+func ChecksumHashContainsFold(v string) predicate.AIP {
+	return predicate.AIP(sql.FieldContainsFold(FieldChecksumHash, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.AIP {
+	return predicate.AIP(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.AIP {
+	return predicate.AIP(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.AIP {
+	return predicate.AIP(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.AIP {
+	return predicate.AIP(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.AIP {
+	return predicate.AIP(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.AIP {
+	return predicate.AIP(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.AIP {
+	return predicate.AIP(sql.FieldLTE(FieldSize, v))
+}
+
+// SizeIsNil applies the IsNil predicate on the "size" field.
+func SizeIsNil() predicate.AIP {
+	return predicate.AIP(sql.FieldIsNull(FieldSize))
+}
+
+// SizeNotNil applies the NotNil predicate on the "size" field.
+func SizeNotNil() predicate.AIP {
+	return predicate.AIP(sql.FieldNotNull(FieldSize))
+}
+
+// FileCountEQ applies the EQ predicate on the "file_count" field.
+func FileCountEQ(v int) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldFileCount, v))
+}
+
+// FileCountNEQ applies the NEQ predicate on the "file_count" field.
+func FileCountNEQ(v int) predicate.AIP {
+	return predicate.AIP(sql.FieldNEQ(FieldFileCount, v))
+}
+
+// FileCountIn applies the In predicate on the "file_count" field.
+func FileCountIn(vs ...int) predicate.AIP {
+	return predicate.AIP(sql.FieldIn(FieldFileCount, vs...))
+}
+
+// FileCountNotIn applies the NotIn predicate on the "file_count" field.
+func FileCountNotIn(vs ...int) predicate.AIP {
+	return predicate.AIP(sql.FieldNotIn(FieldFileCount, vs...))
+}
+
+// FileCountGT applies the GT predicate on the "file_count" field.
+func FileCountGT(v int) predicate.AIP {
+	return predicate.AIP(sql.FieldGT(FieldFileCount, v))
+}
+
+// FileCountGTE applies the GTE predicate on the "file_count" field.
+func FileCountGTE(v int) predicate.AIP {
+	return predicate.AIP(sql.FieldGTE(FieldFileCount, v))
+}
+
+// FileCountLT applies the LT predicate on the "file_count" field.
+func FileCountLT(v int) predicate.AIP {
+	return predicate.AIP(sql.FieldLT(FieldFileCount, v))
+}
+
+// FileCountLTE applies the LTE predicate on the "file_count" field.
+func FileCountLTE(v int) predicate.AIP {
+	return predicate.AIP(sql.FieldLTE(FieldFileCount, v))
+}
+
+// FileCountIsNil applies the IsNil predicate on the "file_count" field.
+func FileCountIsNil() predicate.AIP {
+	return predicate.AIP(sql.FieldIsNull(FieldFileCount))
+}
+
+// FileCountNotNil applies the NotNil predicate on the "file_count" field.
+func FileCountNotNil() predicate.AIP {
+	return predicate.AIP(sql.FieldNotNull(FieldFileCount))
+}
+
+// CustomMetadataIsNil applies the IsNil predicate on the "custom_metadata" field.
+func CustomMetadataIsNil() predicate.AIP {
+	return predicate.AIP(sql.FieldIsNull(FieldCustomMetadata))
+}
+
+// CustomMetadataNotNil applies the NotNil predicate on the "custom_metadata" field.
+func CustomMetadataNotNil() predicate.AIP {
+	return predicate.AIP(sql.FieldNotNull(FieldCustomMetadata))
+}
+
+// DisposalAtEQ applies the EQ predicate on the "disposal_at" field.
+func DisposalAtEQ(v time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldEQ(FieldDisposalAt, v))
+}
+
+// DisposalAtNEQ applies the NEQ predicate on the "disposal_at" field.
+func DisposalAtNEQ(v time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldNEQ(FieldDisposalAt, v))
+}
+
+// DisposalAtIn applies the In predicate on the "disposal_at" field.
+func DisposalAtIn(vs ...time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldIn(FieldDisposalAt, vs...))
+}
+
+// DisposalAtNotIn applies the NotIn predicate on the "disposal_at" field.
+func DisposalAtNotIn(vs ...time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldNotIn(FieldDisposalAt, vs...))
+}
+
+// DisposalAtGT applies the GT predicate on the "disposal_at" field.
+func DisposalAtGT(v time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldGT(FieldDisposalAt, v))
+}
+
+// DisposalAtGTE applies the GTE predicate on the "disposal_at" field.
+func DisposalAtGTE(v time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldGTE(FieldDisposalAt, v))
+}
+
+// DisposalAtLT applies the LT predicate on the "disposal_at" field.
+func DisposalAtLT(v time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldLT(FieldDisposalAt, v))
+}
+
+// DisposalAtLTE applies the LTE predicate on the "disposal_at" field.
+func DisposalAtLTE(v time.Time) predicate.AIP {
+	return predicate.AIP(sql.FieldLTE(FieldDisposalAt, v))
+}
+
+// DisposalAtIsNil applies the IsNil predicate on the "disposal_at" field.
+func DisposalAtIsNil() predicate.AIP {
+	return predicate.AIP(sql.FieldIsNull(FieldDisposalAt))
+}
+
+// DisposalAtNotNil applies the NotNil predicate on the "disposal_at" field.
+func DisposalAtNotNil() predicate.AIP {
+	return predicate.AIP(sql.FieldNotNull(FieldDisposalAt))
+}
+
 // HasLocation applies the HasEdge predicate on the "location" edge.
 func HasLocation() predicate.AIP {
 	return predicate.AIP(func(s *sql.Selector) {
@@ -476,6 +811,75 @@ func HasDeletionRequestsWith(preds ...predicate.DeletionRequest) predicate.AIP {
 	})
 }
 
+// HasFixityChecks applies the HasEdge predicate on the "fixity_checks" edge.
+func HasFixityChecks() predicate.AIP {
+	return predicate.AIP(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FixityChecksTable, FixityChecksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFixityChecksWith applies the HasEdge predicate on the "fixity_checks" edge with a given conditions (other predicates).
+func HasFixityChecksWith(preds ...predicate.FixityCheck) predicate.AIP {
+	return predicate.AIP(func(s *sql.Selector) {
+		step := newFixityChecksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplicas applies the HasEdge predicate on the "replicas" edge.
+func HasReplicas() predicate.AIP {
+	return predicate.AIP(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReplicasTable, ReplicasColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplicasWith applies the HasEdge predicate on the "replicas" edge with a given conditions (other predicates).
+func HasReplicasWith(preds ...predicate.AIPReplica) predicate.AIP {
+	return predicate.AIP(func(s *sql.Selector) {
+		step := newReplicasStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLegalHolds applies the HasEdge predicate on the "legal_holds" edge.
+func HasLegalHolds() predicate.AIP {
+	return predicate.AIP(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LegalHoldsTable, LegalHoldsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLegalHoldsWith applies the HasEdge predicate on the "legal_holds" edge with a given conditions (other predicates).
+func HasLegalHoldsWith(preds ...predicate.AIPLegalHold) predicate.AIP {
+	return predicate.AIP(func(s *sql.Selector) {
+		step := newLegalHoldsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AIP) predicate.AIP {
 	return predicate.AIP(sql.AndPredicates(predicates...))
@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 	"github.com/google/uuid"
@@ -93,6 +96,82 @@ func (_c *AIPCreate) SetNillableDeletionReportKey(v *string) *AIPCreate {
 	return _c
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (_c *AIPCreate) SetChecksumAlgorithm(v string) *AIPCreate {
+	_c.mutation.SetChecksumAlgorithm(v)
+	return _c
+}
+
+// SetNillableChecksumAlgorithm sets the "checksum_algorithm" field if the given value is not nil.
+func (_c *AIPCreate) SetNillableChecksumAlgorithm(v *string) *AIPCreate {
+	if v != nil {
+		_c.SetChecksumAlgorithm(*v)
+	}
+	return _c
+}
+
+// SetChecksumHash sets the "checksum_hash" field.
+func (_c *AIPCreate) SetChecksumHash(v string) *AIPCreate {
+	_c.mutation.SetChecksumHash(v)
+	return _c
+}
+
+// SetNillableChecksumHash sets the "checksum_hash" field if the given value is not nil.
+func (_c *AIPCreate) SetNillableChecksumHash(v *string) *AIPCreate {
+	if v != nil {
+		_c.SetChecksumHash(*v)
+	}
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *AIPCreate) SetSize(v int64) *AIPCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_c *AIPCreate) SetNillableSize(v *int64) *AIPCreate {
+	if v != nil {
+		_c.SetSize(*v)
+	}
+	return _c
+}
+
+// SetFileCount sets the "file_count" field.
+func (_c *AIPCreate) SetFileCount(v int) *AIPCreate {
+	_c.mutation.SetFileCount(v)
+	return _c
+}
+
+// SetNillableFileCount sets the "file_count" field if the given value is not nil.
+func (_c *AIPCreate) SetNillableFileCount(v *int) *AIPCreate {
+	if v != nil {
+		_c.SetFileCount(*v)
+	}
+	return _c
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (_c *AIPCreate) SetCustomMetadata(v map[string]interface{}) *AIPCreate {
+	_c.mutation.SetCustomMetadata(v)
+	return _c
+}
+
+// SetDisposalAt sets the "disposal_at" field.
+func (_c *AIPCreate) SetDisposalAt(v time.Time) *AIPCreate {
+	_c.mutation.SetDisposalAt(v)
+	return _c
+}
+
+// SetNillableDisposalAt sets the "disposal_at" field if the given value is not nil.
+func (_c *AIPCreate) SetNillableDisposalAt(v *time.Time) *AIPCreate {
+	if v != nil {
+		_c.SetDisposalAt(*v)
+	}
+	return _c
+}
+
 // SetLocation sets the "location" edge to the Location entity.
 func (_c *AIPCreate) SetLocation(v *Location) *AIPCreate {
 	return _c.SetLocationID(v.ID)
@@ -128,6 +207,51 @@ func (_c *AIPCreate) AddDeletionRequests(v ...*DeletionRequest) *AIPCreate {
 	return _c.AddDeletionRequestIDs(ids...)
 }
 
+// AddFixityCheckIDs adds the "fixity_checks" edge to the FixityCheck entity by IDs.
+func (_c *AIPCreate) AddFixityCheckIDs(ids ...int) *AIPCreate {
+	_c.mutation.AddFixityCheckIDs(ids...)
+	return _c
+}
+
+// AddFixityChecks adds the "fixity_checks" edges to the FixityCheck entity.
+func (_c *AIPCreate) AddFixityChecks(v ...*FixityCheck) *AIPCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFixityCheckIDs(ids...)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the AIPReplica entity by IDs.
+func (_c *AIPCreate) AddReplicaIDs(ids ...int) *AIPCreate {
+	_c.mutation.AddReplicaIDs(ids...)
+	return _c
+}
+
+// AddReplicas adds the "replicas" edges to the AIPReplica entity.
+func (_c *AIPCreate) AddReplicas(v ...*AIPReplica) *AIPCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplicaIDs(ids...)
+}
+
+// AddLegalHoldIDs adds the "legal_holds" edge to the AIPLegalHold entity by IDs.
+func (_c *AIPCreate) AddLegalHoldIDs(ids ...int) *AIPCreate {
+	_c.mutation.AddLegalHoldIDs(ids...)
+	return _c
+}
+
+// AddLegalHolds adds the "legal_holds" edges to the AIPLegalHold entity.
+func (_c *AIPCreate) AddLegalHolds(v ...*AIPLegalHold) *AIPCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLegalHoldIDs(ids...)
+}
+
 // Mutation returns the AIPMutation object of the builder.
 func (_c *AIPCreate) Mutation() *AIPMutation {
 	return _c.mutation
@@ -242,6 +366,30 @@ func (_c *AIPCreate) createSpec() (*AIP, *sqlgraph.CreateSpec) {
 		_spec.SetField(aip.FieldDeletionReportKey, field.TypeString, value)
 		_node.DeletionReportKey = value
 	}
+	if value, ok := _c.mutation.ChecksumAlgorithm(); ok {
+		_spec.SetField(aip.FieldChecksumAlgorithm, field.TypeString, value)
+		_node.ChecksumAlgorithm = value
+	}
+	if value, ok := _c.mutation.ChecksumHash(); ok {
+		_spec.SetField(aip.FieldChecksumHash, field.TypeString, value)
+		_node.ChecksumHash = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(aip.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.FileCount(); ok {
+		_spec.SetField(aip.FieldFileCount, field.TypeInt, value)
+		_node.FileCount = value
+	}
+	if value, ok := _c.mutation.CustomMetadata(); ok {
+		_spec.SetField(aip.FieldCustomMetadata, field.TypeJSON, value)
+		_node.CustomMetadata = value
+	}
+	if value, ok := _c.mutation.DisposalAt(); ok {
+		_spec.SetField(aip.FieldDisposalAt, field.TypeTime, value)
+		_node.DisposalAt = &value
+	}
 	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -291,6 +439,54 @@ func (_c *AIPCreate) createSpec() (*AIP, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FixityChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.FixityChecksTable,
+			Columns: []string{aip.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.ReplicasTable,
+			Columns: []string{aip.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.LegalHoldsTable,
+			Columns: []string{aip.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aiplegalhold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -427,6 +623,126 @@ func (u *AIPUpsert) ClearDeletionReportKey() *AIPUpsert {
 	return u
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (u *AIPUpsert) SetChecksumAlgorithm(v string) *AIPUpsert {
+	u.Set(aip.FieldChecksumAlgorithm, v)
+	return u
+}
+
+// UpdateChecksumAlgorithm sets the "checksum_algorithm" field to the value that was provided on create.
+func (u *AIPUpsert) UpdateChecksumAlgorithm() *AIPUpsert {
+	u.SetExcluded(aip.FieldChecksumAlgorithm)
+	return u
+}
+
+// ClearChecksumAlgorithm clears the value of the "checksum_algorithm" field.
+func (u *AIPUpsert) ClearChecksumAlgorithm() *AIPUpsert {
+	u.SetNull(aip.FieldChecksumAlgorithm)
+	return u
+}
+
+// SetChecksumHash sets the "checksum_hash" field.
+func (u *AIPUpsert) SetChecksumHash(v string) *AIPUpsert {
+	u.Set(aip.FieldChecksumHash, v)
+	return u
+}
+
+// UpdateChecksumHash sets the "checksum_hash" field to the value that was provided on create.
+func (u *AIPUpsert) UpdateChecksumHash() *AIPUpsert {
+	u.SetExcluded(aip.FieldChecksumHash)
+	return u
+}
+
+// ClearChecksumHash clears the value of the "checksum_hash" field.
+func (u *AIPUpsert) ClearChecksumHash() *AIPUpsert {
+	u.SetNull(aip.FieldChecksumHash)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *AIPUpsert) SetSize(v int64) *AIPUpsert {
+	u.Set(aip.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AIPUpsert) UpdateSize() *AIPUpsert {
+	u.SetExcluded(aip.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *AIPUpsert) AddSize(v int64) *AIPUpsert {
+	u.Add(aip.FieldSize, v)
+	return u
+}
+
+// ClearSize clears the value of the "size" field.
+func (u *AIPUpsert) ClearSize() *AIPUpsert {
+	u.SetNull(aip.FieldSize)
+	return u
+}
+
+// SetFileCount sets the "file_count" field.
+func (u *AIPUpsert) SetFileCount(v int) *AIPUpsert {
+	u.Set(aip.FieldFileCount, v)
+	return u
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *AIPUpsert) UpdateFileCount() *AIPUpsert {
+	u.SetExcluded(aip.FieldFileCount)
+	return u
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *AIPUpsert) AddFileCount(v int) *AIPUpsert {
+	u.Add(aip.FieldFileCount, v)
+	return u
+}
+
+// ClearFileCount clears the value of the "file_count" field.
+func (u *AIPUpsert) ClearFileCount() *AIPUpsert {
+	u.SetNull(aip.FieldFileCount)
+	return u
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (u *AIPUpsert) SetCustomMetadata(v map[string]interface{}) *AIPUpsert {
+	u.Set(aip.FieldCustomMetadata, v)
+	return u
+}
+
+// UpdateCustomMetadata sets the "custom_metadata" field to the value that was provided on create.
+func (u *AIPUpsert) UpdateCustomMetadata() *AIPUpsert {
+	u.SetExcluded(aip.FieldCustomMetadata)
+	return u
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (u *AIPUpsert) ClearCustomMetadata() *AIPUpsert {
+	u.SetNull(aip.FieldCustomMetadata)
+	return u
+}
+
+// SetDisposalAt sets the "disposal_at" field.
+func (u *AIPUpsert) SetDisposalAt(v time.Time) *AIPUpsert {
+	u.Set(aip.FieldDisposalAt, v)
+	return u
+}
+
+// UpdateDisposalAt sets the "disposal_at" field to the value that was provided on create.
+func (u *AIPUpsert) UpdateDisposalAt() *AIPUpsert {
+	u.SetExcluded(aip.FieldDisposalAt)
+	return u
+}
+
+// ClearDisposalAt clears the value of the "disposal_at" field.
+func (u *AIPUpsert) ClearDisposalAt() *AIPUpsert {
+	u.SetNull(aip.FieldDisposalAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -570,6 +886,146 @@ func (u *AIPUpsertOne) ClearDeletionReportKey() *AIPUpsertOne {
 	})
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (u *AIPUpsertOne) SetChecksumAlgorithm(v string) *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetChecksumAlgorithm(v)
+	})
+}
+
+// UpdateChecksumAlgorithm sets the "checksum_algorithm" field to the value that was provided on create.
+func (u *AIPUpsertOne) UpdateChecksumAlgorithm() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateChecksumAlgorithm()
+	})
+}
+
+// ClearChecksumAlgorithm clears the value of the "checksum_algorithm" field.
+func (u *AIPUpsertOne) ClearChecksumAlgorithm() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearChecksumAlgorithm()
+	})
+}
+
+// SetChecksumHash sets the "checksum_hash" field.
+func (u *AIPUpsertOne) SetChecksumHash(v string) *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetChecksumHash(v)
+	})
+}
+
+// UpdateChecksumHash sets the "checksum_hash" field to the value that was provided on create.
+func (u *AIPUpsertOne) UpdateChecksumHash() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateChecksumHash()
+	})
+}
+
+// ClearChecksumHash clears the value of the "checksum_hash" field.
+func (u *AIPUpsertOne) ClearChecksumHash() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearChecksumHash()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AIPUpsertOne) SetSize(v int64) *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AIPUpsertOne) AddSize(v int64) *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AIPUpsertOne) UpdateSize() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// ClearSize clears the value of the "size" field.
+func (u *AIPUpsertOne) ClearSize() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearSize()
+	})
+}
+
+// SetFileCount sets the "file_count" field.
+func (u *AIPUpsertOne) SetFileCount(v int) *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetFileCount(v)
+	})
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *AIPUpsertOne) AddFileCount(v int) *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.AddFileCount(v)
+	})
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *AIPUpsertOne) UpdateFileCount() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateFileCount()
+	})
+}
+
+// ClearFileCount clears the value of the "file_count" field.
+func (u *AIPUpsertOne) ClearFileCount() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearFileCount()
+	})
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (u *AIPUpsertOne) SetCustomMetadata(v map[string]interface{}) *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetCustomMetadata(v)
+	})
+}
+
+// UpdateCustomMetadata sets the "custom_metadata" field to the value that was provided on create.
+func (u *AIPUpsertOne) UpdateCustomMetadata() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateCustomMetadata()
+	})
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (u *AIPUpsertOne) ClearCustomMetadata() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearCustomMetadata()
+	})
+}
+
+// SetDisposalAt sets the "disposal_at" field.
+func (u *AIPUpsertOne) SetDisposalAt(v time.Time) *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetDisposalAt(v)
+	})
+}
+
+// UpdateDisposalAt sets the "disposal_at" field to the value that was provided on create.
+func (u *AIPUpsertOne) UpdateDisposalAt() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateDisposalAt()
+	})
+}
+
+// ClearDisposalAt clears the value of the "disposal_at" field.
+func (u *AIPUpsertOne) ClearDisposalAt() *AIPUpsertOne {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearDisposalAt()
+	})
+}
+
 // Exec executes the query.
 func (u *AIPUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -879,6 +1335,146 @@ func (u *AIPUpsertBulk) ClearDeletionReportKey() *AIPUpsertBulk {
 	})
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (u *AIPUpsertBulk) SetChecksumAlgorithm(v string) *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetChecksumAlgorithm(v)
+	})
+}
+
+// UpdateChecksumAlgorithm sets the "checksum_algorithm" field to the value that was provided on create.
+func (u *AIPUpsertBulk) UpdateChecksumAlgorithm() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateChecksumAlgorithm()
+	})
+}
+
+// ClearChecksumAlgorithm clears the value of the "checksum_algorithm" field.
+func (u *AIPUpsertBulk) ClearChecksumAlgorithm() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearChecksumAlgorithm()
+	})
+}
+
+// SetChecksumHash sets the "checksum_hash" field.
+func (u *AIPUpsertBulk) SetChecksumHash(v string) *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetChecksumHash(v)
+	})
+}
+
+// UpdateChecksumHash sets the "checksum_hash" field to the value that was provided on create.
+func (u *AIPUpsertBulk) UpdateChecksumHash() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateChecksumHash()
+	})
+}
+
+// ClearChecksumHash clears the value of the "checksum_hash" field.
+func (u *AIPUpsertBulk) ClearChecksumHash() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearChecksumHash()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AIPUpsertBulk) SetSize(v int64) *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AIPUpsertBulk) AddSize(v int64) *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AIPUpsertBulk) UpdateSize() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// ClearSize clears the value of the "size" field.
+func (u *AIPUpsertBulk) ClearSize() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearSize()
+	})
+}
+
+// SetFileCount sets the "file_count" field.
+func (u *AIPUpsertBulk) SetFileCount(v int) *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetFileCount(v)
+	})
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *AIPUpsertBulk) AddFileCount(v int) *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.AddFileCount(v)
+	})
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *AIPUpsertBulk) UpdateFileCount() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateFileCount()
+	})
+}
+
+// ClearFileCount clears the value of the "file_count" field.
+func (u *AIPUpsertBulk) ClearFileCount() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearFileCount()
+	})
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (u *AIPUpsertBulk) SetCustomMetadata(v map[string]interface{}) *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetCustomMetadata(v)
+	})
+}
+
+// UpdateCustomMetadata sets the "custom_metadata" field to the value that was provided on create.
+func (u *AIPUpsertBulk) UpdateCustomMetadata() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateCustomMetadata()
+	})
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (u *AIPUpsertBulk) ClearCustomMetadata() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearCustomMetadata()
+	})
+}
+
+// SetDisposalAt sets the "disposal_at" field.
+func (u *AIPUpsertBulk) SetDisposalAt(v time.Time) *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.SetDisposalAt(v)
+	})
+}
+
+// UpdateDisposalAt sets the "disposal_at" field to the value that was provided on create.
+func (u *AIPUpsertBulk) UpdateDisposalAt() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.UpdateDisposalAt()
+	})
+}
+
+// ClearDisposalAt clears the value of the "disposal_at" field.
+func (u *AIPUpsertBulk) ClearDisposalAt() *AIPUpsertBulk {
+	return u.Update(func(s *AIPUpsert) {
+		s.ClearDisposalAt()
+	})
+}
+
 // Exec executes the query.
 func (u *AIPUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
@@ -29,6 +32,9 @@ type AIPQuery struct {
 	withLocation         *LocationQuery
 	withWorkflows        *WorkflowQuery
 	withDeletionRequests *DeletionRequestQuery
+	withFixityChecks     *FixityCheckQuery
+	withReplicas         *AIPReplicaQuery
+	withLegalHolds       *AIPLegalHoldQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +137,72 @@ func (_q *AIPQuery) QueryDeletionRequests() *DeletionRequestQuery {
 	return query
 }
 
+// QueryFixityChecks chains the current query on the "fixity_checks" edge.
+func (_q *AIPQuery) QueryFixityChecks() *FixityCheckQuery {
+	query := (&FixityCheckClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, selector),
+			sqlgraph.To(fixitycheck.Table, fixitycheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, aip.FixityChecksTable, aip.FixityChecksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplicas chains the current query on the "replicas" edge.
+func (_q *AIPQuery) QueryReplicas() *AIPReplicaQuery {
+	query := (&AIPReplicaClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, selector),
+			sqlgraph.To(aipreplica.Table, aipreplica.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, aip.ReplicasTable, aip.ReplicasColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLegalHolds chains the current query on the "legal_holds" edge.
+func (_q *AIPQuery) QueryLegalHolds() *AIPLegalHoldQuery {
+	query := (&AIPLegalHoldClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, selector),
+			sqlgraph.To(aiplegalhold.Table, aiplegalhold.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, aip.LegalHoldsTable, aip.LegalHoldsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first AIP entity from the query.
 // Returns a *NotFoundError when no AIP was found.
 func (_q *AIPQuery) First(ctx context.Context) (*AIP, error) {
@@ -326,6 +398,9 @@ func (_q *AIPQuery) Clone() *AIPQuery {
 		withLocation:         _q.withLocation.Clone(),
 		withWorkflows:        _q.withWorkflows.Clone(),
 		withDeletionRequests: _q.withDeletionRequests.Clone(),
+		withFixityChecks:     _q.withFixityChecks.Clone(),
+		withReplicas:         _q.withReplicas.Clone(),
+		withLegalHolds:       _q.withLegalHolds.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +440,39 @@ func (_q *AIPQuery) WithDeletionRequests(opts ...func(*DeletionRequestQuery)) *A
 	return _q
 }
 
+// WithFixityChecks tells the query-builder to eager-load the nodes that are connected to
+// the "fixity_checks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AIPQuery) WithFixityChecks(opts ...func(*FixityCheckQuery)) *AIPQuery {
+	query := (&FixityCheckClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFixityChecks = query
+	return _q
+}
+
+// WithReplicas tells the query-builder to eager-load the nodes that are connected to
+// the "replicas" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AIPQuery) WithReplicas(opts ...func(*AIPReplicaQuery)) *AIPQuery {
+	query := (&AIPReplicaClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReplicas = query
+	return _q
+}
+
+// WithLegalHolds tells the query-builder to eager-load the nodes that are connected to
+// the "legal_holds" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AIPQuery) WithLegalHolds(opts ...func(*AIPLegalHoldQuery)) *AIPQuery {
+	query := (&AIPLegalHoldClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLegalHolds = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +551,13 @@ func (_q *AIPQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AIP, err
 	var (
 		nodes       = []*AIP{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			_q.withLocation != nil,
 			_q.withWorkflows != nil,
 			_q.withDeletionRequests != nil,
+			_q.withFixityChecks != nil,
+			_q.withReplicas != nil,
+			_q.withLegalHolds != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -487,6 +598,27 @@ func (_q *AIPQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AIP, err
 			return nil, err
 		}
 	}
+	if query := _q.withFixityChecks; query != nil {
+		if err := _q.loadFixityChecks(ctx, query, nodes,
+			func(n *AIP) { n.Edges.FixityChecks = []*FixityCheck{} },
+			func(n *AIP, e *FixityCheck) { n.Edges.FixityChecks = append(n.Edges.FixityChecks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReplicas; query != nil {
+		if err := _q.loadReplicas(ctx, query, nodes,
+			func(n *AIP) { n.Edges.Replicas = []*AIPReplica{} },
+			func(n *AIP, e *AIPReplica) { n.Edges.Replicas = append(n.Edges.Replicas, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLegalHolds; query != nil {
+		if err := _q.loadLegalHolds(ctx, query, nodes,
+			func(n *AIP) { n.Edges.LegalHolds = []*AIPLegalHold{} },
+			func(n *AIP, e *AIPLegalHold) { n.Edges.LegalHolds = append(n.Edges.LegalHolds, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -579,6 +711,96 @@ func (_q *AIPQuery) loadDeletionRequests(ctx context.Context, query *DeletionReq
 	}
 	return nil
 }
+func (_q *AIPQuery) loadFixityChecks(ctx context.Context, query *FixityCheckQuery, nodes []*AIP, init func(*AIP), assign func(*AIP, *FixityCheck)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AIP)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(fixitycheck.FieldAipID)
+	}
+	query.Where(predicate.FixityCheck(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(aip.FixityChecksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AipID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "aip_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AIPQuery) loadReplicas(ctx context.Context, query *AIPReplicaQuery, nodes []*AIP, init func(*AIP), assign func(*AIP, *AIPReplica)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AIP)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(aipreplica.FieldAipID)
+	}
+	query.Where(predicate.AIPReplica(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(aip.ReplicasColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AipID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "aip_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AIPQuery) loadLegalHolds(ctx context.Context, query *AIPLegalHoldQuery, nodes []*AIP, init func(*AIP), assign func(*AIP, *AIPLegalHold)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AIP)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(aiplegalhold.FieldAipID)
+	}
+	query.Where(predicate.AIPLegalHold(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(aip.LegalHoldsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AipID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "aip_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AIPQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,13 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
@@ -128,6 +132,132 @@ func (_u *AIPUpdate) ClearDeletionReportKey() *AIPUpdate {
 	return _u
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (_u *AIPUpdate) SetChecksumAlgorithm(v string) *AIPUpdate {
+	_u.mutation.SetChecksumAlgorithm(v)
+	return _u
+}
+
+// SetNillableChecksumAlgorithm sets the "checksum_algorithm" field if the given value is not nil.
+func (_u *AIPUpdate) SetNillableChecksumAlgorithm(v *string) *AIPUpdate {
+	if v != nil {
+		_u.SetChecksumAlgorithm(*v)
+	}
+	return _u
+}
+
+// ClearChecksumAlgorithm clears the value of the "checksum_algorithm" field.
+func (_u *AIPUpdate) ClearChecksumAlgorithm() *AIPUpdate {
+	_u.mutation.ClearChecksumAlgorithm()
+	return _u
+}
+
+// SetChecksumHash sets the "checksum_hash" field.
+func (_u *AIPUpdate) SetChecksumHash(v string) *AIPUpdate {
+	_u.mutation.SetChecksumHash(v)
+	return _u
+}
+
+// SetNillableChecksumHash sets the "checksum_hash" field if the given value is not nil.
+func (_u *AIPUpdate) SetNillableChecksumHash(v *string) *AIPUpdate {
+	if v != nil {
+		_u.SetChecksumHash(*v)
+	}
+	return _u
+}
+
+// ClearChecksumHash clears the value of the "checksum_hash" field.
+func (_u *AIPUpdate) ClearChecksumHash() *AIPUpdate {
+	_u.mutation.ClearChecksumHash()
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AIPUpdate) SetSize(v int64) *AIPUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AIPUpdate) SetNillableSize(v *int64) *AIPUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AIPUpdate) AddSize(v int64) *AIPUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// ClearSize clears the value of the "size" field.
+func (_u *AIPUpdate) ClearSize() *AIPUpdate {
+	_u.mutation.ClearSize()
+	return _u
+}
+
+// SetFileCount sets the "file_count" field.
+func (_u *AIPUpdate) SetFileCount(v int) *AIPUpdate {
+	_u.mutation.ResetFileCount()
+	_u.mutation.SetFileCount(v)
+	return _u
+}
+
+// SetNillableFileCount sets the "file_count" field if the given value is not nil.
+func (_u *AIPUpdate) SetNillableFileCount(v *int) *AIPUpdate {
+	if v != nil {
+		_u.SetFileCount(*v)
+	}
+	return _u
+}
+
+// AddFileCount adds value to the "file_count" field.
+func (_u *AIPUpdate) AddFileCount(v int) *AIPUpdate {
+	_u.mutation.AddFileCount(v)
+	return _u
+}
+
+// ClearFileCount clears the value of the "file_count" field.
+func (_u *AIPUpdate) ClearFileCount() *AIPUpdate {
+	_u.mutation.ClearFileCount()
+	return _u
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (_u *AIPUpdate) SetCustomMetadata(v map[string]interface{}) *AIPUpdate {
+	_u.mutation.SetCustomMetadata(v)
+	return _u
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (_u *AIPUpdate) ClearCustomMetadata() *AIPUpdate {
+	_u.mutation.ClearCustomMetadata()
+	return _u
+}
+
+// SetDisposalAt sets the "disposal_at" field.
+func (_u *AIPUpdate) SetDisposalAt(v time.Time) *AIPUpdate {
+	_u.mutation.SetDisposalAt(v)
+	return _u
+}
+
+// SetNillableDisposalAt sets the "disposal_at" field if the given value is not nil.
+func (_u *AIPUpdate) SetNillableDisposalAt(v *time.Time) *AIPUpdate {
+	if v != nil {
+		_u.SetDisposalAt(*v)
+	}
+	return _u
+}
+
+// ClearDisposalAt clears the value of the "disposal_at" field.
+func (_u *AIPUpdate) ClearDisposalAt() *AIPUpdate {
+	_u.mutation.ClearDisposalAt()
+	return _u
+}
+
 // SetLocation sets the "location" edge to the Location entity.
 func (_u *AIPUpdate) SetLocation(v *Location) *AIPUpdate {
 	return _u.SetLocationID(v.ID)
@@ -163,6 +293,51 @@ func (_u *AIPUpdate) AddDeletionRequests(v ...*DeletionRequest) *AIPUpdate {
 	return _u.AddDeletionRequestIDs(ids...)
 }
 
+// AddFixityCheckIDs adds the "fixity_checks" edge to the FixityCheck entity by IDs.
+func (_u *AIPUpdate) AddFixityCheckIDs(ids ...int) *AIPUpdate {
+	_u.mutation.AddFixityCheckIDs(ids...)
+	return _u
+}
+
+// AddFixityChecks adds the "fixity_checks" edges to the FixityCheck entity.
+func (_u *AIPUpdate) AddFixityChecks(v ...*FixityCheck) *AIPUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFixityCheckIDs(ids...)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the AIPReplica entity by IDs.
+func (_u *AIPUpdate) AddReplicaIDs(ids ...int) *AIPUpdate {
+	_u.mutation.AddReplicaIDs(ids...)
+	return _u
+}
+
+// AddReplicas adds the "replicas" edges to the AIPReplica entity.
+func (_u *AIPUpdate) AddReplicas(v ...*AIPReplica) *AIPUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplicaIDs(ids...)
+}
+
+// AddLegalHoldIDs adds the "legal_holds" edge to the AIPLegalHold entity by IDs.
+func (_u *AIPUpdate) AddLegalHoldIDs(ids ...int) *AIPUpdate {
+	_u.mutation.AddLegalHoldIDs(ids...)
+	return _u
+}
+
+// AddLegalHolds adds the "legal_holds" edges to the AIPLegalHold entity.
+func (_u *AIPUpdate) AddLegalHolds(v ...*AIPLegalHold) *AIPUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLegalHoldIDs(ids...)
+}
+
 // Mutation returns the AIPMutation object of the builder.
 func (_u *AIPUpdate) Mutation() *AIPMutation {
 	return _u.mutation
@@ -216,6 +391,69 @@ func (_u *AIPUpdate) RemoveDeletionRequests(v ...*DeletionRequest) *AIPUpdate {
 	return _u.RemoveDeletionRequestIDs(ids...)
 }
 
+// ClearFixityChecks clears all "fixity_checks" edges to the FixityCheck entity.
+func (_u *AIPUpdate) ClearFixityChecks() *AIPUpdate {
+	_u.mutation.ClearFixityChecks()
+	return _u
+}
+
+// RemoveFixityCheckIDs removes the "fixity_checks" edge to FixityCheck entities by IDs.
+func (_u *AIPUpdate) RemoveFixityCheckIDs(ids ...int) *AIPUpdate {
+	_u.mutation.RemoveFixityCheckIDs(ids...)
+	return _u
+}
+
+// RemoveFixityChecks removes "fixity_checks" edges to FixityCheck entities.
+func (_u *AIPUpdate) RemoveFixityChecks(v ...*FixityCheck) *AIPUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFixityCheckIDs(ids...)
+}
+
+// ClearReplicas clears all "replicas" edges to the AIPReplica entity.
+func (_u *AIPUpdate) ClearReplicas() *AIPUpdate {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// RemoveReplicaIDs removes the "replicas" edge to AIPReplica entities by IDs.
+func (_u *AIPUpdate) RemoveReplicaIDs(ids ...int) *AIPUpdate {
+	_u.mutation.RemoveReplicaIDs(ids...)
+	return _u
+}
+
+// RemoveReplicas removes "replicas" edges to AIPReplica entities.
+func (_u *AIPUpdate) RemoveReplicas(v ...*AIPReplica) *AIPUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplicaIDs(ids...)
+}
+
+// ClearLegalHolds clears all "legal_holds" edges to the AIPLegalHold entity.
+func (_u *AIPUpdate) ClearLegalHolds() *AIPUpdate {
+	_u.mutation.ClearLegalHolds()
+	return _u
+}
+
+// RemoveLegalHoldIDs removes the "legal_holds" edge to AIPLegalHold entities by IDs.
+func (_u *AIPUpdate) RemoveLegalHoldIDs(ids ...int) *AIPUpdate {
+	_u.mutation.RemoveLegalHoldIDs(ids...)
+	return _u
+}
+
+// RemoveLegalHolds removes "legal_holds" edges to AIPLegalHold entities.
+func (_u *AIPUpdate) RemoveLegalHolds(v ...*AIPLegalHold) *AIPUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLegalHoldIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AIPUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -283,6 +521,48 @@ func (_u *AIPUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DeletionReportKeyCleared() {
 		_spec.ClearField(aip.FieldDeletionReportKey, field.TypeString)
 	}
+	if value, ok := _u.mutation.ChecksumAlgorithm(); ok {
+		_spec.SetField(aip.FieldChecksumAlgorithm, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumAlgorithmCleared() {
+		_spec.ClearField(aip.FieldChecksumAlgorithm, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChecksumHash(); ok {
+		_spec.SetField(aip.FieldChecksumHash, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumHashCleared() {
+		_spec.ClearField(aip.FieldChecksumHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(aip.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(aip.FieldSize, field.TypeInt64, value)
+	}
+	if _u.mutation.SizeCleared() {
+		_spec.ClearField(aip.FieldSize, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.FileCount(); ok {
+		_spec.SetField(aip.FieldFileCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFileCount(); ok {
+		_spec.AddField(aip.FieldFileCount, field.TypeInt, value)
+	}
+	if _u.mutation.FileCountCleared() {
+		_spec.ClearField(aip.FieldFileCount, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CustomMetadata(); ok {
+		_spec.SetField(aip.FieldCustomMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.CustomMetadataCleared() {
+		_spec.ClearField(aip.FieldCustomMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DisposalAt(); ok {
+		_spec.SetField(aip.FieldDisposalAt, field.TypeTime, value)
+	}
+	if _u.mutation.DisposalAtCleared() {
+		_spec.ClearField(aip.FieldDisposalAt, field.TypeTime)
+	}
 	if _u.mutation.LocationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -402,6 +682,141 @@ func (_u *AIPUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FixityChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.FixityChecksTable,
+			Columns: []string{aip.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFixityChecksIDs(); len(nodes) > 0 && !_u.mutation.FixityChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.FixityChecksTable,
+			Columns: []string{aip.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FixityChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.FixityChecksTable,
+			Columns: []string{aip.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.ReplicasTable,
+			Columns: []string{aip.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReplicasIDs(); len(nodes) > 0 && !_u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.ReplicasTable,
+			Columns: []string{aip.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.ReplicasTable,
+			Columns: []string{aip.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.LegalHoldsTable,
+			Columns: []string{aip.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aiplegalhold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLegalHoldsIDs(); len(nodes) > 0 && !_u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.LegalHoldsTable,
+			Columns: []string{aip.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aiplegalhold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.LegalHoldsTable,
+			Columns: []string{aip.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aiplegalhold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{aip.Label}
@@ -518,6 +933,132 @@ func (_u *AIPUpdateOne) ClearDeletionReportKey() *AIPUpdateOne {
 	return _u
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (_u *AIPUpdateOne) SetChecksumAlgorithm(v string) *AIPUpdateOne {
+	_u.mutation.SetChecksumAlgorithm(v)
+	return _u
+}
+
+// SetNillableChecksumAlgorithm sets the "checksum_algorithm" field if the given value is not nil.
+func (_u *AIPUpdateOne) SetNillableChecksumAlgorithm(v *string) *AIPUpdateOne {
+	if v != nil {
+		_u.SetChecksumAlgorithm(*v)
+	}
+	return _u
+}
+
+// ClearChecksumAlgorithm clears the value of the "checksum_algorithm" field.
+func (_u *AIPUpdateOne) ClearChecksumAlgorithm() *AIPUpdateOne {
+	_u.mutation.ClearChecksumAlgorithm()
+	return _u
+}
+
+// SetChecksumHash sets the "checksum_hash" field.
+func (_u *AIPUpdateOne) SetChecksumHash(v string) *AIPUpdateOne {
+	_u.mutation.SetChecksumHash(v)
+	return _u
+}
+
+// SetNillableChecksumHash sets the "checksum_hash" field if the given value is not nil.
+func (_u *AIPUpdateOne) SetNillableChecksumHash(v *string) *AIPUpdateOne {
+	if v != nil {
+		_u.SetChecksumHash(*v)
+	}
+	return _u
+}
+
+// ClearChecksumHash clears the value of the "checksum_hash" field.
+func (_u *AIPUpdateOne) ClearChecksumHash() *AIPUpdateOne {
+	_u.mutation.ClearChecksumHash()
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AIPUpdateOne) SetSize(v int64) *AIPUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AIPUpdateOne) SetNillableSize(v *int64) *AIPUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AIPUpdateOne) AddSize(v int64) *AIPUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// ClearSize clears the value of the "size" field.
+func (_u *AIPUpdateOne) ClearSize() *AIPUpdateOne {
+	_u.mutation.ClearSize()
+	return _u
+}
+
+// SetFileCount sets the "file_count" field.
+func (_u *AIPUpdateOne) SetFileCount(v int) *AIPUpdateOne {
+	_u.mutation.ResetFileCount()
+	_u.mutation.SetFileCount(v)
+	return _u
+}
+
+// SetNillableFileCount sets the "file_count" field if the given value is not nil.
+func (_u *AIPUpdateOne) SetNillableFileCount(v *int) *AIPUpdateOne {
+	if v != nil {
+		_u.SetFileCount(*v)
+	}
+	return _u
+}
+
+// AddFileCount adds value to the "file_count" field.
+func (_u *AIPUpdateOne) AddFileCount(v int) *AIPUpdateOne {
+	_u.mutation.AddFileCount(v)
+	return _u
+}
+
+// ClearFileCount clears the value of the "file_count" field.
+func (_u *AIPUpdateOne) ClearFileCount() *AIPUpdateOne {
+	_u.mutation.ClearFileCount()
+	return _u
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (_u *AIPUpdateOne) SetCustomMetadata(v map[string]interface{}) *AIPUpdateOne {
+	_u.mutation.SetCustomMetadata(v)
+	return _u
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (_u *AIPUpdateOne) ClearCustomMetadata() *AIPUpdateOne {
+	_u.mutation.ClearCustomMetadata()
+	return _u
+}
+
+// SetDisposalAt sets the "disposal_at" field.
+func (_u *AIPUpdateOne) SetDisposalAt(v time.Time) *AIPUpdateOne {
+	_u.mutation.SetDisposalAt(v)
+	return _u
+}
+
+// SetNillableDisposalAt sets the "disposal_at" field if the given value is not nil.
+func (_u *AIPUpdateOne) SetNillableDisposalAt(v *time.Time) *AIPUpdateOne {
+	if v != nil {
+		_u.SetDisposalAt(*v)
+	}
+	return _u
+}
+
+// ClearDisposalAt clears the value of the "disposal_at" field.
+func (_u *AIPUpdateOne) ClearDisposalAt() *AIPUpdateOne {
+	_u.mutation.ClearDisposalAt()
+	return _u
+}
+
 // SetLocation sets the "location" edge to the Location entity.
 func (_u *AIPUpdateOne) SetLocation(v *Location) *AIPUpdateOne {
 	return _u.SetLocationID(v.ID)
@@ -553,6 +1094,51 @@ func (_u *AIPUpdateOne) AddDeletionRequests(v ...*DeletionRequest) *AIPUpdateOne
 	return _u.AddDeletionRequestIDs(ids...)
 }
 
+// AddFixityCheckIDs adds the "fixity_checks" edge to the FixityCheck entity by IDs.
+func (_u *AIPUpdateOne) AddFixityCheckIDs(ids ...int) *AIPUpdateOne {
+	_u.mutation.AddFixityCheckIDs(ids...)
+	return _u
+}
+
+// AddFixityChecks adds the "fixity_checks" edges to the FixityCheck entity.
+func (_u *AIPUpdateOne) AddFixityChecks(v ...*FixityCheck) *AIPUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFixityCheckIDs(ids...)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the AIPReplica entity by IDs.
+func (_u *AIPUpdateOne) AddReplicaIDs(ids ...int) *AIPUpdateOne {
+	_u.mutation.AddReplicaIDs(ids...)
+	return _u
+}
+
+// AddReplicas adds the "replicas" edges to the AIPReplica entity.
+func (_u *AIPUpdateOne) AddReplicas(v ...*AIPReplica) *AIPUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplicaIDs(ids...)
+}
+
+// AddLegalHoldIDs adds the "legal_holds" edge to the AIPLegalHold entity by IDs.
+func (_u *AIPUpdateOne) AddLegalHoldIDs(ids ...int) *AIPUpdateOne {
+	_u.mutation.AddLegalHoldIDs(ids...)
+	return _u
+}
+
+// AddLegalHolds adds the "legal_holds" edges to the AIPLegalHold entity.
+func (_u *AIPUpdateOne) AddLegalHolds(v ...*AIPLegalHold) *AIPUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLegalHoldIDs(ids...)
+}
+
 // Mutation returns the AIPMutation object of the builder.
 func (_u *AIPUpdateOne) Mutation() *AIPMutation {
 	return _u.mutation
@@ -606,6 +1192,69 @@ func (_u *AIPUpdateOne) RemoveDeletionRequests(v ...*DeletionRequest) *AIPUpdate
 	return _u.RemoveDeletionRequestIDs(ids...)
 }
 
+// ClearFixityChecks clears all "fixity_checks" edges to the FixityCheck entity.
+func (_u *AIPUpdateOne) ClearFixityChecks() *AIPUpdateOne {
+	_u.mutation.ClearFixityChecks()
+	return _u
+}
+
+// RemoveFixityCheckIDs removes the "fixity_checks" edge to FixityCheck entities by IDs.
+func (_u *AIPUpdateOne) RemoveFixityCheckIDs(ids ...int) *AIPUpdateOne {
+	_u.mutation.RemoveFixityCheckIDs(ids...)
+	return _u
+}
+
+// RemoveFixityChecks removes "fixity_checks" edges to FixityCheck entities.
+func (_u *AIPUpdateOne) RemoveFixityChecks(v ...*FixityCheck) *AIPUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFixityCheckIDs(ids...)
+}
+
+// ClearReplicas clears all "replicas" edges to the AIPReplica entity.
+func (_u *AIPUpdateOne) ClearReplicas() *AIPUpdateOne {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// RemoveReplicaIDs removes the "replicas" edge to AIPReplica entities by IDs.
+func (_u *AIPUpdateOne) RemoveReplicaIDs(ids ...int) *AIPUpdateOne {
+	_u.mutation.RemoveReplicaIDs(ids...)
+	return _u
+}
+
+// RemoveReplicas removes "replicas" edges to AIPReplica entities.
+func (_u *AIPUpdateOne) RemoveReplicas(v ...*AIPReplica) *AIPUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplicaIDs(ids...)
+}
+
+// ClearLegalHolds clears all "legal_holds" edges to the AIPLegalHold entity.
+func (_u *AIPUpdateOne) ClearLegalHolds() *AIPUpdateOne {
+	_u.mutation.ClearLegalHolds()
+	return _u
+}
+
+// RemoveLegalHoldIDs removes the "legal_holds" edge to AIPLegalHold entities by IDs.
+func (_u *AIPUpdateOne) RemoveLegalHoldIDs(ids ...int) *AIPUpdateOne {
+	_u.mutation.RemoveLegalHoldIDs(ids...)
+	return _u
+}
+
+// RemoveLegalHolds removes "legal_holds" edges to AIPLegalHold entities.
+func (_u *AIPUpdateOne) RemoveLegalHolds(v ...*AIPLegalHold) *AIPUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLegalHoldIDs(ids...)
+}
+
 // Where appends a list predicates to the AIPUpdate builder.
 func (_u *AIPUpdateOne) Where(ps ...predicate.AIP) *AIPUpdateOne {
 	_u.mutation.Where(ps...)
@@ -703,6 +1352,48 @@ func (_u *AIPUpdateOne) sqlSave(ctx context.Context) (_node *AIP, err error) {
 	if _u.mutation.DeletionReportKeyCleared() {
 		_spec.ClearField(aip.FieldDeletionReportKey, field.TypeString)
 	}
+	if value, ok := _u.mutation.ChecksumAlgorithm(); ok {
+		_spec.SetField(aip.FieldChecksumAlgorithm, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumAlgorithmCleared() {
+		_spec.ClearField(aip.FieldChecksumAlgorithm, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChecksumHash(); ok {
+		_spec.SetField(aip.FieldChecksumHash, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumHashCleared() {
+		_spec.ClearField(aip.FieldChecksumHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(aip.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(aip.FieldSize, field.TypeInt64, value)
+	}
+	if _u.mutation.SizeCleared() {
+		_spec.ClearField(aip.FieldSize, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.FileCount(); ok {
+		_spec.SetField(aip.FieldFileCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFileCount(); ok {
+		_spec.AddField(aip.FieldFileCount, field.TypeInt, value)
+	}
+	if _u.mutation.FileCountCleared() {
+		_spec.ClearField(aip.FieldFileCount, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CustomMetadata(); ok {
+		_spec.SetField(aip.FieldCustomMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.CustomMetadataCleared() {
+		_spec.ClearField(aip.FieldCustomMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DisposalAt(); ok {
+		_spec.SetField(aip.FieldDisposalAt, field.TypeTime, value)
+	}
+	if _u.mutation.DisposalAtCleared() {
+		_spec.ClearField(aip.FieldDisposalAt, field.TypeTime)
+	}
 	if _u.mutation.LocationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -822,6 +1513,141 @@ func (_u *AIPUpdateOne) sqlSave(ctx context.Context) (_node *AIP, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FixityChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.FixityChecksTable,
+			Columns: []string{aip.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFixityChecksIDs(); len(nodes) > 0 && !_u.mutation.FixityChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.FixityChecksTable,
+			Columns: []string{aip.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FixityChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.FixityChecksTable,
+			Columns: []string{aip.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.ReplicasTable,
+			Columns: []string{aip.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReplicasIDs(); len(nodes) > 0 && !_u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.ReplicasTable,
+			Columns: []string{aip.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.ReplicasTable,
+			Columns: []string{aip.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.LegalHoldsTable,
+			Columns: []string{aip.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aiplegalhold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLegalHoldsIDs(); len(nodes) > 0 && !_u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.LegalHoldsTable,
+			Columns: []string{aip.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aiplegalhold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   aip.LegalHoldsTable,
+			Columns: []string{aip.LegalHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aiplegalhold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &AIP{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
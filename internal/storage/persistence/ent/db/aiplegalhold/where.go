@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package aiplegalhold
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldID, id))
+}
+
+// UUID applies equality check predicate on the "uuid" field. It's identical to UUIDEQ.
+func UUID(v uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldUUID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldReason, v))
+}
+
+// PlacedBy applies equality check predicate on the "placed_by" field. It's identical to PlacedByEQ.
+func PlacedBy(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldPlacedBy, v))
+}
+
+// PlacedAt applies equality check predicate on the "placed_at" field. It's identical to PlacedAtEQ.
+func PlacedAt(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldPlacedAt, v))
+}
+
+// ReleasedBy applies equality check predicate on the "released_by" field. It's identical to ReleasedByEQ.
+func ReleasedBy(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldReleasedBy, v))
+}
+
+// ReleaseReason applies equality check predicate on the "release_reason" field. It's identical to ReleaseReasonEQ.
+func ReleaseReason(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldReleaseReason, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// AipID applies equality check predicate on the "aip_id" field. It's identical to AipIDEQ.
+func AipID(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldAipID, v))
+}
+
+// ActiveAipID applies equality check predicate on the "active_aip_id" field. It's identical to ActiveAipIDEQ.
+func ActiveAipID(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldActiveAipID, v))
+}
+
+// UUIDEQ applies the EQ predicate on the "uuid" field.
+func UUIDEQ(v uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldUUID, v))
+}
+
+// UUIDNEQ applies the NEQ predicate on the "uuid" field.
+func UUIDNEQ(v uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldUUID, v))
+}
+
+// UUIDIn applies the In predicate on the "uuid" field.
+func UUIDIn(vs ...uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldUUID, vs...))
+}
+
+// UUIDNotIn applies the NotIn predicate on the "uuid" field.
+func UUIDNotIn(vs ...uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldUUID, vs...))
+}
+
+// UUIDGT applies the GT predicate on the "uuid" field.
+func UUIDGT(v uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldUUID, v))
+}
+
+// UUIDGTE applies the GTE predicate on the "uuid" field.
+func UUIDGTE(v uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldUUID, v))
+}
+
+// UUIDLT applies the LT predicate on the "uuid" field.
+func UUIDLT(v uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldUUID, v))
+}
+
+// UUIDLTE applies the LTE predicate on the "uuid" field.
+func UUIDLTE(v uuid.UUID) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldUUID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldContainsFold(FieldReason, v))
+}
+
+// PlacedByEQ applies the EQ predicate on the "placed_by" field.
+func PlacedByEQ(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldPlacedBy, v))
+}
+
+// PlacedByNEQ applies the NEQ predicate on the "placed_by" field.
+func PlacedByNEQ(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldPlacedBy, v))
+}
+
+// PlacedByIn applies the In predicate on the "placed_by" field.
+func PlacedByIn(vs ...string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldPlacedBy, vs...))
+}
+
+// PlacedByNotIn applies the NotIn predicate on the "placed_by" field.
+func PlacedByNotIn(vs ...string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldPlacedBy, vs...))
+}
+
+// PlacedByGT applies the GT predicate on the "placed_by" field.
+func PlacedByGT(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldPlacedBy, v))
+}
+
+// PlacedByGTE applies the GTE predicate on the "placed_by" field.
+func PlacedByGTE(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldPlacedBy, v))
+}
+
+// PlacedByLT applies the LT predicate on the "placed_by" field.
+func PlacedByLT(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldPlacedBy, v))
+}
+
+// PlacedByLTE applies the LTE predicate on the "placed_by" field.
+func PlacedByLTE(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldPlacedBy, v))
+}
+
+// PlacedByContains applies the Contains predicate on the "placed_by" field.
+func PlacedByContains(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldContains(FieldPlacedBy, v))
+}
+
+// PlacedByHasPrefix applies the HasPrefix predicate on the "placed_by" field.
+func PlacedByHasPrefix(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldHasPrefix(FieldPlacedBy, v))
+}
+
+// PlacedByHasSuffix applies the HasSuffix predicate on the "placed_by" field.
+func PlacedByHasSuffix(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldHasSuffix(FieldPlacedBy, v))
+}
+
+// PlacedByEqualFold applies the EqualFold predicate on the "placed_by" field.
+func PlacedByEqualFold(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEqualFold(FieldPlacedBy, v))
+}
+
+// PlacedByContainsFold applies the ContainsFold predicate on the "placed_by" field.
+func PlacedByContainsFold(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldContainsFold(FieldPlacedBy, v))
+}
+
+// PlacedAtEQ applies the EQ predicate on the "placed_at" field.
+func PlacedAtEQ(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldPlacedAt, v))
+}
+
+// PlacedAtNEQ applies the NEQ predicate on the "placed_at" field.
+func PlacedAtNEQ(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldPlacedAt, v))
+}
+
+// PlacedAtIn applies the In predicate on the "placed_at" field.
+func PlacedAtIn(vs ...time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldPlacedAt, vs...))
+}
+
+// PlacedAtNotIn applies the NotIn predicate on the "placed_at" field.
+func PlacedAtNotIn(vs ...time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldPlacedAt, vs...))
+}
+
+// PlacedAtGT applies the GT predicate on the "placed_at" field.
+func PlacedAtGT(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldPlacedAt, v))
+}
+
+// PlacedAtGTE applies the GTE predicate on the "placed_at" field.
+func PlacedAtGTE(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldPlacedAt, v))
+}
+
+// PlacedAtLT applies the LT predicate on the "placed_at" field.
+func PlacedAtLT(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldPlacedAt, v))
+}
+
+// PlacedAtLTE applies the LTE predicate on the "placed_at" field.
+func PlacedAtLTE(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldPlacedAt, v))
+}
+
+// ReleasedByEQ applies the EQ predicate on the "released_by" field.
+func ReleasedByEQ(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldReleasedBy, v))
+}
+
+// ReleasedByNEQ applies the NEQ predicate on the "released_by" field.
+func ReleasedByNEQ(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldReleasedBy, v))
+}
+
+// ReleasedByIn applies the In predicate on the "released_by" field.
+func ReleasedByIn(vs ...string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldReleasedBy, vs...))
+}
+
+// ReleasedByNotIn applies the NotIn predicate on the "released_by" field.
+func ReleasedByNotIn(vs ...string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldReleasedBy, vs...))
+}
+
+// ReleasedByGT applies the GT predicate on the "released_by" field.
+func ReleasedByGT(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldReleasedBy, v))
+}
+
+// ReleasedByGTE applies the GTE predicate on the "released_by" field.
+func ReleasedByGTE(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldReleasedBy, v))
+}
+
+// ReleasedByLT applies the LT predicate on the "released_by" field.
+func ReleasedByLT(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldReleasedBy, v))
+}
+
+// ReleasedByLTE applies the LTE predicate on the "released_by" field.
+func ReleasedByLTE(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldReleasedBy, v))
+}
+
+// ReleasedByContains applies the Contains predicate on the "released_by" field.
+func ReleasedByContains(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldContains(FieldReleasedBy, v))
+}
+
+// ReleasedByHasPrefix applies the HasPrefix predicate on the "released_by" field.
+func ReleasedByHasPrefix(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldHasPrefix(FieldReleasedBy, v))
+}
+
+// ReleasedByHasSuffix applies the HasSuffix predicate on the "released_by" field.
+func ReleasedByHasSuffix(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldHasSuffix(FieldReleasedBy, v))
+}
+
+// ReleasedByIsNil applies the IsNil predicate on the "released_by" field.
+func ReleasedByIsNil() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIsNull(FieldReleasedBy))
+}
+
+// ReleasedByNotNil applies the NotNil predicate on the "released_by" field.
+func ReleasedByNotNil() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotNull(FieldReleasedBy))
+}
+
+// ReleasedByEqualFold applies the EqualFold predicate on the "released_by" field.
+func ReleasedByEqualFold(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEqualFold(FieldReleasedBy, v))
+}
+
+// ReleasedByContainsFold applies the ContainsFold predicate on the "released_by" field.
+func ReleasedByContainsFold(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldContainsFold(FieldReleasedBy, v))
+}
+
+// ReleaseReasonEQ applies the EQ predicate on the "release_reason" field.
+func ReleaseReasonEQ(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldReleaseReason, v))
+}
+
+// ReleaseReasonNEQ applies the NEQ predicate on the "release_reason" field.
+func ReleaseReasonNEQ(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldReleaseReason, v))
+}
+
+// ReleaseReasonIn applies the In predicate on the "release_reason" field.
+func ReleaseReasonIn(vs ...string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldReleaseReason, vs...))
+}
+
+// ReleaseReasonNotIn applies the NotIn predicate on the "release_reason" field.
+func ReleaseReasonNotIn(vs ...string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldReleaseReason, vs...))
+}
+
+// ReleaseReasonGT applies the GT predicate on the "release_reason" field.
+func ReleaseReasonGT(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldReleaseReason, v))
+}
+
+// ReleaseReasonGTE applies the GTE predicate on the "release_reason" field.
+func ReleaseReasonGTE(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldReleaseReason, v))
+}
+
+// ReleaseReasonLT applies the LT predicate on the "release_reason" field.
+func ReleaseReasonLT(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldReleaseReason, v))
+}
+
+// ReleaseReasonLTE applies the LTE predicate on the "release_reason" field.
+func ReleaseReasonLTE(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldReleaseReason, v))
+}
+
+// ReleaseReasonContains applies the Contains predicate on the "release_reason" field.
+func ReleaseReasonContains(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldContains(FieldReleaseReason, v))
+}
+
+// ReleaseReasonHasPrefix applies the HasPrefix predicate on the "release_reason" field.
+func ReleaseReasonHasPrefix(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldHasPrefix(FieldReleaseReason, v))
+}
+
+// ReleaseReasonHasSuffix applies the HasSuffix predicate on the "release_reason" field.
+func ReleaseReasonHasSuffix(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldHasSuffix(FieldReleaseReason, v))
+}
+
+// ReleaseReasonIsNil applies the IsNil predicate on the "release_reason" field.
+func ReleaseReasonIsNil() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIsNull(FieldReleaseReason))
+}
+
+// ReleaseReasonNotNil applies the NotNil predicate on the "release_reason" field.
+func ReleaseReasonNotNil() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotNull(FieldReleaseReason))
+}
+
+// ReleaseReasonEqualFold applies the EqualFold predicate on the "release_reason" field.
+func ReleaseReasonEqualFold(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEqualFold(FieldReleaseReason, v))
+}
+
+// ReleaseReasonContainsFold applies the ContainsFold predicate on the "release_reason" field.
+func ReleaseReasonContainsFold(v string) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldContainsFold(FieldReleaseReason, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// AipIDEQ applies the EQ predicate on the "aip_id" field.
+func AipIDEQ(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldAipID, v))
+}
+
+// AipIDNEQ applies the NEQ predicate on the "aip_id" field.
+func AipIDNEQ(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldAipID, v))
+}
+
+// AipIDIn applies the In predicate on the "aip_id" field.
+func AipIDIn(vs ...int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldAipID, vs...))
+}
+
+// AipIDNotIn applies the NotIn predicate on the "aip_id" field.
+func AipIDNotIn(vs ...int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldAipID, vs...))
+}
+
+// ActiveAipIDEQ applies the EQ predicate on the "active_aip_id" field.
+func ActiveAipIDEQ(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldEQ(FieldActiveAipID, v))
+}
+
+// ActiveAipIDNEQ applies the NEQ predicate on the "active_aip_id" field.
+func ActiveAipIDNEQ(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNEQ(FieldActiveAipID, v))
+}
+
+// ActiveAipIDIn applies the In predicate on the "active_aip_id" field.
+func ActiveAipIDIn(vs ...int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIn(FieldActiveAipID, vs...))
+}
+
+// ActiveAipIDNotIn applies the NotIn predicate on the "active_aip_id" field.
+func ActiveAipIDNotIn(vs ...int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotIn(FieldActiveAipID, vs...))
+}
+
+// ActiveAipIDGT applies the GT predicate on the "active_aip_id" field.
+func ActiveAipIDGT(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGT(FieldActiveAipID, v))
+}
+
+// ActiveAipIDGTE applies the GTE predicate on the "active_aip_id" field.
+func ActiveAipIDGTE(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldGTE(FieldActiveAipID, v))
+}
+
+// ActiveAipIDLT applies the LT predicate on the "active_aip_id" field.
+func ActiveAipIDLT(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLT(FieldActiveAipID, v))
+}
+
+// ActiveAipIDLTE applies the LTE predicate on the "active_aip_id" field.
+func ActiveAipIDLTE(v int) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldLTE(FieldActiveAipID, v))
+}
+
+// ActiveAipIDIsNil applies the IsNil predicate on the "active_aip_id" field.
+func ActiveAipIDIsNil() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldIsNull(FieldActiveAipID))
+}
+
+// ActiveAipIDNotNil applies the NotNil predicate on the "active_aip_id" field.
+func ActiveAipIDNotNil() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.FieldNotNull(FieldActiveAipID))
+}
+
+// HasAip applies the HasEdge predicate on the "aip" edge.
+func HasAip() predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AipTable, AipColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAipWith applies the HasEdge predicate on the "aip" edge with a given conditions (other predicates).
+func HasAipWith(preds ...predicate.AIP) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(func(s *sql.Selector) {
+		step := newAipStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AIPLegalHold) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AIPLegalHold) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AIPLegalHold) predicate.AIPLegalHold {
+	return predicate.AIPLegalHold(sql.NotPredicates(p))
+}
@@ -0,0 +1,915 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/google/uuid"
+)
+
+// AIPLegalHoldCreate is the builder for creating a AIPLegalHold entity.
+type AIPLegalHoldCreate struct {
+	config
+	mutation *AIPLegalHoldMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUUID sets the "uuid" field.
+func (_c *AIPLegalHoldCreate) SetUUID(v uuid.UUID) *AIPLegalHoldCreate {
+	_c.mutation.SetUUID(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AIPLegalHoldCreate) SetReason(v string) *AIPLegalHoldCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetPlacedBy sets the "placed_by" field.
+func (_c *AIPLegalHoldCreate) SetPlacedBy(v string) *AIPLegalHoldCreate {
+	_c.mutation.SetPlacedBy(v)
+	return _c
+}
+
+// SetPlacedAt sets the "placed_at" field.
+func (_c *AIPLegalHoldCreate) SetPlacedAt(v time.Time) *AIPLegalHoldCreate {
+	_c.mutation.SetPlacedAt(v)
+	return _c
+}
+
+// SetNillablePlacedAt sets the "placed_at" field if the given value is not nil.
+func (_c *AIPLegalHoldCreate) SetNillablePlacedAt(v *time.Time) *AIPLegalHoldCreate {
+	if v != nil {
+		_c.SetPlacedAt(*v)
+	}
+	return _c
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (_c *AIPLegalHoldCreate) SetReleasedBy(v string) *AIPLegalHoldCreate {
+	_c.mutation.SetReleasedBy(v)
+	return _c
+}
+
+// SetNillableReleasedBy sets the "released_by" field if the given value is not nil.
+func (_c *AIPLegalHoldCreate) SetNillableReleasedBy(v *string) *AIPLegalHoldCreate {
+	if v != nil {
+		_c.SetReleasedBy(*v)
+	}
+	return _c
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (_c *AIPLegalHoldCreate) SetReleaseReason(v string) *AIPLegalHoldCreate {
+	_c.mutation.SetReleaseReason(v)
+	return _c
+}
+
+// SetNillableReleaseReason sets the "release_reason" field if the given value is not nil.
+func (_c *AIPLegalHoldCreate) SetNillableReleaseReason(v *string) *AIPLegalHoldCreate {
+	if v != nil {
+		_c.SetReleaseReason(*v)
+	}
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *AIPLegalHoldCreate) SetReleasedAt(v time.Time) *AIPLegalHoldCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *AIPLegalHoldCreate) SetNillableReleasedAt(v *time.Time) *AIPLegalHoldCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetAipID sets the "aip_id" field.
+func (_c *AIPLegalHoldCreate) SetAipID(v int) *AIPLegalHoldCreate {
+	_c.mutation.SetAipID(v)
+	return _c
+}
+
+// SetActiveAipID sets the "active_aip_id" field.
+func (_c *AIPLegalHoldCreate) SetActiveAipID(v int) *AIPLegalHoldCreate {
+	_c.mutation.SetActiveAipID(v)
+	return _c
+}
+
+// SetNillableActiveAipID sets the "active_aip_id" field if the given value is not nil.
+func (_c *AIPLegalHoldCreate) SetNillableActiveAipID(v *int) *AIPLegalHoldCreate {
+	if v != nil {
+		_c.SetActiveAipID(*v)
+	}
+	return _c
+}
+
+// SetAip sets the "aip" edge to the AIP entity.
+func (_c *AIPLegalHoldCreate) SetAip(v *AIP) *AIPLegalHoldCreate {
+	return _c.SetAipID(v.ID)
+}
+
+// Mutation returns the AIPLegalHoldMutation object of the builder.
+func (_c *AIPLegalHoldCreate) Mutation() *AIPLegalHoldMutation {
+	return _c.mutation
+}
+
+// Save creates the AIPLegalHold in the database.
+func (_c *AIPLegalHoldCreate) Save(ctx context.Context) (*AIPLegalHold, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AIPLegalHoldCreate) SaveX(ctx context.Context) *AIPLegalHold {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIPLegalHoldCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIPLegalHoldCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AIPLegalHoldCreate) defaults() {
+	if _, ok := _c.mutation.PlacedAt(); !ok {
+		v := aiplegalhold.DefaultPlacedAt()
+		_c.mutation.SetPlacedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AIPLegalHoldCreate) check() error {
+	if _, ok := _c.mutation.UUID(); !ok {
+		return &ValidationError{Name: "uuid", err: errors.New(`db: missing required field "AIPLegalHold.uuid"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`db: missing required field "AIPLegalHold.reason"`)}
+	}
+	if _, ok := _c.mutation.PlacedBy(); !ok {
+		return &ValidationError{Name: "placed_by", err: errors.New(`db: missing required field "AIPLegalHold.placed_by"`)}
+	}
+	if _, ok := _c.mutation.PlacedAt(); !ok {
+		return &ValidationError{Name: "placed_at", err: errors.New(`db: missing required field "AIPLegalHold.placed_at"`)}
+	}
+	if _, ok := _c.mutation.AipID(); !ok {
+		return &ValidationError{Name: "aip_id", err: errors.New(`db: missing required field "AIPLegalHold.aip_id"`)}
+	}
+	if v, ok := _c.mutation.AipID(); ok {
+		if err := aiplegalhold.AipIDValidator(v); err != nil {
+			return &ValidationError{Name: "aip_id", err: fmt.Errorf(`db: validator failed for field "AIPLegalHold.aip_id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AipIDs()) == 0 {
+		return &ValidationError{Name: "aip", err: errors.New(`db: missing required edge "AIPLegalHold.aip"`)}
+	}
+	return nil
+}
+
+func (_c *AIPLegalHoldCreate) sqlSave(ctx context.Context) (*AIPLegalHold, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AIPLegalHoldCreate) createSpec() (*AIPLegalHold, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AIPLegalHold{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(aiplegalhold.Table, sqlgraph.NewFieldSpec(aiplegalhold.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UUID(); ok {
+		_spec.SetField(aiplegalhold.FieldUUID, field.TypeUUID, value)
+		_node.UUID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(aiplegalhold.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.PlacedBy(); ok {
+		_spec.SetField(aiplegalhold.FieldPlacedBy, field.TypeString, value)
+		_node.PlacedBy = value
+	}
+	if value, ok := _c.mutation.PlacedAt(); ok {
+		_spec.SetField(aiplegalhold.FieldPlacedAt, field.TypeTime, value)
+		_node.PlacedAt = value
+	}
+	if value, ok := _c.mutation.ReleasedBy(); ok {
+		_spec.SetField(aiplegalhold.FieldReleasedBy, field.TypeString, value)
+		_node.ReleasedBy = value
+	}
+	if value, ok := _c.mutation.ReleaseReason(); ok {
+		_spec.SetField(aiplegalhold.FieldReleaseReason, field.TypeString, value)
+		_node.ReleaseReason = value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(aiplegalhold.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = value
+	}
+	if value, ok := _c.mutation.ActiveAipID(); ok {
+		_spec.SetField(aiplegalhold.FieldActiveAipID, field.TypeInt, value)
+		_node.ActiveAipID = &value
+	}
+	if nodes := _c.mutation.AipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aiplegalhold.AipTable,
+			Columns: []string{aiplegalhold.AipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AipID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AIPLegalHold.Create().
+//		SetUUID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AIPLegalHoldUpsert) {
+//			SetUUID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AIPLegalHoldCreate) OnConflict(opts ...sql.ConflictOption) *AIPLegalHoldUpsertOne {
+	_c.conflict = opts
+	return &AIPLegalHoldUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AIPLegalHold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AIPLegalHoldCreate) OnConflictColumns(columns ...string) *AIPLegalHoldUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AIPLegalHoldUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AIPLegalHoldUpsertOne is the builder for "upsert"-ing
+	//  one AIPLegalHold node.
+	AIPLegalHoldUpsertOne struct {
+		create *AIPLegalHoldCreate
+	}
+
+	// AIPLegalHoldUpsert is the "OnConflict" setter.
+	AIPLegalHoldUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUUID sets the "uuid" field.
+func (u *AIPLegalHoldUpsert) SetUUID(v uuid.UUID) *AIPLegalHoldUpsert {
+	u.Set(aiplegalhold.FieldUUID, v)
+	return u
+}
+
+// UpdateUUID sets the "uuid" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsert) UpdateUUID() *AIPLegalHoldUpsert {
+	u.SetExcluded(aiplegalhold.FieldUUID)
+	return u
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (u *AIPLegalHoldUpsert) SetReleasedBy(v string) *AIPLegalHoldUpsert {
+	u.Set(aiplegalhold.FieldReleasedBy, v)
+	return u
+}
+
+// UpdateReleasedBy sets the "released_by" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsert) UpdateReleasedBy() *AIPLegalHoldUpsert {
+	u.SetExcluded(aiplegalhold.FieldReleasedBy)
+	return u
+}
+
+// ClearReleasedBy clears the value of the "released_by" field.
+func (u *AIPLegalHoldUpsert) ClearReleasedBy() *AIPLegalHoldUpsert {
+	u.SetNull(aiplegalhold.FieldReleasedBy)
+	return u
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (u *AIPLegalHoldUpsert) SetReleaseReason(v string) *AIPLegalHoldUpsert {
+	u.Set(aiplegalhold.FieldReleaseReason, v)
+	return u
+}
+
+// UpdateReleaseReason sets the "release_reason" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsert) UpdateReleaseReason() *AIPLegalHoldUpsert {
+	u.SetExcluded(aiplegalhold.FieldReleaseReason)
+	return u
+}
+
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (u *AIPLegalHoldUpsert) ClearReleaseReason() *AIPLegalHoldUpsert {
+	u.SetNull(aiplegalhold.FieldReleaseReason)
+	return u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *AIPLegalHoldUpsert) SetReleasedAt(v time.Time) *AIPLegalHoldUpsert {
+	u.Set(aiplegalhold.FieldReleasedAt, v)
+	return u
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsert) UpdateReleasedAt() *AIPLegalHoldUpsert {
+	u.SetExcluded(aiplegalhold.FieldReleasedAt)
+	return u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *AIPLegalHoldUpsert) ClearReleasedAt() *AIPLegalHoldUpsert {
+	u.SetNull(aiplegalhold.FieldReleasedAt)
+	return u
+}
+
+// SetActiveAipID sets the "active_aip_id" field.
+func (u *AIPLegalHoldUpsert) SetActiveAipID(v int) *AIPLegalHoldUpsert {
+	u.Set(aiplegalhold.FieldActiveAipID, v)
+	return u
+}
+
+// UpdateActiveAipID sets the "active_aip_id" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsert) UpdateActiveAipID() *AIPLegalHoldUpsert {
+	u.SetExcluded(aiplegalhold.FieldActiveAipID)
+	return u
+}
+
+// AddActiveAipID adds v to the "active_aip_id" field.
+func (u *AIPLegalHoldUpsert) AddActiveAipID(v int) *AIPLegalHoldUpsert {
+	u.Add(aiplegalhold.FieldActiveAipID, v)
+	return u
+}
+
+// ClearActiveAipID clears the value of the "active_aip_id" field.
+func (u *AIPLegalHoldUpsert) ClearActiveAipID() *AIPLegalHoldUpsert {
+	u.SetNull(aiplegalhold.FieldActiveAipID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AIPLegalHold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AIPLegalHoldUpsertOne) UpdateNewValues() *AIPLegalHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(aiplegalhold.FieldReason)
+		}
+		if _, exists := u.create.mutation.PlacedBy(); exists {
+			s.SetIgnore(aiplegalhold.FieldPlacedBy)
+		}
+		if _, exists := u.create.mutation.PlacedAt(); exists {
+			s.SetIgnore(aiplegalhold.FieldPlacedAt)
+		}
+		if _, exists := u.create.mutation.AipID(); exists {
+			s.SetIgnore(aiplegalhold.FieldAipID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AIPLegalHold.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AIPLegalHoldUpsertOne) Ignore() *AIPLegalHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AIPLegalHoldUpsertOne) DoNothing() *AIPLegalHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AIPLegalHoldCreate.OnConflict
+// documentation for more info.
+func (u *AIPLegalHoldUpsertOne) Update(set func(*AIPLegalHoldUpsert)) *AIPLegalHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AIPLegalHoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUUID sets the "uuid" field.
+func (u *AIPLegalHoldUpsertOne) SetUUID(v uuid.UUID) *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetUUID(v)
+	})
+}
+
+// UpdateUUID sets the "uuid" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertOne) UpdateUUID() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateUUID()
+	})
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (u *AIPLegalHoldUpsertOne) SetReleasedBy(v string) *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetReleasedBy(v)
+	})
+}
+
+// UpdateReleasedBy sets the "released_by" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertOne) UpdateReleasedBy() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateReleasedBy()
+	})
+}
+
+// ClearReleasedBy clears the value of the "released_by" field.
+func (u *AIPLegalHoldUpsertOne) ClearReleasedBy() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.ClearReleasedBy()
+	})
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (u *AIPLegalHoldUpsertOne) SetReleaseReason(v string) *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetReleaseReason(v)
+	})
+}
+
+// UpdateReleaseReason sets the "release_reason" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertOne) UpdateReleaseReason() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateReleaseReason()
+	})
+}
+
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (u *AIPLegalHoldUpsertOne) ClearReleaseReason() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.ClearReleaseReason()
+	})
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *AIPLegalHoldUpsertOne) SetReleasedAt(v time.Time) *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertOne) UpdateReleasedAt() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *AIPLegalHoldUpsertOne) ClearReleasedAt() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// SetActiveAipID sets the "active_aip_id" field.
+func (u *AIPLegalHoldUpsertOne) SetActiveAipID(v int) *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetActiveAipID(v)
+	})
+}
+
+// AddActiveAipID adds v to the "active_aip_id" field.
+func (u *AIPLegalHoldUpsertOne) AddActiveAipID(v int) *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.AddActiveAipID(v)
+	})
+}
+
+// UpdateActiveAipID sets the "active_aip_id" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertOne) UpdateActiveAipID() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateActiveAipID()
+	})
+}
+
+// ClearActiveAipID clears the value of the "active_aip_id" field.
+func (u *AIPLegalHoldUpsertOne) ClearActiveAipID() *AIPLegalHoldUpsertOne {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.ClearActiveAipID()
+	})
+}
+
+// Exec executes the query.
+func (u *AIPLegalHoldUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for AIPLegalHoldCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AIPLegalHoldUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AIPLegalHoldUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AIPLegalHoldUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AIPLegalHoldCreateBulk is the builder for creating many AIPLegalHold entities in bulk.
+type AIPLegalHoldCreateBulk struct {
+	config
+	err      error
+	builders []*AIPLegalHoldCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AIPLegalHold entities in the database.
+func (_c *AIPLegalHoldCreateBulk) Save(ctx context.Context) ([]*AIPLegalHold, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AIPLegalHold, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AIPLegalHoldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AIPLegalHoldCreateBulk) SaveX(ctx context.Context) []*AIPLegalHold {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIPLegalHoldCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIPLegalHoldCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AIPLegalHold.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AIPLegalHoldUpsert) {
+//			SetUUID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AIPLegalHoldCreateBulk) OnConflict(opts ...sql.ConflictOption) *AIPLegalHoldUpsertBulk {
+	_c.conflict = opts
+	return &AIPLegalHoldUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AIPLegalHold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AIPLegalHoldCreateBulk) OnConflictColumns(columns ...string) *AIPLegalHoldUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AIPLegalHoldUpsertBulk{
+		create: _c,
+	}
+}
+
+// AIPLegalHoldUpsertBulk is the builder for "upsert"-ing
+// a bulk of AIPLegalHold nodes.
+type AIPLegalHoldUpsertBulk struct {
+	create *AIPLegalHoldCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AIPLegalHold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AIPLegalHoldUpsertBulk) UpdateNewValues() *AIPLegalHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(aiplegalhold.FieldReason)
+			}
+			if _, exists := b.mutation.PlacedBy(); exists {
+				s.SetIgnore(aiplegalhold.FieldPlacedBy)
+			}
+			if _, exists := b.mutation.PlacedAt(); exists {
+				s.SetIgnore(aiplegalhold.FieldPlacedAt)
+			}
+			if _, exists := b.mutation.AipID(); exists {
+				s.SetIgnore(aiplegalhold.FieldAipID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AIPLegalHold.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AIPLegalHoldUpsertBulk) Ignore() *AIPLegalHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AIPLegalHoldUpsertBulk) DoNothing() *AIPLegalHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AIPLegalHoldCreateBulk.OnConflict
+// documentation for more info.
+func (u *AIPLegalHoldUpsertBulk) Update(set func(*AIPLegalHoldUpsert)) *AIPLegalHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AIPLegalHoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUUID sets the "uuid" field.
+func (u *AIPLegalHoldUpsertBulk) SetUUID(v uuid.UUID) *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetUUID(v)
+	})
+}
+
+// UpdateUUID sets the "uuid" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertBulk) UpdateUUID() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateUUID()
+	})
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (u *AIPLegalHoldUpsertBulk) SetReleasedBy(v string) *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetReleasedBy(v)
+	})
+}
+
+// UpdateReleasedBy sets the "released_by" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertBulk) UpdateReleasedBy() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateReleasedBy()
+	})
+}
+
+// ClearReleasedBy clears the value of the "released_by" field.
+func (u *AIPLegalHoldUpsertBulk) ClearReleasedBy() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.ClearReleasedBy()
+	})
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (u *AIPLegalHoldUpsertBulk) SetReleaseReason(v string) *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetReleaseReason(v)
+	})
+}
+
+// UpdateReleaseReason sets the "release_reason" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertBulk) UpdateReleaseReason() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateReleaseReason()
+	})
+}
+
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (u *AIPLegalHoldUpsertBulk) ClearReleaseReason() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.ClearReleaseReason()
+	})
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *AIPLegalHoldUpsertBulk) SetReleasedAt(v time.Time) *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertBulk) UpdateReleasedAt() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *AIPLegalHoldUpsertBulk) ClearReleasedAt() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// SetActiveAipID sets the "active_aip_id" field.
+func (u *AIPLegalHoldUpsertBulk) SetActiveAipID(v int) *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.SetActiveAipID(v)
+	})
+}
+
+// AddActiveAipID adds v to the "active_aip_id" field.
+func (u *AIPLegalHoldUpsertBulk) AddActiveAipID(v int) *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.AddActiveAipID(v)
+	})
+}
+
+// UpdateActiveAipID sets the "active_aip_id" field to the value that was provided on create.
+func (u *AIPLegalHoldUpsertBulk) UpdateActiveAipID() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.UpdateActiveAipID()
+	})
+}
+
+// ClearActiveAipID clears the value of the "active_aip_id" field.
+func (u *AIPLegalHoldUpsertBulk) ClearActiveAipID() *AIPLegalHoldUpsertBulk {
+	return u.Update(func(s *AIPLegalHoldUpsert) {
+		s.ClearActiveAipID()
+	})
+}
+
+// Exec executes the query.
+func (u *AIPLegalHoldUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the AIPLegalHoldCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for AIPLegalHoldCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AIPLegalHoldUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package aipreplica
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReplicatedAt applies equality check predicate on the "replicated_at" field. It's identical to ReplicatedAtEQ.
+func ReplicatedAt(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldReplicatedAt, v))
+}
+
+// AipID applies equality check predicate on the "aip_id" field. It's identical to AipIDEQ.
+func AipID(v int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldAipID, v))
+}
+
+// LocationID applies equality check predicate on the "location_id" field. It's identical to LocationIDEQ.
+func LocationID(v int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldLocationID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v enums.ReplicaStatus) predicate.AIPReplica {
+	vc := v
+	return predicate.AIPReplica(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v enums.ReplicaStatus) predicate.AIPReplica {
+	vc := v
+	return predicate.AIPReplica(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...enums.ReplicaStatus) predicate.AIPReplica {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AIPReplica(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...enums.ReplicaStatus) predicate.AIPReplica {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AIPReplica(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReplicatedAtEQ applies the EQ predicate on the "replicated_at" field.
+func ReplicatedAtEQ(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldReplicatedAt, v))
+}
+
+// ReplicatedAtNEQ applies the NEQ predicate on the "replicated_at" field.
+func ReplicatedAtNEQ(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNEQ(FieldReplicatedAt, v))
+}
+
+// ReplicatedAtIn applies the In predicate on the "replicated_at" field.
+func ReplicatedAtIn(vs ...time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldIn(FieldReplicatedAt, vs...))
+}
+
+// ReplicatedAtNotIn applies the NotIn predicate on the "replicated_at" field.
+func ReplicatedAtNotIn(vs ...time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNotIn(FieldReplicatedAt, vs...))
+}
+
+// ReplicatedAtGT applies the GT predicate on the "replicated_at" field.
+func ReplicatedAtGT(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldGT(FieldReplicatedAt, v))
+}
+
+// ReplicatedAtGTE applies the GTE predicate on the "replicated_at" field.
+func ReplicatedAtGTE(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldGTE(FieldReplicatedAt, v))
+}
+
+// ReplicatedAtLT applies the LT predicate on the "replicated_at" field.
+func ReplicatedAtLT(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldLT(FieldReplicatedAt, v))
+}
+
+// ReplicatedAtLTE applies the LTE predicate on the "replicated_at" field.
+func ReplicatedAtLTE(v time.Time) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldLTE(FieldReplicatedAt, v))
+}
+
+// ReplicatedAtIsNil applies the IsNil predicate on the "replicated_at" field.
+func ReplicatedAtIsNil() predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldIsNull(FieldReplicatedAt))
+}
+
+// ReplicatedAtNotNil applies the NotNil predicate on the "replicated_at" field.
+func ReplicatedAtNotNil() predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNotNull(FieldReplicatedAt))
+}
+
+// AipIDEQ applies the EQ predicate on the "aip_id" field.
+func AipIDEQ(v int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldAipID, v))
+}
+
+// AipIDNEQ applies the NEQ predicate on the "aip_id" field.
+func AipIDNEQ(v int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNEQ(FieldAipID, v))
+}
+
+// AipIDIn applies the In predicate on the "aip_id" field.
+func AipIDIn(vs ...int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldIn(FieldAipID, vs...))
+}
+
+// AipIDNotIn applies the NotIn predicate on the "aip_id" field.
+func AipIDNotIn(vs ...int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNotIn(FieldAipID, vs...))
+}
+
+// LocationIDEQ applies the EQ predicate on the "location_id" field.
+func LocationIDEQ(v int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldEQ(FieldLocationID, v))
+}
+
+// LocationIDNEQ applies the NEQ predicate on the "location_id" field.
+func LocationIDNEQ(v int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNEQ(FieldLocationID, v))
+}
+
+// LocationIDIn applies the In predicate on the "location_id" field.
+func LocationIDIn(vs ...int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldIn(FieldLocationID, vs...))
+}
+
+// LocationIDNotIn applies the NotIn predicate on the "location_id" field.
+func LocationIDNotIn(vs ...int) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.FieldNotIn(FieldLocationID, vs...))
+}
+
+// HasAip applies the HasEdge predicate on the "aip" edge.
+func HasAip() predicate.AIPReplica {
+	return predicate.AIPReplica(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AipTable, AipColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAipWith applies the HasEdge predicate on the "aip" edge with a given conditions (other predicates).
+func HasAipWith(preds ...predicate.AIP) predicate.AIPReplica {
+	return predicate.AIPReplica(func(s *sql.Selector) {
+		step := newAipStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.AIPReplica {
+	return predicate.AIPReplica(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.AIPReplica {
+	return predicate.AIPReplica(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AIPReplica) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AIPReplica) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AIPReplica) predicate.AIPReplica {
+	return predicate.AIPReplica(sql.NotPredicates(p))
+}
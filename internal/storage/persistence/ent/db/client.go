@@ -16,8 +16,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/searchdocument"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 )
@@ -29,10 +34,20 @@ type Client struct {
 	Schema *migrate.Schema
 	// AIP is the client for interacting with the AIP builders.
 	AIP *AIPClient
+	// AIPLegalHold is the client for interacting with the AIPLegalHold builders.
+	AIPLegalHold *AIPLegalHoldClient
+	// AIPReplica is the client for interacting with the AIPReplica builders.
+	AIPReplica *AIPReplicaClient
 	// DeletionRequest is the client for interacting with the DeletionRequest builders.
 	DeletionRequest *DeletionRequestClient
+	// DeletionRequestApproval is the client for interacting with the DeletionRequestApproval builders.
+	DeletionRequestApproval *DeletionRequestApprovalClient
+	// FixityCheck is the client for interacting with the FixityCheck builders.
+	FixityCheck *FixityCheckClient
 	// Location is the client for interacting with the Location builders.
 	Location *LocationClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// Workflow is the client for interacting with the Workflow builders.
@@ -49,8 +64,13 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AIP = NewAIPClient(c.config)
+	c.AIPLegalHold = NewAIPLegalHoldClient(c.config)
+	c.AIPReplica = NewAIPReplicaClient(c.config)
 	c.DeletionRequest = NewDeletionRequestClient(c.config)
+	c.DeletionRequestApproval = NewDeletionRequestApprovalClient(c.config)
+	c.FixityCheck = NewFixityCheckClient(c.config)
 	c.Location = NewLocationClient(c.config)
+	c.SearchDocument = NewSearchDocumentClient(c.config)
 	c.Task = NewTaskClient(c.config)
 	c.Workflow = NewWorkflowClient(c.config)
 }
@@ -143,13 +163,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		AIP:             NewAIPClient(cfg),
-		DeletionRequest: NewDeletionRequestClient(cfg),
-		Location:        NewLocationClient(cfg),
-		Task:            NewTaskClient(cfg),
-		Workflow:        NewWorkflowClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		AIP:                     NewAIPClient(cfg),
+		AIPLegalHold:            NewAIPLegalHoldClient(cfg),
+		AIPReplica:              NewAIPReplicaClient(cfg),
+		DeletionRequest:         NewDeletionRequestClient(cfg),
+		DeletionRequestApproval: NewDeletionRequestApprovalClient(cfg),
+		FixityCheck:             NewFixityCheckClient(cfg),
+		Location:                NewLocationClient(cfg),
+		SearchDocument:          NewSearchDocumentClient(cfg),
+		Task:                    NewTaskClient(cfg),
+		Workflow:                NewWorkflowClient(cfg),
 	}, nil
 }
 
@@ -167,13 +192,18 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		AIP:             NewAIPClient(cfg),
-		DeletionRequest: NewDeletionRequestClient(cfg),
-		Location:        NewLocationClient(cfg),
-		Task:            NewTaskClient(cfg),
-		Workflow:        NewWorkflowClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		AIP:                     NewAIPClient(cfg),
+		AIPLegalHold:            NewAIPLegalHoldClient(cfg),
+		AIPReplica:              NewAIPReplicaClient(cfg),
+		DeletionRequest:         NewDeletionRequestClient(cfg),
+		DeletionRequestApproval: NewDeletionRequestApprovalClient(cfg),
+		FixityCheck:             NewFixityCheckClient(cfg),
+		Location:                NewLocationClient(cfg),
+		SearchDocument:          NewSearchDocumentClient(cfg),
+		Task:                    NewTaskClient(cfg),
+		Workflow:                NewWorkflowClient(cfg),
 	}, nil
 }
 
@@ -202,21 +232,25 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.AIP.Use(hooks...)
-	c.DeletionRequest.Use(hooks...)
-	c.Location.Use(hooks...)
-	c.Task.Use(hooks...)
-	c.Workflow.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AIP, c.AIPLegalHold, c.AIPReplica, c.DeletionRequest,
+		c.DeletionRequestApproval, c.FixityCheck, c.Location, c.SearchDocument, c.Task,
+		c.Workflow,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.AIP.Intercept(interceptors...)
-	c.DeletionRequest.Intercept(interceptors...)
-	c.Location.Intercept(interceptors...)
-	c.Task.Intercept(interceptors...)
-	c.Workflow.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AIP, c.AIPLegalHold, c.AIPReplica, c.DeletionRequest,
+		c.DeletionRequestApproval, c.FixityCheck, c.Location, c.SearchDocument, c.Task,
+		c.Workflow,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -224,10 +258,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AIPMutation:
 		return c.AIP.mutate(ctx, m)
+	case *AIPLegalHoldMutation:
+		return c.AIPLegalHold.mutate(ctx, m)
+	case *AIPReplicaMutation:
+		return c.AIPReplica.mutate(ctx, m)
 	case *DeletionRequestMutation:
 		return c.DeletionRequest.mutate(ctx, m)
+	case *DeletionRequestApprovalMutation:
+		return c.DeletionRequestApproval.mutate(ctx, m)
+	case *FixityCheckMutation:
+		return c.FixityCheck.mutate(ctx, m)
 	case *LocationMutation:
 		return c.Location.mutate(ctx, m)
+	case *SearchDocumentMutation:
+		return c.SearchDocument.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
 	case *WorkflowMutation:
@@ -265,79 +309,803 @@ func (c *AIPClient) Create() *AIPCreate {
 	return &AIPCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of AIP entities.
-func (c *AIPClient) CreateBulk(builders ...*AIPCreate) *AIPCreateBulk {
-	return &AIPCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AIP entities.
+func (c *AIPClient) CreateBulk(builders ...*AIPCreate) *AIPCreateBulk {
+	return &AIPCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AIPClient) MapCreateBulk(slice any, setFunc func(*AIPCreate, int)) *AIPCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AIPCreateBulk{err: fmt.Errorf("calling to AIPClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AIPCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AIPCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AIP.
+func (c *AIPClient) Update() *AIPUpdate {
+	mutation := newAIPMutation(c.config, OpUpdate)
+	return &AIPUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AIPClient) UpdateOne(_m *AIP) *AIPUpdateOne {
+	mutation := newAIPMutation(c.config, OpUpdateOne, withAIP(_m))
+	return &AIPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AIPClient) UpdateOneID(id int) *AIPUpdateOne {
+	mutation := newAIPMutation(c.config, OpUpdateOne, withAIPID(id))
+	return &AIPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AIP.
+func (c *AIPClient) Delete() *AIPDelete {
+	mutation := newAIPMutation(c.config, OpDelete)
+	return &AIPDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AIPClient) DeleteOne(_m *AIP) *AIPDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AIPClient) DeleteOneID(id int) *AIPDeleteOne {
+	builder := c.Delete().Where(aip.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AIPDeleteOne{builder}
+}
+
+// Query returns a query builder for AIP.
+func (c *AIPClient) Query() *AIPQuery {
+	return &AIPQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAIP},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AIP entity by its id.
+func (c *AIPClient) Get(ctx context.Context, id int) (*AIP, error) {
+	return c.Query().Where(aip.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AIPClient) GetX(ctx context.Context, id int) *AIP {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLocation queries the location edge of a AIP.
+func (c *AIPClient) QueryLocation(_m *AIP) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, aip.LocationTable, aip.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflows queries the workflows edge of a AIP.
+func (c *AIPClient) QueryWorkflows(_m *AIP) *WorkflowQuery {
+	query := (&WorkflowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, id),
+			sqlgraph.To(workflow.Table, workflow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, aip.WorkflowsTable, aip.WorkflowsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeletionRequests queries the deletion_requests edge of a AIP.
+func (c *AIPClient) QueryDeletionRequests(_m *AIP) *DeletionRequestQuery {
+	query := (&DeletionRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, id),
+			sqlgraph.To(deletionrequest.Table, deletionrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, aip.DeletionRequestsTable, aip.DeletionRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFixityChecks queries the fixity_checks edge of a AIP.
+func (c *AIPClient) QueryFixityChecks(_m *AIP) *FixityCheckQuery {
+	query := (&FixityCheckClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, id),
+			sqlgraph.To(fixitycheck.Table, fixitycheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, aip.FixityChecksTable, aip.FixityChecksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplicas queries the replicas edge of a AIP.
+func (c *AIPClient) QueryReplicas(_m *AIP) *AIPReplicaQuery {
+	query := (&AIPReplicaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, id),
+			sqlgraph.To(aipreplica.Table, aipreplica.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, aip.ReplicasTable, aip.ReplicasColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLegalHolds queries the legal_holds edge of a AIP.
+func (c *AIPClient) QueryLegalHolds(_m *AIP) *AIPLegalHoldQuery {
+	query := (&AIPLegalHoldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aip.Table, aip.FieldID, id),
+			sqlgraph.To(aiplegalhold.Table, aiplegalhold.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, aip.LegalHoldsTable, aip.LegalHoldsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AIPClient) Hooks() []Hook {
+	return c.hooks.AIP
+}
+
+// Interceptors returns the client interceptors.
+func (c *AIPClient) Interceptors() []Interceptor {
+	return c.inters.AIP
+}
+
+func (c *AIPClient) mutate(ctx context.Context, m *AIPMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AIPCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AIPUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AIPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AIPDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown AIP mutation op: %q", m.Op())
+	}
+}
+
+// AIPLegalHoldClient is a client for the AIPLegalHold schema.
+type AIPLegalHoldClient struct {
+	config
+}
+
+// NewAIPLegalHoldClient returns a client for the AIPLegalHold from the given config.
+func NewAIPLegalHoldClient(c config) *AIPLegalHoldClient {
+	return &AIPLegalHoldClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `aiplegalhold.Hooks(f(g(h())))`.
+func (c *AIPLegalHoldClient) Use(hooks ...Hook) {
+	c.hooks.AIPLegalHold = append(c.hooks.AIPLegalHold, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `aiplegalhold.Intercept(f(g(h())))`.
+func (c *AIPLegalHoldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AIPLegalHold = append(c.inters.AIPLegalHold, interceptors...)
+}
+
+// Create returns a builder for creating a AIPLegalHold entity.
+func (c *AIPLegalHoldClient) Create() *AIPLegalHoldCreate {
+	mutation := newAIPLegalHoldMutation(c.config, OpCreate)
+	return &AIPLegalHoldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AIPLegalHold entities.
+func (c *AIPLegalHoldClient) CreateBulk(builders ...*AIPLegalHoldCreate) *AIPLegalHoldCreateBulk {
+	return &AIPLegalHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AIPLegalHoldClient) MapCreateBulk(slice any, setFunc func(*AIPLegalHoldCreate, int)) *AIPLegalHoldCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AIPLegalHoldCreateBulk{err: fmt.Errorf("calling to AIPLegalHoldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AIPLegalHoldCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AIPLegalHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AIPLegalHold.
+func (c *AIPLegalHoldClient) Update() *AIPLegalHoldUpdate {
+	mutation := newAIPLegalHoldMutation(c.config, OpUpdate)
+	return &AIPLegalHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AIPLegalHoldClient) UpdateOne(_m *AIPLegalHold) *AIPLegalHoldUpdateOne {
+	mutation := newAIPLegalHoldMutation(c.config, OpUpdateOne, withAIPLegalHold(_m))
+	return &AIPLegalHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AIPLegalHoldClient) UpdateOneID(id int) *AIPLegalHoldUpdateOne {
+	mutation := newAIPLegalHoldMutation(c.config, OpUpdateOne, withAIPLegalHoldID(id))
+	return &AIPLegalHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AIPLegalHold.
+func (c *AIPLegalHoldClient) Delete() *AIPLegalHoldDelete {
+	mutation := newAIPLegalHoldMutation(c.config, OpDelete)
+	return &AIPLegalHoldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AIPLegalHoldClient) DeleteOne(_m *AIPLegalHold) *AIPLegalHoldDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AIPLegalHoldClient) DeleteOneID(id int) *AIPLegalHoldDeleteOne {
+	builder := c.Delete().Where(aiplegalhold.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AIPLegalHoldDeleteOne{builder}
+}
+
+// Query returns a query builder for AIPLegalHold.
+func (c *AIPLegalHoldClient) Query() *AIPLegalHoldQuery {
+	return &AIPLegalHoldQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAIPLegalHold},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AIPLegalHold entity by its id.
+func (c *AIPLegalHoldClient) Get(ctx context.Context, id int) (*AIPLegalHold, error) {
+	return c.Query().Where(aiplegalhold.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AIPLegalHoldClient) GetX(ctx context.Context, id int) *AIPLegalHold {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAip queries the aip edge of a AIPLegalHold.
+func (c *AIPLegalHoldClient) QueryAip(_m *AIPLegalHold) *AIPQuery {
+	query := (&AIPClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aiplegalhold.Table, aiplegalhold.FieldID, id),
+			sqlgraph.To(aip.Table, aip.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, aiplegalhold.AipTable, aiplegalhold.AipColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AIPLegalHoldClient) Hooks() []Hook {
+	return c.hooks.AIPLegalHold
+}
+
+// Interceptors returns the client interceptors.
+func (c *AIPLegalHoldClient) Interceptors() []Interceptor {
+	return c.inters.AIPLegalHold
+}
+
+func (c *AIPLegalHoldClient) mutate(ctx context.Context, m *AIPLegalHoldMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AIPLegalHoldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AIPLegalHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AIPLegalHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AIPLegalHoldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown AIPLegalHold mutation op: %q", m.Op())
+	}
+}
+
+// AIPReplicaClient is a client for the AIPReplica schema.
+type AIPReplicaClient struct {
+	config
+}
+
+// NewAIPReplicaClient returns a client for the AIPReplica from the given config.
+func NewAIPReplicaClient(c config) *AIPReplicaClient {
+	return &AIPReplicaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `aipreplica.Hooks(f(g(h())))`.
+func (c *AIPReplicaClient) Use(hooks ...Hook) {
+	c.hooks.AIPReplica = append(c.hooks.AIPReplica, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `aipreplica.Intercept(f(g(h())))`.
+func (c *AIPReplicaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AIPReplica = append(c.inters.AIPReplica, interceptors...)
+}
+
+// Create returns a builder for creating a AIPReplica entity.
+func (c *AIPReplicaClient) Create() *AIPReplicaCreate {
+	mutation := newAIPReplicaMutation(c.config, OpCreate)
+	return &AIPReplicaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AIPReplica entities.
+func (c *AIPReplicaClient) CreateBulk(builders ...*AIPReplicaCreate) *AIPReplicaCreateBulk {
+	return &AIPReplicaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AIPReplicaClient) MapCreateBulk(slice any, setFunc func(*AIPReplicaCreate, int)) *AIPReplicaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AIPReplicaCreateBulk{err: fmt.Errorf("calling to AIPReplicaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AIPReplicaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AIPReplicaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AIPReplica.
+func (c *AIPReplicaClient) Update() *AIPReplicaUpdate {
+	mutation := newAIPReplicaMutation(c.config, OpUpdate)
+	return &AIPReplicaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AIPReplicaClient) UpdateOne(_m *AIPReplica) *AIPReplicaUpdateOne {
+	mutation := newAIPReplicaMutation(c.config, OpUpdateOne, withAIPReplica(_m))
+	return &AIPReplicaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AIPReplicaClient) UpdateOneID(id int) *AIPReplicaUpdateOne {
+	mutation := newAIPReplicaMutation(c.config, OpUpdateOne, withAIPReplicaID(id))
+	return &AIPReplicaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AIPReplica.
+func (c *AIPReplicaClient) Delete() *AIPReplicaDelete {
+	mutation := newAIPReplicaMutation(c.config, OpDelete)
+	return &AIPReplicaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AIPReplicaClient) DeleteOne(_m *AIPReplica) *AIPReplicaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AIPReplicaClient) DeleteOneID(id int) *AIPReplicaDeleteOne {
+	builder := c.Delete().Where(aipreplica.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AIPReplicaDeleteOne{builder}
+}
+
+// Query returns a query builder for AIPReplica.
+func (c *AIPReplicaClient) Query() *AIPReplicaQuery {
+	return &AIPReplicaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAIPReplica},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AIPReplica entity by its id.
+func (c *AIPReplicaClient) Get(ctx context.Context, id int) (*AIPReplica, error) {
+	return c.Query().Where(aipreplica.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AIPReplicaClient) GetX(ctx context.Context, id int) *AIPReplica {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAip queries the aip edge of a AIPReplica.
+func (c *AIPReplicaClient) QueryAip(_m *AIPReplica) *AIPQuery {
+	query := (&AIPClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aipreplica.Table, aipreplica.FieldID, id),
+			sqlgraph.To(aip.Table, aip.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, aipreplica.AipTable, aipreplica.AipColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a AIPReplica.
+func (c *AIPReplicaClient) QueryLocation(_m *AIPReplica) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aipreplica.Table, aipreplica.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, aipreplica.LocationTable, aipreplica.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AIPReplicaClient) Hooks() []Hook {
+	return c.hooks.AIPReplica
+}
+
+// Interceptors returns the client interceptors.
+func (c *AIPReplicaClient) Interceptors() []Interceptor {
+	return c.inters.AIPReplica
+}
+
+func (c *AIPReplicaClient) mutate(ctx context.Context, m *AIPReplicaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AIPReplicaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AIPReplicaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AIPReplicaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AIPReplicaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown AIPReplica mutation op: %q", m.Op())
+	}
+}
+
+// DeletionRequestClient is a client for the DeletionRequest schema.
+type DeletionRequestClient struct {
+	config
+}
+
+// NewDeletionRequestClient returns a client for the DeletionRequest from the given config.
+func NewDeletionRequestClient(c config) *DeletionRequestClient {
+	return &DeletionRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deletionrequest.Hooks(f(g(h())))`.
+func (c *DeletionRequestClient) Use(hooks ...Hook) {
+	c.hooks.DeletionRequest = append(c.hooks.DeletionRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deletionrequest.Intercept(f(g(h())))`.
+func (c *DeletionRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeletionRequest = append(c.inters.DeletionRequest, interceptors...)
+}
+
+// Create returns a builder for creating a DeletionRequest entity.
+func (c *DeletionRequestClient) Create() *DeletionRequestCreate {
+	mutation := newDeletionRequestMutation(c.config, OpCreate)
+	return &DeletionRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeletionRequest entities.
+func (c *DeletionRequestClient) CreateBulk(builders ...*DeletionRequestCreate) *DeletionRequestCreateBulk {
+	return &DeletionRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeletionRequestClient) MapCreateBulk(slice any, setFunc func(*DeletionRequestCreate, int)) *DeletionRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeletionRequestCreateBulk{err: fmt.Errorf("calling to DeletionRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeletionRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeletionRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeletionRequest.
+func (c *DeletionRequestClient) Update() *DeletionRequestUpdate {
+	mutation := newDeletionRequestMutation(c.config, OpUpdate)
+	return &DeletionRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeletionRequestClient) UpdateOne(_m *DeletionRequest) *DeletionRequestUpdateOne {
+	mutation := newDeletionRequestMutation(c.config, OpUpdateOne, withDeletionRequest(_m))
+	return &DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeletionRequestClient) UpdateOneID(id int) *DeletionRequestUpdateOne {
+	mutation := newDeletionRequestMutation(c.config, OpUpdateOne, withDeletionRequestID(id))
+	return &DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeletionRequest.
+func (c *DeletionRequestClient) Delete() *DeletionRequestDelete {
+	mutation := newDeletionRequestMutation(c.config, OpDelete)
+	return &DeletionRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeletionRequestClient) DeleteOne(_m *DeletionRequest) *DeletionRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeletionRequestClient) DeleteOneID(id int) *DeletionRequestDeleteOne {
+	builder := c.Delete().Where(deletionrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeletionRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for DeletionRequest.
+func (c *DeletionRequestClient) Query() *DeletionRequestQuery {
+	return &DeletionRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeletionRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeletionRequest entity by its id.
+func (c *DeletionRequestClient) Get(ctx context.Context, id int) (*DeletionRequest, error) {
+	return c.Query().Where(deletionrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeletionRequestClient) GetX(ctx context.Context, id int) *DeletionRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAip queries the aip edge of a DeletionRequest.
+func (c *DeletionRequestClient) QueryAip(_m *DeletionRequest) *AIPQuery {
+	query := (&AIPClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deletionrequest.Table, deletionrequest.FieldID, id),
+			sqlgraph.To(aip.Table, aip.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deletionrequest.AipTable, deletionrequest.AipColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflow queries the workflow edge of a DeletionRequest.
+func (c *DeletionRequestClient) QueryWorkflow(_m *DeletionRequest) *WorkflowQuery {
+	query := (&WorkflowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deletionrequest.Table, deletionrequest.FieldID, id),
+			sqlgraph.To(workflow.Table, workflow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, deletionrequest.WorkflowTable, deletionrequest.WorkflowColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApprovals queries the approvals edge of a DeletionRequest.
+func (c *DeletionRequestClient) QueryApprovals(_m *DeletionRequest) *DeletionRequestApprovalQuery {
+	query := (&DeletionRequestApprovalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deletionrequest.Table, deletionrequest.FieldID, id),
+			sqlgraph.To(deletionrequestapproval.Table, deletionrequestapproval.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deletionrequest.ApprovalsTable, deletionrequest.ApprovalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeletionRequestClient) Hooks() []Hook {
+	return c.hooks.DeletionRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeletionRequestClient) Interceptors() []Interceptor {
+	return c.inters.DeletionRequest
+}
+
+func (c *DeletionRequestClient) mutate(ctx context.Context, m *DeletionRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeletionRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeletionRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeletionRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown DeletionRequest mutation op: %q", m.Op())
+	}
+}
+
+// DeletionRequestApprovalClient is a client for the DeletionRequestApproval schema.
+type DeletionRequestApprovalClient struct {
+	config
+}
+
+// NewDeletionRequestApprovalClient returns a client for the DeletionRequestApproval from the given config.
+func NewDeletionRequestApprovalClient(c config) *DeletionRequestApprovalClient {
+	return &DeletionRequestApprovalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deletionrequestapproval.Hooks(f(g(h())))`.
+func (c *DeletionRequestApprovalClient) Use(hooks ...Hook) {
+	c.hooks.DeletionRequestApproval = append(c.hooks.DeletionRequestApproval, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deletionrequestapproval.Intercept(f(g(h())))`.
+func (c *DeletionRequestApprovalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeletionRequestApproval = append(c.inters.DeletionRequestApproval, interceptors...)
+}
+
+// Create returns a builder for creating a DeletionRequestApproval entity.
+func (c *DeletionRequestApprovalClient) Create() *DeletionRequestApprovalCreate {
+	mutation := newDeletionRequestApprovalMutation(c.config, OpCreate)
+	return &DeletionRequestApprovalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeletionRequestApproval entities.
+func (c *DeletionRequestApprovalClient) CreateBulk(builders ...*DeletionRequestApprovalCreate) *DeletionRequestApprovalCreateBulk {
+	return &DeletionRequestApprovalCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *AIPClient) MapCreateBulk(slice any, setFunc func(*AIPCreate, int)) *AIPCreateBulk {
+func (c *DeletionRequestApprovalClient) MapCreateBulk(slice any, setFunc func(*DeletionRequestApprovalCreate, int)) *DeletionRequestApprovalCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &AIPCreateBulk{err: fmt.Errorf("calling to AIPClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeletionRequestApprovalCreateBulk{err: fmt.Errorf("calling to DeletionRequestApprovalClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*AIPCreate, rv.Len())
+	builders := make([]*DeletionRequestApprovalCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &AIPCreateBulk{config: c.config, builders: builders}
+	return &DeletionRequestApprovalCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for AIP.
-func (c *AIPClient) Update() *AIPUpdate {
-	mutation := newAIPMutation(c.config, OpUpdate)
-	return &AIPUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeletionRequestApproval.
+func (c *DeletionRequestApprovalClient) Update() *DeletionRequestApprovalUpdate {
+	mutation := newDeletionRequestApprovalMutation(c.config, OpUpdate)
+	return &DeletionRequestApprovalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *AIPClient) UpdateOne(_m *AIP) *AIPUpdateOne {
-	mutation := newAIPMutation(c.config, OpUpdateOne, withAIP(_m))
-	return &AIPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeletionRequestApprovalClient) UpdateOne(_m *DeletionRequestApproval) *DeletionRequestApprovalUpdateOne {
+	mutation := newDeletionRequestApprovalMutation(c.config, OpUpdateOne, withDeletionRequestApproval(_m))
+	return &DeletionRequestApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *AIPClient) UpdateOneID(id int) *AIPUpdateOne {
-	mutation := newAIPMutation(c.config, OpUpdateOne, withAIPID(id))
-	return &AIPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeletionRequestApprovalClient) UpdateOneID(id int) *DeletionRequestApprovalUpdateOne {
+	mutation := newDeletionRequestApprovalMutation(c.config, OpUpdateOne, withDeletionRequestApprovalID(id))
+	return &DeletionRequestApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for AIP.
-func (c *AIPClient) Delete() *AIPDelete {
-	mutation := newAIPMutation(c.config, OpDelete)
-	return &AIPDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeletionRequestApproval.
+func (c *DeletionRequestApprovalClient) Delete() *DeletionRequestApprovalDelete {
+	mutation := newDeletionRequestApprovalMutation(c.config, OpDelete)
+	return &DeletionRequestApprovalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *AIPClient) DeleteOne(_m *AIP) *AIPDeleteOne {
+func (c *DeletionRequestApprovalClient) DeleteOne(_m *DeletionRequestApproval) *DeletionRequestApprovalDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *AIPClient) DeleteOneID(id int) *AIPDeleteOne {
-	builder := c.Delete().Where(aip.ID(id))
+func (c *DeletionRequestApprovalClient) DeleteOneID(id int) *DeletionRequestApprovalDeleteOne {
+	builder := c.Delete().Where(deletionrequestapproval.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &AIPDeleteOne{builder}
+	return &DeletionRequestApprovalDeleteOne{builder}
 }
 
-// Query returns a query builder for AIP.
-func (c *AIPClient) Query() *AIPQuery {
-	return &AIPQuery{
+// Query returns a query builder for DeletionRequestApproval.
+func (c *DeletionRequestApprovalClient) Query() *DeletionRequestApprovalQuery {
+	return &DeletionRequestApprovalQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeAIP},
+		ctx:    &QueryContext{Type: TypeDeletionRequestApproval},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a AIP entity by its id.
-func (c *AIPClient) Get(ctx context.Context, id int) (*AIP, error) {
-	return c.Query().Where(aip.ID(id)).Only(ctx)
+// Get returns a DeletionRequestApproval entity by its id.
+func (c *DeletionRequestApprovalClient) Get(ctx context.Context, id int) (*DeletionRequestApproval, error) {
+	return c.Query().Where(deletionrequestapproval.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *AIPClient) GetX(ctx context.Context, id int) *AIP {
+func (c *DeletionRequestApprovalClient) GetX(ctx context.Context, id int) *DeletionRequestApproval {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -345,47 +1113,15 @@ func (c *AIPClient) GetX(ctx context.Context, id int) *AIP {
 	return obj
 }
 
-// QueryLocation queries the location edge of a AIP.
-func (c *AIPClient) QueryLocation(_m *AIP) *LocationQuery {
-	query := (&LocationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(aip.Table, aip.FieldID, id),
-			sqlgraph.To(location.Table, location.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, aip.LocationTable, aip.LocationColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryWorkflows queries the workflows edge of a AIP.
-func (c *AIPClient) QueryWorkflows(_m *AIP) *WorkflowQuery {
-	query := (&WorkflowClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(aip.Table, aip.FieldID, id),
-			sqlgraph.To(workflow.Table, workflow.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, aip.WorkflowsTable, aip.WorkflowsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDeletionRequests queries the deletion_requests edge of a AIP.
-func (c *AIPClient) QueryDeletionRequests(_m *AIP) *DeletionRequestQuery {
+// QueryDeletionRequest queries the deletion_request edge of a DeletionRequestApproval.
+func (c *DeletionRequestApprovalClient) QueryDeletionRequest(_m *DeletionRequestApproval) *DeletionRequestQuery {
 	query := (&DeletionRequestClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(aip.Table, aip.FieldID, id),
+			sqlgraph.From(deletionrequestapproval.Table, deletionrequestapproval.FieldID, id),
 			sqlgraph.To(deletionrequest.Table, deletionrequest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, aip.DeletionRequestsTable, aip.DeletionRequestsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, deletionrequestapproval.DeletionRequestTable, deletionrequestapproval.DeletionRequestColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -394,131 +1130,131 @@ func (c *AIPClient) QueryDeletionRequests(_m *AIP) *DeletionRequestQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *AIPClient) Hooks() []Hook {
-	return c.hooks.AIP
+func (c *DeletionRequestApprovalClient) Hooks() []Hook {
+	return c.hooks.DeletionRequestApproval
 }
 
 // Interceptors returns the client interceptors.
-func (c *AIPClient) Interceptors() []Interceptor {
-	return c.inters.AIP
+func (c *DeletionRequestApprovalClient) Interceptors() []Interceptor {
+	return c.inters.DeletionRequestApproval
 }
 
-func (c *AIPClient) mutate(ctx context.Context, m *AIPMutation) (Value, error) {
+func (c *DeletionRequestApprovalClient) mutate(ctx context.Context, m *DeletionRequestApprovalMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&AIPCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeletionRequestApprovalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&AIPUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeletionRequestApprovalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&AIPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeletionRequestApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&AIPDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeletionRequestApprovalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("db: unknown AIP mutation op: %q", m.Op())
+		return nil, fmt.Errorf("db: unknown DeletionRequestApproval mutation op: %q", m.Op())
 	}
 }
 
-// DeletionRequestClient is a client for the DeletionRequest schema.
-type DeletionRequestClient struct {
+// FixityCheckClient is a client for the FixityCheck schema.
+type FixityCheckClient struct {
 	config
 }
 
-// NewDeletionRequestClient returns a client for the DeletionRequest from the given config.
-func NewDeletionRequestClient(c config) *DeletionRequestClient {
-	return &DeletionRequestClient{config: c}
+// NewFixityCheckClient returns a client for the FixityCheck from the given config.
+func NewFixityCheckClient(c config) *FixityCheckClient {
+	return &FixityCheckClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `deletionrequest.Hooks(f(g(h())))`.
-func (c *DeletionRequestClient) Use(hooks ...Hook) {
-	c.hooks.DeletionRequest = append(c.hooks.DeletionRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `fixitycheck.Hooks(f(g(h())))`.
+func (c *FixityCheckClient) Use(hooks ...Hook) {
+	c.hooks.FixityCheck = append(c.hooks.FixityCheck, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `deletionrequest.Intercept(f(g(h())))`.
-func (c *DeletionRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeletionRequest = append(c.inters.DeletionRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `fixitycheck.Intercept(f(g(h())))`.
+func (c *FixityCheckClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FixityCheck = append(c.inters.FixityCheck, interceptors...)
 }
 
-// Create returns a builder for creating a DeletionRequest entity.
-func (c *DeletionRequestClient) Create() *DeletionRequestCreate {
-	mutation := newDeletionRequestMutation(c.config, OpCreate)
-	return &DeletionRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FixityCheck entity.
+func (c *FixityCheckClient) Create() *FixityCheckCreate {
+	mutation := newFixityCheckMutation(c.config, OpCreate)
+	return &FixityCheckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeletionRequest entities.
-func (c *DeletionRequestClient) CreateBulk(builders ...*DeletionRequestCreate) *DeletionRequestCreateBulk {
-	return &DeletionRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FixityCheck entities.
+func (c *FixityCheckClient) CreateBulk(builders ...*FixityCheckCreate) *FixityCheckCreateBulk {
+	return &FixityCheckCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeletionRequestClient) MapCreateBulk(slice any, setFunc func(*DeletionRequestCreate, int)) *DeletionRequestCreateBulk {
+func (c *FixityCheckClient) MapCreateBulk(slice any, setFunc func(*FixityCheckCreate, int)) *FixityCheckCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeletionRequestCreateBulk{err: fmt.Errorf("calling to DeletionRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FixityCheckCreateBulk{err: fmt.Errorf("calling to FixityCheckClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeletionRequestCreate, rv.Len())
+	builders := make([]*FixityCheckCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeletionRequestCreateBulk{config: c.config, builders: builders}
+	return &FixityCheckCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeletionRequest.
-func (c *DeletionRequestClient) Update() *DeletionRequestUpdate {
-	mutation := newDeletionRequestMutation(c.config, OpUpdate)
-	return &DeletionRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FixityCheck.
+func (c *FixityCheckClient) Update() *FixityCheckUpdate {
+	mutation := newFixityCheckMutation(c.config, OpUpdate)
+	return &FixityCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeletionRequestClient) UpdateOne(_m *DeletionRequest) *DeletionRequestUpdateOne {
-	mutation := newDeletionRequestMutation(c.config, OpUpdateOne, withDeletionRequest(_m))
-	return &DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FixityCheckClient) UpdateOne(_m *FixityCheck) *FixityCheckUpdateOne {
+	mutation := newFixityCheckMutation(c.config, OpUpdateOne, withFixityCheck(_m))
+	return &FixityCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeletionRequestClient) UpdateOneID(id int) *DeletionRequestUpdateOne {
-	mutation := newDeletionRequestMutation(c.config, OpUpdateOne, withDeletionRequestID(id))
-	return &DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FixityCheckClient) UpdateOneID(id int) *FixityCheckUpdateOne {
+	mutation := newFixityCheckMutation(c.config, OpUpdateOne, withFixityCheckID(id))
+	return &FixityCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeletionRequest.
-func (c *DeletionRequestClient) Delete() *DeletionRequestDelete {
-	mutation := newDeletionRequestMutation(c.config, OpDelete)
-	return &DeletionRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FixityCheck.
+func (c *FixityCheckClient) Delete() *FixityCheckDelete {
+	mutation := newFixityCheckMutation(c.config, OpDelete)
+	return &FixityCheckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeletionRequestClient) DeleteOne(_m *DeletionRequest) *DeletionRequestDeleteOne {
+func (c *FixityCheckClient) DeleteOne(_m *FixityCheck) *FixityCheckDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeletionRequestClient) DeleteOneID(id int) *DeletionRequestDeleteOne {
-	builder := c.Delete().Where(deletionrequest.ID(id))
+func (c *FixityCheckClient) DeleteOneID(id int) *FixityCheckDeleteOne {
+	builder := c.Delete().Where(fixitycheck.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeletionRequestDeleteOne{builder}
+	return &FixityCheckDeleteOne{builder}
 }
 
-// Query returns a query builder for DeletionRequest.
-func (c *DeletionRequestClient) Query() *DeletionRequestQuery {
-	return &DeletionRequestQuery{
+// Query returns a query builder for FixityCheck.
+func (c *FixityCheckClient) Query() *FixityCheckQuery {
+	return &FixityCheckQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeletionRequest},
+		ctx:    &QueryContext{Type: TypeFixityCheck},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeletionRequest entity by its id.
-func (c *DeletionRequestClient) Get(ctx context.Context, id int) (*DeletionRequest, error) {
-	return c.Query().Where(deletionrequest.ID(id)).Only(ctx)
+// Get returns a FixityCheck entity by its id.
+func (c *FixityCheckClient) Get(ctx context.Context, id int) (*FixityCheck, error) {
+	return c.Query().Where(fixitycheck.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeletionRequestClient) GetX(ctx context.Context, id int) *DeletionRequest {
+func (c *FixityCheckClient) GetX(ctx context.Context, id int) *FixityCheck {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -526,15 +1262,15 @@ func (c *DeletionRequestClient) GetX(ctx context.Context, id int) *DeletionReque
 	return obj
 }
 
-// QueryAip queries the aip edge of a DeletionRequest.
-func (c *DeletionRequestClient) QueryAip(_m *DeletionRequest) *AIPQuery {
+// QueryAip queries the aip edge of a FixityCheck.
+func (c *FixityCheckClient) QueryAip(_m *FixityCheck) *AIPQuery {
 	query := (&AIPClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(deletionrequest.Table, deletionrequest.FieldID, id),
+			sqlgraph.From(fixitycheck.Table, fixitycheck.FieldID, id),
 			sqlgraph.To(aip.Table, aip.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, deletionrequest.AipTable, deletionrequest.AipColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, fixitycheck.AipTable, fixitycheck.AipColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -542,15 +1278,15 @@ func (c *DeletionRequestClient) QueryAip(_m *DeletionRequest) *AIPQuery {
 	return query
 }
 
-// QueryWorkflow queries the workflow edge of a DeletionRequest.
-func (c *DeletionRequestClient) QueryWorkflow(_m *DeletionRequest) *WorkflowQuery {
+// QueryWorkflow queries the workflow edge of a FixityCheck.
+func (c *FixityCheckClient) QueryWorkflow(_m *FixityCheck) *WorkflowQuery {
 	query := (&WorkflowClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(deletionrequest.Table, deletionrequest.FieldID, id),
+			sqlgraph.From(fixitycheck.Table, fixitycheck.FieldID, id),
 			sqlgraph.To(workflow.Table, workflow.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, deletionrequest.WorkflowTable, deletionrequest.WorkflowColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, fixitycheck.WorkflowTable, fixitycheck.WorkflowColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -559,27 +1295,27 @@ func (c *DeletionRequestClient) QueryWorkflow(_m *DeletionRequest) *WorkflowQuer
 }
 
 // Hooks returns the client hooks.
-func (c *DeletionRequestClient) Hooks() []Hook {
-	return c.hooks.DeletionRequest
+func (c *FixityCheckClient) Hooks() []Hook {
+	return c.hooks.FixityCheck
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeletionRequestClient) Interceptors() []Interceptor {
-	return c.inters.DeletionRequest
+func (c *FixityCheckClient) Interceptors() []Interceptor {
+	return c.inters.FixityCheck
 }
 
-func (c *DeletionRequestClient) mutate(ctx context.Context, m *DeletionRequestMutation) (Value, error) {
+func (c *FixityCheckClient) mutate(ctx context.Context, m *FixityCheckMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeletionRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FixityCheckCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeletionRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FixityCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeletionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FixityCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeletionRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FixityCheckDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("db: unknown DeletionRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("db: unknown FixityCheck mutation op: %q", m.Op())
 	}
 }
 
@@ -707,6 +1443,38 @@ func (c *LocationClient) QueryAips(_m *Location) *AIPQuery {
 	return query
 }
 
+// QueryReplicationTargets queries the replication_targets edge of a Location.
+func (c *LocationClient) QueryReplicationTargets(_m *Location) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, location.ReplicationTargetsTable, location.ReplicationTargetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplicas queries the replicas edge of a Location.
+func (c *LocationClient) QueryReplicas(_m *Location) *AIPReplicaQuery {
+	query := (&AIPReplicaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(aipreplica.Table, aipreplica.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ReplicasTable, location.ReplicasColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *LocationClient) Hooks() []Hook {
 	return c.hooks.Location
@@ -732,6 +1500,139 @@ func (c *LocationClient) mutate(ctx context.Context, m *LocationMutation) (Value
 	}
 }
 
+// SearchDocumentClient is a client for the SearchDocument schema.
+type SearchDocumentClient struct {
+	config
+}
+
+// NewSearchDocumentClient returns a client for the SearchDocument from the given config.
+func NewSearchDocumentClient(c config) *SearchDocumentClient {
+	return &SearchDocumentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchdocument.Hooks(f(g(h())))`.
+func (c *SearchDocumentClient) Use(hooks ...Hook) {
+	c.hooks.SearchDocument = append(c.hooks.SearchDocument, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchdocument.Intercept(f(g(h())))`.
+func (c *SearchDocumentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchDocument = append(c.inters.SearchDocument, interceptors...)
+}
+
+// Create returns a builder for creating a SearchDocument entity.
+func (c *SearchDocumentClient) Create() *SearchDocumentCreate {
+	mutation := newSearchDocumentMutation(c.config, OpCreate)
+	return &SearchDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchDocument entities.
+func (c *SearchDocumentClient) CreateBulk(builders ...*SearchDocumentCreate) *SearchDocumentCreateBulk {
+	return &SearchDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchDocumentClient) MapCreateBulk(slice any, setFunc func(*SearchDocumentCreate, int)) *SearchDocumentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchDocumentCreateBulk{err: fmt.Errorf("calling to SearchDocumentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchDocumentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchDocument.
+func (c *SearchDocumentClient) Update() *SearchDocumentUpdate {
+	mutation := newSearchDocumentMutation(c.config, OpUpdate)
+	return &SearchDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchDocumentClient) UpdateOne(_m *SearchDocument) *SearchDocumentUpdateOne {
+	mutation := newSearchDocumentMutation(c.config, OpUpdateOne, withSearchDocument(_m))
+	return &SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchDocumentClient) UpdateOneID(id int) *SearchDocumentUpdateOne {
+	mutation := newSearchDocumentMutation(c.config, OpUpdateOne, withSearchDocumentID(id))
+	return &SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchDocument.
+func (c *SearchDocumentClient) Delete() *SearchDocumentDelete {
+	mutation := newSearchDocumentMutation(c.config, OpDelete)
+	return &SearchDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchDocumentClient) DeleteOne(_m *SearchDocument) *SearchDocumentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchDocumentClient) DeleteOneID(id int) *SearchDocumentDeleteOne {
+	builder := c.Delete().Where(searchdocument.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchDocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchDocument.
+func (c *SearchDocumentClient) Query() *SearchDocumentQuery {
+	return &SearchDocumentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchDocument},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchDocument entity by its id.
+func (c *SearchDocumentClient) Get(ctx context.Context, id int) (*SearchDocument, error) {
+	return c.Query().Where(searchdocument.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchDocumentClient) GetX(ctx context.Context, id int) *SearchDocument {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchDocumentClient) Hooks() []Hook {
+	return c.hooks.SearchDocument
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchDocumentClient) Interceptors() []Interceptor {
+	return c.inters.SearchDocument
+}
+
+func (c *SearchDocumentClient) mutate(ctx context.Context, m *SearchDocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SearchDocument mutation op: %q", m.Op())
+	}
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
@@ -1037,6 +1938,22 @@ func (c *WorkflowClient) QueryDeletionRequest(_m *Workflow) *DeletionRequestQuer
 	return query
 }
 
+// QueryFixityChecks queries the fixity_checks edge of a Workflow.
+func (c *WorkflowClient) QueryFixityChecks(_m *Workflow) *FixityCheckQuery {
+	query := (&FixityCheckClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflow.Table, workflow.FieldID, id),
+			sqlgraph.To(fixitycheck.Table, fixitycheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflow.FixityChecksTable, workflow.FixityChecksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WorkflowClient) Hooks() []Hook {
 	return c.hooks.Workflow
@@ -1065,9 +1982,11 @@ func (c *WorkflowClient) mutate(ctx context.Context, m *WorkflowMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AIP, DeletionRequest, Location, Task, Workflow []ent.Hook
+		AIP, AIPLegalHold, AIPReplica, DeletionRequest, DeletionRequestApproval,
+		FixityCheck, Location, SearchDocument, Task, Workflow []ent.Hook
 	}
 	inters struct {
-		AIP, DeletionRequest, Location, Task, Workflow []ent.Interceptor
+		AIP, AIPLegalHold, AIPReplica, DeletionRequest, DeletionRequestApproval,
+		FixityCheck, Location, SearchDocument, Task, Workflow []ent.Interceptor
 	}
 )
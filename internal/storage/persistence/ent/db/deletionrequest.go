@@ -43,6 +43,8 @@ type DeletionRequest struct {
 	RequestedAt time.Time `json:"requested_at,omitempty"`
 	// ReviewedAt holds the value of the "reviewed_at" field.
 	ReviewedAt time.Time `json:"reviewed_at,omitempty"`
+	// RequiredApprovals holds the value of the "required_approvals" field.
+	RequiredApprovals int `json:"required_approvals,omitempty"`
 	// AipID holds the value of the "aip_id" field.
 	AipID int `json:"aip_id,omitempty"`
 	// WorkflowID holds the value of the "workflow_id" field.
@@ -59,9 +61,11 @@ type DeletionRequestEdges struct {
 	Aip *AIP `json:"aip,omitempty"`
 	// Workflow holds the value of the workflow edge.
 	Workflow *Workflow `json:"workflow,omitempty"`
+	// Approvals holds the value of the approvals edge.
+	Approvals []*DeletionRequestApproval `json:"approvals,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // AipOrErr returns the Aip value or an error if the edge
@@ -86,12 +90,21 @@ func (e DeletionRequestEdges) WorkflowOrErr() (*Workflow, error) {
 	return nil, &NotLoadedError{edge: "workflow"}
 }
 
+// ApprovalsOrErr returns the Approvals value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeletionRequestEdges) ApprovalsOrErr() ([]*DeletionRequestApproval, error) {
+	if e.loadedTypes[2] {
+		return e.Approvals, nil
+	}
+	return nil, &NotLoadedError{edge: "approvals"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*DeletionRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case deletionrequest.FieldID, deletionrequest.FieldAipID, deletionrequest.FieldWorkflowID:
+		case deletionrequest.FieldID, deletionrequest.FieldRequiredApprovals, deletionrequest.FieldAipID, deletionrequest.FieldWorkflowID:
 			values[i] = new(sql.NullInt64)
 		case deletionrequest.FieldRequester, deletionrequest.FieldRequesterIss, deletionrequest.FieldRequesterSub, deletionrequest.FieldReviewer, deletionrequest.FieldReviewerIss, deletionrequest.FieldReviewerSub, deletionrequest.FieldReason, deletionrequest.FieldStatus:
 			values[i] = new(sql.NullString)
@@ -186,6 +199,12 @@ func (_m *DeletionRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ReviewedAt = value.Time
 			}
+		case deletionrequest.FieldRequiredApprovals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_approvals", values[i])
+			} else if value.Valid {
+				_m.RequiredApprovals = int(value.Int64)
+			}
 		case deletionrequest.FieldAipID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field aip_id", values[i])
@@ -221,6 +240,11 @@ func (_m *DeletionRequest) QueryWorkflow() *WorkflowQuery {
 	return NewDeletionRequestClient(_m.config).QueryWorkflow(_m)
 }
 
+// QueryApprovals queries the "approvals" edge of the DeletionRequest entity.
+func (_m *DeletionRequest) QueryApprovals() *DeletionRequestApprovalQuery {
+	return NewDeletionRequestClient(_m.config).QueryApprovals(_m)
+}
+
 // Update returns a builder for updating this DeletionRequest.
 // Note that you need to call DeletionRequest.Unwrap() before calling this method if this DeletionRequest
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -277,6 +301,9 @@ func (_m *DeletionRequest) String() string {
 	builder.WriteString("reviewed_at=")
 	builder.WriteString(_m.ReviewedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("required_approvals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredApprovals))
+	builder.WriteString(", ")
 	builder.WriteString("aip_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AipID))
 	builder.WriteString(", ")
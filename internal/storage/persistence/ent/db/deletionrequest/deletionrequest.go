@@ -38,6 +38,8 @@ const (
 	FieldRequestedAt = "requested_at"
 	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
 	FieldReviewedAt = "reviewed_at"
+	// FieldRequiredApprovals holds the string denoting the required_approvals field in the database.
+	FieldRequiredApprovals = "required_approvals"
 	// FieldAipID holds the string denoting the aip_id field in the database.
 	FieldAipID = "aip_id"
 	// FieldWorkflowID holds the string denoting the workflow_id field in the database.
@@ -46,6 +48,8 @@ const (
 	EdgeAip = "aip"
 	// EdgeWorkflow holds the string denoting the workflow edge name in mutations.
 	EdgeWorkflow = "workflow"
+	// EdgeApprovals holds the string denoting the approvals edge name in mutations.
+	EdgeApprovals = "approvals"
 	// Table holds the table name of the deletionrequest in the database.
 	Table = "deletion_request"
 	// AipTable is the table that holds the aip relation/edge.
@@ -62,6 +66,13 @@ const (
 	WorkflowInverseTable = "workflow"
 	// WorkflowColumn is the table column denoting the workflow relation/edge.
 	WorkflowColumn = "workflow_id"
+	// ApprovalsTable is the table that holds the approvals relation/edge.
+	ApprovalsTable = "deletion_request_approval"
+	// ApprovalsInverseTable is the table name for the DeletionRequestApproval entity.
+	// It exists in this package in order to avoid circular dependency with the "deletionrequestapproval" package.
+	ApprovalsInverseTable = "deletion_request_approval"
+	// ApprovalsColumn is the table column denoting the approvals relation/edge.
+	ApprovalsColumn = "deletion_request_id"
 )
 
 // Columns holds all SQL columns for deletionrequest fields.
@@ -78,6 +89,7 @@ var Columns = []string{
 	FieldStatus,
 	FieldRequestedAt,
 	FieldReviewedAt,
+	FieldRequiredApprovals,
 	FieldAipID,
 	FieldWorkflowID,
 }
@@ -95,6 +107,10 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultRequestedAt holds the default value on creation for the "requested_at" field.
 	DefaultRequestedAt func() time.Time
+	// DefaultRequiredApprovals holds the default value on creation for the "required_approvals" field.
+	DefaultRequiredApprovals int
+	// RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	RequiredApprovalsValidator func(int) error
 	// AipIDValidator is a validator for the "aip_id" field. It is called by the builders before save.
 	AipIDValidator func(int) error
 	// WorkflowIDValidator is a validator for the "workflow_id" field. It is called by the builders before save.
@@ -176,6 +192,11 @@ func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
 }
 
+// ByRequiredApprovals orders the results by the required_approvals field.
+func ByRequiredApprovals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredApprovals, opts...).ToFunc()
+}
+
 // ByAipID orders the results by the aip_id field.
 func ByAipID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAipID, opts...).ToFunc()
@@ -199,6 +220,20 @@ func ByWorkflowField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newWorkflowStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByApprovalsCount orders the results by approvals count.
+func ByApprovalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newApprovalsStep(), opts...)
+	}
+}
+
+// ByApprovals orders the results by approvals terms.
+func ByApprovals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newApprovalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAipStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -213,3 +248,10 @@ func newWorkflowStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, WorkflowTable, WorkflowColumn),
 	)
 }
+func newApprovalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ApprovalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ApprovalsTable, ApprovalsColumn),
+	)
+}
@@ -107,6 +107,11 @@ func ReviewedAt(v time.Time) predicate.DeletionRequest {
 	return predicate.DeletionRequest(sql.FieldEQ(FieldReviewedAt, v))
 }
 
+// RequiredApprovals applies equality check predicate on the "required_approvals" field. It's identical to RequiredApprovalsEQ.
+func RequiredApprovals(v int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
 // AipID applies equality check predicate on the "aip_id" field. It's identical to AipIDEQ.
 func AipID(v int) predicate.DeletionRequest {
 	return predicate.DeletionRequest(sql.FieldEQ(FieldAipID, v))
@@ -762,6 +767,46 @@ func ReviewedAtNotNil() predicate.DeletionRequest {
 	return predicate.DeletionRequest(sql.FieldNotNull(FieldReviewedAt))
 }
 
+// RequiredApprovalsEQ applies the EQ predicate on the "required_approvals" field.
+func RequiredApprovalsEQ(v int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsNEQ applies the NEQ predicate on the "required_approvals" field.
+func RequiredApprovalsNEQ(v int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldNEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsIn applies the In predicate on the "required_approvals" field.
+func RequiredApprovalsIn(vs ...int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsNotIn applies the NotIn predicate on the "required_approvals" field.
+func RequiredApprovalsNotIn(vs ...int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldNotIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsGT applies the GT predicate on the "required_approvals" field.
+func RequiredApprovalsGT(v int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldGT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsGTE applies the GTE predicate on the "required_approvals" field.
+func RequiredApprovalsGTE(v int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldGTE(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLT applies the LT predicate on the "required_approvals" field.
+func RequiredApprovalsLT(v int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldLT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLTE applies the LTE predicate on the "required_approvals" field.
+func RequiredApprovalsLTE(v int) predicate.DeletionRequest {
+	return predicate.DeletionRequest(sql.FieldLTE(FieldRequiredApprovals, v))
+}
+
 // AipIDEQ applies the EQ predicate on the "aip_id" field.
 func AipIDEQ(v int) predicate.DeletionRequest {
 	return predicate.DeletionRequest(sql.FieldEQ(FieldAipID, v))
@@ -858,6 +903,29 @@ func HasWorkflowWith(preds ...predicate.Workflow) predicate.DeletionRequest {
 	})
 }
 
+// HasApprovals applies the HasEdge predicate on the "approvals" edge.
+func HasApprovals() predicate.DeletionRequest {
+	return predicate.DeletionRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ApprovalsTable, ApprovalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApprovalsWith applies the HasEdge predicate on the "approvals" edge with a given conditions (other predicates).
+func HasApprovalsWith(preds ...predicate.DeletionRequestApproval) predicate.DeletionRequest {
+	return predicate.DeletionRequest(func(s *sql.Selector) {
+		step := newApprovalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DeletionRequest) predicate.DeletionRequest {
 	return predicate.DeletionRequest(sql.AndPredicates(predicates...))
@@ -14,6 +14,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 	"github.com/google/uuid"
 )
@@ -140,6 +141,20 @@ func (_c *DeletionRequestCreate) SetNillableReviewedAt(v *time.Time) *DeletionRe
 	return _c
 }
 
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_c *DeletionRequestCreate) SetRequiredApprovals(v int) *DeletionRequestCreate {
+	_c.mutation.SetRequiredApprovals(v)
+	return _c
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_c *DeletionRequestCreate) SetNillableRequiredApprovals(v *int) *DeletionRequestCreate {
+	if v != nil {
+		_c.SetRequiredApprovals(*v)
+	}
+	return _c
+}
+
 // SetAipID sets the "aip_id" field.
 func (_c *DeletionRequestCreate) SetAipID(v int) *DeletionRequestCreate {
 	_c.mutation.SetAipID(v)
@@ -170,6 +185,21 @@ func (_c *DeletionRequestCreate) SetWorkflow(v *Workflow) *DeletionRequestCreate
 	return _c.SetWorkflowID(v.ID)
 }
 
+// AddApprovalIDs adds the "approvals" edge to the DeletionRequestApproval entity by IDs.
+func (_c *DeletionRequestCreate) AddApprovalIDs(ids ...int) *DeletionRequestCreate {
+	_c.mutation.AddApprovalIDs(ids...)
+	return _c
+}
+
+// AddApprovals adds the "approvals" edges to the DeletionRequestApproval entity.
+func (_c *DeletionRequestCreate) AddApprovals(v ...*DeletionRequestApproval) *DeletionRequestCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddApprovalIDs(ids...)
+}
+
 // Mutation returns the DeletionRequestMutation object of the builder.
 func (_c *DeletionRequestCreate) Mutation() *DeletionRequestMutation {
 	return _c.mutation
@@ -213,6 +243,10 @@ func (_c *DeletionRequestCreate) defaults() {
 		v := deletionrequest.DefaultRequestedAt()
 		_c.mutation.SetRequestedAt(v)
 	}
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		v := deletionrequest.DefaultRequiredApprovals
+		_c.mutation.SetRequiredApprovals(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -243,6 +277,14 @@ func (_c *DeletionRequestCreate) check() error {
 	if _, ok := _c.mutation.RequestedAt(); !ok {
 		return &ValidationError{Name: "requested_at", err: errors.New(`db: missing required field "DeletionRequest.requested_at"`)}
 	}
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		return &ValidationError{Name: "required_approvals", err: errors.New(`db: missing required field "DeletionRequest.required_approvals"`)}
+	}
+	if v, ok := _c.mutation.RequiredApprovals(); ok {
+		if err := deletionrequest.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`db: validator failed for field "DeletionRequest.required_approvals": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.AipID(); !ok {
 		return &ValidationError{Name: "aip_id", err: errors.New(`db: missing required field "DeletionRequest.aip_id"`)}
 	}
@@ -330,6 +372,10 @@ func (_c *DeletionRequestCreate) createSpec() (*DeletionRequest, *sqlgraph.Creat
 		_spec.SetField(deletionrequest.FieldReviewedAt, field.TypeTime, value)
 		_node.ReviewedAt = value
 	}
+	if value, ok := _c.mutation.RequiredApprovals(); ok {
+		_spec.SetField(deletionrequest.FieldRequiredApprovals, field.TypeInt, value)
+		_node.RequiredApprovals = value
+	}
 	if nodes := _c.mutation.AipIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -364,6 +410,22 @@ func (_c *DeletionRequestCreate) createSpec() (*DeletionRequest, *sqlgraph.Creat
 		_node.WorkflowID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ApprovalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deletionrequest.ApprovalsTable,
+			Columns: []string{deletionrequest.ApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deletionrequestapproval.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -512,6 +574,24 @@ func (u *DeletionRequestUpsert) ClearReviewedAt() *DeletionRequestUpsert {
 	return u
 }
 
+// SetRequiredApprovals sets the "required_approvals" field.
+func (u *DeletionRequestUpsert) SetRequiredApprovals(v int) *DeletionRequestUpsert {
+	u.Set(deletionrequest.FieldRequiredApprovals, v)
+	return u
+}
+
+// UpdateRequiredApprovals sets the "required_approvals" field to the value that was provided on create.
+func (u *DeletionRequestUpsert) UpdateRequiredApprovals() *DeletionRequestUpsert {
+	u.SetExcluded(deletionrequest.FieldRequiredApprovals)
+	return u
+}
+
+// AddRequiredApprovals adds v to the "required_approvals" field.
+func (u *DeletionRequestUpsert) AddRequiredApprovals(v int) *DeletionRequestUpsert {
+	u.Add(deletionrequest.FieldRequiredApprovals, v)
+	return u
+}
+
 // SetAipID sets the "aip_id" field.
 func (u *DeletionRequestUpsert) SetAipID(v int) *DeletionRequestUpsert {
 	u.Set(deletionrequest.FieldAipID, v)
@@ -711,6 +791,27 @@ func (u *DeletionRequestUpsertOne) ClearReviewedAt() *DeletionRequestUpsertOne {
 	})
 }
 
+// SetRequiredApprovals sets the "required_approvals" field.
+func (u *DeletionRequestUpsertOne) SetRequiredApprovals(v int) *DeletionRequestUpsertOne {
+	return u.Update(func(s *DeletionRequestUpsert) {
+		s.SetRequiredApprovals(v)
+	})
+}
+
+// AddRequiredApprovals adds v to the "required_approvals" field.
+func (u *DeletionRequestUpsertOne) AddRequiredApprovals(v int) *DeletionRequestUpsertOne {
+	return u.Update(func(s *DeletionRequestUpsert) {
+		s.AddRequiredApprovals(v)
+	})
+}
+
+// UpdateRequiredApprovals sets the "required_approvals" field to the value that was provided on create.
+func (u *DeletionRequestUpsertOne) UpdateRequiredApprovals() *DeletionRequestUpsertOne {
+	return u.Update(func(s *DeletionRequestUpsert) {
+		s.UpdateRequiredApprovals()
+	})
+}
+
 // SetAipID sets the "aip_id" field.
 func (u *DeletionRequestUpsertOne) SetAipID(v int) *DeletionRequestUpsertOne {
 	return u.Update(func(s *DeletionRequestUpsert) {
@@ -1081,6 +1182,27 @@ func (u *DeletionRequestUpsertBulk) ClearReviewedAt() *DeletionRequestUpsertBulk
 	})
 }
 
+// SetRequiredApprovals sets the "required_approvals" field.
+func (u *DeletionRequestUpsertBulk) SetRequiredApprovals(v int) *DeletionRequestUpsertBulk {
+	return u.Update(func(s *DeletionRequestUpsert) {
+		s.SetRequiredApprovals(v)
+	})
+}
+
+// AddRequiredApprovals adds v to the "required_approvals" field.
+func (u *DeletionRequestUpsertBulk) AddRequiredApprovals(v int) *DeletionRequestUpsertBulk {
+	return u.Update(func(s *DeletionRequestUpsert) {
+		s.AddRequiredApprovals(v)
+	})
+}
+
+// UpdateRequiredApprovals sets the "required_approvals" field to the value that was provided on create.
+func (u *DeletionRequestUpsertBulk) UpdateRequiredApprovals() *DeletionRequestUpsertBulk {
+	return u.Update(func(s *DeletionRequestUpsert) {
+		s.UpdateRequiredApprovals()
+	})
+}
+
 // SetAipID sets the "aip_id" field.
 func (u *DeletionRequestUpsertBulk) SetAipID(v int) *DeletionRequestUpsertBulk {
 	return u.Update(func(s *DeletionRequestUpsert) {
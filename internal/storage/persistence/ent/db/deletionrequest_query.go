@@ -4,6 +4,7 @@ package db
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -13,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 )
@@ -20,12 +22,13 @@ import (
 // DeletionRequestQuery is the builder for querying DeletionRequest entities.
 type DeletionRequestQuery struct {
 	config
-	ctx          *QueryContext
-	order        []deletionrequest.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.DeletionRequest
-	withAip      *AIPQuery
-	withWorkflow *WorkflowQuery
+	ctx           *QueryContext
+	order         []deletionrequest.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.DeletionRequest
+	withAip       *AIPQuery
+	withWorkflow  *WorkflowQuery
+	withApprovals *DeletionRequestApprovalQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +109,28 @@ func (_q *DeletionRequestQuery) QueryWorkflow() *WorkflowQuery {
 	return query
 }
 
+// QueryApprovals chains the current query on the "approvals" edge.
+func (_q *DeletionRequestQuery) QueryApprovals() *DeletionRequestApprovalQuery {
+	query := (&DeletionRequestApprovalClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deletionrequest.Table, deletionrequest.FieldID, selector),
+			sqlgraph.To(deletionrequestapproval.Table, deletionrequestapproval.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deletionrequest.ApprovalsTable, deletionrequest.ApprovalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first DeletionRequest entity from the query.
 // Returns a *NotFoundError when no DeletionRequest was found.
 func (_q *DeletionRequestQuery) First(ctx context.Context) (*DeletionRequest, error) {
@@ -293,13 +318,14 @@ func (_q *DeletionRequestQuery) Clone() *DeletionRequestQuery {
 		return nil
 	}
 	return &DeletionRequestQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]deletionrequest.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.DeletionRequest{}, _q.predicates...),
-		withAip:      _q.withAip.Clone(),
-		withWorkflow: _q.withWorkflow.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]deletionrequest.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.DeletionRequest{}, _q.predicates...),
+		withAip:       _q.withAip.Clone(),
+		withWorkflow:  _q.withWorkflow.Clone(),
+		withApprovals: _q.withApprovals.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -328,6 +354,17 @@ func (_q *DeletionRequestQuery) WithWorkflow(opts ...func(*WorkflowQuery)) *Dele
 	return _q
 }
 
+// WithApprovals tells the query-builder to eager-load the nodes that are connected to
+// the "approvals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DeletionRequestQuery) WithApprovals(opts ...func(*DeletionRequestApprovalQuery)) *DeletionRequestQuery {
+	query := (&DeletionRequestApprovalClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withApprovals = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +443,10 @@ func (_q *DeletionRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*DeletionRequest{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withAip != nil,
 			_q.withWorkflow != nil,
+			_q.withApprovals != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +479,13 @@ func (_q *DeletionRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withApprovals; query != nil {
+		if err := _q.loadApprovals(ctx, query, nodes,
+			func(n *DeletionRequest) { n.Edges.Approvals = []*DeletionRequestApproval{} },
+			func(n *DeletionRequest, e *DeletionRequestApproval) { n.Edges.Approvals = append(n.Edges.Approvals, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,6 +547,36 @@ func (_q *DeletionRequestQuery) loadWorkflow(ctx context.Context, query *Workflo
 	}
 	return nil
 }
+func (_q *DeletionRequestQuery) loadApprovals(ctx context.Context, query *DeletionRequestApprovalQuery, nodes []*DeletionRequest, init func(*DeletionRequest), assign func(*DeletionRequest, *DeletionRequestApproval)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*DeletionRequest)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(deletionrequestapproval.FieldDeletionRequestID)
+	}
+	query.Where(predicate.DeletionRequestApproval(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(deletionrequest.ApprovalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DeletionRequestID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "deletion_request_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DeletionRequestQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
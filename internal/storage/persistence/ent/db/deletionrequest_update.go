@@ -14,6 +14,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 	"github.com/google/uuid"
@@ -140,6 +141,27 @@ func (_u *DeletionRequestUpdate) ClearReviewedAt() *DeletionRequestUpdate {
 	return _u
 }
 
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *DeletionRequestUpdate) SetRequiredApprovals(v int) *DeletionRequestUpdate {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *DeletionRequestUpdate) SetNillableRequiredApprovals(v *int) *DeletionRequestUpdate {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *DeletionRequestUpdate) AddRequiredApprovals(v int) *DeletionRequestUpdate {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
 // SetAipID sets the "aip_id" field.
 func (_u *DeletionRequestUpdate) SetAipID(v int) *DeletionRequestUpdate {
 	_u.mutation.SetAipID(v)
@@ -184,6 +206,21 @@ func (_u *DeletionRequestUpdate) SetWorkflow(v *Workflow) *DeletionRequestUpdate
 	return _u.SetWorkflowID(v.ID)
 }
 
+// AddApprovalIDs adds the "approvals" edge to the DeletionRequestApproval entity by IDs.
+func (_u *DeletionRequestUpdate) AddApprovalIDs(ids ...int) *DeletionRequestUpdate {
+	_u.mutation.AddApprovalIDs(ids...)
+	return _u
+}
+
+// AddApprovals adds the "approvals" edges to the DeletionRequestApproval entity.
+func (_u *DeletionRequestUpdate) AddApprovals(v ...*DeletionRequestApproval) *DeletionRequestUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddApprovalIDs(ids...)
+}
+
 // Mutation returns the DeletionRequestMutation object of the builder.
 func (_u *DeletionRequestUpdate) Mutation() *DeletionRequestMutation {
 	return _u.mutation
@@ -201,6 +238,27 @@ func (_u *DeletionRequestUpdate) ClearWorkflow() *DeletionRequestUpdate {
 	return _u
 }
 
+// ClearApprovals clears all "approvals" edges to the DeletionRequestApproval entity.
+func (_u *DeletionRequestUpdate) ClearApprovals() *DeletionRequestUpdate {
+	_u.mutation.ClearApprovals()
+	return _u
+}
+
+// RemoveApprovalIDs removes the "approvals" edge to DeletionRequestApproval entities by IDs.
+func (_u *DeletionRequestUpdate) RemoveApprovalIDs(ids ...int) *DeletionRequestUpdate {
+	_u.mutation.RemoveApprovalIDs(ids...)
+	return _u
+}
+
+// RemoveApprovals removes "approvals" edges to DeletionRequestApproval entities.
+func (_u *DeletionRequestUpdate) RemoveApprovals(v ...*DeletionRequestApproval) *DeletionRequestUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveApprovalIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DeletionRequestUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -235,6 +293,11 @@ func (_u *DeletionRequestUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "DeletionRequest.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := deletionrequest.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`db: validator failed for field "DeletionRequest.required_approvals": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.AipID(); ok {
 		if err := deletionrequest.AipIDValidator(v); err != nil {
 			return &ValidationError{Name: "aip_id", err: fmt.Errorf(`db: validator failed for field "DeletionRequest.aip_id": %w`, err)}
@@ -293,6 +356,12 @@ func (_u *DeletionRequestUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.ReviewedAtCleared() {
 		_spec.ClearField(deletionrequest.FieldReviewedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(deletionrequest.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(deletionrequest.FieldRequiredApprovals, field.TypeInt, value)
+	}
 	if _u.mutation.AipCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -351,6 +420,51 @@ func (_u *DeletionRequestUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ApprovalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deletionrequest.ApprovalsTable,
+			Columns: []string{deletionrequest.ApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deletionrequestapproval.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedApprovalsIDs(); len(nodes) > 0 && !_u.mutation.ApprovalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deletionrequest.ApprovalsTable,
+			Columns: []string{deletionrequest.ApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deletionrequestapproval.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ApprovalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deletionrequest.ApprovalsTable,
+			Columns: []string{deletionrequest.ApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deletionrequestapproval.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{deletionrequest.Label}
@@ -479,6 +593,27 @@ func (_u *DeletionRequestUpdateOne) ClearReviewedAt() *DeletionRequestUpdateOne
 	return _u
 }
 
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *DeletionRequestUpdateOne) SetRequiredApprovals(v int) *DeletionRequestUpdateOne {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *DeletionRequestUpdateOne) SetNillableRequiredApprovals(v *int) *DeletionRequestUpdateOne {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *DeletionRequestUpdateOne) AddRequiredApprovals(v int) *DeletionRequestUpdateOne {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
 // SetAipID sets the "aip_id" field.
 func (_u *DeletionRequestUpdateOne) SetAipID(v int) *DeletionRequestUpdateOne {
 	_u.mutation.SetAipID(v)
@@ -523,6 +658,21 @@ func (_u *DeletionRequestUpdateOne) SetWorkflow(v *Workflow) *DeletionRequestUpd
 	return _u.SetWorkflowID(v.ID)
 }
 
+// AddApprovalIDs adds the "approvals" edge to the DeletionRequestApproval entity by IDs.
+func (_u *DeletionRequestUpdateOne) AddApprovalIDs(ids ...int) *DeletionRequestUpdateOne {
+	_u.mutation.AddApprovalIDs(ids...)
+	return _u
+}
+
+// AddApprovals adds the "approvals" edges to the DeletionRequestApproval entity.
+func (_u *DeletionRequestUpdateOne) AddApprovals(v ...*DeletionRequestApproval) *DeletionRequestUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddApprovalIDs(ids...)
+}
+
 // Mutation returns the DeletionRequestMutation object of the builder.
 func (_u *DeletionRequestUpdateOne) Mutation() *DeletionRequestMutation {
 	return _u.mutation
@@ -540,6 +690,27 @@ func (_u *DeletionRequestUpdateOne) ClearWorkflow() *DeletionRequestUpdateOne {
 	return _u
 }
 
+// ClearApprovals clears all "approvals" edges to the DeletionRequestApproval entity.
+func (_u *DeletionRequestUpdateOne) ClearApprovals() *DeletionRequestUpdateOne {
+	_u.mutation.ClearApprovals()
+	return _u
+}
+
+// RemoveApprovalIDs removes the "approvals" edge to DeletionRequestApproval entities by IDs.
+func (_u *DeletionRequestUpdateOne) RemoveApprovalIDs(ids ...int) *DeletionRequestUpdateOne {
+	_u.mutation.RemoveApprovalIDs(ids...)
+	return _u
+}
+
+// RemoveApprovals removes "approvals" edges to DeletionRequestApproval entities.
+func (_u *DeletionRequestUpdateOne) RemoveApprovals(v ...*DeletionRequestApproval) *DeletionRequestUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveApprovalIDs(ids...)
+}
+
 // Where appends a list predicates to the DeletionRequestUpdate builder.
 func (_u *DeletionRequestUpdateOne) Where(ps ...predicate.DeletionRequest) *DeletionRequestUpdateOne {
 	_u.mutation.Where(ps...)
@@ -587,6 +758,11 @@ func (_u *DeletionRequestUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "DeletionRequest.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := deletionrequest.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`db: validator failed for field "DeletionRequest.required_approvals": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.AipID(); ok {
 		if err := deletionrequest.AipIDValidator(v); err != nil {
 			return &ValidationError{Name: "aip_id", err: fmt.Errorf(`db: validator failed for field "DeletionRequest.aip_id": %w`, err)}
@@ -662,6 +838,12 @@ func (_u *DeletionRequestUpdateOne) sqlSave(ctx context.Context) (_node *Deletio
 	if _u.mutation.ReviewedAtCleared() {
 		_spec.ClearField(deletionrequest.FieldReviewedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(deletionrequest.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(deletionrequest.FieldRequiredApprovals, field.TypeInt, value)
+	}
 	if _u.mutation.AipCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -720,6 +902,51 @@ func (_u *DeletionRequestUpdateOne) sqlSave(ctx context.Context) (_node *Deletio
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ApprovalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deletionrequest.ApprovalsTable,
+			Columns: []string{deletionrequest.ApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deletionrequestapproval.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedApprovalsIDs(); len(nodes) > 0 && !_u.mutation.ApprovalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deletionrequest.ApprovalsTable,
+			Columns: []string{deletionrequest.ApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deletionrequestapproval.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ApprovalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deletionrequest.ApprovalsTable,
+			Columns: []string{deletionrequest.ApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deletionrequestapproval.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &DeletionRequest{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deletionrequestapproval
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLTE(FieldID, id))
+}
+
+// Approver applies equality check predicate on the "approver" field. It's identical to ApproverEQ.
+func Approver(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldApprover, v))
+}
+
+// ApproverIss applies equality check predicate on the "approver_iss" field. It's identical to ApproverIssEQ.
+func ApproverIss(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldApproverIss, v))
+}
+
+// ApproverSub applies equality check predicate on the "approver_sub" field. It's identical to ApproverSubEQ.
+func ApproverSub(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldApproverSub, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// DeletionRequestID applies equality check predicate on the "deletion_request_id" field. It's identical to DeletionRequestIDEQ.
+func DeletionRequestID(v int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldDeletionRequestID, v))
+}
+
+// ApproverEQ applies the EQ predicate on the "approver" field.
+func ApproverEQ(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldApprover, v))
+}
+
+// ApproverNEQ applies the NEQ predicate on the "approver" field.
+func ApproverNEQ(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNEQ(FieldApprover, v))
+}
+
+// ApproverIn applies the In predicate on the "approver" field.
+func ApproverIn(vs ...string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldIn(FieldApprover, vs...))
+}
+
+// ApproverNotIn applies the NotIn predicate on the "approver" field.
+func ApproverNotIn(vs ...string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNotIn(FieldApprover, vs...))
+}
+
+// ApproverGT applies the GT predicate on the "approver" field.
+func ApproverGT(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGT(FieldApprover, v))
+}
+
+// ApproverGTE applies the GTE predicate on the "approver" field.
+func ApproverGTE(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGTE(FieldApprover, v))
+}
+
+// ApproverLT applies the LT predicate on the "approver" field.
+func ApproverLT(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLT(FieldApprover, v))
+}
+
+// ApproverLTE applies the LTE predicate on the "approver" field.
+func ApproverLTE(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLTE(FieldApprover, v))
+}
+
+// ApproverContains applies the Contains predicate on the "approver" field.
+func ApproverContains(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldContains(FieldApprover, v))
+}
+
+// ApproverHasPrefix applies the HasPrefix predicate on the "approver" field.
+func ApproverHasPrefix(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldHasPrefix(FieldApprover, v))
+}
+
+// ApproverHasSuffix applies the HasSuffix predicate on the "approver" field.
+func ApproverHasSuffix(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldHasSuffix(FieldApprover, v))
+}
+
+// ApproverEqualFold applies the EqualFold predicate on the "approver" field.
+func ApproverEqualFold(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEqualFold(FieldApprover, v))
+}
+
+// ApproverContainsFold applies the ContainsFold predicate on the "approver" field.
+func ApproverContainsFold(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldContainsFold(FieldApprover, v))
+}
+
+// ApproverIssEQ applies the EQ predicate on the "approver_iss" field.
+func ApproverIssEQ(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldApproverIss, v))
+}
+
+// ApproverIssNEQ applies the NEQ predicate on the "approver_iss" field.
+func ApproverIssNEQ(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNEQ(FieldApproverIss, v))
+}
+
+// ApproverIssIn applies the In predicate on the "approver_iss" field.
+func ApproverIssIn(vs ...string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldIn(FieldApproverIss, vs...))
+}
+
+// ApproverIssNotIn applies the NotIn predicate on the "approver_iss" field.
+func ApproverIssNotIn(vs ...string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNotIn(FieldApproverIss, vs...))
+}
+
+// ApproverIssGT applies the GT predicate on the "approver_iss" field.
+func ApproverIssGT(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGT(FieldApproverIss, v))
+}
+
+// ApproverIssGTE applies the GTE predicate on the "approver_iss" field.
+func ApproverIssGTE(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGTE(FieldApproverIss, v))
+}
+
+// ApproverIssLT applies the LT predicate on the "approver_iss" field.
+func ApproverIssLT(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLT(FieldApproverIss, v))
+}
+
+// ApproverIssLTE applies the LTE predicate on the "approver_iss" field.
+func ApproverIssLTE(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLTE(FieldApproverIss, v))
+}
+
+// ApproverIssContains applies the Contains predicate on the "approver_iss" field.
+func ApproverIssContains(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldContains(FieldApproverIss, v))
+}
+
+// ApproverIssHasPrefix applies the HasPrefix predicate on the "approver_iss" field.
+func ApproverIssHasPrefix(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldHasPrefix(FieldApproverIss, v))
+}
+
+// ApproverIssHasSuffix applies the HasSuffix predicate on the "approver_iss" field.
+func ApproverIssHasSuffix(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldHasSuffix(FieldApproverIss, v))
+}
+
+// ApproverIssEqualFold applies the EqualFold predicate on the "approver_iss" field.
+func ApproverIssEqualFold(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEqualFold(FieldApproverIss, v))
+}
+
+// ApproverIssContainsFold applies the ContainsFold predicate on the "approver_iss" field.
+func ApproverIssContainsFold(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldContainsFold(FieldApproverIss, v))
+}
+
+// ApproverSubEQ applies the EQ predicate on the "approver_sub" field.
+func ApproverSubEQ(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldApproverSub, v))
+}
+
+// ApproverSubNEQ applies the NEQ predicate on the "approver_sub" field.
+func ApproverSubNEQ(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNEQ(FieldApproverSub, v))
+}
+
+// ApproverSubIn applies the In predicate on the "approver_sub" field.
+func ApproverSubIn(vs ...string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldIn(FieldApproverSub, vs...))
+}
+
+// ApproverSubNotIn applies the NotIn predicate on the "approver_sub" field.
+func ApproverSubNotIn(vs ...string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNotIn(FieldApproverSub, vs...))
+}
+
+// ApproverSubGT applies the GT predicate on the "approver_sub" field.
+func ApproverSubGT(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGT(FieldApproverSub, v))
+}
+
+// ApproverSubGTE applies the GTE predicate on the "approver_sub" field.
+func ApproverSubGTE(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGTE(FieldApproverSub, v))
+}
+
+// ApproverSubLT applies the LT predicate on the "approver_sub" field.
+func ApproverSubLT(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLT(FieldApproverSub, v))
+}
+
+// ApproverSubLTE applies the LTE predicate on the "approver_sub" field.
+func ApproverSubLTE(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLTE(FieldApproverSub, v))
+}
+
+// ApproverSubContains applies the Contains predicate on the "approver_sub" field.
+func ApproverSubContains(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldContains(FieldApproverSub, v))
+}
+
+// ApproverSubHasPrefix applies the HasPrefix predicate on the "approver_sub" field.
+func ApproverSubHasPrefix(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldHasPrefix(FieldApproverSub, v))
+}
+
+// ApproverSubHasSuffix applies the HasSuffix predicate on the "approver_sub" field.
+func ApproverSubHasSuffix(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldHasSuffix(FieldApproverSub, v))
+}
+
+// ApproverSubEqualFold applies the EqualFold predicate on the "approver_sub" field.
+func ApproverSubEqualFold(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEqualFold(FieldApproverSub, v))
+}
+
+// ApproverSubContainsFold applies the ContainsFold predicate on the "approver_sub" field.
+func ApproverSubContainsFold(v string) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldContainsFold(FieldApproverSub, v))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// DeletionRequestIDEQ applies the EQ predicate on the "deletion_request_id" field.
+func DeletionRequestIDEQ(v int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldEQ(FieldDeletionRequestID, v))
+}
+
+// DeletionRequestIDNEQ applies the NEQ predicate on the "deletion_request_id" field.
+func DeletionRequestIDNEQ(v int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNEQ(FieldDeletionRequestID, v))
+}
+
+// DeletionRequestIDIn applies the In predicate on the "deletion_request_id" field.
+func DeletionRequestIDIn(vs ...int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldIn(FieldDeletionRequestID, vs...))
+}
+
+// DeletionRequestIDNotIn applies the NotIn predicate on the "deletion_request_id" field.
+func DeletionRequestIDNotIn(vs ...int) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.FieldNotIn(FieldDeletionRequestID, vs...))
+}
+
+// HasDeletionRequest applies the HasEdge predicate on the "deletion_request" edge.
+func HasDeletionRequest() predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DeletionRequestTable, DeletionRequestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeletionRequestWith applies the HasEdge predicate on the "deletion_request" edge with a given conditions (other predicates).
+func HasDeletionRequestWith(preds ...predicate.DeletionRequest) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(func(s *sql.Selector) {
+		step := newDeletionRequestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeletionRequestApproval) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeletionRequestApproval) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeletionRequestApproval) predicate.DeletionRequestApproval {
+	return predicate.DeletionRequestApproval(sql.NotPredicates(p))
+}
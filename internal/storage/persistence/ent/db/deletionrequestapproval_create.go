@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
+)
+
+// DeletionRequestApprovalCreate is the builder for creating a DeletionRequestApproval entity.
+type DeletionRequestApprovalCreate struct {
+	config
+	mutation *DeletionRequestApprovalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetApprover sets the "approver" field.
+func (_c *DeletionRequestApprovalCreate) SetApprover(v string) *DeletionRequestApprovalCreate {
+	_c.mutation.SetApprover(v)
+	return _c
+}
+
+// SetApproverIss sets the "approver_iss" field.
+func (_c *DeletionRequestApprovalCreate) SetApproverIss(v string) *DeletionRequestApprovalCreate {
+	_c.mutation.SetApproverIss(v)
+	return _c
+}
+
+// SetApproverSub sets the "approver_sub" field.
+func (_c *DeletionRequestApprovalCreate) SetApproverSub(v string) *DeletionRequestApprovalCreate {
+	_c.mutation.SetApproverSub(v)
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *DeletionRequestApprovalCreate) SetApprovedAt(v time.Time) *DeletionRequestApprovalCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *DeletionRequestApprovalCreate) SetNillableApprovedAt(v *time.Time) *DeletionRequestApprovalCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletionRequestID sets the "deletion_request_id" field.
+func (_c *DeletionRequestApprovalCreate) SetDeletionRequestID(v int) *DeletionRequestApprovalCreate {
+	_c.mutation.SetDeletionRequestID(v)
+	return _c
+}
+
+// SetDeletionRequest sets the "deletion_request" edge to the DeletionRequest entity.
+func (_c *DeletionRequestApprovalCreate) SetDeletionRequest(v *DeletionRequest) *DeletionRequestApprovalCreate {
+	return _c.SetDeletionRequestID(v.ID)
+}
+
+// Mutation returns the DeletionRequestApprovalMutation object of the builder.
+func (_c *DeletionRequestApprovalCreate) Mutation() *DeletionRequestApprovalMutation {
+	return _c.mutation
+}
+
+// Save creates the DeletionRequestApproval in the database.
+func (_c *DeletionRequestApprovalCreate) Save(ctx context.Context) (*DeletionRequestApproval, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeletionRequestApprovalCreate) SaveX(ctx context.Context) *DeletionRequestApproval {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeletionRequestApprovalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeletionRequestApprovalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeletionRequestApprovalCreate) defaults() {
+	if _, ok := _c.mutation.ApprovedAt(); !ok {
+		v := deletionrequestapproval.DefaultApprovedAt()
+		_c.mutation.SetApprovedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeletionRequestApprovalCreate) check() error {
+	if _, ok := _c.mutation.Approver(); !ok {
+		return &ValidationError{Name: "approver", err: errors.New(`db: missing required field "DeletionRequestApproval.approver"`)}
+	}
+	if _, ok := _c.mutation.ApproverIss(); !ok {
+		return &ValidationError{Name: "approver_iss", err: errors.New(`db: missing required field "DeletionRequestApproval.approver_iss"`)}
+	}
+	if _, ok := _c.mutation.ApproverSub(); !ok {
+		return &ValidationError{Name: "approver_sub", err: errors.New(`db: missing required field "DeletionRequestApproval.approver_sub"`)}
+	}
+	if _, ok := _c.mutation.ApprovedAt(); !ok {
+		return &ValidationError{Name: "approved_at", err: errors.New(`db: missing required field "DeletionRequestApproval.approved_at"`)}
+	}
+	if _, ok := _c.mutation.DeletionRequestID(); !ok {
+		return &ValidationError{Name: "deletion_request_id", err: errors.New(`db: missing required field "DeletionRequestApproval.deletion_request_id"`)}
+	}
+	if v, ok := _c.mutation.DeletionRequestID(); ok {
+		if err := deletionrequestapproval.DeletionRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_request_id", err: fmt.Errorf(`db: validator failed for field "DeletionRequestApproval.deletion_request_id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.DeletionRequestIDs()) == 0 {
+		return &ValidationError{Name: "deletion_request", err: errors.New(`db: missing required edge "DeletionRequestApproval.deletion_request"`)}
+	}
+	return nil
+}
+
+func (_c *DeletionRequestApprovalCreate) sqlSave(ctx context.Context) (*DeletionRequestApproval, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeletionRequestApprovalCreate) createSpec() (*DeletionRequestApproval, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeletionRequestApproval{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deletionrequestapproval.Table, sqlgraph.NewFieldSpec(deletionrequestapproval.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Approver(); ok {
+		_spec.SetField(deletionrequestapproval.FieldApprover, field.TypeString, value)
+		_node.Approver = value
+	}
+	if value, ok := _c.mutation.ApproverIss(); ok {
+		_spec.SetField(deletionrequestapproval.FieldApproverIss, field.TypeString, value)
+		_node.ApproverIss = value
+	}
+	if value, ok := _c.mutation.ApproverSub(); ok {
+		_spec.SetField(deletionrequestapproval.FieldApproverSub, field.TypeString, value)
+		_node.ApproverSub = value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(deletionrequestapproval.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = value
+	}
+	if nodes := _c.mutation.DeletionRequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deletionrequestapproval.DeletionRequestTable,
+			Columns: []string{deletionrequestapproval.DeletionRequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deletionrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DeletionRequestID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeletionRequestApproval.Create().
+//		SetApprover(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeletionRequestApprovalUpsert) {
+//			SetApprover(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeletionRequestApprovalCreate) OnConflict(opts ...sql.ConflictOption) *DeletionRequestApprovalUpsertOne {
+	_c.conflict = opts
+	return &DeletionRequestApprovalUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeletionRequestApproval.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeletionRequestApprovalCreate) OnConflictColumns(columns ...string) *DeletionRequestApprovalUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeletionRequestApprovalUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DeletionRequestApprovalUpsertOne is the builder for "upsert"-ing
+	//  one DeletionRequestApproval node.
+	DeletionRequestApprovalUpsertOne struct {
+		create *DeletionRequestApprovalCreate
+	}
+
+	// DeletionRequestApprovalUpsert is the "OnConflict" setter.
+	DeletionRequestApprovalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DeletionRequestApproval.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeletionRequestApprovalUpsertOne) UpdateNewValues() *DeletionRequestApprovalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Approver(); exists {
+			s.SetIgnore(deletionrequestapproval.FieldApprover)
+		}
+		if _, exists := u.create.mutation.ApproverIss(); exists {
+			s.SetIgnore(deletionrequestapproval.FieldApproverIss)
+		}
+		if _, exists := u.create.mutation.ApproverSub(); exists {
+			s.SetIgnore(deletionrequestapproval.FieldApproverSub)
+		}
+		if _, exists := u.create.mutation.ApprovedAt(); exists {
+			s.SetIgnore(deletionrequestapproval.FieldApprovedAt)
+		}
+		if _, exists := u.create.mutation.DeletionRequestID(); exists {
+			s.SetIgnore(deletionrequestapproval.FieldDeletionRequestID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeletionRequestApproval.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeletionRequestApprovalUpsertOne) Ignore() *DeletionRequestApprovalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeletionRequestApprovalUpsertOne) DoNothing() *DeletionRequestApprovalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeletionRequestApprovalCreate.OnConflict
+// documentation for more info.
+func (u *DeletionRequestApprovalUpsertOne) Update(set func(*DeletionRequestApprovalUpsert)) *DeletionRequestApprovalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeletionRequestApprovalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *DeletionRequestApprovalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for DeletionRequestApprovalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeletionRequestApprovalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeletionRequestApprovalUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeletionRequestApprovalUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeletionRequestApprovalCreateBulk is the builder for creating many DeletionRequestApproval entities in bulk.
+type DeletionRequestApprovalCreateBulk struct {
+	config
+	err      error
+	builders []*DeletionRequestApprovalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeletionRequestApproval entities in the database.
+func (_c *DeletionRequestApprovalCreateBulk) Save(ctx context.Context) ([]*DeletionRequestApproval, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeletionRequestApproval, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeletionRequestApprovalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeletionRequestApprovalCreateBulk) SaveX(ctx context.Context) []*DeletionRequestApproval {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeletionRequestApprovalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeletionRequestApprovalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeletionRequestApproval.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeletionRequestApprovalUpsert) {
+//			SetApprover(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeletionRequestApprovalCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeletionRequestApprovalUpsertBulk {
+	_c.conflict = opts
+	return &DeletionRequestApprovalUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeletionRequestApproval.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeletionRequestApprovalCreateBulk) OnConflictColumns(columns ...string) *DeletionRequestApprovalUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeletionRequestApprovalUpsertBulk{
+		create: _c,
+	}
+}
+
+// DeletionRequestApprovalUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeletionRequestApproval nodes.
+type DeletionRequestApprovalUpsertBulk struct {
+	create *DeletionRequestApprovalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeletionRequestApproval.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeletionRequestApprovalUpsertBulk) UpdateNewValues() *DeletionRequestApprovalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Approver(); exists {
+				s.SetIgnore(deletionrequestapproval.FieldApprover)
+			}
+			if _, exists := b.mutation.ApproverIss(); exists {
+				s.SetIgnore(deletionrequestapproval.FieldApproverIss)
+			}
+			if _, exists := b.mutation.ApproverSub(); exists {
+				s.SetIgnore(deletionrequestapproval.FieldApproverSub)
+			}
+			if _, exists := b.mutation.ApprovedAt(); exists {
+				s.SetIgnore(deletionrequestapproval.FieldApprovedAt)
+			}
+			if _, exists := b.mutation.DeletionRequestID(); exists {
+				s.SetIgnore(deletionrequestapproval.FieldDeletionRequestID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeletionRequestApproval.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeletionRequestApprovalUpsertBulk) Ignore() *DeletionRequestApprovalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeletionRequestApprovalUpsertBulk) DoNothing() *DeletionRequestApprovalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeletionRequestApprovalCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeletionRequestApprovalUpsertBulk) Update(set func(*DeletionRequestApprovalUpsert)) *DeletionRequestApprovalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeletionRequestApprovalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *DeletionRequestApprovalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the DeletionRequestApprovalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for DeletionRequestApprovalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeletionRequestApprovalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
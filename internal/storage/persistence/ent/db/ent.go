@@ -13,8 +13,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/searchdocument"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 )
@@ -77,11 +82,16 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			aip.Table:             aip.ValidColumn,
-			deletionrequest.Table: deletionrequest.ValidColumn,
-			location.Table:        location.ValidColumn,
-			task.Table:            task.ValidColumn,
-			workflow.Table:        workflow.ValidColumn,
+			aip.Table:                     aip.ValidColumn,
+			aiplegalhold.Table:            aiplegalhold.ValidColumn,
+			aipreplica.Table:              aipreplica.ValidColumn,
+			deletionrequest.Table:         deletionrequest.ValidColumn,
+			deletionrequestapproval.Table: deletionrequestapproval.ValidColumn,
+			fixitycheck.Table:             fixitycheck.ValidColumn,
+			location.Table:                location.ValidColumn,
+			searchdocument.Table:          searchdocument.ValidColumn,
+			task.Table:                    task.ValidColumn,
+			workflow.Table:                workflow.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
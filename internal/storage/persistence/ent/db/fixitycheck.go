@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
+	"github.com/google/uuid"
+)
+
+// FixityCheck is the model entity for the FixityCheck schema.
+type FixityCheck struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UUID holds the value of the "uuid" field.
+	UUID uuid.UUID `json:"uuid,omitempty"`
+	// ChecksumAlgorithm holds the value of the "checksum_algorithm" field.
+	ChecksumAlgorithm string `json:"checksum_algorithm,omitempty"`
+	// ExpectedChecksum holds the value of the "expected_checksum" field.
+	ExpectedChecksum string `json:"expected_checksum,omitempty"`
+	// ActualChecksum holds the value of the "actual_checksum" field.
+	ActualChecksum string `json:"actual_checksum,omitempty"`
+	// Status holds the value of the "status" field.
+	Status enums.FixityCheckStatus `json:"status,omitempty"`
+	// CheckedAt holds the value of the "checked_at" field.
+	CheckedAt time.Time `json:"checked_at,omitempty"`
+	// AipID holds the value of the "aip_id" field.
+	AipID int `json:"aip_id,omitempty"`
+	// WorkflowID holds the value of the "workflow_id" field.
+	WorkflowID int `json:"workflow_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FixityCheckQuery when eager-loading is set.
+	Edges        FixityCheckEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FixityCheckEdges holds the relations/edges for other nodes in the graph.
+type FixityCheckEdges struct {
+	// Aip holds the value of the aip edge.
+	Aip *AIP `json:"aip,omitempty"`
+	// Workflow holds the value of the workflow edge.
+	Workflow *Workflow `json:"workflow,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AipOrErr returns the Aip value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FixityCheckEdges) AipOrErr() (*AIP, error) {
+	if e.Aip != nil {
+		return e.Aip, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: aip.Label}
+	}
+	return nil, &NotLoadedError{edge: "aip"}
+}
+
+// WorkflowOrErr returns the Workflow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FixityCheckEdges) WorkflowOrErr() (*Workflow, error) {
+	if e.Workflow != nil {
+		return e.Workflow, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: workflow.Label}
+	}
+	return nil, &NotLoadedError{edge: "workflow"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FixityCheck) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fixitycheck.FieldID, fixitycheck.FieldAipID, fixitycheck.FieldWorkflowID:
+			values[i] = new(sql.NullInt64)
+		case fixitycheck.FieldChecksumAlgorithm, fixitycheck.FieldExpectedChecksum, fixitycheck.FieldActualChecksum, fixitycheck.FieldStatus:
+			values[i] = new(sql.NullString)
+		case fixitycheck.FieldCheckedAt:
+			values[i] = new(sql.NullTime)
+		case fixitycheck.FieldUUID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FixityCheck fields.
+func (_m *FixityCheck) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fixitycheck.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case fixitycheck.FieldUUID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field uuid", values[i])
+			} else if value != nil {
+				_m.UUID = *value
+			}
+		case fixitycheck.FieldChecksumAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum_algorithm", values[i])
+			} else if value.Valid {
+				_m.ChecksumAlgorithm = value.String
+			}
+		case fixitycheck.FieldExpectedChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_checksum", values[i])
+			} else if value.Valid {
+				_m.ExpectedChecksum = value.String
+			}
+		case fixitycheck.FieldActualChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actual_checksum", values[i])
+			} else if value.Valid {
+				_m.ActualChecksum = value.String
+			}
+		case fixitycheck.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = enums.FixityCheckStatus(value.String)
+			}
+		case fixitycheck.FieldCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
+			} else if value.Valid {
+				_m.CheckedAt = value.Time
+			}
+		case fixitycheck.FieldAipID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field aip_id", values[i])
+			} else if value.Valid {
+				_m.AipID = int(value.Int64)
+			}
+		case fixitycheck.FieldWorkflowID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value.Valid {
+				_m.WorkflowID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FixityCheck.
+// This includes values selected through modifiers, order, etc.
+func (_m *FixityCheck) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAip queries the "aip" edge of the FixityCheck entity.
+func (_m *FixityCheck) QueryAip() *AIPQuery {
+	return NewFixityCheckClient(_m.config).QueryAip(_m)
+}
+
+// QueryWorkflow queries the "workflow" edge of the FixityCheck entity.
+func (_m *FixityCheck) QueryWorkflow() *WorkflowQuery {
+	return NewFixityCheckClient(_m.config).QueryWorkflow(_m)
+}
+
+// Update returns a builder for updating this FixityCheck.
+// Note that you need to call FixityCheck.Unwrap() before calling this method if this FixityCheck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FixityCheck) Update() *FixityCheckUpdateOne {
+	return NewFixityCheckClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FixityCheck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FixityCheck) Unwrap() *FixityCheck {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: FixityCheck is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FixityCheck) String() string {
+	var builder strings.Builder
+	builder.WriteString("FixityCheck(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("uuid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UUID))
+	builder.WriteString(", ")
+	builder.WriteString("checksum_algorithm=")
+	builder.WriteString(_m.ChecksumAlgorithm)
+	builder.WriteString(", ")
+	builder.WriteString("expected_checksum=")
+	builder.WriteString(_m.ExpectedChecksum)
+	builder.WriteString(", ")
+	builder.WriteString("actual_checksum=")
+	builder.WriteString(_m.ActualChecksum)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("checked_at=")
+	builder.WriteString(_m.CheckedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("aip_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AipID))
+	builder.WriteString(", ")
+	builder.WriteString("workflow_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FixityChecks is a parsable slice of FixityCheck.
+type FixityChecks []*FixityCheck
@@ -0,0 +1,509 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fixitycheck
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLTE(FieldID, id))
+}
+
+// UUID applies equality check predicate on the "uuid" field. It's identical to UUIDEQ.
+func UUID(v uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldUUID, v))
+}
+
+// ChecksumAlgorithm applies equality check predicate on the "checksum_algorithm" field. It's identical to ChecksumAlgorithmEQ.
+func ChecksumAlgorithm(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldChecksumAlgorithm, v))
+}
+
+// ExpectedChecksum applies equality check predicate on the "expected_checksum" field. It's identical to ExpectedChecksumEQ.
+func ExpectedChecksum(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldExpectedChecksum, v))
+}
+
+// ActualChecksum applies equality check predicate on the "actual_checksum" field. It's identical to ActualChecksumEQ.
+func ActualChecksum(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldActualChecksum, v))
+}
+
+// CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
+func CheckedAt(v time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// AipID applies equality check predicate on the "aip_id" field. It's identical to AipIDEQ.
+func AipID(v int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldAipID, v))
+}
+
+// WorkflowID applies equality check predicate on the "workflow_id" field. It's identical to WorkflowIDEQ.
+func WorkflowID(v int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// UUIDEQ applies the EQ predicate on the "uuid" field.
+func UUIDEQ(v uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldUUID, v))
+}
+
+// UUIDNEQ applies the NEQ predicate on the "uuid" field.
+func UUIDNEQ(v uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNEQ(FieldUUID, v))
+}
+
+// UUIDIn applies the In predicate on the "uuid" field.
+func UUIDIn(vs ...uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIn(FieldUUID, vs...))
+}
+
+// UUIDNotIn applies the NotIn predicate on the "uuid" field.
+func UUIDNotIn(vs ...uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotIn(FieldUUID, vs...))
+}
+
+// UUIDGT applies the GT predicate on the "uuid" field.
+func UUIDGT(v uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGT(FieldUUID, v))
+}
+
+// UUIDGTE applies the GTE predicate on the "uuid" field.
+func UUIDGTE(v uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGTE(FieldUUID, v))
+}
+
+// UUIDLT applies the LT predicate on the "uuid" field.
+func UUIDLT(v uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLT(FieldUUID, v))
+}
+
+// UUIDLTE applies the LTE predicate on the "uuid" field.
+func UUIDLTE(v uuid.UUID) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLTE(FieldUUID, v))
+}
+
+// ChecksumAlgorithmEQ applies the EQ predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmEQ(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmNEQ applies the NEQ predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmNEQ(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNEQ(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmIn applies the In predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmIn(vs ...string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIn(FieldChecksumAlgorithm, vs...))
+}
+
+// ChecksumAlgorithmNotIn applies the NotIn predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmNotIn(vs ...string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotIn(FieldChecksumAlgorithm, vs...))
+}
+
+// ChecksumAlgorithmGT applies the GT predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmGT(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGT(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmGTE applies the GTE predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmGTE(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGTE(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmLT applies the LT predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmLT(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLT(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmLTE applies the LTE predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmLTE(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLTE(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmContains applies the Contains predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmContains(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldContains(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmHasPrefix applies the HasPrefix predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmHasPrefix(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldHasPrefix(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmHasSuffix applies the HasSuffix predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmHasSuffix(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldHasSuffix(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmEqualFold applies the EqualFold predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmEqualFold(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEqualFold(FieldChecksumAlgorithm, v))
+}
+
+// ChecksumAlgorithmContainsFold applies the ContainsFold predicate on the "checksum_algorithm" field.
+func ChecksumAlgorithmContainsFold(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldContainsFold(FieldChecksumAlgorithm, v))
+}
+
+// ExpectedChecksumEQ applies the EQ predicate on the "expected_checksum" field.
+func ExpectedChecksumEQ(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumNEQ applies the NEQ predicate on the "expected_checksum" field.
+func ExpectedChecksumNEQ(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNEQ(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumIn applies the In predicate on the "expected_checksum" field.
+func ExpectedChecksumIn(vs ...string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIn(FieldExpectedChecksum, vs...))
+}
+
+// ExpectedChecksumNotIn applies the NotIn predicate on the "expected_checksum" field.
+func ExpectedChecksumNotIn(vs ...string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotIn(FieldExpectedChecksum, vs...))
+}
+
+// ExpectedChecksumGT applies the GT predicate on the "expected_checksum" field.
+func ExpectedChecksumGT(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGT(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumGTE applies the GTE predicate on the "expected_checksum" field.
+func ExpectedChecksumGTE(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGTE(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumLT applies the LT predicate on the "expected_checksum" field.
+func ExpectedChecksumLT(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLT(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumLTE applies the LTE predicate on the "expected_checksum" field.
+func ExpectedChecksumLTE(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLTE(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumContains applies the Contains predicate on the "expected_checksum" field.
+func ExpectedChecksumContains(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldContains(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumHasPrefix applies the HasPrefix predicate on the "expected_checksum" field.
+func ExpectedChecksumHasPrefix(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldHasPrefix(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumHasSuffix applies the HasSuffix predicate on the "expected_checksum" field.
+func ExpectedChecksumHasSuffix(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldHasSuffix(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumEqualFold applies the EqualFold predicate on the "expected_checksum" field.
+func ExpectedChecksumEqualFold(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEqualFold(FieldExpectedChecksum, v))
+}
+
+// ExpectedChecksumContainsFold applies the ContainsFold predicate on the "expected_checksum" field.
+func ExpectedChecksumContainsFold(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldContainsFold(FieldExpectedChecksum, v))
+}
+
+// ActualChecksumEQ applies the EQ predicate on the "actual_checksum" field.
+func ActualChecksumEQ(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldActualChecksum, v))
+}
+
+// ActualChecksumNEQ applies the NEQ predicate on the "actual_checksum" field.
+func ActualChecksumNEQ(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNEQ(FieldActualChecksum, v))
+}
+
+// ActualChecksumIn applies the In predicate on the "actual_checksum" field.
+func ActualChecksumIn(vs ...string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIn(FieldActualChecksum, vs...))
+}
+
+// ActualChecksumNotIn applies the NotIn predicate on the "actual_checksum" field.
+func ActualChecksumNotIn(vs ...string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotIn(FieldActualChecksum, vs...))
+}
+
+// ActualChecksumGT applies the GT predicate on the "actual_checksum" field.
+func ActualChecksumGT(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGT(FieldActualChecksum, v))
+}
+
+// ActualChecksumGTE applies the GTE predicate on the "actual_checksum" field.
+func ActualChecksumGTE(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGTE(FieldActualChecksum, v))
+}
+
+// ActualChecksumLT applies the LT predicate on the "actual_checksum" field.
+func ActualChecksumLT(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLT(FieldActualChecksum, v))
+}
+
+// ActualChecksumLTE applies the LTE predicate on the "actual_checksum" field.
+func ActualChecksumLTE(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLTE(FieldActualChecksum, v))
+}
+
+// ActualChecksumContains applies the Contains predicate on the "actual_checksum" field.
+func ActualChecksumContains(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldContains(FieldActualChecksum, v))
+}
+
+// ActualChecksumHasPrefix applies the HasPrefix predicate on the "actual_checksum" field.
+func ActualChecksumHasPrefix(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldHasPrefix(FieldActualChecksum, v))
+}
+
+// ActualChecksumHasSuffix applies the HasSuffix predicate on the "actual_checksum" field.
+func ActualChecksumHasSuffix(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldHasSuffix(FieldActualChecksum, v))
+}
+
+// ActualChecksumEqualFold applies the EqualFold predicate on the "actual_checksum" field.
+func ActualChecksumEqualFold(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEqualFold(FieldActualChecksum, v))
+}
+
+// ActualChecksumContainsFold applies the ContainsFold predicate on the "actual_checksum" field.
+func ActualChecksumContainsFold(v string) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldContainsFold(FieldActualChecksum, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v enums.FixityCheckStatus) predicate.FixityCheck {
+	vc := v
+	return predicate.FixityCheck(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v enums.FixityCheckStatus) predicate.FixityCheck {
+	vc := v
+	return predicate.FixityCheck(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...enums.FixityCheckStatus) predicate.FixityCheck {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FixityCheck(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...enums.FixityCheckStatus) predicate.FixityCheck {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FixityCheck(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// CheckedAtEQ applies the EQ predicate on the "checked_at" field.
+func CheckedAtEQ(v time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtNEQ applies the NEQ predicate on the "checked_at" field.
+func CheckedAtNEQ(v time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtIn applies the In predicate on the "checked_at" field.
+func CheckedAtIn(vs ...time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtNotIn applies the NotIn predicate on the "checked_at" field.
+func CheckedAtNotIn(vs ...time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtGT applies the GT predicate on the "checked_at" field.
+func CheckedAtGT(v time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGT(FieldCheckedAt, v))
+}
+
+// CheckedAtGTE applies the GTE predicate on the "checked_at" field.
+func CheckedAtGTE(v time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldGTE(FieldCheckedAt, v))
+}
+
+// CheckedAtLT applies the LT predicate on the "checked_at" field.
+func CheckedAtLT(v time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLT(FieldCheckedAt, v))
+}
+
+// CheckedAtLTE applies the LTE predicate on the "checked_at" field.
+func CheckedAtLTE(v time.Time) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldLTE(FieldCheckedAt, v))
+}
+
+// AipIDEQ applies the EQ predicate on the "aip_id" field.
+func AipIDEQ(v int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldAipID, v))
+}
+
+// AipIDNEQ applies the NEQ predicate on the "aip_id" field.
+func AipIDNEQ(v int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNEQ(FieldAipID, v))
+}
+
+// AipIDIn applies the In predicate on the "aip_id" field.
+func AipIDIn(vs ...int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIn(FieldAipID, vs...))
+}
+
+// AipIDNotIn applies the NotIn predicate on the "aip_id" field.
+func AipIDNotIn(vs ...int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotIn(FieldAipID, vs...))
+}
+
+// WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
+func WorkflowIDEQ(v int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDNEQ applies the NEQ predicate on the "workflow_id" field.
+func WorkflowIDNEQ(v int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDIn applies the In predicate on the "workflow_id" field.
+func WorkflowIDIn(vs ...int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDNotIn applies the NotIn predicate on the "workflow_id" field.
+func WorkflowIDNotIn(vs ...int) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDIsNil applies the IsNil predicate on the "workflow_id" field.
+func WorkflowIDIsNil() predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldIsNull(FieldWorkflowID))
+}
+
+// WorkflowIDNotNil applies the NotNil predicate on the "workflow_id" field.
+func WorkflowIDNotNil() predicate.FixityCheck {
+	return predicate.FixityCheck(sql.FieldNotNull(FieldWorkflowID))
+}
+
+// HasAip applies the HasEdge predicate on the "aip" edge.
+func HasAip() predicate.FixityCheck {
+	return predicate.FixityCheck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AipTable, AipColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAipWith applies the HasEdge predicate on the "aip" edge with a given conditions (other predicates).
+func HasAipWith(preds ...predicate.AIP) predicate.FixityCheck {
+	return predicate.FixityCheck(func(s *sql.Selector) {
+		step := newAipStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkflow applies the HasEdge predicate on the "workflow" edge.
+func HasWorkflow() predicate.FixityCheck {
+	return predicate.FixityCheck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkflowTable, WorkflowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowWith applies the HasEdge predicate on the "workflow" edge with a given conditions (other predicates).
+func HasWorkflowWith(preds ...predicate.Workflow) predicate.FixityCheck {
+	return predicate.FixityCheck(func(s *sql.Selector) {
+		step := newWorkflowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FixityCheck) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FixityCheck) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FixityCheck) predicate.FixityCheck {
+	return predicate.FixityCheck(sql.NotPredicates(p))
+}
@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
+	"github.com/google/uuid"
+)
+
+// FixityCheckCreate is the builder for creating a FixityCheck entity.
+type FixityCheckCreate struct {
+	config
+	mutation *FixityCheckMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUUID sets the "uuid" field.
+func (_c *FixityCheckCreate) SetUUID(v uuid.UUID) *FixityCheckCreate {
+	_c.mutation.SetUUID(v)
+	return _c
+}
+
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (_c *FixityCheckCreate) SetChecksumAlgorithm(v string) *FixityCheckCreate {
+	_c.mutation.SetChecksumAlgorithm(v)
+	return _c
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (_c *FixityCheckCreate) SetExpectedChecksum(v string) *FixityCheckCreate {
+	_c.mutation.SetExpectedChecksum(v)
+	return _c
+}
+
+// SetActualChecksum sets the "actual_checksum" field.
+func (_c *FixityCheckCreate) SetActualChecksum(v string) *FixityCheckCreate {
+	_c.mutation.SetActualChecksum(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *FixityCheckCreate) SetStatus(v enums.FixityCheckStatus) *FixityCheckCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (_c *FixityCheckCreate) SetCheckedAt(v time.Time) *FixityCheckCreate {
+	_c.mutation.SetCheckedAt(v)
+	return _c
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_c *FixityCheckCreate) SetNillableCheckedAt(v *time.Time) *FixityCheckCreate {
+	if v != nil {
+		_c.SetCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetAipID sets the "aip_id" field.
+func (_c *FixityCheckCreate) SetAipID(v int) *FixityCheckCreate {
+	_c.mutation.SetAipID(v)
+	return _c
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_c *FixityCheckCreate) SetWorkflowID(v int) *FixityCheckCreate {
+	_c.mutation.SetWorkflowID(v)
+	return _c
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (_c *FixityCheckCreate) SetNillableWorkflowID(v *int) *FixityCheckCreate {
+	if v != nil {
+		_c.SetWorkflowID(*v)
+	}
+	return _c
+}
+
+// SetAip sets the "aip" edge to the AIP entity.
+func (_c *FixityCheckCreate) SetAip(v *AIP) *FixityCheckCreate {
+	return _c.SetAipID(v.ID)
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (_c *FixityCheckCreate) SetWorkflow(v *Workflow) *FixityCheckCreate {
+	return _c.SetWorkflowID(v.ID)
+}
+
+// Mutation returns the FixityCheckMutation object of the builder.
+func (_c *FixityCheckCreate) Mutation() *FixityCheckMutation {
+	return _c.mutation
+}
+
+// Save creates the FixityCheck in the database.
+func (_c *FixityCheckCreate) Save(ctx context.Context) (*FixityCheck, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FixityCheckCreate) SaveX(ctx context.Context) *FixityCheck {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FixityCheckCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FixityCheckCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FixityCheckCreate) defaults() {
+	if _, ok := _c.mutation.CheckedAt(); !ok {
+		v := fixitycheck.DefaultCheckedAt()
+		_c.mutation.SetCheckedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FixityCheckCreate) check() error {
+	if _, ok := _c.mutation.UUID(); !ok {
+		return &ValidationError{Name: "uuid", err: errors.New(`db: missing required field "FixityCheck.uuid"`)}
+	}
+	if _, ok := _c.mutation.ChecksumAlgorithm(); !ok {
+		return &ValidationError{Name: "checksum_algorithm", err: errors.New(`db: missing required field "FixityCheck.checksum_algorithm"`)}
+	}
+	if _, ok := _c.mutation.ExpectedChecksum(); !ok {
+		return &ValidationError{Name: "expected_checksum", err: errors.New(`db: missing required field "FixityCheck.expected_checksum"`)}
+	}
+	if _, ok := _c.mutation.ActualChecksum(); !ok {
+		return &ValidationError{Name: "actual_checksum", err: errors.New(`db: missing required field "FixityCheck.actual_checksum"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "FixityCheck.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := fixitycheck.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "FixityCheck.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CheckedAt(); !ok {
+		return &ValidationError{Name: "checked_at", err: errors.New(`db: missing required field "FixityCheck.checked_at"`)}
+	}
+	if _, ok := _c.mutation.AipID(); !ok {
+		return &ValidationError{Name: "aip_id", err: errors.New(`db: missing required field "FixityCheck.aip_id"`)}
+	}
+	if v, ok := _c.mutation.AipID(); ok {
+		if err := fixitycheck.AipIDValidator(v); err != nil {
+			return &ValidationError{Name: "aip_id", err: fmt.Errorf(`db: validator failed for field "FixityCheck.aip_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.WorkflowID(); ok {
+		if err := fixitycheck.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`db: validator failed for field "FixityCheck.workflow_id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AipIDs()) == 0 {
+		return &ValidationError{Name: "aip", err: errors.New(`db: missing required edge "FixityCheck.aip"`)}
+	}
+	return nil
+}
+
+func (_c *FixityCheckCreate) sqlSave(ctx context.Context) (*FixityCheck, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FixityCheckCreate) createSpec() (*FixityCheck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FixityCheck{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fixitycheck.Table, sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UUID(); ok {
+		_spec.SetField(fixitycheck.FieldUUID, field.TypeUUID, value)
+		_node.UUID = value
+	}
+	if value, ok := _c.mutation.ChecksumAlgorithm(); ok {
+		_spec.SetField(fixitycheck.FieldChecksumAlgorithm, field.TypeString, value)
+		_node.ChecksumAlgorithm = value
+	}
+	if value, ok := _c.mutation.ExpectedChecksum(); ok {
+		_spec.SetField(fixitycheck.FieldExpectedChecksum, field.TypeString, value)
+		_node.ExpectedChecksum = value
+	}
+	if value, ok := _c.mutation.ActualChecksum(); ok {
+		_spec.SetField(fixitycheck.FieldActualChecksum, field.TypeString, value)
+		_node.ActualChecksum = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(fixitycheck.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CheckedAt(); ok {
+		_spec.SetField(fixitycheck.FieldCheckedAt, field.TypeTime, value)
+		_node.CheckedAt = value
+	}
+	if nodes := _c.mutation.AipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fixitycheck.AipTable,
+			Columns: []string{fixitycheck.AipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AipID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fixitycheck.WorkflowTable,
+			Columns: []string{fixitycheck.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkflowID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FixityCheck.Create().
+//		SetUUID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FixityCheckUpsert) {
+//			SetUUID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FixityCheckCreate) OnConflict(opts ...sql.ConflictOption) *FixityCheckUpsertOne {
+	_c.conflict = opts
+	return &FixityCheckUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FixityCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FixityCheckCreate) OnConflictColumns(columns ...string) *FixityCheckUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FixityCheckUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// FixityCheckUpsertOne is the builder for "upsert"-ing
+	//  one FixityCheck node.
+	FixityCheckUpsertOne struct {
+		create *FixityCheckCreate
+	}
+
+	// FixityCheckUpsert is the "OnConflict" setter.
+	FixityCheckUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUUID sets the "uuid" field.
+func (u *FixityCheckUpsert) SetUUID(v uuid.UUID) *FixityCheckUpsert {
+	u.Set(fixitycheck.FieldUUID, v)
+	return u
+}
+
+// UpdateUUID sets the "uuid" field to the value that was provided on create.
+func (u *FixityCheckUpsert) UpdateUUID() *FixityCheckUpsert {
+	u.SetExcluded(fixitycheck.FieldUUID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FixityCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FixityCheckUpsertOne) UpdateNewValues() *FixityCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ChecksumAlgorithm(); exists {
+			s.SetIgnore(fixitycheck.FieldChecksumAlgorithm)
+		}
+		if _, exists := u.create.mutation.ExpectedChecksum(); exists {
+			s.SetIgnore(fixitycheck.FieldExpectedChecksum)
+		}
+		if _, exists := u.create.mutation.ActualChecksum(); exists {
+			s.SetIgnore(fixitycheck.FieldActualChecksum)
+		}
+		if _, exists := u.create.mutation.Status(); exists {
+			s.SetIgnore(fixitycheck.FieldStatus)
+		}
+		if _, exists := u.create.mutation.CheckedAt(); exists {
+			s.SetIgnore(fixitycheck.FieldCheckedAt)
+		}
+		if _, exists := u.create.mutation.AipID(); exists {
+			s.SetIgnore(fixitycheck.FieldAipID)
+		}
+		if _, exists := u.create.mutation.WorkflowID(); exists {
+			s.SetIgnore(fixitycheck.FieldWorkflowID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FixityCheck.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FixityCheckUpsertOne) Ignore() *FixityCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FixityCheckUpsertOne) DoNothing() *FixityCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FixityCheckCreate.OnConflict
+// documentation for more info.
+func (u *FixityCheckUpsertOne) Update(set func(*FixityCheckUpsert)) *FixityCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FixityCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUUID sets the "uuid" field.
+func (u *FixityCheckUpsertOne) SetUUID(v uuid.UUID) *FixityCheckUpsertOne {
+	return u.Update(func(s *FixityCheckUpsert) {
+		s.SetUUID(v)
+	})
+}
+
+// UpdateUUID sets the "uuid" field to the value that was provided on create.
+func (u *FixityCheckUpsertOne) UpdateUUID() *FixityCheckUpsertOne {
+	return u.Update(func(s *FixityCheckUpsert) {
+		s.UpdateUUID()
+	})
+}
+
+// Exec executes the query.
+func (u *FixityCheckUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for FixityCheckCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FixityCheckUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FixityCheckUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FixityCheckUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FixityCheckCreateBulk is the builder for creating many FixityCheck entities in bulk.
+type FixityCheckCreateBulk struct {
+	config
+	err      error
+	builders []*FixityCheckCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FixityCheck entities in the database.
+func (_c *FixityCheckCreateBulk) Save(ctx context.Context) ([]*FixityCheck, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FixityCheck, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FixityCheckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FixityCheckCreateBulk) SaveX(ctx context.Context) []*FixityCheck {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FixityCheckCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FixityCheckCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FixityCheck.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FixityCheckUpsert) {
+//			SetUUID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FixityCheckCreateBulk) OnConflict(opts ...sql.ConflictOption) *FixityCheckUpsertBulk {
+	_c.conflict = opts
+	return &FixityCheckUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FixityCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FixityCheckCreateBulk) OnConflictColumns(columns ...string) *FixityCheckUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FixityCheckUpsertBulk{
+		create: _c,
+	}
+}
+
+// FixityCheckUpsertBulk is the builder for "upsert"-ing
+// a bulk of FixityCheck nodes.
+type FixityCheckUpsertBulk struct {
+	create *FixityCheckCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FixityCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FixityCheckUpsertBulk) UpdateNewValues() *FixityCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ChecksumAlgorithm(); exists {
+				s.SetIgnore(fixitycheck.FieldChecksumAlgorithm)
+			}
+			if _, exists := b.mutation.ExpectedChecksum(); exists {
+				s.SetIgnore(fixitycheck.FieldExpectedChecksum)
+			}
+			if _, exists := b.mutation.ActualChecksum(); exists {
+				s.SetIgnore(fixitycheck.FieldActualChecksum)
+			}
+			if _, exists := b.mutation.Status(); exists {
+				s.SetIgnore(fixitycheck.FieldStatus)
+			}
+			if _, exists := b.mutation.CheckedAt(); exists {
+				s.SetIgnore(fixitycheck.FieldCheckedAt)
+			}
+			if _, exists := b.mutation.AipID(); exists {
+				s.SetIgnore(fixitycheck.FieldAipID)
+			}
+			if _, exists := b.mutation.WorkflowID(); exists {
+				s.SetIgnore(fixitycheck.FieldWorkflowID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FixityCheck.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FixityCheckUpsertBulk) Ignore() *FixityCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FixityCheckUpsertBulk) DoNothing() *FixityCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FixityCheckCreateBulk.OnConflict
+// documentation for more info.
+func (u *FixityCheckUpsertBulk) Update(set func(*FixityCheckUpsert)) *FixityCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FixityCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUUID sets the "uuid" field.
+func (u *FixityCheckUpsertBulk) SetUUID(v uuid.UUID) *FixityCheckUpsertBulk {
+	return u.Update(func(s *FixityCheckUpsert) {
+		s.SetUUID(v)
+	})
+}
+
+// UpdateUUID sets the "uuid" field to the value that was provided on create.
+func (u *FixityCheckUpsertBulk) UpdateUUID() *FixityCheckUpsertBulk {
+	return u.Update(func(s *FixityCheckUpsert) {
+		s.UpdateUUID()
+	})
+}
+
+// Exec executes the query.
+func (u *FixityCheckUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the FixityCheckCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for FixityCheckCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FixityCheckUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
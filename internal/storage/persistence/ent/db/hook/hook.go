@@ -21,6 +21,30 @@ func (f AIPFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AIPMutation", m)
 }
 
+// The AIPLegalHoldFunc type is an adapter to allow the use of ordinary
+// function as AIPLegalHold mutator.
+type AIPLegalHoldFunc func(context.Context, *db.AIPLegalHoldMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AIPLegalHoldFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.AIPLegalHoldMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AIPLegalHoldMutation", m)
+}
+
+// The AIPReplicaFunc type is an adapter to allow the use of ordinary
+// function as AIPReplica mutator.
+type AIPReplicaFunc func(context.Context, *db.AIPReplicaMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AIPReplicaFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.AIPReplicaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AIPReplicaMutation", m)
+}
+
 // The DeletionRequestFunc type is an adapter to allow the use of ordinary
 // function as DeletionRequest mutator.
 type DeletionRequestFunc func(context.Context, *db.DeletionRequestMutation) (db.Value, error)
@@ -33,6 +57,30 @@ func (f DeletionRequestFunc) Mutate(ctx context.Context, m db.Mutation) (db.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DeletionRequestMutation", m)
 }
 
+// The DeletionRequestApprovalFunc type is an adapter to allow the use of ordinary
+// function as DeletionRequestApproval mutator.
+type DeletionRequestApprovalFunc func(context.Context, *db.DeletionRequestApprovalMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeletionRequestApprovalFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.DeletionRequestApprovalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DeletionRequestApprovalMutation", m)
+}
+
+// The FixityCheckFunc type is an adapter to allow the use of ordinary
+// function as FixityCheck mutator.
+type FixityCheckFunc func(context.Context, *db.FixityCheckMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FixityCheckFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.FixityCheckMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.FixityCheckMutation", m)
+}
+
 // The LocationFunc type is an adapter to allow the use of ordinary
 // function as Location mutator.
 type LocationFunc func(context.Context, *db.LocationMutation) (db.Value, error)
@@ -45,6 +93,18 @@ func (f LocationFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.LocationMutation", m)
 }
 
+// The SearchDocumentFunc type is an adapter to allow the use of ordinary
+// function as SearchDocument mutator.
+type SearchDocumentFunc func(context.Context, *db.SearchDocumentMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchDocumentFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SearchDocumentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SearchDocumentMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *db.TaskMutation) (db.Value, error)
@@ -45,9 +45,13 @@ type Location struct {
 type LocationEdges struct {
 	// Aips holds the value of the aips edge.
 	Aips []*AIP `json:"aips,omitempty"`
+	// ReplicationTargets holds the value of the replication_targets edge.
+	ReplicationTargets []*Location `json:"replication_targets,omitempty"`
+	// Replicas holds the value of the replicas edge.
+	Replicas []*AIPReplica `json:"replicas,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // AipsOrErr returns the Aips value or an error if the edge
@@ -59,6 +63,24 @@ func (e LocationEdges) AipsOrErr() ([]*AIP, error) {
 	return nil, &NotLoadedError{edge: "aips"}
 }
 
+// ReplicationTargetsOrErr returns the ReplicationTargets value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) ReplicationTargetsOrErr() ([]*Location, error) {
+	if e.loadedTypes[1] {
+		return e.ReplicationTargets, nil
+	}
+	return nil, &NotLoadedError{edge: "replication_targets"}
+}
+
+// ReplicasOrErr returns the Replicas value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) ReplicasOrErr() ([]*AIPReplica, error) {
+	if e.loadedTypes[2] {
+		return e.Replicas, nil
+	}
+	return nil, &NotLoadedError{edge: "replicas"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Location) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -157,6 +179,16 @@ func (_m *Location) QueryAips() *AIPQuery {
 	return NewLocationClient(_m.config).QueryAips(_m)
 }
 
+// QueryReplicationTargets queries the "replication_targets" edge of the Location entity.
+func (_m *Location) QueryReplicationTargets() *LocationQuery {
+	return NewLocationClient(_m.config).QueryReplicationTargets(_m)
+}
+
+// QueryReplicas queries the "replicas" edge of the Location entity.
+func (_m *Location) QueryReplicas() *AIPReplicaQuery {
+	return NewLocationClient(_m.config).QueryReplicas(_m)
+}
+
 // Update returns a builder for updating this Location.
 // Note that you need to call Location.Unwrap() before calling this method if this Location
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -32,6 +32,10 @@ const (
 	FieldCreatedAt = "created_at"
 	// EdgeAips holds the string denoting the aips edge name in mutations.
 	EdgeAips = "aips"
+	// EdgeReplicationTargets holds the string denoting the replication_targets edge name in mutations.
+	EdgeReplicationTargets = "replication_targets"
+	// EdgeReplicas holds the string denoting the replicas edge name in mutations.
+	EdgeReplicas = "replicas"
 	// Table holds the table name of the location in the database.
 	Table = "location"
 	// AipsTable is the table that holds the aips relation/edge.
@@ -41,6 +45,15 @@ const (
 	AipsInverseTable = "aip"
 	// AipsColumn is the table column denoting the aips relation/edge.
 	AipsColumn = "location_id"
+	// ReplicationTargetsTable is the table that holds the replication_targets relation/edge. The primary key declared below.
+	ReplicationTargetsTable = "location_replication_targets"
+	// ReplicasTable is the table that holds the replicas relation/edge.
+	ReplicasTable = "aip_replica"
+	// ReplicasInverseTable is the table name for the AIPReplica entity.
+	// It exists in this package in order to avoid circular dependency with the "aipreplica" package.
+	ReplicasInverseTable = "aip_replica"
+	// ReplicasColumn is the table column denoting the replicas relation/edge.
+	ReplicasColumn = "location_id"
 )
 
 // Columns holds all SQL columns for location fields.
@@ -55,6 +68,12 @@ var Columns = []string{
 	FieldCreatedAt,
 }
 
+var (
+	// ReplicationTargetsPrimaryKey and ReplicationTargetsColumn2 are the table columns denoting the
+	// primary key for the replication_targets relation (M2M).
+	ReplicationTargetsPrimaryKey = []string{"location_id", "replication_target_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -83,7 +102,7 @@ func SourceValidator(s enums.LocationSource) error {
 // PurposeValidator is a validator for the "purpose" field enum values. It is called by the builders before save.
 func PurposeValidator(pu enums.LocationPurpose) error {
 	switch pu.String() {
-	case "unspecified", "aip_store":
+	case "unspecified", "aip_store", "restore":
 		return nil
 	default:
 		return fmt.Errorf("location: invalid enum value for purpose field: %q", pu)
@@ -141,6 +160,34 @@ func ByAips(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAipsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByReplicationTargetsCount orders the results by replication_targets count.
+func ByReplicationTargetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReplicationTargetsStep(), opts...)
+	}
+}
+
+// ByReplicationTargets orders the results by replication_targets terms.
+func ByReplicationTargets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplicationTargetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReplicasCount orders the results by replicas count.
+func ByReplicasCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReplicasStep(), opts...)
+	}
+}
+
+// ByReplicas orders the results by replicas terms.
+func ByReplicas(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplicasStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAipsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -148,3 +195,17 @@ func newAipsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, AipsTable, AipsColumn),
 	)
 }
+func newReplicationTargetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ReplicationTargetsTable, ReplicationTargetsPrimaryKey...),
+	)
+}
+func newReplicasStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReplicasInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReplicasTable, ReplicasColumn),
+	)
+}
@@ -370,6 +370,52 @@ func HasAipsWith(preds ...predicate.AIP) predicate.Location {
 	})
 }
 
+// HasReplicationTargets applies the HasEdge predicate on the "replication_targets" edge.
+func HasReplicationTargets() predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ReplicationTargetsTable, ReplicationTargetsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplicationTargetsWith applies the HasEdge predicate on the "replication_targets" edge with a given conditions (other predicates).
+func HasReplicationTargetsWith(preds ...predicate.Location) predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := newReplicationTargetsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplicas applies the HasEdge predicate on the "replicas" edge.
+func HasReplicas() predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReplicasTable, ReplicasColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplicasWith applies the HasEdge predicate on the "replicas" edge with a given conditions (other predicates).
+func HasReplicasWith(preds ...predicate.AIPReplica) predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := newReplicasStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Location) predicate.Location {
 	return predicate.Location(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
 	"github.com/google/uuid"
@@ -91,6 +92,36 @@ func (_c *LocationCreate) AddAips(v ...*AIP) *LocationCreate {
 	return _c.AddAipIDs(ids...)
 }
 
+// AddReplicationTargetIDs adds the "replication_targets" edge to the Location entity by IDs.
+func (_c *LocationCreate) AddReplicationTargetIDs(ids ...int) *LocationCreate {
+	_c.mutation.AddReplicationTargetIDs(ids...)
+	return _c
+}
+
+// AddReplicationTargets adds the "replication_targets" edges to the Location entity.
+func (_c *LocationCreate) AddReplicationTargets(v ...*Location) *LocationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplicationTargetIDs(ids...)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the AIPReplica entity by IDs.
+func (_c *LocationCreate) AddReplicaIDs(ids ...int) *LocationCreate {
+	_c.mutation.AddReplicaIDs(ids...)
+	return _c
+}
+
+// AddReplicas adds the "replicas" edges to the AIPReplica entity.
+func (_c *LocationCreate) AddReplicas(v ...*AIPReplica) *LocationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplicaIDs(ids...)
+}
+
 // Mutation returns the LocationMutation object of the builder.
 func (_c *LocationCreate) Mutation() *LocationMutation {
 	return _c.mutation
@@ -236,6 +267,38 @@ func (_c *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReplicationTargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   location.ReplicationTargetsTable,
+			Columns: location.ReplicationTargetsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReplicasTable,
+			Columns: []string{location.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
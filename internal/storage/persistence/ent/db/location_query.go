@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
 )
@@ -20,11 +21,13 @@ import (
 // LocationQuery is the builder for querying Location entities.
 type LocationQuery struct {
 	config
-	ctx        *QueryContext
-	order      []location.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Location
-	withAips   *AIPQuery
+	ctx                    *QueryContext
+	order                  []location.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Location
+	withAips               *AIPQuery
+	withReplicationTargets *LocationQuery
+	withReplicas           *AIPReplicaQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +86,50 @@ func (_q *LocationQuery) QueryAips() *AIPQuery {
 	return query
 }
 
+// QueryReplicationTargets chains the current query on the "replication_targets" edge.
+func (_q *LocationQuery) QueryReplicationTargets() *LocationQuery {
+	query := (&LocationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, location.ReplicationTargetsTable, location.ReplicationTargetsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplicas chains the current query on the "replicas" edge.
+func (_q *LocationQuery) QueryReplicas() *AIPReplicaQuery {
+	query := (&AIPReplicaClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(aipreplica.Table, aipreplica.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ReplicasTable, location.ReplicasColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Location entity from the query.
 // Returns a *NotFoundError when no Location was found.
 func (_q *LocationQuery) First(ctx context.Context) (*Location, error) {
@@ -270,12 +317,14 @@ func (_q *LocationQuery) Clone() *LocationQuery {
 		return nil
 	}
 	return &LocationQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]location.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Location{}, _q.predicates...),
-		withAips:   _q.withAips.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]location.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.Location{}, _q.predicates...),
+		withAips:               _q.withAips.Clone(),
+		withReplicationTargets: _q.withReplicationTargets.Clone(),
+		withReplicas:           _q.withReplicas.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +342,28 @@ func (_q *LocationQuery) WithAips(opts ...func(*AIPQuery)) *LocationQuery {
 	return _q
 }
 
+// WithReplicationTargets tells the query-builder to eager-load the nodes that are connected to
+// the "replication_targets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LocationQuery) WithReplicationTargets(opts ...func(*LocationQuery)) *LocationQuery {
+	query := (&LocationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReplicationTargets = query
+	return _q
+}
+
+// WithReplicas tells the query-builder to eager-load the nodes that are connected to
+// the "replicas" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LocationQuery) WithReplicas(opts ...func(*AIPReplicaQuery)) *LocationQuery {
+	query := (&AIPReplicaClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReplicas = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +442,10 @@ func (_q *LocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Loc
 	var (
 		nodes       = []*Location{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withAips != nil,
+			_q.withReplicationTargets != nil,
+			_q.withReplicas != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +473,20 @@ func (_q *LocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Loc
 			return nil, err
 		}
 	}
+	if query := _q.withReplicationTargets; query != nil {
+		if err := _q.loadReplicationTargets(ctx, query, nodes,
+			func(n *Location) { n.Edges.ReplicationTargets = []*Location{} },
+			func(n *Location, e *Location) { n.Edges.ReplicationTargets = append(n.Edges.ReplicationTargets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReplicas; query != nil {
+		if err := _q.loadReplicas(ctx, query, nodes,
+			func(n *Location) { n.Edges.Replicas = []*AIPReplica{} },
+			func(n *Location, e *AIPReplica) { n.Edges.Replicas = append(n.Edges.Replicas, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,6 +520,97 @@ func (_q *LocationQuery) loadAips(ctx context.Context, query *AIPQuery, nodes []
 	}
 	return nil
 }
+func (_q *LocationQuery) loadReplicationTargets(ctx context.Context, query *LocationQuery, nodes []*Location, init func(*Location), assign func(*Location, *Location)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Location)
+	nids := make(map[int]map[*Location]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(location.ReplicationTargetsTable)
+		s.Join(joinT).On(s.C(location.FieldID), joinT.C(location.ReplicationTargetsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(location.ReplicationTargetsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(location.ReplicationTargetsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Location]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Location](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "replication_targets" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *LocationQuery) loadReplicas(ctx context.Context, query *AIPReplicaQuery, nodes []*Location, init func(*Location), assign func(*Location, *AIPReplica)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(aipreplica.FieldLocationID)
+	}
+	query.Where(predicate.AIPReplica(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.ReplicasColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *LocationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
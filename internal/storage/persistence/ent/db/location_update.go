@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
@@ -130,6 +131,36 @@ func (_u *LocationUpdate) AddAips(v ...*AIP) *LocationUpdate {
 	return _u.AddAipIDs(ids...)
 }
 
+// AddReplicationTargetIDs adds the "replication_targets" edge to the Location entity by IDs.
+func (_u *LocationUpdate) AddReplicationTargetIDs(ids ...int) *LocationUpdate {
+	_u.mutation.AddReplicationTargetIDs(ids...)
+	return _u
+}
+
+// AddReplicationTargets adds the "replication_targets" edges to the Location entity.
+func (_u *LocationUpdate) AddReplicationTargets(v ...*Location) *LocationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplicationTargetIDs(ids...)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the AIPReplica entity by IDs.
+func (_u *LocationUpdate) AddReplicaIDs(ids ...int) *LocationUpdate {
+	_u.mutation.AddReplicaIDs(ids...)
+	return _u
+}
+
+// AddReplicas adds the "replicas" edges to the AIPReplica entity.
+func (_u *LocationUpdate) AddReplicas(v ...*AIPReplica) *LocationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplicaIDs(ids...)
+}
+
 // Mutation returns the LocationMutation object of the builder.
 func (_u *LocationUpdate) Mutation() *LocationMutation {
 	return _u.mutation
@@ -156,6 +187,48 @@ func (_u *LocationUpdate) RemoveAips(v ...*AIP) *LocationUpdate {
 	return _u.RemoveAipIDs(ids...)
 }
 
+// ClearReplicationTargets clears all "replication_targets" edges to the Location entity.
+func (_u *LocationUpdate) ClearReplicationTargets() *LocationUpdate {
+	_u.mutation.ClearReplicationTargets()
+	return _u
+}
+
+// RemoveReplicationTargetIDs removes the "replication_targets" edge to Location entities by IDs.
+func (_u *LocationUpdate) RemoveReplicationTargetIDs(ids ...int) *LocationUpdate {
+	_u.mutation.RemoveReplicationTargetIDs(ids...)
+	return _u
+}
+
+// RemoveReplicationTargets removes "replication_targets" edges to Location entities.
+func (_u *LocationUpdate) RemoveReplicationTargets(v ...*Location) *LocationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplicationTargetIDs(ids...)
+}
+
+// ClearReplicas clears all "replicas" edges to the AIPReplica entity.
+func (_u *LocationUpdate) ClearReplicas() *LocationUpdate {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// RemoveReplicaIDs removes the "replicas" edge to AIPReplica entities by IDs.
+func (_u *LocationUpdate) RemoveReplicaIDs(ids ...int) *LocationUpdate {
+	_u.mutation.RemoveReplicaIDs(ids...)
+	return _u
+}
+
+// RemoveReplicas removes "replicas" edges to AIPReplica entities.
+func (_u *LocationUpdate) RemoveReplicas(v ...*AIPReplica) *LocationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplicaIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LocationUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -273,6 +346,96 @@ func (_u *LocationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReplicationTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   location.ReplicationTargetsTable,
+			Columns: location.ReplicationTargetsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReplicationTargetsIDs(); len(nodes) > 0 && !_u.mutation.ReplicationTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   location.ReplicationTargetsTable,
+			Columns: location.ReplicationTargetsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplicationTargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   location.ReplicationTargetsTable,
+			Columns: location.ReplicationTargetsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReplicasTable,
+			Columns: []string{location.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReplicasIDs(); len(nodes) > 0 && !_u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReplicasTable,
+			Columns: []string{location.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReplicasTable,
+			Columns: []string{location.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{location.Label}
@@ -392,6 +555,36 @@ func (_u *LocationUpdateOne) AddAips(v ...*AIP) *LocationUpdateOne {
 	return _u.AddAipIDs(ids...)
 }
 
+// AddReplicationTargetIDs adds the "replication_targets" edge to the Location entity by IDs.
+func (_u *LocationUpdateOne) AddReplicationTargetIDs(ids ...int) *LocationUpdateOne {
+	_u.mutation.AddReplicationTargetIDs(ids...)
+	return _u
+}
+
+// AddReplicationTargets adds the "replication_targets" edges to the Location entity.
+func (_u *LocationUpdateOne) AddReplicationTargets(v ...*Location) *LocationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplicationTargetIDs(ids...)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the AIPReplica entity by IDs.
+func (_u *LocationUpdateOne) AddReplicaIDs(ids ...int) *LocationUpdateOne {
+	_u.mutation.AddReplicaIDs(ids...)
+	return _u
+}
+
+// AddReplicas adds the "replicas" edges to the AIPReplica entity.
+func (_u *LocationUpdateOne) AddReplicas(v ...*AIPReplica) *LocationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplicaIDs(ids...)
+}
+
 // Mutation returns the LocationMutation object of the builder.
 func (_u *LocationUpdateOne) Mutation() *LocationMutation {
 	return _u.mutation
@@ -418,6 +611,48 @@ func (_u *LocationUpdateOne) RemoveAips(v ...*AIP) *LocationUpdateOne {
 	return _u.RemoveAipIDs(ids...)
 }
 
+// ClearReplicationTargets clears all "replication_targets" edges to the Location entity.
+func (_u *LocationUpdateOne) ClearReplicationTargets() *LocationUpdateOne {
+	_u.mutation.ClearReplicationTargets()
+	return _u
+}
+
+// RemoveReplicationTargetIDs removes the "replication_targets" edge to Location entities by IDs.
+func (_u *LocationUpdateOne) RemoveReplicationTargetIDs(ids ...int) *LocationUpdateOne {
+	_u.mutation.RemoveReplicationTargetIDs(ids...)
+	return _u
+}
+
+// RemoveReplicationTargets removes "replication_targets" edges to Location entities.
+func (_u *LocationUpdateOne) RemoveReplicationTargets(v ...*Location) *LocationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplicationTargetIDs(ids...)
+}
+
+// ClearReplicas clears all "replicas" edges to the AIPReplica entity.
+func (_u *LocationUpdateOne) ClearReplicas() *LocationUpdateOne {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// RemoveReplicaIDs removes the "replicas" edge to AIPReplica entities by IDs.
+func (_u *LocationUpdateOne) RemoveReplicaIDs(ids ...int) *LocationUpdateOne {
+	_u.mutation.RemoveReplicaIDs(ids...)
+	return _u
+}
+
+// RemoveReplicas removes "replicas" edges to AIPReplica entities.
+func (_u *LocationUpdateOne) RemoveReplicas(v ...*AIPReplica) *LocationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplicaIDs(ids...)
+}
+
 // Where appends a list predicates to the LocationUpdate builder.
 func (_u *LocationUpdateOne) Where(ps ...predicate.Location) *LocationUpdateOne {
 	_u.mutation.Where(ps...)
@@ -565,6 +800,96 @@ func (_u *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReplicationTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   location.ReplicationTargetsTable,
+			Columns: location.ReplicationTargetsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReplicationTargetsIDs(); len(nodes) > 0 && !_u.mutation.ReplicationTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   location.ReplicationTargetsTable,
+			Columns: location.ReplicationTargetsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplicationTargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   location.ReplicationTargetsTable,
+			Columns: location.ReplicationTargetsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReplicasTable,
+			Columns: []string{location.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReplicasIDs(); len(nodes) > 0 && !_u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReplicasTable,
+			Columns: []string{location.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReplicasTable,
+			Columns: []string{location.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aipreplica.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Location{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
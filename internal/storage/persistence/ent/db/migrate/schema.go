@@ -18,6 +18,12 @@ var (
 		{Name: "object_key", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "deletion_report_key", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "checksum_algorithm", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "checksum_hash", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "size", Type: field.TypeInt64, Nullable: true},
+		{Name: "file_count", Type: field.TypeInt, Nullable: true},
+		{Name: "custom_metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "disposal_at", Type: field.TypeTime, Nullable: true},
 		{Name: "location_id", Type: field.TypeInt, Nullable: true},
 	}
 	// AipTable holds the schema information for the "aip" table.
@@ -28,7 +34,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "aip_location_location",
-				Columns:    []*schema.Column{AipColumns[7]},
+				Columns:    []*schema.Column{AipColumns[13]},
 				RefColumns: []*schema.Column{LocationColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -44,6 +50,86 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{AipColumns[4]},
 			},
+			{
+				Name:    "aip_disposal_at",
+				Unique:  false,
+				Columns: []*schema.Column{AipColumns[12]},
+			},
+		},
+	}
+	// AipLegalHoldColumns holds the columns for the "aip_legal_hold" table.
+	AipLegalHoldColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uuid", Type: field.TypeUUID, Unique: true},
+		{Name: "reason", Type: field.TypeString, Size: 2048},
+		{Name: "placed_by", Type: field.TypeString, Size: 1024},
+		{Name: "placed_at", Type: field.TypeTime},
+		{Name: "released_by", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "release_reason", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "active_aip_id", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "aip_id", Type: field.TypeInt},
+	}
+	// AipLegalHoldTable holds the schema information for the "aip_legal_hold" table.
+	AipLegalHoldTable = &schema.Table{
+		Name:       "aip_legal_hold",
+		Columns:    AipLegalHoldColumns,
+		PrimaryKey: []*schema.Column{AipLegalHoldColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "aip_legal_hold_aip_legal_holds",
+				Columns:    []*schema.Column{AipLegalHoldColumns[9]},
+				RefColumns: []*schema.Column{AipColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "aiplegalhold_uuid",
+				Unique:  false,
+				Columns: []*schema.Column{AipLegalHoldColumns[1]},
+			},
+			{
+				Name:    "aiplegalhold_aip_id_released_at",
+				Unique:  false,
+				Columns: []*schema.Column{AipLegalHoldColumns[9], AipLegalHoldColumns[7]},
+			},
+		},
+	}
+	// AipReplicaColumns holds the columns for the "aip_replica" table.
+	AipReplicaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "replicated", "failed"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "replicated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "aip_id", Type: field.TypeInt},
+		{Name: "location_id", Type: field.TypeInt},
+	}
+	// AipReplicaTable holds the schema information for the "aip_replica" table.
+	AipReplicaTable = &schema.Table{
+		Name:       "aip_replica",
+		Columns:    AipReplicaColumns,
+		PrimaryKey: []*schema.Column{AipReplicaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "aip_replica_aip_replicas",
+				Columns:    []*schema.Column{AipReplicaColumns[4]},
+				RefColumns: []*schema.Column{AipColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "aip_replica_location_replicas",
+				Columns:    []*schema.Column{AipReplicaColumns[5]},
+				RefColumns: []*schema.Column{LocationColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "aipreplica_aip_id_location_id",
+				Unique:  true,
+				Columns: []*schema.Column{AipReplicaColumns[4], AipReplicaColumns[5]},
+			},
 		},
 	}
 	// DeletionRequestColumns holds the columns for the "deletion_request" table.
@@ -60,6 +146,7 @@ var (
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "canceled"}, Default: "pending"},
 		{Name: "requested_at", Type: field.TypeTime},
 		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "required_approvals", Type: field.TypeInt, Default: 1},
 		{Name: "aip_id", Type: field.TypeInt},
 		{Name: "workflow_id", Type: field.TypeInt, Unique: true, Nullable: true},
 	}
@@ -71,13 +158,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "deletion_request_aip_deletion_requests",
-				Columns:    []*schema.Column{DeletionRequestColumns[12]},
+				Columns:    []*schema.Column{DeletionRequestColumns[13]},
 				RefColumns: []*schema.Column{AipColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "deletion_request_workflow_deletion_request",
-				Columns:    []*schema.Column{DeletionRequestColumns[13]},
+				Columns:    []*schema.Column{DeletionRequestColumns[14]},
 				RefColumns: []*schema.Column{WorkflowColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -90,13 +177,87 @@ var (
 			},
 		},
 	}
+	// DeletionRequestApprovalColumns holds the columns for the "deletion_request_approval" table.
+	DeletionRequestApprovalColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "approver", Type: field.TypeString, Size: 1024},
+		{Name: "approver_iss", Type: field.TypeString, Size: 1024},
+		{Name: "approver_sub", Type: field.TypeString, Size: 1024},
+		{Name: "approved_at", Type: field.TypeTime},
+		{Name: "deletion_request_id", Type: field.TypeInt},
+	}
+	// DeletionRequestApprovalTable holds the schema information for the "deletion_request_approval" table.
+	DeletionRequestApprovalTable = &schema.Table{
+		Name:       "deletion_request_approval",
+		Columns:    DeletionRequestApprovalColumns,
+		PrimaryKey: []*schema.Column{DeletionRequestApprovalColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deletion_request_approval_deletion_request_approvals",
+				Columns:    []*schema.Column{DeletionRequestApprovalColumns[5]},
+				RefColumns: []*schema.Column{DeletionRequestColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deletionrequestapproval_deletion_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeletionRequestApprovalColumns[5]},
+			},
+		},
+	}
+	// FixityCheckColumns holds the columns for the "fixity_check" table.
+	FixityCheckColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uuid", Type: field.TypeUUID, Unique: true},
+		{Name: "checksum_algorithm", Type: field.TypeString, Size: 32},
+		{Name: "expected_checksum", Type: field.TypeString, Size: 256},
+		{Name: "actual_checksum", Type: field.TypeString, Size: 256},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"passed", "failed", "baseline"}},
+		{Name: "checked_at", Type: field.TypeTime},
+		{Name: "aip_id", Type: field.TypeInt},
+		{Name: "workflow_id", Type: field.TypeInt, Nullable: true},
+	}
+	// FixityCheckTable holds the schema information for the "fixity_check" table.
+	FixityCheckTable = &schema.Table{
+		Name:       "fixity_check",
+		Columns:    FixityCheckColumns,
+		PrimaryKey: []*schema.Column{FixityCheckColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "fixity_check_aip_fixity_checks",
+				Columns:    []*schema.Column{FixityCheckColumns[7]},
+				RefColumns: []*schema.Column{AipColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "fixity_check_workflow_fixity_checks",
+				Columns:    []*schema.Column{FixityCheckColumns[8]},
+				RefColumns: []*schema.Column{WorkflowColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fixitycheck_uuid",
+				Unique:  false,
+				Columns: []*schema.Column{FixityCheckColumns[1]},
+			},
+			{
+				Name:    "fixitycheck_aip_id_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{FixityCheckColumns[7], FixityCheckColumns[6]},
+			},
+		},
+	}
 	// LocationColumns holds the columns for the "location" table.
 	LocationColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 2048},
 		{Name: "description", Type: field.TypeString, Size: 2048},
 		{Name: "source", Type: field.TypeEnum, Enums: []string{"unspecified", "s3", "sftp", "amss", "filesystem"}},
-		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"unspecified", "aip_store"}},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"unspecified", "aip_store", "restore"}},
 		{Name: "uuid", Type: field.TypeUUID, Unique: true},
 		{Name: "config", Type: field.TypeJSON},
 		{Name: "created_at", Type: field.TypeTime},
@@ -122,6 +283,38 @@ var (
 			},
 		},
 	}
+	// SearchDocumentColumns holds the columns for the "search_document" table.
+	SearchDocumentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uuid", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 2048},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SearchDocumentTable holds the schema information for the "search_document" table.
+	SearchDocumentTable = &schema.Table{
+		Name:       "search_document",
+		Columns:    SearchDocumentColumns,
+		PrimaryKey: []*schema.Column{SearchDocumentColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "search_document_fulltext_idx",
+				Unique:  false,
+				Columns: []*schema.Column{SearchDocumentColumns[2], SearchDocumentColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"mysql":    "FULLTEXT",
+						"postgres": "GIN",
+					},
+					OpClassColumns: map[string]string{
+						"content": "gin_trgm_ops",
+						"name":    "gin_trgm_ops",
+					},
+				},
+			},
+		},
+	}
 	// TaskColumns holds the columns for the "task" table.
 	TaskColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -159,7 +352,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "uuid", Type: field.TypeUUID, Unique: true},
 		{Name: "temporal_id", Type: field.TypeString, Size: 255},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"unspecified", "upload aip", "move aip", "delete aip"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"unspecified", "upload aip", "move aip", "delete aip", "audit aip", "replicate aip", "restore aip"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"unspecified", "in progress", "done", "error", "queued", "pending", "canceled"}},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
@@ -186,13 +379,44 @@ var (
 			},
 		},
 	}
+	// LocationReplicationTargetsColumns holds the columns for the "location_replication_targets" table.
+	LocationReplicationTargetsColumns = []*schema.Column{
+		{Name: "location_id", Type: field.TypeInt},
+		{Name: "replication_target_id", Type: field.TypeInt},
+	}
+	// LocationReplicationTargetsTable holds the schema information for the "location_replication_targets" table.
+	LocationReplicationTargetsTable = &schema.Table{
+		Name:       "location_replication_targets",
+		Columns:    LocationReplicationTargetsColumns,
+		PrimaryKey: []*schema.Column{LocationReplicationTargetsColumns[0], LocationReplicationTargetsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "location_replication_targets_location_id",
+				Columns:    []*schema.Column{LocationReplicationTargetsColumns[0]},
+				RefColumns: []*schema.Column{LocationColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "location_replication_targets_replication_target_id",
+				Columns:    []*schema.Column{LocationReplicationTargetsColumns[1]},
+				RefColumns: []*schema.Column{LocationColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AipTable,
+		AipLegalHoldTable,
+		AipReplicaTable,
 		DeletionRequestTable,
+		DeletionRequestApprovalTable,
+		FixityCheckTable,
 		LocationTable,
+		SearchDocumentTable,
 		TaskTable,
 		WorkflowTable,
+		LocationReplicationTargetsTable,
 	}
 )
 
@@ -201,14 +425,36 @@ func init() {
 	AipTable.Annotation = &entsql.Annotation{
 		Table: "aip",
 	}
+	AipLegalHoldTable.ForeignKeys[0].RefTable = AipTable
+	AipLegalHoldTable.Annotation = &entsql.Annotation{
+		Table: "aip_legal_hold",
+	}
+	AipReplicaTable.ForeignKeys[0].RefTable = AipTable
+	AipReplicaTable.ForeignKeys[1].RefTable = LocationTable
+	AipReplicaTable.Annotation = &entsql.Annotation{
+		Table: "aip_replica",
+	}
 	DeletionRequestTable.ForeignKeys[0].RefTable = AipTable
 	DeletionRequestTable.ForeignKeys[1].RefTable = WorkflowTable
 	DeletionRequestTable.Annotation = &entsql.Annotation{
 		Table: "deletion_request",
 	}
+	DeletionRequestApprovalTable.ForeignKeys[0].RefTable = DeletionRequestTable
+	DeletionRequestApprovalTable.Annotation = &entsql.Annotation{
+		Table: "deletion_request_approval",
+	}
+	FixityCheckTable.ForeignKeys[0].RefTable = AipTable
+	FixityCheckTable.ForeignKeys[1].RefTable = WorkflowTable
+	FixityCheckTable.Annotation = &entsql.Annotation{
+		Table: "fixity_check",
+	}
 	LocationTable.Annotation = &entsql.Annotation{
 		Table: "location",
 	}
+	SearchDocumentTable.Annotation = &entsql.Annotation{
+		Table:     "search_document",
+		Collation: "utf8mb4_unicode_ci",
+	}
 	TaskTable.ForeignKeys[0].RefTable = WorkflowTable
 	TaskTable.Annotation = &entsql.Annotation{
 		Table: "task",
@@ -217,4 +463,6 @@ func init() {
 	WorkflowTable.Annotation = &entsql.Annotation{
 		Table: "workflow",
 	}
+	LocationReplicationTargetsTable.ForeignKeys[0].RefTable = LocationTable
+	LocationReplicationTargetsTable.ForeignKeys[1].RefTable = LocationTable
 }
@@ -13,9 +13,14 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/searchdocument"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
@@ -31,11 +36,16 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAIP             = "AIP"
-	TypeDeletionRequest = "DeletionRequest"
-	TypeLocation        = "Location"
-	TypeTask            = "Task"
-	TypeWorkflow        = "Workflow"
+	TypeAIP                     = "AIP"
+	TypeAIPLegalHold            = "AIPLegalHold"
+	TypeAIPReplica              = "AIPReplica"
+	TypeDeletionRequest         = "DeletionRequest"
+	TypeDeletionRequestApproval = "DeletionRequestApproval"
+	TypeFixityCheck             = "FixityCheck"
+	TypeLocation                = "Location"
+	TypeSearchDocument          = "SearchDocument"
+	TypeTask                    = "Task"
+	TypeWorkflow                = "Workflow"
 )
 
 // AIPMutation represents an operation that mutates the AIP nodes in the graph.
@@ -50,6 +60,14 @@ type AIPMutation struct {
 	object_key               *uuid.UUID
 	created_at               *time.Time
 	deletion_report_key      *string
+	checksum_algorithm       *string
+	checksum_hash            *string
+	size                     *int64
+	addsize                  *int64
+	file_count               *int
+	addfile_count            *int
+	custom_metadata          *map[string]interface{}
+	disposal_at              *time.Time
 	clearedFields            map[string]struct{}
 	location                 *int
 	clearedlocation          bool
@@ -59,6 +77,15 @@ type AIPMutation struct {
 	deletion_requests        map[int]struct{}
 	removeddeletion_requests map[int]struct{}
 	cleareddeletion_requests bool
+	fixity_checks            map[int]struct{}
+	removedfixity_checks     map[int]struct{}
+	clearedfixity_checks     bool
+	replicas                 map[int]struct{}
+	removedreplicas          map[int]struct{}
+	clearedreplicas          bool
+	legal_holds              map[int]struct{}
+	removedlegal_holds       map[int]struct{}
+	clearedlegal_holds       bool
 	done                     bool
 	oldValue                 func(context.Context) (*AIP, error)
 	predicates               []predicate.AIP
@@ -440,6 +467,342 @@ func (m *AIPMutation) ResetDeletionReportKey() {
 	delete(m.clearedFields, aip.FieldDeletionReportKey)
 }
 
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (m *AIPMutation) SetChecksumAlgorithm(s string) {
+	m.checksum_algorithm = &s
+}
+
+// ChecksumAlgorithm returns the value of the "checksum_algorithm" field in the mutation.
+func (m *AIPMutation) ChecksumAlgorithm() (r string, exists bool) {
+	v := m.checksum_algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksumAlgorithm returns the old "checksum_algorithm" field's value of the AIP entity.
+// If the AIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPMutation) OldChecksumAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksumAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksumAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksumAlgorithm: %w", err)
+	}
+	return oldValue.ChecksumAlgorithm, nil
+}
+
+// ClearChecksumAlgorithm clears the value of the "checksum_algorithm" field.
+func (m *AIPMutation) ClearChecksumAlgorithm() {
+	m.checksum_algorithm = nil
+	m.clearedFields[aip.FieldChecksumAlgorithm] = struct{}{}
+}
+
+// ChecksumAlgorithmCleared returns if the "checksum_algorithm" field was cleared in this mutation.
+func (m *AIPMutation) ChecksumAlgorithmCleared() bool {
+	_, ok := m.clearedFields[aip.FieldChecksumAlgorithm]
+	return ok
+}
+
+// ResetChecksumAlgorithm resets all changes to the "checksum_algorithm" field.
+func (m *AIPMutation) ResetChecksumAlgorithm() {
+	m.checksum_algorithm = nil
+	delete(m.clearedFields, aip.FieldChecksumAlgorithm)
+}
+
+// SetChecksumHash sets the "checksum_hash" field.
+func (m *AIPMutation) SetChecksumHash(s string) {
+	m.checksum_hash = &s
+}
+
+// ChecksumHash returns the value of the "checksum_hash" field in the mutation.
+func (m *AIPMutation) ChecksumHash() (r string, exists bool) {
+	v := m.checksum_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksumHash returns the old "checksum_hash" field's value of the AIP entity.
+// If the AIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPMutation) OldChecksumHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksumHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksumHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksumHash: %w", err)
+	}
+	return oldValue.ChecksumHash, nil
+}
+
+// ClearChecksumHash clears the value of the "checksum_hash" field.
+func (m *AIPMutation) ClearChecksumHash() {
+	m.checksum_hash = nil
+	m.clearedFields[aip.FieldChecksumHash] = struct{}{}
+}
+
+// ChecksumHashCleared returns if the "checksum_hash" field was cleared in this mutation.
+func (m *AIPMutation) ChecksumHashCleared() bool {
+	_, ok := m.clearedFields[aip.FieldChecksumHash]
+	return ok
+}
+
+// ResetChecksumHash resets all changes to the "checksum_hash" field.
+func (m *AIPMutation) ResetChecksumHash() {
+	m.checksum_hash = nil
+	delete(m.clearedFields, aip.FieldChecksumHash)
+}
+
+// SetSize sets the "size" field.
+func (m *AIPMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *AIPMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the AIP entity.
+// If the AIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *AIPMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *AIPMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSize clears the value of the "size" field.
+func (m *AIPMutation) ClearSize() {
+	m.size = nil
+	m.addsize = nil
+	m.clearedFields[aip.FieldSize] = struct{}{}
+}
+
+// SizeCleared returns if the "size" field was cleared in this mutation.
+func (m *AIPMutation) SizeCleared() bool {
+	_, ok := m.clearedFields[aip.FieldSize]
+	return ok
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *AIPMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+	delete(m.clearedFields, aip.FieldSize)
+}
+
+// SetFileCount sets the "file_count" field.
+func (m *AIPMutation) SetFileCount(i int) {
+	m.file_count = &i
+	m.addfile_count = nil
+}
+
+// FileCount returns the value of the "file_count" field in the mutation.
+func (m *AIPMutation) FileCount() (r int, exists bool) {
+	v := m.file_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileCount returns the old "file_count" field's value of the AIP entity.
+// If the AIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPMutation) OldFileCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileCount: %w", err)
+	}
+	return oldValue.FileCount, nil
+}
+
+// AddFileCount adds i to the "file_count" field.
+func (m *AIPMutation) AddFileCount(i int) {
+	if m.addfile_count != nil {
+		*m.addfile_count += i
+	} else {
+		m.addfile_count = &i
+	}
+}
+
+// AddedFileCount returns the value that was added to the "file_count" field in this mutation.
+func (m *AIPMutation) AddedFileCount() (r int, exists bool) {
+	v := m.addfile_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFileCount clears the value of the "file_count" field.
+func (m *AIPMutation) ClearFileCount() {
+	m.file_count = nil
+	m.addfile_count = nil
+	m.clearedFields[aip.FieldFileCount] = struct{}{}
+}
+
+// FileCountCleared returns if the "file_count" field was cleared in this mutation.
+func (m *AIPMutation) FileCountCleared() bool {
+	_, ok := m.clearedFields[aip.FieldFileCount]
+	return ok
+}
+
+// ResetFileCount resets all changes to the "file_count" field.
+func (m *AIPMutation) ResetFileCount() {
+	m.file_count = nil
+	m.addfile_count = nil
+	delete(m.clearedFields, aip.FieldFileCount)
+}
+
+// SetCustomMetadata sets the "custom_metadata" field.
+func (m *AIPMutation) SetCustomMetadata(value map[string]interface{}) {
+	m.custom_metadata = &value
+}
+
+// CustomMetadata returns the value of the "custom_metadata" field in the mutation.
+func (m *AIPMutation) CustomMetadata() (r map[string]interface{}, exists bool) {
+	v := m.custom_metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCustomMetadata returns the old "custom_metadata" field's value of the AIP entity.
+// If the AIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPMutation) OldCustomMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCustomMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCustomMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCustomMetadata: %w", err)
+	}
+	return oldValue.CustomMetadata, nil
+}
+
+// ClearCustomMetadata clears the value of the "custom_metadata" field.
+func (m *AIPMutation) ClearCustomMetadata() {
+	m.custom_metadata = nil
+	m.clearedFields[aip.FieldCustomMetadata] = struct{}{}
+}
+
+// CustomMetadataCleared returns if the "custom_metadata" field was cleared in this mutation.
+func (m *AIPMutation) CustomMetadataCleared() bool {
+	_, ok := m.clearedFields[aip.FieldCustomMetadata]
+	return ok
+}
+
+// ResetCustomMetadata resets all changes to the "custom_metadata" field.
+func (m *AIPMutation) ResetCustomMetadata() {
+	m.custom_metadata = nil
+	delete(m.clearedFields, aip.FieldCustomMetadata)
+}
+
+// SetDisposalAt sets the "disposal_at" field.
+func (m *AIPMutation) SetDisposalAt(t time.Time) {
+	m.disposal_at = &t
+}
+
+// DisposalAt returns the value of the "disposal_at" field in the mutation.
+func (m *AIPMutation) DisposalAt() (r time.Time, exists bool) {
+	v := m.disposal_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisposalAt returns the old "disposal_at" field's value of the AIP entity.
+// If the AIP object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPMutation) OldDisposalAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisposalAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisposalAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisposalAt: %w", err)
+	}
+	return oldValue.DisposalAt, nil
+}
+
+// ClearDisposalAt clears the value of the "disposal_at" field.
+func (m *AIPMutation) ClearDisposalAt() {
+	m.disposal_at = nil
+	m.clearedFields[aip.FieldDisposalAt] = struct{}{}
+}
+
+// DisposalAtCleared returns if the "disposal_at" field was cleared in this mutation.
+func (m *AIPMutation) DisposalAtCleared() bool {
+	_, ok := m.clearedFields[aip.FieldDisposalAt]
+	return ok
+}
+
+// ResetDisposalAt resets all changes to the "disposal_at" field.
+func (m *AIPMutation) ResetDisposalAt() {
+	m.disposal_at = nil
+	delete(m.clearedFields, aip.FieldDisposalAt)
+}
+
 // ClearLocation clears the "location" edge to the Location entity.
 func (m *AIPMutation) ClearLocation() {
 	m.clearedlocation = true
@@ -575,6 +938,168 @@ func (m *AIPMutation) ResetDeletionRequests() {
 	m.removeddeletion_requests = nil
 }
 
+// AddFixityCheckIDs adds the "fixity_checks" edge to the FixityCheck entity by ids.
+func (m *AIPMutation) AddFixityCheckIDs(ids ...int) {
+	if m.fixity_checks == nil {
+		m.fixity_checks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.fixity_checks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFixityChecks clears the "fixity_checks" edge to the FixityCheck entity.
+func (m *AIPMutation) ClearFixityChecks() {
+	m.clearedfixity_checks = true
+}
+
+// FixityChecksCleared reports if the "fixity_checks" edge to the FixityCheck entity was cleared.
+func (m *AIPMutation) FixityChecksCleared() bool {
+	return m.clearedfixity_checks
+}
+
+// RemoveFixityCheckIDs removes the "fixity_checks" edge to the FixityCheck entity by IDs.
+func (m *AIPMutation) RemoveFixityCheckIDs(ids ...int) {
+	if m.removedfixity_checks == nil {
+		m.removedfixity_checks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.fixity_checks, ids[i])
+		m.removedfixity_checks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFixityChecks returns the removed IDs of the "fixity_checks" edge to the FixityCheck entity.
+func (m *AIPMutation) RemovedFixityChecksIDs() (ids []int) {
+	for id := range m.removedfixity_checks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FixityChecksIDs returns the "fixity_checks" edge IDs in the mutation.
+func (m *AIPMutation) FixityChecksIDs() (ids []int) {
+	for id := range m.fixity_checks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFixityChecks resets all changes to the "fixity_checks" edge.
+func (m *AIPMutation) ResetFixityChecks() {
+	m.fixity_checks = nil
+	m.clearedfixity_checks = false
+	m.removedfixity_checks = nil
+}
+
+// AddReplicaIDs adds the "replicas" edge to the AIPReplica entity by ids.
+func (m *AIPMutation) AddReplicaIDs(ids ...int) {
+	if m.replicas == nil {
+		m.replicas = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.replicas[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplicas clears the "replicas" edge to the AIPReplica entity.
+func (m *AIPMutation) ClearReplicas() {
+	m.clearedreplicas = true
+}
+
+// ReplicasCleared reports if the "replicas" edge to the AIPReplica entity was cleared.
+func (m *AIPMutation) ReplicasCleared() bool {
+	return m.clearedreplicas
+}
+
+// RemoveReplicaIDs removes the "replicas" edge to the AIPReplica entity by IDs.
+func (m *AIPMutation) RemoveReplicaIDs(ids ...int) {
+	if m.removedreplicas == nil {
+		m.removedreplicas = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.replicas, ids[i])
+		m.removedreplicas[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplicas returns the removed IDs of the "replicas" edge to the AIPReplica entity.
+func (m *AIPMutation) RemovedReplicasIDs() (ids []int) {
+	for id := range m.removedreplicas {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReplicasIDs returns the "replicas" edge IDs in the mutation.
+func (m *AIPMutation) ReplicasIDs() (ids []int) {
+	for id := range m.replicas {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplicas resets all changes to the "replicas" edge.
+func (m *AIPMutation) ResetReplicas() {
+	m.replicas = nil
+	m.clearedreplicas = false
+	m.removedreplicas = nil
+}
+
+// AddLegalHoldIDs adds the "legal_holds" edge to the AIPLegalHold entity by ids.
+func (m *AIPMutation) AddLegalHoldIDs(ids ...int) {
+	if m.legal_holds == nil {
+		m.legal_holds = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.legal_holds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLegalHolds clears the "legal_holds" edge to the AIPLegalHold entity.
+func (m *AIPMutation) ClearLegalHolds() {
+	m.clearedlegal_holds = true
+}
+
+// LegalHoldsCleared reports if the "legal_holds" edge to the AIPLegalHold entity was cleared.
+func (m *AIPMutation) LegalHoldsCleared() bool {
+	return m.clearedlegal_holds
+}
+
+// RemoveLegalHoldIDs removes the "legal_holds" edge to the AIPLegalHold entity by IDs.
+func (m *AIPMutation) RemoveLegalHoldIDs(ids ...int) {
+	if m.removedlegal_holds == nil {
+		m.removedlegal_holds = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.legal_holds, ids[i])
+		m.removedlegal_holds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLegalHolds returns the removed IDs of the "legal_holds" edge to the AIPLegalHold entity.
+func (m *AIPMutation) RemovedLegalHoldsIDs() (ids []int) {
+	for id := range m.removedlegal_holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LegalHoldsIDs returns the "legal_holds" edge IDs in the mutation.
+func (m *AIPMutation) LegalHoldsIDs() (ids []int) {
+	for id := range m.legal_holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLegalHolds resets all changes to the "legal_holds" edge.
+func (m *AIPMutation) ResetLegalHolds() {
+	m.legal_holds = nil
+	m.clearedlegal_holds = false
+	m.removedlegal_holds = nil
+}
+
 // Where appends a list predicates to the AIPMutation builder.
 func (m *AIPMutation) Where(ps ...predicate.AIP) {
 	m.predicates = append(m.predicates, ps...)
@@ -609,7 +1134,7 @@ func (m *AIPMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AIPMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 13)
 	if m.name != nil {
 		fields = append(fields, aip.FieldName)
 	}
@@ -631,8 +1156,26 @@ func (m *AIPMutation) Fields() []string {
 	if m.deletion_report_key != nil {
 		fields = append(fields, aip.FieldDeletionReportKey)
 	}
-	return fields
-}
+	if m.checksum_algorithm != nil {
+		fields = append(fields, aip.FieldChecksumAlgorithm)
+	}
+	if m.checksum_hash != nil {
+		fields = append(fields, aip.FieldChecksumHash)
+	}
+	if m.size != nil {
+		fields = append(fields, aip.FieldSize)
+	}
+	if m.file_count != nil {
+		fields = append(fields, aip.FieldFileCount)
+	}
+	if m.custom_metadata != nil {
+		fields = append(fields, aip.FieldCustomMetadata)
+	}
+	if m.disposal_at != nil {
+		fields = append(fields, aip.FieldDisposalAt)
+	}
+	return fields
+}
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
@@ -653,6 +1196,18 @@ func (m *AIPMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case aip.FieldDeletionReportKey:
 		return m.DeletionReportKey()
+	case aip.FieldChecksumAlgorithm:
+		return m.ChecksumAlgorithm()
+	case aip.FieldChecksumHash:
+		return m.ChecksumHash()
+	case aip.FieldSize:
+		return m.Size()
+	case aip.FieldFileCount:
+		return m.FileCount()
+	case aip.FieldCustomMetadata:
+		return m.CustomMetadata()
+	case aip.FieldDisposalAt:
+		return m.DisposalAt()
 	}
 	return nil, false
 }
@@ -676,6 +1231,18 @@ func (m *AIPMutation) OldField(ctx context.Context, name string) (ent.Value, err
 		return m.OldCreatedAt(ctx)
 	case aip.FieldDeletionReportKey:
 		return m.OldDeletionReportKey(ctx)
+	case aip.FieldChecksumAlgorithm:
+		return m.OldChecksumAlgorithm(ctx)
+	case aip.FieldChecksumHash:
+		return m.OldChecksumHash(ctx)
+	case aip.FieldSize:
+		return m.OldSize(ctx)
+	case aip.FieldFileCount:
+		return m.OldFileCount(ctx)
+	case aip.FieldCustomMetadata:
+		return m.OldCustomMetadata(ctx)
+	case aip.FieldDisposalAt:
+		return m.OldDisposalAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown AIP field %s", name)
 }
@@ -734,6 +1301,48 @@ func (m *AIPMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDeletionReportKey(v)
 		return nil
+	case aip.FieldChecksumAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksumAlgorithm(v)
+		return nil
+	case aip.FieldChecksumHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksumHash(v)
+		return nil
+	case aip.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case aip.FieldFileCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileCount(v)
+		return nil
+	case aip.FieldCustomMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomMetadata(v)
+		return nil
+	case aip.FieldDisposalAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisposalAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown AIP field %s", name)
 }
@@ -742,6 +1351,12 @@ func (m *AIPMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *AIPMutation) AddedFields() []string {
 	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, aip.FieldSize)
+	}
+	if m.addfile_count != nil {
+		fields = append(fields, aip.FieldFileCount)
+	}
 	return fields
 }
 
@@ -750,6 +1365,10 @@ func (m *AIPMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *AIPMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case aip.FieldSize:
+		return m.AddedSize()
+	case aip.FieldFileCount:
+		return m.AddedFileCount()
 	}
 	return nil, false
 }
@@ -759,6 +1378,20 @@ func (m *AIPMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *AIPMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case aip.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case aip.FieldFileCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileCount(v)
+		return nil
 	}
 	return fmt.Errorf("unknown AIP numeric field %s", name)
 }
@@ -773,6 +1406,24 @@ func (m *AIPMutation) ClearedFields() []string {
 	if m.FieldCleared(aip.FieldDeletionReportKey) {
 		fields = append(fields, aip.FieldDeletionReportKey)
 	}
+	if m.FieldCleared(aip.FieldChecksumAlgorithm) {
+		fields = append(fields, aip.FieldChecksumAlgorithm)
+	}
+	if m.FieldCleared(aip.FieldChecksumHash) {
+		fields = append(fields, aip.FieldChecksumHash)
+	}
+	if m.FieldCleared(aip.FieldSize) {
+		fields = append(fields, aip.FieldSize)
+	}
+	if m.FieldCleared(aip.FieldFileCount) {
+		fields = append(fields, aip.FieldFileCount)
+	}
+	if m.FieldCleared(aip.FieldCustomMetadata) {
+		fields = append(fields, aip.FieldCustomMetadata)
+	}
+	if m.FieldCleared(aip.FieldDisposalAt) {
+		fields = append(fields, aip.FieldDisposalAt)
+	}
 	return fields
 }
 
@@ -793,6 +1444,24 @@ func (m *AIPMutation) ClearField(name string) error {
 	case aip.FieldDeletionReportKey:
 		m.ClearDeletionReportKey()
 		return nil
+	case aip.FieldChecksumAlgorithm:
+		m.ClearChecksumAlgorithm()
+		return nil
+	case aip.FieldChecksumHash:
+		m.ClearChecksumHash()
+		return nil
+	case aip.FieldSize:
+		m.ClearSize()
+		return nil
+	case aip.FieldFileCount:
+		m.ClearFileCount()
+		return nil
+	case aip.FieldCustomMetadata:
+		m.ClearCustomMetadata()
+		return nil
+	case aip.FieldDisposalAt:
+		m.ClearDisposalAt()
+		return nil
 	}
 	return fmt.Errorf("unknown AIP nullable field %s", name)
 }
@@ -822,13 +1491,31 @@ func (m *AIPMutation) ResetField(name string) error {
 	case aip.FieldDeletionReportKey:
 		m.ResetDeletionReportKey()
 		return nil
+	case aip.FieldChecksumAlgorithm:
+		m.ResetChecksumAlgorithm()
+		return nil
+	case aip.FieldChecksumHash:
+		m.ResetChecksumHash()
+		return nil
+	case aip.FieldSize:
+		m.ResetSize()
+		return nil
+	case aip.FieldFileCount:
+		m.ResetFileCount()
+		return nil
+	case aip.FieldCustomMetadata:
+		m.ResetCustomMetadata()
+		return nil
+	case aip.FieldDisposalAt:
+		m.ResetDisposalAt()
+		return nil
 	}
 	return fmt.Errorf("unknown AIP field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AIPMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 6)
 	if m.location != nil {
 		edges = append(edges, aip.EdgeLocation)
 	}
@@ -838,6 +1525,15 @@ func (m *AIPMutation) AddedEdges() []string {
 	if m.deletion_requests != nil {
 		edges = append(edges, aip.EdgeDeletionRequests)
 	}
+	if m.fixity_checks != nil {
+		edges = append(edges, aip.EdgeFixityChecks)
+	}
+	if m.replicas != nil {
+		edges = append(edges, aip.EdgeReplicas)
+	}
+	if m.legal_holds != nil {
+		edges = append(edges, aip.EdgeLegalHolds)
+	}
 	return edges
 }
 
@@ -861,19 +1557,46 @@ func (m *AIPMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case aip.EdgeFixityChecks:
+		ids := make([]ent.Value, 0, len(m.fixity_checks))
+		for id := range m.fixity_checks {
+			ids = append(ids, id)
+		}
+		return ids
+	case aip.EdgeReplicas:
+		ids := make([]ent.Value, 0, len(m.replicas))
+		for id := range m.replicas {
+			ids = append(ids, id)
+		}
+		return ids
+	case aip.EdgeLegalHolds:
+		ids := make([]ent.Value, 0, len(m.legal_holds))
+		for id := range m.legal_holds {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AIPMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 6)
 	if m.removedworkflows != nil {
 		edges = append(edges, aip.EdgeWorkflows)
 	}
 	if m.removeddeletion_requests != nil {
 		edges = append(edges, aip.EdgeDeletionRequests)
 	}
+	if m.removedfixity_checks != nil {
+		edges = append(edges, aip.EdgeFixityChecks)
+	}
+	if m.removedreplicas != nil {
+		edges = append(edges, aip.EdgeReplicas)
+	}
+	if m.removedlegal_holds != nil {
+		edges = append(edges, aip.EdgeLegalHolds)
+	}
 	return edges
 }
 
@@ -893,13 +1616,31 @@ func (m *AIPMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case aip.EdgeFixityChecks:
+		ids := make([]ent.Value, 0, len(m.removedfixity_checks))
+		for id := range m.removedfixity_checks {
+			ids = append(ids, id)
+		}
+		return ids
+	case aip.EdgeReplicas:
+		ids := make([]ent.Value, 0, len(m.removedreplicas))
+		for id := range m.removedreplicas {
+			ids = append(ids, id)
+		}
+		return ids
+	case aip.EdgeLegalHolds:
+		ids := make([]ent.Value, 0, len(m.removedlegal_holds))
+		for id := range m.removedlegal_holds {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AIPMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 6)
 	if m.clearedlocation {
 		edges = append(edges, aip.EdgeLocation)
 	}
@@ -909,6 +1650,15 @@ func (m *AIPMutation) ClearedEdges() []string {
 	if m.cleareddeletion_requests {
 		edges = append(edges, aip.EdgeDeletionRequests)
 	}
+	if m.clearedfixity_checks {
+		edges = append(edges, aip.EdgeFixityChecks)
+	}
+	if m.clearedreplicas {
+		edges = append(edges, aip.EdgeReplicas)
+	}
+	if m.clearedlegal_holds {
+		edges = append(edges, aip.EdgeLegalHolds)
+	}
 	return edges
 }
 
@@ -922,6 +1672,12 @@ func (m *AIPMutation) EdgeCleared(name string) bool {
 		return m.clearedworkflows
 	case aip.EdgeDeletionRequests:
 		return m.cleareddeletion_requests
+	case aip.EdgeFixityChecks:
+		return m.clearedfixity_checks
+	case aip.EdgeReplicas:
+		return m.clearedreplicas
+	case aip.EdgeLegalHolds:
+		return m.clearedlegal_holds
 	}
 	return false
 }
@@ -950,48 +1706,53 @@ func (m *AIPMutation) ResetEdge(name string) error {
 	case aip.EdgeDeletionRequests:
 		m.ResetDeletionRequests()
 		return nil
+	case aip.EdgeFixityChecks:
+		m.ResetFixityChecks()
+		return nil
+	case aip.EdgeReplicas:
+		m.ResetReplicas()
+		return nil
+	case aip.EdgeLegalHolds:
+		m.ResetLegalHolds()
+		return nil
 	}
 	return fmt.Errorf("unknown AIP edge %s", name)
 }
 
-// DeletionRequestMutation represents an operation that mutates the DeletionRequest nodes in the graph.
-type DeletionRequestMutation struct {
+// AIPLegalHoldMutation represents an operation that mutates the AIPLegalHold nodes in the graph.
+type AIPLegalHoldMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	uuid            *uuid.UUID
-	requester       *string
-	requester_iss   *string
-	requester_sub   *string
-	reviewer        *string
-	reviewer_iss    *string
-	reviewer_sub    *string
-	reason          *string
-	status          *enums.DeletionRequestStatus
-	requested_at    *time.Time
-	reviewed_at     *time.Time
-	clearedFields   map[string]struct{}
-	aip             *int
-	clearedaip      bool
-	workflow        *int
-	clearedworkflow bool
-	done            bool
-	oldValue        func(context.Context) (*DeletionRequest, error)
-	predicates      []predicate.DeletionRequest
-}
-
-var _ ent.Mutation = (*DeletionRequestMutation)(nil)
-
-// deletionrequestOption allows management of the mutation configuration using functional options.
-type deletionrequestOption func(*DeletionRequestMutation)
-
-// newDeletionRequestMutation creates new mutation for the DeletionRequest entity.
-func newDeletionRequestMutation(c config, op Op, opts ...deletionrequestOption) *DeletionRequestMutation {
-	m := &DeletionRequestMutation{
+	op               Op
+	typ              string
+	id               *int
+	uuid             *uuid.UUID
+	reason           *string
+	placed_by        *string
+	placed_at        *time.Time
+	released_by      *string
+	release_reason   *string
+	released_at      *time.Time
+	active_aip_id    *int
+	addactive_aip_id *int
+	clearedFields    map[string]struct{}
+	aip              *int
+	clearedaip       bool
+	done             bool
+	oldValue         func(context.Context) (*AIPLegalHold, error)
+	predicates       []predicate.AIPLegalHold
+}
+
+var _ ent.Mutation = (*AIPLegalHoldMutation)(nil)
+
+// aiplegalholdOption allows management of the mutation configuration using functional options.
+type aiplegalholdOption func(*AIPLegalHoldMutation)
+
+// newAIPLegalHoldMutation creates new mutation for the AIPLegalHold entity.
+func newAIPLegalHoldMutation(c config, op Op, opts ...aiplegalholdOption) *AIPLegalHoldMutation {
+	m := &AIPLegalHoldMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeletionRequest,
+		typ:           TypeAIPLegalHold,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1000,20 +1761,20 @@ func newDeletionRequestMutation(c config, op Op, opts ...deletionrequestOption)
 	return m
 }
 
-// withDeletionRequestID sets the ID field of the mutation.
-func withDeletionRequestID(id int) deletionrequestOption {
-	return func(m *DeletionRequestMutation) {
+// withAIPLegalHoldID sets the ID field of the mutation.
+func withAIPLegalHoldID(id int) aiplegalholdOption {
+	return func(m *AIPLegalHoldMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeletionRequest
+			value *AIPLegalHold
 		)
-		m.oldValue = func(ctx context.Context) (*DeletionRequest, error) {
+		m.oldValue = func(ctx context.Context) (*AIPLegalHold, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeletionRequest.Get(ctx, id)
+					value, err = m.Client().AIPLegalHold.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1022,10 +1783,10 @@ func withDeletionRequestID(id int) deletionrequestOption {
 	}
 }
 
-// withDeletionRequest sets the old DeletionRequest of the mutation.
-func withDeletionRequest(node *DeletionRequest) deletionrequestOption {
-	return func(m *DeletionRequestMutation) {
-		m.oldValue = func(context.Context) (*DeletionRequest, error) {
+// withAIPLegalHold sets the old AIPLegalHold of the mutation.
+func withAIPLegalHold(node *AIPLegalHold) aiplegalholdOption {
+	return func(m *AIPLegalHoldMutation) {
+		m.oldValue = func(context.Context) (*AIPLegalHold, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1034,7 +1795,7 @@ func withDeletionRequest(node *DeletionRequest) deletionrequestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeletionRequestMutation) Client() *Client {
+func (m AIPLegalHoldMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1042,7 +1803,7 @@ func (m DeletionRequestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeletionRequestMutation) Tx() (*Tx, error) {
+func (m AIPLegalHoldMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -1053,7 +1814,7 @@ func (m DeletionRequestMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeletionRequestMutation) ID() (id int, exists bool) {
+func (m *AIPLegalHoldMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1064,7 +1825,7 @@ func (m *DeletionRequestMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeletionRequestMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AIPLegalHoldMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1073,19 +1834,19 @@ func (m *DeletionRequestMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeletionRequest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AIPLegalHold.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUUID sets the "uuid" field.
-func (m *DeletionRequestMutation) SetUUID(u uuid.UUID) {
+func (m *AIPLegalHoldMutation) SetUUID(u uuid.UUID) {
 	m.uuid = &u
 }
 
 // UUID returns the value of the "uuid" field in the mutation.
-func (m *DeletionRequestMutation) UUID() (r uuid.UUID, exists bool) {
+func (m *AIPLegalHoldMutation) UUID() (r uuid.UUID, exists bool) {
 	v := m.uuid
 	if v == nil {
 		return
@@ -1093,10 +1854,10 @@ func (m *DeletionRequestMutation) UUID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldUUID returns the old "uuid" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldUUID returns the old "uuid" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AIPLegalHoldMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
 	}
@@ -1111,429 +1872,272 @@ func (m *DeletionRequestMutation) OldUUID(ctx context.Context) (v uuid.UUID, err
 }
 
 // ResetUUID resets all changes to the "uuid" field.
-func (m *DeletionRequestMutation) ResetUUID() {
+func (m *AIPLegalHoldMutation) ResetUUID() {
 	m.uuid = nil
 }
 
-// SetRequester sets the "requester" field.
-func (m *DeletionRequestMutation) SetRequester(s string) {
-	m.requester = &s
+// SetReason sets the "reason" field.
+func (m *AIPLegalHoldMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Requester returns the value of the "requester" field in the mutation.
-func (m *DeletionRequestMutation) Requester() (r string, exists bool) {
-	v := m.requester
+// Reason returns the value of the "reason" field in the mutation.
+func (m *AIPLegalHoldMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequester returns the old "requester" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldRequester(ctx context.Context) (v string, err error) {
+func (m *AIPLegalHoldMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequester is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequester requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequester: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.Requester, nil
+	return oldValue.Reason, nil
 }
 
-// ResetRequester resets all changes to the "requester" field.
-func (m *DeletionRequestMutation) ResetRequester() {
-	m.requester = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *AIPLegalHoldMutation) ResetReason() {
+	m.reason = nil
 }
 
-// SetRequesterIss sets the "requester_iss" field.
-func (m *DeletionRequestMutation) SetRequesterIss(s string) {
-	m.requester_iss = &s
+// SetPlacedBy sets the "placed_by" field.
+func (m *AIPLegalHoldMutation) SetPlacedBy(s string) {
+	m.placed_by = &s
 }
 
-// RequesterIss returns the value of the "requester_iss" field in the mutation.
-func (m *DeletionRequestMutation) RequesterIss() (r string, exists bool) {
-	v := m.requester_iss
+// PlacedBy returns the value of the "placed_by" field in the mutation.
+func (m *AIPLegalHoldMutation) PlacedBy() (r string, exists bool) {
+	v := m.placed_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequesterIss returns the old "requester_iss" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldPlacedBy returns the old "placed_by" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldRequesterIss(ctx context.Context) (v string, err error) {
+func (m *AIPLegalHoldMutation) OldPlacedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequesterIss is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlacedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequesterIss requires an ID field in the mutation")
+		return v, errors.New("OldPlacedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequesterIss: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlacedBy: %w", err)
 	}
-	return oldValue.RequesterIss, nil
+	return oldValue.PlacedBy, nil
 }
 
-// ResetRequesterIss resets all changes to the "requester_iss" field.
-func (m *DeletionRequestMutation) ResetRequesterIss() {
-	m.requester_iss = nil
+// ResetPlacedBy resets all changes to the "placed_by" field.
+func (m *AIPLegalHoldMutation) ResetPlacedBy() {
+	m.placed_by = nil
 }
 
-// SetRequesterSub sets the "requester_sub" field.
-func (m *DeletionRequestMutation) SetRequesterSub(s string) {
-	m.requester_sub = &s
+// SetPlacedAt sets the "placed_at" field.
+func (m *AIPLegalHoldMutation) SetPlacedAt(t time.Time) {
+	m.placed_at = &t
 }
 
-// RequesterSub returns the value of the "requester_sub" field in the mutation.
-func (m *DeletionRequestMutation) RequesterSub() (r string, exists bool) {
-	v := m.requester_sub
+// PlacedAt returns the value of the "placed_at" field in the mutation.
+func (m *AIPLegalHoldMutation) PlacedAt() (r time.Time, exists bool) {
+	v := m.placed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequesterSub returns the old "requester_sub" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldPlacedAt returns the old "placed_at" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldRequesterSub(ctx context.Context) (v string, err error) {
+func (m *AIPLegalHoldMutation) OldPlacedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequesterSub is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlacedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequesterSub requires an ID field in the mutation")
+		return v, errors.New("OldPlacedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequesterSub: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlacedAt: %w", err)
 	}
-	return oldValue.RequesterSub, nil
+	return oldValue.PlacedAt, nil
 }
 
-// ResetRequesterSub resets all changes to the "requester_sub" field.
-func (m *DeletionRequestMutation) ResetRequesterSub() {
-	m.requester_sub = nil
+// ResetPlacedAt resets all changes to the "placed_at" field.
+func (m *AIPLegalHoldMutation) ResetPlacedAt() {
+	m.placed_at = nil
 }
 
-// SetReviewer sets the "reviewer" field.
-func (m *DeletionRequestMutation) SetReviewer(s string) {
-	m.reviewer = &s
+// SetReleasedBy sets the "released_by" field.
+func (m *AIPLegalHoldMutation) SetReleasedBy(s string) {
+	m.released_by = &s
 }
 
-// Reviewer returns the value of the "reviewer" field in the mutation.
-func (m *DeletionRequestMutation) Reviewer() (r string, exists bool) {
-	v := m.reviewer
+// ReleasedBy returns the value of the "released_by" field in the mutation.
+func (m *AIPLegalHoldMutation) ReleasedBy() (r string, exists bool) {
+	v := m.released_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReviewer returns the old "reviewer" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldReleasedBy returns the old "released_by" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldReviewer(ctx context.Context) (v string, err error) {
+func (m *AIPLegalHoldMutation) OldReleasedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewer is only allowed on UpdateOne operations")
+		return v, errors.New("OldReleasedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewer requires an ID field in the mutation")
+		return v, errors.New("OldReleasedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewer: %w", err)
+		return v, fmt.Errorf("querying old value for OldReleasedBy: %w", err)
 	}
-	return oldValue.Reviewer, nil
+	return oldValue.ReleasedBy, nil
 }
 
-// ClearReviewer clears the value of the "reviewer" field.
-func (m *DeletionRequestMutation) ClearReviewer() {
-	m.reviewer = nil
-	m.clearedFields[deletionrequest.FieldReviewer] = struct{}{}
+// ClearReleasedBy clears the value of the "released_by" field.
+func (m *AIPLegalHoldMutation) ClearReleasedBy() {
+	m.released_by = nil
+	m.clearedFields[aiplegalhold.FieldReleasedBy] = struct{}{}
 }
 
-// ReviewerCleared returns if the "reviewer" field was cleared in this mutation.
-func (m *DeletionRequestMutation) ReviewerCleared() bool {
-	_, ok := m.clearedFields[deletionrequest.FieldReviewer]
+// ReleasedByCleared returns if the "released_by" field was cleared in this mutation.
+func (m *AIPLegalHoldMutation) ReleasedByCleared() bool {
+	_, ok := m.clearedFields[aiplegalhold.FieldReleasedBy]
 	return ok
 }
 
-// ResetReviewer resets all changes to the "reviewer" field.
-func (m *DeletionRequestMutation) ResetReviewer() {
-	m.reviewer = nil
-	delete(m.clearedFields, deletionrequest.FieldReviewer)
+// ResetReleasedBy resets all changes to the "released_by" field.
+func (m *AIPLegalHoldMutation) ResetReleasedBy() {
+	m.released_by = nil
+	delete(m.clearedFields, aiplegalhold.FieldReleasedBy)
 }
 
-// SetReviewerIss sets the "reviewer_iss" field.
-func (m *DeletionRequestMutation) SetReviewerIss(s string) {
-	m.reviewer_iss = &s
+// SetReleaseReason sets the "release_reason" field.
+func (m *AIPLegalHoldMutation) SetReleaseReason(s string) {
+	m.release_reason = &s
 }
 
-// ReviewerIss returns the value of the "reviewer_iss" field in the mutation.
-func (m *DeletionRequestMutation) ReviewerIss() (r string, exists bool) {
-	v := m.reviewer_iss
+// ReleaseReason returns the value of the "release_reason" field in the mutation.
+func (m *AIPLegalHoldMutation) ReleaseReason() (r string, exists bool) {
+	v := m.release_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReviewerIss returns the old "reviewer_iss" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldReleaseReason returns the old "release_reason" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldReviewerIss(ctx context.Context) (v string, err error) {
+func (m *AIPLegalHoldMutation) OldReleaseReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewerIss is only allowed on UpdateOne operations")
+		return v, errors.New("OldReleaseReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewerIss requires an ID field in the mutation")
+		return v, errors.New("OldReleaseReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewerIss: %w", err)
+		return v, fmt.Errorf("querying old value for OldReleaseReason: %w", err)
 	}
-	return oldValue.ReviewerIss, nil
+	return oldValue.ReleaseReason, nil
 }
 
-// ClearReviewerIss clears the value of the "reviewer_iss" field.
-func (m *DeletionRequestMutation) ClearReviewerIss() {
-	m.reviewer_iss = nil
-	m.clearedFields[deletionrequest.FieldReviewerIss] = struct{}{}
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (m *AIPLegalHoldMutation) ClearReleaseReason() {
+	m.release_reason = nil
+	m.clearedFields[aiplegalhold.FieldReleaseReason] = struct{}{}
 }
 
-// ReviewerIssCleared returns if the "reviewer_iss" field was cleared in this mutation.
-func (m *DeletionRequestMutation) ReviewerIssCleared() bool {
-	_, ok := m.clearedFields[deletionrequest.FieldReviewerIss]
+// ReleaseReasonCleared returns if the "release_reason" field was cleared in this mutation.
+func (m *AIPLegalHoldMutation) ReleaseReasonCleared() bool {
+	_, ok := m.clearedFields[aiplegalhold.FieldReleaseReason]
 	return ok
 }
 
-// ResetReviewerIss resets all changes to the "reviewer_iss" field.
-func (m *DeletionRequestMutation) ResetReviewerIss() {
-	m.reviewer_iss = nil
-	delete(m.clearedFields, deletionrequest.FieldReviewerIss)
+// ResetReleaseReason resets all changes to the "release_reason" field.
+func (m *AIPLegalHoldMutation) ResetReleaseReason() {
+	m.release_reason = nil
+	delete(m.clearedFields, aiplegalhold.FieldReleaseReason)
 }
 
-// SetReviewerSub sets the "reviewer_sub" field.
-func (m *DeletionRequestMutation) SetReviewerSub(s string) {
-	m.reviewer_sub = &s
+// SetReleasedAt sets the "released_at" field.
+func (m *AIPLegalHoldMutation) SetReleasedAt(t time.Time) {
+	m.released_at = &t
 }
 
-// ReviewerSub returns the value of the "reviewer_sub" field in the mutation.
-func (m *DeletionRequestMutation) ReviewerSub() (r string, exists bool) {
-	v := m.reviewer_sub
+// ReleasedAt returns the value of the "released_at" field in the mutation.
+func (m *AIPLegalHoldMutation) ReleasedAt() (r time.Time, exists bool) {
+	v := m.released_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReviewerSub returns the old "reviewer_sub" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldReleasedAt returns the old "released_at" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldReviewerSub(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewerSub is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewerSub requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewerSub: %w", err)
-	}
-	return oldValue.ReviewerSub, nil
-}
-
-// ClearReviewerSub clears the value of the "reviewer_sub" field.
-func (m *DeletionRequestMutation) ClearReviewerSub() {
-	m.reviewer_sub = nil
-	m.clearedFields[deletionrequest.FieldReviewerSub] = struct{}{}
-}
-
-// ReviewerSubCleared returns if the "reviewer_sub" field was cleared in this mutation.
-func (m *DeletionRequestMutation) ReviewerSubCleared() bool {
-	_, ok := m.clearedFields[deletionrequest.FieldReviewerSub]
-	return ok
-}
-
-// ResetReviewerSub resets all changes to the "reviewer_sub" field.
-func (m *DeletionRequestMutation) ResetReviewerSub() {
-	m.reviewer_sub = nil
-	delete(m.clearedFields, deletionrequest.FieldReviewerSub)
-}
-
-// SetReason sets the "reason" field.
-func (m *DeletionRequestMutation) SetReason(s string) {
-	m.reason = &s
-}
-
-// Reason returns the value of the "reason" field in the mutation.
-func (m *DeletionRequestMutation) Reason() (r string, exists bool) {
-	v := m.reason
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldReason returns the old "reason" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldReason(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
-	}
-	return oldValue.Reason, nil
-}
-
-// ResetReason resets all changes to the "reason" field.
-func (m *DeletionRequestMutation) ResetReason() {
-	m.reason = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *DeletionRequestMutation) SetStatus(ers enums.DeletionRequestStatus) {
-	m.status = &ers
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *DeletionRequestMutation) Status() (r enums.DeletionRequestStatus, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldStatus(ctx context.Context) (v enums.DeletionRequestStatus, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *DeletionRequestMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetRequestedAt sets the "requested_at" field.
-func (m *DeletionRequestMutation) SetRequestedAt(t time.Time) {
-	m.requested_at = &t
-}
-
-// RequestedAt returns the value of the "requested_at" field in the mutation.
-func (m *DeletionRequestMutation) RequestedAt() (r time.Time, exists bool) {
-	v := m.requested_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRequestedAt returns the old "requested_at" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldRequestedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
-	}
-	return oldValue.RequestedAt, nil
-}
-
-// ResetRequestedAt resets all changes to the "requested_at" field.
-func (m *DeletionRequestMutation) ResetRequestedAt() {
-	m.requested_at = nil
-}
-
-// SetReviewedAt sets the "reviewed_at" field.
-func (m *DeletionRequestMutation) SetReviewedAt(t time.Time) {
-	m.reviewed_at = &t
-}
-
-// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
-func (m *DeletionRequestMutation) ReviewedAt() (r time.Time, exists bool) {
-	v := m.reviewed_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldReviewedAt returns the old "reviewed_at" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldReviewedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AIPLegalHoldMutation) OldReleasedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldReleasedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+		return v, errors.New("OldReleasedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldReleasedAt: %w", err)
 	}
-	return oldValue.ReviewedAt, nil
+	return oldValue.ReleasedAt, nil
 }
 
-// ClearReviewedAt clears the value of the "reviewed_at" field.
-func (m *DeletionRequestMutation) ClearReviewedAt() {
-	m.reviewed_at = nil
-	m.clearedFields[deletionrequest.FieldReviewedAt] = struct{}{}
+// ClearReleasedAt clears the value of the "released_at" field.
+func (m *AIPLegalHoldMutation) ClearReleasedAt() {
+	m.released_at = nil
+	m.clearedFields[aiplegalhold.FieldReleasedAt] = struct{}{}
 }
 
-// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
-func (m *DeletionRequestMutation) ReviewedAtCleared() bool {
-	_, ok := m.clearedFields[deletionrequest.FieldReviewedAt]
+// ReleasedAtCleared returns if the "released_at" field was cleared in this mutation.
+func (m *AIPLegalHoldMutation) ReleasedAtCleared() bool {
+	_, ok := m.clearedFields[aiplegalhold.FieldReleasedAt]
 	return ok
 }
 
-// ResetReviewedAt resets all changes to the "reviewed_at" field.
-func (m *DeletionRequestMutation) ResetReviewedAt() {
-	m.reviewed_at = nil
-	delete(m.clearedFields, deletionrequest.FieldReviewedAt)
+// ResetReleasedAt resets all changes to the "released_at" field.
+func (m *AIPLegalHoldMutation) ResetReleasedAt() {
+	m.released_at = nil
+	delete(m.clearedFields, aiplegalhold.FieldReleasedAt)
 }
 
 // SetAipID sets the "aip_id" field.
-func (m *DeletionRequestMutation) SetAipID(i int) {
+func (m *AIPLegalHoldMutation) SetAipID(i int) {
 	m.aip = &i
 }
 
 // AipID returns the value of the "aip_id" field in the mutation.
-func (m *DeletionRequestMutation) AipID() (r int, exists bool) {
+func (m *AIPLegalHoldMutation) AipID() (r int, exists bool) {
 	v := m.aip
 	if v == nil {
 		return
@@ -1541,10 +2145,10 @@ func (m *DeletionRequestMutation) AipID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldAipID returns the old "aip_id" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldAipID returns the old "aip_id" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldAipID(ctx context.Context) (v int, err error) {
+func (m *AIPLegalHoldMutation) OldAipID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAipID is only allowed on UpdateOne operations")
 	}
@@ -1559,74 +2163,95 @@ func (m *DeletionRequestMutation) OldAipID(ctx context.Context) (v int, err erro
 }
 
 // ResetAipID resets all changes to the "aip_id" field.
-func (m *DeletionRequestMutation) ResetAipID() {
+func (m *AIPLegalHoldMutation) ResetAipID() {
 	m.aip = nil
 }
 
-// SetWorkflowID sets the "workflow_id" field.
-func (m *DeletionRequestMutation) SetWorkflowID(i int) {
-	m.workflow = &i
+// SetActiveAipID sets the "active_aip_id" field.
+func (m *AIPLegalHoldMutation) SetActiveAipID(i int) {
+	m.active_aip_id = &i
+	m.addactive_aip_id = nil
 }
 
-// WorkflowID returns the value of the "workflow_id" field in the mutation.
-func (m *DeletionRequestMutation) WorkflowID() (r int, exists bool) {
-	v := m.workflow
+// ActiveAipID returns the value of the "active_aip_id" field in the mutation.
+func (m *AIPLegalHoldMutation) ActiveAipID() (r int, exists bool) {
+	v := m.active_aip_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWorkflowID returns the old "workflow_id" field's value of the DeletionRequest entity.
-// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldActiveAipID returns the old "active_aip_id" field's value of the AIPLegalHold entity.
+// If the AIPLegalHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeletionRequestMutation) OldWorkflowID(ctx context.Context) (v int, err error) {
+func (m *AIPLegalHoldMutation) OldActiveAipID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWorkflowID is only allowed on UpdateOne operations")
+		return v, errors.New("OldActiveAipID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWorkflowID requires an ID field in the mutation")
+		return v, errors.New("OldActiveAipID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWorkflowID: %w", err)
+		return v, fmt.Errorf("querying old value for OldActiveAipID: %w", err)
 	}
-	return oldValue.WorkflowID, nil
+	return oldValue.ActiveAipID, nil
 }
 
-// ClearWorkflowID clears the value of the "workflow_id" field.
-func (m *DeletionRequestMutation) ClearWorkflowID() {
-	m.workflow = nil
-	m.clearedFields[deletionrequest.FieldWorkflowID] = struct{}{}
+// AddActiveAipID adds i to the "active_aip_id" field.
+func (m *AIPLegalHoldMutation) AddActiveAipID(i int) {
+	if m.addactive_aip_id != nil {
+		*m.addactive_aip_id += i
+	} else {
+		m.addactive_aip_id = &i
+	}
 }
 
-// WorkflowIDCleared returns if the "workflow_id" field was cleared in this mutation.
-func (m *DeletionRequestMutation) WorkflowIDCleared() bool {
-	_, ok := m.clearedFields[deletionrequest.FieldWorkflowID]
+// AddedActiveAipID returns the value that was added to the "active_aip_id" field in this mutation.
+func (m *AIPLegalHoldMutation) AddedActiveAipID() (r int, exists bool) {
+	v := m.addactive_aip_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearActiveAipID clears the value of the "active_aip_id" field.
+func (m *AIPLegalHoldMutation) ClearActiveAipID() {
+	m.active_aip_id = nil
+	m.addactive_aip_id = nil
+	m.clearedFields[aiplegalhold.FieldActiveAipID] = struct{}{}
+}
+
+// ActiveAipIDCleared returns if the "active_aip_id" field was cleared in this mutation.
+func (m *AIPLegalHoldMutation) ActiveAipIDCleared() bool {
+	_, ok := m.clearedFields[aiplegalhold.FieldActiveAipID]
 	return ok
 }
 
-// ResetWorkflowID resets all changes to the "workflow_id" field.
-func (m *DeletionRequestMutation) ResetWorkflowID() {
-	m.workflow = nil
-	delete(m.clearedFields, deletionrequest.FieldWorkflowID)
+// ResetActiveAipID resets all changes to the "active_aip_id" field.
+func (m *AIPLegalHoldMutation) ResetActiveAipID() {
+	m.active_aip_id = nil
+	m.addactive_aip_id = nil
+	delete(m.clearedFields, aiplegalhold.FieldActiveAipID)
 }
 
 // ClearAip clears the "aip" edge to the AIP entity.
-func (m *DeletionRequestMutation) ClearAip() {
+func (m *AIPLegalHoldMutation) ClearAip() {
 	m.clearedaip = true
-	m.clearedFields[deletionrequest.FieldAipID] = struct{}{}
+	m.clearedFields[aiplegalhold.FieldAipID] = struct{}{}
 }
 
 // AipCleared reports if the "aip" edge to the AIP entity was cleared.
-func (m *DeletionRequestMutation) AipCleared() bool {
+func (m *AIPLegalHoldMutation) AipCleared() bool {
 	return m.clearedaip
 }
 
 // AipIDs returns the "aip" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // AipID instead. It exists only for internal usage by the builders.
-func (m *DeletionRequestMutation) AipIDs() (ids []int) {
+func (m *AIPLegalHoldMutation) AipIDs() (ids []int) {
 	if id := m.aip; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1634,47 +2259,20 @@ func (m *DeletionRequestMutation) AipIDs() (ids []int) {
 }
 
 // ResetAip resets all changes to the "aip" edge.
-func (m *DeletionRequestMutation) ResetAip() {
+func (m *AIPLegalHoldMutation) ResetAip() {
 	m.aip = nil
 	m.clearedaip = false
 }
 
-// ClearWorkflow clears the "workflow" edge to the Workflow entity.
-func (m *DeletionRequestMutation) ClearWorkflow() {
-	m.clearedworkflow = true
-	m.clearedFields[deletionrequest.FieldWorkflowID] = struct{}{}
-}
-
-// WorkflowCleared reports if the "workflow" edge to the Workflow entity was cleared.
-func (m *DeletionRequestMutation) WorkflowCleared() bool {
-	return m.WorkflowIDCleared() || m.clearedworkflow
-}
-
-// WorkflowIDs returns the "workflow" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WorkflowID instead. It exists only for internal usage by the builders.
-func (m *DeletionRequestMutation) WorkflowIDs() (ids []int) {
-	if id := m.workflow; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetWorkflow resets all changes to the "workflow" edge.
-func (m *DeletionRequestMutation) ResetWorkflow() {
-	m.workflow = nil
-	m.clearedworkflow = false
-}
-
-// Where appends a list predicates to the DeletionRequestMutation builder.
-func (m *DeletionRequestMutation) Where(ps ...predicate.DeletionRequest) {
+// Where appends a list predicates to the AIPLegalHoldMutation builder.
+func (m *AIPLegalHoldMutation) Where(ps ...predicate.AIPLegalHold) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeletionRequestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AIPLegalHoldMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeletionRequestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeletionRequest, len(ps))
+func (m *AIPLegalHoldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AIPLegalHold, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1682,63 +2280,51 @@ func (m *DeletionRequestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeletionRequestMutation) Op() Op {
+func (m *AIPLegalHoldMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeletionRequestMutation) SetOp(op Op) {
+func (m *AIPLegalHoldMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeletionRequest).
-func (m *DeletionRequestMutation) Type() string {
+// Type returns the node type of this mutation (AIPLegalHold).
+func (m *AIPLegalHoldMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeletionRequestMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+func (m *AIPLegalHoldMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.uuid != nil {
-		fields = append(fields, deletionrequest.FieldUUID)
+		fields = append(fields, aiplegalhold.FieldUUID)
 	}
-	if m.requester != nil {
-		fields = append(fields, deletionrequest.FieldRequester)
-	}
-	if m.requester_iss != nil {
-		fields = append(fields, deletionrequest.FieldRequesterIss)
-	}
-	if m.requester_sub != nil {
-		fields = append(fields, deletionrequest.FieldRequesterSub)
-	}
-	if m.reviewer != nil {
-		fields = append(fields, deletionrequest.FieldReviewer)
-	}
-	if m.reviewer_iss != nil {
-		fields = append(fields, deletionrequest.FieldReviewerIss)
+	if m.reason != nil {
+		fields = append(fields, aiplegalhold.FieldReason)
 	}
-	if m.reviewer_sub != nil {
-		fields = append(fields, deletionrequest.FieldReviewerSub)
+	if m.placed_by != nil {
+		fields = append(fields, aiplegalhold.FieldPlacedBy)
 	}
-	if m.reason != nil {
-		fields = append(fields, deletionrequest.FieldReason)
+	if m.placed_at != nil {
+		fields = append(fields, aiplegalhold.FieldPlacedAt)
 	}
-	if m.status != nil {
-		fields = append(fields, deletionrequest.FieldStatus)
+	if m.released_by != nil {
+		fields = append(fields, aiplegalhold.FieldReleasedBy)
 	}
-	if m.requested_at != nil {
-		fields = append(fields, deletionrequest.FieldRequestedAt)
+	if m.release_reason != nil {
+		fields = append(fields, aiplegalhold.FieldReleaseReason)
 	}
-	if m.reviewed_at != nil {
-		fields = append(fields, deletionrequest.FieldReviewedAt)
+	if m.released_at != nil {
+		fields = append(fields, aiplegalhold.FieldReleasedAt)
 	}
 	if m.aip != nil {
-		fields = append(fields, deletionrequest.FieldAipID)
+		fields = append(fields, aiplegalhold.FieldAipID)
 	}
-	if m.workflow != nil {
-		fields = append(fields, deletionrequest.FieldWorkflowID)
+	if m.active_aip_id != nil {
+		fields = append(fields, aiplegalhold.FieldActiveAipID)
 	}
 	return fields
 }
@@ -1746,34 +2332,26 @@ func (m *DeletionRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeletionRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *AIPLegalHoldMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deletionrequest.FieldUUID:
+	case aiplegalhold.FieldUUID:
 		return m.UUID()
-	case deletionrequest.FieldRequester:
-		return m.Requester()
-	case deletionrequest.FieldRequesterIss:
-		return m.RequesterIss()
-	case deletionrequest.FieldRequesterSub:
-		return m.RequesterSub()
-	case deletionrequest.FieldReviewer:
-		return m.Reviewer()
-	case deletionrequest.FieldReviewerIss:
-		return m.ReviewerIss()
-	case deletionrequest.FieldReviewerSub:
-		return m.ReviewerSub()
-	case deletionrequest.FieldReason:
+	case aiplegalhold.FieldReason:
 		return m.Reason()
-	case deletionrequest.FieldStatus:
-		return m.Status()
-	case deletionrequest.FieldRequestedAt:
-		return m.RequestedAt()
-	case deletionrequest.FieldReviewedAt:
-		return m.ReviewedAt()
-	case deletionrequest.FieldAipID:
+	case aiplegalhold.FieldPlacedBy:
+		return m.PlacedBy()
+	case aiplegalhold.FieldPlacedAt:
+		return m.PlacedAt()
+	case aiplegalhold.FieldReleasedBy:
+		return m.ReleasedBy()
+	case aiplegalhold.FieldReleaseReason:
+		return m.ReleaseReason()
+	case aiplegalhold.FieldReleasedAt:
+		return m.ReleasedAt()
+	case aiplegalhold.FieldAipID:
 		return m.AipID()
-	case deletionrequest.FieldWorkflowID:
-		return m.WorkflowID()
+	case aiplegalhold.FieldActiveAipID:
+		return m.ActiveAipID()
 	}
 	return nil, false
 }
@@ -1781,150 +2359,119 @@ func (m *DeletionRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeletionRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AIPLegalHoldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deletionrequest.FieldUUID:
+	case aiplegalhold.FieldUUID:
 		return m.OldUUID(ctx)
-	case deletionrequest.FieldRequester:
-		return m.OldRequester(ctx)
-	case deletionrequest.FieldRequesterIss:
-		return m.OldRequesterIss(ctx)
-	case deletionrequest.FieldRequesterSub:
-		return m.OldRequesterSub(ctx)
-	case deletionrequest.FieldReviewer:
-		return m.OldReviewer(ctx)
-	case deletionrequest.FieldReviewerIss:
-		return m.OldReviewerIss(ctx)
-	case deletionrequest.FieldReviewerSub:
-		return m.OldReviewerSub(ctx)
-	case deletionrequest.FieldReason:
+	case aiplegalhold.FieldReason:
 		return m.OldReason(ctx)
-	case deletionrequest.FieldStatus:
-		return m.OldStatus(ctx)
-	case deletionrequest.FieldRequestedAt:
-		return m.OldRequestedAt(ctx)
-	case deletionrequest.FieldReviewedAt:
-		return m.OldReviewedAt(ctx)
-	case deletionrequest.FieldAipID:
+	case aiplegalhold.FieldPlacedBy:
+		return m.OldPlacedBy(ctx)
+	case aiplegalhold.FieldPlacedAt:
+		return m.OldPlacedAt(ctx)
+	case aiplegalhold.FieldReleasedBy:
+		return m.OldReleasedBy(ctx)
+	case aiplegalhold.FieldReleaseReason:
+		return m.OldReleaseReason(ctx)
+	case aiplegalhold.FieldReleasedAt:
+		return m.OldReleasedAt(ctx)
+	case aiplegalhold.FieldAipID:
 		return m.OldAipID(ctx)
-	case deletionrequest.FieldWorkflowID:
-		return m.OldWorkflowID(ctx)
+	case aiplegalhold.FieldActiveAipID:
+		return m.OldActiveAipID(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeletionRequest field %s", name)
+	return nil, fmt.Errorf("unknown AIPLegalHold field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeletionRequestMutation) SetField(name string, value ent.Value) error {
+func (m *AIPLegalHoldMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deletionrequest.FieldUUID:
+	case aiplegalhold.FieldUUID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUUID(v)
 		return nil
-	case deletionrequest.FieldRequester:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRequester(v)
-		return nil
-	case deletionrequest.FieldRequesterIss:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRequesterIss(v)
-		return nil
-	case deletionrequest.FieldRequesterSub:
+	case aiplegalhold.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRequesterSub(v)
+		m.SetReason(v)
 		return nil
-	case deletionrequest.FieldReviewer:
+	case aiplegalhold.FieldPlacedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReviewer(v)
+		m.SetPlacedBy(v)
 		return nil
-	case deletionrequest.FieldReviewerIss:
-		v, ok := value.(string)
+	case aiplegalhold.FieldPlacedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReviewerIss(v)
+		m.SetPlacedAt(v)
 		return nil
-	case deletionrequest.FieldReviewerSub:
+	case aiplegalhold.FieldReleasedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReviewerSub(v)
+		m.SetReleasedBy(v)
 		return nil
-	case deletionrequest.FieldReason:
+	case aiplegalhold.FieldReleaseReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
-		return nil
-	case deletionrequest.FieldStatus:
-		v, ok := value.(enums.DeletionRequestStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case deletionrequest.FieldRequestedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRequestedAt(v)
+		m.SetReleaseReason(v)
 		return nil
-	case deletionrequest.FieldReviewedAt:
+	case aiplegalhold.FieldReleasedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReviewedAt(v)
+		m.SetReleasedAt(v)
 		return nil
-	case deletionrequest.FieldAipID:
+	case aiplegalhold.FieldAipID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAipID(v)
 		return nil
-	case deletionrequest.FieldWorkflowID:
+	case aiplegalhold.FieldActiveAipID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWorkflowID(v)
+		m.SetActiveAipID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeletionRequest field %s", name)
+	return fmt.Errorf("unknown AIPLegalHold field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeletionRequestMutation) AddedFields() []string {
+func (m *AIPLegalHoldMutation) AddedFields() []string {
 	var fields []string
+	if m.addactive_aip_id != nil {
+		fields = append(fields, aiplegalhold.FieldActiveAipID)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeletionRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AIPLegalHoldMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case aiplegalhold.FieldActiveAipID:
+		return m.AddedActiveAipID()
 	}
 	return nil, false
 }
@@ -1932,236 +2479,4553 @@ func (m *DeletionRequestMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeletionRequestMutation) AddField(name string, value ent.Value) error {
+func (m *AIPLegalHoldMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case aiplegalhold.FieldActiveAipID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActiveAipID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DeletionRequest numeric field %s", name)
+	return fmt.Errorf("unknown AIPLegalHold numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeletionRequestMutation) ClearedFields() []string {
+func (m *AIPLegalHoldMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(deletionrequest.FieldReviewer) {
-		fields = append(fields, deletionrequest.FieldReviewer)
-	}
-	if m.FieldCleared(deletionrequest.FieldReviewerIss) {
-		fields = append(fields, deletionrequest.FieldReviewerIss)
+	if m.FieldCleared(aiplegalhold.FieldReleasedBy) {
+		fields = append(fields, aiplegalhold.FieldReleasedBy)
 	}
-	if m.FieldCleared(deletionrequest.FieldReviewerSub) {
-		fields = append(fields, deletionrequest.FieldReviewerSub)
+	if m.FieldCleared(aiplegalhold.FieldReleaseReason) {
+		fields = append(fields, aiplegalhold.FieldReleaseReason)
 	}
-	if m.FieldCleared(deletionrequest.FieldReviewedAt) {
-		fields = append(fields, deletionrequest.FieldReviewedAt)
+	if m.FieldCleared(aiplegalhold.FieldReleasedAt) {
+		fields = append(fields, aiplegalhold.FieldReleasedAt)
 	}
-	if m.FieldCleared(deletionrequest.FieldWorkflowID) {
-		fields = append(fields, deletionrequest.FieldWorkflowID)
+	if m.FieldCleared(aiplegalhold.FieldActiveAipID) {
+		fields = append(fields, aiplegalhold.FieldActiveAipID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeletionRequestMutation) FieldCleared(name string) bool {
+func (m *AIPLegalHoldMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeletionRequestMutation) ClearField(name string) error {
+func (m *AIPLegalHoldMutation) ClearField(name string) error {
 	switch name {
-	case deletionrequest.FieldReviewer:
-		m.ClearReviewer()
+	case aiplegalhold.FieldReleasedBy:
+		m.ClearReleasedBy()
 		return nil
-	case deletionrequest.FieldReviewerIss:
-		m.ClearReviewerIss()
-		return nil
-	case deletionrequest.FieldReviewerSub:
-		m.ClearReviewerSub()
+	case aiplegalhold.FieldReleaseReason:
+		m.ClearReleaseReason()
 		return nil
-	case deletionrequest.FieldReviewedAt:
-		m.ClearReviewedAt()
+	case aiplegalhold.FieldReleasedAt:
+		m.ClearReleasedAt()
 		return nil
-	case deletionrequest.FieldWorkflowID:
-		m.ClearWorkflowID()
+	case aiplegalhold.FieldActiveAipID:
+		m.ClearActiveAipID()
 		return nil
 	}
-	return fmt.Errorf("unknown DeletionRequest nullable field %s", name)
+	return fmt.Errorf("unknown AIPLegalHold nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeletionRequestMutation) ResetField(name string) error {
+func (m *AIPLegalHoldMutation) ResetField(name string) error {
 	switch name {
-	case deletionrequest.FieldUUID:
+	case aiplegalhold.FieldUUID:
 		m.ResetUUID()
 		return nil
-	case deletionrequest.FieldRequester:
-		m.ResetRequester()
-		return nil
-	case deletionrequest.FieldRequesterIss:
-		m.ResetRequesterIss()
-		return nil
-	case deletionrequest.FieldRequesterSub:
-		m.ResetRequesterSub()
-		return nil
-	case deletionrequest.FieldReviewer:
-		m.ResetReviewer()
-		return nil
-	case deletionrequest.FieldReviewerIss:
-		m.ResetReviewerIss()
+	case aiplegalhold.FieldReason:
+		m.ResetReason()
 		return nil
-	case deletionrequest.FieldReviewerSub:
-		m.ResetReviewerSub()
+	case aiplegalhold.FieldPlacedBy:
+		m.ResetPlacedBy()
 		return nil
-	case deletionrequest.FieldReason:
-		m.ResetReason()
+	case aiplegalhold.FieldPlacedAt:
+		m.ResetPlacedAt()
 		return nil
-	case deletionrequest.FieldStatus:
-		m.ResetStatus()
+	case aiplegalhold.FieldReleasedBy:
+		m.ResetReleasedBy()
 		return nil
-	case deletionrequest.FieldRequestedAt:
-		m.ResetRequestedAt()
+	case aiplegalhold.FieldReleaseReason:
+		m.ResetReleaseReason()
 		return nil
-	case deletionrequest.FieldReviewedAt:
-		m.ResetReviewedAt()
+	case aiplegalhold.FieldReleasedAt:
+		m.ResetReleasedAt()
 		return nil
-	case deletionrequest.FieldAipID:
+	case aiplegalhold.FieldAipID:
 		m.ResetAipID()
 		return nil
-	case deletionrequest.FieldWorkflowID:
-		m.ResetWorkflowID()
+	case aiplegalhold.FieldActiveAipID:
+		m.ResetActiveAipID()
 		return nil
 	}
-	return fmt.Errorf("unknown DeletionRequest field %s", name)
+	return fmt.Errorf("unknown AIPLegalHold field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeletionRequestMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *AIPLegalHoldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.aip != nil {
-		edges = append(edges, deletionrequest.EdgeAip)
-	}
-	if m.workflow != nil {
-		edges = append(edges, deletionrequest.EdgeWorkflow)
+		edges = append(edges, aiplegalhold.EdgeAip)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeletionRequestMutation) AddedIDs(name string) []ent.Value {
+func (m *AIPLegalHoldMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deletionrequest.EdgeAip:
+	case aiplegalhold.EdgeAip:
 		if id := m.aip; id != nil {
 			return []ent.Value{*id}
 		}
-	case deletionrequest.EdgeWorkflow:
-		if id := m.workflow; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeletionRequestMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *AIPLegalHoldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeletionRequestMutation) RemovedIDs(name string) []ent.Value {
+func (m *AIPLegalHoldMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeletionRequestMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *AIPLegalHoldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedaip {
-		edges = append(edges, deletionrequest.EdgeAip)
+		edges = append(edges, aiplegalhold.EdgeAip)
 	}
-	if m.clearedworkflow {
-		edges = append(edges, deletionrequest.EdgeWorkflow)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AIPLegalHoldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case aiplegalhold.EdgeAip:
+		return m.clearedaip
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AIPLegalHoldMutation) ClearEdge(name string) error {
+	switch name {
+	case aiplegalhold.EdgeAip:
+		m.ClearAip()
+		return nil
+	}
+	return fmt.Errorf("unknown AIPLegalHold unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AIPLegalHoldMutation) ResetEdge(name string) error {
+	switch name {
+	case aiplegalhold.EdgeAip:
+		m.ResetAip()
+		return nil
+	}
+	return fmt.Errorf("unknown AIPLegalHold edge %s", name)
+}
+
+// AIPReplicaMutation represents an operation that mutates the AIPReplica nodes in the graph.
+type AIPReplicaMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	status          *enums.ReplicaStatus
+	created_at      *time.Time
+	replicated_at   *time.Time
+	clearedFields   map[string]struct{}
+	aip             *int
+	clearedaip      bool
+	location        *int
+	clearedlocation bool
+	done            bool
+	oldValue        func(context.Context) (*AIPReplica, error)
+	predicates      []predicate.AIPReplica
+}
+
+var _ ent.Mutation = (*AIPReplicaMutation)(nil)
+
+// aipreplicaOption allows management of the mutation configuration using functional options.
+type aipreplicaOption func(*AIPReplicaMutation)
+
+// newAIPReplicaMutation creates new mutation for the AIPReplica entity.
+func newAIPReplicaMutation(c config, op Op, opts ...aipreplicaOption) *AIPReplicaMutation {
+	m := &AIPReplicaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAIPReplica,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAIPReplicaID sets the ID field of the mutation.
+func withAIPReplicaID(id int) aipreplicaOption {
+	return func(m *AIPReplicaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AIPReplica
+		)
+		m.oldValue = func(ctx context.Context) (*AIPReplica, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AIPReplica.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAIPReplica sets the old AIPReplica of the mutation.
+func withAIPReplica(node *AIPReplica) aipreplicaOption {
+	return func(m *AIPReplicaMutation) {
+		m.oldValue = func(context.Context) (*AIPReplica, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AIPReplicaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AIPReplicaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AIPReplicaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AIPReplicaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AIPReplica.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *AIPReplicaMutation) SetStatus(es enums.ReplicaStatus) {
+	m.status = &es
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AIPReplicaMutation) Status() (r enums.ReplicaStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the AIPReplica entity.
+// If the AIPReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPReplicaMutation) OldStatus(ctx context.Context) (v enums.ReplicaStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AIPReplicaMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AIPReplicaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AIPReplicaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AIPReplica entity.
+// If the AIPReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPReplicaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AIPReplicaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReplicatedAt sets the "replicated_at" field.
+func (m *AIPReplicaMutation) SetReplicatedAt(t time.Time) {
+	m.replicated_at = &t
+}
+
+// ReplicatedAt returns the value of the "replicated_at" field in the mutation.
+func (m *AIPReplicaMutation) ReplicatedAt() (r time.Time, exists bool) {
+	v := m.replicated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplicatedAt returns the old "replicated_at" field's value of the AIPReplica entity.
+// If the AIPReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPReplicaMutation) OldReplicatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplicatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplicatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplicatedAt: %w", err)
+	}
+	return oldValue.ReplicatedAt, nil
+}
+
+// ClearReplicatedAt clears the value of the "replicated_at" field.
+func (m *AIPReplicaMutation) ClearReplicatedAt() {
+	m.replicated_at = nil
+	m.clearedFields[aipreplica.FieldReplicatedAt] = struct{}{}
+}
+
+// ReplicatedAtCleared returns if the "replicated_at" field was cleared in this mutation.
+func (m *AIPReplicaMutation) ReplicatedAtCleared() bool {
+	_, ok := m.clearedFields[aipreplica.FieldReplicatedAt]
+	return ok
+}
+
+// ResetReplicatedAt resets all changes to the "replicated_at" field.
+func (m *AIPReplicaMutation) ResetReplicatedAt() {
+	m.replicated_at = nil
+	delete(m.clearedFields, aipreplica.FieldReplicatedAt)
+}
+
+// SetAipID sets the "aip_id" field.
+func (m *AIPReplicaMutation) SetAipID(i int) {
+	m.aip = &i
+}
+
+// AipID returns the value of the "aip_id" field in the mutation.
+func (m *AIPReplicaMutation) AipID() (r int, exists bool) {
+	v := m.aip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAipID returns the old "aip_id" field's value of the AIPReplica entity.
+// If the AIPReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPReplicaMutation) OldAipID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAipID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAipID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAipID: %w", err)
+	}
+	return oldValue.AipID, nil
+}
+
+// ResetAipID resets all changes to the "aip_id" field.
+func (m *AIPReplicaMutation) ResetAipID() {
+	m.aip = nil
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *AIPReplicaMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *AIPReplicaMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the AIPReplica entity.
+// If the AIPReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIPReplicaMutation) OldLocationID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *AIPReplicaMutation) ResetLocationID() {
+	m.location = nil
+}
+
+// ClearAip clears the "aip" edge to the AIP entity.
+func (m *AIPReplicaMutation) ClearAip() {
+	m.clearedaip = true
+	m.clearedFields[aipreplica.FieldAipID] = struct{}{}
+}
+
+// AipCleared reports if the "aip" edge to the AIP entity was cleared.
+func (m *AIPReplicaMutation) AipCleared() bool {
+	return m.clearedaip
+}
+
+// AipIDs returns the "aip" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AipID instead. It exists only for internal usage by the builders.
+func (m *AIPReplicaMutation) AipIDs() (ids []int) {
+	if id := m.aip; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAip resets all changes to the "aip" edge.
+func (m *AIPReplicaMutation) ResetAip() {
+	m.aip = nil
+	m.clearedaip = false
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *AIPReplicaMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[aipreplica.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *AIPReplicaMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *AIPReplicaMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *AIPReplicaMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the AIPReplicaMutation builder.
+func (m *AIPReplicaMutation) Where(ps ...predicate.AIPReplica) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AIPReplicaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AIPReplicaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AIPReplica, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AIPReplicaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AIPReplicaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AIPReplica).
+func (m *AIPReplicaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AIPReplicaMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.status != nil {
+		fields = append(fields, aipreplica.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, aipreplica.FieldCreatedAt)
+	}
+	if m.replicated_at != nil {
+		fields = append(fields, aipreplica.FieldReplicatedAt)
+	}
+	if m.aip != nil {
+		fields = append(fields, aipreplica.FieldAipID)
+	}
+	if m.location != nil {
+		fields = append(fields, aipreplica.FieldLocationID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AIPReplicaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case aipreplica.FieldStatus:
+		return m.Status()
+	case aipreplica.FieldCreatedAt:
+		return m.CreatedAt()
+	case aipreplica.FieldReplicatedAt:
+		return m.ReplicatedAt()
+	case aipreplica.FieldAipID:
+		return m.AipID()
+	case aipreplica.FieldLocationID:
+		return m.LocationID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AIPReplicaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case aipreplica.FieldStatus:
+		return m.OldStatus(ctx)
+	case aipreplica.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case aipreplica.FieldReplicatedAt:
+		return m.OldReplicatedAt(ctx)
+	case aipreplica.FieldAipID:
+		return m.OldAipID(ctx)
+	case aipreplica.FieldLocationID:
+		return m.OldLocationID(ctx)
+	}
+	return nil, fmt.Errorf("unknown AIPReplica field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AIPReplicaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case aipreplica.FieldStatus:
+		v, ok := value.(enums.ReplicaStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case aipreplica.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case aipreplica.FieldReplicatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplicatedAt(v)
+		return nil
+	case aipreplica.FieldAipID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAipID(v)
+		return nil
+	case aipreplica.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AIPReplica field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AIPReplicaMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AIPReplicaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AIPReplicaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AIPReplica numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AIPReplicaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(aipreplica.FieldReplicatedAt) {
+		fields = append(fields, aipreplica.FieldReplicatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AIPReplicaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AIPReplicaMutation) ClearField(name string) error {
+	switch name {
+	case aipreplica.FieldReplicatedAt:
+		m.ClearReplicatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AIPReplica nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AIPReplicaMutation) ResetField(name string) error {
+	switch name {
+	case aipreplica.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case aipreplica.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case aipreplica.FieldReplicatedAt:
+		m.ResetReplicatedAt()
+		return nil
+	case aipreplica.FieldAipID:
+		m.ResetAipID()
+		return nil
+	case aipreplica.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown AIPReplica field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AIPReplicaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.aip != nil {
+		edges = append(edges, aipreplica.EdgeAip)
+	}
+	if m.location != nil {
+		edges = append(edges, aipreplica.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AIPReplicaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case aipreplica.EdgeAip:
+		if id := m.aip; id != nil {
+			return []ent.Value{*id}
+		}
+	case aipreplica.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AIPReplicaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AIPReplicaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AIPReplicaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedaip {
+		edges = append(edges, aipreplica.EdgeAip)
+	}
+	if m.clearedlocation {
+		edges = append(edges, aipreplica.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AIPReplicaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case aipreplica.EdgeAip:
+		return m.clearedaip
+	case aipreplica.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AIPReplicaMutation) ClearEdge(name string) error {
+	switch name {
+	case aipreplica.EdgeAip:
+		m.ClearAip()
+		return nil
+	case aipreplica.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown AIPReplica unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AIPReplicaMutation) ResetEdge(name string) error {
+	switch name {
+	case aipreplica.EdgeAip:
+		m.ResetAip()
+		return nil
+	case aipreplica.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown AIPReplica edge %s", name)
+}
+
+// DeletionRequestMutation represents an operation that mutates the DeletionRequest nodes in the graph.
+type DeletionRequestMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	uuid                  *uuid.UUID
+	requester             *string
+	requester_iss         *string
+	requester_sub         *string
+	reviewer              *string
+	reviewer_iss          *string
+	reviewer_sub          *string
+	reason                *string
+	status                *enums.DeletionRequestStatus
+	requested_at          *time.Time
+	reviewed_at           *time.Time
+	required_approvals    *int
+	addrequired_approvals *int
+	clearedFields         map[string]struct{}
+	aip                   *int
+	clearedaip            bool
+	workflow              *int
+	clearedworkflow       bool
+	approvals             map[int]struct{}
+	removedapprovals      map[int]struct{}
+	clearedapprovals      bool
+	done                  bool
+	oldValue              func(context.Context) (*DeletionRequest, error)
+	predicates            []predicate.DeletionRequest
+}
+
+var _ ent.Mutation = (*DeletionRequestMutation)(nil)
+
+// deletionrequestOption allows management of the mutation configuration using functional options.
+type deletionrequestOption func(*DeletionRequestMutation)
+
+// newDeletionRequestMutation creates new mutation for the DeletionRequest entity.
+func newDeletionRequestMutation(c config, op Op, opts ...deletionrequestOption) *DeletionRequestMutation {
+	m := &DeletionRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeletionRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeletionRequestID sets the ID field of the mutation.
+func withDeletionRequestID(id int) deletionrequestOption {
+	return func(m *DeletionRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeletionRequest
+		)
+		m.oldValue = func(ctx context.Context) (*DeletionRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeletionRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeletionRequest sets the old DeletionRequest of the mutation.
+func withDeletionRequest(node *DeletionRequest) deletionrequestOption {
+	return func(m *DeletionRequestMutation) {
+		m.oldValue = func(context.Context) (*DeletionRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeletionRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeletionRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeletionRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeletionRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeletionRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUUID sets the "uuid" field.
+func (m *DeletionRequestMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
+}
+
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *DeletionRequestMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUID returns the old "uuid" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	}
+	return oldValue.UUID, nil
+}
+
+// ResetUUID resets all changes to the "uuid" field.
+func (m *DeletionRequestMutation) ResetUUID() {
+	m.uuid = nil
+}
+
+// SetRequester sets the "requester" field.
+func (m *DeletionRequestMutation) SetRequester(s string) {
+	m.requester = &s
+}
+
+// Requester returns the value of the "requester" field in the mutation.
+func (m *DeletionRequestMutation) Requester() (r string, exists bool) {
+	v := m.requester
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequester returns the old "requester" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldRequester(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequester is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequester requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequester: %w", err)
+	}
+	return oldValue.Requester, nil
+}
+
+// ResetRequester resets all changes to the "requester" field.
+func (m *DeletionRequestMutation) ResetRequester() {
+	m.requester = nil
+}
+
+// SetRequesterIss sets the "requester_iss" field.
+func (m *DeletionRequestMutation) SetRequesterIss(s string) {
+	m.requester_iss = &s
+}
+
+// RequesterIss returns the value of the "requester_iss" field in the mutation.
+func (m *DeletionRequestMutation) RequesterIss() (r string, exists bool) {
+	v := m.requester_iss
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequesterIss returns the old "requester_iss" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldRequesterIss(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequesterIss is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequesterIss requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequesterIss: %w", err)
+	}
+	return oldValue.RequesterIss, nil
+}
+
+// ResetRequesterIss resets all changes to the "requester_iss" field.
+func (m *DeletionRequestMutation) ResetRequesterIss() {
+	m.requester_iss = nil
+}
+
+// SetRequesterSub sets the "requester_sub" field.
+func (m *DeletionRequestMutation) SetRequesterSub(s string) {
+	m.requester_sub = &s
+}
+
+// RequesterSub returns the value of the "requester_sub" field in the mutation.
+func (m *DeletionRequestMutation) RequesterSub() (r string, exists bool) {
+	v := m.requester_sub
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequesterSub returns the old "requester_sub" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldRequesterSub(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequesterSub is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequesterSub requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequesterSub: %w", err)
+	}
+	return oldValue.RequesterSub, nil
+}
+
+// ResetRequesterSub resets all changes to the "requester_sub" field.
+func (m *DeletionRequestMutation) ResetRequesterSub() {
+	m.requester_sub = nil
+}
+
+// SetReviewer sets the "reviewer" field.
+func (m *DeletionRequestMutation) SetReviewer(s string) {
+	m.reviewer = &s
+}
+
+// Reviewer returns the value of the "reviewer" field in the mutation.
+func (m *DeletionRequestMutation) Reviewer() (r string, exists bool) {
+	v := m.reviewer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewer returns the old "reviewer" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldReviewer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewer: %w", err)
+	}
+	return oldValue.Reviewer, nil
+}
+
+// ClearReviewer clears the value of the "reviewer" field.
+func (m *DeletionRequestMutation) ClearReviewer() {
+	m.reviewer = nil
+	m.clearedFields[deletionrequest.FieldReviewer] = struct{}{}
+}
+
+// ReviewerCleared returns if the "reviewer" field was cleared in this mutation.
+func (m *DeletionRequestMutation) ReviewerCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldReviewer]
+	return ok
+}
+
+// ResetReviewer resets all changes to the "reviewer" field.
+func (m *DeletionRequestMutation) ResetReviewer() {
+	m.reviewer = nil
+	delete(m.clearedFields, deletionrequest.FieldReviewer)
+}
+
+// SetReviewerIss sets the "reviewer_iss" field.
+func (m *DeletionRequestMutation) SetReviewerIss(s string) {
+	m.reviewer_iss = &s
+}
+
+// ReviewerIss returns the value of the "reviewer_iss" field in the mutation.
+func (m *DeletionRequestMutation) ReviewerIss() (r string, exists bool) {
+	v := m.reviewer_iss
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerIss returns the old "reviewer_iss" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldReviewerIss(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerIss is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerIss requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerIss: %w", err)
+	}
+	return oldValue.ReviewerIss, nil
+}
+
+// ClearReviewerIss clears the value of the "reviewer_iss" field.
+func (m *DeletionRequestMutation) ClearReviewerIss() {
+	m.reviewer_iss = nil
+	m.clearedFields[deletionrequest.FieldReviewerIss] = struct{}{}
+}
+
+// ReviewerIssCleared returns if the "reviewer_iss" field was cleared in this mutation.
+func (m *DeletionRequestMutation) ReviewerIssCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldReviewerIss]
+	return ok
+}
+
+// ResetReviewerIss resets all changes to the "reviewer_iss" field.
+func (m *DeletionRequestMutation) ResetReviewerIss() {
+	m.reviewer_iss = nil
+	delete(m.clearedFields, deletionrequest.FieldReviewerIss)
+}
+
+// SetReviewerSub sets the "reviewer_sub" field.
+func (m *DeletionRequestMutation) SetReviewerSub(s string) {
+	m.reviewer_sub = &s
+}
+
+// ReviewerSub returns the value of the "reviewer_sub" field in the mutation.
+func (m *DeletionRequestMutation) ReviewerSub() (r string, exists bool) {
+	v := m.reviewer_sub
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerSub returns the old "reviewer_sub" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldReviewerSub(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerSub is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerSub requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerSub: %w", err)
+	}
+	return oldValue.ReviewerSub, nil
+}
+
+// ClearReviewerSub clears the value of the "reviewer_sub" field.
+func (m *DeletionRequestMutation) ClearReviewerSub() {
+	m.reviewer_sub = nil
+	m.clearedFields[deletionrequest.FieldReviewerSub] = struct{}{}
+}
+
+// ReviewerSubCleared returns if the "reviewer_sub" field was cleared in this mutation.
+func (m *DeletionRequestMutation) ReviewerSubCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldReviewerSub]
+	return ok
+}
+
+// ResetReviewerSub resets all changes to the "reviewer_sub" field.
+func (m *DeletionRequestMutation) ResetReviewerSub() {
+	m.reviewer_sub = nil
+	delete(m.clearedFields, deletionrequest.FieldReviewerSub)
+}
+
+// SetReason sets the "reason" field.
+func (m *DeletionRequestMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *DeletionRequestMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *DeletionRequestMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DeletionRequestMutation) SetStatus(ers enums.DeletionRequestStatus) {
+	m.status = &ers
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DeletionRequestMutation) Status() (r enums.DeletionRequestStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldStatus(ctx context.Context) (v enums.DeletionRequestStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DeletionRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (m *DeletionRequestMutation) SetRequestedAt(t time.Time) {
+	m.requested_at = &t
+}
+
+// RequestedAt returns the value of the "requested_at" field in the mutation.
+func (m *DeletionRequestMutation) RequestedAt() (r time.Time, exists bool) {
+	v := m.requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedAt returns the old "requested_at" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldRequestedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
+	}
+	return oldValue.RequestedAt, nil
+}
+
+// ResetRequestedAt resets all changes to the "requested_at" field.
+func (m *DeletionRequestMutation) ResetRequestedAt() {
+	m.requested_at = nil
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *DeletionRequestMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *DeletionRequestMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldReviewedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *DeletionRequestMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[deletionrequest.FieldReviewedAt] = struct{}{}
+}
+
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *DeletionRequestMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldReviewedAt]
+	return ok
+}
+
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *DeletionRequestMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, deletionrequest.FieldReviewedAt)
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (m *DeletionRequestMutation) SetRequiredApprovals(i int) {
+	m.required_approvals = &i
+	m.addrequired_approvals = nil
+}
+
+// RequiredApprovals returns the value of the "required_approvals" field in the mutation.
+func (m *DeletionRequestMutation) RequiredApprovals() (r int, exists bool) {
+	v := m.required_approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequiredApprovals returns the old "required_approvals" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldRequiredApprovals(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiredApprovals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiredApprovals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiredApprovals: %w", err)
+	}
+	return oldValue.RequiredApprovals, nil
+}
+
+// AddRequiredApprovals adds i to the "required_approvals" field.
+func (m *DeletionRequestMutation) AddRequiredApprovals(i int) {
+	if m.addrequired_approvals != nil {
+		*m.addrequired_approvals += i
+	} else {
+		m.addrequired_approvals = &i
+	}
+}
+
+// AddedRequiredApprovals returns the value that was added to the "required_approvals" field in this mutation.
+func (m *DeletionRequestMutation) AddedRequiredApprovals() (r int, exists bool) {
+	v := m.addrequired_approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequiredApprovals resets all changes to the "required_approvals" field.
+func (m *DeletionRequestMutation) ResetRequiredApprovals() {
+	m.required_approvals = nil
+	m.addrequired_approvals = nil
+}
+
+// SetAipID sets the "aip_id" field.
+func (m *DeletionRequestMutation) SetAipID(i int) {
+	m.aip = &i
+}
+
+// AipID returns the value of the "aip_id" field in the mutation.
+func (m *DeletionRequestMutation) AipID() (r int, exists bool) {
+	v := m.aip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAipID returns the old "aip_id" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldAipID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAipID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAipID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAipID: %w", err)
+	}
+	return oldValue.AipID, nil
+}
+
+// ResetAipID resets all changes to the "aip_id" field.
+func (m *DeletionRequestMutation) ResetAipID() {
+	m.aip = nil
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (m *DeletionRequestMutation) SetWorkflowID(i int) {
+	m.workflow = &i
+}
+
+// WorkflowID returns the value of the "workflow_id" field in the mutation.
+func (m *DeletionRequestMutation) WorkflowID() (r int, exists bool) {
+	v := m.workflow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflowID returns the old "workflow_id" field's value of the DeletionRequest entity.
+// If the DeletionRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestMutation) OldWorkflowID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkflowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkflowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflowID: %w", err)
+	}
+	return oldValue.WorkflowID, nil
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (m *DeletionRequestMutation) ClearWorkflowID() {
+	m.workflow = nil
+	m.clearedFields[deletionrequest.FieldWorkflowID] = struct{}{}
+}
+
+// WorkflowIDCleared returns if the "workflow_id" field was cleared in this mutation.
+func (m *DeletionRequestMutation) WorkflowIDCleared() bool {
+	_, ok := m.clearedFields[deletionrequest.FieldWorkflowID]
+	return ok
+}
+
+// ResetWorkflowID resets all changes to the "workflow_id" field.
+func (m *DeletionRequestMutation) ResetWorkflowID() {
+	m.workflow = nil
+	delete(m.clearedFields, deletionrequest.FieldWorkflowID)
+}
+
+// ClearAip clears the "aip" edge to the AIP entity.
+func (m *DeletionRequestMutation) ClearAip() {
+	m.clearedaip = true
+	m.clearedFields[deletionrequest.FieldAipID] = struct{}{}
+}
+
+// AipCleared reports if the "aip" edge to the AIP entity was cleared.
+func (m *DeletionRequestMutation) AipCleared() bool {
+	return m.clearedaip
+}
+
+// AipIDs returns the "aip" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AipID instead. It exists only for internal usage by the builders.
+func (m *DeletionRequestMutation) AipIDs() (ids []int) {
+	if id := m.aip; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAip resets all changes to the "aip" edge.
+func (m *DeletionRequestMutation) ResetAip() {
+	m.aip = nil
+	m.clearedaip = false
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (m *DeletionRequestMutation) ClearWorkflow() {
+	m.clearedworkflow = true
+	m.clearedFields[deletionrequest.FieldWorkflowID] = struct{}{}
+}
+
+// WorkflowCleared reports if the "workflow" edge to the Workflow entity was cleared.
+func (m *DeletionRequestMutation) WorkflowCleared() bool {
+	return m.WorkflowIDCleared() || m.clearedworkflow
+}
+
+// WorkflowIDs returns the "workflow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkflowID instead. It exists only for internal usage by the builders.
+func (m *DeletionRequestMutation) WorkflowIDs() (ids []int) {
+	if id := m.workflow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkflow resets all changes to the "workflow" edge.
+func (m *DeletionRequestMutation) ResetWorkflow() {
+	m.workflow = nil
+	m.clearedworkflow = false
+}
+
+// AddApprovalIDs adds the "approvals" edge to the DeletionRequestApproval entity by ids.
+func (m *DeletionRequestMutation) AddApprovalIDs(ids ...int) {
+	if m.approvals == nil {
+		m.approvals = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.approvals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApprovals clears the "approvals" edge to the DeletionRequestApproval entity.
+func (m *DeletionRequestMutation) ClearApprovals() {
+	m.clearedapprovals = true
+}
+
+// ApprovalsCleared reports if the "approvals" edge to the DeletionRequestApproval entity was cleared.
+func (m *DeletionRequestMutation) ApprovalsCleared() bool {
+	return m.clearedapprovals
+}
+
+// RemoveApprovalIDs removes the "approvals" edge to the DeletionRequestApproval entity by IDs.
+func (m *DeletionRequestMutation) RemoveApprovalIDs(ids ...int) {
+	if m.removedapprovals == nil {
+		m.removedapprovals = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.approvals, ids[i])
+		m.removedapprovals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApprovals returns the removed IDs of the "approvals" edge to the DeletionRequestApproval entity.
+func (m *DeletionRequestMutation) RemovedApprovalsIDs() (ids []int) {
+	for id := range m.removedapprovals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApprovalsIDs returns the "approvals" edge IDs in the mutation.
+func (m *DeletionRequestMutation) ApprovalsIDs() (ids []int) {
+	for id := range m.approvals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApprovals resets all changes to the "approvals" edge.
+func (m *DeletionRequestMutation) ResetApprovals() {
+	m.approvals = nil
+	m.clearedapprovals = false
+	m.removedapprovals = nil
+}
+
+// Where appends a list predicates to the DeletionRequestMutation builder.
+func (m *DeletionRequestMutation) Where(ps ...predicate.DeletionRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeletionRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeletionRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeletionRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeletionRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeletionRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeletionRequest).
+func (m *DeletionRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeletionRequestMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.uuid != nil {
+		fields = append(fields, deletionrequest.FieldUUID)
+	}
+	if m.requester != nil {
+		fields = append(fields, deletionrequest.FieldRequester)
+	}
+	if m.requester_iss != nil {
+		fields = append(fields, deletionrequest.FieldRequesterIss)
+	}
+	if m.requester_sub != nil {
+		fields = append(fields, deletionrequest.FieldRequesterSub)
+	}
+	if m.reviewer != nil {
+		fields = append(fields, deletionrequest.FieldReviewer)
+	}
+	if m.reviewer_iss != nil {
+		fields = append(fields, deletionrequest.FieldReviewerIss)
+	}
+	if m.reviewer_sub != nil {
+		fields = append(fields, deletionrequest.FieldReviewerSub)
+	}
+	if m.reason != nil {
+		fields = append(fields, deletionrequest.FieldReason)
+	}
+	if m.status != nil {
+		fields = append(fields, deletionrequest.FieldStatus)
+	}
+	if m.requested_at != nil {
+		fields = append(fields, deletionrequest.FieldRequestedAt)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, deletionrequest.FieldReviewedAt)
+	}
+	if m.required_approvals != nil {
+		fields = append(fields, deletionrequest.FieldRequiredApprovals)
+	}
+	if m.aip != nil {
+		fields = append(fields, deletionrequest.FieldAipID)
+	}
+	if m.workflow != nil {
+		fields = append(fields, deletionrequest.FieldWorkflowID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeletionRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deletionrequest.FieldUUID:
+		return m.UUID()
+	case deletionrequest.FieldRequester:
+		return m.Requester()
+	case deletionrequest.FieldRequesterIss:
+		return m.RequesterIss()
+	case deletionrequest.FieldRequesterSub:
+		return m.RequesterSub()
+	case deletionrequest.FieldReviewer:
+		return m.Reviewer()
+	case deletionrequest.FieldReviewerIss:
+		return m.ReviewerIss()
+	case deletionrequest.FieldReviewerSub:
+		return m.ReviewerSub()
+	case deletionrequest.FieldReason:
+		return m.Reason()
+	case deletionrequest.FieldStatus:
+		return m.Status()
+	case deletionrequest.FieldRequestedAt:
+		return m.RequestedAt()
+	case deletionrequest.FieldReviewedAt:
+		return m.ReviewedAt()
+	case deletionrequest.FieldRequiredApprovals:
+		return m.RequiredApprovals()
+	case deletionrequest.FieldAipID:
+		return m.AipID()
+	case deletionrequest.FieldWorkflowID:
+		return m.WorkflowID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeletionRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deletionrequest.FieldUUID:
+		return m.OldUUID(ctx)
+	case deletionrequest.FieldRequester:
+		return m.OldRequester(ctx)
+	case deletionrequest.FieldRequesterIss:
+		return m.OldRequesterIss(ctx)
+	case deletionrequest.FieldRequesterSub:
+		return m.OldRequesterSub(ctx)
+	case deletionrequest.FieldReviewer:
+		return m.OldReviewer(ctx)
+	case deletionrequest.FieldReviewerIss:
+		return m.OldReviewerIss(ctx)
+	case deletionrequest.FieldReviewerSub:
+		return m.OldReviewerSub(ctx)
+	case deletionrequest.FieldReason:
+		return m.OldReason(ctx)
+	case deletionrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case deletionrequest.FieldRequestedAt:
+		return m.OldRequestedAt(ctx)
+	case deletionrequest.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
+	case deletionrequest.FieldRequiredApprovals:
+		return m.OldRequiredApprovals(ctx)
+	case deletionrequest.FieldAipID:
+		return m.OldAipID(ctx)
+	case deletionrequest.FieldWorkflowID:
+		return m.OldWorkflowID(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeletionRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeletionRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deletionrequest.FieldUUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUID(v)
+		return nil
+	case deletionrequest.FieldRequester:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequester(v)
+		return nil
+	case deletionrequest.FieldRequesterIss:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequesterIss(v)
+		return nil
+	case deletionrequest.FieldRequesterSub:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequesterSub(v)
+		return nil
+	case deletionrequest.FieldReviewer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewer(v)
+		return nil
+	case deletionrequest.FieldReviewerIss:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewerIss(v)
+		return nil
+	case deletionrequest.FieldReviewerSub:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewerSub(v)
+		return nil
+	case deletionrequest.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case deletionrequest.FieldStatus:
+		v, ok := value.(enums.DeletionRequestStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case deletionrequest.FieldRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedAt(v)
+		return nil
+	case deletionrequest.FieldReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedAt(v)
+		return nil
+	case deletionrequest.FieldRequiredApprovals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequiredApprovals(v)
+		return nil
+	case deletionrequest.FieldAipID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAipID(v)
+		return nil
+	case deletionrequest.FieldWorkflowID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflowID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeletionRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addrequired_approvals != nil {
+		fields = append(fields, deletionrequest.FieldRequiredApprovals)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeletionRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deletionrequest.FieldRequiredApprovals:
+		return m.AddedRequiredApprovals()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeletionRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deletionrequest.FieldRequiredApprovals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequiredApprovals(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeletionRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deletionrequest.FieldReviewer) {
+		fields = append(fields, deletionrequest.FieldReviewer)
+	}
+	if m.FieldCleared(deletionrequest.FieldReviewerIss) {
+		fields = append(fields, deletionrequest.FieldReviewerIss)
+	}
+	if m.FieldCleared(deletionrequest.FieldReviewerSub) {
+		fields = append(fields, deletionrequest.FieldReviewerSub)
+	}
+	if m.FieldCleared(deletionrequest.FieldReviewedAt) {
+		fields = append(fields, deletionrequest.FieldReviewedAt)
+	}
+	if m.FieldCleared(deletionrequest.FieldWorkflowID) {
+		fields = append(fields, deletionrequest.FieldWorkflowID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeletionRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeletionRequestMutation) ClearField(name string) error {
+	switch name {
+	case deletionrequest.FieldReviewer:
+		m.ClearReviewer()
+		return nil
+	case deletionrequest.FieldReviewerIss:
+		m.ClearReviewerIss()
+		return nil
+	case deletionrequest.FieldReviewerSub:
+		m.ClearReviewerSub()
+		return nil
+	case deletionrequest.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	case deletionrequest.FieldWorkflowID:
+		m.ClearWorkflowID()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeletionRequestMutation) ResetField(name string) error {
+	switch name {
+	case deletionrequest.FieldUUID:
+		m.ResetUUID()
+		return nil
+	case deletionrequest.FieldRequester:
+		m.ResetRequester()
+		return nil
+	case deletionrequest.FieldRequesterIss:
+		m.ResetRequesterIss()
+		return nil
+	case deletionrequest.FieldRequesterSub:
+		m.ResetRequesterSub()
+		return nil
+	case deletionrequest.FieldReviewer:
+		m.ResetReviewer()
+		return nil
+	case deletionrequest.FieldReviewerIss:
+		m.ResetReviewerIss()
+		return nil
+	case deletionrequest.FieldReviewerSub:
+		m.ResetReviewerSub()
+		return nil
+	case deletionrequest.FieldReason:
+		m.ResetReason()
+		return nil
+	case deletionrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case deletionrequest.FieldRequestedAt:
+		m.ResetRequestedAt()
+		return nil
+	case deletionrequest.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	case deletionrequest.FieldRequiredApprovals:
+		m.ResetRequiredApprovals()
+		return nil
+	case deletionrequest.FieldAipID:
+		m.ResetAipID()
+		return nil
+	case deletionrequest.FieldWorkflowID:
+		m.ResetWorkflowID()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeletionRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.aip != nil {
+		edges = append(edges, deletionrequest.EdgeAip)
+	}
+	if m.workflow != nil {
+		edges = append(edges, deletionrequest.EdgeWorkflow)
+	}
+	if m.approvals != nil {
+		edges = append(edges, deletionrequest.EdgeApprovals)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeletionRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deletionrequest.EdgeAip:
+		if id := m.aip; id != nil {
+			return []ent.Value{*id}
+		}
+	case deletionrequest.EdgeWorkflow:
+		if id := m.workflow; id != nil {
+			return []ent.Value{*id}
+		}
+	case deletionrequest.EdgeApprovals:
+		ids := make([]ent.Value, 0, len(m.approvals))
+		for id := range m.approvals {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeletionRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedapprovals != nil {
+		edges = append(edges, deletionrequest.EdgeApprovals)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeletionRequestMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deletionrequest.EdgeApprovals:
+		ids := make([]ent.Value, 0, len(m.removedapprovals))
+		for id := range m.removedapprovals {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeletionRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedaip {
+		edges = append(edges, deletionrequest.EdgeAip)
+	}
+	if m.clearedworkflow {
+		edges = append(edges, deletionrequest.EdgeWorkflow)
+	}
+	if m.clearedapprovals {
+		edges = append(edges, deletionrequest.EdgeApprovals)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeletionRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deletionrequest.EdgeAip:
+		return m.clearedaip
+	case deletionrequest.EdgeWorkflow:
+		return m.clearedworkflow
+	case deletionrequest.EdgeApprovals:
+		return m.clearedapprovals
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeletionRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case deletionrequest.EdgeAip:
+		m.ClearAip()
+		return nil
+	case deletionrequest.EdgeWorkflow:
+		m.ClearWorkflow()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeletionRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case deletionrequest.EdgeAip:
+		m.ResetAip()
+		return nil
+	case deletionrequest.EdgeWorkflow:
+		m.ResetWorkflow()
+		return nil
+	case deletionrequest.EdgeApprovals:
+		m.ResetApprovals()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequest edge %s", name)
+}
+
+// DeletionRequestApprovalMutation represents an operation that mutates the DeletionRequestApproval nodes in the graph.
+type DeletionRequestApprovalMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	approver                *string
+	approver_iss            *string
+	approver_sub            *string
+	approved_at             *time.Time
+	clearedFields           map[string]struct{}
+	deletion_request        *int
+	cleareddeletion_request bool
+	done                    bool
+	oldValue                func(context.Context) (*DeletionRequestApproval, error)
+	predicates              []predicate.DeletionRequestApproval
+}
+
+var _ ent.Mutation = (*DeletionRequestApprovalMutation)(nil)
+
+// deletionrequestapprovalOption allows management of the mutation configuration using functional options.
+type deletionrequestapprovalOption func(*DeletionRequestApprovalMutation)
+
+// newDeletionRequestApprovalMutation creates new mutation for the DeletionRequestApproval entity.
+func newDeletionRequestApprovalMutation(c config, op Op, opts ...deletionrequestapprovalOption) *DeletionRequestApprovalMutation {
+	m := &DeletionRequestApprovalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeletionRequestApproval,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeletionRequestApprovalID sets the ID field of the mutation.
+func withDeletionRequestApprovalID(id int) deletionrequestapprovalOption {
+	return func(m *DeletionRequestApprovalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeletionRequestApproval
+		)
+		m.oldValue = func(ctx context.Context) (*DeletionRequestApproval, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeletionRequestApproval.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeletionRequestApproval sets the old DeletionRequestApproval of the mutation.
+func withDeletionRequestApproval(node *DeletionRequestApproval) deletionrequestapprovalOption {
+	return func(m *DeletionRequestApprovalMutation) {
+		m.oldValue = func(context.Context) (*DeletionRequestApproval, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeletionRequestApprovalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeletionRequestApprovalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeletionRequestApprovalMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeletionRequestApprovalMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeletionRequestApproval.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetApprover sets the "approver" field.
+func (m *DeletionRequestApprovalMutation) SetApprover(s string) {
+	m.approver = &s
+}
+
+// Approver returns the value of the "approver" field in the mutation.
+func (m *DeletionRequestApprovalMutation) Approver() (r string, exists bool) {
+	v := m.approver
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprover returns the old "approver" field's value of the DeletionRequestApproval entity.
+// If the DeletionRequestApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestApprovalMutation) OldApprover(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprover is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprover requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprover: %w", err)
+	}
+	return oldValue.Approver, nil
+}
+
+// ResetApprover resets all changes to the "approver" field.
+func (m *DeletionRequestApprovalMutation) ResetApprover() {
+	m.approver = nil
+}
+
+// SetApproverIss sets the "approver_iss" field.
+func (m *DeletionRequestApprovalMutation) SetApproverIss(s string) {
+	m.approver_iss = &s
+}
+
+// ApproverIss returns the value of the "approver_iss" field in the mutation.
+func (m *DeletionRequestApprovalMutation) ApproverIss() (r string, exists bool) {
+	v := m.approver_iss
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApproverIss returns the old "approver_iss" field's value of the DeletionRequestApproval entity.
+// If the DeletionRequestApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestApprovalMutation) OldApproverIss(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApproverIss is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApproverIss requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApproverIss: %w", err)
+	}
+	return oldValue.ApproverIss, nil
+}
+
+// ResetApproverIss resets all changes to the "approver_iss" field.
+func (m *DeletionRequestApprovalMutation) ResetApproverIss() {
+	m.approver_iss = nil
+}
+
+// SetApproverSub sets the "approver_sub" field.
+func (m *DeletionRequestApprovalMutation) SetApproverSub(s string) {
+	m.approver_sub = &s
+}
+
+// ApproverSub returns the value of the "approver_sub" field in the mutation.
+func (m *DeletionRequestApprovalMutation) ApproverSub() (r string, exists bool) {
+	v := m.approver_sub
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApproverSub returns the old "approver_sub" field's value of the DeletionRequestApproval entity.
+// If the DeletionRequestApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestApprovalMutation) OldApproverSub(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApproverSub is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApproverSub requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApproverSub: %w", err)
+	}
+	return oldValue.ApproverSub, nil
+}
+
+// ResetApproverSub resets all changes to the "approver_sub" field.
+func (m *DeletionRequestApprovalMutation) ResetApproverSub() {
+	m.approver_sub = nil
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (m *DeletionRequestApprovalMutation) SetApprovedAt(t time.Time) {
+	m.approved_at = &t
+}
+
+// ApprovedAt returns the value of the "approved_at" field in the mutation.
+func (m *DeletionRequestApprovalMutation) ApprovedAt() (r time.Time, exists bool) {
+	v := m.approved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedAt returns the old "approved_at" field's value of the DeletionRequestApproval entity.
+// If the DeletionRequestApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestApprovalMutation) OldApprovedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedAt: %w", err)
+	}
+	return oldValue.ApprovedAt, nil
+}
+
+// ResetApprovedAt resets all changes to the "approved_at" field.
+func (m *DeletionRequestApprovalMutation) ResetApprovedAt() {
+	m.approved_at = nil
+}
+
+// SetDeletionRequestID sets the "deletion_request_id" field.
+func (m *DeletionRequestApprovalMutation) SetDeletionRequestID(i int) {
+	m.deletion_request = &i
+}
+
+// DeletionRequestID returns the value of the "deletion_request_id" field in the mutation.
+func (m *DeletionRequestApprovalMutation) DeletionRequestID() (r int, exists bool) {
+	v := m.deletion_request
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletionRequestID returns the old "deletion_request_id" field's value of the DeletionRequestApproval entity.
+// If the DeletionRequestApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionRequestApprovalMutation) OldDeletionRequestID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletionRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletionRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletionRequestID: %w", err)
+	}
+	return oldValue.DeletionRequestID, nil
+}
+
+// ResetDeletionRequestID resets all changes to the "deletion_request_id" field.
+func (m *DeletionRequestApprovalMutation) ResetDeletionRequestID() {
+	m.deletion_request = nil
+}
+
+// ClearDeletionRequest clears the "deletion_request" edge to the DeletionRequest entity.
+func (m *DeletionRequestApprovalMutation) ClearDeletionRequest() {
+	m.cleareddeletion_request = true
+	m.clearedFields[deletionrequestapproval.FieldDeletionRequestID] = struct{}{}
+}
+
+// DeletionRequestCleared reports if the "deletion_request" edge to the DeletionRequest entity was cleared.
+func (m *DeletionRequestApprovalMutation) DeletionRequestCleared() bool {
+	return m.cleareddeletion_request
+}
+
+// DeletionRequestIDs returns the "deletion_request" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeletionRequestID instead. It exists only for internal usage by the builders.
+func (m *DeletionRequestApprovalMutation) DeletionRequestIDs() (ids []int) {
+	if id := m.deletion_request; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeletionRequest resets all changes to the "deletion_request" edge.
+func (m *DeletionRequestApprovalMutation) ResetDeletionRequest() {
+	m.deletion_request = nil
+	m.cleareddeletion_request = false
+}
+
+// Where appends a list predicates to the DeletionRequestApprovalMutation builder.
+func (m *DeletionRequestApprovalMutation) Where(ps ...predicate.DeletionRequestApproval) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeletionRequestApprovalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeletionRequestApprovalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeletionRequestApproval, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeletionRequestApprovalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeletionRequestApprovalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeletionRequestApproval).
+func (m *DeletionRequestApprovalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeletionRequestApprovalMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.approver != nil {
+		fields = append(fields, deletionrequestapproval.FieldApprover)
+	}
+	if m.approver_iss != nil {
+		fields = append(fields, deletionrequestapproval.FieldApproverIss)
+	}
+	if m.approver_sub != nil {
+		fields = append(fields, deletionrequestapproval.FieldApproverSub)
+	}
+	if m.approved_at != nil {
+		fields = append(fields, deletionrequestapproval.FieldApprovedAt)
+	}
+	if m.deletion_request != nil {
+		fields = append(fields, deletionrequestapproval.FieldDeletionRequestID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeletionRequestApprovalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deletionrequestapproval.FieldApprover:
+		return m.Approver()
+	case deletionrequestapproval.FieldApproverIss:
+		return m.ApproverIss()
+	case deletionrequestapproval.FieldApproverSub:
+		return m.ApproverSub()
+	case deletionrequestapproval.FieldApprovedAt:
+		return m.ApprovedAt()
+	case deletionrequestapproval.FieldDeletionRequestID:
+		return m.DeletionRequestID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeletionRequestApprovalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deletionrequestapproval.FieldApprover:
+		return m.OldApprover(ctx)
+	case deletionrequestapproval.FieldApproverIss:
+		return m.OldApproverIss(ctx)
+	case deletionrequestapproval.FieldApproverSub:
+		return m.OldApproverSub(ctx)
+	case deletionrequestapproval.FieldApprovedAt:
+		return m.OldApprovedAt(ctx)
+	case deletionrequestapproval.FieldDeletionRequestID:
+		return m.OldDeletionRequestID(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeletionRequestApproval field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeletionRequestApprovalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deletionrequestapproval.FieldApprover:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprover(v)
+		return nil
+	case deletionrequestapproval.FieldApproverIss:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApproverIss(v)
+		return nil
+	case deletionrequestapproval.FieldApproverSub:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApproverSub(v)
+		return nil
+	case deletionrequestapproval.FieldApprovedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedAt(v)
+		return nil
+	case deletionrequestapproval.FieldDeletionRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletionRequestID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequestApproval field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeletionRequestApprovalMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeletionRequestApprovalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeletionRequestApprovalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeletionRequestApproval numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeletionRequestApprovalMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeletionRequestApprovalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeletionRequestApprovalMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DeletionRequestApproval nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeletionRequestApprovalMutation) ResetField(name string) error {
+	switch name {
+	case deletionrequestapproval.FieldApprover:
+		m.ResetApprover()
+		return nil
+	case deletionrequestapproval.FieldApproverIss:
+		m.ResetApproverIss()
+		return nil
+	case deletionrequestapproval.FieldApproverSub:
+		m.ResetApproverSub()
+		return nil
+	case deletionrequestapproval.FieldApprovedAt:
+		m.ResetApprovedAt()
+		return nil
+	case deletionrequestapproval.FieldDeletionRequestID:
+		m.ResetDeletionRequestID()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequestApproval field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeletionRequestApprovalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deletion_request != nil {
+		edges = append(edges, deletionrequestapproval.EdgeDeletionRequest)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeletionRequestApprovalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deletionrequestapproval.EdgeDeletionRequest:
+		if id := m.deletion_request; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeletionRequestApprovalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeletionRequestApprovalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeletionRequestApprovalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeletion_request {
+		edges = append(edges, deletionrequestapproval.EdgeDeletionRequest)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeletionRequestApprovalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deletionrequestapproval.EdgeDeletionRequest:
+		return m.cleareddeletion_request
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeletionRequestApprovalMutation) ClearEdge(name string) error {
+	switch name {
+	case deletionrequestapproval.EdgeDeletionRequest:
+		m.ClearDeletionRequest()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequestApproval unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeletionRequestApprovalMutation) ResetEdge(name string) error {
+	switch name {
+	case deletionrequestapproval.EdgeDeletionRequest:
+		m.ResetDeletionRequest()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionRequestApproval edge %s", name)
+}
+
+// FixityCheckMutation represents an operation that mutates the FixityCheck nodes in the graph.
+type FixityCheckMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	uuid               *uuid.UUID
+	checksum_algorithm *string
+	expected_checksum  *string
+	actual_checksum    *string
+	status             *enums.FixityCheckStatus
+	checked_at         *time.Time
+	clearedFields      map[string]struct{}
+	aip                *int
+	clearedaip         bool
+	workflow           *int
+	clearedworkflow    bool
+	done               bool
+	oldValue           func(context.Context) (*FixityCheck, error)
+	predicates         []predicate.FixityCheck
+}
+
+var _ ent.Mutation = (*FixityCheckMutation)(nil)
+
+// fixitycheckOption allows management of the mutation configuration using functional options.
+type fixitycheckOption func(*FixityCheckMutation)
+
+// newFixityCheckMutation creates new mutation for the FixityCheck entity.
+func newFixityCheckMutation(c config, op Op, opts ...fixitycheckOption) *FixityCheckMutation {
+	m := &FixityCheckMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFixityCheck,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFixityCheckID sets the ID field of the mutation.
+func withFixityCheckID(id int) fixitycheckOption {
+	return func(m *FixityCheckMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FixityCheck
+		)
+		m.oldValue = func(ctx context.Context) (*FixityCheck, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FixityCheck.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFixityCheck sets the old FixityCheck of the mutation.
+func withFixityCheck(node *FixityCheck) fixitycheckOption {
+	return func(m *FixityCheckMutation) {
+		m.oldValue = func(context.Context) (*FixityCheck, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FixityCheckMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FixityCheckMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FixityCheckMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FixityCheckMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FixityCheck.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUUID sets the "uuid" field.
+func (m *FixityCheckMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
+}
+
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *FixityCheckMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUID returns the old "uuid" field's value of the FixityCheck entity.
+// If the FixityCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FixityCheckMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	}
+	return oldValue.UUID, nil
+}
+
+// ResetUUID resets all changes to the "uuid" field.
+func (m *FixityCheckMutation) ResetUUID() {
+	m.uuid = nil
+}
+
+// SetChecksumAlgorithm sets the "checksum_algorithm" field.
+func (m *FixityCheckMutation) SetChecksumAlgorithm(s string) {
+	m.checksum_algorithm = &s
+}
+
+// ChecksumAlgorithm returns the value of the "checksum_algorithm" field in the mutation.
+func (m *FixityCheckMutation) ChecksumAlgorithm() (r string, exists bool) {
+	v := m.checksum_algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksumAlgorithm returns the old "checksum_algorithm" field's value of the FixityCheck entity.
+// If the FixityCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FixityCheckMutation) OldChecksumAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksumAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksumAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksumAlgorithm: %w", err)
+	}
+	return oldValue.ChecksumAlgorithm, nil
+}
+
+// ResetChecksumAlgorithm resets all changes to the "checksum_algorithm" field.
+func (m *FixityCheckMutation) ResetChecksumAlgorithm() {
+	m.checksum_algorithm = nil
+}
+
+// SetExpectedChecksum sets the "expected_checksum" field.
+func (m *FixityCheckMutation) SetExpectedChecksum(s string) {
+	m.expected_checksum = &s
+}
+
+// ExpectedChecksum returns the value of the "expected_checksum" field in the mutation.
+func (m *FixityCheckMutation) ExpectedChecksum() (r string, exists bool) {
+	v := m.expected_checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpectedChecksum returns the old "expected_checksum" field's value of the FixityCheck entity.
+// If the FixityCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FixityCheckMutation) OldExpectedChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedChecksum: %w", err)
+	}
+	return oldValue.ExpectedChecksum, nil
+}
+
+// ResetExpectedChecksum resets all changes to the "expected_checksum" field.
+func (m *FixityCheckMutation) ResetExpectedChecksum() {
+	m.expected_checksum = nil
+}
+
+// SetActualChecksum sets the "actual_checksum" field.
+func (m *FixityCheckMutation) SetActualChecksum(s string) {
+	m.actual_checksum = &s
+}
+
+// ActualChecksum returns the value of the "actual_checksum" field in the mutation.
+func (m *FixityCheckMutation) ActualChecksum() (r string, exists bool) {
+	v := m.actual_checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActualChecksum returns the old "actual_checksum" field's value of the FixityCheck entity.
+// If the FixityCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FixityCheckMutation) OldActualChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActualChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActualChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActualChecksum: %w", err)
+	}
+	return oldValue.ActualChecksum, nil
+}
+
+// ResetActualChecksum resets all changes to the "actual_checksum" field.
+func (m *FixityCheckMutation) ResetActualChecksum() {
+	m.actual_checksum = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *FixityCheckMutation) SetStatus(ecs enums.FixityCheckStatus) {
+	m.status = &ecs
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *FixityCheckMutation) Status() (r enums.FixityCheckStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the FixityCheck entity.
+// If the FixityCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FixityCheckMutation) OldStatus(ctx context.Context) (v enums.FixityCheckStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *FixityCheckMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (m *FixityCheckMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
+}
+
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *FixityCheckMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedAt returns the old "checked_at" field's value of the FixityCheck entity.
+// If the FixityCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FixityCheckMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
+}
+
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *FixityCheckMutation) ResetCheckedAt() {
+	m.checked_at = nil
+}
+
+// SetAipID sets the "aip_id" field.
+func (m *FixityCheckMutation) SetAipID(i int) {
+	m.aip = &i
+}
+
+// AipID returns the value of the "aip_id" field in the mutation.
+func (m *FixityCheckMutation) AipID() (r int, exists bool) {
+	v := m.aip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAipID returns the old "aip_id" field's value of the FixityCheck entity.
+// If the FixityCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FixityCheckMutation) OldAipID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAipID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAipID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAipID: %w", err)
+	}
+	return oldValue.AipID, nil
+}
+
+// ResetAipID resets all changes to the "aip_id" field.
+func (m *FixityCheckMutation) ResetAipID() {
+	m.aip = nil
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (m *FixityCheckMutation) SetWorkflowID(i int) {
+	m.workflow = &i
+}
+
+// WorkflowID returns the value of the "workflow_id" field in the mutation.
+func (m *FixityCheckMutation) WorkflowID() (r int, exists bool) {
+	v := m.workflow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflowID returns the old "workflow_id" field's value of the FixityCheck entity.
+// If the FixityCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FixityCheckMutation) OldWorkflowID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkflowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkflowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflowID: %w", err)
+	}
+	return oldValue.WorkflowID, nil
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (m *FixityCheckMutation) ClearWorkflowID() {
+	m.workflow = nil
+	m.clearedFields[fixitycheck.FieldWorkflowID] = struct{}{}
+}
+
+// WorkflowIDCleared returns if the "workflow_id" field was cleared in this mutation.
+func (m *FixityCheckMutation) WorkflowIDCleared() bool {
+	_, ok := m.clearedFields[fixitycheck.FieldWorkflowID]
+	return ok
+}
+
+// ResetWorkflowID resets all changes to the "workflow_id" field.
+func (m *FixityCheckMutation) ResetWorkflowID() {
+	m.workflow = nil
+	delete(m.clearedFields, fixitycheck.FieldWorkflowID)
+}
+
+// ClearAip clears the "aip" edge to the AIP entity.
+func (m *FixityCheckMutation) ClearAip() {
+	m.clearedaip = true
+	m.clearedFields[fixitycheck.FieldAipID] = struct{}{}
+}
+
+// AipCleared reports if the "aip" edge to the AIP entity was cleared.
+func (m *FixityCheckMutation) AipCleared() bool {
+	return m.clearedaip
+}
+
+// AipIDs returns the "aip" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AipID instead. It exists only for internal usage by the builders.
+func (m *FixityCheckMutation) AipIDs() (ids []int) {
+	if id := m.aip; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAip resets all changes to the "aip" edge.
+func (m *FixityCheckMutation) ResetAip() {
+	m.aip = nil
+	m.clearedaip = false
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (m *FixityCheckMutation) ClearWorkflow() {
+	m.clearedworkflow = true
+	m.clearedFields[fixitycheck.FieldWorkflowID] = struct{}{}
+}
+
+// WorkflowCleared reports if the "workflow" edge to the Workflow entity was cleared.
+func (m *FixityCheckMutation) WorkflowCleared() bool {
+	return m.WorkflowIDCleared() || m.clearedworkflow
+}
+
+// WorkflowIDs returns the "workflow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkflowID instead. It exists only for internal usage by the builders.
+func (m *FixityCheckMutation) WorkflowIDs() (ids []int) {
+	if id := m.workflow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkflow resets all changes to the "workflow" edge.
+func (m *FixityCheckMutation) ResetWorkflow() {
+	m.workflow = nil
+	m.clearedworkflow = false
+}
+
+// Where appends a list predicates to the FixityCheckMutation builder.
+func (m *FixityCheckMutation) Where(ps ...predicate.FixityCheck) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FixityCheckMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FixityCheckMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FixityCheck, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FixityCheckMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FixityCheckMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FixityCheck).
+func (m *FixityCheckMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FixityCheckMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.uuid != nil {
+		fields = append(fields, fixitycheck.FieldUUID)
+	}
+	if m.checksum_algorithm != nil {
+		fields = append(fields, fixitycheck.FieldChecksumAlgorithm)
+	}
+	if m.expected_checksum != nil {
+		fields = append(fields, fixitycheck.FieldExpectedChecksum)
+	}
+	if m.actual_checksum != nil {
+		fields = append(fields, fixitycheck.FieldActualChecksum)
+	}
+	if m.status != nil {
+		fields = append(fields, fixitycheck.FieldStatus)
+	}
+	if m.checked_at != nil {
+		fields = append(fields, fixitycheck.FieldCheckedAt)
+	}
+	if m.aip != nil {
+		fields = append(fields, fixitycheck.FieldAipID)
+	}
+	if m.workflow != nil {
+		fields = append(fields, fixitycheck.FieldWorkflowID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FixityCheckMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fixitycheck.FieldUUID:
+		return m.UUID()
+	case fixitycheck.FieldChecksumAlgorithm:
+		return m.ChecksumAlgorithm()
+	case fixitycheck.FieldExpectedChecksum:
+		return m.ExpectedChecksum()
+	case fixitycheck.FieldActualChecksum:
+		return m.ActualChecksum()
+	case fixitycheck.FieldStatus:
+		return m.Status()
+	case fixitycheck.FieldCheckedAt:
+		return m.CheckedAt()
+	case fixitycheck.FieldAipID:
+		return m.AipID()
+	case fixitycheck.FieldWorkflowID:
+		return m.WorkflowID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FixityCheckMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fixitycheck.FieldUUID:
+		return m.OldUUID(ctx)
+	case fixitycheck.FieldChecksumAlgorithm:
+		return m.OldChecksumAlgorithm(ctx)
+	case fixitycheck.FieldExpectedChecksum:
+		return m.OldExpectedChecksum(ctx)
+	case fixitycheck.FieldActualChecksum:
+		return m.OldActualChecksum(ctx)
+	case fixitycheck.FieldStatus:
+		return m.OldStatus(ctx)
+	case fixitycheck.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
+	case fixitycheck.FieldAipID:
+		return m.OldAipID(ctx)
+	case fixitycheck.FieldWorkflowID:
+		return m.OldWorkflowID(ctx)
+	}
+	return nil, fmt.Errorf("unknown FixityCheck field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FixityCheckMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fixitycheck.FieldUUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUID(v)
+		return nil
+	case fixitycheck.FieldChecksumAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksumAlgorithm(v)
+		return nil
+	case fixitycheck.FieldExpectedChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedChecksum(v)
+		return nil
+	case fixitycheck.FieldActualChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActualChecksum(v)
+		return nil
+	case fixitycheck.FieldStatus:
+		v, ok := value.(enums.FixityCheckStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case fixitycheck.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
+	case fixitycheck.FieldAipID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAipID(v)
+		return nil
+	case fixitycheck.FieldWorkflowID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflowID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FixityCheck field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FixityCheckMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FixityCheckMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FixityCheckMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FixityCheck numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FixityCheckMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fixitycheck.FieldWorkflowID) {
+		fields = append(fields, fixitycheck.FieldWorkflowID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FixityCheckMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FixityCheckMutation) ClearField(name string) error {
+	switch name {
+	case fixitycheck.FieldWorkflowID:
+		m.ClearWorkflowID()
+		return nil
+	}
+	return fmt.Errorf("unknown FixityCheck nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FixityCheckMutation) ResetField(name string) error {
+	switch name {
+	case fixitycheck.FieldUUID:
+		m.ResetUUID()
+		return nil
+	case fixitycheck.FieldChecksumAlgorithm:
+		m.ResetChecksumAlgorithm()
+		return nil
+	case fixitycheck.FieldExpectedChecksum:
+		m.ResetExpectedChecksum()
+		return nil
+	case fixitycheck.FieldActualChecksum:
+		m.ResetActualChecksum()
+		return nil
+	case fixitycheck.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case fixitycheck.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
+	case fixitycheck.FieldAipID:
+		m.ResetAipID()
+		return nil
+	case fixitycheck.FieldWorkflowID:
+		m.ResetWorkflowID()
+		return nil
+	}
+	return fmt.Errorf("unknown FixityCheck field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FixityCheckMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.aip != nil {
+		edges = append(edges, fixitycheck.EdgeAip)
+	}
+	if m.workflow != nil {
+		edges = append(edges, fixitycheck.EdgeWorkflow)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FixityCheckMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fixitycheck.EdgeAip:
+		if id := m.aip; id != nil {
+			return []ent.Value{*id}
+		}
+	case fixitycheck.EdgeWorkflow:
+		if id := m.workflow; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FixityCheckMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FixityCheckMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FixityCheckMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedaip {
+		edges = append(edges, fixitycheck.EdgeAip)
+	}
+	if m.clearedworkflow {
+		edges = append(edges, fixitycheck.EdgeWorkflow)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FixityCheckMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fixitycheck.EdgeAip:
+		return m.clearedaip
+	case fixitycheck.EdgeWorkflow:
+		return m.clearedworkflow
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FixityCheckMutation) ClearEdge(name string) error {
+	switch name {
+	case fixitycheck.EdgeAip:
+		m.ClearAip()
+		return nil
+	case fixitycheck.EdgeWorkflow:
+		m.ClearWorkflow()
+		return nil
+	}
+	return fmt.Errorf("unknown FixityCheck unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FixityCheckMutation) ResetEdge(name string) error {
+	switch name {
+	case fixitycheck.EdgeAip:
+		m.ResetAip()
+		return nil
+	case fixitycheck.EdgeWorkflow:
+		m.ResetWorkflow()
+		return nil
+	}
+	return fmt.Errorf("unknown FixityCheck edge %s", name)
+}
+
+// LocationMutation represents an operation that mutates the Location nodes in the graph.
+type LocationMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	name                       *string
+	description                *string
+	source                     *enums.LocationSource
+	purpose                    *enums.LocationPurpose
+	uuid                       *uuid.UUID
+	_config                    *types.LocationConfig
+	created_at                 *time.Time
+	clearedFields              map[string]struct{}
+	aips                       map[int]struct{}
+	removedaips                map[int]struct{}
+	clearedaips                bool
+	replication_targets        map[int]struct{}
+	removedreplication_targets map[int]struct{}
+	clearedreplication_targets bool
+	replicas                   map[int]struct{}
+	removedreplicas            map[int]struct{}
+	clearedreplicas            bool
+	done                       bool
+	oldValue                   func(context.Context) (*Location, error)
+	predicates                 []predicate.Location
+}
+
+var _ ent.Mutation = (*LocationMutation)(nil)
+
+// locationOption allows management of the mutation configuration using functional options.
+type locationOption func(*LocationMutation)
+
+// newLocationMutation creates new mutation for the Location entity.
+func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
+	m := &LocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocationID sets the ID field of the mutation.
+func withLocationID(id int) locationOption {
+	return func(m *LocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Location
+		)
+		m.oldValue = func(ctx context.Context) (*Location, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Location.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocation sets the old Location of the mutation.
+func withLocation(node *Location) locationOption {
+	return func(m *LocationMutation) {
+		m.oldValue = func(context.Context) (*Location, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *LocationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LocationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LocationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *LocationMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *LocationMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LocationMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetSource sets the "source" field.
+func (m *LocationMutation) SetSource(es enums.LocationSource) {
+	m.source = &es
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *LocationMutation) Source() (r enums.LocationSource, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldSource(ctx context.Context) (v enums.LocationSource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *LocationMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetPurpose sets the "purpose" field.
+func (m *LocationMutation) SetPurpose(ep enums.LocationPurpose) {
+	m.purpose = &ep
+}
+
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *LocationMutation) Purpose() (r enums.LocationPurpose, exists bool) {
+	v := m.purpose
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurpose returns the old "purpose" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldPurpose(ctx context.Context) (v enums.LocationPurpose, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+	}
+	return oldValue.Purpose, nil
+}
+
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *LocationMutation) ResetPurpose() {
+	m.purpose = nil
+}
+
+// SetUUID sets the "uuid" field.
+func (m *LocationMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
+}
+
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *LocationMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUID returns the old "uuid" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	}
+	return oldValue.UUID, nil
+}
+
+// ResetUUID resets all changes to the "uuid" field.
+func (m *LocationMutation) ResetUUID() {
+	m.uuid = nil
+}
+
+// SetConfig sets the "config" field.
+func (m *LocationMutation) SetConfig(tc types.LocationConfig) {
+	m._config = &tc
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *LocationMutation) Config() (r types.LocationConfig, exists bool) {
+	v := m._config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfig returns the old "config" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldConfig(ctx context.Context) (v types.LocationConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *LocationMutation) ResetConfig() {
+	m._config = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LocationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LocationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LocationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddAipIDs adds the "aips" edge to the AIP entity by ids.
+func (m *LocationMutation) AddAipIDs(ids ...int) {
+	if m.aips == nil {
+		m.aips = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.aips[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAips clears the "aips" edge to the AIP entity.
+func (m *LocationMutation) ClearAips() {
+	m.clearedaips = true
+}
+
+// AipsCleared reports if the "aips" edge to the AIP entity was cleared.
+func (m *LocationMutation) AipsCleared() bool {
+	return m.clearedaips
+}
+
+// RemoveAipIDs removes the "aips" edge to the AIP entity by IDs.
+func (m *LocationMutation) RemoveAipIDs(ids ...int) {
+	if m.removedaips == nil {
+		m.removedaips = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.aips, ids[i])
+		m.removedaips[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAips returns the removed IDs of the "aips" edge to the AIP entity.
+func (m *LocationMutation) RemovedAipsIDs() (ids []int) {
+	for id := range m.removedaips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AipsIDs returns the "aips" edge IDs in the mutation.
+func (m *LocationMutation) AipsIDs() (ids []int) {
+	for id := range m.aips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAips resets all changes to the "aips" edge.
+func (m *LocationMutation) ResetAips() {
+	m.aips = nil
+	m.clearedaips = false
+	m.removedaips = nil
+}
+
+// AddReplicationTargetIDs adds the "replication_targets" edge to the Location entity by ids.
+func (m *LocationMutation) AddReplicationTargetIDs(ids ...int) {
+	if m.replication_targets == nil {
+		m.replication_targets = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.replication_targets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplicationTargets clears the "replication_targets" edge to the Location entity.
+func (m *LocationMutation) ClearReplicationTargets() {
+	m.clearedreplication_targets = true
+}
+
+// ReplicationTargetsCleared reports if the "replication_targets" edge to the Location entity was cleared.
+func (m *LocationMutation) ReplicationTargetsCleared() bool {
+	return m.clearedreplication_targets
+}
+
+// RemoveReplicationTargetIDs removes the "replication_targets" edge to the Location entity by IDs.
+func (m *LocationMutation) RemoveReplicationTargetIDs(ids ...int) {
+	if m.removedreplication_targets == nil {
+		m.removedreplication_targets = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.replication_targets, ids[i])
+		m.removedreplication_targets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplicationTargets returns the removed IDs of the "replication_targets" edge to the Location entity.
+func (m *LocationMutation) RemovedReplicationTargetsIDs() (ids []int) {
+	for id := range m.removedreplication_targets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReplicationTargetsIDs returns the "replication_targets" edge IDs in the mutation.
+func (m *LocationMutation) ReplicationTargetsIDs() (ids []int) {
+	for id := range m.replication_targets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplicationTargets resets all changes to the "replication_targets" edge.
+func (m *LocationMutation) ResetReplicationTargets() {
+	m.replication_targets = nil
+	m.clearedreplication_targets = false
+	m.removedreplication_targets = nil
+}
+
+// AddReplicaIDs adds the "replicas" edge to the AIPReplica entity by ids.
+func (m *LocationMutation) AddReplicaIDs(ids ...int) {
+	if m.replicas == nil {
+		m.replicas = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.replicas[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplicas clears the "replicas" edge to the AIPReplica entity.
+func (m *LocationMutation) ClearReplicas() {
+	m.clearedreplicas = true
+}
+
+// ReplicasCleared reports if the "replicas" edge to the AIPReplica entity was cleared.
+func (m *LocationMutation) ReplicasCleared() bool {
+	return m.clearedreplicas
+}
+
+// RemoveReplicaIDs removes the "replicas" edge to the AIPReplica entity by IDs.
+func (m *LocationMutation) RemoveReplicaIDs(ids ...int) {
+	if m.removedreplicas == nil {
+		m.removedreplicas = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.replicas, ids[i])
+		m.removedreplicas[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplicas returns the removed IDs of the "replicas" edge to the AIPReplica entity.
+func (m *LocationMutation) RemovedReplicasIDs() (ids []int) {
+	for id := range m.removedreplicas {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReplicasIDs returns the "replicas" edge IDs in the mutation.
+func (m *LocationMutation) ReplicasIDs() (ids []int) {
+	for id := range m.replicas {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplicas resets all changes to the "replicas" edge.
+func (m *LocationMutation) ResetReplicas() {
+	m.replicas = nil
+	m.clearedreplicas = false
+	m.removedreplicas = nil
+}
+
+// Where appends a list predicates to the LocationMutation builder.
+func (m *LocationMutation) Where(ps ...predicate.Location) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Location, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Location).
+func (m *LocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, location.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, location.FieldDescription)
+	}
+	if m.source != nil {
+		fields = append(fields, location.FieldSource)
+	}
+	if m.purpose != nil {
+		fields = append(fields, location.FieldPurpose)
+	}
+	if m.uuid != nil {
+		fields = append(fields, location.FieldUUID)
+	}
+	if m._config != nil {
+		fields = append(fields, location.FieldConfig)
+	}
+	if m.created_at != nil {
+		fields = append(fields, location.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case location.FieldName:
+		return m.Name()
+	case location.FieldDescription:
+		return m.Description()
+	case location.FieldSource:
+		return m.Source()
+	case location.FieldPurpose:
+		return m.Purpose()
+	case location.FieldUUID:
+		return m.UUID()
+	case location.FieldConfig:
+		return m.Config()
+	case location.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case location.FieldName:
+		return m.OldName(ctx)
+	case location.FieldDescription:
+		return m.OldDescription(ctx)
+	case location.FieldSource:
+		return m.OldSource(ctx)
+	case location.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case location.FieldUUID:
+		return m.OldUUID(ctx)
+	case location.FieldConfig:
+		return m.OldConfig(ctx)
+	case location.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Location field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case location.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case location.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case location.FieldSource:
+		v, ok := value.(enums.LocationSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case location.FieldPurpose:
+		v, ok := value.(enums.LocationPurpose)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurpose(v)
+		return nil
+	case location.FieldUUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUID(v)
+		return nil
+	case location.FieldConfig:
+		v, ok := value.(types.LocationConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	case location.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Location numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Location nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocationMutation) ResetField(name string) error {
+	switch name {
+	case location.FieldName:
+		m.ResetName()
+		return nil
+	case location.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case location.FieldSource:
+		m.ResetSource()
+		return nil
+	case location.FieldPurpose:
+		m.ResetPurpose()
+		return nil
+	case location.FieldUUID:
+		m.ResetUUID()
+		return nil
+	case location.FieldConfig:
+		m.ResetConfig()
+		return nil
+	case location.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.aips != nil {
+		edges = append(edges, location.EdgeAips)
+	}
+	if m.replication_targets != nil {
+		edges = append(edges, location.EdgeReplicationTargets)
+	}
+	if m.replicas != nil {
+		edges = append(edges, location.EdgeReplicas)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeAips:
+		ids := make([]ent.Value, 0, len(m.aips))
+		for id := range m.aips {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeReplicationTargets:
+		ids := make([]ent.Value, 0, len(m.replication_targets))
+		for id := range m.replication_targets {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeReplicas:
+		ids := make([]ent.Value, 0, len(m.replicas))
+		for id := range m.replicas {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedaips != nil {
+		edges = append(edges, location.EdgeAips)
+	}
+	if m.removedreplication_targets != nil {
+		edges = append(edges, location.EdgeReplicationTargets)
+	}
+	if m.removedreplicas != nil {
+		edges = append(edges, location.EdgeReplicas)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeAips:
+		ids := make([]ent.Value, 0, len(m.removedaips))
+		for id := range m.removedaips {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeReplicationTargets:
+		ids := make([]ent.Value, 0, len(m.removedreplication_targets))
+		for id := range m.removedreplication_targets {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeReplicas:
+		ids := make([]ent.Value, 0, len(m.removedreplicas))
+		for id := range m.removedreplicas {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedaips {
+		edges = append(edges, location.EdgeAips)
+	}
+	if m.clearedreplication_targets {
+		edges = append(edges, location.EdgeReplicationTargets)
+	}
+	if m.clearedreplicas {
+		edges = append(edges, location.EdgeReplicas)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeletionRequestMutation) EdgeCleared(name string) bool {
+func (m *LocationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deletionrequest.EdgeAip:
-		return m.clearedaip
-	case deletionrequest.EdgeWorkflow:
-		return m.clearedworkflow
+	case location.EdgeAips:
+		return m.clearedaips
+	case location.EdgeReplicationTargets:
+		return m.clearedreplication_targets
+	case location.EdgeReplicas:
+		return m.clearedreplicas
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeletionRequestMutation) ClearEdge(name string) error {
+func (m *LocationMutation) ClearEdge(name string) error {
 	switch name {
-	case deletionrequest.EdgeAip:
-		m.ClearAip()
-		return nil
-	case deletionrequest.EdgeWorkflow:
-		m.ClearWorkflow()
-		return nil
 	}
-	return fmt.Errorf("unknown DeletionRequest unique edge %s", name)
+	return fmt.Errorf("unknown Location unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeletionRequestMutation) ResetEdge(name string) error {
+func (m *LocationMutation) ResetEdge(name string) error {
 	switch name {
-	case deletionrequest.EdgeAip:
-		m.ResetAip()
+	case location.EdgeAips:
+		m.ResetAips()
 		return nil
-	case deletionrequest.EdgeWorkflow:
-		m.ResetWorkflow()
+	case location.EdgeReplicationTargets:
+		m.ResetReplicationTargets()
+		return nil
+	case location.EdgeReplicas:
+		m.ResetReplicas()
 		return nil
 	}
-	return fmt.Errorf("unknown DeletionRequest edge %s", name)
+	return fmt.Errorf("unknown Location edge %s", name)
 }
 
-// LocationMutation represents an operation that mutates the Location nodes in the graph.
-type LocationMutation struct {
+// SearchDocumentMutation represents an operation that mutates the SearchDocument nodes in the graph.
+type SearchDocumentMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	name          *string
-	description   *string
-	source        *enums.LocationSource
-	purpose       *enums.LocationPurpose
 	uuid          *uuid.UUID
-	_config       *types.LocationConfig
-	created_at    *time.Time
+	name          *string
+	content       *string
+	fields        *map[string]string
+	updated_at    *time.Time
 	clearedFields map[string]struct{}
-	aips          map[int]struct{}
-	removedaips   map[int]struct{}
-	clearedaips   bool
 	done          bool
-	oldValue      func(context.Context) (*Location, error)
-	predicates    []predicate.Location
+	oldValue      func(context.Context) (*SearchDocument, error)
+	predicates    []predicate.SearchDocument
 }
 
-var _ ent.Mutation = (*LocationMutation)(nil)
+var _ ent.Mutation = (*SearchDocumentMutation)(nil)
 
-// locationOption allows management of the mutation configuration using functional options.
-type locationOption func(*LocationMutation)
+// searchdocumentOption allows management of the mutation configuration using functional options.
+type searchdocumentOption func(*SearchDocumentMutation)
 
-// newLocationMutation creates new mutation for the Location entity.
-func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
-	m := &LocationMutation{
+// newSearchDocumentMutation creates new mutation for the SearchDocument entity.
+func newSearchDocumentMutation(c config, op Op, opts ...searchdocumentOption) *SearchDocumentMutation {
+	m := &SearchDocumentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLocation,
+		typ:           TypeSearchDocument,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2170,20 +7034,20 @@ func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutat
 	return m
 }
 
-// withLocationID sets the ID field of the mutation.
-func withLocationID(id int) locationOption {
-	return func(m *LocationMutation) {
+// withSearchDocumentID sets the ID field of the mutation.
+func withSearchDocumentID(id int) searchdocumentOption {
+	return func(m *SearchDocumentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Location
+			value *SearchDocument
 		)
-		m.oldValue = func(ctx context.Context) (*Location, error) {
+		m.oldValue = func(ctx context.Context) (*SearchDocument, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Location.Get(ctx, id)
+					value, err = m.Client().SearchDocument.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2192,10 +7056,10 @@ func withLocationID(id int) locationOption {
 	}
 }
 
-// withLocation sets the old Location of the mutation.
-func withLocation(node *Location) locationOption {
-	return func(m *LocationMutation) {
-		m.oldValue = func(context.Context) (*Location, error) {
+// withSearchDocument sets the old SearchDocument of the mutation.
+func withSearchDocument(node *SearchDocument) searchdocumentOption {
+	return func(m *SearchDocumentMutation) {
+		m.oldValue = func(context.Context) (*SearchDocument, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2204,7 +7068,7 @@ func withLocation(node *Location) locationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LocationMutation) Client() *Client {
+func (m SearchDocumentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2212,7 +7076,7 @@ func (m LocationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LocationMutation) Tx() (*Tx, error) {
+func (m SearchDocumentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -2223,7 +7087,7 @@ func (m LocationMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LocationMutation) ID() (id int, exists bool) {
+func (m *SearchDocumentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2234,7 +7098,7 @@ func (m *LocationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LocationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SearchDocumentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2243,327 +7107,214 @@ func (m *LocationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SearchDocument.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *LocationMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *LocationMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *LocationMutation) ResetName() {
-	m.name = nil
-}
-
-// SetDescription sets the "description" field.
-func (m *LocationMutation) SetDescription(s string) {
-	m.description = &s
-}
-
-// Description returns the value of the "description" field in the mutation.
-func (m *LocationMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDescription returns the old "description" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *LocationMutation) ResetDescription() {
-	m.description = nil
-}
-
-// SetSource sets the "source" field.
-func (m *LocationMutation) SetSource(es enums.LocationSource) {
-	m.source = &es
-}
-
-// Source returns the value of the "source" field in the mutation.
-func (m *LocationMutation) Source() (r enums.LocationSource, exists bool) {
-	v := m.source
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSource returns the old "source" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldSource(ctx context.Context) (v enums.LocationSource, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
-	}
-	return oldValue.Source, nil
-}
-
-// ResetSource resets all changes to the "source" field.
-func (m *LocationMutation) ResetSource() {
-	m.source = nil
-}
-
-// SetPurpose sets the "purpose" field.
-func (m *LocationMutation) SetPurpose(ep enums.LocationPurpose) {
-	m.purpose = &ep
+// SetUUID sets the "uuid" field.
+func (m *SearchDocumentMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
 }
 
-// Purpose returns the value of the "purpose" field in the mutation.
-func (m *LocationMutation) Purpose() (r enums.LocationPurpose, exists bool) {
-	v := m.purpose
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *SearchDocumentMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPurpose returns the old "purpose" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldUUID returns the old "uuid" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldPurpose(ctx context.Context) (v enums.LocationPurpose, err error) {
+func (m *SearchDocumentMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurpose requires an ID field in the mutation")
+		return v, errors.New("OldUUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
 	}
-	return oldValue.Purpose, nil
+	return oldValue.UUID, nil
 }
 
-// ResetPurpose resets all changes to the "purpose" field.
-func (m *LocationMutation) ResetPurpose() {
-	m.purpose = nil
+// ResetUUID resets all changes to the "uuid" field.
+func (m *SearchDocumentMutation) ResetUUID() {
+	m.uuid = nil
 }
 
-// SetUUID sets the "uuid" field.
-func (m *LocationMutation) SetUUID(u uuid.UUID) {
-	m.uuid = &u
+// SetName sets the "name" field.
+func (m *SearchDocumentMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UUID returns the value of the "uuid" field in the mutation.
-func (m *LocationMutation) UUID() (r uuid.UUID, exists bool) {
-	v := m.uuid
+// Name returns the value of the "name" field in the mutation.
+func (m *SearchDocumentMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUUID returns the old "uuid" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SearchDocumentMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUUID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UUID, nil
+	return oldValue.Name, nil
 }
 
-// ResetUUID resets all changes to the "uuid" field.
-func (m *LocationMutation) ResetUUID() {
-	m.uuid = nil
+// ResetName resets all changes to the "name" field.
+func (m *SearchDocumentMutation) ResetName() {
+	m.name = nil
 }
 
-// SetConfig sets the "config" field.
-func (m *LocationMutation) SetConfig(tc types.LocationConfig) {
-	m._config = &tc
+// SetContent sets the "content" field.
+func (m *SearchDocumentMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// Config returns the value of the "config" field in the mutation.
-func (m *LocationMutation) Config() (r types.LocationConfig, exists bool) {
-	v := m._config
+// Content returns the value of the "content" field in the mutation.
+func (m *SearchDocumentMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfig returns the old "config" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldConfig(ctx context.Context) (v types.LocationConfig, err error) {
+func (m *SearchDocumentMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfig requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.Config, nil
+	return oldValue.Content, nil
 }
 
-// ResetConfig resets all changes to the "config" field.
-func (m *LocationMutation) ResetConfig() {
-	m._config = nil
+// ResetContent resets all changes to the "content" field.
+func (m *SearchDocumentMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *LocationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetFields sets the "fields" field.
+func (m *SearchDocumentMutation) SetFields(value map[string]string) {
+	m.fields = &value
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LocationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// GetFields returns the value of the "fields" field in the mutation.
+func (m *SearchDocumentMutation) GetFields() (r map[string]string, exists bool) {
+	v := m.fields
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldFields returns the old "fields" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SearchDocumentMutation) OldFields(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFields is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFields requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFields: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Fields, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LocationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearFields clears the value of the "fields" field.
+func (m *SearchDocumentMutation) ClearFields() {
+	m.fields = nil
+	m.clearedFields[searchdocument.FieldFields] = struct{}{}
 }
 
-// AddAipIDs adds the "aips" edge to the AIP entity by ids.
-func (m *LocationMutation) AddAipIDs(ids ...int) {
-	if m.aips == nil {
-		m.aips = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.aips[ids[i]] = struct{}{}
-	}
+// FieldsCleared returns if the "fields" field was cleared in this mutation.
+func (m *SearchDocumentMutation) FieldsCleared() bool {
+	_, ok := m.clearedFields[searchdocument.FieldFields]
+	return ok
 }
 
-// ClearAips clears the "aips" edge to the AIP entity.
-func (m *LocationMutation) ClearAips() {
-	m.clearedaips = true
+// ResetFields resets all changes to the "fields" field.
+func (m *SearchDocumentMutation) ResetFields() {
+	m.fields = nil
+	delete(m.clearedFields, searchdocument.FieldFields)
 }
 
-// AipsCleared reports if the "aips" edge to the AIP entity was cleared.
-func (m *LocationMutation) AipsCleared() bool {
-	return m.clearedaips
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SearchDocumentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveAipIDs removes the "aips" edge to the AIP entity by IDs.
-func (m *LocationMutation) RemoveAipIDs(ids ...int) {
-	if m.removedaips == nil {
-		m.removedaips = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.aips, ids[i])
-		m.removedaips[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SearchDocumentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAips returns the removed IDs of the "aips" edge to the AIP entity.
-func (m *LocationMutation) RemovedAipsIDs() (ids []int) {
-	for id := range m.removedaips {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// AipsIDs returns the "aips" edge IDs in the mutation.
-func (m *LocationMutation) AipsIDs() (ids []int) {
-	for id := range m.aips {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetAips resets all changes to the "aips" edge.
-func (m *LocationMutation) ResetAips() {
-	m.aips = nil
-	m.clearedaips = false
-	m.removedaips = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SearchDocumentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the LocationMutation builder.
-func (m *LocationMutation) Where(ps ...predicate.Location) {
+// Where appends a list predicates to the SearchDocumentMutation builder.
+func (m *SearchDocumentMutation) Where(ps ...predicate.SearchDocument) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SearchDocumentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Location, len(ps))
+func (m *SearchDocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchDocument, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2571,45 +7322,39 @@ func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LocationMutation) Op() Op {
+func (m *SearchDocumentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LocationMutation) SetOp(op Op) {
+func (m *SearchDocumentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Location).
-func (m *LocationMutation) Type() string {
+// Type returns the node type of this mutation (SearchDocument).
+func (m *SearchDocumentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LocationMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.name != nil {
-		fields = append(fields, location.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, location.FieldDescription)
-	}
-	if m.source != nil {
-		fields = append(fields, location.FieldSource)
+func (m *SearchDocumentMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.uuid != nil {
+		fields = append(fields, searchdocument.FieldUUID)
 	}
-	if m.purpose != nil {
-		fields = append(fields, location.FieldPurpose)
+	if m.name != nil {
+		fields = append(fields, searchdocument.FieldName)
 	}
-	if m.uuid != nil {
-		fields = append(fields, location.FieldUUID)
+	if m.content != nil {
+		fields = append(fields, searchdocument.FieldContent)
 	}
-	if m._config != nil {
-		fields = append(fields, location.FieldConfig)
+	if m.fields != nil {
+		fields = append(fields, searchdocument.FieldFields)
 	}
-	if m.created_at != nil {
-		fields = append(fields, location.FieldCreatedAt)
+	if m.updated_at != nil {
+		fields = append(fields, searchdocument.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2617,22 +7362,18 @@ func (m *LocationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+func (m *SearchDocumentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case location.FieldName:
-		return m.Name()
-	case location.FieldDescription:
-		return m.Description()
-	case location.FieldSource:
-		return m.Source()
-	case location.FieldPurpose:
-		return m.Purpose()
-	case location.FieldUUID:
+	case searchdocument.FieldUUID:
 		return m.UUID()
-	case location.FieldConfig:
-		return m.Config()
-	case location.FieldCreatedAt:
-		return m.CreatedAt()
+	case searchdocument.FieldName:
+		return m.Name()
+	case searchdocument.FieldContent:
+		return m.Content()
+	case searchdocument.FieldFields:
+		return m.GetFields()
+	case searchdocument.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2640,236 +7381,185 @@ func (m *LocationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SearchDocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case location.FieldName:
-		return m.OldName(ctx)
-	case location.FieldDescription:
-		return m.OldDescription(ctx)
-	case location.FieldSource:
-		return m.OldSource(ctx)
-	case location.FieldPurpose:
-		return m.OldPurpose(ctx)
-	case location.FieldUUID:
+	case searchdocument.FieldUUID:
 		return m.OldUUID(ctx)
-	case location.FieldConfig:
-		return m.OldConfig(ctx)
-	case location.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case searchdocument.FieldName:
+		return m.OldName(ctx)
+	case searchdocument.FieldContent:
+		return m.OldContent(ctx)
+	case searchdocument.FieldFields:
+		return m.OldFields(ctx)
+	case searchdocument.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Location field %s", name)
+	return nil, fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LocationMutation) SetField(name string, value ent.Value) error {
+func (m *SearchDocumentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case location.FieldName:
-		v, ok := value.(string)
+	case searchdocument.FieldUUID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetUUID(v)
 		return nil
-	case location.FieldDescription:
+	case searchdocument.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case location.FieldSource:
-		v, ok := value.(enums.LocationSource)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
-		return nil
-	case location.FieldPurpose:
-		v, ok := value.(enums.LocationPurpose)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPurpose(v)
+		m.SetName(v)
 		return nil
-	case location.FieldUUID:
-		v, ok := value.(uuid.UUID)
+	case searchdocument.FieldContent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUUID(v)
+		m.SetContent(v)
 		return nil
-	case location.FieldConfig:
-		v, ok := value.(types.LocationConfig)
+	case searchdocument.FieldFields:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfig(v)
+		m.SetFields(v)
 		return nil
-	case location.FieldCreatedAt:
+	case searchdocument.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Location field %s", name)
+	return fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LocationMutation) AddedFields() []string {
+func (m *SearchDocumentMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SearchDocumentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LocationMutation) AddField(name string, value ent.Value) error {
+func (m *SearchDocumentMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Location numeric field %s", name)
+	return fmt.Errorf("unknown SearchDocument numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LocationMutation) ClearedFields() []string {
-	return nil
+func (m *SearchDocumentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(searchdocument.FieldFields) {
+		fields = append(fields, searchdocument.FieldFields)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LocationMutation) FieldCleared(name string) bool {
+func (m *SearchDocumentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LocationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Location nullable field %s", name)
+func (m *SearchDocumentMutation) ClearField(name string) error {
+	switch name {
+	case searchdocument.FieldFields:
+		m.ClearFields()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchDocument nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LocationMutation) ResetField(name string) error {
+func (m *SearchDocumentMutation) ResetField(name string) error {
 	switch name {
-	case location.FieldName:
-		m.ResetName()
-		return nil
-	case location.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case location.FieldSource:
-		m.ResetSource()
+	case searchdocument.FieldUUID:
+		m.ResetUUID()
 		return nil
-	case location.FieldPurpose:
-		m.ResetPurpose()
+	case searchdocument.FieldName:
+		m.ResetName()
 		return nil
-	case location.FieldUUID:
-		m.ResetUUID()
+	case searchdocument.FieldContent:
+		m.ResetContent()
 		return nil
-	case location.FieldConfig:
-		m.ResetConfig()
+	case searchdocument.FieldFields:
+		m.ResetFields()
 		return nil
-	case location.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case searchdocument.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Location field %s", name)
+	return fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LocationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.aips != nil {
-		edges = append(edges, location.EdgeAips)
-	}
+func (m *SearchDocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LocationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case location.EdgeAips:
-		ids := make([]ent.Value, 0, len(m.aips))
-		for id := range m.aips {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SearchDocumentMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LocationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedaips != nil {
-		edges = append(edges, location.EdgeAips)
-	}
+func (m *SearchDocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case location.EdgeAips:
-		ids := make([]ent.Value, 0, len(m.removedaips))
-		for id := range m.removedaips {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SearchDocumentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LocationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedaips {
-		edges = append(edges, location.EdgeAips)
-	}
+func (m *SearchDocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LocationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case location.EdgeAips:
-		return m.clearedaips
-	}
+func (m *SearchDocumentMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LocationMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Location unique edge %s", name)
+func (m *SearchDocumentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchDocument unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LocationMutation) ResetEdge(name string) error {
-	switch name {
-	case location.EdgeAips:
-		m.ResetAips()
-		return nil
-	}
-	return fmt.Errorf("unknown Location edge %s", name)
+func (m *SearchDocumentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchDocument edge %s", name)
 }
 
 // TaskMutation represents an operation that mutates the Task nodes in the graph.
@@ -3640,6 +8330,9 @@ type WorkflowMutation struct {
 	clearedtasks            bool
 	deletion_request        *int
 	cleareddeletion_request bool
+	fixity_checks           map[int]struct{}
+	removedfixity_checks    map[int]struct{}
+	clearedfixity_checks    bool
 	done                    bool
 	oldValue                func(context.Context) (*Workflow, error)
 	predicates              []predicate.Workflow
@@ -4141,6 +8834,60 @@ func (m *WorkflowMutation) ResetDeletionRequest() {
 	m.cleareddeletion_request = false
 }
 
+// AddFixityCheckIDs adds the "fixity_checks" edge to the FixityCheck entity by ids.
+func (m *WorkflowMutation) AddFixityCheckIDs(ids ...int) {
+	if m.fixity_checks == nil {
+		m.fixity_checks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.fixity_checks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFixityChecks clears the "fixity_checks" edge to the FixityCheck entity.
+func (m *WorkflowMutation) ClearFixityChecks() {
+	m.clearedfixity_checks = true
+}
+
+// FixityChecksCleared reports if the "fixity_checks" edge to the FixityCheck entity was cleared.
+func (m *WorkflowMutation) FixityChecksCleared() bool {
+	return m.clearedfixity_checks
+}
+
+// RemoveFixityCheckIDs removes the "fixity_checks" edge to the FixityCheck entity by IDs.
+func (m *WorkflowMutation) RemoveFixityCheckIDs(ids ...int) {
+	if m.removedfixity_checks == nil {
+		m.removedfixity_checks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.fixity_checks, ids[i])
+		m.removedfixity_checks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFixityChecks returns the removed IDs of the "fixity_checks" edge to the FixityCheck entity.
+func (m *WorkflowMutation) RemovedFixityChecksIDs() (ids []int) {
+	for id := range m.removedfixity_checks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FixityChecksIDs returns the "fixity_checks" edge IDs in the mutation.
+func (m *WorkflowMutation) FixityChecksIDs() (ids []int) {
+	for id := range m.fixity_checks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFixityChecks resets all changes to the "fixity_checks" edge.
+func (m *WorkflowMutation) ResetFixityChecks() {
+	m.fixity_checks = nil
+	m.clearedfixity_checks = false
+	m.removedfixity_checks = nil
+}
+
 // Where appends a list predicates to the WorkflowMutation builder.
 func (m *WorkflowMutation) Where(ps ...predicate.Workflow) {
 	m.predicates = append(m.predicates, ps...)
@@ -4394,7 +9141,7 @@ func (m *WorkflowMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *WorkflowMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 4)
 	if m.aip != nil {
 		edges = append(edges, workflow.EdgeAip)
 	}
@@ -4404,6 +9151,9 @@ func (m *WorkflowMutation) AddedEdges() []string {
 	if m.deletion_request != nil {
 		edges = append(edges, workflow.EdgeDeletionRequest)
 	}
+	if m.fixity_checks != nil {
+		edges = append(edges, workflow.EdgeFixityChecks)
+	}
 	return edges
 }
 
@@ -4425,16 +9175,25 @@ func (m *WorkflowMutation) AddedIDs(name string) []ent.Value {
 		if id := m.deletion_request; id != nil {
 			return []ent.Value{*id}
 		}
+	case workflow.EdgeFixityChecks:
+		ids := make([]ent.Value, 0, len(m.fixity_checks))
+		for id := range m.fixity_checks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *WorkflowMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 4)
 	if m.removedtasks != nil {
 		edges = append(edges, workflow.EdgeTasks)
 	}
+	if m.removedfixity_checks != nil {
+		edges = append(edges, workflow.EdgeFixityChecks)
+	}
 	return edges
 }
 
@@ -4448,13 +9207,19 @@ func (m *WorkflowMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case workflow.EdgeFixityChecks:
+		ids := make([]ent.Value, 0, len(m.removedfixity_checks))
+		for id := range m.removedfixity_checks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *WorkflowMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 4)
 	if m.clearedaip {
 		edges = append(edges, workflow.EdgeAip)
 	}
@@ -4464,6 +9229,9 @@ func (m *WorkflowMutation) ClearedEdges() []string {
 	if m.cleareddeletion_request {
 		edges = append(edges, workflow.EdgeDeletionRequest)
 	}
+	if m.clearedfixity_checks {
+		edges = append(edges, workflow.EdgeFixityChecks)
+	}
 	return edges
 }
 
@@ -4477,6 +9245,8 @@ func (m *WorkflowMutation) EdgeCleared(name string) bool {
 		return m.clearedtasks
 	case workflow.EdgeDeletionRequest:
 		return m.cleareddeletion_request
+	case workflow.EdgeFixityChecks:
+		return m.clearedfixity_checks
 	}
 	return false
 }
@@ -4508,6 +9278,9 @@ func (m *WorkflowMutation) ResetEdge(name string) error {
 	case workflow.EdgeDeletionRequest:
 		m.ResetDeletionRequest()
 		return nil
+	case workflow.EdgeFixityChecks:
+		m.ResetFixityChecks()
+		return nil
 	}
 	return fmt.Errorf("unknown Workflow edge %s", name)
 }
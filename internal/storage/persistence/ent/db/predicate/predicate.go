@@ -9,12 +9,27 @@ import (
 // AIP is the predicate function for aip builders.
 type AIP func(*sql.Selector)
 
+// AIPLegalHold is the predicate function for aiplegalhold builders.
+type AIPLegalHold func(*sql.Selector)
+
+// AIPReplica is the predicate function for aipreplica builders.
+type AIPReplica func(*sql.Selector)
+
 // DeletionRequest is the predicate function for deletionrequest builders.
 type DeletionRequest func(*sql.Selector)
 
+// DeletionRequestApproval is the predicate function for deletionrequestapproval builders.
+type DeletionRequestApproval func(*sql.Selector)
+
+// FixityCheck is the predicate function for fixitycheck builders.
+type FixityCheck func(*sql.Selector)
+
 // Location is the predicate function for location builders.
 type Location func(*sql.Selector)
 
+// SearchDocument is the predicate function for searchdocument builders.
+type SearchDocument func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
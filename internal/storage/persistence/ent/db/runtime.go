@@ -6,8 +6,13 @@ import (
 	"time"
 
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aiplegalhold"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aipreplica"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequestapproval"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/location"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/searchdocument"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/schema"
@@ -23,26 +28,88 @@ func init() {
 	aipDescCreatedAt := aipFields[5].Descriptor()
 	// aip.DefaultCreatedAt holds the default value on creation for the created_at field.
 	aip.DefaultCreatedAt = aipDescCreatedAt.Default.(func() time.Time)
+	aiplegalholdFields := schema.AIPLegalHold{}.Fields()
+	_ = aiplegalholdFields
+	// aiplegalholdDescPlacedAt is the schema descriptor for placed_at field.
+	aiplegalholdDescPlacedAt := aiplegalholdFields[3].Descriptor()
+	// aiplegalhold.DefaultPlacedAt holds the default value on creation for the placed_at field.
+	aiplegalhold.DefaultPlacedAt = aiplegalholdDescPlacedAt.Default.(func() time.Time)
+	// aiplegalholdDescAipID is the schema descriptor for aip_id field.
+	aiplegalholdDescAipID := aiplegalholdFields[7].Descriptor()
+	// aiplegalhold.AipIDValidator is a validator for the "aip_id" field. It is called by the builders before save.
+	aiplegalhold.AipIDValidator = aiplegalholdDescAipID.Validators[0].(func(int) error)
+	aipreplicaFields := schema.AIPReplica{}.Fields()
+	_ = aipreplicaFields
+	// aipreplicaDescCreatedAt is the schema descriptor for created_at field.
+	aipreplicaDescCreatedAt := aipreplicaFields[1].Descriptor()
+	// aipreplica.DefaultCreatedAt holds the default value on creation for the created_at field.
+	aipreplica.DefaultCreatedAt = aipreplicaDescCreatedAt.Default.(func() time.Time)
+	// aipreplicaDescAipID is the schema descriptor for aip_id field.
+	aipreplicaDescAipID := aipreplicaFields[3].Descriptor()
+	// aipreplica.AipIDValidator is a validator for the "aip_id" field. It is called by the builders before save.
+	aipreplica.AipIDValidator = aipreplicaDescAipID.Validators[0].(func(int) error)
+	// aipreplicaDescLocationID is the schema descriptor for location_id field.
+	aipreplicaDescLocationID := aipreplicaFields[4].Descriptor()
+	// aipreplica.LocationIDValidator is a validator for the "location_id" field. It is called by the builders before save.
+	aipreplica.LocationIDValidator = aipreplicaDescLocationID.Validators[0].(func(int) error)
 	deletionrequestFields := schema.DeletionRequest{}.Fields()
 	_ = deletionrequestFields
 	// deletionrequestDescRequestedAt is the schema descriptor for requested_at field.
 	deletionrequestDescRequestedAt := deletionrequestFields[9].Descriptor()
 	// deletionrequest.DefaultRequestedAt holds the default value on creation for the requested_at field.
 	deletionrequest.DefaultRequestedAt = deletionrequestDescRequestedAt.Default.(func() time.Time)
+	// deletionrequestDescRequiredApprovals is the schema descriptor for required_approvals field.
+	deletionrequestDescRequiredApprovals := deletionrequestFields[11].Descriptor()
+	// deletionrequest.DefaultRequiredApprovals holds the default value on creation for the required_approvals field.
+	deletionrequest.DefaultRequiredApprovals = deletionrequestDescRequiredApprovals.Default.(int)
+	// deletionrequest.RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	deletionrequest.RequiredApprovalsValidator = deletionrequestDescRequiredApprovals.Validators[0].(func(int) error)
 	// deletionrequestDescAipID is the schema descriptor for aip_id field.
-	deletionrequestDescAipID := deletionrequestFields[11].Descriptor()
+	deletionrequestDescAipID := deletionrequestFields[12].Descriptor()
 	// deletionrequest.AipIDValidator is a validator for the "aip_id" field. It is called by the builders before save.
 	deletionrequest.AipIDValidator = deletionrequestDescAipID.Validators[0].(func(int) error)
 	// deletionrequestDescWorkflowID is the schema descriptor for workflow_id field.
-	deletionrequestDescWorkflowID := deletionrequestFields[12].Descriptor()
+	deletionrequestDescWorkflowID := deletionrequestFields[13].Descriptor()
 	// deletionrequest.WorkflowIDValidator is a validator for the "workflow_id" field. It is called by the builders before save.
 	deletionrequest.WorkflowIDValidator = deletionrequestDescWorkflowID.Validators[0].(func(int) error)
+	deletionrequestapprovalFields := schema.DeletionRequestApproval{}.Fields()
+	_ = deletionrequestapprovalFields
+	// deletionrequestapprovalDescApprovedAt is the schema descriptor for approved_at field.
+	deletionrequestapprovalDescApprovedAt := deletionrequestapprovalFields[3].Descriptor()
+	// deletionrequestapproval.DefaultApprovedAt holds the default value on creation for the approved_at field.
+	deletionrequestapproval.DefaultApprovedAt = deletionrequestapprovalDescApprovedAt.Default.(func() time.Time)
+	// deletionrequestapprovalDescDeletionRequestID is the schema descriptor for deletion_request_id field.
+	deletionrequestapprovalDescDeletionRequestID := deletionrequestapprovalFields[4].Descriptor()
+	// deletionrequestapproval.DeletionRequestIDValidator is a validator for the "deletion_request_id" field. It is called by the builders before save.
+	deletionrequestapproval.DeletionRequestIDValidator = deletionrequestapprovalDescDeletionRequestID.Validators[0].(func(int) error)
+	fixitycheckFields := schema.FixityCheck{}.Fields()
+	_ = fixitycheckFields
+	// fixitycheckDescCheckedAt is the schema descriptor for checked_at field.
+	fixitycheckDescCheckedAt := fixitycheckFields[5].Descriptor()
+	// fixitycheck.DefaultCheckedAt holds the default value on creation for the checked_at field.
+	fixitycheck.DefaultCheckedAt = fixitycheckDescCheckedAt.Default.(func() time.Time)
+	// fixitycheckDescAipID is the schema descriptor for aip_id field.
+	fixitycheckDescAipID := fixitycheckFields[6].Descriptor()
+	// fixitycheck.AipIDValidator is a validator for the "aip_id" field. It is called by the builders before save.
+	fixitycheck.AipIDValidator = fixitycheckDescAipID.Validators[0].(func(int) error)
+	// fixitycheckDescWorkflowID is the schema descriptor for workflow_id field.
+	fixitycheckDescWorkflowID := fixitycheckFields[7].Descriptor()
+	// fixitycheck.WorkflowIDValidator is a validator for the "workflow_id" field. It is called by the builders before save.
+	fixitycheck.WorkflowIDValidator = fixitycheckDescWorkflowID.Validators[0].(func(int) error)
 	locationFields := schema.Location{}.Fields()
 	_ = locationFields
 	// locationDescCreatedAt is the schema descriptor for created_at field.
 	locationDescCreatedAt := locationFields[6].Descriptor()
 	// location.DefaultCreatedAt holds the default value on creation for the created_at field.
 	location.DefaultCreatedAt = locationDescCreatedAt.Default.(func() time.Time)
+	searchdocumentFields := schema.SearchDocument{}.Fields()
+	_ = searchdocumentFields
+	// searchdocumentDescUpdatedAt is the schema descriptor for updated_at field.
+	searchdocumentDescUpdatedAt := searchdocumentFields[4].Descriptor()
+	// searchdocument.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	searchdocument.DefaultUpdatedAt = searchdocumentDescUpdatedAt.Default.(func() time.Time)
+	// searchdocument.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	searchdocument.UpdateDefaultUpdatedAt = searchdocumentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
 	// taskDescWorkflowID is the schema descriptor for workflow_id field.
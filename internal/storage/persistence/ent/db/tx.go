@@ -14,10 +14,20 @@ type Tx struct {
 	config
 	// AIP is the client for interacting with the AIP builders.
 	AIP *AIPClient
+	// AIPLegalHold is the client for interacting with the AIPLegalHold builders.
+	AIPLegalHold *AIPLegalHoldClient
+	// AIPReplica is the client for interacting with the AIPReplica builders.
+	AIPReplica *AIPReplicaClient
 	// DeletionRequest is the client for interacting with the DeletionRequest builders.
 	DeletionRequest *DeletionRequestClient
+	// DeletionRequestApproval is the client for interacting with the DeletionRequestApproval builders.
+	DeletionRequestApproval *DeletionRequestApprovalClient
+	// FixityCheck is the client for interacting with the FixityCheck builders.
+	FixityCheck *FixityCheckClient
 	// Location is the client for interacting with the Location builders.
 	Location *LocationClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// Workflow is the client for interacting with the Workflow builders.
@@ -154,8 +164,13 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.AIP = NewAIPClient(tx.config)
+	tx.AIPLegalHold = NewAIPLegalHoldClient(tx.config)
+	tx.AIPReplica = NewAIPReplicaClient(tx.config)
 	tx.DeletionRequest = NewDeletionRequestClient(tx.config)
+	tx.DeletionRequestApproval = NewDeletionRequestApprovalClient(tx.config)
+	tx.FixityCheck = NewFixityCheckClient(tx.config)
 	tx.Location = NewLocationClient(tx.config)
+	tx.SearchDocument = NewSearchDocumentClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
 	tx.Workflow = NewWorkflowClient(tx.config)
 }
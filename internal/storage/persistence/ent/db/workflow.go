@@ -49,9 +49,11 @@ type WorkflowEdges struct {
 	Tasks []*Task `json:"tasks,omitempty"`
 	// DeletionRequest holds the value of the deletion_request edge.
 	DeletionRequest *DeletionRequest `json:"deletion_request,omitempty"`
+	// FixityChecks holds the value of the fixity_checks edge.
+	FixityChecks []*FixityCheck `json:"fixity_checks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // AipOrErr returns the Aip value or an error if the edge
@@ -85,6 +87,15 @@ func (e WorkflowEdges) DeletionRequestOrErr() (*DeletionRequest, error) {
 	return nil, &NotLoadedError{edge: "deletion_request"}
 }
 
+// FixityChecksOrErr returns the FixityChecks value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowEdges) FixityChecksOrErr() ([]*FixityCheck, error) {
+	if e.loadedTypes[3] {
+		return e.FixityChecks, nil
+	}
+	return nil, &NotLoadedError{edge: "fixity_checks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Workflow) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -189,6 +200,11 @@ func (_m *Workflow) QueryDeletionRequest() *DeletionRequestQuery {
 	return NewWorkflowClient(_m.config).QueryDeletionRequest(_m)
 }
 
+// QueryFixityChecks queries the "fixity_checks" edge of the Workflow entity.
+func (_m *Workflow) QueryFixityChecks() *FixityCheckQuery {
+	return NewWorkflowClient(_m.config).QueryFixityChecks(_m)
+}
+
 // Update returns a builder for updating this Workflow.
 // Note that you need to call Workflow.Unwrap() before calling this method if this Workflow
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -436,6 +436,29 @@ func HasDeletionRequestWith(preds ...predicate.DeletionRequest) predicate.Workfl
 	})
 }
 
+// HasFixityChecks applies the HasEdge predicate on the "fixity_checks" edge.
+func HasFixityChecks() predicate.Workflow {
+	return predicate.Workflow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FixityChecksTable, FixityChecksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFixityChecksWith applies the HasEdge predicate on the "fixity_checks" edge with a given conditions (other predicates).
+func HasFixityChecksWith(preds ...predicate.FixityCheck) predicate.Workflow {
+	return predicate.Workflow(func(s *sql.Selector) {
+		step := newFixityChecksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Workflow) predicate.Workflow {
 	return predicate.Workflow(sql.AndPredicates(predicates...))
@@ -35,6 +35,8 @@ const (
 	EdgeTasks = "tasks"
 	// EdgeDeletionRequest holds the string denoting the deletion_request edge name in mutations.
 	EdgeDeletionRequest = "deletion_request"
+	// EdgeFixityChecks holds the string denoting the fixity_checks edge name in mutations.
+	EdgeFixityChecks = "fixity_checks"
 	// Table holds the table name of the workflow in the database.
 	Table = "workflow"
 	// AipTable is the table that holds the aip relation/edge.
@@ -58,6 +60,13 @@ const (
 	DeletionRequestInverseTable = "deletion_request"
 	// DeletionRequestColumn is the table column denoting the deletion_request relation/edge.
 	DeletionRequestColumn = "workflow_id"
+	// FixityChecksTable is the table that holds the fixity_checks relation/edge.
+	FixityChecksTable = "fixity_check"
+	// FixityChecksInverseTable is the table name for the FixityCheck entity.
+	// It exists in this package in order to avoid circular dependency with the "fixitycheck" package.
+	FixityChecksInverseTable = "fixity_check"
+	// FixityChecksColumn is the table column denoting the fixity_checks relation/edge.
+	FixityChecksColumn = "workflow_id"
 )
 
 // Columns holds all SQL columns for workflow fields.
@@ -90,7 +99,7 @@ var (
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type enums.WorkflowType) error {
 	switch _type.String() {
-	case "unspecified", "upload aip", "move aip", "delete aip":
+	case "unspecified", "upload aip", "move aip", "delete aip", "audit aip", "replicate aip", "restore aip":
 		return nil
 	default:
 		return fmt.Errorf("workflow: invalid enum value for type field: %q", _type)
@@ -177,6 +186,20 @@ func ByDeletionRequestField(field string, opts ...sql.OrderTermOption) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newDeletionRequestStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByFixityChecksCount orders the results by fixity_checks count.
+func ByFixityChecksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFixityChecksStep(), opts...)
+	}
+}
+
+// ByFixityChecks orders the results by fixity_checks terms.
+func ByFixityChecks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFixityChecksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAipStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -198,3 +221,10 @@ func newDeletionRequestStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, DeletionRequestTable, DeletionRequestColumn),
 	)
 }
+func newFixityChecksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FixityChecksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FixityChecksTable, FixityChecksColumn),
+	)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
 	"github.com/google/uuid"
@@ -124,6 +125,21 @@ func (_c *WorkflowCreate) SetDeletionRequest(v *DeletionRequest) *WorkflowCreate
 	return _c.SetDeletionRequestID(v.ID)
 }
 
+// AddFixityCheckIDs adds the "fixity_checks" edge to the FixityCheck entity by IDs.
+func (_c *WorkflowCreate) AddFixityCheckIDs(ids ...int) *WorkflowCreate {
+	_c.mutation.AddFixityCheckIDs(ids...)
+	return _c
+}
+
+// AddFixityChecks adds the "fixity_checks" edges to the FixityCheck entity.
+func (_c *WorkflowCreate) AddFixityChecks(v ...*FixityCheck) *WorkflowCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFixityCheckIDs(ids...)
+}
+
 // Mutation returns the WorkflowMutation object of the builder.
 func (_c *WorkflowCreate) Mutation() *WorkflowMutation {
 	return _c.mutation
@@ -291,6 +307,22 @@ func (_c *WorkflowCreate) createSpec() (*Workflow, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FixityChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.FixityChecksTable,
+			Columns: []string{workflow.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
@@ -29,6 +30,7 @@ type WorkflowQuery struct {
 	withAip             *AIPQuery
 	withTasks           *TaskQuery
 	withDeletionRequest *DeletionRequestQuery
+	withFixityChecks    *FixityCheckQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +133,28 @@ func (_q *WorkflowQuery) QueryDeletionRequest() *DeletionRequestQuery {
 	return query
 }
 
+// QueryFixityChecks chains the current query on the "fixity_checks" edge.
+func (_q *WorkflowQuery) QueryFixityChecks() *FixityCheckQuery {
+	query := (&FixityCheckClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflow.Table, workflow.FieldID, selector),
+			sqlgraph.To(fixitycheck.Table, fixitycheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflow.FixityChecksTable, workflow.FixityChecksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Workflow entity from the query.
 // Returns a *NotFoundError when no Workflow was found.
 func (_q *WorkflowQuery) First(ctx context.Context) (*Workflow, error) {
@@ -326,6 +350,7 @@ func (_q *WorkflowQuery) Clone() *WorkflowQuery {
 		withAip:             _q.withAip.Clone(),
 		withTasks:           _q.withTasks.Clone(),
 		withDeletionRequest: _q.withDeletionRequest.Clone(),
+		withFixityChecks:    _q.withFixityChecks.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +390,17 @@ func (_q *WorkflowQuery) WithDeletionRequest(opts ...func(*DeletionRequestQuery)
 	return _q
 }
 
+// WithFixityChecks tells the query-builder to eager-load the nodes that are connected to
+// the "fixity_checks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkflowQuery) WithFixityChecks(opts ...func(*FixityCheckQuery)) *WorkflowQuery {
+	query := (&FixityCheckClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFixityChecks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +479,11 @@ func (_q *WorkflowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wor
 	var (
 		nodes       = []*Workflow{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withAip != nil,
 			_q.withTasks != nil,
 			_q.withDeletionRequest != nil,
+			_q.withFixityChecks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -486,6 +523,13 @@ func (_q *WorkflowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wor
 			return nil, err
 		}
 	}
+	if query := _q.withFixityChecks; query != nil {
+		if err := _q.loadFixityChecks(ctx, query, nodes,
+			func(n *Workflow) { n.Edges.FixityChecks = []*FixityCheck{} },
+			func(n *Workflow, e *FixityCheck) { n.Edges.FixityChecks = append(n.Edges.FixityChecks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -575,6 +619,36 @@ func (_q *WorkflowQuery) loadDeletionRequest(ctx context.Context, query *Deletio
 	}
 	return nil
 }
+func (_q *WorkflowQuery) loadFixityChecks(ctx context.Context, query *FixityCheckQuery, nodes []*Workflow, init func(*Workflow), assign func(*Workflow, *FixityCheck)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workflow)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(fixitycheck.FieldWorkflowID)
+	}
+	query.Where(predicate.FixityCheck(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workflow.FixityChecksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkflowID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workflow_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *WorkflowQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
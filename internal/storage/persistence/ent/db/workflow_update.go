@@ -14,6 +14,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/aip"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/deletionrequest"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/fixitycheck"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/predicate"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/task"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence/ent/db/workflow"
@@ -182,6 +183,21 @@ func (_u *WorkflowUpdate) SetDeletionRequest(v *DeletionRequest) *WorkflowUpdate
 	return _u.SetDeletionRequestID(v.ID)
 }
 
+// AddFixityCheckIDs adds the "fixity_checks" edge to the FixityCheck entity by IDs.
+func (_u *WorkflowUpdate) AddFixityCheckIDs(ids ...int) *WorkflowUpdate {
+	_u.mutation.AddFixityCheckIDs(ids...)
+	return _u
+}
+
+// AddFixityChecks adds the "fixity_checks" edges to the FixityCheck entity.
+func (_u *WorkflowUpdate) AddFixityChecks(v ...*FixityCheck) *WorkflowUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFixityCheckIDs(ids...)
+}
+
 // Mutation returns the WorkflowMutation object of the builder.
 func (_u *WorkflowUpdate) Mutation() *WorkflowMutation {
 	return _u.mutation
@@ -220,6 +236,27 @@ func (_u *WorkflowUpdate) ClearDeletionRequest() *WorkflowUpdate {
 	return _u
 }
 
+// ClearFixityChecks clears all "fixity_checks" edges to the FixityCheck entity.
+func (_u *WorkflowUpdate) ClearFixityChecks() *WorkflowUpdate {
+	_u.mutation.ClearFixityChecks()
+	return _u
+}
+
+// RemoveFixityCheckIDs removes the "fixity_checks" edge to FixityCheck entities by IDs.
+func (_u *WorkflowUpdate) RemoveFixityCheckIDs(ids ...int) *WorkflowUpdate {
+	_u.mutation.RemoveFixityCheckIDs(ids...)
+	return _u
+}
+
+// RemoveFixityChecks removes "fixity_checks" edges to FixityCheck entities.
+func (_u *WorkflowUpdate) RemoveFixityChecks(v ...*FixityCheck) *WorkflowUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFixityCheckIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *WorkflowUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -409,6 +446,51 @@ func (_u *WorkflowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FixityChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.FixityChecksTable,
+			Columns: []string{workflow.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFixityChecksIDs(); len(nodes) > 0 && !_u.mutation.FixityChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.FixityChecksTable,
+			Columns: []string{workflow.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FixityChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.FixityChecksTable,
+			Columns: []string{workflow.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{workflow.Label}
@@ -578,6 +660,21 @@ func (_u *WorkflowUpdateOne) SetDeletionRequest(v *DeletionRequest) *WorkflowUpd
 	return _u.SetDeletionRequestID(v.ID)
 }
 
+// AddFixityCheckIDs adds the "fixity_checks" edge to the FixityCheck entity by IDs.
+func (_u *WorkflowUpdateOne) AddFixityCheckIDs(ids ...int) *WorkflowUpdateOne {
+	_u.mutation.AddFixityCheckIDs(ids...)
+	return _u
+}
+
+// AddFixityChecks adds the "fixity_checks" edges to the FixityCheck entity.
+func (_u *WorkflowUpdateOne) AddFixityChecks(v ...*FixityCheck) *WorkflowUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFixityCheckIDs(ids...)
+}
+
 // Mutation returns the WorkflowMutation object of the builder.
 func (_u *WorkflowUpdateOne) Mutation() *WorkflowMutation {
 	return _u.mutation
@@ -616,6 +713,27 @@ func (_u *WorkflowUpdateOne) ClearDeletionRequest() *WorkflowUpdateOne {
 	return _u
 }
 
+// ClearFixityChecks clears all "fixity_checks" edges to the FixityCheck entity.
+func (_u *WorkflowUpdateOne) ClearFixityChecks() *WorkflowUpdateOne {
+	_u.mutation.ClearFixityChecks()
+	return _u
+}
+
+// RemoveFixityCheckIDs removes the "fixity_checks" edge to FixityCheck entities by IDs.
+func (_u *WorkflowUpdateOne) RemoveFixityCheckIDs(ids ...int) *WorkflowUpdateOne {
+	_u.mutation.RemoveFixityCheckIDs(ids...)
+	return _u
+}
+
+// RemoveFixityChecks removes "fixity_checks" edges to FixityCheck entities.
+func (_u *WorkflowUpdateOne) RemoveFixityChecks(v ...*FixityCheck) *WorkflowUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFixityCheckIDs(ids...)
+}
+
 // Where appends a list predicates to the WorkflowUpdate builder.
 func (_u *WorkflowUpdateOne) Where(ps ...predicate.Workflow) *WorkflowUpdateOne {
 	_u.mutation.Where(ps...)
@@ -835,6 +953,51 @@ func (_u *WorkflowUpdateOne) sqlSave(ctx context.Context) (_node *Workflow, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FixityChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.FixityChecksTable,
+			Columns: []string{workflow.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFixityChecksIDs(); len(nodes) > 0 && !_u.mutation.FixityChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.FixityChecksTable,
+			Columns: []string{workflow.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FixityChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.FixityChecksTable,
+			Columns: []string{workflow.FixityChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fixitycheck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Workflow{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
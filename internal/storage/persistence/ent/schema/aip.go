@@ -47,6 +47,29 @@ func (AIP) Fields() []ent.Field {
 		field.String("deletion_report_key").
 			Annotations(entsql.Annotation{Size: 1024}).
 			Optional(),
+		field.String("checksum_algorithm").
+			Annotations(entsql.Annotation{Size: 32}).
+			Optional(),
+		// checksum_hash is the hash of the stored AIP object, used as the
+		// baseline value for fixity checks.
+		field.String("checksum_hash").
+			Annotations(entsql.Annotation{Size: 256}).
+			Optional(),
+		// size and file_count are recorded when the AIP is stored and are used
+		// to report the usage of its location.
+		field.Int64("size").
+			Optional(),
+		field.Int("file_count").
+			Optional(),
+		// custom_metadata holds the SIP custom metadata keys copied onto the
+		// AIP when it was created.
+		field.JSON("custom_metadata", map[string]any{}).
+			Optional(),
+		// disposal_at is the date after which the AIP is due for deletion
+		// according to its retention policy.
+		field.Time("disposal_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -60,6 +83,12 @@ func (AIP) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("deletion_requests", DeletionRequest.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("fixity_checks", FixityCheck.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("replicas", AIPReplica.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("legal_holds", AIPLegalHold.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -68,5 +97,6 @@ func (AIP) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("aip_id"),
 		index.Fields("object_key"),
+		index.Fields("disposal_at"),
 	}
 }
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AIPLegalHold holds the schema definition for the AIPLegalHold entity.
+type AIPLegalHold struct {
+	ent.Schema
+}
+
+// Annotations of the AIPLegalHold.
+func (AIPLegalHold) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aip_legal_hold"},
+	}
+}
+
+// Fields of the AIPLegalHold.
+func (AIPLegalHold) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("uuid", uuid.UUID{}).
+			Unique(),
+		field.String("reason").
+			Annotations(entsql.Annotation{
+				Size: 2048,
+			}).
+			Immutable(),
+		field.String("placed_by").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}).
+			Immutable(),
+		field.Time("placed_at").
+			Immutable().
+			Default(time.Now),
+		field.String("released_by").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}).
+			Optional(),
+		field.String("release_reason").
+			Annotations(entsql.Annotation{
+				Size: 2048,
+			}).
+			Optional(),
+		field.Time("released_at").
+			Optional(),
+		field.Int("aip_id").
+			Positive().
+			Immutable(),
+		// active_aip_id mirrors aip_id while the hold is active and is cleared
+		// on release, its unique index allows a single active hold per AIP.
+		field.Int("active_aip_id").
+			Optional().
+			Nillable().
+			Unique(),
+	}
+}
+
+// Edges of the AIPLegalHold.
+func (AIPLegalHold) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("aip", AIP.Type).
+			Ref("legal_holds").
+			Unique().
+			Required().
+			Immutable().
+			Field("aip_id"),
+	}
+}
+
+// Indexes of the AIPLegalHold.
+func (AIPLegalHold) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("uuid"),
+		index.Fields("aip_id", "released_at"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+// AIPReplica holds the schema definition for the AIPReplica entity.
+type AIPReplica struct {
+	ent.Schema
+}
+
+// Annotations of the AIPReplica.
+func (AIPReplica) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aip_replica"},
+	}
+}
+
+// Fields of the AIPReplica.
+func (AIPReplica) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").
+			GoType(enums.ReplicaStatusPending),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("replicated_at").
+			Optional(),
+		field.Int("aip_id").
+			Positive().
+			Immutable(),
+		field.Int("location_id").
+			Positive().
+			Immutable(),
+	}
+}
+
+// Edges of the AIPReplica.
+func (AIPReplica) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("aip", AIP.Type).
+			Ref("replicas").
+			Unique().
+			Required().
+			Immutable().
+			Field("aip_id"),
+		edge.From("location", Location.Type).
+			Ref("replicas").
+			Unique().
+			Required().
+			Immutable().
+			Field("location_id"),
+	}
+}
+
+// Indexes of the AIPReplica.
+func (AIPReplica) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("aip_id", "location_id").
+			Unique(),
+	}
+}
@@ -74,6 +74,9 @@ func (DeletionRequest) Fields() []ent.Field {
 			Default(time.Now),
 		field.Time("reviewed_at").
 			Optional(),
+		field.Int("required_approvals").
+			Positive().
+			Default(1),
 		field.Int("aip_id").
 			Positive(),
 		field.Int("workflow_id").
@@ -94,6 +97,8 @@ func (DeletionRequest) Edges() []ent.Edge {
 			Ref("deletion_request").
 			Unique().
 			Field("workflow_id"),
+		edge.To("approvals", DeletionRequestApproval.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
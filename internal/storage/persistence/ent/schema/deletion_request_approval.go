@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeletionRequestApproval holds the schema definition for the
+// DeletionRequestApproval entity.
+type DeletionRequestApproval struct {
+	ent.Schema
+}
+
+// Annotations of the DeletionRequestApproval.
+func (DeletionRequestApproval) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deletion_request_approval"},
+	}
+}
+
+// Fields of the DeletionRequestApproval.
+func (DeletionRequestApproval) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("approver").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}).
+			Immutable(),
+		field.String("approver_iss").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}).
+			Immutable(),
+		field.String("approver_sub").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}).
+			Immutable(),
+		field.Time("approved_at").
+			Immutable().
+			Default(time.Now),
+		field.Int("deletion_request_id").
+			Positive().
+			Immutable(),
+	}
+}
+
+// Edges of the DeletionRequestApproval.
+func (DeletionRequestApproval) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("deletion_request", DeletionRequest.Type).
+			Ref("approvals").
+			Unique().
+			Required().
+			Immutable().
+			Field("deletion_request_id"),
+	}
+}
+
+// Indexes of the DeletionRequestApproval.
+func (DeletionRequestApproval) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deletion_request_id"),
+	}
+}
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+// FixityCheck holds the schema definition for the FixityCheck entity.
+type FixityCheck struct {
+	ent.Schema
+}
+
+// Annotations of the FixityCheck.
+func (FixityCheck) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "fixity_check"},
+	}
+}
+
+// Fields of the FixityCheck.
+func (FixityCheck) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("uuid", uuid.UUID{}).
+			Unique(),
+		field.String("checksum_algorithm").
+			Annotations(entsql.Annotation{
+				Size: 32,
+			}).
+			Immutable(),
+		field.String("expected_checksum").
+			Annotations(entsql.Annotation{
+				Size: 256,
+			}).
+			Immutable(),
+		field.String("actual_checksum").
+			Annotations(entsql.Annotation{
+				Size: 256,
+			}).
+			Immutable(),
+		field.Enum("status").
+			GoType(enums.FixityCheckStatusPassed).
+			Immutable(),
+		field.Time("checked_at").
+			Immutable().
+			Default(time.Now),
+		field.Int("aip_id").
+			Positive().
+			Immutable(),
+		field.Int("workflow_id").
+			Positive().
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the FixityCheck.
+func (FixityCheck) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("aip", AIP.Type).
+			Ref("fixity_checks").
+			Unique().
+			Required().
+			Immutable().
+			Field("aip_id"),
+		edge.From("workflow", Workflow.Type).
+			Ref("fixity_checks").
+			Unique().
+			Immutable().
+			Field("workflow_id"),
+	}
+}
+
+// Indexes of the FixityCheck.
+func (FixityCheck) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("uuid"),
+		index.Fields("aip_id", "checked_at"),
+	}
+}
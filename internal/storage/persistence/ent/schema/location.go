@@ -56,6 +56,9 @@ func (Location) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("aips", AIP.Type).
 			Ref("location"),
+		edge.To("replication_targets", Location.Type),
+		edge.To("replicas", AIPReplica.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SearchDocument holds the schema definition for the SearchDocument entity.
+type SearchDocument struct {
+	ent.Schema
+}
+
+// Annotations of the SearchDocument.
+func (SearchDocument) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "search_document",
+			// Use a case-insensitive collation for full-text searches.
+			Collation: "utf8mb4_unicode_ci",
+		},
+	}
+}
+
+// Fields of the SearchDocument.
+func (SearchDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("uuid", uuid.UUID{}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			Annotations(entsql.Annotation{
+				Size: 2048,
+			}),
+		field.Text("content"),
+		field.JSON("fields", map[string]string{}).
+			Optional(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the SearchDocument.
+func (SearchDocument) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "content").
+			StorageKey("search_document_fulltext_idx").
+			Annotations(
+				entsql.IndexTypes(map[string]string{
+					dialect.MySQL:    "FULLTEXT",
+					dialect.Postgres: "GIN",
+				}),
+				// PostgreSQL uses trigram indexes, which speed up the case
+				// insensitive substring matching of the search predicate.
+				entsql.OpClassColumn("name", "gin_trgm_ops"),
+				entsql.OpClassColumn("content", "gin_trgm_ops"),
+			),
+	}
+}
@@ -59,6 +59,8 @@ func (Workflow) Edges() []ent.Edge {
 		edge.To("deletion_request", DeletionRequest.Type).
 			Annotations(entsql.OnDelete(entsql.SetNull)).
 			Unique(),
+		edge.To("fixity_checks", FixityCheck.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
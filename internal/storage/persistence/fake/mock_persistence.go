@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	storage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	search "github.com/artefactual-sdps/enduro/internal/search"
 	enums "github.com/artefactual-sdps/enduro/internal/storage/enums"
 	persistence "github.com/artefactual-sdps/enduro/internal/storage/persistence"
 	types "github.com/artefactual-sdps/enduro/internal/storage/types"
@@ -84,6 +85,82 @@ func (c *MockStorageCreateAIPCall) DoAndReturn(f func(context.Context, *storage.
 	return c
 }
 
+// CreateAIPLegalHold mocks base method.
+func (m *MockStorage) CreateAIPLegalHold(arg0 context.Context, arg1 *types.AIPLegalHold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAIPLegalHold", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAIPLegalHold indicates an expected call of CreateAIPLegalHold.
+func (mr *MockStorageMockRecorder) CreateAIPLegalHold(arg0, arg1 any) *MockStorageCreateAIPLegalHoldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAIPLegalHold", reflect.TypeOf((*MockStorage)(nil).CreateAIPLegalHold), arg0, arg1)
+	return &MockStorageCreateAIPLegalHoldCall{Call: call}
+}
+
+// MockStorageCreateAIPLegalHoldCall wrap *gomock.Call
+type MockStorageCreateAIPLegalHoldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageCreateAIPLegalHoldCall) Return(arg0 error) *MockStorageCreateAIPLegalHoldCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageCreateAIPLegalHoldCall) Do(f func(context.Context, *types.AIPLegalHold) error) *MockStorageCreateAIPLegalHoldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageCreateAIPLegalHoldCall) DoAndReturn(f func(context.Context, *types.AIPLegalHold) error) *MockStorageCreateAIPLegalHoldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateAIPReplica mocks base method.
+func (m *MockStorage) CreateAIPReplica(arg0 context.Context, arg1 *types.AIPReplica) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAIPReplica", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAIPReplica indicates an expected call of CreateAIPReplica.
+func (mr *MockStorageMockRecorder) CreateAIPReplica(arg0, arg1 any) *MockStorageCreateAIPReplicaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAIPReplica", reflect.TypeOf((*MockStorage)(nil).CreateAIPReplica), arg0, arg1)
+	return &MockStorageCreateAIPReplicaCall{Call: call}
+}
+
+// MockStorageCreateAIPReplicaCall wrap *gomock.Call
+type MockStorageCreateAIPReplicaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageCreateAIPReplicaCall) Return(arg0 error) *MockStorageCreateAIPReplicaCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageCreateAIPReplicaCall) Do(f func(context.Context, *types.AIPReplica) error) *MockStorageCreateAIPReplicaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageCreateAIPReplicaCall) DoAndReturn(f func(context.Context, *types.AIPReplica) error) *MockStorageCreateAIPReplicaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateDeletionRequest mocks base method.
 func (m *MockStorage) CreateDeletionRequest(arg0 context.Context, arg1 *types.DeletionRequest) error {
 	m.ctrl.T.Helper()
@@ -122,6 +199,82 @@ func (c *MockStorageCreateDeletionRequestCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// CreateDeletionRequestApproval mocks base method.
+func (m *MockStorage) CreateDeletionRequestApproval(arg0 context.Context, arg1 *types.DeletionRequestApproval) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeletionRequestApproval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeletionRequestApproval indicates an expected call of CreateDeletionRequestApproval.
+func (mr *MockStorageMockRecorder) CreateDeletionRequestApproval(arg0, arg1 any) *MockStorageCreateDeletionRequestApprovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeletionRequestApproval", reflect.TypeOf((*MockStorage)(nil).CreateDeletionRequestApproval), arg0, arg1)
+	return &MockStorageCreateDeletionRequestApprovalCall{Call: call}
+}
+
+// MockStorageCreateDeletionRequestApprovalCall wrap *gomock.Call
+type MockStorageCreateDeletionRequestApprovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageCreateDeletionRequestApprovalCall) Return(arg0 error) *MockStorageCreateDeletionRequestApprovalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageCreateDeletionRequestApprovalCall) Do(f func(context.Context, *types.DeletionRequestApproval) error) *MockStorageCreateDeletionRequestApprovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageCreateDeletionRequestApprovalCall) DoAndReturn(f func(context.Context, *types.DeletionRequestApproval) error) *MockStorageCreateDeletionRequestApprovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateFixityCheck mocks base method.
+func (m *MockStorage) CreateFixityCheck(arg0 context.Context, arg1 *types.FixityCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFixityCheck", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFixityCheck indicates an expected call of CreateFixityCheck.
+func (mr *MockStorageMockRecorder) CreateFixityCheck(arg0, arg1 any) *MockStorageCreateFixityCheckCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFixityCheck", reflect.TypeOf((*MockStorage)(nil).CreateFixityCheck), arg0, arg1)
+	return &MockStorageCreateFixityCheckCall{Call: call}
+}
+
+// MockStorageCreateFixityCheckCall wrap *gomock.Call
+type MockStorageCreateFixityCheckCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageCreateFixityCheckCall) Return(arg0 error) *MockStorageCreateFixityCheckCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageCreateFixityCheckCall) Do(f func(context.Context, *types.FixityCheck) error) *MockStorageCreateFixityCheckCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageCreateFixityCheckCall) DoAndReturn(f func(context.Context, *types.FixityCheck) error) *MockStorageCreateFixityCheckCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateLocation mocks base method.
 func (m *MockStorage) CreateLocation(ctx context.Context, location *storage.Location, config *types.LocationConfig) (*storage.Location, error) {
 	m.ctrl.T.Helper()
@@ -237,19 +390,172 @@ func (c *MockStorageCreateWorkflowCall) DoAndReturn(f func(context.Context, *typ
 	return c
 }
 
+// DeleteAIPReplica mocks base method.
+func (m *MockStorage) DeleteAIPReplica(ctx context.Context, aipID uuid.UUID, locationID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAIPReplica", ctx, aipID, locationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAIPReplica indicates an expected call of DeleteAIPReplica.
+func (mr *MockStorageMockRecorder) DeleteAIPReplica(ctx, aipID, locationID any) *MockStorageDeleteAIPReplicaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAIPReplica", reflect.TypeOf((*MockStorage)(nil).DeleteAIPReplica), ctx, aipID, locationID)
+	return &MockStorageDeleteAIPReplicaCall{Call: call}
+}
+
+// MockStorageDeleteAIPReplicaCall wrap *gomock.Call
+type MockStorageDeleteAIPReplicaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageDeleteAIPReplicaCall) Return(arg0 error) *MockStorageDeleteAIPReplicaCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageDeleteAIPReplicaCall) Do(f func(context.Context, uuid.UUID, uuid.UUID) error) *MockStorageDeleteAIPReplicaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageDeleteAIPReplicaCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID) error) *MockStorageDeleteAIPReplicaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSearchDocument mocks base method.
+func (m *MockStorage) DeleteSearchDocument(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSearchDocument", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSearchDocument indicates an expected call of DeleteSearchDocument.
+func (mr *MockStorageMockRecorder) DeleteSearchDocument(arg0, arg1 any) *MockStorageDeleteSearchDocumentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSearchDocument", reflect.TypeOf((*MockStorage)(nil).DeleteSearchDocument), arg0, arg1)
+	return &MockStorageDeleteSearchDocumentCall{Call: call}
+}
+
+// MockStorageDeleteSearchDocumentCall wrap *gomock.Call
+type MockStorageDeleteSearchDocumentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageDeleteSearchDocumentCall) Return(arg0 error) *MockStorageDeleteSearchDocumentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageDeleteSearchDocumentCall) Do(f func(context.Context, uuid.UUID) error) *MockStorageDeleteSearchDocumentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageDeleteSearchDocumentCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockStorageDeleteSearchDocumentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// HasActiveAIPLegalHold mocks base method.
+func (m *MockStorage) HasActiveAIPLegalHold(ctx context.Context, aipID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasActiveAIPLegalHold", ctx, aipID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasActiveAIPLegalHold indicates an expected call of HasActiveAIPLegalHold.
+func (mr *MockStorageMockRecorder) HasActiveAIPLegalHold(ctx, aipID any) *MockStorageHasActiveAIPLegalHoldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasActiveAIPLegalHold", reflect.TypeOf((*MockStorage)(nil).HasActiveAIPLegalHold), ctx, aipID)
+	return &MockStorageHasActiveAIPLegalHoldCall{Call: call}
+}
+
+// MockStorageHasActiveAIPLegalHoldCall wrap *gomock.Call
+type MockStorageHasActiveAIPLegalHoldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageHasActiveAIPLegalHoldCall) Return(arg0 bool, arg1 error) *MockStorageHasActiveAIPLegalHoldCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageHasActiveAIPLegalHoldCall) Do(f func(context.Context, uuid.UUID) (bool, error)) *MockStorageHasActiveAIPLegalHoldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageHasActiveAIPLegalHoldCall) DoAndReturn(f func(context.Context, uuid.UUID) (bool, error)) *MockStorageHasActiveAIPLegalHoldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IndexSearchDocument mocks base method.
+func (m *MockStorage) IndexSearchDocument(arg0 context.Context, arg1 *search.Document) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexSearchDocument", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexSearchDocument indicates an expected call of IndexSearchDocument.
+func (mr *MockStorageMockRecorder) IndexSearchDocument(arg0, arg1 any) *MockStorageIndexSearchDocumentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexSearchDocument", reflect.TypeOf((*MockStorage)(nil).IndexSearchDocument), arg0, arg1)
+	return &MockStorageIndexSearchDocumentCall{Call: call}
+}
+
+// MockStorageIndexSearchDocumentCall wrap *gomock.Call
+type MockStorageIndexSearchDocumentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageIndexSearchDocumentCall) Return(arg0 error) *MockStorageIndexSearchDocumentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageIndexSearchDocumentCall) Do(f func(context.Context, *search.Document) error) *MockStorageIndexSearchDocumentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageIndexSearchDocumentCall) DoAndReturn(f func(context.Context, *search.Document) error) *MockStorageIndexSearchDocumentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListAIPs mocks base method.
-func (m *MockStorage) ListAIPs(ctx context.Context, payload *storage.ListAipsPayload) (*storage.AIPs, error) {
+func (m *MockStorage) ListAIPs(ctx context.Context, payload *storage.ListAipsPayload, scope *persistence.AIPScope) (*storage.AIPs, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAIPs", ctx, payload)
+	ret := m.ctrl.Call(m, "ListAIPs", ctx, payload, scope)
 	ret0, _ := ret[0].(*storage.AIPs)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAIPs indicates an expected call of ListAIPs.
-func (mr *MockStorageMockRecorder) ListAIPs(ctx, payload any) *MockStorageListAIPsCall {
+func (mr *MockStorageMockRecorder) ListAIPs(ctx, payload, scope any) *MockStorageListAIPsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAIPs", reflect.TypeOf((*MockStorage)(nil).ListAIPs), ctx, payload)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAIPs", reflect.TypeOf((*MockStorage)(nil).ListAIPs), ctx, payload, scope)
 	return &MockStorageListAIPsCall{Call: call}
 }
 
@@ -265,13 +571,52 @@ func (c *MockStorageListAIPsCall) Return(arg0 *storage.AIPs, arg1 error) *MockSt
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageListAIPsCall) Do(f func(context.Context, *storage.ListAipsPayload) (*storage.AIPs, error)) *MockStorageListAIPsCall {
+func (c *MockStorageListAIPsCall) Do(f func(context.Context, *storage.ListAipsPayload, *persistence.AIPScope) (*storage.AIPs, error)) *MockStorageListAIPsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageListAIPsCall) DoAndReturn(f func(context.Context, *storage.ListAipsPayload) (*storage.AIPs, error)) *MockStorageListAIPsCall {
+func (c *MockStorageListAIPsCall) DoAndReturn(f func(context.Context, *storage.ListAipsPayload, *persistence.AIPScope) (*storage.AIPs, error)) *MockStorageListAIPsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDeletionRequestApprovals mocks base method.
+func (m *MockStorage) ListDeletionRequestApprovals(ctx context.Context, deletionRequestDBID int) ([]*types.DeletionRequestApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletionRequestApprovals", ctx, deletionRequestDBID)
+	ret0, _ := ret[0].([]*types.DeletionRequestApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletionRequestApprovals indicates an expected call of ListDeletionRequestApprovals.
+func (mr *MockStorageMockRecorder) ListDeletionRequestApprovals(ctx, deletionRequestDBID any) *MockStorageListDeletionRequestApprovalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletionRequestApprovals", reflect.TypeOf((*MockStorage)(nil).ListDeletionRequestApprovals), ctx, deletionRequestDBID)
+	return &MockStorageListDeletionRequestApprovalsCall{Call: call}
+}
+
+// MockStorageListDeletionRequestApprovalsCall wrap *gomock.Call
+type MockStorageListDeletionRequestApprovalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageListDeletionRequestApprovalsCall) Return(arg0 []*types.DeletionRequestApproval, arg1 error) *MockStorageListDeletionRequestApprovalsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageListDeletionRequestApprovalsCall) Do(f func(context.Context, int) ([]*types.DeletionRequestApproval, error)) *MockStorageListDeletionRequestApprovalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageListDeletionRequestApprovalsCall) DoAndReturn(f func(context.Context, int) ([]*types.DeletionRequestApproval, error)) *MockStorageListDeletionRequestApprovalsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -315,6 +660,45 @@ func (c *MockStorageListDeletionRequestsCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// ListDisposableAIPs mocks base method.
+func (m *MockStorage) ListDisposableAIPs(ctx context.Context, f *persistence.DisposalFilter) ([]*types.AIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisposableAIPs", ctx, f)
+	ret0, _ := ret[0].([]*types.AIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisposableAIPs indicates an expected call of ListDisposableAIPs.
+func (mr *MockStorageMockRecorder) ListDisposableAIPs(ctx, f any) *MockStorageListDisposableAIPsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisposableAIPs", reflect.TypeOf((*MockStorage)(nil).ListDisposableAIPs), ctx, f)
+	return &MockStorageListDisposableAIPsCall{Call: call}
+}
+
+// MockStorageListDisposableAIPsCall wrap *gomock.Call
+type MockStorageListDisposableAIPsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageListDisposableAIPsCall) Return(arg0 []*types.AIP, arg1 error) *MockStorageListDisposableAIPsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageListDisposableAIPsCall) Do(f func(context.Context, *persistence.DisposalFilter) ([]*types.AIP, error)) *MockStorageListDisposableAIPsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageListDisposableAIPsCall) DoAndReturn(f func(context.Context, *persistence.DisposalFilter) ([]*types.AIP, error)) *MockStorageListDisposableAIPsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListLocations mocks base method.
 func (m *MockStorage) ListLocations(ctx context.Context) (storage.LocationCollection, error) {
 	m.ctrl.T.Helper()
@@ -432,6 +816,45 @@ func (c *MockStorageLocationAIPsCall) DoAndReturn(f func(context.Context, uuid.U
 	return c
 }
 
+// LocationUsage mocks base method.
+func (m *MockStorage) LocationUsage(ctx context.Context, locationID uuid.UUID) (*types.LocationUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocationUsage", ctx, locationID)
+	ret0, _ := ret[0].(*types.LocationUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocationUsage indicates an expected call of LocationUsage.
+func (mr *MockStorageMockRecorder) LocationUsage(ctx, locationID any) *MockStorageLocationUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocationUsage", reflect.TypeOf((*MockStorage)(nil).LocationUsage), ctx, locationID)
+	return &MockStorageLocationUsageCall{Call: call}
+}
+
+// MockStorageLocationUsageCall wrap *gomock.Call
+type MockStorageLocationUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageLocationUsageCall) Return(arg0 *types.LocationUsage, arg1 error) *MockStorageLocationUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageLocationUsageCall) Do(f func(context.Context, uuid.UUID) (*types.LocationUsage, error)) *MockStorageLocationUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageLocationUsageCall) DoAndReturn(f func(context.Context, uuid.UUID) (*types.LocationUsage, error)) *MockStorageLocationUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReadAIP mocks base method.
 func (m *MockStorage) ReadAIP(ctx context.Context, aipID uuid.UUID) (*storage.AIP, error) {
 	m.ctrl.T.Helper()
@@ -588,6 +1011,84 @@ func (c *MockStorageReadWorkflowCall) DoAndReturn(f func(context.Context, int) (
 	return c
 }
 
+// ReleaseAIPLegalHold mocks base method.
+func (m *MockStorage) ReleaseAIPLegalHold(ctx context.Context, aipID uuid.UUID, releasedBy string, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAIPLegalHold", ctx, aipID, releasedBy, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseAIPLegalHold indicates an expected call of ReleaseAIPLegalHold.
+func (mr *MockStorageMockRecorder) ReleaseAIPLegalHold(ctx, aipID, releasedBy, reason any) *MockStorageReleaseAIPLegalHoldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAIPLegalHold", reflect.TypeOf((*MockStorage)(nil).ReleaseAIPLegalHold), ctx, aipID, releasedBy, reason)
+	return &MockStorageReleaseAIPLegalHoldCall{Call: call}
+}
+
+// MockStorageReleaseAIPLegalHoldCall wrap *gomock.Call
+type MockStorageReleaseAIPLegalHoldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageReleaseAIPLegalHoldCall) Return(arg0 error) *MockStorageReleaseAIPLegalHoldCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageReleaseAIPLegalHoldCall) Do(f func(context.Context, uuid.UUID, string, string) error) *MockStorageReleaseAIPLegalHoldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageReleaseAIPLegalHoldCall) DoAndReturn(f func(context.Context, uuid.UUID, string, string) error) *MockStorageReleaseAIPLegalHoldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchDocuments mocks base method.
+func (m *MockStorage) SearchDocuments(arg0 context.Context, arg1 *search.Query) ([]*search.Result, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchDocuments", arg0, arg1)
+	ret0, _ := ret[0].([]*search.Result)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchDocuments indicates an expected call of SearchDocuments.
+func (mr *MockStorageMockRecorder) SearchDocuments(arg0, arg1 any) *MockStorageSearchDocumentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchDocuments", reflect.TypeOf((*MockStorage)(nil).SearchDocuments), arg0, arg1)
+	return &MockStorageSearchDocumentsCall{Call: call}
+}
+
+// MockStorageSearchDocumentsCall wrap *gomock.Call
+type MockStorageSearchDocumentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSearchDocumentsCall) Return(arg0 []*search.Result, arg1 int, arg2 error) *MockStorageSearchDocumentsCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSearchDocumentsCall) Do(f func(context.Context, *search.Query) ([]*search.Result, int, error)) *MockStorageSearchDocumentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSearchDocumentsCall) DoAndReturn(f func(context.Context, *search.Query) ([]*search.Result, int, error)) *MockStorageSearchDocumentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateAIP mocks base method.
 func (m *MockStorage) UpdateAIP(ctx context.Context, aipID uuid.UUID, updater persistence.AIPUpdater) (*types.AIP, *storage.AIP, error) {
 	m.ctrl.T.Helper()
@@ -666,6 +1167,44 @@ func (c *MockStorageUpdateAIPLocationIDCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// UpdateAIPReplicaStatus mocks base method.
+func (m *MockStorage) UpdateAIPReplicaStatus(ctx context.Context, aipID uuid.UUID, locationID uuid.UUID, status enums.ReplicaStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAIPReplicaStatus", ctx, aipID, locationID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAIPReplicaStatus indicates an expected call of UpdateAIPReplicaStatus.
+func (mr *MockStorageMockRecorder) UpdateAIPReplicaStatus(ctx, aipID, locationID, status any) *MockStorageUpdateAIPReplicaStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAIPReplicaStatus", reflect.TypeOf((*MockStorage)(nil).UpdateAIPReplicaStatus), ctx, aipID, locationID, status)
+	return &MockStorageUpdateAIPReplicaStatusCall{Call: call}
+}
+
+// MockStorageUpdateAIPReplicaStatusCall wrap *gomock.Call
+type MockStorageUpdateAIPReplicaStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageUpdateAIPReplicaStatusCall) Return(arg0 error) *MockStorageUpdateAIPReplicaStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageUpdateAIPReplicaStatusCall) Do(f func(context.Context, uuid.UUID, uuid.UUID, enums.ReplicaStatus) error) *MockStorageUpdateAIPReplicaStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageUpdateAIPReplicaStatusCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID, enums.ReplicaStatus) error) *MockStorageUpdateAIPReplicaStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateAIPStatus mocks base method.
 func (m *MockStorage) UpdateAIPStatus(ctx context.Context, aipID uuid.UUID, status enums.AIPStatus) error {
 	m.ctrl.T.Helper()
@@ -1,17 +1,36 @@
 package persistence
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 )
 
+// AIPScope limits the AIPs to the ones stored in any of the given locations.
+// It's used to apply the location scoped attributes of the user, a nil scope
+// doesn't limit the AIPs.
+type AIPScope struct {
+	LocationIDs []uuid.UUID
+}
+
 type DeletionRequestFilter struct {
 	AIPUUID *uuid.UUID
 	Status  *enums.DeletionRequestStatus
 }
 
+// DisposalFilter selects the stored AIPs that are past their disposal date.
+type DisposalFilter struct {
+	// DueBy selects the AIPs with a disposal date before or equal to it.
+	DueBy time.Time
+
+	// Limit is the maximum number of AIPs returned.
+	Limit int
+}
+
 type WorkflowFilter struct {
+	UUID    *uuid.UUID
 	AIPUUID *uuid.UUID
 	Status  *enums.WorkflowStatus
 	Type    *enums.WorkflowType
@@ -3,13 +3,21 @@ package persistence
 import (
 	"embed"
 
+	"entgo.io/ent/dialect"
 	"github.com/golang-migrate/migrate/v4/source"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 )
 
-//go:embed migrations/*.sql
+//go:embed migrations/*.sql migrations/postgres/*.sql
 var fs embed.FS
 
-func SourceDriver() (source.Driver, error) {
+// SourceDriver returns the migration sources of the given database driver.
+// PostgreSQL has its own set of migrations, all other drivers use the MySQL
+// migrations.
+func SourceDriver(driver string) (source.Driver, error) {
+	if driver == dialect.Postgres {
+		return iofs.New(fs, "migrations/postgres")
+	}
+
 	return iofs.New(fs, "migrations")
 }
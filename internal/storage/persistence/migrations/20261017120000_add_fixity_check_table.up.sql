@@ -0,0 +1,6 @@
+-- modify "aip" table
+ALTER TABLE `aip` ADD COLUMN `checksum_algorithm` varchar(32) NULL, ADD COLUMN `checksum_hash` varchar(256) NULL;
+-- modify "workflow" table
+ALTER TABLE `workflow` MODIFY COLUMN `type` enum('unspecified','upload aip','move aip','delete aip','audit aip') NOT NULL;
+-- create "fixity_check" table
+CREATE TABLE `fixity_check` (`id` bigint NOT NULL AUTO_INCREMENT, `uuid` char(36) NOT NULL, `checksum_algorithm` varchar(32) NOT NULL, `expected_checksum` varchar(256) NOT NULL, `actual_checksum` varchar(256) NOT NULL, `status` enum('passed','failed') NOT NULL, `checked_at` timestamp NOT NULL, `aip_id` bigint NOT NULL, `workflow_id` bigint NULL, PRIMARY KEY (`id`), INDEX `fixity_check_workflow_fixity_checks` (`workflow_id`), INDEX `fixitycheck_aip_id_checked_at` (`aip_id`, `checked_at`), INDEX `fixitycheck_uuid` (`uuid`), UNIQUE INDEX `uuid` (`uuid`), CONSTRAINT `fixity_check_aip_fixity_checks` FOREIGN KEY (`aip_id`) REFERENCES `aip` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `fixity_check_workflow_fixity_checks` FOREIGN KEY (`workflow_id`) REFERENCES `workflow` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
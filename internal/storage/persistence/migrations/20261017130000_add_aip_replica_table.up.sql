@@ -0,0 +1,6 @@
+-- modify "workflow" table
+ALTER TABLE `workflow` MODIFY COLUMN `type` enum('unspecified','upload aip','move aip','delete aip','audit aip','replicate aip') NOT NULL;
+-- create "aip_replica" table
+CREATE TABLE `aip_replica` (`id` bigint NOT NULL AUTO_INCREMENT, `status` enum('pending','replicated','failed') NOT NULL, `created_at` timestamp NOT NULL, `replicated_at` timestamp NULL, `aip_id` bigint NOT NULL, `location_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `aip_replica_location_replicas` (`location_id`), UNIQUE INDEX `aipreplica_aip_id_location_id` (`aip_id`, `location_id`), CONSTRAINT `aip_replica_aip_replicas` FOREIGN KEY (`aip_id`) REFERENCES `aip` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `aip_replica_location_replicas` FOREIGN KEY (`location_id`) REFERENCES `location` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "location_replication_targets" table
+CREATE TABLE `location_replication_targets` (`location_id` bigint NOT NULL, `replication_target_id` bigint NOT NULL, PRIMARY KEY (`location_id`, `replication_target_id`), INDEX `location_replication_targets_replication_target_id` (`replication_target_id`), CONSTRAINT `location_replication_targets_location_id` FOREIGN KEY (`location_id`) REFERENCES `location` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `location_replication_targets_replication_target_id` FOREIGN KEY (`replication_target_id`) REFERENCES `location` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
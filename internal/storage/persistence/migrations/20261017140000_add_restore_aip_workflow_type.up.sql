@@ -0,0 +1,2 @@
+-- modify "workflow" table
+ALTER TABLE `workflow` MODIFY COLUMN `type` enum('unspecified','upload aip','move aip','delete aip','audit aip','replicate aip','restore aip') NOT NULL;
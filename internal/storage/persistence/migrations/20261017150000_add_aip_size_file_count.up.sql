@@ -0,0 +1,2 @@
+-- modify "aip" table
+ALTER TABLE `aip` ADD COLUMN `size` bigint NULL, ADD COLUMN `file_count` bigint NULL;
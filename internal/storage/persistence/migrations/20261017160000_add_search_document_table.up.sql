@@ -0,0 +1,4 @@
+-- create "search_document" table
+CREATE TABLE `search_document` (`id` bigint NOT NULL AUTO_INCREMENT, `uuid` char(36) NOT NULL, `name` varchar(2048) NOT NULL, `content` longtext NOT NULL, `fields` json NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), FULLTEXT INDEX `search_document_fulltext_idx` (`name`, `content`), UNIQUE INDEX `uuid` (`uuid`)) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- index existing AIPs
+INSERT INTO `search_document` (`uuid`, `name`, `content`, `updated_at`) SELECT `aip_id`, `name`, '', CURRENT_TIMESTAMP FROM `aip`;
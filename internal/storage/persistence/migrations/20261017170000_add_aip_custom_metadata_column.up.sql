@@ -0,0 +1,2 @@
+-- modify "aip" table
+ALTER TABLE `aip` ADD COLUMN `custom_metadata` json NULL;
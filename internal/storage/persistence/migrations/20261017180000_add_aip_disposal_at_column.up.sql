@@ -0,0 +1,2 @@
+-- modify "aip" table
+ALTER TABLE `aip` ADD COLUMN `disposal_at` timestamp NULL, ADD INDEX `aip_disposal_at` (`disposal_at`);
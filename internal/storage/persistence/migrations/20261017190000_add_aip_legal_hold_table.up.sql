@@ -0,0 +1,2 @@
+-- create "aip_legal_hold" table
+CREATE TABLE `aip_legal_hold` (`id` bigint NOT NULL AUTO_INCREMENT, `uuid` char(36) NOT NULL, `reason` varchar(2048) NOT NULL, `placed_by` varchar(1024) NOT NULL, `placed_at` timestamp NOT NULL, `released_by` varchar(1024) NULL, `release_reason` varchar(2048) NULL, `released_at` timestamp NULL, `aip_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `aiplegalhold_aip_id_released_at` (`aip_id`, `released_at`), INDEX `aiplegalhold_uuid` (`uuid`), UNIQUE INDEX `uuid` (`uuid`), CONSTRAINT `aip_legal_hold_aip_legal_holds` FOREIGN KEY (`aip_id`) REFERENCES `aip` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,4 @@
+-- modify "deletion_request" table
+ALTER TABLE `deletion_request` ADD COLUMN `required_approvals` bigint NOT NULL DEFAULT 1;
+-- create "deletion_request_approval" table
+CREATE TABLE `deletion_request_approval` (`id` bigint NOT NULL AUTO_INCREMENT, `approver` varchar(1024) NOT NULL, `approver_iss` varchar(1024) NOT NULL, `approver_sub` varchar(1024) NOT NULL, `approved_at` timestamp NOT NULL, `deletion_request_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `deletionrequestapproval_deletion_request_id` (`deletion_request_id`), CONSTRAINT `deletion_request_approval_deletion_request_approvals` FOREIGN KEY (`deletion_request_id`) REFERENCES `deletion_request` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- modify "fixity_check" table
+ALTER TABLE `fixity_check` MODIFY COLUMN `status` enum('passed','failed','baseline') NOT NULL;
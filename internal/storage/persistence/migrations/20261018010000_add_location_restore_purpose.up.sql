@@ -0,0 +1,2 @@
+-- modify "location" table
+ALTER TABLE `location` MODIFY COLUMN `purpose` enum('unspecified','aip_store','restore') NOT NULL;
@@ -0,0 +1,6 @@
+-- modify "aip_legal_hold" table
+ALTER TABLE `aip_legal_hold` ADD COLUMN `active_aip_id` bigint NULL;
+-- backfill the oldest active legal hold of each AIP
+UPDATE `aip_legal_hold` AS `h` JOIN (SELECT MIN(`id`) AS `id` FROM `aip_legal_hold` WHERE `released_at` IS NULL GROUP BY `aip_id`) AS `a` ON `h`.`id` = `a`.`id` SET `h`.`active_aip_id` = `h`.`aip_id`;
+-- modify "aip_legal_hold" table
+ALTER TABLE `aip_legal_hold` ADD UNIQUE INDEX `active_aip_id` (`active_aip_id`);
@@ -0,0 +1,200 @@
+-- enable trigram matching, used by the search document index
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+-- create "location" table
+CREATE TABLE "location" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "name" character varying NOT NULL,
+  "description" character varying NOT NULL,
+  "source" character varying NOT NULL,
+  "purpose" character varying NOT NULL,
+  "uuid" uuid NOT NULL,
+  "config" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "location_uuid_key" to table: "location"
+CREATE UNIQUE INDEX "location_uuid_key" ON "location" ("uuid");
+-- create index "location_name" to table: "location"
+CREATE INDEX "location_name" ON "location" ("name");
+-- create index "location_uuid" to table: "location"
+CREATE INDEX "location_uuid" ON "location" ("uuid");
+-- create "search_document" table
+CREATE TABLE "search_document" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "content" text NOT NULL,
+  "fields" jsonb NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "search_document_uuid_key" to table: "search_document"
+CREATE UNIQUE INDEX "search_document_uuid_key" ON "search_document" ("uuid");
+-- create index "search_document_fulltext_idx" to table: "search_document"
+CREATE INDEX "search_document_fulltext_idx" ON "search_document" USING GIN ("name" gin_trgm_ops, "content" gin_trgm_ops);
+-- create "aip" table
+CREATE TABLE "aip" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "name" character varying NOT NULL,
+  "aip_id" uuid NOT NULL,
+  "status" character varying NOT NULL,
+  "object_key" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "deletion_report_key" character varying NULL,
+  "checksum_algorithm" character varying NULL,
+  "checksum_hash" character varying NULL,
+  "size" bigint NULL,
+  "file_count" bigint NULL,
+  "custom_metadata" jsonb NULL,
+  "disposal_at" timestamptz NULL,
+  "location_id" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "aip_location_location" FOREIGN KEY ("location_id") REFERENCES "location" ("id") ON DELETE SET NULL
+);
+-- create index "aip_aip_id_key" to table: "aip"
+CREATE UNIQUE INDEX "aip_aip_id_key" ON "aip" ("aip_id");
+-- create index "aip_object_key_key" to table: "aip"
+CREATE UNIQUE INDEX "aip_object_key_key" ON "aip" ("object_key");
+-- create index "aip_aip_id" to table: "aip"
+CREATE INDEX "aip_aip_id" ON "aip" ("aip_id");
+-- create index "aip_object_key" to table: "aip"
+CREATE INDEX "aip_object_key" ON "aip" ("object_key");
+-- create index "aip_disposal_at" to table: "aip"
+CREATE INDEX "aip_disposal_at" ON "aip" ("disposal_at");
+-- create "aip_legal_hold" table
+CREATE TABLE "aip_legal_hold" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "reason" character varying NOT NULL,
+  "placed_by" character varying NOT NULL,
+  "placed_at" timestamptz NOT NULL,
+  "released_by" character varying NULL,
+  "release_reason" character varying NULL,
+  "released_at" timestamptz NULL,
+  "aip_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "aip_legal_hold_aip_legal_holds" FOREIGN KEY ("aip_id") REFERENCES "aip" ("id") ON DELETE CASCADE
+);
+-- create index "aip_legal_hold_uuid_key" to table: "aip_legal_hold"
+CREATE UNIQUE INDEX "aip_legal_hold_uuid_key" ON "aip_legal_hold" ("uuid");
+-- create index "aiplegalhold_uuid" to table: "aip_legal_hold"
+CREATE INDEX "aiplegalhold_uuid" ON "aip_legal_hold" ("uuid");
+-- create index "aiplegalhold_aip_id_released_at" to table: "aip_legal_hold"
+CREATE INDEX "aiplegalhold_aip_id_released_at" ON "aip_legal_hold" ("aip_id", "released_at");
+-- create "aip_replica" table
+CREATE TABLE "aip_replica" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "status" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "replicated_at" timestamptz NULL,
+  "aip_id" bigint NOT NULL,
+  "location_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "aip_replica_aip_replicas" FOREIGN KEY ("aip_id") REFERENCES "aip" ("id") ON DELETE CASCADE,
+  CONSTRAINT "aip_replica_location_replicas" FOREIGN KEY ("location_id") REFERENCES "location" ("id") ON DELETE CASCADE
+);
+-- create index "aipreplica_aip_id_location_id" to table: "aip_replica"
+CREATE UNIQUE INDEX "aipreplica_aip_id_location_id" ON "aip_replica" ("aip_id", "location_id");
+-- create "workflow" table
+CREATE TABLE "workflow" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "temporal_id" character varying NOT NULL,
+  "type" character varying NOT NULL,
+  "status" character varying NOT NULL,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "aip_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "workflow_aip_workflows" FOREIGN KEY ("aip_id") REFERENCES "aip" ("id") ON DELETE CASCADE
+);
+-- create index "workflow_uuid_key" to table: "workflow"
+CREATE UNIQUE INDEX "workflow_uuid_key" ON "workflow" ("uuid");
+-- create index "workflow_uuid" to table: "workflow"
+CREATE INDEX "workflow_uuid" ON "workflow" ("uuid");
+-- create "deletion_request" table
+CREATE TABLE "deletion_request" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "requester" character varying NOT NULL,
+  "requester_iss" character varying NOT NULL,
+  "requester_sub" character varying NOT NULL,
+  "reviewer" character varying NULL,
+  "reviewer_iss" character varying NULL,
+  "reviewer_sub" character varying NULL,
+  "reason" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "requested_at" timestamptz NOT NULL,
+  "reviewed_at" timestamptz NULL,
+  "required_approvals" bigint NOT NULL DEFAULT 1,
+  "aip_id" bigint NOT NULL,
+  "workflow_id" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "deletion_request_aip_deletion_requests" FOREIGN KEY ("aip_id") REFERENCES "aip" ("id") ON DELETE CASCADE,
+  CONSTRAINT "deletion_request_workflow_deletion_request" FOREIGN KEY ("workflow_id") REFERENCES "workflow" ("id") ON DELETE SET NULL
+);
+-- create index "deletion_request_uuid_key" to table: "deletion_request"
+CREATE UNIQUE INDEX "deletion_request_uuid_key" ON "deletion_request" ("uuid");
+-- create index "deletion_request_workflow_id_key" to table: "deletion_request"
+CREATE UNIQUE INDEX "deletion_request_workflow_id_key" ON "deletion_request" ("workflow_id");
+-- create index "deletionrequest_uuid" to table: "deletion_request"
+CREATE INDEX "deletionrequest_uuid" ON "deletion_request" ("uuid");
+-- create "deletion_request_approval" table
+CREATE TABLE "deletion_request_approval" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "approver" character varying NOT NULL,
+  "approver_iss" character varying NOT NULL,
+  "approver_sub" character varying NOT NULL,
+  "approved_at" timestamptz NOT NULL,
+  "deletion_request_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "deletion_request_approval_deletion_request_approvals" FOREIGN KEY ("deletion_request_id") REFERENCES "deletion_request" ("id") ON DELETE CASCADE
+);
+-- create index "deletionrequestapproval_deletion_request_id" to table: "deletion_request_approval"
+CREATE INDEX "deletionrequestapproval_deletion_request_id" ON "deletion_request_approval" ("deletion_request_id");
+-- create "fixity_check" table
+CREATE TABLE "fixity_check" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "checksum_algorithm" character varying NOT NULL,
+  "expected_checksum" character varying NOT NULL,
+  "actual_checksum" character varying NOT NULL,
+  "status" character varying NOT NULL,
+  "checked_at" timestamptz NOT NULL,
+  "aip_id" bigint NOT NULL,
+  "workflow_id" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fixity_check_aip_fixity_checks" FOREIGN KEY ("aip_id") REFERENCES "aip" ("id") ON DELETE CASCADE,
+  CONSTRAINT "fixity_check_workflow_fixity_checks" FOREIGN KEY ("workflow_id") REFERENCES "workflow" ("id") ON DELETE SET NULL
+);
+-- create index "fixity_check_uuid_key" to table: "fixity_check"
+CREATE UNIQUE INDEX "fixity_check_uuid_key" ON "fixity_check" ("uuid");
+-- create index "fixitycheck_uuid" to table: "fixity_check"
+CREATE INDEX "fixitycheck_uuid" ON "fixity_check" ("uuid");
+-- create index "fixitycheck_aip_id_checked_at" to table: "fixity_check"
+CREATE INDEX "fixitycheck_aip_id_checked_at" ON "fixity_check" ("aip_id", "checked_at");
+-- create "location_replication_targets" table
+CREATE TABLE "location_replication_targets" (
+  "location_id" bigint NOT NULL,
+  "replication_target_id" bigint NOT NULL,
+  PRIMARY KEY ("location_id", "replication_target_id"),
+  CONSTRAINT "location_replication_targets_location_id" FOREIGN KEY ("location_id") REFERENCES "location" ("id") ON DELETE CASCADE,
+  CONSTRAINT "location_replication_targets_replication_target_id" FOREIGN KEY ("replication_target_id") REFERENCES "location" ("id") ON DELETE CASCADE
+);
+-- create "task" table
+CREATE TABLE "task" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "uuid" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "status" character varying NOT NULL,
+  "started_at" timestamptz NULL,
+  "completed_at" timestamptz NULL,
+  "note" text NOT NULL,
+  "workflow_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "task_workflow_tasks" FOREIGN KEY ("workflow_id") REFERENCES "workflow" ("id") ON DELETE CASCADE
+);
+-- create index "task_uuid_key" to table: "task"
+CREATE UNIQUE INDEX "task_uuid_key" ON "task" ("uuid");
+-- create index "task_uuid" to table: "task"
+CREATE INDEX "task_uuid" ON "task" ("uuid");
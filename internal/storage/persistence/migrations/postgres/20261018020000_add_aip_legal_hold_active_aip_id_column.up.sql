@@ -0,0 +1,6 @@
+-- modify "aip_legal_hold" table
+ALTER TABLE "aip_legal_hold" ADD COLUMN "active_aip_id" bigint NULL;
+-- backfill the oldest active legal hold of each AIP
+UPDATE "aip_legal_hold" SET "active_aip_id" = "aip_id" WHERE "id" IN (SELECT MIN("id") FROM "aip_legal_hold" WHERE "released_at" IS NULL GROUP BY "aip_id");
+-- create index "aip_legal_hold_active_aip_id_key" to table: "aip_legal_hold"
+CREATE UNIQUE INDEX "aip_legal_hold_active_aip_id_key" ON "aip_legal_hold" ("active_aip_id");
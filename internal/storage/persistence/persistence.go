@@ -2,14 +2,25 @@ package persistence
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
 )
 
+var (
+	// ErrActiveAIPLegalHold is returned when placing a legal hold on an AIP
+	// that is already under an active legal hold.
+	ErrActiveAIPLegalHold = errors.New("AIP is already under legal hold")
+	// ErrNoActiveAIPLegalHold is returned when releasing the legal hold of an
+	// AIP that isn't under an active legal hold.
+	ErrNoActiveAIPLegalHold = errors.New("AIP is not under legal hold")
+)
+
 type (
 	AIPUpdater             func(*types.AIP) (*types.AIP, error)
 	WorkflowUpdater        func(*types.Workflow) (*types.Workflow, error)
@@ -20,7 +31,8 @@ type (
 type Storage interface {
 	// AIP.
 	CreateAIP(ctx context.Context, aip *goastorage.AIP) (*goastorage.AIP, error)
-	ListAIPs(ctx context.Context, payload *goastorage.ListAipsPayload) (*goastorage.AIPs, error)
+	ListAIPs(ctx context.Context, payload *goastorage.ListAipsPayload, scope *AIPScope) (*goastorage.AIPs, error)
+	ListDisposableAIPs(ctx context.Context, f *DisposalFilter) ([]*types.AIP, error)
 	ReadAIP(ctx context.Context, aipID uuid.UUID) (*goastorage.AIP, error)
 	// TODO: normalize type usage between *goastorage.AIP and *types.AIP.
 	// For now, we return both types from this method to minimize changes and be able to publish an event
@@ -40,6 +52,7 @@ type Storage interface {
 	ListLocations(ctx context.Context) (goastorage.LocationCollection, error)
 	ReadLocation(ctx context.Context, locationID uuid.UUID) (*goastorage.Location, error)
 	LocationAIPs(ctx context.Context, locationID uuid.UUID) (goastorage.AIPCollection, error)
+	LocationUsage(ctx context.Context, locationID uuid.UUID) (*types.LocationUsage, error)
 
 	// Workflow.
 	CreateWorkflow(context.Context, *types.Workflow) error
@@ -56,4 +69,24 @@ type Storage interface {
 	ListDeletionRequests(context.Context, *DeletionRequestFilter) ([]*types.DeletionRequest, error)
 	UpdateDeletionRequest(context.Context, int, DeletionRequestUpdater) (*types.DeletionRequest, error)
 	ReadDeletionRequest(context.Context, uuid.UUID) (*types.DeletionRequest, error)
+	CreateDeletionRequestApproval(context.Context, *types.DeletionRequestApproval) error
+	ListDeletionRequestApprovals(ctx context.Context, deletionRequestDBID int) ([]*types.DeletionRequestApproval, error)
+
+	// FixityCheck.
+	CreateFixityCheck(context.Context, *types.FixityCheck) error
+
+	// AIPReplica.
+	CreateAIPReplica(context.Context, *types.AIPReplica) error
+	UpdateAIPReplicaStatus(ctx context.Context, aipID, locationID uuid.UUID, status enums.ReplicaStatus) error
+	DeleteAIPReplica(ctx context.Context, aipID, locationID uuid.UUID) error
+
+	// AIPLegalHold.
+	CreateAIPLegalHold(context.Context, *types.AIPLegalHold) error
+	HasActiveAIPLegalHold(ctx context.Context, aipID uuid.UUID) (bool, error)
+	ReleaseAIPLegalHold(ctx context.Context, aipID uuid.UUID, releasedBy, reason string) error
+
+	// SearchDocument.
+	IndexSearchDocument(context.Context, *search.Document) error
+	DeleteSearchDocument(context.Context, uuid.UUID) error
+	SearchDocuments(context.Context, *search.Query) ([]*search.Result, int, error)
 }
@@ -8,6 +8,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
 	"github.com/artefactual-sdps/enduro/internal/telemetry"
@@ -46,11 +47,15 @@ func (w *wrapper) CreateAIP(ctx context.Context, aip *goastorage.AIP) (*goastora
 	return r, nil
 }
 
-func (w *wrapper) ListAIPs(ctx context.Context, payload *goastorage.ListAipsPayload) (*goastorage.AIPs, error) {
+func (w *wrapper) ListAIPs(
+	ctx context.Context,
+	payload *goastorage.ListAipsPayload,
+	scope *AIPScope,
+) (*goastorage.AIPs, error) {
 	ctx, span := w.tracer.Start(ctx, "ListAIPs")
 	defer span.End()
 
-	r, err := w.wrapped.ListAIPs(ctx, payload)
+	r, err := w.wrapped.ListAIPs(ctx, payload, scope)
 	if err != nil {
 		telemetry.RecordError(span, err)
 		return nil, updateError(err, "ListAIPs")
@@ -59,6 +64,19 @@ func (w *wrapper) ListAIPs(ctx context.Context, payload *goastorage.ListAipsPayl
 	return r, nil
 }
 
+func (w *wrapper) ListDisposableAIPs(ctx context.Context, f *DisposalFilter) ([]*types.AIP, error) {
+	ctx, span := w.tracer.Start(ctx, "ListDisposableAIPs")
+	defer span.End()
+
+	r, err := w.wrapped.ListDisposableAIPs(ctx, f)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, updateError(err, "ListDisposableAIPs")
+	}
+
+	return r, nil
+}
+
 func (w *wrapper) ReadAIP(ctx context.Context, aipID uuid.UUID) (*goastorage.AIP, error) {
 	ctx, span := w.tracer.Start(ctx, "ReadAIP")
 	defer span.End()
@@ -184,6 +202,19 @@ func (w *wrapper) LocationAIPs(ctx context.Context, locationID uuid.UUID) (goast
 	return r, nil
 }
 
+func (w *wrapper) LocationUsage(ctx context.Context, locationID uuid.UUID) (*types.LocationUsage, error) {
+	ctx, span := w.tracer.Start(ctx, "LocationUsage")
+	defer span.End()
+
+	r, err := w.wrapped.LocationUsage(ctx, locationID)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, updateError(err, "LocationUsage")
+	}
+
+	return r, nil
+}
+
 func (w *wrapper) CreateWorkflow(ctx context.Context, workflow *types.Workflow) error {
 	ctx, span := w.tracer.Start(ctx, "CreateWorkflow")
 	defer span.End()
@@ -310,3 +341,166 @@ func (w *wrapper) ReadDeletionRequest(
 
 	return r, nil
 }
+
+func (w *wrapper) CreateDeletionRequestApproval(ctx context.Context, a *types.DeletionRequestApproval) error {
+	ctx, span := w.tracer.Start(ctx, "CreateDeletionRequestApproval")
+	defer span.End()
+
+	err := w.wrapped.CreateDeletionRequestApproval(ctx, a)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "CreateDeletionRequestApproval")
+	}
+
+	return nil
+}
+
+func (w *wrapper) ListDeletionRequestApprovals(
+	ctx context.Context,
+	deletionRequestDBID int,
+) ([]*types.DeletionRequestApproval, error) {
+	ctx, span := w.tracer.Start(ctx, "ListDeletionRequestApprovals")
+	defer span.End()
+
+	r, err := w.wrapped.ListDeletionRequestApprovals(ctx, deletionRequestDBID)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, updateError(err, "ListDeletionRequestApprovals")
+	}
+
+	return r, nil
+}
+
+func (w *wrapper) CreateFixityCheck(ctx context.Context, fc *types.FixityCheck) error {
+	ctx, span := w.tracer.Start(ctx, "CreateFixityCheck")
+	defer span.End()
+
+	err := w.wrapped.CreateFixityCheck(ctx, fc)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "CreateFixityCheck")
+	}
+
+	return nil
+}
+
+func (w *wrapper) CreateAIPReplica(ctx context.Context, r *types.AIPReplica) error {
+	ctx, span := w.tracer.Start(ctx, "CreateAIPReplica")
+	defer span.End()
+
+	err := w.wrapped.CreateAIPReplica(ctx, r)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "CreateAIPReplica")
+	}
+
+	return nil
+}
+
+func (w *wrapper) DeleteAIPReplica(ctx context.Context, aipID, locationID uuid.UUID) error {
+	ctx, span := w.tracer.Start(ctx, "DeleteAIPReplica")
+	defer span.End()
+
+	err := w.wrapped.DeleteAIPReplica(ctx, aipID, locationID)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "DeleteAIPReplica")
+	}
+
+	return nil
+}
+
+func (w *wrapper) UpdateAIPReplicaStatus(
+	ctx context.Context,
+	aipID, locationID uuid.UUID,
+	status enums.ReplicaStatus,
+) error {
+	ctx, span := w.tracer.Start(ctx, "UpdateAIPReplicaStatus")
+	defer span.End()
+
+	err := w.wrapped.UpdateAIPReplicaStatus(ctx, aipID, locationID, status)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "UpdateAIPReplicaStatus")
+	}
+
+	return nil
+}
+
+func (w *wrapper) CreateAIPLegalHold(ctx context.Context, h *types.AIPLegalHold) error {
+	ctx, span := w.tracer.Start(ctx, "CreateAIPLegalHold")
+	defer span.End()
+
+	err := w.wrapped.CreateAIPLegalHold(ctx, h)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "CreateAIPLegalHold")
+	}
+
+	return nil
+}
+
+func (w *wrapper) HasActiveAIPLegalHold(ctx context.Context, aipID uuid.UUID) (bool, error) {
+	ctx, span := w.tracer.Start(ctx, "HasActiveAIPLegalHold")
+	defer span.End()
+
+	ok, err := w.wrapped.HasActiveAIPLegalHold(ctx, aipID)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return false, updateError(err, "HasActiveAIPLegalHold")
+	}
+
+	return ok, nil
+}
+
+func (w *wrapper) ReleaseAIPLegalHold(ctx context.Context, aipID uuid.UUID, releasedBy, reason string) error {
+	ctx, span := w.tracer.Start(ctx, "ReleaseAIPLegalHold")
+	defer span.End()
+
+	err := w.wrapped.ReleaseAIPLegalHold(ctx, aipID, releasedBy, reason)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "ReleaseAIPLegalHold")
+	}
+
+	return nil
+}
+
+func (w *wrapper) IndexSearchDocument(ctx context.Context, doc *search.Document) error {
+	ctx, span := w.tracer.Start(ctx, "IndexSearchDocument")
+	defer span.End()
+
+	err := w.wrapped.IndexSearchDocument(ctx, doc)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "IndexSearchDocument")
+	}
+
+	return nil
+}
+
+func (w *wrapper) DeleteSearchDocument(ctx context.Context, id uuid.UUID) error {
+	ctx, span := w.tracer.Start(ctx, "DeleteSearchDocument")
+	defer span.End()
+
+	err := w.wrapped.DeleteSearchDocument(ctx, id)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return updateError(err, "DeleteSearchDocument")
+	}
+
+	return nil
+}
+
+func (w *wrapper) SearchDocuments(ctx context.Context, q *search.Query) ([]*search.Result, int, error) {
+	ctx, span := w.tracer.Start(ctx, "SearchDocuments")
+	defer span.End()
+
+	r, total, err := w.wrapped.SearchDocuments(ctx, q)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, 0, updateError(err, "SearchDocuments")
+	}
+
+	return r, total, nil
+}
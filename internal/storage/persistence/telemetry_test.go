@@ -569,3 +569,192 @@ func TestReadDeletionRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateFixityCheck(t *testing.T) {
+	t.Parallel()
+
+	fcID := uuid.New()
+	aipID := uuid.New()
+	checkedAt := time.Now().Truncate(time.Second)
+
+	type test struct {
+		name    string
+		params  *types.FixityCheck
+		mock    func(*fake.MockStorage)
+		wantErr string
+	}
+	for _, tc := range []test{
+		{
+			name: "Creates a fixity check successfully",
+			params: &types.FixityCheck{
+				UUID:              fcID,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "abc123",
+				Status:            enums.FixityCheckStatusPassed,
+				CheckedAt:         checkedAt,
+				AIPUUID:           aipID,
+			},
+			mock: func(svc *fake.MockStorage) {
+				svc.EXPECT().
+					CreateFixityCheck(
+						mockutil.Context(),
+						&types.FixityCheck{
+							UUID:              fcID,
+							ChecksumAlgorithm: "sha256",
+							ExpectedChecksum:  "abc123",
+							ActualChecksum:    "abc123",
+							Status:            enums.FixityCheckStatusPassed,
+							CheckedAt:         checkedAt,
+							AIPUUID:           aipID,
+						},
+					).
+					Return(nil)
+			},
+		},
+		{
+			name: "Errors when creating a fixity check",
+			params: &types.FixityCheck{
+				UUID:   fcID,
+				Status: enums.FixityCheckStatusPassed,
+			},
+			mock: func(svc *fake.MockStorage) {
+				svc.EXPECT().
+					CreateFixityCheck(
+						mockutil.Context(),
+						&types.FixityCheck{
+							UUID:   fcID,
+							Status: enums.FixityCheckStatusPassed,
+						},
+					).
+					Return(errors.New("create fixity check: db: aip not found"))
+			},
+			wantErr: "CreateFixityCheck: create fixity check: db: aip not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := fake.NewMockStorage(gomock.NewController(t))
+			if tc.mock != nil {
+				tc.mock(svc)
+			}
+
+			tracer := noop.NewTracerProvider().Tracer("test")
+			w := persistence.WithTelemetry(svc, tracer)
+
+			err := w.CreateFixityCheck(t.Context(), tc.params)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestCreateAIPReplica(t *testing.T) {
+	t.Parallel()
+
+	r := &types.AIPReplica{
+		AIPUUID:      uuid.New(),
+		LocationUUID: uuid.New(),
+		Status:       enums.ReplicaStatusPending,
+	}
+
+	type test struct {
+		name    string
+		mock    func(*fake.MockStorage)
+		wantErr string
+	}
+	for _, tc := range []test{
+		{
+			name: "Creates an AIP replica successfully",
+			mock: func(svc *fake.MockStorage) {
+				svc.EXPECT().CreateAIPReplica(mockutil.Context(), r).Return(nil)
+			},
+		},
+		{
+			name: "Errors when creating an AIP replica",
+			mock: func(svc *fake.MockStorage) {
+				svc.EXPECT().
+					CreateAIPReplica(mockutil.Context(), r).
+					Return(errors.New("create AIP replica: db: aip not found"))
+			},
+			wantErr: "CreateAIPReplica: create AIP replica: db: aip not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := fake.NewMockStorage(gomock.NewController(t))
+			if tc.mock != nil {
+				tc.mock(svc)
+			}
+
+			tracer := noop.NewTracerProvider().Tracer("test")
+			w := persistence.WithTelemetry(svc, tracer)
+
+			err := w.CreateAIPReplica(t.Context(), r)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestUpdateAIPReplicaStatus(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.New()
+	locationID := uuid.New()
+
+	type test struct {
+		name    string
+		mock    func(*fake.MockStorage)
+		wantErr string
+	}
+	for _, tc := range []test{
+		{
+			name: "Updates an AIP replica status successfully",
+			mock: func(svc *fake.MockStorage) {
+				svc.EXPECT().
+					UpdateAIPReplicaStatus(mockutil.Context(), aipID, locationID, enums.ReplicaStatusReplicated).
+					Return(nil)
+			},
+		},
+		{
+			name: "Errors when updating an AIP replica status",
+			mock: func(svc *fake.MockStorage) {
+				svc.EXPECT().
+					UpdateAIPReplicaStatus(mockutil.Context(), aipID, locationID, enums.ReplicaStatusReplicated).
+					Return(errors.New("update AIP replica status: update operation had unexpected results"))
+			},
+			wantErr: "UpdateAIPReplicaStatus: update AIP replica status: update operation had unexpected results",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := fake.NewMockStorage(gomock.NewController(t))
+			if tc.mock != nil {
+				tc.mock(svc)
+			}
+
+			tracer := noop.NewTracerProvider().Tracer("test")
+			w := persistence.WithTelemetry(svc, tracer)
+
+			err := w.UpdateAIPReplicaStatus(t.Context(), aipID, locationID, enums.ReplicaStatusReplicated)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/auditlog"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+// RestoreAips starts a restore workflow for each of the requested AIPs, which
+// copies the AIP to the restore location and deletes the restored copy when
+// the configured TTL expires. The restore location is the requested location,
+// which must have the restore purpose, or, if none is given, the configured
+// restore bucket.
+func (s *serviceImpl) RestoreAips(ctx context.Context, payload *goastorage.RestoreAipsPayload) error {
+	var locationID uuid.UUID
+	if payload.LocationUUID != nil {
+		loc, err := s.ReadLocation(ctx, *payload.LocationUUID)
+		if err != nil {
+			var notFound *goastorage.LocationNotFound
+			if errors.As(err, &notFound) {
+				return goastorage.MakeNotValid(errors.New("restore location not found"))
+			}
+			return err
+		}
+		if loc.Source == enums.LocationSourceAmss.String() {
+			return goastorage.MakeNotValid(errors.New("AIPs can't be restored to an AMSS location"))
+		}
+		if loc.Purpose != enums.LocationPurposeRestore.String() {
+			return goastorage.MakeNotValid(errors.New("restore location must have the restore purpose"))
+		}
+		locationID = loc.UUID
+	} else if s.config.Restore.Bucket == nil {
+		return goastorage.MakeNotValid(errors.New("restore location not configured"))
+	}
+
+	aips := make([]*goastorage.AIP, 0, len(payload.AipUuids))
+	for _, id := range payload.AipUuids {
+		aipID, err := uuid.Parse(id)
+		if err != nil {
+			return goastorage.MakeNotValid(errors.New("invalid AIP UUID"))
+		}
+
+		aip, err := s.ReadAip(ctx, aipID)
+		if err != nil {
+			return err
+		}
+		if aip.Status != enums.AIPStatusStored.String() {
+			return goastorage.MakeNotValid(errors.New("AIP is not available for restore"))
+		}
+
+		aips = append(aips, aip)
+	}
+
+	var userEmail string
+	if claims := auth.UserClaimsFromContext(ctx); claims != nil {
+		userEmail = claims.Email
+	}
+
+	var unpack bool
+	if payload.Unpack != nil {
+		unpack = *payload.Unpack
+	}
+
+	restoreID := uuid.Must(uuid.NewRandomFromReader(s.rander))
+	for _, aip := range aips {
+		_, err := InitStorageRestoreWorkflow(ctx, s.tc, &StorageRestoreWorkflowRequest{
+			AIPID:      aip.UUID,
+			RestoreID:  restoreID,
+			LocationID: locationID,
+			Unpack:     unpack,
+			TTL:        s.config.Restore.TTL,
+			TaskQueue:  s.config.TaskQueue,
+		})
+		if err != nil {
+			s.logger.Error(err, "error initializing restore workflow", "AIP UUID", aip.UUID)
+			return goastorage.MakeNotAvailable(errors.New("cannot perform operation"))
+		}
+
+		s.auditLogger.Log(ctx, &auditlog.Event{
+			Level:      auditlog.LevelInfo,
+			Msg:        "AIP restore requested",
+			Type:       "AIP.restore",
+			ResourceID: aip.UUID.String(),
+			User:       userEmail,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package storage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.artefactual.dev/tools/bucket"
+	temporalapi_enums "go.temporal.io/api/enums/v1"
+	temporalsdk_client "go.temporal.io/sdk/client"
+	temporalsdk_mocks "go.temporal.io/sdk/mocks"
+	"go.uber.org/mock/gomock"
+	goa "goa.design/goa/v3/pkg"
+	"gotest.tools/v3/assert"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence/fake"
+)
+
+func TestServiceRestoreAips(t *testing.T) {
+	t.Parallel()
+
+	config := func(restore storage.RestoreConfig) *storage.Config {
+		return &storage.Config{
+			TaskQueue: "global",
+			Internal:  bucket.Config{URL: "mem://"},
+			Restore:   restore,
+		}
+	}
+	restoreConfig := storage.RestoreConfig{
+		Bucket: &bucket.Config{URL: "mem://"},
+		TTL:    time.Hour,
+	}
+
+	expectWorkflow := func(tc *temporalsdk_mocks.Client, locID uuid.UUID, err error) {
+		tc.On(
+			"ExecuteWorkflow",
+			mock.AnythingOfType("*context.timerCtx"),
+			temporalsdk_client.StartWorkflowOptions{
+				ID:                    "storage-restore-workflow-" + aipID.String() + "-" + uuid0.String(),
+				TaskQueue:             "global",
+				WorkflowIDReusePolicy: temporalapi_enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			},
+			"storage-restore-workflow",
+			&storage.StorageRestoreWorkflowRequest{
+				AIPID:      aipID,
+				RestoreID:  uuid0,
+				LocationID: locID,
+				Unpack:     true,
+				TTL:        time.Hour,
+				TaskQueue:  "global",
+			},
+		).Return(nil, err)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		config   *storage.Config
+		payload  *goastorage.RestoreAipsPayload
+		mock     func(context.Context, *fake.MockStorage, *temporalsdk_mocks.Client)
+		wantName string
+		wantErr  string
+	}{
+		{
+			name:     "Errors when no restore location is configured",
+			payload:  &goastorage.RestoreAipsPayload{AipUuids: []string{aipID.String()}},
+			wantName: "not_valid",
+			wantErr:  "restore location not configured",
+		},
+		{
+			name: "Errors when the restore location doesn't exist",
+			payload: &goastorage.RestoreAipsPayload{
+				AipUuids:     []string{aipID.String()},
+				LocationUUID: &locationID,
+			},
+			mock: func(ctx context.Context, ps *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				ps.EXPECT().
+					ReadLocation(gomock.AssignableToTypeOf(ctx), locationID).
+					Return(nil, &goastorage.LocationNotFound{UUID: locationID, Message: "location not found"})
+			},
+			wantName: "not_valid",
+			wantErr:  "restore location not found",
+		},
+		{
+			name: "Errors when the restore location is an AMSS location",
+			payload: &goastorage.RestoreAipsPayload{
+				AipUuids:     []string{aipID.String()},
+				LocationUUID: &locationID,
+			},
+			mock: func(ctx context.Context, ps *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				ps.EXPECT().
+					ReadLocation(gomock.AssignableToTypeOf(ctx), locationID).
+					Return(&goastorage.Location{UUID: locationID, Source: enums.LocationSourceAmss.String()}, nil)
+			},
+			wantName: "not_valid",
+			wantErr:  "AIPs can't be restored to an AMSS location",
+		},
+		{
+			name: "Errors when the restore location doesn't have the restore purpose",
+			payload: &goastorage.RestoreAipsPayload{
+				AipUuids:     []string{aipID.String()},
+				LocationUUID: &locationID,
+			},
+			mock: func(ctx context.Context, ps *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				ps.EXPECT().
+					ReadLocation(gomock.AssignableToTypeOf(ctx), locationID).
+					Return(&goastorage.Location{
+						UUID:    locationID,
+						Source:  enums.LocationSourceFilesystem.String(),
+						Purpose: enums.LocationPurposeAipStore.String(),
+					}, nil)
+			},
+			wantName: "not_valid",
+			wantErr:  "restore location must have the restore purpose",
+		},
+		{
+			name:    "Errors when the AIP isn't stored",
+			config:  config(restoreConfig),
+			payload: &goastorage.RestoreAipsPayload{AipUuids: []string{aipID.String()}},
+			mock: func(ctx context.Context, ps *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				ps.EXPECT().
+					ReadAIP(gomock.AssignableToTypeOf(ctx), aipID).
+					Return(&goastorage.AIP{UUID: aipID, Status: enums.AIPStatusDeleted.String()}, nil)
+			},
+			wantName: "not_valid",
+			wantErr:  "AIP is not available for restore",
+		},
+		{
+			name:   "Errors when the restore workflow can't be started",
+			config: config(restoreConfig),
+			payload: &goastorage.RestoreAipsPayload{
+				AipUuids: []string{aipID.String()},
+				Unpack:   new(true),
+			},
+			mock: func(ctx context.Context, ps *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				ps.EXPECT().
+					ReadAIP(gomock.AssignableToTypeOf(ctx), aipID).
+					Return(&goastorage.AIP{UUID: aipID, Status: enums.AIPStatusStored.String()}, nil)
+				expectWorkflow(tc, uuid.Nil, errors.New("something went wrong"))
+			},
+			wantName: "not_available",
+			wantErr:  "cannot perform operation",
+		},
+		{
+			name:   "Restores an AIP to the configured restore bucket",
+			config: config(restoreConfig),
+			payload: &goastorage.RestoreAipsPayload{
+				AipUuids: []string{aipID.String()},
+				Unpack:   new(true),
+			},
+			mock: func(ctx context.Context, ps *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				ps.EXPECT().
+					ReadAIP(gomock.AssignableToTypeOf(ctx), aipID).
+					Return(&goastorage.AIP{UUID: aipID, Status: enums.AIPStatusStored.String()}, nil)
+				expectWorkflow(tc, uuid.Nil, nil)
+			},
+		},
+		{
+			name:   "Restores an AIP to a location",
+			config: config(storage.RestoreConfig{TTL: time.Hour}),
+			payload: &goastorage.RestoreAipsPayload{
+				AipUuids:     []string{aipID.String()},
+				LocationUUID: &locationID,
+				Unpack:       new(true),
+			},
+			mock: func(ctx context.Context, ps *fake.MockStorage, tc *temporalsdk_mocks.Client) {
+				ps.EXPECT().
+					ReadLocation(gomock.AssignableToTypeOf(ctx), locationID).
+					Return(&goastorage.Location{
+						UUID:    locationID,
+						Source:  enums.LocationSourceFilesystem.String(),
+						Purpose: enums.LocationPurposeRestore.String(),
+					}, nil)
+				ps.EXPECT().
+					ReadAIP(gomock.AssignableToTypeOf(ctx), aipID).
+					Return(&goastorage.AIP{UUID: aipID, Status: enums.AIPStatusStored.String()}, nil)
+				expectWorkflow(tc, locationID, nil)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			attrs := &setUpAttrs{config: tt.config}
+			ctx := t.Context()
+			svc := setUpService(t, ctx, attrs)
+			if tt.mock != nil {
+				tt.mock(ctx, attrs.persistenceMock, attrs.temporalClientMock)
+			}
+
+			err := svc.RestoreAips(ctx, tt.payload)
+			if tt.wantErr != "" {
+				assert.Equal(t, err.(*goa.ServiceError).Name, tt.wantName)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			attrs.temporalClientMock.AssertExpectations(t)
+		})
+	}
+}
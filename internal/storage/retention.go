@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+	"github.com/artefactual-sdps/enduro/internal/storage/types"
+)
+
+// Requester of the AIP deletion requests created by the retention workflow.
+const (
+	RetentionRequesterName = "Enduro-retention"
+	RetentionRequesterSub  = "retention"
+	RetentionRequesterIss  = "enduro"
+)
+
+// AutoApproves reports whether the deletion requests created by the retention
+// workflow for the AIPs stored in a location are automatically approved.
+func (c RetentionConfig) AutoApproves(locationID *uuid.UUID) bool {
+	if locationID != nil {
+		if p, ok := c.Policy(*locationID); ok {
+			return p.AutoApprove
+		}
+	}
+
+	return c.AutoApprove
+}
+
+// parseDisposalDate parses a disposal date from a custom metadata value, as an
+// RFC 3339 timestamp or a "YYYY-MM-DD" date.
+func parseDisposalDate(v any) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("disposal date is not a string: %v", v)
+	}
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid disposal date: %q", s)
+	}
+
+	return t, nil
+}
+
+// disposalDate returns the disposal date of an AIP created at createdAt, taken
+// from its custom metadata or from the retention policy of its location. It
+// returns nil if the AIP isn't subject to any retention rule.
+func (s *serviceImpl) disposalDate(createdAt time.Time, locationID *uuid.UUID, md map[string]any) *time.Time {
+	cfg := s.config.Retention
+	if cfg.MetadataKey != "" {
+		if v, ok := md[cfg.MetadataKey]; ok {
+			t, err := parseDisposalDate(v)
+			if err == nil {
+				return &t
+			}
+			s.logger.Error(err, "Ignoring AIP custom metadata disposal date.", "key", cfg.MetadataKey)
+		}
+	}
+
+	if locationID != nil {
+		if p, ok := cfg.Policy(*locationID); ok {
+			t := createdAt.Add(p.Period)
+			return &t
+		}
+	}
+
+	return nil
+}
+
+// setLocationDisposalDate sets the disposal date of an AIP that doesn't have
+// one yet, following the retention policy of the location it's stored in.
+func (s *serviceImpl) setLocationDisposalDate(ctx context.Context, aipID, locationID uuid.UUID) error {
+	p, ok := s.config.Retention.Policy(locationID)
+	if !ok {
+		return nil
+	}
+
+	_, _, err := s.storagePersistence.UpdateAIP(ctx, aipID, func(a *types.AIP) (*types.AIP, error) {
+		if a.DisposalAt == nil {
+			a.DisposalAt = new(a.CreatedAt.Add(p.Period))
+		}
+		return a, nil
+	})
+
+	return err
+}
+
+// ListDisposableAIPs returns the stored AIPs with a disposal date on or before
+// dueBy, excluding those under a legal hold and those with a pending or
+// rejected deletion request.
+func (s *serviceImpl) ListDisposableAIPs(ctx context.Context, dueBy time.Time, limit int) ([]*types.AIP, error) {
+	return s.storagePersistence.ListDisposableAIPs(ctx, &persistence.DisposalFilter{
+		DueBy: dueBy,
+		Limit: limit,
+	})
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
+)
+
+// aipScope returns the persistence scope limiting the AIPs to the locations
+// for which the user is granted attr, or nil if attr is granted for all the
+// locations.
+func aipScope(ctx context.Context, attr string) *persistence.AIPScope {
+	ids, all := auth.UserClaimsFromContext(ctx).AttributeScope(attr, auth.LocationScope)
+	if all {
+		return nil
+	}
+
+	return &persistence.AIPScope{LocationIDs: ids}
+}
+
+// inLocationScope reports whether the user is granted attr for the location.
+func inLocationScope(claims *auth.Claims, attr string, locationID *uuid.UUID) bool {
+	if claims.CheckAttributes([]string{attr}) {
+		return true
+	}
+	if locationID == nil {
+		return false
+	}
+
+	return claims.CheckAttributes([]string{auth.ScopedAttribute(attr, auth.LocationScope, *locationID)})
+}
+
+// checkAIPScope returns an AIP not found error if the user is not granted attr
+// for the location of the AIP, to hide the AIPs of other locations from the
+// users with location scoped attributes.
+func checkAIPScope(ctx context.Context, attr string, aip *goastorage.AIP) error {
+	if !inLocationScope(auth.UserClaimsFromContext(ctx), attr, aip.LocationUUID) {
+		return &goastorage.AIPNotFound{UUID: aip.UUID, Message: "AIP not found"}
+	}
+
+	return nil
+}
+
+// checkLocationScope returns a location not found error if the user is not
+// granted attr for the location.
+func checkLocationScope(ctx context.Context, attr string, locationID uuid.UUID) error {
+	if !inLocationScope(auth.UserClaimsFromContext(ctx), attr, &locationID) {
+		return &goastorage.LocationNotFound{UUID: locationID, Message: "location not found"}
+	}
+
+	return nil
+}
+
+// filterLocations removes the locations for which the user is not granted
+// attr.
+func filterLocations(
+	ctx context.Context,
+	attr string,
+	locations goastorage.LocationCollection,
+) goastorage.LocationCollection {
+	ids, all := auth.UserClaimsFromContext(ctx).AttributeScope(attr, auth.LocationScope)
+	if all {
+		return locations
+	}
+
+	return slices.DeleteFunc(locations, func(l *goastorage.Location) bool {
+		return !slices.Contains(ids, l.UUID)
+	})
+}
@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/auth"
+	"github.com/artefactual-sdps/enduro/internal/search"
+)
+
+// indexAIP adds aip to the search index with its descriptive metadata.
+// Indexing errors are logged and not returned because they don't prevent the
+// creation of the AIP.
+func (s *serviceImpl) indexAIP(ctx context.Context, aip *goastorage.AIP, metadata map[string]string) {
+	doc := &search.Document{
+		UUID:   aip.UUID,
+		Name:   aip.Name,
+		Fields: metadata,
+	}
+	if err := s.searchBackend.Index(ctx, doc); err != nil {
+		s.logger.Error(err, "error indexing AIP", "AIPID", aip.UUID)
+	}
+}
+
+// unindexAIP removes a deleted AIP from the search index. Errors are logged and
+// not returned because the AIP has already been deleted.
+func (s *serviceImpl) unindexAIP(ctx context.Context, aipID uuid.UUID) {
+	if err := s.searchBackend.Delete(ctx, aipID); err != nil {
+		s.logger.Error(err, "error removing AIP from the search index", "AIPID", aipID)
+	}
+}
+
+// Search returns the AIPs matching the payload query. The search index doesn't
+// record the AIP locations, so searching requires the AIPs list attribute for
+// all the locations.
+func (s *serviceImpl) Search(ctx context.Context, payload *goastorage.SearchPayload) (*goastorage.SearchResults, error) {
+	if !auth.UserClaimsFromContext(ctx).CheckAttributes([]string{auth.StorageAIPSListAttr}) {
+		return nil, ErrForbidden
+	}
+
+	var limit, offset int
+	if payload.Limit != nil {
+		limit = *payload.Limit
+	}
+	if payload.Offset != nil {
+		offset = *payload.Offset
+	}
+	q := search.NewQuery(payload.Query, limit, offset)
+	if len(q.Terms()) == 0 {
+		return nil, goastorage.MakeNotValid(errors.New("query has no search terms"))
+	}
+
+	r, total, err := s.searchBackend.Search(ctx, q)
+	if err != nil {
+		s.logger.Error(err, "error searching AIPs")
+		return nil, ErrInternalError
+	}
+
+	items := make([]*goastorage.SearchResult, len(r))
+	for i, res := range r {
+		items[i] = &goastorage.SearchResult{
+			UUID:   res.UUID,
+			Name:   res.Name,
+			Fields: res.Fields,
+		}
+	}
+
+	return &goastorage.SearchResults{
+		Items: items,
+		Page: &goastorage.EnduroPage{
+			Limit:  q.Limit,
+			Offset: q.Offset,
+			Total:  total,
+		},
+	}, nil
+}
@@ -0,0 +1,199 @@
+package storage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.artefactual.dev/tools/ref"
+	"gotest.tools/v3/assert"
+
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/search"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+// fakeSearchBackend records the indexed documents and returns the configured
+// search results.
+type fakeSearchBackend struct {
+	docs    []*search.Document
+	deleted []uuid.UUID
+	query   *search.Query
+	results []*search.Result
+	total   int
+	err     error
+}
+
+var _ search.Backend = (*fakeSearchBackend)(nil)
+
+func (b *fakeSearchBackend) Index(_ context.Context, doc *search.Document) error {
+	b.docs = append(b.docs, doc)
+	return b.err
+}
+
+func (b *fakeSearchBackend) Delete(_ context.Context, id uuid.UUID) error {
+	b.deleted = append(b.deleted, id)
+	return b.err
+}
+
+func (b *fakeSearchBackend) Search(_ context.Context, q *search.Query) ([]*search.Result, int, error) {
+	b.query = q
+	return b.results, b.total, b.err
+}
+
+func TestServiceCreateAipIndex(t *testing.T) {
+	t.Parallel()
+
+	backend := &fakeSearchBackend{}
+	attrs := setUpAttrs{searchBackend: backend}
+	svc := setUpService(t, t.Context(), &attrs)
+
+	aip := &goastorage.AIP{
+		Name:         "AIP 1",
+		UUID:         aipID,
+		Status:       "stored",
+		ObjectKey:    objectKey,
+		LocationUUID: &locationID,
+	}
+	attrs.persistenceMock.
+		EXPECT().
+		CreateAIP(mockutil.Context(), aip).
+		Return(&goastorage.AIP{
+			Name:         "AIP 1",
+			UUID:         aipID,
+			Status:       "stored",
+			ObjectKey:    objectKey,
+			LocationUUID: &locationID,
+			CreatedAt:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC).Format(time.DateTime),
+		}, nil)
+	attrs.persistenceMock.
+		EXPECT().
+		ReadLocation(mockutil.Context(), locationID).
+		Return(&goastorage.Location{UUID: locationID}, nil)
+
+	_, err := svc.CreateAip(t.Context(), &goastorage.CreateAipPayload{
+		UUID:         aipID.String(),
+		Name:         "AIP 1",
+		ObjectKey:    objectKey.String(),
+		Status:       "stored",
+		LocationUUID: &locationID,
+		Metadata:     map[string]string{"dc.title": "Vecteur 2024"},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, backend.docs, []*search.Document{
+		{
+			UUID:   aipID,
+			Name:   "AIP 1",
+			Fields: map[string]string{"dc.title": "Vecteur 2024"},
+		},
+	})
+}
+
+func TestServiceUpdateAipStatusUnindex(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name        string
+		status      enums.AIPStatus
+		wantDeleted []uuid.UUID
+	}{
+		{
+			name:        "Removes a deleted AIP from the search index",
+			status:      enums.AIPStatusDeleted,
+			wantDeleted: []uuid.UUID{aipID},
+		},
+		{
+			name:   "Keeps a stored AIP in the search index",
+			status: enums.AIPStatusStored,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			backend := &fakeSearchBackend{}
+			attrs := setUpAttrs{searchBackend: backend}
+			svc := setUpService(t, t.Context(), &attrs)
+
+			attrs.persistenceMock.
+				EXPECT().
+				UpdateAIPStatus(mockutil.Context(), aipID, tt.status).
+				Return(nil)
+
+			err := svc.UpdateAipStatus(t.Context(), aipID, tt.status)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, backend.deleted, tt.wantDeleted)
+		})
+	}
+}
+
+func TestServiceSearch(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name      string
+		payload   *goastorage.SearchPayload
+		backend   *fakeSearchBackend
+		want      *goastorage.SearchResults
+		wantQuery *search.Query
+		wantErr   string
+	}{
+		{
+			name:    "Returns the matching AIPs",
+			payload: &goastorage.SearchPayload{Query: "vecteur", Limit: ref.New(10), Offset: ref.New(5)},
+			backend: &fakeSearchBackend{
+				results: []*search.Result{
+					{
+						UUID:   aipID,
+						Name:   "AIP 1",
+						Fields: map[string]string{"dc.title": "Vecteur 2024"},
+					},
+				},
+				total: 6,
+			},
+			want: &goastorage.SearchResults{
+				Items: []*goastorage.SearchResult{
+					{
+						UUID:   aipID,
+						Name:   "AIP 1",
+						Fields: map[string]string{"dc.title": "Vecteur 2024"},
+					},
+				},
+				Page: &goastorage.EnduroPage{Limit: 10, Offset: 5, Total: 6},
+			},
+			wantQuery: &search.Query{Text: "vecteur", Limit: 10, Offset: 5},
+		},
+		{
+			name:    "Errors when the query has no search terms",
+			payload: &goastorage.SearchPayload{Query: ""},
+			backend: &fakeSearchBackend{},
+			wantErr: "query has no search terms",
+		},
+		{
+			name:      "Errors when the search fails",
+			payload:   &goastorage.SearchPayload{Query: "vecteur"},
+			backend:   &fakeSearchBackend{err: errors.New("backend error")},
+			wantQuery: &search.Query{Text: "vecteur", Limit: search.DefaultLimit},
+			wantErr:   "internal error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			attrs := setUpAttrs{searchBackend: tt.backend}
+			svc := setUpService(t, t.Context(), &attrs)
+
+			got, err := svc.Search(t.Context(), tt.payload)
+			assert.DeepEqual(t, tt.backend.query, tt.wantQuery)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
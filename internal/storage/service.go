@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
@@ -14,11 +15,13 @@ import (
 	temporalsdk_client "go.temporal.io/sdk/client"
 	"goa.design/goa/v3/security"
 	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
 
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	"github.com/artefactual-sdps/enduro/internal/auditlog"
 	"github.com/artefactual-sdps/enduro/internal/auth"
 	"github.com/artefactual-sdps/enduro/internal/event"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
 	"github.com/artefactual-sdps/enduro/internal/storage/types"
@@ -60,6 +63,24 @@ type Service interface {
 	ListDeletionRequests(context.Context, *persistence.DeletionRequestFilter) ([]*types.DeletionRequest, error)
 	ReadDeletionRequest(ctx context.Context, drID uuid.UUID) (*types.DeletionRequest, error)
 	UpdateDeletionRequest(context.Context, int, persistence.DeletionRequestUpdater) (*types.DeletionRequest, error)
+	CreateDeletionRequestApproval(context.Context, *types.DeletionRequestApproval) error
+	ListDeletionRequestApprovals(ctx context.Context, deletionRequestDBID int) ([]*types.DeletionRequestApproval, error)
+
+	CreateFixityCheck(context.Context, *types.FixityCheck) error
+
+	// ListDisposableAIPs returns the stored AIPs that are past their disposal
+	// date and can be requested for deletion by the retention workflow.
+	ListDisposableAIPs(ctx context.Context, dueBy time.Time, limit int) ([]*types.AIP, error)
+
+	CreateAIPReplica(context.Context, *types.AIPReplica) error
+	UpdateAIPReplicaStatus(ctx context.Context, aipID, locationID uuid.UUID, status enums.ReplicaStatus) error
+	// DeleteAIPReplicas deletes the replicas of an AIP from its replication
+	// locations and returns the IDs of the locations.
+	DeleteAIPReplicas(ctx context.Context, aipID uuid.UUID) ([]uuid.UUID, error)
+
+	// CheckAIPLegalHold returns ErrLegalHold if the AIP is under an active
+	// legal hold.
+	CheckAIPLegalHold(ctx context.Context, aipID uuid.UUID) error
 }
 
 type serviceImpl struct {
@@ -92,6 +113,9 @@ type serviceImpl struct {
 
 	// Shared outbound HTTP client for AMSS requests.
 	amssHTTPClient *http.Client
+
+	// Search backend indexing the AIPs.
+	searchBackend search.Backend
 }
 
 var _ Service = (*serviceImpl)(nil)
@@ -100,6 +124,7 @@ var (
 	ErrUnauthorized  error = goastorage.Unauthorized("Unauthorized")
 	ErrForbidden     error = goastorage.Forbidden("Forbidden")
 	ErrInternalError error = goastorage.MakeInternalError(errors.New("internal error"))
+	ErrLegalHold     error = goastorage.MakeNotValid(errors.New("AIP is under legal hold"))
 )
 
 func NewService(
@@ -114,6 +139,7 @@ func NewService(
 	rander io.Reader,
 	auditLogger *auditlog.Logger,
 	amssHTTPClient *http.Client,
+	searchBackend search.Backend,
 ) (s *serviceImpl, err error) {
 	s = &serviceImpl{
 		logger:             logger,
@@ -126,6 +152,7 @@ func NewService(
 		rander:             rander,
 		auditLogger:        auditLogger,
 		amssHTTPClient:     amssHTTPClient,
+		searchBackend:      searchBackend,
 	}
 
 	if s.rander == nil {
@@ -134,6 +161,9 @@ func NewService(
 	if s.auditLogger == nil {
 		s.auditLogger = auditlog.Discard()
 	}
+	if s.searchBackend == nil {
+		s.searchBackend = search.NopBackend{}
+	}
 
 	l, err := NewInternalLocation(ctx, &config.Internal)
 	if err != nil {
@@ -157,7 +187,7 @@ func (s *serviceImpl) BearerAuth(
 		return ctx, ErrUnauthorized
 	}
 
-	if !claims.CheckAttributes(scheme.RequiredScopes) {
+	if !claims.CheckAttributesInAnyScope(scheme.RequiredScopes) {
 		return ctx, ErrForbidden
 	}
 
@@ -190,12 +220,32 @@ func (s *serviceImpl) CreateAip(ctx context.Context, payload *goastorage.CreateA
 		return nil, goastorage.MakeNotValid(errors.New("invalid object_key"))
 	}
 
+	// The location quota isn't checked here: AIPs are created with a location
+	// only when they are already stored in it (e.g. by Archivematica) and
+	// rejecting them would leave untracked AIPs in the location.
 	p := &goastorage.AIP{
-		Name:         payload.Name,
-		UUID:         aipID,
-		Status:       payload.Status,
-		ObjectKey:    objKey,
-		LocationUUID: payload.LocationUUID,
+		Name:           payload.Name,
+		UUID:           aipID,
+		Status:         payload.Status,
+		ObjectKey:      objKey,
+		LocationUUID:   payload.LocationUUID,
+		CustomMetadata: payload.CustomMetadata,
+	}
+	if t := s.disposalDate(time.Now(), payload.LocationUUID, payload.CustomMetadata); t != nil {
+		p.DisposalAt = new(t.UTC().Format(time.RFC3339))
+	}
+
+	// Read the location of a stored AIP to record its size, if the AIP was
+	// stored in AMSS, and to replicate it.
+	var loc *goastorage.Location
+	if p.Status == enums.AIPStatusStored.String() && p.LocationUUID != nil {
+		loc, err = s.ReadLocation(ctx, *p.LocationUUID)
+		if err != nil {
+			s.logger.Error(err, "error reading AIP location", "AIPID", aipID, "LocationID", *p.LocationUUID)
+		}
+	}
+	if loc != nil && loc.Source == enums.LocationSourceAmss.String() {
+		p.Size = s.amssAIPSize(ctx, loc, objKey)
 	}
 
 	aip, err := s.storagePersistence.CreateAIP(ctx, p)
@@ -203,26 +253,81 @@ func (s *serviceImpl) CreateAip(ctx context.Context, payload *goastorage.CreateA
 		return nil, err
 	}
 
+	s.indexAIP(ctx, aip, payload.Metadata)
+
 	PublishEvent(ctx, s.evsvc, &goastorage.AIPCreatedEvent{
 		UUID: aipID,
 		Item: aip,
 	})
 
+	if loc != nil {
+		s.replicateAIP(ctx, aip.UUID, loc)
+	}
+
 	return aip, nil
 }
 
+// amssAIPSize returns the size of an AIP stored in an AMSS location as
+// reported by the Storage Service, or nil if it can't be read. The AIPs stored
+// by Archivematica aren't copied by Enduro, so their size isn't recorded by the
+// copy activity.
+func (s *serviceImpl) amssAIPSize(ctx context.Context, loc *goastorage.Location, objectKey uuid.UUID) *int64 {
+	l, err := NewLocation(loc)
+	if err != nil {
+		s.logger.Error(err, "error reading AMSS location", "LocationID", loc.UUID)
+		return nil
+	}
+
+	b, err := s.openBucket(ctx, l)
+	if err != nil {
+		s.logger.Error(err, "error opening AMSS location", "LocationID", loc.UUID)
+		return nil
+	}
+	defer b.Close()
+
+	attrs, err := b.Attributes(ctx, objectKey.String())
+	if err != nil {
+		s.logger.Error(err, "error reading AIP size from AMSS", "ObjectKey", objectKey)
+		return nil
+	}
+
+	return &attrs.Size
+}
+
+// replicateAIP starts the replication workflow for an AIP stored in a location
+// with replication targets. Errors are logged, replication can be retried by
+// moving the AIP or re-running the workflow.
+func (s *serviceImpl) replicateAIP(ctx context.Context, aipID uuid.UUID, loc *goastorage.Location) {
+	if len(loc.ReplicationTargets) == 0 {
+		return
+	}
+
+	_, err := InitStorageReplicateWorkflow(ctx, s.tc, &StorageReplicateWorkflowRequest{
+		AIPID:     aipID,
+		TaskQueue: s.config.TaskQueue,
+	})
+	if err != nil {
+		s.logger.Error(err, "error initializing replicate workflow", "AIPID", aipID)
+	}
+}
+
 func (s *serviceImpl) ListLocations(
 	ctx context.Context,
 	payload *goastorage.ListLocationsPayload,
 ) (goastorage.LocationCollection, error) {
-	return s.storagePersistence.ListLocations(ctx)
+	locations, err := s.storagePersistence.ListLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterLocations(ctx, auth.StorageLocationsListAttr, locations), nil
 }
 
 func (s *serviceImpl) ListAips(
 	ctx context.Context,
 	payload *goastorage.ListAipsPayload,
 ) (*goastorage.AIPs, error) {
-	return s.storagePersistence.ListAIPs(ctx, payload)
+	return s.storagePersistence.ListAIPs(ctx, payload, aipScope(ctx, auth.StorageAIPSListAttr))
 }
 
 func (s *serviceImpl) MoveAip(ctx context.Context, payload *goastorage.MoveAipPayload) error {
@@ -236,6 +341,14 @@ func (s *serviceImpl) MoveAip(ctx context.Context, payload *goastorage.MoveAipPa
 		return err
 	}
 
+	if err := s.CheckAIPLegalHold(ctx, aipID); err != nil {
+		return err
+	}
+
+	if err := s.checkQuota(ctx, payload.LocationUUID); err != nil {
+		return err
+	}
+
 	_, err = InitStorageMoveWorkflow(ctx, s.tc, &StorageMoveWorkflowRequest{
 		AIPID:      aip.UUID,
 		LocationID: payload.LocationUUID,
@@ -300,7 +413,16 @@ func (s *serviceImpl) ShowAip(ctx context.Context, payload *goastorage.ShowAipPa
 		return nil, goastorage.MakeNotValid(errors.New("cannot perform operation"))
 	}
 
-	return s.ReadAip(ctx, aipID)
+	aip, err := s.ReadAip(ctx, aipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAIPScope(ctx, auth.StorageAIPSReadAttr, aip); err != nil {
+		return nil, err
+	}
+
+	return aip, nil
 }
 
 func (s *serviceImpl) ReadAip(ctx context.Context, aipID uuid.UUID) (*goastorage.AIP, error) {
@@ -331,6 +453,10 @@ func (s *serviceImpl) UpdateAipStatus(ctx context.Context, aipID uuid.UUID, stat
 		return err
 	}
 
+	if status == enums.AIPStatusDeleted {
+		s.unindexAIP(ctx, aipID)
+	}
+
 	PublishEvent(ctx, s.evsvc, &goastorage.AIPStatusUpdatedEvent{
 		UUID:   aipID,
 		Status: status.String(),
@@ -345,6 +471,10 @@ func (s *serviceImpl) UpdateAipLocationID(ctx context.Context, aipID, locationID
 		return err
 	}
 
+	if err := s.setLocationDisposalDate(ctx, aipID, locationID); err != nil {
+		s.logger.Error(err, "error setting AIP disposal date", "AIPID", aipID, "LocationID", locationID)
+	}
+
 	PublishEvent(ctx, s.evsvc, &goastorage.AIPLocationUpdatedEvent{
 		UUID:         aipID,
 		LocationUUID: locationID,
@@ -359,6 +489,10 @@ func (s *serviceImpl) DeleteAip(ctx context.Context, aipID uuid.UUID) error {
 		return err
 	}
 
+	if err := s.CheckAIPLegalHold(ctx, aipID); err != nil {
+		return err
+	}
+
 	bucket, err := s.openAIPBucket(ctx, aip)
 	if err != nil {
 		return fmt.Errorf("open AIP bucket: %v", err)
@@ -373,6 +507,49 @@ func (s *serviceImpl) DeleteAip(ctx context.Context, aipID uuid.UUID) error {
 	return nil
 }
 
+func (s *serviceImpl) DeleteAIPReplicas(ctx context.Context, aipID uuid.UUID) ([]uuid.UUID, error) {
+	aip, err := s.ReadAip(ctx, aipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.CheckAIPLegalHold(ctx, aipID); err != nil {
+		return nil, err
+	}
+
+	var locationIDs []uuid.UUID
+	for _, r := range aip.Replicas {
+		if err := s.deleteAIPReplica(ctx, aipID, r.LocationUUID); err != nil {
+			return locationIDs, err
+		}
+		locationIDs = append(locationIDs, r.LocationUUID)
+	}
+
+	return locationIDs, nil
+}
+
+// deleteAIPReplica deletes the replica object of an AIP from a replication
+// location, ignoring missing objects (e.g. failed replicas), and the replica.
+func (s *serviceImpl) deleteAIPReplica(ctx context.Context, aipID, locationID uuid.UUID) error {
+	l, err := s.Location(ctx, locationID)
+	if err != nil {
+		return fmt.Errorf("get replica location: %w", err)
+	}
+
+	bucket, err := s.openBucket(ctx, l)
+	if err != nil {
+		return fmt.Errorf("open replica bucket: %v", err)
+	}
+	defer bucket.Close()
+
+	err = bucket.Delete(ctx, aipID.String())
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return fmt.Errorf("delete AIP replica: %v", err)
+	}
+
+	return s.storagePersistence.DeleteAIPReplica(ctx, aipID, locationID)
+}
+
 // AipReader returns a blob.Reader for the AIP content.
 //
 // If the AIP is stored in the Archivematica Storage Service, reader does not
@@ -458,6 +635,20 @@ func (s *serviceImpl) ListAipWorkflows(
 	}
 	f.AIPUUID = &aipUUID
 
+	// Only read the AIP to check its location if the user attributes are
+	// scoped to specific locations.
+	if claims := auth.UserClaimsFromContext(ctx); !claims.CheckAttributes(
+		[]string{auth.StorageAIPSWorkflowsListAttr},
+	) {
+		aip, err := s.ReadAip(ctx, aipUUID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkAIPScope(ctx, auth.StorageAIPSWorkflowsListAttr, aip); err != nil {
+			return nil, err
+		}
+	}
+
 	if payload.Status != nil {
 		s, err := enums.ParseWorkflowStatus(*payload.Status)
 		if err != nil {
@@ -505,6 +696,9 @@ func (s *serviceImpl) CreateLocation(
 	case goastorage.ConfigKindS3:
 		c, _ := payload.Config.AsS3()
 		config.Value = c.ConvertToS3Config()
+	case goastorage.ConfigKindFilesystem:
+		c, _ := payload.Config.AsFilesystem()
+		config.Value = c.ConvertToFilesystemConfig()
 	default:
 		return nil, fmt.Errorf("unsupported config type: %s", payload.Config.Kind())
 	}
@@ -512,13 +706,31 @@ func (s *serviceImpl) CreateLocation(
 	if !config.Value.Valid() {
 		return nil, goastorage.MakeNotValid(errors.New("invalid configuration"))
 	}
+	if c, ok := config.Value.(*types.FilesystemConfig); ok {
+		path, err := filesystemLocationPath(s.config.FilesystemRoots, c.Path)
+		if err != nil {
+			return nil, goastorage.MakeNotValid(err)
+		}
+		c.Path = path
+	}
+
+	for _, target := range payload.ReplicationTargets {
+		targetID, err := uuid.Parse(target)
+		if err != nil {
+			return nil, goastorage.MakeNotValid(errors.New("replication_targets: invalid UUID"))
+		}
+		if _, err := s.storagePersistence.ReadLocation(ctx, targetID); err != nil {
+			return nil, goastorage.MakeNotValid(fmt.Errorf("replication_targets: location %s not found", target))
+		}
+	}
 
 	location, err := s.storagePersistence.CreateLocation(ctx, &goastorage.Location{
-		Name:        payload.Name,
-		Description: payload.Description,
-		Source:      source.String(),
-		Purpose:     purpose.String(),
-		UUID:        UUID,
+		Name:               payload.Name,
+		Description:        payload.Description,
+		Source:             source.String(),
+		Purpose:            purpose.String(),
+		UUID:               UUID,
+		ReplicationTargets: payload.ReplicationTargets,
 	}, &config)
 	if err != nil {
 		return nil, goastorage.MakeNotValid(errors.New("cannot persist location"))
@@ -551,6 +763,10 @@ func (s *serviceImpl) ShowLocation(
 		return nil, goastorage.MakeNotValid(errors.New("cannot perform operation"))
 	}
 
+	if err := checkLocationScope(ctx, auth.StorageLocationsReadAttr, locationID); err != nil {
+		return nil, err
+	}
+
 	return s.ReadLocation(ctx, locationID)
 }
 
@@ -563,6 +779,10 @@ func (s *serviceImpl) ListLocationAips(
 		return nil, goastorage.MakeNotValid(errors.New("cannot perform operation"))
 	}
 
+	if err := checkLocationScope(ctx, auth.StorageLocationsAIPSListAttr, locationID); err != nil {
+		return nil, err
+	}
+
 	aips, err := s.storagePersistence.LocationAIPs(ctx, locationID)
 	if err != nil {
 		return nil, goastorage.MakeNotAvailable(errors.New("cannot perform operation"))
@@ -571,6 +791,62 @@ func (s *serviceImpl) ListLocationAips(
 	return aips, nil
 }
 
+func (s *serviceImpl) LocationUsage(
+	ctx context.Context,
+	payload *goastorage.LocationUsagePayload,
+) (*goastorage.LocationUsage, error) {
+	locationID, err := uuid.Parse(payload.UUID)
+	if err != nil {
+		return nil, goastorage.MakeNotValid(errors.New("cannot perform operation"))
+	}
+
+	if err := checkLocationScope(ctx, auth.StorageLocationsReadAttr, locationID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ReadLocation(ctx, locationID); err != nil {
+		return nil, err
+	}
+
+	usage, err := s.storagePersistence.LocationUsage(ctx, locationID)
+	if err != nil {
+		s.logger.Error(err, "error reading location usage", "LocationID", locationID)
+		return nil, goastorage.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+
+	res := &goastorage.LocationUsage{
+		AipCount:  usage.AIPCount,
+		Size:      usage.Size,
+		FileCount: usage.FileCount,
+	}
+	if q, ok := s.config.Quota(locationID); ok {
+		res.Quota = &q.MaxSize
+		res.QuotaExceeded = usage.Size >= q.MaxSize
+	}
+
+	return res, nil
+}
+
+// checkQuota returns a not_valid error when the location has a quota and the
+// AIPs stored in it have reached it.
+func (s *serviceImpl) checkQuota(ctx context.Context, locationID uuid.UUID) error {
+	q, ok := s.config.Quota(locationID)
+	if !ok {
+		return nil
+	}
+
+	usage, err := s.storagePersistence.LocationUsage(ctx, locationID)
+	if err != nil {
+		s.logger.Error(err, "error reading location usage", "LocationID", locationID)
+		return goastorage.MakeNotAvailable(errors.New("cannot perform operation"))
+	}
+	if usage.Size >= q.MaxSize {
+		return goastorage.MakeNotValid(errors.New("location quota exceeded"))
+	}
+
+	return nil
+}
+
 func (svc *serviceImpl) CreateWorkflow(ctx context.Context, w *types.Workflow) error {
 	err := svc.storagePersistence.CreateWorkflow(ctx, w)
 	if err != nil {
@@ -639,15 +915,6 @@ func (svc *serviceImpl) UpdateTask(
 	return task, nil
 }
 
-func (svc *serviceImpl) CreateDeletionRequest(ctx context.Context, dr *types.DeletionRequest) error {
-	if err := svc.storagePersistence.CreateDeletionRequest(ctx, dr); err != nil {
-		return err
-	}
-	svc.auditLogger.Log(ctx, deletionRequestAuditEvent(dr))
-
-	return nil
-}
-
 func (svc *serviceImpl) ListDeletionRequests(
 	ctx context.Context,
 	f *persistence.DeletionRequestFilter,
@@ -662,16 +929,43 @@ func (svc *serviceImpl) ReadDeletionRequest(
 	return svc.storagePersistence.ReadDeletionRequest(ctx, id)
 }
 
-func (svc *serviceImpl) UpdateDeletionRequest(
+func (svc *serviceImpl) CreateDeletionRequestApproval(ctx context.Context, a *types.DeletionRequestApproval) error {
+	return svc.storagePersistence.CreateDeletionRequestApproval(ctx, a)
+}
+
+func (svc *serviceImpl) ListDeletionRequestApprovals(
 	ctx context.Context,
-	id int,
-	upd persistence.DeletionRequestUpdater,
-) (*types.DeletionRequest, error) {
-	dr, err := svc.storagePersistence.UpdateDeletionRequest(ctx, id, upd)
-	if err != nil {
-		return nil, err
+	deletionRequestDBID int,
+) ([]*types.DeletionRequestApproval, error) {
+	return svc.storagePersistence.ListDeletionRequestApprovals(ctx, deletionRequestDBID)
+}
+
+func (svc *serviceImpl) CreateFixityCheck(ctx context.Context, fc *types.FixityCheck) error {
+	if err := svc.storagePersistence.CreateFixityCheck(ctx, fc); err != nil {
+		return err
 	}
-	svc.auditLogger.Log(ctx, deletionRequestAuditEvent(dr))
 
-	return dr, nil
+	PublishEvent(ctx, svc.evsvc, &goastorage.AIPFixityCheckedEvent{
+		UUID:              fc.UUID,
+		AipUUID:           fc.AIPUUID,
+		Status:            fc.Status.String(),
+		ChecksumAlgorithm: fc.ChecksumAlgorithm,
+		ExpectedChecksum:  fc.ExpectedChecksum,
+		ActualChecksum:    fc.ActualChecksum,
+		CheckedAt:         fc.CheckedAt.UTC().Format(time.RFC3339),
+	})
+
+	return nil
+}
+
+func (svc *serviceImpl) CreateAIPReplica(ctx context.Context, r *types.AIPReplica) error {
+	return svc.storagePersistence.CreateAIPReplica(ctx, r)
+}
+
+func (svc *serviceImpl) UpdateAIPReplicaStatus(
+	ctx context.Context,
+	aipID, locationID uuid.UUID,
+	status enums.ReplicaStatus,
+) error {
+	return svc.storagePersistence.UpdateAIPReplicaStatus(ctx, aipID, locationID, status)
 }
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 	"time"
 
@@ -30,6 +33,7 @@ import (
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	"github.com/artefactual-sdps/enduro/internal/auth"
 	"github.com/artefactual-sdps/enduro/internal/event"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/storage"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 	"github.com/artefactual-sdps/enduro/internal/storage/persistence"
@@ -54,6 +58,8 @@ type setUpAttrs struct {
 	temporalClientMock *temporalsdk_mocks.Client
 	tokenVerifier      auth.TokenVerifier
 	ticketProvider     auth.TicketProvider
+	searchBackend      search.Backend
+	evsvc              event.Service[*goastorage.StorageEvent]
 }
 
 func setUpService(t *testing.T, ctx context.Context, attrs *setUpAttrs) storage.Service {
@@ -80,6 +86,7 @@ func setUpService(t *testing.T, ctx context.Context, attrs *setUpAttrs) storage.
 		temporalClient:     &tc,
 		temporalClientMock: tcMock,
 		tokenVerifier:      auth.OIDCTokenVerifiers{},
+		evsvc:              event.NewServiceNop[*goastorage.StorageEvent](),
 	}
 	if attrs.logger != nil {
 		params.logger = attrs.logger
@@ -99,6 +106,12 @@ func setUpService(t *testing.T, ctx context.Context, attrs *setUpAttrs) storage.
 	if attrs.ticketProvider != nil {
 		params.ticketProvider = attrs.ticketProvider
 	}
+	if attrs.searchBackend != nil {
+		params.searchBackend = attrs.searchBackend
+	}
+	if attrs.evsvc != nil {
+		params.evsvc = attrs.evsvc
+	}
 
 	*attrs = params
 
@@ -108,12 +121,13 @@ func setUpService(t *testing.T, ctx context.Context, attrs *setUpAttrs) storage.
 		*params.config,
 		*params.persistence,
 		*params.temporalClient,
-		event.NewServiceNop[*goastorage.StorageEvent](),
+		params.evsvc,
 		params.tokenVerifier,
 		params.ticketProvider,
 		rand.New(rand.NewSource(1)), // #nosec: G404
 		nil,
 		nil,
+		params.searchBackend,
 	)
 	assert.NilError(t, err)
 
@@ -149,6 +163,7 @@ func TestNewService(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 		)
 
 		assert.Error(t, err, "NewInternalLocation: open bucket: s3blob.OpenBucket: bucketName is required")
@@ -192,6 +207,11 @@ func TestServiceCreate(t *testing.T) {
 				nil,
 			)
 
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(mockutil.Context(), locationID).
+			Return(&goastorage.Location{UUID: locationID}, nil)
+
 		got, err := svc.CreateAip(t.Context(), &goastorage.CreateAipPayload{
 			UUID:         aipID.String(),
 			Name:         name,
@@ -211,6 +231,204 @@ func TestServiceCreate(t *testing.T) {
 		})
 	})
 
+	t.Run("Sets the AIP disposal date from its custom metadata", func(t *testing.T) {
+		t.Parallel()
+
+		aip := &goastorage.AIP{
+			Name:           "AIP 1",
+			UUID:           aipID,
+			Status:         "stored",
+			ObjectKey:      objectKey,
+			LocationUUID:   &locationID,
+			CustomMetadata: map[string]any{"disposal_date": "2030-01-02"},
+			DisposalAt:     new("2030-01-02T00:00:00Z"),
+		}
+
+		attrs := setUpAttrs{
+			config: &storage.Config{
+				TaskQueue: "global",
+				Internal:  bucket.Config{URL: "mem://"},
+				Retention: storage.RetentionConfig{
+					MetadataKey: "disposal_date",
+					Policies: []storage.RetentionPolicy{
+						{LocationID: locationID, Period: time.Hour},
+					},
+				},
+			},
+		}
+		svc := setUpService(t, t.Context(), &attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			CreateAIP(mockutil.Context(), aip).
+			Return(aip, nil)
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(mockutil.Context(), locationID).
+			Return(&goastorage.Location{UUID: locationID}, nil)
+
+		got, err := svc.CreateAip(t.Context(), &goastorage.CreateAipPayload{
+			UUID:           aipID.String(),
+			Name:           "AIP 1",
+			ObjectKey:      objectKey.String(),
+			Status:         "stored",
+			LocationUUID:   &locationID,
+			CustomMetadata: map[string]any{"disposal_date": "2030-01-02"},
+		})
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, aip)
+	})
+
+	t.Run("Sets the AIP disposal date from the location retention policy", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := setUpAttrs{
+			config: &storage.Config{
+				TaskQueue: "global",
+				Internal:  bucket.Config{URL: "mem://"},
+				Retention: storage.RetentionConfig{
+					Policies: []storage.RetentionPolicy{
+						{LocationID: locationID, Period: 24 * time.Hour},
+					},
+				},
+			},
+		}
+		svc := setUpService(t, t.Context(), &attrs)
+
+		before := time.Now().Truncate(time.Second)
+		attrs.persistenceMock.
+			EXPECT().
+			CreateAIP(mockutil.Context(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, aip *goastorage.AIP) (*goastorage.AIP, error) {
+				assert.Assert(t, aip.DisposalAt != nil)
+				disposalAt, err := time.Parse(time.RFC3339, *aip.DisposalAt)
+				assert.NilError(t, err)
+				assert.Assert(t, !disposalAt.Before(before.Add(24*time.Hour)))
+				assert.Assert(t, !disposalAt.After(time.Now().Add(24*time.Hour)))
+				return aip, nil
+			})
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(mockutil.Context(), locationID).
+			Return(&goastorage.Location{UUID: locationID}, nil)
+
+		_, err := svc.CreateAip(t.Context(), &goastorage.CreateAipPayload{
+			UUID:         aipID.String(),
+			Name:         "AIP 1",
+			ObjectKey:    objectKey.String(),
+			Status:       "stored",
+			LocationUUID: &locationID,
+		})
+
+		assert.NilError(t, err)
+	})
+
+	t.Run("Records the size of an AIP stored in AMSS", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, fmt.Sprintf("/api/v2/file/%s/", objectKey))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"size": 12345, "status": "UPLOADED", "uuid": "` + objectKey.String() + `"}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		aip := &goastorage.AIP{
+			Name:         "AIP 1",
+			UUID:         aipID,
+			Status:       "stored",
+			ObjectKey:    objectKey,
+			LocationUUID: &locationID,
+			Size:         new(int64(12345)),
+		}
+
+		attrs := setUpAttrs{}
+		svc := setUpService(t, t.Context(), &attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(mockutil.Context(), locationID).
+			Return(&goastorage.Location{
+				UUID:   locationID,
+				Source: enums.LocationSourceAmss.String(),
+				Config: goastorage.NewConfigAmss(&goastorage.AMSSConfig{
+					URL:      srv.URL,
+					Username: "test",
+					APIKey:   "secret",
+				}),
+			}, nil)
+		attrs.persistenceMock.
+			EXPECT().
+			CreateAIP(mockutil.Context(), aip).
+			Return(aip, nil)
+
+		got, err := svc.CreateAip(t.Context(), &goastorage.CreateAipPayload{
+			UUID:         aipID.String(),
+			Name:         "AIP 1",
+			ObjectKey:    objectKey.String(),
+			Status:       "stored",
+			LocationUUID: &locationID,
+		})
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, aip)
+	})
+
+	t.Run("Starts the replication of a stored AIP", func(t *testing.T) {
+		t.Parallel()
+
+		targetID := uuid.MustParse("7ad7b1cf-8d4c-4d2b-9c3a-21c1d8ef2a63")
+		aip := &goastorage.AIP{
+			Name:         "AIP 1",
+			UUID:         aipID,
+			Status:       "stored",
+			ObjectKey:    objectKey,
+			LocationUUID: &locationID,
+		}
+
+		attrs := setUpAttrs{}
+		svc := setUpService(t, t.Context(), &attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			CreateAIP(mockutil.Context(), aip).
+			Return(aip, nil)
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(mockutil.Context(), locationID).
+			Return(&goastorage.Location{
+				UUID:               locationID,
+				ReplicationTargets: []string{targetID.String()},
+			}, nil)
+		attrs.temporalClientMock.
+			On(
+				"ExecuteWorkflow",
+				mock.AnythingOfType("*context.timerCtx"),
+				temporalsdk_client.StartWorkflowOptions{
+					ID:                    "storage-replicate-workflow-" + aipID.String(),
+					TaskQueue:             "global",
+					WorkflowIDReusePolicy: temporalapi_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+				},
+				"storage-replicate-workflow",
+				&storage.StorageReplicateWorkflowRequest{AIPID: aipID, TaskQueue: "global"},
+			).
+			Return(&temporalsdk_mocks.WorkflowRun{}, nil).
+			Once()
+
+		got, err := svc.CreateAip(t.Context(), &goastorage.CreateAipPayload{
+			UUID:         aipID.String(),
+			Name:         "AIP 1",
+			ObjectKey:    objectKey.String(),
+			Status:       "stored",
+			LocationUUID: &locationID,
+		})
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, aip)
+		attrs.temporalClientMock.AssertExpectations(t)
+	})
+
 	t.Run("Returns not_valid if AIP ID is invalid", func(t *testing.T) {
 		t.Parallel()
 
@@ -240,6 +458,47 @@ func TestServiceCreate(t *testing.T) {
 		assert.Equal(t, err.(*goa.ServiceError).Name, "not_valid")
 		assert.Error(t, err, "invalid object_key")
 	})
+
+	t.Run("Records a stored AIP in a location over its quota", func(t *testing.T) {
+		t.Parallel()
+
+		aip := &goastorage.AIP{
+			Name:         "AIP 1",
+			UUID:         aipID,
+			Status:       "stored",
+			ObjectKey:    objectKey,
+			LocationUUID: &locationID,
+		}
+
+		attrs := &setUpAttrs{
+			config: &storage.Config{
+				TaskQueue: "global",
+				Internal:  bucket.Config{URL: "mem://"},
+				Quotas:    []storage.QuotaConfig{{LocationID: locationID, MaxSize: 1024}},
+			},
+		}
+		svc := setUpService(t, t.Context(), attrs)
+
+		// The location usage isn't read, the AIP is already in the location.
+		attrs.persistenceMock.
+			EXPECT().
+			CreateAIP(mockutil.Context(), aip).
+			Return(aip, nil)
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(mockutil.Context(), locationID).
+			Return(&goastorage.Location{UUID: locationID}, nil)
+
+		got, err := svc.CreateAip(t.Context(), &goastorage.CreateAipPayload{
+			UUID:         aipID.String(),
+			Name:         "AIP 1",
+			ObjectKey:    objectKey.String(),
+			Status:       "stored",
+			LocationUUID: &locationID,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, aip)
+	})
 }
 
 func TestServiceLocation(t *testing.T) {
@@ -349,6 +608,36 @@ func TestServiceList(t *testing.T) {
 		assert.NilError(t, err)
 		assert.DeepEqual(t, res, storedLocations, cmpopts.IgnoreUnexported(goastorage.Config{}))
 	})
+
+	t.Run("Returns the locations in the user scope", func(t *testing.T) {
+		t.Parallel()
+
+		scopedID := uuid.New()
+		ctx := auth.WithUserClaims(t.Context(), &auth.Claims{
+			Attributes: []string{
+				auth.ScopedAttribute(auth.StorageLocationsListAttr, auth.LocationScope, scopedID),
+			},
+		})
+		attrs := &setUpAttrs{}
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ListLocations(ctx).
+			Return(goastorage.LocationCollection{
+				{Name: "perma-aips-1", UUID: uuid.New()},
+				{Name: "perma-aips-2", UUID: scopedID},
+			}, nil)
+
+		res, err := svc.ListLocations(ctx, &goastorage.ListLocationsPayload{})
+		assert.NilError(t, err)
+		assert.DeepEqual(
+			t,
+			res,
+			goastorage.LocationCollection{{Name: "perma-aips-2", UUID: scopedID}},
+			cmpopts.IgnoreUnexported(goastorage.Config{}),
+		)
+	})
 }
 
 func TestServiceListAips(t *testing.T) {
@@ -383,7 +672,7 @@ func TestServiceListAips(t *testing.T) {
 
 		attrs.persistenceMock.
 			EXPECT().
-			ListAIPs(ctx, payload).
+			ListAIPs(ctx, payload, nil).
 			Return(aips, nil)
 
 		res, err := svc.ListAips(ctx, payload)
@@ -402,12 +691,34 @@ func TestServiceListAips(t *testing.T) {
 
 		attrs.persistenceMock.
 			EXPECT().
-			ListAIPs(ctx, nil).
+			ListAIPs(ctx, nil, nil).
 			Return(nil, mockErr)
 
 		_, err := svc.ListAips(ctx, nil)
 		assert.ErrorIs(t, err, mockErr)
 	})
+
+	t.Run("Limits the AIPs to the user scope", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := auth.WithUserClaims(t.Context(), &auth.Claims{
+			Attributes: []string{
+				auth.ScopedAttribute(auth.StorageAIPSListAttr, auth.LocationScope, locationID),
+			},
+		})
+		attrs := &setUpAttrs{}
+		svc := setUpService(t, ctx, attrs)
+
+		aips := &goastorage.AIPs{Items: goastorage.AIPCollection{}}
+		attrs.persistenceMock.
+			EXPECT().
+			ListAIPs(ctx, nil, &persistence.AIPScope{LocationIDs: []uuid.UUID{locationID}}).
+			Return(aips, nil)
+
+		res, err := svc.ListAips(ctx, nil)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, res, aips)
+	})
 }
 
 func TestReject(t *testing.T) {
@@ -555,6 +866,39 @@ func TestServiceUpdateAipLocationUUID(t *testing.T) {
 func TestServiceDeleteAip(t *testing.T) {
 	t.Parallel()
 
+	t.Run("Errors if the AIP is under legal hold", func(t *testing.T) {
+		t.Parallel()
+
+		var attrs setUpAttrs
+		ctx := t.Context()
+		svc := setUpService(t, ctx, &attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadAIP(
+				ctx,
+				aipID,
+			).
+			Return(
+				&goastorage.AIP{
+					UUID:         aipID,
+					ObjectKey:    aipID,
+					LocationUUID: &uuid.Nil,
+				},
+				nil,
+			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				ctx,
+				aipID,
+			).
+			Return(true, nil)
+
+		err := svc.DeleteAip(ctx, aipID)
+		assert.ErrorIs(t, err, storage.ErrLegalHold)
+	})
+
 	t.Run("Delete AIP from an internal location", func(t *testing.T) {
 		t.Parallel()
 
@@ -579,6 +923,13 @@ func TestServiceDeleteAip(t *testing.T) {
 				},
 				nil,
 			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				ctx,
+				aipID,
+			).
+			Return(false, nil)
 
 		err := svc.DeleteAip(ctx, aipID)
 		assert.NilError(t, err)
@@ -610,6 +961,13 @@ func TestServiceDeleteAip(t *testing.T) {
 				},
 				nil,
 			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				ctx,
+				aipID,
+			).
+			Return(false, nil)
 
 		attrs.persistenceMock.
 			EXPECT().
@@ -654,6 +1012,13 @@ func TestServiceDeleteAip(t *testing.T) {
 				},
 				nil,
 			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				ctx,
+				aipID,
+			).
+			Return(false, nil)
 
 		attrs.persistenceMock.
 			EXPECT().
@@ -699,6 +1064,13 @@ func TestServiceDeleteAip(t *testing.T) {
 				},
 				nil,
 			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				ctx,
+				aipID,
+			).
+			Return(false, nil)
 
 		attrs.persistenceMock.
 			EXPECT().
@@ -738,66 +1110,155 @@ func TestServiceDeleteAip(t *testing.T) {
 	})
 }
 
-func TestAipReader(t *testing.T) {
+func TestServiceDeleteAIPReplicas(t *testing.T) {
 	t.Parallel()
 
-	t.Run("Provides a valid reader", func(t *testing.T) {
+	t.Run("Deletes the AIP replicas", func(t *testing.T) {
 		t.Parallel()
 
 		ctx := t.Context()
-		td := tfs.NewDir(t, "enduro-service-test")
+		td := tfs.NewDir(t, "enduro-service-test", tfs.WithDir("replicated"), tfs.WithDir("failed"))
+		replicatedID := uuid.New()
+		failedID := uuid.New()
 
 		var attrs setUpAttrs
 		svc := setUpService(t, ctx, &attrs)
 
-		// Write a test blob to the bucket.
-		writeTestBlob(ctx, t, "file://"+td.Path(), aipID.String())
+		// Only the replicated location has a copy of the AIP.
+		writeTestBlob(ctx, t, "file://"+td.Join("replicated"), aipID.String())
 
 		attrs.persistenceMock.
 			EXPECT().
-			ReadLocation(
-				ctx,
-				locationID,
-			).
+			ReadAIP(ctx, aipID).
 			Return(
-				&goastorage.Location{
-					UUID: locationID,
-					Config: goastorage.NewConfigURL(&goastorage.URLConfig{
-						URL: "file://" + td.Path(),
-					}),
+				&goastorage.AIP{
+					UUID:         aipID,
+					ObjectKey:    aipID,
+					LocationUUID: &locationID,
+					Replicas: goastorage.AIPReplicaCollection{
+						{LocationUUID: replicatedID, Status: enums.ReplicaStatusReplicated.String()},
+						{LocationUUID: failedID, Status: enums.ReplicaStatusFailed.String()},
+					},
 				},
 				nil,
 			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(ctx, aipID).
+			Return(false, nil)
+		for id, dir := range map[uuid.UUID]string{replicatedID: "replicated", failedID: "failed"} {
+			attrs.persistenceMock.
+				EXPECT().
+				ReadLocation(ctx, id).
+				Return(
+					&goastorage.Location{
+						UUID: id,
+						Config: goastorage.NewConfigURL(&goastorage.URLConfig{
+							URL: "file://" + td.Join(dir),
+						}),
+					},
+					nil,
+				)
+			attrs.persistenceMock.
+				EXPECT().
+				DeleteAIPReplica(ctx, aipID, id).
+				Return(nil)
+		}
 
-		reader, err := svc.AipReader(ctx, &goastorage.AIP{
-			UUID:         aipID,
-			ObjectKey:    aipID,
-			LocationUUID: &locationID,
-		})
-		assert.NilError(t, err)
-		defer reader.Close()
-
-		blob, err := io.ReadAll(reader)
+		got, err := svc.DeleteAIPReplicas(ctx, aipID)
 		assert.NilError(t, err)
-		assert.Equal(t, string(blob), "Testing 1-2-3!")
+		assert.DeepEqual(t, got, []uuid.UUID{replicatedID, failedID})
+		_, err = os.Stat(td.Join("replicated", aipID.String()))
+		assert.Assert(t, os.IsNotExist(err))
 	})
 
-	t.Run("Fails if the location is not available", func(t *testing.T) {
+	t.Run("Errors if the AIP is under legal hold", func(t *testing.T) {
 		t.Parallel()
 
-		attrs := setUpAttrs{}
+		var attrs setUpAttrs
 		ctx := t.Context()
 		svc := setUpService(t, ctx, &attrs)
 
 		attrs.persistenceMock.
 			EXPECT().
-			ReadLocation(
-				ctx,
-				locationID,
-			).
+			ReadAIP(ctx, aipID).
 			Return(
+				&goastorage.AIP{
+					UUID:     aipID,
+					Replicas: goastorage.AIPReplicaCollection{{LocationUUID: locationID}},
+				},
 				nil,
-				&goastorage.LocationNotFound{UUID: locationID, Message: "location not found"},
+			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(ctx, aipID).
+			Return(true, nil)
+
+		_, err := svc.DeleteAIPReplicas(ctx, aipID)
+		assert.ErrorIs(t, err, storage.ErrLegalHold)
+	})
+}
+
+func TestAipReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Provides a valid reader", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		td := tfs.NewDir(t, "enduro-service-test")
+
+		var attrs setUpAttrs
+		svc := setUpService(t, ctx, &attrs)
+
+		// Write a test blob to the bucket.
+		writeTestBlob(ctx, t, "file://"+td.Path(), aipID.String())
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(
+				ctx,
+				locationID,
+			).
+			Return(
+				&goastorage.Location{
+					UUID: locationID,
+					Config: goastorage.NewConfigURL(&goastorage.URLConfig{
+						URL: "file://" + td.Path(),
+					}),
+				},
+				nil,
+			)
+
+		reader, err := svc.AipReader(ctx, &goastorage.AIP{
+			UUID:         aipID,
+			ObjectKey:    aipID,
+			LocationUUID: &locationID,
+		})
+		assert.NilError(t, err)
+		defer reader.Close()
+
+		blob, err := io.ReadAll(reader)
+		assert.NilError(t, err)
+		assert.Equal(t, string(blob), "Testing 1-2-3!")
+	})
+
+	t.Run("Fails if the location is not available", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, &attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(
+				ctx,
+				locationID,
+			).
+			Return(
+				nil,
+				&goastorage.LocationNotFound{UUID: locationID, Message: "location not found"},
 			)
 
 		_, err := svc.AipReader(ctx, &goastorage.AIP{
@@ -1050,6 +1511,13 @@ func TestServiceMove(t *testing.T) {
 				&goastorage.AIP{UUID: aipID},
 				nil,
 			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				gomock.AssignableToTypeOf(ctx),
+				aipID,
+			).
+			Return(false, nil)
 
 		err := svc.MoveAip(ctx, &goastorage.MoveAipPayload{
 			UUID:         aipID.String(),
@@ -1059,6 +1527,88 @@ func TestServiceMove(t *testing.T) {
 		assert.ErrorContains(t, err, "cannot perform operation")
 	})
 
+	t.Run("Returns not_valid if the AIP is under legal hold", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadAIP(
+				gomock.AssignableToTypeOf(ctx),
+				aipID,
+			).
+			Return(
+				&goastorage.AIP{UUID: aipID},
+				nil,
+			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				gomock.AssignableToTypeOf(ctx),
+				aipID,
+			).
+			Return(true, nil)
+
+		err := svc.MoveAip(ctx, &goastorage.MoveAipPayload{
+			UUID:         aipID.String(),
+			LocationUUID: locationID,
+		})
+		assert.Equal(t, err.(*goa.ServiceError).Name, "not_valid")
+		assert.ErrorContains(t, err, "AIP is under legal hold")
+	})
+
+	t.Run("Returns not_valid if the location quota is exceeded", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{
+			config: &storage.Config{
+				TaskQueue: "global",
+				Internal:  bucket.Config{URL: "mem://"},
+				Quotas:    []storage.QuotaConfig{{LocationID: locationID, MaxSize: 1024}},
+			},
+		}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadAIP(
+				gomock.AssignableToTypeOf(ctx),
+				aipID,
+			).
+			Return(
+				&goastorage.AIP{UUID: aipID},
+				nil,
+			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				gomock.AssignableToTypeOf(ctx),
+				aipID,
+			).
+			Return(false, nil)
+		attrs.persistenceMock.
+			EXPECT().
+			LocationUsage(
+				gomock.AssignableToTypeOf(ctx),
+				locationID,
+			).
+			Return(
+				&types.LocationUsage{AIPCount: 2, Size: 1024},
+				nil,
+			)
+
+		err := svc.MoveAip(ctx, &goastorage.MoveAipPayload{
+			UUID:         aipID.String(),
+			LocationUUID: locationID,
+		})
+		assert.Equal(t, err.(*goa.ServiceError).Name, "not_valid")
+		assert.ErrorContains(t, err, "location quota exceeded")
+	})
+
 	t.Run("Returns no error if AIP is moved", func(t *testing.T) {
 		t.Parallel()
 
@@ -1093,6 +1643,13 @@ func TestServiceMove(t *testing.T) {
 				&goastorage.AIP{UUID: aipID},
 				nil,
 			)
+		attrs.persistenceMock.
+			EXPECT().
+			HasActiveAIPLegalHold(
+				gomock.AssignableToTypeOf(ctx),
+				aipID,
+			).
+			Return(false, nil)
 
 		err := svc.MoveAip(ctx, &goastorage.MoveAipPayload{
 			UUID:         aipID.String(),
@@ -1467,6 +2024,222 @@ func TestServiceAddLocation(t *testing.T) {
 		assert.NilError(t, err)
 		assert.DeepEqual(t, res, &goastorage.CreateLocationResult{UUID: uuid0.String()})
 	})
+
+	t.Run("Returns location with filesystem config", func(t *testing.T) {
+		root := tfs.NewDir(t, "enduro-location-root", tfs.WithDir("aips"))
+		attrs := &setUpAttrs{
+			config: &storage.Config{
+				TaskQueue:       "global",
+				Internal:        bucket.Config{URL: "mem://"},
+				FilesystemRoots: []string{root.Path()},
+			},
+		}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			CreateLocation(
+				gomock.AssignableToTypeOf(ctx),
+				&goastorage.Location{
+					Name:    "perma-aips-1",
+					Source:  enums.LocationSourceFilesystem.String(),
+					Purpose: enums.LocationPurposeAipStore.String(),
+					UUID:    uuid0,
+				},
+				&types.LocationConfig{
+					Value: &types.FilesystemConfig{
+						Path: root.Join("aips"),
+					},
+				},
+			).
+			Return(
+				&goastorage.Location{},
+				nil,
+			)
+
+		res, err := svc.CreateLocation(ctx, &goastorage.CreateLocationPayload{
+			Name:    "perma-aips-1",
+			Source:  enums.LocationSourceFilesystem.String(),
+			Purpose: enums.LocationPurposeAipStore.String(),
+			Config: goastorage.NewConfigFilesystem(&goastorage.FilesystemConfig{
+				Path: root.Path() + "/./aips/",
+			}),
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, res, &goastorage.CreateLocationResult{UUID: uuid0.String()})
+	})
+
+	t.Run("Returns error if filesystem path is not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		root := tfs.NewDir(t, "enduro-location-root", tfs.WithDir("aips"))
+		other := tfs.NewDir(t, "enduro-location-other")
+		for _, tc := range []struct {
+			name    string
+			roots   []string
+			path    string
+			wantErr string
+		}{
+			{
+				name:    "no roots",
+				path:    root.Join("aips"),
+				wantErr: "filesystem locations are not enabled",
+			},
+			{
+				name:    "outside roots",
+				roots:   []string{root.Path()},
+				path:    other.Path(),
+				wantErr: "path: not under an allowed root directory",
+			},
+			{
+				name:    "filesystem root",
+				roots:   []string{root.Path()},
+				path:    "/",
+				wantErr: "path: not under an allowed root directory",
+			},
+			{
+				name:    "missing directory",
+				roots:   []string{root.Path()},
+				path:    root.Join("missing"),
+				wantErr: "path: directory not found",
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				attrs := &setUpAttrs{
+					config: &storage.Config{
+						TaskQueue:       "global",
+						Internal:        bucket.Config{URL: "mem://"},
+						FilesystemRoots: tc.roots,
+					},
+				}
+				ctx := t.Context()
+				svc := setUpService(t, ctx, attrs)
+
+				res, err := svc.CreateLocation(ctx, &goastorage.CreateLocationPayload{
+					Name:    "perma-aips-1",
+					Source:  enums.LocationSourceFilesystem.String(),
+					Purpose: enums.LocationPurposeAipStore.String(),
+					Config: goastorage.NewConfigFilesystem(&goastorage.FilesystemConfig{
+						Path: tc.path,
+					}),
+				})
+				assert.Assert(t, res == nil)
+				assert.Equal(t, err.(*goa.ServiceError).Name, "not_valid")
+				assert.ErrorContains(t, err, tc.wantErr)
+			})
+		}
+	})
+
+	t.Run("Returns error if filesystem path is relative", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		res, err := svc.CreateLocation(ctx, &goastorage.CreateLocationPayload{
+			Name:    "perma-aips-1",
+			Source:  enums.LocationSourceFilesystem.String(),
+			Purpose: enums.LocationPurposeAipStore.String(),
+			Config: goastorage.NewConfigFilesystem(&goastorage.FilesystemConfig{
+				Path: "nas/aips",
+			}),
+		})
+		assert.Assert(t, res == nil)
+		assert.Equal(t, err.(*goa.ServiceError).Name, "not_valid")
+		assert.ErrorContains(t, err, "invalid configuration")
+	})
+
+	t.Run("Returns location with replication targets", func(t *testing.T) {
+		attrs := &setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(gomock.AssignableToTypeOf(ctx), locationID).
+			Return(&goastorage.Location{UUID: locationID}, nil)
+
+		attrs.persistenceMock.
+			EXPECT().
+			CreateLocation(
+				gomock.AssignableToTypeOf(ctx),
+				&goastorage.Location{
+					Name:               "perma-aips-1",
+					Source:             enums.LocationSourceS3.String(),
+					Purpose:            enums.LocationPurposeAipStore.String(),
+					UUID:               uuid0,
+					ReplicationTargets: []string{locationID.String()},
+				},
+				&types.LocationConfig{
+					Value: &types.URLConfig{
+						URL: "mem://",
+					},
+				},
+			).
+			Return(
+				&goastorage.Location{},
+				nil,
+			)
+
+		res, err := svc.CreateLocation(ctx, &goastorage.CreateLocationPayload{
+			Name:    "perma-aips-1",
+			Source:  enums.LocationSourceS3.String(),
+			Purpose: enums.LocationPurposeAipStore.String(),
+			Config: goastorage.NewConfigURL(&goastorage.URLConfig{
+				URL: "mem://",
+			}),
+			ReplicationTargets: []string{locationID.String()},
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, res, &goastorage.CreateLocationResult{UUID: uuid0.String()})
+	})
+
+	t.Run("Returns not_valid if a replication target is invalid", func(t *testing.T) {
+		attrs := &setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		res, err := svc.CreateLocation(ctx, &goastorage.CreateLocationPayload{
+			Name:    "perma-aips-1",
+			Source:  enums.LocationSourceS3.String(),
+			Purpose: enums.LocationPurposeAipStore.String(),
+			Config: goastorage.NewConfigURL(&goastorage.URLConfig{
+				URL: "mem://",
+			}),
+			ReplicationTargets: []string{"12345"},
+		})
+		assert.Assert(t, res == nil)
+		assert.Equal(t, err.(*goa.ServiceError).Name, "not_valid")
+		assert.ErrorContains(t, err, "replication_targets: invalid UUID")
+	})
+
+	t.Run("Returns not_valid if a replication target doesn't exist", func(t *testing.T) {
+		attrs := &setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(gomock.AssignableToTypeOf(ctx), locationID).
+			Return(nil, &goastorage.LocationNotFound{UUID: locationID, Message: "location not found"})
+
+		res, err := svc.CreateLocation(ctx, &goastorage.CreateLocationPayload{
+			Name:    "perma-aips-1",
+			Source:  enums.LocationSourceS3.String(),
+			Purpose: enums.LocationPurposeAipStore.String(),
+			Config: goastorage.NewConfigURL(&goastorage.URLConfig{
+				URL: "mem://",
+			}),
+			ReplicationTargets: []string{locationID.String()},
+		})
+		assert.Assert(t, res == nil)
+		assert.Equal(t, err.(*goa.ServiceError).Name, "not_valid")
+		assert.ErrorContains(t, err, "replication_targets: location "+locationID.String()+" not found")
+	})
 }
 
 func TestServiceShowLocation(t *testing.T) {
@@ -1513,6 +2286,23 @@ func TestServiceShowLocation(t *testing.T) {
 		assert.NilError(t, err)
 		assert.DeepEqual(t, res, &goastorage.Location{UUID: locationID}, cmpopts.IgnoreUnexported(goastorage.Config{}))
 	})
+
+	t.Run("Returns not found if the location is not in the user scope", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{}
+		ctx := auth.WithUserClaims(t.Context(), &auth.Claims{
+			Attributes: []string{
+				auth.ScopedAttribute(auth.StorageLocationsReadAttr, auth.LocationScope, uuid.New()),
+			},
+		})
+		svc := setUpService(t, ctx, attrs)
+
+		_, err := svc.ShowLocation(ctx, &goastorage.ShowLocationPayload{
+			UUID: locationID.String(),
+		})
+		assert.DeepEqual(t, err, &goastorage.LocationNotFound{UUID: locationID, Message: "location not found"})
+	})
 }
 
 func TestServiceListLocationAips(t *testing.T) {
@@ -1603,6 +2393,135 @@ func TestServiceListLocationAips(t *testing.T) {
 	})
 }
 
+func TestServiceLocationUsage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Returns not_valid if cannot parse location UUID", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		res, err := svc.LocationUsage(ctx, &goastorage.LocationUsagePayload{
+			UUID: "hello world",
+		})
+		assert.Assert(t, res == nil)
+		assert.Equal(t, err.(*goa.ServiceError).Name, "not_valid")
+		assert.ErrorContains(t, err, "cannot perform operation")
+	})
+
+	t.Run("Returns not_found if location does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(
+				ctx,
+				locationID,
+			).
+			Return(
+				nil,
+				&goastorage.LocationNotFound{UUID: locationID, Message: "location not found"},
+			)
+
+		res, err := svc.LocationUsage(ctx, &goastorage.LocationUsagePayload{
+			UUID: locationID.String(),
+		})
+		assert.Assert(t, res == nil)
+		assert.ErrorContains(t, err, "location not found")
+	})
+
+	t.Run("Returns the location usage", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(
+				ctx,
+				locationID,
+			).
+			Return(
+				&goastorage.Location{UUID: locationID},
+				nil,
+			)
+		attrs.persistenceMock.
+			EXPECT().
+			LocationUsage(
+				ctx,
+				locationID,
+			).
+			Return(
+				&types.LocationUsage{AIPCount: 2, Size: 2048, FileCount: 30},
+				nil,
+			)
+
+		res, err := svc.LocationUsage(ctx, &goastorage.LocationUsagePayload{
+			UUID: locationID.String(),
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, res, &goastorage.LocationUsage{
+			AipCount:  2,
+			Size:      2048,
+			FileCount: 30,
+		})
+	})
+
+	t.Run("Returns the location usage with its quota", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{
+			config: &storage.Config{
+				Internal: bucket.Config{URL: "mem://"},
+				Quotas:   []storage.QuotaConfig{{LocationID: locationID, MaxSize: 2048}},
+			},
+		}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadLocation(
+				ctx,
+				locationID,
+			).
+			Return(
+				&goastorage.Location{UUID: locationID},
+				nil,
+			)
+		attrs.persistenceMock.
+			EXPECT().
+			LocationUsage(
+				ctx,
+				locationID,
+			).
+			Return(
+				&types.LocationUsage{AIPCount: 2, Size: 2048, FileCount: 30},
+				nil,
+			)
+
+		res, err := svc.LocationUsage(ctx, &goastorage.LocationUsagePayload{
+			UUID: locationID.String(),
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, res, &goastorage.LocationUsage{
+			AipCount:      2,
+			Size:          2048,
+			FileCount:     30,
+			Quota:         new(int64(2048)),
+			QuotaExceeded: true,
+		})
+	})
+}
+
 func TestServiceShow(t *testing.T) {
 	t.Parallel()
 
@@ -1654,6 +2573,54 @@ func TestServiceShow(t *testing.T) {
 			LocationUUID: &uuid.Nil,
 		})
 	})
+
+	t.Run("Returns stored AIP in the user scope", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{}
+		ctx := auth.WithUserClaims(t.Context(), &auth.Claims{
+			Attributes: []string{"storage:aips:*:location/" + locationID.String()},
+		})
+		svc := setUpService(t, ctx, attrs)
+
+		aip := &goastorage.AIP{
+			UUID:         aipID,
+			ObjectKey:    objectKey,
+			LocationUUID: &locationID,
+		}
+		attrs.persistenceMock.
+			EXPECT().
+			ReadAIP(ctx, aipID).
+			Return(aip, nil)
+
+		res, err := svc.ShowAip(ctx, &goastorage.ShowAipPayload{
+			UUID: aipID.String(),
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, res, aip)
+	})
+
+	t.Run("Returns not found if the AIP is not in the user scope", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := &setUpAttrs{}
+		ctx := auth.WithUserClaims(t.Context(), &auth.Claims{
+			Attributes: []string{
+				auth.ScopedAttribute(auth.StorageAIPSReadAttr, auth.LocationScope, uuid.New()),
+			},
+		})
+		svc := setUpService(t, ctx, attrs)
+
+		attrs.persistenceMock.
+			EXPECT().
+			ReadAIP(ctx, aipID).
+			Return(&goastorage.AIP{UUID: aipID, LocationUUID: &locationID}, nil)
+
+		_, err := svc.ShowAip(ctx, &goastorage.ShowAipPayload{
+			UUID: aipID.String(),
+		})
+		assert.DeepEqual(t, err, &goastorage.AIPNotFound{UUID: aipID, Message: "AIP not found"})
+	})
 }
 
 func TestCreateWorkflow(t *testing.T) {
@@ -1934,6 +2901,93 @@ func TestCreateDeletionRequest(t *testing.T) {
 	})
 }
 
+func TestCreateFixityCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Creates a fixity check and publishes an event", func(t *testing.T) {
+		t.Parallel()
+
+		evsvc := event.NewServiceInMem[*goastorage.StorageEvent]()
+		attrs := &setUpAttrs{evsvc: evsvc}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		sub, err := evsvc.Subscribe(ctx)
+		assert.NilError(t, err)
+		defer sub.Close()
+
+		fc := &types.FixityCheck{
+			UUID:              uuid.MustParse("5b1e6f6c-7d2a-4c55-9a43-2b8d7e1c0f3a"),
+			ChecksumAlgorithm: "sha256",
+			ExpectedChecksum:  "abc123",
+			ActualChecksum:    "def456",
+			Status:            enums.FixityCheckStatusFailed,
+			CheckedAt:         time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+			AIPUUID:           uuid.MustParse("e2ace0da-8697-453d-9ea1-4c9b62309e54"),
+			WorkflowDBID:      1,
+		}
+
+		attrs.persistenceMock.
+			EXPECT().
+			CreateFixityCheck(ctx, fc).
+			Return(nil)
+
+		err = svc.CreateFixityCheck(ctx, fc)
+		assert.NilError(t, err)
+
+		select {
+		case ev := <-sub.C():
+			got, ok := ev.Value.AsAipFixityCheckedEvent()
+			assert.Assert(t, ok)
+			assert.DeepEqual(t, got, &goastorage.AIPFixityCheckedEvent{
+				UUID:              fc.UUID,
+				AipUUID:           fc.AIPUUID,
+				Status:            "failed",
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "def456",
+				CheckedAt:         "2026-10-17T12:00:00Z",
+			})
+		case <-time.After(time.Second):
+			t.Fatal("fixity check event not published")
+		}
+	})
+
+	t.Run("Returns a persistence error without publishing an event", func(t *testing.T) {
+		t.Parallel()
+
+		evsvc := event.NewServiceInMem[*goastorage.StorageEvent]()
+		attrs := &setUpAttrs{evsvc: evsvc}
+		ctx := t.Context()
+		svc := setUpService(t, ctx, attrs)
+
+		sub, err := evsvc.Subscribe(ctx)
+		assert.NilError(t, err)
+		defer sub.Close()
+
+		fc := &types.FixityCheck{
+			UUID:    uuid.New(),
+			Status:  enums.FixityCheckStatusPassed,
+			AIPUUID: uuid.New(),
+		}
+		perErr := errors.New("persistence error")
+
+		attrs.persistenceMock.
+			EXPECT().
+			CreateFixityCheck(ctx, fc).
+			Return(perErr)
+
+		err = svc.CreateFixityCheck(ctx, fc)
+		assert.ErrorIs(t, err, perErr)
+
+		select {
+		case ev := <-sub.C():
+			t.Fatalf("unexpected event: %v", ev.Value.Kind())
+		default:
+		}
+	})
+}
+
 func TestListDeletionRequests(t *testing.T) {
 	t.Parallel()
 
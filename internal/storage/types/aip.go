@@ -29,4 +29,22 @@ type AIP struct {
 
 	// DeletionReportKey is the object store key for the AIP deletion report.
 	DeletionReportKey *string
+
+	// ChecksumAlgorithm is the algorithm used to compute ChecksumHash
+	// (e.g. "sha256").
+	ChecksumAlgorithm string
+
+	// ChecksumHash is the checksum of the stored AIP object, used as the
+	// baseline value for fixity checks.
+	ChecksumHash string
+
+	// Size is the size of the stored AIP object in bytes.
+	Size int64
+
+	// FileCount is the number of files in the AIP.
+	FileCount int
+
+	// DisposalAt is the date after which the AIP is due for deletion
+	// according to its retention policy.
+	DisposalAt *time.Time
 }
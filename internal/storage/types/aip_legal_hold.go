@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AIPLegalHold is a legal hold placed on an AIP that prevents its deletion and
+// moves until it's released.
+type AIPLegalHold struct {
+	DBID          int
+	UUID          uuid.UUID
+	AIPUUID       uuid.UUID
+	Reason        string
+	PlacedBy      string
+	PlacedAt      time.Time
+	ReleasedBy    string
+	ReleaseReason string
+	ReleasedAt    time.Time
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+// AIPReplica is a copy of an AIP stored in one of the replication targets of
+// the AIP location.
+type AIPReplica struct {
+	DBID         int
+	AIPUUID      uuid.UUID
+	LocationUUID uuid.UUID
+	Status       enums.ReplicaStatus
+	CreatedAt    time.Time
+	ReplicatedAt time.Time
+}
@@ -9,18 +9,30 @@ import (
 )
 
 type DeletionRequest struct {
-	DBID         int
-	UUID         uuid.UUID
-	Requester    string
-	RequesterIss string
-	RequesterSub string
-	Reviewer     string
-	ReviewerIss  string
-	ReviewerSub  string
-	Reason       string
-	Status       enums.DeletionRequestStatus
-	RequestedAt  time.Time
-	ReviewedAt   time.Time
-	AIPUUID      uuid.UUID
-	WorkflowDBID int
+	DBID              int
+	UUID              uuid.UUID
+	Requester         string
+	RequesterIss      string
+	RequesterSub      string
+	Reviewer          string
+	ReviewerIss       string
+	ReviewerSub       string
+	Reason            string
+	Status            enums.DeletionRequestStatus
+	RequestedAt       time.Time
+	ReviewedAt        time.Time
+	RequiredApprovals int
+	AIPUUID           uuid.UUID
+	WorkflowDBID      int
+}
+
+// DeletionRequestApproval is the approval of a deletion request by one of its
+// reviewers.
+type DeletionRequestApproval struct {
+	DBID                int
+	DeletionRequestDBID int
+	Approver            string
+	ApproverIss         string
+	ApproverSub         string
+	ApprovedAt          time.Time
 }
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+type FixityCheck struct {
+	DBID              int
+	UUID              uuid.UUID
+	ChecksumAlgorithm string
+	ExpectedChecksum  string
+	ActualChecksum    string
+	Status            enums.FixityCheckStatus
+	CheckedAt         time.Time
+	AIPUUID           uuid.UUID
+	WorkflowDBID      int
+}
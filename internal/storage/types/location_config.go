@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/rukavina/sftpblob"
 	"go.artefactual.dev/tools/bucket"
 	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
 
 	"github.com/artefactual-sdps/enduro/internal/storage/ssblob"
 )
@@ -24,9 +28,9 @@ type configVal interface {
 // location.
 //
 // Value holds one backend config that satisfies configVal. The built-in config
-// implementations are S3Config, SFTPConfig, URLConfig, and AMSSConfig. JSON
-// encoding and decoding recognize those four shapes, and decoding rejects
-// documents that describe more than one of them.
+// implementations are S3Config, SFTPConfig, URLConfig, AMSSConfig, and
+// FilesystemConfig. JSON encoding and decoding recognize those five shapes, and
+// decoding rejects documents that describe more than one of them.
 //
 // Each config validates itself and can open the location as a
 // gocloud.dev/blob bucket, which is how most callers use it. A few callers
@@ -43,10 +47,11 @@ type LocationConfig struct {
 }
 
 type configTypes struct {
-	S3         *S3Config   `json:"s3,omitempty"`
-	SFTPConfig *SFTPConfig `json:"sftp,omitempty"`
-	URLConfig  *URLConfig  `json:"url,omitempty"`
-	AMSSConfig *AMSSConfig `json:"amss,omitempty"`
+	S3         *S3Config         `json:"s3,omitempty"`
+	SFTPConfig *SFTPConfig       `json:"sftp,omitempty"`
+	URLConfig  *URLConfig        `json:"url,omitempty"`
+	AMSSConfig *AMSSConfig       `json:"amss,omitempty"`
+	Filesystem *FilesystemConfig `json:"filesystem,omitempty"`
 }
 
 func (c LocationConfig) MarshalJSON() ([]byte, error) {
@@ -61,6 +66,8 @@ func (c LocationConfig) MarshalJSON() ([]byte, error) {
 		types.URLConfig = c
 	case *AMSSConfig:
 		types.AMSSConfig = c
+	case *FilesystemConfig:
+		types.Filesystem = c
 	default:
 		return nil, fmt.Errorf("unsupported config type: %T", c)
 	}
@@ -93,6 +100,8 @@ func (c *LocationConfig) UnmarshalJSON(blob []byte) error {
 		c.Value = types.URLConfig
 	case types.AMSSConfig != nil:
 		c.Value = types.AMSSConfig
+	case types.Filesystem != nil:
+		c.Value = types.Filesystem
 
 	default:
 		return errors.New("undefined configuration document")
@@ -101,6 +110,34 @@ func (c *LocationConfig) UnmarshalJSON(blob []byte) error {
 	return nil
 }
 
+// Scrubbed returns a copy of c without its secrets: the S3 credentials, the
+// SFTP password, the AMSS API key and the password of URL configs.
+func (c LocationConfig) Scrubbed() LocationConfig {
+	switch v := c.Value.(type) {
+	case *S3Config:
+		s := *v
+		s.Key, s.Secret, s.Token = "", "", ""
+		return LocationConfig{Value: &s}
+	case *SFTPConfig:
+		s := *v
+		s.Password = ""
+		return LocationConfig{Value: &s}
+	case *URLConfig:
+		s := *v
+		if u, err := url.Parse(s.URL); err == nil && u.User != nil {
+			u.User = url.User(u.User.Username())
+			s.URL = u.String()
+		}
+		return LocationConfig{Value: &s}
+	case *AMSSConfig:
+		s := *v
+		s.APIKey = ""
+		return LocationConfig{Value: &s}
+	}
+
+	return c
+}
+
 type S3Config struct {
 	Bucket    string `json:"bucket"`
 	Region    string `json:"region"`
@@ -202,3 +239,35 @@ func (c AMSSConfig) OpenBucketWithHTTPClient(ctx context.Context, httpClient *ht
 	}
 	return b, nil
 }
+
+// FilesystemConfig configures a location backed by a directory of the local
+// filesystem, e.g. a mounted NAS volume.
+type FilesystemConfig struct {
+	// Path is the absolute path of the directory where the objects are stored.
+	Path string `json:"path"`
+}
+
+// Valid reports whether Path is an absolute path without parent directory
+// references.
+func (c FilesystemConfig) Valid() bool {
+	if c.Path == "" || !filepath.IsAbs(c.Path) {
+		return false
+	}
+
+	return !slices.Contains(strings.Split(filepath.ToSlash(c.Path), "/"), "..")
+}
+
+// OpenBucket opens a fileblob bucket rooted at Path. The directory must exist.
+// Temporary files are written next to the objects so they can be renamed
+// atomically when the directory is in a different mount than os.TempDir.
+func (c FilesystemConfig) OpenBucket(ctx context.Context) (*blob.Bucket, error) {
+	if !c.Valid() {
+		return nil, fmt.Errorf("open filesystem bucket: invalid path: %q", c.Path)
+	}
+
+	b, err := fileblob.OpenBucket(c.Path, &fileblob.Options{NoTempDir: true})
+	if err != nil {
+		return nil, fmt.Errorf("open filesystem bucket: %v", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,14 @@
+package types
+
+// LocationUsage holds the storage usage totals of the AIPs in a location.
+// Deleted AIPs are not included.
+type LocationUsage struct {
+	// AIPCount is the number of AIPs in the location.
+	AIPCount int
+
+	// Size is the total size of the AIPs in bytes.
+	Size int64
+
+	// FileCount is the total number of files in the AIPs.
+	FileCount int64
+}
@@ -2,12 +2,15 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	temporalsdk_client "go.temporal.io/sdk/client"
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
 
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 )
@@ -15,8 +18,19 @@ import (
 const (
 	CopyToPermanentLocationActivityName = "copy-to-permanent-location-activity"
 	DeleteFromAMSSLocationActivityName  = "delete-from-amss-location-activity"
+	VerifyAIPFixityActivityName         = "verify-aip-fixity-activity"
+	ReplicateAIPActivityName            = "replicate-aip-activity"
+	RestoreAIPActivityName              = "restore-aip-activity"
+	DeleteRestoredAIPActivityName       = "delete-restored-aip-activity"
 	StorageDeleteWorkflowName           = "storage-delete-workflow"
 	StorageMoveWorkflowName             = "storage-move-workflow"
+	StorageFixityWorkflowName           = "storage-fixity-workflow"
+	StorageFixityAuditWorkflowName      = "storage-fixity-audit-workflow"
+	StorageFixityAuditScheduleID        = "storage-fixity-audit-schedule"
+	StorageReplicateWorkflowName        = "storage-replicate-workflow"
+	StorageRetentionWorkflowName        = "storage-retention-workflow"
+	StorageRetentionScheduleID          = "storage-retention-schedule"
+	StorageRestoreWorkflowName          = "storage-restore-workflow"
 	DeletionDecisionSignalName          = "deletion-decision-signal"
 )
 
@@ -44,6 +58,61 @@ type StorageMoveWorkflowRequest struct {
 	TaskQueue  string
 }
 
+// StorageFixityWorkflowRequest is the request of the workflow that verifies
+// the fixity of a single AIP.
+type StorageFixityWorkflowRequest struct {
+	AIPID uuid.UUID
+}
+
+// StorageFixityAuditWorkflowRequest is the request of the scheduled workflow
+// that verifies the fixity of all the stored AIPs.
+type StorageFixityAuditWorkflowRequest struct {
+	// BatchSize is the number of AIPs audited before continuing as new.
+	BatchSize int
+
+	// Offset is the number of AIPs already audited in previous runs.
+	Offset int
+}
+
+// StorageRetentionWorkflowRequest is the request of the scheduled workflow
+// that requests the deletion of the AIPs past their disposal date.
+type StorageRetentionWorkflowRequest struct {
+	// BatchSize is the maximum number of deletion requests created in a run.
+	BatchSize int
+
+	// TaskQueue is the task queue of the AIP deletion workflows.
+	TaskQueue string
+}
+
+// StorageReplicateWorkflowRequest is the request of the workflow that copies
+// an AIP to the replication targets of its location.
+type StorageReplicateWorkflowRequest struct {
+	AIPID     uuid.UUID
+	TaskQueue string
+}
+
+// StorageRestoreWorkflowRequest is the request of the workflow that restores
+// an AIP to a restore location.
+type StorageRestoreWorkflowRequest struct {
+	AIPID uuid.UUID
+
+	// RestoreID identifies the restore request, which may include many AIPs.
+	RestoreID uuid.UUID
+
+	// LocationID is the restore location. If nil, the AIP is restored to the
+	// configured restore bucket.
+	LocationID uuid.UUID
+
+	// Unpack determines whether the AIP is extracted in the restore location.
+	Unpack bool
+
+	// TTL is the duration the restored AIP is kept before it's deleted. If
+	// negative, the restored AIP is kept indefinitely.
+	TTL time.Duration
+
+	TaskQueue string
+}
+
 func StorageDeleteWorkflowID(aipID uuid.UUID) string {
 	return fmt.Sprintf("%s-%s", StorageDeleteWorkflowName, aipID)
 }
@@ -79,3 +148,163 @@ func InitStorageMoveWorkflow(
 	}
 	return tc.ExecuteWorkflow(ctx, opts, StorageMoveWorkflowName, req)
 }
+
+func StorageFixityWorkflowID(aipID uuid.UUID) string {
+	return fmt.Sprintf("%s-%s", StorageFixityWorkflowName, aipID)
+}
+
+func StorageReplicateWorkflowID(aipID uuid.UUID) string {
+	return fmt.Sprintf("%s-%s", StorageReplicateWorkflowName, aipID)
+}
+
+func InitStorageReplicateWorkflow(
+	ctx context.Context,
+	tc temporalsdk_client.Client,
+	req *StorageReplicateWorkflowRequest,
+) (temporalsdk_client.WorkflowRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	opts := temporalsdk_client.StartWorkflowOptions{
+		ID:                    StorageReplicateWorkflowID(req.AIPID),
+		TaskQueue:             req.TaskQueue,
+		WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}
+	return tc.ExecuteWorkflow(ctx, opts, StorageReplicateWorkflowName, req)
+}
+
+func StorageRestoreWorkflowID(aipID, restoreID uuid.UUID) string {
+	return fmt.Sprintf("%s-%s-%s", StorageRestoreWorkflowName, aipID, restoreID)
+}
+
+func InitStorageRestoreWorkflow(
+	ctx context.Context,
+	tc temporalsdk_client.Client,
+	req *StorageRestoreWorkflowRequest,
+) (temporalsdk_client.WorkflowRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	opts := temporalsdk_client.StartWorkflowOptions{
+		ID:                    StorageRestoreWorkflowID(req.AIPID, req.RestoreID),
+		TaskQueue:             req.TaskQueue,
+		WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}
+	return tc.ExecuteWorkflow(ctx, opts, StorageRestoreWorkflowName, req)
+}
+
+// InitStorageFixityAuditSchedule creates or updates the Temporal schedule that
+// periodically starts the fixity audit workflow. If fixity auditing is not
+// enabled, an existing schedule is deleted.
+func InitStorageFixityAuditSchedule(
+	ctx context.Context,
+	tc temporalsdk_client.Client,
+	cfg FixityConfig,
+	taskQueue string,
+) error {
+	var action *temporalsdk_client.ScheduleWorkflowAction
+	if cfg.Enabled {
+		action = &temporalsdk_client.ScheduleWorkflowAction{
+			ID:        StorageFixityAuditWorkflowName,
+			Workflow:  StorageFixityAuditWorkflowName,
+			TaskQueue: taskQueue,
+			Args: []any{
+				&StorageFixityAuditWorkflowRequest{BatchSize: cfg.BatchSize},
+			},
+		}
+	}
+
+	if err := initSchedule(ctx, tc, StorageFixityAuditScheduleID, cfg.Schedule, action); err != nil {
+		return fmt.Errorf("fixity audit schedule: %v", err)
+	}
+
+	return nil
+}
+
+// InitStorageRetentionSchedule creates or updates the Temporal schedule that
+// periodically starts the retention workflow. If retention is not enabled, an
+// existing schedule is deleted.
+func InitStorageRetentionSchedule(
+	ctx context.Context,
+	tc temporalsdk_client.Client,
+	cfg RetentionConfig,
+	taskQueue string,
+) error {
+	var action *temporalsdk_client.ScheduleWorkflowAction
+	if cfg.Enabled {
+		action = &temporalsdk_client.ScheduleWorkflowAction{
+			ID:        StorageRetentionWorkflowName,
+			Workflow:  StorageRetentionWorkflowName,
+			TaskQueue: taskQueue,
+			Args: []any{
+				&StorageRetentionWorkflowRequest{
+					BatchSize: cfg.BatchSize,
+					TaskQueue: taskQueue,
+				},
+			},
+		}
+	}
+
+	if err := initSchedule(ctx, tc, StorageRetentionScheduleID, cfg.Schedule, action); err != nil {
+		return fmt.Errorf("retention schedule: %v", err)
+	}
+
+	return nil
+}
+
+// initSchedule creates or updates the Temporal schedule with the given ID to
+// start action following the cron expression. If action is nil, an existing
+// schedule is deleted.
+func initSchedule(
+	ctx context.Context,
+	tc temporalsdk_client.Client,
+	id string,
+	cron string,
+	action *temporalsdk_client.ScheduleWorkflowAction,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	sc := tc.ScheduleClient()
+
+	if action == nil {
+		err := sc.GetHandle(ctx, id).Delete(ctx)
+		var notFound *serviceerror.NotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return fmt.Errorf("delete: %v", err)
+		}
+		return nil
+	}
+
+	spec := temporalsdk_client.ScheduleSpec{
+		CronExpressions: []string{cron},
+	}
+
+	_, err := sc.Create(ctx, temporalsdk_client.ScheduleOptions{
+		ID:      id,
+		Spec:    spec,
+		Action:  action,
+		Overlap: temporalsdk_api_enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+	})
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, temporalsdk_temporal.ErrScheduleAlreadyRunning) {
+		return fmt.Errorf("create: %v", err)
+	}
+
+	// Update the existing schedule to match the current configuration.
+	err = sc.GetHandle(ctx, id).Update(ctx, temporalsdk_client.ScheduleUpdateOptions{
+		DoUpdate: func(in temporalsdk_client.ScheduleUpdateInput) (*temporalsdk_client.ScheduleUpdate, error) {
+			s := in.Description.Schedule
+			s.Spec = &spec
+			s.Action = action
+			return &temporalsdk_client.ScheduleUpdate{Schedule: &s}, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("update: %v", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package workflows
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -62,6 +63,16 @@ func (w *StorageDeleteWorkflow) Execute(
 	if aip.LocationUUID == nil || *aip.LocationUUID == uuid.Nil {
 		return fmt.Errorf("AIP location is missing")
 	}
+	if underLegalHold(&aip) {
+		return errLegalHold
+	}
+
+	// Get the approval policy of the AIP location. Auto-approval would bypass
+	// a policy requiring the approval of multiple users, so it's refused.
+	policy := w.cfg.ApprovalPolicy(aip.LocationUUID)
+	if req.AutoApprove && policy.MinApprovers > 1 {
+		return errAutoApproveNotAllowed
+	}
 
 	// Set AIP status to processing.
 	if err := updateAIPStatus(ctx, w.storagesvc, req.AIPID, enums.AIPStatusProcessing); err != nil {
@@ -110,6 +121,8 @@ func (w *StorageDeleteWorkflow) Execute(
 	var initialTaskNote string
 	if req.AutoApprove {
 		initialTaskNote = fmt.Sprintf("%s\n\nAuto-approving deletion request.", taskNote)
+	} else if policy.MinApprovers > 1 {
+		initialTaskNote = fmt.Sprintf("%s\n\nAwaiting the approval of %d users.", taskNote, policy.MinApprovers)
 	} else {
 		initialTaskNote = fmt.Sprintf("%s\n\nAwaiting user review.", taskNote)
 	}
@@ -126,7 +139,7 @@ func (w *StorageDeleteWorkflow) Execute(
 	}
 
 	// Wrap review into a function to be able to complete review Task on error.
-	reviewSignal, err := w.review(ctx, logger, req, workflowDBID)
+	reviewSignal, approvers, err := w.review(ctx, logger, req, workflowDBID, policy)
 
 	// Complete review task.
 	taskStatus := enums.TaskStatusDone
@@ -138,7 +151,7 @@ func (w *StorageDeleteWorkflow) Execute(
 	} else {
 		switch reviewSignal.Status {
 		case enums.DeletionRequestStatusApproved:
-			taskNote = fmt.Sprintf("%s\n\nAIP deletion request approved by %s.", taskNote, reviewSignal.UserEmail)
+			taskNote = fmt.Sprintf("%s\n\nAIP deletion request approved by %s.", taskNote, strings.Join(approvers, ", "))
 		case enums.DeletionRequestStatusRejected:
 			taskNote = fmt.Sprintf("%s\n\nAIP deletion request rejected by %s.", taskNote, reviewSignal.UserEmail)
 		case enums.DeletionRequestStatusCanceled:
@@ -155,6 +168,12 @@ func (w *StorageDeleteWorkflow) Execute(
 		return temporalsdk_workflow.ErrCanceled
 	}
 
+	// Fail workflow if a legal hold was placed on the AIP during the review.
+	reviewed, err := checkLegalHold(ctx, w.storagesvc, req.AIPID)
+	if err != nil {
+		return err
+	}
+
 	// Create delete AIP task.
 	deleteTaskID, err := createTask(
 		ctx,
@@ -228,36 +247,50 @@ func (w *StorageDeleteWorkflow) Execute(
 	// If all goes well update AIP status to deleted, used in the defer function.
 	aipStatus = enums.AIPStatusDeleted
 
+	// Delete the AIP replicas, including those created during the review.
+	if len(reviewed.Replicas) > 0 {
+		if err := deleteAIPReplicas(ctx, w.storagesvc, workflowDBID, req.AIPID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// review creates the deletion request and waits for its review. It returns
+// the final decision and the names of the users who approved the request.
 func (w *StorageDeleteWorkflow) review(
 	ctx temporalsdk_workflow.Context,
 	logger temporalsdk_log.Logger,
 	req storage.StorageDeleteWorkflowRequest,
 	workflowDBID int,
-) (*storage.DeletionDecisionSignal, error) {
+	policy storage.DeletionApprovalPolicy,
+) (*storage.DeletionDecisionSignal, []string, error) {
 	// Create DeletionRequest.
-	var drDBID int
+	var dr storage.CreateDeletionRequestLocalActivityResult
 	activityOpts := localActivityOptions(ctx)
 	err := temporalsdk_workflow.ExecuteLocalActivity(
 		activityOpts,
 		storage.CreateDeletionRequestLocalActivity,
 		w.storagesvc,
 		&storage.CreateDeletionRequestLocalActivityParams{
-			Requester:    req.UserEmail,
-			RequesterIss: req.UserIss,
-			RequesterSub: req.UserSub,
-			Reason:       req.Reason,
-			WorkflowDBID: workflowDBID,
-			AIPUUID:      req.AIPID,
+			Requester:         req.UserEmail,
+			RequesterIss:      req.UserIss,
+			RequesterSub:      req.UserSub,
+			Reason:            req.Reason,
+			RequiredApprovals: policy.MinApprovers,
+			WorkflowDBID:      workflowDBID,
+			AIPUUID:           req.AIPID,
 		},
-	).Get(activityOpts, &drDBID)
+	).Get(activityOpts, &dr)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var signal storage.DeletionDecisionSignal
+	var (
+		signal    storage.DeletionDecisionSignal
+		approvals []storage.DeletionDecisionSignal
+	)
 	if req.AutoApprove {
 		signal = storage.DeletionDecisionSignal{
 			Status:    enums.DeletionRequestStatusApproved,
@@ -266,33 +299,66 @@ func (w *StorageDeleteWorkflow) review(
 			UserIss:   req.UserIss,
 		}
 		logger.Info("Auto-approved AIP deletion workflow decision", "signal", signal)
+
+		if err := createDeletionRequestApproval(ctx, w.storagesvc, dr.DBID, signal); err != nil {
+			return nil, nil, err
+		}
+		approvals = append(approvals, signal)
 	} else {
 		// Set Workflow status to pending.
 		if err := updateWorkflowStatus(ctx, w.storagesvc, workflowDBID, enums.WorkflowStatusPending); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Set AIP status to pending.
 		if err := updateAIPStatus(ctx, w.storagesvc, req.AIPID, enums.AIPStatusPending); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		// Wait for a delete request decision signal.
-		open := temporalsdk_workflow.GetSignalChannel(ctx, storage.DeletionDecisionSignalName).Receive(ctx, &signal)
-		if !open {
-			return nil, fmt.Errorf("deletion decision signal channel closed")
-		}
+		// Wait for delete request decision signals until the request is
+		// rejected or canceled, or approved by enough distinct users.
+		ch := temporalsdk_workflow.GetSignalChannel(ctx, storage.DeletionDecisionSignalName)
+		for {
+			open := ch.Receive(ctx, &signal)
+			if !open {
+				return nil, nil, fmt.Errorf("deletion decision signal channel closed")
+			}
 
-		logger.Info("Received AIP deletion workflow decision", "signal", signal)
+			logger.Info("Received AIP deletion workflow decision", "signal", signal)
+
+			if signal.Status != enums.DeletionRequestStatusApproved {
+				break
+			}
+			if !policy.RequesterCanApprove && signal.UserIss == req.UserIss && signal.UserSub == req.UserSub {
+				logger.Warn("Ignoring AIP deletion approval by the requester", "signal", signal)
+				continue
+			}
+			if slices.ContainsFunc(approvals, func(a storage.DeletionDecisionSignal) bool {
+				return a.UserIss == signal.UserIss && a.UserSub == signal.UserSub
+			}) {
+				logger.Warn("Ignoring repeated AIP deletion approval", "signal", signal)
+				continue
+			}
+
+			if err := createDeletionRequestApproval(ctx, w.storagesvc, dr.DBID, signal); err != nil {
+				return nil, nil, err
+			}
+			approvals = append(approvals, signal)
+			// Use the approvals required by the persisted request, the location
+			// policy may have changed since the request was created.
+			if len(approvals) >= dr.RequiredApprovals {
+				break
+			}
+		}
 
 		// Set AIP status to processing.
 		if err := updateAIPStatus(ctx, w.storagesvc, req.AIPID, enums.AIPStatusProcessing); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Set Workflow status to in progress.
 		if err := updateWorkflowStatus(ctx, w.storagesvc, workflowDBID, enums.WorkflowStatusInProgress); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -301,14 +367,35 @@ func (w *StorageDeleteWorkflow) review(
 		activityOpts,
 		storage.UpdateDeletionRequestLocalActivity,
 		w.storagesvc,
-		drDBID,
+		dr.DBID,
 		signal,
 	).Get(activityOpts, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	approvers := make([]string, len(approvals))
+	for i, a := range approvals {
+		approvers[i] = a.UserEmail
 	}
 
-	return &signal, nil
+	return &signal, approvers, nil
+}
+
+func createDeletionRequestApproval(
+	ctx temporalsdk_workflow.Context,
+	storagesvc storage.Service,
+	drDBID int,
+	approval storage.DeletionDecisionSignal,
+) error {
+	activityOpts := localActivityOptions(ctx)
+	return temporalsdk_workflow.ExecuteLocalActivity(
+		activityOpts,
+		storage.CreateDeletionRequestApprovalLocalActivity,
+		storagesvc,
+		drDBID,
+		approval,
+	).Get(activityOpts, nil)
 }
 
 func (w *StorageDeleteWorkflow) deleteAIPFromAMSSLocation(
@@ -1,6 +1,7 @@
 package workflows
 
 import (
+	"cmp"
 	"fmt"
 	"strings"
 	"testing"
@@ -38,9 +39,13 @@ type StorageDeleteWorkflowTestSuite struct {
 	storagesvc storage.Service
 	formFiller pdfs.FormFiller
 
-	req        *storage.StorageDeleteWorkflowRequest
-	aip        *goastorage.AIP
-	reviewTask *datatypes.Task
+	req               *storage.StorageDeleteWorkflowRequest
+	aip               *goastorage.AIP
+	reviewTask        *datatypes.Task
+	requiredApprovals int
+	// persistedApprovals is the number of approvals stored on the deletion
+	// request, it defaults to requiredApprovals.
+	persistedApprovals int
 }
 
 func NewStorageDeleteWorkflowTestSuite(
@@ -61,10 +66,11 @@ func NewStorageDeleteWorkflowTestSuite(
 		Status:       enums.AIPStatusStored.String(),
 	}
 	s.reviewTask = &datatypes.Task{ID: 1}
+	s.requiredApprovals = 1
 	httpClient := cleanhttp.DefaultPooledClient()
 
 	s.env.RegisterActivityWithOptions(
-		activities.NewDeleteFromAMSSLocationActivity(httpClient, false, time.Microsecond*1).Execute,
+		activities.NewDeleteFromAMSSLocationActivity(s.storagesvc, httpClient, false, time.Microsecond*1).Execute,
 		temporalsdk_activity.RegisterOptions{Name: storage.DeleteFromAMSSLocationActivityName},
 	)
 	s.env.RegisterActivityWithOptions(
@@ -119,6 +125,12 @@ func (s *StorageDeleteWorkflowTestSuite) createDeletionRequest() {
 	initialTaskNote := fmt.Sprintf("%s\n\nAwaiting user review.", s.reviewTask.Note)
 	if s.req.AutoApprove {
 		initialTaskNote = fmt.Sprintf("%s\n\nAuto-approving deletion request.", s.reviewTask.Note)
+	} else if s.requiredApprovals > 1 {
+		initialTaskNote = fmt.Sprintf(
+			"%s\n\nAwaiting the approval of %d users.",
+			s.reviewTask.Note,
+			s.requiredApprovals,
+		)
 	}
 	s.env.OnActivity(
 		storage.CreateTaskLocalActivity,
@@ -137,14 +149,18 @@ func (s *StorageDeleteWorkflowTestSuite) createDeletionRequest() {
 		mock.AnythingOfType("*context.valueCtx"),
 		s.storagesvc,
 		&storage.CreateDeletionRequestLocalActivityParams{
-			Requester:    s.req.UserEmail,
-			RequesterIss: s.req.UserIss,
-			RequesterSub: s.req.UserSub,
-			Reason:       s.req.Reason,
-			WorkflowDBID: workflowDBID,
-			AIPUUID:      s.req.AIPID,
+			Requester:         s.req.UserEmail,
+			RequesterIss:      s.req.UserIss,
+			RequesterSub:      s.req.UserSub,
+			Reason:            s.req.Reason,
+			RequiredApprovals: s.requiredApprovals,
+			WorkflowDBID:      workflowDBID,
+			AIPUUID:           s.req.AIPID,
 		},
-	).Return(deletionRequestDBID, nil)
+	).Return(&storage.CreateDeletionRequestLocalActivityResult{
+		DBID:              deletionRequestDBID,
+		RequiredApprovals: cmp.Or(s.persistedApprovals, s.requiredApprovals),
+	}, nil)
 
 	if !s.req.AutoApprove {
 		s.env.OnActivity(
@@ -169,6 +185,18 @@ func (s *StorageDeleteWorkflowTestSuite) createDeletionRequest() {
 	}
 }
 
+// approveDeletionRequest mocks the activity called when a user approves the
+// deletion request.
+func (s *StorageDeleteWorkflowTestSuite) approveDeletionRequest(signal storage.DeletionDecisionSignal) {
+	s.env.OnActivity(
+		storage.CreateDeletionRequestApprovalLocalActivity,
+		mock.AnythingOfType("*context.valueCtx"),
+		s.storagesvc,
+		deletionRequestDBID,
+		signal,
+	).Return(nil).Once()
+}
+
 func TestStorageDeleteWorkflow(t *testing.T) {
 	t.Parallel()
 
@@ -204,6 +232,7 @@ func TestStorageDeleteWorkflow(t *testing.T) {
 
 		s := NewStorageDeleteWorkflowTestSuite(t, &req)
 		s.createDeletionRequest()
+		s.approveDeletionRequest(signal)
 
 		s.env.RegisterDelayedCallback(
 			func() {
@@ -376,6 +405,7 @@ func TestStorageDeleteWorkflow(t *testing.T) {
 
 		s := NewStorageDeleteWorkflowTestSuite(t, &req)
 		s.createDeletionRequest()
+		s.approveDeletionRequest(signal)
 
 		s.env.OnActivity(
 			storage.UpdateDeletionRequestLocalActivity,
@@ -473,55 +503,171 @@ func TestStorageDeleteWorkflow(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	t.Run("Create and cancel deletion request", func(t *testing.T) {
+	t.Run("Auto-approve deletion request from a filesystem location", func(t *testing.T) {
 		t.Parallel()
 
 		req := storage.StorageDeleteWorkflowRequest{
-			AIPID:     uuid.New(),
-			Reason:    "Reason",
-			UserEmail: "requester@example.com",
-			UserSub:   "subject",
-			UserIss:   "issuer",
-			TaskQueue: "global",
+			AIPID:       uuid.New(),
+			Reason:      "Reason",
+			UserEmail:   "requester@example.com",
+			UserSub:     "subject",
+			UserIss:     "issuer",
+			TaskQueue:   "global",
+			AutoApprove: true,
+			SkipReport:  true,
 		}
 
 		signal := storage.DeletionDecisionSignal{
-			Status:    enums.DeletionRequestStatusCanceled,
-			UserEmail: "requester@example.com",
-			UserIss:   "issuer",
-			UserSub:   "subject",
+			Status:    enums.DeletionRequestStatusApproved,
+			UserEmail: req.UserEmail,
+			UserIss:   req.UserIss,
+			UserSub:   req.UserSub,
+		}
+
+		locationInfo := &storage.ReadLocationInfoLocalActivityResult{
+			Source: enums.LocationSourceFilesystem,
+			Config: types.LocationConfig{Value: &types.FilesystemConfig{
+				Path: "/mnt/nas/aips",
+			}},
 		}
 
+		deleteTaskDBID := 3
+
 		s := NewStorageDeleteWorkflowTestSuite(t, &req)
 		s.createDeletionRequest()
+		s.approveDeletionRequest(signal)
 
-		s.env.RegisterDelayedCallback(
-			func() {
-				s.env.SignalWorkflow(storage.DeletionDecisionSignalName, signal)
+		s.env.OnActivity(
+			storage.UpdateDeletionRequestLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			deletionRequestDBID,
+			signal,
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   s.reviewTask.ID,
+				Status: enums.TaskStatusDone,
+				Note:   fmt.Sprintf("%s\n\nAuto-approved deletion request.", s.reviewTask.Note),
 			},
-			0,
-		)
+		).Return(nil)
 
 		s.env.OnActivity(
-			storage.UpdateAIPStatusLocalActivity,
+			storage.CreateTaskLocalActivity,
 			mock.AnythingOfType("*context.valueCtx"),
 			s.storagesvc,
-			&storage.UpdateAIPStatusLocalActivityParams{
-				AIPID:  s.aip.UUID,
-				Status: enums.AIPStatusProcessing,
+			&storage.CreateTaskLocalActivityParams{
+				WorkflowDBID: workflowDBID,
+				Status:       enums.TaskStatusInProgress,
+				Name:         "Delete AIP",
+				Note:         "Deleting AIP",
+			},
+		).Return(deleteTaskDBID, nil)
+
+		s.env.OnActivity(
+			storage.ReadLocationInfoLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			*s.aip.LocationUUID,
+		).Return(locationInfo, nil)
+
+		s.env.OnActivity(
+			storage.DeleteAIPLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.DeleteAIPLocalActivityParams{AIPID: s.aip.UUID},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   deleteTaskDBID,
+				Status: enums.TaskStatusDone,
+				Note:   "AIP deleted from FILESYSTEM source location",
 			},
 		).Return(nil)
 
 		s.env.OnActivity(
-			storage.UpdateWorkflowStatusLocalActivity,
+			storage.CompleteWorkflowLocalActivity,
 			mock.AnythingOfType("*context.valueCtx"),
 			s.storagesvc,
-			&storage.UpdateWorkflowStatusLocalActivityParams{
+			&storage.CompleteWorkflowLocalActivityParams{
 				DBID:   workflowDBID,
-				Status: enums.WorkflowStatusInProgress,
+				Status: enums.WorkflowStatusDone,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateAIPStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateAIPStatusLocalActivityParams{
+				AIPID:  s.aip.UUID,
+				Status: enums.AIPStatusDeleted,
 			},
 		).Return(nil)
 
+		s.env.ExecuteWorkflow(
+			NewStorageDeleteWorkflow(
+				storage.AIPDeletionConfig{
+					ReportTemplatePath: "../../../assets/Enduro_AIP_deletion_report_v3.tmpl.pdf",
+				},
+				s.storagesvc,
+			).Execute,
+			req,
+		)
+
+		require.True(t, s.env.IsWorkflowCompleted())
+		err := s.env.GetWorkflowResult(nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("Deletes the AIP replicas", func(t *testing.T) {
+		t.Parallel()
+
+		req := storage.StorageDeleteWorkflowRequest{
+			AIPID:       uuid.New(),
+			Reason:      "Reason",
+			UserEmail:   "requester@example.com",
+			UserSub:     "subject",
+			UserIss:     "issuer",
+			TaskQueue:   "global",
+			AutoApprove: true,
+			SkipReport:  true,
+		}
+
+		signal := storage.DeletionDecisionSignal{
+			Status:    enums.DeletionRequestStatusApproved,
+			UserEmail: req.UserEmail,
+			UserIss:   req.UserIss,
+			UserSub:   req.UserSub,
+		}
+
+		locationInfo := &storage.ReadLocationInfoLocalActivityResult{
+			Source: enums.LocationSourceFilesystem,
+			Config: types.LocationConfig{Value: &types.FilesystemConfig{
+				Path: "/mnt/nas/aips",
+			}},
+		}
+
+		deleteTaskDBID := 3
+
+		replicaLocationID := uuid.New()
+		deleteReplicasTaskDBID := 4
+
+		s := NewStorageDeleteWorkflowTestSuite(t, &req)
+		s.aip.Replicas = goastorage.AIPReplicaCollection{
+			{LocationUUID: replicaLocationID, Status: enums.ReplicaStatusReplicated.String()},
+		}
+		s.createDeletionRequest()
+		s.approveDeletionRequest(signal)
+
 		s.env.OnActivity(
 			storage.UpdateDeletionRequestLocalActivity,
 			mock.AnythingOfType("*context.valueCtx"),
@@ -537,18 +683,86 @@ func TestStorageDeleteWorkflow(t *testing.T) {
 			&storage.CompleteTaskLocalActivityParams{
 				DBID:   s.reviewTask.ID,
 				Status: enums.TaskStatusDone,
-				Note:   fmt.Sprintf("%s\n\nAIP deletion request canceled by %s.", s.reviewTask.Note, signal.UserEmail),
+				Note:   fmt.Sprintf("%s\n\nAuto-approved deletion request.", s.reviewTask.Note),
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CreateTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CreateTaskLocalActivityParams{
+				WorkflowDBID: workflowDBID,
+				Status:       enums.TaskStatusInProgress,
+				Name:         "Delete AIP",
+				Note:         "Deleting AIP",
+			},
+		).Return(deleteTaskDBID, nil)
+
+		s.env.OnActivity(
+			storage.ReadLocationInfoLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			*s.aip.LocationUUID,
+		).Return(locationInfo, nil)
+
+		s.env.OnActivity(
+			storage.DeleteAIPLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.DeleteAIPLocalActivityParams{AIPID: s.aip.UUID},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   deleteTaskDBID,
+				Status: enums.TaskStatusDone,
+				Note:   "AIP deleted from FILESYSTEM source location",
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CreateTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CreateTaskLocalActivityParams{
+				WorkflowDBID: workflowDBID,
+				Status:       enums.TaskStatusInProgress,
+				Name:         "Delete AIP replicas",
+				Note:         "Deleting AIP replicas from replication locations",
+			},
+		).Return(deleteReplicasTaskDBID, nil)
+
+		s.env.OnActivity(
+			storage.DeleteAIPReplicasLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.DeleteAIPReplicasLocalActivityParams{AIPID: s.aip.UUID},
+		).Return(&storage.DeleteAIPReplicasLocalActivityResult{
+			LocationIDs: []uuid.UUID{replicaLocationID},
+		}, nil)
+
+		s.env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   deleteReplicasTaskDBID,
+				Status: enums.TaskStatusDone,
+				Note:   "AIP replicas deleted from replication locations:\n" + replicaLocationID.String(),
 			},
 		).Return(nil)
 
-		// These activities are from the deferred workflow completion callback.
 		s.env.OnActivity(
 			storage.CompleteWorkflowLocalActivity,
 			mock.AnythingOfType("*context.valueCtx"),
 			s.storagesvc,
 			&storage.CompleteWorkflowLocalActivityParams{
 				DBID:   workflowDBID,
-				Status: enums.WorkflowStatusCanceled,
+				Status: enums.WorkflowStatusDone,
 			},
 		).Return(nil)
 
@@ -558,7 +772,7 @@ func TestStorageDeleteWorkflow(t *testing.T) {
 			s.storagesvc,
 			&storage.UpdateAIPStatusLocalActivityParams{
 				AIPID:  s.aip.UUID,
-				Status: enums.AIPStatusStored,
+				Status: enums.AIPStatusDeleted,
 			},
 		).Return(nil)
 
@@ -574,6 +788,468 @@ func TestStorageDeleteWorkflow(t *testing.T) {
 
 		require.True(t, s.env.IsWorkflowCompleted())
 		err := s.env.GetWorkflowResult(nil)
-		require.ErrorContains(t, err, "canceled")
+		require.NoError(t, err)
+		s.env.AssertExpectations(t)
+	})
+
+	t.Run("Requires the approval of several distinct users", func(t *testing.T) {
+		t.Parallel()
+
+		req := storage.StorageDeleteWorkflowRequest{
+			AIPID:      uuid.New(),
+			Reason:     "Reason",
+			UserEmail:  "requester@example.com",
+			UserSub:    "subject",
+			UserIss:    "issuer",
+			TaskQueue:  "global",
+			SkipReport: true,
+		}
+
+		signal1 := storage.DeletionDecisionSignal{
+			Status:    enums.DeletionRequestStatusApproved,
+			UserEmail: "reviewer1@example.com",
+			UserIss:   "issuer",
+			UserSub:   "subject-1",
+		}
+		signal2 := storage.DeletionDecisionSignal{
+			Status:    enums.DeletionRequestStatusApproved,
+			UserEmail: "reviewer2@example.com",
+			UserIss:   "issuer",
+			UserSub:   "subject-2",
+		}
+		requesterSignal := storage.DeletionDecisionSignal{
+			Status:    enums.DeletionRequestStatusApproved,
+			UserEmail: req.UserEmail,
+			UserIss:   req.UserIss,
+			UserSub:   req.UserSub,
+		}
+
+		locationInfo := &storage.ReadLocationInfoLocalActivityResult{
+			Source: enums.LocationSourceFilesystem,
+			Config: types.LocationConfig{Value: &types.FilesystemConfig{
+				Path: "/mnt/nas/aips",
+			}},
+		}
+
+		deleteTaskDBID := 3
+
+		s := NewStorageDeleteWorkflowTestSuite(t, &req)
+		s.requiredApprovals = 2
+		s.createDeletionRequest()
+
+		// The repeated approval and the approval of the requester are ignored.
+		s.approveDeletionRequest(signal1)
+		s.approveDeletionRequest(signal2)
+		s.env.RegisterDelayedCallback(
+			func() {
+				s.env.SignalWorkflow(storage.DeletionDecisionSignalName, signal1)
+				s.env.SignalWorkflow(storage.DeletionDecisionSignalName, signal1)
+				s.env.SignalWorkflow(storage.DeletionDecisionSignalName, requesterSignal)
+				s.env.SignalWorkflow(storage.DeletionDecisionSignalName, signal2)
+			},
+			0,
+		)
+
+		s.env.OnActivity(
+			storage.UpdateAIPStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateAIPStatusLocalActivityParams{
+				AIPID:  s.aip.UUID,
+				Status: enums.AIPStatusProcessing,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateWorkflowStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateWorkflowStatusLocalActivityParams{
+				DBID:   workflowDBID,
+				Status: enums.WorkflowStatusInProgress,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateDeletionRequestLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			deletionRequestDBID,
+			signal2,
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   s.reviewTask.ID,
+				Status: enums.TaskStatusDone,
+				Note: fmt.Sprintf(
+					"%s\n\nAIP deletion request approved by %s, %s.",
+					s.reviewTask.Note,
+					signal1.UserEmail,
+					signal2.UserEmail,
+				),
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CreateTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CreateTaskLocalActivityParams{
+				WorkflowDBID: workflowDBID,
+				Status:       enums.TaskStatusInProgress,
+				Name:         "Delete AIP",
+				Note:         "Deleting AIP",
+			},
+		).Return(deleteTaskDBID, nil)
+
+		s.env.OnActivity(
+			storage.ReadLocationInfoLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			*s.aip.LocationUUID,
+		).Return(locationInfo, nil)
+
+		s.env.OnActivity(
+			storage.DeleteAIPLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.DeleteAIPLocalActivityParams{AIPID: s.aip.UUID},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   deleteTaskDBID,
+				Status: enums.TaskStatusDone,
+				Note:   "AIP deleted from FILESYSTEM source location",
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CompleteWorkflowLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteWorkflowLocalActivityParams{
+				DBID:   workflowDBID,
+				Status: enums.WorkflowStatusDone,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateAIPStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateAIPStatusLocalActivityParams{
+				AIPID:  s.aip.UUID,
+				Status: enums.AIPStatusDeleted,
+			},
+		).Return(nil)
+
+		s.env.ExecuteWorkflow(
+			NewStorageDeleteWorkflow(storage.AIPDeletionConfig{MinApprovers: 2}, s.storagesvc).Execute,
+			req,
+		)
+
+		require.True(t, s.env.IsWorkflowCompleted())
+		err := s.env.GetWorkflowResult(nil)
+		require.NoError(t, err)
+		s.env.AssertExpectations(t)
+	})
+
+	t.Run("Waits for the approvals required by the persisted deletion request", func(t *testing.T) {
+		t.Parallel()
+
+		req := storage.StorageDeleteWorkflowRequest{
+			AIPID:     uuid.New(),
+			Reason:    "Reason",
+			UserEmail: "requester@example.com",
+			UserSub:   "subject",
+			UserIss:   "issuer",
+			TaskQueue: "global",
+		}
+
+		approveSignal := storage.DeletionDecisionSignal{
+			Status:    enums.DeletionRequestStatusApproved,
+			UserEmail: "reviewer@example.com",
+			UserIss:   "issuer",
+			UserSub:   "subject-1",
+		}
+		cancelSignal := storage.DeletionDecisionSignal{
+			Status:    enums.DeletionRequestStatusCanceled,
+			UserEmail: req.UserEmail,
+			UserIss:   req.UserIss,
+			UserSub:   req.UserSub,
+		}
+
+		// The configuration requires a single approval, but the deletion
+		// request was persisted requiring two.
+		s := NewStorageDeleteWorkflowTestSuite(t, &req)
+		s.persistedApprovals = 2
+		s.createDeletionRequest()
+
+		s.approveDeletionRequest(approveSignal)
+		s.env.RegisterDelayedCallback(
+			func() {
+				s.env.SignalWorkflow(storage.DeletionDecisionSignalName, approveSignal)
+				s.env.SignalWorkflow(storage.DeletionDecisionSignalName, cancelSignal)
+			},
+			0,
+		)
+
+		s.env.OnActivity(
+			storage.UpdateAIPStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateAIPStatusLocalActivityParams{
+				AIPID:  s.aip.UUID,
+				Status: enums.AIPStatusProcessing,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateWorkflowStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateWorkflowStatusLocalActivityParams{
+				DBID:   workflowDBID,
+				Status: enums.WorkflowStatusInProgress,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateDeletionRequestLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			deletionRequestDBID,
+			cancelSignal,
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   s.reviewTask.ID,
+				Status: enums.TaskStatusDone,
+				Note: fmt.Sprintf(
+					"%s\n\nAIP deletion request canceled by %s.",
+					s.reviewTask.Note,
+					cancelSignal.UserEmail,
+				),
+			},
+		).Return(nil)
+
+		// These activities are from the deferred workflow completion callback.
+		s.env.OnActivity(
+			storage.CompleteWorkflowLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteWorkflowLocalActivityParams{
+				DBID:   workflowDBID,
+				Status: enums.WorkflowStatusCanceled,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateAIPStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateAIPStatusLocalActivityParams{
+				AIPID:  s.aip.UUID,
+				Status: enums.AIPStatusStored,
+			},
+		).Return(nil)
+
+		s.env.ExecuteWorkflow(
+			NewStorageDeleteWorkflow(storage.AIPDeletionConfig{MinApprovers: 1}, s.storagesvc).Execute,
+			req,
+		)
+
+		require.True(t, s.env.IsWorkflowCompleted())
+		err := s.env.GetWorkflowResult(nil)
+		require.ErrorContains(t, err, "canceled")
+		s.env.AssertExpectations(t)
+	})
+
+	t.Run("Create and cancel deletion request", func(t *testing.T) {
+		t.Parallel()
+
+		req := storage.StorageDeleteWorkflowRequest{
+			AIPID:     uuid.New(),
+			Reason:    "Reason",
+			UserEmail: "requester@example.com",
+			UserSub:   "subject",
+			UserIss:   "issuer",
+			TaskQueue: "global",
+		}
+
+		signal := storage.DeletionDecisionSignal{
+			Status:    enums.DeletionRequestStatusCanceled,
+			UserEmail: "requester@example.com",
+			UserIss:   "issuer",
+			UserSub:   "subject",
+		}
+
+		s := NewStorageDeleteWorkflowTestSuite(t, &req)
+		s.createDeletionRequest()
+
+		s.env.RegisterDelayedCallback(
+			func() {
+				s.env.SignalWorkflow(storage.DeletionDecisionSignalName, signal)
+			},
+			0,
+		)
+
+		s.env.OnActivity(
+			storage.UpdateAIPStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateAIPStatusLocalActivityParams{
+				AIPID:  s.aip.UUID,
+				Status: enums.AIPStatusProcessing,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateWorkflowStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateWorkflowStatusLocalActivityParams{
+				DBID:   workflowDBID,
+				Status: enums.WorkflowStatusInProgress,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateDeletionRequestLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			deletionRequestDBID,
+			signal,
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   s.reviewTask.ID,
+				Status: enums.TaskStatusDone,
+				Note:   fmt.Sprintf("%s\n\nAIP deletion request canceled by %s.", s.reviewTask.Note, signal.UserEmail),
+			},
+		).Return(nil)
+
+		// These activities are from the deferred workflow completion callback.
+		s.env.OnActivity(
+			storage.CompleteWorkflowLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.CompleteWorkflowLocalActivityParams{
+				DBID:   workflowDBID,
+				Status: enums.WorkflowStatusCanceled,
+			},
+		).Return(nil)
+
+		s.env.OnActivity(
+			storage.UpdateAIPStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			&storage.UpdateAIPStatusLocalActivityParams{
+				AIPID:  s.aip.UUID,
+				Status: enums.AIPStatusStored,
+			},
+		).Return(nil)
+
+		s.env.ExecuteWorkflow(
+			NewStorageDeleteWorkflow(
+				storage.AIPDeletionConfig{
+					ReportTemplatePath: "../../../assets/Enduro_AIP_deletion_report_v3.tmpl.pdf",
+				},
+				s.storagesvc,
+			).Execute,
+			req,
+		)
+
+		require.True(t, s.env.IsWorkflowCompleted())
+		err := s.env.GetWorkflowResult(nil)
+		require.ErrorContains(t, err, "canceled")
+	})
+
+	t.Run("Fails when the AIP is under legal hold", func(t *testing.T) {
+		t.Parallel()
+
+		req := storage.StorageDeleteWorkflowRequest{
+			AIPID:     uuid.New(),
+			Reason:    "Reason",
+			UserEmail: "requester@example.com",
+			UserSub:   "subject",
+			UserIss:   "issuer",
+			TaskQueue: "global",
+		}
+
+		s := NewStorageDeleteWorkflowTestSuite(t, &req)
+		s.aip.LegalHolds = goastorage.AIPLegalHoldCollection{
+			{UUID: uuid.New(), Reason: "Litigation", PlacedBy: "Jane Doe", PlacedAt: "2026-10-17T10:00:00Z"},
+		}
+
+		s.env.OnActivity(
+			storage.ReadAIPLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			s.aip.UUID,
+		).Return(s.aip, nil)
+
+		s.env.ExecuteWorkflow(
+			NewStorageDeleteWorkflow(storage.AIPDeletionConfig{}, s.storagesvc).Execute,
+			req,
+		)
+
+		require.True(t, s.env.IsWorkflowCompleted())
+		err := s.env.GetWorkflowResult(nil)
+		require.ErrorContains(t, err, "AIP is under legal hold")
+		s.env.AssertExpectations(t)
+	})
+
+	t.Run("Refuses to auto-approve when multiple approvers are required", func(t *testing.T) {
+		t.Parallel()
+
+		req := storage.StorageDeleteWorkflowRequest{
+			AIPID:       uuid.New(),
+			Reason:      "Reason",
+			UserEmail:   "requester@example.com",
+			UserSub:     "subject",
+			UserIss:     "issuer",
+			TaskQueue:   "global",
+			AutoApprove: true,
+		}
+
+		s := NewStorageDeleteWorkflowTestSuite(t, &req)
+
+		s.env.OnActivity(
+			storage.ReadAIPLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			s.storagesvc,
+			s.aip.UUID,
+		).Return(s.aip, nil)
+
+		s.env.ExecuteWorkflow(
+			NewStorageDeleteWorkflow(storage.AIPDeletionConfig{
+				ApprovalPolicies: []storage.DeletionApprovalPolicy{
+					{LocationID: *s.aip.LocationUUID, MinApprovers: 2},
+				},
+			}, s.storagesvc).Execute,
+			req,
+		)
+
+		require.True(t, s.env.IsWorkflowCompleted())
+		err := s.env.GetWorkflowResult(nil)
+		require.ErrorContains(t, err, "AIP deletion can't be auto-approved: multiple approvers required")
+		s.env.AssertExpectations(t)
 	})
 }
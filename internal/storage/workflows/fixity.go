@@ -0,0 +1,205 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+// defaultFixityAuditBatchSize is the number of AIPs audited per run when the
+// request doesn't set a batch size.
+const defaultFixityAuditBatchSize = 100
+
+// StorageFixityWorkflow verifies the fixity of a single AIP.
+type StorageFixityWorkflow struct {
+	storagesvc storage.Service
+}
+
+func NewStorageFixityWorkflow(storagesvc storage.Service) *StorageFixityWorkflow {
+	return &StorageFixityWorkflow{
+		storagesvc: storagesvc,
+	}
+}
+
+func (w *StorageFixityWorkflow) Execute(
+	ctx temporalsdk_workflow.Context,
+	req storage.StorageFixityWorkflowRequest,
+) (e error) {
+	// Create persistence workflow.
+	workflowDBID, err := createWorkflow(ctx, w.storagesvc, req.AIPID, enums.WorkflowTypeAuditAip)
+	if err != nil {
+		return err
+	}
+
+	// Complete persistence workflow.
+	status := enums.WorkflowStatusDone
+	defer func() {
+		if e != nil {
+			status = enums.WorkflowStatusError
+		}
+
+		err := completeWorkflow(ctx, w.storagesvc, workflowDBID, status)
+		if err != nil {
+			e = errors.Join(e, err)
+		}
+	}()
+
+	// Create verify fixity task.
+	taskID, err := createTask(
+		ctx,
+		w.storagesvc,
+		workflowDBID,
+		enums.TaskStatusInProgress,
+		"Verify fixity",
+		"Verifying AIP checksum",
+	)
+	if err != nil {
+		return err
+	}
+
+	// Recompute the AIP checksum and compare it with the stored value.
+	{
+		var re activities.VerifyAIPFixityActivityResult
+		activityOpts := temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
+			StartToCloseTimeout: time.Hour * 2,
+			HeartbeatTimeout:    time.Second * 20,
+			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+				InitialInterval:    time.Second,
+				BackoffCoefficient: 2,
+				MaximumInterval:    time.Minute * 10,
+				MaximumAttempts:    5,
+				NonRetryableErrorTypes: []string{
+					"TemporalTimeout:StartToClose",
+				},
+			},
+		})
+		err := temporalsdk_workflow.ExecuteActivity(
+			activityOpts,
+			storage.VerifyAIPFixityActivityName,
+			&activities.VerifyAIPFixityActivityParams{
+				AIPID:        req.AIPID,
+				WorkflowDBID: workflowDBID,
+			},
+		).Get(activityOpts, &re)
+
+		// Complete verify fixity task.
+		taskStatus := enums.TaskStatusDone
+		var taskNote string
+		switch {
+		case err != nil:
+			taskStatus = enums.TaskStatusError
+			taskNote = fmt.Sprintf("Failed to verify AIP fixity\n%s", err.Error())
+		case re.Status == enums.FixityCheckStatusFailed:
+			taskStatus = enums.TaskStatusError
+			status = enums.WorkflowStatusError
+			taskNote = fmt.Sprintf(
+				"AIP fixity check failed, checksums don't match\nExpected %s: %s\nActual %s: %s",
+				re.ChecksumAlgorithm,
+				re.ExpectedChecksum,
+				re.ChecksumAlgorithm,
+				re.ActualChecksum,
+			)
+		case re.Status == enums.FixityCheckStatusBaseline:
+			taskNote = fmt.Sprintf(
+				"AIP checksum recorded as fixity baseline\n%s: %s",
+				re.ChecksumAlgorithm,
+				re.ActualChecksum,
+			)
+		default:
+			taskNote = fmt.Sprintf(
+				"AIP fixity check passed\n%s: %s",
+				re.ChecksumAlgorithm,
+				re.ActualChecksum,
+			)
+		}
+		taskErr := completeTask(ctx, w.storagesvc, taskID, taskStatus, taskNote)
+		if err = errors.Join(err, taskErr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StorageFixityAuditWorkflow verifies the fixity of all the stored AIPs, one
+// batch per run. It's started periodically by the fixity audit schedule and
+// runs a StorageFixityWorkflow for each AIP.
+type StorageFixityAuditWorkflow struct {
+	storagesvc storage.Service
+}
+
+func NewStorageFixityAuditWorkflow(storagesvc storage.Service) *StorageFixityAuditWorkflow {
+	return &StorageFixityAuditWorkflow{
+		storagesvc: storagesvc,
+	}
+}
+
+func (w *StorageFixityAuditWorkflow) Execute(
+	ctx temporalsdk_workflow.Context,
+	req storage.StorageFixityAuditWorkflowRequest,
+) error {
+	logger := temporalsdk_workflow.GetLogger(ctx)
+
+	batchSize := req.BatchSize
+	if batchSize < 1 {
+		batchSize = defaultFixityAuditBatchSize
+	}
+
+	// List the next batch of stored AIPs.
+	var list storage.ListStoredAIPsLocalActivityResult
+	{
+		activityOpts := localActivityOptions(ctx)
+		err := temporalsdk_workflow.ExecuteLocalActivity(
+			activityOpts,
+			storage.ListStoredAIPsLocalActivity,
+			w.storagesvc,
+			&storage.ListStoredAIPsLocalActivityParams{
+				Limit:  batchSize,
+				Offset: req.Offset,
+			},
+		).Get(activityOpts, &list)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Verify the fixity of each AIP. A failure on one AIP is logged and
+	// doesn't stop the audit of the remaining AIPs.
+	for _, aipID := range list.AIPIDs {
+		childOpts := temporalsdk_workflow.WithChildOptions(ctx, temporalsdk_workflow.ChildWorkflowOptions{
+			WorkflowID:            storage.StorageFixityWorkflowID(aipID),
+			WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		})
+		err := temporalsdk_workflow.ExecuteChildWorkflow(
+			childOpts,
+			storage.StorageFixityWorkflowName,
+			&storage.StorageFixityWorkflowRequest{AIPID: aipID},
+		).Get(childOpts, nil)
+		if err != nil {
+			logger.Error("fixity audit: error verifying AIP fixity", "AIPID", aipID.String(), "error", err.Error())
+		}
+	}
+
+	// Continue as new with the next batch, if any.
+	offset := req.Offset + len(list.AIPIDs)
+	if len(list.AIPIDs) == batchSize && offset < list.Total {
+		return temporalsdk_workflow.NewContinueAsNewError(
+			ctx,
+			storage.StorageFixityAuditWorkflowName,
+			&storage.StorageFixityAuditWorkflowRequest{
+				BatchSize: batchSize,
+				Offset:    offset,
+			},
+		)
+	}
+
+	return nil
+}
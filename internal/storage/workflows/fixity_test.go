@@ -0,0 +1,266 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+	"go.uber.org/mock/gomock"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
+)
+
+func TestStorageFixityWorkflow(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.New()
+	workflowDBID := 1
+	taskDBID := 2
+
+	type test struct {
+		name           string
+		result         *activities.VerifyAIPFixityActivityResult
+		activityErr    error
+		wantTaskStatus enums.TaskStatus
+		wantTaskNote   string
+		wantStatus     enums.WorkflowStatus
+		wantErr        string
+	}
+	for _, tt := range []test{
+		{
+			name: "Verifies AIP fixity",
+			result: &activities.VerifyAIPFixityActivityResult{
+				Status:            enums.FixityCheckStatusPassed,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "abc123",
+			},
+			wantTaskStatus: enums.TaskStatusDone,
+			wantTaskNote:   "AIP fixity check passed\nsha256: abc123",
+			wantStatus:     enums.WorkflowStatusDone,
+		},
+		{
+			name: "Records AIP fixity baseline",
+			result: &activities.VerifyAIPFixityActivityResult{
+				Status:            enums.FixityCheckStatusBaseline,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "abc123",
+			},
+			wantTaskStatus: enums.TaskStatusDone,
+			wantTaskNote:   "AIP checksum recorded as fixity baseline\nsha256: abc123",
+			wantStatus:     enums.WorkflowStatusDone,
+		},
+		{
+			name: "Reports a checksum mismatch",
+			result: &activities.VerifyAIPFixityActivityResult{
+				Status:            enums.FixityCheckStatusFailed,
+				ChecksumAlgorithm: "sha256",
+				ExpectedChecksum:  "abc123",
+				ActualChecksum:    "def456",
+			},
+			wantTaskStatus: enums.TaskStatusError,
+			wantTaskNote:   "AIP fixity check failed, checksums don't match\nExpected sha256: abc123\nActual sha256: def456",
+			wantStatus:     enums.WorkflowStatusError,
+		},
+		{
+			name:           "Errors when the fixity can't be verified",
+			activityErr:    errors.New("new AIP reader: not found"),
+			wantTaskStatus: enums.TaskStatusError,
+			wantTaskNote:   "Failed to verify AIP fixity\nactivity error (type: verify-aip-fixity-activity, scheduledEventID: 0, startedEventID: 0, identity: ): new AIP reader: not found",
+			wantStatus:     enums.WorkflowStatusError,
+			wantErr:        "new AIP reader: not found",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := temporalsdk_testsuite.WorkflowTestSuite{}
+			env := s.NewTestWorkflowEnvironment()
+			storagesvc := fake.NewMockService(gomock.NewController(t))
+
+			env.RegisterActivityWithOptions(
+				activities.NewVerifyAIPFixityActivity(storagesvc).Execute,
+				temporalsdk_activity.RegisterOptions{Name: storage.VerifyAIPFixityActivityName},
+			)
+
+			env.OnActivity(
+				storage.CreateWorkflowLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CreateWorkflowLocalActivityParams{
+					AIPID:      aipID,
+					TemporalID: "default-test-workflow-id",
+					Type:       enums.WorkflowTypeAuditAip,
+				},
+			).Return(workflowDBID, nil)
+
+			env.OnActivity(
+				storage.CreateTaskLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CreateTaskLocalActivityParams{
+					WorkflowDBID: workflowDBID,
+					Status:       enums.TaskStatusInProgress,
+					Name:         "Verify fixity",
+					Note:         "Verifying AIP checksum",
+				},
+			).Return(taskDBID, nil)
+
+			env.OnActivity(
+				storage.VerifyAIPFixityActivityName,
+				mock.AnythingOfType("*context.timerCtx"),
+				&activities.VerifyAIPFixityActivityParams{
+					AIPID:        aipID,
+					WorkflowDBID: workflowDBID,
+				},
+			).Return(tt.result, tt.activityErr)
+
+			env.OnActivity(
+				storage.CompleteTaskLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CompleteTaskLocalActivityParams{
+					DBID:   taskDBID,
+					Status: tt.wantTaskStatus,
+					Note:   tt.wantTaskNote,
+				},
+			).Return(nil)
+
+			env.OnActivity(
+				storage.CompleteWorkflowLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CompleteWorkflowLocalActivityParams{
+					DBID:   workflowDBID,
+					Status: tt.wantStatus,
+				},
+			).Return(nil)
+
+			env.ExecuteWorkflow(
+				NewStorageFixityWorkflow(storagesvc).Execute,
+				storage.StorageFixityWorkflowRequest{AIPID: aipID},
+			)
+
+			require.True(t, env.IsWorkflowCompleted())
+			err := env.GetWorkflowResult(nil)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestStorageFixityAuditWorkflow(t *testing.T) {
+	t.Parallel()
+
+	aipID1 := uuid.New()
+	aipID2 := uuid.New()
+
+	type test struct {
+		name           string
+		req            storage.StorageFixityAuditWorkflowRequest
+		list           *storage.ListStoredAIPsLocalActivityParams
+		aipIDs         []uuid.UUID
+		total          int
+		wantContinueAt int
+	}
+	for _, tt := range []test{
+		{
+			name:   "Audits the last batch of AIPs",
+			req:    storage.StorageFixityAuditWorkflowRequest{BatchSize: 2, Offset: 2},
+			list:   &storage.ListStoredAIPsLocalActivityParams{Limit: 2, Offset: 2},
+			aipIDs: []uuid.UUID{aipID1},
+			total:  3,
+		},
+		{
+			name:           "Audits a batch of AIPs and continues as new",
+			req:            storage.StorageFixityAuditWorkflowRequest{BatchSize: 2},
+			list:           &storage.ListStoredAIPsLocalActivityParams{Limit: 2, Offset: 0},
+			aipIDs:         []uuid.UUID{aipID1, aipID2},
+			total:          3,
+			wantContinueAt: 2,
+		},
+		{
+			name:   "Uses the default batch size",
+			req:    storage.StorageFixityAuditWorkflowRequest{},
+			list:   &storage.ListStoredAIPsLocalActivityParams{Limit: 100, Offset: 0},
+			aipIDs: []uuid.UUID{aipID1, aipID2},
+			total:  2,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := temporalsdk_testsuite.WorkflowTestSuite{}
+			env := s.NewTestWorkflowEnvironment()
+			storagesvc := fake.NewMockService(gomock.NewController(t))
+
+			env.RegisterWorkflowWithOptions(
+				NewStorageFixityAuditWorkflow(storagesvc).Execute,
+				temporalsdk_workflow.RegisterOptions{Name: storage.StorageFixityAuditWorkflowName},
+			)
+			env.RegisterWorkflowWithOptions(
+				NewStorageFixityWorkflow(storagesvc).Execute,
+				temporalsdk_workflow.RegisterOptions{Name: storage.StorageFixityWorkflowName},
+			)
+
+			env.OnActivity(
+				storage.ListStoredAIPsLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				tt.list,
+			).Return(&storage.ListStoredAIPsLocalActivityResult{
+				AIPIDs: tt.aipIDs,
+				Total:  tt.total,
+			}, nil)
+
+			// The first AIP fails, which doesn't stop the audit.
+			for i, id := range tt.aipIDs {
+				var err error
+				if i == 0 {
+					err = errors.New("fixity check failed")
+				}
+				env.OnWorkflow(
+					storage.StorageFixityWorkflowName,
+					mock.Anything,
+					storage.StorageFixityWorkflowRequest{AIPID: id},
+				).Return(err).Once()
+			}
+
+			env.ExecuteWorkflow(storage.StorageFixityAuditWorkflowName, tt.req)
+
+			require.True(t, env.IsWorkflowCompleted())
+			env.AssertExpectations(t)
+
+			err := env.GetWorkflowError()
+			if tt.wantContinueAt == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var continueAsNew *temporalsdk_workflow.ContinueAsNewError
+			require.ErrorAs(t, err, &continueAsNew)
+			require.Equal(t, storage.StorageFixityAuditWorkflowName, continueAsNew.WorkflowType.Name)
+
+			var next storage.StorageFixityAuditWorkflowRequest
+			err = converter.GetDefaultDataConverter().FromPayloads(continueAsNew.Input, &next)
+			require.NoError(t, err)
+			require.Equal(t, storage.StorageFixityAuditWorkflowRequest{
+				BatchSize: tt.req.BatchSize,
+				Offset:    tt.wantContinueAt,
+			}, next)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
 	temporalsdk_temporal "go.temporal.io/sdk/temporal"
 	temporalsdk_workflow "go.temporal.io/sdk/workflow"
 
@@ -27,6 +28,12 @@ func (w *StorageMoveWorkflow) Execute(
 	ctx temporalsdk_workflow.Context,
 	req storage.StorageMoveWorkflowRequest,
 ) (e error) {
+	// Fail workflow if the AIP is under legal hold.
+	aip, err := checkLegalHold(ctx, w.storagesvc, req.AIPID)
+	if err != nil {
+		return err
+	}
+
 	// Set AIP status to moving.
 	{
 		if err := updateAIPStatus(ctx, w.storagesvc, req.AIPID, enums.AIPStatusProcessing); err != nil {
@@ -140,6 +147,14 @@ func (w *StorageMoveWorkflow) Execute(
 		}
 	}
 
+	// Delete the AIP replicas in the replication targets of the source
+	// location, the AIP is replicated to the targets of its new location below.
+	if len(aip.Replicas) > 0 {
+		if err := deleteAIPReplicas(ctx, w.storagesvc, workflowDBID, req.AIPID); err != nil {
+			return err
+		}
+	}
+
 	// Update AIP location
 	{
 		activityOpts := localActivityOptions(ctx)
@@ -164,5 +179,26 @@ func (w *StorageMoveWorkflow) Execute(
 		}
 	}
 
+	// Start the replication of the AIP to the targets of its new location
+	// without waiting for it to complete.
+	{
+		childOpts := temporalsdk_workflow.WithChildOptions(ctx, temporalsdk_workflow.ChildWorkflowOptions{
+			WorkflowID:            storage.StorageReplicateWorkflowID(req.AIPID),
+			WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			ParentClosePolicy:     temporalsdk_api_enums.PARENT_CLOSE_POLICY_ABANDON,
+		})
+		err := temporalsdk_workflow.ExecuteChildWorkflow(
+			childOpts,
+			storage.StorageReplicateWorkflowName,
+			&storage.StorageReplicateWorkflowRequest{
+				AIPID:     req.AIPID,
+				TaskQueue: req.TaskQueue,
+			},
+		).GetChildWorkflowExecution().Get(childOpts, nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -6,10 +6,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
 	temporalsdk_activity "go.temporal.io/sdk/activity"
 	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
 	"go.uber.org/mock/gomock"
 
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	"github.com/artefactual-sdps/enduro/internal/storage"
 	"github.com/artefactual-sdps/enduro/internal/storage/activities"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
@@ -17,143 +20,262 @@ import (
 )
 
 func TestStorageMoveWorkflow(t *testing.T) {
-	s := temporalsdk_testsuite.WorkflowTestSuite{}
-	env := s.NewTestWorkflowEnvironment()
-	ctrl := gomock.NewController(t)
-	storagesvc := fake.NewMockService(ctrl)
+	t.Parallel()
 
-	env.RegisterActivityWithOptions(
-		activities.NewCopyToPermanentLocationActivity(storagesvc).Execute,
-		temporalsdk_activity.RegisterOptions{Name: storage.CopyToPermanentLocationActivityName},
-	)
-
-	req := storage.StorageMoveWorkflowRequest{
-		AIPID:      uuid.New(),
-		LocationID: uuid.New(),
-		TaskQueue:  "global",
-	}
-	workflowDBID := 1
-	copyTaskDBID := 1
-	deleteTaskDBID := 2
+	replicaLocationID := uuid.New()
 
-	env.OnActivity(
-		storage.UpdateAIPStatusLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.UpdateAIPStatusLocalActivityParams{
-			AIPID:  req.AIPID,
-			Status: enums.AIPStatusProcessing,
+	for _, tt := range []struct {
+		name     string
+		replicas goastorage.AIPReplicaCollection
+	}{
+		{
+			name: "Moves an AIP",
 		},
-	).Return(nil)
-
-	env.OnActivity(
-		storage.CreateWorkflowLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.CreateWorkflowLocalActivityParams{
-			AIPID:      req.AIPID,
-			TemporalID: "default-test-workflow-id",
-			Type:       enums.WorkflowTypeMoveAip,
+		{
+			name: "Moves an AIP and deletes its replicas",
+			replicas: goastorage.AIPReplicaCollection{
+				{LocationUUID: replicaLocationID, Status: enums.ReplicaStatusReplicated.String()},
+			},
 		},
-	).Return(workflowDBID, nil)
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-	env.OnActivity(
-		storage.CreateTaskLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.CreateTaskLocalActivityParams{
-			WorkflowDBID: workflowDBID,
-			Status:       enums.TaskStatusInProgress,
-			Name:         "Copy AIP",
-			Note:         "Copying AIP to target location",
-		},
-	).Return(copyTaskDBID, nil)
+			s := temporalsdk_testsuite.WorkflowTestSuite{}
+			env := s.NewTestWorkflowEnvironment()
+			ctrl := gomock.NewController(t)
+			storagesvc := fake.NewMockService(ctrl)
 
-	env.OnActivity(
-		storage.CopyToPermanentLocationActivityName,
-		mock.AnythingOfType("*context.timerCtx"),
-		&activities.CopyToPermanentLocationActivityParams{
-			AIPID:      req.AIPID,
-			LocationID: req.LocationID,
-		},
-	).Return(nil, nil)
+			env.RegisterWorkflowWithOptions(
+				NewStorageMoveWorkflow(storagesvc).Execute,
+				temporalsdk_workflow.RegisterOptions{Name: storage.StorageMoveWorkflowName},
+			)
+			env.RegisterWorkflowWithOptions(
+				NewStorageReplicateWorkflow(storagesvc).Execute,
+				temporalsdk_workflow.RegisterOptions{Name: storage.StorageReplicateWorkflowName},
+			)
+			env.RegisterActivityWithOptions(
+				activities.NewCopyToPermanentLocationActivity(storagesvc, false, noop.NewMeterProvider()).Execute,
+				temporalsdk_activity.RegisterOptions{Name: storage.CopyToPermanentLocationActivityName},
+			)
 
-	env.OnActivity(
-		storage.CompleteTaskLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.CompleteTaskLocalActivityParams{
-			DBID:   copyTaskDBID,
-			Status: enums.TaskStatusDone,
-			Note:   "AIP copied to target location",
-		},
-	).Return(nil)
+			req := storage.StorageMoveWorkflowRequest{
+				AIPID:      uuid.New(),
+				LocationID: uuid.New(),
+				TaskQueue:  "global",
+			}
+			workflowDBID := 1
+			copyTaskDBID := 1
+			deleteTaskDBID := 2
+			deleteReplicasTaskDBID := 3
 
-	env.OnActivity(
-		storage.CreateTaskLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.CreateTaskLocalActivityParams{
-			WorkflowDBID: workflowDBID,
-			Status:       enums.TaskStatusInProgress,
-			Name:         "Delete AIP",
-			Note:         "Deleting AIP from source location",
-		},
-	).Return(deleteTaskDBID, nil)
+			env.OnActivity(
+				storage.ReadAIPLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				req.AIPID,
+			).Return(&goastorage.AIP{UUID: req.AIPID, Replicas: tt.replicas}, nil)
 
-	env.OnActivity(
-		storage.DeleteAIPLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.DeleteAIPLocalActivityParams{
-			AIPID: req.AIPID,
-		},
-	).Return(nil)
+			env.OnActivity(
+				storage.UpdateAIPStatusLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.UpdateAIPStatusLocalActivityParams{
+					AIPID:  req.AIPID,
+					Status: enums.AIPStatusProcessing,
+				},
+			).Return(nil)
 
-	env.OnActivity(
-		storage.CompleteTaskLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.CompleteTaskLocalActivityParams{
-			DBID:   deleteTaskDBID,
-			Status: enums.TaskStatusDone,
-			Note:   "AIP deleted from source location",
-		},
-	).Return(nil)
+			env.OnActivity(
+				storage.CreateWorkflowLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CreateWorkflowLocalActivityParams{
+					AIPID:      req.AIPID,
+					TemporalID: "default-test-workflow-id",
+					Type:       enums.WorkflowTypeMoveAip,
+				},
+			).Return(workflowDBID, nil)
 
-	env.OnActivity(
-		storage.UpdateAIPLocationLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.UpdateAIPLocationLocalActivityParams{
-			AIPID:      req.AIPID,
-			LocationID: req.LocationID,
-		},
-	).Return(nil)
+			env.OnActivity(
+				storage.CreateTaskLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CreateTaskLocalActivityParams{
+					WorkflowDBID: workflowDBID,
+					Status:       enums.TaskStatusInProgress,
+					Name:         "Copy AIP",
+					Note:         "Copying AIP to target location",
+				},
+			).Return(copyTaskDBID, nil)
 
-	env.OnActivity(
-		storage.UpdateAIPStatusLocalActivity,
-		mock.AnythingOfType("*context.valueCtx"),
-		storagesvc,
-		&storage.UpdateAIPStatusLocalActivityParams{
-			AIPID:  req.AIPID,
-			Status: enums.AIPStatusStored,
-		},
-	).Return(nil)
+			env.OnActivity(
+				storage.CopyToPermanentLocationActivityName,
+				mock.AnythingOfType("*context.timerCtx"),
+				&activities.CopyToPermanentLocationActivityParams{
+					AIPID:      req.AIPID,
+					LocationID: req.LocationID,
+				},
+			).Return(nil, nil)
+
+			env.OnActivity(
+				storage.CompleteTaskLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CompleteTaskLocalActivityParams{
+					DBID:   copyTaskDBID,
+					Status: enums.TaskStatusDone,
+					Note:   "AIP copied to target location",
+				},
+			).Return(nil)
+
+			env.OnActivity(
+				storage.CreateTaskLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CreateTaskLocalActivityParams{
+					WorkflowDBID: workflowDBID,
+					Status:       enums.TaskStatusInProgress,
+					Name:         "Delete AIP",
+					Note:         "Deleting AIP from source location",
+				},
+			).Return(deleteTaskDBID, nil)
+
+			env.OnActivity(
+				storage.DeleteAIPLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.DeleteAIPLocalActivityParams{
+					AIPID: req.AIPID,
+				},
+			).Return(nil)
+
+			env.OnActivity(
+				storage.CompleteTaskLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CompleteTaskLocalActivityParams{
+					DBID:   deleteTaskDBID,
+					Status: enums.TaskStatusDone,
+					Note:   "AIP deleted from source location",
+				},
+			).Return(nil)
+
+			if len(tt.replicas) > 0 {
+				env.OnActivity(
+					storage.CreateTaskLocalActivity,
+					mock.AnythingOfType("*context.valueCtx"),
+					storagesvc,
+					&storage.CreateTaskLocalActivityParams{
+						WorkflowDBID: workflowDBID,
+						Status:       enums.TaskStatusInProgress,
+						Name:         "Delete AIP replicas",
+						Note:         "Deleting AIP replicas from replication locations",
+					},
+				).Return(deleteReplicasTaskDBID, nil)
+
+				env.OnActivity(
+					storage.DeleteAIPReplicasLocalActivity,
+					mock.AnythingOfType("*context.valueCtx"),
+					storagesvc,
+					&storage.DeleteAIPReplicasLocalActivityParams{AIPID: req.AIPID},
+				).Return(&storage.DeleteAIPReplicasLocalActivityResult{
+					LocationIDs: []uuid.UUID{replicaLocationID},
+				}, nil)
+
+				env.OnActivity(
+					storage.CompleteTaskLocalActivity,
+					mock.AnythingOfType("*context.valueCtx"),
+					storagesvc,
+					&storage.CompleteTaskLocalActivityParams{
+						DBID:   deleteReplicasTaskDBID,
+						Status: enums.TaskStatusDone,
+						Note:   "AIP replicas deleted from replication locations:\n" + replicaLocationID.String(),
+					},
+				).Return(nil)
+			}
+
+			env.OnActivity(
+				storage.UpdateAIPLocationLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.UpdateAIPLocationLocalActivityParams{
+					AIPID:      req.AIPID,
+					LocationID: req.LocationID,
+				},
+			).Return(nil)
+
+			env.OnActivity(
+				storage.UpdateAIPStatusLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.UpdateAIPStatusLocalActivityParams{
+					AIPID:  req.AIPID,
+					Status: enums.AIPStatusStored,
+				},
+			).Return(nil)
+
+			env.OnWorkflow(
+				storage.StorageReplicateWorkflowName,
+				mock.Anything,
+				storage.StorageReplicateWorkflowRequest{
+					AIPID:     req.AIPID,
+					TaskQueue: req.TaskQueue,
+				},
+			).Return(nil)
+
+			env.OnActivity(
+				storage.CompleteWorkflowLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CompleteWorkflowLocalActivityParams{
+					DBID:   workflowDBID,
+					Status: enums.WorkflowStatusDone,
+				},
+			).Return(nil)
+
+			env.ExecuteWorkflow(storage.StorageMoveWorkflowName, req)
+
+			require.True(t, env.IsWorkflowCompleted())
+			err := env.GetWorkflowResult(nil)
+			require.NoError(t, err)
+			env.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStorageMoveWorkflowLegalHold(t *testing.T) {
+	s := temporalsdk_testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+	storagesvc := fake.NewMockService(gomock.NewController(t))
+
+	env.RegisterWorkflowWithOptions(
+		NewStorageMoveWorkflow(storagesvc).Execute,
+		temporalsdk_workflow.RegisterOptions{Name: storage.StorageMoveWorkflowName},
+	)
+
+	req := storage.StorageMoveWorkflowRequest{
+		AIPID:      uuid.New(),
+		LocationID: uuid.New(),
+		TaskQueue:  "global",
+	}
 
 	env.OnActivity(
-		storage.CompleteWorkflowLocalActivity,
+		storage.ReadAIPLocalActivity,
 		mock.AnythingOfType("*context.valueCtx"),
 		storagesvc,
-		&storage.CompleteWorkflowLocalActivityParams{
-			DBID:   workflowDBID,
-			Status: enums.WorkflowStatusDone,
+		req.AIPID,
+	).Return(&goastorage.AIP{
+		UUID: req.AIPID,
+		LegalHolds: goastorage.AIPLegalHoldCollection{
+			{UUID: uuid.New(), Reason: "Litigation", PlacedBy: "Jane Doe", PlacedAt: "2026-10-17T10:00:00Z"},
 		},
-	).Return(nil)
+	}, nil)
 
-	env.ExecuteWorkflow(NewStorageMoveWorkflow(storagesvc).Execute, req)
+	env.ExecuteWorkflow(storage.StorageMoveWorkflowName, req)
 
 	require.True(t, env.IsWorkflowCompleted())
 	err := env.GetWorkflowResult(nil)
-	require.NoError(t, err)
+	require.ErrorContains(t, err, "AIP is under legal hold")
+	env.AssertExpectations(t)
 }
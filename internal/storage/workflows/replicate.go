@@ -0,0 +1,150 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+// StorageReplicateWorkflow copies an AIP to the replication targets of its
+// location and records the status of each replica.
+type StorageReplicateWorkflow struct {
+	storagesvc storage.Service
+}
+
+func NewStorageReplicateWorkflow(storagesvc storage.Service) *StorageReplicateWorkflow {
+	return &StorageReplicateWorkflow{
+		storagesvc: storagesvc,
+	}
+}
+
+func (w *StorageReplicateWorkflow) Execute(
+	ctx temporalsdk_workflow.Context,
+	req storage.StorageReplicateWorkflowRequest,
+) (e error) {
+	// Create a pending replica for each replication target.
+	var replicas storage.CreateAIPReplicasLocalActivityResult
+	{
+		activityOpts := localActivityOptions(ctx)
+		err := temporalsdk_workflow.ExecuteLocalActivity(
+			activityOpts,
+			storage.CreateAIPReplicasLocalActivity,
+			w.storagesvc,
+			&storage.CreateAIPReplicasLocalActivityParams{AIPID: req.AIPID},
+		).Get(activityOpts, &replicas)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Nothing to do if the AIP location has no replication targets.
+	if len(replicas.LocationIDs) == 0 {
+		return nil
+	}
+
+	// Create persistence workflow.
+	workflowDBID, err := createWorkflow(ctx, w.storagesvc, req.AIPID, enums.WorkflowTypeReplicateAip)
+	if err != nil {
+		return err
+	}
+
+	// Complete persistence workflow.
+	defer func() {
+		status := enums.WorkflowStatusDone
+		if e != nil {
+			status = enums.WorkflowStatusError
+		}
+
+		err := completeWorkflow(ctx, w.storagesvc, workflowDBID, status)
+		if err != nil {
+			e = errors.Join(e, err)
+		}
+	}()
+
+	// Copy the AIP to each replication target. A failed replica is recorded
+	// and doesn't stop the replication to the remaining targets.
+	var replicaErrs error
+	for _, locationID := range replicas.LocationIDs {
+		taskID, err := createTask(
+			ctx,
+			w.storagesvc,
+			workflowDBID,
+			enums.TaskStatusInProgress,
+			"Replicate AIP",
+			fmt.Sprintf("Copying AIP to replication location %s", locationID),
+		)
+		if err != nil {
+			return errors.Join(replicaErrs, err)
+		}
+
+		var re activities.ReplicateAIPActivityResult
+		activityOpts := temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
+			StartToCloseTimeout: time.Hour * 2,
+			HeartbeatTimeout:    time.Second * 20,
+			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+				InitialInterval:    time.Second,
+				BackoffCoefficient: 2,
+				MaximumInterval:    time.Minute * 10,
+				MaximumAttempts:    5,
+				NonRetryableErrorTypes: []string{
+					"TemporalTimeout:StartToClose",
+				},
+			},
+		})
+		err = temporalsdk_workflow.ExecuteActivity(
+			activityOpts,
+			storage.ReplicateAIPActivityName,
+			&activities.ReplicateAIPActivityParams{
+				AIPID:      req.AIPID,
+				LocationID: locationID,
+			},
+		).Get(activityOpts, &re)
+
+		replicaStatus := enums.ReplicaStatusReplicated
+		taskStatus := enums.TaskStatusDone
+		taskNote := fmt.Sprintf(
+			"AIP replicated to location %s\n%s: %s",
+			locationID,
+			re.ChecksumAlgorithm,
+			re.Checksum,
+		)
+		if err != nil {
+			replicaErrs = errors.Join(replicaErrs, err)
+			replicaStatus = enums.ReplicaStatusFailed
+			taskStatus = enums.TaskStatusError
+			taskNote = fmt.Sprintf("Failed to replicate AIP to location %s\n%s", locationID, err.Error())
+		}
+
+		// Update replica status.
+		{
+			activityOpts := localActivityOptions(ctx)
+			err := temporalsdk_workflow.ExecuteLocalActivity(
+				activityOpts,
+				storage.UpdateAIPReplicaStatusLocalActivity,
+				w.storagesvc,
+				&storage.UpdateAIPReplicaStatusLocalActivityParams{
+					AIPID:      req.AIPID,
+					LocationID: locationID,
+					Status:     replicaStatus,
+				},
+			).Get(activityOpts, nil)
+			if err != nil {
+				return errors.Join(replicaErrs, err)
+			}
+		}
+
+		// Complete replicate AIP task.
+		if err := completeTask(ctx, w.storagesvc, taskID, taskStatus, taskNote); err != nil {
+			return errors.Join(replicaErrs, err)
+		}
+	}
+
+	return replicaErrs
+}
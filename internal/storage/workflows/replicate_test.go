@@ -0,0 +1,192 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
+)
+
+func TestStorageReplicateWorkflow(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.New()
+	locationID1 := uuid.MustParse("b2f1c9a8-5a1e-4d0f-9f5d-3c1d2b8e7a61")
+	locationID2 := uuid.MustParse("c7e3f6d2-4b8a-4e1c-8d7f-2a9b5c3e1f04")
+	workflowDBID := 1
+
+	t.Run("Does nothing when the AIP location has no replication targets", func(t *testing.T) {
+		t.Parallel()
+
+		s := temporalsdk_testsuite.WorkflowTestSuite{}
+		env := s.NewTestWorkflowEnvironment()
+		storagesvc := fake.NewMockService(gomock.NewController(t))
+
+		env.OnActivity(
+			storage.CreateAIPReplicasLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.CreateAIPReplicasLocalActivityParams{AIPID: aipID},
+		).Return(&storage.CreateAIPReplicasLocalActivityResult{}, nil)
+
+		env.ExecuteWorkflow(
+			NewStorageReplicateWorkflow(storagesvc).Execute,
+			storage.StorageReplicateWorkflowRequest{AIPID: aipID},
+		)
+
+		require.True(t, env.IsWorkflowCompleted())
+		require.NoError(t, env.GetWorkflowResult(nil))
+		env.AssertExpectations(t)
+	})
+
+	t.Run("Replicates the AIP and records failed replicas", func(t *testing.T) {
+		t.Parallel()
+
+		s := temporalsdk_testsuite.WorkflowTestSuite{}
+		env := s.NewTestWorkflowEnvironment()
+		storagesvc := fake.NewMockService(gomock.NewController(t))
+
+		env.RegisterActivityWithOptions(
+			activities.NewReplicateAIPActivity(storagesvc).Execute,
+			temporalsdk_activity.RegisterOptions{Name: storage.ReplicateAIPActivityName},
+		)
+
+		env.OnActivity(
+			storage.CreateAIPReplicasLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.CreateAIPReplicasLocalActivityParams{AIPID: aipID},
+		).Return(&storage.CreateAIPReplicasLocalActivityResult{
+			LocationIDs: []uuid.UUID{locationID1, locationID2},
+		}, nil)
+
+		env.OnActivity(
+			storage.CreateWorkflowLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.CreateWorkflowLocalActivityParams{
+				AIPID:      aipID,
+				TemporalID: "default-test-workflow-id",
+				Type:       enums.WorkflowTypeReplicateAip,
+			},
+		).Return(workflowDBID, nil)
+
+		// The first replica is copied and verified.
+		env.OnActivity(
+			storage.CreateTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.CreateTaskLocalActivityParams{
+				WorkflowDBID: workflowDBID,
+				Status:       enums.TaskStatusInProgress,
+				Name:         "Replicate AIP",
+				Note:         "Copying AIP to replication location " + locationID1.String(),
+			},
+		).Return(2, nil)
+
+		env.OnActivity(
+			storage.ReplicateAIPActivityName,
+			mock.AnythingOfType("*context.timerCtx"),
+			&activities.ReplicateAIPActivityParams{
+				AIPID:      aipID,
+				LocationID: locationID1,
+			},
+		).Return(&activities.ReplicateAIPActivityResult{
+			ChecksumAlgorithm: "sha256",
+			Checksum:          "abc123",
+		}, nil)
+
+		env.OnActivity(
+			storage.UpdateAIPReplicaStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.UpdateAIPReplicaStatusLocalActivityParams{
+				AIPID:      aipID,
+				LocationID: locationID1,
+				Status:     enums.ReplicaStatusReplicated,
+			},
+		).Return(nil)
+
+		env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.CompleteTaskLocalActivityParams{
+				DBID:   2,
+				Status: enums.TaskStatusDone,
+				Note:   "AIP replicated to location " + locationID1.String() + "\nsha256: abc123",
+			},
+		).Return(nil)
+
+		// The second replica fails.
+		env.OnActivity(
+			storage.CreateTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.CreateTaskLocalActivityParams{
+				WorkflowDBID: workflowDBID,
+				Status:       enums.TaskStatusInProgress,
+				Name:         "Replicate AIP",
+				Note:         "Copying AIP to replication location " + locationID2.String(),
+			},
+		).Return(3, nil)
+
+		env.OnActivity(
+			storage.ReplicateAIPActivityName,
+			mock.AnythingOfType("*context.timerCtx"),
+			&activities.ReplicateAIPActivityParams{
+				AIPID:      aipID,
+				LocationID: locationID2,
+			},
+		).Return(nil, errors.New("replica checksum mismatch"))
+
+		env.OnActivity(
+			storage.UpdateAIPReplicaStatusLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.UpdateAIPReplicaStatusLocalActivityParams{
+				AIPID:      aipID,
+				LocationID: locationID2,
+				Status:     enums.ReplicaStatusFailed,
+			},
+		).Return(nil)
+
+		env.OnActivity(
+			storage.CompleteTaskLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			mock.MatchedBy(func(p *storage.CompleteTaskLocalActivityParams) bool {
+				return p.DBID == 3 && p.Status == enums.TaskStatusError
+			}),
+		).Return(nil)
+
+		env.OnActivity(
+			storage.CompleteWorkflowLocalActivity,
+			mock.AnythingOfType("*context.valueCtx"),
+			storagesvc,
+			&storage.CompleteWorkflowLocalActivityParams{
+				DBID:   workflowDBID,
+				Status: enums.WorkflowStatusError,
+			},
+		).Return(nil)
+
+		env.ExecuteWorkflow(
+			NewStorageReplicateWorkflow(storagesvc).Execute,
+			storage.StorageReplicateWorkflowRequest{AIPID: aipID},
+		)
+
+		require.True(t, env.IsWorkflowCompleted())
+		require.ErrorContains(t, env.GetWorkflowResult(nil), "replica checksum mismatch")
+		env.AssertExpectations(t)
+	})
+}
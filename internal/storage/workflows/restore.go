@@ -0,0 +1,165 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+)
+
+// StorageRestoreWorkflow restores an AIP to a restore location and deletes the
+// restored copy when its TTL expires.
+type StorageRestoreWorkflow struct {
+	storagesvc storage.Service
+}
+
+func NewStorageRestoreWorkflow(storagesvc storage.Service) *StorageRestoreWorkflow {
+	return &StorageRestoreWorkflow{
+		storagesvc: storagesvc,
+	}
+}
+
+func (w *StorageRestoreWorkflow) Execute(
+	ctx temporalsdk_workflow.Context,
+	req storage.StorageRestoreWorkflowRequest,
+) (e error) {
+	// Create persistence workflow.
+	workflowDBID, err := createWorkflow(ctx, w.storagesvc, req.AIPID, enums.WorkflowTypeRestoreAip)
+	if err != nil {
+		return err
+	}
+
+	// Complete persistence workflow.
+	defer func() {
+		status := enums.WorkflowStatusDone
+		if e != nil {
+			status = enums.WorkflowStatusError
+		}
+
+		err := completeWorkflow(ctx, w.storagesvc, workflowDBID, status)
+		if err != nil {
+			e = errors.Join(e, err)
+		}
+	}()
+
+	// Create restore AIP task.
+	restoreTaskID, err := createTask(
+		ctx,
+		w.storagesvc,
+		workflowDBID,
+		enums.TaskStatusInProgress,
+		"Restore AIP",
+		"Restoring AIP to restore location",
+	)
+	if err != nil {
+		return err
+	}
+
+	// Copy the AIP to the restore location, unpacking it if requested.
+	var restored activities.RestoreAIPActivityResult
+	{
+		activityOpts := temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
+			StartToCloseTimeout: time.Hour * 2,
+			HeartbeatTimeout:    time.Second * 20,
+			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+				InitialInterval:    time.Second,
+				BackoffCoefficient: 2,
+				MaximumInterval:    time.Minute * 10,
+				MaximumAttempts:    5,
+				NonRetryableErrorTypes: []string{
+					"TemporalTimeout:StartToClose",
+				},
+			},
+		})
+		err := temporalsdk_workflow.ExecuteActivity(
+			activityOpts,
+			storage.RestoreAIPActivityName,
+			&activities.RestoreAIPActivityParams{
+				AIPID:      req.AIPID,
+				RestoreID:  req.RestoreID,
+				LocationID: req.LocationID,
+				Unpack:     req.Unpack,
+			},
+		).Get(activityOpts, &restored)
+
+		// Complete restore AIP task.
+		taskStatus := enums.TaskStatusDone
+		taskNote := fmt.Sprintf("AIP restored to %q", restored.Key)
+		if req.Unpack {
+			taskNote = fmt.Sprintf("AIP unpacked to %q (%d files)", restored.Key, restored.Files)
+		}
+		if err != nil {
+			taskStatus = enums.TaskStatusError
+			taskNote = fmt.Sprintf("Failed to restore AIP\n%s", err.Error())
+		}
+		taskErr := completeTask(ctx, w.storagesvc, restoreTaskID, taskStatus, taskNote)
+		if err = errors.Join(err, taskErr); err != nil {
+			return err
+		}
+	}
+
+	// Keep the restored AIP indefinitely if it doesn't expire.
+	if req.TTL < 0 {
+		return nil
+	}
+
+	// Create expire restored AIP task.
+	expiresAt := temporalsdk_workflow.Now(ctx).Add(req.TTL)
+	expireTaskID, err := createTask(
+		ctx,
+		w.storagesvc,
+		workflowDBID,
+		enums.TaskStatusPending,
+		"Expire restored AIP",
+		fmt.Sprintf("Restored AIP will be deleted at %s", expiresAt.UTC().Format(time.RFC3339)),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Wait until the restored AIP expires.
+	if err := temporalsdk_workflow.Sleep(ctx, req.TTL); err != nil {
+		return err
+	}
+
+	// Delete the restored AIP.
+	{
+		activityOpts := temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
+			StartToCloseTimeout: time.Minute * 30,
+			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+				InitialInterval:    time.Second,
+				BackoffCoefficient: 2,
+				MaximumInterval:    time.Minute * 10,
+				MaximumAttempts:    5,
+			},
+		})
+		err := temporalsdk_workflow.ExecuteActivity(
+			activityOpts,
+			storage.DeleteRestoredAIPActivityName,
+			&activities.DeleteRestoredAIPActivityParams{
+				LocationID: req.LocationID,
+				Key:        restored.Key,
+			},
+		).Get(activityOpts, nil)
+
+		// Complete expire restored AIP task.
+		taskStatus := enums.TaskStatusDone
+		taskNote := "Restored AIP deleted"
+		if err != nil {
+			taskStatus = enums.TaskStatusError
+			taskNote = fmt.Sprintf("Failed to delete restored AIP\n%s", err.Error())
+		}
+		taskErr := completeTask(ctx, w.storagesvc, expireTaskID, taskStatus, taskNote)
+		if err = errors.Join(err, taskErr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
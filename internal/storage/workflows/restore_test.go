@@ -0,0 +1,212 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/activities"
+	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
+)
+
+func TestStorageRestoreWorkflow(t *testing.T) {
+	t.Parallel()
+
+	aipID := uuid.New()
+	restoreID := uuid.New()
+	locationID := uuid.New()
+	workflowDBID := 1
+	restoreTaskDBID := 2
+	expireTaskDBID := 3
+	key := "Test AIP-" + aipID.String() + "-" + restoreID.String() + "/"
+
+	type test struct {
+		name            string
+		ttl             time.Duration
+		restoreErr      error
+		deleteErr       error
+		wantRestoreTask enums.TaskStatus
+		wantRestoreNote string
+		wantExpireTask  enums.TaskStatus
+		wantExpireNote  string
+		wantStatus      enums.WorkflowStatus
+		wantErr         string
+	}
+	for _, tt := range []test{
+		{
+			name:            "Restores an AIP and deletes it when it expires",
+			ttl:             time.Hour,
+			wantRestoreTask: enums.TaskStatusDone,
+			wantRestoreNote: "AIP unpacked to \"" + key + "\" (2 files)",
+			wantExpireTask:  enums.TaskStatusDone,
+			wantExpireNote:  "Restored AIP deleted",
+			wantStatus:      enums.WorkflowStatusDone,
+		},
+		{
+			name:            "Restores an AIP that doesn't expire",
+			ttl:             -1,
+			wantRestoreTask: enums.TaskStatusDone,
+			wantRestoreNote: "AIP unpacked to \"" + key + "\" (2 files)",
+			wantStatus:      enums.WorkflowStatusDone,
+		},
+		{
+			name:            "Errors when the AIP can't be restored",
+			ttl:             time.Hour,
+			restoreErr:      errors.New("read AIP: not found"),
+			wantRestoreTask: enums.TaskStatusError,
+			wantRestoreNote: "Failed to restore AIP\nactivity error (type: restore-aip-activity, scheduledEventID: 0, startedEventID: 0, identity: ): read AIP: not found",
+			wantStatus:      enums.WorkflowStatusError,
+			wantErr:         "read AIP: not found",
+		},
+		{
+			name:            "Errors when the restored AIP can't be deleted",
+			ttl:             time.Hour,
+			deleteErr:       errors.New("delete failed"),
+			wantRestoreTask: enums.TaskStatusDone,
+			wantRestoreNote: "AIP unpacked to \"" + key + "\" (2 files)",
+			wantExpireTask:  enums.TaskStatusError,
+			wantExpireNote:  "Failed to delete restored AIP\nactivity error (type: delete-restored-aip-activity, scheduledEventID: 0, startedEventID: 0, identity: ): delete failed",
+			wantStatus:      enums.WorkflowStatusError,
+			wantErr:         "delete failed",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := temporalsdk_testsuite.WorkflowTestSuite{}
+			env := s.NewTestWorkflowEnvironment()
+			storagesvc := fake.NewMockService(gomock.NewController(t))
+
+			env.RegisterActivityWithOptions(
+				activities.NewRestoreAIPActivity(storagesvc, nil).Execute,
+				temporalsdk_activity.RegisterOptions{Name: storage.RestoreAIPActivityName},
+			)
+			env.RegisterActivityWithOptions(
+				activities.NewDeleteRestoredAIPActivity(storagesvc, nil).Execute,
+				temporalsdk_activity.RegisterOptions{Name: storage.DeleteRestoredAIPActivityName},
+			)
+
+			env.OnActivity(
+				storage.CreateWorkflowLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CreateWorkflowLocalActivityParams{
+					AIPID:      aipID,
+					TemporalID: "default-test-workflow-id",
+					Type:       enums.WorkflowTypeRestoreAip,
+				},
+			).Return(workflowDBID, nil)
+
+			env.OnActivity(
+				storage.CreateTaskLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CreateTaskLocalActivityParams{
+					WorkflowDBID: workflowDBID,
+					Status:       enums.TaskStatusInProgress,
+					Name:         "Restore AIP",
+					Note:         "Restoring AIP to restore location",
+				},
+			).Return(restoreTaskDBID, nil)
+
+			var restored *activities.RestoreAIPActivityResult
+			if tt.restoreErr == nil {
+				restored = &activities.RestoreAIPActivityResult{Key: key, Files: 2}
+			}
+			env.OnActivity(
+				storage.RestoreAIPActivityName,
+				mock.AnythingOfType("*context.timerCtx"),
+				&activities.RestoreAIPActivityParams{
+					AIPID:      aipID,
+					RestoreID:  restoreID,
+					LocationID: locationID,
+					Unpack:     true,
+				},
+			).Return(restored, tt.restoreErr)
+
+			env.OnActivity(
+				storage.CompleteTaskLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CompleteTaskLocalActivityParams{
+					DBID:   restoreTaskDBID,
+					Status: tt.wantRestoreTask,
+					Note:   tt.wantRestoreNote,
+				},
+			).Return(nil)
+
+			if tt.wantExpireNote != "" {
+				env.OnActivity(
+					storage.CreateTaskLocalActivity,
+					mock.AnythingOfType("*context.valueCtx"),
+					storagesvc,
+					mock.MatchedBy(func(p *storage.CreateTaskLocalActivityParams) bool {
+						return p.WorkflowDBID == workflowDBID &&
+							p.Status == enums.TaskStatusPending &&
+							p.Name == "Expire restored AIP"
+					}),
+				).Return(expireTaskDBID, nil)
+
+				env.OnActivity(
+					storage.DeleteRestoredAIPActivityName,
+					mock.AnythingOfType("*context.timerCtx"),
+					&activities.DeleteRestoredAIPActivityParams{
+						LocationID: locationID,
+						Key:        key,
+					},
+				).Return(&activities.DeleteRestoredAIPActivityResult{}, tt.deleteErr)
+
+				env.OnActivity(
+					storage.CompleteTaskLocalActivity,
+					mock.AnythingOfType("*context.valueCtx"),
+					storagesvc,
+					&storage.CompleteTaskLocalActivityParams{
+						DBID:   expireTaskDBID,
+						Status: tt.wantExpireTask,
+						Note:   tt.wantExpireNote,
+					},
+				).Return(nil)
+			}
+
+			env.OnActivity(
+				storage.CompleteWorkflowLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				&storage.CompleteWorkflowLocalActivityParams{
+					DBID:   workflowDBID,
+					Status: tt.wantStatus,
+				},
+			).Return(nil)
+
+			env.ExecuteWorkflow(
+				NewStorageRestoreWorkflow(storagesvc).Execute,
+				storage.StorageRestoreWorkflowRequest{
+					AIPID:      aipID,
+					RestoreID:  restoreID,
+					LocationID: locationID,
+					Unpack:     true,
+					TTL:        tt.ttl,
+				},
+			)
+
+			require.True(t, env.IsWorkflowCompleted())
+			env.AssertExpectations(t)
+
+			err := env.GetWorkflowResult(nil)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+)
+
+// defaultRetentionBatchSize is the maximum number of deletion requests created
+// per run when the request doesn't set a batch size.
+const defaultRetentionBatchSize = 100
+
+// StorageRetentionWorkflow requests the deletion of the stored AIPs that are
+// past their disposal date, skipping those under a legal hold or with a pending
+// or rejected deletion request. It's started periodically by the retention
+// schedule and starts a StorageDeleteWorkflow for each AIP, which awaits
+// review or is auto-approved according to the retention policy.
+type StorageRetentionWorkflow struct {
+	cfg        storage.RetentionConfig
+	storagesvc storage.Service
+}
+
+func NewStorageRetentionWorkflow(
+	cfg storage.RetentionConfig,
+	storagesvc storage.Service,
+) *StorageRetentionWorkflow {
+	return &StorageRetentionWorkflow{
+		cfg:        cfg,
+		storagesvc: storagesvc,
+	}
+}
+
+func (w *StorageRetentionWorkflow) Execute(
+	ctx temporalsdk_workflow.Context,
+	req storage.StorageRetentionWorkflowRequest,
+) error {
+	logger := temporalsdk_workflow.GetLogger(ctx)
+
+	batchSize := req.BatchSize
+	if batchSize < 1 {
+		batchSize = defaultRetentionBatchSize
+	}
+
+	// List the stored AIPs past their disposal date.
+	var list storage.ListDisposableAIPsLocalActivityResult
+	{
+		activityOpts := localActivityOptions(ctx)
+		err := temporalsdk_workflow.ExecuteLocalActivity(
+			activityOpts,
+			storage.ListDisposableAIPsLocalActivity,
+			w.storagesvc,
+			&storage.ListDisposableAIPsLocalActivityParams{
+				DueBy: temporalsdk_workflow.Now(ctx),
+				Limit: batchSize,
+			},
+		).Get(activityOpts, &list)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Start a deletion workflow for each AIP. The deletion workflows are
+	// abandoned when this workflow completes, as they may await review for a
+	// long time. A failure on one AIP is logged and doesn't stop the others.
+	for _, aip := range list.AIPs {
+		childOpts := temporalsdk_workflow.WithChildOptions(ctx, temporalsdk_workflow.ChildWorkflowOptions{
+			WorkflowID:            storage.StorageDeleteWorkflowID(aip.AIPID),
+			WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+			ParentClosePolicy:     temporalsdk_api_enums.PARENT_CLOSE_POLICY_ABANDON,
+		})
+		err := temporalsdk_workflow.ExecuteChildWorkflow(
+			childOpts,
+			storage.StorageDeleteWorkflowName,
+			storage.StorageDeleteWorkflowRequest{
+				AIPID: aip.AIPID,
+				Reason: fmt.Sprintf(
+					"The retention period of the AIP ended on %s.",
+					aip.DisposalAt.UTC().Format(time.DateOnly),
+				),
+				UserEmail:   storage.RetentionRequesterName,
+				UserSub:     storage.RetentionRequesterSub,
+				UserIss:     storage.RetentionRequesterIss,
+				TaskQueue:   req.TaskQueue,
+				AutoApprove: w.cfg.AutoApproves(aip.LocationID),
+			},
+		).GetChildWorkflowExecution().Get(ctx, nil)
+		if err != nil {
+			logger.Error("retention: error requesting AIP deletion", "AIPID", aip.AIPID.String(), "error", err.Error())
+		}
+	}
+
+	return nil
+}
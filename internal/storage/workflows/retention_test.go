@@ -0,0 +1,127 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+	"go.uber.org/mock/gomock"
+
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/storage/fake"
+)
+
+func TestStorageRetentionWorkflow(t *testing.T) {
+	t.Parallel()
+
+	aipID1 := uuid.New()
+	aipID2 := uuid.New()
+	locationID := uuid.New()
+	disposalAt := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	cfg := storage.RetentionConfig{
+		Policies: []storage.RetentionPolicy{
+			{LocationID: locationID, Period: time.Hour, AutoApprove: true},
+		},
+	}
+
+	type test struct {
+		name      string
+		req       storage.StorageRetentionWorkflowRequest
+		wantLimit int
+		aips      []*storage.DisposableAIP
+		listErr   error
+		wantErr   string
+	}
+	for _, tt := range []test{
+		{
+			name:      "Requests the deletion of disposable AIPs",
+			req:       storage.StorageRetentionWorkflowRequest{BatchSize: 10, TaskQueue: "storage"},
+			wantLimit: 10,
+			aips: []*storage.DisposableAIP{
+				{AIPID: aipID1, LocationID: &locationID, DisposalAt: disposalAt},
+				{AIPID: aipID2, DisposalAt: disposalAt},
+			},
+		},
+		{
+			name:      "Uses the default batch size",
+			req:       storage.StorageRetentionWorkflowRequest{TaskQueue: "storage"},
+			wantLimit: 100,
+		},
+		{
+			name:      "Errors when the AIPs can't be listed",
+			req:       storage.StorageRetentionWorkflowRequest{BatchSize: 10},
+			wantLimit: 10,
+			listErr:   errors.New("database error"),
+			wantErr:   "database error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := temporalsdk_testsuite.WorkflowTestSuite{}
+			env := s.NewTestWorkflowEnvironment()
+			storagesvc := fake.NewMockService(gomock.NewController(t))
+
+			env.RegisterWorkflowWithOptions(
+				NewStorageRetentionWorkflow(cfg, storagesvc).Execute,
+				temporalsdk_workflow.RegisterOptions{Name: storage.StorageRetentionWorkflowName},
+			)
+			env.RegisterWorkflowWithOptions(
+				NewStorageDeleteWorkflow(storage.AIPDeletionConfig{}, storagesvc).Execute,
+				temporalsdk_workflow.RegisterOptions{Name: storage.StorageDeleteWorkflowName},
+			)
+
+			var res *storage.ListDisposableAIPsLocalActivityResult
+			if tt.listErr == nil {
+				res = &storage.ListDisposableAIPsLocalActivityResult{AIPs: tt.aips}
+			}
+			env.OnActivity(
+				storage.ListDisposableAIPsLocalActivity,
+				mock.AnythingOfType("*context.valueCtx"),
+				storagesvc,
+				mock.MatchedBy(func(p *storage.ListDisposableAIPsLocalActivityParams) bool {
+					return p.Limit == tt.wantLimit && !p.DueBy.IsZero()
+				}),
+			).Return(res, tt.listErr)
+
+			// The first deletion request fails, which doesn't stop the others.
+			for i, aip := range tt.aips {
+				var err error
+				if i == 0 {
+					err = errors.New("deletion request failed")
+				}
+				env.OnWorkflow(
+					storage.StorageDeleteWorkflowName,
+					mock.Anything,
+					storage.StorageDeleteWorkflowRequest{
+						AIPID:       aip.AIPID,
+						Reason:      "The retention period of the AIP ended on 2026-01-02.",
+						UserEmail:   storage.RetentionRequesterName,
+						UserSub:     storage.RetentionRequesterSub,
+						UserIss:     storage.RetentionRequesterIss,
+						TaskQueue:   tt.req.TaskQueue,
+						AutoApprove: aip.LocationID != nil,
+					},
+				).Return(err).Once()
+			}
+
+			env.ExecuteWorkflow(storage.StorageRetentionWorkflowName, tt.req)
+
+			require.True(t, env.IsWorkflowCompleted())
+			env.AssertExpectations(t)
+
+			err := env.GetWorkflowError()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,16 +1,29 @@
 package workflows
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	temporalsdk_temporal "go.temporal.io/sdk/temporal"
 	temporalsdk_workflow "go.temporal.io/sdk/workflow"
 
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	"github.com/artefactual-sdps/enduro/internal/storage"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
 )
 
+// errLegalHold is returned when an AIP can't be deleted or moved because it's
+// under a legal hold.
+var errLegalHold = errors.New("AIP is under legal hold")
+
+// errAutoApproveNotAllowed is returned when the auto-approval of an AIP
+// deletion is requested but the approval policy of its location requires more
+// than one approver.
+var errAutoApproveNotAllowed = errors.New("AIP deletion can't be auto-approved: multiple approvers required")
+
 func localActivityOptions(ctx temporalsdk_workflow.Context) temporalsdk_workflow.Context {
 	return temporalsdk_workflow.WithLocalActivityOptions(ctx, temporalsdk_workflow.LocalActivityOptions{
 		ScheduleToCloseTimeout: 5 * time.Second,
@@ -23,6 +36,86 @@ func localActivityOptions(ctx temporalsdk_workflow.Context) temporalsdk_workflow
 	})
 }
 
+// underLegalHold reports whether an AIP has a legal hold that hasn't been
+// released.
+func underLegalHold(aip *goastorage.AIP) bool {
+	for _, h := range aip.LegalHolds {
+		if h.ReleasedAt == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkLegalHold reads an AIP and returns errLegalHold if it's under a legal
+// hold.
+func checkLegalHold(
+	ctx temporalsdk_workflow.Context,
+	storagesvc storage.Service,
+	aipID uuid.UUID,
+) (*goastorage.AIP, error) {
+	var aip goastorage.AIP
+	activityOpts := localActivityOptions(ctx)
+	err := temporalsdk_workflow.ExecuteLocalActivity(
+		activityOpts,
+		storage.ReadAIPLocalActivity,
+		storagesvc,
+		aipID,
+	).Get(activityOpts, &aip)
+	if err != nil {
+		return nil, err
+	}
+	if underLegalHold(&aip) {
+		return nil, errLegalHold
+	}
+
+	return &aip, nil
+}
+
+// deleteAIPReplicas deletes the replicas of an AIP from their replication
+// locations in a new task of the given workflow.
+func deleteAIPReplicas(
+	ctx temporalsdk_workflow.Context,
+	storagesvc storage.Service,
+	workflowDBID int,
+	aipID uuid.UUID,
+) error {
+	taskID, err := createTask(
+		ctx,
+		storagesvc,
+		workflowDBID,
+		enums.TaskStatusInProgress,
+		"Delete AIP replicas",
+		"Deleting AIP replicas from replication locations",
+	)
+	if err != nil {
+		return err
+	}
+
+	var re storage.DeleteAIPReplicasLocalActivityResult
+	activityOpts := localActivityOptions(ctx)
+	err = temporalsdk_workflow.ExecuteLocalActivity(
+		activityOpts,
+		storage.DeleteAIPReplicasLocalActivity,
+		storagesvc,
+		&storage.DeleteAIPReplicasLocalActivityParams{AIPID: aipID},
+	).Get(activityOpts, &re)
+
+	taskStatus := enums.TaskStatusDone
+	locations := make([]string, len(re.LocationIDs))
+	for i, id := range re.LocationIDs {
+		locations[i] = id.String()
+	}
+	taskNote := fmt.Sprintf("AIP replicas deleted from replication locations:\n%s", strings.Join(locations, "\n"))
+	if err != nil {
+		taskStatus = enums.TaskStatusError
+		taskNote = fmt.Sprintf("Failed to delete AIP replicas\n%s", err.Error())
+	}
+
+	return errors.Join(err, completeTask(ctx, storagesvc, taskID, taskStatus, taskNote))
+}
+
 func updateAIPStatus(
 	ctx temporalsdk_workflow.Context,
 	storagesvc storage.Service,
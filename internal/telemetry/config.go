@@ -1,7 +1,10 @@
 package telemetry
 
+import "time"
+
 type Config struct {
-	Traces TracesConfig
+	Traces  TracesConfig
+	Metrics MetricsConfig
 }
 
 type TracesConfig struct {
@@ -9,3 +12,15 @@ type TracesConfig struct {
 	Address       string
 	SamplingRatio *float64
 }
+
+type MetricsConfig struct {
+	// Enabled enables the export of metrics over OTLP. Metrics are always
+	// available for scraping on the observability server.
+	Enabled bool
+
+	// Address is the gRPC address of the OTLP metrics collector.
+	Address string
+
+	// Interval is the time between OTLP metric exports.
+	Interval time.Duration
+}
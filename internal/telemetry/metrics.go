@@ -0,0 +1,103 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	otelsdk_metric "go.opentelemetry.io/otel/sdk/metric"
+	"google.golang.org/grpc"
+)
+
+const defaultMetricsInterval = time.Minute
+
+var (
+	// OperationDurationBuckets are the histogram bucket boundaries, in
+	// seconds, used to record the duration of short operations like API
+	// requests.
+	OperationDurationBuckets = []float64{
+		0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30,
+	}
+
+	// ProcessingDurationBuckets are the histogram bucket boundaries, in
+	// seconds, used to record the duration of long running operations like
+	// workflow tasks or AIP transfers.
+	ProcessingDurationBuckets = []float64{
+		1, 5, 15, 30, 60, 300, 900, 1800, 3600, 7200, 21600, 86400,
+	}
+)
+
+// MeterProvider provides Meters that are used by instrumentation code to
+// record measurements. The metrics are always registered in reg, so they can
+// be scraped from the Prometheus endpoint of the observability server, and
+// they are also exported over OTLP when enabled.
+func MeterProvider(
+	ctx context.Context,
+	logger logr.Logger,
+	cfg Config,
+	reg prometheus.Registerer,
+	appName, appVersion string,
+) (metric.MeterProvider, ShutdownProvider, error) {
+	logger = logger.WithValues("enabled", cfg.Metrics.Enabled, "addr", cfg.Metrics.Address)
+
+	resource, err := newResource(appName, appVersion)
+	if err != nil {
+		return nil, nil, fmt.Errorf("MeterProvider: new resource: %v", err)
+	}
+
+	promExporter, err := otelprom.New(otelprom.WithRegisterer(reg))
+	if err != nil {
+		return nil, nil, fmt.Errorf("MeterProvider: prometheus exporter init: %v", err)
+	}
+
+	opts := []otelsdk_metric.Option{
+		otelsdk_metric.WithResource(resource),
+		otelsdk_metric.WithReader(promExporter),
+	}
+
+	if cfg.Metrics.Enabled && cfg.Metrics.Address != "" {
+		if _, _, err := net.SplitHostPort(cfg.Metrics.Address); err != nil {
+			return nil, nil, fmt.Errorf("MeterProvider: invalid metrics address %q: %w", cfg.Metrics.Address, err)
+		}
+
+		exporter, err := otlpmetricgrpc.New(ctx,
+			otlpmetricgrpc.WithEndpoint(cfg.Metrics.Address),
+			otlpmetricgrpc.WithInsecure(),
+			otlpmetricgrpc.WithRetry(otlpmetricgrpc.RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Second,
+				MaxInterval:     30 * time.Second,
+				MaxElapsedTime:  0,
+			}),
+			otlpmetricgrpc.WithDialOption(
+				grpc.WithUnaryInterceptor(errorLoggingInterceptor(logger, "Metric export failed.")),
+			),
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("MeterProvider: exporter init: %v", err)
+		}
+
+		interval := cfg.Metrics.Interval
+		if interval <= 0 {
+			interval = defaultMetricsInterval
+		}
+		opts = append(opts, otelsdk_metric.WithReader(
+			otelsdk_metric.NewPeriodicReader(exporter, otelsdk_metric.WithInterval(interval)),
+		))
+
+		logger.V(1).Info("Using OTel gRPC metric exporter; exporter will retry on failure.")
+	} else {
+		logger.V(1).Info("OTLP metric exporter is disabled.")
+	}
+
+	mp := otelsdk_metric.NewMeterProvider(opts...)
+	shutdown := func(ctx context.Context) error { return mp.Shutdown(ctx) }
+
+	return mp, shutdown, nil
+}
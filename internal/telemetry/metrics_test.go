@@ -0,0 +1,68 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"gotest.tools/v3/assert"
+)
+
+func TestMeterProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Invalid address fails fast", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := Config{
+			Metrics: MetricsConfig{
+				Enabled: true,
+				Address: "invalid-endpoint", // missing port
+			},
+		}
+
+		_, _, err := MeterProvider(t.Context(), logr.Discard(), cfg, prometheus.NewRegistry(), "enduro", "dev")
+		assert.Assert(t, err != nil, "expected error for invalid address")
+	})
+
+	t.Run("Valid address returns provider and shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := Config{
+			Metrics: MetricsConfig{
+				Enabled: true,
+				Address: "localhost:4317",
+			},
+		}
+
+		mp, shutdown, err := MeterProvider(t.Context(), logr.Discard(), cfg, prometheus.NewRegistry(), "enduro", "dev")
+		assert.NilError(t, err)
+		assert.Assert(t, mp != nil, "expected meter provider")
+		assert.Assert(t, shutdown != nil, "expected shutdown func")
+	})
+
+	t.Run("Registers metrics for scraping", func(t *testing.T) {
+		t.Parallel()
+
+		reg := prometheus.NewRegistry()
+		mp, shutdown, err := MeterProvider(t.Context(), logr.Discard(), Config{}, reg, "enduro", "dev")
+		assert.NilError(t, err)
+		t.Cleanup(func() { _ = shutdown(t.Context()) })
+
+		counter, err := mp.Meter("test").Int64Counter("enduro.test.sips")
+		assert.NilError(t, err)
+		counter.Add(t.Context(), 2)
+
+		families, err := reg.Gather()
+		assert.NilError(t, err)
+
+		var found bool
+		for _, f := range families {
+			if f.GetName() == "enduro_test_sips_total" {
+				found = true
+				assert.Equal(t, f.GetMetric()[0].GetCounter().GetValue(), float64(2))
+			}
+		}
+		assert.Assert(t, found, "expected enduro_test_sips_total metric")
+	})
+}
@@ -1,5 +1,23 @@
 package telemetry
 
-import "context"
+import (
+	"context"
+
+	otelsdk_resource "go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+)
 
 type ShutdownProvider func(ctx context.Context) error
+
+// newResource returns the resource describing the application that produces
+// the telemetry data.
+func newResource(appName, appVersion string) (*otelsdk_resource.Resource, error) {
+	return otelsdk_resource.Merge(
+		otelsdk_resource.Default(),
+		otelsdk_resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(appName),
+			semconv.ServiceVersion(appVersion),
+		),
+	)
+}
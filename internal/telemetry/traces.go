@@ -11,9 +11,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/propagation"
-	otelsdk_resource "go.opentelemetry.io/otel/sdk/resource"
 	otelsdk_trace "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc"
@@ -49,21 +47,14 @@ func TracerProvider(
 			MaxElapsedTime:  0,
 		}),
 		otlptracegrpc.WithDialOption(
-			grpc.WithUnaryInterceptor(errorLoggingInterceptor(logger)),
+			grpc.WithUnaryInterceptor(errorLoggingInterceptor(logger, "Trace export failed.")),
 		),
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("TracerProvider: exporter init: %v", err)
 	}
 
-	resource, err := otelsdk_resource.Merge(
-		otelsdk_resource.Default(),
-		otelsdk_resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceName(appName),
-			semconv.ServiceVersion(appVersion),
-		),
-	)
+	resource, err := newResource(appName, appVersion)
 	if err != nil {
 		return nil, nil, fmt.Errorf("TracerProvider: new resource: %v", err)
 	}
@@ -93,8 +84,9 @@ func RecordError(span trace.Span, err error) {
 	span.RecordError(err)
 }
 
-// errorLoggingInterceptor returns a gRPC interceptor that logs export errors.
-func errorLoggingInterceptor(logger logr.Logger) grpc.UnaryClientInterceptor {
+// errorLoggingInterceptor returns a gRPC interceptor that logs export errors
+// with the given message.
+func errorLoggingInterceptor(logger logr.Logger, msg string) grpc.UnaryClientInterceptor {
 	return func(
 		ctx context.Context,
 		method string,
@@ -106,7 +98,7 @@ func errorLoggingInterceptor(logger logr.Logger) grpc.UnaryClientInterceptor {
 		err := invoker(ctx, method, req, reply, cc, opts...)
 		if err != nil {
 			s := status.Convert(err)
-			logger.Info("Trace export failed.",
+			logger.Info(msg,
 				"method", method,
 				"code", s.Code().String(),
 				"err", err,
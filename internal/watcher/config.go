@@ -60,6 +60,26 @@ func (c Config) Validate() error {
 	return err
 }
 
+// ScanAntivirus returns true if antivirus scanning is enabled for the watcher
+// with the given name.
+func (c Config) ScanAntivirus(name string) bool {
+	if c.Embedded != nil && c.Embedded.Name == name {
+		return c.Embedded.ScanAntivirus
+	}
+	for _, fs := range c.Filesystem {
+		if fs != nil && fs.Name == name {
+			return fs.ScanAntivirus
+		}
+	}
+	for _, minio := range c.Minio {
+		if minio != nil && minio.Name == name {
+			return minio.ScanAntivirus
+		}
+	}
+
+	return false
+}
+
 func (c Config) CompletedDirs() []string {
 	dirs := []string{}
 	for _, item := range c.Filesystem {
@@ -95,6 +115,14 @@ type FilesystemConfig struct {
 	// WorkflowType specifies which workflow this watcher should execute
 	// (default: "create aip").
 	WorkflowType enums.WorkflowType
+
+	// ScanAntivirus enables the antivirus scanning of the SIPs received by
+	// this watcher. It requires the [antivirus] configuration.
+	ScanAntivirus bool
+
+	// ProcessingProfile is the name of the [[preservation.profiles]] processing
+	// profile used for the SIPs received by this watcher, if any.
+	ProcessingProfile string
 }
 
 func (cfg *FilesystemConfig) setDefaults() {
@@ -132,4 +160,12 @@ type MinioConfig struct {
 	// WorkflowType specifies which workflow this watcher should execute
 	// (default: "create aip").
 	WorkflowType enums.WorkflowType
+
+	// ScanAntivirus enables the antivirus scanning of the SIPs received by
+	// this watcher. It requires the [antivirus] configuration.
+	ScanAntivirus bool
+
+	// ProcessingProfile is the name of the [[preservation.profiles]] processing
+	// profile used for the SIPs received by this watcher, if any.
+	ProcessingProfile string
 }
@@ -32,6 +32,26 @@ func TestCompletedDirs(t *testing.T) {
 	})
 }
 
+func TestScanAntivirus(t *testing.T) {
+	t.Parallel()
+
+	c := watcher.Config{
+		Filesystem: []*watcher.FilesystemConfig{
+			nil,
+			{Name: "fs", ScanAntivirus: true},
+		},
+		Minio: []*watcher.MinioConfig{
+			{Name: "minio"},
+		},
+		Embedded: &watcher.MinioConfig{Name: "embedded", ScanAntivirus: true},
+	}
+
+	assert.Equal(t, c.ScanAntivirus("fs"), true)
+	assert.Equal(t, c.ScanAntivirus("minio"), false)
+	assert.Equal(t, c.ScanAntivirus("embedded"), true)
+	assert.Equal(t, c.ScanAntivirus("missing"), false)
+}
+
 func TestValidate(t *testing.T) {
 	t.Parallel()
 
@@ -37,16 +37,20 @@ type BlobEvent struct {
 
 	// Type of workflow to execute.
 	WorkflowType enums.WorkflowType
+
+	// Name of the processing profile to use, if any.
+	ProcessingProfile string
 }
 
 func NewBlobEvent(w Watcher, key string, isDir bool) *BlobEvent {
 	return &BlobEvent{
-		WatcherName:     w.String(),
-		RetentionPeriod: w.RetentionPeriod(),
-		CompletedDir:    w.CompletedDir(),
-		WorkflowType:    w.WorkflowType(),
-		Key:             key,
-		IsDir:           isDir,
+		WatcherName:       w.String(),
+		RetentionPeriod:   w.RetentionPeriod(),
+		CompletedDir:      w.CompletedDir(),
+		WorkflowType:      w.WorkflowType(),
+		ProcessingProfile: w.ProcessingProfile(),
+		Key:               key,
+		IsDir:             isDir,
 	}
 }
 
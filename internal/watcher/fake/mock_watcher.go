@@ -197,6 +197,44 @@ func (c *MockWatcherPathCall) DoAndReturn(f func() string) *MockWatcherPathCall
 	return c
 }
 
+// ProcessingProfile mocks base method.
+func (m *MockWatcher) ProcessingProfile() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessingProfile")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProcessingProfile indicates an expected call of ProcessingProfile.
+func (mr *MockWatcherMockRecorder) ProcessingProfile() *MockWatcherProcessingProfileCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessingProfile", reflect.TypeOf((*MockWatcher)(nil).ProcessingProfile))
+	return &MockWatcherProcessingProfileCall{Call: call}
+}
+
+// MockWatcherProcessingProfileCall wrap *gomock.Call
+type MockWatcherProcessingProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWatcherProcessingProfileCall) Return(arg0 string) *MockWatcherProcessingProfileCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWatcherProcessingProfileCall) Do(f func() string) *MockWatcherProcessingProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWatcherProcessingProfileCall) DoAndReturn(f func() string) *MockWatcherProcessingProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RetentionPeriod mocks base method.
 func (m *MockWatcher) RetentionPeriod() time.Duration {
 	m.ctrl.T.Helper()
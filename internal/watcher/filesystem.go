@@ -74,6 +74,7 @@ func NewFilesystemWatcher(ctx context.Context, config *FilesystemConfig) (*files
 			retentionPeriod: config.RetentionPeriod,
 			completedDir:    config.CompletedDir,
 			workflowType:    config.WorkflowType,
+			profile:         config.ProcessingProfile,
 		},
 	}
 
@@ -93,6 +93,7 @@ func NewMinioWatcher(
 			name:            config.Name,
 			retentionPeriod: config.RetentionPeriod,
 			workflowType:    config.WorkflowType,
+			profile:         config.ProcessingProfile,
 		},
 	}, nil
 }
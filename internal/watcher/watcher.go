@@ -42,6 +42,7 @@ type Watcher interface {
 	RetentionPeriod() time.Duration
 	CompletedDir() string
 	WorkflowType() enums.WorkflowType
+	ProcessingProfile() string
 
 	// Full path of the watched bucket when available, empty string otherwise.
 	Path() string
@@ -54,6 +55,7 @@ type commonWatcherImpl struct {
 	retentionPeriod time.Duration
 	completedDir    string
 	workflowType    enums.WorkflowType
+	profile         string
 }
 
 func (w *commonWatcherImpl) String() string {
@@ -72,6 +74,10 @@ func (w *commonWatcherImpl) WorkflowType() enums.WorkflowType {
 	return w.workflowType
 }
 
+func (w *commonWatcherImpl) ProcessingProfile() string {
+	return w.profile
+}
+
 type Service interface {
 	// Watchers return all known watchers.
 	Watchers() []Watcher
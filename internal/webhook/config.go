@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"go.artefactual.dev/tools/bucket"
+)
+
+var (
+	// ErrMissingName is returned when a webhook endpoint name is empty.
+	ErrMissingName = errors.New("webhook: missing endpoint name")
+	// ErrMissingSecret is returned when a webhook endpoint has no secret.
+	ErrMissingSecret = errors.New("webhook: missing endpoint secret")
+	// ErrInvalidURL is returned when a webhook endpoint URL is not a valid
+	// HTTP(S) URL.
+	ErrInvalidURL = errors.New("webhook: invalid endpoint URL")
+	// ErrUnknownEventType is returned when an endpoint filter references an
+	// event type that doesn't exist.
+	ErrUnknownEventType = errors.New("webhook: unknown event type")
+)
+
+type Config struct {
+	// Endpoints is the list of webhook endpoints that are notified of ingest
+	// and storage events.
+	Endpoints []EndpointConfig
+
+	// Timeout is the maximum duration of a single delivery attempt.
+	Timeout time.Duration
+
+	// MaxAttempts is the maximum number of delivery attempts for an event,
+	// including the first one.
+	MaxAttempts int
+
+	// InitialInterval is the backoff interval before the first retry. The
+	// interval doubles after each failed attempt, up to MaxInterval.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum backoff interval between retries.
+	MaxInterval time.Duration
+
+	// DeadLetter is an optional bucket where undeliverable events are
+	// recorded. Undeliverable events are only logged when not set.
+	DeadLetter *bucket.Config
+}
+
+type EndpointConfig struct {
+	// Name identifies the endpoint in logs and dead-letter records.
+	Name string
+
+	// URL is the HTTP(S) URL events are posted to.
+	URL string
+
+	// Secret is the key used to sign the request body (HMAC-SHA256).
+	Secret string
+
+	// Events is the list of event types sent to the endpoint, e.g.
+	// "sip_status_updated_event". All event types are sent when empty.
+	Events []string
+}
+
+func (c Config) Validate() error {
+	var errs error
+
+	names := make([]string, 0, len(c.Endpoints))
+	for _, e := range c.Endpoints {
+		if err := e.Validate(); err != nil {
+			errs = errors.Join(errs, err)
+		}
+		if e.Name != "" && slices.Contains(names, e.Name) {
+			errs = errors.Join(errs, fmt.Errorf("webhook: duplicate endpoint name %q", e.Name))
+		}
+		names = append(names, e.Name)
+	}
+
+	if len(c.Endpoints) > 0 && c.MaxAttempts < 1 {
+		errs = errors.Join(errs, errors.New("webhook: maxAttempts must be greater than zero"))
+	}
+
+	return errs
+}
+
+func (c EndpointConfig) Validate() error {
+	var errs error
+
+	if c.Name == "" {
+		errs = errors.Join(errs, ErrMissingName)
+	}
+	if c.Secret == "" {
+		errs = errors.Join(errs, fmt.Errorf("%w: %q", ErrMissingSecret, c.Name))
+	}
+	if u, err := url.Parse(c.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = errors.Join(errs, fmt.Errorf("%w: %q", ErrInvalidURL, c.URL))
+	}
+	for _, t := range c.Events {
+		if !slices.Contains(EventTypes, t) {
+			errs = errors.Join(errs, fmt.Errorf("%w: %q", ErrUnknownEventType, t))
+		}
+	}
+
+	return errs
+}
+
+// subscribed reports whether the endpoint accepts events of type t.
+func (c EndpointConfig) subscribed(t string) bool {
+	return len(c.Events) == 0 || slices.Contains(c.Events, t)
+}
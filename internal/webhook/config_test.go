@@ -0,0 +1,80 @@
+package webhook_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-sdps/enduro/internal/webhook"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name    string
+		cfg     webhook.Config
+		wantErr string
+	}{
+		{
+			name: "Allows an empty config",
+		},
+		{
+			name: "Validates a config",
+			cfg: webhook.Config{
+				MaxAttempts: 5,
+				Endpoints: []webhook.EndpointConfig{
+					{
+						Name:   "catalogue",
+						URL:    "https://catalogue.example.com/hooks/enduro",
+						Secret: "secret",
+						Events: []string{"sip_status_updated_event", "aip_task_created_event"},
+					},
+					{
+						Name:   "ticketing",
+						URL:    "http://ticketing.example.com",
+						Secret: "secret",
+					},
+				},
+			},
+		},
+		{
+			name: "Errors on invalid endpoints",
+			cfg: webhook.Config{
+				Endpoints: []webhook.EndpointConfig{
+					{
+						URL:    "ftp://catalogue.example.com",
+						Events: []string{"sip_deleted_event"},
+					},
+				},
+			},
+			wantErr: `webhook: missing endpoint name
+webhook: missing endpoint secret: ""
+webhook: invalid endpoint URL: "ftp://catalogue.example.com"
+webhook: unknown event type: "sip_deleted_event"
+webhook: maxAttempts must be greater than zero`,
+		},
+		{
+			name: "Errors on duplicate endpoint names",
+			cfg: webhook.Config{
+				MaxAttempts: 5,
+				Endpoints: []webhook.EndpointConfig{
+					{Name: "catalogue", URL: "https://one.example.com", Secret: "secret"},
+					{Name: "catalogue", URL: "https://two.example.com", Secret: "secret"},
+				},
+			},
+			wantErr: `webhook: duplicate endpoint name "catalogue"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.cfg.Validate()
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gocloud.dev/blob"
+)
+
+const (
+	// SignatureHeader is the request header that holds the HMAC-SHA256
+	// signature of the request body, formatted as "sha256=<hex digest>".
+	SignatureHeader = "X-Enduro-Signature"
+
+	// EventHeader is the request header that holds the event type.
+	EventHeader = "X-Enduro-Event"
+
+	// DeliveryHeader is the request header that holds the event ID.
+	DeliveryHeader = "X-Enduro-Delivery"
+
+	// queueSize is the number of events buffered for each endpoint. Events are
+	// dead-lettered when the queue of an endpoint is full.
+	queueSize = 256
+)
+
+// DeadLetter records an event that couldn't be delivered to an endpoint.
+type DeadLetter struct {
+	Endpoint string    `json:"endpoint"`
+	URL      string    `json:"url"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+	Event    *Event    `json:"event"`
+}
+
+// Dispatcher delivers events to the configured webhook endpoints. Each
+// endpoint has its own queue, so a slow or failing endpoint doesn't delay
+// deliveries to the others.
+type Dispatcher struct {
+	logger     logr.Logger
+	cfg        Config
+	client     *http.Client
+	deadLetter *blob.Bucket
+	endpoints  []*endpoint
+}
+
+type endpoint struct {
+	EndpointConfig
+	queue chan *Event
+}
+
+// NewDispatcher returns a new dispatcher for the endpoints in cfg. Failed
+// deliveries are recorded in deadLetter, which may be nil.
+func NewDispatcher(logger logr.Logger, cfg Config, client *http.Client, deadLetter *blob.Bucket) *Dispatcher {
+	d := &Dispatcher{
+		logger:     logger,
+		cfg:        cfg,
+		client:     client,
+		deadLetter: deadLetter,
+		endpoints:  make([]*endpoint, 0, len(cfg.Endpoints)),
+	}
+	for _, e := range cfg.Endpoints {
+		d.endpoints = append(d.endpoints, &endpoint{
+			EndpointConfig: e,
+			queue:          make(chan *Event, queueSize),
+		})
+	}
+
+	return d
+}
+
+// Run delivers the events from sources until ctx is canceled or a source
+// fails. The events still queued when Run returns are recorded in the
+// dead-letter bucket.
+func (d *Dispatcher) Run(ctx context.Context, sources ...Source) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, e := range d.endpoints {
+		wg.Go(func() { d.work(ctx, e) })
+	}
+
+	errCh := make(chan error, len(sources))
+	for _, src := range sources {
+		wg.Go(func() { errCh <- src(ctx, d) })
+	}
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errCh:
+	}
+	cancel()
+	wg.Wait()
+
+	// The sources have stopped, so nothing else is queued from here on.
+	d.drain(ctx)
+
+	return err
+}
+
+// Dispatch queues ev for delivery to the endpoints subscribed to its type.
+func (d *Dispatcher) Dispatch(ctx context.Context, ev *Event) {
+	for _, e := range d.endpoints {
+		if !e.subscribed(ev.Type) {
+			continue
+		}
+		select {
+		case e.queue <- ev:
+		default:
+			d.fail(ctx, e, ev, 0, errors.New("delivery queue is full"))
+		}
+	}
+}
+
+// work delivers the events queued for e until ctx is canceled.
+func (d *Dispatcher) work(ctx context.Context, e *endpoint) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-e.queue:
+			d.deliver(ctx, e, ev)
+		}
+	}
+}
+
+// drain records the events left in the endpoint queues as failed deliveries.
+func (d *Dispatcher) drain(ctx context.Context) {
+	for _, e := range d.endpoints {
+		for len(e.queue) > 0 {
+			d.fail(ctx, e, <-e.queue, 0, errors.New("dispatcher stopped before delivery"))
+		}
+	}
+}
+
+// deliver posts ev to e, retrying with exponential backoff until it succeeds
+// or the maximum number of attempts is reached.
+func (d *Dispatcher) deliver(ctx context.Context, e *endpoint, ev *Event) {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		d.fail(ctx, e, ev, 0, err)
+		return
+	}
+
+	interval := d.cfg.InitialInterval
+	for attempt := 1; ; attempt++ {
+		err = d.post(ctx, e, ev, body)
+		if err == nil {
+			return
+		}
+		if attempt >= d.cfg.MaxAttempts || ctx.Err() != nil {
+			d.fail(ctx, e, ev, attempt, err)
+			return
+		}
+
+		d.logger.V(1).Info(
+			"Webhook delivery failed, retrying.",
+			"endpoint", e.Name,
+			"event", ev.ID,
+			"attempt", attempt,
+			"err", err,
+		)
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			d.fail(ctx, e, ev, attempt, err)
+			return
+		case <-t.C:
+		}
+
+		interval *= 2
+		if d.cfg.MaxInterval > 0 && interval > d.cfg.MaxInterval {
+			interval = d.cfg.MaxInterval
+		}
+	}
+}
+
+// post sends a single signed delivery request.
+func (d *Dispatcher) post(ctx context.Context, e *endpoint, ev *Event, body []byte) error {
+	if d.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.cfg.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, ev.Type)
+	req.Header.Set(DeliveryHeader, ev.ID.String())
+	req.Header.Set(SignatureHeader, Sign(e.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// fail logs a failed delivery and records it in the dead-letter bucket.
+func (d *Dispatcher) fail(ctx context.Context, e *endpoint, ev *Event, attempts int, err error) {
+	d.logger.Error(err, "Webhook delivery failed.", "endpoint", e.Name, "event", ev.ID, "attempts", attempts)
+
+	if d.deadLetter == nil {
+		return
+	}
+
+	data, mErr := json.Marshal(&DeadLetter{
+		Endpoint: e.Name,
+		URL:      e.URL,
+		Attempts: attempts,
+		Error:    err.Error(),
+		FailedAt: time.Now().UTC(),
+		Event:    ev,
+	})
+	if mErr != nil {
+		d.logger.Error(mErr, "Error encoding dead-letter record.", "endpoint", e.Name, "event", ev.ID)
+		return
+	}
+
+	// Record the failure even if the dispatcher is shutting down.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	key := fmt.Sprintf("%s/%s.json", e.Name, ev.ID)
+	if err := d.deadLetter.WriteAll(ctx, key, data, &blob.WriterOptions{ContentType: "application/json"}); err != nil {
+		d.logger.Error(err, "Error writing dead-letter record.", "endpoint", e.Name, "event", ev.ID)
+	}
+}
+
+// Sign returns the signature of body using secret, formatted as the value of
+// the SignatureHeader request header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,355 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/memblob"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/poll"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/event"
+	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/internal/storage"
+	"github.com/artefactual-sdps/enduro/internal/webhook"
+)
+
+// receiver is a webhook endpoint that records the requests it receives and
+// responds with the queued status codes (200 when none are left).
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (r *receiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func config(endpoints ...webhook.EndpointConfig) webhook.Config {
+	return webhook.Config{
+		Endpoints:       endpoints,
+		Timeout:         time.Second,
+		MaxAttempts:     3,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+	}
+}
+
+func testEvent(t string) *webhook.Event {
+	return &webhook.Event{
+		ID:        uuid.MustParse("c6d1b1bc-4c4e-4a4c-9b4c-4ee9e7a8a2b4"),
+		Type:      t,
+		Timestamp: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+		Data:      json.RawMessage(`{"uuid":"c6d1b1bc-4c4e-4a4c-9b4c-4ee9e7a8a2b4"}`),
+	}
+}
+
+// run runs the dispatcher in the background until the test ends.
+func run(t *testing.T, d *webhook.Dispatcher, sources ...webhook.Source) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+	go func() { done <- d.Run(ctx, sources...) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.NilError(t, <-done)
+	})
+}
+
+func TestDispatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Delivers signed events to subscribed endpoints", func(t *testing.T) {
+		t.Parallel()
+
+		all, filtered := &receiver{}, &receiver{}
+		allSrv, filteredSrv := httptest.NewServer(all), httptest.NewServer(filtered)
+		t.Cleanup(allSrv.Close)
+		t.Cleanup(filteredSrv.Close)
+
+		d := webhook.NewDispatcher(logr.Discard(), config(
+			webhook.EndpointConfig{Name: "all", URL: allSrv.URL, Secret: "secret"},
+			webhook.EndpointConfig{
+				Name:   "filtered",
+				URL:    filteredSrv.URL,
+				Secret: "secret",
+				Events: []string{"sip_status_updated_event"},
+			},
+		), allSrv.Client(), nil)
+		run(t, d)
+
+		d.Dispatch(t.Context(), testEvent("sip_created_event"))
+		d.Dispatch(t.Context(), testEvent("sip_status_updated_event"))
+
+		poll.WaitOn(t, func(poll.LogT) poll.Result {
+			if all.count() == 2 && filtered.count() == 1 {
+				return poll.Success()
+			}
+			return poll.Continue("waiting for deliveries")
+		})
+
+		req, body := filtered.requests[0], filtered.bodies[0]
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.Header.Get("Content-Type"), "application/json")
+		assert.Equal(t, req.Header.Get(webhook.EventHeader), "sip_status_updated_event")
+		assert.Equal(t, req.Header.Get(webhook.DeliveryHeader), "c6d1b1bc-4c4e-4a4c-9b4c-4ee9e7a8a2b4")
+		assert.Equal(t, req.Header.Get(webhook.SignatureHeader), webhook.Sign("secret", body))
+		assert.Equal(t, string(body), `{"id":"c6d1b1bc-4c4e-4a4c-9b4c-4ee9e7a8a2b4","type":"sip_status_updated_event","timestamp":"2026-10-17T12:00:00Z","data":{"uuid":"c6d1b1bc-4c4e-4a4c-9b4c-4ee9e7a8a2b4"}}`)
+	})
+
+	t.Run("Retries failed deliveries", func(t *testing.T) {
+		t.Parallel()
+
+		r := &receiver{statuses: []int{http.StatusInternalServerError, http.StatusBadGateway}}
+		srv := httptest.NewServer(r)
+		t.Cleanup(srv.Close)
+
+		deadLetter, err := blob.OpenBucket(t.Context(), "mem://")
+		assert.NilError(t, err)
+		t.Cleanup(func() { deadLetter.Close() })
+
+		d := webhook.NewDispatcher(logr.Discard(), config(
+			webhook.EndpointConfig{Name: "ticketing", URL: srv.URL, Secret: "secret"},
+		), srv.Client(), deadLetter)
+		run(t, d)
+
+		d.Dispatch(t.Context(), testEvent("sip_created_event"))
+
+		poll.WaitOn(t, func(poll.LogT) poll.Result {
+			if r.count() == 3 {
+				return poll.Success()
+			}
+			return poll.Continue("waiting for deliveries")
+		})
+
+		// All the attempts deliver the same event.
+		assert.DeepEqual(t, r.bodies[0], r.bodies[2])
+
+		exists, err := deadLetter.Exists(t.Context(), "ticketing/c6d1b1bc-4c4e-4a4c-9b4c-4ee9e7a8a2b4.json")
+		assert.NilError(t, err)
+		assert.Assert(t, !exists)
+	})
+
+	t.Run("Records undeliverable events in the dead-letter bucket", func(t *testing.T) {
+		t.Parallel()
+
+		r := &receiver{statuses: []int{500, 500, 500}}
+		srv := httptest.NewServer(r)
+		t.Cleanup(srv.Close)
+
+		deadLetter, err := blob.OpenBucket(t.Context(), "mem://")
+		assert.NilError(t, err)
+		t.Cleanup(func() { deadLetter.Close() })
+
+		d := webhook.NewDispatcher(logr.Discard(), config(
+			webhook.EndpointConfig{Name: "ticketing", URL: srv.URL, Secret: "secret"},
+		), srv.Client(), deadLetter)
+		run(t, d)
+
+		ev := testEvent("sip_created_event")
+		d.Dispatch(t.Context(), ev)
+
+		key := "ticketing/c6d1b1bc-4c4e-4a4c-9b4c-4ee9e7a8a2b4.json"
+		poll.WaitOn(t, func(poll.LogT) poll.Result {
+			if ok, _ := deadLetter.Exists(t.Context(), key); ok {
+				return poll.Success()
+			}
+			return poll.Continue("waiting for dead-letter record")
+		})
+
+		data, err := deadLetter.ReadAll(t.Context(), key)
+		assert.NilError(t, err)
+
+		var got webhook.DeadLetter
+		assert.NilError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, got.Endpoint, "ticketing")
+		assert.Equal(t, got.URL, srv.URL)
+		assert.Equal(t, got.Attempts, 3)
+		assert.Equal(t, got.Error, "unexpected response status: 500 Internal Server Error")
+		assert.Equal(t, got.Event.ID, ev.ID)
+		assert.Equal(t, r.count(), 3)
+	})
+
+	t.Run("Records the queued events in the dead-letter bucket on shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		r := &receiver{}
+		srv := httptest.NewServer(r)
+		t.Cleanup(srv.Close)
+
+		deadLetter, err := blob.OpenBucket(t.Context(), "mem://")
+		assert.NilError(t, err)
+		t.Cleanup(func() { deadLetter.Close() })
+
+		d := webhook.NewDispatcher(logr.Discard(), config(
+			webhook.EndpointConfig{Name: "ticketing", URL: srv.URL, Secret: "secret"},
+		), srv.Client(), deadLetter)
+
+		ev1, ev2 := testEvent("sip_created_event"), testEvent("sip_updated_event")
+		ev2.ID = uuid.MustParse("e2b0f3a4-5d6c-4b7a-8e9f-0a1b2c3d4e5f")
+		d.Dispatch(t.Context(), ev1)
+		d.Dispatch(t.Context(), ev2)
+
+		// Run stops right away, before the queued events can be delivered.
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		assert.NilError(t, d.Run(ctx))
+
+		for _, ev := range []*webhook.Event{ev1, ev2} {
+			exists, err := deadLetter.Exists(t.Context(), "ticketing/"+ev.ID.String()+".json")
+			assert.NilError(t, err)
+			assert.Assert(t, exists, ev.ID)
+		}
+		assert.Equal(t, r.count(), 0)
+	})
+}
+
+func TestEventSource(t *testing.T) {
+	t.Parallel()
+
+	r := &receiver{}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	evsvc := event.NewServiceInMem[*goaingest.IngestEvent]()
+	d := webhook.NewDispatcher(logr.Discard(), config(
+		webhook.EndpointConfig{Name: "catalogue", URL: srv.URL, Secret: "secret"},
+	), srv.Client(), nil)
+	run(t, d, webhook.EventSource(evsvc, &ingest.EventSerializer{}))
+
+	sipID := uuid.MustParse("e2ace0da-8697-453d-9ea1-4c9b62309e54")
+
+	// Publish events until the source has subscribed to the event service.
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		ingest.PublishEvent(t.Context(), evsvc, &goaingest.IngestPingEvent{Message: new("ping")})
+		ingest.PublishEvent(t.Context(), evsvc, &goaingest.SIPStatusUpdatedEvent{
+			UUID:   sipID,
+			Status: "ingested",
+		})
+		if r.count() > 0 {
+			return poll.Success()
+		}
+		return poll.Continue("waiting for deliveries")
+	}, poll.WithDelay(10*time.Millisecond))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var got webhook.Event
+	assert.NilError(t, json.Unmarshal(r.bodies[0], &got))
+	assert.Equal(t, r.requests[0].Header.Get(webhook.EventHeader), "sip_status_updated_event")
+	assert.Equal(t, got.Type, "sip_status_updated_event")
+	assert.Equal(t, string(got.Data), `{"uuid":"e2ace0da-8697-453d-9ea1-4c9b62309e54","status":"ingested"}`)
+}
+
+func TestStorageEventSource(t *testing.T) {
+	t.Parallel()
+
+	r := &receiver{}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	evsvc := event.NewServiceInMem[*goastorage.StorageEvent]()
+	d := webhook.NewDispatcher(logr.Discard(), config(
+		webhook.EndpointConfig{
+			Name:   "deletions",
+			URL:    srv.URL,
+			Secret: "secret",
+			Events: []string{"aip_deletion_request_created_event", "aip_deletion_request_updated_event"},
+		},
+	), srv.Client(), nil)
+	run(t, d, webhook.EventSource(evsvc, &storage.EventSerializer{}))
+
+	drID := uuid.MustParse("5b1e6f6c-7d2a-4c55-9a43-2b8d7e1c0f3a")
+	aipID := uuid.MustParse("e2ace0da-8697-453d-9ea1-4c9b62309e54")
+
+	// Publish the creation event until the source has subscribed to the event
+	// service.
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		storage.PublishEvent(t.Context(), evsvc, &goastorage.AIPStatusUpdatedEvent{
+			UUID:   aipID,
+			Status: "pending",
+		})
+		storage.PublishEvent(t.Context(), evsvc, &goastorage.AIPDeletionRequestCreatedEvent{
+			UUID:      drID,
+			AipUUID:   aipID,
+			Status:    "pending",
+			Reason:    "Duplicate",
+			Requester: "requester@example.com",
+		})
+		if r.count() > 0 {
+			return poll.Success()
+		}
+		return poll.Continue("waiting for deliveries")
+	}, poll.WithDelay(10*time.Millisecond))
+
+	storage.PublishEvent(t.Context(), evsvc, &goastorage.AIPDeletionRequestUpdatedEvent{
+		UUID:      drID,
+		AipUUID:   aipID,
+		Status:    "approved",
+		Reason:    "Duplicate",
+		Requester: "requester@example.com",
+		Reviewer:  new("reviewer@example.com"),
+	})
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for _, req := range r.requests {
+			if req.Header.Get(webhook.EventHeader) == "aip_deletion_request_updated_event" {
+				return poll.Success()
+			}
+		}
+		return poll.Continue("waiting for deliveries")
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var got webhook.Event
+	assert.NilError(t, json.Unmarshal(r.bodies[0], &got))
+	assert.Equal(t, r.requests[0].Header.Get(webhook.EventHeader), "aip_deletion_request_created_event")
+	assert.Equal(t, got.Type, "aip_deletion_request_created_event")
+	assert.Equal(t, string(got.Data), `{"uuid":"5b1e6f6c-7d2a-4c55-9a43-2b8d7e1c0f3a","aip_uuid":"e2ace0da-8697-453d-9ea1-4c9b62309e54","status":"pending","reason":"Duplicate","requester":"requester@example.com"}`)
+
+	last := len(r.bodies) - 1
+	assert.NilError(t, json.Unmarshal(r.bodies[last], &got))
+	assert.Equal(t, got.Type, "aip_deletion_request_updated_event")
+	assert.Equal(t, string(got.Data), `{"uuid":"5b1e6f6c-7d2a-4c55-9a43-2b8d7e1c0f3a","aip_uuid":"e2ace0da-8697-453d-9ea1-4c9b62309e54","status":"approved","reason":"Duplicate","requester":"requester@example.com","reviewer":"reviewer@example.com"}`)
+
+	// AIP status events are not delivered to the endpoint.
+	for _, req := range r.requests {
+		assert.Assert(t, req.Header.Get(webhook.EventHeader) != "aip_status_updated_event")
+	}
+}
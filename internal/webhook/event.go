@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	goaingest "github.com/artefactual-sdps/enduro/internal/api/gen/ingest"
+	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
+	"github.com/artefactual-sdps/enduro/internal/event"
+)
+
+// EventTypes lists the event types that can be delivered to webhook endpoints.
+// Ping events are only used to keep monitor connections alive and are never
+// delivered.
+var EventTypes = []string{
+	string(goaingest.ValueKindSipCreatedEvent),
+	string(goaingest.ValueKindSipUpdatedEvent),
+	string(goaingest.ValueKindSipStatusUpdatedEvent),
+	string(goaingest.ValueKindSipWorkflowCreatedEvent),
+	string(goaingest.ValueKindSipWorkflowUpdatedEvent),
+	string(goaingest.ValueKindSipTaskCreatedEvent),
+	string(goaingest.ValueKindSipTaskUpdatedEvent),
+	string(goaingest.ValueKindBatchCreatedEvent),
+	string(goaingest.ValueKindBatchUpdatedEvent),
+	string(goastorage.ValueKindLocationCreatedEvent),
+	string(goastorage.ValueKindAipCreatedEvent),
+	string(goastorage.ValueKindAipUpdatedEvent),
+	string(goastorage.ValueKindAipStatusUpdatedEvent),
+	string(goastorage.ValueKindAipLocationUpdatedEvent),
+	string(goastorage.ValueKindAipWorkflowCreatedEvent),
+	string(goastorage.ValueKindAipWorkflowUpdatedEvent),
+	string(goastorage.ValueKindAipTaskCreatedEvent),
+	string(goastorage.ValueKindAipTaskUpdatedEvent),
+	string(goastorage.ValueKindAipDeletionRequestCreatedEvent),
+	string(goastorage.ValueKindAipDeletionRequestUpdatedEvent),
+	string(goastorage.ValueKindAipFixityCheckedEvent),
+}
+
+// Event is the JSON document posted to webhook endpoints.
+type Event struct {
+	// ID uniquely identifies the event. Retried deliveries of an event share
+	// the same ID so receivers can discard duplicates.
+	ID uuid.UUID `json:"id"`
+
+	// Type is the event type, e.g. "sip_status_updated_event".
+	Type string `json:"type"`
+
+	// Timestamp is the time the event was received by the dispatcher.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Data is the event value, encoded as in the monitor API endpoints.
+	Data json.RawMessage `json:"data"`
+}
+
+// Source subscribes to an event service and dispatches its events until ctx
+// is canceled.
+type Source func(ctx context.Context, d *Dispatcher) error
+
+// EventSource returns a Source for an ingest or storage event service. The
+// serializer must encode events in the monitor response body format.
+func EventSource[T any](svc event.Service[T], serializer event.Serializer[T]) Source {
+	return func(ctx context.Context, d *Dispatcher) error {
+		sub, err := svc.Subscribe(ctx)
+		if err != nil {
+			return fmt.Errorf("webhook: subscribe: %v", err)
+		}
+		defer sub.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case v, ok := <-sub.C():
+				if !ok {
+					return nil
+				}
+				ev, err := newEvent(v, serializer)
+				if err != nil {
+					d.logger.Error(err, "Error converting event.")
+					continue
+				}
+				if ev != nil {
+					d.Dispatch(ctx, ev)
+				}
+			}
+		}
+	}
+}
+
+// newEvent converts a monitor event into a webhook event. It returns nil for
+// ping events.
+func newEvent[T any](v T, serializer event.Serializer[T]) (*Event, error) {
+	blob, err := serializer.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: encode event: %v", err)
+	}
+
+	var body struct {
+		Value struct {
+			Type  string          `json:"type"`
+			Value json.RawMessage `json:"value"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(blob, &body); err != nil {
+		return nil, fmt.Errorf("webhook: decode event: %v", err)
+	}
+	if strings.HasSuffix(body.Value.Type, "_ping_event") {
+		return nil, nil
+	}
+
+	return &Event{
+		ID:        uuid.New(),
+		Type:      body.Value.Type,
+		Timestamp: time.Now().UTC(),
+		Data:      body.Value.Value,
+	}, nil
+}
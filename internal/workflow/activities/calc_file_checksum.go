@@ -2,11 +2,14 @@ package activities
 
 import (
 	"context"
-	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"slices"
 
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
 )
@@ -16,6 +19,9 @@ const CalcFileChecksumActivityName = "calc-file-checksum-activity"
 type (
 	CalcFileChecksumActivityParams struct {
 		Path string
+
+		// Algorithm is the checksum algorithm (default: SHA-256).
+		Algorithm datatypes.ChecksumAlgo
 	}
 	CalcFileChecksumActivityResult struct {
 		Checksum datatypes.Checksum
@@ -27,13 +33,27 @@ func NewCalcFileChecksumActivity() *CalcFileChecksumActivity {
 	return &CalcFileChecksumActivity{}
 }
 
-// Execute calculates the SHA-256 checksum of the file at params.Path.
+// Execute calculates the checksum of the file at params.Path using
+// params.Algorithm.
 //
-// If params.Path is a directory, Execute returns an error.
+// If params.Path is a directory, Execute returns its tree hash: the checksum
+// of a manifest listing every regular file in the directory, one
+// "<hash>  <path>\n" line per file, with the file checksum as a lowercase hex
+// string and the path relative to the directory, using forward slashes. The
+// lines are sorted by path, comparing the paths byte-wise. Empty directories and symbolic links are ignored, so the tree
+// hash only depends on the file names and contents.
 func (a *CalcFileChecksumActivity) Execute(
 	ctx context.Context,
 	params *CalcFileChecksumActivityParams,
 ) (*CalcFileChecksumActivityResult, error) {
+	algo := params.Algorithm
+	if algo == "" {
+		algo = datatypes.ChecksumAlgoSHA256
+	}
+	if algo.New() == nil {
+		return nil, fmt.Errorf("calculate file checksum: unsupported algorithm: %q", algo)
+	}
+
 	fi, err := os.Stat(params.Path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -42,25 +62,75 @@ func (a *CalcFileChecksumActivity) Execute(
 		return nil, fmt.Errorf("calculate file checksum: stat file: %v", err)
 	}
 
+	var sum []byte
 	if fi.IsDir() {
-		return nil, fmt.Errorf("calculate file checksum: not a file: %s", params.Path)
+		sum, err = treeHash(params.Path, algo)
+	} else {
+		sum, err = fileHash(params.Path, algo.New())
 	}
-
-	f, err := os.Open(params.Path)
 	if err != nil {
-		return nil, fmt.Errorf("calculate file checksum: open file: %v", err)
-	}
-	defer f.Close()
-
-	h := sha256.New()
-	if _, err := io.Copy(h, f); err != nil {
 		return nil, fmt.Errorf("calculate file checksum: compute hash: %v", err)
 	}
 
 	return &CalcFileChecksumActivityResult{
 		Checksum: datatypes.Checksum{
-			Algorithm: datatypes.ChecksumAlgoSHA256,
-			Hash:      hex.EncodeToString(h.Sum(nil)),
+			Algorithm: algo,
+			Hash:      hex.EncodeToString(sum),
 		},
 	}, nil
 }
+
+func fileHash(path string, h hash.Hash) ([]byte, error) {
+	f, err := os.Open(path) // #nosec G304 -- trusted file path.
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+func treeHash(root string, algo datatypes.ChecksumAlgo) ([]byte, error) {
+	var paths []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, filepath.ToSlash(rel))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// WalkDir sorts the entries of each directory, not the full paths (e.g.
+	// "a/b" is visited before "a.txt"), so sort the paths to keep the tree
+	// hash independent from the directory structure traversal.
+	slices.Sort(paths)
+
+	tree := algo.New()
+	for _, rel := range paths {
+		sum, err := fileHash(filepath.Join(root, filepath.FromSlash(rel)), algo.New())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fmt.Fprintf(tree, "%x  %s\n", sum, rel); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree.Sum(nil), nil
+}
@@ -7,6 +7,7 @@ import (
 	temporalsdk_activity "go.temporal.io/sdk/activity"
 	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
 	"gotest.tools/v3/assert"
+	tfs "gotest.tools/v3/fs"
 
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
 	"github.com/artefactual-sdps/enduro/internal/workflow/activities"
@@ -15,6 +16,17 @@ import (
 func TestCalcFileChecksumActivity(t *testing.T) {
 	t.Parallel()
 
+	dir := tfs.NewDir(t, "enduro-calc-checksum-test",
+		tfs.WithFile("a.txt", "a"),
+		tfs.WithDir("empty"),
+		tfs.WithDir("sub", tfs.WithFile("b.txt", "b")),
+	)
+	// "a/b.txt" is walked before "a.txt" but sorts after it.
+	sortDir := tfs.NewDir(t, "enduro-calc-checksum-test",
+		tfs.WithFile("a.txt", "a"),
+		tfs.WithDir("a", tfs.WithFile("b.txt", "b")),
+	)
+
 	tests := []struct {
 		name    string
 		params  activities.CalcFileChecksumActivityParams
@@ -33,6 +45,43 @@ func TestCalcFileChecksumActivity(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Calculates file checksum with the given algorithm",
+			params: activities.CalcFileChecksumActivityParams{
+				Path:      filepath.Join("..", "..", "testdata", "zipped_transfer", "small.zip"),
+				Algorithm: datatypes.ChecksumAlgoMD5,
+			},
+			want: activities.CalcFileChecksumActivityResult{
+				Checksum: datatypes.Checksum{
+					Algorithm: datatypes.ChecksumAlgoMD5,
+					Hash:      "c7045eff6010bd5056d1fcac5f156ffb",
+				},
+			},
+		},
+		{
+			name: "Calculates directory tree hash",
+			params: activities.CalcFileChecksumActivityParams{
+				Path: dir.Path(),
+			},
+			want: activities.CalcFileChecksumActivityResult{
+				Checksum: datatypes.Checksum{
+					Algorithm: datatypes.ChecksumAlgoSHA256,
+					Hash:      "bc3ab2631afcb90f5a60ffac01cb1df191d417c8d0fb898a7772afc91c630a4f",
+				},
+			},
+		},
+		{
+			name: "Calculates directory tree hash with sorted paths",
+			params: activities.CalcFileChecksumActivityParams{
+				Path: sortDir.Path(),
+			},
+			want: activities.CalcFileChecksumActivityResult{
+				Checksum: datatypes.Checksum{
+					Algorithm: datatypes.ChecksumAlgoSHA256,
+					Hash:      "92f8446eb64ee692354f87cc93d6ce7fdb735b77c91eac66f5ca9c6376177b04",
+				},
+			},
+		},
 		{
 			name: "Fails when file is not found",
 			params: activities.CalcFileChecksumActivityParams{
@@ -41,11 +90,12 @@ func TestCalcFileChecksumActivity(t *testing.T) {
 			wantErr: "calculate file checksum: file not found",
 		},
 		{
-			name: "Fails when path is a directory",
+			name: "Fails when the algorithm is not supported",
 			params: activities.CalcFileChecksumActivityParams{
-				Path: filepath.Join("..", "..", "testdata", "zipped_transfer"),
+				Path:      filepath.Join("..", "..", "testdata", "zipped_transfer", "small.zip"),
+				Algorithm: "CRC32",
 			},
-			wantErr: "calculate file checksum: not a file",
+			wantErr: `calculate file checksum: unsupported algorithm: "CRC32"`,
 		},
 	}
 	for _, tt := range tests {
@@ -33,6 +33,10 @@ type PollSIPStatusesActivityParams struct {
 	BatchUUID        uuid.UUID
 	ExpectedSIPCount int
 	ExpectedStatus   enums.SIPStatus
+
+	// SIPUUIDs limits the polling to the given SIPs of the batch, e.g. the
+	// SIPs being retried. All the SIPs of the batch are polled if empty.
+	SIPUUIDs []uuid.UUID
 }
 
 type PollSIPStatusesActivityResult struct {
@@ -76,7 +80,7 @@ func (a *PollSIPStatusesActivity) Execute(
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		case <-ticker.C:
-			result, err := a.checkSIPStatuses(ctx, params.BatchUUID, params.ExpectedSIPCount, params.ExpectedStatus)
+			result, err := a.checkSIPStatuses(ctx, params)
 			if err != nil {
 				return nil, fmt.Errorf("check SIP statuses: %v", err)
 			}
@@ -98,28 +102,33 @@ type checkResult struct {
 
 func (a *PollSIPStatusesActivity) checkSIPStatuses(
 	ctx context.Context,
-	batchUUID uuid.UUID,
-	expectedSIPCount int,
-	expectedStatus enums.SIPStatus,
+	params *PollSIPStatusesActivityParams,
 ) (*checkResult, error) {
 	// Query all SIPs for this batch. Limit to the maximum page size for now,
 	// we may switch to a stats-based or aggregated query approach in the future.
 	result, err := a.ingestsvc.ListSips(ctx, &goaingest.ListSipsPayload{
-		BatchUUID: new(batchUUID.String()),
+		BatchUUID: new(params.BatchUUID.String()),
 		Limit:     ref.New(entfilter.MaxPageSize),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list SIPs: %v", err)
 	}
 
+	items := result.Items
+	if len(params.SIPUUIDs) > 0 {
+		items = slices.DeleteFunc(slices.Clone(items), func(item *goaingest.SIP) bool {
+			return !slices.Contains(params.SIPUUIDs, item.UUID)
+		})
+	}
+
 	// Fail if we don't have the expected number of SIPs.
-	if len(result.Items) != expectedSIPCount {
-		return nil, fmt.Errorf("expected %d SIPs but found %d", expectedSIPCount, len(result.Items))
+	if len(items) != params.ExpectedSIPCount {
+		return nil, fmt.Errorf("expected %d SIPs but found %d", params.ExpectedSIPCount, len(items))
 	}
 
 	expectedStatusCount := 0
-	sips := make(map[uuid.UUID]datatypes.SIP, len(result.Items))
-	for _, item := range result.Items {
+	sips := make(map[uuid.UUID]datatypes.SIP, len(items))
+	for _, item := range items {
 		status, err := enums.ParseSIPStatus(item.Status)
 		if err != nil {
 			return nil, fmt.Errorf("invalid SIP status: %s", item.Status)
@@ -127,7 +136,7 @@ func (a *PollSIPStatusesActivity) checkSIPStatuses(
 
 		// Check if this SIP has the expected status.
 		// If not and it's not in a final status, keep polling.
-		if status == expectedStatus {
+		if status == params.ExpectedStatus {
 			expectedStatusCount++
 		} else if !slices.Contains(finalStatuses, status) {
 			return &checkResult{done: false}, nil
@@ -138,7 +147,7 @@ func (a *PollSIPStatusesActivity) checkSIPStatuses(
 
 	res := &checkResult{
 		done:        true,
-		allExpected: expectedStatusCount == expectedSIPCount,
+		allExpected: expectedStatusCount == params.ExpectedSIPCount,
 		sips:        sips,
 	}
 
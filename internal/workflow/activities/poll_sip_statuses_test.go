@@ -111,6 +111,35 @@ func TestPollSIPStatusesActivity(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Only checks the given SIPs",
+			params: &activities.PollSIPStatusesActivityParams{
+				BatchUUID:        batchUUID,
+				ExpectedSIPCount: 1,
+				ExpectedStatus:   enums.SIPStatusValidated,
+				SIPUUIDs:         []uuid.UUID{sip1UUID},
+			},
+			mock: func(r *ingestfake.MockServiceMockRecorder) {
+				r.ListSips(mockutil.Context(), payload).
+					Return(&goaingest.SIPs{Items: []*goaingest.SIP{
+						{
+							UUID:   sip1UUID,
+							Status: enums.SIPStatusValidated.String(),
+						},
+						{
+							UUID:    sip2UUID,
+							Status:  enums.SIPStatusIngested.String(),
+							AipUUID: new(aip2UUID.String()),
+						},
+					}}, nil)
+			},
+			want: &activities.PollSIPStatusesActivityResult{
+				AllExpectedStatus: true,
+				SIPs: map[uuid.UUID]datatypes.SIP{
+					sip1UUID: {UUID: sip1UUID},
+				},
+			},
+		},
 		{
 			name: "Returns false when some SIPs have failed status",
 			params: &activities.PollSIPStatusesActivityParams{
@@ -0,0 +1,100 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go.artefactual.dev/tools/temporal"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+
+	"github.com/artefactual-sdps/enduro/internal/antivirus"
+)
+
+const ScanSIPActivityName = "scan-sip-activity"
+
+type (
+	ScanSIPActivity struct {
+		scanner antivirus.Scanner
+	}
+
+	ScanSIPActivityParams struct {
+		// Path is the full path of the SIP, either a file or a directory.
+		Path string
+	}
+
+	ScanSIPActivityResult struct {
+		// Infected lists the files that matched a malware signature.
+		Infected []InfectedFile
+	}
+
+	InfectedFile struct {
+		// Path of the file relative to the SIP path.
+		Path string
+
+		// Signature is the name of the matched malware signature.
+		Signature string
+	}
+)
+
+func NewScanSIPActivity(scanner antivirus.Scanner) *ScanSIPActivity {
+	return &ScanSIPActivity{scanner: scanner}
+}
+
+// Execute scans every regular file in the SIP at params.Path for malware and
+// returns the files that are infected. An error is only returned when a file
+// can't be scanned. A heartbeat with the relative path of the file is recorded
+// after each file is scanned, so large SIPs don't time out.
+func (a *ScanSIPActivity) Execute(
+	ctx context.Context,
+	params *ScanSIPActivityParams,
+) (*ScanSIPActivityResult, error) {
+	logger := temporal.GetLogger(ctx)
+	logger.V(1).Info(
+		fmt.Sprintf("Executing %s", ScanSIPActivityName),
+		"Path", params.Path,
+	)
+
+	res := &ScanSIPActivityResult{}
+	err := filepath.WalkDir(params.Path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(params.Path, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			rel = d.Name()
+		}
+
+		f, err := os.Open(path) // #nosec G304 -- trusted file path.
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		r, err := a.scanner.Scan(ctx, f)
+		if err != nil {
+			return fmt.Errorf("%s: %v", rel, err)
+		}
+		if r.Infected {
+			res.Infected = append(res.Infected, InfectedFile{Path: rel, Signature: r.Signature})
+		}
+
+		temporalsdk_activity.RecordHeartbeat(ctx, rel)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan SIP: %v", err)
+	}
+
+	return res, nil
+}
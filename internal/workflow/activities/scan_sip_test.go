@@ -0,0 +1,203 @@
+package activities_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/interceptor"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	temporalsdk_worker "go.temporal.io/sdk/worker"
+	"gotest.tools/v3/assert"
+	tfs "gotest.tools/v3/fs"
+
+	"github.com/artefactual-sdps/enduro/internal/antivirus"
+	"github.com/artefactual-sdps/enduro/internal/workflow/activities"
+)
+
+// heartbeatRecorder is a worker interceptor that records the details of every
+// activity heartbeat, which the test environment listener throttles.
+type heartbeatRecorder struct {
+	interceptor.WorkerInterceptorBase
+	details []any
+}
+
+func (r *heartbeatRecorder) InterceptActivity(
+	ctx context.Context,
+	next interceptor.ActivityInboundInterceptor,
+) interceptor.ActivityInboundInterceptor {
+	i := &heartbeatRecorderInbound{recorder: r}
+	i.Next = next
+	return i
+}
+
+type heartbeatRecorderInbound struct {
+	interceptor.ActivityInboundInterceptorBase
+	recorder *heartbeatRecorder
+}
+
+func (i *heartbeatRecorderInbound) Init(outbound interceptor.ActivityOutboundInterceptor) error {
+	o := &heartbeatRecorderOutbound{recorder: i.recorder}
+	o.Next = outbound
+	return i.Next.Init(o)
+}
+
+type heartbeatRecorderOutbound struct {
+	interceptor.ActivityOutboundInterceptorBase
+	recorder *heartbeatRecorder
+}
+
+func (o *heartbeatRecorderOutbound) RecordHeartbeat(ctx context.Context, details ...any) {
+	o.recorder.details = append(o.recorder.details, details...)
+	o.Next.RecordHeartbeat(ctx, details...)
+}
+
+// scannerFunc adapts a function on the scanned content to antivirus.Scanner.
+type scannerFunc func(content string) (*antivirus.Result, error)
+
+func (f scannerFunc) Scan(ctx context.Context, r io.Reader) (*antivirus.Result, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return f(string(b))
+}
+
+func scanSIPActivityEnv(t *testing.T, scanner antivirus.Scanner) *temporalsdk_testsuite.TestActivityEnvironment {
+	t.Helper()
+
+	ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+	env := ts.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(
+		activities.NewScanSIPActivity(scanner).Execute,
+		temporalsdk_activity.RegisterOptions{Name: activities.ScanSIPActivityName},
+	)
+
+	return env
+}
+
+func TestScanSIPActivity(t *testing.T) {
+	t.Parallel()
+
+	scanner := scannerFunc(func(content string) (*antivirus.Result, error) {
+		switch {
+		case content == "unscannable":
+			return nil, errors.New("clamd: INSTREAM size limit exceeded.")
+		case strings.Contains(content, "malware"):
+			return &antivirus.Result{Infected: true, Signature: "Test-Signature"}, nil
+		default:
+			return &antivirus.Result{}, nil
+		}
+	})
+
+	type test struct {
+		name    string
+		path    func(t *testing.T) string
+		want    *activities.ScanSIPActivityResult
+		wantErr string
+	}
+	for _, tc := range []test{
+		{
+			name: "Scans a clean SIP",
+			path: func(t *testing.T) string {
+				return tfs.NewDir(t, "enduro-scan-test",
+					tfs.WithFile("bagit.txt", "BagIt-Version: 0.97"),
+					tfs.WithDir("data", tfs.WithFile("object.txt", "object")),
+				).Path()
+			},
+			want: &activities.ScanSIPActivityResult{},
+		},
+		{
+			name: "Lists the infected files",
+			path: func(t *testing.T) string {
+				return tfs.NewDir(t, "enduro-scan-test",
+					tfs.WithFile("bagit.txt", "BagIt-Version: 0.97"),
+					tfs.WithDir("data",
+						tfs.WithFile("a.txt", "malware"),
+						tfs.WithFile("b.txt", "object"),
+						tfs.WithDir("sub", tfs.WithFile("c.txt", "more malware")),
+					),
+				).Path()
+			},
+			want: &activities.ScanSIPActivityResult{
+				Infected: []activities.InfectedFile{
+					{Path: "data/a.txt", Signature: "Test-Signature"},
+					{Path: "data/sub/c.txt", Signature: "Test-Signature"},
+				},
+			},
+		},
+		{
+			name: "Scans a single file SIP",
+			path: func(t *testing.T) string {
+				return tfs.NewDir(t, "enduro-scan-test", tfs.WithFile("sip.zip", "malware")).Join("sip.zip")
+			},
+			want: &activities.ScanSIPActivityResult{
+				Infected: []activities.InfectedFile{
+					{Path: "sip.zip", Signature: "Test-Signature"},
+				},
+			},
+		},
+		{
+			name: "Errors when a file can't be scanned",
+			path: func(t *testing.T) string {
+				return tfs.NewDir(t, "enduro-scan-test", tfs.WithFile("big.bin", "unscannable")).Path()
+			},
+			wantErr: "scan SIP: big.bin: clamd: INSTREAM size limit exceeded.",
+		},
+		{
+			name: "Errors when the SIP doesn't exist",
+			path: func(t *testing.T) string {
+				return "/missing/sip"
+			},
+			wantErr: "scan SIP: lstat /missing/sip: no such file or directory",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := scanSIPActivityEnv(t, scanner)
+			future, err := env.ExecuteActivity(
+				activities.ScanSIPActivityName,
+				&activities.ScanSIPActivityParams{Path: tc.path(t)},
+			)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			var got activities.ScanSIPActivityResult
+			assert.NilError(t, future.Get(&got))
+			assert.DeepEqual(t, &got, tc.want)
+		})
+	}
+}
+
+func TestScanSIPActivityHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	dir := tfs.NewDir(t, "enduro-scan-test",
+		tfs.WithFile("bagit.txt", "BagIt-Version: 0.97"),
+		tfs.WithDir("data", tfs.WithFile("object.txt", "object")),
+	)
+
+	scanner := scannerFunc(func(content string) (*antivirus.Result, error) {
+		return &antivirus.Result{}, nil
+	})
+
+	recorder := &heartbeatRecorder{}
+	env := scanSIPActivityEnv(t, scanner)
+	env.SetWorkerOptions(temporalsdk_worker.Options{
+		Interceptors: []interceptor.WorkerInterceptor{recorder},
+	})
+
+	_, err := env.ExecuteActivity(
+		activities.ScanSIPActivityName,
+		&activities.ScanSIPActivityParams{Path: dir.Path()},
+	)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, recorder.details, []any{"bagit.txt", "data/object.txt"})
+}
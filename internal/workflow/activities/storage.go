@@ -14,6 +14,7 @@ import (
 
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
 )
 
 type CreateStorageAIPActivity struct {
@@ -26,6 +27,9 @@ type CreateStorageAIPActivityParams struct {
 	ObjectKey  string
 	Status     string
 	LocationID *uuid.UUID
+
+	// CustomMetadata is the SIP custom metadata copied onto the AIP.
+	CustomMetadata childwf.CustomMetadata
 }
 
 type CreateStorageAIPActivityResult struct {
@@ -44,11 +48,12 @@ func (a *CreateStorageAIPActivity) Execute(
 	logger.V(1).Info("Executing CreateStorageSIPActivity", "params", params)
 
 	payload := goastorage.CreateAipPayload{
-		UUID:         params.AIPID,
-		Name:         params.Name,
-		Status:       params.Status,
-		ObjectKey:    params.ObjectKey,
-		LocationUUID: params.LocationID,
+		UUID:           params.AIPID,
+		Name:           params.Name,
+		Status:         params.Status,
+		ObjectKey:      params.ObjectKey,
+		LocationUUID:   params.LocationID,
+		CustomMetadata: customMetadataPayload(params.CustomMetadata),
 	}
 
 	aip, err := a.client.CreateAip(ctx, &payload)
@@ -73,6 +78,21 @@ func (a *CreateStorageAIPActivity) Execute(
 	return &CreateStorageAIPActivityResult{CreatedAt: aip.CreatedAt}, nil
 }
 
+// customMetadataPayload converts md to the custom metadata of a create_aip
+// payload, keeping the JSON encoding of the values.
+func customMetadataPayload(md childwf.CustomMetadata) map[string]any {
+	if len(md) == 0 {
+		return nil
+	}
+
+	res := make(map[string]any, len(md))
+	for k, v := range md {
+		res[k] = v
+	}
+
+	return res
+}
+
 type MoveToPermanentStorageActivityParams struct {
 	AIPID      string
 	LocationID uuid.UUID
@@ -7,17 +7,23 @@ import (
 	"os"
 	"time"
 
+	"go.artefactual.dev/tools/temporal"
 	"gocloud.dev/blob"
 
 	goastorage "github.com/artefactual-sdps/enduro/internal/api/gen/storage"
 	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/internal/search"
 	"github.com/artefactual-sdps/enduro/internal/storage/enums"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
 )
 
 type UploadActivityParams struct {
 	AIPPath string
 	AIPID   string
 	Name    string
+
+	// CustomMetadata is the SIP custom metadata copied onto the AIP.
+	CustomMetadata childwf.CustomMetadata
 }
 
 type UploadActivity struct {
@@ -39,13 +45,22 @@ func (a *UploadActivity) Execute(ctx context.Context, params *UploadActivityPara
 		return &UploadActivityResult{}, err
 	}
 
+	// The METS metadata is only used to index the AIP, so a missing or
+	// invalid METS file doesn't fail the upload.
+	metadata, err := search.ReadAIPMETS(ctx, params.AIPPath)
+	if err != nil {
+		temporal.GetLogger(ctx).Info("Unable to read AIP METS file", "err", err.Error())
+	}
+
 	childCtx, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
-	_, err := a.storageClient.CreateAip(childCtx, &goastorage.CreateAipPayload{
-		UUID:      params.AIPID,
-		Name:      params.Name,
-		ObjectKey: params.AIPID,
-		Status:    enums.AIPStatusPending.String(),
+	_, err = a.storageClient.CreateAip(childCtx, &goastorage.CreateAipPayload{
+		UUID:           params.AIPID,
+		Name:           params.Name,
+		ObjectKey:      params.AIPID,
+		Status:         enums.AIPStatusPending.String(),
+		Metadata:       metadata,
+		CustomMetadata: customMetadataPayload(params.CustomMetadata),
 	})
 
 	return &UploadActivityResult{}, err
@@ -0,0 +1,152 @@
+package activities
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"go.artefactual.dev/tools/temporal"
+
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+)
+
+const VerifyManifestActivityName = "verify-manifest-activity"
+
+type (
+	VerifyManifestActivity struct{}
+
+	VerifyManifestActivityParams struct {
+		// Path is the full path of the SIP directory.
+		Path string
+	}
+
+	VerifyManifestActivityResult struct {
+		// Manifest is the path of the manifest relative to the SIP, or empty
+		// if the SIP has no manifest.
+		Manifest string
+
+		// Algorithm is the checksum algorithm used by the manifest.
+		Algorithm datatypes.ChecksumAlgo
+
+		// Failures lists the manifest entries that couldn't be verified.
+		Failures []string
+	}
+)
+
+func NewVerifyManifestActivity() *VerifyManifestActivity {
+	return &VerifyManifestActivity{}
+}
+
+// Execute looks for a checksum manifest in the SIP at params.Path and
+// verifies the checksums it lists.
+//
+// The manifest is a file named "checksum.<algorithm>" (e.g. "checksum.sha256")
+// found in the root or the "metadata" directory of the SIP, the same
+// convention Archivematica uses for transfers with existing checksums. Each
+// line has the "<hash>  <path>" format of the sha256sum and similar tools, with
+// the path relative to the directory of the manifest. When several manifests
+// are found, only the one using the strongest algorithm is verified.
+//
+// An error is only returned when the manifest can't be read or a listed file
+// can't be hashed, verification failures are listed in the result.
+func (a *VerifyManifestActivity) Execute(
+	ctx context.Context,
+	params *VerifyManifestActivityParams,
+) (*VerifyManifestActivityResult, error) {
+	logger := temporal.GetLogger(ctx)
+	logger.V(1).Info(
+		fmt.Sprintf("Executing %s", VerifyManifestActivityName),
+		"Path", params.Path,
+	)
+
+	res := &VerifyManifestActivityResult{}
+
+	manifest, algo, err := findManifest(params.Path)
+	if err != nil {
+		return nil, fmt.Errorf("verify manifest: %v", err)
+	}
+	if manifest == "" {
+		return res, nil
+	}
+	res.Manifest, res.Algorithm = manifest, algo
+
+	f, err := os.Open(filepath.Join(params.Path, manifest)) // #nosec G304 -- trusted file path.
+	if err != nil {
+		return nil, fmt.Errorf("verify manifest: %v", err)
+	}
+	defer f.Close()
+
+	dir := path.Dir(manifest)
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		want, name, ok := strings.Cut(line, " ")
+		name = strings.TrimPrefix(strings.TrimLeft(name, " "), "*")
+		if !ok || name == "" {
+			res.Failures = append(res.Failures, fmt.Sprintf("line %d: invalid entry", n))
+			continue
+		}
+
+		// Resolve the path relative to the manifest directory and make sure
+		// it doesn't point outside of the SIP.
+		rel := path.Join(dir, filepath.ToSlash(name))
+		if !fs.ValidPath(rel) {
+			res.Failures = append(res.Failures, fmt.Sprintf("%s: path is outside of the SIP", name))
+			continue
+		}
+
+		fi, err := os.Lstat(filepath.Join(params.Path, filepath.FromSlash(rel)))
+		if err != nil || !fi.Mode().IsRegular() {
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("verify manifest: %v", err)
+			}
+			res.Failures = append(res.Failures, fmt.Sprintf("%s: file not found", rel))
+			continue
+		}
+
+		sum, err := fileHash(filepath.Join(params.Path, filepath.FromSlash(rel)), algo.New())
+		if err != nil {
+			return nil, fmt.Errorf("verify manifest: %s: %v", rel, err)
+		}
+		if got := fmt.Sprintf("%x", sum); !strings.EqualFold(got, want) {
+			res.Failures = append(res.Failures, fmt.Sprintf("%s: checksum mismatch", rel))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("verify manifest: %v", err)
+	}
+
+	return res, nil
+}
+
+// findManifest returns the relative path and the algorithm of the strongest
+// checksum manifest found in the SIP, or an empty path if there is none.
+func findManifest(root string) (string, datatypes.ChecksumAlgo, error) {
+	for _, algo := range datatypes.ChecksumAlgos {
+		for _, dir := range []string{".", "metadata"} {
+			name := path.Join(dir, "checksum."+algo.Short())
+			fi, err := os.Stat(filepath.Join(root, filepath.FromSlash(name)))
+			if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrInvalid) {
+				continue
+			}
+			if err != nil {
+				return "", "", err
+			}
+			if fi.Mode().IsRegular() {
+				return name, algo, nil
+			}
+		}
+	}
+
+	return "", "", nil
+}
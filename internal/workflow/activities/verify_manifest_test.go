@@ -0,0 +1,118 @@
+package activities_test
+
+import (
+	"testing"
+
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"gotest.tools/v3/assert"
+	tfs "gotest.tools/v3/fs"
+
+	"github.com/artefactual-sdps/enduro/internal/datatypes"
+	"github.com/artefactual-sdps/enduro/internal/workflow/activities"
+)
+
+const (
+	sha256A = "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb"
+	sha256B = "3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d"
+	md5A    = "0cc175b9c0f1b6a831c399e269772661"
+)
+
+func TestVerifyManifestActivity(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name string
+		ops  []tfs.PathOp
+		want *activities.VerifyManifestActivityResult
+	}
+	for _, tt := range []test{
+		{
+			name: "Returns an empty result without a manifest",
+			ops:  []tfs.PathOp{tfs.WithFile("a.txt", "a")},
+			want: &activities.VerifyManifestActivityResult{},
+		},
+		{
+			name: "Verifies a manifest in the SIP root",
+			ops: []tfs.PathOp{
+				tfs.WithFile("a.txt", "a"),
+				tfs.WithDir("sub", tfs.WithFile("b.txt", "b")),
+				tfs.WithFile("checksum.sha256", sha256A+"  a.txt\n"+sha256B+" *sub/b.txt\n"),
+			},
+			want: &activities.VerifyManifestActivityResult{
+				Manifest:  "checksum.sha256",
+				Algorithm: datatypes.ChecksumAlgoSHA256,
+			},
+		},
+		{
+			name: "Verifies an Archivematica manifest in the metadata directory",
+			ops: []tfs.PathOp{
+				tfs.WithDir("objects", tfs.WithFile("a.txt", "a")),
+				tfs.WithDir("metadata", tfs.WithFile("checksum.md5", md5A+"  ../objects/a.txt\n")),
+			},
+			want: &activities.VerifyManifestActivityResult{
+				Manifest:  "metadata/checksum.md5",
+				Algorithm: datatypes.ChecksumAlgoMD5,
+			},
+		},
+		{
+			name: "Prefers the manifest using the strongest algorithm",
+			ops: []tfs.PathOp{
+				tfs.WithFile("a.txt", "a"),
+				tfs.WithFile("checksum.md5", "bad  a.txt\n"),
+				tfs.WithDir("metadata", tfs.WithFile("checksum.sha256", sha256A+"  ../a.txt\n")),
+			},
+			want: &activities.VerifyManifestActivityResult{
+				Manifest:  "metadata/checksum.sha256",
+				Algorithm: datatypes.ChecksumAlgoSHA256,
+			},
+		},
+		{
+			name: "Lists the entries that fail verification",
+			ops: []tfs.PathOp{
+				tfs.WithFile("a.txt", "a"),
+				tfs.WithFile("b.txt", "not b"),
+				tfs.WithFile("checksum.sha256", "# Comment\n\n"+
+					sha256A+"  a.txt\n"+
+					sha256B+"  b.txt\n"+
+					sha256B+"  c.txt\n"+
+					sha256B+"  ../b.txt\n"+
+					"invalid\n",
+				),
+			},
+			want: &activities.VerifyManifestActivityResult{
+				Manifest:  "checksum.sha256",
+				Algorithm: datatypes.ChecksumAlgoSHA256,
+				Failures: []string{
+					"b.txt: checksum mismatch",
+					"c.txt: file not found",
+					"../b.txt: path is outside of the SIP",
+					"line 7: invalid entry",
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := tfs.NewDir(t, "enduro-verify-manifest-test", tt.ops...)
+
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(
+				activities.NewVerifyManifestActivity().Execute,
+				temporalsdk_activity.RegisterOptions{Name: activities.VerifyManifestActivityName},
+			)
+
+			future, err := env.ExecuteActivity(
+				activities.VerifyManifestActivityName,
+				&activities.VerifyManifestActivityParams{Path: dir.Path()},
+			)
+			assert.NilError(t, err)
+
+			var res activities.VerifyManifestActivityResult
+			assert.NilError(t, future.Get(&res))
+			assert.DeepEqual(t, &res, tt.want)
+		})
+	}
+}
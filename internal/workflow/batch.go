@@ -48,6 +48,7 @@ func (w *BatchWorkflow) Execute(ctx temporalsdk_workflow.Context, req *ingest.Ba
 		"identifier", req.Batch.Identifier,
 		"source_id", req.SIPSourceID.String(),
 		"keys", req.Keys,
+		"retry", state.retry,
 	)
 
 	defer func() {
@@ -83,17 +84,29 @@ func (w *BatchWorkflow) Execute(ctx temporalsdk_workflow.Context, req *ingest.Ba
 		)
 	}()
 
-	// Update batch status to "processing" and add start date.
+	// Update batch status to "processing" and add start date, a retried batch
+	// keeps its original start date.
 	state.batch.Status = enums.BatchStatusProcessing
-	state.batch.StartedAt = temporalsdk_workflow.Now(ctx)
+	if !state.retry {
+		state.batch.StartedAt = temporalsdk_workflow.Now(ctx)
+	}
 	if err := w.updateBatch(ctx, state); err != nil {
 		return err
 	}
 
-	// Create SIPs and start processing workflows.
-	for i, key := range req.Keys {
-		if err := w.startSIPWorkflow(ctx, state, i, key, req.SIPSourceID); err != nil {
-			return err
+	if state.retry {
+		// Start processing workflows for the failed SIPs.
+		for i, sip := range req.RetrySIPs {
+			if err := w.retrySIPWorkflow(ctx, state, i, sip); err != nil {
+				return err
+			}
+		}
+	} else {
+		// Create SIPs and start processing workflows.
+		for i, batchSIP := range req.BatchSIPs() {
+			if err := w.startSIPWorkflow(ctx, state, i, batchSIP, req.SIPSourceID); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -109,17 +122,25 @@ func (w *BatchWorkflow) Execute(ctx temporalsdk_workflow.Context, req *ingest.Ba
 		}
 	}()
 
-	// Poll SIP statuses until all are validated.
+	// Poll SIP statuses until all are validated. When the batch is retried,
+	// only the SIPs being processed again are polled.
+	pollValidatedParams := &activities.PollSIPStatusesActivityParams{
+		BatchUUID:        state.batch.UUID,
+		ExpectedSIPCount: len(state.sipDetails),
+		ExpectedStatus:   enums.SIPStatusValidated,
+	}
+	if state.retry {
+		for _, sd := range state.processingSIPDetails() {
+			pollValidatedParams.SIPUUIDs = append(pollValidatedParams.SIPUUIDs, sd.sip.UUID)
+		}
+		pollValidatedParams.ExpectedSIPCount = len(pollValidatedParams.SIPUUIDs)
+	}
 	activityOpts := withActivityOptsForHeartbeatedPolling(ctx)
 	var pollValidatedResult activities.PollSIPStatusesActivityResult
 	err := temporalsdk_workflow.ExecuteActivity(
 		activityOpts,
 		activities.PollSIPStatusesActivityName,
-		&activities.PollSIPStatusesActivityParams{
-			BatchUUID:        state.batch.UUID,
-			ExpectedSIPCount: len(state.sipDetails),
-			ExpectedStatus:   enums.SIPStatusValidated,
-		},
+		pollValidatedParams,
 	).Get(activityOpts, &pollValidatedResult)
 	if err != nil {
 		return err
@@ -152,6 +173,11 @@ func (w *BatchWorkflow) Execute(ctx temporalsdk_workflow.Context, req *ingest.Ba
 
 	// Fail workflow if not all SIPs reached "ingested" status.
 	if !pollIngestedResult.AllExpectedStatus {
+		// A retried batch is not reviewed, canceling it would also clear the
+		// SIPs ingested before the retry. It can be retried again instead.
+		if state.retry {
+			return fmt.Errorf("not all SIPs reached %q status", enums.SIPStatusIngested)
+		}
 		if err := w.waitForBatchDecision(ctx, state); err != nil {
 			return err
 		}
@@ -203,7 +229,7 @@ func (w *BatchWorkflow) startSIPWorkflow(
 	ctx temporalsdk_workflow.Context,
 	state *batchWorkflowState,
 	index int,
-	key string,
+	batchSIP *ingest.BatchSIP,
 	sourceID uuid.UUID,
 ) error {
 	// Generate SIP UUID using SideEffect to ensure determinism.
@@ -215,13 +241,28 @@ func (w *BatchWorkflow) startSIPWorkflow(
 		return fmt.Errorf("generate SIP UUID: %v", err)
 	}
 
+	// Manifest metadata takes precedence over the batch defaults.
+	name := batchSIP.Key
+	if batchSIP.Title != "" {
+		name = batchSIP.Title
+	}
+	profile := state.processingProfile
+	if batchSIP.ProcessingProfile != "" {
+		profile = batchSIP.ProcessingProfile
+	}
+
 	// Create SIP.
 	sip := datatypes.SIP{
-		UUID:     sipUUID,
-		Name:     key,
-		Status:   enums.SIPStatusQueued,
-		Batch:    &state.batch,
-		Uploader: state.batch.Uploader,
+		UUID:              sipUUID,
+		Name:              name,
+		Status:            enums.SIPStatusQueued,
+		Batch:             &state.batch,
+		Uploader:          state.batch.Uploader,
+		ProcessingProfile: profile,
+		SIPSourceID:       uuid.NullUUID{UUID: sourceID, Valid: sourceID != uuid.Nil},
+	}
+	if batchSIP.LocationID != nil {
+		sip.LocationID = uuid.NullUUID{UUID: *batchSIP.LocationID, Valid: true}
 	}
 	activityOpts := withLocalActivityOpts(ctx)
 	err := temporalsdk_workflow.ExecuteLocalActivity(
@@ -245,14 +286,18 @@ func (w *BatchWorkflow) startSIPWorkflow(
 		processingCtx,
 		ingest.ProcessingWorkflowName,
 		&ingest.ProcessingWorkflowRequest{
-			User:            state.user,
-			SIPUUID:         sipUUID,
-			SIPName:         key,
-			Key:             key,
-			SIPSourceID:     sourceID,
-			Type:            enums.WorkflowTypeCreateAip,
-			RetentionPeriod: -1 * time.Second,
-			BatchUUID:       state.batch.UUID,
+			User:              state.user,
+			SIPUUID:           sipUUID,
+			SIPName:           name,
+			Key:               batchSIP.Key,
+			SIPSourceID:       sourceID,
+			Type:              enums.WorkflowTypeCreateAip,
+			RetentionPeriod:   -1 * time.Second,
+			BatchUUID:         state.batch.UUID,
+			BatchIdentifier:   state.batch.Identifier,
+			ProcessingProfile: profile,
+			LocationID:        batchSIP.LocationID,
+			CustomMetadata:    batchSIP.CustomMetadata,
 		},
 	)
 	err = wf.GetChildWorkflowExecution().Get(processingCtx, &we)
@@ -261,14 +306,77 @@ func (w *BatchWorkflow) startSIPWorkflow(
 	}
 
 	// Store SIP details in the batch workflow state.
-	state.addSIPDetails(index, sip, wf, we)
+	state.addSIPDetails(index, sip, batchSIP, wf, we)
+
+	return nil
+}
+
+// retrySIPWorkflow starts a new processing workflow for a SIP of a retried
+// batch that ended with an error or failed status, using the failed SIP/PIP
+// stored in the internal bucket as input. Other SIPs are only added to the
+// batch workflow state.
+func (w *BatchWorkflow) retrySIPWorkflow(
+	ctx temporalsdk_workflow.Context,
+	state *batchWorkflowState,
+	index int,
+	sip datatypes.SIP,
+) error {
+	if sip.Status != enums.SIPStatusError && sip.Status != enums.SIPStatusFailed {
+		state.addRetrySIPDetails(index, sip, nil, temporalsdk_workflow.Execution{})
+		return nil
+	}
+
+	// Queue the SIP before starting the workflow, so the workflow status
+	// updates aren't overwritten.
+	activityOpts := withLocalActivityOpts(ctx)
+	err := temporalsdk_workflow.ExecuteLocalActivity(
+		activityOpts, setStatusLocalActivity, w.ingestsvc, sip.UUID, enums.SIPStatusQueued,
+	).Get(activityOpts, nil)
+	if err != nil {
+		return fmt.Errorf("set SIP status: %v", err)
+	}
+	sip.Status = enums.SIPStatusQueued
+
+	req := &ingest.ProcessingWorkflowRequest{
+		User:              state.user,
+		SIPUUID:           sip.UUID,
+		SIPName:           sip.Name,
+		SIPSourceID:       sip.SIPSourceID.UUID,
+		Key:               sip.FailedKey,
+		Retry:             true,
+		Type:              enums.WorkflowTypeCreateAip,
+		RetentionPeriod:   -1 * time.Second,
+		BatchUUID:         state.batch.UUID,
+		BatchIdentifier:   state.batch.Identifier,
+		ProcessingProfile: sip.ProcessingProfile,
+		CustomMetadata:    sip.CustomMetadata,
+	}
+	if sip.LocationID.Valid {
+		req.LocationID = &sip.LocationID.UUID
+	}
+
+	// Start processing workflow for the SIP, keeping track of the workflow future and execution.
+	var we temporalsdk_workflow.Execution
+	processingCtx := temporalsdk_workflow.WithChildOptions(ctx, temporalsdk_workflow.ChildWorkflowOptions{
+		TaskQueue:         w.cfg.Temporal.TaskQueue,
+		WorkflowID:        fmt.Sprintf("%s-%s", ingest.ProcessingWorkflowName, sip.UUID.String()),
+		ParentClosePolicy: temporalapi_enums.PARENT_CLOSE_POLICY_TERMINATE,
+	})
+	wf := temporalsdk_workflow.ExecuteChildWorkflow(processingCtx, ingest.ProcessingWorkflowName, req)
+	err = wf.GetChildWorkflowExecution().Get(processingCtx, &we)
+	if err != nil {
+		return fmt.Errorf("processing workflow: %v", err)
+	}
+
+	// Store SIP details in the batch workflow state.
+	state.addRetrySIPDetails(index, sip, wf, we)
 
 	return nil
 }
 
 func (w *BatchWorkflow) signalWorkflows(ctx temporalsdk_workflow.Context, state *batchWorkflowState, cont bool) error {
 	var signalErr error
-	for _, sd := range state.sipDetails {
+	for _, sd := range state.processingSIPDetails() {
 		err := temporalsdk_workflow.SignalExternalWorkflow(
 			ctx,
 			sd.workflowExecution.ID,
@@ -318,8 +426,9 @@ func (w *BatchWorkflow) waitForWorkflowsCompletion(ctx temporalsdk_workflow.Cont
 		return nil
 	}
 
+	sds := state.processingSIPDetails()
 	selector := temporalsdk_workflow.NewSelector(ctx)
-	for _, sd := range state.sipDetails {
+	for _, sd := range sds {
 		selector.AddFuture(sd.workflowFuture, func(f temporalsdk_workflow.Future) {
 			var res ingest.ProcessingWorkflowResult
 			if err := f.Get(ctx, &res); err == nil {
@@ -330,7 +439,7 @@ func (w *BatchWorkflow) waitForWorkflowsCompletion(ctx temporalsdk_workflow.Cont
 
 	// Wait for all SIP workflows to complete or context cancellation.
 	// Block once per SIP/future.
-	for range state.sipDetails {
+	for range sds {
 		selector.Select(ctx)
 		if err := ctx.Err(); err != nil {
 			return fmt.Errorf("waiting for workflows: %v", err)
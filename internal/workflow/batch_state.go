@@ -23,9 +23,17 @@ type batchWorkflowState struct {
 	// batch, when available.
 	user *childwf.User
 
+	// processingProfile is the name of the processing profile to use for the
+	// SIPs of the batch, if any.
+	processingProfile string
+
 	// sipDetails contains details for each SIP in the batch.
 	sipDetails []*sipDetails
 
+	// retry indicates that the batch is being retried, only the SIPs with an
+	// error or failed status are processed.
+	retry bool
+
 	// workflowsCompleted prevents consuming child processing workflow futures
 	// twice. The batch workflow waits explicitly before postbatch to collect
 	// processing results, and also defers a wait to let child workflows finish
@@ -39,7 +47,12 @@ type sipDetails struct {
 	// sip represents the SIP being processed.
 	sip datatypes.SIP
 
-	// workflowFuture is used to wait for workflow completion.
+	// batchSIP is the SIP as requested for the batch, including the metadata
+	// given in the batch manifest, if any.
+	batchSIP *ingest.BatchSIP
+
+	// workflowFuture is used to wait for workflow completion. It's nil for
+	// the SIPs that are not processed when the batch is retried.
 	workflowFuture temporalsdk_workflow.ChildWorkflowFuture
 
 	// workflowExecution contains the execution details of the child workflow.
@@ -52,11 +65,18 @@ type sipDetails struct {
 // newBatchWorkflowState initializes a new batchWorkflowState with the given
 // workflow context and batch workflow request.
 func newBatchWorkflowState(ctx temporalsdk_workflow.Context, req *ingest.BatchWorkflowRequest) *batchWorkflowState {
+	sipCount := len(req.BatchSIPs())
+	if len(req.RetrySIPs) > 0 {
+		sipCount = len(req.RetrySIPs)
+	}
+
 	return &batchWorkflowState{
-		logger:     temporalsdk_workflow.GetLogger(ctx),
-		batch:      req.Batch,
-		user:       req.User,
-		sipDetails: make([]*sipDetails, len(req.Keys)),
+		logger:            temporalsdk_workflow.GetLogger(ctx),
+		batch:             req.Batch,
+		user:              req.User,
+		processingProfile: req.ProcessingProfile,
+		sipDetails:        make([]*sipDetails, sipCount),
+		retry:             len(req.RetrySIPs) > 0,
 	}
 }
 
@@ -74,6 +94,24 @@ func (s *batchWorkflowState) PostbatchParams() *childwf.PostbatchParams {
 
 // addSIPDetails adds a new sipDetails entry to the batchWorkflowState at the specified index.
 func (s *batchWorkflowState) addSIPDetails(
+	index int,
+	sip datatypes.SIP,
+	batchSIP *ingest.BatchSIP,
+	wf temporalsdk_workflow.ChildWorkflowFuture,
+	we temporalsdk_workflow.Execution,
+) {
+	s.sipDetails[index] = &sipDetails{
+		sip:               sip,
+		batchSIP:          batchSIP,
+		workflowFuture:    wf,
+		workflowExecution: we,
+	}
+}
+
+// addRetrySIPDetails adds a new sipDetails entry for a SIP of a retried batch
+// to the batchWorkflowState at the specified index. The workflow future and
+// execution are only set for the SIPs being processed again.
+func (s *batchWorkflowState) addRetrySIPDetails(
 	index int,
 	sip datatypes.SIP,
 	wf temporalsdk_workflow.ChildWorkflowFuture,
@@ -83,21 +121,46 @@ func (s *batchWorkflowState) addSIPDetails(
 		sip:               sip,
 		workflowFuture:    wf,
 		workflowExecution: we,
+		customMetadata:    sip.CustomMetadata,
+	}
+}
+
+// processingSIPDetails returns the details of the SIPs with a processing
+// workflow.
+func (s *batchWorkflowState) processingSIPDetails() []*sipDetails {
+	var sds []*sipDetails
+	for _, sd := range s.sipDetails {
+		if sd.workflowFuture != nil {
+			sds = append(sds, sd)
+		}
 	}
+
+	return sds
 }
 
 func (s *batchWorkflowState) postbatchSIPs() []*childwf.PostbatchSIP {
 	pbs := make([]*childwf.PostbatchSIP, len(s.sipDetails))
 	for i, sd := range s.sipDetails {
 		s := &childwf.PostbatchSIP{
-			UUID:           sd.sip.UUID,
-			Name:           sd.sip.Name,
-			FileCount:      sd.sip.FileCount,
-			CustomMetadata: sd.customMetadata,
+			UUID:              sd.sip.UUID,
+			Name:              sd.sip.Name,
+			FileCount:         sd.sip.FileCount,
+			ProcessingProfile: sd.sip.ProcessingProfile,
+			CustomMetadata:    sd.customMetadata,
 		}
 		if sd.sip.AIPID.Valid {
 			s.AIPID = &sd.sip.AIPID.UUID
 		}
+		if sd.sip.LocationID.Valid {
+			s.LocationID = &sd.sip.LocationID.UUID
+		}
+		if sd.batchSIP != nil {
+			s.Key = sd.batchSIP.Key
+			s.LocationID = sd.batchSIP.LocationID
+			if s.CustomMetadata == nil {
+				s.CustomMetadata = sd.batchSIP.CustomMetadata
+			}
+		}
 		pbs[i] = s
 	}
 
@@ -212,6 +212,7 @@ func (s *BatchWorkflowTestSuite) TestBatch() {
 					CreatedAt:  startTime,
 					StartedAt:  startTime,
 				},
+				SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 			},
 		},
 	).Return(1, nil)
@@ -234,6 +235,7 @@ func (s *BatchWorkflowTestSuite) TestBatch() {
 					CreatedAt:  startTime,
 					StartedAt:  startTime,
 				},
+				SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 			},
 		},
 	).Return(2, nil)
@@ -291,6 +293,7 @@ func (s *BatchWorkflowTestSuite) TestBatch() {
 					Name:      batchSIP1Key,
 					AIPID:     &batchAIP1UUID,
 					FileCount: 8,
+					Key:       batchSIP1Key,
 					CustomMetadata: childwf_pkg.CustomMetadata{
 						"external_id": json.RawMessage(`"sip-1"`),
 					},
@@ -300,6 +303,7 @@ func (s *BatchWorkflowTestSuite) TestBatch() {
 					Name:      batchSIP2Key,
 					AIPID:     &batchAIP2UUID,
 					FileCount: 16,
+					Key:       batchSIP2Key,
 					CustomMetadata: childwf_pkg.CustomMetadata{
 						"external_id": json.RawMessage(`"sip-2"`),
 					},
@@ -350,6 +354,7 @@ func (s *BatchWorkflowTestSuite) TestBatch() {
 				Type:            enums.WorkflowTypeCreateAip,
 				RetentionPeriod: -1 * time.Second,
 				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
 			},
 			{
 				User:            user,
@@ -360,6 +365,232 @@ func (s *BatchWorkflowTestSuite) TestBatch() {
 				Type:            enums.WorkflowTypeCreateAip,
 				RetentionPeriod: -1 * time.Second,
 				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
+			},
+		},
+		[]ingest.BatchSignal{
+			{Continue: true},
+			{Continue: true},
+		},
+		false,
+	)
+}
+
+// TestBatchManifest tests:
+// - Use the title and processing profile of the manifest for each SIP.
+// - Pass the location and custom metadata of the manifest to the child
+// processing workflows.
+// - Pass the manifest metadata to the postbatch child workflow.
+func (s *BatchWorkflowTestSuite) TestBatchManifest() {
+	locationID := uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1")
+	sip1Metadata := childwf_pkg.CustomMetadata{
+		"collection": json.RawMessage(`"Smith"`),
+	}
+	sip2Metadata := childwf_pkg.CustomMetadata{
+		"collection": json.RawMessage(`"Jones"`),
+	}
+	cfg := config.Configuration{
+		ChildWorkflows: childwf.Configs{
+			{
+				Type:         enums.ChildWorkflowTypePostbatch,
+				TaskQueue:    "postbatch",
+				WorkflowName: "postbatch",
+			},
+		},
+	}
+	s.SetupWorkflowTest(cfg)
+
+	// The first processing workflow returns the manifest metadata merged with
+	// its own, the second one fails to return any.
+	s.childResults = map[uuid.UUID]*ingest.ProcessingWorkflowResult{
+		batchSIP1UUID: {
+			CustomMetadata: childwf_pkg.CustomMetadata{
+				"collection":  json.RawMessage(`"Smith"`),
+				"external_id": json.RawMessage(`"sip-1"`),
+			},
+		},
+	}
+
+	batch := &datatypes.Batch{
+		UUID:       batchUUID,
+		Identifier: batchIdentifier,
+		Status:     enums.BatchStatusProcessing,
+		SIPSCount:  2,
+		CreatedAt:  startTime,
+		StartedAt:  startTime,
+	}
+
+	s.env.OnActivity(
+		updateBatchLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&updateBatchLocalActivityParams{
+			UUID:      batchUUID,
+			Status:    enums.BatchStatusProcessing,
+			StartedAt: startTime,
+		},
+	).Return(&updateBatchLocalActivityResult{}, nil)
+	s.env.OnActivity(
+		createSIPLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&createSIPLocalActivityParams{
+			SIP: datatypes.SIP{
+				UUID:              batchSIP1UUID,
+				Name:              "Letters",
+				Status:            enums.SIPStatusQueued,
+				Batch:             batch,
+				ProcessingProfile: "fast",
+				SIPSourceID:       uuid.NullUUID{UUID: sourceID, Valid: true},
+				LocationID:        uuid.NullUUID{UUID: locationID, Valid: true},
+			},
+		},
+	).Return(1, nil)
+	s.env.OnActivity(
+		createSIPLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&createSIPLocalActivityParams{
+			SIP: datatypes.SIP{
+				UUID:              batchSIP2UUID,
+				Name:              batchSIP2Key,
+				Status:            enums.SIPStatusQueued,
+				Batch:             batch,
+				ProcessingProfile: "default",
+				SIPSourceID:       uuid.NullUUID{UUID: sourceID, Valid: true},
+			},
+		},
+	).Return(2, nil)
+	s.env.OnActivity(
+		activities.PollSIPStatusesActivityName,
+		mock.AnythingOfType("*context.timerCtx"),
+		&activities.PollSIPStatusesActivityParams{
+			BatchUUID:        batchUUID,
+			ExpectedSIPCount: 2,
+			ExpectedStatus:   enums.SIPStatusValidated,
+		},
+	).Return(&activities.PollSIPStatusesActivityResult{AllExpectedStatus: true}, nil)
+	s.env.OnActivity(
+		activities.PollSIPStatusesActivityName,
+		mock.AnythingOfType("*context.timerCtx"),
+		&activities.PollSIPStatusesActivityParams{
+			BatchUUID:        batchUUID,
+			ExpectedSIPCount: 2,
+			ExpectedStatus:   enums.SIPStatusIngested,
+		},
+	).Return(&activities.PollSIPStatusesActivityResult{
+		AllExpectedStatus: true,
+		SIPs: map[uuid.UUID]datatypes.SIP{
+			batchSIP1UUID: {
+				UUID:      batchSIP1UUID,
+				AIPID:     uuid.NullUUID{UUID: batchAIP1UUID, Valid: true},
+				FileCount: 8,
+			},
+			batchSIP2UUID: {
+				UUID:      batchSIP2UUID,
+				AIPID:     uuid.NullUUID{UUID: batchAIP2UUID, Valid: true},
+				FileCount: 16,
+			},
+		},
+	}, nil)
+	s.env.OnWorkflow(
+		postBatchChildWorkflow,
+		internalCtx,
+		&childwf_pkg.PostbatchParams{
+			Batch: &childwf_pkg.PostbatchBatch{
+				UUID:       batchUUID,
+				Identifier: batchIdentifier,
+				SIPSCount:  2,
+			},
+			SIPs: []*childwf_pkg.PostbatchSIP{
+				{
+					UUID:              batchSIP1UUID,
+					Name:              "Letters",
+					AIPID:             &batchAIP1UUID,
+					FileCount:         8,
+					Key:               batchSIP1Key,
+					ProcessingProfile: "fast",
+					LocationID:        &locationID,
+					CustomMetadata: childwf_pkg.CustomMetadata{
+						"collection":  json.RawMessage(`"Smith"`),
+						"external_id": json.RawMessage(`"sip-1"`),
+					},
+				},
+				{
+					UUID:              batchSIP2UUID,
+					Name:              batchSIP2Key,
+					AIPID:             &batchAIP2UUID,
+					FileCount:         16,
+					Key:               batchSIP2Key,
+					ProcessingProfile: "default",
+					CustomMetadata:    sip2Metadata,
+				},
+			},
+		},
+	).Return(&childwf_pkg.PostbatchResult{Outcome: childwf_pkg.OutcomeSuccess}, nil)
+	s.env.OnActivity(
+		updateBatchLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&updateBatchLocalActivityParams{
+			UUID:        batchUUID,
+			Status:      enums.BatchStatusIngested,
+			StartedAt:   startTime,
+			CompletedAt: startTime,
+		},
+	).Return(&updateBatchLocalActivityResult{}, nil)
+
+	s.ExecuteAndValidateWorkflow(
+		&ingest.BatchWorkflowRequest{
+			Batch: datatypes.Batch{
+				UUID:       batchUUID,
+				Identifier: batchIdentifier,
+				Status:     enums.BatchStatusQueued,
+				CreatedAt:  startTime,
+				SIPSCount:  2,
+			},
+			SIPSourceID: sourceID,
+			Keys:        []string{batchSIP1Key, batchSIP2Key},
+			SIPs: []*ingest.BatchSIP{
+				{
+					Key:               batchSIP1Key,
+					Title:             "Letters",
+					ProcessingProfile: "fast",
+					LocationID:        &locationID,
+					CustomMetadata:    sip1Metadata,
+				},
+				{
+					Key:            batchSIP2Key,
+					CustomMetadata: sip2Metadata,
+				},
+			},
+			ProcessingProfile: "default",
+		},
+		[]ingest.ProcessingWorkflowRequest{
+			{
+				SIPUUID:           batchSIP1UUID,
+				SIPName:           "Letters",
+				Key:               batchSIP1Key,
+				SIPSourceID:       sourceID,
+				Type:              enums.WorkflowTypeCreateAip,
+				RetentionPeriod:   -1 * time.Second,
+				BatchUUID:         batchUUID,
+				BatchIdentifier:   batchIdentifier,
+				ProcessingProfile: "fast",
+				LocationID:        &locationID,
+				CustomMetadata:    sip1Metadata,
+			},
+			{
+				SIPUUID:           batchSIP2UUID,
+				SIPName:           batchSIP2Key,
+				Key:               batchSIP2Key,
+				SIPSourceID:       sourceID,
+				Type:              enums.WorkflowTypeCreateAip,
+				RetentionPeriod:   -1 * time.Second,
+				BatchUUID:         batchUUID,
+				BatchIdentifier:   batchIdentifier,
+				ProcessingProfile: "default",
+				CustomMetadata:    sip2Metadata,
 			},
 		},
 		[]ingest.BatchSignal{
@@ -411,6 +642,7 @@ func (s *BatchWorkflowTestSuite) TestBatchValidationFailed() {
 					CreatedAt:  startTime,
 					StartedAt:  startTime,
 				},
+				SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 			},
 		},
 	).Return(1, nil)
@@ -433,6 +665,7 @@ func (s *BatchWorkflowTestSuite) TestBatchValidationFailed() {
 					CreatedAt:  startTime,
 					StartedAt:  startTime,
 				},
+				SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 			},
 		},
 	).Return(2, nil)
@@ -482,6 +715,7 @@ func (s *BatchWorkflowTestSuite) TestBatchValidationFailed() {
 				Type:            enums.WorkflowTypeCreateAip,
 				RetentionPeriod: -1 * time.Second,
 				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
 			},
 			{
 				SIPUUID:         batchSIP2UUID,
@@ -491,6 +725,7 @@ func (s *BatchWorkflowTestSuite) TestBatchValidationFailed() {
 				Type:            enums.WorkflowTypeCreateAip,
 				RetentionPeriod: -1 * time.Second,
 				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
 			},
 		},
 		[]ingest.BatchSignal{
@@ -544,6 +779,7 @@ func (s *BatchWorkflowTestSuite) TestBatchIngestFailedContinue() {
 					CreatedAt:  startTime,
 					StartedAt:  startTime,
 				},
+				SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 			},
 		},
 	).Return(1, nil)
@@ -566,6 +802,7 @@ func (s *BatchWorkflowTestSuite) TestBatchIngestFailedContinue() {
 					CreatedAt:  startTime,
 					StartedAt:  startTime,
 				},
+				SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 			},
 		},
 	).Return(2, nil)
@@ -655,6 +892,7 @@ func (s *BatchWorkflowTestSuite) TestBatchIngestFailedContinue() {
 				Type:            enums.WorkflowTypeCreateAip,
 				RetentionPeriod: -1 * time.Second,
 				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
 			},
 			{
 				SIPUUID:         batchSIP2UUID,
@@ -664,6 +902,7 @@ func (s *BatchWorkflowTestSuite) TestBatchIngestFailedContinue() {
 				Type:            enums.WorkflowTypeCreateAip,
 				RetentionPeriod: -1 * time.Second,
 				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
 			},
 		},
 		[]ingest.BatchSignal{
@@ -718,6 +957,7 @@ func (s *BatchWorkflowTestSuite) TestBatchIngestFailedCancel() {
 					CreatedAt:  startTime,
 					StartedAt:  startTime,
 				},
+				SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 			},
 		},
 	).Return(1, nil)
@@ -740,6 +980,7 @@ func (s *BatchWorkflowTestSuite) TestBatchIngestFailedCancel() {
 					CreatedAt:  startTime,
 					StartedAt:  startTime,
 				},
+				SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
 			},
 		},
 	).Return(2, nil)
@@ -824,6 +1065,7 @@ func (s *BatchWorkflowTestSuite) TestBatchIngestFailedCancel() {
 				Type:            enums.WorkflowTypeCreateAip,
 				RetentionPeriod: -1 * time.Second,
 				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
 			},
 			{
 				SIPUUID:         batchSIP2UUID,
@@ -833,6 +1075,7 @@ func (s *BatchWorkflowTestSuite) TestBatchIngestFailedCancel() {
 				Type:            enums.WorkflowTypeCreateAip,
 				RetentionPeriod: -1 * time.Second,
 				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
 			},
 		},
 		[]ingest.BatchSignal{
@@ -869,3 +1112,229 @@ func (s *BatchWorkflowTestSuite) TestBatchError() {
 		Keys:        []string{batchSIP1Key, batchSIP2Key},
 	}, nil, nil, true)
 }
+
+// TestBatchRetry tests:
+// - Update batch status (processing), keeping the original start date.
+// - Queue and start a child processing workflow for each failed SIP.
+// - Poll the retried SIP statuses until all are validated.
+// - Signal the retried SIP workflows to continue processing.
+// - Poll SIP statuses until all are ingested.
+// - Update batch status (ingested).
+func (s *BatchWorkflowTestSuite) TestBatchRetry() {
+	s.SetupWorkflowTest(config.Configuration{})
+
+	retryStartedAt := startTime.Add(-time.Hour)
+	failedKey := "Failed_" + batchSIP2Key
+	locationID := uuid.MustParse("f2cc963f-c14d-4eaa-b950-bd207189a1f1")
+
+	// Mock initial batch status update.
+	s.env.OnActivity(
+		updateBatchLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&updateBatchLocalActivityParams{
+			UUID:      batchUUID,
+			Status:    enums.BatchStatusProcessing,
+			StartedAt: retryStartedAt,
+		},
+	).Return(&updateBatchLocalActivityResult{}, nil)
+
+	// Mock failed SIP status update.
+	s.env.OnActivity(
+		setStatusLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		batchSIP2UUID,
+		enums.SIPStatusQueued,
+	).Return(&setStatusLocalActivityResult{}, nil)
+
+	// Mock validated SIP statuses poll, only for the retried SIP.
+	s.env.OnActivity(
+		activities.PollSIPStatusesActivityName,
+		mock.AnythingOfType("*context.timerCtx"),
+		&activities.PollSIPStatusesActivityParams{
+			BatchUUID:        batchUUID,
+			SIPUUIDs:         []uuid.UUID{batchSIP2UUID},
+			ExpectedSIPCount: 1,
+			ExpectedStatus:   enums.SIPStatusValidated,
+		},
+	).Return(&activities.PollSIPStatusesActivityResult{AllExpectedStatus: true}, nil)
+
+	// Mock ingested SIP statuses poll.
+	s.env.OnActivity(
+		activities.PollSIPStatusesActivityName,
+		mock.AnythingOfType("*context.timerCtx"),
+		&activities.PollSIPStatusesActivityParams{
+			BatchUUID:        batchUUID,
+			ExpectedSIPCount: 2,
+			ExpectedStatus:   enums.SIPStatusIngested,
+		},
+	).Return(&activities.PollSIPStatusesActivityResult{AllExpectedStatus: true}, nil)
+
+	// Mock final batch status update.
+	s.env.OnActivity(
+		updateBatchLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&updateBatchLocalActivityParams{
+			UUID:        batchUUID,
+			Status:      enums.BatchStatusIngested,
+			StartedAt:   retryStartedAt,
+			CompletedAt: startTime,
+		},
+	).Return(&updateBatchLocalActivityResult{}, nil)
+
+	s.ExecuteAndValidateWorkflow(
+		&ingest.BatchWorkflowRequest{
+			Batch: datatypes.Batch{
+				UUID:       batchUUID,
+				Identifier: batchIdentifier,
+				Status:     enums.BatchStatusQueued,
+				CreatedAt:  retryStartedAt,
+				StartedAt:  retryStartedAt,
+				SIPSCount:  2,
+			},
+			RetrySIPs: []datatypes.SIP{
+				{
+					UUID:   batchSIP1UUID,
+					Name:   batchSIP1Key,
+					Status: enums.SIPStatusIngested,
+					AIPID:  uuid.NullUUID{UUID: batchAIP1UUID, Valid: true},
+				},
+				{
+					UUID:        batchSIP2UUID,
+					Name:        batchSIP2Key,
+					Status:      enums.SIPStatusFailed,
+					FailedAs:    enums.SIPFailedAsSIP,
+					FailedKey:   failedKey,
+					SIPSourceID: uuid.NullUUID{UUID: sourceID, Valid: true},
+					LocationID:  uuid.NullUUID{UUID: locationID, Valid: true},
+				},
+			},
+		},
+		[]ingest.ProcessingWorkflowRequest{
+			{
+				SIPUUID:         batchSIP2UUID,
+				SIPName:         batchSIP2Key,
+				SIPSourceID:     sourceID,
+				Key:             failedKey,
+				Retry:           true,
+				Type:            enums.WorkflowTypeCreateAip,
+				RetentionPeriod: -1 * time.Second,
+				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
+				LocationID:      &locationID,
+			},
+		},
+		[]ingest.BatchSignal{
+			{Continue: true},
+		},
+		false,
+	)
+}
+
+// TestBatchRetryIngestFailed tests:
+// - Update batch status (processing).
+// - Queue and start a child processing workflow for the failed SIP.
+// - Poll SIP statuses until some fail to ingest.
+// - Update batch status (failed) without waiting for a batch decision.
+func (s *BatchWorkflowTestSuite) TestBatchRetryIngestFailed() {
+	s.SetupWorkflowTest(config.Configuration{})
+
+	failedKey := "Failed_" + batchSIP1Key
+
+	// Mock initial batch status update.
+	s.env.OnActivity(
+		updateBatchLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&updateBatchLocalActivityParams{
+			UUID:      batchUUID,
+			Status:    enums.BatchStatusProcessing,
+			StartedAt: startTime,
+		},
+	).Return(&updateBatchLocalActivityResult{}, nil)
+
+	// Mock failed SIP status update.
+	s.env.OnActivity(
+		setStatusLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		batchSIP1UUID,
+		enums.SIPStatusQueued,
+	).Return(&setStatusLocalActivityResult{}, nil)
+
+	// Mock validated SIP statuses poll.
+	s.env.OnActivity(
+		activities.PollSIPStatusesActivityName,
+		mock.AnythingOfType("*context.timerCtx"),
+		&activities.PollSIPStatusesActivityParams{
+			BatchUUID:        batchUUID,
+			SIPUUIDs:         []uuid.UUID{batchSIP1UUID},
+			ExpectedSIPCount: 1,
+			ExpectedStatus:   enums.SIPStatusValidated,
+		},
+	).Return(&activities.PollSIPStatusesActivityResult{AllExpectedStatus: true}, nil)
+
+	// Mock ingested SIP statuses poll.
+	s.env.OnActivity(
+		activities.PollSIPStatusesActivityName,
+		mock.AnythingOfType("*context.timerCtx"),
+		&activities.PollSIPStatusesActivityParams{
+			BatchUUID:        batchUUID,
+			ExpectedSIPCount: 1,
+			ExpectedStatus:   enums.SIPStatusIngested,
+		},
+	).Return(&activities.PollSIPStatusesActivityResult{AllExpectedStatus: false}, nil)
+
+	// Mock final batch status update.
+	s.env.OnActivity(
+		updateBatchLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&updateBatchLocalActivityParams{
+			UUID:        batchUUID,
+			Status:      enums.BatchStatusFailed,
+			StartedAt:   startTime,
+			CompletedAt: startTime,
+		},
+	).Return(&updateBatchLocalActivityResult{}, nil)
+
+	s.ExecuteAndValidateWorkflow(
+		&ingest.BatchWorkflowRequest{
+			Batch: datatypes.Batch{
+				UUID:       batchUUID,
+				Identifier: batchIdentifier,
+				Status:     enums.BatchStatusQueued,
+				CreatedAt:  startTime,
+				StartedAt:  startTime,
+				SIPSCount:  1,
+			},
+			RetrySIPs: []datatypes.SIP{
+				{
+					UUID:      batchSIP1UUID,
+					Name:      batchSIP1Key,
+					Status:    enums.SIPStatusError,
+					FailedAs:  enums.SIPFailedAsPIP,
+					FailedKey: failedKey,
+				},
+			},
+		},
+		[]ingest.ProcessingWorkflowRequest{
+			{
+				SIPUUID:         batchSIP1UUID,
+				SIPName:         batchSIP1Key,
+				Key:             failedKey,
+				Retry:           true,
+				Type:            enums.WorkflowTypeCreateAip,
+				RetentionPeriod: -1 * time.Second,
+				BatchUUID:       batchUUID,
+				BatchIdentifier: batchIdentifier,
+			},
+		},
+		[]ingest.BatchSignal{
+			{Continue: true},
+		},
+		true,
+	)
+}
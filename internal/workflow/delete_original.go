@@ -47,14 +47,14 @@ func (w *ProcessingWorkflow) deleteOriginalSIP(ctx temporalsdk_workflow.Context,
 
 	// Delete the original SIP based on its origin.
 	activityOpts := withActivityOptsForRequest(ctx)
-	if state.req.WatcherName != "" {
+	if state.req.WatcherName != "" && !state.req.Retry {
 		err = temporalsdk_workflow.ExecuteActivity(
 			activityOpts,
 			activities.DeleteOriginalActivityName,
 			state.req.WatcherName,
 			state.req.Key,
 		).Get(activityOpts, nil)
-	} else if state.req.SIPSourceID != uuid.Nil {
+	} else if state.req.SIPSourceID != uuid.Nil && !state.req.Retry {
 		err = temporalsdk_workflow.ExecuteActivity(
 			activityOpts,
 			activities.DeleteOriginalFromSIPSourceActivityName,
@@ -40,7 +40,8 @@ func (w *ProcessingWorkflow) downloadSIP(sessCtx temporalsdk_workflow.Context, s
 		destinationPath = cfg.SharedPath
 	}
 
-	if state.req.WatcherName != "" {
+	// A retried SIP is downloaded from its failed copy in the internal bucket.
+	if state.req.WatcherName != "" && !state.req.Retry {
 		err = w.watcherDownload(sessCtx, state, destinationPath)
 	} else {
 		err = w.bucketDownload(sessCtx, state, destinationPath)
@@ -108,7 +109,7 @@ func (w *ProcessingWorkflow) bucketDownload(ctx temporalsdk_workflow.Context, st
 	}
 
 	var activityName string
-	if state.req.SIPSourceID != uuid.Nil {
+	if state.req.SIPSourceID != uuid.Nil && !state.req.Retry {
 		// SIP source request, use SIP source bucket.
 		// TODO: At some point there may be multiple SIP sources, so we
 		// should use the source ID to determine which bucket to use.
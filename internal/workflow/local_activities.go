@@ -11,6 +11,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
 	"github.com/artefactual-sdps/enduro/internal/enums"
 	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
 )
 
 type createSIPLocalActivityParams struct {
@@ -40,6 +41,10 @@ type updateSIPLocalActivityParams struct {
 	FileCount    int32
 	ChecksumAlgo datatypes.ChecksumAlgo
 	ChecksumHash string
+
+	// CustomMetadata is the merged custom metadata returned by the child
+	// workflows.
+	CustomMetadata childwf.CustomMetadata
 }
 
 type updateSIPLocalActivityResult struct{}
@@ -99,6 +104,10 @@ func updateSIPLocalActivity(
 				s.ChecksumHash = params.ChecksumHash
 			}
 
+			if len(params.CustomMetadata) > 0 {
+				s.CustomMetadata = params.CustomMetadata
+			}
+
 			return s, nil
 		},
 	)
@@ -259,6 +268,35 @@ func completeTaskLocalActivity(
 	)
 }
 
+type indexSIPLocalActivityParams struct {
+	SIPUUID        uuid.UUID
+	CustomMetadata childwf.CustomMetadata
+}
+
+type indexSIPLocalActivityResult struct{}
+
+func indexSIPLocalActivity(
+	ctx context.Context,
+	ingestsvc ingest.Service,
+	params *indexSIPLocalActivityParams,
+) (*indexSIPLocalActivityResult, error) {
+	return &indexSIPLocalActivityResult{}, ingestsvc.IndexSIP(ctx, params.SIPUUID, params.CustomMetadata)
+}
+
+type checkAIPLocationLocalActivityParams struct {
+	LocationID uuid.UUID
+}
+
+type checkAIPLocationLocalActivityResult struct{}
+
+func checkAIPLocationLocalActivity(
+	ctx context.Context,
+	ingestsvc ingest.Service,
+	params *checkAIPLocationLocalActivityParams,
+) (*checkAIPLocationLocalActivityResult, error) {
+	return &checkAIPLocationLocalActivityResult{}, ingestsvc.CheckAIPLocation(ctx, params.LocationID)
+}
+
 type updateBatchLocalActivityParams struct {
 	UUID        uuid.UUID
 	Status      enums.BatchStatus
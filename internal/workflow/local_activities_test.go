@@ -2,6 +2,7 @@ package workflow
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -18,6 +19,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/enums"
 	ingest_fake "github.com/artefactual-sdps/enduro/internal/ingest/fake"
 	"github.com/artefactual-sdps/enduro/internal/persistence"
+	"github.com/artefactual-sdps/enduro/pkg/childwf"
 )
 
 func TestCreateWorkflowLocalActivity(t *testing.T) {
@@ -231,6 +233,9 @@ func TestUpdateSIPLocalActivity(t *testing.T) {
 				FileCount:    filecount,
 				ChecksumAlgo: datatypes.ChecksumAlgoSHA256,
 				ChecksumHash: hash,
+				CustomMetadata: childwf.CustomMetadata{
+					"external_id": json.RawMessage(`"12345"`),
+				},
 			},
 			mockCalls: func(ctx context.Context, svc *ingest_fake.MockService) {
 				svc.EXPECT().
@@ -251,6 +256,9 @@ func TestUpdateSIPLocalActivity(t *testing.T) {
 								assert.Equal(t, s.FailedKey, failedKey)
 								assert.Equal(t, s.ChecksumAlgorithm, string(datatypes.ChecksumAlgoSHA256))
 								assert.Equal(t, s.ChecksumHash, hash)
+								assert.DeepEqual(t, s.CustomMetadata, map[string]json.RawMessage{
+									"external_id": json.RawMessage(`"12345"`),
+								})
 								return nil
 							},
 						),
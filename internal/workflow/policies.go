@@ -27,6 +27,25 @@ func withActivityOptsForLongLivedRequest(ctx temporalsdk_workflow.Context) tempo
 	})
 }
 
+// withActivityOptsForLongLivedHeartbeatedRequest returns a workflow context
+// with activity options suited for long-running activities that record a
+// heartbeat after each unit of work (e.g. each file processed).
+func withActivityOptsForLongLivedHeartbeatedRequest(ctx temporalsdk_workflow.Context) temporalsdk_workflow.Context {
+	return temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour * 24,
+		HeartbeatTimeout:    time.Minute * 10,
+		RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute * 10,
+			MaximumAttempts:    5,
+			NonRetryableErrorTypes: []string{
+				"TemporalTimeout:StartToClose",
+			},
+		},
+	})
+}
+
 // withActivityOptsForRequest returns a workflow context with activity options
 // suited for short-lived requests that may require multiple attempts.
 func withActivityOptsForRequest(ctx temporalsdk_workflow.Context) temporalsdk_workflow.Context {
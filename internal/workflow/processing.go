@@ -32,6 +32,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
 	"github.com/artefactual-sdps/enduro/internal/enums"
 	"github.com/artefactual-sdps/enduro/internal/ingest"
+	"github.com/artefactual-sdps/enduro/internal/pres"
 	"github.com/artefactual-sdps/enduro/internal/temporal"
 	"github.com/artefactual-sdps/enduro/internal/watcher"
 	"github.com/artefactual-sdps/enduro/internal/workflow/activities"
@@ -101,6 +102,11 @@ func (w *ProcessingWorkflow) registerChildDecisionHandlers(
 func (w *ProcessingWorkflow) cleanup(ctx temporalsdk_workflow.Context, state *workflowState) {
 	state.logger.Debug("Cleaning up workflow state")
 
+	// The workflow has been canceled, e.g. using the cancel SIP endpoint.
+	if ctx.Err() == temporalsdk_workflow.ErrCanceled {
+		state.status = enums.WorkflowStatusCanceled
+	}
+
 	// Set workflow status to "error" unless it completed successfully, failed
 	// due to invalid contents, or was canceled.
 	if state.status != enums.WorkflowStatusDone &&
@@ -140,13 +146,14 @@ func (w *ProcessingWorkflow) cleanup(ctx temporalsdk_workflow.Context, state *wo
 		updateSIPLocalActivity,
 		w.ingestsvc,
 		&updateSIPLocalActivityParams{
-			UUID:        state.sip.uuid,
-			Name:        state.sip.name,
-			AIPUUID:     state.aip.id,
-			CompletedAt: temporalsdk_workflow.Now(dctx).UTC(),
-			Status:      state.sip.status,
-			FailedAs:    failedAs,
-			FailedKey:   state.sip.failed_key,
+			UUID:           state.sip.uuid,
+			Name:           state.sip.name,
+			AIPUUID:        state.aip.id,
+			CompletedAt:    temporalsdk_workflow.Now(dctx).UTC(),
+			Status:         state.sip.status,
+			FailedAs:       failedAs,
+			FailedKey:      state.sip.failed_key,
+			CustomMetadata: state.customMetadata,
 		},
 	).Get(activityOpts, nil)
 	if err != nil {
@@ -180,6 +187,16 @@ func (w *ProcessingWorkflow) sessionCleanup(ctx temporalsdk_workflow.Context, st
 		}
 	}
 
+	// Use a disconnected context so the temporary directories are also
+	// removed after cancellation.
+	if state.status == enums.WorkflowStatusCanceled {
+		ctx, _ = temporalsdk_workflow.NewDisconnectedContext(ctx)
+
+		if state.amTransferID != "" {
+			w.abortAMTransfer(ctx, state)
+		}
+	}
+
 	ctx = temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
 		StartToCloseTimeout: 15 * time.Minute,
 		RetryPolicy: &temporalsdk_temporal.RetryPolicy{
@@ -202,6 +219,26 @@ func (w *ProcessingWorkflow) sessionCleanup(ctx temporalsdk_workflow.Context, st
 	temporalsdk_workflow.CompleteSession(ctx)
 }
 
+// abortAMTransfer removes the Archivematica transfer and ingest of a canceled
+// workflow. ctx must be disconnected from the workflow cancellation.
+func (w *ProcessingWorkflow) abortAMTransfer(ctx temporalsdk_workflow.Context, state *workflowState) {
+	activityOpts := withActivityOptsForRequest(ctx)
+	err := temporalsdk_workflow.ExecuteActivity(
+		activityOpts,
+		am.AbortTransferActivityName,
+		&am.AbortTransferActivityParams{
+			TransferID: state.amTransferID,
+			SIPID:      state.aip.id,
+		},
+	).Get(activityOpts, nil)
+	if err != nil {
+		state.logger.Error(
+			"session cleanup: error aborting Archivematica transfer",
+			"error", err.Error(),
+		)
+	}
+}
+
 // ProcessingWorkflow orchestrates all the activities related to the processing
 // of a SIP in Archivematica, including is retrieval, creation of transfer,
 // etc...
@@ -220,7 +257,8 @@ func (w *ProcessingWorkflow) Execute(
 	}
 
 	// Persist the SIP as early as possible if the request comes from a watcher.
-	if state.req.WatcherName != "" {
+	// A retried SIP has already been persisted.
+	if state.req.WatcherName != "" && !state.req.Retry {
 		activityOpts := withLocalActivityOpts(ctx)
 		err := temporalsdk_workflow.ExecuteLocalActivity(
 			activityOpts,
@@ -228,9 +266,11 @@ func (w *ProcessingWorkflow) Execute(
 			w.ingestsvc,
 			&createSIPLocalActivityParams{
 				SIP: datatypes.SIP{
-					UUID:   state.sip.uuid,
-					Name:   state.sip.name,
-					Status: state.sip.status,
+					UUID:              state.sip.uuid,
+					Name:              state.sip.name,
+					Status:            state.sip.status,
+					ProcessingProfile: state.req.ProcessingProfile,
+					WatcherName:       state.req.WatcherName,
 				},
 			},
 		).Get(activityOpts, nil)
@@ -239,6 +279,9 @@ func (w *ProcessingWorkflow) Execute(
 		}
 	}
 
+	// Make the custom metadata provided with the SIP, if any, searchable.
+	w.indexSIP(ctx, state)
+
 	// Ensure that the status of the SIP and the workflow is always updated when
 	// this function returns.
 	defer w.cleanup(ctx, state)
@@ -316,7 +359,12 @@ func (w *ProcessingWorkflow) SessionHandler(
 	ctx, sessCtx temporalsdk_workflow.Context, attempt int, state *workflowState,
 ) error {
 	// Cleanup session files on exit.
-	defer func() { w.sessionCleanup(sessCtx, state) }()
+	defer func() {
+		if ctx.Err() == temporalsdk_workflow.ErrCanceled {
+			state.status = enums.WorkflowStatusCanceled
+		}
+		w.sessionCleanup(sessCtx, state)
+	}()
 
 	sipStartedAt := temporalsdk_workflow.Now(sessCtx).UTC()
 
@@ -392,18 +440,17 @@ func (w *ProcessingWorkflow) SessionHandler(
 		}
 	}
 
-	// Calculate the SIP checksum if the SIP is not a directory.
-	if !state.sip.isDir {
-		if err := w.calcSIPChecksum(sessCtx, state); err != nil {
-			return err
-		}
+	// Calculate the SIP checksum, a tree hash if the SIP is a directory.
+	if err := w.calcSIPChecksum(sessCtx, state); err != nil {
+		return err
+	}
 
-		// If duplicate SIPs are not allowed, check if a SIP with the same
-		// checksum has already been ingested or is being processed.
-		if !w.cfg.Ingest.AllowDuplicates {
-			if err := w.checkForDuplicateSIP(sessCtx, state); err != nil {
-				return err
-			}
+	// If duplicate SIPs are not allowed and the SIP is not a directory, check
+	// if a SIP with the same checksum has already been ingested or is being
+	// processed.
+	if !state.sip.isDir && !w.cfg.Ingest.AllowDuplicates {
+		if err := w.checkForDuplicateSIP(sessCtx, state); err != nil {
+			return err
 		}
 	}
 
@@ -431,6 +478,16 @@ func (w *ProcessingWorkflow) SessionHandler(
 		}
 	}
 
+	// Verify the checksum manifest included in the SIP, if any.
+	if err := w.verifyManifest(sessCtx, state); err != nil {
+		return err
+	}
+
+	// Scan the SIP for malware.
+	if err := w.scanSIP(sessCtx, state); err != nil {
+		return err
+	}
+
 	// Preprocessing child workflow.
 	if err := w.preprocessing(sessCtx, state); err != nil {
 		return err
@@ -646,6 +703,7 @@ func (w *ProcessingWorkflow) transferA3m(
 		activityOpts := temporalsdk_workflow.WithActivityOptions(sessCtx, temporalsdk_workflow.ActivityOptions{
 			StartToCloseTimeout: time.Hour * 24,
 			HeartbeatTimeout:    time.Second * 5,
+			WaitForCancellation: true,
 			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
 				MaximumAttempts: 1,
 			},
@@ -657,8 +715,17 @@ func (w *ProcessingWorkflow) transferA3m(
 			WorkflowUUID: state.workflowUUID,
 		}
 
+		profile, err := w.processingProfile(state)
+		if err != nil {
+			return sessCtx, err
+		}
+		if profile != nil {
+			processing := w.cfg.A3m.Processing.WithProfile(profile.A3m)
+			params.Processing = &processing
+		}
+
 		result := a3m.CreateAIPActivityResult{}
-		err := temporalsdk_workflow.ExecuteActivity(activityOpts, a3m.CreateAIPActivityName, params).
+		err = temporalsdk_workflow.ExecuteActivity(activityOpts, a3m.CreateAIPActivityName, params).
 			Get(sessCtx, &result)
 		if err != nil {
 			return sessCtx, err
@@ -706,9 +773,10 @@ func (w *ProcessingWorkflow) transferA3m(
 			},
 		})
 		err := temporalsdk_workflow.ExecuteActivity(activityOpts, activities.UploadActivityName, &activities.UploadActivityParams{
-			AIPPath: state.aip.path,
-			AIPID:   state.aip.id,
-			Name:    state.sip.name,
+			AIPPath:        state.aip.path,
+			AIPID:          state.aip.id,
+			Name:           state.sip.name,
+			CustomMetadata: w.aipCustomMetadata(state),
 		}).
 			Get(activityOpts, nil)
 		if err != nil {
@@ -736,9 +804,13 @@ func (w *ProcessingWorkflow) transferA3m(
 	var reviewTaskID int
 
 	if state.req.Type == enums.WorkflowTypeCreateAip {
+		locationID, err := w.aipLocationID(sessCtx, state)
+		if err != nil {
+			return sessCtx, err
+		}
 		reviewResult = &ingest.ReviewPerformedSignal{
 			Accepted:   true,
-			LocationID: &w.cfg.Ingest.Storage.DefaultPermanentLocationID,
+			LocationID: &locationID,
 		}
 	} else {
 		// Set SIP to pending status.
@@ -977,6 +1049,7 @@ func (w *ProcessingWorkflow) transferAM(
 		temporalsdk_workflow.ActivityOptions{
 			StartToCloseTimeout: time.Hour * 2,
 			HeartbeatTimeout:    2 * w.cfg.AM.PollInterval,
+			WaitForCancellation: true,
 			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
 				InitialInterval:    time.Second * 5,
 				BackoffCoefficient: 2,
@@ -998,26 +1071,38 @@ func (w *ProcessingWorkflow) transferAM(
 	}
 
 	// Start AM transfer.
+	profile, err := w.processingProfile(state)
+	if err != nil {
+		return sessCtx, err
+	}
+	var processingConfig string
+	if profile != nil {
+		processingConfig = profile.AMProcessingConfig
+	}
+
 	activityOpts = withActivityOptsForRequest(sessCtx)
 	transferResult := am.StartTransferActivityResult{}
 	err = temporalsdk_workflow.ExecuteActivity(
 		activityOpts,
 		am.StartTransferActivityName,
 		&am.StartTransferActivityParams{
-			Name:         state.sip.name,
-			RelativePath: filepath.Join(uploadResult.RemoteRelativePath, baseName),
-			ZipPIP:       w.cfg.AM.ZipPIP,
+			Name:             state.sip.name,
+			RelativePath:     filepath.Join(uploadResult.RemoteRelativePath, baseName),
+			ZipPIP:           w.cfg.AM.ZipPIP,
+			ProcessingConfig: processingConfig,
 		},
 	).Get(activityOpts, &transferResult)
 	if err != nil {
 		return sessCtx, err
 	}
+	state.amTransferID = transferResult.TransferID
 
 	pollOpts := temporalsdk_workflow.WithActivityOptions(
 		sessCtx,
 		temporalsdk_workflow.ActivityOptions{
 			HeartbeatTimeout:    2 * w.cfg.AM.PollInterval,
 			StartToCloseTimeout: w.cfg.AM.TransferDeadline,
+			WaitForCancellation: true,
 			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
 				InitialInterval:    5 * time.Second,
 				BackoffCoefficient: 2,
@@ -1058,18 +1143,21 @@ func (w *ProcessingWorkflow) transferAM(
 		return sessCtx, err
 	}
 
-	// Create storage AIP record and set location to AMSS location.
+	// Create storage AIP record and set location to AMSS location. The AIP
+	// storage location can't be selected with Archivematica, the ingest API
+	// rejects the SIPs requesting one.
 	{
 		activityOpts := withLocalActivityOpts(sessCtx)
 		err := temporalsdk_workflow.ExecuteActivity(
 			activityOpts,
 			activities.CreateStorageAIPActivityName,
 			&activities.CreateStorageAIPActivityParams{
-				Name:       state.sip.name,
-				AIPID:      state.aip.id,
-				ObjectKey:  state.aip.id,
-				LocationID: &w.cfg.Ingest.Storage.DefaultPermanentLocationID,
-				Status:     "stored",
+				Name:           state.sip.name,
+				AIPID:          state.aip.id,
+				ObjectKey:      state.aip.id,
+				LocationID:     &w.cfg.Ingest.Storage.DefaultPermanentLocationID,
+				Status:         "stored",
+				CustomMetadata: w.aipCustomMetadata(state),
 			}).
 			Get(activityOpts, nil)
 		if err != nil {
@@ -1120,11 +1208,12 @@ func (w *ProcessingWorkflow) preprocessing(ctx temporalsdk_workflow.Context, sta
 		preCtx,
 		cfg.WorkflowName,
 		childwf.PreprocessingParams{
-			User:         state.req.User,
-			RelativePath: relPath,
-			SIPID:        state.sip.uuid,
-			BatchID:      state.req.BatchUUID,
-			SIPName:      state.sip.name,
+			User:           state.req.User,
+			RelativePath:   relPath,
+			SIPID:          state.sip.uuid,
+			BatchID:        state.req.BatchUUID,
+			SIPName:        state.sip.name,
+			CustomMetadata: state.customMetadata,
 		},
 	)
 
@@ -1183,7 +1272,8 @@ func (w *ProcessingWorkflow) preprocessing(ctx temporalsdk_workflow.Context, sta
 		state.sip.path = filepath.Join(cfg.SharedPath, filepath.Clean(ppResult.RelativePath))
 		state.sip.isDir = true
 		state.sip.transformed = true
-		state.customMetadata = ppResult.CustomMetadata
+		state.customMetadata = mergeCustomMetadata(state.customMetadata, ppResult.CustomMetadata)
+		w.indexSIP(ctx, state)
 	}
 
 	if err := w.saveChildwfTasks(ctx, state, ppResult.Tasks); err != nil {
@@ -1365,6 +1455,88 @@ func mergeCustomMetadata(
 	return base
 }
 
+// aipCustomMetadata returns the custom metadata keys of the SIP that are
+// copied onto the AIP, as configured in AIPMetadataKeys.
+func (w *ProcessingWorkflow) aipCustomMetadata(state *workflowState) childwf.CustomMetadata {
+	var md childwf.CustomMetadata
+	for _, k := range w.cfg.Ingest.Storage.AIPMetadataKeys {
+		v, ok := state.customMetadata[k]
+		if !ok {
+			continue
+		}
+		if md == nil {
+			md = make(childwf.CustomMetadata)
+		}
+		md[k] = v
+	}
+
+	return md
+}
+
+// indexSIP adds the custom metadata returned by the child workflows to the
+// search document of the SIP. Errors are logged but don't stop the workflow.
+func (w *ProcessingWorkflow) indexSIP(ctx temporalsdk_workflow.Context, state *workflowState) {
+	if len(state.customMetadata) == 0 {
+		return
+	}
+
+	activityOpts := withLocalActivityOpts(ctx)
+	err := temporalsdk_workflow.ExecuteLocalActivity(
+		activityOpts,
+		indexSIPLocalActivity,
+		w.ingestsvc,
+		&indexSIPLocalActivityParams{
+			SIPUUID:        state.sip.uuid,
+			CustomMetadata: state.customMetadata,
+		},
+	).Get(activityOpts, nil)
+	if err != nil {
+		state.logger.Error("error indexing SIP", "error", err.Error())
+	}
+}
+
+// aipLocationID returns the identifier of the storage location of the AIP: the
+// location requested when the SIP was submitted, the location of the first
+// location rule matching the SIP, or the default permanent location.
+func (w *ProcessingWorkflow) aipLocationID(
+	ctx temporalsdk_workflow.Context,
+	state *workflowState,
+) (uuid.UUID, error) {
+	if state.req.LocationID != nil {
+		return *state.req.LocationID, nil
+	}
+
+	var uploader string
+	if state.req.User != nil {
+		uploader = state.req.User.Email
+	}
+	locationID, ok := w.cfg.Ingest.Storage.AIPLocationID(ingest.SIPLocationAttrs{
+		Watcher:        state.req.WatcherName,
+		SIPSourceID:    state.req.SIPSourceID,
+		Uploader:       uploader,
+		Batch:          state.req.BatchIdentifier,
+		CustomMetadata: state.customMetadata,
+	})
+	if !ok {
+		return locationID, nil
+	}
+
+	// Locations selected by a rule are checked when used, because the storage
+	// locations may change after the configuration is loaded.
+	activityOpts := withLocalActivityOpts(ctx)
+	err := temporalsdk_workflow.ExecuteLocalActivity(
+		activityOpts,
+		checkAIPLocationLocalActivity,
+		w.ingestsvc,
+		&checkAIPLocationLocalActivityParams{LocationID: locationID},
+	).Get(activityOpts, nil)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("check location rule: %v", err)
+	}
+
+	return locationID, nil
+}
+
 // poststorage executes the configured poststorage child workflow and waits for
 // its result.
 func (w *ProcessingWorkflow) poststorage(ctx temporalsdk_workflow.Context, state *workflowState) error {
@@ -1397,6 +1569,9 @@ func (w *ProcessingWorkflow) poststorage(ctx temporalsdk_workflow.Context, state
 	}
 
 	state.customMetadata = mergeCustomMetadata(state.customMetadata, res.CustomMetadata)
+	if len(res.CustomMetadata) > 0 {
+		w.indexSIP(ctx, state)
+	}
 
 	if err := w.saveChildwfTasks(ctx, state, res.Tasks); err != nil {
 		return err
@@ -1486,6 +1661,11 @@ func (w *ProcessingWorkflow) sendFailedToInternalBucket(
 		ext,
 	)
 
+	// The SIP is being retried from its failed key, keep it there.
+	if state.req.Key == state.sip.failed_key {
+		return nil
+	}
+
 	// The SIP is already in the internal bucket.
 	if state.req.WatcherName == "" && state.req.SIPSourceID == uuid.Nil && !state.sip.transformed {
 		// Copy the SIP.
@@ -1620,6 +1800,107 @@ func (w *ProcessingWorkflow) validatePREMIS(
 	return nil
 }
 
+// scanAntivirus returns true if antivirus scanning is enabled for the origin
+// of the SIP: its watcher, its SIP source or an upload.
+// processingProfile returns the processing profile selected for the SIP, or
+// nil if the SIP uses the default processing configuration.
+func (w *ProcessingWorkflow) processingProfile(state *workflowState) (*pres.Profile, error) {
+	name := state.req.ProcessingProfile
+	if name == "" {
+		return nil, nil
+	}
+
+	profile := w.cfg.Preservation.Profiles.ByName(name)
+	if profile == nil {
+		return nil, fmt.Errorf("processing profile %q not found", name)
+	}
+
+	return profile, nil
+}
+
+func (w *ProcessingWorkflow) scanAntivirus(req *ingest.ProcessingWorkflowRequest) bool {
+	if !w.cfg.Antivirus.Enabled() {
+		return false
+	}
+
+	switch {
+	case req.WatcherName != "":
+		return w.cfg.Watcher.ScanAntivirus(req.WatcherName)
+	case req.SIPSourceID != uuid.Nil:
+		return w.cfg.SIPSource.ID == req.SIPSourceID && w.cfg.SIPSource.ScanAntivirus
+	default:
+		return w.cfg.Antivirus.ScanUploads
+	}
+}
+
+// scanSIP scans the SIP files for malware, failing the workflow if any file
+// is infected.
+func (w *ProcessingWorkflow) scanSIP(ctx temporalsdk_workflow.Context, state *workflowState) error {
+	if !w.scanAntivirus(state.req) {
+		return nil
+	}
+
+	id, err := w.createTask(
+		ctx,
+		&datatypes.Task{
+			Name:         "Scan for malware",
+			Status:       enums.TaskStatusInProgress,
+			WorkflowUUID: state.workflowUUID,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("create scan SIP task: %v", err)
+	}
+
+	// Set the default (successful) scan task completion values.
+	task := datatypes.Task{
+		ID:     id,
+		Status: enums.TaskStatusDone,
+		Note:   "No malware found",
+	}
+
+	var result activities.ScanSIPActivityResult
+	activityOpts := withActivityOptsForLongLivedHeartbeatedRequest(ctx)
+	err = temporalsdk_workflow.ExecuteActivity(
+		activityOpts,
+		activities.ScanSIPActivityName,
+		&activities.ScanSIPActivityParams{Path: state.sip.path},
+	).Get(activityOpts, &result)
+	if err != nil {
+		task.SystemError(
+			"Malware scan has failed.",
+			"An error has occurred while attempting to scan the SIP for malware. Please try again, or ask a system administrator to investigate.",
+		)
+		state.status = enums.WorkflowStatusError
+	} else if len(result.Infected) > 0 {
+		infected := make([]string, len(result.Infected))
+		for i, f := range result.Infected {
+			infected[i] = fmt.Sprintf("%s (%s)", f.Path, f.Signature)
+		}
+		task.Failed(
+			"Malware was found in the following files:",
+			strings.Join(infected, "\n"),
+			"Please remove the infected files before reattempting ingest.",
+		)
+		state.status = enums.WorkflowStatusFailed
+		err = fmt.Errorf("%d infected file(s) found", len(result.Infected))
+	}
+
+	// Update the scan task.
+	if e := w.completeTask(ctx, task); e != nil {
+		return errors.Join(
+			err,
+			fmt.Errorf("complete scan SIP task: %v", e),
+		)
+	}
+
+	if err != nil {
+		return fmt.Errorf("scan SIP: %v", err)
+	}
+
+	return nil
+}
+
 func (w *ProcessingWorkflow) updateSIPProcessing(ctx temporalsdk_workflow.Context, state *workflowState) error {
 	activityOpts := withLocalActivityOpts(ctx)
 	return temporalsdk_workflow.ExecuteLocalActivity(
@@ -1814,15 +2095,7 @@ func (w *ProcessingWorkflow) calcSIPChecksum(
 		}
 	}()
 
-	var result activities.CalcFileChecksumActivityResult
-	opts := withActivityOptsForLocalAction(sessCtx)
-	err = temporalsdk_workflow.ExecuteActivity(
-		opts,
-		activities.CalcFileChecksumActivityName,
-		&activities.CalcFileChecksumActivityParams{
-			Path: state.sip.path,
-		},
-	).Get(opts, &result)
+	state.sip.checksum, err = w.calcChecksum(sessCtx, state.sip.path, w.cfg.Ingest.ChecksumAlgo())
 	if err != nil {
 		task.SystemError(
 			"Calculating SIP checksum failed.",
@@ -1832,11 +2105,10 @@ func (w *ProcessingWorkflow) calcSIPChecksum(
 		return err
 	}
 
-	state.sip.checksum = result.Checksum
 	task.Note = fmt.Sprintf("SIP checksum calculated: %s", state.sip.checksum.Hash)
 
 	// Persist the SIP checksum.
-	opts = withLocalActivityOpts(sessCtx)
+	opts := withLocalActivityOpts(sessCtx)
 	err = temporalsdk_workflow.ExecuteLocalActivity(
 		opts,
 		updateSIPLocalActivity,
@@ -1856,6 +2128,130 @@ func (w *ProcessingWorkflow) calcSIPChecksum(
 		return fmt.Errorf("save SIP checksum: %v", err)
 	}
 
+	// Compare the SIP checksum with the checksum provided by the depositor.
+	expected := state.req.ExpectedChecksum
+	if expected == nil {
+		return nil
+	}
+
+	calculated := state.sip.checksum
+	if calculated.Algorithm != expected.Algorithm {
+		calculated, err = w.calcChecksum(sessCtx, state.sip.path, expected.Algorithm)
+		if err != nil {
+			task.SystemError(
+				"Calculating SIP checksum failed.",
+				"An error has occurred while calculating the SIP checksum. Please try again, or ask a system administrator to investigate.",
+			)
+			state.status = enums.WorkflowStatusError
+			return err
+		}
+	}
+
+	if !strings.EqualFold(calculated.Hash, expected.Hash) {
+		task.Failed(
+			"The SIP checksum does not match the expected checksum.",
+			fmt.Sprintf(
+				"Expected %s checksum: %s\nCalculated %s checksum: %s",
+				expected.Algorithm, expected.Hash, calculated.Algorithm, calculated.Hash,
+			),
+			"Please make sure the SIP was transferred completely before reattempting ingest.",
+		)
+		state.status = enums.WorkflowStatusFailed
+		return errors.New("SIP checksum does not match the expected checksum")
+	}
+
+	task.Note += "\n\nSIP checksum matches the expected checksum"
+
+	return nil
+}
+
+func (w *ProcessingWorkflow) calcChecksum(
+	ctx temporalsdk_workflow.Context,
+	path string,
+	algo datatypes.ChecksumAlgo,
+) (datatypes.Checksum, error) {
+	var result activities.CalcFileChecksumActivityResult
+	opts := withActivityOptsForLocalAction(ctx)
+	err := temporalsdk_workflow.ExecuteActivity(
+		opts,
+		activities.CalcFileChecksumActivityName,
+		&activities.CalcFileChecksumActivityParams{
+			Path:      path,
+			Algorithm: algo,
+		},
+	).Get(opts, &result)
+	if err != nil {
+		return datatypes.Checksum{}, err
+	}
+
+	return result.Checksum, nil
+}
+
+// verifyManifest verifies the checksums listed in the checksum manifest
+// included in the SIP, if any. A task is only recorded if a manifest is found.
+func (w *ProcessingWorkflow) verifyManifest(ctx temporalsdk_workflow.Context, state *workflowState) error {
+	if !state.sip.isDir {
+		return nil
+	}
+
+	var result activities.VerifyManifestActivityResult
+	activityOpts := withActivityOptsForLocalAction(ctx)
+	err := temporalsdk_workflow.ExecuteActivity(
+		activityOpts,
+		activities.VerifyManifestActivityName,
+		&activities.VerifyManifestActivityParams{Path: state.sip.path},
+	).Get(activityOpts, &result)
+	if err == nil && result.Manifest == "" {
+		return nil
+	}
+
+	id, e := w.createTask(
+		ctx,
+		&datatypes.Task{
+			Name:         "Verify checksum manifest",
+			Status:       enums.TaskStatusInProgress,
+			WorkflowUUID: state.workflowUUID,
+		},
+	)
+	if e != nil {
+		return errors.Join(err, fmt.Errorf("create verify manifest task: %v", e))
+	}
+
+	// Set the default (successful) verify manifest task completion values.
+	task := datatypes.Task{
+		ID:     id,
+		Status: enums.TaskStatusDone,
+		Note:   fmt.Sprintf("All checksums listed in %s are valid", result.Manifest),
+	}
+
+	if err != nil {
+		task.SystemError(
+			"Checksum manifest verification has failed.",
+			"An error has occurred while attempting to verify the SIP checksum manifest. Please try again, or ask a system administrator to investigate.",
+		)
+		state.status = enums.WorkflowStatusError
+	} else if len(result.Failures) > 0 {
+		task.Failed(
+			fmt.Sprintf("The following entries in %s could not be verified:", result.Manifest),
+			strings.Join(result.Failures, "\n"),
+			"Please make sure the SIP was transferred completely before reattempting ingest.",
+		)
+		state.status = enums.WorkflowStatusFailed
+		err = fmt.Errorf("%d manifest entries could not be verified", len(result.Failures))
+	}
+
+	// Update the verify manifest task.
+	if e := w.completeTask(ctx, task); e != nil {
+		return errors.Join(
+			err,
+			fmt.Errorf("complete verify manifest task: %v", e),
+		)
+	}
+
+	if err != nil {
+		return fmt.Errorf("verify manifest: %v", err)
+	}
+
 	return nil
 }
 
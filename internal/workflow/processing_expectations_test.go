@@ -19,6 +19,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
 	"github.com/artefactual-sdps/enduro/internal/enums"
 	"github.com/artefactual-sdps/enduro/internal/workflow/activities"
+	childwf_pkg "github.com/artefactual-sdps/enduro/pkg/childwf"
 )
 
 const (
@@ -44,6 +45,8 @@ const (
 	CountSIPFilesTaskID = 108
 	calcChecksumTaskID  = 109
 	duplicateSIPTaskID  = 110
+	scanSIPTaskID       = 111
+	verifyManifestID    = 112
 
 	sipName      = "name.zip"
 	key          = "transfer.zip"
@@ -122,6 +125,9 @@ type expectationParams struct {
 
 	// Completed directory for original SIP disposal.
 	completedDir string
+
+	// Custom metadata copied onto the AIP.
+	aipCustomMetadata childwf_pkg.CustomMetadata
 }
 
 // defaultParams returns a new expectationParams instance with sensible defaults.
@@ -164,9 +170,10 @@ var expectations = map[string]expectationFunc{
 			s.workflow.ingestsvc,
 			&createSIPLocalActivityParams{
 				SIP: datatypes.SIP{
-					UUID:   sipUUID,
-					Name:   sipName,
-					Status: enums.SIPStatusQueued,
+					UUID:        sipUUID,
+					Name:        sipName,
+					Status:      enums.SIPStatusQueued,
+					WatcherName: watcherName,
 				},
 			},
 		).Return(sipID, nil)
@@ -268,7 +275,10 @@ var expectations = map[string]expectationFunc{
 		s.env.OnActivity(
 			activities.CalcFileChecksumActivityName,
 			sessionCtx,
-			&activities.CalcFileChecksumActivityParams{Path: params.downloadPath},
+			&activities.CalcFileChecksumActivityParams{
+				Path:      params.downloadPath,
+				Algorithm: datatypes.ChecksumAlgoSHA256,
+			},
 		).Return(
 			&activities.CalcFileChecksumActivityResult{
 				Checksum: datatypes.Checksum{
@@ -484,9 +494,10 @@ var expectations = map[string]expectationFunc{
 			activities.UploadActivityName,
 			sessionCtx,
 			&activities.UploadActivityParams{
-				Name:    sipName,
-				AIPID:   aipUUID.String(),
-				AIPPath: a3mAIPPath,
+				Name:           sipName,
+				AIPID:          aipUUID.String(),
+				AIPPath:        a3mAIPPath,
+				CustomMetadata: params.aipCustomMetadata,
 			},
 		).Return(nil, nil)
 	},
@@ -113,6 +113,10 @@ func (s *ProcessingWorkflowTestSuite) SetupWorkflowTest(
 		activities.NewCalcFileChecksumActivity().Execute,
 		temporalsdk_activity.RegisterOptions{Name: activities.CalcFileChecksumActivityName},
 	)
+	s.env.RegisterActivityWithOptions(
+		activities.NewVerifyManifestActivity().Execute,
+		temporalsdk_activity.RegisterOptions{Name: activities.VerifyManifestActivityName},
+	)
 	s.env.RegisterActivityWithOptions(
 		activities.NewCheckDuplicateSIPActivity(ingestsvc).Execute,
 		temporalsdk_activity.RegisterOptions{Name: activities.CheckDuplicateSIPActivityName},
@@ -137,6 +141,10 @@ func (s *ProcessingWorkflowTestSuite) SetupWorkflowTest(
 		activities.NewClassifySIPActivity().Execute,
 		temporalsdk_activity.RegisterOptions{Name: activities.ClassifySIPActivityName},
 	)
+	s.env.RegisterActivityWithOptions(
+		activities.NewScanSIPActivity(nil).Execute,
+		temporalsdk_activity.RegisterOptions{Name: activities.ScanSIPActivityName},
+	)
 
 	// Set up AM taskqueue.
 	if cfg.Preservation.TaskQueue == temporal.AmWorkerTaskQueue {
@@ -246,6 +254,13 @@ func (s *ProcessingWorkflowTestSuite) setupAMWorkflowTest(
 			Name: am.DeleteTransferActivityName,
 		},
 	)
+	s.env.RegisterActivityWithOptions(
+		am.NewAbortTransferActivity(
+			amclienttest.NewMockTransferService(ctrl),
+			amclienttest.NewMockIngestService(ctrl),
+		).Execute,
+		temporalsdk_activity.RegisterOptions{Name: am.AbortTransferActivityName},
+	)
 }
 
 func (s *ProcessingWorkflowTestSuite) setupA3mWorkflowTest(
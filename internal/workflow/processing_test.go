@@ -21,6 +21,7 @@ import (
 
 	"github.com/artefactual-sdps/enduro/internal/a3m"
 	"github.com/artefactual-sdps/enduro/internal/am"
+	"github.com/artefactual-sdps/enduro/internal/antivirus"
 	"github.com/artefactual-sdps/enduro/internal/childwf"
 	"github.com/artefactual-sdps/enduro/internal/config"
 	"github.com/artefactual-sdps/enduro/internal/datatypes"
@@ -29,6 +30,7 @@ import (
 	"github.com/artefactual-sdps/enduro/internal/premis"
 	"github.com/artefactual-sdps/enduro/internal/pres"
 	"github.com/artefactual-sdps/enduro/internal/temporal"
+	"github.com/artefactual-sdps/enduro/internal/watcher"
 	"github.com/artefactual-sdps/enduro/internal/workflow/activities"
 	"github.com/artefactual-sdps/enduro/internal/workflow/localact"
 	childwf_pkg "github.com/artefactual-sdps/enduro/pkg/childwf"
@@ -210,6 +212,7 @@ func (s *ProcessingWorkflowTestSuite) TestFilesystemWatcherDispose() {
 	expectations["download"](s, params)
 	params.updateTaskParams(copySIPTaskID, enums.TaskStatusDone, "", "SIP successfully copied")
 	expectations["completeTask"](s, params)
+	calcChecksumExpectations(s, params)
 	expectations["classifySIP"](s, params)
 	countSIPFilesExpectations(s, params)
 	expectations["saveFileCount"](s, params)
@@ -360,7 +363,10 @@ func (s *ProcessingWorkflowTestSuite) TestChildWorkflows() {
 	s.SetupWorkflowTest(config.Configuration{
 		A3m:          a3m.Config{ShareDir: s.CreateTransferDir()},
 		Preservation: pres.Config{TaskQueue: temporal.A3mWorkerTaskQueue},
-		Ingest:       ingest.Config{Storage: ingest.StorageConfig{DefaultPermanentLocationID: locationID}},
+		Ingest: ingest.Config{Storage: ingest.StorageConfig{
+			DefaultPermanentLocationID: locationID,
+			AIPMetadataKeys:            []string{"external_id", "missing"},
+		}},
 		ChildWorkflows: childwf.Configs{
 			{
 				Type:         enums.ChildWorkflowTypePreprocessing,
@@ -420,6 +426,16 @@ func (s *ProcessingWorkflowTestSuite) TestChildWorkflows() {
 		nil,
 	)
 
+	s.env.OnActivity(
+		indexSIPLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&indexSIPLocalActivityParams{
+			SIPUUID:        sipUUID,
+			CustomMetadata: preprocessingMetadata,
+		},
+	).Return(&indexSIPLocalActivityResult{}, nil)
+
 	s.env.OnActivity(
 		localact.SaveChildwfTasksActivity,
 		ctx,
@@ -448,6 +464,9 @@ func (s *ProcessingWorkflowTestSuite) TestChildWorkflows() {
 	expectations["completeTask"](s, params)
 	countSIPFilesExpectations(s, params)
 	expectations["saveFileCount"](s, params)
+	params.aipCustomMetadata = childwf_pkg.CustomMetadata{
+		"external_id": json.RawMessage(`"12345"`),
+	}
 	autoApproveA3mExpectations(s, params)
 
 	s.env.OnWorkflow(
@@ -475,6 +494,21 @@ func (s *ProcessingWorkflowTestSuite) TestChildWorkflows() {
 		nil,
 	)
 
+	s.env.OnActivity(
+		indexSIPLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		&indexSIPLocalActivityParams{
+			SIPUUID: sipUUID,
+			CustomMetadata: childwf_pkg.CustomMetadata{
+				"external_id": json.RawMessage(`"12345"`),
+				"flags":       json.RawMessage(`{"validated":true}`),
+				"package":     json.RawMessage(`{"type":"aip","identifier":"AIP-67890"}`),
+				"storage":     json.RawMessage(`{"notified":true,"location":"permanent"}`),
+			},
+		},
+	).Return(&indexSIPLocalActivityResult{}, nil)
+
 	s.env.OnActivity(
 		localact.SaveChildwfTasksActivity,
 		ctx,
@@ -781,6 +815,154 @@ func (s *ProcessingWorkflowTestSuite) TestFailedPIPA3m() {
 	}, nil, true)
 }
 
+// TestCanceledA3m tests:
+// - a3m as preservation system.
+// - The "create AIP" workflow type.
+// - Workflow cancellation while a3m is creating the AIP.
+// - Cleanup without moving to failed.
+// - Watched bucket download.
+func (s *ProcessingWorkflowTestSuite) TestCanceledA3m() {
+	s.SetupWorkflowTest(config.Configuration{
+		A3m:          a3m.Config{ShareDir: s.CreateTransferDir()},
+		Preservation: pres.Config{TaskQueue: temporal.A3mWorkerTaskQueue},
+		Ingest:       ingest.Config{Storage: ingest.StorageConfig{DefaultPermanentLocationID: locationID}},
+	}, nil)
+
+	params := defaultParams()
+	downloadExpectations(s, params)
+	calcChecksumExpectations(s, params)
+	checkDuplicateSIPExpectations(s, params)
+	expectations["archiveExtract"](s, params)
+	expectations["classifySIP"](s, params)
+	countSIPFilesExpectations(s, params)
+	expectations["saveFileCount"](s, params)
+	expectations["bundle"](s, params)
+
+	// Cancel the workflow while a3m is creating the AIP.
+	s.env.OnActivity(
+		a3m.CreateAIPActivityName,
+		sessionCtx,
+		&a3m.CreateAIPActivityParams{
+			Name:         sipName,
+			Path:         transferPath,
+			WorkflowUUID: workflowUUID,
+		},
+	).After(time.Hour).Return(&a3m.CreateAIPActivityResult{}, nil)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.CancelWorkflow()
+	}, time.Minute)
+
+	params.sipStatus = enums.SIPStatusCanceled
+	expectations["removePaths"](s, params)
+	expectations["updateSIPFailed"](s, params)
+	expectations["completeWorkflow"](s, params)
+
+	s.ExecuteAndValidateWorkflow(&ingest.ProcessingWorkflowRequest{
+		Key:         key,
+		WatcherName: watcherName,
+		Type:        enums.WorkflowTypeCreateAip,
+		SIPUUID:     sipUUID,
+		SIPName:     sipName,
+	}, &ingest.ProcessingWorkflowResult{}, false)
+}
+
+// TestCanceledAM tests:
+// - Archivematica as preservation system.
+// - The "create AIP" workflow type.
+// - Workflow cancellation while Archivematica is ingesting the transfer.
+// - Abort of the Archivematica transfer.
+// - Cleanup without moving to failed.
+// - Watched bucket download.
+func (s *ProcessingWorkflowTestSuite) TestCanceledAM() {
+	s.SetupWorkflowTest(config.Configuration{
+		AM:           am.Config{ZipPIP: true, TransferDeadline: time.Hour * 2},
+		Preservation: pres.Config{TaskQueue: temporal.AmWorkerTaskQueue},
+		Ingest:       ingest.Config{Storage: ingest.StorageConfig{DefaultPermanentLocationID: amssLocationID}},
+	}, nil)
+
+	params := defaultParams()
+	downloadExpectations(s, params)
+	calcChecksumExpectations(s, params)
+	checkDuplicateSIPExpectations(s, params)
+	expectations["archiveExtract"](s, params)
+	expectations["classifySIP"](s, params)
+	countSIPFilesExpectations(s, params)
+	expectations["saveFileCount"](s, params)
+	expectations["createBag"](s, params)
+	expectations["zipArchive"](s, params)
+
+	baseName := filepath.Base(extractPath)
+	s.env.OnActivity(
+		am.UploadTransferActivityName,
+		sessionCtx,
+		&am.UploadTransferActivityParams{SourcePath: extractPath + "/"},
+	).Return(&am.UploadTransferActivityResult{RemoteRelativePath: baseName}, nil)
+	s.env.OnActivity(
+		am.StartTransferActivityName,
+		sessionCtx,
+		&am.StartTransferActivityParams{
+			Name:         sipName,
+			RelativePath: filepath.Join(baseName, key),
+			ZipPIP:       true,
+		},
+	).Return(&am.StartTransferActivityResult{TransferID: transferID.String()}, nil)
+	s.env.OnActivity(
+		am.PollTransferActivityName,
+		sessionCtx,
+		&am.PollTransferActivityParams{
+			TransferID:   transferID.String(),
+			WorkflowUUID: workflowUUID,
+		},
+	).Return(&am.PollTransferActivityResult{SIPID: aipUUID.String()}, nil)
+
+	// Cancel the workflow while Archivematica is ingesting the transfer.
+	s.env.OnActivity(
+		am.PollIngestActivityName,
+		sessionCtx,
+		&am.PollIngestActivityParams{
+			SIPID:        aipUUID.String(),
+			WorkflowUUID: workflowUUID,
+		},
+	).After(time.Hour).Return(&am.PollIngestActivityResult{Status: "COMPLETE"}, nil)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.CancelWorkflow()
+	}, time.Minute)
+
+	// The Archivematica transfer is aborted on cleanup.
+	s.env.OnActivity(
+		am.AbortTransferActivityName,
+		sessionCtx,
+		&am.AbortTransferActivityParams{
+			TransferID: transferID.String(),
+			SIPID:      aipUUID.String(),
+		},
+	).Return(&am.AbortTransferActivityResult{}, nil).Once()
+
+	params.removePaths = []string{tempPath, extractPath + "/transfer.zip"}
+	params.sipStatus = enums.SIPStatusCanceled
+	expectations["removePaths"](s, params)
+	s.env.OnActivity(
+		updateSIPLocalActivity,
+		ctx,
+		s.workflow.ingestsvc,
+		mock.MatchedBy(func(updateParams *updateSIPLocalActivityParams) bool {
+			return updateParams.UUID == sipUUID &&
+				updateParams.AIPUUID == aipUUID.String() &&
+				updateParams.Status == enums.SIPStatusCanceled &&
+				updateParams.FailedKey == ""
+		}),
+	).Return(nil, nil)
+	expectations["completeWorkflow"](s, params)
+
+	s.ExecuteAndValidateWorkflow(&ingest.ProcessingWorkflowRequest{
+		Key:         key,
+		WatcherName: watcherName,
+		Type:        enums.WorkflowTypeCreateAip,
+		SIPUUID:     sipUUID,
+		SIPName:     sipName,
+	}, &ingest.ProcessingWorkflowResult{}, false)
+}
+
 // TestFailedPIPAM tests:
 // - Archivematica as preservation system.
 // - The "create AIP" workflow type.
@@ -895,6 +1077,73 @@ func (s *ProcessingWorkflowTestSuite) TestInternalUpload() {
 	}, &ingest.ProcessingWorkflowResult{}, false)
 }
 
+// TestWatcherRetry tests:
+// - a3m as preservation system.
+// - The "create AIP" workflow type.
+// - Retry of a watcher SIP, which is not persisted again.
+// - Failed SIP download from the internal bucket, not the watcher.
+// - Failed SIP deletion from the internal bucket after the retention period.
+func (s *ProcessingWorkflowTestSuite) TestWatcherRetry() {
+	s.SetupWorkflowTest(config.Configuration{
+		A3m:          a3m.Config{ShareDir: s.CreateTransferDir()},
+		Preservation: pres.Config{TaskQueue: temporal.A3mWorkerTaskQueue},
+		Ingest:       ingest.Config{Storage: ingest.StorageConfig{DefaultPermanentLocationID: locationID}},
+	}, nil)
+
+	params := defaultParams()
+	expectations["setStatusInProgress"](s, params)
+	expectations["createWorkflow"](s, params)
+	params.updateTaskParams(copySIPTaskID, enums.TaskStatusInProgress, "Copy SIP to workspace", "")
+	expectations["createTask"](s, params)
+
+	s.env.OnActivity(
+		activities.DownloadFromInternalBucketActivityName,
+		sessionCtx,
+		&bucketdownload.Params{Key: key},
+	).Return(&bucketdownload.Result{FilePath: tempPath + "/" + key}, nil)
+
+	params.updateTaskParams(copySIPTaskID, enums.TaskStatusDone, "", "SIP successfully copied")
+	expectations["completeTask"](s, params)
+	calcChecksumExpectations(s, params)
+	checkDuplicateSIPExpectations(s, params)
+	expectations["archiveExtract"](s, params)
+	expectations["classifySIP"](s, params)
+	countSIPFilesExpectations(s, params)
+	expectations["saveFileCount"](s, params)
+	autoApproveA3mExpectations(s, params)
+
+	expectations["removePaths"](s, params)
+	params.updateTaskParams(
+		deleteSIPTaskID,
+		enums.TaskStatusInProgress,
+		"Delete original SIP",
+		fmt.Sprintf("The original SIP will be deleted in %s", retentionPeriod),
+	)
+	expectations["createTask"](s, params)
+
+	s.env.OnActivity(
+		activities.DeleteOriginalFromInternalBucketActivityName,
+		sessionCtx,
+		&bucketdelete.Params{Key: key},
+	).Return(&bucketdelete.Result{}, nil)
+
+	params.updateTaskParams(deleteSIPTaskID, enums.TaskStatusDone, "", "SIP successfully deleted")
+	expectations["completeTask"](s, params)
+	expectations["updateSIPIngested"](s, params)
+	expectations["completeWorkflow"](s, params)
+
+	s.ExecuteAndValidateWorkflow(&ingest.ProcessingWorkflowRequest{
+		Key:             key,
+		WatcherName:     watcherName,
+		Retry:           true,
+		Type:            enums.WorkflowTypeCreateAip,
+		RetentionPeriod: retentionPeriod,
+		SIPUUID:         sipUUID,
+		SIPName:         sipName,
+		Extension:       ".zip",
+	}, &ingest.ProcessingWorkflowResult{}, false)
+}
+
 // TestInternalUploadError tests:
 // - a3m as preservation system.
 // - The "create AIP" workflow type.
@@ -1278,6 +1527,212 @@ func (s *ProcessingWorkflowTestSuite) TestAllowDuplicateSIP() {
 	}, &ingest.ProcessingWorkflowResult{}, false)
 }
 
+// TestInfectedSIP tests:
+// - a3m as preservation system.
+// - The "create AIP" workflow type.
+// - Antivirus scanning enabled for the watcher.
+// - Malware found in the SIP.
+// - Move to failed SIP.
+// - Watched bucket download.
+func (s *ProcessingWorkflowTestSuite) TestInfectedSIP() {
+	s.SetupWorkflowTest(config.Configuration{
+		A3m:          a3m.Config{ShareDir: s.CreateTransferDir()},
+		Antivirus:    antivirus.Config{Address: "tcp://clamav:3310"},
+		Preservation: pres.Config{TaskQueue: temporal.A3mWorkerTaskQueue},
+		Ingest: ingest.Config{
+			AllowDuplicates: true,
+			Storage:         ingest.StorageConfig{DefaultPermanentLocationID: locationID},
+		},
+		Watcher: watcher.Config{
+			Minio: []*watcher.MinioConfig{{Name: watcherName, ScanAntivirus: true}},
+		},
+	}, nil)
+
+	params := defaultParams()
+	downloadExpectations(s, params)
+	calcChecksumExpectations(s, params)
+	expectations["archiveExtract"](s, params)
+
+	params.updateTaskParams(scanSIPTaskID, enums.TaskStatusInProgress, "Scan for malware", "")
+	expectations["createTask"](s, params)
+
+	s.env.OnActivity(
+		activities.ScanSIPActivityName,
+		sessionCtx,
+		&activities.ScanSIPActivityParams{Path: params.extractPath},
+	).Return(
+		&activities.ScanSIPActivityResult{
+			Infected: []activities.InfectedFile{
+				{Path: "data/eicar.com", Signature: "Eicar-Test-Signature"},
+			},
+		},
+		nil,
+	)
+
+	params.updateTaskParams(
+		scanSIPTaskID,
+		enums.TaskStatusFailed,
+		"",
+		"Content error: Malware was found in the following files:\n\ndata/eicar.com (Eicar-Test-Signature)\n\nPlease remove the infected files before reattempting ingest.",
+	)
+	expectations["completeTask"](s, params)
+
+	params.sipStatus = enums.SIPStatusFailed
+	params.failedAs = enums.SIPFailedAsSIP
+	params.failedKey = failedSIPKey
+	params.removePaths = []string{tempPath}
+	expectations["uploadToFailed"](s, params)
+	expectations["removePaths"](s, params)
+	expectations["updateSIPFailed"](s, params)
+	expectations["completeWorkflow"](s, params)
+
+	s.ExecuteAndValidateWorkflow(&ingest.ProcessingWorkflowRequest{
+		Key:         key,
+		WatcherName: watcherName,
+		Type:        enums.WorkflowTypeCreateAip,
+		SIPUUID:     sipUUID,
+		SIPName:     sipName,
+	}, nil, true)
+}
+
+// TestExpectedChecksumMismatch tests:
+// - a3m as preservation system.
+// - The "create AIP" workflow type.
+// - Expected checksum using a different algorithm than the configured one.
+// - SIP checksum mismatch.
+// - Move to failed SIP.
+// - Watched bucket download.
+func (s *ProcessingWorkflowTestSuite) TestExpectedChecksumMismatch() {
+	s.SetupWorkflowTest(config.Configuration{
+		A3m:          a3m.Config{ShareDir: s.CreateTransferDir()},
+		Preservation: pres.Config{TaskQueue: temporal.A3mWorkerTaskQueue},
+		Ingest: ingest.Config{
+			AllowDuplicates: true,
+			Storage:         ingest.StorageConfig{DefaultPermanentLocationID: locationID},
+		},
+	}, nil)
+
+	params := defaultParams()
+	downloadExpectations(s, params)
+
+	params.updateTaskParams(calcChecksumTaskID, enums.TaskStatusInProgress, "Calculate SIP checksum", "")
+	expectations["createTask"](s, params)
+	expectations["calcFileChecksum"](s, params)
+	expectations["saveChecksum"](s, params)
+
+	s.env.OnActivity(
+		activities.CalcFileChecksumActivityName,
+		sessionCtx,
+		&activities.CalcFileChecksumActivityParams{
+			Path:      params.downloadPath,
+			Algorithm: datatypes.ChecksumAlgoMD5,
+		},
+	).Return(
+		&activities.CalcFileChecksumActivityResult{
+			Checksum: datatypes.Checksum{
+				Algorithm: datatypes.ChecksumAlgoMD5,
+				Hash:      "098f6bcd4621d373cade4e832627b4f6",
+			},
+		},
+		nil,
+	)
+
+	params.updateTaskParams(
+		calcChecksumTaskID,
+		enums.TaskStatusFailed,
+		"",
+		"Content error: The SIP checksum does not match the expected checksum.\n\nExpected MD5 checksum: 0cc175b9c0f1b6a831c399e269772661\nCalculated MD5 checksum: 098f6bcd4621d373cade4e832627b4f6\n\nPlease make sure the SIP was transferred completely before reattempting ingest.",
+	)
+	expectations["completeTask"](s, params)
+
+	params.sipStatus = enums.SIPStatusFailed
+	params.failedAs = enums.SIPFailedAsSIP
+	params.failedKey = failedSIPKey
+	params.removePaths = []string{tempPath}
+	expectations["uploadToFailed"](s, params)
+	expectations["removePaths"](s, params)
+	expectations["updateSIPFailed"](s, params)
+	expectations["completeWorkflow"](s, params)
+
+	s.ExecuteAndValidateWorkflow(&ingest.ProcessingWorkflowRequest{
+		Key:         key,
+		WatcherName: watcherName,
+		Type:        enums.WorkflowTypeCreateAip,
+		SIPUUID:     sipUUID,
+		SIPName:     sipName,
+		ExpectedChecksum: &datatypes.Checksum{
+			Algorithm: datatypes.ChecksumAlgoMD5,
+			Hash:      "0cc175b9c0f1b6a831c399e269772661",
+		},
+	}, nil, true)
+}
+
+// TestManifestMismatch tests:
+// - a3m as preservation system.
+// - The "create AIP" workflow type.
+// - Checksum manifest included in the SIP.
+// - Checksum manifest verification failures.
+// - Move to failed SIP.
+// - Watched bucket download.
+func (s *ProcessingWorkflowTestSuite) TestManifestMismatch() {
+	s.SetupWorkflowTest(config.Configuration{
+		A3m:          a3m.Config{ShareDir: s.CreateTransferDir()},
+		Preservation: pres.Config{TaskQueue: temporal.A3mWorkerTaskQueue},
+		Ingest: ingest.Config{
+			AllowDuplicates: true,
+			Storage:         ingest.StorageConfig{DefaultPermanentLocationID: locationID},
+		},
+	}, nil)
+
+	params := defaultParams()
+	downloadExpectations(s, params)
+	calcChecksumExpectations(s, params)
+	expectations["archiveExtract"](s, params)
+
+	s.env.OnActivity(
+		activities.VerifyManifestActivityName,
+		sessionCtx,
+		&activities.VerifyManifestActivityParams{Path: params.extractPath},
+	).Return(
+		&activities.VerifyManifestActivityResult{
+			Manifest:  "metadata/checksum.sha256",
+			Algorithm: datatypes.ChecksumAlgoSHA256,
+			Failures: []string{
+				"objects/a.txt: checksum mismatch",
+				"objects/b.txt: file not found",
+			},
+		},
+		nil,
+	)
+
+	params.updateTaskParams(verifyManifestID, enums.TaskStatusInProgress, "Verify checksum manifest", "")
+	expectations["createTask"](s, params)
+	params.updateTaskParams(
+		verifyManifestID,
+		enums.TaskStatusFailed,
+		"",
+		"Content error: The following entries in metadata/checksum.sha256 could not be verified:\n\nobjects/a.txt: checksum mismatch\nobjects/b.txt: file not found\n\nPlease make sure the SIP was transferred completely before reattempting ingest.",
+	)
+	expectations["completeTask"](s, params)
+
+	params.sipStatus = enums.SIPStatusFailed
+	params.failedAs = enums.SIPFailedAsSIP
+	params.failedKey = failedSIPKey
+	params.removePaths = []string{tempPath}
+	expectations["uploadToFailed"](s, params)
+	expectations["removePaths"](s, params)
+	expectations["updateSIPFailed"](s, params)
+	expectations["completeWorkflow"](s, params)
+
+	s.ExecuteAndValidateWorkflow(&ingest.ProcessingWorkflowRequest{
+		Key:         key,
+		WatcherName: watcherName,
+		Type:        enums.WorkflowTypeCreateAip,
+		SIPUUID:     sipUUID,
+		SIPName:     sipName,
+	}, nil, true)
+}
+
 // TestCalculateSIPChecksumSysError tests:
 // - Archivematica as preservation system.
 // - The "create AIP" workflow type.
@@ -1306,7 +1761,10 @@ func (s *ProcessingWorkflowTestSuite) TestCalculateSIPChecksumSysError() {
 	s.env.OnActivity(
 		activities.CalcFileChecksumActivityName,
 		sessionCtx,
-		&activities.CalcFileChecksumActivityParams{Path: params.downloadPath},
+		&activities.CalcFileChecksumActivityParams{
+			Path:      params.downloadPath,
+			Algorithm: datatypes.ChecksumAlgoSHA256,
+		},
 	).Return(
 		nil,
 		errors.New("checksum error"),
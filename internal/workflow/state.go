@@ -43,6 +43,12 @@ type workflowState struct {
 	sip *sipInfo
 	aip *aipInfo
 
+	// amTransferID is the identifier of the Archivematica transfer.
+	//
+	// It is populated when the transfer is started and used to abort it if
+	// the workflow is canceled.
+	amTransferID string
+
 	// customMetadata is opaque metadata shared between child workflows.
 	customMetadata childwf.CustomMetadata
 
@@ -70,6 +76,9 @@ func newWorkflowState(ctx temporalsdk_workflow.Context, req *ingest.ProcessingWo
 
 		// Initialize the AIP to empty struct to avoid nil pointer errors.
 		aip: &aipInfo{},
+
+		// Start with the custom metadata provided with the SIP, if any.
+		customMetadata: mergeCustomMetadata(nil, req.CustomMetadata),
 	}
 }
 
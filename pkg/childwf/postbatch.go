@@ -26,7 +26,20 @@ type PostbatchSIP struct {
 	AIPID     *uuid.UUID // Nullable.
 	FileCount int32
 
-	// CustomMetadata is opaque metadata returned by the processing workflow.
+	// Key is the key of the SIP object in the SIP source.
+	Key string
+
+	// ProcessingProfile is the name of the processing profile used for the
+	// SIP, if any.
+	ProcessingProfile string
+
+	// LocationID is the identifier of the storage location requested for the
+	// AIP in the batch manifest, if any.
+	LocationID *uuid.UUID // Nullable.
+
+	// CustomMetadata is opaque metadata returned by the processing workflow,
+	// or the custom fields given in the batch manifest if the processing
+	// workflow didn't return any.
 	CustomMetadata CustomMetadata
 }
 
@@ -23,6 +23,10 @@ type PreprocessingParams struct {
 
 	// SIPName is the original filename of the SIP being processed.
 	SIPName string
+
+	// CustomMetadata is opaque metadata provided with the SIP, e.g. in a batch
+	// manifest, if any.
+	CustomMetadata CustomMetadata
 }
 
 type PreprocessingResult struct {